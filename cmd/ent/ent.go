@@ -19,6 +19,8 @@ func main() {
 		base.InitCmd(),
 		base.DescribeCmd(),
 		base.GenerateCmd(),
+		base.CompatCmd(),
+		base.ImportCmd(),
 	)
 	_ = cmd.Execute()
 }
@@ -23,6 +23,8 @@ func main() {
 		base.InitCmd(),
 		base.DescribeCmd(),
 		base.GenerateCmd(migrate),
+		base.CompatCmd(),
+		base.ImportCmd(),
 	)
 	_ = cmd.Execute()
 }
@@ -18,6 +18,7 @@ import (
 
 	"entgo.io/ent/cmd/internal/printer"
 	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/compat"
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema/field"
 
@@ -92,12 +93,14 @@ func InitCmd() *cobra.Command {
 
 // DescribeCmd returns the describe command for ent/c packages.
 func DescribeCmd() *cobra.Command {
-	return &cobra.Command{
+	var jsonOut bool
+	cmd := &cobra.Command{
 		Use:   "describe [flags] path",
 		Short: "printer a description of the graph schema",
 		Example: examples(
 			"ent describe ./ent/schema",
 			"ent describe github.com/a8m/x",
+			"ent describe --json ./ent/schema",
 		),
 		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, path []string) {
@@ -105,9 +108,17 @@ func DescribeCmd() *cobra.Command {
 			if err != nil {
 				log.Fatalln(err)
 			}
-			printer.Fprint(os.Stdout, graph)
+			if !jsonOut {
+				printer.Fprint(os.Stdout, graph)
+				return
+			}
+			if err := printer.FprintJSON(os.Stdout, graph); err != nil {
+				log.Fatalln(err)
+			}
 		},
 	}
+	cmd.Flags().BoolVar(&jsonOut, "json", false, "print the description in JSON format, including the lineage of the fields")
+	return cmd
 }
 
 // GenerateCmd returns the generate command for ent/c packages.
@@ -170,11 +181,64 @@ func GenerateCmd(postRun ...func(*gen.Config)) *cobra.Command {
 	cmd.Flags().StringVar(&storage, "storage", "sql", "storage driver to support in codegen")
 	cmd.Flags().StringVar(&cfg.Header, "header", "", "override codegen header")
 	cmd.Flags().StringVar(&cfg.Target, "target", "", "target directory for codegen")
+	cmd.Flags().StringVar(&cfg.BuildTarget, "build-target", "", "build target to generate the code for")
 	cmd.Flags().StringSliceVarP(&features, "feature", "", nil, "extend codegen with additional features")
 	cmd.Flags().StringSliceVarP(&templates, "template", "", nil, "external templates to execute")
 	return cmd
 }
 
+// CompatCmd returns the compat command for ent/c packages.
+func CompatCmd() *cobra.Command {
+	var (
+		failOn string
+		cmd    = &cobra.Command{
+			Use:   "compat [flags] old new",
+			Short: "classify the database changes between two versions of the schema",
+			Example: examples(
+				"ent compat base/ent/internal/schema.go ./ent/internal/schema.go",
+				"ent compat --fail-on migration base/ent/internal/schema.go ./ent/schema",
+			),
+			Args: cobra.ExactArgs(2),
+			Run: func(cmd *cobra.Command, path []string) {
+				level, err := compat.ParseLevel(failOn)
+				if err != nil {
+					log.Fatalln(err)
+				}
+				graphs := make([]*gen.Graph, 2)
+				for i := range path {
+					if graphs[i], err = loadCompat(path[i]); err != nil {
+						log.Fatalln(err)
+					}
+				}
+				report, err := compat.Compare(graphs[0], graphs[1])
+				if err != nil {
+					log.Fatalln(err)
+				}
+				for _, c := range report.Changes {
+					fmt.Println(c)
+				}
+				if len(report.Changes) > 0 && report.Level() >= level {
+					os.Exit(1)
+				}
+			},
+		}
+	)
+	cmd.Flags().StringVar(&failOn, "fail-on", compat.Breaking.String(), "exit with an error on changes at this level or above (compatible, migration or breaking)")
+	return cmd
+}
+
+// loadCompat loads the graph of a schema snapshot file, or a schema package.
+func loadCompat(path string) (*gen.Graph, error) {
+	if filepath.Ext(path) != ".go" {
+		return entc.LoadGraph(path, &gen.Config{})
+	}
+	snap, err := compat.ReadSnapshot(path)
+	if err != nil {
+		return nil, err
+	}
+	return gen.NewGraph(&gen.Config{Schema: snap.Schema, Package: snap.Package}, snap.Schemas...)
+}
+
 // initEnv initialize an environment for ent codegen.
 func initEnv(target string, names []string) error {
 	if err := createDir(target); err != nil {
@@ -5,6 +5,7 @@
 package printer
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
@@ -33,6 +34,95 @@ func Fprint(w io.Writer, g *gen.Graph) {
 	Config{Writer: w}.Print(g)
 }
 
+// FprintJSON writes a JSON description of the graph to the given writer.
+func FprintJSON(w io.Writer, g *gen.Graph) error {
+	return Config{Writer: w}.PrintJSON(g)
+}
+
+type (
+	// jsonType is the JSON description of a type.
+	jsonType struct {
+		Name   string       `json:"name"`
+		Fields []*jsonField `json:"fields"`
+		Edges  []*jsonEdge  `json:"edges,omitempty"`
+	}
+	// jsonField is the JSON description of a field.
+	jsonField struct {
+		Name          string       `json:"name"`
+		Type          string       `json:"type"`
+		Column        string       `json:"column"`
+		Unique        bool         `json:"unique,omitempty"`
+		Optional      bool         `json:"optional,omitempty"`
+		Nillable      bool         `json:"nillable,omitempty"`
+		Default       bool         `json:"default,omitempty"`
+		UpdateDefault bool         `json:"update_default,omitempty"`
+		Immutable     bool         `json:"immutable,omitempty"`
+		Sensitive     bool         `json:"sensitive,omitempty"`
+		Comment       string       `json:"comment,omitempty"`
+		Lineage       *jsonLineage `json:"lineage,omitempty"`
+	}
+	// jsonLineage is the JSON description of the lineage of a field.
+	jsonLineage struct {
+		Owner          string `json:"owner,omitempty"`
+		Classification string `json:"classification,omitempty"`
+		Retention      string `json:"retention,omitempty"`
+	}
+	// jsonEdge is the JSON description of an edge.
+	jsonEdge struct {
+		Name     string `json:"name"`
+		Type     string `json:"type"`
+		Inverse  bool   `json:"inverse,omitempty"`
+		Relation string `json:"relation"`
+		Unique   bool   `json:"unique,omitempty"`
+		Optional bool   `json:"optional,omitempty"`
+	}
+)
+
+// PrintJSON prints a JSON description of the graph to the given writer, including
+// the lineage information of the fields (e.g. owner team or data classification).
+func (p Config) PrintJSON(g *gen.Graph) error {
+	types := make([]*jsonType, 0, len(g.Nodes))
+	for _, n := range g.Nodes {
+		t := &jsonType{Name: n.Name}
+		for _, f := range append([]*gen.Field{n.ID}, n.Fields...) {
+			jf := &jsonField{
+				Name:          f.Name,
+				Type:          f.Type.String(),
+				Column:        f.StorageKey(),
+				Unique:        f.Unique,
+				Optional:      f.Optional,
+				Nillable:      f.Nillable,
+				Default:       f.Default,
+				UpdateDefault: f.UpdateDefault,
+				Immutable:     f.Immutable,
+				Sensitive:     f.Sensitive(),
+				Comment:       f.Comment(),
+			}
+			if l := f.Lineage(); l != nil {
+				jf.Lineage = &jsonLineage{Owner: l.Owner, Classification: string(l.Classification)}
+				if l.Retention != 0 {
+					jf.Lineage.Retention = l.Retention.String()
+				}
+			}
+			t.Fields = append(t.Fields, jf)
+		}
+		for _, e := range n.Edges {
+			t.Edges = append(t.Edges, &jsonEdge{
+				Name:     e.Name,
+				Type:     e.Type.Name,
+				Inverse:  e.IsInverse(),
+				Relation: e.Rel.Type.String(),
+				Unique:   e.Unique,
+				Optional: e.Optional,
+			})
+		}
+		types = append(types, t)
+	}
+	enc := json.NewEncoder(p)
+	enc.SetIndent("", "  ")
+	return enc.Encode(types)
+}
+
 // node returns description of a type. The format of the description is:
 //
 //	Type:
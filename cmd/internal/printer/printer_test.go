@@ -7,8 +7,11 @@ package printer
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/entlineage"
 	"entgo.io/ent/schema/field"
 
 	"github.com/stretchr/testify/assert"
@@ -175,3 +178,31 @@ Group:
 		assert.Equal(t, tt.out, "\n"+b.String())
 	}
 }
+
+func TestPrinter_PrintJSON(t *testing.T) {
+	g, err := gen.NewGraph(&gen.Config{}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true, Annotations: map[string]interface{}{
+				entlineage.Annotation{}.Name(): entlineage.Annotation{Classification: entlineage.Confidential, Retention: 720 * time.Hour},
+			}},
+		},
+		Annotations: map[string]interface{}{
+			entlineage.Annotation{}.Name(): entlineage.Owner("identity"),
+		},
+	})
+	assert.NoError(t, err)
+	b := &strings.Builder{}
+	assert.NoError(t, FprintJSON(b, g))
+	assert.JSONEq(t, `[
+  {
+    "name": "User",
+    "fields": [
+      {"name": "id", "type": "int", "column": "id", "lineage": {"owner": "identity"}},
+      {"name": "name", "type": "string", "column": "name", "lineage": {"owner": "identity"}},
+      {"name": "email", "type": "string", "column": "email", "unique": true, "lineage": {"owner": "identity", "classification": "confidential", "retention": "720h0m0s"}}
+    ]
+  }
+]`, b.String())
+}
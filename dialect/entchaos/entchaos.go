@@ -0,0 +1,315 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entchaos provides a driver that injects faults (latency, dropped connections and
+// driver errors) into database operations, for testing the retry and timeout logic of
+// applications. Faults are injected by rules, that match operations by their type and
+// query, and are applied by probability (using a seeded random source for reproducible
+// runs), or deterministically using the Skip and Times limits.
+//
+//	drv := entchaos.NewDriver(drv,
+//		entchaos.WithSeed(1),
+//		// Fail the first update of the users table with a deadlock.
+//		entchaos.WithRule(entchaos.Rule{
+//			Op:      entchaos.OpExec,
+//			Pattern: regexp.MustCompile("^UPDATE `users`"),
+//			Err:     entchaos.Deadlock(dialect.MySQL),
+//			Times:   1,
+//		}),
+//		// Delay 10% of the queries by 100ms.
+//		entchaos.WithRule(entchaos.Rule{
+//			Op:          entchaos.OpQuery,
+//			Probability: 0.1,
+//			Latency:     100 * time.Millisecond,
+//		}),
+//	)
+//	client := ent.NewClient(ent.Driver(drv))
+//
+package entchaos
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math/rand"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+)
+
+// Operation types that are matched by rules.
+const (
+	OpQuery    = "query"
+	OpExec     = "exec"
+	OpBegin    = "begin"
+	OpCommit   = "commit"
+	OpRollback = "rollback"
+)
+
+// ErrBadConn is the error returned by drivers when a connection was dropped.
+var ErrBadConn = driver.ErrBadConn
+
+// Rule describes a fault that is injected into the operations it matches.
+type Rule struct {
+	// Name of the rule, used for reporting its injections. Optional.
+	Name string
+	// Op is the type of operations the rule matches. An empty
+	// value matches all operations.
+	Op string
+	// Pattern is matched against the query of the operations. The queries of
+	// the transaction operations are "BEGIN", "COMMIT" and "ROLLBACK". A nil
+	// value matches all queries.
+	Pattern *regexp.Regexp
+	// Probability of injecting the fault into a matched operation, in the
+	// range (0, 1]. If it is zero, the fault is injected into all of them.
+	Probability float64
+	// Skip is the number of matched operations to skip before injecting the fault.
+	Skip int
+	// Times is the maximum number of injections. Zero means unlimited.
+	Times int
+	// Latency is added to the operation before it is executed (or failed).
+	Latency time.Duration
+	// Err is returned instead of executing the operation. For example,
+	// ErrBadConn, context.DeadlineExceeded or the result of Deadlock.
+	Err error
+}
+
+// rule holds the state of a configured rule.
+type rule struct {
+	Rule
+	matched, injected int
+}
+
+// Option allows configuring the Driver using functional options.
+type Option func(*Driver)
+
+// WithRule adds the given rule to the driver. Rules are evaluated in the order
+// they were added, and the latencies of all injected rules are accumulated.
+func WithRule(r Rule) Option {
+	return func(d *Driver) {
+		d.rules = append(d.rules, &rule{Rule: r})
+	}
+}
+
+// WithSeed sets the seed of the random source that is used for applying rules
+// by probability, for reproducible runs. The default seed is the current time.
+func WithSeed(seed int64) Option {
+	return func(d *Driver) {
+		d.rand = rand.New(rand.NewSource(seed))
+	}
+}
+
+// Driver is a dialect.Driver that injects faults into the operations of its underlying driver.
+type Driver struct {
+	dialect.Driver
+	disabled int32
+	mu       sync.Mutex
+	rand     *rand.Rand
+	rules    []*rule
+}
+
+// NewDriver returns a new Driver that wraps the given driver with the faults configured by the options.
+func NewDriver(drv dialect.Driver, opts ...Option) *Driver {
+	d := &Driver{
+		Driver: drv,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Enable enables the injection of faults. Drivers are enabled by default.
+func (d *Driver) Enable() { atomic.StoreInt32(&d.disabled, 0) }
+
+// Disable disables the injection of faults. For example, while preparing the test data.
+func (d *Driver) Disable() { atomic.StoreInt32(&d.disabled, 1) }
+
+// Injected returns the number of faults that were injected by the rule with the given name.
+func (d *Driver) Injected(name string) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var n int
+	for _, r := range d.rules {
+		if r.Name == name {
+			n += r.injected
+		}
+	}
+	return n
+}
+
+// Exec injects the matched faults, and calls the underlying driver Exec method.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if err := d.inject(ctx, OpExec, query); err != nil {
+		return err
+	}
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Query injects the matched faults, and calls the underlying driver Query method.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if err := d.inject(ctx, OpQuery, query); err != nil {
+		return err
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// Tx injects the matched faults, and starts a transaction whose operations are injected by the driver.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	if err := d.inject(ctx, OpBegin, "BEGIN"); err != nil {
+		return nil, err
+	}
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, ctx: ctx, drv: d}, nil
+}
+
+// BeginTx injects the matched faults, and calls the underlying driver BeginTx command if it is supported.
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, errors.New("entchaos: Driver.BeginTx is not supported")
+	}
+	if err := d.inject(ctx, OpBegin, "BEGIN"); err != nil {
+		return nil, err
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, ctx: ctx, drv: d}, nil
+}
+
+// Tx is a transaction whose operations are injected by its driver.
+type Tx struct {
+	dialect.Tx
+	ctx context.Context
+	drv *Driver
+}
+
+// Exec injects the matched faults, and calls the underlying transaction Exec method.
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if err := t.drv.inject(ctx, OpExec, query); err != nil {
+		return err
+	}
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+// Query injects the matched faults, and calls the underlying transaction Query method.
+func (t *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	if err := t.drv.inject(ctx, OpQuery, query); err != nil {
+		return err
+	}
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+// Commit injects the matched faults, and calls the underlying transaction Commit method.
+// Note that the transaction is rolled back if a fault error is injected.
+func (t *Tx) Commit() error {
+	if err := t.drv.inject(t.ctx, OpCommit, "COMMIT"); err != nil {
+		_ = t.Tx.Rollback()
+		return err
+	}
+	return t.Tx.Commit()
+}
+
+// Rollback injects the matched faults, and calls the underlying transaction Rollback method.
+// Note that the transaction is rolled back even if a fault error is injected.
+func (t *Tx) Rollback() error {
+	if err := t.drv.inject(t.ctx, OpRollback, "ROLLBACK"); err != nil {
+		_ = t.Tx.Rollback()
+		return err
+	}
+	return t.Tx.Rollback()
+}
+
+type skipCtxKey struct{}
+
+// WithoutFaults returns a new context that disables the injection of faults
+// into the operations that are executed with it.
+func WithoutFaults(parent context.Context) context.Context {
+	return context.WithValue(parent, skipCtxKey{}, true)
+}
+
+// inject applies the rules that match the given operation, and
+// returns the error of the first rule that injects one.
+func (d *Driver) inject(ctx context.Context, op, query string) error {
+	if atomic.LoadInt32(&d.disabled) == 1 || ctx.Value(skipCtxKey{}) != nil {
+		return nil
+	}
+	var (
+		latency time.Duration
+		err     error
+	)
+	d.mu.Lock()
+	for _, r := range d.rules {
+		if r.Op != "" && r.Op != op || r.Pattern != nil && !r.Pattern.MatchString(query) {
+			continue
+		}
+		r.matched++
+		if r.matched <= r.Skip || r.Times > 0 && r.injected >= r.Times {
+			continue
+		}
+		if r.Probability > 0 && d.rand.Float64() >= r.Probability {
+			continue
+		}
+		r.injected++
+		latency += r.Latency
+		if r.Err != nil {
+			err = r.Err
+			break
+		}
+	}
+	d.mu.Unlock()
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+// Deadlock returns the error that is returned by the driver of the given dialect when a
+// transaction was aborted due to a deadlock. It is a *mysql.MySQLError for MySQL, and a
+// *pq.Error for PostgreSQL.
+func Deadlock(name string) error {
+	switch name {
+	case dialect.MySQL:
+		return &mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock; try restarting transaction"}
+	case dialect.Postgres:
+		return &pq.Error{Severity: "ERROR", Code: "40P01", Message: "deadlock detected"}
+	default:
+		return errors.New("database is locked")
+	}
+}
+
+// LockTimeout returns the error that is returned by the driver of the given dialect when
+// a statement failed to acquire a lock in time. It is a *mysql.MySQLError for MySQL, and
+// a *pq.Error for PostgreSQL.
+func LockTimeout(name string) error {
+	switch name {
+	case dialect.MySQL:
+		return &mysql.MySQLError{Number: 1205, Message: "Lock wait timeout exceeded; try restarting transaction"}
+	case dialect.Postgres:
+		return &pq.Error{Severity: "ERROR", Code: "55P03", Message: "canceling statement due to lock timeout"}
+	default:
+		return errors.New("database is locked")
+	}
+}
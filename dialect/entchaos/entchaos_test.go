@@ -0,0 +1,141 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entchaos
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// countingDriver counts the operations that were executed.
+type countingDriver struct {
+	dialect.Driver
+	execs, queries, commits int
+}
+
+func (d *countingDriver) Exec(context.Context, string, interface{}, interface{}) error {
+	d.execs++
+	return nil
+}
+
+func (d *countingDriver) Query(context.Context, string, interface{}, interface{}) error {
+	d.queries++
+	return nil
+}
+
+func (d *countingDriver) Tx(context.Context) (dialect.Tx, error) {
+	return &countingTx{Tx: dialect.NopTx(d), drv: d}, nil
+}
+
+type countingTx struct {
+	dialect.Tx
+	drv *countingDriver
+}
+
+func (t *countingTx) Commit() error {
+	t.drv.commits++
+	return nil
+}
+
+func TestDriver_Deterministic(t *testing.T) {
+	ctx := context.Background()
+	cd := &countingDriver{}
+	drv := NewDriver(cd,
+		WithRule(Rule{
+			Name:    "deadlock",
+			Op:      OpExec,
+			Pattern: regexp.MustCompile("^UPDATE `users`"),
+			Err:     Deadlock(dialect.MySQL),
+			Skip:    1,
+			Times:   2,
+		}),
+		WithRule(Rule{
+			Name: "commit",
+			Op:   OpCommit,
+			Err:  ErrBadConn,
+		}),
+	)
+	update := "UPDATE `users` SET `name` = ?"
+	require.NoError(t, drv.Exec(ctx, update, []interface{}{"a8m"}, nil), "first match is skipped")
+	for i := 0; i < 2; i++ {
+		err := drv.Exec(ctx, update, []interface{}{"a8m"}, nil)
+		var merr *mysql.MySQLError
+		require.True(t, errors.As(err, &merr))
+		require.Equal(t, uint16(1213), merr.Number)
+	}
+	require.NoError(t, drv.Exec(ctx, update, []interface{}{"a8m"}, nil), "rule exceeded its times")
+	require.NoError(t, drv.Exec(ctx, "UPDATE `pets` SET `name` = ?", []interface{}{"pedro"}, nil))
+	require.NoError(t, drv.Query(ctx, "SELECT * FROM `users`", []interface{}{}, nil))
+	require.Equal(t, 3, cd.execs)
+	require.Equal(t, 1, cd.queries)
+	require.Equal(t, 2, drv.Injected("deadlock"))
+
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, update, []interface{}{"a8m"}, nil))
+	require.ErrorIs(t, tx.Commit(), ErrBadConn)
+	require.Zero(t, cd.commits)
+
+	// Faults are not injected when the driver is disabled,
+	// or when operations are executed without faults.
+	drv.Disable()
+	tx, err = drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	drv.Enable()
+	tx, err = drv.Tx(WithoutFaults(ctx))
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 2, cd.commits)
+	require.Equal(t, 1, drv.Injected("commit"))
+}
+
+func TestDriver_Probability(t *testing.T) {
+	ctx := context.Background()
+	run := func(seed int64) []bool {
+		drv := NewDriver(&countingDriver{}, WithSeed(seed), WithRule(Rule{
+			Probability: 0.5,
+			Err:         LockTimeout(dialect.Postgres),
+		}))
+		failed := make([]bool, 20)
+		for i := range failed {
+			failed[i] = drv.Query(ctx, "SELECT 1", []interface{}{}, nil) != nil
+		}
+		return failed
+	}
+	a, b := run(1), run(1)
+	require.Equal(t, a, b, "runs with the same seed are reproducible")
+	require.Contains(t, a, true)
+	require.Contains(t, a, false)
+
+	err := NewDriver(&countingDriver{}, WithRule(Rule{Err: Deadlock(dialect.Postgres)})).Exec(ctx, "DELETE FROM `users`", []interface{}{}, nil)
+	var perr *pq.Error
+	require.True(t, errors.As(err, &perr))
+	require.Equal(t, pq.ErrorCode("40P01"), perr.Code)
+}
+
+func TestDriver_Latency(t *testing.T) {
+	ctx := context.Background()
+	cd := &countingDriver{}
+	drv := NewDriver(cd, WithRule(Rule{Op: OpQuery, Latency: 50 * time.Millisecond}))
+	start := time.Now()
+	require.NoError(t, drv.Query(ctx, "SELECT 1", []interface{}{}, nil))
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// Latencies respect the deadline of the context.
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, drv.Query(ctx, "SELECT 1", []interface{}{}, nil), context.DeadlineExceeded)
+	require.Equal(t, 1, cd.queries)
+}
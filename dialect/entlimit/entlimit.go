@@ -0,0 +1,268 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entlimit provides a driver that caps the number of concurrent in-flight
+// operations per entity (table) or per operation type, for protecting the database
+// from fan-out spikes in bursty workloads.
+//
+//	drv, err := sql.Open(dialect.Postgres, dsn)
+//	if err != nil {
+//		return err
+//	}
+//	client := ent.NewClient(ent.Driver(entlimit.NewDriver(drv,
+//		entlimit.Limit("users", 10),
+//		entlimit.Limit(entlimit.OpExec, 50),
+//		entlimit.MaxWaiting(100),
+//	)))
+//
+package entlimit
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// Operation types that are passed to the KeyFunc.
+const (
+	OpQuery = "query"
+	OpExec  = "exec"
+)
+
+// ErrQueueFull is returned when an operation is rejected,
+// because the queue of its key exceeded the MaxWaiting limit.
+var ErrQueueFull = errors.New("entlimit: too many waiting operations")
+
+// KeyFunc returns the key that is used for limiting the given operation.
+// An empty key means the operation is not limited.
+type KeyFunc func(ctx context.Context, op, query string) string
+
+// Stats holds the metrics of a limited key.
+type Stats struct {
+	// InFlight is the number of operations currently executed.
+	InFlight int64
+	// Waiting is the number of operations currently waiting in queue.
+	Waiting int64
+	// Executed is the total number of operations that were executed.
+	Executed int64
+	// Rejected is the total number of operations that were rejected,
+	// either because the queue was full or the context was canceled.
+	Rejected int64
+	// WaitDuration is the total time operations spent waiting in queue.
+	WaitDuration time.Duration
+}
+
+// Option allows configuring the Driver using functional options.
+type Option func(*Driver)
+
+// Limit sets the maximum number of concurrent operations for the given key.
+// Keys are table names by default, and the OpQuery and OpExec keys are used
+// for operations without a table (or when no limit was set for their table).
+func Limit(key string, n int) Option {
+	return func(d *Driver) {
+		d.limits[key] = n
+	}
+}
+
+// MaxWaiting sets the maximum number of operations that can wait in the queue of
+// each key. Operations beyond this limit fail with ErrQueueFull. The default is 0,
+// which means the queue is unbounded and operations wait until their context is done.
+func MaxWaiting(n int) Option {
+	return func(d *Driver) {
+		d.maxWaiting = int64(n)
+	}
+}
+
+// WithKeyFunc sets the function that is used for computing the limiting keys.
+// The default is KeyByTable.
+func WithKeyFunc(fn KeyFunc) Option {
+	return func(d *Driver) {
+		d.key = fn
+	}
+}
+
+// Driver is a dialect.Driver that limits the number of concurrent operations.
+type Driver struct {
+	dialect.Driver
+	key        KeyFunc
+	limits     map[string]int
+	maxWaiting int64
+	mu         sync.Mutex
+	sems       map[string]*semaphore
+}
+
+// NewDriver returns a new Driver that wraps the given driver with the limits configured
+// by the options. Operations whose key has no configured limit are not limited.
+func NewDriver(drv dialect.Driver, opts ...Option) *Driver {
+	d := &Driver{
+		Driver: drv,
+		key:    KeyByTable,
+		limits: make(map[string]int),
+		sems:   make(map[string]*semaphore),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Exec waits for a free slot of the operation key, and calls the underlying driver Exec method.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.exec(ctx, d.Driver, query, args, v)
+}
+
+// Query waits for a free slot of the operation key, and calls the underlying driver Query method.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.query(ctx, d.Driver, query, args, v)
+}
+
+// Tx starts a transaction whose operations are limited by the driver.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, drv: d}, nil
+}
+
+// Stats returns the metrics of all keys that were limited by the driver.
+func (d *Driver) Stats() map[string]Stats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	stats := make(map[string]Stats, len(d.sems))
+	for k, s := range d.sems {
+		stats[k] = s.stats()
+	}
+	return stats
+}
+
+// Tx is a transaction whose operations are limited by its driver.
+type Tx struct {
+	dialect.Tx
+	drv *Driver
+}
+
+// Exec waits for a free slot of the operation key, and calls the underlying transaction Exec method.
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return t.drv.exec(ctx, t.Tx, query, args, v)
+}
+
+// Query waits for a free slot of the operation key, and calls the underlying transaction Query method.
+func (t *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	return t.drv.query(ctx, t.Tx, query, args, v)
+}
+
+func (d *Driver) exec(ctx context.Context, eq dialect.ExecQuerier, query string, args, v interface{}) error {
+	release, err := d.acquire(ctx, OpExec, query)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return eq.Exec(ctx, query, args, v)
+}
+
+func (d *Driver) query(ctx context.Context, eq dialect.ExecQuerier, query string, args, v interface{}) error {
+	release, err := d.acquire(ctx, OpQuery, query)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return eq.Query(ctx, query, args, v)
+}
+
+// acquire waits for a free slot of the operation key, and returns a function for releasing it.
+func (d *Driver) acquire(ctx context.Context, op, query string) (func(), error) {
+	key := d.key(ctx, op, query)
+	if _, ok := d.limits[key]; !ok {
+		key = op
+	}
+	n, ok := d.limits[key]
+	if !ok || n <= 0 {
+		return func() {}, nil
+	}
+	d.mu.Lock()
+	s, ok := d.sems[key]
+	if !ok {
+		s = &semaphore{ch: make(chan struct{}, n)}
+		d.sems[key] = s
+	}
+	d.mu.Unlock()
+	return s.acquire(ctx, d.maxWaiting)
+}
+
+// semaphore limits the concurrent operations of a key.
+type semaphore struct {
+	// 64-bit fields must be first for atomic alignment on 32-bit platforms.
+	waiting, executed, rejected int64
+	wait                        int64
+	ch                          chan struct{}
+}
+
+func (s *semaphore) acquire(ctx context.Context, maxWaiting int64) (func(), error) {
+	select {
+	case s.ch <- struct{}{}:
+		atomic.AddInt64(&s.executed, 1)
+		return s.release, nil
+	default:
+	}
+	if w := atomic.AddInt64(&s.waiting, 1); maxWaiting > 0 && w > maxWaiting {
+		atomic.AddInt64(&s.waiting, -1)
+		atomic.AddInt64(&s.rejected, 1)
+		return nil, ErrQueueFull
+	}
+	start := time.Now()
+	defer func() {
+		atomic.AddInt64(&s.waiting, -1)
+		atomic.AddInt64(&s.wait, int64(time.Since(start)))
+	}()
+	select {
+	case s.ch <- struct{}{}:
+		atomic.AddInt64(&s.executed, 1)
+		return s.release, nil
+	case <-ctx.Done():
+		atomic.AddInt64(&s.rejected, 1)
+		return nil, ctx.Err()
+	}
+}
+
+func (s *semaphore) release() { <-s.ch }
+
+func (s *semaphore) stats() Stats {
+	return Stats{
+		InFlight:     int64(len(s.ch)),
+		Waiting:      atomic.LoadInt64(&s.waiting),
+		Executed:     atomic.LoadInt64(&s.executed),
+		Rejected:     atomic.LoadInt64(&s.rejected),
+		WaitDuration: time.Duration(atomic.LoadInt64(&s.wait)),
+	}
+}
+
+type entityCtxKey struct{}
+
+// WithEntity returns a new context that is used for limiting the operations executed
+// with it by the given key (usually, an entity name), instead of their table name.
+func WithEntity(parent context.Context, key string) context.Context {
+	return context.WithValue(parent, entityCtxKey{}, key)
+}
+
+// tableRe matches the first table of a SELECT, INSERT, UPDATE or DELETE statement.
+var tableRe = regexp.MustCompile("(?i)\\b(?:FROM|INTO|UPDATE)\\s+[`\"]?(\\w+)")
+
+// KeyByTable is the default KeyFunc. It returns the key that was set on the context using
+// WithEntity, or the name of the first table that appears in the query otherwise.
+func KeyByTable(ctx context.Context, _, query string) string {
+	if key, ok := ctx.Value(entityCtxKey{}).(string); ok {
+		return key
+	}
+	if m := tableRe.FindStringSubmatch(query); m != nil {
+		return strings.ToLower(m[1])
+	}
+	return ""
+}
@@ -0,0 +1,125 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entlimit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/stretchr/testify/require"
+)
+
+// blockingDriver blocks all operations until its channel is closed.
+type blockingDriver struct {
+	dialect.Driver
+	running, max int32
+	unblock      chan struct{}
+}
+
+func (d *blockingDriver) Exec(context.Context, string, interface{}, interface{}) error {
+	return d.run()
+}
+
+func (d *blockingDriver) Query(context.Context, string, interface{}, interface{}) error {
+	return d.run()
+}
+
+func (d *blockingDriver) run() error {
+	n := atomic.AddInt32(&d.running, 1)
+	defer atomic.AddInt32(&d.running, -1)
+	for {
+		m := atomic.LoadInt32(&d.max)
+		if n <= m || atomic.CompareAndSwapInt32(&d.max, m, n) {
+			break
+		}
+	}
+	<-d.unblock
+	return nil
+}
+
+func TestDriver_Limit(t *testing.T) {
+	ctx := context.Background()
+	bd := &blockingDriver{unblock: make(chan struct{})}
+	drv := NewDriver(bd, Limit("users", 2))
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, drv.Query(ctx, "SELECT * FROM `users` WHERE `id` = ?", []interface{}{1}, nil))
+		}()
+	}
+	require.Eventually(t, func() bool {
+		return drv.Stats()["users"].Waiting == 3
+	}, time.Second, 10*time.Millisecond)
+	// Other tables are not limited.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.NoError(t, drv.Exec(ctx, "INSERT INTO `pets` (`name`) VALUES (?)", []interface{}{"pedro"}, nil))
+	}()
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&bd.running) == 3
+	}, time.Second, 10*time.Millisecond)
+	close(bd.unblock)
+	wg.Wait()
+	require.Equal(t, int32(3), bd.max)
+	stats := drv.Stats()["users"]
+	require.Equal(t, int64(5), stats.Executed)
+	require.Zero(t, stats.InFlight)
+	require.Zero(t, stats.Waiting)
+	require.Zero(t, stats.Rejected)
+}
+
+func TestDriver_MaxWaiting(t *testing.T) {
+	ctx := context.Background()
+	bd := &blockingDriver{unblock: make(chan struct{})}
+	drv := NewDriver(bd, Limit(OpExec, 1), MaxWaiting(1))
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, drv.Exec(ctx, "DELETE FROM `users`", []interface{}{}, nil))
+		}()
+	}
+	require.Eventually(t, func() bool {
+		return drv.Stats()[OpExec].Waiting == 1
+	}, time.Second, 10*time.Millisecond)
+	err := drv.Exec(ctx, "DELETE FROM `users`", []interface{}{}, nil)
+	require.ErrorIs(t, err, ErrQueueFull)
+	// Operations are rejected also when their context is done.
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	drv2 := NewDriver(bd, Limit(OpExec, 1))
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.NoError(t, drv2.Exec(ctx, "DELETE FROM `users`", []interface{}{}, nil))
+	}()
+	require.Eventually(t, func() bool {
+		return drv2.Stats()[OpExec].InFlight == 1
+	}, time.Second, 10*time.Millisecond)
+	require.ErrorIs(t, drv2.Exec(cctx, "DELETE FROM `users`", []interface{}{}, nil), context.DeadlineExceeded)
+	close(bd.unblock)
+	wg.Wait()
+	require.Equal(t, int64(1), drv.Stats()[OpExec].Rejected)
+	require.Equal(t, int64(1), drv2.Stats()[OpExec].Rejected)
+}
+
+func TestKeyByTable(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, "users", KeyByTable(ctx, OpQuery, "SELECT * FROM `users`"))
+	require.Equal(t, "users", KeyByTable(ctx, OpQuery, `SELECT * FROM "Users" WHERE "id" = $1`))
+	require.Equal(t, "pets", KeyByTable(ctx, OpExec, "INSERT INTO pets (name) VALUES (?)"))
+	require.Equal(t, "pets", KeyByTable(ctx, OpExec, "UPDATE `pets` SET `name` = ?"))
+	require.Equal(t, "User", KeyByTable(WithEntity(ctx, "User"), OpExec, "UPDATE `pets` SET `name` = ?"))
+	require.Empty(t, KeyByTable(ctx, OpQuery, "g.V().count()"))
+}
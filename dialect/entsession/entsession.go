@@ -0,0 +1,202 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entsession provides a driver that propagates values from the context (e.g. the
+// user or the request ID) into session variables of the database when transactions start.
+// This allows row-level security policies, triggers and DB-side auditing to see the identity
+// of the application users.
+//
+//	drv, err := sql.Open(dialect.Postgres, dsn)
+//	if err != nil {
+//		return err
+//	}
+//	client := ent.NewClient(ent.Driver(entsession.NewDriver(drv,
+//		entsession.ContextValue("app.user_id", userIDKey{}),
+//		entsession.Var("app.request_id", func(ctx context.Context) (string, bool) {
+//			return middleware.GetReqID(ctx), true
+//		}),
+//	)))
+//
+// In PostgreSQL, the variables are set using set_config with is_local (the equivalent of SET LOCAL),
+// and they can be read using current_setting('app.user_id', true) until the transaction ends. In MySQL,
+// the variables are set as user-defined variables (e.g. @`app.user_id`), and they are reset to NULL
+// when the transaction ends. Note that variables are set only for transactions, and operations that
+// are executed outside of a transaction are passed as-is to the underlying driver.
+package entsession
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// VarFunc returns the value of a session variable from the context.
+// Variables whose functions return false are not set.
+type VarFunc func(context.Context) (string, bool)
+
+// Option allows configuring the Driver using functional options.
+type Option func(*Driver)
+
+// Var maps the given session variable to the value returned by the function.
+func Var(name string, fn VarFunc) Option {
+	return func(d *Driver) {
+		d.vars = append(d.vars, &variable{name: name, value: fn})
+	}
+}
+
+// ContextValue maps the given session variable to the value stored in the context under the
+// given key. Values are formatted using fmt.Sprint, and missing (nil) values are not set.
+func ContextValue(name string, key interface{}) Option {
+	return Var(name, func(ctx context.Context) (string, bool) {
+		v := ctx.Value(key)
+		if v == nil {
+			return "", false
+		}
+		return fmt.Sprint(v), true
+	})
+}
+
+type variable struct {
+	name  string
+	value VarFunc
+}
+
+// Driver is a dialect.Driver that sets session variables at the start of transactions.
+type Driver struct {
+	dialect.Driver
+	vars []*variable
+}
+
+// NewDriver returns a new Driver that wraps the given driver with the variables configured by the options.
+func NewDriver(drv dialect.Driver, opts ...Option) *Driver {
+	d := &Driver{Driver: drv}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Tx starts a transaction and sets the session variables of the given context on it.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.init(ctx, tx)
+}
+
+// BeginTx starts a transaction with options if it is supported by the underlying
+// driver, and sets the session variables of the given context on it.
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("entsession: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return d.init(ctx, tx)
+}
+
+// init sets the session variables on the given transaction, and rolls it back on failure.
+func (d *Driver) init(ctx context.Context, tx dialect.Tx) (dialect.Tx, error) {
+	var set []string
+	for _, v := range d.vars {
+		value, ok := v.value(ctx)
+		if !ok {
+			continue
+		}
+		if err := d.set(ctx, tx, v.name, value); err != nil {
+			return nil, rollback(tx, err)
+		}
+		set = append(set, v.name)
+	}
+	if len(set) == 0 || d.Dialect() != dialect.MySQL {
+		return tx, nil
+	}
+	// User-defined variables in MySQL outlive the transaction,
+	// and therefore, they are reset when it ends.
+	return &Tx{Tx: tx, ctx: ctx, vars: set}, nil
+}
+
+// set sets the session variable on the given transaction.
+func (d *Driver) set(ctx context.Context, tx dialect.Tx, name, value string) error {
+	switch d.Dialect() {
+	case dialect.Postgres:
+		rows := &entsql.Rows{}
+		if err := tx.Query(ctx, "SELECT set_config($1, $2, true)", []interface{}{name, value}, rows); err != nil {
+			return fmt.Errorf("entsession: setting variable %q: %w", name, err)
+		}
+		return rows.Close()
+	case dialect.MySQL:
+		query, args := variableSet(name, value)
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("entsession: setting variable %q: %w", name, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("entsession: session variables are not supported by the %s dialect", d.Dialect())
+	}
+}
+
+// variableSet returns the MySQL statement for setting (or resetting, if the value is nil) a user-defined variable.
+func variableSet(name string, value interface{}) (string, []interface{}) {
+	b := &entsql.Builder{}
+	b.SetDialect(dialect.MySQL)
+	b.WriteString("SET @").WriteString(b.Quote(name)).WriteString(" = ")
+	if value == nil {
+		b.WriteString("NULL")
+	} else {
+		b.Arg(value)
+	}
+	return b.Query()
+}
+
+// Tx is a MySQL transaction that resets its user-defined variables when it ends.
+type Tx struct {
+	dialect.Tx
+	ctx  context.Context
+	vars []string
+}
+
+// Commit resets the variables and commits the transaction.
+func (t *Tx) Commit() error {
+	if err := t.reset(); err != nil {
+		return rollback(t.Tx, err)
+	}
+	return t.Tx.Commit()
+}
+
+// Rollback resets the variables and rolls back the transaction.
+func (t *Tx) Rollback() error {
+	if err := t.reset(); err != nil {
+		return rollback(t.Tx, err)
+	}
+	return t.Tx.Rollback()
+}
+
+// reset resets the user-defined variables of the transaction.
+func (t *Tx) reset() error {
+	for _, name := range t.vars {
+		query, args := variableSet(name, nil)
+		if err := t.Tx.Exec(t.ctx, query, args, nil); err != nil {
+			return fmt.Errorf("entsession: resetting variable %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// rollback calls to tx.Rollback and wraps the given error with the rollback error if occurred.
+func rollback(tx dialect.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
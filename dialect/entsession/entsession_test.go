@@ -0,0 +1,74 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entsession
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+type userKey struct{}
+
+func TestDriver_Postgres(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := NewDriver(sql.OpenDB(dialect.Postgres, db),
+		ContextValue("app.user_id", userKey{}),
+		Var("app.tenant", func(context.Context) (string, bool) { return "", false }),
+	)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT set_config($1, $2, true)")).
+		WithArgs("app.user_id", "42").
+		WillReturnRows(sqlmock.NewRows([]string{"set_config"}).AddRow("42"))
+	mock.ExpectCommit()
+	tx, err := drv.Tx(context.WithValue(context.Background(), userKey{}, 42))
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Variables without values are not set.
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	tx, err = drv.Tx(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDriver_MySQL(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := NewDriver(sql.OpenDB(dialect.MySQL, db), ContextValue("app.user_id", userKey{}))
+	ctx := context.WithValue(context.Background(), userKey{}, "a8m")
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SET @`app.user_id` = ?")).
+		WithArgs("a8m").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("SET @`app.user_id` = NULL")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	tx, err := drv.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDriver_Unsupported(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := NewDriver(sql.OpenDB(dialect.SQLite, db), ContextValue("app.user_id", userKey{}))
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	_, err = drv.Tx(context.WithValue(context.Background(), userKey{}, 1))
+	require.EqualError(t, err, "entsession: session variables are not supported by the sqlite3 dialect")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
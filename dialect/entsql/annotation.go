@@ -4,7 +4,13 @@
 
 package entsql
 
-import "entgo.io/ent/schema"
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema"
+)
 
 // Annotation is a builtin schema annotation for attaching
 // SQL metadata to schema objects for both codegen and runtime.
@@ -75,6 +81,17 @@ type Annotation struct {
 	//
 	Incremental *bool `json:"incremental,omitempty"`
 
+	// Identity configures the values that are generated by the database for the auto-increment
+	// ID column of the schema. In PostgreSQL, ID columns are defined as identity columns (i.e.
+	// GENERATED BY DEFAULT AS IDENTITY), and in MySQL and SQLite, the start value is set on the
+	// AUTO_INCREMENT counter of the table. For example:
+	//
+	//	entsql.Annotation{
+	//		Identity: &entsql.Identity{Start: 1000},
+	//	}
+	//
+	Identity *Identity `json:"identity,omitempty"`
+
 	// OnDelete specifies a custom referential action for DELETE operations on parent
 	// table that has matching rows in the child table.
 	//
@@ -88,6 +105,7 @@ type Annotation struct {
 	OnDelete ReferenceOption `json:"on_delete,omitempty"`
 
 	// Check allows injecting custom "DDL" for setting an unnamed "CHECK" clause in "CREATE TABLE".
+	// When defined on a field, the constraint is named after the table and the column (e.g. users_age_check).
 	//
 	//	entsql.Annotation{
 	//		Check: "age < 10",
@@ -96,6 +114,7 @@ type Annotation struct {
 	Check string `json:"check,omitempty"`
 
 	// Checks allows injecting custom "DDL" for setting named "CHECK" clauses in "CREATE TABLE".
+	// When defined on a field, the constraints are added to the table of the field.
 	//
 	//	entsql.Annotation{
 	//		Checks: map[string]string{
@@ -104,6 +123,441 @@ type Annotation struct {
 	//	}
 	//
 	Checks map[string]string `json:"checks,omitempty"`
+
+	// View defines the query of a view that is created for the schema instead of a
+	// table. The columns returned by the query must match the fields of the schema,
+	// including the "id" column, and the view is replaced by the migration when its
+	// definition changes. Views are supported by the PostgreSQL and SQLite dialects.
+	//
+	//	entsql.Annotation{
+	//		View: "SELECT id, name FROM users WHERE active",
+	//	}
+	//
+	View string `json:"view,omitempty"`
+
+	// Materialized indicates that the view defined by the View option is a materialized
+	// view. Materialized views are supported only by the PostgreSQL dialect, their indexes
+	// are managed by the migration, and they are refreshed using the Refresh method of the
+	// generated client.
+	//
+	//	entsql.Annotation{
+	//		View:         "SELECT date(created_at) AS id, COUNT(*) AS count FROM users GROUP BY 1",
+	//		Materialized: true,
+	//	}
+	//
+	Materialized bool `json:"materialized,omitempty"`
+
+	// ViewQueries holds the queries of the view per dialect, as they were composed
+	// by the ViewQuery and MaterializedViewQuery options. If set, the query of the
+	// migrated dialect takes precedence over the query defined by the View option.
+	//
+	//	entsql.Annotation{
+	//		ViewQueries: map[string]string{
+	//			dialect.Postgres: `SELECT "id", "name" FROM "users" WHERE "active"`,
+	//			dialect.SQLite:   "SELECT `id`, `name` FROM `users` WHERE `active`",
+	//		},
+	//	}
+	//
+	ViewQueries map[string]string `json:"view_queries,omitempty"`
+
+	// AccessPatterns holds the expected access patterns of the schema. Each pattern lists the
+	// fields (or edges) that are used together for filtering and ordering the queries, and
+	// an index on these columns (in this order) is created, unless one is already defined.
+	//
+	//	entsql.Annotation{
+	//		AccessPatterns: [][]string{
+	//			{"owner_id", "created_at"},
+	//		},
+	//	}
+	//
+	AccessPatterns [][]string `json:"access_patterns,omitempty"`
+
+	// Compression defines the algorithm that is used to compress the values of a string,
+	// bytes or JSON field before they are written to the database. The values are stored
+	// in a binary column and decompressed when they are scanned. See the sqlcompress
+	// package for the supported algorithms.
+	//
+	//	entsql.Annotation{
+	//		Compression: "gzip",
+	//	}
+	//
+	Compression string `json:"compression,omitempty"`
+
+	// SoftDelete defines the name of the nillable time field that marks the entities of the
+	// schema as deleted. If set, the generated Delete builders set the field instead of deleting
+	// the rows, and the generated queries return only the entities that were not deleted.
+	// See the SoftDelete mixin for more info.
+	//
+	//	entsql.Annotation{
+	//		SoftDelete: "deleted_at",
+	//	}
+	//
+	SoftDelete string `json:"soft_delete,omitempty"`
+
+	// Version defines the name of the integer field that holds the version of the entities
+	// of the schema, for optimistic concurrency control. If set, the generated UpdateOne
+	// builders update the entity only if its version was not changed since it was loaded,
+	// and increment it. See the Version mixin for more info.
+	//
+	//	entsql.Annotation{
+	//		Version: "version",
+	//	}
+	//
+	Version string `json:"version,omitempty"`
+
+	// Archive indicates if the schema has an archive table, that holds the same columns as
+	// its table and is named "<table>_archive". If set, the generated clients have an ArchiveWhere
+	// method that moves the matched rows to the archive table, and the generated queries can read
+	// the archived rows using the IncludeArchived and OnlyArchived methods.
+	//
+	//	entsql.Annotation{
+	//		Archive: true,
+	//	}
+	//
+	Archive bool `json:"archive,omitempty"`
+
+	// Sequence defines the database sequence that the default value of the column is drawn
+	// from (using nextval). The sequence is created (or altered) by the migration, and it may
+	// be shared by the columns of multiple tables. Sequences are supported only by the
+	// PostgreSQL dialect. For example:
+	//
+	//	entsql.Annotation{
+	//		Sequence: &entsql.Sequence{Name: "invoice_numbers", Start: 1000},
+	//	}
+	//
+	Sequence *Sequence `json:"sequence,omitempty"`
+
+	// QueryDefaults defines the defaults of the generated queries of the schema, such as their
+	// default order, limit and predicates. See the QueryDefaults type for more info.
+	//
+	//	entsql.Annotation{
+	//		QueryDefaults: &entsql.QueryDefaults{
+	//			Order: []string{"-created_at"},
+	//			Limit: 100,
+	//		},
+	//	}
+	//
+	QueryDefaults *QueryDefaults `json:"query_defaults,omitempty"`
+
+	// Generated defines the expression of a generated column, whose value is computed by the
+	// database from the other columns of the row. Generated fields are optional and immutable,
+	// they cannot be set by the generated builders, and their values are read back after the
+	// entities are created (where RETURNING is supported). For example:
+	//
+	//	entsql.Annotation{
+	//		Generated: &entsql.Generated{Expr: "lower(email)", Stored: true},
+	//	}
+	//
+	Generated *Generated `json:"generated,omitempty"`
+
+	// EdgeOrder defines the default order of the entities of an edge, when it is eager-loaded or traversed
+	// and the query was not ordered explicitly. Fields that are prefixed with "-" are sorted in descending
+	// order. The fields belong to the type that the edge points to. See the OrderField function for more info.
+	//
+	//	entsql.Annotation{
+	//		EdgeOrder: []string{"rank", "id"},
+	//	}
+	//
+	EdgeOrder []string `json:"edge_order,omitempty"`
+}
+
+// QueryDefaults describes the defaults of the generated queries of a schema. The default order and
+// limit are applied on the queries that return entities (e.g. All, First and Only), and were not
+// ordered or limited explicitly. The default predicates are applied on all queries of the schema,
+// including graph traversals and eager-loading. Queries can opt out of them using NoDefaults.
+type QueryDefaults struct {
+	// Order holds the fields of the default order. Fields that are
+	// prefixed with "-" are sorted in descending order (e.g. "-created_at").
+	Order []string `json:"order,omitempty"`
+	// Limit is the maximum number of entities that are returned by default.
+	// It is not applied on eager-loaded edges.
+	Limit int `json:"limit,omitempty"`
+	// Where holds the predicates that are applied on all queries.
+	Where []*Condition `json:"where,omitempty"`
+}
+
+// Condition describes a predicate that compares a field with a value. The supported operators
+// are "=", "<>", "<", "<=", ">", ">=", and the "IS NULL" and "IS NOT NULL" operators that do
+// not accept a value. Values can be strings, numbers or booleans.
+type Condition struct {
+	Field string      `json:"field"`
+	Op    string      `json:"op"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// DefaultOrder returns a new annotation that sets the default order of the generated queries
+// of the schema. Fields that are prefixed with "-" are sorted in descending order.
+//
+//	func (Post) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.DefaultOrder("-created_at", "id"),
+//		}
+//	}
+//
+func DefaultOrder(fields ...string) *Annotation {
+	return &Annotation{QueryDefaults: &QueryDefaults{Order: fields}}
+}
+
+// DefaultLimit returns a new annotation that sets the default limit of the generated queries of the schema.
+func DefaultLimit(limit int) *Annotation {
+	return &Annotation{QueryDefaults: &QueryDefaults{Limit: limit}}
+}
+
+// DefaultWhere returns a new annotation that adds a predicate to all generated queries of the schema.
+//
+//	func (Post) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.DefaultWhere("status", "<>", "spam"),
+//		}
+//	}
+//
+func DefaultWhere(field, op string, value interface{}) *Annotation {
+	return &Annotation{QueryDefaults: &QueryDefaults{Where: []*Condition{{Field: field, Op: op, Value: value}}}}
+}
+
+// Generated describes the expression of a generated column.
+type Generated struct {
+	// Expr is the SQL expression that computes the value of the column.
+	Expr string `json:"expr"`
+	// Stored indicates that the value is computed when the row is written and stored
+	// in the table (STORED), instead of being computed when it is read (VIRTUAL). Note
+	// that PostgreSQL supports only stored generated columns, and therefore, they are
+	// always stored in PostgreSQL.
+	Stored bool `json:"stored,omitempty"`
+}
+
+// GeneratedAs returns a new annotation that defines a virtual generated column,
+// whose value is computed by the database using the given expression. For example:
+//
+//	field.String("email_domain").
+//		Annotations(entsql.GeneratedAs("lower(substr(email, instr(email, '@') + 1))"))
+//
+func GeneratedAs(expr string) *Annotation {
+	return &Annotation{Generated: &Generated{Expr: expr}}
+}
+
+// StoredAs returns a new annotation that defines a stored generated column,
+// whose value is computed by the database using the given expression.
+func StoredAs(expr string) *Annotation {
+	return &Annotation{Generated: &Generated{Expr: expr, Stored: true}}
+}
+
+// Identity describes the options of an auto-increment (identity) column. Zero values stand
+// for the defaults of the database (i.e. a column that starts with 1 and is incremented by 1).
+type Identity struct {
+	// Start is the first value that is generated for the column.
+	Start int64 `json:"start,omitempty"`
+	// Increment is the value that is added to the last value to generate the next one. It is
+	// supported only by PostgreSQL, as MySQL configures it using the auto_increment_increment
+	// system variable.
+	Increment int64 `json:"increment,omitempty"`
+}
+
+// AutoIncrement returns a new annotation that configures the auto-increment ID
+// column of the schema to start with the given value, and to be incremented by
+// the given increment. For example:
+//
+//	field.Int64("id").
+//		Annotations(entsql.AutoIncrement(1000, 1))
+//
+func AutoIncrement(start, increment int64) *Annotation {
+	return &Annotation{Identity: &Identity{Start: start, Increment: increment}}
+}
+
+// Sequence describes a database sequence. Zero values stand for the defaults of the database
+// (i.e. a sequence that starts with 1, is incremented by 1, and caches a single value).
+type Sequence struct {
+	// Name of the sequence.
+	Name string `json:"name"`
+	// Start is the first value of the sequence.
+	Start int64 `json:"start,omitempty"`
+	// Increment is the value that is added to the current value to create the next one.
+	Increment int64 `json:"increment,omitempty"`
+	// Cache is the number of values that are preallocated by the database for faster access.
+	Cache int64 `json:"cache,omitempty"`
+}
+
+// NextVal returns a new annotation that sets the default value of the column to the next
+// value of the given sequence. Sequences that are shared by multiple columns (or tables)
+// should be declared once and passed to all of them, since their definitions must match.
+//
+//	var invoiceNumbers = &entsql.Sequence{Name: "invoice_numbers", Start: 1000, Cache: 20}
+//
+//	func (Invoice) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.Int64("number").
+//				Optional().
+//				Immutable().
+//				Annotations(entsql.NextVal(invoiceNumbers)),
+//		}
+//	}
+//
+func NextVal(seq *Sequence) *Annotation {
+	return &Annotation{Sequence: seq}
+}
+
+// View returns a new annotation that defines the schema as a view with the given query.
+//
+//	func (ActiveUser) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.View("SELECT id, name FROM users WHERE active"),
+//		}
+//	}
+//
+func View(query string) *Annotation {
+	return &Annotation{View: query}
+}
+
+// MaterializedView returns a new annotation that defines the schema as a materialized
+// view with the given query.
+//
+//	func (DailyStats) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.MaterializedView("SELECT date(created_at) AS id, COUNT(*) AS count FROM users GROUP BY 1"),
+//		}
+//	}
+//
+func MaterializedView(query string) *Annotation {
+	return &Annotation{View: query, Materialized: true}
+}
+
+// AccessPattern returns a new annotation that declares an expected access pattern of the
+// schema. The codegen creates an index for the given fields (or edges), unless an existing
+// index starts with them, and warns about edges of the schema that their foreign-keys are
+// not covered by any index, as the queries generated for these edges cannot use one.
+//
+//	func (Pet) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.AccessPattern("owner_id", "created_at"),
+//			entsql.AccessPattern("name"),
+//		}
+//	}
+//
+func AccessPattern(fields ...string) *Annotation {
+	return &Annotation{AccessPatterns: [][]string{fields}}
+}
+
+// Compress returns a new annotation that compresses the values of a field using
+// the given algorithm (e.g. "gzip" or "zstd"). Existing uncompressed values are
+// read as is, and compressed once they are updated.
+//
+//	field.JSON("payload", map[string]interface{}{}).
+//		Annotations(
+//			entsql.Compress("gzip"),
+//		)
+//
+func Compress(alg string) *Annotation {
+	return &Annotation{Compression: alg}
+}
+
+// SoftDelete returns a new annotation that soft-deletes the entities of the schema,
+// using the given nillable time field to mark them as deleted.
+//
+//	func (Pet) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.SoftDelete("deleted_at"),
+//		}
+//	}
+//
+func SoftDelete(field string) *Annotation {
+	return &Annotation{SoftDelete: field}
+}
+
+// Version returns a new annotation that uses the given integer field for optimistic
+// concurrency control of the entities of the schema.
+//
+//	func (Pet) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Version("version"),
+//		}
+//	}
+//
+func Version(field string) *Annotation {
+	return &Annotation{Version: field}
+}
+
+// Archive returns a new annotation that adds an archive table to the schema,
+// for moving cold rows out of its table.
+//
+//	func (Event) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Archive(),
+//		}
+//	}
+//
+func Archive() *Annotation {
+	return &Annotation{Archive: true}
+}
+
+// OrderField returns a new edge annotation that sets the default order of the entities of the edge. It is
+// applied when the edge is eager-loaded or traversed and the query was not ordered explicitly, and takes
+// precedence over the default order of the type that the edge points to. Fields that are prefixed with "-"
+// are sorted in descending order.
+//
+//	func (Pet) Edges() []ent.Edge {
+//		return []ent.Edge{
+//			edge.To("friends", Pet.Type).
+//				Annotations(entsql.OrderField("rank", "id")),
+//		}
+//	}
+//
+func OrderField(fields ...string) *Annotation {
+	return &Annotation{EdgeOrder: fields}
+}
+
+// ViewQuery returns a new annotation that defines the schema as a view, with a query
+// that is composed by the given function using the SQL builder. The function is called
+// once for each dialect that supports views, and therefore, it should not depend on the
+// dialect of the selector. Note that views cannot have arguments, and the function panics
+// if the composed query contains any. Use sql.Expr or sql.ExprP for literal values.
+//
+//	func (UserStats) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.ViewQuery(func(s *sql.Selector) {
+//				u, p := sql.Table("users").As("u"), sql.Table("posts").As("p")
+//				s.From(u).
+//					Select(u.C("id"), u.C("name"), sql.As(sql.Count(p.C("id")), "posts")).
+//					LeftJoin(p).On(u.C("id"), p.C("author_id")).
+//					GroupBy(u.C("id"), u.C("name"))
+//			}),
+//		}
+//	}
+//
+func ViewQuery(fn func(*sql.Selector)) *Annotation {
+	return &Annotation{ViewQueries: viewQueries(fn)}
+}
+
+// MaterializedViewQuery is like ViewQuery, but defines the schema as a materialized view.
+func MaterializedViewQuery(fn func(*sql.Selector)) *Annotation {
+	return &Annotation{ViewQueries: viewQueries(fn), Materialized: true}
+}
+
+// viewQueries composes the view query for the dialects that support views.
+func viewQueries(fn func(*sql.Selector)) map[string]string {
+	queries := make(map[string]string)
+	for _, d := range []string{dialect.Postgres, dialect.SQLite} {
+		s := sql.Dialect(d).Select()
+		fn(s)
+		query, args := s.Query()
+		if len(args) > 0 {
+			panic(fmt.Sprintf("entsql: view query cannot contain arguments: %q %v", query, args))
+		}
+		queries[d] = query
+	}
+	return queries
+}
+
+// IsView reports if the annotation defines the schema as a view.
+func (a Annotation) IsView() bool {
+	return a.View != "" || len(a.ViewQueries) > 0
+}
+
+// ViewQueryOf returns the query of the view for the given dialect.
+func (a Annotation) ViewQueryOf(dialect string) string {
+	if q, ok := a.ViewQueries[dialect]; ok {
+		return q
+	}
+	return a.View
 }
 
 // Name describes the annotation name.
@@ -148,6 +602,57 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if c := ant.Check; c != "" {
 		a.Check = c
 	}
+	if v := ant.View; v != "" {
+		a.View = v
+	}
+	if ant.Materialized {
+		a.Materialized = true
+	}
+	if c := ant.Compression; c != "" {
+		a.Compression = c
+	}
+	if f := ant.SoftDelete; f != "" {
+		a.SoftDelete = f
+	}
+	if f := ant.Version; f != "" {
+		a.Version = f
+	}
+	if ant.Archive {
+		a.Archive = true
+	}
+	if s := ant.Sequence; s != nil {
+		a.Sequence = s
+	}
+	if i := ant.Identity; i != nil {
+		a.Identity = i
+	}
+	if g := ant.Generated; g != nil {
+		a.Generated = g
+	}
+	if o := ant.EdgeOrder; len(o) > 0 {
+		a.EdgeOrder = o
+	}
+	if d := ant.QueryDefaults; d != nil {
+		if a.QueryDefaults == nil {
+			a.QueryDefaults = &QueryDefaults{}
+		}
+		if len(d.Order) > 0 {
+			a.QueryDefaults.Order = d.Order
+		}
+		if d.Limit != 0 {
+			a.QueryDefaults.Limit = d.Limit
+		}
+		a.QueryDefaults.Where = append(a.QueryDefaults.Where, d.Where...)
+	}
+	a.AccessPatterns = append(a.AccessPatterns, ant.AccessPatterns...)
+	if queries := ant.ViewQueries; len(queries) > 0 {
+		if a.ViewQueries == nil {
+			a.ViewQueries = make(map[string]string)
+		}
+		for d, q := range queries {
+			a.ViewQueries[d] = q
+		}
+	}
 	if checks := ant.Checks; len(checks) > 0 {
 		if a.Checks == nil {
 			a.Checks = make(map[string]string)
@@ -0,0 +1,268 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entstats provides a driver that collects statistics (count, latency and errors)
+// of the executed statements, grouped by the Go call site that issued them. The call site
+// of a statement is the first frame in its call stack that does not belong to the ent
+// runtime packages or to the packages that were configured using WithSkip (e.g. the
+// generated ent package), which is usually the line that built and executed the query.
+// The driver can also cancel statements that exceed a hard limit using WithWatchdog.
+//
+//	drv := entstats.NewDriver(drv, entstats.WithSkip("example.com/app/ent"))
+//	client := ent.NewClient(ent.Driver(drv))
+//	// ...
+//	http.HandleFunc("/debug/entstats", func(w http.ResponseWriter, _ *http.Request) {
+//		drv.Dump(w)
+//	})
+//
+package entstats
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// Stat holds the statistics of a call site.
+type Stat struct {
+	// Site is the location of the call site, formatted as "file:line".
+	Site string
+	// Func is the fully qualified name of the function of the call site.
+	Func string
+	// Queries and Execs are the number of Query and Exec operations issued by the call site.
+	Queries, Execs int64
+	// Errors is the number of operations that returned an error.
+	Errors int64
+	// Total and Max are the total and maximum latency of the operations.
+	Total, Max time.Duration
+}
+
+// Count returns the total number of operations issued by the call site.
+func (s Stat) Count() int64 {
+	return s.Queries + s.Execs
+}
+
+// Avg returns the average latency of the operations issued by the call site.
+func (s Stat) Avg() time.Duration {
+	if n := s.Count(); n > 0 {
+		return s.Total / time.Duration(n)
+	}
+	return 0
+}
+
+// Option allows configuring the driver using functional options.
+type Option func(*Driver)
+
+// WithSkip adds packages to the list of packages that are skipped when resolving the call
+// site of a statement. Subpackages of the given packages are skipped as well. Usually, it
+// is called with the import path of the generated ent package.
+func WithSkip(pkgs ...string) Option {
+	return func(d *Driver) {
+		d.skip = append(d.skip, pkgs...)
+	}
+}
+
+// WithDepth sets the maximum number of frames that are inspected when resolving the call
+// site of a statement. The default is 32.
+func WithDepth(n int) Option {
+	return func(d *Driver) {
+		d.depth = n
+	}
+}
+
+// Driver is a dialect.Driver that collects statistics of the statements
+// executed on its underlying driver, grouped by their call site.
+type Driver struct {
+	dialect.Driver
+	skip  []string
+	depth int
+	mu    sync.Mutex
+	stats map[string]*Stat
+	// Watchdog configuration.
+	limit  time.Duration
+	report func(*Incident)
+}
+
+// NewDriver returns a new Driver that wraps the given driver.
+func NewDriver(drv dialect.Driver, opts ...Option) *Driver {
+	d := &Driver{
+		Driver: drv,
+		depth:  32,
+		// The ent runtime packages. The root
+		// package is matched exactly by skipped.
+		skip: []string{
+			"entgo.io/ent/dialect",
+			"entgo.io/ent/entql",
+			"entgo.io/ent/privacy",
+		},
+		stats: make(map[string]*Stat),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Exec calls the underlying driver Exec method, and records its statistics.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.do(ctx, false, query, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// Query calls the underlying driver Query method, and records its statistics.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.do(ctx, true, query, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// Tx starts a transaction whose statements are recorded.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, drv: d}, nil
+}
+
+// Stats returns the statistics of all call sites, ordered by their number of operations.
+func (d *Driver) Stats() []Stat {
+	d.mu.Lock()
+	stats := make([]Stat, 0, len(d.stats))
+	for _, s := range d.stats {
+		stats = append(stats, *s)
+	}
+	d.mu.Unlock()
+	sort.Slice(stats, func(i, j int) bool {
+		if ci, cj := stats[i].Count(), stats[j].Count(); ci != cj {
+			return ci > cj
+		}
+		return stats[i].Site < stats[j].Site
+	})
+	return stats
+}
+
+// Reset clears the collected statistics.
+func (d *Driver) Reset() {
+	d.mu.Lock()
+	d.stats = make(map[string]*Stat)
+	d.mu.Unlock()
+}
+
+// Dump writes the statistics of all call sites as a table to the given writer.
+func (d *Driver) Dump(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "COUNT\tQUERIES\tEXECS\tERRORS\tTOTAL\tAVG\tMAX\tSITE\tFUNC")
+	for _, s := range d.Stats() {
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%d\t%s\t%s\t%s\t%s\t%s\n", s.Count(), s.Queries, s.Execs, s.Errors, s.Total, s.Avg(), s.Max, s.Site, s.Func)
+	}
+	return tw.Flush()
+}
+
+// do executes an operation and records it for the call site of the caller.
+func (d *Driver) do(ctx context.Context, query bool, stmt string, f func(context.Context) error) error {
+	site, fn := d.caller()
+	ctx, done := d.watch(ctx, stmt, site, fn)
+	start := time.Now()
+	err := f(ctx)
+	latency := time.Since(start)
+	done()
+	d.record(site, fn, query, latency, err)
+	return err
+}
+
+// record records an operation for the given call site.
+func (d *Driver) record(site, fn string, query bool, latency time.Duration, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	s, ok := d.stats[site]
+	if !ok {
+		s = &Stat{Site: site, Func: fn}
+		d.stats[site] = s
+	}
+	if query {
+		s.Queries++
+	} else {
+		s.Execs++
+	}
+	if err != nil {
+		s.Errors++
+	}
+	s.Total += latency
+	if latency > s.Max {
+		s.Max = latency
+	}
+}
+
+// caller returns the location and the function name of the first
+// frame in the call stack that does not belong to a skipped package.
+func (d *Driver) caller() (string, string) {
+	pcs := make([]uintptr, d.depth)
+	// Skip runtime.Callers, caller, do and the Exec or Query method.
+	n := runtime.Callers(4, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		f, more := frames.Next()
+		if !d.skipped(f.Function) {
+			return fmt.Sprintf("%s:%d", f.File, f.Line), f.Function
+		}
+		if !more {
+			return "unknown", ""
+		}
+	}
+}
+
+// skipped reports if the given function belongs to a skipped package.
+func (d *Driver) skipped(fn string) bool {
+	switch pkg := funcPackage(fn); {
+	case pkg == "runtime", pkg == "entgo.io/ent", pkg == "database/sql", strings.HasPrefix(pkg, "database/sql/"):
+		return true
+	default:
+		for _, p := range d.skip {
+			if pkg == p || strings.HasPrefix(pkg, p+"/") {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// funcPackage returns the import path of the package of the given
+// function name. For example, "entgo.io/ent/dialect/sql.(*Driver).Exec".
+func funcPackage(fn string) string {
+	i := strings.LastIndexByte(fn, '/')
+	if j := strings.IndexByte(fn[i+1:], '.'); j != -1 {
+		return fn[:i+1+j]
+	}
+	return fn
+}
+
+// Tx is a dialect.Tx that records the statistics of its statements.
+type Tx struct {
+	dialect.Tx
+	drv *Driver
+}
+
+// Exec calls the underlying transaction Exec method, and records its statistics.
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return t.drv.do(ctx, false, query, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+// Query calls the underlying transaction Query method, and records its statistics.
+func (t *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	return t.drv.do(ctx, true, query, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
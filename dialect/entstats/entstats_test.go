@@ -0,0 +1,67 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entstats
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/stretchr/testify/require"
+)
+
+type nopDriver struct {
+	dialect.Driver
+	err error
+}
+
+func (d nopDriver) Exec(context.Context, string, interface{}, interface{}) error { return d.err }
+
+func (d nopDriver) Query(context.Context, string, interface{}, interface{}) error { return d.err }
+
+func TestFuncPackage(t *testing.T) {
+	for fn, pkg := range map[string]string{
+		"entgo.io/ent/dialect/sql.(*Driver).Exec":      "entgo.io/ent/dialect/sql",
+		"entgo.io/ent.(*Mutation).Op":                  "entgo.io/ent",
+		"example.com/app/ent.(*UserQuery).All":         "example.com/app/ent",
+		"example.com/app/ent/user.And.func1":           "example.com/app/ent/user",
+		"main.main":                                    "main",
+		"database/sql.(*DB).QueryContext":              "database/sql",
+		"entgo.io/ent/entc/integration.TestQueryStats": "entgo.io/ent/entc/integration",
+	} {
+		require.Equal(t, pkg, funcPackage(fn))
+	}
+}
+
+func TestDriver(t *testing.T) {
+	ctx := context.Background()
+	drv := NewDriver(nopDriver{})
+	// Stop skipping the ent packages, in order to attribute
+	// the statements to the functions of this test.
+	drv.skip = nil
+	list := func() error { return drv.Query(ctx, "SELECT", []interface{}{}, nil) }
+	for i := 0; i < 3; i++ {
+		require.NoError(t, list())
+	}
+	require.NoError(t, drv.Exec(ctx, "INSERT", []interface{}{}, nil))
+	drv.Driver = nopDriver{err: errors.New("oops")}
+	require.Error(t, drv.Exec(ctx, "INSERT", []interface{}{}, nil))
+
+	stats := drv.Stats()
+	require.Len(t, stats, 3)
+	require.Equal(t, int64(3), stats[0].Queries)
+	require.True(t, strings.HasSuffix(stats[0].Func, "TestDriver.func1"), stats[0].Func)
+	require.Equal(t, int64(1), stats[1].Execs)
+	require.Equal(t, int64(1), stats[2].Errors)
+
+	var b strings.Builder
+	require.NoError(t, drv.Dump(&b))
+	require.Len(t, strings.Split(strings.TrimSpace(b.String()), "\n"), 4)
+	drv.Reset()
+	require.Empty(t, drv.Stats())
+}
@@ -0,0 +1,127 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entstats
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Incident describes a statement that exceeded the limit of the watchdog.
+type Incident struct {
+	// Site and Func are the call site that issued the statement.
+	Site, Func string
+	// Query is the statement that exceeded the limit.
+	Query string
+	// Start is the time the statement started, and Limit is the limit it exceeded.
+	Start time.Time
+	Limit time.Duration
+	// Canceled is the number of server-side executions of the statement that
+	// were canceled (e.g. using pg_cancel_backend on PostgreSQL).
+	Canceled int
+	// Err holds the error of the server-side cancellation, if it failed.
+	Err error
+}
+
+// String formats the incident as a structured (logfmt) log line.
+func (i *Incident) String() string {
+	s := fmt.Sprintf("msg=%q site=%s func=%s start=%s limit=%s canceled=%d query=%q",
+		"query exceeded watchdog limit", i.Site, i.Func, i.Start.Format(time.RFC3339Nano), i.Limit, i.Canceled, i.Query)
+	if i.Err != nil {
+		s += " err=" + strconv.Quote(i.Err.Error())
+	}
+	return s
+}
+
+// WithWatchdog enables a watchdog that tracks the in-flight statements, and cancels the ones
+// that run longer than the given limit. On PostgreSQL and MySQL, the statement is canceled on
+// the server-side (using pg_cancel_backend and KILL QUERY, respectively), as canceling its
+// context does not necessarily stop its execution on the database. On other dialects, only
+// the context of the statement is canceled.
+//
+// Each cancellation is reported to the given function, along with the call site of the
+// statement. If report is nil, incidents are written to the standard logger.
+//
+// Note that the server-side execution is matched by its query text and duration. Hence,
+// concurrent executions of the same statement that exceeded the limit are canceled as well.
+func WithWatchdog(limit time.Duration, report func(*Incident)) Option {
+	return func(d *Driver) {
+		if report == nil {
+			report = func(i *Incident) { log.Print("entstats: ", i) }
+		}
+		d.limit, d.report = limit, report
+	}
+}
+
+// watch starts watching the given statement, and returns its context
+// and a function that must be called when the statement returns.
+func (d *Driver) watch(ctx context.Context, query, site, fn string) (context.Context, func()) {
+	if d.limit <= 0 {
+		return ctx, func() {}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	start := time.Now()
+	t := time.AfterFunc(d.limit, func() {
+		i := &Incident{Site: site, Func: fn, Query: query, Start: start, Limit: d.limit}
+		i.Canceled, i.Err = d.cancel(query)
+		cancel()
+		d.report(i)
+	})
+	return ctx, func() {
+		t.Stop()
+		cancel()
+	}
+}
+
+// cancel cancels the server-side executions of the given query that exceeded the limit.
+// It is executed on the underlying driver, and therefore, on a separate connection.
+func (d *Driver) cancel(query string) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	switch d.Dialect() {
+	case dialect.Postgres:
+		var canceled []bool
+		if err := d.scan(ctx, &canceled, "SELECT pg_cancel_backend(pid) FROM pg_stat_activity WHERE pid <> pg_backend_pid() AND state = 'active' AND query = $1 AND now() - query_start >= $2 * interval '1 second'", query, d.limit.Seconds()); err != nil {
+			return 0, err
+		}
+		n := 0
+		for _, ok := range canceled {
+			if ok {
+				n++
+			}
+		}
+		return n, nil
+	case dialect.MySQL:
+		var ids []int64
+		if err := d.scan(ctx, &ids, "SELECT ID FROM information_schema.PROCESSLIST WHERE ID <> CONNECTION_ID() AND INFO = ? AND TIME >= ?", query, int64(d.limit.Seconds())); err != nil {
+			return 0, err
+		}
+		for i, id := range ids {
+			// KILL does not accept placeholders.
+			if err := d.Driver.Exec(ctx, "KILL QUERY "+strconv.FormatInt(id, 10), []interface{}{}, nil); err != nil {
+				return i, err
+			}
+		}
+		return len(ids), nil
+	default:
+		return 0, nil
+	}
+}
+
+// scan executes the query on the underlying driver and scans its rows into v.
+func (d *Driver) scan(ctx context.Context, v interface{}, query string, args ...interface{}) error {
+	rows := &sql.Rows{}
+	if err := d.Driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,79 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entstats
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+// slowDriver blocks the "SLOW" statements until their context is canceled.
+type slowDriver struct {
+	dialect.Driver
+}
+
+func (d slowDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if query == "SLOW" {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func TestWatchdog(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT pg_cancel_backend(pid) FROM pg_stat_activity")).
+		WithArgs("SLOW", 0.05).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_cancel_backend"}).AddRow(true))
+	incidents := make(chan *Incident, 1)
+	drv := NewDriver(slowDriver{sql.OpenDB(dialect.Postgres, db)}, WithWatchdog(50*time.Millisecond, func(i *Incident) {
+		incidents <- i
+	}))
+	drv.skip = nil
+	ctx := context.Background()
+	err = drv.Query(ctx, "SLOW", []interface{}{}, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	i := <-incidents
+	require.Equal(t, "SLOW", i.Query)
+	require.Equal(t, 1, i.Canceled)
+	require.NoError(t, i.Err)
+	require.True(t, strings.HasSuffix(i.Func, "TestWatchdog"), i.Func)
+	require.Contains(t, i.String(), `canceled=1 query="SLOW"`)
+	require.Equal(t, int64(1), drv.Stats()[0].Errors)
+
+	// Statements that complete within the limit are not reported.
+	mock.ExpectExec("FAST").WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, drv.Exec(ctx, "FAST", []interface{}{}, nil))
+	time.Sleep(100 * time.Millisecond)
+	require.Empty(t, incidents)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWatchdog_MySQL(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery("SELECT ID FROM information_schema.PROCESSLIST").
+		WithArgs("SLOW", 0).
+		WillReturnRows(sqlmock.NewRows([]string{"ID"}).AddRow(7).AddRow(9))
+	mock.ExpectExec("KILL QUERY 7").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("KILL QUERY 9").WillReturnResult(sqlmock.NewResult(0, 0))
+	incidents := make(chan *Incident, 1)
+	drv := NewDriver(slowDriver{sql.OpenDB(dialect.MySQL, db)}, WithWatchdog(10*time.Millisecond, func(i *Incident) {
+		incidents <- i
+	}))
+	require.ErrorIs(t, drv.Query(context.Background(), "SLOW", []interface{}{}, nil), context.Canceled)
+	require.Equal(t, 2, (<-incidents).Canceled)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,261 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package enttrace provides an extension that instruments the generated clients with
+// OpenCensus tracing and stats. Spans are emitted for each query and mutation, with the
+// entity type, the operation, the number of predicates and the number of affected rows
+// as attributes, and the statements executed by the driver are recorded as their child
+// spans. The spans and metrics can be exported to OpenTelemetry backends using the
+// OpenCensus bridge (go.opentelemetry.io/otel/bridge/opencensus).
+//
+//	ext := enttrace.New(enttrace.WithStatement())
+//	client := ent.NewClient(ent.Driver(drv))
+//	client.AddExtension(ext)
+//	// Metrics are collected only for the registered views.
+//	if err := view.Register(enttrace.Views()...); err != nil {
+//		return err
+//	}
+//
+package enttrace
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"go.opencensus.io/trace"
+)
+
+// Attributes recorded on the spans. The statement attributes follow
+// the OpenTelemetry semantic conventions for database clients.
+const (
+	TypeAttribute         = "ent.type"
+	OperationAttribute    = "ent.operation"
+	PredicatesAttribute   = "ent.predicates"
+	RowsAttribute         = "ent.rows"
+	RowsAffectedAttribute = "ent.rows_affected"
+	SystemAttribute       = "db.system"
+	TableAttribute        = "db.sql.table"
+	StatementAttribute    = "db.statement"
+)
+
+// Extension is an ent.Extension that traces the queries and the mutations of the client,
+// and the statements that are executed by its driver.
+type Extension struct {
+	ent.DefaultExtension
+	startOptions trace.StartOptions
+	statement    bool
+}
+
+// Option allows configuring the extension using functional options.
+type Option func(*Extension)
+
+// WithSampler sets the sampler of the spans that are started by the extension.
+func WithSampler(sampler trace.Sampler) Option {
+	return func(e *Extension) {
+		e.startOptions.Sampler = sampler
+	}
+}
+
+// WithStatement enables recording the SQL statements (without their arguments) in the
+// spans of the driver. Only enable this if it is safe to record them with respect to
+// security.
+func WithStatement() Option {
+	return func(e *Extension) {
+		e.statement = true
+	}
+}
+
+// New returns a new Extension configured with the given options.
+func New(opts ...Option) *Extension {
+	e := &Extension{}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Name implements the ent.Extension interface.
+func (*Extension) Name() string { return "enttrace" }
+
+// Hooks returns a hook that traces the mutations, and records their stats.
+func (e *Extension) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				op := m.Op().String()
+				ctx, span := e.startSpan(ctx, m.Type()+"."+op)
+				defer span.End()
+				span.AddAttributes(
+					trace.StringAttribute(TypeAttribute, m.Type()),
+					trace.StringAttribute(OperationAttribute, op),
+				)
+				start := time.Now()
+				v, err := next.Mutate(ctx, m)
+				recordOperation(ctx, m.Type(), op, start, err)
+				if err != nil {
+					setError(span, err)
+					return nil, err
+				}
+				// Bulk operations return the number of affected rows,
+				// and the rest of the operations return the entity.
+				affected := int64(1)
+				if n, ok := v.(int); ok {
+					affected = int64(n)
+				}
+				span.AddAttributes(trace.Int64Attribute(RowsAffectedAttribute, affected))
+				return v, nil
+			})
+		},
+	}
+}
+
+// Interceptors returns an interceptor that traces the queries, and records their stats.
+func (e *Extension) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				qc := ent.QueryFromContext(ctx)
+				if qc == nil {
+					return next.Query(ctx, q)
+				}
+				ctx, span := e.startSpan(ctx, qc.Type+"."+qc.Op)
+				defer span.End()
+				span.AddAttributes(
+					trace.StringAttribute(TypeAttribute, qc.Type),
+					trace.StringAttribute(OperationAttribute, qc.Op),
+					trace.Int64Attribute(PredicatesAttribute, int64(len(qc.Predicates))),
+				)
+				start := time.Now()
+				v, err := next.Query(ctx, q)
+				recordOperation(ctx, qc.Type, qc.Op, start, err)
+				if err != nil {
+					setError(span, err)
+					return nil, err
+				}
+				if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice {
+					span.AddAttributes(trace.Int64Attribute(RowsAttribute, int64(rv.Len())))
+				}
+				return v, nil
+			})
+		}),
+	}
+}
+
+// Driver returns a driver that traces the statements that are executed on the given driver.
+func (e *Extension) Driver(drv dialect.Driver) dialect.Driver {
+	return &Driver{Driver: drv, ext: e}
+}
+
+// Driver is a dialect.Driver that traces the statements that are
+// executed on its underlying driver, and records their stats.
+type Driver struct {
+	dialect.Driver
+	ext *Extension
+}
+
+// NewDriver returns a new Driver that wraps the given driver. Note that queries and mutations
+// are traced only by the extension. Hence, NewDriver should be used only for tracing drivers
+// that are not used by generated clients.
+func NewDriver(drv dialect.Driver, opts ...Option) *Driver {
+	return &Driver{Driver: drv, ext: New(opts...)}
+}
+
+// Exec calls the underlying driver Exec method, and traces it.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.do(ctx, "Exec", query, v, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// Query calls the underlying driver Query method, and traces it.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.do(ctx, "Query", query, nil, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// Tx starts a transaction whose statements are traced.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, drv: d}, nil
+}
+
+// Tx is a dialect.Tx that traces the statements that are executed in the transaction.
+type Tx struct {
+	dialect.Tx
+	drv *Driver
+}
+
+// Exec calls the underlying transaction Exec method, and traces it.
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return t.drv.do(ctx, "Exec", query, v, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+// Query calls the underlying transaction Query method, and traces it.
+func (t *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	return t.drv.do(ctx, "Query", query, nil, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) do(ctx context.Context, op, query string, v interface{}, f func(context.Context) error) error {
+	system := d.Dialect()
+	ctx, span := d.ext.startSpan(ctx, system+":"+strings.ToLower(op))
+	defer span.End()
+	table := tableName(query)
+	span.AddAttributes(
+		trace.StringAttribute(SystemAttribute, system),
+		trace.StringAttribute(TableAttribute, table),
+	)
+	if d.ext.statement {
+		span.AddAttributes(trace.StringAttribute(StatementAttribute, query))
+	}
+	start := time.Now()
+	err := f(ctx)
+	recordStatement(ctx, table, op, start, err)
+	if err != nil {
+		setError(span, err)
+		return err
+	}
+	if res, ok := v.(*sql.Result); ok && *res != nil {
+		if n, err := (*res).RowsAffected(); err == nil {
+			span.AddAttributes(trace.Int64Attribute(RowsAffectedAttribute, n))
+		}
+	}
+	return nil
+}
+
+func (e *Extension) startSpan(ctx context.Context, name string) (context.Context, *trace.Span) {
+	return trace.StartSpan(ctx, name,
+		trace.WithSampler(e.startOptions.Sampler),
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+}
+
+func setError(span *trace.Span, err error) {
+	span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: err.Error()})
+}
+
+// tableRe matches the first table of a SELECT, INSERT, UPDATE or DELETE statement.
+var tableRe = regexp.MustCompile("(?i)\\b(?:FROM|INTO|UPDATE)\\s+[`\"]?(\\w+)")
+
+// tableName returns the name of the first table that appears in the query, if any.
+func tableName(query string) string {
+	if m := tableRe.FindStringSubmatch(query); m != nil {
+		return strings.ToLower(m[1])
+	}
+	return ""
+}
@@ -0,0 +1,166 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package enttrace
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/trace"
+)
+
+// exporter collects the exported spans.
+type exporter struct {
+	mu    sync.Mutex
+	spans []*trace.SpanData
+}
+
+func (e *exporter) ExportSpan(s *trace.SpanData) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, s)
+}
+
+func (e *exporter) reset() []*trace.SpanData {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	spans := e.spans
+	e.spans = nil
+	return spans
+}
+
+func newExporter(t *testing.T) *exporter {
+	e := &exporter{}
+	trace.RegisterExporter(e)
+	t.Cleanup(func() { trace.UnregisterExporter(e) })
+	return e
+}
+
+func TestDriver(t *testing.T) {
+	ctx := context.Background()
+	exp := newExporter(t)
+	drv, err := sql.Open(dialect.SQLite, "file:enttrace?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ext := New(WithSampler(trace.AlwaysSample()), WithStatement())
+	tdrv := ext.Driver(drv)
+	require.NoError(t, tdrv.Exec(ctx, "CREATE TABLE users (id integer PRIMARY KEY, name text)", []interface{}{}, nil))
+	exp.reset()
+
+	var res sql.Result
+	require.NoError(t, tdrv.Exec(ctx, "INSERT INTO `users` (`name`) VALUES (?), (?)", []interface{}{"a8m", "nati"}, &res))
+	spans := exp.reset()
+	require.Len(t, spans, 1)
+	require.Equal(t, "sqlite3:exec", spans[0].Name)
+	require.Equal(t, dialect.SQLite, spans[0].Attributes[SystemAttribute])
+	require.Equal(t, "users", spans[0].Attributes[TableAttribute])
+	require.Equal(t, "INSERT INTO `users` (`name`) VALUES (?), (?)", spans[0].Attributes[StatementAttribute])
+	require.Equal(t, int64(2), spans[0].Attributes[RowsAffectedAttribute])
+
+	t.Log("statements of transactions are traced")
+	tx, err := tdrv.Tx(ctx)
+	require.NoError(t, err)
+	rows := &sql.Rows{}
+	require.NoError(t, tx.Query(ctx, "SELECT name FROM users", []interface{}{}, rows))
+	require.NoError(t, rows.Close())
+	require.Error(t, tx.Exec(ctx, "INSERT INTO unknown (name) VALUES (?)", []interface{}{"a8m"}, nil))
+	require.NoError(t, tx.Rollback())
+	spans = exp.reset()
+	require.Len(t, spans, 2)
+	require.Equal(t, "sqlite3:query", spans[0].Name)
+	require.Equal(t, "users", spans[0].Attributes[TableAttribute])
+	require.Equal(t, "sqlite3:exec", spans[1].Name)
+	require.Equal(t, int32(trace.StatusCodeUnknown), spans[1].Status.Code)
+
+	t.Log("statements are not recorded by default")
+	require.NoError(t, NewDriver(drv, WithSampler(trace.AlwaysSample())).Exec(ctx, "DELETE FROM users", []interface{}{}, nil))
+	spans = exp.reset()
+	require.Len(t, spans, 1)
+	require.NotContains(t, spans[0].Attributes, StatementAttribute)
+}
+
+// mutation is a minimal ent.Mutation for testing the hooks.
+type mutation struct {
+	ent.Mutation
+	op ent.Op
+}
+
+func (m mutation) Op() ent.Op     { return m.op }
+func (mutation) Type() string     { return "User" }
+func (m mutation) String() string { return m.op.String() }
+
+func TestExtension_Hooks(t *testing.T) {
+	ctx := context.Background()
+	exp := newExporter(t)
+	ext := New(WithSampler(trace.AlwaysSample()))
+	require.Equal(t, "enttrace", ext.Name())
+	hooks := ext.Hooks()
+	require.Len(t, hooks, 1)
+
+	m := hooks[0](ent.MutateFunc(func(ctx context.Context, _ ent.Mutation) (ent.Value, error) {
+		require.NotNil(t, trace.FromContext(ctx), "span should be propagated")
+		return 3, nil
+	}))
+	v, err := m.Mutate(ctx, mutation{op: ent.OpUpdate})
+	require.NoError(t, err)
+	require.Equal(t, 3, v)
+	spans := exp.reset()
+	require.Len(t, spans, 1)
+	require.Equal(t, "User.OpUpdate", spans[0].Name)
+	require.Equal(t, "User", spans[0].Attributes[TypeAttribute])
+	require.Equal(t, "OpUpdate", spans[0].Attributes[OperationAttribute])
+	require.Equal(t, int64(3), spans[0].Attributes[RowsAffectedAttribute])
+
+	m = hooks[0](ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+		return nil, errors.New("oops")
+	}))
+	_, err = m.Mutate(ctx, mutation{op: ent.OpCreate})
+	require.EqualError(t, err, "oops")
+	spans = exp.reset()
+	require.Len(t, spans, 1)
+	require.Equal(t, "oops", spans[0].Status.Message)
+	require.NotContains(t, spans[0].Attributes, RowsAffectedAttribute)
+}
+
+func TestExtension_Interceptors(t *testing.T) {
+	exp := newExporter(t)
+	require.NoError(t, view.Register(OperationCountView))
+	defer view.Unregister(OperationCountView)
+	ext := New(WithSampler(trace.AlwaysSample()))
+	inters := ext.Interceptors()
+	require.Len(t, inters, 1)
+
+	q := inters[0].Intercept(ent.QuerierFunc(func(context.Context, ent.Query) (ent.Value, error) {
+		return []string{"a8m", "nati"}, nil
+	}))
+	ctx := ent.NewQueryContext(context.Background(), &ent.QueryContext{Type: "User", Op: "All", Predicates: make([]interface{}, 2)})
+	v, err := q.Query(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, v, 2)
+	spans := exp.reset()
+	require.Len(t, spans, 1)
+	require.Equal(t, "User.All", spans[0].Name)
+	require.Equal(t, int64(2), spans[0].Attributes[PredicatesAttribute])
+	require.Equal(t, int64(2), spans[0].Attributes[RowsAttribute])
+
+	rows, err := view.RetrieveData(OperationCountView.Name)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, int64(1), rows[0].Data.(*view.CountData).Value)
+
+	t.Log("queries without context are not traced")
+	_, err = q.Query(context.Background(), nil)
+	require.NoError(t, err)
+	require.Empty(t, exp.reset())
+}
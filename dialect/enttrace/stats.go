@@ -0,0 +1,122 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package enttrace
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+// The following measures are supported for use in custom views.
+var (
+	OperationCount = stats.Int64(
+		"ent/operation_count",
+		"Number of queries and mutations executed",
+		stats.UnitDimensionless,
+	)
+	OperationLatency = stats.Float64(
+		"ent/operation_latency",
+		"Latency of queries and mutations",
+		stats.UnitMilliseconds,
+	)
+	StatementLatency = stats.Float64(
+		"ent/statement_latency",
+		"Latency of the statements executed by the driver",
+		stats.UnitMilliseconds,
+	)
+)
+
+// The following tags are applied to stats recorded by this package.
+var (
+	// Type is the entity type of the query or the mutation.
+	Type, _ = tag.NewKey("ent_type")
+	// Operation is the operation of the query or the mutation (e.g. "All" or "OpUpdate"),
+	// or the operation of the statement (i.e. "Query" or "Exec").
+	Operation, _ = tag.NewKey("ent_operation")
+	// Table is the first table that appears in the statement.
+	Table, _ = tag.NewKey("ent_table")
+	// Status is "ok" for operations that succeeded, and "error" otherwise.
+	Status, _ = tag.NewKey("ent_status")
+)
+
+// DefaultLatencyDistribution is the default distribution used by the latency views in this package.
+var DefaultLatencyDistribution = view.Distribution(1, 2, 3, 4, 5, 6, 8, 10, 13, 16, 20, 25, 30, 40, 50, 65, 80, 100, 130, 160, 200, 250, 300, 400, 500, 650, 800, 1000, 2000, 5000, 10000, 20000, 50000, 100000)
+
+// Package enttrace provides some convenience views for measures.
+// You still need to register these views for data to actually be collected.
+var (
+	OperationCountView = &view.View{
+		Name:        "ent/operation_count",
+		Measure:     OperationCount,
+		Aggregation: view.Count(),
+		Description: "Count of queries and mutations, by type, operation and status",
+		TagKeys:     []tag.Key{Type, Operation, Status},
+	}
+
+	OperationLatencyView = &view.View{
+		Name:        "ent/operation_latency",
+		Measure:     OperationLatency,
+		Aggregation: DefaultLatencyDistribution,
+		Description: "Latency of queries and mutations, by type and operation",
+		TagKeys:     []tag.Key{Type, Operation},
+	}
+
+	StatementLatencyView = &view.View{
+		Name:        "ent/statement_latency",
+		Measure:     StatementLatency,
+		Aggregation: DefaultLatencyDistribution,
+		Description: "Latency of statements, by table and operation",
+		TagKeys:     []tag.Key{Table, Operation},
+	}
+)
+
+// Views are the default views provided by this package.
+func Views() []*view.View {
+	return []*view.View{
+		OperationCountView,
+		OperationLatencyView,
+		StatementLatencyView,
+	}
+}
+
+// recordOperation records the stats of a query or a mutation.
+func recordOperation(ctx context.Context, typ, op string, start time.Time, err error) {
+	_ = stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(Type, typ),
+			tag.Upsert(Operation, op),
+			tag.Upsert(Status, status(err)),
+		},
+		OperationCount.M(1),
+		OperationLatency.M(sinceMillis(start)),
+	)
+}
+
+// recordStatement records the stats of a statement.
+func recordStatement(ctx context.Context, table, op string, start time.Time, err error) {
+	_ = stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(Table, table),
+			tag.Upsert(Operation, op),
+			tag.Upsert(Status, status(err)),
+		},
+		StatementLatency.M(sinceMillis(start)),
+	)
+}
+
+func status(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
+
+func sinceMillis(start time.Time) float64 {
+	return float64(time.Since(start)) / float64(time.Millisecond)
+}
@@ -663,6 +663,68 @@ func (d *DropIndexBuilder) Query() (string, []interface{}) {
 	return d.String(), nil
 }
 
+// TruncateBuilder is a builder for `TRUNCATE TABLE` statement.
+type TruncateBuilder struct {
+	Builder
+	tables  []string
+	restart bool
+	cascade bool
+}
+
+// Truncate creates a builder for the `TRUNCATE TABLE` statement.
+// Note that MySQL accepts only one table in the statement, and
+// SQLite does not support it.
+//
+//	Dialect(dialect.Postgres).
+//		Truncate("users", "pets").
+//		RestartIdentity().
+//		Cascade()
+//
+func Truncate(tables ...string) *TruncateBuilder {
+	return &TruncateBuilder{tables: tables}
+}
+
+// RestartIdentity sets the RESTART IDENTITY option of the statement, that resets the
+// sequences of the identity columns in PostgreSQL. Note that MySQL always resets the
+// AUTO_INCREMENT counters of truncated tables, and therefore, the option is ignored.
+func (t *TruncateBuilder) RestartIdentity() *TruncateBuilder {
+	t.restart = true
+	return t
+}
+
+// Cascade sets the CASCADE option of the statement, that truncates the tables that reference
+// the truncated tables using foreign-keys in PostgreSQL. It is not supported by MySQL.
+func (t *TruncateBuilder) Cascade() *TruncateBuilder {
+	t.cascade = true
+	return t
+}
+
+// Query returns query representation of a `TRUNCATE TABLE` statement.
+//
+//	TRUNCATE TABLE table_name [, ...] [RESTART IDENTITY] [CASCADE]
+//
+func (t *TruncateBuilder) Query() (string, []interface{}) {
+	switch {
+	case len(t.tables) == 0:
+		t.AddError(fmt.Errorf("sql: missing tables for TRUNCATE statement"))
+	case t.dialect == dialect.SQLite:
+		t.AddError(fmt.Errorf("sql: TRUNCATE statement is not supported by SQLite"))
+	case t.dialect == dialect.MySQL && len(t.tables) > 1:
+		t.AddError(fmt.Errorf("sql: MySQL does not support truncating multiple tables in one statement"))
+	case t.dialect == dialect.MySQL && t.cascade:
+		t.AddError(fmt.Errorf("sql: CASCADE option is not supported by MySQL"))
+	}
+	t.WriteString("TRUNCATE TABLE ")
+	t.IdentComma(t.tables...)
+	if t.restart && t.postgres() {
+		t.WriteString(" RESTART IDENTITY")
+	}
+	if t.cascade {
+		t.WriteString(" CASCADE")
+	}
+	return t.String(), nil
+}
+
 // InsertBuilder is a builder for `INSERT INTO` statement.
 type InsertBuilder struct {
 	Builder
@@ -672,6 +734,7 @@ type InsertBuilder struct {
 	defaults  bool
 	returning []string
 	values    [][]interface{}
+	selector  *Selector
 	conflict  *conflict
 }
 
@@ -714,6 +777,17 @@ func (i *InsertBuilder) Values(values ...interface{}) *InsertBuilder {
 	return i
 }
 
+// Select sets the query that the inserted rows are selected from, instead of a list of values.
+//
+//	Insert("users_archive").
+//		Columns("id", "name").
+//		Select(Select("id", "name").From(Table("users")).Where(LT("age", 20)))
+//
+func (i *InsertBuilder) Select(s *Selector) *InsertBuilder {
+	i.selector = s
+	return i
+}
+
 // Default sets the default values clause based on the dialect type.
 func (i *InsertBuilder) Default() *InsertBuilder {
 	i.defaults = true
@@ -970,9 +1044,13 @@ func (i *InsertBuilder) Query() (string, []interface{}) {
 	i.WriteString("INSERT INTO ")
 	i.writeSchema(i.schema)
 	i.Ident(i.table).Pad()
-	if i.defaults && len(i.columns) == 0 {
+	switch {
+	case i.defaults && len(i.columns) == 0:
 		i.writeDefault()
-	} else {
+	case i.selector != nil:
+		i.WriteByte('(').IdentComma(i.columns...).WriteByte(')').Pad()
+		i.Join(i.selector)
+	default:
 		i.WriteByte('(').IdentComma(i.columns...).WriteByte(')')
 		i.WriteString(" VALUES ")
 		for j, v := range i.values {
@@ -1051,6 +1129,7 @@ type UpdateBuilder struct {
 	nulls   []string
 	columns []string
 	values  []interface{}
+	from    []TableView
 }
 
 // Update creates a builder for the `UPDATE` statement.
@@ -1110,9 +1189,37 @@ func (u *UpdateBuilder) Where(p *Predicate) *UpdateBuilder {
 	return u
 }
 
+// From adds tables to the `FROM` clause of the statement, for updating rows
+// that are joined with the rows of other tables. The join conditions are
+// set using the Where method.
+//
+//	t1, t2 := Table("users"), Table("groups")
+//	Update("users").
+//		Set("active", false).
+//		From(t2).
+//		Where(And(ColumnsEQ(t1.C("group_id"), t2.C("id")), EQ(t2.C("active"), false)))
+//
+// In MySQL, the tables are joined using the multiple-table syntax (i.e. UPDATE t1, t2 SET ...).
+func (u *UpdateBuilder) From(tables ...TableView) *UpdateBuilder {
+	u.from = append(u.from, tables...)
+	return u
+}
+
 // FromSelect makes it possible to update entities that match the sub-query.
+// The (inner) joins of the selector are added to the FROM clause of the
+// statement, and their conditions are added to its WHERE clause.
 func (u *UpdateBuilder) FromSelect(s *Selector) *UpdateBuilder {
-	u.Where(s.where)
+	ts, on, err := selectJoins(s)
+	if err != nil {
+		u.AddError(err)
+	}
+	u.From(ts...)
+	for _, p := range on {
+		u.Where(p)
+	}
+	if s.where != nil || len(on) == 0 {
+		u.Where(s.where)
+	}
 	if table, _ := s.from.(*SelectTable); table != nil {
 		u.table = table.name
 	}
@@ -1129,8 +1236,16 @@ func (u *UpdateBuilder) Query() (string, []interface{}) {
 	b := u.Builder.clone()
 	b.WriteString("UPDATE ")
 	b.writeSchema(u.schema)
-	b.Ident(u.table).WriteString(" SET ")
+	b.Ident(u.table)
+	if len(u.from) > 0 && b.mysql() {
+		b.Comma().writeTables(u.from)
+	}
+	b.WriteString(" SET ")
 	u.writeSetter(&b)
+	if len(u.from) > 0 && !b.mysql() {
+		b.WriteString(" FROM ")
+		b.writeTables(u.from)
+	}
 	if u.where != nil {
 		b.WriteString(" WHERE ")
 		b.Join(u.where)
@@ -1144,7 +1259,7 @@ func (u *UpdateBuilder) writeSetter(b *Builder) {
 		if i > 0 {
 			b.Comma()
 		}
-		b.Ident(c).WriteString(" = NULL")
+		u.writeColumn(b, c).WriteString(" = NULL")
 	}
 	if len(u.nulls) > 0 && len(u.columns) > 0 {
 		b.Comma()
@@ -1153,7 +1268,7 @@ func (u *UpdateBuilder) writeSetter(b *Builder) {
 		if i > 0 {
 			b.Comma()
 		}
-		b.Ident(c).WriteString(" = ")
+		u.writeColumn(b, c).WriteString(" = ")
 		switch v := u.values[i].(type) {
 		case Querier:
 			b.Join(v)
@@ -1163,12 +1278,42 @@ func (u *UpdateBuilder) writeSetter(b *Builder) {
 	}
 }
 
+// writeColumn writes the given column of the "SET" clause. The columns are
+// qualified with the table name in MySQL multiple-table updates, as they
+// can be ambiguous.
+func (u *UpdateBuilder) writeColumn(b *Builder, c string) *Builder {
+	if len(u.from) > 0 && b.mysql() {
+		return b.Ident(Table(u.table).C(c))
+	}
+	return b.Ident(c)
+}
+
+// selectJoins returns the tables and the conditions of the joins of the given selector, for
+// adding them to DELETE or UPDATE statements. Only inner joins can be expressed by them.
+func selectJoins(s *Selector) ([]TableView, []*Predicate, error) {
+	var (
+		ts []TableView
+		on []*Predicate
+	)
+	for _, j := range s.joins {
+		if j.kind != "JOIN" {
+			return nil, nil, fmt.Errorf("sql: %s is not supported by DELETE and UPDATE statements", j.kind)
+		}
+		ts = append(ts, j.table)
+		if j.on != nil {
+			on = append(on, j.on)
+		}
+	}
+	return ts, on, nil
+}
+
 // DeleteBuilder is a builder for `DELETE` statement.
 type DeleteBuilder struct {
 	Builder
 	table  string
 	schema string
 	where  *Predicate
+	using  []TableView
 }
 
 // Delete creates a builder for the `DELETE` statement.
@@ -1203,9 +1348,38 @@ func (d *DeleteBuilder) Where(p *Predicate) *DeleteBuilder {
 	return d
 }
 
-// FromSelect makes it possible to delete a sub query.
+// Using adds tables to the `USING` clause of the statement, for deleting rows
+// that are joined with the rows of other tables. The join conditions are set
+// using the Where method.
+//
+//	t1, t2 := Table("users"), Table("groups")
+//	Delete("users").
+//		Using(t2).
+//		Where(And(ColumnsEQ(t1.C("group_id"), t2.C("id")), EQ(t2.C("active"), false)))
+//
+// In MySQL, the tables are joined using the multiple-table syntax (i.e. DELETE t1 FROM t1, t2),
+// and in other dialects that do not support joins in DELETE statements (e.g. SQLite), they are
+// queried by a correlated sub-query (i.e. DELETE FROM t1 WHERE EXISTS (SELECT 1 FROM t2 WHERE ...)).
+func (d *DeleteBuilder) Using(tables ...TableView) *DeleteBuilder {
+	d.using = append(d.using, tables...)
+	return d
+}
+
+// FromSelect makes it possible to delete a sub query. The (inner)
+// joins of the selector are added to the USING clause of the
+// statement, and their conditions are added to its WHERE clause.
 func (d *DeleteBuilder) FromSelect(s *Selector) *DeleteBuilder {
-	d.Where(s.where)
+	ts, on, err := selectJoins(s)
+	if err != nil {
+		d.AddError(err)
+	}
+	d.Using(ts...)
+	for _, p := range on {
+		d.Where(p)
+	}
+	if s.where != nil || len(on) == 0 {
+		d.Where(s.where)
+	}
 	if table, _ := s.from.(*SelectTable); table != nil {
 		d.table = table.name
 	}
@@ -1214,9 +1388,35 @@ func (d *DeleteBuilder) FromSelect(s *Selector) *DeleteBuilder {
 
 // Query returns query representation of a `DELETE` statement.
 func (d *DeleteBuilder) Query() (string, []interface{}) {
-	d.WriteString("DELETE FROM ")
+	d.WriteString("DELETE ")
+	if len(d.using) > 0 && d.mysql() {
+		d.writeSchema(d.schema)
+		d.Ident(d.table).Pad()
+	}
+	d.WriteString("FROM ")
 	d.writeSchema(d.schema)
 	d.Ident(d.table)
+	switch {
+	case len(d.using) == 0:
+	case d.postgres():
+		d.WriteString(" USING ")
+		d.writeTables(d.using)
+	case d.mysql():
+		d.Comma().writeTables(d.using)
+	default:
+		// Dialects that do not support joins in DELETE statements
+		// query the joined tables using a correlated sub-query.
+		d.WriteString(" WHERE EXISTS ")
+		d.Nested(func(b *Builder) {
+			b.WriteString("SELECT 1 FROM ")
+			b.writeTables(d.using)
+			if d.where != nil {
+				b.WriteString(" WHERE ")
+				b.Join(d.where)
+			}
+		})
+		return d.String(), d.args
+	}
 	if d.where != nil {
 		d.WriteString(" WHERE ")
 		d.Join(d.where)
@@ -1999,6 +2199,13 @@ type SelectTable struct {
 	name   string
 	schema string
 	quote  bool
+	sample *tableSample
+}
+
+// tableSample holds the TABLESAMPLE clause of a table.
+type tableSample struct {
+	method  string
+	percent float64
 }
 
 // Table returns a new table selector.
@@ -2053,6 +2260,17 @@ func (s *SelectTable) Unquote() *SelectTable {
 	return s
 }
 
+// TableSample adds the TABLESAMPLE clause to the table selector, for selecting a random
+// sample of approximately the given percentage of the table rows. The sampling method
+// is usually SYSTEM (page-level) or BERNOULLI (row-level).
+//
+//	Select().From(Table("users").TableSample("SYSTEM", 10))
+//
+func (s *SelectTable) TableSample(method string, percent float64) *SelectTable {
+	s.sample = &tableSample{method: method, percent: percent}
+	return s
+}
+
 // ref returns the table reference.
 func (s *SelectTable) ref() string {
 	if !s.quote {
@@ -2065,6 +2283,13 @@ func (s *SelectTable) ref() string {
 		b.WriteString(" AS ")
 		b.Ident(s.as)
 	}
+	if s.sample != nil {
+		b.WriteString(" TABLESAMPLE ").
+			WriteString(s.sample.method).
+			WriteString(" (").
+			WriteString(strconv.FormatFloat(s.sample.percent, 'f', -1, 64)).
+			WriteString(")")
+	}
 	return b.String()
 }
 
@@ -2315,6 +2540,34 @@ func (s *Selector) Table() *SelectTable {
 	return s.from.(*SelectTable)
 }
 
+// Sample returns a function for selecting a random sample of approximately the given percentage
+// (0-100) of the rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table
+// pages instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+//
+//	s := Select().From(Table("users"))
+//	Sample(10)(s)
+//
+func Sample(percent float64) func(*Selector) {
+	return func(s *Selector) {
+		t, ok := s.from.(*SelectTable)
+		switch {
+		case ok && s.postgres():
+			t.TableSample("SYSTEM", percent)
+		default:
+			s.Where(P(func(b *Builder) {
+				switch {
+				case b.postgres():
+					b.WriteString("RANDOM() * 100 < ").Arg(percent)
+				case b.Dialect() == dialect.SQLite:
+					b.WriteString("ABS(RANDOM() % 10000) < ").Arg(percent * 100)
+				default:
+					b.WriteString("RAND() * 100 < ").Arg(percent)
+				}
+			}))
+		}
+	}
+}
+
 // TableName returns the name of the selected table or alias of selector.
 func (s *Selector) TableName() string {
 	switch view := s.from.(type) {
@@ -2327,6 +2580,11 @@ func (s *Selector) TableName() string {
 	}
 }
 
+// HasJoins reports if the selector joins other tables.
+func (s *Selector) HasJoins() bool {
+	return len(s.joins) > 0
+}
+
 // Join appends a `JOIN` clause to the statement.
 func (s *Selector) Join(t TableView) *Selector {
 	return s.join("JOIN", t)
@@ -2681,21 +2939,7 @@ func (s *Selector) Query() (string, []interface{}) {
 	}
 	for _, join := range s.joins {
 		b.WriteString(" " + join.kind + " ")
-		switch view := join.table.(type) {
-		case *SelectTable:
-			view.SetDialect(s.dialect)
-			b.WriteString(view.ref())
-		case *Selector:
-			view.SetDialect(s.dialect)
-			b.Nested(func(b *Builder) {
-				b.Join(view)
-			})
-			b.WriteString(" AS ")
-			b.Ident(view.as)
-		case *WithBuilder:
-			view.SetDialect(s.dialect)
-			b.Ident(view.Name())
-		}
+		b.writeTables([]TableView{join.table})
 		if join.on != nil {
 			b.WriteString(" ON ")
 			b.Join(join.on)
@@ -2924,6 +3168,13 @@ func RowNumber() *WindowBuilder {
 	return &WindowBuilder{fn: "ROW_NUMBER"}
 }
 
+// Rank returns a new window clause with the RANK() as a function.
+// Using this function will assign each row its rank in the order
+// defined by the ORDER BY clause in the window spec, with gaps.
+func Rank() *WindowBuilder {
+	return &WindowBuilder{fn: "RANK"}
+}
+
 // PartitionBy indicates to divide the query rows into groups by the given columns.
 // Note that, standard SQL spec allows partition only by columns, and in order to
 // use the "expression" version, use the PartitionByExpr.
@@ -3186,6 +3437,31 @@ func (b *Builder) AddError(err error) *Builder {
 	return b
 }
 
+// writeTables writes the given tables (or sub-queries), separated by commas.
+func (b *Builder) writeTables(ts []TableView) *Builder {
+	for i, t := range ts {
+		if i > 0 {
+			b.Comma()
+		}
+		switch view := t.(type) {
+		case *SelectTable:
+			view.SetDialect(b.dialect)
+			b.WriteString(view.ref())
+		case *Selector:
+			view.SetDialect(b.dialect)
+			b.Nested(func(b *Builder) {
+				b.Join(view)
+			})
+			b.WriteString(" AS ")
+			b.Ident(view.as)
+		case *WithBuilder:
+			view.SetDialect(b.dialect)
+			b.Ident(view.Name())
+		}
+	}
+	return b
+}
+
 func (b *Builder) writeSchema(schema string) {
 	if schema != "" && b.dialect != dialect.SQLite {
 		b.Ident(schema).WriteByte('.')
@@ -3633,6 +3909,17 @@ func (d *DialectBuilder) DropIndex(name string) *DropIndexBuilder {
 	return b
 }
 
+// Truncate creates a TruncateBuilder for the configured dialect.
+//
+//	Dialect(dialect.Postgres).
+//		Truncate("users")
+//
+func (d *DialectBuilder) Truncate(tables ...string) *TruncateBuilder {
+	b := Truncate(tables...)
+	b.SetDialect(d.dialect)
+	return b
+}
+
 func isFunc(s string) bool {
 	return strings.Contains(s, "(") && strings.Contains(s, ")")
 }
@@ -243,6 +243,20 @@ func TestBuilder(t *testing.T) {
 			wantQuery: `INSERT INTO "mydb"."users" ("age") VALUES ($1)`,
 			wantArgs:  []interface{}{1},
 		},
+		{
+			input: Insert("users_archive").
+				Columns("id", "age").
+				Select(Select("id", "age").From(Table("users")).Where(LT("age", 20))),
+			wantQuery: "INSERT INTO `users_archive` (`id`, `age`) SELECT `id`, `age` FROM `users` WHERE `age` < ?",
+			wantArgs:  []interface{}{20},
+		},
+		{
+			input: Dialect(dialect.Postgres).Insert("users_archive").
+				Columns("id", "age").
+				Select(Select("id", "age").From(Table("users")).Where(LT("age", 20))),
+			wantQuery: `INSERT INTO "users_archive" ("id", "age") SELECT "id", "age" FROM "users" WHERE "age" < $1`,
+			wantArgs:  []interface{}{20},
+		},
 		{
 			input:     Dialect(dialect.SQLite).Insert("users").Columns("age").Values(1).Schema("mydb"),
 			wantQuery: "INSERT INTO `users` (`age`) VALUES (?)",
@@ -1431,6 +1445,17 @@ func TestBuilder(t *testing.T) {
 			input:     DropIndex("name_index").Table("users"),
 			wantQuery: "DROP INDEX `name_index` ON `users`",
 		},
+		{
+			input:     Dialect(dialect.MySQL).Truncate("users").RestartIdentity(),
+			wantQuery: "TRUNCATE TABLE `users`",
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Truncate("users", "pets").
+				RestartIdentity().
+				Cascade(),
+			wantQuery: `TRUNCATE TABLE "users", "pets" RESTART IDENTITY CASCADE`,
+		},
 		{
 			input: Select().
 				From(Table("pragma_table_info('t1')").Unquote()).
@@ -1819,6 +1844,39 @@ func TestSelector_UnionOrderBy(t *testing.T) {
 	require.Equal(t, `SELECT * FROM "users" WHERE "active" UNION SELECT * FROM "old_users1" ORDER BY "users"."whatever"`, query)
 }
 
+func TestSelector_Sample(t *testing.T) {
+	query, args := Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("users").As("u").TableSample("BERNOULLI", 0.5)).
+		Query()
+	require.Equal(t, `SELECT * FROM "users" AS "u" TABLESAMPLE BERNOULLI (0.5)`, query)
+	require.Empty(t, args)
+
+	s := Dialect(dialect.Postgres).Select("*").From(Table("users")).Where(EQ("active", true))
+	Sample(10)(s)
+	query, args = s.Query()
+	require.Equal(t, `SELECT * FROM "users" TABLESAMPLE SYSTEM (10) WHERE "active"`, query)
+	require.Empty(t, args)
+
+	s = Dialect(dialect.Postgres).Select("*").From(Select("*").From(Table("users")).As("t"))
+	Sample(10)(s)
+	query, args = s.Query()
+	require.Equal(t, `SELECT * FROM (SELECT * FROM "users") AS "t" WHERE RANDOM() * 100 < $1`, query)
+	require.Equal(t, []interface{}{10.0}, args)
+
+	s = Dialect(dialect.MySQL).Select("*").From(Table("users")).Where(EQ("active", true))
+	Sample(10)(s)
+	query, args = s.Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE `active` AND RAND() * 100 < ?", query)
+	require.Equal(t, []interface{}{10.0}, args)
+
+	s = Dialect(dialect.SQLite).Select("*").From(Table("users"))
+	Sample(2.5)(s)
+	query, args = s.Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE ABS(RANDOM() % 10000) < ?", query)
+	require.Equal(t, []interface{}{250.0}, args)
+}
+
 func TestUpdateBuilder_SetExpr(t *testing.T) {
 	d := Dialect(dialect.Postgres)
 	excluded := d.Table("excluded")
@@ -1837,6 +1895,118 @@ func TestUpdateBuilder_SetExpr(t *testing.T) {
 	require.Equal(t, []interface{}{"Ariel", "~", "~"}, args)
 }
 
+func TestDeleteBuilder_Using(t *testing.T) {
+	for _, tt := range []struct {
+		dialect string
+		query   string
+		args    []interface{}
+	}{
+		{
+			dialect: dialect.Postgres,
+			query:   `DELETE FROM "users" USING "groups" WHERE "users"."group_id" = "groups"."id" AND "groups"."name" = $1`,
+			args:    []interface{}{"admins"},
+		},
+		{
+			dialect: dialect.MySQL,
+			query:   "DELETE `users` FROM `users`, `groups` WHERE `users`.`group_id` = `groups`.`id` AND `groups`.`name` = ?",
+			args:    []interface{}{"admins"},
+		},
+		{
+			dialect: dialect.SQLite,
+			query:   "DELETE FROM `users` WHERE EXISTS (SELECT 1 FROM `groups` WHERE `users`.`group_id` = `groups`.`id` AND `groups`.`name` = ?)",
+			args:    []interface{}{"admins"},
+		},
+	} {
+		t.Run(tt.dialect, func(t *testing.T) {
+			d := Dialect(tt.dialect)
+			users, groups := d.Table("users"), d.Table("groups")
+			query, args := d.Delete("users").
+				Using(groups).
+				Where(And(ColumnsEQ(users.C("group_id"), groups.C("id")), EQ(groups.C("name"), "admins"))).
+				Query()
+			require.Equal(t, tt.query, query)
+			require.Equal(t, tt.args, args)
+		})
+	}
+
+	t.Run("FromSelect", func(t *testing.T) {
+		d := Dialect(dialect.Postgres)
+		users, groups := d.Table("users"), d.Table("groups")
+		s := d.Select().From(users)
+		s.Join(groups).On(users.C("group_id"), groups.C("id"))
+		s.Where(EQ(groups.C("name"), "admins"))
+		query, args := d.Delete("users").FromSelect(s).Query()
+		require.Equal(t, `DELETE FROM "users" USING "groups" AS "t1" WHERE "users"."group_id" = "t1"."id" AND "t1"."name" = $1`, query)
+		require.Equal(t, []interface{}{"admins"}, args)
+
+		s = d.Select().From(users)
+		s.LeftJoin(groups).On(users.C("group_id"), groups.C("id"))
+		b := d.Delete("users").FromSelect(s)
+		require.EqualError(t, b.Err(), "sql: LEFT JOIN is not supported by DELETE and UPDATE statements")
+	})
+}
+
+func TestUpdateBuilder_From(t *testing.T) {
+	for _, tt := range []struct {
+		dialect string
+		query   string
+		args    []interface{}
+	}{
+		{
+			dialect: dialect.Postgres,
+			query:   `UPDATE "users" SET "active" = $1 FROM "groups" WHERE "users"."group_id" = "groups"."id" AND "groups"."name" = $2`,
+			args:    []interface{}{false, "admins"},
+		},
+		{
+			dialect: dialect.MySQL,
+			query:   "UPDATE `users`, `groups` SET `users`.`active` = ? WHERE `users`.`group_id` = `groups`.`id` AND `groups`.`name` = ?",
+			args:    []interface{}{false, "admins"},
+		},
+		{
+			dialect: dialect.SQLite,
+			query:   "UPDATE `users` SET `active` = ? FROM `groups` WHERE `users`.`group_id` = `groups`.`id` AND `groups`.`name` = ?",
+			args:    []interface{}{false, "admins"},
+		},
+	} {
+		t.Run(tt.dialect, func(t *testing.T) {
+			d := Dialect(tt.dialect)
+			users, groups := d.Table("users"), d.Table("groups")
+			query, args := d.Update("users").
+				Set("active", false).
+				From(groups).
+				Where(And(ColumnsEQ(users.C("group_id"), groups.C("id")), EQ(groups.C("name"), "admins"))).
+				Query()
+			require.Equal(t, tt.query, query)
+			require.Equal(t, tt.args, args)
+		})
+	}
+
+	t.Run("FromSelect", func(t *testing.T) {
+		d := Dialect(dialect.MySQL)
+		users, groups := d.Table("users"), d.Table("groups")
+		s := d.Select().From(users)
+		s.Join(groups).On(users.C("group_id"), groups.C("id"))
+		query, args := d.Update("users").SetNull("name").FromSelect(s).Query()
+		require.Equal(t, "UPDATE `users`, `groups` AS `t1` SET `users`.`name` = NULL WHERE `users`.`group_id` = `t1`.`id`", query)
+		require.Empty(t, args)
+	})
+}
+
+func TestTruncateBuilder_Err(t *testing.T) {
+	for _, b := range []*TruncateBuilder{
+		Dialect(dialect.Postgres).Truncate(),
+		Dialect(dialect.SQLite).Truncate("users"),
+		Dialect(dialect.MySQL).Truncate("users", "pets"),
+		Dialect(dialect.MySQL).Truncate("users").Cascade(),
+	} {
+		b.Query()
+		require.Error(t, b.Err())
+	}
+	b := Dialect(dialect.Postgres).Truncate("users")
+	b.Query()
+	require.NoError(t, b.Err())
+}
+
 func TestInsert_OnConflict(t *testing.T) {
 	t.Run("Postgres", func(t *testing.T) { // And SQLite.
 		query, args := Dialect(dialect.Postgres).
@@ -2103,6 +2273,15 @@ func TestWindowFunction(t *testing.T) {
 	require.Equal(t, []interface{}{2}, args)
 }
 
+func TestWindowFunctions(t *testing.T) {
+	query, args := Select("id", "name").
+		AppendSelectExprAs(Rank().PartitionBy("country").OrderExpr(Expr("`age` DESC")), "rank").
+		From(Table("users")).
+		Query()
+	require.Equal(t, "SELECT `id`, `name`, (RANK() OVER (PARTITION BY `country` ORDER BY `age` DESC)) AS `rank` FROM `users`", query)
+	require.Empty(t, args)
+}
+
 func TestSelector_UnqualifiedColumns(t *testing.T) {
 	t1, t2 := Table("t1"), Table("t2")
 	s := Select(t1.C("a"), t2.C("b"))
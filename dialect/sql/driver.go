@@ -18,29 +18,42 @@ import (
 type Driver struct {
 	Conn
 	dialect string
+	// stmts holds the cached prepared statements,
+	// if the statement cache is enabled.
+	stmts *stmtCache
 }
 
 // NewDriver creates a new Driver with the given Conn and dialect.
-func NewDriver(dialect string, c Conn) *Driver {
-	return &Driver{dialect: dialect, Conn: c}
+func NewDriver(dialect string, c Conn, opts ...DriverOption) *Driver {
+	d := &Driver{dialect: dialect, Conn: c}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if db, ok := c.ExecQuerier.(*sql.DB); ok && d.stmts != nil {
+		d.Conn = Conn{&stmtDB{DB: db, stmts: d.stmts}}
+	}
+	return d
 }
 
 // Open wraps the database/sql.Open method and returns a dialect.Driver that implements the an ent/dialect.Driver interface.
-func Open(dialect, source string) (*Driver, error) {
+func Open(dialect, source string, opts ...DriverOption) (*Driver, error) {
 	db, err := sql.Open(dialect, source)
 	if err != nil {
 		return nil, err
 	}
-	return NewDriver(dialect, Conn{db}), nil
+	return NewDriver(dialect, Conn{db}, opts...), nil
 }
 
 // OpenDB wraps the given database/sql.DB method with a Driver.
-func OpenDB(dialect string, db *sql.DB) *Driver {
-	return NewDriver(dialect, Conn{db})
+func OpenDB(dialect string, db *sql.DB, opts ...DriverOption) *Driver {
+	return NewDriver(dialect, Conn{db}, opts...)
 }
 
 // DB returns the underlying *sql.DB instance.
 func (d Driver) DB() *sql.DB {
+	if s, ok := d.ExecQuerier.(*stmtDB); ok {
+		return s.DB
+	}
 	return d.ExecQuerier.(*sql.DB)
 }
 
@@ -66,14 +79,23 @@ func (d *Driver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, erro
 	if err != nil {
 		return nil, err
 	}
+	conn := Conn{tx}
+	if d.stmts != nil {
+		conn = Conn{&stmtTx{Tx: tx, stmts: d.stmts}}
+	}
 	return &Tx{
-		Conn: Conn{tx},
+		Conn: conn,
 		Tx:   tx,
 	}, nil
 }
 
-// Close closes the underlying connection.
-func (d *Driver) Close() error { return d.DB().Close() }
+// Close closes the cached statements and the underlying connection.
+func (d *Driver) Close() error {
+	if d.stmts != nil {
+		d.stmts.close()
+	}
+	return d.DB().Close()
+}
 
 // Tx implements dialect.Tx interface.
 type Tx struct {
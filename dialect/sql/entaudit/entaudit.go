@@ -0,0 +1,350 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entaudit provides a tamper-evident audit log for ent mutations. Each entry of the
+// log holds the hash of its previous entry, and is hashed (or signed, if a key is configured)
+// together with it. Hence, modifying, deleting or reordering entries in the database breaks
+// the chain, and is detected by the Verify method.
+//
+//	alog := entaudit.New(drv, entaudit.WithKey(key), entaudit.WithActor(actorFromContext))
+//	if err := alog.Create(ctx); err != nil {
+//		return err
+//	}
+//	client.Use(alog.Hook())
+//	// ...
+//	if err := alog.Verify(ctx); err != nil {
+//		log.Printf("audit log was tampered: %v", err)
+//	}
+//
+package entaudit
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"reflect"
+	"sync"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// Table is the default name of the audit log table.
+const Table = "ent_audit"
+
+// Entry is an entry of the audit log.
+type Entry struct {
+	// Seq is the sequence number of the entry, starting from 1.
+	Seq int64 `json:"seq"`
+	// Prev is the hash of the previous entry, or empty for the first entry.
+	Prev string `json:"prev,omitempty"`
+	// Time is the time the entry was appended.
+	Time time.Time `json:"time"`
+	// Actor identifies who executed the mutation, as returned by the WithActor option.
+	Actor string `json:"actor,omitempty"`
+	// Type and Op are the schema type and the operation of the mutation.
+	Type string `json:"type"`
+	Op   string `json:"op"`
+	// IDs of the mutated entities.
+	IDs []interface{} `json:"ids,omitempty"`
+	// Fields, Added and Cleared hold the fields that were set, added to and cleared by the mutation.
+	Fields  map[string]interface{} `json:"fields,omitempty"`
+	Added   map[string]interface{} `json:"added,omitempty"`
+	Cleared []string               `json:"cleared,omitempty"`
+	// Hash is the hash of the entry. It is not part of the hashed data.
+	Hash string `json:"-"`
+}
+
+// Option allows configuring the Log using functional options.
+type Option func(*Log)
+
+// WithTable sets the name of the audit log table. Defaults to "ent_audit".
+func WithTable(name string) Option {
+	return func(l *Log) {
+		l.table = name
+	}
+}
+
+// WithKey sets the key that is used to sign the entries using HMAC-SHA256. Without
+// a key, entries are hashed using SHA-256, and parties with write access to the table
+// can recompute the chain after modifying it.
+func WithKey(key []byte) Option {
+	return func(l *Log) {
+		l.key = key
+	}
+}
+
+// WithActor sets the function that returns the actor of the mutations from their context
+// (e.g. the authenticated user).
+func WithActor(fn func(context.Context) string) Option {
+	return func(l *Log) {
+		l.actor = fn
+	}
+}
+
+// Log is a hash-chained audit log that is stored in a database table.
+type Log struct {
+	drv   dialect.Driver
+	table string
+	key   []byte
+	actor func(context.Context) string
+	mu    sync.Mutex
+}
+
+// New returns a new Log that is stored using the given driver.
+func New(drv dialect.Driver, opts ...Option) *Log {
+	l := &Log{drv: drv, table: Table}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Create creates the audit log table, if it does not exist.
+func (l *Log) Create(ctx context.Context) error {
+	query, args := sql.Dialect(l.drv.Dialect()).
+		CreateTable(l.table).
+		IfNotExists().
+		Columns(
+			sql.Column("seq").Type("bigint").Attr("NOT NULL"),
+			sql.Column("data").Type("text").Attr("NOT NULL"),
+			sql.Column("hash").Type("varchar(64)").Attr("NOT NULL"),
+		).
+		PrimaryKey("seq").
+		Query()
+	if err := l.drv.Exec(ctx, query, args, nil); err != nil {
+		return fmt.Errorf("entaudit: create table: %w", err)
+	}
+	return nil
+}
+
+// Hook returns a hook that appends an entry to the log for each successful mutation. Note
+// that entries are appended after the mutation was executed, and outside of its transaction.
+// Therefore, entries of mutations that are rolled back remain in the log.
+func (l *Log) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			e, err := newEntry(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			if m.Op().Is(ent.OpCreate) {
+				if id, ok := call(m, "ID"); ok && id[1].Bool() {
+					e.IDs = []interface{}{id[0].Interface()}
+				}
+			}
+			if l.actor != nil {
+				e.Actor = l.actor(ctx)
+			}
+			if err := l.Append(ctx, e); err != nil {
+				return nil, err
+			}
+			return v, nil
+		})
+	}
+}
+
+// newEntry returns an entry for the given mutation, before it is executed.
+func newEntry(ctx context.Context, m ent.Mutation) (*Entry, error) {
+	e := &Entry{Type: m.Type(), Op: m.Op().String(), Cleared: m.ClearedFields()}
+	for _, name := range m.Fields() {
+		if e.Fields == nil {
+			e.Fields = make(map[string]interface{})
+		}
+		e.Fields[name], _ = m.Field(name)
+	}
+	for _, name := range m.AddedFields() {
+		if e.Added == nil {
+			e.Added = make(map[string]interface{})
+		}
+		e.Added[name], _ = m.AddedField(name)
+	}
+	// The entities of update and delete operations are resolved before they are executed.
+	if !m.Op().Is(ent.OpCreate) {
+		out, ok := call(m, "IDs", reflect.ValueOf(ctx))
+		if !ok {
+			return nil, fmt.Errorf("entaudit: unexpected mutation type %T", m)
+		}
+		if err, _ := out[1].Interface().(error); err != nil {
+			return nil, err
+		}
+		for i := 0; i < out[0].Len(); i++ {
+			e.IDs = append(e.IDs, out[0].Index(i).Interface())
+		}
+	}
+	return e, nil
+}
+
+// call calls the method of the generated mutation with the given name.
+func call(m ent.Mutation, name string, args ...reflect.Value) ([]reflect.Value, bool) {
+	fn := reflect.ValueOf(m).MethodByName(name)
+	if !fn.IsValid() || fn.Type().NumIn() != len(args) || fn.Type().NumOut() != 2 {
+		return nil, false
+	}
+	return fn.Call(args), true
+}
+
+// Append appends the given entry to the log, and sets its Seq, Prev, Time and Hash fields.
+// Appends of concurrent processes are serialized by the primary key of the table, and
+// retried on conflicts.
+func (l *Log) Append(ctx context.Context, e *Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := 0; ; i++ {
+		head, err := l.Head(ctx)
+		if err != nil {
+			return err
+		}
+		e.Seq, e.Prev = 1, ""
+		if head != nil {
+			e.Seq, e.Prev = head.Seq+1, head.Hash
+		}
+		e.Time = time.Now().UTC()
+		data, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("entaudit: marshal entry: %w", err)
+		}
+		e.Hash = l.hash(data)
+		query, args := sql.Dialect(l.drv.Dialect()).
+			Insert(l.table).
+			Columns("seq", "data", "hash").
+			Values(e.Seq, string(data), e.Hash).
+			Query()
+		err = l.drv.Exec(ctx, query, args, nil)
+		switch {
+		case err == nil:
+			return nil
+		case sqlgraph.IsUniqueConstraintError(err) && i < 10:
+			// Another process appended an entry with the same sequence number.
+		default:
+			return fmt.Errorf("entaudit: append entry: %w", err)
+		}
+	}
+}
+
+// Head returns the last entry of the log, or nil if the log is empty. Storing the hash of the
+// head outside of the database (e.g. periodically) allows detecting truncation of the log.
+func (l *Log) Head(ctx context.Context) (*Entry, error) {
+	var head *Entry
+	err := l.scan(ctx, func(s *sql.Selector) {
+		s.OrderBy(sql.Desc("seq")).Limit(1)
+	}, func(e *Entry, _ []byte) error {
+		head = e
+		return nil
+	})
+	return head, err
+}
+
+// Entries returns the entries of the log, starting from the given sequence number.
+func (l *Log) Entries(ctx context.Context, from int64) ([]*Entry, error) {
+	var entries []*Entry
+	err := l.scan(ctx, func(s *sql.Selector) {
+		s.Where(sql.GTE("seq", from)).OrderBy("seq")
+	}, func(e *Entry, _ []byte) error {
+		entries = append(entries, e)
+		return nil
+	})
+	return entries, err
+}
+
+// ChainError is returned by Verify when the chain of the log is broken.
+type ChainError struct {
+	// Seq is the sequence number of the first invalid entry.
+	Seq int64
+	// Reason describes why the entry is invalid.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *ChainError) Error() string {
+	return fmt.Sprintf("entaudit: invalid entry %d: %s", e.Seq, e.Reason)
+}
+
+// Verify verifies the chain of the log, and returns a *ChainError for the first entry that was
+// modified, removed or reordered. Note that removal of entries from the end of the log cannot
+// be detected by the chain itself, and requires comparing the head with a previously stored one.
+func (l *Log) Verify(ctx context.Context) error {
+	var prev *Entry
+	return l.scan(ctx, func(s *sql.Selector) {
+		s.OrderBy("seq")
+	}, func(e *Entry, data []byte) error {
+		want := int64(1)
+		if prev != nil {
+			want = prev.Seq + 1
+		}
+		switch {
+		case e.Seq != want:
+			return &ChainError{Seq: want, Reason: "entry is missing"}
+		case prev != nil && e.Prev != prev.Hash || prev == nil && e.Prev != "":
+			return &ChainError{Seq: e.Seq, Reason: "previous hash does not match"}
+		case !hmac.Equal([]byte(e.Hash), []byte(l.hash(data))):
+			return &ChainError{Seq: e.Seq, Reason: "hash does not match"}
+		}
+		prev = e
+		return nil
+	})
+}
+
+// scan queries the entries of the log, and calls fn for each entry with its hashed data.
+func (l *Log) scan(ctx context.Context, where func(*sql.Selector), fn func(*Entry, []byte) error) error {
+	s := sql.Dialect(l.drv.Dialect()).Select("seq", "data", "hash").From(sql.Table(l.table))
+	where(s)
+	query, args := s.Query()
+	rows := &sql.Rows{}
+	if err := l.drv.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("entaudit: query entries: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			seq        int64
+			data, hash string
+		)
+		if err := rows.Scan(&seq, &data, &hash); err != nil {
+			return fmt.Errorf("entaudit: scan entry: %w", err)
+		}
+		e := &Entry{}
+		if err := json.Unmarshal([]byte(data), e); err != nil {
+			return &ChainError{Seq: seq, Reason: fmt.Sprintf("invalid data: %v", err)}
+		}
+		if e.Seq != seq {
+			return &ChainError{Seq: seq, Reason: "sequence number does not match"}
+		}
+		e.Hash = hash
+		if err := fn(e, []byte(data)); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// hash returns the hex-encoded hash (or signature) of the given data.
+func (l *Log) hash(data []byte) string {
+	var h hash.Hash
+	if len(l.key) > 0 {
+		h = hmac.New(sha256.New, l.key)
+	} else {
+		h = sha256.New()
+	}
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// IsChainError reports if the given error is a *ChainError.
+func IsChainError(err error) bool {
+	var e *ChainError
+	return errors.As(err, &e)
+}
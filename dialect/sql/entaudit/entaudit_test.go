@@ -0,0 +1,60 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entaudit
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:audit?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	l := New(drv, WithKey([]byte("secret")))
+	require.NoError(t, l.Create(ctx))
+	require.NoError(t, l.Create(ctx), "table is created only if it does not exist")
+	head, err := l.Head(ctx)
+	require.NoError(t, err)
+	require.Nil(t, head)
+	require.NoError(t, l.Verify(ctx))
+
+	for i := 0; i < 3; i++ {
+		e := &Entry{Type: "User", Op: "OpUpdateOne", IDs: []interface{}{i}, Fields: map[string]interface{}{"name": "a8m"}}
+		require.NoError(t, l.Append(ctx, e))
+		require.Equal(t, int64(i+1), e.Seq)
+	}
+	entries, err := l.Entries(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, entries[0].Hash, entries[1].Prev)
+	require.Equal(t, "a8m", entries[1].Fields["name"])
+	require.NoError(t, l.Verify(ctx))
+
+	// Entries that were recomputed without the key fail the verification.
+	require.Error(t, New(drv).Verify(ctx))
+
+	// Modified entries.
+	exec := func(query string, args ...interface{}) {
+		require.NoError(t, drv.Exec(ctx, query, args, nil))
+	}
+	exec("UPDATE ent_audit SET data = REPLACE(data, 'a8m', 'nati') WHERE seq = 2")
+	err = l.Verify(ctx)
+	require.EqualError(t, err, "entaudit: invalid entry 2: hash does not match")
+	require.True(t, IsChainError(err))
+	exec("UPDATE ent_audit SET data = ?, hash = ? WHERE seq = 2", entries[0].Time.String(), entries[0].Hash)
+	require.True(t, IsChainError(l.Verify(ctx)))
+
+	// Removed entries.
+	exec("DELETE FROM ent_audit WHERE seq = 2")
+	require.EqualError(t, l.Verify(ctx), "entaudit: invalid entry 2: entry is missing")
+}
@@ -0,0 +1,272 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entdoctor checks that the contents of a database match the expectations of its ent
+// schema, and reports the rows that violate them, together with a plan for repairing them. It
+// detects issues that are usually caused by legacy data, manual edits, or databases that were
+// migrated without foreign-key constraints:
+//
+//   - Orphaned foreign-keys: rows that reference rows that do not exist.
+//   - Enum values: values of enum columns that are not one of the enum values.
+//   - Null values: NULL values in columns of required (non-nillable) fields.
+//
+// For example:
+//
+//	report, err := entdoctor.Check(ctx, drv, migrate.Tables)
+//	if err != nil {
+//		return err
+//	}
+//	for _, issue := range report.Issues {
+//		log.Println(issue)
+//	}
+//	for _, stmt := range report.Plan() {
+//		log.Println(stmt)
+//	}
+package entdoctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// Kinds of the issues.
+const (
+	KindOrphan = "orphan" // foreign-keys that reference rows that do not exist.
+	KindEnum   = "enum"   // values of enum columns that are not one of the enum values.
+	KindNull   = "null"   // NULL values in columns of required fields.
+)
+
+type (
+	// Report is the result of the check.
+	Report struct {
+		// Issues holds the issues that were found, ordered by their tables.
+		Issues []*Issue `json:"issues"`
+	}
+
+	// Issue describes a set of rows in a table that violate an expectation of the schema.
+	Issue struct {
+		// Kind of the issue. For example, "orphan".
+		Kind string `json:"kind"`
+		// Table and Columns are the table and the columns of the violating rows.
+		Table   string   `json:"table"`
+		Columns []string `json:"columns"`
+		// Count is the number of violating rows.
+		Count int `json:"count"`
+		// Repair is the statement that repairs the rows, or nil if they should be repaired
+		// manually (e.g. a required column without a default value).
+		Repair *Statement `json:"repair,omitempty"`
+	}
+
+	// Statement is an SQL statement of the repair plan.
+	Statement struct {
+		Query string        `json:"query"`
+		Args  []interface{} `json:"args,omitempty"`
+	}
+)
+
+// OK reports if no issues were found.
+func (r *Report) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// Plan returns the statements that repair the issues, in the order of the issues.
+// Note that the statements are not executed, and should be reviewed before they are
+// executed (preferably in a transaction), as repairing orphaned rows may delete them.
+func (r *Report) Plan() []*Statement {
+	var plan []*Statement
+	for _, i := range r.Issues {
+		if i.Repair != nil {
+			plan = append(plan, i.Repair)
+		}
+	}
+	return plan
+}
+
+// String implements the fmt.Stringer interface.
+func (i *Issue) String() string {
+	var msg string
+	switch i.Kind {
+	case KindOrphan:
+		msg = "reference rows that do not exist"
+	case KindEnum:
+		msg = "hold values that are not one of the enum values"
+	case KindNull:
+		msg = "hold NULL values in a required column"
+	}
+	return fmt.Sprintf("%s: %d rows of table %q (%s) %s", i.Kind, i.Count, i.Table, strings.Join(i.Columns, ", "), msg)
+}
+
+// String implements the fmt.Stringer interface.
+func (s *Statement) String() string {
+	if len(s.Args) == 0 {
+		return s.Query
+	}
+	return fmt.Sprintf("%s %v", s.Query, s.Args)
+}
+
+// Option allows configuring the check using functional options.
+type Option func(*checker)
+
+// WithKinds limits the check to the given kinds of issues. By default, all kinds are checked.
+func WithKinds(kinds ...string) Option {
+	return func(c *checker) {
+		c.kinds = make(map[string]bool, len(kinds))
+		for _, k := range kinds {
+			c.kinds[k] = true
+		}
+	}
+}
+
+// checker holds the configuration of a check.
+type checker struct {
+	drv   dialect.Driver
+	kinds map[string]bool
+}
+
+// Check checks the contents of the database against the given tables (e.g. the generated
+// migrate.Tables), and returns a report of the issues that were found. Views are not checked.
+// Note that the check scans the tables, and should be executed with care on large databases.
+func Check(ctx context.Context, drv dialect.Driver, tables []*schema.Table, opts ...Option) (*Report, error) {
+	c := &checker{drv: drv}
+	for _, opt := range opts {
+		opt(c)
+	}
+	r := &Report{}
+	for _, t := range tables {
+		if t.Annotation != nil && t.Annotation.IsView() {
+			continue
+		}
+		if err := c.check(ctx, r, t); err != nil {
+			return nil, fmt.Errorf("entdoctor: check table %q: %w", t.Name, err)
+		}
+	}
+	return r, nil
+}
+
+// check checks the given table, and adds its issues to the report.
+func (c *checker) check(ctx context.Context, r *Report, t *schema.Table) error {
+	b := sql.Dialect(c.drv.Dialect())
+	table := b.Table(t.Name)
+	if c.enabled(KindNull) {
+		for _, col := range t.Columns {
+			if col.Nullable {
+				continue
+			}
+			issue := &Issue{Kind: KindNull, Table: t.Name, Columns: []string{col.Name}}
+			p := sql.IsNull(table.C(col.Name))
+			if v, ok := defaultValue(col); ok {
+				issue.Repair = statement(b.Update(t.Name).Set(col.Name, v).Where(p))
+			}
+			if err := c.add(ctx, r, issue, table, p); err != nil {
+				return err
+			}
+		}
+	}
+	if c.enabled(KindEnum) {
+		for _, col := range t.Columns {
+			if col.Type != field.TypeEnum || len(col.Enums) == 0 {
+				continue
+			}
+			values := make([]interface{}, len(col.Enums))
+			for i := range col.Enums {
+				values[i] = col.Enums[i]
+			}
+			issue := &Issue{Kind: KindEnum, Table: t.Name, Columns: []string{col.Name}}
+			p := sql.And(sql.NotNull(table.C(col.Name)), sql.NotIn(table.C(col.Name), values...))
+			if v, ok := defaultValue(col); ok {
+				issue.Repair = statement(b.Update(t.Name).Set(col.Name, v).Where(p))
+			} else if col.Nullable {
+				issue.Repair = statement(b.Update(t.Name).SetNull(col.Name).Where(p))
+			}
+			if err := c.add(ctx, r, issue, table, p); err != nil {
+				return err
+			}
+		}
+	}
+	if c.enabled(KindOrphan) {
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable == nil || len(fk.Columns) == 0 || len(fk.Columns) != len(fk.RefColumns) {
+				continue
+			}
+			var (
+				nullable = true
+				ref      = b.Table(fk.RefTable.Name).As("ref")
+				columns  = make([]string, len(fk.Columns))
+				ps       = make([]*sql.Predicate, 0, len(fk.Columns)+1)
+				eqs      = make([]*sql.Predicate, len(fk.Columns))
+			)
+			for i, col := range fk.Columns {
+				nullable = nullable && col.Nullable
+				columns[i] = col.Name
+				ps = append(ps, sql.NotNull(table.C(col.Name)))
+				eqs[i] = sql.ColumnsEQ(ref.C(fk.RefColumns[i].Name), table.C(col.Name))
+			}
+			p := sql.And(append(ps, sql.NotExists(b.Select().From(ref).Where(sql.And(eqs...))))...)
+			issue := &Issue{Kind: KindOrphan, Table: t.Name, Columns: columns}
+			if nullable {
+				update := b.Update(t.Name)
+				for _, col := range columns {
+					update.SetNull(col)
+				}
+				issue.Repair = statement(update.Where(p))
+			} else {
+				issue.Repair = statement(b.Delete(t.Name).Where(p))
+			}
+			if err := c.add(ctx, r, issue, table, p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// add counts the rows of the table that match the predicate, and adds
+// the issue to the report if there are any.
+func (c *checker) add(ctx context.Context, r *Report, issue *Issue, table *sql.SelectTable, p *sql.Predicate) error {
+	query, args := sql.Dialect(c.drv.Dialect()).
+		Select(sql.Count("*")).
+		From(table).
+		Where(p).
+		Query()
+	rows := &sql.Rows{}
+	if err := c.drv.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	n, err := sql.ScanInt(rows)
+	if err != nil {
+		return err
+	}
+	if issue.Count = n; n > 0 {
+		r.Issues = append(r.Issues, issue)
+	}
+	return nil
+}
+
+// enabled reports if the given kind of issues should be checked.
+func (c *checker) enabled(kind string) bool {
+	return c.kinds == nil || c.kinds[kind]
+}
+
+// defaultValue returns the default value of the column, if it can be used as an argument.
+func defaultValue(c *schema.Column) (interface{}, bool) {
+	switch c.Default.(type) {
+	case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return c.Default, true
+	default:
+		return nil, false
+	}
+}
+
+// statement returns the statement of the given query builder.
+func statement(q sql.Querier) *Statement {
+	query, args := q.Query()
+	return &Statement{Query: query, Args: args}
+}
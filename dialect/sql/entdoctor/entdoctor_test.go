@@ -0,0 +1,149 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entdoctor
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:doctor?mode=memory&cache=shared&_fk=0")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+
+	var (
+		usersColumns = []*schema.Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "name", Type: field.TypeString},
+			{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "user"}, Default: "user"},
+			{Name: "status", Type: field.TypeEnum, Enums: []string{"active"}, Nullable: true},
+		}
+		users = &schema.Table{
+			Name:       "users",
+			Columns:    usersColumns,
+			PrimaryKey: usersColumns[:1],
+		}
+		petsColumns = []*schema.Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "user_pets", Type: field.TypeInt, Nullable: true},
+		}
+		pets = &schema.Table{
+			Name:       "pets",
+			Columns:    petsColumns,
+			PrimaryKey: petsColumns[:1],
+			ForeignKeys: []*schema.ForeignKey{
+				{Symbol: "pets_users_pets", Columns: petsColumns[1:], RefColumns: usersColumns[:1], OnDelete: schema.SetNull},
+			},
+		}
+	)
+	pets.ForeignKeys[0].RefTable = users
+	// Create the tables without the constraints and the NOT NULL clauses, as legacy databases may do.
+	for _, q := range []string{
+		"CREATE TABLE `users` (`id` integer PRIMARY KEY AUTOINCREMENT, `name` text NULL, `role` text NULL, `status` text NULL)",
+		"CREATE TABLE `pets` (`id` integer PRIMARY KEY AUTOINCREMENT, `user_pets` integer NULL)",
+		"INSERT INTO `users` (`name`, `role`, `status`) VALUES ('a8m', 'admin', 'active'), (NULL, 'owner', 'banned'), ('nati', 'user', NULL)",
+		"INSERT INTO `pets` (`user_pets`) VALUES (1), (4), (5), (NULL)",
+	} {
+		require.NoError(t, drv.Exec(ctx, q, []interface{}{}, nil))
+	}
+
+	r, err := Check(ctx, drv, []*schema.Table{users, pets})
+	require.NoError(t, err)
+	require.False(t, r.OK())
+	require.Len(t, r.Issues, 4)
+	require.Equal(t, KindNull, r.Issues[0].Kind)
+	require.Equal(t, []string{"name"}, r.Issues[0].Columns)
+	require.Equal(t, 1, r.Issues[0].Count)
+	require.Nil(t, r.Issues[0].Repair, "required columns without defaults must be repaired manually")
+	require.Equal(t, KindEnum, r.Issues[1].Kind)
+	require.Equal(t, []string{"role"}, r.Issues[1].Columns)
+	require.Equal(t, "UPDATE `users` SET `role` = ? WHERE `users`.`role` IS NOT NULL AND `users`.`role` NOT IN (?, ?)", r.Issues[1].Repair.Query)
+	require.Equal(t, []interface{}{"user", "admin", "user"}, r.Issues[1].Repair.Args)
+	require.Equal(t, KindEnum, r.Issues[2].Kind)
+	require.Equal(t, []string{"status"}, r.Issues[2].Columns)
+	require.Equal(t, "UPDATE `users` SET `status` = NULL WHERE `users`.`status` IS NOT NULL AND `users`.`status` NOT IN (?)", r.Issues[2].Repair.Query)
+	require.Equal(t, KindOrphan, r.Issues[3].Kind)
+	require.Equal(t, "pets", r.Issues[3].Table)
+	require.Equal(t, []string{"user_pets"}, r.Issues[3].Columns)
+	require.Equal(t, 2, r.Issues[3].Count)
+	require.Equal(t, `orphan: 2 rows of table "pets" (user_pets) reference rows that do not exist`, r.Issues[3].String())
+
+	plan := r.Plan()
+	require.Len(t, plan, 3)
+	for _, s := range plan {
+		require.NoError(t, drv.Exec(ctx, s.Query, s.Args, nil))
+	}
+	r, err = Check(ctx, drv, []*schema.Table{users, pets})
+	require.NoError(t, err)
+	require.Len(t, r.Issues, 1, "only the manual repair is left")
+	require.Equal(t, KindNull, r.Issues[0].Kind)
+
+	r, err = Check(ctx, drv, []*schema.Table{users, pets}, WithKinds(KindOrphan, KindEnum))
+	require.NoError(t, err)
+	require.True(t, r.OK())
+}
+
+func TestCheck_Delete(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:doctor_delete?mode=memory&cache=shared&_fk=0")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+
+	var (
+		groups = &schema.Table{
+			Name:    "groups",
+			Columns: []*schema.Column{{Name: "id", Type: field.TypeInt, Increment: true}},
+		}
+		membersColumns = []*schema.Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "group_id", Type: field.TypeInt},
+		}
+		members = &schema.Table{
+			Name:       "members",
+			Columns:    membersColumns,
+			PrimaryKey: membersColumns[:1],
+			ForeignKeys: []*schema.ForeignKey{
+				{Symbol: "members_groups", Columns: membersColumns[1:], RefColumns: groups.Columns, RefTable: groups},
+			},
+		}
+	)
+	groups.PrimaryKey = groups.Columns
+	for _, q := range []string{
+		"CREATE TABLE `groups` (`id` integer PRIMARY KEY AUTOINCREMENT)",
+		"CREATE TABLE `members` (`id` integer PRIMARY KEY AUTOINCREMENT, `group_id` integer NOT NULL)",
+		"INSERT INTO `groups` (`id`) VALUES (1)",
+		"INSERT INTO `members` (`group_id`) VALUES (1), (2)",
+	} {
+		require.NoError(t, drv.Exec(ctx, q, []interface{}{}, nil))
+	}
+	r, err := Check(ctx, drv, []*schema.Table{groups, members})
+	require.NoError(t, err)
+	require.Len(t, r.Issues, 1)
+	require.Equal(t, "DELETE FROM `members` WHERE `members`.`group_id` IS NOT NULL AND NOT EXISTS (SELECT * FROM `groups` AS `ref` WHERE `ref`.`id` = `members`.`group_id`)", r.Issues[0].Repair.Query)
+	require.NoError(t, drv.Exec(ctx, r.Issues[0].Repair.Query, r.Issues[0].Repair.Args, nil))
+	n, err := countRows(ctx, drv, "members")
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
+func countRows(ctx context.Context, drv dialect.Driver, table string) (int, error) {
+	rows := &sql.Rows{}
+	query, args := sql.Select(sql.Count("*")).From(sql.Table(table)).Query()
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	return sql.ScanInt(rows)
+}
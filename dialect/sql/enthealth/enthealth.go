@@ -0,0 +1,272 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package enthealth provides an HTTP handler that reports the health of the database that is
+// used by an ent client: its connectivity, the status of the schema migration (i.e. whether
+// there are pending changes to apply), and the lag of a read replica, when configured. The
+// handler responds with 200 if all checks passed and 503 otherwise, and it can be wired into
+// Kubernetes readiness probes.
+//
+//	drv, err := sql.Open(dialect.Postgres, dsn)
+//	if err != nil {
+//		return err
+//	}
+//	client := ent.NewClient(ent.Driver(drv))
+//	http.Handle("/readyz", enthealth.Handler(drv,
+//		enthealth.WithTables(migrate.Tables),
+//		enthealth.WithReplica(replica, 10*time.Second),
+//	))
+//
+package enthealth
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// Names of the checks.
+const (
+	CheckDatabase   = "database"
+	CheckMigrations = "migrations"
+	CheckReplica    = "replica"
+)
+
+type (
+	// Report is the result of the health checks.
+	Report struct {
+		// OK reports if all checks passed.
+		OK bool `json:"ok"`
+		// Checks holds the results of the executed checks.
+		Checks []*Result `json:"checks"`
+	}
+
+	// Result is the result of a single check.
+	Result struct {
+		// Name of the check. For example, "database".
+		Name string `json:"name"`
+		// OK reports if the check passed.
+		OK bool `json:"ok"`
+		// Error holds the error message of a failed check.
+		Error string `json:"error,omitempty"`
+		// Pending is the number of pending migration changes.
+		Pending int `json:"pending,omitempty"`
+		// Lag is the replication lag of the replica, in seconds.
+		Lag float64 `json:"lag,omitempty"`
+	}
+)
+
+// Option allows configuring the Checker using functional options.
+type Option func(*Checker)
+
+// WithTables enables the migration check, which fails if the database schema is not
+// in sync with the given tables (e.g. the generated migrate.Tables). The given options
+// must match the options that are used to migrate the database (e.g. WithGlobalUniqueID).
+// Once the migration was found to be applied, the check is not executed again.
+func WithTables(tables []*schema.Table, opts ...schema.MigrateOption) Option {
+	return func(c *Checker) {
+		c.tables, c.migrateOpts = tables, opts
+	}
+}
+
+// WithReplica enables the replica check, which fails if the replication lag of the
+// given replica (PostgreSQL or MySQL) exceeds maxLag.
+func WithReplica(replica dialect.Driver, maxLag time.Duration) Option {
+	return func(c *Checker) {
+		c.replica, c.maxLag = replica, maxLag
+	}
+}
+
+// WithTimeout sets the timeout of the health checks. Defaults to 5 seconds.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Checker) {
+		c.timeout = d
+	}
+}
+
+// Checker checks the health of a database. It implements the http.Handler interface.
+type Checker struct {
+	drv         dialect.Driver
+	tables      []*schema.Table
+	migrateOpts []schema.MigrateOption
+	replica     dialect.Driver
+	maxLag      time.Duration
+	timeout     time.Duration
+	mu          sync.Mutex
+	migrated    bool // migration was found to be applied
+}
+
+// New creates a new Checker for the given driver.
+func New(drv dialect.Driver, opts ...Option) *Checker {
+	c := &Checker{drv: drv, timeout: 5 * time.Second}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Handler returns an http.Handler that reports the health of the given driver.
+func Handler(drv dialect.Driver, opts ...Option) http.Handler {
+	return New(drv, opts...)
+}
+
+// ServeHTTP implements the http.Handler interface. It writes the report as JSON, with status
+// code 200 if all checks passed, or 503 (Service Unavailable) otherwise.
+func (c *Checker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report := c.Check(r.Context())
+	w.Header().Set("Content-Type", "application/json")
+	if !report.OK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
+
+// Check executes the configured health checks. Note that the migration and replica checks
+// are executed only if the database is reachable.
+func (c *Checker) Check(ctx context.Context) *Report {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	r := &Report{OK: true}
+	add := func(res *Result, err error) {
+		res.OK = err == nil
+		if err != nil {
+			res.Error = err.Error()
+			r.OK = false
+		}
+		r.Checks = append(r.Checks, res)
+	}
+	add(&Result{Name: CheckDatabase}, ping(ctx, c.drv))
+	if !r.OK {
+		return r
+	}
+	if c.tables != nil {
+		res := &Result{Name: CheckMigrations}
+		add(res, c.checkMigrations(ctx, res))
+	}
+	if c.replica != nil {
+		res := &Result{Name: CheckReplica}
+		add(res, c.checkReplica(ctx, res))
+	}
+	return r
+}
+
+// ping checks the connectivity to the database.
+func ping(ctx context.Context, drv dialect.Driver) error {
+	rows := &sql.Rows{}
+	if err := drv.Query(ctx, "SELECT 1", []interface{}{}, rows); err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+// checkMigrations checks that there are no pending changes to apply on the database.
+func (c *Checker) checkMigrations(ctx context.Context, res *Result) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.migrated {
+		return nil
+	}
+	m, err := schema.NewMigrate(c.drv, c.migrateOpts...)
+	if err != nil {
+		return err
+	}
+	plan, err := m.Plan(ctx, c.tables...)
+	if err != nil {
+		return err
+	}
+	if res.Pending = len(plan.Changes); res.Pending > 0 {
+		return fmt.Errorf("%d pending migration changes", res.Pending)
+	}
+	c.migrated = true
+	return nil
+}
+
+// checkReplica checks that the replication lag of the replica does not exceed the limit.
+func (c *Checker) checkReplica(ctx context.Context, res *Result) error {
+	lag, err := replicaLag(ctx, c.replica)
+	if err != nil {
+		return err
+	}
+	if res.Lag = lag.Seconds(); lag > c.maxLag {
+		return fmt.Errorf("replication lag %s exceeds %s", lag, c.maxLag)
+	}
+	return nil
+}
+
+// replicaLag returns the replication lag of the given replica.
+func replicaLag(ctx context.Context, drv dialect.Driver) (time.Duration, error) {
+	switch drv.Dialect() {
+	case dialect.Postgres:
+		rows := &sql.Rows{}
+		// The replay timestamp is NULL if the server is not a replica.
+		if err := drv.Query(ctx, "SELECT COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)", []interface{}{}, rows); err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+		var lag float64
+		if err := sql.ScanOne(rows, &lag); err != nil {
+			return 0, err
+		}
+		return time.Duration(lag * float64(time.Second)), nil
+	case dialect.MySQL:
+		return mysqlLag(ctx, drv)
+	default:
+		return 0, fmt.Errorf("replication lag is not supported by dialect %q", drv.Dialect())
+	}
+}
+
+// mysqlLag returns the replication lag of a MySQL replica. The SHOW SLAVE STATUS statement
+// is used, as it is supported by all versions of MySQL and MariaDB.
+func mysqlLag(ctx context.Context, drv dialect.Driver) (time.Duration, error) {
+	rows := &sql.Rows{}
+	if err := drv.Query(ctx, "SHOW SLAVE STATUS", []interface{}{}, rows); err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+		return 0, errors.New("server is not a replica")
+	}
+	values := make([]stdsql.NullString, len(columns))
+	ptrs := make([]interface{}, len(columns))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	if err := rows.Scan(ptrs...); err != nil {
+		return 0, err
+	}
+	for i, c := range columns {
+		if c != "Seconds_Behind_Master" && c != "Seconds_Behind_Source" {
+			continue
+		}
+		// NULL means that the replication is not running.
+		if !values[i].Valid {
+			return 0, errors.New("replication is not running")
+		}
+		d, err := time.ParseDuration(values[i].String + "s")
+		if err != nil {
+			return 0, fmt.Errorf("parse replication lag %q: %w", values[i].String, err)
+		}
+		return d, nil
+	}
+	return 0, errors.New("replication lag column was not found")
+}
@@ -0,0 +1,98 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package enthealth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:health?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	users := &schema.Table{
+		Name: "users",
+		Columns: []*schema.Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+		},
+	}
+	users.PrimaryKey = users.Columns[:1]
+	h := Handler(drv, WithTables([]*schema.Table{users}))
+
+	serve := func() (int, *Report) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		var r Report
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&r))
+		return rec.Code, &r
+	}
+	code, r := serve()
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.False(t, r.OK)
+	require.Len(t, r.Checks, 2)
+	require.True(t, r.Checks[0].OK)
+	require.Equal(t, CheckMigrations, r.Checks[1].Name)
+	require.False(t, r.Checks[1].OK)
+	require.Equal(t, 1, r.Checks[1].Pending)
+
+	m, err := schema.NewMigrate(drv)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(context.Background(), users))
+	code, r = serve()
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, r.OK)
+	require.True(t, r.Checks[1].OK)
+
+	require.NoError(t, drv.Close())
+	code, r = serve()
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Len(t, r.Checks, 1, "other checks are skipped if the database is unreachable")
+	require.Equal(t, CheckDatabase, r.Checks[0].Name)
+	require.NotEmpty(t, r.Checks[0].Error)
+}
+
+func TestReplicaLag(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	mock.ExpectQuery("SELECT COALESCE").WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(12.5))
+	replica := sql.OpenDB(dialect.Postgres, db)
+	r := New(replica, WithReplica(replica, 10*time.Second)).Check(ctx)
+	require.False(t, r.OK)
+	require.Equal(t, 12.5, r.Checks[1].Lag)
+	require.Equal(t, "replication lag 12.5s exceeds 10s", r.Checks[1].Error)
+
+	mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	mock.ExpectQuery("SHOW SLAVE STATUS").
+		WillReturnRows(sqlmock.NewRows([]string{"Slave_IO_State", "Seconds_Behind_Master"}).AddRow("Waiting for master", "3"))
+	replica = sql.OpenDB(dialect.MySQL, db)
+	r = New(replica, WithReplica(replica, 10*time.Second)).Check(ctx)
+	require.True(t, r.OK)
+	require.Equal(t, float64(3), r.Checks[1].Lag)
+
+	mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	mock.ExpectQuery("SHOW SLAVE STATUS").
+		WillReturnRows(sqlmock.NewRows([]string{"Slave_IO_State", "Seconds_Behind_Master"}).AddRow("", nil))
+	r = New(replica, WithReplica(replica, 10*time.Second)).Check(ctx)
+	require.False(t, r.OK)
+	require.Equal(t, "replication is not running", r.Checks[1].Error)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,225 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entlock provides helpers for serializing updates of entities across
+// transactions using database advisory locks, acquired in a consistent order.
+//
+//	locker := entlock.New(dialect.Postgres)
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	if err := locker.Acquire(ctx, tx, entlock.Key{Type: "Account", ID: from}, entlock.Key{Type: "Account", ID: to}); err != nil {
+//		return rollback(tx, err)
+//	}
+//
+package entlock
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Key identifies the advisory lock of an entity.
+type Key struct {
+	// Type of the entity. For example, "User".
+	Type string
+	// ID of the entity.
+	ID interface{}
+}
+
+// String returns the name of the lock.
+func (k Key) String() string {
+	return fmt.Sprintf("%s:%v", k.Type, k.ID)
+}
+
+// Locker acquires advisory locks for a specific SQL dialect.
+type Locker struct {
+	dialect string
+	timeout time.Duration
+}
+
+// Option allows configuring the Locker using functional options.
+type Option func(*Locker)
+
+// WithTimeout sets the time to wait for each lock in MySQL. The default
+// is to wait indefinitely, or until a deadlock is detected by the database.
+// PostgreSQL locks are bounded by the lock_timeout setting of the session.
+func WithTimeout(d time.Duration) Option {
+	return func(l *Locker) {
+		l.timeout = d
+	}
+}
+
+// New returns a new Locker for the given dialect.
+func New(dialect string, opts ...Option) *Locker {
+	l := &Locker{dialect: dialect, timeout: -1}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Acquire acquires the advisory locks of the given keys using the given transaction (or connection).
+// The keys are deduplicated and acquired in a consistent order, and therefore, transactions that lock
+// overlapping sets of entities cannot deadlock each other.
+//
+// In PostgreSQL, transaction-level locks are used (pg_advisory_xact_lock), and they are released
+// automatically when the transaction ends. In MySQL, locks are held by the session (GET_LOCK), and
+// they must be released explicitly using Release on the same connection after the transaction ends.
+// If one of the locks cannot be acquired, the locks that were already acquired by the call are released.
+// SQLite serializes writing transactions, and therefore, Acquire is a no-op in SQLite.
+//
+// The tx argument can be a *sql.Tx or a *sql.Conn from the standard library, or a generated ent.Tx
+// when the sql/execquery feature is enabled.
+func (l *Locker) Acquire(ctx context.Context, tx sql.ExecQuerier, keys ...Key) error {
+	switch l.dialect {
+	case dialect.Postgres:
+		for _, k := range sorted(keys) {
+			if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", hash(k)); err != nil {
+				return fmt.Errorf("entlock: acquire lock %q: %w", k, err)
+			}
+		}
+	case dialect.MySQL:
+		keys = sorted(keys)
+		for i, k := range keys {
+			var ok stdsql.NullInt64
+			err := queryRow(ctx, tx, &ok, "SELECT GET_LOCK(?, ?)", name(k), l.seconds())
+			switch {
+			case err != nil:
+				err = fmt.Errorf("entlock: acquire lock %q: %w", k, err)
+			case ok.Int64 != 1:
+				err = fmt.Errorf("entlock: acquire lock %q: timeout exceeded", k)
+			}
+			if err != nil {
+				// Session-level locks outlive the transaction. Therefore, the
+				// locks that were already acquired are released before failing.
+				if rerr := l.Release(ctx, tx, keys[:i]...); rerr != nil {
+					err = fmt.Errorf("%w: %v", err, rerr)
+				}
+				return err
+			}
+		}
+	case dialect.SQLite:
+	default:
+		return fmt.Errorf("entlock: unsupported dialect %q", l.dialect)
+	}
+	return nil
+}
+
+// Acquire acquires the advisory locks of the given keys using a Locker for the dialect of the
+// given transaction (or connection), and the default options. See Locker.Acquire for more info.
+//
+// The dialect is resolved using the Dialect method of the argument if it is implemented (e.g.
+// dialect.Driver), and otherwise, by querying the version of the database.
+func Acquire(ctx context.Context, tx sql.ExecQuerier, keys ...Key) error {
+	d, err := detect(ctx, tx)
+	if err != nil {
+		return err
+	}
+	return New(d).Acquire(ctx, tx, keys...)
+}
+
+// Release releases the session-level locks of the given keys that were acquired by Acquire.
+// It is required only in MySQL, and should be called on the same connection after the
+// transaction ends. In other dialects, Release is a no-op.
+func (l *Locker) Release(ctx context.Context, conn sql.ExecQuerier, keys ...Key) error {
+	if l.dialect != dialect.MySQL {
+		return nil
+	}
+	keys = sorted(keys)
+	for i := len(keys) - 1; i >= 0; i-- {
+		var ok stdsql.NullInt64
+		if err := queryRow(ctx, conn, &ok, "SELECT RELEASE_LOCK(?)", name(keys[i])); err != nil {
+			return fmt.Errorf("entlock: release lock %q: %w", keys[i], err)
+		}
+	}
+	return nil
+}
+
+// detect returns the dialect of the given transaction (or connection).
+func detect(ctx context.Context, tx sql.ExecQuerier) (string, error) {
+	if d, ok := tx.(interface{ Dialect() string }); ok {
+		return d.Dialect(), nil
+	}
+	var version string
+	if err := queryRow(ctx, tx, &version, "SELECT version()"); err == nil {
+		if strings.HasPrefix(version, "PostgreSQL") {
+			return dialect.Postgres, nil
+		}
+		return dialect.MySQL, nil
+	}
+	if err := queryRow(ctx, tx, &version, "SELECT sqlite_version()"); err != nil {
+		return "", fmt.Errorf("entlock: detect dialect: %w", err)
+	}
+	return dialect.SQLite, nil
+}
+
+// seconds returns the MySQL timeout in seconds, where negative values mean infinite timeout.
+func (l *Locker) seconds() interface{} {
+	if l.timeout < 0 {
+		return -1
+	}
+	return l.timeout.Seconds()
+}
+
+// sorted returns the deduplicated keys sorted by their names.
+func sorted(keys []Key) []Key {
+	set := make(map[string]Key, len(keys))
+	for _, k := range keys {
+		set[k.String()] = k
+	}
+	sorted := make([]Key, 0, len(set))
+	for _, k := range set {
+		sorted = append(sorted, k)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].String() < sorted[j].String()
+	})
+	return sorted
+}
+
+// hash returns the PostgreSQL lock identifier of the key.
+func hash(k Key) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(k.String()))
+	return int64(h.Sum64())
+}
+
+// maxNameLen is the maximum length of lock names in MySQL.
+const maxNameLen = 64
+
+// name returns the MySQL lock name of the key.
+func name(k Key) string {
+	if s := "entlock:" + k.String(); len(s) <= maxNameLen {
+		return s
+	}
+	return fmt.Sprintf("entlock:%x", uint64(hash(k)))
+}
+
+func queryRow(ctx context.Context, tx sql.ExecQuerier, v interface{}, query string, args ...interface{}) error {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return stdsql.ErrNoRows
+	}
+	if err := rows.Scan(v); err != nil {
+		return err
+	}
+	return rows.Close()
+}
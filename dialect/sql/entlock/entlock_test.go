@@ -0,0 +1,118 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entlock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocker_Postgres(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	a, b := Key{Type: "Account", ID: 1}, Key{Type: "Account", ID: 2}
+	for _, k := range []Key{a, b} {
+		mock.ExpectExec("SELECT pg_advisory_xact_lock\\(\\$1\\)").
+			WithArgs(hash(k)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	l := New(dialect.Postgres)
+	require.NoError(t, l.Acquire(context.Background(), db, b, a, b))
+	require.NoError(t, l.Release(context.Background(), db, a, b))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLocker_MySQL(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery("SELECT GET_LOCK\\(\\?, \\?\\)").
+		WithArgs("entlock:Account:1", -1).
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	mock.ExpectQuery("SELECT GET_LOCK\\(\\?, \\?\\)").
+		WithArgs("entlock:Account:2", -1).
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	mock.ExpectQuery("SELECT RELEASE_LOCK\\(\\?\\)").
+		WithArgs("entlock:Account:2").
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	mock.ExpectQuery("SELECT RELEASE_LOCK\\(\\?\\)").
+		WithArgs("entlock:Account:1").
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	l := New(dialect.MySQL)
+	keys := []Key{{Type: "Account", ID: 2}, {Type: "Account", ID: 1}}
+	require.NoError(t, l.Acquire(context.Background(), db, keys...))
+	require.NoError(t, l.Release(context.Background(), db, keys...))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectQuery("SELECT GET_LOCK\\(\\?, \\?\\)").
+		WithArgs("entlock:Account:1", float64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(0))
+	err = New(dialect.MySQL, WithTimeout(1e9)).Acquire(context.Background(), db, keys[1])
+	require.EqualError(t, err, `entlock: acquire lock "Account:1": timeout exceeded`)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Acquired locks are released if a later lock cannot be acquired.
+	mock.ExpectQuery("SELECT GET_LOCK\\(\\?, \\?\\)").
+		WithArgs("entlock:Account:1", -1).
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	mock.ExpectQuery("SELECT GET_LOCK\\(\\?, \\?\\)").
+		WithArgs("entlock:Account:2", -1).
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(0))
+	mock.ExpectQuery("SELECT RELEASE_LOCK\\(\\?\\)").
+		WithArgs("entlock:Account:1").
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	err = l.Acquire(context.Background(), db, keys...)
+	require.EqualError(t, err, `entlock: acquire lock "Account:2": timeout exceeded`)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcquire(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	k := Key{Type: "Account", ID: 1}
+	mock.ExpectQuery("SELECT version\\(\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow("PostgreSQL 14.2"))
+	mock.ExpectExec("SELECT pg_advisory_xact_lock\\(\\$1\\)").
+		WithArgs(hash(k)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, Acquire(context.Background(), db, k))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectQuery("SELECT version\\(\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow("8.0.28"))
+	mock.ExpectQuery("SELECT GET_LOCK\\(\\?, \\?\\)").
+		WithArgs("entlock:Account:1", -1).
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	require.NoError(t, Acquire(context.Background(), db, k))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectQuery("SELECT version\\(\\)").
+		WillReturnError(errors.New("no such function: version"))
+	mock.ExpectQuery("SELECT sqlite_version\\(\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow("3.38.0"))
+	require.NoError(t, Acquire(context.Background(), db, k))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLocker_Unsupported(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	require.NoError(t, New(dialect.SQLite).Acquire(context.Background(), db, Key{Type: "User", ID: 1}))
+	require.Error(t, New(dialect.Gremlin).Acquire(context.Background(), db, Key{Type: "User", ID: 1}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestName(t *testing.T) {
+	require.Equal(t, "entlock:User:1", name(Key{Type: "User", ID: 1}))
+	long := name(Key{Type: "User", ID: strings.Repeat("a", 100)})
+	require.True(t, len(long) <= maxNameLen)
+	require.True(t, strings.HasPrefix(long, "entlock:"))
+}
@@ -37,6 +37,7 @@ type Atlas struct {
 	dropIndexes     bool // drop deleted indexes
 	withForeignKeys bool // with foreign keys
 	mode            Mode
+	lock            string            // name of the lock to hold during the migration
 	hooks           []Hook            // hooks to apply before creation
 	diffHooks       []DiffHook        // diff hooks to run when diffing current and desired
 	applyHook       []ApplyHook       // apply hooks to run when applying the plan
@@ -48,7 +49,16 @@ type Atlas struct {
 	url     *url.URL       // url of database connection
 	dialect string         // Ent dialect to use when generating migration files
 
-	types []string // pre-existing pk range allocation for global unique id
+	types          []string                          // pre-existing pk range allocation for global unique id
+	typeRanges     []string                          // static pk range allocation for global unique id
+	typeRangesFile string                            // file to read the static pk range allocation from
+	planned        map[*migrate.Change]*plannedTable // tables of the planned changes, used by guards
+}
+
+// plannedTable describes the table that is affected by a planned change.
+type plannedTable struct {
+	name    string // table name
+	created bool   // table is created by the plan
 }
 
 // Diff compares the state read from a database connection or migration directory with the state defined by the Ent
@@ -234,46 +244,28 @@ func (a *Atlas) NamedDiff(ctx context.Context, name string, tables ...*Table) er
 // method on service start ensures the information are correct and are set again, if they aren't. For MySQL versions > 8
 // calling this method is only required once after the upgrade.
 func (a *Atlas) VerifyTableRange(ctx context.Context, tables []*Table) error {
-	if a.driver != nil {
-		var err error
-		a.sqlDialect, err = a.entDialect(a.driver)
-		if err != nil {
-			return err
+	return a.dialectDo(ctx, func() error {
+		vr, ok := a.sqlDialect.(verifyRanger)
+		if !ok {
+			return nil
 		}
-	} else {
-		c, err := sqlclient.OpenURL(ctx, a.url)
+		types, err := a.loadTypes(ctx, a.sqlDialect)
 		if err != nil {
+			// In most cases this means the table does not exist, which in turn
+			// indicates the user does not use global unique ids.
 			return err
 		}
-		defer c.Close()
-		a.sqlDialect, err = a.entDialect(entsql.OpenDB(a.dialect, c.DB))
-		if err != nil {
-			return err
+		for _, t := range tables {
+			id := lastTypeRange(types, t.Name)
+			if id == -1 {
+				continue
+			}
+			if err := vr.verifyRange(ctx, a.sqlDialect, t, int64(id<<32)); err != nil {
+				return err
+			}
 		}
-	}
-	defer func() {
-		a.sqlDialect = nil
-	}()
-	vr, ok := a.sqlDialect.(verifyRanger)
-	if !ok {
 		return nil
-	}
-	types, err := a.loadTypes(ctx, a.sqlDialect)
-	if err != nil {
-		// In most cases this means the table does not exist, which in turn
-		// indicates the user does not use global unique ids.
-		return err
-	}
-	for _, t := range tables {
-		id := indexOf(types, t.Name)
-		if id == -1 {
-			continue
-		}
-		if err := vr.verifyRange(ctx, a.sqlDialect, t, int64(id<<32)); err != nil {
-			return err
-		}
-	}
-	return nil
+	})
 }
 
 type (
@@ -495,6 +487,90 @@ func WithApplyHook(hooks ...ApplyHook) MigrateOption {
 	}
 }
 
+// A Guard reports whether a planned change should be applied on the database. Guards are
+// executed on the connection that the plan is applied on, and can be used for skipping the
+// changes that should not be applied online (e.g. changes of large tables), in order to
+// apply them manually.
+type Guard func(context.Context, dialect.ExecQuerier, *migrate.Change) (bool, error)
+
+// GuardChanges returns an ApplyHook that applies only the planned changes that are approved by
+// all the given guards, and skips the rest. Note that skipping a change may fail the changes
+// that depend on it (e.g. an index on a skipped column). For example:
+//
+//	schema.WithApplyHook(schema.GuardChanges(schema.MaxRows(1_000_000)))
+//
+func GuardChanges(guards ...Guard) ApplyHook {
+	return func(next Applier) Applier {
+		return ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+			changes := make([]*migrate.Change, 0, len(plan.Changes))
+		Change:
+			for _, c := range plan.Changes {
+				for _, g := range guards {
+					ok, err := g(ctx, conn, c)
+					if err != nil {
+						return err
+					}
+					if !ok {
+						continue Change
+					}
+				}
+				changes = append(changes, c)
+			}
+			guarded := *plan
+			guarded.Changes = changes
+			return next.Apply(ctx, conn, &guarded)
+		})
+	}
+}
+
+// MaxRows returns a Guard that skips the changes that modify, rename or drop existing tables
+// holding more than n rows. Note that the guard skips only changes of plans that were computed
+// by the same migration engine (using Plan, or in Create), and allows the rest.
+func MaxRows(n int64) Guard {
+	return func(ctx context.Context, conn dialect.ExecQuerier, c *migrate.Change) (bool, error) {
+		a, ok := ctx.Value(applyCtxKey{}).(*Atlas)
+		if !ok {
+			return true, nil
+		}
+		t, ok := a.planned[c]
+		if !ok || t.created {
+			return true, nil
+		}
+		rows := &entsql.Rows{}
+		query, args := entsql.Dialect(a.dialect).
+			Select(entsql.Count("*")).From(entsql.Table(t.name)).Query()
+		if err := conn.Query(ctx, query, args, rows); err != nil {
+			return false, fmt.Errorf("count rows of table %q: %w", t.name, err)
+		}
+		defer rows.Close()
+		count, err := entsql.ScanInt64(rows)
+		if err != nil {
+			return false, err
+		}
+		return count <= n, nil
+	}
+}
+
+// applyCtxKey is the context key of the migration engine that applies the plan.
+type applyCtxKey struct{}
+
+// WithLock sets the name of a lock that is held during the migration, in order to prevent
+// multiple instances of the application (e.g. replicas that are started simultaneously) from
+// running the migration concurrently. Instances that are started while the lock is held wait
+// until it is released, and then, run the migration on the updated database (which is usually
+// a no-op). For example:
+//
+//	client.Schema.Create(ctx, schema.WithLock("ent_migrate"))
+//
+// In MySQL and PostgreSQL, the lock is implemented using advisory locks (GET_LOCK and
+// pg_advisory_xact_lock). The time to wait for the lock can be limited by setting a deadline
+// on the context. In other dialects (e.g. SQLite), the lock is implemented using the LockTable.
+func WithLock(name string) MigrateOption {
+	return func(a *Atlas) {
+		a.lock = name
+	}
+}
+
 // WithAtlas is an opt-out option for v0.11 indicating the migration
 // should be executed using the deprecated legacy engine.
 // Note, in future versions, this option is going to be removed
@@ -585,6 +661,7 @@ type atBuilder interface {
 	atUniqueC(*Table, *Column, *schema.Table, *schema.Column)
 	atIncrementC(*schema.Table, *schema.Column)
 	atIncrementT(*schema.Table, int64)
+	atIdentityC(*Column, *schema.Table, *schema.Column) error
 	atIndex(*Index, *schema.Table, *schema.Index) error
 	atTypeRangeSQL(t ...string) string
 }
@@ -607,6 +684,18 @@ func (a *Atlas) init() error {
 	if !a.withForeignKeys {
 		a.diffHooks = append(a.diffHooks, withoutForeignKeys)
 	}
+	if a.typeRangesFile != "" {
+		types, err := readTypeRanges(a.typeRangesFile)
+		if err != nil {
+			return err
+		}
+		a.typeRanges = types
+	}
+	if a.typeRanges != nil {
+		if err := checkTypeRanges(a.typeRanges); err != nil {
+			return err
+		}
+	}
 	if a.dir != nil && a.fmt == nil {
 		a.fmt = sqltool.GolangMigrateFormatter
 	}
@@ -625,12 +714,70 @@ func (a *Atlas) init() error {
 
 // create is the Atlas engine based online migration.
 func (a *Atlas) create(ctx context.Context, tables ...*Table) (err error) {
-	if a.universalID {
-		tables = append(tables, NewTable(TypeTable).
-			AddPrimary(&Column{Name: "id", Type: field.TypeUint, Increment: true}).
-			AddColumn(&Column{Name: "type", Type: field.TypeString, Unique: true}),
-		)
+	return a.txDo(ctx, func(tx dialect.Tx) error {
+		plan, err := a.plan(ctx, tx, "changes", a.withTypeTable(tables))
+		if err != nil {
+			return err
+		}
+		return a.apply(ctx, tx, plan)
+	})
+}
+
+// Plan computes the changes that are required for migrating the connected database to the state
+// defined by the given tables, without applying them. The returned plan can be inspected, filtered
+// or reordered, and then applied using the Apply method. For example:
+//
+//	m, err := schema.NewMigrate(drv)
+//	if err != nil {
+//		return err
+//	}
+//	plan, err := m.Plan(ctx, migrate.Tables...)
+//	if err != nil {
+//		return err
+//	}
+//	for _, c := range plan.Changes {
+//		fmt.Println(c.Cmd)
+//	}
+//	return m.Apply(ctx, plan)
+//
+func (a *Atlas) Plan(ctx context.Context, tables ...*Table) (plan *migrate.Plan, err error) {
+	if a.legacy {
+		return nil, errors.New("sql/schema: Plan is not supported by the legacy migration engine")
 	}
+	a.setupTables(tables)
+	err = a.txDo(ctx, func(tx dialect.Tx) error {
+		plan, err = a.plan(ctx, tx, "changes", a.withTypeTable(tables))
+		return err
+	})
+	return plan, err
+}
+
+// Apply applies the given plan on the connected database, in the same way Create applies the
+// changes it planned. That is, the changes are executed in a transaction and the configured
+// ApplyHooks (e.g. GuardChanges) are executed on the plan.
+func (a *Atlas) Apply(ctx context.Context, plan *migrate.Plan) error {
+	if a.legacy {
+		return errors.New("sql/schema: Apply is not supported by the legacy migration engine")
+	}
+	return a.txDo(ctx, func(tx dialect.Tx) error {
+		return a.apply(ctx, tx, plan)
+	})
+}
+
+// withTypeTable returns the given tables with the TypeTable, if global unique ids are enabled.
+func (a *Atlas) withTypeTable(tables []*Table) []*Table {
+	if !a.universalID {
+		return tables
+	}
+	return append(tables, NewTable(TypeTable).
+		AddPrimary(&Column{Name: "id", Type: field.TypeUint, Increment: true}).
+		AddColumn(&Column{Name: "type", Type: field.TypeString, Unique: true}),
+	)
+}
+
+// txDo opens a connection to the database, and executes the given function
+// in a transaction, with the Atlas driver opened on the transaction.
+func (a *Atlas) txDo(ctx context.Context, fn func(dialect.Tx) error) (err error) {
 	if a.driver != nil {
 		a.sqlDialect, err = a.entDialect(a.driver)
 		if err != nil {
@@ -662,27 +809,20 @@ func (a *Atlas) create(ctx context.Context, tables ...*Table) (err error) {
 		return err
 	}
 	defer func() { a.atDriver = nil }()
-	if err := func() error {
-		plan, err := a.plan(ctx, tx, "changes", tables)
-		if err != nil {
-			return err
-		}
-		// Apply plan (changes).
-		var applier Applier = ApplyFunc(func(ctx context.Context, tx dialect.ExecQuerier, plan *migrate.Plan) error {
-			for _, c := range plan.Changes {
-				if err := tx.Exec(ctx, c.Cmd, c.Args, nil); err != nil {
-					if c.Comment != "" {
-						err = fmt.Errorf("%s: %w", c.Comment, err)
-					}
-					return err
-				}
+	unlock := func(context.Context) error { return nil }
+	if err := func() (err error) {
+		if a.lock != "" {
+			if unlock, err = a.acquire(ctx, tx); err != nil {
+				return err
 			}
-			return nil
-		})
-		for i := len(a.applyHook) - 1; i >= 0; i-- {
-			applier = a.applyHook[i](applier)
 		}
-		return applier.Apply(ctx, tx, plan)
+		if err := fn(tx); err != nil {
+			// Errors are ignored, as the lock is released also when
+			// the transaction (or its session) ends.
+			_ = unlock(ctx)
+			return err
+		}
+		return unlock(ctx)
 	}(); err != nil {
 		err = fmt.Errorf("sql/schema: %w", err)
 		if rerr := tx.Rollback(); rerr != nil {
@@ -693,10 +833,41 @@ func (a *Atlas) create(ctx context.Context, tables ...*Table) (err error) {
 	return tx.Commit()
 }
 
+// acquire acquires the migration lock on the given transaction, using advisory
+// locks if they are supported by the dialect, or the LockTable otherwise.
+func (a *Atlas) acquire(ctx context.Context, tx dialect.Tx) (func(context.Context) error, error) {
+	if l, ok := a.sqlDialect.(locker); ok {
+		return l.lock(ctx, tx, a.lock)
+	}
+	return tableLock(ctx, tx, a.dialect, a.lock)
+}
+
+// apply applies the plan (changes) using the configured apply hooks.
+func (a *Atlas) apply(ctx context.Context, tx dialect.ExecQuerier, plan *migrate.Plan) error {
+	var applier Applier = ApplyFunc(func(ctx context.Context, tx dialect.ExecQuerier, plan *migrate.Plan) error {
+		for _, c := range plan.Changes {
+			if err := tx.Exec(ctx, c.Cmd, c.Args, nil); err != nil {
+				if c.Comment != "" {
+					err = fmt.Errorf("%s: %w", c.Comment, err)
+				}
+				return err
+			}
+		}
+		return nil
+	})
+	for i := len(a.applyHook) - 1; i >= 0; i-- {
+		applier = a.applyHook[i](applier)
+	}
+	return applier.Apply(context.WithValue(ctx, applyCtxKey{}, a), tx, plan)
+}
+
 // plan creates the current state by inspecting the connected database, computing the current state of the Ent schema
 // and proceeds to diff the changes to create a migration plan.
 // before diffing.
 func (a *Atlas) plan(ctx context.Context, conn dialect.ExecQuerier, name string, tables []*Table) (*migrate.Plan, error) {
+	// Views are not part of the Atlas schema diffing, and
+	// their changes are planned separately (see viewChanges).
+	tables, views := splitViews(tables)
 	current, err := a.atDriver.InspectSchema(ctx, "", &schema.InspectOptions{
 		Tables: func() (t []string) {
 			for i := range tables {
@@ -715,6 +886,14 @@ func (a *Atlas) plan(ctx context.Context, conn dialect.ExecQuerier, name string,
 			return nil, err
 		}
 		a.types = types
+		// Ranges of a static allocation that are missing
+		// from the database are inserted by the plan.
+		if a.typeRanges != nil {
+			if err := matchTypeRanges(types, a.typeRanges); err != nil {
+				return nil, err
+			}
+			a.types = append(types[:len(types):len(types)], a.typeRanges[len(types):]...)
+		}
 	}
 	desired, err := a.StateReader(tables...).ReadState(ctx)
 	if err != nil {
@@ -729,11 +908,30 @@ func (a *Atlas) plan(ctx context.Context, conn dialect.ExecQuerier, name string,
 	if err != nil {
 		return nil, err
 	}
+	// Columns that are converted to identity columns
+	// are altered after the planned changes.
+	var converts []*migrate.Change
+	if c, ok := a.sqlDialect.(identityConverter); ok {
+		converts = c.convertIdentity(changes)
+	}
 	// Plan changes.
 	plan, err := a.atDriver.PlanChanges(ctx, name, changes)
 	if err != nil {
 		return nil, err
 	}
+	a.setPlanned(changes, plan)
+	// Changed views are dropped before the tables they may depend on
+	// are changed, and (re)created after all tables were created.
+	drop, create, err := a.viewChanges(ctx, conn, views)
+	if err != nil {
+		return nil, err
+	}
+	// Sequences are created before the tables, since the defaults of their columns depend on them.
+	seqs, err := a.sequenceChanges(ctx, conn, tables)
+	if err != nil {
+		return nil, err
+	}
+	plan.Changes = append(append(append(append(drop, seqs...), plan.Changes...), converts...), create...)
 	// Insert new types.
 	newTypes := a.types[len(types):]
 	if len(newTypes) > 0 {
@@ -747,6 +945,46 @@ func (a *Atlas) plan(ctx context.Context, conn dialect.ExecQuerier, name string,
 
 var errTypeTableNotFound = errors.New("ent_type table not found")
 
+// setPlanned records the tables that are affected by the planned changes. Note that some
+// dialects plan the changes of a table separately, and the source of these changes is the
+// table-level change (e.g. AddColumn), which does not reference its table.
+func (a *Atlas) setPlanned(changes []schema.Change, plan *migrate.Plan) {
+	tables := make(map[schema.Change]*plannedTable)
+	for _, c := range changes {
+		switch c := c.(type) {
+		case *schema.AddTable:
+			tables[c] = &plannedTable{name: c.T.Name, created: true}
+		case *schema.DropTable:
+			tables[c] = &plannedTable{name: c.T.Name}
+		case *schema.RenameTable:
+			tables[c] = &plannedTable{name: c.From.Name}
+		case *schema.ModifyTable:
+			t := &plannedTable{name: c.T.Name}
+			tables[c] = t
+			for _, c := range c.Changes {
+				tables[c] = t
+			}
+		}
+	}
+	if a.planned == nil {
+		a.planned = make(map[*migrate.Change]*plannedTable)
+	}
+	for _, c := range plan.Changes {
+		t, ok := tables[c.Source]
+		// Indexes of created tables may be planned separately.
+		if i, isAdd := c.Source.(*schema.AddIndex); !ok && isAdd && i.I.Table != nil {
+			for src, pt := range tables {
+				if add, isAdd := src.(*schema.AddTable); isAdd && add.T.Name == i.I.Table.Name {
+					t, ok = pt, true
+				}
+			}
+		}
+		if ok {
+			a.planned[c] = t
+		}
+	}
+}
+
 // loadTypes loads the currently saved range allocations from the TypeTable.
 func (a *Atlas) loadTypes(ctx context.Context, conn dialect.ExecQuerier) ([]string, error) {
 	// Fetch pre-existing type allocations.
@@ -794,8 +1032,16 @@ func (a *Atlas) tables(tables []*Table) ([]*schema.Table, error) {
 	ts := make([]*schema.Table, len(tables))
 	for i, et := range tables {
 		at := schema.NewTable(et.Name)
+		if et.Comment != "" && a.supportComments() {
+			at.SetComment(et.Comment)
+		}
 		a.sqlDialect.atTable(et, at)
 		if a.universalID && et.Name != TypeTable {
+			for _, c := range et.PrimaryKey {
+				if c.Identity != nil {
+					return nil, fmt.Errorf("identity options of column %s.%s cannot be used with universal ids", et.Name, c.Name)
+				}
+			}
 			r, err := a.pkRange(et)
 			if err != nil {
 				return nil, err
@@ -855,6 +1101,9 @@ func (a *Atlas) aColumns(et *Table, at *schema.Table) error {
 		if c1.Collation != "" {
 			c2.SetCollation(c1.Collation)
 		}
+		if c1.Comment != "" && a.supportComments() {
+			c2.SetComment(c1.Comment)
+		}
 		if err := a.sqlDialect.atTypeC(c1, c2); err != nil {
 			return err
 		}
@@ -867,12 +1116,38 @@ func (a *Atlas) aColumns(et *Table, at *schema.Table) error {
 			}
 			c2.SetDefault(&schema.RawExpr{X: x})
 		}
+		if g := c1.Generated; g != nil {
+			if g.Expr == "" {
+				return fmt.Errorf("missing expression for generated column %q", c1.Name)
+			}
+			// PostgreSQL supports only stored generated columns.
+			typ := "VIRTUAL"
+			if g.Stored || a.dialect == dialect.Postgres {
+				typ = "STORED"
+			}
+			c2.SetGeneratedExpr(&schema.GeneratedExpr{Expr: g.Expr, Type: typ})
+		}
+		if c1.Sequence != nil {
+			s, ok := a.sqlDialect.(sequencer)
+			if !ok {
+				return fmt.Errorf("sequences are not supported by the %s dialect", a.sqlDialect.Dialect())
+			}
+			c2.SetDefault(&schema.RawExpr{X: s.nextVal(c1.Sequence)})
+		}
 		if c1.Unique && (len(et.PrimaryKey) != 1 || et.PrimaryKey[0] != c1) {
 			a.sqlDialect.atUniqueC(et, c1, at, c2)
 		}
 		if c1.Increment {
 			a.sqlDialect.atIncrementC(at, c2)
 		}
+		if c1.Identity != nil {
+			if !c1.Increment {
+				return fmt.Errorf("identity options are set on column %q that is not auto-incremented", c1.Name)
+			}
+			if err := a.sqlDialect.atIdentityC(c1, at, c2); err != nil {
+				return err
+			}
+		}
 		at.AddColumns(c2)
 	}
 	return nil
@@ -935,6 +1210,12 @@ func (a *Atlas) setupTables(tables []*Table) {
 	}
 }
 
+// supportComments reports if the dialect supports storing comments on tables
+// and columns. SQLite is skipped, as it does not support the COMMENT clause.
+func (a *Atlas) supportComments() bool {
+	return a.dialect != dialect.SQLite
+}
+
 // symbol makes sure the symbol length is not longer than the maxlength in the dialect.
 func (a *Atlas) symbol(name string) string {
 	size := 64
@@ -962,9 +1243,12 @@ func (a *Atlas) entDialect(drv dialect.Driver) (sqlDialect, error) {
 }
 
 func (a *Atlas) pkRange(et *Table) (int64, error) {
-	idx := indexOf(a.types, et.Name)
-	// If the table re-created, re-use its range from
-	// the past. Otherwise, allocate a new id-range.
+	// If the table re-created, re-use its (last) range
+	// from the past. Otherwise, allocate a new id-range.
+	idx := lastTypeRange(a.types, et.Name)
+	if idx == -1 && a.typeRanges != nil {
+		return 0, fmt.Errorf("table %q is missing from the type ranges", et.Name)
+	}
 	if idx == -1 {
 		if len(a.types) > MaxTypes {
 			return 0, fmt.Errorf("max number of types exceeded: %d", MaxTypes)
@@ -0,0 +1,82 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"ariga.io/atlas/sql/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtlas_Generated(t *testing.T) {
+	users := &Table{
+		Name: "users",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "email", Type: field.TypeString},
+			{Name: "domain", Type: field.TypeString, Nullable: true, Generated: &entsql.Generated{Expr: "lower(email)"}},
+		},
+	}
+	users.PrimaryKey = users.Columns[:1]
+	tables := func(d string) ([]*schema.Table, error) {
+		a := &Atlas{dialect: d}
+		sqlDialect, err := a.entDialect(nil)
+		require.NoError(t, err)
+		a.sqlDialect = sqlDialect
+		return a.tables([]*Table{users})
+	}
+	ts, err := tables(dialect.MySQL)
+	require.NoError(t, err)
+	c, ok := ts[0].Column("domain")
+	require.True(t, ok)
+	require.Contains(t, c.Attrs, &schema.GeneratedExpr{Expr: "lower(email)", Type: "VIRTUAL"})
+
+	ts, err = tables(dialect.Postgres)
+	require.NoError(t, err)
+	c, ok = ts[0].Column("domain")
+	require.True(t, ok)
+	require.Contains(t, c.Attrs, &schema.GeneratedExpr{Expr: "lower(email)", Type: "STORED"}, "generated columns are always stored in postgres")
+
+	users.Columns[2].Generated = &entsql.Generated{}
+	_, err = tables(dialect.MySQL)
+	require.EqualError(t, err, `missing expression for generated column "domain"`)
+}
+
+func TestSQLite_Generated(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:generated?mode=memory&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+	users := &Table{
+		Name: "users",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "email", Type: field.TypeString},
+			{Name: "domain", Type: field.TypeString, Nullable: true, Generated: &entsql.Generated{Expr: "lower(substr(email, instr(email, '@') + 1))", Stored: true}},
+		},
+	}
+	users.PrimaryKey = users.Columns[:1]
+	m, err := NewMigrate(drv)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users))
+	require.NoError(t, drv.Exec(ctx, "INSERT INTO `users` (`email`) VALUES ('a8m@EntGo.io')", []interface{}{}, nil))
+	rows := &sql.Rows{}
+	require.NoError(t, drv.Query(ctx, "SELECT `domain` FROM `users`", []interface{}{}, rows))
+	domain, err := sql.ScanString(rows)
+	require.NoError(t, err)
+	require.Equal(t, "entgo.io", domain)
+	require.NoError(t, m.Create(ctx, users), "generated columns are not changed")
+
+	m, err = NewMigrate(drv, WithAtlas(false))
+	require.NoError(t, err)
+	require.EqualError(t, m.Create(ctx, users), "sql/schema: generated column users.domain is supported only by the Atlas migration engine")
+}
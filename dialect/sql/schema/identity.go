@@ -0,0 +1,84 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+)
+
+// identityConverter is implemented by the dialects that convert existing auto-increment
+// columns, that were not created as identity columns (e.g. serial columns in PostgreSQL),
+// to identity columns.
+type identityConverter interface {
+	// convertIdentity removes the identity changes of the converted columns from the given
+	// changes, and returns the changes that convert them after the planned changes are executed.
+	convertIdentity([]schema.Change) []*migrate.Change
+}
+
+// convertIdentity converts serial columns to identity columns. Atlas drops the default value
+// and the sequence of a serial column that is changed to an integer column, but it cannot add
+// the identity to an existing column. Therefore, the identity is added separately, and it is
+// set to continue from the last value of the column.
+func (d *Postgres) convertIdentity(changes []schema.Change) []*migrate.Change {
+	var converts []*migrate.Change
+	for _, c := range changes {
+		t, ok := c.(*schema.ModifyTable)
+		if !ok {
+			continue
+		}
+		for _, c := range t.Changes {
+			c, ok := c.(*schema.ModifyColumn)
+			if !ok || !c.Change.Is(schema.ChangeAttr) {
+				continue
+			}
+			_, serial := c.From.Type.Type.(*postgres.SerialType)
+			id, ok := pgIdentity(c.To.Attrs)
+			if _, was := pgIdentity(c.From.Attrs); !serial || !ok || was {
+				continue
+			}
+			c.Change &= ^schema.ChangeAttr
+			start, inc := int64(1), int64(1)
+			if s := id.Sequence; s != nil {
+				if s.Start != 0 {
+					start = s.Start
+				}
+				if s.Increment != 0 {
+					inc = s.Increment
+				}
+			}
+			table, column := quoteName(dialect.Postgres, t.T.Name), quoteName(dialect.Postgres, c.To.Name)
+			converts = append(converts, &migrate.Change{
+				Cmd:     fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s ADD GENERATED BY DEFAULT AS IDENTITY (START WITH %d INCREMENT BY %d)", table, column, start, inc),
+				Reverse: fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP IDENTITY IF EXISTS", table, column),
+				Comment: fmt.Sprintf("convert serial column %q of table %q to identity column", c.To.Name, t.T.Name),
+				Source:  c,
+			})
+			if inc > 0 {
+				converts = append(converts, &migrate.Change{
+					Cmd:     fmt.Sprintf("SELECT setval(pg_get_serial_sequence('%s', '%s'), GREATEST(MAX(%s) + %d, %d), false) FROM %s", table, c.To.Name, column, inc, start, table),
+					Comment: fmt.Sprintf("continue identity column %q of table %q from its last value", c.To.Name, t.T.Name),
+					Source:  c,
+				})
+			}
+		}
+	}
+	return converts
+}
+
+// pgIdentity returns the identity attribute of a PostgreSQL column, if exists.
+func pgIdentity(attrs []schema.Attr) (*postgres.Identity, bool) {
+	for _, a := range attrs {
+		if id, ok := a.(*postgres.Identity); ok {
+			return id, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,106 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtlas_Identity(t *testing.T) {
+	users := &Table{
+		Name: "users",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true, Identity: &entsql.Identity{Start: 1000, Increment: 10}},
+		},
+	}
+	users.PrimaryKey = users.Columns
+	tables := func(d string) ([]*schema.Table, error) {
+		a := &Atlas{dialect: d}
+		sqlDialect, err := a.entDialect(nil)
+		require.NoError(t, err)
+		a.sqlDialect = sqlDialect
+		return a.tables([]*Table{users})
+	}
+	ts, err := tables(dialect.Postgres)
+	require.NoError(t, err)
+	c, ok := ts[0].Column("id")
+	require.True(t, ok)
+	require.Contains(t, c.Attrs, &postgres.Identity{Sequence: &postgres.Sequence{Start: 1000, Increment: 10}})
+
+	_, err = tables(dialect.MySQL)
+	require.EqualError(t, err, `mysql does not support the increment of column "id" (use the auto_increment_increment variable)`)
+	_, err = tables(dialect.SQLite)
+	require.EqualError(t, err, `sqlite does not support the increment of column "id"`)
+	users.Columns[0].Identity.Increment = 0
+	ts, err = tables(dialect.MySQL)
+	require.NoError(t, err)
+	require.Contains(t, ts[0].Attrs, &mysql.AutoIncrement{V: 1000})
+
+	users.Columns[0].Increment = false
+	_, err = tables(dialect.Postgres)
+	require.EqualError(t, err, `identity options are set on column "id" that is not auto-incremented`)
+}
+
+func TestSQLite_Identity(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:identity?mode=memory&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+	users := &Table{
+		Name: "users",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true, Identity: &entsql.Identity{Start: 1000}},
+			{Name: "name", Type: field.TypeString},
+		},
+	}
+	users.PrimaryKey = users.Columns[:1]
+	m, err := NewMigrate(drv)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users))
+	var res sql.Result
+	require.NoError(t, drv.Exec(ctx, "INSERT INTO `users` (`name`) VALUES ('a8m')", []interface{}{}, &res))
+	id, err := res.LastInsertId()
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), id)
+
+	m, err = NewMigrate(drv, WithGlobalUniqueID(true))
+	require.NoError(t, err)
+	require.EqualError(t, m.Create(ctx, users), "sql/schema: identity options of column users.id cannot be used with universal ids")
+}
+
+func TestPostgres_ConvertIdentity(t *testing.T) {
+	table := schema.NewTable("users")
+	change := &schema.ModifyColumn{
+		From:   schema.NewColumn("id").SetType(&postgres.SerialType{T: postgres.TypeBigSerial}),
+		To:     schema.NewIntColumn("id", postgres.TypeBigInt).AddAttrs(&postgres.Identity{Sequence: &postgres.Sequence{Start: 1000}}),
+		Change: schema.ChangeType | schema.ChangeDefault | schema.ChangeAttr,
+	}
+	converts := (&Postgres{}).convertIdentity([]schema.Change{
+		&schema.ModifyTable{T: table, Changes: []schema.Change{change}},
+	})
+	require.Equal(t, schema.ChangeType|schema.ChangeDefault, change.Change, "the identity is added separately")
+	require.Equal(t, []string{
+		`ALTER TABLE "users" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY (START WITH 1000 INCREMENT BY 1)`,
+		`SELECT setval(pg_get_serial_sequence('"users"', 'id'), GREATEST(MAX("id") + 1, 1000), false) FROM "users"`,
+	}, cmds(converts))
+	require.Equal(t, `ALTER TABLE "users" ALTER COLUMN "id" DROP IDENTITY IF EXISTS`, converts[0].Reverse)
+
+	// Identity columns are altered by Atlas.
+	change.From = schema.NewIntColumn("id", postgres.TypeBigInt).AddAttrs(&postgres.Identity{})
+	change.Change = schema.ChangeAttr
+	require.Empty(t, (&Postgres{}).convertIdentity([]schema.Change{&schema.ModifyTable{T: table, Changes: []schema.Change{change}}}))
+	require.Equal(t, schema.ChangeAttr, change.Change)
+}
@@ -18,6 +18,10 @@ const (
 	// TypeTable defines the table name holding the type information.
 	TypeTable = "ent_types"
 
+	// LockTable defines the table name holding the migration locks in
+	// dialects that do not support advisory locks. See WithLock.
+	LockTable = "ent_locks"
+
 	// MaxTypes defines the max number of types can be created when
 	// defining universal ids. The left 16-bits are reserved.
 	MaxTypes = math.MaxUint16
@@ -139,6 +143,25 @@ func (m *Migrate) Create(ctx context.Context, tables ...*Table) error {
 }
 
 func (m *Migrate) create(ctx context.Context, tables ...*Table) error {
+	if _, views := splitViews(tables); len(views) > 0 {
+		return fmt.Errorf("sql/schema: view %q is supported only by the Atlas migration engine", views[0].Name)
+	}
+	switch seqs, err := tableSequences(tables); {
+	case err != nil:
+		return err
+	case len(seqs) > 0:
+		return fmt.Errorf("sql/schema: sequence %q is supported only by the Atlas migration engine", seqs[0].Name)
+	}
+	for _, t := range tables {
+		for _, c := range t.Columns {
+			if c.Identity != nil {
+				return fmt.Errorf("sql/schema: identity options of column %s.%s are supported only by the Atlas migration engine", t.Name, c.Name)
+			}
+			if c.Generated != nil {
+				return fmt.Errorf("sql/schema: generated column %s.%s is supported only by the Atlas migration engine", t.Name, c.Name)
+			}
+		}
+	}
 	tx, err := m.Tx(ctx)
 	if err != nil {
 		return err
@@ -594,6 +617,30 @@ func exist(ctx context.Context, conn dialect.ExecQuerier, query string, args ...
 	return n > 0, nil
 }
 
+// tableLock acquires the named migration lock by inserting a row to the LockTable in the
+// migration transaction, and deleting it before the transaction is committed. Concurrent
+// migrations block on the uncommitted row (or on the write lock of the database in SQLite),
+// until the transaction that holds it is done.
+func tableLock(ctx context.Context, conn dialect.ExecQuerier, d, name string) (func(context.Context) error, error) {
+	b := sql.Dialect(d)
+	query, args := b.CreateTable(LockTable).
+		IfNotExists().
+		Columns(sql.Column("name").Type("varchar(255)").Attr("NOT NULL")).
+		PrimaryKey("name").
+		Query()
+	if err := conn.Exec(ctx, query, args, nil); err != nil {
+		return nil, fmt.Errorf("create lock table: %w", err)
+	}
+	query, args = b.Insert(LockTable).Columns("name").Values(name).Query()
+	if err := conn.Exec(ctx, query, args, nil); err != nil {
+		return nil, fmt.Errorf("acquire lock %q: %w", name, err)
+	}
+	return func(ctx context.Context) error {
+		query, args := b.Delete(LockTable).Where(sql.EQ("name", name)).Query()
+		return conn.Exec(ctx, query, args, nil)
+	}, nil
+}
+
 func indexOf(a []string, s string) int {
 	for i := range a {
 		if a[i] == s {
@@ -620,6 +667,13 @@ type sqlDialect interface {
 	needsConversion(*Column, *Column) bool
 }
 
+// locker is implemented by the dialects that support advisory locks. The
+// lock is acquired on the migration transaction, and the returned function
+// releases it, if it is not released when the transaction ends.
+type locker interface {
+	lock(context.Context, dialect.ExecQuerier, string) (func(context.Context) error, error)
+}
+
 type preparer interface {
 	prepare(context.Context, dialect.Tx, *changes, string) error
 }
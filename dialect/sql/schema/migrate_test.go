@@ -17,6 +17,7 @@ import (
 	"ariga.io/atlas/sql/migrate"
 	"ariga.io/atlas/sql/schema"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 	"github.com/DATA-DOG/go-sqlmock"
@@ -153,6 +154,18 @@ func TestMigrate_Diff(t *testing.T) {
 
 	// Checksum will be updated as well.
 	require.NoError(t, migrate.Validate(d))
+
+	// Views are reverted in the down files.
+	db, err = sql.Open(dialect.SQLite, "file:views?mode=memory&_fk=1")
+	require.NoError(t, err)
+	p = t.TempDir()
+	d, err = migrate.NewLocalDir(p)
+	require.NoError(t, err)
+	m, err = NewMigrate(db, WithDir(d))
+	require.NoError(t, err)
+	require.NoError(t, m.NamedDiff(ctx, "views", &Table{Name: "adults", Annotation: entsql.View("SELECT `id` FROM `users`")}))
+	requireFileEqual(t, filepath.Join(p, v+"_views.up.sql"), "-- create view \"adults\"\nCREATE VIEW `adults` AS SELECT `id` FROM `users`;\n")
+	requireFileEqual(t, filepath.Join(p, v+"_views.down.sql"), "-- reverse: create view \"adults\"\nDROP VIEW IF EXISTS `adults`;\n")
 }
 
 func requireFileEqual(t *testing.T, name, contents string) {
@@ -239,3 +252,164 @@ func TestMigrateWithoutForeignKeys(t *testing.T) {
 		require.EqualValues(t, "name", addColumn.C.Name)
 	})
 }
+
+func TestAtlas_Comments(t *testing.T) {
+	users := &Table{
+		Name:    "users",
+		Comment: "users table",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "name", Type: field.TypeString, Comment: "name column"},
+		},
+	}
+	users.PrimaryKey = users.Columns[:1]
+	for d, supported := range map[string]bool{dialect.MySQL: true, dialect.Postgres: true, dialect.SQLite: false} {
+		a := &Atlas{dialect: d}
+		sqlDialect, err := a.entDialect(nil)
+		require.NoError(t, err)
+		a.sqlDialect = sqlDialect
+		ts, err := a.tables([]*Table{users})
+		require.NoError(t, err)
+		c, ok := ts[0].Column("name")
+		require.True(t, ok)
+		if !supported {
+			require.Empty(t, ts[0].Attrs, d)
+			require.Empty(t, c.Attrs, d)
+			continue
+		}
+		require.Contains(t, ts[0].Attrs, &schema.Comment{Text: "users table"}, d)
+		require.Contains(t, c.Attrs, &schema.Comment{Text: "name column"}, d)
+	}
+}
+
+func TestAtlas_PlanApply(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:plan?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+	users := &Table{
+		Name: "users",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "name", Type: field.TypeString},
+		},
+	}
+	users.PrimaryKey = users.Columns[:1]
+	m, err := NewMigrate(drv, WithApplyHook(GuardChanges(MaxRows(1))))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users))
+	require.NoError(t, drv.Exec(ctx, "INSERT INTO `users` (`name`) VALUES ('a8m'), ('nati')", []interface{}{}, nil))
+
+	users.Columns = append(users.Columns, &Column{Name: "age", Type: field.TypeInt, Nullable: true})
+	pets := &Table{
+		Name: "pets",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+		},
+	}
+	pets.PrimaryKey = pets.Columns[:1]
+	plan, err := m.Plan(ctx, users, pets)
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 2)
+	sources := make([]schema.Change, len(plan.Changes))
+	for i, c := range plan.Changes {
+		sources[i] = c.Source
+	}
+	require.IsType(t, &schema.AddColumn{}, sources[0])
+	require.IsType(t, &schema.AddTable{}, sources[1])
+
+	// Planning does not change the database.
+	again, err := m.Plan(ctx, users, pets)
+	require.NoError(t, err)
+	require.Len(t, again.Changes, 2)
+
+	// Changes of the "users" table are skipped by the guard.
+	require.NoError(t, m.Apply(ctx, plan))
+	plan, err = m.Plan(ctx, users, pets)
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	require.IsType(t, &schema.AddColumn{}, plan.Changes[0].Source)
+
+	m, err = NewMigrate(drv, WithApplyHook(GuardChanges(MaxRows(2))))
+	require.NoError(t, err)
+	plan, err = m.Plan(ctx, users, pets)
+	require.NoError(t, err)
+	require.NoError(t, m.Apply(ctx, plan))
+	plan, err = m.Plan(ctx, users, pets)
+	require.NoError(t, err)
+	require.Empty(t, plan.Changes)
+}
+
+func TestAtlas_Lock(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:lock?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+	users := &Table{
+		Name: "users",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+		},
+	}
+	users.PrimaryKey = users.Columns[:1]
+	var locked bool
+	m, err := NewMigrate(drv, WithLock("migrate"), WithApplyHook(func(next Applier) Applier {
+		return ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+			rows := &sql.Rows{}
+			if err := conn.Query(ctx, "SELECT COUNT(*) FROM `ent_locks` WHERE `name` = 'migrate'", []interface{}{}, rows); err != nil {
+				return err
+			}
+			n, err := sql.ScanInt(rows)
+			if err != nil {
+				return err
+			}
+			locked = n == 1
+			return next.Apply(ctx, conn, plan)
+		})
+	}))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users))
+	require.True(t, locked, "lock should be held during the migration")
+	rows := &sql.Rows{}
+	require.NoError(t, drv.Query(ctx, "SELECT COUNT(*) FROM `ent_locks`", []interface{}{}, rows))
+	n, err := sql.ScanInt(rows)
+	require.NoError(t, err)
+	require.Zero(t, n, "lock should be released after the migration")
+}
+
+func TestLock_Dialects(t *testing.T) {
+	db, mk, err := sqlmock.New()
+	require.NoError(t, err)
+	ctx := context.Background()
+	drv := sql.OpenDB(dialect.MySQL, db)
+	mk.ExpectQuery(escape("SELECT GET_LOCK(?, ?)")).
+		WithArgs("migrate", -1).
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	mk.ExpectQuery(escape("SELECT RELEASE_LOCK(?)")).
+		WithArgs("migrate").
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	unlock, err := (&MySQL{Driver: drv}).lock(ctx, drv, "migrate")
+	require.NoError(t, err)
+	require.NoError(t, unlock(ctx))
+
+	mk.ExpectQuery(escape("SELECT GET_LOCK(?, ?)")).
+		WithArgs("migrate", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(0))
+	tctx, cancel := context.WithTimeout(ctx, time.Second/2)
+	defer cancel()
+	_, err = (&MySQL{Driver: drv}).lock(tctx, drv, "migrate")
+	require.EqualError(t, err, `mysql: acquire lock "migrate": timeout`)
+
+	tctx, cancel = context.WithDeadline(ctx, time.Now().Add(-time.Second))
+	defer cancel()
+	_, err = (&MySQL{Driver: drv}).lock(tctx, drv, "migrate")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	mk.ExpectExec(escape("SELECT pg_advisory_xact_lock($1)")).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	unlock, err = (&Postgres{Driver: drv}).lock(ctx, drv, "migrate")
+	require.NoError(t, err)
+	require.NoError(t, unlock(ctx))
+	require.NoError(t, mk.ExpectationsWereMet())
+}
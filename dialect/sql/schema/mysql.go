@@ -10,6 +10,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
@@ -49,6 +50,40 @@ func (d *MySQL) init(ctx context.Context, conn dialect.ExecQuerier) error {
 	return nil
 }
 
+// lock acquires the named lock using GET_LOCK. The lock is held by the session, and it
+// is released explicitly, as DDL statements in MySQL commit the transaction implicitly.
+// The time to wait for the lock is bounded by the deadline of the context, if it is set,
+// and it is rounded up to whole seconds, as a timeout of 0 does not wait for the lock.
+func (d *MySQL) lock(ctx context.Context, conn dialect.ExecQuerier, name string) (func(context.Context) error, error) {
+	timeout := -1
+	if t, ok := ctx.Deadline(); ok {
+		left := time.Until(t)
+		if left <= 0 {
+			return nil, fmt.Errorf("mysql: acquire lock %q: %w", name, context.DeadlineExceeded)
+		}
+		timeout = int(math.Ceil(left.Seconds()))
+	}
+	rows := &sql.Rows{}
+	if err := conn.Query(ctx, "SELECT GET_LOCK(?, ?)", []interface{}{name, timeout}, rows); err != nil {
+		return nil, fmt.Errorf("mysql: acquire lock %q: %w", name, err)
+	}
+	defer rows.Close()
+	locked, err := sql.ScanInt(rows)
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("mysql: acquire lock %q: %w", name, err)
+	case locked != 1:
+		return nil, fmt.Errorf("mysql: acquire lock %q: timeout", name)
+	}
+	return func(ctx context.Context) error {
+		rows := &sql.Rows{}
+		if err := conn.Query(ctx, "SELECT RELEASE_LOCK(?)", []interface{}{name}, rows); err != nil {
+			return fmt.Errorf("mysql: release lock %q: %w", name, err)
+		}
+		return rows.Close()
+	}, nil
+}
+
 func (d *MySQL) tableExist(ctx context.Context, conn dialect.ExecQuerier, name string) (bool, error) {
 	query, args := sql.Select(sql.Count("*")).From(sql.Table("TABLES").Schema("INFORMATION_SCHEMA")).
 		Where(sql.And(
@@ -746,7 +781,42 @@ func (d *MySQL) defaultSize(c *Column) int64 {
 // needsConversion reports if column "old" needs to be converted
 // (by table altering) to column "new".
 func (d *MySQL) needsConversion(old, new *Column) bool {
-	return d.cType(old) != d.cType(new)
+	return mysqlType(d.cType(old)) != mysqlType(d.cType(new))
+}
+
+// mysqlAliases holds the MySQL type synonyms and the types they are
+// stored as. Note that multi-word aliases precede their prefixes.
+var mysqlAliases = [...][2]string{
+	{"boolean", "bool"},
+	{"character varying", "varchar"},
+	{"character", "char"},
+	{"dec", "decimal"},
+	{"double precision", "double"},
+	{"fixed", "decimal"},
+	{"float4", "float"},
+	{"float8", "double"},
+	{"int1", "tinyint"},
+	{"int2", "smallint"},
+	{"int3", "mediumint"},
+	{"int4", "int"},
+	{"int8", "bigint"},
+	{"integer", "int"},
+	{"middleint", "mediumint"},
+	{"numeric", "decimal"},
+	{"real", "double"},
+}
+
+// mysqlType returns the given MySQL type with its type synonym (if any) resolved
+// to the type MySQL stores it as. For example, "integer(10) unsigned" is returned
+// as "int(10) unsigned", and "numeric(5,2)" as "decimal(5,2)".
+func mysqlType(t string) string {
+	t = strings.ToLower(strings.TrimSpace(t))
+	for _, a := range mysqlAliases {
+		if rest := strings.TrimPrefix(t, a[0]); rest != t && (rest == "" || rest[0] == '(' || rest[0] == ' ') {
+			return a[1] + rest
+		}
+	}
+	return t
 }
 
 // indexModified used by the migration differ to check if the index was modified.
@@ -816,7 +886,7 @@ func (d *MySQL) atTable(t1 *Table, t2 *schema.Table) {
 
 func (d *MySQL) atTypeC(c1 *Column, c2 *schema.Column) error {
 	if c1.SchemaType != nil && c1.SchemaType[dialect.MySQL] != "" {
-		t, err := mysql.ParseType(strings.ToLower(c1.SchemaType[dialect.MySQL]))
+		t, err := mysql.ParseType(mysqlType(c1.SchemaType[dialect.MySQL]))
 		if err != nil {
 			return err
 		}
@@ -927,6 +997,16 @@ func (d *MySQL) atIncrementT(t *schema.Table, v int64) {
 	t.AddAttrs(&mysql.AutoIncrement{V: v})
 }
 
+func (d *MySQL) atIdentityC(c1 *Column, t *schema.Table, _ *schema.Column) error {
+	if i := c1.Identity.Increment; i != 0 && i != 1 {
+		return fmt.Errorf("mysql does not support the increment of column %q (use the auto_increment_increment variable)", c1.Name)
+	}
+	if v := c1.Identity.Start; v != 0 {
+		d.atIncrementT(t, v)
+	}
+	return nil
+}
+
 func (d *MySQL) atImplicitIndexName(idx *Index, c1 *Column) bool {
 	if idx.Name == c1.Name {
 		return true
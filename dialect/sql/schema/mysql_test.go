@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 
+	"ariga.io/atlas/sql/schema"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 )
@@ -1370,6 +1371,48 @@ func TestMySQL_Create(t *testing.T) {
 	}
 }
 
+func TestMySQL_TypeAliases(t *testing.T) {
+	d := &MySQL{}
+	for _, tt := range []struct {
+		old, new *Column
+		convert  bool
+	}{
+		{
+			old: &Column{Type: field.TypeInt32},
+			new: &Column{Type: field.TypeInt32, SchemaType: map[string]string{dialect.MySQL: "INTEGER"}},
+		},
+		{
+			old: &Column{Type: field.TypeFloat64, SchemaType: map[string]string{dialect.MySQL: "decimal(5,2)"}},
+			new: &Column{Type: field.TypeFloat64, SchemaType: map[string]string{dialect.MySQL: "numeric(5,2)"}},
+		},
+		{
+			old: &Column{Type: field.TypeUint32},
+			new: &Column{Type: field.TypeUint32, SchemaType: map[string]string{dialect.MySQL: "int4 unsigned", dialect.Postgres: "bigint"}},
+		},
+		{
+			old: &Column{Type: field.TypeFloat64},
+			new: &Column{Type: field.TypeFloat64, SchemaType: map[string]string{dialect.MySQL: "double precision"}},
+		},
+		{
+			old:     &Column{Type: field.TypeInt32},
+			new:     &Column{Type: field.TypeInt32, SchemaType: map[string]string{dialect.MySQL: "integer8"}},
+			convert: true,
+		},
+		{
+			old:     &Column{Type: field.TypeFloat64, SchemaType: map[string]string{dialect.MySQL: "decimal(5,2)"}},
+			new:     &Column{Type: field.TypeFloat64, SchemaType: map[string]string{dialect.MySQL: "dec(6,2)"}},
+			convert: true,
+		},
+	} {
+		require.Equal(t, tt.convert, d.needsConversion(tt.old, tt.new), "%s => %s", d.cType(tt.old), d.cType(tt.new))
+	}
+	c := &schema.Column{Type: &schema.ColumnType{}}
+	require.NoError(t, d.atTypeC(&Column{Type: field.TypeFloat64, SchemaType: map[string]string{dialect.MySQL: "double precision"}}, c))
+	require.Equal(t, &schema.FloatType{T: "double"}, c.Type.Type)
+	require.NoError(t, d.atTypeC(&Column{Type: field.TypeInt32, SchemaType: map[string]string{dialect.MySQL: "integer"}}, c))
+	require.Equal(t, &schema.IntegerType{T: "int"}, c.Type.Type)
+}
+
 type mysqlMock struct {
 	sqlmock.Sqlmock
 }
@@ -7,6 +7,7 @@ package schema
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"strconv"
 	"strings"
 	"unicode"
@@ -56,6 +57,17 @@ func (d *Postgres) init(ctx context.Context, tx dialect.ExecQuerier) error {
 }
 
 // tableExist checks if a table exists in the database and current schema.
+// lock acquires the named lock using pg_advisory_xact_lock.
+// The lock is released automatically when the transaction ends.
+func (d *Postgres) lock(ctx context.Context, conn dialect.ExecQuerier, name string) (func(context.Context) error, error) {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	if err := conn.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", []interface{}{int64(h.Sum64())}, nil); err != nil {
+		return nil, fmt.Errorf("postgres: acquire lock %q: %w", name, err)
+	}
+	return func(context.Context) error { return nil }, nil
+}
+
 func (d *Postgres) tableExist(ctx context.Context, conn dialect.ExecQuerier, name string) (bool, error) {
 	query, args := sql.Dialect(dialect.Postgres).
 		Select(sql.Count("*")).From(sql.Table("tables").Schema("information_schema")).
@@ -555,7 +567,25 @@ func (d *Postgres) alterColumns(table string, add, modify, drop []*Column) sql.Q
 // (by table altering) to column "new".
 func (d *Postgres) needsConversion(old, new *Column) bool {
 	oldT, newT := d.cType(old), d.cType(new)
-	return oldT != newT && (oldT != "ARRAY" || !arrayType(newT))
+	return oldT != newT && (oldT != "ARRAY" || !arrayType(newT)) && pgType(oldT) != pgType(newT)
+}
+
+// pgType returns the given PostgreSQL type in its canonical form, in order to
+// compare types that are written using their aliases. For example, "int4" and
+// "int" are returned as "integer", and "bool" is returned as "boolean".
+func pgType(t string) string {
+	pt, err := postgres.ParseType(strings.ToLower(t))
+	if err != nil {
+		return t
+	}
+	if _, ok := pt.(*schema.UnsupportedType); ok {
+		return t
+	}
+	ft, err := postgres.FormatType(pt)
+	if err != nil {
+		return t
+	}
+	return ft
 }
 
 // callExpr reports if the given string ~looks like a function call expression.
@@ -774,6 +804,20 @@ func (d *Postgres) atIncrementT(t *schema.Table, v int64) {
 	t.AddAttrs(&postgres.Identity{Sequence: &postgres.Sequence{Start: v}})
 }
 
+func (d *Postgres) atIdentityC(c1 *Column, _ *schema.Table, c2 *schema.Column) error {
+	if _, ok := c2.Type.Type.(*postgres.SerialType); ok {
+		return fmt.Errorf("identity options are not supported by serial column %q", c1.Name)
+	}
+	// The identity attribute is replaced, because it may be shared with the table.
+	id := &postgres.Identity{Sequence: &postgres.Sequence{Start: c1.Identity.Start, Increment: c1.Identity.Increment}}
+	for i, a := range c2.Attrs {
+		if _, ok := a.(*postgres.Identity); ok {
+			c2.Attrs[i] = id
+		}
+	}
+	return nil
+}
+
 func (d *Postgres) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) error {
 	for _, c1 := range idx1.Columns {
 		c2, ok := t2.Column(c1.Name)
@@ -1005,6 +1005,43 @@ func TestPostgres_Create(t *testing.T) {
 	}
 }
 
+func TestPostgres_TypeAliases(t *testing.T) {
+	d := &Postgres{}
+	for _, tt := range []struct {
+		old, new *Column
+		convert  bool
+	}{
+		{
+			old: &Column{Type: field.TypeInt32},
+			new: &Column{Type: field.TypeInt32, SchemaType: map[string]string{dialect.Postgres: "int4"}},
+		},
+		{
+			old: &Column{Type: field.TypeBool},
+			new: &Column{Type: field.TypeBool, SchemaType: map[string]string{dialect.Postgres: "BOOL"}},
+		},
+		{
+			old: &Column{Type: field.TypeTime},
+			new: &Column{Type: field.TypeTime, SchemaType: map[string]string{dialect.Postgres: "timestamptz", dialect.MySQL: "datetime"}},
+		},
+		{
+			old: &Column{Type: field.TypeFloat64, SchemaType: map[string]string{dialect.Postgres: "numeric(5,2)"}},
+			new: &Column{Type: field.TypeFloat64, SchemaType: map[string]string{dialect.Postgres: "decimal(5,2)"}},
+		},
+		{
+			old:     &Column{Type: field.TypeInt32},
+			new:     &Column{Type: field.TypeInt32, SchemaType: map[string]string{dialect.Postgres: "int8"}},
+			convert: true,
+		},
+		{
+			old:     &Column{Type: field.TypeString, SchemaType: map[string]string{dialect.Postgres: "varchar(10)"}},
+			new:     &Column{Type: field.TypeString, SchemaType: map[string]string{dialect.Postgres: "character varying(20)"}},
+			convert: true,
+		},
+	} {
+		require.Equal(t, tt.convert, d.needsConversion(tt.old, tt.new), "%s => %s", d.cType(tt.old), d.cType(tt.new))
+	}
+}
+
 type pgMock struct {
 	sqlmock.Sqlmock
 }
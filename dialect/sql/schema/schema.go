@@ -36,6 +36,7 @@ type Table struct {
 	PrimaryKey  []*Column
 	ForeignKeys []*ForeignKey
 	Annotation  *entsql.Annotation
+	Comment     string
 }
 
 // NewTable returns a new table with the given name.
@@ -94,6 +95,12 @@ func (t *Table) SetAnnotation(ant *entsql.Annotation) *Table {
 	return t
 }
 
+// SetComment sets the table comment.
+func (t *Table) SetComment(c string) *Table {
+	t.Comment = c
+	return t
+}
+
 // AddIndex creates and adds a new index to the table from the given options.
 func (t *Table) AddIndex(name string, unique bool, columns []string) *Table {
 	return t.addIndex(&Index{
@@ -278,6 +285,39 @@ func CopyTables(tables []*Table) ([]*Table, error) {
 	return copyT, nil
 }
 
+// Dependents returns the names of the given tables, and of the tables that depend on them.
+// That is, tables that reference them using foreign-keys, directly or indirectly. The names
+// are ordered such that dependent tables come before the tables they reference, which is the
+// order for deleting the rows of the tables without violating foreign-key constraints.
+//
+//	schema.Dependents(migrate.Tables, migrate.UsersTable.Name)
+//
+func Dependents(tables []*Table, names ...string) []string {
+	var (
+		order []string
+		seen  = make(map[string]bool)
+		visit func(string)
+	)
+	visit = func(name string) {
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+		for _, t := range tables {
+			for _, fk := range t.ForeignKeys {
+				if fk.RefTable != nil && fk.RefTable.Name == name {
+					visit(t.Name)
+				}
+			}
+		}
+		order = append(order, name)
+	}
+	for _, name := range names {
+		visit(name)
+	}
+	return order
+}
+
 // Column schema definition for SQL dialects.
 type Column struct {
 	Name       string            // column name.
@@ -292,6 +332,10 @@ type Column struct {
 	Default    interface{}       // default value.
 	Enums      []string          // enum values.
 	Collation  string            // collation type (utf8mb4_unicode_ci, utf8mb4_general_ci)
+	Comment    string            // column comment.
+	Sequence   *entsql.Sequence  // sequence of the default value.
+	Identity   *entsql.Identity  // options of the auto increment attribute.
+	Generated  *entsql.Generated // expression of a generated column.
 	typ        string            // row column type (used for Rows.Scan).
 	indexes    Indexes           // linked indexes.
 	foreign    *ForeignKey       // linked foreign-key.
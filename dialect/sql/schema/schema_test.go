@@ -149,3 +149,21 @@ func TestCopyTables(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tables, copyT)
 }
+
+func TestDependents(t *testing.T) {
+	var (
+		users      = &Table{Name: "users"}
+		pets       = &Table{Name: "pets"}
+		groups     = &Table{Name: "groups"}
+		userGroups = &Table{Name: "user_groups"}
+		tables     = []*Table{users, pets, groups, userGroups}
+	)
+	users.AddForeignKey(&ForeignKey{RefTable: users})
+	pets.AddForeignKey(&ForeignKey{RefTable: users})
+	userGroups.AddForeignKey(&ForeignKey{RefTable: users})
+	userGroups.AddForeignKey(&ForeignKey{RefTable: groups})
+	require.Equal(t, []string{"pets", "user_groups", "users"}, Dependents(tables, "users"))
+	require.Equal(t, []string{"user_groups", "groups"}, Dependents(tables, "groups"))
+	require.Equal(t, []string{"pets"}, Dependents(tables, "pets"))
+	require.Equal(t, []string{"pets", "user_groups", "users", "groups"}, Dependents(tables, "users", "pets", "groups", "user_groups"))
+}
@@ -0,0 +1,169 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// sequencer is implemented by the dialects that support sequences.
+type sequencer interface {
+	// sequence returns the state of the sequence in the database, or nil if it does not exist.
+	sequence(context.Context, dialect.ExecQuerier, string) (*entsql.Sequence, error)
+	// createSequence returns the statement for creating the sequence.
+	createSequence(entsql.Sequence) string
+	// alterSequence returns the statement for changing the sequence to the given definition.
+	alterSequence(entsql.Sequence) string
+	// dropSequence returns the statement for dropping the sequence.
+	dropSequence(entsql.Sequence) string
+	// nextVal returns the expression that draws the next value of the sequence.
+	nextVal(*entsql.Sequence) string
+}
+
+// seqDef returns the definition of the given sequence, with the defaults of the databases
+// for its zero values. i.e. a sequence that starts with 1 (or -1 for a descending sequence),
+// is incremented by 1, and caches a single value.
+func seqDef(s *entsql.Sequence) entsql.Sequence {
+	def := *s
+	if def.Increment == 0 {
+		def.Increment = 1
+	}
+	if def.Start == 0 {
+		def.Start = 1
+		if def.Increment < 0 {
+			def.Start = -1
+		}
+	}
+	if def.Cache == 0 {
+		def.Cache = 1
+	}
+	return def
+}
+
+// tableSequences returns the definitions of the sequences that are used by the columns of
+// the given tables. Sequences that are shared by multiple columns must be defined the same.
+func tableSequences(tables []*Table) ([]entsql.Sequence, error) {
+	var (
+		seqs  []entsql.Sequence
+		users = make(map[string]string)
+	)
+	for _, t := range tables {
+		for _, c := range t.Columns {
+			if c.Sequence == nil {
+				continue
+			}
+			def, user := seqDef(c.Sequence), t.Name+"."+c.Name
+			switch prev, ok := users[def.Name]; {
+			case def.Name == "":
+				return nil, fmt.Errorf("missing sequence name for column %s", user)
+			case !ok:
+				seqs = append(seqs, def)
+				users[def.Name] = user
+			default:
+				for _, s := range seqs {
+					if s.Name == def.Name && s != def {
+						return nil, fmt.Errorf("sequence %q is defined differently by columns %s and %s", def.Name, prev, user)
+					}
+				}
+			}
+		}
+	}
+	return seqs, nil
+}
+
+// sequenceChanges returns the changes for creating the sequences that are used by the columns of the
+// given tables, or altering them in case their definition was changed. Sequences are not dropped by
+// the migration, since they may be shared with other tables or systems.
+func (a *Atlas) sequenceChanges(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) ([]*migrate.Change, error) {
+	seqs, err := tableSequences(tables)
+	if err != nil || len(seqs) == 0 {
+		return nil, err
+	}
+	s, ok := a.sqlDialect.(sequencer)
+	if !ok {
+		return nil, fmt.Errorf("sequences are not supported by the %s dialect", a.sqlDialect.Dialect())
+	}
+	var changes []*migrate.Change
+	for _, def := range seqs {
+		curr, err := s.sequence(ctx, conn, def.Name)
+		switch {
+		case err != nil:
+			return nil, err
+		case curr == nil:
+			changes = append(changes, &migrate.Change{
+				Cmd:     s.createSequence(def),
+				Reverse: s.dropSequence(def),
+				Comment: fmt.Sprintf("create sequence %q", def.Name),
+			})
+		case *curr != def:
+			changes = append(changes, &migrate.Change{
+				Cmd:     s.alterSequence(def),
+				Reverse: s.alterSequence(*curr),
+				Comment: fmt.Sprintf("alter sequence %q", def.Name),
+			})
+		}
+	}
+	return changes, nil
+}
+
+// sequence returns the state of the sequence in the database.
+func (d *Postgres) sequence(ctx context.Context, conn dialect.ExecQuerier, name string) (*entsql.Sequence, error) {
+	schema, args := "CURRENT_SCHEMA()", []interface{}{name}
+	if d.schema != "" {
+		schema, args = "$2", append(args, d.schema)
+	}
+	rows := &sql.Rows{}
+	query := "SELECT start_value, increment_by, cache_size FROM pg_sequences WHERE sequencename = $1 AND schemaname = " + schema
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("reading sequence %q: %w", name, err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	s := &entsql.Sequence{Name: name}
+	if err := rows.Scan(&s.Start, &s.Increment, &s.Cache); err != nil {
+		return nil, fmt.Errorf("scanning sequence %q: %w", name, err)
+	}
+	return s, rows.Close()
+}
+
+// createSequence returns the statement for creating the sequence.
+func (d *Postgres) createSequence(s entsql.Sequence) string {
+	return fmt.Sprintf("CREATE SEQUENCE %s START WITH %d INCREMENT BY %d CACHE %d", quoteName(dialect.Postgres, s.Name), s.Start, s.Increment, s.Cache)
+}
+
+// alterSequence returns the statement for altering the sequence. Note that changing the start
+// value of an existing sequence does not change its current value, but only the value that is
+// used by ALTER SEQUENCE RESTART.
+func (d *Postgres) alterSequence(s entsql.Sequence) string {
+	return fmt.Sprintf("ALTER SEQUENCE %s START WITH %d INCREMENT BY %d CACHE %d", quoteName(dialect.Postgres, s.Name), s.Start, s.Increment, s.Cache)
+}
+
+// dropSequence returns the statement for dropping the sequence.
+func (d *Postgres) dropSequence(s entsql.Sequence) string {
+	return fmt.Sprintf("DROP SEQUENCE IF EXISTS %s", quoteName(dialect.Postgres, s.Name))
+}
+
+// plainIdent matches the identifiers that are not quoted by PostgreSQL.
+var plainIdent = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
+// nextVal returns the default expression of columns that are drawn from the sequence,
+// in the same format it is reported by the database (in order to avoid false diffs).
+func (d *Postgres) nextVal(s *entsql.Sequence) string {
+	name := s.Name
+	if !plainIdent.MatchString(name) {
+		name = quoteName(dialect.Postgres, name)
+	}
+	return fmt.Sprintf("nextval('%s'::regclass)", name)
+}
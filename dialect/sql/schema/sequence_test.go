@@ -0,0 +1,110 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_SequenceChanges(t *testing.T) {
+	numbers := &entsql.Sequence{Name: "invoice_numbers", Start: 1000, Cache: 20}
+	tables := []*Table{
+		{Name: "invoices", Columns: []*Column{{Name: "number", Type: field.TypeInt64, Sequence: numbers}}},
+		{Name: "credit_notes", Columns: []*Column{{Name: "number", Type: field.TypeInt64, Sequence: numbers}}},
+	}
+	const query = "SELECT start_value, increment_by, cache_size FROM pg_sequences WHERE sequencename = $1 AND schemaname = CURRENT_SCHEMA()"
+	tests := []struct {
+		name           string
+		before         func(sqlmock.Sqlmock)
+		stmts, reverse []string
+	}{
+		{
+			name: "create",
+			before: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(escape(query)).
+					WithArgs("invoice_numbers").
+					WillReturnRows(sqlmock.NewRows([]string{"start_value", "increment_by", "cache_size"}))
+			},
+			stmts:   []string{`CREATE SEQUENCE "invoice_numbers" START WITH 1000 INCREMENT BY 1 CACHE 20`},
+			reverse: []string{`DROP SEQUENCE IF EXISTS "invoice_numbers"`},
+		},
+		{
+			name: "unchanged",
+			before: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(escape(query)).
+					WithArgs("invoice_numbers").
+					WillReturnRows(sqlmock.NewRows([]string{"start_value", "increment_by", "cache_size"}).AddRow(1000, 1, 20))
+			},
+		},
+		{
+			name: "changed",
+			before: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(escape(query)).
+					WithArgs("invoice_numbers").
+					WillReturnRows(sqlmock.NewRows([]string{"start_value", "increment_by", "cache_size"}).AddRow(1, 1, 1))
+			},
+			stmts:   []string{`ALTER SEQUENCE "invoice_numbers" START WITH 1000 INCREMENT BY 1 CACHE 20`},
+			reverse: []string{`ALTER SEQUENCE "invoice_numbers" START WITH 1 INCREMENT BY 1 CACHE 1`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.before(mock)
+			a := &Atlas{sqlDialect: &Postgres{Driver: sql.OpenDB(dialect.Postgres, db)}}
+			changes, err := a.sequenceChanges(context.Background(), a.sqlDialect, tables)
+			require.NoError(t, err)
+			require.Equal(t, tt.stmts, cmds(changes))
+			for i := range tt.reverse {
+				require.Equal(t, tt.reverse[i], changes[i].Reverse)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	d := &Postgres{}
+	require.Equal(t, "nextval('invoice_numbers'::regclass)", d.nextVal(numbers))
+	require.Equal(t, `nextval('"Invoices"'::regclass)`, d.nextVal(&entsql.Sequence{Name: "Invoices"}))
+	require.Equal(t, `CREATE SEQUENCE "down" START WITH -1 INCREMENT BY -1 CACHE 1`, d.createSequence(seqDef(&entsql.Sequence{Name: "down", Increment: -1})))
+}
+
+func TestTableSequences(t *testing.T) {
+	seqs, err := tableSequences([]*Table{
+		{Name: "a", Columns: []*Column{{Name: "n", Sequence: &entsql.Sequence{Name: "s"}}}},
+		{Name: "b", Columns: []*Column{{Name: "n", Sequence: &entsql.Sequence{Name: "s", Start: 1, Increment: 1, Cache: 1}}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []entsql.Sequence{{Name: "s", Start: 1, Increment: 1, Cache: 1}}, seqs, "zero values are the defaults of the database")
+
+	_, err = tableSequences([]*Table{
+		{Name: "a", Columns: []*Column{{Name: "n", Sequence: &entsql.Sequence{Name: "s"}}}},
+		{Name: "b", Columns: []*Column{{Name: "n", Sequence: &entsql.Sequence{Name: "s", Start: 100}}}},
+	})
+	require.EqualError(t, err, `sequence "s" is defined differently by columns a.n and b.n`)
+
+	_, err = tableSequences([]*Table{{Name: "a", Columns: []*Column{{Name: "n", Sequence: &entsql.Sequence{}}}}})
+	require.EqualError(t, err, "missing sequence name for column a.n")
+}
+
+func TestSQLite_Sequences(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:sequences?mode=memory&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	a := &Atlas{sqlDialect: &SQLite{Driver: db}}
+	_, err = a.sequenceChanges(context.Background(), db, []*Table{
+		{Name: "a", Columns: []*Column{{Name: "n", Sequence: &entsql.Sequence{Name: "s"}}}},
+	})
+	require.EqualError(t, err, "sequences are not supported by the sqlite3 dialect")
+}
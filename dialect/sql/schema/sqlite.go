@@ -439,6 +439,17 @@ func (d *SQLite) atIncrementT(t *schema.Table, v int64) {
 	t.AddAttrs(&sqlite.AutoIncrement{Seq: v})
 }
 
+func (d *SQLite) atIdentityC(c1 *Column, t *schema.Table, _ *schema.Column) error {
+	if i := c1.Identity.Increment; i != 0 && i != 1 {
+		return fmt.Errorf("sqlite does not support the increment of column %q", c1.Name)
+	}
+	// The sequence of the table holds the last value that was generated.
+	if v := c1.Identity.Start; v > 1 {
+		d.atIncrementT(t, v-1)
+	}
+	return nil
+}
+
 func (d *SQLite) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) error {
 	for _, c1 := range idx1.Columns {
 		c2, ok := t2.Column(c1.Name)
@@ -0,0 +1,293 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"ariga.io/atlas/sql/sqlclient"
+)
+
+// WithTypeRanges sets a static allocation of the pk ranges of the global unique ids option, and
+// enables it. The position of each table in the list defines its range (i.e. the table at index i
+// owns the ids [i<<32, (i+1)<<32)), and therefore, the allocation is reviewed in code instead of
+// being determined by the order the tables were created in the database. For example:
+//
+//	schema.WithTypeRanges("users", "groups", "pets")
+//
+// Before a migration is planned, the ranges that are stored in the TypeTable are checked against
+// the allocation, and the missing ones are added to it. Tables can be removed from the allocation
+// only from its end, and tables that are missing from it cannot be migrated.
+func WithTypeRanges(types ...string) MigrateOption {
+	return func(a *Atlas) {
+		a.universalID = true
+		a.typeRanges = types
+	}
+}
+
+// WithTypeRangesFile is like WithTypeRanges, but reads the allocation from the given file. Each
+// non-empty line of the file holds the name of one table, and lines starting with "#" are ignored.
+// For example:
+//
+//	# Append new tables to the end of the file.
+//	users
+//	groups
+//	pets
+//
+func WithTypeRangesFile(name string) MigrateOption {
+	return func(a *Atlas) {
+		a.universalID = true
+		a.typeRangesFile = name
+	}
+}
+
+// readTypeRanges reads the static pk range allocation from the given file.
+func readTypeRanges(name string) ([]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("sql/schema: open type ranges file: %w", err)
+	}
+	defer f.Close()
+	var types []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			types = append(types, line)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("sql/schema: read type ranges file: %w", err)
+	}
+	return types, nil
+}
+
+// checkTypeRanges validates the static pk range allocation.
+func checkTypeRanges(types []string) error {
+	if len(types) > MaxTypes {
+		return fmt.Errorf("sql/schema: max number of types exceeded: %d", MaxTypes)
+	}
+	seen := make(map[string]bool, len(types))
+	for _, t := range types {
+		if seen[t] {
+			return fmt.Errorf("sql/schema: type %q is allocated more than once in the type ranges", t)
+		}
+		seen[t] = true
+	}
+	return nil
+}
+
+// matchTypeRanges reports an error if the pk ranges that are stored in the
+// database do not match the static allocation of the ranges.
+func matchTypeRanges(stored, static []string) error {
+	for i := range stored {
+		switch {
+		case i >= len(static):
+			return fmt.Errorf("type range %d is allocated to %q in the database, but is missing from the type ranges", i, stored[i])
+		case stored[i] != static[i]:
+			return fmt.Errorf("type range %d is allocated to %q in the database, but to %q in the type ranges", i, stored[i], static[i])
+		}
+	}
+	return nil
+}
+
+// rangeType returns the table name of the given TypeTable entry. Tables that their
+// ranges were extended own additional entries with a "#n" suffix (e.g. "users#1").
+func rangeType(t string) string {
+	if i := strings.LastIndexByte(t, '#'); i > 0 {
+		if _, err := strconv.Atoi(t[i+1:]); err == nil {
+			return t[:i]
+		}
+	}
+	return t
+}
+
+// typeRangeIndexes returns the indexes of the pk ranges of the given table.
+func typeRangeIndexes(types []string, name string) (idx []int) {
+	for i, t := range types {
+		if rangeType(t) == name {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+// lastTypeRange returns the index of the pk range that is currently used by
+// the given table (i.e. the last range allocated to it), or -1 if none exists.
+func lastTypeRange(types []string, name string) int {
+	idx := typeRangeIndexes(types, name)
+	if len(idx) == 0 {
+		return -1
+	}
+	return idx[len(idx)-1]
+}
+
+// TypeRange describes a pk range that is allocated to a table by the global unique ids option.
+type TypeRange struct {
+	// Type is the name of the table that owns the range.
+	Type string
+	// Index is the position of the range in the TypeTable.
+	Index int
+	// Start and End are the first and last ids of the range.
+	Start, End int64
+	// Max is the max id of the table in the range, or Start-1 if no
+	// ids were allocated from the range.
+	Max int64
+}
+
+// Usage returns the fraction of the ids of the range that were allocated.
+func (r *TypeRange) Usage() float64 {
+	return float64(r.Max-r.Start+1) / float64(r.End-r.Start+1)
+}
+
+// TypeRanges returns the pk ranges that are allocated to the given tables, ordered by their position in
+// the TypeTable, and the max id of the tables in each range. It can be used for detecting exhaustion of
+// ranges ahead of time, for example, by running it periodically and alerting when the usage crosses a
+// threshold:
+//
+//	ranges, err := m.TypeRanges(ctx, migrate.Tables)
+//	if err != nil {
+//		return err
+//	}
+//	for _, r := range ranges {
+//		if r.Usage() > 0.8 {
+//			log.Printf("type range %d of %q is %.0f%% used", r.Index, r.Type, r.Usage()*100)
+//		}
+//	}
+//
+// Note that only the last range of each table is in use, and exhausted ranges can be extended using
+// the ExtendTypeRange method.
+func (a *Atlas) TypeRanges(ctx context.Context, tables []*Table) ([]*TypeRange, error) {
+	var ranges []*TypeRange
+	err := a.dialectDo(ctx, func() error {
+		types, err := a.loadTypes(ctx, a.sqlDialect)
+		if err != nil {
+			return err
+		}
+		byName := make(map[string]*Table, len(tables))
+		for _, t := range tables {
+			byName[t.Name] = t
+		}
+		for i, name := range types {
+			t, ok := byName[rangeType(name)]
+			if !ok {
+				continue
+			}
+			r := &TypeRange{Type: t.Name, Index: i, Start: int64(i) << 32, End: int64(i+1)<<32 - 1}
+			if r.Max, err = a.rangeMax(ctx, t, r); err != nil {
+				return err
+			}
+			ranges = append(ranges, r)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ranges, nil
+}
+
+// rangeMax returns the max id of the table in the given range, or the range start-1 if none exists.
+func (a *Atlas) rangeMax(ctx context.Context, t *Table, r *TypeRange) (int64, error) {
+	pk := "id"
+	if len(t.PrimaryKey) == 1 {
+		pk = t.PrimaryKey[0].Name
+	}
+	rows := &entsql.Rows{}
+	query, args := entsql.Dialect(a.dialect).
+		Select(entsql.Max(pk)).
+		From(entsql.Table(t.Name)).
+		Where(entsql.And(entsql.GTE(pk, r.Start), entsql.LTE(pk, r.End))).
+		Query()
+	if err := a.sqlDialect.Query(ctx, query, args, rows); err != nil {
+		return 0, fmt.Errorf("query max id of table %q: %w", t.Name, err)
+	}
+	defer rows.Close()
+	var max sql.NullInt64
+	if rows.Next() {
+		if err := rows.Scan(&max); err != nil {
+			return 0, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if !max.Valid {
+		return r.Start - 1, nil
+	}
+	return max.Int64, nil
+}
+
+// ExtendTypeRange allocates an additional pk range to the given table, and sets its auto-increment
+// counter to the start of the new range. The new range is stored in the TypeTable as "<table>#<n>",
+// and is used by the table in the next migrations. Ids that were allocated from the previous ranges
+// of the table are not changed, as they may be referenced by other tables.
+//
+// Note that the ranges of a static allocation (see WithTypeRanges) are extended by appending
+// the new entries to the allocation.
+func (a *Atlas) ExtendTypeRange(ctx context.Context, t *Table) (*TypeRange, error) {
+	if a.typeRanges != nil || a.typeRangesFile != "" {
+		return nil, fmt.Errorf("sql/schema: type ranges are allocated statically; extend the range of %q by appending it to the allocation with a \"#n\" suffix", t.Name)
+	}
+	var r *TypeRange
+	err := a.txDo(ctx, func(tx dialect.Tx) error {
+		types, err := a.loadTypes(ctx, tx)
+		if err != nil {
+			return err
+		}
+		n := len(typeRangeIndexes(types, t.Name))
+		switch {
+		case n == 0:
+			return fmt.Errorf("table %q does not own a type range", t.Name)
+		case len(types) >= MaxTypes:
+			return fmt.Errorf("max number of types exceeded: %d", MaxTypes)
+		}
+		name := fmt.Sprintf("%s#%d", t.Name, n)
+		if err := tx.Exec(ctx, a.sqlDialect.atTypeRangeSQL(name), []interface{}{}, nil); err != nil {
+			return fmt.Errorf("insert type range %q: %w", name, err)
+		}
+		r = &TypeRange{Type: t.Name, Index: len(types), Start: int64(len(types)) << 32, End: int64(len(types)+1)<<32 - 1}
+		r.Max = r.Start - 1
+		return a.sqlDialect.setRange(ctx, tx, t, r.Start)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// dialectDo opens a connection to the database, and executes the given function
+// with the dialect of the connection, without opening a transaction.
+func (a *Atlas) dialectDo(ctx context.Context, fn func() error) (err error) {
+	if a.driver != nil {
+		a.sqlDialect, err = a.entDialect(a.driver)
+		if err != nil {
+			return err
+		}
+	} else {
+		c, err := sqlclient.OpenURL(ctx, a.url)
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+		a.sqlDialect, err = a.entDialect(entsql.OpenDB(a.dialect, c.DB))
+		if err != nil {
+			return err
+		}
+	}
+	defer func() {
+		a.sqlDialect = nil
+	}()
+	return fn()
+}
@@ -0,0 +1,114 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtlas_TypeRanges(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:typeranges?mode=memory&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+	table := func(name string) *Table {
+		tb := &Table{
+			Name: name,
+			Columns: []*Column{
+				{Name: "id", Type: field.TypeInt, Increment: true},
+				{Name: "name", Type: field.TypeString},
+			},
+		}
+		tb.PrimaryKey = tb.Columns[:1]
+		return tb
+	}
+	insert := func(tb *Table) int64 {
+		var res sql.Result
+		require.NoError(t, drv.Exec(ctx, "INSERT INTO `"+tb.Name+"` (`name`) VALUES ('a8m')", []interface{}{}, &res))
+		id, err := res.LastInsertId()
+		require.NoError(t, err)
+		return id
+	}
+	users, groups, pets := table("users"), table("groups"), table("pets")
+
+	_, err = NewMigrate(drv, WithTypeRanges("users", "pets", "users"))
+	require.EqualError(t, err, `sql/schema: type "users" is allocated more than once in the type ranges`)
+	_, err = NewMigrate(drv, WithTypeRangesFile(filepath.Join(t.TempDir(), "missing")))
+	require.Error(t, err)
+
+	t.Log("Ranges are allocated by their position in the static allocation")
+	m, err := NewMigrate(drv, WithTypeRanges("users", "pets"))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, pets))
+	require.Equal(t, int64(1<<32+1), insert(pets))
+	require.NoError(t, m.Create(ctx, users, pets))
+	require.Equal(t, int64(1), insert(users))
+	err = m.Create(ctx, users, groups, pets)
+	require.EqualError(t, err, `sql/schema: table "groups" is missing from the type ranges`)
+
+	t.Log("The stored ranges must match the static allocation")
+	m, err = NewMigrate(drv, WithTypeRanges("pets", "users"))
+	require.NoError(t, err)
+	err = m.Create(ctx, users, pets)
+	require.EqualError(t, err, `sql/schema: type range 0 is allocated to "users" in the database, but to "pets" in the type ranges`)
+	m, err = NewMigrate(drv, WithTypeRanges("users"))
+	require.NoError(t, err)
+	err = m.Create(ctx, users)
+	require.EqualError(t, err, `sql/schema: type range 1 is allocated to "pets" in the database, but is missing from the type ranges`)
+
+	t.Log("The static allocation can be read from a file")
+	name := filepath.Join(t.TempDir(), "ranges")
+	require.NoError(t, os.WriteFile(name, []byte("# Append new tables to the end of the file.\nusers\npets\n\ngroups\n"), 0644))
+	m, err = NewMigrate(drv, WithTypeRangesFile(name))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users, groups, pets))
+	require.Equal(t, int64(2<<32+1), insert(groups))
+	_, err = m.ExtendTypeRange(ctx, users)
+	require.Error(t, err)
+
+	t.Log("Usage of the ranges is reported")
+	m, err = NewMigrate(drv, WithGlobalUniqueID(true))
+	require.NoError(t, err)
+	ranges, err := m.TypeRanges(ctx, []*Table{users, pets})
+	require.NoError(t, err)
+	require.Equal(t, []*TypeRange{
+		{Type: "users", Index: 0, Start: 0, End: 1<<32 - 1, Max: 1},
+		{Type: "pets", Index: 1, Start: 1 << 32, End: 2<<32 - 1, Max: 1<<32 + 1},
+	}, ranges)
+	require.Equal(t, 2/float64(1<<32), ranges[0].Usage())
+
+	t.Log("Extended ranges are used by the next inserts and migrations")
+	r, err := m.ExtendTypeRange(ctx, users)
+	require.NoError(t, err)
+	require.Equal(t, &TypeRange{Type: "users", Index: 3, Start: 3 << 32, End: 4<<32 - 1, Max: 3<<32 - 1}, r)
+	require.Equal(t, int64(3<<32+1), insert(users))
+	_, err = m.ExtendTypeRange(ctx, table("unknown"))
+	require.EqualError(t, err, `sql/schema: table "unknown" does not own a type range`)
+	require.NoError(t, m.Create(ctx, users, groups, pets))
+	ranges, err = m.TypeRanges(ctx, []*Table{users})
+	require.NoError(t, err)
+	require.Len(t, ranges, 2)
+	require.Equal(t, int64(1), ranges[0].Max)
+	require.Equal(t, int64(3<<32+1), ranges[1].Max)
+	require.NoError(t, m.VerifyTableRange(ctx, []*Table{users}))
+}
+
+func TestRangeType(t *testing.T) {
+	require.Equal(t, "users", rangeType("users"))
+	require.Equal(t, "users", rangeType("users#1"))
+	require.Equal(t, "users#a", rangeType("users#a"))
+	require.Equal(t, "#1", rangeType("#1"))
+	require.Equal(t, 3, lastTypeRange([]string{"users", "pets", "groups", "users#1"}, "users"))
+	require.Equal(t, -1, lastTypeRange([]string{"users"}, "pets"))
+}
@@ -0,0 +1,210 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// IsView reports if the table is defined as a view (or a materialized view)
+// using the View or ViewQuery options of the entsql annotation.
+func (t *Table) IsView() bool {
+	return t.Annotation != nil && t.Annotation.IsView()
+}
+
+type (
+	// viewer is implemented by the dialects that support views. Since the definitions
+	// of views are normalized by the databases, the migration compares the checksums
+	// of their create statements, that are stored (or computed) by the dialects.
+	viewer interface {
+		// view returns the state of the view in the database, or nil if it does not exist.
+		view(context.Context, dialect.ExecQuerier, string) (*viewState, error)
+		// createView returns the statements for creating the view and its indexes, and
+		// the checksum that is reported by the view method after they are executed.
+		createView(*Table) ([]string, string, error)
+		// dropView returns the statement for dropping the view.
+		dropView(*viewState) string
+	}
+	// viewState describes a view in the database.
+	viewState struct {
+		name         string
+		materialized bool
+		sum          string
+	}
+)
+
+// splitViews splits the given tables into tables and views.
+func splitViews(all []*Table) (tables, views []*Table) {
+	for _, t := range all {
+		if t.IsView() {
+			views = append(views, t)
+		} else {
+			tables = append(tables, t)
+		}
+	}
+	return tables, views
+}
+
+// viewChanges returns the changes for creating the given views, or replacing them in case their
+// definition (or indexes) were changed. The drop changes are returned separately, because they
+// need to be executed before the changes of the tables that the views may depend on.
+func (a *Atlas) viewChanges(ctx context.Context, conn dialect.ExecQuerier, views []*Table) (drop, create []*migrate.Change, err error) {
+	if len(views) == 0 {
+		return nil, nil, nil
+	}
+	v, ok := a.sqlDialect.(viewer)
+	if !ok {
+		return nil, nil, fmt.Errorf("views are not supported by the %s dialect", a.sqlDialect.Dialect())
+	}
+	for _, t := range views {
+		curr, err := v.view(ctx, conn, t.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		stmts, sum, err := v.createView(t)
+		if err != nil {
+			return nil, nil, err
+		}
+		if curr != nil && curr.sum == sum {
+			continue
+		}
+		if curr != nil {
+			drop = append(drop, &migrate.Change{
+				Cmd:     v.dropView(curr),
+				Comment: fmt.Sprintf("drop view %q", t.Name),
+			})
+		}
+		for i, stmt := range stmts {
+			c := &migrate.Change{
+				Cmd:     stmt,
+				Comment: fmt.Sprintf("create view %q", t.Name),
+			}
+			// Dropping the view drops its indexes and comment as well. Note that replaced
+			// views are not reversible, because their previous definition is not known.
+			if i == 0 && curr == nil {
+				c.Reverse = v.dropView(&viewState{name: t.Name, materialized: t.Annotation.Materialized})
+			}
+			create = append(create, c)
+		}
+	}
+	return drop, create, nil
+}
+
+// viewChecksum returns the checksum of the given statements.
+func viewChecksum(stmts ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(stmts, ";\n")))
+	return hex.EncodeToString(h[:])
+}
+
+// quoteName quotes the name of a schema object (e.g. a view) for the given dialect.
+func quoteName(drv, name string) string {
+	var b sql.Builder
+	b.SetDialect(drv)
+	return b.Quote(name)
+}
+
+// viewPrefix is the prefix of the checksums that are stored in the comments of the views.
+const viewPrefix = "ent:"
+
+// view returns the state of the view in the database. The checksum of the view is stored in its comment.
+func (d *Postgres) view(ctx context.Context, conn dialect.ExecQuerier, name string) (*viewState, error) {
+	schema, args := "CURRENT_SCHEMA()", []interface{}{name}
+	if d.schema != "" {
+		schema, args = "$2", append(args, d.schema)
+	}
+	rows := &sql.Rows{}
+	query := "SELECT c.relkind, COALESCE(obj_description(c.oid, 'pg_class'), '') FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace " +
+		"WHERE c.relname = $1 AND c.relkind IN ('v', 'm') AND n.nspname = " + schema
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("reading view %q: %w", name, err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	var kind, comment string
+	if err := rows.Scan(&kind, &comment); err != nil {
+		return nil, fmt.Errorf("scanning view %q: %w", name, err)
+	}
+	return &viewState{name: name, materialized: kind == "m", sum: strings.TrimPrefix(comment, viewPrefix)}, rows.Close()
+}
+
+// createView returns the statements for creating the view, its indexes, and storing its checksum.
+func (d *Postgres) createView(t *Table) ([]string, string, error) {
+	kind := "VIEW"
+	if t.Annotation.Materialized {
+		kind = "MATERIALIZED VIEW"
+	} else if len(t.Indexes) > 0 {
+		return nil, "", fmt.Errorf("indexes are not supported for view %q, unless it is materialized", t.Name)
+	}
+	name := quoteName(dialect.Postgres, t.Name)
+	stmts := []string{fmt.Sprintf("CREATE %s %s AS %s", kind, name, t.Annotation.ViewQueryOf(dialect.Postgres))}
+	for _, idx := range t.Indexes {
+		query, _ := d.addIndex(idx, t.Name).Query()
+		stmts = append(stmts, query)
+	}
+	sum := viewChecksum(stmts...)
+	return append(stmts, fmt.Sprintf("COMMENT ON %s %s IS '%s%s'", kind, name, viewPrefix, sum)), sum, nil
+}
+
+// dropView returns the statement for dropping the view.
+func (d *Postgres) dropView(v *viewState) string {
+	kind := "VIEW"
+	if v.materialized {
+		kind = "MATERIALIZED VIEW"
+	}
+	return fmt.Sprintf("DROP %s IF EXISTS %s", kind, quoteName(dialect.Postgres, v.name))
+}
+
+// view returns the state of the view in the database. The checksum of the view is
+// computed from its create statement, that is stored as is in the schema table.
+func (d *SQLite) view(ctx context.Context, conn dialect.ExecQuerier, name string) (*viewState, error) {
+	rows := &sql.Rows{}
+	query, args := sql.Select("sql").
+		From(sql.Table("sqlite_master")).
+		Where(sql.And(
+			sql.EQ("type", "view"),
+			sql.EQ("name", name),
+		)).
+		Query()
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("reading view %q: %w", name, err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	var stmt string
+	if err := rows.Scan(&stmt); err != nil {
+		return nil, fmt.Errorf("scanning view %q: %w", name, err)
+	}
+	return &viewState{name: name, sum: viewChecksum(stmt)}, rows.Close()
+}
+
+// createView returns the statement for creating the view.
+func (d *SQLite) createView(t *Table) ([]string, string, error) {
+	switch {
+	case t.Annotation.Materialized:
+		return nil, "", fmt.Errorf("materialized view %q is not supported by sqlite", t.Name)
+	case len(t.Indexes) > 0:
+		return nil, "", fmt.Errorf("indexes are not supported for view %q", t.Name)
+	}
+	stmt := fmt.Sprintf("CREATE VIEW %s AS %s", quoteName(dialect.SQLite, t.Name), t.Annotation.ViewQueryOf(dialect.SQLite))
+	return []string{stmt}, viewChecksum(stmt), nil
+}
+
+// dropView returns the statement for dropping the view.
+func (d *SQLite) dropView(v *viewState) string {
+	return fmt.Sprintf("DROP VIEW IF EXISTS %s", quoteName(dialect.SQLite, v.name))
+}
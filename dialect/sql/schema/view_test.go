@@ -0,0 +1,136 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"ariga.io/atlas/sql/migrate"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_ViewChanges(t *testing.T) {
+	stats := &Table{
+		Name:       "daily_stats",
+		Columns:    []*Column{{Name: "day", Type: field.TypeString}},
+		Annotation: entsql.MaterializedView("SELECT day FROM events GROUP BY day"),
+	}
+	stats.Indexes = []*Index{{Name: "dailystats_day", Unique: true, Columns: stats.Columns}}
+	const query = "SELECT c.relkind, COALESCE(obj_description(c.oid, 'pg_class'), '') FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace " +
+		"WHERE c.relname = $1 AND c.relkind IN ('v', 'm') AND n.nspname = CURRENT_SCHEMA()"
+	d := &Postgres{}
+	stmts, sum, err := d.createView(stats)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`CREATE MATERIALIZED VIEW "daily_stats" AS SELECT day FROM events GROUP BY day`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS "dailystats_day" ON "daily_stats"("day")`,
+		`COMMENT ON MATERIALIZED VIEW "daily_stats" IS 'ent:` + sum + `'`,
+	}, stmts)
+
+	tests := []struct {
+		name       string
+		before     func(sqlmock.Sqlmock)
+		drop, stmt []string
+	}{
+		{
+			name: "create",
+			before: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(escape(query)).
+					WithArgs("daily_stats").
+					WillReturnRows(sqlmock.NewRows([]string{"relkind", "comment"}))
+			},
+			stmt: stmts,
+		},
+		{
+			name: "unchanged",
+			before: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(escape(query)).
+					WithArgs("daily_stats").
+					WillReturnRows(sqlmock.NewRows([]string{"relkind", "comment"}).AddRow("m", viewPrefix+sum))
+			},
+		},
+		{
+			name: "changed",
+			before: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(escape(query)).
+					WithArgs("daily_stats").
+					WillReturnRows(sqlmock.NewRows([]string{"relkind", "comment"}).AddRow("v", ""))
+			},
+			drop: []string{`DROP VIEW IF EXISTS "daily_stats"`},
+			stmt: stmts,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.before(mock)
+			a := &Atlas{sqlDialect: &Postgres{Driver: sql.OpenDB(dialect.Postgres, db)}}
+			drop, create, err := a.viewChanges(context.Background(), a.sqlDialect, []*Table{stats})
+			require.NoError(t, err)
+			require.Equal(t, tt.drop, cmds(drop))
+			require.Equal(t, tt.stmt, cmds(create))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	_, _, err = d.createView(&Table{Name: "v", Indexes: stats.Indexes, Annotation: entsql.View("SELECT 1")})
+	require.Error(t, err, "plain views cannot have indexes")
+}
+
+func TestSQLite_ViewChanges(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:views?mode=memory&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	require.NoError(t, db.Exec(ctx, "CREATE TABLE `users` (`id` integer PRIMARY KEY, `age` integer)", []interface{}{}, nil))
+
+	a := &Atlas{sqlDialect: &SQLite{Driver: db}}
+	adults := &Table{Name: "adults", Annotation: entsql.View("SELECT `id` FROM `users` WHERE `age` >= 18")}
+	drop, create, err := a.viewChanges(ctx, db, []*Table{adults})
+	require.NoError(t, err)
+	require.Empty(t, drop)
+	require.Equal(t, []string{"CREATE VIEW `adults` AS SELECT `id` FROM `users` WHERE `age` >= 18"}, cmds(create))
+	require.Equal(t, "DROP VIEW IF EXISTS `adults`", create[0].Reverse)
+	require.NoError(t, db.Exec(ctx, create[0].Cmd, []interface{}{}, nil))
+
+	drop, create, err = a.viewChanges(ctx, db, []*Table{adults})
+	require.NoError(t, err)
+	require.Empty(t, drop)
+	require.Empty(t, create)
+
+	adults.Annotation = entsql.View("SELECT `id` FROM `users` WHERE `age` >= 21")
+	drop, create, err = a.viewChanges(ctx, db, []*Table{adults})
+	require.NoError(t, err)
+	require.Equal(t, []string{"DROP VIEW IF EXISTS `adults`"}, cmds(drop))
+	require.Equal(t, []string{"CREATE VIEW `adults` AS SELECT `id` FROM `users` WHERE `age` >= 21"}, cmds(create))
+	require.Empty(t, create[0].Reverse, "replaced views are not reversible")
+
+	minors := &Table{Name: "minors", Annotation: entsql.ViewQuery(func(s *sql.Selector) {
+		u := sql.Table("users")
+		s.From(u).Select(u.C("id")).Where(sql.ExprP(u.C("age") + " < 18"))
+	})}
+	_, create, err = a.viewChanges(ctx, db, []*Table{minors})
+	require.NoError(t, err)
+	require.Equal(t, []string{"CREATE VIEW `minors` AS SELECT `users`.`id` FROM `users` WHERE `users`.`age` < 18"}, cmds(create))
+
+	_, _, err = a.viewChanges(ctx, db, []*Table{{Name: "stats", Annotation: entsql.MaterializedView("SELECT 1")}})
+	require.Error(t, err, "materialized views are not supported by sqlite")
+}
+
+func cmds(changes []*migrate.Change) []string {
+	var stmts []string
+	for _, c := range changes {
+		stmts = append(stmts, c.Cmd)
+	}
+	return stmts
+}
@@ -0,0 +1,87 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlcodec provides the runtime support for fields that their values are transformed
+// at rest using a field.Codec (e.g. encrypted). The generated code encodes the values of these
+// fields using the Value and JSON functions before they are written to the database, and decodes
+// them using the Decode function after they are scanned.
+package sqlcodec
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/schema/field"
+)
+
+// ErrNoCodec is returned when the codec of a field was not set. The codecs
+// are set by the runtime package of the generated code, and therefore, it
+// should be imported in the main package.
+var ErrNoCodec = errors.New("sqlcodec: codec is not set (forgotten import ent/runtime?)")
+
+// Decode decodes the given bytes using the given codec. A nil []byte is returned as is.
+func Decode(c field.Codec, b []byte) ([]byte, error) {
+	if c == nil {
+		return nil, ErrNoCodec
+	}
+	if b == nil {
+		return nil, nil
+	}
+	d, err := c.Decode(b)
+	if err != nil {
+		return nil, fmt.Errorf("sqlcodec: decode: %w", err)
+	}
+	return d, nil
+}
+
+// Value returns a driver.Valuer that encodes the given string or bytes value
+// using the given codec. A nil []byte is stored as NULL.
+func Value(c field.Codec, v interface{}) driver.Valuer {
+	return valuer{c: c, v: v}
+}
+
+// JSON returns a driver.Valuer that encodes the JSON encoding of the given value.
+func JSON(c field.Codec, v interface{}) driver.Valuer {
+	return valuer{c: c, v: v, json: true}
+}
+
+type valuer struct {
+	c    field.Codec
+	v    interface{}
+	json bool
+}
+
+// Value implements the driver.Valuer interface.
+func (v valuer) Value() (driver.Value, error) {
+	if v.c == nil {
+		return nil, ErrNoCodec
+	}
+	var b []byte
+	switch x := v.v.(type) {
+	case string:
+		b = []byte(x)
+	case []byte:
+		if x == nil && !v.json {
+			return nil, nil
+		}
+		b = x
+	default:
+		if !v.json {
+			return nil, fmt.Errorf("sqlcodec: unexpected value type %T", v.v)
+		}
+	}
+	if v.json {
+		var err error
+		if b, err = json.Marshal(v.v); err != nil {
+			return nil, err
+		}
+	}
+	e, err := v.c.Encode(b)
+	if err != nil {
+		return nil, fmt.Errorf("sqlcodec: encode: %w", err)
+	}
+	return e, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlcodec
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+var hexCodec = field.CodecFuncs(
+	func(b []byte) ([]byte, error) {
+		return []byte(hex.EncodeToString(b)), nil
+	},
+	func(b []byte) ([]byte, error) {
+		return hex.DecodeString(string(b))
+	},
+)
+
+func TestValue(t *testing.T) {
+	v, err := Value(hexCodec, "ent").Value()
+	require.NoError(t, err)
+	require.Equal(t, []byte("656e74"), v)
+	d, err := Decode(hexCodec, v.([]byte))
+	require.NoError(t, err)
+	require.Equal(t, "ent", string(d))
+
+	v, err = Value(hexCodec, []byte("a")).Value()
+	require.NoError(t, err)
+	require.Equal(t, []byte("61"), v)
+
+	v, err = JSON(hexCodec, map[string]int{"a": 1}).Value()
+	require.NoError(t, err)
+	d, err = Decode(hexCodec, v.([]byte))
+	require.NoError(t, err)
+	require.Equal(t, `{"a":1}`, string(d))
+
+	v, err = Value(hexCodec, []byte(nil)).Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	_, err = Value(nil, "ent").Value()
+	require.ErrorIs(t, err, ErrNoCodec)
+	_, err = Value(hexCodec, 1).Value()
+	require.EqualError(t, err, "sqlcodec: unexpected value type int")
+	failing := field.CodecFuncs(
+		func([]byte) ([]byte, error) { return nil, errors.New("encode failed") },
+		func([]byte) ([]byte, error) { return nil, errors.New("decode failed") },
+	)
+	_, err = Value(failing, "ent").Value()
+	require.EqualError(t, err, "sqlcodec: encode: encode failed")
+}
+
+func TestDecode(t *testing.T) {
+	d, err := Decode(hexCodec, nil)
+	require.NoError(t, err)
+	require.Nil(t, d)
+	_, err = Decode(nil, []byte("61"))
+	require.ErrorIs(t, err, ErrNoCodec)
+	_, err = Decode(hexCodec, []byte("zz"))
+	require.Error(t, err)
+}
@@ -0,0 +1,198 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlcompress provides the runtime support for fields that are compressed using the
+// entsql.Compress annotation. Compressed values are prefixed with a header that identifies
+// their algorithm, and values without this header are returned as is. Hence, a field can be
+// annotated after its table was populated, and its existing rows are compressed once they
+// are updated.
+//
+// The gzip algorithm is supported by default. Other algorithms, like zstd, can be registered
+// using the Register function. For example, using github.com/klauspost/compress/zstd:
+//
+//	enc, _ := zstd.NewWriter(nil)
+//	dec, _ := zstd.NewReader(nil)
+//	sqlcompress.Register(sqlcompress.Zstd, sqlcompress.ZstdID, sqlcompress.CodecFuncs(
+//		func(b []byte) ([]byte, error) { return enc.EncodeAll(b, nil), nil },
+//		func(b []byte) ([]byte, error) { return dec.DecodeAll(b, nil) },
+//	))
+//
+package sqlcompress
+
+import (
+	"bytes"
+	"compress/gzip"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sync"
+)
+
+// Names and identifiers of the well-known algorithms.
+const (
+	Gzip   = "gzip"
+	GzipID = 1
+	Zstd   = "zstd"
+	ZstdID = 2
+)
+
+// Codec compresses and decompresses values.
+type Codec interface {
+	Compress([]byte) ([]byte, error)
+	Decompress([]byte) ([]byte, error)
+}
+
+// CodecFuncs returns a Codec from the given compress and decompress functions.
+func CodecFuncs(compress, decompress func([]byte) ([]byte, error)) Codec {
+	return codecFuncs{compress: compress, decompress: decompress}
+}
+
+type codecFuncs struct {
+	compress, decompress func([]byte) ([]byte, error)
+}
+
+func (c codecFuncs) Compress(b []byte) ([]byte, error)   { return c.compress(b) }
+func (c codecFuncs) Decompress(b []byte) ([]byte, error) { return c.decompress(b) }
+
+// magic is the prefix of the header of compressed values. It is followed
+// by a single byte that holds the identifier of the algorithm. The NUL byte
+// does not appear in text, and therefore, in uncompressed string and JSON values.
+var magic = []byte{0x00, 'e', 'z'}
+
+type codec struct {
+	Codec
+	name string
+	id   byte
+}
+
+var codecs = struct {
+	sync.RWMutex
+	names map[string]*codec
+	ids   map[byte]*codec
+}{
+	names: make(map[string]*codec),
+	ids:   make(map[byte]*codec),
+}
+
+func init() {
+	Register(Gzip, GzipID, CodecFuncs(gzipCompress, gzipDecompress))
+}
+
+// Register makes a compression algorithm available by the given name and identifier.
+// The identifier is stored in the header of the compressed values, and must not be
+// changed once values were written with it. Register panics if it is called twice
+// with the same name or identifier.
+func Register(name string, id byte, c Codec) {
+	codecs.Lock()
+	defer codecs.Unlock()
+	if c == nil {
+		panic("sqlcompress: Register codec is nil")
+	}
+	if _, dup := codecs.names[name]; dup {
+		panic("sqlcompress: Register called twice for algorithm " + name)
+	}
+	if _, dup := codecs.ids[id]; dup {
+		panic(fmt.Sprintf("sqlcompress: Register called twice for identifier %d", id))
+	}
+	cd := &codec{Codec: c, name: name, id: id}
+	codecs.names[name], codecs.ids[id] = cd, cd
+}
+
+// Compress compresses the given bytes using the given algorithm, and prefixes them with the header.
+func Compress(alg string, b []byte) ([]byte, error) {
+	codecs.RLock()
+	c, ok := codecs.names[alg]
+	codecs.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("sqlcompress: unknown algorithm %q (forgotten Register?)", alg)
+	}
+	z, err := c.Compress(b)
+	if err != nil {
+		return nil, fmt.Errorf("sqlcompress: compress %s: %w", alg, err)
+	}
+	return append(append(append(make([]byte, 0, len(magic)+1+len(z)), magic...), c.id), z...), nil
+}
+
+// Decompress decompresses the given bytes. Bytes that were not compressed by Compress
+// (i.e. they are not prefixed with the header) are returned as is.
+func Decompress(b []byte) ([]byte, error) {
+	if len(b) <= len(magic) || !bytes.HasPrefix(b, magic) {
+		return b, nil
+	}
+	id := b[len(magic)]
+	codecs.RLock()
+	c, ok := codecs.ids[id]
+	codecs.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("sqlcompress: unknown algorithm identifier %d (forgotten Register?)", id)
+	}
+	d, err := c.Decompress(b[len(magic)+1:])
+	if err != nil {
+		return nil, fmt.Errorf("sqlcompress: decompress %s: %w", c.name, err)
+	}
+	return d, nil
+}
+
+// Value returns a driver.Valuer that compresses the given string or bytes value
+// using the given algorithm. A nil []byte is stored as NULL.
+func Value(alg string, v interface{}) driver.Valuer {
+	return valuer{alg: alg, v: v}
+}
+
+// JSON returns a driver.Valuer that compresses the JSON encoding of the given value.
+func JSON(alg string, v interface{}) driver.Valuer {
+	return valuer{alg: alg, v: v, json: true}
+}
+
+type valuer struct {
+	alg  string
+	v    interface{}
+	json bool
+}
+
+// Value implements the driver.Valuer interface.
+func (v valuer) Value() (driver.Value, error) {
+	if v.json {
+		b, err := json.Marshal(v.v)
+		if err != nil {
+			return nil, err
+		}
+		return Compress(v.alg, b)
+	}
+	var b []byte
+	switch x := v.v.(type) {
+	case string:
+		b = []byte(x)
+	case []byte:
+		if x == nil {
+			return nil, nil
+		}
+		b = x
+	default:
+		return nil, fmt.Errorf("sqlcompress: unexpected value type %T", v.v)
+	}
+	return Compress(v.alg, b)
+}
+
+func gzipCompress(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gzipDecompress(b []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
@@ -0,0 +1,72 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlcompress
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValue(t *testing.T) {
+	text := strings.Repeat("ent ", 100)
+	v, err := Value(Gzip, text).Value()
+	require.NoError(t, err)
+	b := v.([]byte)
+	require.True(t, bytes.HasPrefix(b, []byte{0x00, 'e', 'z', GzipID}))
+	require.Less(t, len(b), len(text))
+	d, err := Decompress(b)
+	require.NoError(t, err)
+	require.Equal(t, text, string(d))
+
+	v, err = JSON(Gzip, map[string]int{"a": 1}).Value()
+	require.NoError(t, err)
+	d, err = Decompress(v.([]byte))
+	require.NoError(t, err)
+	require.Equal(t, `{"a":1}`, string(d))
+
+	v, err = Value(Gzip, []byte(nil)).Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	_, err = Value(Zstd, text).Value()
+	require.EqualError(t, err, `sqlcompress: unknown algorithm "zstd" (forgotten Register?)`)
+	_, err = Value(Gzip, 1).Value()
+	require.Error(t, err)
+}
+
+func TestDecompress(t *testing.T) {
+	// Uncompressed values are returned as is.
+	for _, s := range []string{"", "{}", "hello", "\x00e"} {
+		d, err := Decompress([]byte(s))
+		require.NoError(t, err)
+		require.Equal(t, s, string(d))
+	}
+	_, err := Decompress([]byte{0x00, 'e', 'z', 99, 1})
+	require.EqualError(t, err, "sqlcompress: unknown algorithm identifier 99 (forgotten Register?)")
+	_, err = Decompress([]byte{0x00, 'e', 'z', GzipID, 1})
+	require.Error(t, err)
+}
+
+func TestRegister(t *testing.T) {
+	reverse := func(b []byte) ([]byte, error) {
+		r := make([]byte, len(b))
+		for i := range b {
+			r[len(b)-1-i] = b[i]
+		}
+		return r, nil
+	}
+	Register("reverse", 100, CodecFuncs(reverse, reverse))
+	b, err := Compress("reverse", []byte("abc"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x00, 'e', 'z', 100, 'c', 'b', 'a'}, b)
+	d, err := Decompress(b)
+	require.NoError(t, err)
+	require.Equal(t, "abc", string(d))
+	require.Panics(t, func() { Register("reverse", 101, CodecFuncs(reverse, reverse)) })
+	require.Panics(t, func() { Register(Zstd, GzipID, CodecFuncs(reverse, reverse)) })
+}
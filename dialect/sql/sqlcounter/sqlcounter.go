@@ -0,0 +1,369 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlcounter provides write-behind batching for counter-style updates (e.g. views or likes).
+// Increments are coalesced in memory, and are flushed periodically in batched UPDATE statements,
+// instead of updating the same hot rows on each increment.
+//
+// Flushes are two-phased for crash-safety. The coalesced increments are first appended to a journal
+// table, and then applied to their tables and removed from the journal in one transaction. Hence, an
+// interrupted flush is completed by the next flush (of any process), and increments are never applied
+// twice. Note that increments that were not flushed yet are lost if the process crashes, and therefore,
+// the flush interval bounds the loss.
+//
+//	c := sqlcounter.New(drv, sqlcounter.WithInterval(time.Second))
+//	if err := c.Create(ctx); err != nil {
+//		return err
+//	}
+//	c.Start()
+//	defer c.Close(ctx)
+//	client.AddExtension(c)
+//	// ...
+//	c.Add(post.Table, post.FieldViews, id, 1)
+//
+package sqlcounter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DefaultTable is the default name of the journal table.
+const DefaultTable = "ent_counter_journal"
+
+// ErrClosed is returned by Add when the Counter was closed.
+var ErrClosed = errors.New("sqlcounter: counter is closed")
+
+// Counter coalesces increments of counter columns in memory, and flushes them in batches.
+// It implements the ent.Extension interface, and reports the error of the last flush in
+// its health check.
+type Counter struct {
+	ent.DefaultExtension
+	drv      dialect.Driver
+	table    string
+	idColumn string
+	interval time.Duration
+	// flushMu serializes the flushes.
+	flushMu sync.Mutex
+	mu      sync.Mutex
+	pending map[key]int64
+	err     error
+	closed  bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// key identifies a counter column of a row.
+type key struct {
+	table, column, id string
+}
+
+// Option allows configuring the Counter using functional options.
+type Option func(*Counter)
+
+// WithTable sets the name of the journal table. The default is DefaultTable.
+func WithTable(name string) Option {
+	return func(c *Counter) {
+		c.table = name
+	}
+}
+
+// WithIDColumn sets the name of the column that identifies the rows of the
+// counted tables. The default is "id".
+func WithIDColumn(name string) Option {
+	return func(c *Counter) {
+		c.idColumn = name
+	}
+}
+
+// WithInterval sets the interval of the periodic flushes that are executed
+// after Start is called. The default is 1 second.
+func WithInterval(d time.Duration) Option {
+	return func(c *Counter) {
+		c.interval = d
+	}
+}
+
+// New returns a new Counter that flushes the increments using the given driver.
+func New(drv dialect.Driver, opts ...Option) *Counter {
+	c := &Counter{
+		drv:      drv,
+		table:    DefaultTable,
+		idColumn: "id",
+		interval: time.Second,
+		pending:  make(map[key]int64),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Name implements the ent.Extension interface.
+func (*Counter) Name() string { return "sqlcounter" }
+
+// HealthCheck implements the ent.Extension interface. It returns the error of the last flush, if it failed.
+func (c *Counter) HealthCheck(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// Create creates the journal table if it does not exist.
+func (c *Counter) Create(ctx context.Context) error {
+	var id string
+	switch c.drv.Dialect() {
+	case dialect.SQLite:
+		id = "integer PRIMARY KEY AUTOINCREMENT"
+	case dialect.Postgres:
+		id = "bigserial PRIMARY KEY"
+	case dialect.MySQL:
+		id = "bigint AUTO_INCREMENT PRIMARY KEY"
+	default:
+		return fmt.Errorf("sqlcounter: unsupported dialect %q", c.drv.Dialect())
+	}
+	query, args := sql.Dialect(c.drv.Dialect()).
+		CreateTable(c.table).
+		IfNotExists().
+		Column(sql.Column("id").Type(id)).
+		Column(sql.Column("table_name").Type("varchar(255)").Attr("NOT NULL")).
+		Column(sql.Column("column_name").Type("varchar(255)").Attr("NOT NULL")).
+		Column(sql.Column("row_id").Type("varchar(255)").Attr("NOT NULL")).
+		Column(sql.Column("delta").Type("bigint").Attr("NOT NULL")).
+		Query()
+	return c.drv.Exec(ctx, query, args, nil)
+}
+
+// Add adds the given delta to the counter column of the row with the given id. The increment
+// is applied by the next flush, and can be read before it using Pending.
+func (c *Counter) Add(table, column string, id interface{}, delta int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return ErrClosed
+	}
+	c.pending[key{table: table, column: column, id: fmt.Sprint(id)}] += delta
+	return nil
+}
+
+// Pending returns the sum of the increments of the given counter column
+// that were not flushed yet. It allows reading the up-to-date value of a
+// counter by adding it to the value that is stored in the database.
+func (c *Counter) Pending(table, column string, id interface{}) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.pending[key{table: table, column: column, id: fmt.Sprint(id)}]
+}
+
+// Start starts flushing the increments periodically in the background, until Close is called.
+func (c *Counter) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stop != nil || c.closed {
+		return
+	}
+	stop, done := make(chan struct{}), make(chan struct{})
+	c.stop, c.done = stop, done
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				// Errors are reported by the health check, and
+				// the increments are retried by the next flush.
+				_ = c.Flush(context.Background())
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// Close stops the periodic flushes, and flushes the pending increments.
+// Increments that are added after Close is called are rejected.
+func (c *Counter) Close(ctx context.Context) error {
+	c.mu.Lock()
+	c.closed = true
+	stop, done := c.stop, c.done
+	c.stop = nil
+	c.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+	return c.Flush(ctx)
+}
+
+// Flush appends the pending increments to the journal, and applies all journaled
+// increments (including the ones that were left by interrupted flushes) to their tables.
+func (c *Counter) Flush(ctx context.Context) error {
+	c.flushMu.Lock()
+	defer c.flushMu.Unlock()
+	c.mu.Lock()
+	batch := c.pending
+	c.pending = make(map[key]int64)
+	c.mu.Unlock()
+	err := c.withTx(ctx, func(tx dialect.Tx) error {
+		return c.journal(ctx, tx, batch)
+	})
+	if err != nil {
+		// Return the batch to the pending increments, to be retried by the next flush.
+		c.mu.Lock()
+		for k, v := range batch {
+			c.pending[k] += v
+		}
+		c.mu.Unlock()
+		err = fmt.Errorf("sqlcounter: append to journal: %w", err)
+	} else if err = c.withTx(ctx, func(tx dialect.Tx) error {
+		return c.apply(ctx, tx)
+	}); err != nil {
+		err = fmt.Errorf("sqlcounter: apply journal: %w", err)
+	}
+	c.mu.Lock()
+	c.err = err
+	c.mu.Unlock()
+	return err
+}
+
+// maxBatch is the maximum number of rows that are inserted in one statement.
+const maxBatch = 500
+
+// journal appends the given increments to the journal table.
+func (c *Counter) journal(ctx context.Context, tx dialect.ExecQuerier, batch map[key]int64) error {
+	keys := make([]key, 0, len(batch))
+	for k, v := range batch {
+		if v != 0 {
+			keys = append(keys, k)
+		}
+	}
+	// Sort the keys to keep the order of the updates deterministic,
+	// and reduce the chance of deadlocks between concurrent flushes.
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].table != keys[j].table {
+			return keys[i].table < keys[j].table
+		}
+		if keys[i].column != keys[j].column {
+			return keys[i].column < keys[j].column
+		}
+		return keys[i].id < keys[j].id
+	})
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > maxBatch {
+			n = maxBatch
+		}
+		insert := sql.Dialect(c.drv.Dialect()).
+			Insert(c.table).
+			Columns("table_name", "column_name", "row_id", "delta")
+		for _, k := range keys[:n] {
+			insert.Values(k.table, k.column, k.id, batch[k])
+		}
+		query, args := insert.Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return err
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
+// entry is a journaled increment.
+type entry struct {
+	id    int64
+	key   key
+	delta int64
+}
+
+// withTx runs the given function in a transaction.
+func (c *Counter) withTx(ctx context.Context, fn func(dialect.Tx) error) error {
+	tx, err := c.drv.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// apply applies the journaled increments to their tables, and removes them from the journal.
+func (c *Counter) apply(ctx context.Context, tx dialect.ExecQuerier) error {
+	b := sql.Dialect(c.drv.Dialect())
+	query, args := b.Select("id", "table_name", "column_name", "row_id", "delta").
+		From(b.Table(c.table)).
+		OrderBy("id").
+		Query()
+	rows := &sql.Rows{}
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	var entries []entry
+	for rows.Next() {
+		var e entry
+		if err := rows.Scan(&e.id, &e.key.table, &e.key.column, &e.key.id, &e.delta); err != nil {
+			rows.Close()
+			return err
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	// Claim the entries by deleting them first. If some of them were deleted
+	// by a concurrent flush, the transaction is rolled back to avoid applying
+	// them twice, and the rest of the entries are applied by the next flush.
+	ids := make([]interface{}, len(entries))
+	for i, e := range entries {
+		ids[i] = e.id
+	}
+	query, args = b.Delete(c.table).Where(sql.In("id", ids...)).Query()
+	var res sql.Result
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n != int64(len(entries)) {
+		return fmt.Errorf("journal entries were applied by a concurrent flush")
+	}
+	var (
+		keys   []key
+		deltas = make(map[key]int64)
+	)
+	for _, e := range entries {
+		if _, ok := deltas[e.key]; !ok {
+			keys = append(keys, e.key)
+		}
+		deltas[e.key] += e.delta
+	}
+	for _, k := range keys {
+		if deltas[k] == 0 {
+			continue
+		}
+		query, args := b.Update(k.table).
+			Add(k.column, deltas[k]).
+			Where(sql.EQ(c.idColumn, k.id)).
+			Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlcounter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounter(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:sqlcounter?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	require.NoError(t, drv.Exec(ctx, "CREATE TABLE posts (id integer PRIMARY KEY, views integer NOT NULL DEFAULT 0)", []interface{}{}, nil))
+	require.NoError(t, drv.Exec(ctx, "INSERT INTO posts (id) VALUES (1), (2)", []interface{}{}, nil))
+	views := func(id int) int64 {
+		rows := &sql.Rows{}
+		require.NoError(t, drv.Query(ctx, "SELECT views FROM posts WHERE id = ?", []interface{}{id}, rows))
+		defer rows.Close()
+		require.True(t, rows.Next())
+		var n int64
+		require.NoError(t, rows.Scan(&n))
+		return n
+	}
+
+	c := New(drv)
+	require.NoError(t, c.Create(ctx))
+	require.NoError(t, c.Create(ctx), "create should be idempotent")
+	for i := 0; i < 10; i++ {
+		require.NoError(t, c.Add("posts", "views", 1, 1))
+	}
+	require.NoError(t, c.Add("posts", "views", 2, 5))
+	require.NoError(t, c.Add("posts", "views", 2, -5))
+	require.Equal(t, int64(10), c.Pending("posts", "views", 1))
+	require.Zero(t, views(1))
+	require.NoError(t, c.Flush(ctx))
+	require.Equal(t, int64(10), views(1))
+	require.Zero(t, views(2))
+	require.Zero(t, c.Pending("posts", "views", 1))
+
+	t.Log("journaled increments of interrupted flushes are applied by the next flush")
+	require.NoError(t, c.journal(ctx, drv, map[key]int64{{table: "posts", column: "views", id: "2"}: 3}))
+	require.NoError(t, c.Add("posts", "views", 2, 1))
+	require.NoError(t, c.Flush(ctx))
+	require.Equal(t, int64(4), views(2))
+	require.NoError(t, c.Flush(ctx))
+	require.Equal(t, int64(4), views(2), "journal entries are applied once")
+
+	t.Log("failed flushes are reported by the health check")
+	require.NoError(t, c.HealthCheck(ctx))
+	require.NoError(t, c.Add("unknown", "views", 1, 1))
+	require.Error(t, c.Flush(ctx))
+	require.Error(t, c.HealthCheck(ctx))
+	require.NoError(t, drv.Exec(ctx, "DELETE FROM "+DefaultTable, []interface{}{}, nil))
+	require.NoError(t, c.Flush(ctx))
+	require.NoError(t, c.HealthCheck(ctx))
+
+	t.Log("increments are flushed periodically and on close")
+	c = New(drv, WithInterval(10*time.Millisecond))
+	c.Start()
+	require.NoError(t, c.Add("posts", "views", 1, 5))
+	require.Eventually(t, func() bool { return views(1) == 15 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, c.Add("posts", "views", 1, 5))
+	require.NoError(t, c.Close(ctx))
+	require.Equal(t, int64(20), views(1))
+	require.ErrorIs(t, c.Add("posts", "views", 1, 5), ErrClosed)
+}
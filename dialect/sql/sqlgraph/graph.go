@@ -8,14 +8,17 @@ package sqlgraph
 
 import (
 	"context"
+	stdsql "database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -250,6 +253,37 @@ func HasNeighbors(q *sql.Selector, s *Step) {
 	}
 }
 
+// CountNeighbors returns an expression that counts the neighbors of each row of the given
+// Selector in the edge of the given step, using a correlated subquery. The expression can be
+// selected, or used as an argument of an aggregation function. For example:
+//
+//	sql.Sum(CountNeighbors(s, step))
+//
+func CountNeighbors(q *sql.Selector, s *Step) string {
+	builder := sql.Dialect(q.Dialect())
+	var (
+		t    *sql.SelectTable
+		pred *sql.Predicate
+	)
+	switch r := s.Edge.Rel; {
+	case r == M2M:
+		pk1 := s.Edge.Columns[0]
+		if s.Edge.Inverse {
+			pk1 = s.Edge.Columns[1]
+		}
+		t = builder.Table(s.Edge.Table).Schema(s.Edge.Schema).As("neighbors")
+		pred = sql.ColumnsEQ(t.C(pk1), q.C(s.From.Column))
+	case r == M2O || (r == O2O && s.Edge.Inverse):
+		t = builder.Table(s.To.Table).Schema(s.To.Schema).As("neighbors")
+		pred = sql.ColumnsEQ(t.C(s.To.Column), q.C(s.Edge.Columns[0]))
+	case r == O2M || (r == O2O && !s.Edge.Inverse):
+		t = builder.Table(s.Edge.Table).Schema(s.Edge.Schema).As("neighbors")
+		pred = sql.ColumnsEQ(t.C(s.Edge.Columns[0]), q.C(s.From.Column))
+	}
+	query, _ := builder.Select(sql.Count("*")).From(t).Where(pred).Query()
+	return "(" + query + ")"
+}
+
 // HasNeighborsWith applies on the given Selector a neighbors check.
 // The given predicate applies its filtering on the selector.
 func HasNeighborsWith(q *sql.Selector, s *Step, pred func(*sql.Selector)) {
@@ -288,6 +322,48 @@ func HasNeighborsWith(q *sql.Selector, s *Step, pred func(*sql.Selector)) {
 	}
 }
 
+// unscopedKey is the context key for marking selectors as unscoped.
+type unscopedKey struct{}
+
+// WithUnscoped returns a new context that marks the selectors it is attached to as unscoped.
+// The generated edge predicates (e.g. HasPets) of soft-deleted types do not filter out the
+// deleted neighbors of selectors that were marked as unscoped.
+func WithUnscoped(parent context.Context) context.Context {
+	return context.WithValue(parent, unscopedKey{}, true)
+}
+
+// IsUnscoped reports if the given context was marked as unscoped by WithUnscoped.
+func IsUnscoped(ctx context.Context) bool {
+	unscoped, _ := ctx.Value(unscopedKey{}).(bool)
+	return unscoped
+}
+
+// JoinTable returns a predicate that joins the selector with the given table, on the given columns of
+// the selector table (left) and the joined table (right), and applies the given predicates on the rows
+// of the joined table. Unlike the HasNeighborsWith predicates that use sub-queries, DELETE and UPDATE
+// statements with this predicate join the other table directly (i.e. DELETE ... USING and UPDATE ... FROM).
+//
+//	sqlgraph.JoinTable(user.Table, pet.OwnerColumn, user.FieldID, user.Active(false))
+//
+func JoinTable(table, left, right string, preds ...func(*sql.Selector)) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(table)
+		s.Join(t).On(s.C(left), t.C(right))
+		if len(preds) == 0 {
+			return
+		}
+		joined := builder.Select().From(t)
+		joined.WithContext(s.Context())
+		for _, p := range preds {
+			p(joined)
+		}
+		if p := joined.P(); p != nil {
+			s.Where(p)
+		}
+	}
+}
+
 type (
 	// FieldSpec holds the information for updating a field
 	// column in the database.
@@ -353,6 +429,14 @@ type (
 		//	}
 		//
 		OnConflict []sql.ConflictOption
+
+		// Returning holds the columns that are set by the database (e.g. columns
+		// with a database default), and should be scanned back after the node was
+		// inserted, using the ScanValues and Assign functions. It is ignored by the
+		// dialects that do not support the RETURNING clause (i.e. MySQL).
+		Returning  []string
+		ScanValues func(columns []string) ([]interface{}, error)
+		Assign     func(columns []string, values []interface{}) error
 	}
 
 	// BatchCreateSpec holds the information for creating
@@ -370,9 +454,31 @@ type (
 		//	}
 		//
 		OnConflict []sql.ConflictOption
+
+		// MaxParams limits the number of arguments of each INSERT statement.
+		// Nodes that exceed it are inserted in chunks, using multiple statements
+		// in one transaction. Defaults to the limit of the dialect (see MaxParams).
+		MaxParams int
+	}
+
+	// InsertSelectSpec holds the information for creating nodes
+	// from the result of a query (INSERT INTO ... SELECT).
+	InsertSelectSpec struct {
+		Node    *NodeSpec
+		Columns []string      // columns of the node, populated by the selected columns of the query by their order.
+		From    *sql.Selector // query whose result is inserted.
 	}
 )
 
+// MaxParams holds the maximum number of arguments of a statement for each dialect,
+// that is used for chunking batch inserts. SQLite is configured with the default of
+// SQLITE_MAX_VARIABLE_NUMBER (32766), and should be changed if it was compiled differently.
+var MaxParams = map[string]int{
+	dialect.MySQL:    65535,
+	dialect.Postgres: 65535,
+	dialect.SQLite:   32766,
+}
+
 // CreateNode applies the CreateSpec on the graph. The operation creates a new
 // record in the database, and connects it to other nodes specified in spec.Edges.
 func CreateNode(ctx context.Context, drv dialect.Driver, spec *CreateSpec) error {
@@ -388,6 +494,39 @@ func BatchCreate(ctx context.Context, drv dialect.Driver, spec *BatchCreateSpec)
 	return cr.nodes(ctx, drv)
 }
 
+// InsertSelect applies the InsertSelectSpec on the graph, and returns the number of created nodes.
+// The operation is executed by the database using one statement, without loading the result of
+// the query into the application. For example:
+//
+//	INSERT INTO `backups` (`name`, `age`) SELECT `users`.`name`, `users`.`age` FROM `users` WHERE `age` > ?
+//
+func InsertSelect(ctx context.Context, drv dialect.Driver, spec *InsertSelectSpec) (int, error) {
+	switch {
+	case spec.From == nil:
+		return 0, fmt.Errorf("sqlgraph: missing query for inserting into table %s", spec.Node.Table)
+	case len(spec.Columns) == 0:
+		return 0, fmt.Errorf("sqlgraph: missing columns for inserting into table %s", spec.Node.Table)
+	}
+	if err := spec.From.Err(); err != nil {
+		return 0, err
+	}
+	var res sql.Result
+	query, args := sql.Dialect(drv.Dialect()).
+		Insert(spec.Node.Table).
+		Schema(spec.Node.Schema).
+		Columns(spec.Columns...).
+		Select(spec.From).
+		Query()
+	if err := drv.Exec(ctx, query, args, &res); err != nil {
+		return 0, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
 type (
 	// EdgeMut defines edge mutations.
 	EdgeMut struct {
@@ -397,9 +536,13 @@ type (
 
 	// FieldMut defines field mutations.
 	FieldMut struct {
-		Set   []*FieldSpec // field = ?
-		Add   []*FieldSpec // field = field + ?
-		Clear []*FieldSpec // field = NULL
+		Set    []*FieldSpec // field = ?
+		Add    []*FieldSpec // field = field + ?
+		Clear  []*FieldSpec // field = NULL
+		Max    []*FieldSpec // field = ? if ? > field
+		And    []*FieldSpec // field = field & ?
+		Or     []*FieldSpec // field = field | ?
+		Append []*FieldSpec // field = field || ?
 	}
 
 	// UpdateSpec holds the information for updating one
@@ -409,6 +552,15 @@ type (
 		Edges     EdgeMut
 		Fields    FieldMut
 		Predicate func(*sql.Selector)
+		// Version holds the version column of the node, that is incremented by
+		// each update. If its value is set, UpdateNode updates the node only if
+		// its version is equal to it, and fails with a *ConflictError otherwise.
+		Version *FieldSpec
+		// Conditions holds the expected state of the node (e.g. the values of its fields)
+		// for conditional updates. If they are set, UpdateNode updates the node only if it
+		// matches them, and fails with a *ConflictError otherwise. Unlike Predicate, they
+		// are not used for checking the existence of the node.
+		Conditions func(*sql.Selector)
 
 		ScanValues func(columns []string) ([]interface{}, error)
 		Assign     func(columns []string, values []interface{}) error
@@ -447,6 +599,28 @@ func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("record with id %v not found in table %s", e.id, e.table)
 }
 
+// ConflictError returns when trying to update an entity and its version
+// in the database was already changed, or it does not match the conditions
+// of the update.
+type ConflictError struct {
+	table   string
+	id      driver.Value
+	version driver.Value
+}
+
+func (e *ConflictError) Error() string {
+	if e.version == nil {
+		return fmt.Sprintf("record with id %v does not match the update conditions in table %s", e.id, e.table)
+	}
+	return fmt.Sprintf("record with id %v and version %v was changed in table %s", e.id, e.version, e.table)
+}
+
+// Version returns the version that was expected for the record, or
+// nil if the record does not match the conditions of the update.
+func (e *ConflictError) Version() driver.Value {
+	return e.version
+}
+
 // DeleteSpec holds the information for delete one
 // or more nodes in the graph.
 type DeleteSpec struct {
@@ -466,7 +640,11 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	if pred := spec.Predicate; pred != nil {
 		pred(selector)
 	}
-	query, args := builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).FromSelect(selector).Query()
+	stmt := builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).FromSelect(selector)
+	if err := stmt.Err(); err != nil {
+		return 0, err
+	}
+	query, args := stmt.Query()
 	if err := drv.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
 	}
@@ -477,6 +655,107 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	return int(affected), nil
 }
 
+// TruncateSpec holds the information for truncating tables.
+type TruncateSpec struct {
+	// Tables holds the tables to truncate. In SQLite, the rows of the tables are
+	// deleted by their order, and therefore, dependent tables should come first.
+	Tables []string
+	// Cascade indicates that the tables that reference the truncated tables are truncated
+	// as well. PostgreSQL truncates them using the CASCADE option, and in MySQL and SQLite,
+	// they should be listed in Tables (e.g. using schema.Dependents).
+	Cascade bool
+}
+
+// Truncate applies the TruncateSpec on the graph. It removes all rows from the tables, and resets their
+// identity (auto-increment) sequences. MySQL and PostgreSQL execute TRUNCATE TABLE statements, and SQLite,
+// that does not support them, deletes the rows and resets the sequences of the tables in sqlite_sequence.
+//
+// Unless Cascade is set, MySQL and PostgreSQL fail to truncate tables that are referenced by foreign-keys
+// of other tables. Note that TRUNCATE causes an implicit commit in MySQL, and therefore, it cannot be
+// rolled back, even if it was executed in a transaction.
+func Truncate(ctx context.Context, drv dialect.Driver, spec *TruncateSpec) error {
+	if len(spec.Tables) == 0 {
+		return nil
+	}
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := truncate(ctx, tx, drv.Dialect(), spec); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// truncate truncates the tables of the spec using the given transaction.
+func truncate(ctx context.Context, tx dialect.ExecQuerier, d string, spec *TruncateSpec) error {
+	var (
+		builder = sql.Dialect(d)
+		exec    = func(stmt sql.Querier) error {
+			query, args := stmt.Query()
+			if err, ok := stmt.(interface{ Err() error }); ok && err.Err() != nil {
+				return err.Err()
+			}
+			return tx.Exec(ctx, query, args, nil)
+		}
+	)
+	switch d {
+	case dialect.Postgres:
+		stmt := builder.Truncate(spec.Tables...).RestartIdentity()
+		if spec.Cascade {
+			stmt.Cascade()
+		}
+		return exec(stmt)
+	case dialect.MySQL:
+		if spec.Cascade {
+			if err := tx.Exec(ctx, "SET FOREIGN_KEY_CHECKS = 0", []interface{}{}, nil); err != nil {
+				return err
+			}
+		}
+		var err error
+		for _, t := range spec.Tables {
+			if err = exec(builder.Truncate(t)); err != nil {
+				break
+			}
+		}
+		// Foreign-key checks are enabled on failures as well, as
+		// the session is reused by the other users of the connection.
+		if spec.Cascade {
+			if cerr := tx.Exec(ctx, "SET FOREIGN_KEY_CHECKS = 1", []interface{}{}, nil); err == nil {
+				err = cerr
+			}
+		}
+		return err
+	case dialect.SQLite:
+		for _, t := range spec.Tables {
+			if err := exec(builder.Delete(t)); err != nil {
+				return err
+			}
+		}
+		// The sqlite_sequence table exists only if a table with
+		// an AUTOINCREMENT column was created in the database.
+		rows := &sql.Rows{}
+		query, args := builder.Select().Count().
+			From(sql.Table("sqlite_master")).
+			Where(sql.And(sql.EQ("type", "table"), sql.EQ("name", "sqlite_sequence"))).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+		n, err := sql.ScanInt(rows)
+		if err != nil || n == 0 {
+			return err
+		}
+		names := make([]interface{}, len(spec.Tables))
+		for i := range spec.Tables {
+			names[i] = spec.Tables[i]
+		}
+		return exec(builder.Delete("sqlite_sequence").Where(sql.In("name", names...)))
+	default:
+		return fmt.Errorf("sqlgraph: truncate is not supported by dialect %q", d)
+	}
+}
+
 // QuerySpec holds the information for querying
 // nodes in the graph.
 type QuerySpec struct {
@@ -501,6 +780,58 @@ func QueryNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) error
 	return qr.nodes(ctx, drv)
 }
 
+// IterateNodes queries the nodes in the graph query, and returns an iterator that scans
+// them one at a time to the given values. Callers must close the returned iterator.
+func IterateNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) (*NodeIterator, error) {
+	builder := sql.Dialect(drv.Dialect())
+	qr := &query{graph: graph{builder: builder}, QuerySpec: spec}
+	return qr.iterate(ctx, drv)
+}
+
+// NodeIterator iterates over the rows of a graph query, and scans
+// them using the ScanValues and Assign functions of its spec.
+type NodeIterator struct {
+	spec    *QuerySpec
+	rows    *sql.Rows
+	columns []string
+	err     error
+}
+
+// Next scans the next node, and reports if there was one. It returns false when the rows
+// are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *NodeIterator) Next() bool {
+	if it.err != nil || !it.rows.Next() {
+		return false
+	}
+	values, err := it.spec.ScanValues(it.columns)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	if err := it.rows.Scan(values...); err != nil {
+		it.err = err
+		return false
+	}
+	if err := it.spec.Assign(it.columns, values); err != nil {
+		it.err = err
+		return false
+	}
+	return true
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *NodeIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.rows.Err()
+}
+
+// Close closes the underlying rows of the iterator.
+func (it *NodeIterator) Close() error {
+	return it.rows.Close()
+}
+
 // CountNodes counts the nodes in the given graph query.
 func CountNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) (int, error) {
 	builder := sql.Dialect(drv.Dialect())
@@ -508,6 +839,17 @@ func CountNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) (int,
 	return qr.count(ctx, drv)
 }
 
+// EstimateNodes returns an estimation of the number of nodes matching the given query spec, based on
+// the statistics of the database, and it is useful for tables that are too large to be counted.
+// In PostgreSQL, unfiltered queries use the pg_class.reltuples statistic, and other queries use the
+// row estimation of the query planner (EXPLAIN). In MySQL, the row estimation of the query planner is
+// used. Other dialects, and tables without statistics, fall back to an exact count.
+func EstimateNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) (int, error) {
+	builder := sql.Dialect(drv.Dialect())
+	qr := &query{graph: graph{builder: builder}, QuerySpec: spec}
+	return qr.estimate(ctx, drv)
+}
+
 // EdgeQuerySpec holds the information for querying
 // edges in the graph.
 type EdgeQuerySpec struct {
@@ -556,33 +898,32 @@ type query struct {
 }
 
 func (q *query) nodes(ctx context.Context, drv dialect.Driver) error {
+	it, err := q.iterate(ctx, drv)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for it.Next() {
+	}
+	return it.Err()
+}
+
+func (q *query) iterate(ctx context.Context, drv dialect.Driver) (*NodeIterator, error) {
 	rows := &sql.Rows{}
 	selector, err := q.selector(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := drv.Query(ctx, query, args, rows); err != nil {
-		return err
+		return nil, err
 	}
-	defer rows.Close()
 	columns, err := rows.Columns()
 	if err != nil {
-		return err
-	}
-	for rows.Next() {
-		values, err := q.ScanValues(columns)
-		if err != nil {
-			return err
-		}
-		if err := rows.Scan(values...); err != nil {
-			return err
-		}
-		if err := q.Assign(columns, values); err != nil {
-			return err
-		}
+		rows.Close()
+		return nil, err
 	}
-	return rows.Err()
+	return &NodeIterator{spec: q.QuerySpec, rows: rows, columns: columns}, nil
 }
 
 func (q *query) count(ctx context.Context, drv dialect.Driver) (int, error) {
@@ -614,6 +955,144 @@ func (q *query) count(ctx context.Context, drv dialect.Driver) (int, error) {
 	return sql.ScanInt(rows)
 }
 
+func (q *query) estimate(ctx context.Context, drv dialect.Driver) (int, error) {
+	var (
+		n   = -1
+		err error
+	)
+	switch drv.Dialect() {
+	case dialect.Postgres:
+		if q.From == nil && q.Predicate == nil && q.Limit == 0 && q.Offset == 0 && !q.Unique && len(q.Modifiers) == 0 {
+			n, err = q.pgReltuples(ctx, drv)
+		} else {
+			n, err = q.pgExplain(ctx, drv)
+		}
+	case dialect.MySQL:
+		n, err = q.mysqlExplain(ctx, drv)
+	}
+	if err != nil {
+		return 0, err
+	}
+	// Fall back to an exact count in case there
+	// are no statistics available for the table.
+	if n < 0 {
+		return q.count(ctx, drv)
+	}
+	return n, nil
+}
+
+// pgReltuples returns the number of rows in the table as recorded by the last VACUUM or ANALYZE,
+// or -1 in case the table was not analyzed yet.
+func (q *query) pgReltuples(ctx context.Context, drv dialect.Driver) (int, error) {
+	table := q.Node.Table
+	if q.Node.Schema != "" {
+		table = q.Node.Schema + "." + table
+	}
+	rows := &sql.Rows{}
+	query, args := q.builder.SelectExpr(sql.Raw("reltuples::bigint")).
+		From(sql.Table("pg_class").Unquote()).
+		Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("oid = to_regclass(").Arg(table).WriteString(")")
+		})).
+		Query()
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return -1, rows.Err()
+	}
+	var n int
+	if err := rows.Scan(&n); err != nil {
+		return 0, err
+	}
+	return n, rows.Close()
+}
+
+// pgExplain returns the number of rows estimated by the PostgreSQL planner.
+func (q *query) pgExplain(ctx context.Context, drv dialect.Driver) (int, error) {
+	selector, err := q.selector(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := drv.Query(ctx, "EXPLAIN (FORMAT JSON) "+query, args, rows); err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	var plan []byte
+	if !rows.Next() {
+		return -1, rows.Err()
+	}
+	if err := rows.Scan(&plan); err != nil {
+		return 0, err
+	}
+	var plans []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		}
+	}
+	if err := json.Unmarshal(plan, &plans); err != nil {
+		return 0, fmt.Errorf("sqlgraph: decoding query plan: %w", err)
+	}
+	if len(plans) == 0 {
+		return -1, nil
+	}
+	return int(plans[0].Plan.Rows), rows.Close()
+}
+
+// mysqlExplain returns the number of rows estimated by the MySQL optimizer for the first table
+// of the query plan, which is the table selected by the query in the common case.
+func (q *query) mysqlExplain(ctx context.Context, drv dialect.Driver) (int, error) {
+	selector, err := q.selector(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := drv.Query(ctx, "EXPLAIN "+query, args, rows); err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	if !rows.Next() {
+		return -1, rows.Err()
+	}
+	var (
+		values         = make([]sql.NullString, len(columns))
+		dest           = make([]interface{}, len(columns))
+		rowsN, percent = -1.0, 100.0
+	)
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, err
+	}
+	for i, c := range columns {
+		if !values[i].Valid {
+			continue
+		}
+		switch c {
+		case "rows":
+			rowsN, err = strconv.ParseFloat(values[i].String, 64)
+		case "filtered":
+			percent, err = strconv.ParseFloat(values[i].String, 64)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("sqlgraph: parsing %q column of query plan: %w", c, err)
+		}
+	}
+	if rowsN < 0 {
+		return -1, nil
+	}
+	return int(rowsN * percent / 100), rows.Close()
+}
+
 func (q *query) selector(ctx context.Context) (*sql.Selector, error) {
 	selector := q.builder.
 		Select().
@@ -678,14 +1157,23 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 		return fmt.Errorf("sql/sqlgraph: missing node id for update table %q", u.Node.Table)
 	}
 	update := u.builder.Update(u.Node.Table).Schema(u.Node.Schema).Where(idp)
-	if pred := u.Predicate; pred != nil {
+	if u.Predicate != nil || u.Conditions != nil {
 		selector := u.builder.Select().From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema))
-		pred(selector)
+		if pred := u.Predicate; pred != nil {
+			pred(selector)
+		}
+		if cond := u.Conditions; cond != nil {
+			cond(selector)
+		}
 		update.FromSelect(selector)
 	}
 	if err := u.setTableColumns(update, addEdges, clearEdges); err != nil {
 		return err
 	}
+	version := u.Version
+	if version != nil && version.Value != nil {
+		update.Where(sql.EQ(version.Column, version.Value))
+	}
 	if !update.Empty() {
 		var res sql.Result
 		query, args := update.Query()
@@ -697,11 +1185,24 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 			return err
 		}
 		// In case there are zero affected rows by this statement, we need to distinguish
-		// between the case of "record was not found" and "record was not changed".
-		if affected == 0 && u.Predicate != nil {
-			if err := u.ensureExists(ctx); err != nil {
+		// between the case of "record was not found" and "record was not changed". Note
+		// that versioned records are always changed, as their version is incremented.
+		if affected == 0 && (u.Predicate != nil || u.Conditions != nil || version != nil) {
+			if err := u.ensureExists(ctx, idp); err != nil {
 				return err
 			}
+			if u.Conditions != nil {
+				matched, err := u.exists(ctx, idp, u.Predicate, u.Conditions)
+				if err != nil {
+					return err
+				}
+				if !matched {
+					return &ConflictError{table: u.Node.Table, id: u.nodeID()}
+				}
+			}
+			if version != nil {
+				return &ConflictError{table: u.Node.Table, id: u.nodeID(), version: version.Value}
+			}
 		}
 	}
 	if id != nil {
@@ -747,9 +1248,16 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 	}
 	// In case of single statement update, avoid opening a transaction manually.
 	if !multiple {
-		update.FromSelect(selector)
+		if err := update.FromSelect(selector).Err(); err != nil {
+			return 0, err
+		}
 		return u.updateTable(ctx, update)
 	}
+	// Predicates that join other tables (see JoinTable) may
+	// match the same node more than once.
+	if selector.HasJoins() {
+		selector.Select(selector.C(u.Node.ID.Column)).Distinct()
+	}
 	tx, err := drv.Tx(ctx)
 	if err != nil {
 		return 0, err
@@ -858,12 +1366,88 @@ func (u *updater) setTableColumns(update *sql.UpdateBuilder, addEdges, clearEdge
 	if err != nil {
 		return err
 	}
-	for _, fi := range u.Fields.Add {
-		update.Add(fi.Column, fi.Value)
+	setFieldOps(update, u.Node.Table, u.Fields)
+	if v := u.Version; v != nil {
+		update.Add(v.Column, 1)
 	}
 	return nil
 }
 
+// setFieldOps sets the columns that are updated by in-database operations (e.g. field = field + ?).
+// Operations on the same column are combined into one expression, and applied in the following
+// order: add, bitwise and, bitwise or, set-if-greater and append.
+func setFieldOps(update *sql.UpdateBuilder, table string, fields FieldMut) {
+	if len(fields.Max) == 0 && len(fields.And) == 0 && len(fields.Or) == 0 && len(fields.Append) == 0 {
+		// Keep the simple form for the common case.
+		for _, fi := range fields.Add {
+			update.Add(fi.Column, fi.Value)
+		}
+		return
+	}
+	var (
+		columns []string
+		exprs   = make(map[string]func(*sql.Builder))
+	)
+	// apply applies the given operation on the expressions of the columns.
+	// Numeric operations are applied on the column value, or on zero if it
+	// is NULL, in case it is the first operation on this column.
+	apply := func(specs []*FieldSpec, numeric bool, op func(*sql.Builder, func(*sql.Builder), driver.Value)) {
+		for _, fi := range specs {
+			column, v := fi.Column, fi.Value
+			x, ok := exprs[column]
+			switch {
+			case ok:
+			case numeric:
+				columns = append(columns, column)
+				x = func(b *sql.Builder) {
+					b.WriteString("COALESCE").Nested(func(b *sql.Builder) {
+						b.Ident(sql.Table(table).C(column)).Comma().WriteString("0")
+					})
+				}
+			default:
+				columns = append(columns, column)
+				x = func(b *sql.Builder) {
+					b.Ident(sql.Table(table).C(column))
+				}
+			}
+			exprs[column] = func(b *sql.Builder) {
+				op(b, x, v)
+			}
+		}
+	}
+	apply(fields.Add, true, func(b *sql.Builder, x func(*sql.Builder), v driver.Value) {
+		x(b)
+		b.WriteString(" + ").Arg(v)
+	})
+	apply(fields.And, true, func(b *sql.Builder, x func(*sql.Builder), v driver.Value) {
+		b.Nested(func(b *sql.Builder) {
+			x(b)
+			b.WriteString(" & ").Arg(v)
+		})
+	})
+	apply(fields.Or, true, func(b *sql.Builder, x func(*sql.Builder), v driver.Value) {
+		b.Nested(func(b *sql.Builder) {
+			x(b)
+			b.WriteString(" | ").Arg(v)
+		})
+	})
+	apply(fields.Max, false, func(b *sql.Builder, x func(*sql.Builder), v driver.Value) {
+		b.WriteString("CASE WHEN ")
+		x(b)
+		b.WriteString(" IS NULL OR ")
+		x(b)
+		b.WriteString(" < ").Arg(v).WriteString(" THEN ").Arg(v).WriteString(" ELSE ")
+		x(b)
+		b.WriteString(" END")
+	})
+	apply(fields.Append, false, func(b *sql.Builder, x func(*sql.Builder), v driver.Value) {
+		b.Join(sqljson.Append(sql.ExprFunc(x), v))
+	})
+	for _, c := range columns {
+		update.Set(c, sql.ExprFunc(exprs[c]))
+	}
+}
+
 func (u *updater) scan(rows *sql.Rows) error {
 	defer rows.Close()
 	columns, err := rows.Columns()
@@ -874,10 +1458,7 @@ func (u *updater) scan(rows *sql.Rows) error {
 		if err := rows.Err(); err != nil {
 			return err
 		}
-		if len(u.Node.CompositeID) == 2 {
-			return &NotFoundError{table: u.Node.Table, id: []driver.Value{u.Node.CompositeID[0].Value, u.Node.CompositeID[1].Value}}
-		}
-		return &NotFoundError{table: u.Node.Table, id: u.Node.ID.Value}
+		return &NotFoundError{table: u.Node.Table, id: u.nodeID()}
 	}
 	values, err := u.ScanValues(columns)
 	if err != nil {
@@ -892,25 +1473,42 @@ func (u *updater) scan(rows *sql.Rows) error {
 	return nil
 }
 
-func (u *updater) ensureExists(ctx context.Context) error {
-	exists := u.builder.Select().From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).Where(sql.EQ(u.Node.ID.Column, u.Node.ID.Value))
-	u.Predicate(exists)
-	query, args := u.builder.SelectExpr(sql.Exists(exists)).Query()
-	rows := &sql.Rows{}
-	if err := u.tx.Query(ctx, query, args, rows); err != nil {
-		return err
+// nodeID returns the id of the updated node, or its composite id.
+func (u *updater) nodeID() driver.Value {
+	if len(u.Node.CompositeID) == 2 {
+		return []driver.Value{u.Node.CompositeID[0].Value, u.Node.CompositeID[1].Value}
 	}
-	defer rows.Close()
-	found, err := sql.ScanBool(rows)
+	return u.Node.ID.Value
+}
+
+func (u *updater) ensureExists(ctx context.Context, idp *sql.Predicate) error {
+	found, err := u.exists(ctx, idp, u.Predicate)
 	if err != nil {
 		return err
 	}
 	if !found {
-		return &NotFoundError{table: u.Node.Table, id: u.Node.ID.Value}
+		return &NotFoundError{table: u.Node.Table, id: u.nodeID()}
 	}
 	return nil
 }
 
+// exists reports if the node exists and matches all given (non-nil) predicates.
+func (u *updater) exists(ctx context.Context, idp *sql.Predicate, preds ...func(*sql.Selector)) (bool, error) {
+	exists := u.builder.Select().From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).Where(idp)
+	for _, p := range preds {
+		if p != nil {
+			p(exists)
+		}
+	}
+	query, args := u.builder.SelectExpr(sql.Exists(exists)).Query()
+	rows := &sql.Rows{}
+	if err := u.tx.Query(ctx, query, args, rows); err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	return sql.ScanBool(rows)
+}
+
 type creator struct {
 	graph
 	*CreateSpec
@@ -976,14 +1574,14 @@ func (c *creator) insert(ctx context.Context, insert *sql.InsertBuilder) error {
 	// If the id field was provided by the user.
 	if c.ID.Value != nil {
 		insert.Set(c.ID.Column, c.ID.Value)
-		// In case of "ON CONFLICT", the record may exist in the
-		// database, and we need to get back the database id field.
-		if len(c.CreateSpec.OnConflict) == 0 {
+		// In case of "ON CONFLICT", the record may exist in the database, and we need
+		// to get back the database id field. The same goes for the returning columns.
+		if len(c.CreateSpec.OnConflict) == 0 && (len(c.Returning) == 0 || insert.Dialect() == dialect.MySQL) {
 			query, args := insert.Query()
 			return c.tx.Exec(ctx, query, args, nil)
 		}
 	}
-	return c.insertLastID(ctx, insert.Returning(c.ID.Column))
+	return c.insertLastID(ctx, insert.Returning(append([]string{c.ID.Column}, c.Returning...)...))
 }
 
 // ensureConflict ensures the ON CONFLICT is added to the insert statement.
@@ -1053,16 +1651,23 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 			}
 		}
 	}
-	sorted := keys(columns)
-	insert := c.builder.Insert(c.Nodes[0].Table).Schema(c.Nodes[0].Schema).Default().Columns(sorted...)
-	for i := range values {
-		vs := make([]interface{}, len(sorted))
-		for j, c := range sorted {
-			vs[j] = values[i][c]
+	var (
+		sorted    = keys(columns)
+		returning = c.returning()
+		chunks    = c.chunks(drv.Dialect(), len(sorted))
+		inserts   = make([]*sql.InsertBuilder, len(chunks))
+	)
+	for i, chunk := range chunks {
+		inserts[i] = c.builder.Insert(c.Nodes[0].Table).Schema(c.Nodes[0].Schema).Default().Columns(sorted...)
+		for j := chunk[0]; j < chunk[1]; j++ {
+			vs := make([]interface{}, len(sorted))
+			for k, c := range sorted {
+				vs[k] = values[j][c]
+			}
+			inserts[i].Values(vs...)
 		}
-		insert.Values(vs...)
 	}
-	tx, err := c.mayTx(ctx, drv)
+	tx, err := c.mayTx(ctx, drv, len(chunks) > 1)
 	if err != nil {
 		return err
 	}
@@ -1071,12 +1676,19 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 		// In case the spec does not contain an ID field, we assume
 		// we interact with an edge-schema with composite primary key.
 		if c.Nodes[0].ID == nil {
-			c.ensureConflict(insert)
-			query, args := insert.Query()
-			return tx.Exec(ctx, query, args, nil)
+			for _, insert := range inserts {
+				c.ensureConflict(insert)
+				query, args := insert.Query()
+				if err := tx.Exec(ctx, query, args, nil); err != nil {
+					return err
+				}
+			}
+			return nil
 		}
-		if err := c.batchInsert(ctx, tx, insert); err != nil {
-			return fmt.Errorf("insert nodes to table %q: %w", c.Nodes[0].Table, err)
+		for i, insert := range inserts {
+			if err := c.batchInsert(ctx, tx, insert, c.Nodes[chunks[i][0]:chunks[i][1]], returning); err != nil {
+				return fmt.Errorf("insert nodes to table %q: %w", c.Nodes[0].Table, err)
+			}
 		}
 		if err := c.batchAddM2M(ctx, c.BatchCreateSpec); err != nil {
 			return err
@@ -1097,7 +1709,10 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 }
 
 // mayTx opens a new transaction if the create operation spans across multiple statements.
-func (c *batchCreator) mayTx(ctx context.Context, drv dialect.Driver) (dialect.Tx, error) {
+func (c *batchCreator) mayTx(ctx context.Context, drv dialect.Driver, chunked bool) (dialect.Tx, error) {
+	if chunked {
+		return drv.Tx(ctx)
+	}
 	for _, node := range c.Nodes {
 		for _, edge := range node.Edges {
 			if isExternalEdge(edge) {
@@ -1109,9 +1724,45 @@ func (c *batchCreator) mayTx(ctx context.Context, drv dialect.Driver) (dialect.T
 }
 
 // batchInsert inserts a batch of nodes to their table and sets their ID if it was not provided by the user.
-func (c *batchCreator) batchInsert(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
+func (c *batchCreator) batchInsert(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder, nodes []*CreateSpec, returning []string) error {
 	c.ensureConflict(insert)
-	return c.insertLastIDs(ctx, tx, insert.Returning(c.Nodes[0].ID.Column))
+	return c.insertLastIDs(ctx, tx, insert.Returning(append([]string{c.Nodes[0].ID.Column}, returning...)...), nodes, returning)
+}
+
+// returning returns the union of the returning columns of the nodes.
+func (c *batchCreator) returning() []string {
+	columns := make(map[string]struct{})
+	for _, node := range c.Nodes {
+		for _, column := range node.Returning {
+			columns[column] = struct{}{}
+		}
+	}
+	return keys(columns)
+}
+
+// chunks splits the nodes to ranges ([start, end)) of nodes that can be inserted
+// in one statement, without exceeding the maximum number of arguments.
+func (c *batchCreator) chunks(name string, columns int) [][2]int {
+	limit := c.MaxParams
+	if limit <= 0 {
+		limit = MaxParams[name]
+	}
+	size := len(c.Nodes)
+	if limit > 0 && columns > 0 {
+		size = limit / columns
+		if size == 0 {
+			size = 1
+		}
+	}
+	chunks := make([][2]int, 0, (len(c.Nodes)+size-1)/size)
+	for i := 0; i < len(c.Nodes); i += size {
+		end := i + size
+		if end > len(c.Nodes) {
+			end = len(c.Nodes)
+		}
+		chunks = append(chunks, [2]int{i, end})
+	}
+	return chunks
 }
 
 // ensureConflict ensures the ON CONFLICT is added to the insert statement.
@@ -1407,6 +2058,15 @@ func (c *creator) insertLastID(ctx context.Context, insert *sql.InsertBuilder) e
 			return err
 		}
 		defer rows.Close()
+		if len(c.Returning) > 0 {
+			switch n, err := scanReturning(rows, []*CreateSpec{c.CreateSpec}, c.Returning); {
+			case err != nil:
+				return err
+			case n == 0:
+				return stdsql.ErrNoRows
+			}
+			return nil
+		}
 		switch _, ok := c.ID.Value.(field.ValueScanner); {
 		case ok:
 			// If the ID implements the sql.Scanner
@@ -1442,8 +2102,9 @@ func (c *creator) insertLastID(ctx context.Context, insert *sql.InsertBuilder) e
 	return nil
 }
 
-// insertLastIDs invokes the batch insert query on the transaction and returns the LastInsertID of all entities.
-func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
+// insertLastIDs invokes the batch insert query of the given nodes on the transaction and returns
+// the LastInsertID of all entities, and the values of their returning columns.
+func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder, nodes []*CreateSpec, returning []string) error {
 	query, args := insert.Query()
 	if err := insert.Err(); err != nil {
 		return err
@@ -1455,21 +2116,8 @@ func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier
 			return err
 		}
 		defer rows.Close()
-		for i := 0; rows.Next(); i++ {
-			node := c.Nodes[i]
-			if node.ID.Type.Numeric() {
-				// Normalize the type to int64 to make it looks
-				// like LastInsertId.
-				var id int64
-				if err := rows.Scan(&id); err != nil {
-					return err
-				}
-				node.ID.Value = id
-			} else if err := rows.Scan(&node.ID.Value); err != nil {
-				return err
-			}
-		}
-		return nil
+		_, err := scanReturning(rows, nodes, returning)
+		return err
 	}
 	// MySQL.
 	var res sql.Result
@@ -1478,7 +2126,7 @@ func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier
 	}
 	// If the ID field is not numeric (e.g. string),
 	// there is no way to scan the LAST_INSERT_ID.
-	if len(c.Nodes) > 0 && c.Nodes[0].ID.Type.Numeric() {
+	if len(nodes) > 0 && nodes[0].ID.Type.Numeric() {
 		id, err := res.LastInsertId()
 		if err != nil {
 			return err
@@ -1489,13 +2137,69 @@ func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier
 		}
 		// Assume the ID field is AUTO_INCREMENT
 		// if its type is numeric.
-		for i := 0; int64(i) < affected && i < len(c.Nodes); i++ {
-			c.Nodes[i].ID.Value = id + int64(i)
+		for i := 0; int64(i) < affected && i < len(nodes); i++ {
+			nodes[i].ID.Value = id + int64(i)
 		}
 	}
 	return nil
 }
 
+// scanReturning scans the rows of the RETURNING clause (i.e. the ID column followed by the given
+// columns) to the given nodes, in their order, and returns the number of rows that were scanned.
+func scanReturning(rows *sql.Rows, nodes []*CreateSpec, columns []string) (int, error) {
+	n := 0
+	for ; rows.Next(); n++ {
+		if n == len(nodes) {
+			return n, fmt.Errorf("sql/sqlgraph: unexpected number of returned rows: %d", n+1)
+		}
+		var (
+			id     int64
+			node   = nodes[n]
+			values []interface{}
+		)
+		switch {
+		case len(columns) == 0:
+		case node.ScanValues != nil:
+			vs, err := node.ScanValues(columns)
+			if err != nil {
+				return n, err
+			}
+			values = vs
+		default:
+			// The node did not ask for the returning columns of other nodes.
+			values = make([]interface{}, len(columns))
+			for i := range values {
+				values[i] = new(interface{})
+			}
+		}
+		_, scanner := node.ID.Value.(field.ValueScanner)
+		switch {
+		case scanner:
+			// If the ID implements the sql.Scanner
+			// interface it should be a pointer type.
+			values = append([]interface{}{node.ID.Value}, values...)
+		case node.ID.Type.Numeric():
+			// Normalize the type to int64 to make it
+			// looks like LastInsertId.
+			values = append([]interface{}{&id}, values...)
+		default:
+			values = append([]interface{}{&node.ID.Value}, values...)
+		}
+		if err := rows.Scan(values...); err != nil {
+			return n, err
+		}
+		if !scanner && node.ID.Type.Numeric() {
+			node.ID.Value = id
+		}
+		if len(columns) > 0 && node.Assign != nil {
+			if err := node.Assign(columns, values[1:]); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, rows.Err()
+}
+
 // rollback calls to tx.Rollback and wraps the given error with the rollback error if occurred.
 func rollback(tx dialect.Tx, err error) error {
 	if rerr := tx.Rollback(); rerr != nil {
@@ -635,6 +635,53 @@ func TestHasNeighbors(t *testing.T) {
 	}
 }
 
+func TestCountNeighbors(t *testing.T) {
+	tests := []struct {
+		name     string
+		step     *Step
+		wantExpr string
+	}{
+		{
+			name: "O2M/1type",
+			step: NewStep(
+				From("users", "id"),
+				To("users", "id"),
+				Edge(O2M, false, "users", "parent_id"),
+			),
+			wantExpr: "(SELECT COUNT(*) FROM `users` AS `neighbors` WHERE `neighbors`.`parent_id` = `users`.`id`)",
+		},
+		{
+			name: "M2O/2types",
+			step: NewStep(
+				From("users", "id"),
+				To("groups", "id"),
+				Edge(M2O, true, "users", "group_id"),
+			),
+			wantExpr: "(SELECT COUNT(*) FROM `groups` AS `neighbors` WHERE `neighbors`.`id` = `users`.`group_id`)",
+		},
+		{
+			name: "M2M/2types/inverse",
+			step: NewStep(
+				From("users", "id"),
+				To("groups", "id"),
+				Edge(M2M, true, "group_users", "group_id", "user_id"),
+			),
+			wantExpr: "(SELECT COUNT(*) FROM `group_users` AS `neighbors` WHERE `neighbors`.`user_id` = `users`.`id`)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := sql.Select("*").From(sql.Table("users"))
+			require.Equal(t, tt.wantExpr, CountNeighbors(s, tt.step))
+		})
+	}
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users"))
+	expr := CountNeighbors(s, NewStep(From("users", "id"), To("pets", "id"), Edge(O2M, false, "pets", "owner_id")))
+	require.Equal(t, `(SELECT COUNT(*) FROM "pets" AS "neighbors" WHERE "neighbors"."owner_id" = "users"."id")`, expr)
+	query, _ := s.Select(sql.Sum(expr)).Query()
+	require.Equal(t, `SELECT SUM((SELECT COUNT(*) FROM "pets" AS "neighbors" WHERE "neighbors"."owner_id" = "users"."id")) FROM "users"`, query)
+}
+
 func TestHasNeighborsWith(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1487,6 +1534,53 @@ func TestBatchCreate(t *testing.T) {
 	}
 }
 
+func TestBatchCreate_ChunksReturning(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape(`INSERT INTO "users" ("age", "name") VALUES ($1, $2), ($3, $4) RETURNING "id", "status"`)).
+		WithArgs(32, "a8m", 30, "nati").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(1, "active").AddRow(2, "active"))
+	mock.ExpectQuery(escape(`INSERT INTO "users" ("age", "name") VALUES ($1, $2) RETURNING "id", "status"`)).
+		WithArgs(28, "ariel").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(3, "pending"))
+	mock.ExpectCommit()
+
+	var (
+		status = make([]string, 3)
+		spec   = &BatchCreateSpec{MaxParams: 4}
+	)
+	for i, f := range []struct {
+		age  int
+		name string
+	}{{32, "a8m"}, {30, "nati"}, {28, "ariel"}} {
+		i := i
+		spec.Nodes = append(spec.Nodes, &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{
+				{Column: "age", Type: field.TypeInt, Value: f.age},
+				{Column: "name", Type: field.TypeString, Value: f.name},
+			},
+			Returning: []string{"status"},
+			ScanValues: func(columns []string) ([]interface{}, error) {
+				return []interface{}{new(string)}, nil
+			},
+			Assign: func(columns []string, values []interface{}) error {
+				status[i] = *values[0].(*string)
+				return nil
+			},
+		})
+	}
+	err = BatchCreate(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	for i, id := range []int64{1, 2, 3} {
+		require.Equal(t, id, spec.Nodes[i].ID.Value)
+	}
+	require.Equal(t, []string{"active", "active", "pending"}, status)
+}
+
 type user struct {
 	id    int
 	age   int
@@ -1607,6 +1701,45 @@ func TestUpdateNode(t *testing.T) {
 			},
 			wantUser: &user{age: 31, id: 1},
 		},
+		{
+			name: "fields/ops",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "flags", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Add: []*FieldSpec{
+						{Column: "age", Type: field.TypeInt, Value: 1},
+					},
+					Max: []*FieldSpec{
+						{Column: "age", Type: field.TypeInt, Value: 30},
+					},
+					And: []*FieldSpec{
+						{Column: "flags", Type: field.TypeInt, Value: 6},
+					},
+					Or: []*FieldSpec{
+						{Column: "flags", Type: field.TypeInt, Value: 1},
+					},
+					Append: []*FieldSpec{
+						{Column: "tags", Type: field.TypeJSON, Value: []string{"a"}},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `age` = CASE WHEN COALESCE(`users`.`age`, 0) + ? IS NULL OR COALESCE(`users`.`age`, 0) + ? < ? THEN ? ELSE COALESCE(`users`.`age`, 0) + ? END, `flags` = ((COALESCE(`users`.`flags`, 0) & ?) | ?), `tags` = json_insert(COALESCE(`users`.`tags`, '[]'), '$[#]', json(?)) WHERE `id` = ?")).
+					WithArgs(1, 1, 30, 30, 1, 6, 1, `"a"`, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `flags`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age"}).
+						AddRow(1, 30))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{age: 30, id: 1},
+		},
 		{
 			name: "fields/ensure_exists",
 			spec: &UpdateSpec{
@@ -1644,6 +1777,169 @@ func TestUpdateNode(t *testing.T) {
 			wantErr:  true,
 			wantUser: &user{},
 		},
+		{
+			name: "fields/version",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "name", Type: field.TypeString, Value: "Ariel"},
+					},
+				},
+				Version: &FieldSpec{Column: "version", Type: field.TypeInt64, Value: int64(2)},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `name` = ?, `version` = COALESCE(`users`.`version`, 0) + ? WHERE `id` = ? AND `version` = ?")).
+					WithArgs("Ariel", 1, 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 30, "Ariel"))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{name: "Ariel", age: 30, id: 1},
+		},
+		{
+			name: "fields/version_conflict",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "name", Type: field.TypeString, Value: "Ariel"},
+					},
+				},
+				Version: &FieldSpec{Column: "version", Type: field.TypeInt64, Value: int64(2)},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `name` = ?, `version` = COALESCE(`users`.`version`, 0) + ? WHERE `id` = ? AND `version` = ?")).
+					WithArgs("Ariel", 1, 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(escape("SELECT EXISTS (SELECT * FROM `users` WHERE `id` = ?)")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).
+						AddRow(true))
+				mock.ExpectRollback()
+			},
+			wantErr:  true,
+			wantUser: &user{},
+		},
+		{
+			name: "fields/conditions",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "name", Type: field.TypeString, Value: "Ariel"},
+					},
+				},
+				Conditions: func(s *sql.Selector) {
+					s.Where(sql.EQ("name", "a8m"))
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `name` = ? WHERE `id` = ? AND `name` = ?")).
+					WithArgs("Ariel", 1, "a8m").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 30, "Ariel"))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{name: "Ariel", age: 30, id: 1},
+		},
+		{
+			name: "fields/conditions_unchanged",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "name", Type: field.TypeString, Value: "a8m"},
+					},
+				},
+				Conditions: func(s *sql.Selector) {
+					s.Where(sql.EQ("name", "a8m"))
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `name` = ? WHERE `id` = ? AND `name` = ?")).
+					WithArgs("a8m", 1, "a8m").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(escape("SELECT EXISTS (SELECT * FROM `users` WHERE `id` = ?)")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).
+						AddRow(true))
+				mock.ExpectQuery(escape("SELECT EXISTS (SELECT * FROM `users` WHERE `id` = ? AND `name` = ?)")).
+					WithArgs(1, "a8m").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).
+						AddRow(true))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 30, "a8m"))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{name: "a8m", age: 30, id: 1},
+		},
+		{
+			name: "fields/conditions_conflict",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Predicate: func(s *sql.Selector) {
+					s.Where(sql.EQ("deleted", false))
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "name", Type: field.TypeString, Value: "Ariel"},
+					},
+				},
+				Conditions: func(s *sql.Selector) {
+					s.Where(sql.EQ("name", "a8m"))
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `name` = ? WHERE `id` = ? AND (NOT `deleted` AND `name` = ?)")).
+					WithArgs("Ariel", 1, "a8m").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(escape("SELECT EXISTS (SELECT * FROM `users` WHERE `id` = ? AND NOT `deleted`)")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).
+						AddRow(true))
+				mock.ExpectQuery(escape("SELECT EXISTS (SELECT * FROM `users` WHERE (`id` = ? AND NOT `deleted`) AND `name` = ?)")).
+					WithArgs(1, "a8m").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).
+						AddRow(false))
+				mock.ExpectRollback()
+			},
+			wantErr:  true,
+			wantUser: &user{},
+		},
 		{
 			name: "edges/o2o_non_inverse and m2o",
 			spec: &UpdateSpec{
@@ -1909,6 +2205,29 @@ func TestUpdateNodes(t *testing.T) {
 			},
 			wantAffected: 1,
 		},
+		{
+			name: "with join",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table: "users",
+					ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "active", Type: field.TypeBool, Value: false},
+					},
+				},
+				Predicate: JoinTable("groups", "group_id", "id", func(s *sql.Selector) {
+					s.Where(sql.EQ(s.C("name"), "admins"))
+				}),
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(escape("UPDATE `users` SET `active` = ? FROM `groups` AS `t1` WHERE `users`.`group_id` = `t1`.`id` AND `t1`.`name` = ?")).
+					WithArgs(false, "admins").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			wantAffected: 2,
+		},
 		{
 			name: "own_fks/m2o_o2o_inverse",
 			spec: &UpdateSpec{
@@ -2120,6 +2439,161 @@ func TestDeleteNodesSchema(t *testing.T) {
 	require.Equal(t, 2, affected)
 }
 
+func TestInsertSelect(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectExec(escape("INSERT INTO `mydb`.`backups` (`name`, `age`) SELECT `users`.`name`, `users`.`age` FROM `users` WHERE `age` > ?")).
+		WithArgs(30).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	users := sql.Table("users")
+	spec := &InsertSelectSpec{
+		Node: &NodeSpec{
+			Table:  "backups",
+			Schema: "mydb",
+			ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Columns: []string{"name", "age"},
+		From:    sql.Select(users.C("name"), users.C("age")).From(users).Where(sql.GT("age", 30)),
+	}
+	affected, err := InsertSelect(context.Background(), sql.OpenDB("", db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 2, affected)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	spec.Columns = nil
+	_, err = InsertSelect(context.Background(), sql.OpenDB("", db), spec)
+	require.EqualError(t, err, "sqlgraph: missing columns for inserting into table backups")
+}
+
+func TestDeleteNodesJoin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectExec(escape(`DELETE FROM "users" USING "groups" AS "t1" WHERE "users"."group_id" = "t1"."id" AND "t1"."name" = $1`)).
+		WithArgs("admins").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	affected, err := DeleteNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), &DeleteSpec{
+		Node: &NodeSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Predicate: JoinTable("groups", "group_id", "id", func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C("name"), "admins"))
+		}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, affected)
+
+	_, err = DeleteNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), &DeleteSpec{
+		Node: &NodeSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Predicate: func(s *sql.Selector) {
+			t := sql.Table("groups")
+			s.LeftJoin(t).On(s.C("group_id"), t.C("id"))
+		},
+	})
+	require.EqualError(t, err, "sql: LEFT JOIN is not supported by DELETE and UPDATE statements")
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect string
+		spec    *TruncateSpec
+		expect  func(sqlmock.Sqlmock)
+		wantErr string
+	}{
+		{
+			name:    "postgres",
+			dialect: dialect.Postgres,
+			spec:    &TruncateSpec{Tables: []string{"users", "pets"}, Cascade: true},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape(`TRUNCATE TABLE "users", "pets" RESTART IDENTITY CASCADE`)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectCommit()
+			},
+		},
+		{
+			name:    "mysql",
+			dialect: dialect.MySQL,
+			spec:    &TruncateSpec{Tables: []string{"pets", "users"}, Cascade: true},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("SET FOREIGN_KEY_CHECKS = 0")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec(escape("TRUNCATE TABLE `pets`")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec(escape("TRUNCATE TABLE `users`")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec(escape("SET FOREIGN_KEY_CHECKS = 1")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectCommit()
+			},
+		},
+		{
+			name:    "mysql/error",
+			dialect: dialect.MySQL,
+			spec:    &TruncateSpec{Tables: []string{"users"}, Cascade: true},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("SET FOREIGN_KEY_CHECKS = 0")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec(escape("TRUNCATE TABLE `users`")).
+					WillReturnError(fmt.Errorf("truncate failed"))
+				m.ExpectExec(escape("SET FOREIGN_KEY_CHECKS = 1")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectRollback()
+			},
+			wantErr: "truncate failed",
+		},
+		{
+			name:    "sqlite",
+			dialect: dialect.SQLite,
+			spec:    &TruncateSpec{Tables: []string{"pets", "users"}},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("DELETE FROM `pets`")).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				m.ExpectExec(escape("DELETE FROM `users`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+					WithArgs("table", "sqlite_sequence").
+					WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).AddRow(1))
+				m.ExpectExec(escape("DELETE FROM `sqlite_sequence` WHERE `name` IN (?, ?)")).
+					WithArgs("pets", "users").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				m.ExpectCommit()
+			},
+		},
+		{
+			name:    "unsupported",
+			dialect: dialect.Gremlin,
+			spec:    &TruncateSpec{Tables: []string{"users"}},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectRollback()
+			},
+			wantErr: `sqlgraph: truncate is not supported by dialect "gremlin"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.expect(mock)
+			err = Truncate(context.Background(), sql.OpenDB(tt.dialect, db), tt.spec)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestQueryNodes(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2189,6 +2663,110 @@ func TestQueryNodes(t *testing.T) {
 	require.Equal(t, 3, n)
 }
 
+func TestIterateNodes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape("SELECT `users`.`id`, `users`.`age`, `users`.`name` FROM `users` WHERE `age` < ?")).
+		WithArgs(40).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+			AddRow(1, 10, "a8m").
+			AddRow(2, 20, "nati").
+			AddRow(3, "invalid", "").
+			AddRow(4, 40, "")).
+		RowsWillBeClosed()
+
+	var (
+		users []*user
+		spec  = &QuerySpec{
+			Node: &NodeSpec{
+				Table:   "users",
+				Columns: []string{"id", "age", "name"},
+				ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.LT("age", 40))
+			},
+			ScanValues: func(columns []string) ([]interface{}, error) {
+				u := &user{}
+				users = append(users, u)
+				return u.values(columns)
+			},
+			Assign: func(columns []string, values []interface{}) error {
+				return users[len(users)-1].assign(columns, values)
+			},
+		}
+	)
+	it, err := IterateNodes(context.Background(), sql.OpenDB("", db), spec)
+	require.NoError(t, err)
+	require.True(t, it.Next())
+	require.Equal(t, &user{id: 1, age: 10, name: "a8m"}, users[0])
+	require.Len(t, users, 1, "nodes are scanned one at a time")
+	require.True(t, it.Next())
+	require.Equal(t, &user{id: 2, age: 20, name: "nati"}, users[1])
+	require.False(t, it.Next())
+	require.Error(t, it.Err())
+	require.False(t, it.Next(), "iteration stops after an error")
+	require.NoError(t, it.Close())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEstimateNodes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	spec := &QuerySpec{
+		Node: &NodeSpec{
+			Table:  "users",
+			Schema: "public",
+			ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+	}
+	// Unfiltered queries use the table statistics.
+	mock.ExpectQuery(escape(`SELECT reltuples::bigint FROM pg_class WHERE oid = to_regclass($1)`)).
+		WithArgs("public.users").
+		WillReturnRows(sqlmock.NewRows([]string{"reltuples"}).AddRow(1000))
+	n, err := EstimateNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 1000, n)
+
+	// Fall back to count for tables that were not analyzed.
+	mock.ExpectQuery(escape(`SELECT reltuples::bigint FROM pg_class WHERE oid = to_regclass($1)`)).
+		WithArgs("public.users").
+		WillReturnRows(sqlmock.NewRows([]string{"reltuples"}).AddRow(-1))
+	mock.ExpectQuery(escape(`SELECT COUNT("public"."users"."id") FROM "public"."users"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).AddRow(10))
+	n, err = EstimateNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+
+	// Filtered queries use the query planner.
+	spec.Node.Schema = ""
+	spec.Predicate = func(s *sql.Selector) {
+		s.Where(sql.LT("age", 40))
+	}
+	mock.ExpectQuery(escape(`EXPLAIN (FORMAT JSON) SELECT * FROM "users" WHERE "age" < $1`)).
+		WithArgs(40).
+		WillReturnRows(sqlmock.NewRows([]string{"QUERY PLAN"}).AddRow(`[{"Plan": {"Node Type": "Seq Scan", "Plan Rows": 420}}]`))
+	n, err = EstimateNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 420, n)
+
+	mock.ExpectQuery(escape("EXPLAIN SELECT * FROM `users` WHERE `age` < ?")).
+		WithArgs(40).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "table", "rows", "filtered"}).AddRow(1, "users", 1000, 33.3))
+	n, err = EstimateNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 333, n)
+
+	// Other dialects use an exact count.
+	mock.ExpectQuery(escape("SELECT COUNT(`users`.`id`) FROM `users` WHERE `age` < ?")).
+		WithArgs(40).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).AddRow(5))
+	n, err = EstimateNodes(context.Background(), sql.OpenDB(dialect.SQLite, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestQueryNodesSchema(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -0,0 +1,185 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlidem provides a store for idempotency keys that is kept in an SQL table,
+// and is used by ent for replaying the results of mutations that were already executed.
+package sqlidem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DefaultTable is the default name of the idempotency keys table.
+const DefaultTable = "ent_idempotency_keys"
+
+// ErrInProgress is returned by Claim when the key was claimed by
+// an operation that has not completed yet.
+var ErrInProgress = errors.New("sqlidem: operation with the same idempotency key is in progress")
+
+// Store records idempotency keys and the results of their operations in an SQL table.
+// Keys are scoped (for example, by entity type), and expire after the TTL of the store.
+type Store struct {
+	table string
+	ttl   time.Duration
+	now   func() time.Time
+}
+
+// Option allows configuring the Store using functional options.
+type Option func(*Store)
+
+// WithTable sets the name of the keys table. The default is DefaultTable.
+func WithTable(name string) Option {
+	return func(s *Store) {
+		s.table = name
+	}
+}
+
+// WithTTL sets the time keys are kept in the store. Operations executed with an expired
+// key are executed again. The default is 24 hours.
+func WithTTL(d time.Duration) Option {
+	return func(s *Store) {
+		s.ttl = d
+	}
+}
+
+// WithClock sets the clock of the store, that is used for recording keys and expiring
+// them. It is useful for freezing the time in tests. The default is time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// New returns a new Store configured with the given options.
+func New(opts ...Option) *Store {
+	s := &Store{table: DefaultTable, ttl: 24 * time.Hour, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Create creates the keys table if it does not exist.
+func (s *Store) Create(ctx context.Context, drv dialect.Driver) error {
+	text := "text"
+	switch drv.Dialect() {
+	case dialect.SQLite, dialect.Postgres:
+	case dialect.MySQL:
+		text = "longtext"
+	default:
+		return fmt.Errorf("sqlidem: unsupported dialect %q", drv.Dialect())
+	}
+	query, args := sql.Dialect(drv.Dialect()).
+		CreateTable(s.table).
+		IfNotExists().
+		Column(sql.Column("scope").Type("varchar(255)").Attr("NOT NULL")).
+		Column(sql.Column("idem_key").Type("varchar(255)").Attr("NOT NULL")).
+		Column(sql.Column("result").Type(text).Attr("NULL")).
+		Column(sql.Column("created_at").Type("bigint").Attr("NOT NULL")).
+		PrimaryKey("scope", "idem_key").
+		Query()
+	return drv.Exec(ctx, query, args, nil)
+}
+
+// Claim claims the given key for executing its operation. If the key was claimed, the caller should
+// execute the operation and record its result using Complete (preferably in the same transaction).
+// If the key was already completed, its result is returned with claimed set to false. ErrInProgress
+// is returned if the key was claimed by an operation that has not completed yet.
+func (s *Store) Claim(ctx context.Context, drv dialect.Driver, scope, key string) (result string, claimed bool, err error) {
+	now := s.now().UnixMilli()
+	b := sql.Dialect(drv.Dialect())
+	insert := b.Insert(s.table).
+		Columns("scope", "idem_key", "created_at").
+		Values(scope, key, now)
+	if drv.Dialect() == dialect.MySQL {
+		insert.OnConflict(sql.ResolveWith(func(u *sql.UpdateSet) {
+			u.SetIgnore("scope")
+		}))
+	} else {
+		insert.OnConflict(sql.ConflictColumns("scope", "idem_key"), sql.DoNothing())
+	}
+	if ok, err := exec(ctx, drv, insert); err != nil || ok {
+		return "", ok, err
+	}
+	query, args := b.Select("result", "created_at").
+		From(b.Table(s.table)).
+		Where(sql.And(sql.EQ("scope", scope), sql.EQ("idem_key", key))).
+		Query()
+	rows := &sql.Rows{}
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return "", false, err
+	}
+	var (
+		res       sql.NullString
+		createdAt int64
+	)
+	if !rows.Next() {
+		rows.Close()
+		return "", false, fmt.Errorf("sqlidem: key %q was not found", key)
+	}
+	if err := rows.Scan(&res, &createdAt); err != nil {
+		rows.Close()
+		return "", false, err
+	}
+	if err := rows.Close(); err != nil {
+		return "", false, err
+	}
+	if createdAt+s.ttl.Milliseconds() >= now {
+		if !res.Valid {
+			return "", false, ErrInProgress
+		}
+		return res.String, false, nil
+	}
+	// The key has expired, and it is claimed again by resetting it.
+	// Concurrent claims are resolved by the created_at condition.
+	ok, err := exec(ctx, drv, b.Update(s.table).
+		Set("created_at", now).
+		SetNull("result").
+		Where(sql.And(sql.EQ("scope", scope), sql.EQ("idem_key", key), sql.EQ("created_at", createdAt))),
+	)
+	if err == nil && !ok {
+		err = ErrInProgress
+	}
+	return "", ok, err
+}
+
+// Complete records the result of the operation of a claimed key.
+func (s *Store) Complete(ctx context.Context, drv dialect.Driver, scope, key, result string) error {
+	_, err := exec(ctx, drv, sql.Dialect(drv.Dialect()).
+		Update(s.table).
+		Set("result", result).
+		Where(sql.And(sql.EQ("scope", scope), sql.EQ("idem_key", key))),
+	)
+	return err
+}
+
+// Release removes a claimed key, in case its operation failed
+// and was not executed in the same transaction as the claim.
+func (s *Store) Release(ctx context.Context, drv dialect.Driver, scope, key string) error {
+	_, err := exec(ctx, drv, sql.Dialect(drv.Dialect()).
+		Delete(s.table).
+		Where(sql.And(sql.EQ("scope", scope), sql.EQ("idem_key", key), sql.IsNull("result"))),
+	)
+	return err
+}
+
+// exec executes the given statement, and reports if it affected a row.
+func exec(ctx context.Context, drv dialect.Driver, q sql.Querier) (bool, error) {
+	query, args := q.Query()
+	var res sql.Result
+	if err := drv.Exec(ctx, query, args, &res); err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
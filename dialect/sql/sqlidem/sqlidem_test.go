@@ -0,0 +1,67 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlidem
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:sqlidem?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	now := time.Now()
+	s := New(WithTTL(time.Hour), WithClock(func() time.Time { return now }))
+	require.NoError(t, s.Create(ctx, drv))
+	require.NoError(t, s.Create(ctx, drv), "create should be idempotent")
+
+	res, ok, err := s.Claim(ctx, drv, "Order", "k1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, res)
+	// Keys are claimed only once.
+	_, ok, err = s.Claim(ctx, drv, "Order", "k1")
+	require.ErrorIs(t, err, ErrInProgress)
+	require.False(t, ok)
+	// Keys are scoped.
+	_, ok, err = s.Claim(ctx, drv, "User", "k1")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, s.Complete(ctx, drv, "Order", "k1", "1"))
+	res, ok, err = s.Claim(ctx, drv, "Order", "k1")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, "1", res)
+
+	// Completed keys are not released.
+	require.NoError(t, s.Release(ctx, drv, "Order", "k1"))
+	res, ok, err = s.Claim(ctx, drv, "Order", "k1")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, "1", res)
+	require.NoError(t, s.Release(ctx, drv, "User", "k1"))
+	_, ok, err = s.Claim(ctx, drv, "User", "k1")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Expired keys are claimed again.
+	now = now.Add(time.Hour + time.Second)
+	res, ok, err = s.Claim(ctx, drv, "Order", "k1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, res)
+	_, _, err = s.Claim(ctx, drv, "Order", "k1")
+	require.ErrorIs(t, err, ErrInProgress)
+}
@@ -7,6 +7,7 @@ package sqljson
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"unicode"
 
@@ -310,6 +311,50 @@ func LenPath(b *sql.Builder, column string, opts ...Option) {
 	path.length(b)
 }
 
+// Append returns an expression for appending the elements of the given slice
+// to the JSON array that is returned by x. A NULL value is treated as an empty
+// array. It is used for updating JSON arrays in the database, without reading
+// them first.
+//
+//	update.Set("tags", sqljson.Append(sql.Expr("tags"), []string{"a", "b"}))
+//
+func Append(x sql.Querier, elems interface{}) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.MySQL:
+			b.WriteString("JSON_MERGE_PRESERVE").Nested(func(b *sql.Builder) {
+				b.WriteString("COALESCE").Nested(func(b *sql.Builder) {
+					b.Join(x).Comma().WriteString("JSON_ARRAY()")
+				})
+				b.Comma().Arg(marshal(elems))
+			})
+		case dialect.Postgres:
+			b.WriteString("COALESCE").Nested(func(b *sql.Builder) {
+				b.Join(x).Comma().WriteString("'[]'::jsonb")
+			})
+			b.WriteString(" || ").Arg(marshal(elems)).WriteString("::jsonb")
+		default:
+			// SQLite does not support concatenating JSON arrays, and
+			// therefore, the elements are inserted one after the other.
+			b.WriteString("json_insert").Nested(func(b *sql.Builder) {
+				b.WriteString("COALESCE").Nested(func(b *sql.Builder) {
+					b.Join(x).Comma().WriteString("'[]'")
+				})
+				rv := reflect.ValueOf(elems)
+				if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+					b.AddError(fmt.Errorf("sqljson: unexpected type %T for append", elems))
+					return
+				}
+				for i := 0; i < rv.Len(); i++ {
+					b.Comma().WriteString("'$[#]'").Comma().WriteString("json").Nested(func(b *sql.Builder) {
+						b.Arg(marshal(rv.Index(i).Interface()))
+					})
+				}
+			})
+		}
+	})
+}
+
 // Option allows for calling database JSON paths with functional options.
 type Option func(*PathOptions)
 
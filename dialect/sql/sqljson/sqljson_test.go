@@ -327,6 +327,43 @@ func TestWritePath(t *testing.T) {
 	}
 }
 
+func TestAppend(t *testing.T) {
+	tests := []struct {
+		input     sql.Querier
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			input: sql.Dialect(dialect.MySQL).
+				Update("users").
+				Set("tags", sqljson.Append(sql.Expr("`tags`"), []string{"a", "b"})),
+			wantQuery: "UPDATE `users` SET `tags` = JSON_MERGE_PRESERVE(COALESCE(`tags`, JSON_ARRAY()), ?)",
+			wantArgs:  []interface{}{`["a","b"]`},
+		},
+		{
+			input: sql.Dialect(dialect.Postgres).
+				Update("users").
+				Set("tags", sqljson.Append(sql.Expr(`"tags"`), []string{"a", "b"})),
+			wantQuery: `UPDATE "users" SET "tags" = COALESCE("tags", '[]'::jsonb) || $1::jsonb`,
+			wantArgs:  []interface{}{`["a","b"]`},
+		},
+		{
+			input: sql.Dialect(dialect.SQLite).
+				Update("users").
+				Set("ints", sqljson.Append(sql.Expr("`ints`"), []int{1, 2})),
+			wantQuery: "UPDATE `users` SET `ints` = json_insert(COALESCE(`ints`, '[]'), '$[#]', json(?), '$[#]', json(?))",
+			wantArgs:  []interface{}{"1", "2"},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
 func TestParsePath(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -0,0 +1,256 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlqueue provides a durable task queue that is stored in an SQL table,
+// and is used by ent for executing mutations asynchronously.
+package sqlqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DefaultTable is the default name of the queue table.
+const DefaultTable = "ent_async_tasks"
+
+// Task is a unit of work that is stored in the queue.
+type Task struct {
+	// ID of the task. Set by Enqueue.
+	ID int64
+	// Kind of the task. Used by workers to dispatch the task.
+	Kind string
+	// Key is the idempotency key of the task. Enqueuing a task with
+	// a key that was already enqueued (or executed) is a no-op.
+	Key string
+	// Payload of the task.
+	Payload []byte
+	// Attempts is the number of failed executions of the task.
+	Attempts int
+	// LastError holds the error of the last failed execution.
+	LastError string
+}
+
+// Queue is a durable task queue that is stored in an SQL table. Tasks are claimed by workers
+// for a limited time (see WithLockTimeout), and are retried with a backoff until they succeed
+// or exceed the maximum number of attempts (see WithMaxAttempts).
+type Queue struct {
+	table       string
+	maxAttempts int
+	lockTimeout time.Duration
+	backoff     func(attempts int) time.Duration
+	now         func() time.Time
+}
+
+// Option allows configuring the Queue using functional options.
+type Option func(*Queue)
+
+// WithTable sets the name of the queue table. The default is DefaultTable.
+func WithTable(name string) Option {
+	return func(q *Queue) {
+		q.table = name
+	}
+}
+
+// WithMaxAttempts sets the maximum number of executions of a task. Tasks that
+// exceed it are kept in the queue (for inspection), but are no longer claimed.
+// The default is 10.
+func WithMaxAttempts(n int) Option {
+	return func(q *Queue) {
+		q.maxAttempts = n
+	}
+}
+
+// WithLockTimeout sets the time a claimed task is locked for its worker. After it
+// passes, the task can be claimed by other workers. The default is 1 minute.
+func WithLockTimeout(d time.Duration) Option {
+	return func(q *Queue) {
+		q.lockTimeout = d
+	}
+}
+
+// WithBackoff sets the function that returns the delay before retrying a task that
+// failed the given number of times. The default is exponential, starting at 1 second
+// and capped at 1 hour.
+func WithBackoff(fn func(attempts int) time.Duration) Option {
+	return func(q *Queue) {
+		q.backoff = fn
+	}
+}
+
+// WithClock sets the clock of the queue, that is used for scheduling and locking
+// tasks. It is useful for freezing the time in tests. The default is time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(q *Queue) {
+		q.now = now
+	}
+}
+
+// New returns a new Queue configured with the given options.
+func New(opts ...Option) *Queue {
+	q := &Queue{
+		table:       DefaultTable,
+		maxAttempts: 10,
+		lockTimeout: time.Minute,
+		backoff:     ExponentialBackoff(time.Second, time.Hour),
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+	return q
+}
+
+// ExponentialBackoff returns a backoff function that doubles the delay on each
+// attempt, starting at the given base and capped at the given maximum.
+func ExponentialBackoff(base, max time.Duration) func(int) time.Duration {
+	return func(attempts int) time.Duration {
+		d := base
+		for i := 1; i < attempts && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		return d
+	}
+}
+
+// Create creates the queue table if it does not exist.
+func (q *Queue) Create(ctx context.Context, drv dialect.Driver) error {
+	b := sql.Dialect(drv.Dialect())
+	var id, text string
+	switch drv.Dialect() {
+	case dialect.SQLite:
+		id, text = "integer PRIMARY KEY AUTOINCREMENT", "text"
+	case dialect.Postgres:
+		id, text = "bigserial PRIMARY KEY", "text"
+	case dialect.MySQL:
+		id, text = "bigint AUTO_INCREMENT PRIMARY KEY", "longtext"
+	default:
+		return fmt.Errorf("sqlqueue: unsupported dialect %q", drv.Dialect())
+	}
+	query, args := b.CreateTable(q.table).
+		IfNotExists().
+		Column(sql.Column("id").Type(id)).
+		Column(sql.Column("kind").Type("varchar(255)").Attr("NOT NULL")).
+		Column(sql.Column("task_key").Type("varchar(255)").Attr("NOT NULL UNIQUE")).
+		Column(sql.Column("payload").Type(text).Attr("NOT NULL")).
+		Column(sql.Column("attempts").Type("bigint").Attr("NOT NULL DEFAULT 0")).
+		Column(sql.Column("last_error").Type(text).Attr("NULL")).
+		Column(sql.Column("run_at").Type("bigint").Attr("NOT NULL")).
+		Column(sql.Column("locked_until").Type("bigint").Attr("NOT NULL DEFAULT 0")).
+		Column(sql.Column("done_at").Type("bigint").Attr("NULL")).
+		Query()
+	return drv.Exec(ctx, query, args, nil)
+}
+
+// Enqueue adds the given task to the queue using the given driver (or transactional driver).
+// Enqueuing a task with a key that already exists in the queue is a no-op.
+func (q *Queue) Enqueue(ctx context.Context, drv dialect.Driver, t *Task) error {
+	if t.Key == "" {
+		return errors.New("sqlqueue: missing task key")
+	}
+	insert := sql.Dialect(drv.Dialect()).
+		Insert(q.table).
+		Columns("kind", "task_key", "payload", "run_at").
+		Values(t.Kind, t.Key, string(t.Payload), q.now().UnixMilli())
+	if drv.Dialect() == dialect.MySQL {
+		insert.OnConflict(sql.ResolveWith(func(u *sql.UpdateSet) {
+			u.SetIgnore("task_key")
+		}))
+	} else {
+		insert.OnConflict(sql.ConflictColumns("task_key"), sql.DoNothing())
+	}
+	query, args := insert.Query()
+	return drv.Exec(ctx, query, args, nil)
+}
+
+// Claim locks and returns up to n tasks that are ready for execution. The claimed tasks
+// should be reported back to the queue using Done or Fail before their lock expires.
+func (q *Queue) Claim(ctx context.Context, drv dialect.Driver, n int) ([]*Task, error) {
+	now := q.now().UnixMilli()
+	b := sql.Dialect(drv.Dialect())
+	t := b.Table(q.table)
+	query, args := b.Select(t.C("id"), t.C("kind"), t.C("task_key"), t.C("payload"), t.C("attempts")).
+		From(t).
+		Where(sql.And(
+			sql.IsNull(t.C("done_at")),
+			sql.LTE(t.C("run_at"), now),
+			sql.LT(t.C("locked_until"), now),
+			sql.LT(t.C("attempts"), q.maxAttempts),
+		)).
+		OrderBy(t.C("id")).
+		Limit(n).
+		Query()
+	rows := &sql.Rows{}
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	var candidates []*Task
+	for rows.Next() {
+		var (
+			t       Task
+			payload string
+		)
+		if err := rows.Scan(&t.ID, &t.Kind, &t.Key, &payload, &t.Attempts); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		t.Payload = []byte(payload)
+		candidates = append(candidates, &t)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	// Lock the candidates, skipping the ones
+	// that were claimed by concurrent workers.
+	tasks := make([]*Task, 0, len(candidates))
+	for _, t := range candidates {
+		query, args := b.Update(q.table).
+			Set("locked_until", now+q.lockTimeout.Milliseconds()).
+			Where(sql.And(sql.EQ("id", t.ID), sql.LT("locked_until", now))).
+			Query()
+		var res sql.Result
+		if err := drv.Exec(ctx, query, args, &res); err != nil {
+			return nil, err
+		}
+		if affected, err := res.RowsAffected(); err != nil {
+			return nil, err
+		} else if affected == 1 {
+			tasks = append(tasks, t)
+		}
+	}
+	return tasks, nil
+}
+
+// Done marks the given task as executed. It is usually called using the same
+// transaction the task was executed with, to ensure it is executed only once.
+func (q *Queue) Done(ctx context.Context, drv dialect.Driver, t *Task) error {
+	query, args := sql.Dialect(drv.Dialect()).
+		Update(q.table).
+		Set("done_at", q.now().UnixMilli()).
+		Where(sql.EQ("id", t.ID)).
+		Query()
+	return drv.Exec(ctx, query, args, nil)
+}
+
+// Fail records a failed execution of the given task, and schedules its retry.
+func (q *Queue) Fail(ctx context.Context, drv dialect.Driver, t *Task, err error) error {
+	t.Attempts++
+	t.LastError = err.Error()
+	query, args := sql.Dialect(drv.Dialect()).
+		Update(q.table).
+		Set("attempts", t.Attempts).
+		Set("last_error", t.LastError).
+		Set("run_at", q.now().Add(q.backoff(t.Attempts)).UnixMilli()).
+		Set("locked_until", 0).
+		Where(sql.EQ("id", t.ID)).
+		Query()
+	return drv.Exec(ctx, query, args, nil)
+}
@@ -0,0 +1,88 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlqueue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:sqlqueue?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	now := time.Now()
+	q := New(
+		WithMaxAttempts(2),
+		WithBackoff(func(int) time.Duration { return time.Minute }),
+		WithClock(func() time.Time { return now }),
+	)
+	require.NoError(t, q.Create(ctx, drv))
+	require.NoError(t, q.Create(ctx, drv), "create should be idempotent")
+
+	require.Error(t, q.Enqueue(ctx, drv, &Task{Kind: "k"}), "key is required")
+	require.NoError(t, q.Enqueue(ctx, drv, &Task{Kind: "k", Key: "1", Payload: []byte("a")}))
+	require.NoError(t, q.Enqueue(ctx, drv, &Task{Kind: "k", Key: "2", Payload: []byte("b")}))
+	require.NoError(t, q.Enqueue(ctx, drv, &Task{Kind: "k", Key: "1", Payload: []byte("c")}), "duplicate keys are ignored")
+
+	tasks, err := q.Claim(ctx, drv, 10)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	require.Equal(t, "1", tasks[0].Key)
+	require.Equal(t, []byte("a"), tasks[0].Payload)
+	// Claimed tasks are locked.
+	locked, err := q.Claim(ctx, drv, 10)
+	require.NoError(t, err)
+	require.Empty(t, locked)
+
+	require.NoError(t, q.Done(ctx, drv, tasks[0]))
+	require.NoError(t, q.Fail(ctx, drv, tasks[1], errors.New("oops")))
+	require.Equal(t, 1, tasks[1].Attempts)
+	// Failed tasks are retried after their backoff.
+	retry, err := q.Claim(ctx, drv, 10)
+	require.NoError(t, err)
+	require.Empty(t, retry)
+	now = now.Add(time.Minute)
+	retry, err = q.Claim(ctx, drv, 10)
+	require.NoError(t, err)
+	require.Len(t, retry, 1)
+	require.Equal(t, "2", retry[0].Key)
+	require.Equal(t, 1, retry[0].Attempts)
+
+	// Tasks that exceed the maximum attempts are no longer claimed.
+	require.NoError(t, q.Fail(ctx, drv, retry[0], errors.New("oops")))
+	now = now.Add(time.Hour)
+	retry, err = q.Claim(ctx, drv, 10)
+	require.NoError(t, err)
+	require.Empty(t, retry)
+
+	// Expired locks can be claimed by other workers.
+	require.NoError(t, q.Enqueue(ctx, drv, &Task{Kind: "k", Key: "3"}))
+	tasks, err = q.Claim(ctx, drv, 10)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	now = now.Add(q.lockTimeout + time.Second)
+	tasks, err = q.Claim(ctx, drv, 10)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := ExponentialBackoff(time.Second, 10*time.Second)
+	require.Equal(t, time.Second, b(1))
+	require.Equal(t, 2*time.Second, b(2))
+	require.Equal(t, 8*time.Second, b(4))
+	require.Equal(t, 10*time.Second, b(5))
+	require.Equal(t, 10*time.Second, b(100))
+}
@@ -0,0 +1,546 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlreplay provides a driver that records the queries (and their arguments and results)
+// that are executed on a database into a golden file, and serves them from the golden file in replay
+// mode, for fast and hermetic tests of read-heavy services without a live database.
+//
+//	var update = flag.Bool("update", false, "update golden files")
+//
+//	func TestService(t *testing.T) {
+//		var drv *sqlreplay.Driver
+//		if *update {
+//			drv = sqlreplay.Record(entsql.OpenDB(dialect.Postgres, db))
+//			defer drv.Recording().Save("testdata/service.json")
+//		} else {
+//			rec, err := sqlreplay.Load("testdata/service.json")
+//			require.NoError(t, err)
+//			drv = sqlreplay.Replay(dialect.Postgres, rec)
+//		}
+//		client := ent.NewClient(ent.Driver(drv))
+//		// ...
+//	}
+//
+package sqlreplay
+
+import (
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ErrNotRecorded is returned in replay mode for queries that do not exist in the recording.
+var ErrNotRecorded = errors.New("sqlreplay: query was not recorded")
+
+// Driver is a dialect.Driver that records or replays queries.
+type Driver struct {
+	*sql.Driver
+	rec *Recording
+	// drv is the recorded driver. Nil in replay mode.
+	drv dialect.Driver
+}
+
+// Record returns a driver that executes the queries on the given driver and records them.
+// The recording is returned by the Recording method, and is usually saved in a golden file.
+func Record(drv dialect.Driver) *Driver {
+	rec := &Recording{}
+	return &Driver{Driver: replayer(drv.Dialect(), rec), rec: rec, drv: drv}
+}
+
+// Replay returns a driver that serves the queries from the given recording, without a
+// database. Queries are matched by their statement and arguments, and identical queries
+// are served in the order they were recorded, where the last one is served repeatedly.
+func Replay(name string, rec *Recording) *Driver {
+	return &Driver{Driver: replayer(name, rec), rec: rec}
+}
+
+// Recording returns the recording of the driver.
+func (d *Driver) Recording() *Recording {
+	return d.rec
+}
+
+// Exec executes (or replays) a statement that does not return rows.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if d.drv == nil {
+		return d.Driver.Exec(ctx, query, args, v)
+	}
+	ctx, err := d.recordExec(ctx, d.drv, query, args)
+	if err != nil {
+		return err
+	}
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Query executes (or replays) a statement that returns rows.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if d.drv == nil {
+		return d.Driver.Query(ctx, query, args, v)
+	}
+	ctx, err := d.recordQuery(ctx, d.drv, query, args)
+	if err != nil {
+		return err
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// Tx starts a transaction. In replay mode, transactions are no-ops.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	rtx, err := d.Driver.Tx(ctx)
+	if err != nil || d.drv == nil {
+		return rtx, err
+	}
+	tx, err := d.drv.Tx(ctx)
+	if err != nil {
+		_ = rtx.Rollback()
+		return nil, err
+	}
+	return &Tx{Tx: rtx, tx: tx, drv: d}, nil
+}
+
+// Tx is a transaction that is recorded by its driver.
+type Tx struct {
+	dialect.Tx
+	tx  dialect.Tx
+	drv *Driver
+}
+
+// Exec executes a statement in the transaction, and records it.
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	ctx, err := t.drv.recordExec(ctx, t.tx, query, args)
+	if err != nil {
+		return err
+	}
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+// Query executes a query in the transaction, and records it.
+func (t *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	ctx, err := t.drv.recordQuery(ctx, t.tx, query, args)
+	if err != nil {
+		return err
+	}
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+// Commit commits the recorded transaction.
+func (t *Tx) Commit() error {
+	_ = t.Tx.Commit()
+	return t.tx.Commit()
+}
+
+// Rollback rolls back the recorded transaction.
+func (t *Tx) Rollback() error {
+	_ = t.Tx.Rollback()
+	return t.tx.Rollback()
+}
+
+type interactionCtxKey struct{}
+
+// recordQuery executes the query on the given driver, and returns a context that
+// holds its recorded interaction, for serving it to the caller by the replayer.
+func (d *Driver) recordQuery(ctx context.Context, eq dialect.ExecQuerier, query string, args interface{}) (context.Context, error) {
+	it, err := newInteraction(opQuery, query, args)
+	if err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	if err := eq.Query(ctx, query, args, rows); err != nil {
+		it.Err = err.Error()
+	} else if err := it.scan(rows); err != nil {
+		return nil, err
+	}
+	d.rec.add(it)
+	return context.WithValue(ctx, interactionCtxKey{}, it), nil
+}
+
+// recordExec executes the statement on the given driver, and returns a context that
+// holds its recorded interaction, for serving it to the caller by the replayer.
+func (d *Driver) recordExec(ctx context.Context, eq dialect.ExecQuerier, query string, args interface{}) (context.Context, error) {
+	it, err := newInteraction(opExec, query, args)
+	if err != nil {
+		return nil, err
+	}
+	var res stdsql.Result
+	if err := eq.Exec(ctx, query, args, &res); err != nil {
+		it.Err = err.Error()
+	} else {
+		if id, err := res.LastInsertId(); err == nil {
+			it.LastInsertID = &id
+		}
+		if n, err := res.RowsAffected(); err == nil {
+			it.RowsAffected = &n
+		}
+	}
+	d.rec.add(it)
+	return context.WithValue(ctx, interactionCtxKey{}, it), nil
+}
+
+// Recording holds the recorded queries of a driver.
+type Recording struct {
+	mu           sync.Mutex
+	interactions []*interaction
+	// served holds the number of times each key was served in replay mode.
+	served map[string]int
+}
+
+// Load loads a recording from the given file.
+func Load(path string) (*Recording, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Read reads a recording from the given reader.
+func Read(r io.Reader) (*Recording, error) {
+	var file struct {
+		Interactions []*interaction `json:"interactions"`
+	}
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("sqlreplay: decoding recording: %w", err)
+	}
+	return &Recording{interactions: file.Interactions}, nil
+}
+
+// Save saves the recording to the given file.
+func (r *Recording) Save(path string) error {
+	var buf bytes.Buffer
+	if err := r.Write(&buf); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// Write writes the recording to the given writer.
+func (r *Recording) Write(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Interactions []*interaction `json:"interactions"`
+	}{Interactions: r.interactions})
+}
+
+// Len returns the number of recorded queries.
+func (r *Recording) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.interactions)
+}
+
+func (r *Recording) add(it *interaction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, it)
+}
+
+// lookup returns the next recorded interaction of the given operation.
+func (r *Recording) lookup(op, query string, args []driver.NamedValue) (*interaction, error) {
+	values := make([]interface{}, len(args))
+	for i := range args {
+		values[i] = args[i].Value
+	}
+	it, err := newInteraction(op, query, values)
+	if err != nil {
+		return nil, err
+	}
+	key := it.key()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.served == nil {
+		r.served = make(map[string]int)
+	}
+	var last *interaction
+	for i, n := 0, r.served[key]; i < len(r.interactions); i++ {
+		if r.interactions[i].key() != key {
+			continue
+		}
+		if last = r.interactions[i]; n == 0 {
+			break
+		}
+		n--
+	}
+	if last == nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrNotRecorded, query, it.argsKey())
+	}
+	r.served[key]++
+	return last, nil
+}
+
+// Operations of the recorded interactions.
+const (
+	opQuery = "query"
+	opExec  = "exec"
+)
+
+// interaction is a recorded query.
+type interaction struct {
+	Op           string    `json:"op"`
+	Query        string    `json:"query"`
+	Args         []value   `json:"args,omitempty"`
+	Columns      []string  `json:"columns,omitempty"`
+	Rows         [][]value `json:"rows,omitempty"`
+	LastInsertID *int64    `json:"last_insert_id,omitempty"`
+	RowsAffected *int64    `json:"rows_affected,omitempty"`
+	Err          string    `json:"error,omitempty"`
+}
+
+func newInteraction(op, query string, args interface{}) (*interaction, error) {
+	it := &interaction{Op: op, Query: query}
+	list, ok := args.([]interface{})
+	if !ok && args != nil {
+		return nil, fmt.Errorf("sqlreplay: invalid type %T. expect []interface{} for args", args)
+	}
+	for _, a := range list {
+		v, err := driver.DefaultParameterConverter.ConvertValue(a)
+		if err != nil {
+			return nil, fmt.Errorf("sqlreplay: converting argument %v: %w", a, err)
+		}
+		it.Args = append(it.Args, value{v})
+	}
+	return it, nil
+}
+
+// scan reads all rows into the interaction.
+func (it *interaction) scan(rows *sql.Rows) error {
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	it.Columns = columns
+	for rows.Next() {
+		dest := make([]interface{}, len(columns))
+		for i := range dest {
+			dest[i] = new(interface{})
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		row := make([]value, len(columns))
+		for i := range dest {
+			row[i] = value{*dest[i].(*interface{})}
+		}
+		it.Rows = append(it.Rows, row)
+	}
+	return rows.Err()
+}
+
+func (it *interaction) key() string {
+	return it.Op + "\x00" + it.Query + "\x00" + it.argsKey()
+}
+
+func (it *interaction) argsKey() string {
+	b, _ := json.Marshal(it.Args)
+	return string(b)
+}
+
+// value is a driver value that keeps its type in JSON encoding.
+type value struct {
+	v driver.Value
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v value) MarshalJSON() ([]byte, error) {
+	var t string
+	switch x := v.v.(type) {
+	case nil:
+		return []byte("null"), nil
+	case int64:
+		t = "int"
+	case float64:
+		t = "float"
+	case bool:
+		t = "bool"
+	case []byte:
+		t = "bytes"
+	case string:
+		t = "string"
+	case time.Time:
+		t = "time"
+		v.v = x.Format(time.RFC3339Nano)
+	default:
+		return nil, fmt.Errorf("sqlreplay: unexpected value type %T", x)
+	}
+	return json.Marshal(map[string]interface{}{t: v.v})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *value) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		v.v = nil
+		return nil
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	for t, raw := range m {
+		var err error
+		switch t {
+		case "int":
+			var x int64
+			err = json.Unmarshal(raw, &x)
+			v.v = x
+		case "float":
+			var x float64
+			err = json.Unmarshal(raw, &x)
+			v.v = x
+		case "bool":
+			var x bool
+			err = json.Unmarshal(raw, &x)
+			v.v = x
+		case "bytes":
+			var x []byte
+			err = json.Unmarshal(raw, &x)
+			v.v = x
+		case "string":
+			var x string
+			err = json.Unmarshal(raw, &x)
+			v.v = x
+		case "time":
+			var x string
+			if err = json.Unmarshal(raw, &x); err == nil {
+				v.v, err = time.Parse(time.RFC3339Nano, x)
+			}
+		default:
+			err = fmt.Errorf("sqlreplay: unexpected value type %q", t)
+		}
+		return err
+	}
+	return fmt.Errorf("sqlreplay: invalid value %s", b)
+}
+
+// replayer returns an SQL driver that serves the queries from the given recording,
+// or from the interaction that is stored in the context of the query (in record mode).
+func replayer(name string, rec *Recording) *sql.Driver {
+	return sql.OpenDB(name, stdsql.OpenDB(&connector{rec: rec}))
+}
+
+// connector implements the driver.Connector interface.
+type connector struct {
+	rec *Recording
+}
+
+func (c *connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{rec: c.rec}, nil
+}
+
+func (c *connector) Driver() driver.Driver {
+	return replayDriver{c}
+}
+
+// replayDriver implements the driver.Driver interface.
+type replayDriver struct {
+	c *connector
+}
+
+func (d replayDriver) Open(string) (driver.Conn, error) {
+	return d.c.Connect(context.Background())
+}
+
+// conn is a connection that serves recorded queries.
+type conn struct {
+	rec *Recording
+}
+
+func (*conn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("sqlreplay: prepared statements are not supported")
+}
+
+func (*conn) Close() error { return nil }
+
+func (*conn) Begin() (driver.Tx, error) { return tx{}, nil }
+
+func (*conn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) { return tx{}, nil }
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	it, err := c.interaction(ctx, opQuery, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &rows{it: it}, nil
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	it, err := c.interaction(ctx, opExec, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return result{it}, nil
+}
+
+func (c *conn) interaction(ctx context.Context, op, query string, args []driver.NamedValue) (*interaction, error) {
+	it, ok := ctx.Value(interactionCtxKey{}).(*interaction)
+	if !ok {
+		var err error
+		if it, err = c.rec.lookup(op, query, args); err != nil {
+			return nil, err
+		}
+	}
+	if it.Err != "" {
+		return nil, errors.New(it.Err)
+	}
+	return it, nil
+}
+
+// tx is a no-op transaction.
+type tx struct{}
+
+func (tx) Commit() error   { return nil }
+func (tx) Rollback() error { return nil }
+
+// rows serves the recorded rows of an interaction.
+type rows struct {
+	it  *interaction
+	idx int
+}
+
+func (r *rows) Columns() []string { return r.it.Columns }
+
+func (r *rows) Close() error { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.idx >= len(r.it.Rows) {
+		return io.EOF
+	}
+	for i, v := range r.it.Rows[r.idx] {
+		dest[i] = v.v
+	}
+	r.idx++
+	return nil
+}
+
+// result serves the recorded result of an interaction.
+type result struct {
+	it *interaction
+}
+
+func (r result) LastInsertId() (int64, error) {
+	if r.it.LastInsertID == nil {
+		return 0, errors.New("sqlreplay: LastInsertId was not recorded")
+	}
+	return *r.it.LastInsertID, nil
+}
+
+func (r result) RowsAffected() (int64, error) {
+	if r.it.RowsAffected == nil {
+		return 0, errors.New("sqlreplay: RowsAffected was not recorded")
+	}
+	return *r.it.RowsAffected, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlreplay
+
+import (
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+type user struct {
+	ID        int
+	Name      string
+	Nickname  stdsql.NullString
+	CreatedAt time.Time
+}
+
+// run executes the same operations in record and replay modes.
+func run(t *testing.T, drv dialect.Driver) []user {
+	ctx := context.Background()
+	b := sql.Dialect(dialect.SQLite)
+	insert := func(eq dialect.ExecQuerier, name string, nick interface{}) {
+		query, args := b.Insert("users").
+			Columns("name", "nickname", "created_at").
+			Values(name, nick, time.Date(2022, 7, 1, 10, 0, 0, 0, time.UTC)).
+			Query()
+		var res stdsql.Result
+		require.NoError(t, eq.Exec(ctx, query, args, &res))
+		n, err := res.RowsAffected()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), n)
+	}
+	insert(drv, "a8m", "ariel")
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	insert(tx, "nati", nil)
+	require.NoError(t, tx.Commit())
+
+	query, args := b.Select("id", "name", "nickname", "created_at").
+		From(sql.Table("users")).
+		Where(sql.GT("id", 0)).
+		OrderBy("id").
+		Query()
+	rows := &sql.Rows{}
+	require.NoError(t, drv.Query(ctx, query, args, rows))
+	defer rows.Close()
+	var users []user
+	for rows.Next() {
+		var u user
+		require.NoError(t, rows.Scan(&u.ID, &u.Name, &u.Nickname, &u.CreatedAt))
+		users = append(users, u)
+	}
+	require.NoError(t, rows.Err())
+	// Errors are recorded and replayed.
+	require.Error(t, drv.Query(ctx, "SELECT * FROM `unknown`", []interface{}{}, &sql.Rows{}))
+	return users
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open(dialect.SQLite, "file:sqlreplay?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Exec(ctx, "CREATE TABLE `users` (`id` integer PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `nickname` text NULL, `created_at` datetime NOT NULL)", []interface{}{}, nil))
+
+	rdrv := Record(db)
+	recorded := run(t, rdrv)
+	require.Len(t, recorded, 2)
+	require.Equal(t, "a8m", recorded[0].Name)
+	require.Equal(t, "ariel", recorded[0].Nickname.String)
+	require.False(t, recorded[1].Nickname.Valid)
+	require.Equal(t, 4, rdrv.Recording().Len())
+
+	var buf bytes.Buffer
+	require.NoError(t, rdrv.Recording().Write(&buf))
+	rec, err := Read(&buf)
+	require.NoError(t, err)
+	// Drop the table to ensure the database is not used in replay mode.
+	require.NoError(t, db.Exec(ctx, "DROP TABLE `users`", []interface{}{}, nil))
+	pdrv := Replay(dialect.SQLite, rec)
+	replayed := run(t, pdrv)
+	require.Len(t, replayed, 2)
+	for i := range recorded {
+		require.Equal(t, recorded[i].ID, replayed[i].ID)
+		require.Equal(t, recorded[i].Name, replayed[i].Name)
+		require.Equal(t, recorded[i].Nickname, replayed[i].Nickname)
+		require.True(t, recorded[i].CreatedAt.Equal(replayed[i].CreatedAt))
+	}
+
+	err = pdrv.Query(ctx, "SELECT * FROM `users` WHERE `id` = ?", []interface{}{1}, &sql.Rows{})
+	require.True(t, errors.Is(err, ErrNotRecorded))
+}
@@ -0,0 +1,315 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"entgo.io/ent/dialect/sql/sqlsaga/internal/ent/migrate"
+
+	"entgo.io/ent/dialect/sql/sqlsaga/internal/ent/saga"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// Saga is the client for interacting with the Saga builders.
+	Saga *SagaClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	client.AddExtension(ent.RegisteredExtensions()...)
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.Saga = NewSagaClient(c.config)
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:    ctx,
+		config: cfg,
+		Saga:   NewSagaClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:    ctx,
+		config: cfg,
+		Saga:   NewSagaClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		Saga.
+//		Query().
+//		Count(ctx)
+//
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	if c.readDriver != nil {
+		cfg.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+// The read driver of the client is closed as well, if it was configured.
+func (c *Client) Close() error {
+	err := c.driver.Close()
+	if _, ok := c.driver.(*txDriver); !ok && c.readDriver != nil {
+		if rerr := c.readDriver.Close(); err == nil {
+			err = rerr
+		}
+	}
+	return err
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.Saga.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Saga.Intercept(interceptors...)
+}
+
+// AddExtension adds the given extensions to the client. Their hooks and interceptors are added to all the
+// entity clients, and their drivers wrap the drivers of the client. Extensions that decorate the driver
+// should be added before the client is used, as entity clients that were obtained before the call (e.g.
+// `users := client.User`) keep using the previous driver. For example:
+//
+//	client.AddExtension(cache.NewExtension(cache.WithTTL(time.Minute)))
+//
+func (c *Client) AddExtension(exts ...ent.Extension) {
+	if len(exts) == 0 {
+		return
+	}
+	for _, ext := range exts {
+		if drv := ext.Driver(c.driver); drv != nil {
+			c.driver = drv
+		}
+		if c.readDriver != nil {
+			if drv := ext.Driver(c.readDriver); drv != nil {
+				c.readDriver = drv
+			}
+		}
+	}
+	c.extensions = append(c.extensions[:len(c.extensions):len(c.extensions)], exts...)
+	c.init()
+	for _, ext := range exts {
+		c.Use(ext.Hooks()...)
+		c.Intercept(ext.Interceptors()...)
+	}
+}
+
+// HealthCheck runs the health checks of the extensions that were added to the client,
+// and returns the first error that was reported.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	for _, ext := range c.extensions {
+		if err := ext.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("ent: extension %q: %w", ext.Name(), err)
+		}
+	}
+	return nil
+}
+
+// WithOptions returns a new client that is derived from c and configured with the given options.
+// Hooks that are registered on the new client using Use are not added to c (and vice versa). For
+// example, creating a client for trusted background jobs:
+//
+//	client.WithOptions(ent.ReplaceHooks(AuditHook()), ent.SkipPrivacy()).
+//		Saga.
+//		Delete().
+//		Exec(ctx)
+//
+func (c *Client) WithOptions(opts ...Option) *Client {
+	cfg := c.config
+	cfg.hooks = &hooks{
+		Saga: c.hooks.Saga[:len(c.hooks.Saga):len(c.hooks.Saga)],
+	}
+	cfg.inters = &inters{
+		Saga: c.inters.Saga[:len(c.inters.Saga):len(c.inters.Saga)],
+	}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// SagaClient is a client for the Saga schema.
+type SagaClient struct {
+	config
+}
+
+// NewSagaClient returns a client for the Saga from the given config.
+func NewSagaClient(c config) *SagaClient {
+	return &SagaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `saga.Hooks(f(g(h())))`.
+func (c *SagaClient) Use(hooks ...Hook) {
+	c.hooks.Saga = append(c.hooks.Saga, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `saga.Intercept(f(g(h())))`.
+func (c *SagaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Saga = append(c.inters.Saga, interceptors...)
+}
+
+// Create returns a builder for creating a Saga entity.
+func (c *SagaClient) Create() *SagaCreate {
+	mutation := newSagaMutation(c.config, OpCreate)
+	return &SagaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Saga entities.
+func (c *SagaClient) CreateBulk(builders ...*SagaCreate) *SagaCreateBulk {
+	return &SagaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Saga.
+func (c *SagaClient) Update() *SagaUpdate {
+	mutation := newSagaMutation(c.config, OpUpdate)
+	return &SagaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SagaClient) UpdateOne(s *Saga) *SagaUpdateOne {
+	mutation := newSagaMutation(c.config, OpUpdateOne, withSaga(s))
+	return &SagaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SagaClient) UpdateOneID(id string) *SagaUpdateOne {
+	mutation := newSagaMutation(c.config, OpUpdateOne, withSagaID(id))
+	return &SagaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Saga.
+func (c *SagaClient) Delete() *SagaDelete {
+	mutation := newSagaMutation(c.config, OpDelete)
+	return &SagaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SagaClient) DeleteOne(s *Saga) *SagaDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *SagaClient) DeleteOneID(id string) *SagaDeleteOne {
+	builder := c.Delete().Where(saga.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SagaDeleteOne{builder}
+}
+
+// Query returns a query builder for Saga.
+func (c *SagaClient) Query() *SagaQuery {
+	return &SagaQuery{
+		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Saga entity by its id.
+func (c *SagaClient) Get(ctx context.Context, id string) (*Saga, error) {
+	node, err := c.Query().Where(saga.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SagaClient) GetX(ctx context.Context, id string) *Saga {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Interceptors returns the client interceptors.
+func (c *SagaClient) Interceptors() []Interceptor {
+	return c.inters.Saga
+}
+
+// Hooks returns the client hooks.
+func (c *SagaClient) Hooks() []Hook {
+	return c.hooks.Saga
+}
@@ -0,0 +1,49 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// EntSagasColumns holds the columns for the "ent_sagas" table.
+	EntSagasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "compensating", "done", "compensated", "failed"}},
+		{Name: "step", Type: field.TypeInt, Comment: "Number of the executed steps of the saga."},
+		{Name: "data", Type: field.TypeString, Size: 2147483647, Comment: "JSON encoded values of the saga state."},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "updated_at", Type: field.TypeInt64, Comment: "Unix time in milliseconds of the last progress of the saga."},
+	}
+	// EntSagasTable holds the schema information for the "ent_sagas" table.
+	EntSagasTable = &schema.Table{
+		Name:       "ent_sagas",
+		Columns:    EntSagasColumns,
+		PrimaryKey: []*schema.Column{EntSagasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "saga_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{EntSagasColumns[2], EntSagasColumns[6]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		EntSagasTable,
+	}
+)
+
+func init() {
+	EntSagasTable.Annotation = &entsql.Annotation{
+		Table: "ent_sagas",
+	}
+}
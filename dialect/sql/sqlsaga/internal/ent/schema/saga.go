@@ -0,0 +1,53 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Saga holds the schema definition for the persisted state of saga executions.
+type Saga struct {
+	ent.Schema
+}
+
+// Annotations of the Saga.
+func (Saga) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "ent_sagas"},
+	}
+}
+
+// Fields of the Saga.
+func (Saga) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("name").
+			Immutable(),
+		field.Enum("status").
+			Values("running", "compensating", "done", "compensated", "failed"),
+		field.Int("step").
+			Comment("Number of the executed steps of the saga."),
+		field.Text("data").
+			Comment("JSON encoded values of the saga state."),
+		field.Text("last_error").
+			Optional(),
+		field.Int64("updated_at").
+			Comment("Unix time in milliseconds of the last progress of the saga."),
+	}
+}
+
+// Indexes of the Saga.
+func (Saga) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "updated_at"),
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlsaga provides an orchestrator for sagas, sequences of steps (for example, ent mutations
+// or calls to external services) that register compensating actions. On failure, the compensations
+// of the executed steps are run in reverse order. The state of the sagas is stored in the "ent_sagas"
+// table using an ent schema, and sagas that were interrupted by a crash are compensated by Recover.
+package sqlsaga
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/sqlsaga/internal/ent"
+	"entgo.io/ent/dialect/sql/sqlsaga/internal/ent/saga"
+)
+
+// Status of a saga.
+type Status string
+
+// List of saga statuses.
+const (
+	StatusRunning      Status = "running"      // Steps are executed.
+	StatusCompensating Status = "compensating" // A step failed, and compensations are executed.
+	StatusDone         Status = "done"         // All steps were executed successfully.
+	StatusCompensated  Status = "compensated"  // All executed steps were compensated.
+	StatusFailed       Status = "failed"       // A compensation failed, and the saga requires manual handling.
+)
+
+// Step of a saga.
+type Step struct {
+	// Name of the step. Used for reporting errors.
+	Name string
+	// Action executes the step. Values that are needed for compensating
+	// the step (for example, the IDs of created entities) should be
+	// stored in the state, as they are persisted for recovery.
+	Action func(context.Context, *State) error
+	// Compensate undoes the action of the step. It is optional,
+	// and may be called more than once in case of recovery.
+	Compensate func(context.Context, *State) error
+}
+
+// Saga defines a named sequence of steps.
+type Saga struct {
+	name  string
+	steps []Step
+}
+
+// New returns a new saga definition with the given name and steps.
+func New(name string, steps ...Step) *Saga {
+	return &Saga{name: name, steps: steps}
+}
+
+// Name returns the name of the saga.
+func (s *Saga) Name() string { return s.name }
+
+// State holds the persisted values of a saga execution.
+type State struct {
+	// ID of the saga execution.
+	ID string
+	// Name of the saga.
+	Name string
+	// Status of the saga execution.
+	Status Status
+	data   map[string]json.RawMessage
+}
+
+// Set stores the given value in the state under the given key. The value is
+// encoded as JSON, and persisted after the execution of the current step.
+func (s *State) Set(key string, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("sqlsaga: encode %q: %w", key, err)
+	}
+	if s.data == nil {
+		s.data = make(map[string]json.RawMessage)
+	}
+	s.data[key] = b
+	return nil
+}
+
+// Get decodes the value that is stored in the state under the given key into v.
+// ErrNotFound is returned if the key does not exist.
+func (s *State) Get(key string, v interface{}) error {
+	b, ok := s.data[key]
+	if !ok {
+		return fmt.Errorf("sqlsaga: get %q: %w", key, ErrNotFound)
+	}
+	return json.Unmarshal(b, v)
+}
+
+// ErrNotFound is returned by State.Get when the key does not exist.
+var ErrNotFound = errors.New("key was not found")
+
+// Error is returned when a saga fails. It holds the error of the failed
+// step, and the error of the compensation that failed, if there was one.
+type Error struct {
+	// ID and Name of the saga.
+	ID, Name string
+	// Step is the name of the step that failed.
+	Step string
+	// Err is the error returned by the step.
+	Err error
+	// CompensateErr is the error returned by a compensation. If it is not nil,
+	// the status of the saga is StatusFailed and it requires manual handling.
+	CompensateErr error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("sqlsaga: saga %s(%s) failed at step %q: %v", e.Name, e.ID, e.Step, e.Err)
+	if e.CompensateErr != nil {
+		msg += fmt.Sprintf(" (compensation failed: %v)", e.CompensateErr)
+	}
+	return msg
+}
+
+// Unwrap returns the error of the failed step.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Orchestrator executes sagas, and stores their state in the database.
+type Orchestrator struct {
+	client     *ent.Client
+	staleAfter time.Duration
+	sagas      map[string]*Saga
+	now        func() time.Time
+}
+
+// Option allows configuring the Orchestrator using functional options.
+type Option func(*Orchestrator)
+
+// WithStaleAfter sets the time after which a saga that did not make progress is considered
+// as interrupted, and is compensated by Recover. The default is 5 minutes. It should be
+// larger than the execution time of the longest step.
+func WithStaleAfter(d time.Duration) Option {
+	return func(o *Orchestrator) {
+		o.staleAfter = d
+	}
+}
+
+// WithClock sets the clock of the orchestrator, that is used for recording the progress
+// of sagas and detecting stale ones. It is useful for freezing the time in tests. The
+// default is time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(o *Orchestrator) {
+		o.now = now
+	}
+}
+
+// NewOrchestrator returns a new Orchestrator that stores the state of the
+// sagas using the given driver, and is configured with the given options.
+func NewOrchestrator(drv dialect.Driver, opts ...Option) *Orchestrator {
+	o := &Orchestrator{
+		client:     ent.NewClient(ent.Driver(drv)),
+		staleAfter: 5 * time.Minute,
+		sagas:      make(map[string]*Saga),
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Register registers the given sagas in the orchestrator. Sagas must be
+// registered before their executions can be recovered.
+func (o *Orchestrator) Register(sagas ...*Saga) {
+	for _, s := range sagas {
+		o.sagas[s.name] = s
+	}
+}
+
+// Create creates the sagas table if it does not exist.
+func (o *Orchestrator) Create(ctx context.Context) error {
+	return o.client.Schema.Create(ctx)
+}
+
+// Run executes the registered saga with the given name, and identifies its execution using
+// the given id. The init function, if it is not nil, is called for setting the initial values
+// of the state (for example, the input of the saga). If a step fails, the executed steps are
+// compensated in reverse order, and an *Error is returned.
+func (o *Orchestrator) Run(ctx context.Context, name, id string, init func(*State) error) error {
+	s, ok := o.sagas[name]
+	if !ok {
+		return fmt.Errorf("sqlsaga: saga %q was not registered", name)
+	}
+	st := &State{ID: id, Name: name, Status: StatusRunning}
+	if init != nil {
+		if err := init(st); err != nil {
+			return err
+		}
+	}
+	data, err := st.marshal()
+	if err != nil {
+		return err
+	}
+	err = o.client.Saga.Create().
+		SetID(id).
+		SetName(name).
+		SetStatus(saga.Status(st.Status)).
+		SetStep(0).
+		SetData(data).
+		SetUpdatedAt(o.now().UnixMilli()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("sqlsaga: start saga %s(%s): %w", name, id, err)
+	}
+	for i, step := range s.steps {
+		if err := step.Action(ctx, st); err != nil {
+			return o.compensate(ctx, s, st, i, &Error{ID: id, Name: name, Step: step.Name, Err: err})
+		}
+		if err := o.save(ctx, st, i+1, nil); err != nil {
+			return o.compensate(ctx, s, st, i+1, &Error{ID: id, Name: name, Step: step.Name, Err: err})
+		}
+	}
+	st.Status = StatusDone
+	return o.save(ctx, st, len(s.steps), nil)
+}
+
+// Recover compensates the sagas that were interrupted (for example, by a crash), and did not make
+// progress in the stale duration of the orchestrator (see WithStaleAfter). Interrupted executions
+// of sagas that were not registered are skipped. The errors of the compensations are joined and
+// returned after all interrupted sagas were handled.
+func (o *Orchestrator) Recover(ctx context.Context) error {
+	now := o.now().UnixMilli()
+	list, err := o.client.Saga.Query().
+		Where(
+			saga.StatusIn(saga.StatusRunning, saga.StatusCompensating),
+			saga.UpdatedAtLT(now-o.staleAfter.Milliseconds()),
+		).
+		Order(ent.Asc(saga.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []string
+	for _, v := range list {
+		s, ok := o.sagas[v.Name]
+		if !ok {
+			continue
+		}
+		st := &State{ID: v.ID, Name: v.Name, Status: StatusCompensating}
+		if err := json.Unmarshal([]byte(v.Data), &st.data); err != nil {
+			return fmt.Errorf("sqlsaga: decode state of saga %s(%s): %w", v.Name, v.ID, err)
+		}
+		// Claim the saga by updating its timestamp, skipping
+		// sagas that were recovered by concurrent orchestrators.
+		n, err := o.client.Saga.Update().
+			Where(saga.ID(v.ID), saga.UpdatedAt(v.UpdatedAt)).
+			SetStatus(saga.StatusCompensating).
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			continue
+		}
+		step := "recovery"
+		if v.Step < len(s.steps) {
+			step = s.steps[v.Step].Name
+		}
+		err = o.compensate(ctx, s, st, v.Step, &Error{ID: v.ID, Name: v.Name, Step: step, Err: errors.New("saga was interrupted")})
+		if serr := (*Error)(nil); errors.As(err, &serr) && serr.CompensateErr == nil {
+			continue
+		}
+		errs = append(errs, err.Error())
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("sqlsaga: recover: %v", errs)
+	}
+	return nil
+}
+
+// Status returns the status of the saga execution with the given id.
+func (o *Orchestrator) Status(ctx context.Context, id string) (Status, error) {
+	v, err := o.client.Saga.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return "", fmt.Errorf("sqlsaga: saga %q was not found", id)
+	}
+	if err != nil {
+		return "", err
+	}
+	return Status(v.Status), nil
+}
+
+// compensate executes the compensations of the first n steps of the saga in
+// reverse order, and records the progress in the database after each of them.
+func (o *Orchestrator) compensate(ctx context.Context, s *Saga, st *State, n int, serr *Error) error {
+	st.Status = StatusCompensating
+	if err := o.save(ctx, st, n, serr.Err); err != nil {
+		serr.CompensateErr = err
+		return serr
+	}
+	for i := n - 1; i >= 0; i-- {
+		if c := s.steps[i].Compensate; c != nil {
+			if err := c(ctx, st); err != nil {
+				serr.CompensateErr = fmt.Errorf("step %q: %w", s.steps[i].Name, err)
+				st.Status = StatusFailed
+				if err := o.save(ctx, st, i+1, serr); err != nil {
+					serr.CompensateErr = fmt.Errorf("%v: %w", serr.CompensateErr, err)
+				}
+				return serr
+			}
+		}
+		if err := o.save(ctx, st, i, serr.Err); err != nil {
+			serr.CompensateErr = err
+			return serr
+		}
+	}
+	st.Status = StatusCompensated
+	if err := o.save(ctx, st, 0, serr.Err); err != nil {
+		serr.CompensateErr = err
+	}
+	return serr
+}
+
+// save persists the state of the saga, and the number of its executed steps.
+func (o *Orchestrator) save(ctx context.Context, st *State, step int, lastErr error) error {
+	data, err := st.marshal()
+	if err != nil {
+		return err
+	}
+	u := o.client.Saga.UpdateOneID(st.ID).
+		SetStatus(saga.Status(st.Status)).
+		SetStep(step).
+		SetData(data).
+		SetUpdatedAt(o.now().UnixMilli())
+	if lastErr != nil {
+		u.SetLastError(lastErr.Error())
+	}
+	return u.Exec(ctx)
+}
+
+func (s *State) marshal() (string, error) {
+	if s.data == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(s.data)
+	if err != nil {
+		return "", fmt.Errorf("sqlsaga: encode state: %w", err)
+	}
+	return string(b), nil
+}
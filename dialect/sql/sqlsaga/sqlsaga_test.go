@@ -0,0 +1,132 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlsaga
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrchestrator(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:sqlsaga?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	o := NewOrchestrator(drv)
+	require.NoError(t, o.Create(ctx))
+	require.NoError(t, o.Create(ctx), "create should be idempotent")
+
+	var calls []string
+	step := func(name string, fail bool) Step {
+		return Step{
+			Name: name,
+			Action: func(_ context.Context, s *State) error {
+				calls = append(calls, "do:"+name)
+				if fail {
+					return errors.New("oops")
+				}
+				return s.Set(name, len(calls))
+			},
+			Compensate: func(_ context.Context, s *State) error {
+				var n int
+				if err := s.Get(name, &n); err != nil {
+					return err
+				}
+				calls = append(calls, "undo:"+name)
+				return nil
+			},
+		}
+	}
+	o.Register(
+		New("ok", step("a", false), step("b", false)),
+		New("fail", step("a", false), step("b", false), step("c", true)),
+	)
+	require.Error(t, o.Run(ctx, "unknown", "1", nil))
+
+	require.NoError(t, o.Run(ctx, "ok", "1", nil))
+	require.Equal(t, []string{"do:a", "do:b"}, calls)
+	status, err := o.Status(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, StatusDone, status)
+	require.Error(t, o.Run(ctx, "ok", "1", nil), "ids are unique")
+
+	calls = nil
+	err = o.Run(ctx, "fail", "2", func(s *State) error { return s.Set("input", "x") })
+	serr := &Error{}
+	require.True(t, errors.As(err, &serr))
+	require.Equal(t, "c", serr.Step)
+	require.NoError(t, serr.CompensateErr)
+	require.Equal(t, []string{"do:a", "do:b", "do:c", "undo:b", "undo:a"}, calls)
+	status, err = o.Status(ctx, "2")
+	require.NoError(t, err)
+	require.Equal(t, StatusCompensated, status)
+	v := o.client.Saga.GetX(ctx, "2")
+	require.Zero(t, v.Step)
+	require.Equal(t, "oops", v.LastError)
+	require.JSONEq(t, `{"input":"x","a":1,"b":2}`, v.Data)
+}
+
+func TestOrchestrator_Recover(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:sqlsaga_recover?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	now := time.Now()
+	o := NewOrchestrator(drv, WithStaleAfter(time.Minute), WithClock(func() time.Time { return now }))
+	require.NoError(t, o.Create(ctx))
+
+	var undone []string
+	crash := errors.New("crash")
+	o.Register(New("saga",
+		Step{
+			Name: "a",
+			Action: func(_ context.Context, s *State) error {
+				return s.Set("id", 1)
+			},
+			Compensate: func(_ context.Context, s *State) error {
+				var id int
+				if err := s.Get("id", &id); err != nil {
+					return err
+				}
+				undone = append(undone, "a")
+				return nil
+			},
+		},
+		Step{
+			Name: "b",
+			Action: func(context.Context, *State) error {
+				// Simulate a crash by panicking in the middle of the saga.
+				panic(crash)
+			},
+		},
+	))
+	require.PanicsWithValue(t, crash, func() { _ = o.Run(ctx, "saga", "1", nil) })
+	require.Empty(t, undone)
+
+	// Sagas that are not stale are not recovered.
+	require.NoError(t, o.Recover(ctx))
+	require.Empty(t, undone)
+	status, err := o.Status(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, StatusRunning, status)
+
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, o.Recover(ctx))
+	require.Equal(t, []string{"a"}, undone)
+	status, err = o.Status(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, StatusCompensated, status)
+	// Recovered sagas are not compensated again.
+	require.NoError(t, o.Recover(ctx))
+	require.Equal(t, []string{"a"}, undone)
+}
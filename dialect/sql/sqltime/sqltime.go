@@ -0,0 +1,179 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqltime provides helpers for working with time columns in SQL,
+// like truncating them into time buckets for time-series aggregations.
+package sqltime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Units of time that are supported by Interval.
+const (
+	Second = "second"
+	Minute = "minute"
+	Hour   = "hour"
+	Day    = "day"
+	Week   = "week"
+	Month  = "month"
+	Year   = "year"
+)
+
+// Interval describes the size of a time bucket. For example, 15 minutes.
+type Interval struct {
+	// N is the number of units in the interval.
+	N int
+	// Unit of the interval. One of: Second, Minute, Hour, Day, Week, Month and Year.
+	Unit string
+}
+
+// ParseInterval parses an interval in the format of "<n> <unit>" or "<unit>".
+// For example, "1 hour", "15 minutes" or "day".
+func ParseInterval(s string) (Interval, error) {
+	i := Interval{N: 1}
+	parts := strings.Fields(strings.ToLower(s))
+	switch len(parts) {
+	case 1:
+		i.Unit = parts[0]
+	case 2:
+		n, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return Interval{}, fmt.Errorf("sqltime: invalid interval %q: %w", s, err)
+		}
+		i.N, i.Unit = n, parts[1]
+	default:
+		return Interval{}, fmt.Errorf("sqltime: invalid interval %q", s)
+	}
+	i.Unit = strings.TrimSuffix(i.Unit, "s")
+	if err := i.Validate(); err != nil {
+		return Interval{}, err
+	}
+	return i, nil
+}
+
+// Validate reports an error if the interval is invalid.
+func (i Interval) Validate() error {
+	switch {
+	case i.N <= 0:
+		return fmt.Errorf("sqltime: non-positive interval size %d", i.N)
+	case i.Unit == Month || i.Unit == Year:
+		if i.N != 1 {
+			return fmt.Errorf("sqltime: unsupported interval of %d %ss", i.N, i.Unit)
+		}
+	case i.seconds() == 0:
+		return fmt.Errorf("sqltime: unknown interval unit %q", i.Unit)
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (i Interval) String() string {
+	if i.N == 1 {
+		return "1 " + i.Unit
+	}
+	return strconv.Itoa(i.N) + " " + i.Unit + "s"
+}
+
+// seconds returns the length of fixed-size intervals in seconds,
+// and 0 for calendar intervals or unknown units.
+func (i Interval) seconds() int64 {
+	var s int64
+	switch i.Unit {
+	case Second:
+		s = 1
+	case Minute:
+		s = 60
+	case Hour:
+		s = 60 * 60
+	case Day:
+		s = 24 * 60 * 60
+	case Week:
+		s = 7 * 24 * 60 * 60
+	}
+	return s * int64(i.N)
+}
+
+// weekOffset aligns the week buckets to Monday, as 1970-01-01 was a Thursday.
+const weekOffset = 4 * 24 * 60 * 60
+
+// Bucket returns an SQL expression that truncates the given column into the start time of its bucket,
+// represented as a Unix timestamp in seconds. The column is written as-is to the expression, and is
+// expected to be quoted. For example, using Selector.C:
+//
+//	t := sql.Dialect(dialect.Postgres).Table("events")
+//	b := sqltime.Bucket(dialect.Postgres, t.C("created_at"), sqltime.Interval{N: 15, Unit: sqltime.Minute})
+//	sql.Dialect(dialect.Postgres).
+//		Select(b, sql.Count("*")).
+//		From(t).
+//		GroupBy(b)
+//
+// Week buckets start on Monday, and calendar buckets (Month and Year) are computed using date_trunc
+// in PostgreSQL, strftime modifiers in SQLite and DATE_FORMAT in MySQL.
+func Bucket(name, column string, i Interval) string {
+	b := &sql.Builder{}
+	b.SetDialect(name)
+	if i.Unit == Month || i.Unit == Year {
+		calendar(b, column, i.Unit)
+		return b.String()
+	}
+	secs, offset := i.seconds(), int64(0)
+	if i.Unit == Week {
+		offset = weekOffset
+	}
+	// SQLite does not provide the FLOOR function by default, and
+	// integer division is used instead. Note, this affects times
+	// before the Unix epoch, which are truncated towards zero.
+	if name != dialect.SQLite {
+		b.WriteString("FLOOR")
+	}
+	b.Nested(func(b *sql.Builder) {
+		b.Nested(func(b *sql.Builder) {
+			epoch(b, column)
+			if offset > 0 {
+				b.WriteString(" - ").WriteString(strconv.FormatInt(offset, 10))
+			}
+		})
+		b.WriteString(" / ").WriteString(strconv.FormatInt(secs, 10))
+	})
+	b.WriteString(" * ").WriteString(strconv.FormatInt(secs, 10))
+	if offset > 0 {
+		b.WriteString(" + ").WriteString(strconv.FormatInt(offset, 10))
+	}
+	return b.String()
+}
+
+// epoch writes an expression that converts the column to a Unix timestamp.
+func epoch(b *sql.Builder, column string) {
+	switch b.Dialect() {
+	case dialect.SQLite:
+		b.WriteString("CAST(strftime('%s', ").WriteString(column).WriteString(") AS INTEGER)")
+	case dialect.Postgres:
+		b.WriteString("EXTRACT(EPOCH FROM ").WriteString(column).WriteString(")")
+	default:
+		b.WriteString("UNIX_TIMESTAMP(").WriteString(column).WriteString(")")
+	}
+}
+
+// calendar writes an expression that truncates the column to
+// the start of its month or year, and returns it as a Unix timestamp.
+func calendar(b *sql.Builder, column, unit string) {
+	switch b.Dialect() {
+	case dialect.SQLite:
+		b.WriteString("CAST(strftime('%s', ").WriteString(column).WriteString(", 'start of ").WriteString(unit).WriteString("') AS INTEGER)")
+	case dialect.Postgres:
+		b.WriteString("EXTRACT(EPOCH FROM DATE_TRUNC('").WriteString(unit).WriteString("', ").WriteString(column).WriteString("))")
+	default:
+		format := "%Y-%m-01"
+		if unit == Year {
+			format = "%Y-01-01"
+		}
+		b.WriteString("UNIX_TIMESTAMP(DATE_FORMAT(").WriteString(column).WriteString(", '").WriteString(format).WriteString("'))")
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqltime_test
+
+import (
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqltime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    sqltime.Interval
+		wantErr bool
+	}{
+		{input: "hour", want: sqltime.Interval{N: 1, Unit: sqltime.Hour}},
+		{input: "1 hour", want: sqltime.Interval{N: 1, Unit: sqltime.Hour}},
+		{input: "15 Minutes", want: sqltime.Interval{N: 15, Unit: sqltime.Minute}},
+		{input: " 2  weeks ", want: sqltime.Interval{N: 2, Unit: sqltime.Week}},
+		{input: "1 month", want: sqltime.Interval{N: 1, Unit: sqltime.Month}},
+		{input: "2 months", wantErr: true},
+		{input: "0 days", wantErr: true},
+		{input: "x days", wantErr: true},
+		{input: "1 fortnight", wantErr: true},
+		{input: "", wantErr: true},
+		{input: "1 hour ago", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			i, err := sqltime.ParseInterval(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, i)
+		})
+	}
+	require.Equal(t, "15 minutes", sqltime.Interval{N: 15, Unit: sqltime.Minute}.String())
+}
+
+func TestBucket(t *testing.T) {
+	tests := []struct {
+		dialect  string
+		interval sqltime.Interval
+		want     string
+	}{
+		{
+			dialect:  dialect.SQLite,
+			interval: sqltime.Interval{N: 1, Unit: sqltime.Hour},
+			want:     "((CAST(strftime('%s', `events`.`created_at`) AS INTEGER)) / 3600) * 3600",
+		},
+		{
+			dialect:  dialect.MySQL,
+			interval: sqltime.Interval{N: 15, Unit: sqltime.Minute},
+			want:     "FLOOR((UNIX_TIMESTAMP(`events`.`created_at`)) / 900) * 900",
+		},
+		{
+			dialect:  dialect.Postgres,
+			interval: sqltime.Interval{N: 1, Unit: sqltime.Week},
+			want:     `FLOOR((EXTRACT(EPOCH FROM "events"."created_at") - 345600) / 604800) * 604800 + 345600`,
+		},
+		{
+			dialect:  dialect.SQLite,
+			interval: sqltime.Interval{N: 1, Unit: sqltime.Month},
+			want:     "CAST(strftime('%s', `events`.`created_at`, 'start of month') AS INTEGER)",
+		},
+		{
+			dialect:  dialect.MySQL,
+			interval: sqltime.Interval{N: 1, Unit: sqltime.Year},
+			want:     "UNIX_TIMESTAMP(DATE_FORMAT(`events`.`created_at`, '%Y-01-01'))",
+		},
+		{
+			dialect:  dialect.Postgres,
+			interval: sqltime.Interval{N: 1, Unit: sqltime.Month},
+			want:     `EXTRACT(EPOCH FROM DATE_TRUNC('month', "events"."created_at"))`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect+"/"+tt.interval.String(), func(t *testing.T) {
+			c := sql.Dialect(tt.dialect).Table("events").C("created_at")
+			require.Equal(t, tt.want, sqltime.Bucket(tt.dialect, c, tt.interval))
+		})
+	}
+}
+
+func TestBucket_GroupBy(t *testing.T) {
+	d := sql.Dialect(dialect.Postgres)
+	t1 := d.Table("events")
+	b := sqltime.Bucket(dialect.Postgres, t1.C("created_at"), sqltime.Interval{N: 1, Unit: sqltime.Day})
+	query, args := d.Select(b, sql.Count("*")).From(t1).GroupBy(b).OrderBy(b).Query()
+	require.Equal(t, `SELECT FLOOR((EXTRACT(EPOCH FROM "events"."created_at")) / 86400) * 86400, COUNT(*) FROM "events" GROUP BY FLOOR((EXTRACT(EPOCH FROM "events"."created_at")) / 86400) * 86400 ORDER BY FLOOR((EXTRACT(EPOCH FROM "events"."created_at")) / 86400) * 86400`, query)
+	require.Empty(t, args)
+}
@@ -0,0 +1,173 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+//go:build js && wasm
+// +build js,wasm
+
+package sqlwasm
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"syscall/js"
+)
+
+// SQLJS returns an Engine for the given sql.js database object. For example:
+//
+//	// const SQL = await initSqlJs();
+//	// globalThis.db = new SQL.Database();
+//	drv, err := sqlwasm.OpenDB(sqlwasm.SQLJS(js.Global().Get("db")))
+//
+// Note that sql.js does not expose the declared types of the columns, and therefore, the values
+// of time columns are returned as text. Use the OO1 engine for schemas that have time fields.
+func SQLJS(db js.Value) Engine {
+	return &engine{db: db, free: "free", changes: func(db js.Value) js.Value {
+		return db.Call("getRowsModified")
+	}}
+}
+
+// OO1 returns an Engine for the given database object of the object-oriented API of the
+// official SQLite WASM build (e.g. an OPFS-backed database), where sqlite3 is the namespace
+// object of the build, that is used for reading the declared types of the columns. For example:
+//
+//	// globalThis.sqlite3 = await sqlite3InitModule();
+//	// globalThis.db = new sqlite3.oo1.OpfsDb("/app.db");
+//	drv, err := sqlwasm.OpenDB(sqlwasm.OO1(js.Global().Get("sqlite3"), js.Global().Get("db")))
+//
+func OO1(sqlite3, db js.Value) Engine {
+	capi := sqlite3.Get("capi")
+	return &engine{db: db, free: "finalize", changes: func(db js.Value) js.Value {
+		return db.Call("changes")
+	}, decltype: func(stmt js.Value, i int) string {
+		t := capi.Call("sqlite3_column_decltype", stmt.Get("pointer"), i)
+		if t.Type() != js.TypeString {
+			return ""
+		}
+		return t.String()
+	}}
+}
+
+// engine implements the Engine interface using the statement API that is shared
+// by sql.js and the SQLite WASM build (prepare, bind, step and get).
+type engine struct {
+	db      js.Value
+	free    string                  // Name of the method that releases a statement.
+	changes func(js.Value) js.Value // Returns the number of rows changed by the last statement.
+	// decltype returns the declared type of a column of the statement, if it is supported by the engine.
+	decltype func(stmt js.Value, i int) string
+}
+
+func (e *engine) Exec(query string, args []driver.Value) (r driver.Result, err error) {
+	defer catch(&err)
+	e.run(query, args, func(js.Value) {})
+	res := Result{Changes: int64(e.changes(e.db).Int())}
+	e.run("SELECT last_insert_rowid()", nil, func(stmt js.Value) {
+		v, _ := value(stmt.Call("get").Index(0))
+		res.LastID, _ = v.(int64)
+	})
+	return res, nil
+}
+
+func (e *engine) Query(query string, args []driver.Value) (columns []Column, rows [][]driver.Value, err error) {
+	defer catch(&err)
+	e.run(query, args, func(stmt js.Value) {
+		if columns == nil {
+			names := stmt.Call("getColumnNames")
+			columns = make([]Column, names.Length())
+			for i := range columns {
+				columns[i].Name = names.Index(i).String()
+				if e.decltype != nil {
+					columns[i].DeclType = e.decltype(stmt, i)
+				}
+			}
+		}
+		row := stmt.Call("get")
+		vs := make([]driver.Value, row.Length())
+		for i := range vs {
+			if vs[i], err = value(row.Index(i)); err != nil {
+				panic(err)
+			}
+		}
+		rows = append(rows, vs)
+	})
+	return columns, rows, nil
+}
+
+func (e *engine) Close() (err error) {
+	defer catch(&err)
+	e.db.Call("close")
+	return nil
+}
+
+// run executes the given query and calls f for each of its rows.
+func (e *engine) run(query string, args []driver.Value, f func(stmt js.Value)) {
+	stmt := e.db.Call("prepare", query)
+	defer stmt.Call(e.free)
+	if len(args) > 0 {
+		vs := make([]interface{}, len(args))
+		for i, arg := range args {
+			vs[i] = jsValue(arg)
+		}
+		stmt.Call("bind", vs)
+	}
+	for stmt.Call("step").Bool() {
+		f(stmt)
+	}
+}
+
+// jsValue converts an argument to its JavaScript value.
+func jsValue(v driver.Value) interface{} {
+	switch v := v.(type) {
+	case int64:
+		return float64(v)
+	case []byte:
+		a := js.Global().Get("Uint8Array").New(len(v))
+		js.CopyBytesToJS(a, v)
+		return a
+	default:
+		return v
+	}
+}
+
+// value converts a JavaScript value returned by the engine to a driver value.
+func value(v js.Value) (driver.Value, error) {
+	switch v.Type() {
+	case js.TypeNull, js.TypeUndefined:
+		return nil, nil
+	case js.TypeString:
+		return v.String(), nil
+	case js.TypeBoolean:
+		if v.Bool() {
+			return int64(1), nil
+		}
+		return int64(0), nil
+	case js.TypeNumber:
+		f := v.Float()
+		if f == math.Trunc(f) && math.Abs(f) <= 1<<53-1 {
+			return int64(f), nil
+		}
+		return f, nil
+	case js.TypeObject:
+		if v.InstanceOf(js.Global().Get("Uint8Array")) {
+			b := make([]byte, v.Length())
+			js.CopyBytesToGo(b, v)
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("sqlwasm: unexpected value type %s", v.Type())
+}
+
+// catch recovers from the JavaScript errors that are thrown by the engine.
+func catch(err *error) {
+	switch e := recover().(type) {
+	case nil:
+	case js.Error:
+		*err = fmt.Errorf("sqlwasm: %s", e.Get("message").String())
+	case error:
+		*err = e
+	default:
+		panic(e)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlwasm provides an SQLite driver for programs that are compiled to WebAssembly and executed
+// in the browser, where the database engine is provided by the JavaScript host (e.g. sql.js or the
+// OPFS-backed SQLite WASM build). It allows local-first applications to use the same generated client
+// both on the server and in the browser.
+//
+//	//go:build js && wasm
+//
+//	func main() {
+//		// The sql.js database object is created by the JavaScript
+//		// host and is passed to the Go program as a global.
+//		drv, err := sqlwasm.OpenDB(sqlwasm.SQLJS(js.Global().Get("db")))
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		client := ent.NewClient(ent.Driver(drv))
+//		// ...
+//	}
+//
+// Since the JavaScript engines store values using JavaScript types, time values are stored as text
+// using the format of the github.com/mattn/go-sqlite3 driver. Like in that driver, text values of
+// columns that are declared as DATE, DATETIME or TIMESTAMP are returned as time.Time values, and
+// other text values are returned as is. Integers beyond the safe integer range of JavaScript (2^53-1)
+// are passed to the engine as floating-point numbers.
+package sqlwasm
+
+import (
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Engine is the interface implemented by the SQLite engines that are executed by the JavaScript
+// host. The arguments that are passed to the engine and the values that are returned by it are
+// nil, int64, float64, string or []byte. Engine implementations for sql.js and the OPFS-backed
+// SQLite WASM build are provided by the SQLJS and OO1 functions, and are available only in
+// js/wasm builds.
+type Engine interface {
+	// Exec executes a statement with the given arguments and returns its result.
+	Exec(query string, args []driver.Value) (driver.Result, error)
+	// Query executes a query with the given arguments and returns its columns and rows.
+	Query(query string, args []driver.Value) (columns []Column, rows [][]driver.Value, err error)
+	// Close closes the database.
+	Close() error
+}
+
+// Column describes a column of the rows that are returned by Engine.Query.
+type Column struct {
+	// Name of the column.
+	Name string
+	// DeclType is the declared type of the column (e.g. "datetime"), or
+	// empty if it is unknown or if the column is an expression.
+	DeclType string
+}
+
+// Result is a driver.Result that can be returned by Engine implementations.
+type Result struct {
+	LastID  int64 // Last inserted rowid.
+	Changes int64 // Number of changed rows.
+}
+
+// LastInsertId implements the driver.Result interface.
+func (r Result) LastInsertId() (int64, error) { return r.LastID, nil }
+
+// RowsAffected implements the driver.Result interface.
+func (r Result) RowsAffected() (int64, error) { return r.Changes, nil }
+
+// OpenDB returns an SQLite driver that executes its queries using the given engine and enables
+// the foreign-key constraints of the database. Since the engine holds a single connection, the
+// returned driver uses at most one connection, and operations that are executed outside of an
+// open transaction block until it is done.
+func OpenDB(e Engine) (*sql.Driver, error) {
+	if _, err := e.Exec("PRAGMA foreign_keys = on", nil); err != nil {
+		return nil, fmt.Errorf("sqlwasm: enable foreign keys: %w", err)
+	}
+	db := stdsql.OpenDB(&connector{e: e})
+	db.SetMaxOpenConns(1)
+	return sql.OpenDB(dialect.SQLite, db), nil
+}
+
+// TimeFormat is the format of the time values stored in the database.
+const TimeFormat = "2006-01-02 15:04:05.999999999-07:00"
+
+// timeFormats are the formats of the text values of time columns that are returned
+// as time values. They are the formats that are accepted by the mattn/go-sqlite3 driver.
+var timeFormats = [...]string{
+	TimeFormat,
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// connector implements the driver.Connector interface.
+type connector struct {
+	e Engine
+}
+
+func (c *connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{e: c.e}, nil
+}
+
+func (c *connector) Driver() driver.Driver {
+	return wasmDriver{c}
+}
+
+// wasmDriver implements the driver.Driver interface.
+type wasmDriver struct {
+	c *connector
+}
+
+func (d wasmDriver) Open(string) (driver.Conn, error) {
+	return d.c.Connect(context.Background())
+}
+
+// conn is a connection that executes its queries using the engine.
+type conn struct {
+	e Engine
+}
+
+func (*conn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("sqlwasm: prepared statements are not supported")
+}
+
+func (c *conn) Close() error { return c.e.Close() }
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	if _, err := c.e.Exec("BEGIN", nil); err != nil {
+		return nil, err
+	}
+	return &tx{e: c.e}, nil
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	vs, err := values(args)
+	if err != nil {
+		return nil, err
+	}
+	columns, rs, err := c.e.Query(query, vs)
+	if err != nil {
+		return nil, err
+	}
+	return &rows{columns: columns, rows: rs}, nil
+}
+
+func (c *conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	vs, err := values(args)
+	if err != nil {
+		return nil, err
+	}
+	return c.e.Exec(query, vs)
+}
+
+// values converts the query arguments to the values that are passed to the engine.
+func values(args []driver.NamedValue) ([]driver.Value, error) {
+	vs := make([]driver.Value, len(args))
+	for i, arg := range args {
+		if arg.Name != "" {
+			return nil, fmt.Errorf("sqlwasm: named argument %q is not supported", arg.Name)
+		}
+		switch v := arg.Value.(type) {
+		case bool:
+			if v {
+				vs[i] = int64(1)
+			} else {
+				vs[i] = int64(0)
+			}
+		case time.Time:
+			vs[i] = v.Format(TimeFormat)
+		default:
+			vs[i] = v
+		}
+	}
+	return vs, nil
+}
+
+// tx is a transaction that is executed using the BEGIN, COMMIT and ROLLBACK statements.
+type tx struct {
+	e Engine
+}
+
+func (t *tx) Commit() error {
+	_, err := t.e.Exec("COMMIT", nil)
+	return err
+}
+
+func (t *tx) Rollback() error {
+	_, err := t.e.Exec("ROLLBACK", nil)
+	return err
+}
+
+// rows serves the rows that were returned by the engine.
+type rows struct {
+	columns []Column
+	rows    [][]driver.Value
+	idx     int
+}
+
+func (r *rows) Columns() []string {
+	names := make([]string, len(r.columns))
+	for i, c := range r.columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+func (r *rows) Close() error { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.idx >= len(r.rows) {
+		return io.EOF
+	}
+	for i, v := range r.rows[r.idx] {
+		if s, ok := v.(string); ok && i < len(r.columns) && isTime(r.columns[i].DeclType) {
+			v = parseTime(s)
+		}
+		dest[i] = v
+	}
+	r.idx++
+	return nil
+}
+
+// isTime reports if the declared type of a column holds time values.
+func isTime(decltype string) bool {
+	switch strings.ToLower(decltype) {
+	case "date", "datetime", "timestamp":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseTime returns the time value of the given text of a time
+// column, or the text itself if it is not formatted as a time.
+func parseTime(s string) driver.Value {
+	v := strings.TrimSuffix(s, "Z")
+	for _, f := range timeFormats {
+		if t, err := time.ParseInLocation(f, v, time.UTC); err == nil {
+			return t
+		}
+	}
+	return s
+}
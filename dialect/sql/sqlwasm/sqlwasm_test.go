@@ -0,0 +1,168 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlwasm
+
+import (
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEngine is an Engine that mimics the JavaScript engines using
+// a database/sql connection, and records the executed statements.
+type fakeEngine struct {
+	db    *stdsql.DB
+	execs []string
+}
+
+func (e *fakeEngine) Exec(query string, args []driver.Value) (driver.Result, error) {
+	e.execs = append(e.execs, query)
+	res, err := e.db.Exec(query, e.args(args)...)
+	if err != nil {
+		return nil, err
+	}
+	id, _ := res.LastInsertId()
+	n, _ := res.RowsAffected()
+	return Result{LastID: id, Changes: n}, nil
+}
+
+func (e *fakeEngine) Query(query string, args []driver.Value) ([]Column, [][]driver.Value, error) {
+	rows, err := e.db.Query(query, e.args(args)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, nil, err
+	}
+	columns := make([]Column, len(types))
+	for i, t := range types {
+		columns[i] = Column{Name: t.Name(), DeclType: t.DatabaseTypeName()}
+	}
+	var vs [][]driver.Value
+	for rows.Next() {
+		row, ptrs := make([]driver.Value, len(columns)), make([]interface{}, len(columns))
+		for i := range row {
+			ptrs[i] = &row[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, nil, err
+		}
+		// The JavaScript engines return time values as text.
+		for i, v := range row {
+			if t, ok := v.(time.Time); ok {
+				row[i] = t.Format(TimeFormat)
+			}
+		}
+		vs = append(vs, row)
+	}
+	return columns, vs, rows.Err()
+}
+
+func (e *fakeEngine) Close() error { return e.db.Close() }
+
+// args asserts that the arguments are only of the types supported by the JavaScript engines.
+func (e *fakeEngine) args(args []driver.Value) []interface{} {
+	vs := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch arg.(type) {
+		case nil, int64, float64, string, []byte:
+		default:
+			panic("unexpected argument type")
+		}
+		vs[i] = arg
+	}
+	return vs
+}
+
+func TestOpenDB(t *testing.T) {
+	db, err := stdsql.Open("sqlite3", "file:ent?mode=memory&cache=shared")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	e := &fakeEngine{db: db}
+	drv, err := OpenDB(e)
+	require.NoError(t, err)
+	defer drv.Close()
+	require.Equal(t, dialect.SQLite, drv.Dialect())
+	require.Equal(t, []string{"PRAGMA foreign_keys = on"}, e.execs)
+
+	ctx := context.Background()
+	err = drv.Exec(ctx, "CREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT, active BOOL, avatar BLOB, created_at DATETIME, score REAL)", []interface{}{}, nil)
+	require.NoError(t, err)
+
+	created := time.Date(2022, 7, 1, 10, 0, 0, 5, time.UTC)
+	var res stdsql.Result
+	query, args := sql.Dialect(dialect.SQLite).
+		Insert("users").
+		Columns("name", "active", "avatar", "created_at", "score").
+		Values("a8m", true, []byte("png"), created, 1.5).
+		Query()
+	require.NoError(t, drv.Exec(ctx, query, args, &res))
+	id, err := res.LastInsertId()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), id)
+
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "INSERT INTO users (name) VALUES (?)", []interface{}{"nati"}, nil))
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, []string{"BEGIN", "INSERT INTO users (name) VALUES (?)", "ROLLBACK"}, e.execs[len(e.execs)-3:])
+
+	var rows sql.Rows
+	require.NoError(t, drv.Query(ctx, "SELECT name, active, avatar, created_at, score, CURRENT_TIMESTAMP FROM users", []interface{}{}, &rows))
+	var (
+		n       int
+		name    string
+		active  bool
+		avatar  []byte
+		createT time.Time
+		score   float64
+		now     string
+	)
+	for rows.Next() {
+		n++
+		require.NoError(t, rows.Scan(&name, &active, &avatar, &createT, &score, &now))
+	}
+	require.NoError(t, rows.Close())
+	require.Equal(t, 1, n, "rolled back insert should not be visible")
+	require.Equal(t, "a8m", name)
+	require.True(t, active)
+	require.Equal(t, []byte("png"), avatar)
+	require.True(t, created.Equal(createT))
+	require.Equal(t, 1.5, score)
+	require.Len(t, now, len("2006-01-02 15:04:05"), "expressions are returned as text")
+
+	// Text columns that hold values that are formatted as timestamps are returned as is.
+	stamp := "2022-07-01 10:00:00"
+	require.NoError(t, drv.Exec(ctx, "UPDATE users SET name = ?", []interface{}{stamp}, nil))
+	require.NoError(t, drv.Query(ctx, "SELECT name FROM users", []interface{}{}, &rows))
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&name))
+	require.NoError(t, rows.Close())
+	require.Equal(t, stamp, name)
+}
+
+func TestParseTime(t *testing.T) {
+	for _, s := range []string{"", "a8m", "2022/07/01", "10:00:00"} {
+		require.Equal(t, s, parseTime(s))
+	}
+	for _, s := range []string{"2022-07-01 10:00:00", "2022-07-01T10:00:00Z", "2022-07-01 10:00", "2022-07-01 13:00:00+03:00"} {
+		require.True(t, time.Date(2022, 7, 1, 10, 0, 0, 0, time.UTC).Equal(parseTime(s).(time.Time)), s)
+	}
+	require.Equal(t, time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC), parseTime("2022-07-01"))
+	require.True(t, isTime("DATETIME"))
+	require.True(t, isTime("timestamp"))
+	require.False(t, isTime("TEXT"))
+	require.False(t, isTime(""))
+}
@@ -0,0 +1,237 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// DriverOption allows configuring the Driver using functional options.
+type DriverOption func(*Driver)
+
+// WithStmtCache enables caching of prepared statements in the Driver. Statements are keyed by their query
+// string, and are shared by all connections of the pool, as the database/sql package prepares them lazily on
+// each connection it uses. At most size statements are cached, and the least recently used statements are
+// closed once the limit is exceeded. A non-positive size disables the cache. For example:
+//
+//	drv, err := sql.Open(dialect.Postgres, dsn, sql.WithStmtCache(512))
+//
+// Statements that fail with a bad connection or an invalidated statement (e.g. after a schema change) are evicted
+// from the cache and closed, and they are prepared again on their next use. Other failures, like constraint errors,
+// keep the statements cached.
+// Note that the cache is intended for applications that execute a bounded set of queries (the case of the
+// generated code). Queries whose text varies (e.g. IN predicates with a different number of arguments) are
+// cached separately, and may evict more valuable statements.
+func WithStmtCache(size int) DriverOption {
+	return func(d *Driver) {
+		if size > 0 {
+			d.stmts = newStmtCache(size)
+		}
+	}
+}
+
+// stmtCache is an LRU cache of prepared statements.
+type stmtCache struct {
+	size int
+	mu   sync.Mutex
+	ll   *list.List
+	// entries holds the list elements by their query strings.
+	entries map[string]*list.Element
+}
+
+// stmtEntry is a cached statement. Evicted statements are
+// closed once they are not used by any of the callers.
+type stmtEntry struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+func newStmtCache(size int) *stmtCache {
+	return &stmtCache{size: size, ll: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// get returns the cached statement of the query, or nil if it is not cached.
+// Callers must call release with the error of the statement execution once it is done.
+func (c *stmtCache) get(query string) *stmtEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[query]
+	if !ok {
+		return nil
+	}
+	c.ll.MoveToFront(el)
+	e := el.Value.(*stmtEntry)
+	e.refs++
+	return e
+}
+
+// acquire returns the cached statement of the query, or prepares and caches a new one.
+// Callers must call release with the error of the statement execution once it is done.
+func (c *stmtCache) acquire(ctx context.Context, db *sql.DB, query string) (*stmtEntry, error) {
+	if e := c.get(query); e != nil {
+		return e, nil
+	}
+	// Statements are prepared without holding the lock, and concurrent callers
+	// may prepare the same query. In this case, only one of them is cached.
+	stmt, err := db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[query]; ok {
+		c.ll.MoveToFront(el)
+		e := el.Value.(*stmtEntry)
+		e.refs++
+		stmt.Close()
+		return e, nil
+	}
+	e := &stmtEntry{query: query, stmt: stmt, refs: 1}
+	c.entries[query] = c.ll.PushFront(e)
+	for c.ll.Len() > c.size {
+		c.evict(c.ll.Back().Value.(*stmtEntry))
+	}
+	return e, nil
+}
+
+// release releases the given entry, and evicts it from the cache
+// if the execution failed with an error that may invalidate it.
+func (c *stmtCache) release(e *stmtEntry, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil && invalidStmt(err) {
+		c.evict(e)
+	}
+	if e.refs--; e.refs == 0 && e.evicted {
+		e.stmt.Close()
+	}
+}
+
+// evict removes the entry from the cache, and closes its statement if it is not in use.
+// Statements that are in use are closed by the last caller that releases them.
+func (c *stmtCache) evict(e *stmtEntry) {
+	if e.evicted {
+		return
+	}
+	e.evicted = true
+	if el, ok := c.entries[e.query]; ok && el.Value == e {
+		c.ll.Remove(el)
+		delete(c.entries, e.query)
+	}
+	if e.refs == 0 {
+		e.stmt.Close()
+	}
+}
+
+// invalidStmt reports if the error of a statement execution indicates that
+// the statement is not usable anymore, and should be prepared again.
+func invalidStmt(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return true
+	}
+	msg := err.Error()
+	for _, s := range []string{
+		"statement is closed",                     // database/sql
+		"cached plan must not change result type", // Postgres
+		"Error 1243",                              // MySQL (Unknown prepared statement handler).
+		"Error 1615",                              // MySQL (Prepared statement needs to be re-prepared).
+	} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	// Postgres (prepared statement "name" does not exist).
+	return strings.Contains(msg, "prepared statement") && strings.Contains(msg, "does not exist")
+}
+
+// len returns the number of cached statements.
+func (c *stmtCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// close evicts all statements from the cache.
+func (c *stmtCache) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.ll.Len() > 0 {
+		c.evict(c.ll.Back().Value.(*stmtEntry))
+	}
+}
+
+// stmtDB is an ExecQuerier that executes the queries using the cached statements of the database.
+type stmtDB struct {
+	*sql.DB
+	stmts *stmtCache
+}
+
+// ExecContext executes the query using its cached statement.
+func (s *stmtDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e, err := s.stmts.acquire(ctx, s.DB, query)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.stmt.ExecContext(ctx, args...)
+	s.stmts.release(e, err)
+	return res, err
+}
+
+// QueryContext executes the query using its cached statement. Closing a statement that has open rows is
+// deferred by the database/sql package until the rows are closed, and therefore, the statement is released
+// once the query was executed.
+func (s *stmtDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	e, err := s.stmts.acquire(ctx, s.DB, query)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := e.stmt.QueryContext(ctx, args...)
+	s.stmts.release(e, err)
+	return rows, err
+}
+
+// stmtTx is an ExecQuerier that executes the queries of a transaction using the cached statements of
+// the database. Statements that were already prepared on the connection of the transaction are reused
+// by the database/sql package, and the others are prepared on the connection. Queries that were not
+// cached are executed without being prepared, as preparing them on the database may require another
+// connection from the pool, while the transaction holds one.
+type stmtTx struct {
+	*sql.Tx
+	stmts *stmtCache
+}
+
+// ExecContext executes the query using its cached statement, if it exists.
+func (s *stmtTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e := s.stmts.get(query)
+	if e == nil {
+		return s.Tx.ExecContext(ctx, query, args...)
+	}
+	stmt := s.StmtContext(ctx, e.stmt)
+	res, err := stmt.ExecContext(ctx, args...)
+	stmt.Close()
+	s.stmts.release(e, err)
+	return res, err
+}
+
+// QueryContext executes the query using its cached statement, if it exists.
+func (s *stmtTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	e := s.stmts.get(query)
+	if e == nil {
+		return s.Tx.QueryContext(ctx, query, args...)
+	}
+	stmt := s.StmtContext(ctx, e.stmt)
+	rows, err := stmt.QueryContext(ctx, args...)
+	stmt.Close()
+	s.stmts.release(e, err)
+	return rows, err
+}
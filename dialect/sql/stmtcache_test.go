@@ -0,0 +1,121 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStmtCache(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := OpenDB(dialect.MySQL, db, WithStmtCache(2))
+	require.Equal(t, db, drv.DB())
+
+	insert := "INSERT INTO `users` (`name`) VALUES (?)"
+	mock.ExpectPrepare(regexp.QuoteMeta(insert))
+	mock.ExpectExec(regexp.QuoteMeta(insert)).WithArgs("a8m").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(insert)).WithArgs("nati").WillReturnResult(sqlmock.NewResult(2, 1))
+	require.NoError(t, drv.Exec(ctx, insert, []interface{}{"a8m"}, nil))
+	require.NoError(t, drv.Exec(ctx, insert, []interface{}{"nati"}, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, 1, drv.stmts.len())
+
+	count := "SELECT COUNT(*) FROM `users`"
+	mock.ExpectPrepare(regexp.QuoteMeta(count))
+	mock.ExpectQuery(regexp.QuoteMeta(count)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(count)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	for i := 0; i < 2; i++ {
+		rows := &Rows{}
+		require.NoError(t, drv.Query(ctx, count, []interface{}{}, rows))
+		n, err := ScanInt(rows)
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, 2, drv.stmts.len())
+
+	// The least recently used statement (INSERT) is evicted and closed.
+	update := "UPDATE `users` SET `name` = ?"
+	mock.ExpectPrepare(regexp.QuoteMeta(update))
+	mock.ExpectExec(regexp.QuoteMeta(update)).WithArgs("a8m").WillReturnResult(sqlmock.NewResult(0, 2))
+	require.NoError(t, drv.Exec(ctx, update, []interface{}{"a8m"}, nil))
+	require.Equal(t, 2, drv.stmts.len())
+	mock.ExpectPrepare(regexp.QuoteMeta(insert))
+	mock.ExpectExec(regexp.QuoteMeta(insert)).WithArgs("a8m").WillReturnResult(sqlmock.NewResult(3, 1))
+	require.NoError(t, drv.Exec(ctx, insert, []interface{}{"a8m"}, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Statements that fail are evicted, and prepared again on their next use.
+	mock.ExpectExec(regexp.QuoteMeta(insert)).WithArgs("a8m").WillReturnError(errors.New("prepared statement does not exist"))
+	require.Error(t, drv.Exec(ctx, insert, []interface{}{"a8m"}, nil))
+	require.Equal(t, 1, drv.stmts.len())
+	mock.ExpectPrepare(regexp.QuoteMeta(insert))
+	mock.ExpectExec(regexp.QuoteMeta(insert)).WithArgs("a8m").WillReturnResult(sqlmock.NewResult(4, 1))
+	require.NoError(t, drv.Exec(ctx, insert, []interface{}{"a8m"}, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Other failures, like constraint errors, keep the statements cached.
+	mock.ExpectExec(regexp.QuoteMeta(insert)).WithArgs("a8m").WillReturnError(errors.New("Error 1062: Duplicate entry 'a8m' for key 'name'"))
+	require.Error(t, drv.Exec(ctx, insert, []interface{}{"a8m"}, nil))
+	require.Equal(t, 2, drv.stmts.len())
+	mock.ExpectExec(regexp.QuoteMeta(insert)).WithArgs("nati").WillReturnResult(sqlmock.NewResult(5, 1))
+	require.NoError(t, drv.Exec(ctx, insert, []interface{}{"nati"}, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Failures to prepare statements are returned, and are not cached.
+	mock.ExpectPrepare(regexp.QuoteMeta("DELETE")).WillReturnError(errors.New("syntax error"))
+	require.Error(t, drv.Exec(ctx, "DELETE FROM `users`", []interface{}{}, nil))
+	require.Equal(t, 2, drv.stmts.len())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectClose()
+	require.NoError(t, drv.Close())
+	require.Zero(t, drv.stmts.len())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStmtCache_Tx(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := OpenDB(dialect.MySQL, db, WithStmtCache(10))
+
+	insert := "INSERT INTO `users` (`name`) VALUES (?)"
+	mock.ExpectPrepare(regexp.QuoteMeta(insert))
+	mock.ExpectExec(regexp.QuoteMeta(insert)).WithArgs("a8m").WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, drv.Exec(ctx, insert, []interface{}{"a8m"}, nil))
+
+	// Cached statements are used by transactions, and
+	// the others are executed without being prepared.
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(insert)).WithArgs("nati").WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `users`")).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, insert, []interface{}{"nati"}, nil))
+	require.NoError(t, tx.Exec(ctx, "DELETE FROM `users`", []interface{}{}, nil))
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, 1, drv.stmts.len())
+}
+
+func TestStmtCache_Disabled(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := OpenDB(dialect.MySQL, db, WithStmtCache(0))
+	require.Nil(t, drv.stmts)
+	require.Equal(t, db, drv.ExecQuerier)
+}
@@ -7,7 +7,10 @@ package ent
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -63,6 +66,9 @@ type (
 		// Hooks returns an optional list of Hook to apply on
 		// mutations.
 		Hooks() []Hook
+		// Interceptors returns an optional list of Interceptor
+		// to apply on queries.
+		Interceptors() []Interceptor
 		// Policy returns the privacy policy of the schema.
 		Policy() Policy
 		// Annotations returns a list of schema annotations to be used by
@@ -169,6 +175,9 @@ type (
 		// Hooks returns a slice of hooks to add to the schema.
 		// Note that mixin hooks are executed before schema hooks.
 		Hooks() []Hook
+		// Interceptors returns a slice of interceptors to add to the schema.
+		// Note that mixin interceptors are executed before schema interceptors.
+		Interceptors() []Interceptor
 		// Policy returns a privacy policy to add to the schema.
 		// Note that mixin policy are executed before schema policy.
 		Policy() Policy
@@ -223,6 +232,9 @@ func (Schema) Mixin() []Mixin { return nil }
 // Hooks of the schema.
 func (Schema) Hooks() []Hook { return nil }
 
+// Interceptors of the schema.
+func (Schema) Interceptors() []Interceptor { return nil }
+
 // Policy of the schema.
 func (Schema) Policy() Policy { return nil }
 
@@ -234,6 +246,33 @@ type (
 	Value interface{}
 	// Query represents an ent query builder.
 	Query interface{}
+	// FieldInfo describes a field of an ent schema. It is returned by the Fields
+	// function of the generated entity packages (e.g. user.Fields), and allows
+	// generic code (e.g. admin or export tools) to iterate the fields of a type
+	// without loading its schema package.
+	FieldInfo struct {
+		// Name of the field in the schema. e.g. "created_at".
+		Name string
+		// Column holds the storage-key of the field. e.g. SQL column name.
+		Column string
+		// StructField holds the name of the field in the generated struct.
+		StructField string
+		// Type and GoType hold the type of the field, and its Go type. e.g. "time.Time".
+		Type   field.Type
+		GoType string
+		// Enums holds the values of enum fields.
+		Enums []string
+		// Optional, Nillable, Immutable, Sensitive, Unique and Default
+		// report the options that were set on the field in the schema.
+		Optional, Nillable, Immutable, Sensitive, Unique, Default bool
+		// Comment holds the comment of the field.
+		Comment string
+		// Owner, Classification and Retention hold the lineage information of the
+		// field, as defined by the entlineage annotation of the field or its schema.
+		Owner          string
+		Classification string
+		Retention      time.Duration
+	}
 	// Mutation represents an operation that mutate the graph.
 	// For example, adding a new node, updating many, or dropping
 	// data. The implementation is generated by entc (ent codegen).
@@ -363,3 +402,189 @@ const (
 func (i Op) Is(o Op) bool { return i&o != 0 }
 
 //go:generate go run golang.org/x/tools/cmd/stringer -type Op
+
+type (
+	// Querier is the interface that wraps the Query method. Calling Querier.Query(ent.Query)
+	// executes the given query (e.g. *ent.UserQuery), and returns its result. For example,
+	// the nodes that are returned by the All method, or the number that is returned by Count.
+	Querier interface {
+		// Query executes the given query.
+		Query(context.Context, Query) (Value, error)
+	}
+
+	// The QuerierFunc type is an adapter to allow the use of ordinary
+	// function as Querier. If f is a function with the appropriate signature,
+	// QuerierFunc(f) is a Querier that calls f.
+	QuerierFunc func(context.Context, Query) (Value, error)
+
+	// Interceptor defines the "query middleware". It gets a Querier and returns a Querier,
+	// and it is executed on every query that is executed by the generated query builders
+	// (e.g. All, IDs, Count and Exist). Interceptors can modify the query before it is
+	// executed, for example, by adding predicates to it, or replace its result (e.g. cache).
+	//
+	//	ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+	//		return ent.QuerierFunc(func(ctx context.Context, query ent.Query) (ent.Value, error) {
+	//			qc := ent.QueryFromContext(ctx)
+	//			fmt.Printf("Type: %s, Operation: %s, ConcreteType: %T\n", qc.Type, qc.Op, query)
+	//			return next.Query(ctx, query)
+	//		})
+	//	})
+	//
+	Interceptor interface {
+		// Intercept is a function that gets a Querier and returns a Querier.
+		Intercept(Querier) Querier
+	}
+
+	// The InterceptFunc type is an adapter to allow the use of ordinary
+	// function as Interceptor. If f is a function with the appropriate
+	// signature, InterceptFunc(f) is an Interceptor that calls f.
+	InterceptFunc func(Querier) Querier
+
+	// Traverser defines a graph-traversal "middleware". Unlike interceptors that are executed
+	// only on the queries that return a result, traversers are executed also on the queries
+	// of the traversal steps (e.g. the user query in client.User.Query().QueryPets().All(ctx)),
+	// and therefore, they are used for scoping all the queries of a type (e.g. multi-tenancy).
+	Traverser interface {
+		// Traverse is called before the query (or the traversal step) is executed.
+		Traverse(context.Context, Query) error
+	}
+
+	// The TraverseFunc type is an adapter to allow the use of ordinary function as Traverser.
+	// If f is a function with the appropriate signature, TraverseFunc(f) is a Traverser that
+	// calls f. It implements the Interceptor interface as well, and can be registered as one.
+	TraverseFunc func(context.Context, Query) error
+
+	// QueryContext describes a query that is executed by an Interceptor. It is available
+	// in the context that is passed to the interceptors using QueryFromContext.
+	QueryContext struct {
+		// Type is the name of the queried type. e.g. "User".
+		Type string
+		// Op is the operation of the query. i.e. "All", "IDs", "Count" or "Exist".
+		Op string
+		// Limit and Offset hold the limit and offset of the query, if they were set.
+		Limit  *int
+		Offset *int
+		// Unique reports if the query was configured to return unique results, if it was set.
+		Unique *bool
+		// Fields holds the fields that were selected by the query, if any.
+		Fields []string
+		// Edges holds the names of the edges that are eager-loaded by the query, if any.
+		Edges []string
+		// EdgeQueries holds the queries of the eager-loaded edges (e.g. *gen.PetQuery for
+		// the WithPets option of a user query), keyed by their names. Interceptors may modify
+		// them, for example, for scoping the eager-loaded edges.
+		EdgeQueries map[string]Query
+		// Predicates holds the predicates that were added to the query (e.g. predicate.User),
+		// in the order they were added. Note that predicates that are added to the query by the
+		// interceptors are not reflected in the QueryContext.
+		Predicates []interface{}
+		// Order holds the ordering functions of the query (e.g. gen.Asc(user.FieldName)),
+		// in the order they were added.
+		Order []interface{}
+	}
+)
+
+// Query calls f(ctx, q).
+func (f QuerierFunc) Query(ctx context.Context, q Query) (Value, error) {
+	return f(ctx, q)
+}
+
+// Intercept calls f(next).
+func (f InterceptFunc) Intercept(next Querier) Querier {
+	return f(next)
+}
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFunc) Intercept(next Querier) Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFunc) Traverse(ctx context.Context, q Query) error {
+	return f(ctx, q)
+}
+
+// queryCtxKey is the context key of the QueryContext.
+type queryCtxKey struct{}
+
+// NewQueryContext returns a new context with the given QueryContext attached.
+func NewQueryContext(parent context.Context, qc *QueryContext) context.Context {
+	return context.WithValue(parent, queryCtxKey{}, qc)
+}
+
+// QueryFromContext returns the QueryContext value stored in ctx, if any.
+func QueryFromContext(ctx context.Context) *QueryContext {
+	qc, _ := ctx.Value(queryCtxKey{}).(*QueryContext)
+	return qc
+}
+
+type (
+	// Extension bundles runtime code, like hooks, interceptors, driver decorators and health checks,
+	// that is added to the generated clients as a whole using their AddExtension method. For example,
+	// packages that provide caching, auditing or metrics can be enabled and configured as follows:
+	//
+	//	client.AddExtension(metrics.NewExtension(metrics.WithRegistry(reg)))
+	//
+	// Extensions should embed DefaultExtension, and implement only the methods they need.
+	Extension interface {
+		// Name of the extension. It is used for reporting the errors of its health check.
+		Name() string
+		// Hooks returns the hooks that are added to all entity clients.
+		Hooks() []Hook
+		// Interceptors returns the interceptors that are added to all entity clients.
+		Interceptors() []Interceptor
+		// Driver returns the driver that wraps the given driver of the client (e.g. for tracing
+		// the executed statements), or nil if the extension does not decorate the driver.
+		Driver(dialect.Driver) dialect.Driver
+		// HealthCheck reports if the extension is healthy (e.g. its cache server is reachable).
+		HealthCheck(context.Context) error
+	}
+
+	// DefaultExtension is the default implementation for the Extension interface.
+	// Note that the Name method is not implemented, and should be implemented by
+	// the extensions that embed it.
+	DefaultExtension struct{}
+)
+
+// Hooks of the extension.
+func (DefaultExtension) Hooks() []Hook { return nil }
+
+// Interceptors of the extension.
+func (DefaultExtension) Interceptors() []Interceptor { return nil }
+
+// Driver of the extension.
+func (DefaultExtension) Driver(dialect.Driver) dialect.Driver { return nil }
+
+// HealthCheck of the extension.
+func (DefaultExtension) HealthCheck(context.Context) error { return nil }
+
+// extensions holds the globally registered extensions.
+var extensions struct {
+	sync.RWMutex
+	list []Extension
+}
+
+// RegisterExtension registers the given extensions globally. Registered extensions are added to all
+// generated clients that are created afterwards (using NewClient or Open). It allows enabling them
+// using build tags, by registering them in the init function of a file with the build constraint:
+//
+//	//go:build audit
+//
+//	package main
+//
+//	func init() {
+//		ent.RegisterExtension(audit.NewExtension())
+//	}
+//
+func RegisterExtension(exts ...Extension) {
+	extensions.Lock()
+	defer extensions.Unlock()
+	extensions.list = append(extensions.list, exts...)
+}
+
+// RegisteredExtensions returns the extensions that were registered using RegisterExtension.
+func RegisteredExtensions() []Extension {
+	extensions.RLock()
+	defer extensions.RUnlock()
+	return append([]Extension(nil), extensions.list...)
+}
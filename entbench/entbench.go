@@ -0,0 +1,326 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entbench provides a harness for benchmarking scenarios of ent clients (e.g. bulk
+// inserts, deep eager-loading and predicate-heavy scans), and emits comparable reports,
+// for tracking performance regressions across schema changes and ent versions.
+//
+//	counter := entbench.CountQueries(drv)
+//	client := ent.NewClient(ent.Driver(counter))
+//	report, err := entbench.Run(ctx, []entbench.Scenario{
+//		{
+//			Name: entbench.BulkInsert,
+//			Run: func(ctx context.Context) error {
+//				return client.User.CreateBulk(builders...).Exec(ctx)
+//			},
+//		},
+//	}, entbench.WithCounter(counter))
+//
+package entbench
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// Names of the standard scenarios.
+const (
+	// BulkInsert creates a batch of entities in a single operation.
+	BulkInsert = "bulk-insert"
+	// EagerLoad queries entities with multiple levels of eager-loaded edges.
+	EagerLoad = "eager-load"
+	// PredicateScan queries entities using many predicates (and/or/not, and edge predicates).
+	PredicateScan = "predicate-scan"
+)
+
+// Scenario is a benchmarked operation.
+type Scenario struct {
+	// Name of the scenario. For example, one of the standard scenarios.
+	Name string
+	// Setup prepares the data of the scenario. It is optional, and it is not measured.
+	Setup func(context.Context) error
+	// Run executes one iteration of the scenario.
+	Run func(context.Context) error
+}
+
+// Result holds the metrics of a scenario.
+type Result struct {
+	Name         string  `json:"name"`
+	Dialect      string  `json:"dialect,omitempty"`
+	Iterations   int     `json:"iterations"`
+	NsPerOp      int64   `json:"ns_per_op"`
+	AllocsPerOp  int64   `json:"allocs_per_op"`
+	BytesPerOp   int64   `json:"bytes_per_op"`
+	QueriesPerOp float64 `json:"queries_per_op"`
+}
+
+// Report holds the results of a run, and the environment they were measured in.
+type Report struct {
+	GoVersion  string   `json:"go_version"`
+	EntVersion string   `json:"ent_version"`
+	Results    []Result `json:"results"`
+}
+
+// Option allows configuring Run using functional options.
+type Option func(*options)
+
+type options struct {
+	duration time.Duration
+	dialect  string
+	counter  *Counter
+}
+
+// WithDuration sets the minimum duration each scenario is executed. The default is 1 second.
+func WithDuration(d time.Duration) Option {
+	return func(o *options) {
+		o.duration = d
+	}
+}
+
+// WithCounter sets the counter of the driver the scenarios are executed with, for measuring the
+// number of queries per operation. Its dialect is recorded in the results.
+func WithCounter(c *Counter) Option {
+	return func(o *options) {
+		o.counter = c
+		o.dialect = c.Dialect()
+	}
+}
+
+// Run executes the given scenarios, and returns their report.
+func Run(ctx context.Context, scenarios []Scenario, opts ...Option) (*Report, error) {
+	o := &options{duration: time.Second}
+	for _, opt := range opts {
+		opt(o)
+	}
+	report := &Report{GoVersion: runtime.Version(), EntVersion: entVersion()}
+	for _, s := range scenarios {
+		if s.Setup != nil {
+			if err := s.Setup(ctx); err != nil {
+				return nil, fmt.Errorf("entbench: setup %s: %w", s.Name, err)
+			}
+		}
+		// Warm up caches and connection pools.
+		if err := s.Run(ctx); err != nil {
+			return nil, fmt.Errorf("entbench: run %s: %w", s.Name, err)
+		}
+		var (
+			n       int
+			queries int64
+			before  runtime.MemStats
+			after   runtime.MemStats
+		)
+		if o.counter != nil {
+			queries = o.counter.Count()
+		}
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		for ; n == 0 || time.Since(start) < o.duration; n++ {
+			if err := s.Run(ctx); err != nil {
+				return nil, fmt.Errorf("entbench: run %s: %w", s.Name, err)
+			}
+		}
+		elapsed := time.Since(start)
+		runtime.ReadMemStats(&after)
+		r := Result{
+			Name:        s.Name,
+			Dialect:     o.dialect,
+			Iterations:  n,
+			NsPerOp:     elapsed.Nanoseconds() / int64(n),
+			AllocsPerOp: int64(after.Mallocs-before.Mallocs) / int64(n),
+			BytesPerOp:  int64(after.TotalAlloc-before.TotalAlloc) / int64(n),
+		}
+		if o.counter != nil {
+			r.QueriesPerOp = float64(o.counter.Count()-queries) / float64(n)
+		}
+		report.Results = append(report.Results, r)
+	}
+	return report, nil
+}
+
+// Benchmark runs the given scenario as a Go benchmark, and reports the number of
+// queries per operation if a counter is given. Note that the testing package calls
+// benchmarks once for each round of b.N, and therefore, the setup of the scenario
+// should be idempotent. For example:
+//
+//	func BenchmarkEagerLoad(b *testing.B) {
+//		entbench.Benchmark(b, scenario, counter)
+//	}
+//
+func Benchmark(b *testing.B, s Scenario, c *Counter) {
+	ctx := context.Background()
+	if s.Setup != nil {
+		if err := s.Setup(ctx); err != nil {
+			b.Fatalf("entbench: setup %s: %v", s.Name, err)
+		}
+	}
+	var queries int64
+	if c != nil {
+		queries = c.Count()
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := s.Run(ctx); err != nil {
+			b.Fatalf("entbench: run %s: %v", s.Name, err)
+		}
+	}
+	b.StopTimer()
+	if c != nil {
+		b.ReportMetric(float64(c.Count()-queries)/float64(b.N), "queries/op")
+	}
+}
+
+// Write writes the report as JSON to the given writer.
+func (r *Report) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// ReadReport reads a report that was written using Report.Write.
+func ReadReport(r io.Reader) (*Report, error) {
+	report := &Report{}
+	if err := json.NewDecoder(r).Decode(report); err != nil {
+		return nil, fmt.Errorf("entbench: decoding report: %w", err)
+	}
+	return report, nil
+}
+
+// Regression describes a metric of a scenario that regressed between two reports.
+type Regression struct {
+	Name, Dialect string
+	// Metric is the name of the metric. For example, "ns_per_op".
+	Metric string
+	// Base and Head hold the values of the metric in the compared reports.
+	Base, Head float64
+}
+
+// String implements the fmt.Stringer interface.
+func (r Regression) String() string {
+	name := r.Name
+	if r.Dialect != "" {
+		name += "/" + r.Dialect
+	}
+	return fmt.Sprintf("%s: %s regressed from %g to %g (%+.1f%%)", name, r.Metric, r.Base, r.Head, (r.Head-r.Base)/r.Base*100)
+}
+
+// Compare compares the results of the head report with the results of the base report, and returns the
+// metrics that regressed by more than the given threshold (e.g. 0.1 for 10%). Any increase in the number
+// of queries per operation is considered a regression. Scenarios that do not exist in both reports are
+// ignored.
+func Compare(base, head *Report, threshold float64) []Regression {
+	type key struct{ name, dialect string }
+	results := make(map[key]Result, len(base.Results))
+	for _, r := range base.Results {
+		results[key{r.Name, r.Dialect}] = r
+	}
+	var regs []Regression
+	for _, h := range head.Results {
+		b, ok := results[key{h.Name, h.Dialect}]
+		if !ok {
+			continue
+		}
+		for _, m := range []struct {
+			name       string
+			base, head float64
+			threshold  float64
+		}{
+			{"ns_per_op", float64(b.NsPerOp), float64(h.NsPerOp), threshold},
+			{"allocs_per_op", float64(b.AllocsPerOp), float64(h.AllocsPerOp), threshold},
+			{"bytes_per_op", float64(b.BytesPerOp), float64(h.BytesPerOp), threshold},
+			{"queries_per_op", b.QueriesPerOp, h.QueriesPerOp, 0},
+		} {
+			if m.base > 0 && m.head > m.base*(1+m.threshold) {
+				regs = append(regs, Regression{Name: h.Name, Dialect: h.Dialect, Metric: m.name, Base: m.base, Head: m.head})
+			}
+		}
+	}
+	sort.SliceStable(regs, func(i, j int) bool {
+		return regs[i].Name < regs[j].Name
+	})
+	return regs
+}
+
+// Counter is a dialect.Driver that counts the queries that are executed on its underlying driver.
+type Counter struct {
+	dialect.Driver
+	n int64
+}
+
+// CountQueries returns a new Counter that wraps the given driver.
+func CountQueries(drv dialect.Driver) *Counter {
+	return &Counter{Driver: drv}
+}
+
+// Count returns the number of queries that were executed.
+func (c *Counter) Count() int64 {
+	return atomic.LoadInt64(&c.n)
+}
+
+// Exec counts the statement and calls the underlying driver Exec method.
+func (c *Counter) Exec(ctx context.Context, query string, args, v interface{}) error {
+	atomic.AddInt64(&c.n, 1)
+	return c.Driver.Exec(ctx, query, args, v)
+}
+
+// Query counts the query and calls the underlying driver Query method.
+func (c *Counter) Query(ctx context.Context, query string, args, v interface{}) error {
+	atomic.AddInt64(&c.n, 1)
+	return c.Driver.Query(ctx, query, args, v)
+}
+
+// Tx starts a transaction whose queries are counted.
+func (c *Counter) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := c.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &countTx{Tx: tx, c: c}, nil
+}
+
+type countTx struct {
+	dialect.Tx
+	c *Counter
+}
+
+func (t *countTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	atomic.AddInt64(&t.c.n, 1)
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+func (t *countTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	atomic.AddInt64(&t.c.n, 1)
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+// entVersion returns the version of the ent module the binary was built with.
+func entVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	if info.Main.Path == "entgo.io/ent" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == "entgo.io/ent" {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entbench
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/stretchr/testify/require"
+)
+
+type nopDriver struct {
+	dialect.Driver
+}
+
+func (nopDriver) Dialect() string { return dialect.SQLite }
+
+func (nopDriver) Exec(context.Context, string, interface{}, interface{}) error { return nil }
+
+func (nopDriver) Query(context.Context, string, interface{}, interface{}) error { return nil }
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	c := CountQueries(nopDriver{})
+	var setup int
+	report, err := Run(ctx, []Scenario{
+		{
+			Name:  BulkInsert,
+			Setup: func(context.Context) error { setup++; return nil },
+			Run: func(ctx context.Context) error {
+				return c.Exec(ctx, "INSERT", []interface{}{}, nil)
+			},
+		},
+		{
+			Name: EagerLoad,
+			Run: func(ctx context.Context) error {
+				for i := 0; i < 3; i++ {
+					if err := c.Query(ctx, "SELECT", []interface{}{}, nil); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+	}, WithCounter(c), WithDuration(10*time.Millisecond))
+	require.NoError(t, err)
+	require.Equal(t, 1, setup)
+	require.Len(t, report.Results, 2)
+	require.Equal(t, BulkInsert, report.Results[0].Name)
+	require.Equal(t, dialect.SQLite, report.Results[0].Dialect)
+	require.Positive(t, report.Results[0].Iterations)
+	require.Equal(t, 1.0, report.Results[0].QueriesPerOp)
+	require.Equal(t, 3.0, report.Results[1].QueriesPerOp)
+	require.NotEmpty(t, report.GoVersion)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.Write(&buf))
+	read, err := ReadReport(&buf)
+	require.NoError(t, err)
+	require.Equal(t, report, read)
+}
+
+func TestCompare(t *testing.T) {
+	base := &Report{Results: []Result{
+		{Name: BulkInsert, NsPerOp: 100, AllocsPerOp: 10, BytesPerOp: 100, QueriesPerOp: 1},
+		{Name: EagerLoad, NsPerOp: 100, AllocsPerOp: 10, BytesPerOp: 100, QueriesPerOp: 3},
+	}}
+	head := &Report{Results: []Result{
+		{Name: BulkInsert, NsPerOp: 105, AllocsPerOp: 20, BytesPerOp: 90, QueriesPerOp: 1},
+		{Name: EagerLoad, NsPerOp: 100, AllocsPerOp: 10, BytesPerOp: 100, QueriesPerOp: 4},
+		{Name: PredicateScan, NsPerOp: 100},
+	}}
+	regs := Compare(base, head, 0.1)
+	require.Len(t, regs, 2)
+	require.Equal(t, BulkInsert, regs[0].Name)
+	require.Equal(t, "allocs_per_op", regs[0].Metric)
+	require.Equal(t, EagerLoad, regs[1].Name)
+	require.Equal(t, "queries_per_op", regs[1].Metric)
+	require.Equal(t, "eager-load: queries_per_op regressed from 3 to 4 (+33.3%)", regs[1].String())
+}
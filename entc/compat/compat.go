@@ -0,0 +1,307 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package compat compares two versions of an ent schema (e.g. the schema snapshots of two commits), and
+// classifies the changes of their database schema by their compatibility with services that still run the
+// old version. It is useful as a CI gate for services that share one database. For example:
+//
+//	old, err := compat.ReadSnapshot("base/ent/internal/schema.go")
+//	if err != nil {
+//		return err
+//	}
+//	report, err := compat.CompareSnapshots(old, current)
+//	if err != nil {
+//		return err
+//	}
+//	if report.Level() == compat.Breaking {
+//		return fmt.Errorf("breaking schema changes: %v", report.Changes)
+//	}
+package compat
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/internal"
+)
+
+// Level describes the compatibility level of a change.
+type Level int
+
+const (
+	// Compatible changes can be applied to the database while services that use
+	// the old schema keep running. For example, adding tables, nullable columns or
+	// non-unique indexes, or increasing the size of columns.
+	Compatible Level = iota
+	// Migration changes require migrating the existing data before they are applied.
+	// For example, adding a unique index, a foreign-key, or making a column required.
+	Migration
+	// Breaking changes break services that use the old schema. For example, dropping
+	// tables or columns, changing the type of columns, or adding a required column
+	// without a default value.
+	Breaking
+)
+
+// String implements the fmt.Stringer interface.
+func (l Level) String() string {
+	switch l {
+	case Compatible:
+		return "compatible"
+	case Migration:
+		return "migration"
+	case Breaking:
+		return "breaking"
+	default:
+		return fmt.Sprintf("Level(%d)", l)
+	}
+}
+
+// ParseLevel parses the string representation of a level (e.g. "breaking").
+func ParseLevel(s string) (Level, error) {
+	for _, l := range []Level{Compatible, Migration, Breaking} {
+		if strings.EqualFold(s, l.String()) {
+			return l, nil
+		}
+	}
+	return 0, fmt.Errorf("compat: unknown level %q", s)
+}
+
+type (
+	// Report holds the changes between two versions of a schema.
+	Report struct {
+		Changes []*Change `json:"changes"`
+	}
+
+	// Change describes a change in a table of the database schema.
+	Change struct {
+		Level  Level  `json:"level"`
+		Table  string `json:"table"`
+		Column string `json:"column,omitempty"`
+		Desc   string `json:"desc"`
+	}
+)
+
+// Level returns the level of the most severe change in the report,
+// or Compatible if there are no changes.
+func (r *Report) Level() Level {
+	l := Compatible
+	for _, c := range r.Changes {
+		if c.Level > l {
+			l = c.Level
+		}
+	}
+	return l
+}
+
+// String implements the fmt.Stringer interface.
+func (c *Change) String() string {
+	if c.Column != "" {
+		return fmt.Sprintf("%s: table %q: column %q %s", c.Level, c.Table, c.Column, c.Desc)
+	}
+	return fmt.Sprintf("%s: table %q %s", c.Level, c.Table, c.Desc)
+}
+
+// ReadSnapshot reads the schema snapshot from the given file, that was generated
+// using the "schema/snapshot" feature-flag (i.e. <project>/ent/internal/schema.go).
+func ReadSnapshot(path string) (*gen.Snapshot, error) {
+	return (&internal.Snapshot{Path: path}).Load()
+}
+
+// CompareSnapshots compares the database schemas of the given schema snapshots.
+func CompareSnapshots(old, new *gen.Snapshot) (*Report, error) {
+	graphs := make([]*gen.Graph, 2)
+	for i, snap := range []*gen.Snapshot{old, new} {
+		g, err := gen.NewGraph(&gen.Config{Schema: snap.Schema, Package: snap.Package}, snap.Schemas...)
+		if err != nil {
+			return nil, fmt.Errorf("compat: load snapshot of %s: %w", snap.Package, err)
+		}
+		graphs[i] = g
+	}
+	return Compare(graphs[0], graphs[1])
+}
+
+// Compare compares the database schemas of the given graphs.
+func Compare(old, new *gen.Graph) (*Report, error) {
+	oldTables, err := old.Tables()
+	if err != nil {
+		return nil, err
+	}
+	newTables, err := new.Tables()
+	if err != nil {
+		return nil, err
+	}
+	return CompareTables(oldTables, newTables), nil
+}
+
+// CompareTables compares the given versions of the database tables. The changes
+// are reported ordered by the table names, and by their columns in each table.
+func CompareTables(old, new []*schema.Table) *Report {
+	var (
+		r      = &Report{}
+		tables = make(map[string][2]*schema.Table)
+	)
+	for _, t := range old {
+		tables[t.Name] = [2]*schema.Table{t, nil}
+	}
+	for _, t := range new {
+		tables[t.Name] = [2]*schema.Table{tables[t.Name][0], t}
+	}
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		switch t := tables[name]; {
+		case t[1] == nil:
+			r.add(Breaking, name, "", "was dropped")
+		case t[0] == nil:
+			r.add(Compatible, name, "", "was added")
+		default:
+			r.table(t[0], t[1])
+		}
+	}
+	return r
+}
+
+// table adds the changes between the two versions of the table.
+func (r *Report) table(old, new *schema.Table) {
+	if pk := columnNames(new.PrimaryKey); !reflect.DeepEqual(columnNames(old.PrimaryKey), pk) {
+		r.add(Breaking, new.Name, "", fmt.Sprintf("primary key was changed to (%s)", strings.Join(pk, ", ")))
+	}
+	for _, c1 := range old.Columns {
+		if !new.HasColumn(c1.Name) {
+			r.add(Breaking, new.Name, c1.Name, "was dropped")
+		}
+	}
+	for _, c2 := range new.Columns {
+		c1, ok := old.Column(c2.Name)
+		switch {
+		case !ok && (c2.Nullable || c2.Default != nil || c2.Increment):
+			r.add(Compatible, new.Name, c2.Name, "was added")
+		case !ok:
+			r.add(Breaking, new.Name, c2.Name, "was added as a required column without a default value")
+		default:
+			r.column(new.Name, c1, c2)
+		}
+	}
+	for _, idx := range old.Indexes {
+		if idx2, ok := index(new, idx.Name); !ok || !sameIndex(idx, idx2) {
+			r.add(Compatible, new.Name, "", fmt.Sprintf("index %q was dropped", idx.Name))
+		}
+	}
+	for _, idx := range new.Indexes {
+		if idx1, ok := index(old, idx.Name); ok && sameIndex(idx1, idx) {
+			continue
+		}
+		if idx.Unique {
+			r.add(Migration, new.Name, "", fmt.Sprintf("unique index %q was added (existing rows must be unique)", idx.Name))
+		} else {
+			r.add(Compatible, new.Name, "", fmt.Sprintf("index %q was added", idx.Name))
+		}
+	}
+	for _, fk := range old.ForeignKeys {
+		if _, ok := foreignKey(new, fk.Symbol); !ok {
+			r.add(Compatible, new.Name, "", fmt.Sprintf("foreign key %q was dropped", fk.Symbol))
+		}
+	}
+	for _, fk := range new.ForeignKeys {
+		if _, ok := foreignKey(old, fk.Symbol); !ok {
+			r.add(Migration, new.Name, "", fmt.Sprintf("foreign key %q was added (existing rows must reference existing rows)", fk.Symbol))
+		}
+	}
+}
+
+// column adds the changes between the two versions of the column.
+func (r *Report) column(table string, c1, c2 *schema.Column) {
+	if c1.Type != c2.Type || !reflect.DeepEqual(c1.SchemaType, c2.SchemaType) {
+		r.add(Breaking, table, c2.Name, fmt.Sprintf("type was changed from %s to %s", c1.Type, c2.Type))
+		return
+	}
+	switch {
+	case !c1.Nullable && c2.Nullable:
+		r.add(Breaking, table, c2.Name, "became nullable (old readers cannot read NULL values)")
+	case c1.Nullable && !c2.Nullable:
+		r.add(Migration, table, c2.Name, "became required (existing NULL values must be replaced)")
+	}
+	switch {
+	case c1.Size == 0 || c2.Size == 0 || c1.Size == c2.Size:
+	case c1.Size < c2.Size:
+		r.add(Compatible, table, c2.Name, fmt.Sprintf("size was increased from %d to %d", c1.Size, c2.Size))
+	default:
+		r.add(Breaking, table, c2.Name, fmt.Sprintf("size was decreased from %d to %d", c1.Size, c2.Size))
+	}
+	if removed, added := diffEnums(c1.Enums, c2.Enums); len(removed) > 0 {
+		r.add(Breaking, table, c2.Name, fmt.Sprintf("enum values were removed: %s", strings.Join(removed, ", ")))
+	} else if len(added) > 0 {
+		r.add(Compatible, table, c2.Name, fmt.Sprintf("enum values were added: %s", strings.Join(added, ", ")))
+	}
+	switch {
+	case !c1.Unique && c2.Unique:
+		r.add(Migration, table, c2.Name, "became unique (existing values must be unique)")
+	case c1.Unique && !c2.Unique:
+		r.add(Compatible, table, c2.Name, "is no longer unique")
+	}
+	if !reflect.DeepEqual(c1.Default, c2.Default) {
+		r.add(Compatible, table, c2.Name, fmt.Sprintf("default value was changed from %v to %v", c1.Default, c2.Default))
+	}
+}
+
+func (r *Report) add(l Level, table, column, desc string) {
+	r.Changes = append(r.Changes, &Change{Level: l, Table: table, Column: column, Desc: desc})
+}
+
+func columnNames(columns []*schema.Column) []string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+func index(t *schema.Table, name string) (*schema.Index, bool) {
+	for _, idx := range t.Indexes {
+		if idx.Name == name {
+			return idx, true
+		}
+	}
+	return nil, false
+}
+
+func sameIndex(idx1, idx2 *schema.Index) bool {
+	return idx1.Unique == idx2.Unique && reflect.DeepEqual(columnNames(idx1.Columns), columnNames(idx2.Columns))
+}
+
+func foreignKey(t *schema.Table, symbol string) (*schema.ForeignKey, bool) {
+	for _, fk := range t.ForeignKeys {
+		if fk.Symbol == symbol {
+			return fk, true
+		}
+	}
+	return nil, false
+}
+
+// diffEnums returns the enum values that were removed and added in the new version.
+func diffEnums(old, new []string) (removed, added []string) {
+	values := make(map[string]bool, len(old))
+	for _, v := range old {
+		values[v] = true
+	}
+	for _, v := range new {
+		if !values[v] {
+			added = append(added, v)
+		}
+		delete(values, v)
+	}
+	for _, v := range old {
+		if values[v] {
+			removed = append(removed, v)
+		}
+	}
+	return removed, added
+}
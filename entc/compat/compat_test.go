@@ -0,0 +1,183 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package compat
+
+import (
+	"testing"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareTables(t *testing.T) {
+	var (
+		id    = &schema.Column{Name: "id", Type: field.TypeInt, Increment: true}
+		name  = &schema.Column{Name: "name", Type: field.TypeString, Size: 100}
+		role  = &schema.Column{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "user"}}
+		age   = &schema.Column{Name: "age", Type: field.TypeInt, Nullable: true}
+		users = &schema.Table{Name: "users", Columns: []*schema.Column{id, name, role, age}, PrimaryKey: []*schema.Column{id}}
+		pets  = &schema.Table{Name: "pets", Columns: []*schema.Column{id}, PrimaryKey: []*schema.Column{id}}
+	)
+	r := CompareTables([]*schema.Table{users, pets}, []*schema.Table{users, pets})
+	require.Empty(t, r.Changes)
+	require.Equal(t, Compatible, r.Level())
+
+	tests := []struct {
+		name    string
+		columns []*schema.Column
+		indexes []*schema.Index
+		level   Level
+		desc    string
+	}{
+		{
+			name:    "nullable column added",
+			columns: []*schema.Column{id, name, role, age, {Name: "nickname", Type: field.TypeString, Nullable: true}},
+			level:   Compatible,
+			desc:    `compatible: table "users": column "nickname" was added`,
+		},
+		{
+			name:    "required column added",
+			columns: []*schema.Column{id, name, role, age, {Name: "nickname", Type: field.TypeString}},
+			level:   Breaking,
+			desc:    `breaking: table "users": column "nickname" was added as a required column without a default value`,
+		},
+		{
+			name:    "column with default added",
+			columns: []*schema.Column{id, name, role, age, {Name: "nickname", Type: field.TypeString, Default: "a8m"}},
+			level:   Compatible,
+			desc:    `compatible: table "users": column "nickname" was added`,
+		},
+		{
+			name:    "column dropped",
+			columns: []*schema.Column{id, name, role},
+			level:   Breaking,
+			desc:    `breaking: table "users": column "age" was dropped`,
+		},
+		{
+			name:    "type changed",
+			columns: []*schema.Column{id, name, role, {Name: "age", Type: field.TypeFloat64, Nullable: true}},
+			level:   Breaking,
+			desc:    `breaking: table "users": column "age" type was changed from int to float64`,
+		},
+		{
+			name:    "column became required",
+			columns: []*schema.Column{id, name, role, {Name: "age", Type: field.TypeInt}},
+			level:   Migration,
+			desc:    `migration: table "users": column "age" became required (existing NULL values must be replaced)`,
+		},
+		{
+			name:    "column became nullable",
+			columns: []*schema.Column{id, {Name: "name", Type: field.TypeString, Size: 100, Nullable: true}, role, age},
+			level:   Breaking,
+			desc:    `breaking: table "users": column "name" became nullable (old readers cannot read NULL values)`,
+		},
+		{
+			name:    "size increased",
+			columns: []*schema.Column{id, {Name: "name", Type: field.TypeString, Size: 200}, role, age},
+			level:   Compatible,
+			desc:    `compatible: table "users": column "name" size was increased from 100 to 200`,
+		},
+		{
+			name:    "size decreased",
+			columns: []*schema.Column{id, {Name: "name", Type: field.TypeString, Size: 50}, role, age},
+			level:   Breaking,
+			desc:    `breaking: table "users": column "name" size was decreased from 100 to 50`,
+		},
+		{
+			name:    "enum values added",
+			columns: []*schema.Column{id, name, {Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "user", "guest"}}, age},
+			level:   Compatible,
+			desc:    `compatible: table "users": column "role" enum values were added: guest`,
+		},
+		{
+			name:    "enum values removed",
+			columns: []*schema.Column{id, name, {Name: "role", Type: field.TypeEnum, Enums: []string{"user"}}, age},
+			level:   Breaking,
+			desc:    `breaking: table "users": column "role" enum values were removed: admin`,
+		},
+		{
+			name:    "column became unique",
+			columns: []*schema.Column{id, {Name: "name", Type: field.TypeString, Size: 100, Unique: true}, role, age},
+			level:   Migration,
+			desc:    `migration: table "users": column "name" became unique (existing values must be unique)`,
+		},
+		{
+			name:    "index added",
+			columns: users.Columns,
+			indexes: []*schema.Index{{Name: "user_name", Columns: []*schema.Column{name}}},
+			level:   Compatible,
+			desc:    `compatible: table "users" index "user_name" was added`,
+		},
+		{
+			name:    "unique index added",
+			columns: users.Columns,
+			indexes: []*schema.Index{{Name: "user_name_age", Unique: true, Columns: []*schema.Column{name, age}}},
+			level:   Migration,
+			desc:    `migration: table "users" unique index "user_name_age" was added (existing rows must be unique)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := &schema.Table{Name: "users", Columns: tt.columns, Indexes: tt.indexes, PrimaryKey: []*schema.Column{id}}
+			r := CompareTables([]*schema.Table{users, pets}, []*schema.Table{changed, pets})
+			require.Len(t, r.Changes, 1)
+			require.Equal(t, tt.level, r.Level())
+			require.Equal(t, tt.desc, r.Changes[0].String())
+		})
+	}
+
+	r = CompareTables([]*schema.Table{users, pets}, []*schema.Table{users, {Name: "groups"}})
+	require.Equal(t, Breaking, r.Level())
+	require.Len(t, r.Changes, 2)
+	require.Equal(t, `compatible: table "groups" was added`, r.Changes[0].String())
+	require.Equal(t, `breaking: table "pets" was dropped`, r.Changes[1].String())
+}
+
+func TestCompare(t *testing.T) {
+	old, err := gen.NewGraph(&gen.Config{}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.NoError(t, err)
+	new, err := gen.NewGraph(&gen.Config{}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "nickname", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+		},
+	}, &load.Schema{
+		Name: "Pet",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", Unique: true},
+		},
+	})
+	require.NoError(t, err)
+	r, err := Compare(old, new)
+	require.NoError(t, err)
+	require.Equal(t, Compatible, r.Level())
+	require.Len(t, r.Changes, 2)
+	require.Equal(t, `compatible: table "pets" was added`, r.Changes[0].String())
+	require.Equal(t, `compatible: table "users": column "nickname" was added`, r.Changes[1].String())
+
+	r, err = Compare(new, old)
+	require.NoError(t, err)
+	require.Equal(t, Breaking, r.Level())
+}
+
+func TestParseLevel(t *testing.T) {
+	for _, l := range []Level{Compatible, Migration, Breaking} {
+		parsed, err := ParseLevel(l.String())
+		require.NoError(t, err)
+		require.Equal(t, l, parsed)
+	}
+	_, err := ParseLevel("unknown")
+	require.Error(t, err)
+}
@@ -127,6 +127,22 @@ func FeatureNames(names ...string) Option {
 	}
 }
 
+// BuildTarget sets the build target to generate the code for. Schemas, fields and
+// edges that were annotated with schema.BuildTargets and do not include the given
+// target are omitted from the generated code. For example:
+//
+//	err := entc.Generate("./schema", &gen.Config{
+//		Target:  "./wasm/ent",
+//		Package: "<project>/wasm/ent",
+//	}, entc.BuildTarget("wasm"))
+//
+func BuildTarget(name string) Option {
+	return func(cfg *gen.Config) error {
+		cfg.BuildTarget = name
+		return nil
+	}
+}
+
 // Annotation is used to attach arbitrary metadata to the schema objects in codegen.
 // Unlike schema annotations, being serializable to JSON raw value is not mandatory.
 //
@@ -108,6 +108,291 @@ var (
 		Description: "Allows users to configure the `ON CONFLICT`/`ON DUPLICATE KEY` clause for `INSERT` statements",
 	}
 
+	// FeatureDiff provides a feature-flag for comparing entities and applying their differences.
+	FeatureDiff = Feature{
+		Name:        "diff",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Diff provides helpers for comparing two copies of an entity field-by-field and applying the changes on update builders",
+	}
+
+	// FeatureSync provides a feature-flag for syncing changes made on remote replicas (e.g. mobile or edge
+	// SQLite databases) with conflict resolution. It implies the "diff" feature, and it is applied only on
+	// types with a version field (see entsql.Version).
+	FeatureSync = Feature{
+		Name:        "sync",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Sync provides an API for applying batches of changes made on remote replicas, and resolving their conflicts",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "sync",
+				Format: "sync.go",
+			},
+		},
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "sync.go"))
+		},
+	}
+
+	// FeatureTimeBucket provides a feature-flag for aggregating query results by time buckets.
+	FeatureTimeBucket = Feature{
+		Name:        "sql/timebucket",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to aggregate query results by time buckets (e.g. 1 hour) of time fields",
+	}
+
+	// FeatureEstimate provides a feature-flag for approximate counts and sampled queries.
+	FeatureEstimate = Feature{
+		Name:        "sql/estimate",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to estimate the count of queries using database statistics, and to query random samples of tables",
+	}
+
+	// FeatureQueryLimit provides a feature-flag for enforcing a limit on queries that are executed without one.
+	FeatureQueryLimit = Feature{
+		Name:        "querylimit",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to configure a client-level policy that rejects or limits All calls on queries without a limit",
+	}
+
+	// FeatureSingleflight provides a feature-flag for coalescing identical concurrent Only and Get calls.
+	FeatureSingleflight = Feature{
+		Name:        "sql/singleflight",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to coalesce identical concurrent Get and Only calls into a single database query",
+	}
+
+	// FeatureAsync provides a feature-flag for executing mutations asynchronously using a durable queue.
+	FeatureAsync = Feature{
+		Name:        "sql/async",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to enqueue mutations into a durable queue, and execute them asynchronously by workers",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "async",
+				Format: "async.go",
+			},
+		},
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "async.go"))
+		},
+	}
+
+	// FeatureIdempotency provides a feature-flag for setting idempotency keys on the create builders.
+	FeatureIdempotency = Feature{
+		Name:        "sql/idempotency",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to set idempotency keys on creations, that replay the original result on retries",
+	}
+
+	// FeatureFieldMask provides a feature-flag for selecting and masking fields using field masks.
+	FeatureFieldMask = Feature{
+		Name:        "fieldmask",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to derive query selections and partial entities from field masks (e.g. gRPC read masks)",
+	}
+
+	// FeatureMiddleware provides a feature-flag for generating an HTTP middleware that injects the client
+	// and per-request transactions into the context of the requests.
+	FeatureMiddleware = Feature{
+		Name:        "entmiddleware",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates an HTTP middleware that stores the client (or a per-request transaction) in the request context",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "entmiddleware",
+				Format: "entmiddleware/entmiddleware.go",
+			},
+		},
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "entmiddleware"))
+		},
+	}
+
+	// FeatureEdgeFields provides a feature-flag for exposing the foreign-keys of edges as regular fields.
+	FeatureEdgeFields = Feature{
+		Name:        "edgefields",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Exposes the foreign-keys of edges as edge-fields (with predicates and setters), unless defined in the schema",
+	}
+
+	// FeaturePatch provides a feature-flag for generating typed patches, that distinguish
+	// fields that were not set from fields that were explicitly cleared.
+	FeaturePatch = Feature{
+		Name:        "patch",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates typed patches (e.g. for PATCH APIs) that distinguish fields that were not set from fields that were cleared",
+	}
+
+	// FeatureFieldInfo provides a feature-flag for generating the Fields function in
+	// the entity packages, that describes the fields of the type at runtime.
+	FeatureFieldInfo = Feature{
+		Name:        "fieldinfo",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the Fields function in the entity packages, that describes the fields of the type (e.g. for admin or export tools)",
+	}
+
+	// FeatureOrderField provides a feature-flag for generating the OrderByField method of the
+	// queries, that orders them by fields whose names are given at runtime (e.g. from API parameters).
+	FeatureOrderField = Feature{
+		Name:        "orderfield",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the OrderByField method of the queries, that validates the fields against the order fields of the schema",
+	}
+
+	FeatureJoin = Feature{
+		Name:        "sql/join",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds the Join method to the queries, for joining entities with the entities of their neighbor types into typed rows, and the Using and From methods to the delete and update builders, for filtering them by joined tables",
+	}
+
+	FeatureProjection = Feature{
+		Name:        "sql/projection",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds the Project method to the queries, for querying fields into lightweight projection structs",
+	}
+
+	// FeatureSensitive provides a feature-flag for excluding sensitive fields from queries without an explicit selection.
+	FeatureSensitive = Feature{
+		Name:        "sql/sensitive",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Excludes the sensitive fields from queries without an explicit field selection, unless WithSensitive is called",
+	}
+
+	// FeatureTransfer provides a feature-flag for generating the TransferOwnership method of the clients,
+	// that rewires the owning edges of an entity to another entity (e.g. when merging accounts).
+	FeatureTransfer = Feature{
+		Name:        "sql/transfer",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the TransferOwnership method of the clients, that moves the entities owned by one entity to another in a single transaction",
+	}
+
+	// FeatureDedup provides a feature-flag for generating the FindDuplicates and Merge methods of the clients.
+	FeatureDedup = Feature{
+		Name:        "sql/dedup",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the FindDuplicates and Merge methods of the clients, for finding duplicate entities and merging them into one",
+	}
+
+	// FeatureReadSnapshot provides a feature-flag for generating the Snapshot method of the client, that
+	// returns a read-only transactional client whose queries observe one consistent state of the database.
+	FeatureReadSnapshot = Feature{
+		Name:        "sql/snapshot",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the Snapshot method of the client, for querying multiple entities in a consistent point in time",
+	}
+
+	// FeaturePagination provides a feature-flag for generating the Paginate method of the query
+	// builders, that paginates the queries using opaque (keyset) cursors, without GraphQL.
+	FeaturePagination = Feature{
+		Name:        "sql/pagination",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the Paginate method of the query builders, for cursor-based pagination over the ID and order fields",
+	}
+
+	// FeatureIterate provides a feature-flag for generating the Iterate method of the query builders,
+	// that streams the results of queries instead of loading all of them to memory.
+	FeatureIterate = Feature{
+		Name:        "sql/iterate",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the Iterate method of the query builders, for decoding large result sets one entity at a time",
+	}
+
+	// FeatureSelected provides a feature-flag for tracking the fields that were selected by queries
+	// in the returned entities, and generating strict accessors for them.
+	FeatureSelected = Feature{
+		Name:        "sql/selected",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Tracks the fields that were loaded into the entities by queries with a field selection, and adds the Selected method and strict field accessors to the entities",
+	}
+
+	// FeatureInsertSelect provides a feature-flag for generating the CreateFromQuery method of the clients,
+	// that creates entities from the result of a query using one INSERT INTO ... SELECT statement.
+	FeatureInsertSelect = Feature{
+		Name:        "sql/insertselect",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the CreateFromQuery method of the clients, for copying the result of queries into tables in the database (INSERT INTO ... SELECT)",
+	}
+
+	// FeatureSavepoint provides a feature-flag for supporting nested transactions using savepoints. When enabled,
+	// calling Tx or BeginTx on a transactional client creates a savepoint instead of failing, and the CreateBulk
+	// builders can create their entities in separate savepoints using ContinueOnError.
+	FeatureSavepoint = Feature{
+		Name:        "sql/savepoint",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Supports nested transactions by creating savepoints when Tx or BeginTx are called on transactional clients, and partial bulk creation using CreateBulk.ContinueOnError",
+	}
+
+	// FeatureTruncate provides a feature-flag for generating the Truncate methods of the clients, and the
+	// ResetAll method of the client, that remove all rows from the tables and reset their identity sequences.
+	FeatureTruncate = Feature{
+		Name:        "sql/truncate",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the Truncate and ResetAll methods of the clients, for removing all rows and resetting the identity sequences of tables",
+	}
+
+	// FeatureLoadStrategy provides a feature-flag for configuring the eager-loading strategy of the queries,
+	// and loading unique edges whose foreign-keys reside in the table of their entities using a LEFT JOIN.
+	FeatureLoadStrategy = Feature{
+		Name:        "sql/loadstrategy",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows eager-loading unique edges (e.g. M2O) using a LEFT JOIN in the query of their entities, instead of an additional query",
+	}
+
+	// FeatureGetOrCreate provides a feature-flag for generating the GetOrCreate and FirstOrCreate methods of
+	// the clients, that query an entity and create it if it does not exist, while handling concurrent creations.
+	// If FeatureLock is enabled as well, the LockOrCreate method is generated for locking the entity in a transaction.
+	FeatureGetOrCreate = Feature{
+		Name:        "sql/getorcreate",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the GetOrCreate, FirstOrCreate and LockOrCreate (with sql/lock) methods of the clients, for querying entities and creating them if they do not exist",
+	}
+
+	// FeatureRetention provides a feature-flag for generating the ApplyRetention methods of the clients, that
+	// execute the retention policies of the types that were annotated with entretention in bounded batches.
+	FeatureRetention = Feature{
+		Name:        "sql/retention",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates the ApplyRetention and RunRetention methods of the clients, for deleting, anonymizing or archiving expired entities",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "retention",
+				Format: "retention.go",
+			},
+		},
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "retention.go"))
+		},
+	}
+
 	FeatureVersionedMigration = Feature{
 		Name:        "sql/versioned-migration",
 		Stage:       Experimental,
@@ -127,6 +412,35 @@ var (
 		FeatureExecQuery,
 		FeatureUpsert,
 		FeatureVersionedMigration,
+		FeatureDiff,
+		FeatureSync,
+		FeatureTimeBucket,
+		FeatureEstimate,
+		FeatureQueryLimit,
+		FeatureSingleflight,
+		FeatureAsync,
+		FeatureIdempotency,
+		FeatureFieldMask,
+		FeatureMiddleware,
+		FeatureEdgeFields,
+		FeaturePatch,
+		FeatureFieldInfo,
+		FeatureOrderField,
+		FeatureJoin,
+		FeatureProjection,
+		FeatureSensitive,
+		FeatureTransfer,
+		FeatureDedup,
+		FeatureReadSnapshot,
+		FeaturePagination,
+		FeatureIterate,
+		FeatureRetention,
+		FeatureSelected,
+		FeatureInsertSelect,
+		FeatureSavepoint,
+		FeatureTruncate,
+		FeatureLoadStrategy,
+		FeatureGetOrCreate,
 	}
 )
 
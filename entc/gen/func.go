@@ -85,6 +85,9 @@ func quote(v interface{}) interface{} {
 // fieldOps returns all predicate operations for a given field.
 func fieldOps(f *Field) (ops []Op) {
 	switch t := f.Type.Type; {
+	// The database holds the compressed or encoded values,
+	// and therefore, they can be checked only for NULL.
+	case f.Encoded():
 	case f.HasGoType() && !f.ConvertedToBasic() && !f.Type.Valuer():
 	case t == field.TypeJSON:
 	case t == field.TypeBool:
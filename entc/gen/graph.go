@@ -13,11 +13,13 @@ import (
 	"go/token"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"runtime/debug"
 	"strings"
 	"text/template/parse"
 
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
@@ -73,6 +75,10 @@ type (
 		// Hooks holds an optional list of Hooks to apply on the graph before/after the code-generation.
 		Hooks []Hook
 
+		// Naming defines the naming strategy of the storage identifiers that are
+		// derived from the schema. Defaults to snake_case and pluralized tables.
+		Naming *Naming
+
 		// Annotations that are injected to the Config object can be accessed
 		// globally in all templates. In order to access an annotation from a
 		// graph template, do the following:
@@ -90,6 +96,13 @@ type (
 		//
 		// Note that the mapping is from the annotation-name (e.g. "GQL") to a JSON decoded object.
 		Annotations Annotations
+
+		// BuildTarget defines the name of the build target (e.g. "wasm") to generate the code for.
+		// If set, schemas, fields and edges that were annotated with schema.BuildTargets and do
+		// not include it are omitted from the generated code. Note that the generated migration
+		// schema is trimmed as well, and therefore, migrations should be executed by a client
+		// that was generated without a build target.
+		BuildTarget string
 	}
 
 	// Graph holds the nodes/entities of the loaded graph schema. Note that, it doesn't
@@ -143,7 +156,14 @@ func (f GenerateFunc) Generate(g *Graph) error {
 // It fails if one of the schemas is invalid.
 func NewGraph(c *Config, schemas ...*load.Schema) (g *Graph, err error) {
 	defer catch(&err)
+	if c.BuildTarget != "" {
+		schemas, err = buildTarget(c.BuildTarget, schemas)
+		check(err, "trim schemas")
+	}
 	g = &Graph{Config: c, Nodes: make([]*Type, 0, len(schemas)), Schemas: schemas}
+	if c.Naming != nil {
+		check(c.Naming.check(), "naming strategy")
+	}
 	for i := range schemas {
 		g.addNode(schemas[i])
 	}
@@ -157,6 +177,8 @@ func NewGraph(c *Config, schemas ...*load.Schema) (g *Graph, err error) {
 		check(t.setupFKs(), "set %q foreign-keys", t.Name)
 	}
 	check(g.edgeSchemas(), "resolving edges")
+	check(g.edgeOrders(), "resolving edge orders")
+	check(g.compositeIDs(), "resolving composite identifiers")
 	for i := range schemas {
 		g.addIndexes(schemas[i])
 	}
@@ -267,6 +289,14 @@ func (g *Graph) addIndexes(schema *load.Schema) {
 	for _, idx := range schema.Indexes {
 		check(typ.AddIndex(idx), "invalid index for schema %q", schema.Name)
 	}
+	if ant := typ.EntSQL(); ant != nil && len(ant.AccessPatterns) > 0 {
+		for _, names := range ant.AccessPatterns {
+			check(typ.addAccessPattern(names), "invalid access pattern for schema %q", schema.Name)
+		}
+		for _, w := range typ.uncoveredFKs() {
+			log.Printf("entc/gen: warning: %s\n", w)
+		}
+	}
 }
 
 // addEdges adds the node edges to the graph.
@@ -274,8 +304,18 @@ func (g *Graph) addEdges(schema *load.Schema) {
 	t, _ := g.typ(schema.Name)
 	seen := make(map[string]struct{}, len(schema.Edges))
 	for _, e := range schema.Edges {
+		if e.External != nil {
+			g.addExternalEdge(t, e, seen)
+			continue
+		}
+		if len(e.Types) > 0 {
+			g.addPolymorphicEdge(t, e, seen)
+			continue
+		}
 		typ, ok := g.typ(e.Type)
 		expect(ok, "type %q does not exist for edge", e.Type)
+		expect(!t.IsView(), "view type %q cannot have edges", t.Name)
+		expect(!typ.IsView(), "edge %s.%s cannot point to view type %q", t.Name, e.Name, typ.Name)
 		_, ok = t.fields[e.Name]
 		expect(!ok, "%s schema cannot contain field and edge with the same name %q", schema.Name, e.Name)
 		_, ok = seen[e.Name]
@@ -343,6 +383,84 @@ func (g *Graph) addEdges(schema *load.Schema) {
 	}
 }
 
+// addExternalEdge adds an edge to entities that are owned by another ent client or package.
+func (g *Graph) addExternalEdge(t *Type, e *load.Edge, seen map[string]struct{}) {
+	_, ok := t.fields[e.Name]
+	expect(!ok, "%s schema cannot contain field and edge with the same name %q", t.Name, e.Name)
+	_, ok = seen[e.Name]
+	expect(!ok, "%s schema contains multiple %q edges", t.Name, e.Name)
+	seen[e.Name] = struct{}{}
+	expect(e.Field != "", "external edge %s.%s must be bound to a field", t.Name, e.Name)
+	f, ok := t.fields[e.Field]
+	expect(ok, "field %q was not found in %s.Fields() for external edge %q", e.Field, t.Name, e.Name)
+	expect(e.External.PkgPath != "", "external edge %s.%s must reference a named type, but got %q", t.Name, e.Name, e.External.Ident)
+	typ := *e.External
+	// The package of the external type conflicts with the ent package or the generated package (e.g.
+	// the entities of another ent client). Hence, it is imported with the name of its parent directory
+	// as a prefix. For example, "entgo.io/ent/entc/integration/ent" is imported as "integrationent".
+	if name := typ.PkgName; name == "ent" || name == path.Base(g.Package) {
+		alias := path.Base(path.Dir(typ.PkgPath)) + name
+		typ.Ident = strings.Replace(typ.Ident, name+".", alias+".", 1)
+		typ.PkgName = alias
+	}
+	t.ExternalEdges = append(t.ExternalEdges, &ExternalEdge{
+		def:         e,
+		Name:        e.Name,
+		Type:        &typ,
+		Field:       f,
+		Owner:       t,
+		Annotations: e.Annotations,
+	})
+}
+
+// addPolymorphicEdge adds an edge to an entity of one of several types. The edge is expanded
+// to a unique edge to each of the types, named as follows: "<edge>_<type>" (e.g. owner_user).
+func (g *Graph) addPolymorphicEdge(t *Type, e *load.Edge, seen map[string]struct{}) {
+	expect(!t.IsView(), "view type %q cannot have edges", t.Name)
+	_, ok := t.fields[e.Name]
+	expect(!ok, "%s schema cannot contain field and edge with the same name %q", t.Name, e.Name)
+	_, ok = seen[e.Name]
+	expect(!ok, "%s schema contains multiple %q edges", t.Name, e.Name)
+	seen[e.Name] = struct{}{}
+	expect(len(e.Types) > 1, "polymorphic edge %s.%s must point to at least two types", t.Name, e.Name)
+	pe := &PolymorphicEdge{def: e, Name: e.Name, Owner: t, Annotations: e.Annotations}
+	for _, name := range e.Types {
+		typ, ok := g.typ(name)
+		expect(ok, "type %q does not exist for edge", name)
+		expect(!typ.IsView(), "edge %s.%s cannot point to view type %q", t.Name, e.Name, typ.Name)
+		expect(typ.HasOneFieldID(), "edge %s.%s cannot point to type %q with a composite identifier", t.Name, e.Name, typ.Name)
+		for _, pt := range pe.Edges {
+			expect(pt.Type != typ, "polymorphic edge %s.%s contains type %q more than once", t.Name, e.Name, name)
+		}
+		de := &load.Edge{
+			Name:        e.Name + "_" + snake(typ.Name),
+			Type:        typ.Name,
+			Unique:      true,
+			Comment:     e.Comment,
+			Annotations: e.Annotations,
+		}
+		_, ok = t.fields[de.Name]
+		expect(!ok, "%s schema cannot contain field and edge with the same name %q", t.Name, de.Name)
+		_, ok = seen[de.Name]
+		expect(!ok, "%s schema contains multiple %q edges", t.Name, de.Name)
+		seen[de.Name] = struct{}{}
+		te := &Edge{
+			def:         de,
+			Type:        typ,
+			Name:        de.Name,
+			Owner:       t,
+			Unique:      true,
+			Optional:    true,
+			StructTag:   structTag(de.Name, ""),
+			Annotations: de.Annotations,
+		}
+		t.Edges = append(t.Edges, te)
+		pe.Edges = append(pe.Edges, te)
+		typ.polymorphs = append(typ.polymorphs, pe)
+	}
+	t.PolymorphicEdges = append(t.PolymorphicEdges, pe)
+}
+
 // resolve resolves the type reference and relation of edges.
 // It fails if one of the references is missing or invalid.
 //
@@ -383,7 +501,7 @@ func (g *Graph) resolve(t *Type) error {
 			table := t.Table()
 			// Name the foreign-key column in a format that wouldn't change even if an inverse
 			// edge is dropped (or added). The format is: "<Edge-Owner>_<Edge-Name>".
-			column := fmt.Sprintf("%s_%s", e.Type.Label(), snake(ref.Name))
+			column := t.naming().foreignKey(e.Type.Name, ref.Name)
 			switch a, b := ref.Unique, e.Unique; {
 			// If the relation column is in the inverse side/table. The rule is simple, if assoc is O2M,
 			// then inverse is M2O and the relation is in its table.
@@ -399,12 +517,12 @@ func (g *Graph) resolve(t *Type) error {
 
 			case !a && !b:
 				e.Rel.Type, ref.Rel.Type = M2M, M2M
-				table = e.Type.Label() + "_" + ref.Name
-				c1, c2 := ref.Owner.Label()+"_id", ref.Type.Label()+"_id"
+				table = t.naming().joinTable(e.Type.Name, ref.Name)
+				c1, c2 := t.naming().joinColumn(ref.Owner.Label()), t.naming().joinColumn(ref.Type.Label())
 				// If the relation is from the same type: User has Friends ([]User),
 				// we give the second column a different name (the relation name).
 				if c1 == c2 {
-					c2 = rules.Singularize(e.Name) + "_id"
+					c2 = t.naming().joinColumn(rules.Singularize(e.Name))
 				}
 				e.Rel.Columns = []string{c1, c2}
 				ref.Rel.Columns = []string{c1, c2}
@@ -420,8 +538,8 @@ func (g *Graph) resolve(t *Type) error {
 			case !e.Unique && e.Type == t:
 				e.Rel.Type = M2M
 				e.Bidi = true
-				e.Rel.Table = t.Label() + "_" + e.Name
-				e.Rel.Columns = []string{e.Owner.Label() + "_id", rules.Singularize(e.Name) + "_id"}
+				e.Rel.Table = t.naming().joinTable(t.Name, e.Name)
+				e.Rel.Columns = []string{t.naming().joinColumn(e.Owner.Label()), t.naming().joinColumn(rules.Singularize(e.Name))}
 			case e.Unique && e.Type == t:
 				e.Rel.Type = O2O
 				e.Bidi = true
@@ -434,8 +552,45 @@ func (g *Graph) resolve(t *Type) error {
 				e.Rel.Table = e.Type.Table()
 			}
 			if !e.M2M() {
-				e.Rel.Columns = []string{fmt.Sprintf("%s_%s", t.Label(), snake(e.Name))}
+				e.Rel.Columns = []string{t.naming().foreignKey(t.Name, e.Name)}
+			}
+		}
+	}
+	return nil
+}
+
+// edgeOrders resolves the default orders of the edges that were annotated with entsql.OrderField.
+// The fields of the order belong to the type that the edge points to, and it can be defined only
+// on non-unique edges. Like the other entsql annotations, it is ignored by non-SQL storage drivers.
+func (g *Graph) edgeOrders() error {
+	if g.Storage != nil && g.Storage.Name != "sql" {
+		return nil
+	}
+	for _, n := range g.Nodes {
+		for _, e := range n.Edges {
+			ant := e.EntSQL()
+			if ant == nil || len(ant.EdgeOrder) == 0 {
+				continue
 			}
+			if e.Unique {
+				return fmt.Errorf("order cannot be defined on unique edge %s.%s", n.Name, e.Name)
+			}
+			t := e.Type
+			for _, name := range ant.EdgeOrder {
+				desc := strings.HasPrefix(name, "-")
+				f, ok := t.fields[strings.TrimPrefix(name, "-")]
+				if t.HasOneFieldID() && t.ID != nil && strings.TrimPrefix(name, "-") == t.ID.Name {
+					f, ok = t.ID, true
+				}
+				switch {
+				case !ok:
+					return fmt.Errorf("order field %q of edge %s.%s was not found in schema %s", name, n.Name, e.Name, t.Name)
+				case f.IsJSON():
+					return fmt.Errorf("json field %s.%s cannot be used in the order of edge %s.%s", t.Name, f.Name, n.Name, e.Name)
+				}
+				e.order = append(e.order, &OrderTerm{Field: f, Desc: desc})
+			}
+			t.edgeOrdered = true
 		}
 	}
 	return nil
@@ -530,6 +685,7 @@ func (g *Graph) edgeSchemas() error {
 				for _, f := range ant.ID {
 					typ.EdgeSchema.ID = append(typ.EdgeSchema.ID, typ.fields[f])
 				}
+				typ.CompositeID = typ.EdgeSchema.ID
 			}
 			if typ.HasCompositeID() {
 				continue
@@ -557,6 +713,84 @@ func (g *Graph) edgeSchemas() error {
 	return nil
 }
 
+// compositeIDs resolves the composite primary keys of types that are not edge schemas. i.e. types
+// that define their identifier using the field.ID annotation. The identifier holds exactly two fields,
+// that must be required and non-nillable, are immutable, and since composite foreign-keys are not supported, other types can reference
+// these types only by edges that hold their foreign-keys in the table of the composite type.
+func (g *Graph) compositeIDs() error {
+	for _, n := range g.Nodes {
+		ant := fieldAnnotate(n.Annotations)
+		if n.IsEdgeSchema() || ant == nil || len(ant.ID) == 0 {
+			continue
+		}
+		// The graph updates and deletes nodes by their composite identifiers
+		// using pairs of columns, like the identifiers of the edge schemas.
+		if len(ant.ID) != 2 {
+			return fmt.Errorf("composite identifier of schema %s must have exactly 2 fields, got %d", n.Name, len(ant.ID))
+		}
+		ids := make([]*Field, 0, len(ant.ID))
+		for _, name := range ant.ID {
+			f, ok := n.fields[name]
+			switch {
+			case !ok:
+				return fmt.Errorf("composite identifier field %q was not found in schema %s", name, n.Name)
+			case f.Optional || f.Nillable:
+				return fmt.Errorf("composite identifier field %s.%s cannot be optional or nillable", n.Name, name)
+			case f.IsJSON():
+				return fmt.Errorf("composite identifier field %s.%s cannot be a JSON field", n.Name, name)
+			}
+			for _, id := range ids {
+				if id == f {
+					return fmt.Errorf("composite identifier field %s.%s is defined more than once", n.Name, name)
+				}
+			}
+			ids = append(ids, f)
+		}
+		// Identifier fields cannot be changed by updates.
+		for _, f := range ids {
+			f.Immutable = true
+		}
+		n.ID, n.CompositeID = nil, ids
+	}
+	for _, n := range g.Nodes {
+		for _, e := range n.Edges {
+			switch {
+			case n.HasCompositeID() && !n.IsEdgeSchema() && !e.OwnFK():
+				return fmt.Errorf("edge %s.%s is not supported, as type %s has a composite identifier, and it can be referenced only by its own foreign-keys (M2O or inverse O2O edges)", n.Name, e.Name, n.Name)
+			case e.Type.HasCompositeID() && !e.Type.IsEdgeSchema() && (e.OwnFK() || e.M2M()):
+				return fmt.Errorf("edge %s.%s is not supported, as type %s has a composite identifier, and it can be referenced only by its own foreign-keys", n.Name, e.Name, e.Type.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// tableAnnotation returns the entsql.Annotation of the table of the given type,
+// merged with the check constraints that were defined on its fields. Unnamed
+// field checks are named after the table and the column (e.g. users_age_check).
+func tableAnnotation(n *Type) *entsql.Annotation {
+	ant := n.EntSQL()
+	for _, f := range n.Fields {
+		fa := f.EntSQL()
+		if fa == nil || fa.Check == "" && len(fa.Checks) == 0 {
+			continue
+		}
+		if ant == nil {
+			ant = &entsql.Annotation{}
+		}
+		if ant.Checks == nil {
+			ant.Checks = make(map[string]string)
+		}
+		if fa.Check != "" {
+			ant.Checks[fmt.Sprintf("%s_%s_check", n.Table(), f.StorageKey())] = fa.Check
+		}
+		for name, check := range fa.Checks {
+			ant.Checks[name] = check
+		}
+	}
+	return ant
+}
+
 // Tables returns the schema definitions of SQL tables for the graph.
 func (g *Graph) Tables() (all []*schema.Table, err error) {
 	tables := make(map[string]*schema.Table)
@@ -565,7 +799,7 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 		if n.HasOneFieldID() {
 			table.AddPrimary(n.ID.PK())
 		}
-		table.SetAnnotation(n.EntSQL())
+		table.SetAnnotation(tableAnnotation(n)).SetComment(n.Comment())
 		for _, f := range n.Fields {
 			if !f.IsEdgeField() {
 				table.AddColumn(f.Column())
@@ -586,7 +820,7 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 				// the foreign-key on) and "ref" is the referenced table.
 				owner, ref := tables[e.Rel.Table], tables[n.Table()]
 				pk := ref.PrimaryKey[0]
-				column := &schema.Column{Name: e.Rel.Column(), Size: pk.Size, Type: pk.Type, Unique: e.Rel.Type == O2O, SchemaType: pk.SchemaType, Nullable: true}
+				column := &schema.Column{Name: e.Rel.Column(), Size: pk.Size, Type: pk.Type, Unique: e.Rel.Type == O2O, SchemaType: pk.SchemaType, Nullable: true, Comment: fkComment(e)}
 				// If it's not a circular reference (self-referencing table),
 				// and the inverse edge is required, make it non-nullable.
 				if n != e.Type && e.Ref != nil && !e.Ref.Optional {
@@ -603,7 +837,7 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 			case M2O:
 				ref, owner := tables[e.Type.Table()], tables[e.Rel.Table]
 				pk := ref.PrimaryKey[0]
-				column := &schema.Column{Name: e.Rel.Column(), Size: pk.Size, Type: pk.Type, SchemaType: pk.SchemaType, Nullable: true}
+				column := &schema.Column{Name: e.Rel.Column(), Size: pk.Size, Type: pk.Type, SchemaType: pk.SchemaType, Nullable: true, Comment: fkComment(e)}
 				// If it's not a circular reference (self-referencing table),
 				// and the edge is non-optional (required), make it non-nullable.
 				if n != e.Type && !e.Optional {
@@ -673,9 +907,56 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 			index.Annotation = entsqlIndexAnnotate(idx.Annotations)
 		}
 	}
+	// Append archive tables after all columns were added. Archive tables hold the columns of
+	// their tables, without their constraints (except the primary key), indexes and foreign-keys.
+	for _, n := range g.Nodes {
+		if name := n.ArchiveTable(); name != "" {
+			all = append(all, archiveTable(name, tables[n.Table()]))
+		}
+	}
 	return
 }
 
+// archiveTable returns the archive table with the given name for the given table.
+func archiveTable(name string, t *schema.Table) *schema.Table {
+	archive := schema.NewTable(name).SetComment(t.Comment)
+	for _, c := range t.Columns {
+		column := &schema.Column{
+			Name:       c.Name,
+			Type:       c.Type,
+			SchemaType: c.SchemaType,
+			Attr:       c.Attr,
+			Size:       c.Size,
+			Nullable:   c.Nullable,
+			Default:    c.Default,
+			Enums:      c.Enums,
+			Collation:  c.Collation,
+			Comment:    c.Comment,
+		}
+		if len(t.PrimaryKey) == 1 && t.PrimaryKey[0] == c {
+			archive.AddPrimary(column)
+		} else {
+			archive.AddColumn(column)
+		}
+	}
+	return archive
+}
+
+// fkComment returns the comment of the foreign-key column of the given edge. The comment
+// of the edge-field (if exists) takes precedence over the comments of the edge and its inverse.
+func fkComment(e *Edge) string {
+	if fk, err := e.ForeignKey(); err == nil && fk.Field.IsEdgeField() && fk.Field.Comment() != "" {
+		return fk.Field.Comment()
+	}
+	if c := e.Comment(); c != "" {
+		return c
+	}
+	if e.Ref != nil {
+		return e.Ref.Comment()
+	}
+	return ""
+}
+
 // mayAddColumn adds the given column if it does not already exist in the table.
 func mayAddColumn(t *schema.Table, c *schema.Column) {
 	if !t.HasColumn(c.Name) {
@@ -684,18 +965,13 @@ func mayAddColumn(t *schema.Table, c *schema.Column) {
 }
 
 func addCompositePK(t *schema.Table, n *Type) error {
-	columns := make([]*schema.Column, 0, len(n.EdgeSchema.ID))
-	for _, id := range n.EdgeSchema.ID {
-		for _, f := range n.Fields {
-			if !f.IsEdgeField() || id != f {
-				continue
-			}
-			c, ok := t.Column(f.StorageKey())
-			if !ok {
-				return fmt.Errorf("missing column %q for edge field %q.%q", f.StorageKey(), n.Name, f.Name)
-			}
-			columns = append(columns, c)
+	columns := make([]*schema.Column, 0, len(n.CompositeID))
+	for _, f := range n.CompositeID {
+		c, ok := t.Column(f.StorageKey())
+		if !ok {
+			return fmt.Errorf("missing column %q for identifier field %q.%q", f.StorageKey(), n.Name, f.Name)
 		}
+		columns = append(columns, c)
 	}
 	t.PrimaryKey = columns
 	return nil
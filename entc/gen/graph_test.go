@@ -9,9 +9,12 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 
 	"github.com/stretchr/testify/require"
@@ -215,6 +218,151 @@ func TestNewGraphDuplicateEdgeField(t *testing.T) {
 	require.EqualError(t, err, `entc/gen: User schema cannot contain field and edge with the same name "parent"`)
 }
 
+func TestNewGraphViewEdges(t *testing.T) {
+	view := dict("EntSQL", map[string]string{"view": "SELECT id FROM users"})
+	_, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]},
+		&load.Schema{Name: "User"},
+		&load.Schema{
+			Name:        "Stats",
+			Annotations: view,
+			Edges:       []*load.Edge{{Name: "user", Type: "User", Unique: true}},
+		})
+	require.EqualError(t, err, `entc/gen: view type "Stats" cannot have edges`)
+
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]},
+		&load.Schema{Name: "User", Edges: []*load.Edge{{Name: "stats", Type: "Stats"}}},
+		&load.Schema{Name: "Stats", Annotations: view})
+	require.EqualError(t, err, `entc/gen: edge User.stats cannot point to view type "Stats"`)
+}
+
+func TestNewGraphExternalEdges(t *testing.T) {
+	account := &field.TypeInfo{Type: field.TypeOther, Ident: "*ent.Account", PkgPath: "example.com/accounts/ent", PkgName: "ent", Nillable: true}
+	graph, err := NewGraph(&Config{Package: "example.com/app/ent", Storage: drivers[0]},
+		&load.Schema{
+			Name: "Post",
+			Fields: []*load.Field{
+				{Name: "account_id", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true},
+			},
+			Edges: []*load.Edge{
+				{Name: "account", Type: "Account", Field: "account_id", Unique: true, External: account},
+			},
+		})
+	require.NoError(t, err)
+	post := graph.Nodes[0]
+	require.Empty(t, post.Edges)
+	require.Len(t, post.ExternalEdges, 1)
+	e := post.ExternalEdges[0]
+	require.Equal(t, "PostAccountLoader", e.LoaderName())
+	require.Equal(t, post.Fields[0], e.Field)
+	require.Equal(t, "*accountsent.Account", e.Type.String())
+	require.Equal(t, "accountsent", e.Type.PkgName)
+	require.Equal(t, "*ent.Account", account.Ident, "type of the schema edge should not be changed")
+
+	_, err = NewGraph(&Config{Package: "example.com/app/ent", Storage: drivers[0]},
+		&load.Schema{
+			Name:  "Post",
+			Edges: []*load.Edge{{Name: "account", Type: "Account", External: account}},
+		})
+	require.EqualError(t, err, `entc/gen: external edge Post.account must be bound to a field`)
+
+	_, err = NewGraph(&Config{Package: "example.com/app/ent", Storage: drivers[0]},
+		&load.Schema{
+			Name:  "Post",
+			Edges: []*load.Edge{{Name: "account", Type: "Account", Field: "account_id", External: account}},
+		})
+	require.EqualError(t, err, `entc/gen: field "account_id" was not found in Post.Fields() for external edge "account"`)
+}
+
+func TestNewGraphPolymorphicEdges(t *testing.T) {
+	graph, err := NewGraph(&Config{Package: "example.com/app/ent", Storage: drivers[0]},
+		&load.Schema{
+			Name:  "Comment",
+			Edges: []*load.Edge{{Name: "owner", Unique: true, Types: []string{"User", "Org"}}},
+		},
+		&load.Schema{Name: "User"},
+		&load.Schema{Name: "Org"},
+	)
+	require.NoError(t, err)
+	comment, user, org := graph.Nodes[0], graph.Nodes[1], graph.Nodes[2]
+	require.Len(t, comment.PolymorphicEdges, 1)
+	pe := comment.PolymorphicEdges[0]
+	require.Equal(t, "CommentOwner", pe.InterfaceName())
+	require.Len(t, comment.Edges, 2)
+	require.Equal(t, []*Edge{comment.Edges[0], comment.Edges[1]}, pe.Edges)
+	require.Equal(t, "owner_user", pe.Edges[0].Name)
+	require.Equal(t, user, pe.Edges[0].Type)
+	require.Equal(t, "owner_org", pe.Edges[1].Name)
+	require.Equal(t, M2O, pe.Edges[1].Rel.Type)
+	require.Equal(t, []string{"comment_owner_org"}, pe.Edges[1].Rel.Columns)
+	require.Equal(t, []*Type{org, user}, user.PolymorphicTypes())
+	require.Equal(t, []*Type{org, user}, org.PolymorphicTypes())
+	require.Empty(t, comment.PolymorphicTypes())
+
+	_, err = NewGraph(&Config{Package: "example.com/app/ent", Storage: drivers[0]},
+		&load.Schema{
+			Name:  "Comment",
+			Edges: []*load.Edge{{Name: "owner", Unique: true, Types: []string{"User"}}},
+		},
+		&load.Schema{Name: "User"},
+	)
+	require.EqualError(t, err, "entc/gen: polymorphic edge Comment.owner must point to at least two types")
+
+	_, err = NewGraph(&Config{Package: "example.com/app/ent", Storage: drivers[0]},
+		&load.Schema{
+			Name: "Comment",
+			Edges: []*load.Edge{
+				{Name: "owner", Unique: true, Types: []string{"User", "Comment"}},
+				{Name: "owner_user", Type: "User", Unique: true},
+			},
+		},
+		&load.Schema{Name: "User"},
+	)
+	require.EqualError(t, err, `entc/gen: Comment schema contains multiple "owner_user" edges`)
+}
+
+func TestNewGraphAccessPatterns(t *testing.T) {
+	patterns := func(ps ...[]string) map[string]interface{} {
+		return dict("EntSQL", map[string]interface{}{"access_patterns": ps})
+	}
+	user := &load.Schema{
+		Name:   "User",
+		Fields: []*load.Field{{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}}},
+		Edges:  []*load.Edge{{Name: "pets", Type: "Pet"}},
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}},
+		},
+		Edges: []*load.Edge{{Name: "owner", Type: "User", RefName: "pets", Inverse: true, Unique: true}},
+		Indexes: []*load.Index{
+			{Fields: []string{"name", "created_at"}},
+		},
+		Annotations: patterns([]string{"owner", "created_at"}, []string{"name"}, []string{"id"}),
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet)
+	require.NoError(t, err)
+	indexes := graph.Nodes[1].Indexes
+	require.Len(t, indexes, 2, "name and id patterns are covered by existing indexes")
+	require.Equal(t, "pet_user_pets_created_at", indexes[1].Name)
+	require.Equal(t, []string{"user_pets", "created_at"}, indexes[1].Columns)
+	require.Empty(t, graph.Nodes[1].uncoveredFKs())
+
+	pet.Annotations = patterns([]string{"created_at"})
+	graph, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet)
+	require.NoError(t, err)
+	require.Equal(t, []string{`column "user_pets" of Pet (edge User.pets) is not covered by any index`}, graph.Nodes[1].uncoveredFKs())
+
+	pet.Annotations = patterns([]string{"age"})
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet)
+	require.EqualError(t, err, `entc/gen: invalid access pattern for schema "Pet": unknown access pattern field or edge "age"`)
+	user.Annotations = patterns([]string{"pets"})
+	pet.Annotations = nil
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet)
+	require.EqualError(t, err, `entc/gen: invalid access pattern for schema "User": edge "pets" of access pattern does not hold a foreign-key`)
+}
+
 func TestNewGraphThroughUndefinedType(t *testing.T) {
 	_, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
 		Name: "T1",
@@ -257,6 +405,128 @@ func TestNewGraphThroughDuplicates(t *testing.T) {
 	require.EqualError(t, err, `entc/gen: resolving edges: edge User.groups defined with Through("group_edges", T1.Type), but schema User already has an edge named group_edges`)
 }
 
+func TestNewGraphCompositeID(t *testing.T) {
+	ids := dict("Fields", map[string]interface{}{"ID": []string{"tenant", "number"}})
+	invoice := func(fields ...*load.Field) *load.Schema {
+		return &load.Schema{
+			Name:        "Invoice",
+			Annotations: ids,
+			Fields: append([]*load.Field{
+				{Name: "tenant", Info: &field.TypeInfo{Type: field.TypeInt}},
+			}, fields...),
+			Edges: []*load.Edge{
+				{Name: "owner", Type: "User", RefName: "invoices", Inverse: true, Unique: true},
+			},
+		}
+	}
+	user := &load.Schema{
+		Name:  "User",
+		Edges: []*load.Edge{{Name: "invoices", Type: "Invoice"}},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, invoice(&load.Field{Name: "number", Info: &field.TypeInfo{Type: field.TypeString}}))
+	require.NoError(t, err)
+	inv := graph.Nodes[1]
+	require.True(t, inv.HasCompositeID())
+	require.Nil(t, inv.ID)
+	require.Len(t, inv.CompositeID, 2)
+	require.True(t, inv.CompositeID[0].Immutable)
+	tables, err := graph.Tables()
+	require.NoError(t, err)
+	require.Len(t, tables[1].PrimaryKey, 2)
+	require.Equal(t, "tenant", tables[1].PrimaryKey[0].Name)
+	require.Equal(t, "number", tables[1].PrimaryKey[1].Name)
+
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, invoice())
+	require.EqualError(t, err, `entc/gen: resolving composite identifiers: composite identifier field "number" was not found in schema Invoice`)
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, invoice(&load.Field{Name: "number", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true}))
+	require.EqualError(t, err, `entc/gen: resolving composite identifiers: composite identifier field Invoice.number cannot be optional or nillable`)
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]},
+		&load.Schema{Name: "User"},
+		&load.Schema{Name: "Invoice", Annotations: dict("Fields", map[string]interface{}{"ID": []string{"tenant", "year", "number"}}), Fields: []*load.Field{
+			{Name: "tenant", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "year", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "number", Info: &field.TypeInfo{Type: field.TypeString}},
+		}},
+	)
+	require.EqualError(t, err, `entc/gen: resolving composite identifiers: composite identifier of schema Invoice must have exactly 2 fields, got 3`)
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]},
+		&load.Schema{Name: "User"},
+		&load.Schema{Name: "Invoice", Annotations: ids, Fields: []*load.Field{
+			{Name: "tenant", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "number", Info: &field.TypeInfo{Type: field.TypeString}},
+		}, Edges: []*load.Edge{{Name: "users", Type: "User"}}},
+	)
+	require.EqualError(t, err, `entc/gen: resolving composite identifiers: edge Invoice.users is not supported, as type Invoice has a composite identifier, and it can be referenced only by its own foreign-keys (M2O or inverse O2O edges)`)
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]},
+		&load.Schema{Name: "User", Edges: []*load.Edge{{Name: "invoice", Type: "Invoice", Unique: true}}},
+		&load.Schema{Name: "Invoice", Annotations: ids, Fields: []*load.Field{
+			{Name: "tenant", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "number", Info: &field.TypeInfo{Type: field.TypeString}},
+		}},
+	)
+	require.EqualError(t, err, `entc/gen: resolving composite identifiers: edge User.invoice is not supported, as type Invoice has a composite identifier, and it can be referenced only by its own foreign-keys`)
+}
+
+func TestNewGraphBuildTarget(t *testing.T) {
+	server := map[string]interface{}{"BuildTargets": schema.BuildTargets("server")}
+	schemas := []*load.Schema{
+		{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+				{Name: "avatar", Info: &field.TypeInfo{Type: field.TypeBytes}, Annotations: server},
+			},
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet"},
+				{Name: "events", Type: "Event"},
+				{Name: "friends", Type: "User", Annotations: server},
+			},
+			Indexes: []*load.Index{
+				{Fields: []string{"name"}},
+				{Fields: []string{"name", "avatar"}},
+			},
+		},
+		{
+			Name: "Pet",
+			Edges: []*load.Edge{
+				{Name: "owner", Type: "User", Inverse: true, RefName: "pets", Unique: true},
+			},
+		},
+		{
+			Name:        "Event",
+			Annotations: server,
+			Edges: []*load.Edge{
+				{Name: "user", Type: "User", Inverse: true, RefName: "events", Unique: true},
+			},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, schemas...)
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 3)
+	require.Len(t, graph.Nodes[0].Fields, 2)
+	require.Len(t, graph.Nodes[0].Edges, 3)
+
+	graph, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], BuildTarget: "wasm"}, schemas...)
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 2)
+	user := graph.Nodes[0]
+	require.Len(t, user.Fields, 1)
+	require.Equal(t, "name", user.Fields[0].Name)
+	require.Len(t, user.Edges, 1)
+	require.Equal(t, "pets", user.Edges[0].Name)
+	require.Len(t, user.Indexes, 1)
+	require.Len(t, schemas[0].Fields, 2, "loaded schemas should not be modified")
+
+	graph, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], BuildTarget: "server"}, schemas...)
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 3)
+
+	schemas[1].Fields = []*load.Field{{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: server}}
+	schemas[1].Edges[0].Field = "owner_id"
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], BuildTarget: "wasm"}, schemas...)
+	require.EqualError(t, err, `entc/gen: trim schemas: edge Pet.owner is included in build target "wasm", but its field "owner_id" is not`)
+}
+
 func TestRelation(t *testing.T) {
 	require := require.New(t)
 	_, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, T1)
@@ -343,6 +613,173 @@ func TestFKColumns(t *testing.T) {
 	}
 }
 
+func TestEdgeStorageKey(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet"},
+			{Name: "groups", Type: "Group"},
+			{Name: "parent", Type: "User", Unique: true, Inverse: true, Ref: &load.Edge{Name: "children", Type: "User", StorageKey: &edge.StorageKey{Columns: []string{"parent_id"}}}, StorageKey: &edge.StorageKey{Columns: []string{"parent_id"}}},
+		},
+	}
+	// Storage-keys are defined on the inverse edges.
+	pet := &load.Schema{
+		Name: "Pet",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "pets", Inverse: true, Unique: true, StorageKey: &edge.StorageKey{Columns: []string{"owner_id"}, Symbols: []string{"pet_owner"}}},
+		},
+	}
+	group := &load.Schema{
+		Name: "Group",
+		Edges: []*load.Edge{
+			{Name: "users", Type: "User", RefName: "groups", Inverse: true, StorageKey: &edge.StorageKey{Table: "memberships", Columns: []string{"member_id", "group_id"}}},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet, group)
+	require.NoError(err)
+	u, p, g := graph.Nodes[0], graph.Nodes[1], graph.Nodes[2]
+	require.Equal([]string{"owner_id"}, u.Edges[0].Rel.Columns)
+	require.Equal([]string{"owner_id"}, p.Edges[0].Rel.Columns)
+	for _, e := range []*Edge{u.Edges[1], g.Edges[0]} {
+		require.Equal("memberships", e.Rel.Table)
+		require.Equal([]string{"member_id", "group_id"}, e.Rel.Columns)
+	}
+	// Both edges of the edge.To(..).From(..) form share the key.
+	require.Equal([]string{"parent_id"}, u.Edges[2].Rel.Columns)
+	require.Equal([]string{"parent_id"}, u.Edges[3].Rel.Columns)
+
+	tables, err := graph.Tables()
+	require.NoError(err)
+	require.Equal("pets", tables[1].Name)
+	require.Equal("owner_id", tables[1].ForeignKeys[0].Columns[0].Name)
+	require.Equal("pet_owner", tables[1].ForeignKeys[0].Symbol)
+	require.Equal("memberships", tables[3].Name)
+	require.Equal("member_id", tables[3].Columns[0].Name)
+
+	// Storage-keys cannot be defined on both edges.
+	pet.Edges[0].StorageKey = &edge.StorageKey{Columns: []string{"owner_id"}}
+	user.Edges[0].StorageKey = &edge.StorageKey{Columns: []string{"user_id"}}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet, group)
+	require.Error(err)
+}
+
+func TestEdgeFields(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet"},
+			{Name: "cars", Type: "Car"},
+			{Name: "spouse", Type: "User", Unique: true},
+		},
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "pets", Inverse: true, Unique: true, Required: true},
+		},
+	}
+	car := &load.Schema{
+		Name: "Car",
+		Fields: []*load.Field{
+			{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "cars", Inverse: true, Unique: true},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet, car)
+	require.NoError(err)
+	require.Empty(graph.Nodes[1].Fields, "disabled by default")
+
+	graph, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureEdgeFields}}, user, pet, car)
+	require.NoError(err)
+	u, p, c := graph.Nodes[0], graph.Nodes[1], graph.Nodes[2]
+	require.Len(p.Fields, 1)
+	require.Equal("owner_id", p.Fields[0].Name)
+	require.Equal("user_pets", p.Fields[0].StorageKey())
+	require.False(p.Fields[0].Optional)
+	require.Equal(p.Fields[0], p.Edges[0].Field())
+	require.True(p.Edges[0].HasFieldSetter())
+	require.Empty(p.UnexportedForeignKeys())
+	// Bidirectional edges.
+	require.Len(u.Fields, 1)
+	require.Equal("spouse_id", u.Fields[0].Name)
+	require.Equal("user_spouse", u.Fields[0].StorageKey())
+	require.True(u.Fields[0].Optional)
+	// Fields with the same name are not replaced.
+	require.Len(c.Fields, 1)
+	require.Nil(c.Edges[0].Field())
+	require.Len(c.UnexportedForeignKeys(), 1)
+}
+
+func TestNaming(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "first_name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "nick", Info: &field.TypeInfo{Type: field.TypeString}, StorageKey: "nick_name"},
+		},
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet"},
+			{Name: "groups", Type: "GroupInfo"},
+			{Name: "friends", Type: "User"},
+		},
+	}
+	group := &load.Schema{
+		Name: "GroupInfo",
+		Edges: []*load.Edge{
+			{Name: "users", Type: "User", RefName: "groups", Inverse: true},
+		},
+	}
+	require := require.New(t)
+	_, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Naming: &Naming{Case: "kebab"}}, user)
+	require.EqualError(err, `entc/gen: naming strategy: unexpected naming case "kebab"`)
+
+	graph, err := NewGraph(&Config{
+		Package: "entc/gen",
+		Storage: drivers[0],
+		Naming: &Naming{
+			Case:           CaseCamel,
+			TablePrefix:    "app_",
+			SingularTables: true,
+		},
+	}, user, &load.Schema{Name: "Pet"}, group)
+	require.NoError(err)
+	t1 := graph.Nodes[0]
+	require.Equal("app_user", t1.Table())
+	require.Equal("app_groupInfo", graph.Nodes[2].Table())
+	require.Equal("firstName", t1.Fields[0].StorageKey())
+	require.Equal("nick_name", t1.Fields[1].StorageKey(), "explicit storage keys are not affected")
+	for i, r := range []Relation{
+		{Type: O2M, Table: "app_pet", Columns: []string{"userPets"}},
+		{Type: M2M, Table: "app_userGroups", Columns: []string{"userId", "groupInfoId"}},
+		{Type: M2M, Table: "app_userFriends", Columns: []string{"userId", "friendId"}},
+	} {
+		require.Equal(r.Type, t1.Edges[i].Rel.Type)
+		require.Equal(r.Table, t1.Edges[i].Rel.Table)
+		require.Equal(r.Columns, t1.Edges[i].Rel.Columns)
+	}
+
+	graph, err = NewGraph(&Config{
+		Package: "entc/gen",
+		Storage: drivers[0],
+		Naming: &Naming{
+			ForeignKey: func(typ, edge string) string {
+				return "fk_" + strings.ToLower(typ) + "_" + edge
+			},
+		},
+	}, user, &load.Schema{Name: "Pet"}, group)
+	require.NoError(err)
+	t1 = graph.Nodes[0]
+	require.Equal("users", t1.Table())
+	require.Equal("first_name", t1.Fields[0].StorageKey())
+	require.Equal([]string{"fk_user_pets"}, t1.Edges[0].Rel.Columns)
+	require.Equal("user_groups", t1.Edges[1].Rel.Table)
+	require.Equal([]string{"user_id", "group_info_id"}, t1.Edges[1].Rel.Columns)
+}
+
 func TestGraph_Gen(t *testing.T) {
 	require := require.New(t)
 	target := filepath.Join(os.TempDir(), "ent")
@@ -516,3 +953,187 @@ func TestDependencyAnnotation_Build(t *testing.T) {
 		require.Equal(t, tt.field, d.Field)
 	}
 }
+
+func TestGraph_TableComments(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name:    "User",
+		Comment: "users comment",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Comment: "name comment"},
+		},
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet", Comment: "pets comment"},
+			{Name: "card", Type: "Card", Unique: true},
+		},
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "pets", Inverse: true, Unique: true},
+		},
+	}
+	card := &load.Schema{
+		Name: "Card",
+		Fields: []*load.Field{
+			{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true, Comment: "owner_id comment"},
+		},
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "card", Field: "owner_id", Inverse: true, Unique: true, Comment: "owner comment"},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet, card)
+	require.NoError(err)
+	require.Equal("users comment", graph.Nodes[0].Comment())
+	require.Empty(graph.Nodes[1].Comment())
+	tables, err := graph.Tables()
+	require.NoError(err)
+	require.Equal("users comment", tables[0].Comment)
+	c, ok := tables[0].Column("name")
+	require.True(ok)
+	require.Equal("name comment", c.Comment)
+	// Edge comments are stored on the foreign-key column.
+	c, ok = tables[1].Column("user_pets")
+	require.True(ok)
+	require.Equal("pets comment", c.Comment)
+	// Edge-field comments take precedence over edge comments.
+	c, ok = tables[2].Column("owner_id")
+	require.True(ok)
+	require.Equal("owner_id comment", c.Comment)
+}
+
+func TestGraph_FieldChecks(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: dict("EntSQL", dict("check", "age > 0"))},
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: dict("EntSQL", dict("checks", dict("name_not_empty", "name <> ''")))},
+		},
+		Annotations: dict("EntSQL", dict("checks", dict("adult_name", "age < 18 OR name <> 'a8m'"))),
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Fields: []*load.Field{
+			{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: dict("EntSQL", dict("check", "age >= 0"))},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet)
+	require.NoError(t, err)
+	tables, err := graph.Tables()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"users_age_check": "age > 0",
+		"name_not_empty":  "name <> ''",
+		"adult_name":      "age < 18 OR name <> 'a8m'",
+	}, tables[0].Annotation.Checks)
+	require.Equal(t, map[string]string{"pets_age_check": "age >= 0"}, tables[1].Annotation.Checks)
+	require.Len(t, graph.Nodes[0].EntSQL().Checks, 1, "type annotation should not be changed")
+}
+
+func TestGraph_ArchiveTables(t *testing.T) {
+	require := require.New(t)
+	archive := map[string]interface{}{"EntSQL": map[string]interface{}{"archive": true}}
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+		},
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet"},
+		},
+		Indexes:     []*load.Index{{Fields: []string{"name"}}},
+		Annotations: archive,
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "pets", Inverse: true, Unique: true},
+		},
+		Annotations: archive,
+	}
+	group := &load.Schema{
+		Name: "Group",
+		Edges: []*load.Edge{
+			{Name: "users", Type: "User"},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet, group)
+	require.NoError(err)
+	require.Equal("users_archive", graph.Nodes[0].ArchiveTable())
+	require.Empty(graph.Nodes[2].ArchiveTable())
+	require.Empty(graph.Nodes[2].ArchiveEdges(), "edges are archived only with archived types")
+	edges := graph.Nodes[0].ArchiveEdges()
+	require.Len(edges, 1)
+	require.Equal("pets", edges[0].Name)
+	tables, err := graph.Tables()
+	require.NoError(err)
+	require.Len(tables, 5)
+	users, uarchive, parchive := tables[0], tables[3], tables[4]
+	require.Equal("users_archive", uarchive.Name)
+	require.Len(uarchive.Columns, len(users.Columns))
+	require.Len(uarchive.PrimaryKey, 1)
+	require.Equal("id", uarchive.PrimaryKey[0].Name)
+	require.False(uarchive.PrimaryKey[0].Increment)
+	c, ok := uarchive.Column("name")
+	require.True(ok)
+	require.False(c.Unique)
+	require.Empty(uarchive.Indexes)
+	// Foreign-key columns are archived without their constraints.
+	require.Equal("pets_archive", parchive.Name)
+	_, ok = parchive.Column("user_pets")
+	require.True(ok)
+	require.Empty(parchive.ForeignKeys)
+}
+
+func TestGraph_EdgeOrders(t *testing.T) {
+	require := require.New(t)
+	order := func(fields ...string) map[string]interface{} {
+		return dict("EntSQL", dict("edge_order", fields))
+	}
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "meta", Info: &field.TypeInfo{Type: field.TypeJSON}},
+		},
+		Edges: []*load.Edge{
+			{Name: "friends", Type: "User", Annotations: order("-name", "id")},
+			{Name: "groups", Type: "Group"},
+		},
+	}
+	group := &load.Schema{
+		Name: "Group",
+		Edges: []*load.Edge{
+			{Name: "users", Type: "User", RefName: "groups", Inverse: true},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, group)
+	require.NoError(err)
+	require.True(graph.Nodes[0].EdgeOrdered())
+	require.False(graph.Nodes[1].EdgeOrdered())
+	terms := graph.Nodes[0].Edges[0].Order()
+	require.Len(terms, 2)
+	require.Equal("name", terms[0].Field.Name)
+	require.True(terms[0].Desc)
+	require.Equal("id", terms[1].Field.Name)
+	require.False(terms[1].Desc)
+	require.Nil(graph.Nodes[0].Edges[1].Order())
+
+	// Edge orders are ignored by non-SQL storage drivers.
+	graph, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[1]}, user, group)
+	require.NoError(err)
+	require.False(graph.Nodes[0].EdgeOrdered())
+
+	for ant, msg := range map[string]string{
+		"created_at": `order field "created_at" of edge User.friends was not found in schema User`,
+		"-meta":      "json field User.meta cannot be used in the order of edge User.friends",
+	} {
+		user.Edges[0].Annotations = order(ant)
+		_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, group)
+		require.EqualError(err, "entc/gen: resolving edge orders: "+msg)
+	}
+	user.Edges[0].Annotations = order("name")
+	user.Edges[0].Unique = true
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, group)
+	require.EqualError(err, "entc/gen: resolving edge orders: order cannot be defined on unique edge User.friends")
+}
@@ -0,0 +1,115 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Cases of storage identifiers.
+const (
+	// CaseSnake names identifiers in snake_case (e.g. "user_info"). It is the default.
+	CaseSnake = "snake"
+	// CaseCamel names identifiers in camelCase (e.g. "userInfo").
+	CaseCamel = "camel"
+)
+
+// Naming defines the naming strategy of the storage identifiers (tables, columns,
+// foreign-keys and join tables) that are derived from the schema. Names that are
+// set explicitly in the schema (e.g. using StorageKey or the entsql.Annotation)
+// are not affected by it.
+//
+//	entc.Generate("./schema", &gen.Config{
+//		Naming: &gen.Naming{
+//			Case:           gen.CaseCamel,
+//			TablePrefix:    "app_",
+//			SingularTables: true,
+//		},
+//	})
+//
+type Naming struct {
+	// Case of the identifiers. Either CaseSnake (the default) or CaseCamel.
+	Case string `json:"case,omitempty"`
+
+	// TablePrefix and TableSuffix are added to the names of all
+	// tables, including the join tables of M2M edges.
+	TablePrefix string `json:"table_prefix,omitempty"`
+	TableSuffix string `json:"table_suffix,omitempty"`
+
+	// SingularTables disables the pluralization of table names.
+	SingularTables bool `json:"singular_tables,omitempty"`
+
+	// ForeignKey allows overriding the name of foreign-key columns. It gets the
+	// name of the type that holds the edge (edge.To), and the name of the edge.
+	// The default format is "<type>_<edge>" (in the configured case).
+	ForeignKey func(typ, edge string) string `json:"-"`
+}
+
+// check checks that the naming strategy is valid.
+func (n *Naming) check() error {
+	switch n.Case {
+	case "", CaseSnake, CaseCamel:
+		return nil
+	default:
+		return fmt.Errorf("unexpected naming case %q", n.Case)
+	}
+}
+
+// naming returns the naming strategy of the config, or the default one if it was not set.
+func (c *Config) naming() *Naming {
+	if c == nil || c.Naming == nil {
+		return &Naming{}
+	}
+	return c.Naming
+}
+
+// table returns the table name of the given type.
+func (n *Naming) table(typ string) string {
+	if !n.SingularTables {
+		typ = rules.Pluralize(typ)
+	}
+	return n.TablePrefix + n.ident(snake(typ)) + n.TableSuffix
+}
+
+// column returns the column name of the given field.
+func (n *Naming) column(field string) string {
+	return n.ident(snake(field))
+}
+
+// foreignKey returns the foreign-key column of the given edge.
+func (n *Naming) foreignKey(typ, edge string) string {
+	if n.ForeignKey != nil {
+		return n.ForeignKey(typ, edge)
+	}
+	return n.ident(snake(typ) + "_" + snake(edge))
+}
+
+// joinTable returns the join table of the given M2M edge. Note that for
+// compatibility, the edge name is not converted to snake_case by default.
+func (n *Naming) joinTable(typ, edge string) string {
+	return n.TablePrefix + n.ident(snake(typ)+"_"+edge) + n.TableSuffix
+}
+
+// joinColumn returns the column of a join table that references the given name.
+func (n *Naming) joinColumn(name string) string {
+	return n.ident(name + "_id")
+}
+
+// ident formats the given snake_case identifier in the configured case.
+func (n *Naming) ident(s string) string {
+	if n.Case != CaseCamel {
+		return s
+	}
+	words := strings.Split(snake(s), "_")
+	for i, w := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(w)
+		} else {
+			words[i] = rules.Capitalize(w)
+		}
+	}
+	return strings.Join(words, "")
+}
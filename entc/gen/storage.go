@@ -53,7 +53,10 @@ var drivers = []*Storage{
 		Dialects:  []string{"dialect.SQLite", "dialect.MySQL", "dialect.Postgres"},
 		Imports: []string{
 			"entgo.io/ent/dialect/sql",
+			"entgo.io/ent/dialect/sql/sqlcodec",
+			"entgo.io/ent/dialect/sql/sqlcompress",
 			"entgo.io/ent/dialect/sql/sqlgraph",
+			"entgo.io/ent/dialect/sql/sqljson",
 			"entgo.io/ent/schema/field",
 		},
 		SchemaMode: Unique | Indexes | Cascade | Migrate,
@@ -0,0 +1,124 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema"
+)
+
+// buildTarget returns the schemas that are included in the given build target, trimmed from
+// their fields, edges and indexes that are not included in it. An edge is omitted also if the
+// type it points to, its edge-schema or the edge it references (for inverse edges) is omitted.
+// Note that the given schemas are not modified.
+func buildTarget(target string, schemas []*load.Schema) ([]*load.Schema, error) {
+	type key struct{ typ, name string }
+	var (
+		nodes   = make(map[string]bool)
+		omitted = make(map[key]bool)
+		trimmed = make([]*load.Schema, 0, len(schemas))
+	)
+	included := func(annotations map[string]interface{}) bool {
+		ant := buildTargetsAnnotate(annotations)
+		return ant == nil || ant.Includes(target)
+	}
+	for _, s := range schemas {
+		if included(s.Annotations) {
+			nodes[s.Name] = true
+		}
+	}
+	omit := func(s *load.Schema, e *load.Edge) bool {
+		if e.External != nil {
+			return !nodes[s.Name] || !included(e.Annotations)
+		}
+		if len(e.Types) > 0 {
+			for _, t := range e.Types {
+				if !nodes[t] {
+					return true
+				}
+			}
+			return !nodes[s.Name] || !included(e.Annotations)
+		}
+		return !nodes[s.Name] || !nodes[e.Type] || !included(e.Annotations) || e.Through != nil && !nodes[e.Through.T]
+	}
+	// Inverse edges may reference assoc edges that are defined
+	// in schemas that come after them. Hence, we resolve them last.
+	for _, s := range schemas {
+		for _, e := range s.Edges {
+			if !e.Inverse && omit(s, e) {
+				omitted[key{s.Name, e.Name}] = true
+			}
+		}
+	}
+	for _, s := range schemas {
+		for _, e := range s.Edges {
+			if e.Inverse && (omit(s, e) || omitted[key{e.Type, e.RefName}]) {
+				omitted[key{s.Name, e.Name}] = true
+			}
+		}
+	}
+	for _, s := range schemas {
+		if !nodes[s.Name] {
+			continue
+		}
+		t := *s
+		t.Fields, t.Edges, t.Indexes = nil, nil, nil
+		fields := make(map[string]bool)
+		for _, f := range s.Fields {
+			switch {
+			case included(f.Annotations):
+				t.Fields = append(t.Fields, f)
+			case f.Name == "id":
+				return nil, fmt.Errorf("id field of type %q is not included in build target %q", s.Name, target)
+			default:
+				fields[f.Name] = true
+			}
+		}
+		for _, e := range s.Edges {
+			switch {
+			case omitted[key{s.Name, e.Name}]:
+				continue
+			case e.Field != "" && fields[e.Field]:
+				return nil, fmt.Errorf("edge %s.%s is included in build target %q, but its field %q is not", s.Name, e.Name, target, e.Field)
+			case e.Ref != nil && !included(e.Ref.Annotations):
+				e1 := *e
+				e1.Ref = nil
+				e = &e1
+			}
+			t.Edges = append(t.Edges, e)
+		}
+	Index:
+		for _, idx := range s.Indexes {
+			for _, f := range idx.Fields {
+				if fields[f] {
+					continue Index
+				}
+			}
+			for _, e := range idx.Edges {
+				if omitted[key{s.Name, e}] {
+					continue Index
+				}
+			}
+			t.Indexes = append(t.Indexes, idx)
+		}
+		trimmed = append(trimmed, &t)
+	}
+	return trimmed, nil
+}
+
+// buildTargetsAnnotate extracts the build-targets annotation from a loaded annotation format.
+func buildTargetsAnnotate(annotation map[string]interface{}) *schema.BuildTargetsAnnotation {
+	annotate := &schema.BuildTargetsAnnotation{}
+	if annotation == nil || annotation[annotate.Name()] == nil {
+		return nil
+	}
+	if buf, err := json.Marshal(annotation[annotate.Name()]); err == nil {
+		_ = json.Unmarshal(buf, &annotate)
+	}
+	return annotate
+}
@@ -169,6 +169,7 @@ var (
 	}
 	// patterns for extending partial-templates (included by other templates).
 	partialPatterns = [...]string{
+		"base/additional/*",
 		"client/additional/*",
 		"client/additional/*/*",
 		"config/*/*",
@@ -176,6 +177,7 @@ var (
 		"delete/additional/*",
 		"dialect/*/*/*/spec/*",
 		"dialect/*/*/spec/*",
+		"dialect/*/client/type/additional/*",
 		"dialect/*/config/*/*",
 		"dialect/*/import/additional/*",
 		"dialect/*/query/selector/*",
@@ -195,10 +197,13 @@ var (
 		"model/additional/*",
 		"model/comment/additional/*",
 		"model/edges/fields/additional/*",
+		"mutation/additional/*",
 		"tx/additional/*",
 		"tx/additional/*/*",
 		"update/additional/*",
 		"query/additional/*",
+		"query/all/*",
+		"query/only/*",
 		"privacy/additional/*",
 		"privacy/additional/*/*",
 	}
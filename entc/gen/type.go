@@ -11,9 +11,11 @@ import (
 	"fmt"
 	"go/token"
 	"go/types"
+	"math"
 	"path"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -22,6 +24,10 @@ import (
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/entfield"
+	"entgo.io/ent/schema/entlineage"
+	"entgo.io/ent/schema/entpartition"
+	"entgo.io/ent/schema/entretention"
 	"entgo.io/ent/schema/field"
 )
 
@@ -40,11 +46,22 @@ type (
 		alias string
 		// ID holds the ID field of this type.
 		ID *Field
+		// CompositeID holds the fields of the composite identifier of this type,
+		// if it was defined using the field.ID annotation. In this case, ID is nil.
+		CompositeID []*Field
 		// Fields holds all the primitive fields of this type.
 		Fields []*Field
 		fields map[string]*Field
 		// Edge holds all the edges of this type.
 		Edges []*Edge
+		// ExternalEdges holds the edges of this type to entities
+		// that are owned by another ent client or package.
+		ExternalEdges []*ExternalEdge
+		// PolymorphicEdges holds the edges of this type that
+		// point to an entity of one of several types.
+		PolymorphicEdges []*PolymorphicEdge
+		// polymorphs holds the polymorphic edges that point to this type.
+		polymorphs []*PolymorphicEdge
 		// Indexes are the configured indexes for this type.
 		Indexes []*Index
 		// ForeignKeys are the foreign-keys that resides in the type table.
@@ -59,6 +76,8 @@ type (
 			ID       []*Field
 			To, From *Edge
 		}
+		// edgeOrdered indicates if the type is the target of an ordered edge.
+		edgeOrdered bool
 	}
 
 	// Field holds the information of a type field used for the templates.
@@ -88,6 +107,8 @@ type (
 		StructTag string
 		// Validators holds the number of validators the field have.
 		Validators int
+		// Codec indicates if the field values are encoded at rest using a field.Codec.
+		Codec bool
 		// Position info of the field.
 		Position *load.Position
 		// UserDefined indicates that this field was defined explicitly by the user in
@@ -98,6 +119,8 @@ type (
 		Annotations Annotations
 		// referenced foreign-key.
 		fk *ForeignKey
+		// lineage information of the field, merged with the lineage of its type.
+		lineage *entlineage.Annotation
 	}
 
 	// Edge of a graph between two types.
@@ -137,6 +160,66 @@ type (
 		// Annotations that were defined for the edge in the schema.
 		// The mapping is from the Annotation.Name() to a JSON decoded object.
 		Annotations Annotations
+		// default order of the edge, if it was annotated with entsql.OrderField.
+		order []*OrderTerm
+	}
+
+	// ExternalEdge of a type to entities that are owned by another ent client or
+	// package, and are referenced by their ids only. See edge.External for details.
+	ExternalEdge struct {
+		def *load.Edge
+		// Name holds the name of the edge.
+		Name string
+		// Type holds the Go type of the referenced entities.
+		Type *field.TypeInfo
+		// Field holds the field that stores the id of the referenced entity.
+		Field *Field
+		// Owner holds the type of the edge-owner.
+		Owner *Type
+		// Annotations that were defined for the edge in the schema.
+		// The mapping is from the Annotation.Name() to a JSON decoded object.
+		Annotations Annotations
+	}
+
+	// PolymorphicEdge of a type to an entity of one of several types. It is stored using a
+	// unique edge (and a foreign-key) to each of the types. See edge.Polymorphic for details.
+	PolymorphicEdge struct {
+		def *load.Edge
+		// Name holds the name of the edge.
+		Name string
+		// Owner holds the type of the edge-owner.
+		Owner *Type
+		// Edges holds the edges to each of the types, in the order they were defined.
+		Edges []*Edge
+		// Annotations that were defined for the edge in the schema.
+		// The mapping is from the Annotation.Name() to a JSON decoded object.
+		Annotations Annotations
+	}
+
+	// QueryDefaults holds the defaults of the generated queries of a type,
+	// if it was annotated with entsql.QueryDefaults.
+	QueryDefaults struct {
+		// Order holds the terms of the default order.
+		Order []*OrderTerm
+		// Limit holds the default limit, or zero if there is none.
+		Limit int
+		// Where holds the default predicates.
+		Where []*DefaultPredicate
+	}
+
+	// OrderTerm is a field of the default order of a type.
+	OrderTerm struct {
+		Field *Field
+		Desc  bool
+	}
+
+	// DefaultPredicate is a predicate that is applied by default on all queries of a type.
+	DefaultPredicate struct {
+		Field *Field
+		// Func is the name of the predicate function in the dialect/sql package (e.g. EQ or IsNull).
+		Func string
+		// Value holds the Go literal of the value, or an empty string for functions without a value.
+		Value string
 	}
 
 	// Relation holds the relational database information for edges.
@@ -240,12 +323,18 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 			Immutable:     f.Immutable,
 			StructTag:     structTag(f.Name, f.Tag),
 			Validators:    f.Validators,
+			Codec:         f.Codec,
 			UserDefined:   true,
 			Annotations:   f.Annotations,
 		}
 		if err := typ.checkField(tf, f); err != nil {
 			return nil, err
 		}
+		// Generated fields are computed by the database,
+		// and therefore, they cannot be set by the builders.
+		if tf.Generated() {
+			tf.Optional, tf.Immutable = true, true
+		}
 		// User defined id field.
 		if tf.Name == typ.ID.Name {
 			if tf.Optional {
@@ -257,6 +346,66 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 			typ.fields[f.Name] = tf
 		}
 	}
+	for _, f := range append([]*Field{typ.ID}, typ.Fields...) {
+		f.lineage = lineageAnnotate(typ.Annotations, f.Annotations)
+	}
+	if ant := fieldAnnotate(typ.Annotations); ant != nil {
+		for _, name := range ant.OrderFields {
+			f, ok := typ.fields[name]
+			if name == typ.ID.Name {
+				f, ok = typ.ID, true
+			}
+			switch {
+			case !ok:
+				return nil, fmt.Errorf("unknown order field %q for type %q", name, typ.Name)
+			case f.IsJSON():
+				return nil, fmt.Errorf("json field %q cannot be used as an order field of type %q", name, typ.Name)
+			case f.Encoded():
+				return nil, fmt.Errorf("encoded field %q cannot be used as an order field of type %q", name, typ.Name)
+			}
+		}
+	}
+	if ant := typ.EntSQL(); ant != nil && ant.SoftDelete != "" {
+		f, ok := typ.fields[ant.SoftDelete]
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("soft-delete field %q was not found in schema %s", ant.SoftDelete, typ.Name)
+		case f.Type.Type != field.TypeTime || !f.Optional || !f.Nillable:
+			return nil, fmt.Errorf("soft-delete field %s.%s must be an optional and nillable time field", typ.Name, f.Name)
+		case c.Storage != nil && c.Storage.Name != "sql":
+			return nil, fmt.Errorf("soft-delete of schema %s is not supported by storage %q", typ.Name, c.Storage.Name)
+		}
+	}
+	if ant := typ.EntSQL(); ant != nil && ant.Version != "" {
+		f, ok := typ.fields[ant.Version]
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("version field %q was not found in schema %s", ant.Version, typ.Name)
+		case f.Type.Type != field.TypeInt && f.Type.Type != field.TypeInt64 || f.HasGoType() || f.Optional || !f.Immutable || !f.Default:
+			return nil, fmt.Errorf("version field %s.%s must be an immutable int or int64 field with a default value", typ.Name, f.Name)
+		case c.Storage != nil && c.Storage.Name != "sql":
+			return nil, fmt.Errorf("version of schema %s is not supported by storage %q", typ.Name, c.Storage.Name)
+		}
+	}
+	if ant := typ.EntSQL(); ant != nil && ant.Archive {
+		switch {
+		case typ.IsView():
+			return nil, fmt.Errorf("archive table is not supported by view %s", typ.Name)
+		case typ.HasCompositeID():
+			return nil, fmt.Errorf("archive table of schema %s requires a single id field", typ.Name)
+		case c.Storage != nil && c.Storage.Name != "sql":
+			return nil, fmt.Errorf("archive table of schema %s is not supported by storage %q", typ.Name, c.Storage.Name)
+		}
+	}
+	if err := typ.checkRetention(); err != nil {
+		return nil, err
+	}
+	if err := typ.checkTimeRange(); err != nil {
+		return nil, err
+	}
+	if _, err := typ.queryDefaults(); err != nil {
+		return nil, err
+	}
 	return typ, nil
 }
 
@@ -268,7 +417,7 @@ func (t Type) IsEdgeSchema() bool {
 
 // HasCompositeID indicates if the type has a composite ID field.
 func (t Type) HasCompositeID() bool {
-	return t.IsEdgeSchema() && len(t.EdgeSchema.ID) > 1
+	return len(t.CompositeID) > 1
 }
 
 // HasOneFieldID indicates if the type has an ID with one field (not composite).
@@ -289,7 +438,7 @@ func (t Type) Table() string {
 	if t.schema != nil && t.schema.Config.Table != "" {
 		return t.schema.Config.Table
 	}
-	return snake(rules.Pluralize(t.Name))
+	return t.naming().table(t.Name)
 }
 
 // EntSQL returns the EntSQL annotation if exists.
@@ -297,6 +446,266 @@ func (t Type) EntSQL() *entsql.Annotation {
 	return entsqlAnnotate(t.Annotations)
 }
 
+// SoftDeleteField returns the field that marks the entities of the type as deleted,
+// if the type was annotated with entsql.SoftDelete.
+func (t Type) SoftDeleteField() *Field {
+	if ant := t.EntSQL(); ant != nil && ant.SoftDelete != "" {
+		return t.fields[ant.SoftDelete]
+	}
+	return nil
+}
+
+// VersionField returns the field that holds the version of the entities of the type,
+// if the type was annotated with entsql.Version.
+func (t Type) VersionField() *Field {
+	if ant := t.EntSQL(); ant != nil && ant.Version != "" {
+		return t.fields[ant.Version]
+	}
+	return nil
+}
+
+// ArchiveTable returns the name of the archive table of the type,
+// or an empty string if the type was not annotated with entsql.Archive.
+func (t Type) ArchiveTable() string {
+	if ant := t.EntSQL(); ant != nil && ant.Archive {
+		return t.Table() + "_archive"
+	}
+	return ""
+}
+
+// ArchiveEdges returns the edges of an archived type that their entities can be archived
+// with the entities of the type. i.e. O2O and O2M edges that hold the foreign-key in the
+// table of another archived type.
+func (t Type) ArchiveEdges() (edges []*Edge) {
+	if t.ArchiveTable() == "" {
+		return nil
+	}
+	for _, e := range t.Edges {
+		if !e.IsInverse() && (e.O2M() || e.O2O()) && e.Type.Name != t.Name && e.Type.ArchiveTable() != "" {
+			edges = append(edges, e)
+		}
+	}
+	return
+}
+
+// RetentionPolicy returns the retention policy of the type, if it was annotated with entretention.
+func (t Type) RetentionPolicy() *entretention.Annotation {
+	return retentionAnnotate(t.Annotations)
+}
+
+// checkRetention checks the retention policy of the type, if it was defined.
+func (t Type) checkRetention() error {
+	ant := t.RetentionPolicy()
+	if ant == nil {
+		return nil
+	}
+	f, ok := t.fields[ant.Field]
+	switch {
+	case !ok:
+		return fmt.Errorf("retention field %q was not found in schema %s", ant.Field, t.Name)
+	case f.Type.Type != field.TypeTime:
+		return fmt.Errorf("retention field %s.%s must be a time field", t.Name, f.Name)
+	case ant.Window <= 0:
+		return fmt.Errorf("retention window of schema %s must be positive", t.Name)
+	case t.Storage != nil && t.Storage.Name != "sql":
+		return fmt.Errorf("retention of schema %s is not supported by storage %q", t.Name, t.Storage.Name)
+	}
+	switch ant.Strategy {
+	case entretention.StrategyDelete, entretention.StrategyArchive:
+		if len(ant.Fields) > 0 {
+			return fmt.Errorf("retention strategy %q of schema %s does not accept fields", ant.Strategy, t.Name)
+		}
+	case entretention.StrategyAnonymize:
+		if len(ant.Fields) == 0 {
+			return fmt.Errorf("anonymize retention of schema %s requires at least one field", t.Name)
+		}
+		for _, name := range ant.Fields {
+			f, ok := t.fields[name]
+			switch {
+			case !ok:
+				return fmt.Errorf("anonymized field %q was not found in schema %s", name, t.Name)
+			case !f.Optional || f.Immutable || name == ant.Field:
+				return fmt.Errorf("anonymized field %s.%s must be an optional and mutable field, other than the retention field", t.Name, name)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown retention strategy %q for schema %s", ant.Strategy, t.Name)
+	}
+	return nil
+}
+
+// TimeRange returns the time-range partitioning of the type, if it was annotated with entpartition.
+func (t Type) TimeRange() *entpartition.Annotation {
+	return partitionAnnotate(t.Annotations)
+}
+
+// TimeRangeField returns the field that the type is partitioned by, if it was annotated with entpartition.
+func (t Type) TimeRangeField() *Field {
+	if ant := t.TimeRange(); ant != nil {
+		return t.fields[ant.Field]
+	}
+	return nil
+}
+
+// checkTimeRange checks the time-range partitioning of the type, if it was defined.
+func (t Type) checkTimeRange() error {
+	ant := t.TimeRange()
+	if ant == nil {
+		return nil
+	}
+	f, ok := t.fields[ant.Field]
+	switch {
+	case !ok:
+		return fmt.Errorf("partition field %q was not found in schema %s", ant.Field, t.Name)
+	case f.Type.Type != field.TypeTime || f.Optional:
+		return fmt.Errorf("partition field %s.%s must be a required time field", t.Name, f.Name)
+	case ant.MaxRange < 0:
+		return fmt.Errorf("partition max range of schema %s must not be negative", t.Name)
+	case t.Storage != nil && t.Storage.Name != "sql":
+		return fmt.Errorf("partitioning of schema %s is not supported by storage %q", t.Name, t.Storage.Name)
+	}
+	return nil
+}
+
+// QueryDefaults returns the defaults of the generated queries of the type,
+// or nil if the type was not annotated with entsql.QueryDefaults.
+func (t Type) QueryDefaults() *QueryDefaults {
+	d, _ := t.queryDefaults()
+	return d
+}
+
+// EdgeOrdered indicates if the type is the target of an edge that was annotated with
+// entsql.OrderField. The queries of such types hold the default order of their edges.
+func (t Type) EdgeOrdered() bool {
+	return t.edgeOrdered
+}
+
+// HasScopedPredicates indicates if the queries of the type are filtered by default,
+// either by the soft-delete field or by the default predicates of the type.
+func (t Type) HasScopedPredicates() bool {
+	d := t.QueryDefaults()
+	return t.SoftDeleteField() != nil || d != nil && len(d.Where) > 0
+}
+
+// defaultFuncs maps the operators of entsql.Condition to their predicate functions.
+var defaultFuncs = map[string]string{
+	"=":           "EQ",
+	"<>":          "NEQ",
+	"<":           "LT",
+	"<=":          "LTE",
+	">":           "GT",
+	">=":          "GTE",
+	"IS NULL":     "IsNull",
+	"IS NOT NULL": "NotNull",
+}
+
+// queryDefaults returns the query defaults of the type, or an error if they are invalid.
+func (t Type) queryDefaults() (*QueryDefaults, error) {
+	ant := t.EntSQL()
+	if ant == nil || ant.QueryDefaults == nil {
+		return nil, nil
+	}
+	switch {
+	case t.Storage != nil && t.Storage.Name != "sql":
+		return nil, fmt.Errorf("query defaults of schema %s are not supported by storage %q", t.Name, t.Storage.Name)
+	case ant.QueryDefaults.Limit < 0:
+		return nil, fmt.Errorf("default limit of schema %s must not be negative", t.Name)
+	}
+	lookup := func(name string) (*Field, bool) {
+		if t.HasOneFieldID() && t.ID != nil && name == t.ID.Name {
+			return t.ID, true
+		}
+		f, ok := t.fields[name]
+		return f, ok
+	}
+	d := &QueryDefaults{Limit: ant.QueryDefaults.Limit}
+	for _, name := range ant.QueryDefaults.Order {
+		desc := strings.HasPrefix(name, "-")
+		f, ok := lookup(strings.TrimPrefix(name, "-"))
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("default order field %q was not found in schema %s", name, t.Name)
+		case f.IsJSON():
+			return nil, fmt.Errorf("json field %s.%s cannot be used in the default order", t.Name, f.Name)
+		}
+		d.Order = append(d.Order, &OrderTerm{Field: f, Desc: desc})
+	}
+	for _, c := range ant.QueryDefaults.Where {
+		f, ok := lookup(c.Field)
+		if !ok {
+			return nil, fmt.Errorf("default predicate field %q was not found in schema %s", c.Field, t.Name)
+		}
+		fn, ok := defaultFuncs[c.Op]
+		if !ok {
+			return nil, fmt.Errorf("unknown operator %q in default predicate of %s.%s", c.Op, t.Name, f.Name)
+		}
+		p := &DefaultPredicate{Field: f, Func: fn}
+		if fn == "IsNull" || fn == "NotNull" {
+			if c.Value != nil {
+				return nil, fmt.Errorf("operator %q in default predicate of %s.%s does not accept a value", c.Op, t.Name, f.Name)
+			}
+			d.Where = append(d.Where, p)
+			continue
+		}
+		switch v := c.Value.(type) {
+		case string:
+			if !f.IsString() && !f.IsEnum() {
+				return nil, fmt.Errorf("invalid value %q in default predicate of %s.%s", v, t.Name, f.Name)
+			}
+			p.Value = strconv.Quote(v)
+		case bool:
+			if !f.IsBool() {
+				return nil, fmt.Errorf("invalid value %v in default predicate of %s.%s", v, t.Name, f.Name)
+			}
+			p.Value = strconv.FormatBool(v)
+		case float64, int, int64:
+			if !f.Type.Numeric() {
+				return nil, fmt.Errorf("invalid value %v in default predicate of %s.%s", v, t.Name, f.Name)
+			}
+			p.Value = fmt.Sprint(v)
+		default:
+			return nil, fmt.Errorf("unsupported value %v (%T) in default predicate of %s.%s", v, v, t.Name, f.Name)
+		}
+		d.Where = append(d.Where, p)
+	}
+	return d, nil
+}
+
+// Comment returns the comment of the type, if it was defined using schema.Comment.
+func (t Type) Comment() string {
+	if t.schema != nil {
+		return t.schema.Comment
+	}
+	return ""
+}
+
+// NeighborTypes returns the distinct types that are connected to the type by its
+// edges (excluding the type itself), in the order of their first edge.
+func (t Type) NeighborTypes() []*Type {
+	var (
+		types []*Type
+		seen  = map[string]bool{t.Name: true}
+	)
+	for _, e := range t.Edges {
+		if !seen[e.Type.Name] {
+			seen[e.Type.Name] = true
+			types = append(types, e.Type)
+		}
+	}
+	return types
+}
+
+// IsView indicates if the type is backed by a database view (or a materialized view).
+func (t Type) IsView() bool {
+	ant := t.EntSQL()
+	return ant != nil && ant.IsView()
+}
+
+// IsMaterializedView indicates if the type is backed by a materialized view.
+func (t Type) IsMaterializedView() bool {
+	return t.IsView() && t.EntSQL().Materialized
+}
+
 // Package returns the package name of this node.
 func (t Type) Package() string {
 	if name := t.PackageAlias(); name != "" {
@@ -355,6 +764,33 @@ func (t Type) HasValidators() bool {
 	return false
 }
 
+// PolymorphicTypes returns the types of all polymorphic edges that point to this type, including
+// itself, sorted by their names. Each type has an As<Type> method for every type in this list.
+func (t Type) PolymorphicTypes() []*Type {
+	seen := make(map[string]*Type)
+	for _, pe := range t.polymorphs {
+		for _, e := range pe.Edges {
+			seen[e.Type.Name] = e.Type
+		}
+	}
+	types := make([]*Type, 0, len(seen))
+	for _, typ := range seen {
+		types = append(types, typ)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	return types
+}
+
+// HasCodecs reports if any of the type's field has a codec.
+func (t Type) HasCodecs() bool {
+	for _, f := range t.Fields {
+		if f.Codec {
+			return true
+		}
+	}
+	return false
+}
+
 // HasDefault reports if any of this type's fields has default value on creation.
 func (t Type) HasDefault() bool {
 	fields := t.Fields
@@ -379,6 +815,22 @@ func (t Type) HasUpdateDefault() bool {
 	return false
 }
 
+// HasSensitive reports if this type has a sensitive field.
+func (t Type) HasSensitive() bool {
+	return len(t.SensitiveFields()) > 0
+}
+
+// SensitiveFields returns the sensitive fields of the type.
+func (t Type) SensitiveFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.Sensitive() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 // HasOptional reports if this type has an optional field.
 func (t Type) HasOptional() bool {
 	for _, f := range t.Fields {
@@ -435,10 +887,23 @@ func (t Type) EdgesWithID() (edges []*Edge) {
 	return
 }
 
+// TransferEdges returns the edges that own the entities they point to, and are rewired by the
+// TransferOwnership method of the "sql/transfer" feature. i.e. O2O and O2M associations (whose
+// foreign-keys reside in the tables of the neighbors), and M2M associations. Inverse, bidirectional
+// and edge-schema edges are not included.
+func (t Type) TransferEdges() (edges []*Edge) {
+	for _, e := range t.EdgesWithID() {
+		if !e.IsInverse() && !e.Bidi && e.Through == nil && !e.Type.IsEdgeSchema() && !e.M2O() {
+			edges = append(edges, e)
+		}
+	}
+	return
+}
+
 // RuntimeMixin returns schema mixin that needs to be loaded at
 // runtime. For example, for default values, validators or hooks.
 func (t Type) RuntimeMixin() bool {
-	return len(t.MixedInFields()) > 0 || len(t.MixedInHooks()) > 0 || len(t.MixedInPolicies()) > 0
+	return len(t.MixedInFields()) > 0 || len(t.MixedInHooks()) > 0 || len(t.MixedInInterceptors()) > 0 || len(t.MixedInPolicies()) > 0
 }
 
 // MixedInFields returns the indices of mixin holds runtime code.
@@ -449,7 +914,7 @@ func (t Type) MixedInFields() []int {
 		fields = append(fields, t.ID)
 	}
 	for _, f := range fields {
-		if f.Position != nil && f.Position.MixedIn && (f.Default || f.UpdateDefault || f.Validators > 0) {
+		if f.Position != nil && f.Position.MixedIn && (f.Default || f.UpdateDefault || f.Validators > 0 || f.Codec) {
 			idx[f.Position.MixinIndex] = struct{}{}
 		}
 	}
@@ -470,6 +935,20 @@ func (t Type) MixedInHooks() []int {
 	return sortedKeys(idx)
 }
 
+// MixedInInterceptors returns the indices of mixin with interceptors.
+func (t Type) MixedInInterceptors() []int {
+	if t.schema == nil {
+		return nil
+	}
+	idx := make(map[int]struct{})
+	for _, h := range t.schema.Interceptors {
+		if h.MixedIn {
+			idx[h.MixinIndex] = struct{}{}
+		}
+	}
+	return sortedKeys(idx)
+}
+
 // MixedInPolicies returns the indices of mixin with policies.
 func (t Type) MixedInPolicies() []int {
 	if t.schema == nil {
@@ -522,6 +1001,18 @@ func (t Type) MutableFields() []*Field {
 	return fields
 }
 
+// CreateFields returns all type fields that can be set on creation.
+// i.e. all fields except the ones that are generated by the database.
+func (t Type) CreateFields() []*Field {
+	fields := make([]*Field, 0, len(t.Fields))
+	for _, f := range t.Fields {
+		if !f.Generated() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 // ImmutableFields returns all type fields that are immutable (for update).
 func (t Type) ImmutableFields() []*Field {
 	fields := make([]*Field, 0, len(t.Fields))
@@ -544,6 +1035,18 @@ func (t Type) MutationFields() []*Field {
 	return fields
 }
 
+// DatabaseDefaultFields returns the fields of the typed-mutation whose default values are set by
+// the database. They are scanned back after the entities are created (using the RETURNING clause).
+func (t Type) DatabaseDefaultFields() []*Field {
+	var fields []*Field
+	for _, f := range t.MutationFields() {
+		if f.DatabaseDefault() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 // EnumFields returns the enum fields of the schema, if any.
 func (t Type) EnumFields() []*Field {
 	var fields []*Field
@@ -555,6 +1058,92 @@ func (t Type) EnumFields() []*Field {
 	return fields
 }
 
+// OrderFields returns the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+// The fields are configured using the field.OrderFields annotation, and default to the ID field and
+// the fields that lead an index (including unique fields) if the annotation was not set. Encoded
+// fields are excluded, as their stored values do not keep the order of the original values.
+func (t Type) OrderFields() []*Field {
+	var fields []*Field
+	if ant := fieldAnnotate(t.Annotations); ant != nil && len(ant.OrderFields) > 0 {
+		for _, name := range ant.OrderFields {
+			if t.HasOneFieldID() && name == t.ID.Name {
+				fields = append(fields, t.ID)
+			} else if f, ok := t.fields[name]; ok {
+				fields = append(fields, f)
+			}
+		}
+		return fields
+	}
+	if t.HasOneFieldID() {
+		fields = append(fields, t.ID)
+	}
+	for _, f := range t.Fields {
+		indexed := f.Unique
+		for _, idx := range t.Indexes {
+			indexed = indexed || idx.Columns[0] == f.StorageKey()
+		}
+		if indexed && !f.IsJSON() && !f.Encoded() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// PaginationFields returns the fields that can be used as keys of cursor-based pagination. i.e. the
+// ID field, followed by the order fields that are not optional (NULL values cannot be compared by the
+// keyset predicates) or sensitive (their values are stored in the cursors).
+func (t Type) PaginationFields() []*Field {
+	if !t.HasOneFieldID() {
+		return nil
+	}
+	fields := []*Field{t.ID}
+	for _, f := range t.OrderFields() {
+		if f != t.ID && !f.Optional && !f.Sensitive() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// MutableAPIFields returns the mutable fields of the type that can be set by the public API.
+func (t Type) MutableAPIFields() []*Field {
+	var fields []*Field
+	for _, f := range t.MutableFields() {
+		if !f.ReadOnlyAPI() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// ReadOnlyAPIFields returns the fields that were annotated as read-only in the public API.
+func (t Type) ReadOnlyAPIFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.ReadOnlyAPI() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// ReadOnlyAPIDefaultFuncs returns the fields that were annotated as read-only in the public API,
+// and have a default function. These fields are checked by the create builders before their
+// defaults are applied, because the generated values cannot be told apart from explicit values.
+// Views are read-only, and therefore, they are not checked.
+func (t Type) ReadOnlyAPIDefaultFuncs() []*Field {
+	if t.IsView() {
+		return nil
+	}
+	var fields []*Field
+	for _, f := range t.ReadOnlyAPIFields() {
+		if f.Default && f.DefaultFunc() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 // FieldBy returns the first field that the given function returns true on it.
 func (t Type) FieldBy(fn func(*Field) bool) (*Field, bool) {
 	if fn(t.ID) {
@@ -657,6 +1246,72 @@ func (t *Type) AddIndex(idx *load.Index) error {
 	return nil
 }
 
+// addAccessPattern adds an index for the columns of the given fields or edges,
+// unless the type already has an index (or a primary key) that starts with them.
+func (t *Type) addAccessPattern(names []string) error {
+	if len(names) == 0 {
+		return errors.New("missing fields or edges")
+	}
+	columns := make([]string, 0, len(names))
+	for _, name := range names {
+		c, err := t.patternColumn(name)
+		if err != nil {
+			return err
+		}
+		columns = append(columns, c)
+	}
+	if t.HasOneFieldID() && len(columns) == 1 && columns[0] == t.ID.StorageKey() {
+		return nil
+	}
+	for _, idx := range t.Indexes {
+		if len(idx.Columns) >= len(columns) && reflect.DeepEqual(idx.Columns[:len(columns)], columns) {
+			return nil
+		}
+	}
+	t.Indexes = append(t.Indexes, &Index{
+		Name:    strings.Join(append([]string{strings.ToLower(t.Name)}, columns...), "_"),
+		Columns: columns,
+	})
+	return nil
+}
+
+// patternColumn returns the column of the given field or edge of an access pattern.
+func (t *Type) patternColumn(name string) (string, error) {
+	if t.HasOneFieldID() && name == t.ID.Name {
+		return t.ID.StorageKey(), nil
+	}
+	if f, ok := t.fields[name]; ok {
+		return f.StorageKey(), nil
+	}
+	for _, e := range t.Edges {
+		if e.Name == name {
+			if !e.OwnFK() {
+				return "", fmt.Errorf("edge %q of access pattern does not hold a foreign-key", name)
+			}
+			return e.Rel.Column(), nil
+		}
+	}
+	return "", fmt.Errorf("unknown access pattern field or edge %q", name)
+}
+
+// uncoveredFKs returns a description of the foreign-keys of the type that are not covered by
+// any index. The queries that are generated for their edges (e.g. traversals and eager-loading)
+// filter the table by these columns, and therefore, cannot use an index.
+func (t *Type) uncoveredFKs() []string {
+	var uncovered []string
+	for _, fk := range t.ForeignKeys {
+		c := fk.Edge.Rel.Column()
+		covered := fk.Edge.Rel.Type == O2O || t.HasOneFieldID() && c == t.ID.StorageKey()
+		for _, idx := range t.Indexes {
+			covered = covered || idx.Columns[0] == c
+		}
+		if !covered {
+			uncovered = append(uncovered, fmt.Sprintf("column %q of %s (edge %s.%s) is not covered by any index", c, t.Name, fk.Edge.Owner.Name, fk.Edge.Name))
+		}
+	}
+	return uncovered
+}
+
 // setupFKs makes sure all edge-fks are created for the edges.
 func (t *Type) setupFKs() error {
 	for _, e := range t.Edges {
@@ -700,6 +1355,13 @@ func (t *Type) setupFKs() error {
 				}
 			}
 		}
+		// Expose the foreign-key as an edge-field, if it was not defined in the schema.
+		holder, name := owner.exposeFK(e, refid)
+		if holder != nil {
+			if err := owner.setupFieldEdge(fk, holder, name); err != nil {
+				return err
+			}
+		}
 		// Special case for checking if the FK is already defined as the ID field (see issue 1288).
 		if key, _ := e.StorageKey(); key != nil && len(key.Columns) == 1 && key.Columns[0] == refid.StorageKey() {
 			fk.Field = refid
@@ -710,6 +1372,45 @@ func (t *Type) setupFKs() error {
 	return nil
 }
 
+// exposeFK adds an edge-field to the type for the foreign-key of the given assoc-edge, if
+// the FeatureEdgeFields is enabled and an edge-field was not defined in the schema. It returns
+// the edge that holds the foreign-key and the name of the added field, or nil if the foreign-key
+// was not exposed. Note that, foreign-keys that their edge does not exist in the type that holds
+// them (e.g. O2M edges without back-references), or their field name is already taken, are skipped.
+func (t *Type) exposeFK(e *Edge, refid *Field) (*Edge, string) {
+	if t.Config == nil || !t.featureEnabled(FeatureEdgeFields) || e.def.Field != "" || e.Ref != nil && e.Ref.def.Field != "" {
+		return nil, ""
+	}
+	holder := e
+	if !e.OwnFK() {
+		holder = e.Ref
+	}
+	if holder == nil {
+		return nil, ""
+	}
+	name := holder.Name + "_id"
+	if _, ok := t.fields[name]; ok || name == t.ID.Name {
+		return nil, ""
+	}
+	tf := &Field{
+		cfg: t.Config,
+		def: &load.Field{
+			Name:       name,
+			Info:       refid.Type,
+			Optional:   holder.Optional,
+			StorageKey: e.Rel.Column(),
+		},
+		Name:        name,
+		Type:        refid.Type,
+		Optional:    holder.Optional,
+		StructTag:   structTag(name, ""),
+		UserDefined: true,
+	}
+	t.Fields = append(t.Fields, tf)
+	t.fields[name] = tf
+	return holder, name
+}
+
 // setupEdgeField check the field-edge validity and configures it and its foreign-key.
 func (t *Type) setupFieldEdge(fk *ForeignKey, fkOwner *Edge, fkName string) error {
 	tf, ok := t.fields[fkName]
@@ -831,6 +1532,22 @@ func (t Type) HookPositions() []*load.Position {
 	return nil
 }
 
+// NumInterceptors returns the number of interceptors declared in the type schema.
+func (t Type) NumInterceptors() int {
+	if t.schema != nil {
+		return len(t.schema.Interceptors)
+	}
+	return 0
+}
+
+// InterceptorPositions returns the position information of interceptors declared in the type schema.
+func (t Type) InterceptorPositions() []*load.Position {
+	if t.schema != nil {
+		return t.schema.Interceptors
+	}
+	return nil
+}
+
 // NumPolicy returns the number of privacy-policy declared in the type schema.
 func (t Type) NumPolicy() int {
 	if t.schema != nil {
@@ -901,12 +1618,55 @@ func (t *Type) checkField(tf *Field, f *load.Field) (err error) {
 			// Enum types should be named as follows: typepkg.Field.
 			f.Info.Ident = fmt.Sprintf("%s.%s", t.PackageDir(), pascal(f.Name))
 		}
+	case tf.Compression() != "" && (tf.HasGoType() && !tf.IsJSON() || !tf.IsString() && !tf.IsBytes() && !tf.IsJSON()):
+		err = fmt.Errorf("compressed field %q must be a string, bytes or JSON field without a GoType", f.Name)
+	case tf.Codec && (tf.HasGoType() && !tf.IsJSON() || !tf.IsString() && !tf.IsBytes() && !tf.IsJSON()):
+		err = fmt.Errorf("encoded field %q must be a string, bytes or JSON field without a GoType", f.Name)
+	case tf.Codec && tf.Compression() != "":
+		err = fmt.Errorf("encoded field %q cannot be compressed", f.Name)
+	case tf.Codec && tf.Name == t.ID.Name:
+		err = fmt.Errorf("id field %q cannot have a codec", f.Name)
 	case tf.Validators > 0 && !tf.ConvertedToBasic():
 		err = fmt.Errorf("GoType %q for field %q must be converted to the basic %q type for validators", tf.Type, f.Name, tf.Type.Type)
+	case tf.EntSQL() != nil && tf.EntSQL().Sequence != nil:
+		err = tf.checkSequence()
+	case tf.EntSQL() != nil && tf.EntSQL().Identity != nil && (f.Name != "id" || !tf.Type.Type.Integer()):
+		err = fmt.Errorf("identity options are supported only by integer ID fields, got field %q", f.Name)
+	case tf.Generated():
+		err = tf.checkGenerated(t)
 	}
 	return err
 }
 
+// checkGenerated checks the expression and the options of a generated field.
+func (f Field) checkGenerated(t *Type) error {
+	switch ant := f.EntSQL(); {
+	case ant.Generated.Expr == "":
+		return fmt.Errorf("missing expression for generated field %q", f.Name)
+	case f.Name == t.ID.Name:
+		return fmt.Errorf("id field %q cannot be generated", f.Name)
+	case f.Default || f.UpdateDefault || ant.Default != "" || ant.Sequence != nil:
+		return fmt.Errorf("generated field %q cannot have a default value", f.Name)
+	case f.Encoded():
+		return fmt.Errorf("generated field %q cannot be encoded or compressed", f.Name)
+	}
+	return nil
+}
+
+// checkSequence checks the sequence that the default value of the field is drawn from.
+func (f Field) checkSequence() error {
+	seq := f.EntSQL().Sequence
+	switch {
+	case seq.Name == "":
+		return fmt.Errorf("missing sequence name for field %q", f.Name)
+	case !f.Type.Type.Integer():
+		return fmt.Errorf("field %q with sequence %q must be an integer field", f.Name, seq.Name)
+	case f.Default || f.EntSQL().Default != "":
+		return fmt.Errorf("field %q with sequence %q cannot have a default value", f.Name, seq.Name)
+	}
+	return nil
+}
+
 // UnexportedForeignKeys returns all foreign-keys that belong to the type
 // but are not exported (not defined with field). i.e. generated by ent.
 func (t Type) UnexportedForeignKeys() []*ForeignKey {
@@ -942,6 +1702,11 @@ func aliases(g *Graph) {
 				n.alias = path.Base(g.Package) + name
 			}
 		}
+		for _, e := range n.ExternalEdges {
+			if n, ok := names[e.Type.PkgName]; ok {
+				n.alias = path.Base(g.Package) + e.Type.PkgName
+			}
+		}
 	}
 }
 
@@ -1015,6 +1780,90 @@ func (f Field) EntSQL() *entsql.Annotation {
 	return entsqlAnnotate(f.Annotations)
 }
 
+// DatabaseDefault reports if the default value of the field is set by the database
+// (using the entsql.Annotation, a sequence or a generated column), and not by the
+// generated code.
+func (f Field) DatabaseDefault() bool {
+	ant := f.EntSQL()
+	return ant != nil && (ant.Default != "" || ant.Sequence != nil || ant.Generated != nil) && !f.Default && !f.Encoded()
+}
+
+// Generated reports if the field is a generated column, whose
+// value is computed by the database (see entsql.Generated).
+func (f Field) Generated() bool {
+	ant := f.EntSQL()
+	return ant != nil && ant.Generated != nil
+}
+
+// ReadOnlyAPI reports if the field was annotated as read-only in the public API.
+func (f Field) ReadOnlyAPI() bool {
+	ant := &entfield.Annotation{}
+	if f.Annotations == nil || f.Annotations[ant.Name()] == nil {
+		return false
+	}
+	if buf, err := json.Marshal(f.Annotations[ant.Name()]); err == nil {
+		_ = json.Unmarshal(buf, &ant)
+	}
+	return ant.ReadOnlyAPI
+}
+
+// Lineage returns the lineage information of the field (e.g. owner team or data classification),
+// that was defined using the entlineage annotation on the field or its type, or nil if there is none.
+func (f Field) Lineage() *entlineage.Annotation {
+	return f.lineage
+}
+
+// Compression returns the compression algorithm of the field, if it was annotated with entsql.Compress.
+func (f Field) Compression() string {
+	if ant := f.EntSQL(); ant != nil {
+		return ant.Compression
+	}
+	return ""
+}
+
+// CompressValue returns an expression that compresses the given value
+// identifier. It is used by the SQL templates for compressed fields.
+func (f Field) CompressValue(ident string) string {
+	fn := "Value"
+	if f.IsJSON() {
+		fn = "JSON"
+	}
+	return fmt.Sprintf("sqlcompress.%s(%q, %s)", fn, f.Compression(), ident)
+}
+
+// CodecName returns the name of the package variable that holds the codec of the field.
+func (f Field) CodecName() string {
+	return pascal(f.Name) + "Codec"
+}
+
+// Encoded reports if the field values are transformed before they are stored,
+// either by compression or by a codec. Encoded values are stored as bytes.
+func (f Field) Encoded() bool {
+	return f.Codec || f.Compression() != ""
+}
+
+// EncodeValue returns an expression that encodes the given value identifier of an encoded
+// field, where pkg is the package of its type. It is used by the SQL templates for writing.
+func (f Field) EncodeValue(pkg, ident string) string {
+	if !f.Codec {
+		return f.CompressValue(ident)
+	}
+	fn := "Value"
+	if f.IsJSON() {
+		fn = "JSON"
+	}
+	return fmt.Sprintf("sqlcodec.%s(%s.%s, %s)", fn, pkg, f.CodecName(), ident)
+}
+
+// DecodeValue returns an expression that decodes the given bytes identifier of an encoded
+// field, where pkg is the package of its type. It is used by the SQL templates for reading.
+func (f Field) DecodeValue(pkg, ident string) string {
+	if !f.Codec {
+		return fmt.Sprintf("sqlcompress.Decompress(%s)", ident)
+	}
+	return fmt.Sprintf("sqlcodec.Decode(%s.%s, %s)", pkg, f.CodecName(), ident)
+}
+
 // mutMethods returns the method names of mutation interface.
 var mutMethods = func() map[string]struct{} {
 	t := reflect.TypeOf(new(ent.Mutation)).Elem()
@@ -1142,6 +1991,9 @@ func (f Field) NillableValue() bool {
 
 // ScanType returns the Go type that is used for `rows.Scan`.
 func (f Field) ScanType() string {
+	if f.Encoded() {
+		return "[]byte"
+	}
 	if f.Type.ValueScanner() {
 		if f.Nillable && !f.standardNullType() {
 			return "sql.NullScanner"
@@ -1170,6 +2022,9 @@ func (f Field) ScanType() string {
 // to be used by the `rows.Scan` method. An sql.Scanner or a
 // nillable-type supported by the SQL driver (e.g. []byte).
 func (f Field) NewScanType() string {
+	if f.Encoded() {
+		return "new([]byte)"
+	}
 	if f.Type.ValueScanner() {
 		expr := fmt.Sprintf("new(%s)", f.Type.RType.String())
 		if f.Nillable && !f.standardNullType() {
@@ -1258,6 +2113,7 @@ func (f Field) Column() *schema.Column {
 		Nullable: f.Optional,
 		Size:     f.size(),
 		Enums:    f.EnumValues(),
+		Comment:  f.Comment(),
 	}
 	switch {
 	case f.Default && (f.Type.Numeric() || f.Type.Type == field.TypeBool):
@@ -1277,9 +2133,23 @@ func (f Field) Column() *schema.Column {
 	if ant := f.EntSQL(); ant != nil && ant.Collation != "" {
 		c.Collation = ant.Collation
 	}
+	if ant := f.EntSQL(); ant != nil && ant.Sequence != nil {
+		c.Sequence = ant.Sequence
+	}
+	if ant := f.EntSQL(); ant != nil && ant.Generated != nil {
+		c.Generated = ant.Generated
+	}
 	if f.def != nil {
 		c.SchemaType = f.def.SchemaType
 	}
+	// Encoded values are stored in binary columns,
+	// and their defaults are set only by the generated code.
+	if f.Encoded() {
+		c.Type, c.Default = field.TypeBytes, nil
+		if c.Size == 0 {
+			c.Size = math.MaxUint32
+		}
+	}
 	return c
 }
 
@@ -1310,6 +2180,7 @@ func (f Field) PK() *schema.Column {
 		Type:      f.Type.Type,
 		Key:       schema.PrimaryKey,
 		Increment: f.incremental(f.Type.Type.Integer()),
+		Comment:   f.Comment(),
 	}
 	// If the PK was defined by the user, and it is UUID or string.
 	if f.UserDefined && !f.Type.Numeric() {
@@ -1325,6 +2196,14 @@ func (f Field) PK() *schema.Column {
 	if ant := f.EntSQL(); ant != nil && ant.Default != "" {
 		c.Default = ant.Default
 	}
+	// IDs that are drawn from a sequence are not
+	// generated by the database as identity columns.
+	if ant := f.EntSQL(); ant != nil && ant.Sequence != nil {
+		c.Sequence, c.Increment = ant.Sequence, false
+	}
+	if ant := f.EntSQL(); ant != nil && ant.Identity != nil {
+		c.Identity = ant.Identity
+	}
 	if f.def != nil {
 		c.SchemaType = f.def.SchemaType
 	}
@@ -1337,7 +2216,7 @@ func (f Field) StorageKey() string {
 	if f.def != nil && f.def.StorageKey != "" {
 		return f.def.StorageKey
 	}
-	return snake(f.Name)
+	return f.cfg.naming().column(f.Name)
 }
 
 // HasGoType indicate if a basic field (like string or bool)
@@ -1383,6 +2262,29 @@ func (f Field) SupportsMutationAdd() bool {
 	return f.ConvertedToBasic() || f.implementsAdder()
 }
 
+// SupportsMutationGreater reports if the field supports the "SetIfGreater" operation,
+// that sets the field only if the given value is greater than its stored value.
+func (f Field) SupportsMutationGreater() bool {
+	return f.Type.Numeric() && !f.IsEdgeField() && f.ConvertedToBasic()
+}
+
+// SupportsMutationBitwise reports if the field supports the bitwise operations (a | b, a & b).
+func (f Field) SupportsMutationBitwise() bool {
+	return f.Type.Type.Integer() && !f.IsEdgeField() && f.ConvertedToBasic()
+}
+
+// SupportsMutationAppend reports if the field supports the mutation "Append(T)" interface.
+// Only JSON fields that are stored as arrays (i.e. Go slices) support it.
+func (f Field) SupportsMutationAppend() bool {
+	return f.IsJSON() && f.Type.RType != nil && f.Type.RType.Kind == reflect.Slice
+}
+
+// HasFieldOps reports if the field supports one of the in-database operations that
+// are not supported by all storage drivers (i.e. SetIfGreater, bitwise and Append).
+func (f Field) HasFieldOps() bool {
+	return f.SupportsMutationGreater() || f.SupportsMutationBitwise() || f.SupportsMutationAppend()
+}
+
 // MutationAddAssignExpr returns the expression for summing to identifiers and assigning to the mutation field.
 //
 //	MutationAddAssignExpr(a, b) => *m.a += b		// Basic Go type.
@@ -1521,7 +2423,7 @@ func (f Field) enums(lf *load.Field) ([]Enum, error) {
 // Ops returns all predicate operations of the field.
 func (f *Field) Ops() []Op {
 	ops := fieldOps(f)
-	if f.Name != "id" && f.cfg != nil && f.cfg.Storage.Ops != nil {
+	if f.Name != "id" && !f.Encoded() && f.cfg != nil && f.cfg.Storage.Ops != nil {
 		ops = append(ops, f.cfg.Storage.Ops(f)...)
 	}
 	return ops
@@ -1743,21 +2645,26 @@ func (e *Edge) setStorageKey() error {
 	return nil
 }
 
-// StorageKey returns the storage-key defined on the schema if exists.
+// StorageKey returns the storage-key defined on the schema if exists. The storage-key
+// can be defined on either side of the relation (the assoc or the inverse edge).
 func (e Edge) StorageKey() (*edge.StorageKey, error) {
 	key := e.def.StorageKey
-	if !e.IsInverse() {
-		return key, nil
+	ref := e.Ref
+	if ref == nil && e.IsInverse() {
+		ref, _ = e.Type.HasAssoc(e.Inverse)
 	}
-	assoc, ok := e.Owner.HasAssoc(e.Inverse)
-	if !ok || assoc.def.StorageKey == nil {
+	if ref == nil || ref.def == nil || ref.def.StorageKey == nil {
 		return key, nil
 	}
-	// Assoc/To edge found with storage-key configured.
-	if key != nil {
-		return nil, fmt.Errorf("multiple storage-keys defined for edge %q<->%q", e.Name, assoc.Name)
+	switch rkey := ref.def.StorageKey; {
+	case key == nil:
+		return rkey, nil
+	// Edges that were defined using the edge.To(..).From(..) form share the same key.
+	case reflect.DeepEqual(key, rkey):
+		return key, nil
+	default:
+		return nil, fmt.Errorf("multiple storage-keys defined for edge %q<->%q", e.Name, ref.Name)
 	}
-	return assoc.def.StorageKey, nil
 }
 
 // EntSQL returns the EntSQL annotation if exists.
@@ -1765,6 +2672,42 @@ func (e Edge) EntSQL() *entsql.Annotation {
 	return entsqlAnnotate(e.Annotations)
 }
 
+// Order returns the default order of the edge entities,
+// or nil if the edge was not annotated with entsql.OrderField.
+func (e Edge) Order() []*OrderTerm {
+	return e.order
+}
+
+// StructField returns the struct member of the external edge in the model.
+func (e ExternalEdge) StructField() string {
+	return pascal(e.Name)
+}
+
+// LoaderName returns the name of the loader interface of the external edge.
+func (e ExternalEdge) LoaderName() string {
+	return e.Owner.Name + e.StructField() + "Loader"
+}
+
+// Comment returns the comment of the external edge.
+func (e ExternalEdge) Comment() string {
+	return e.def.Comment
+}
+
+// StructField returns the struct member of the polymorphic edge in the model.
+func (e PolymorphicEdge) StructField() string {
+	return pascal(e.Name)
+}
+
+// InterfaceName returns the name of the interface that is implemented by the types of the edge.
+func (e PolymorphicEdge) InterfaceName() string {
+	return e.Owner.Name + e.StructField()
+}
+
+// Comment returns the comment of the polymorphic edge.
+func (e PolymorphicEdge) Comment() string {
+	return e.def.Comment
+}
+
 // Column returns the first element from the columns slice.
 func (r Relation) Column() string {
 	if len(r.Columns) == 0 {
@@ -1867,6 +2810,54 @@ func entsqlIndexAnnotate(annotation map[string]interface{}) *entsql.IndexAnnotat
 	return annotate
 }
 
+// retentionAnnotate extracts the entretention annotation from a loaded annotation format.
+// The strategy of the returned annotation defaults to entretention.StrategyDelete.
+func retentionAnnotate(annotation map[string]interface{}) *entretention.Annotation {
+	annotate := &entretention.Annotation{}
+	if annotation == nil || annotation[annotate.Name()] == nil {
+		return nil
+	}
+	if buf, err := json.Marshal(annotation[annotate.Name()]); err == nil {
+		_ = json.Unmarshal(buf, &annotate)
+	}
+	if annotate.Strategy == "" {
+		annotate.Strategy = entretention.StrategyDelete
+	}
+	return annotate
+}
+
+// partitionAnnotate extracts the entpartition annotation from a loaded annotation format.
+func partitionAnnotate(annotation map[string]interface{}) *entpartition.Annotation {
+	annotate := &entpartition.Annotation{}
+	if annotation == nil || annotation[annotate.Name()] == nil {
+		return nil
+	}
+	if buf, err := json.Marshal(annotation[annotate.Name()]); err == nil {
+		_ = json.Unmarshal(buf, &annotate)
+	}
+	return annotate
+}
+
+// lineageAnnotate extracts the entlineage annotations from the loaded annotations of a type
+// and its field, and merges them. It returns nil if neither of them describes the lineage.
+func lineageAnnotate(typ, field map[string]interface{}) *entlineage.Annotation {
+	var annotate entlineage.Annotation
+	for _, annotation := range []map[string]interface{}{typ, field} {
+		if annotation == nil || annotation[annotate.Name()] == nil {
+			continue
+		}
+		var ant entlineage.Annotation
+		if buf, err := json.Marshal(annotation[annotate.Name()]); err == nil {
+			_ = json.Unmarshal(buf, &ant)
+		}
+		annotate = annotate.Merge(ant).(entlineage.Annotation)
+	}
+	if annotate.IsZero() {
+		return nil
+	}
+	return &annotate
+}
+
 var (
 	// global identifiers used by the generated package.
 	globalIdent = names(
@@ -1874,8 +2865,10 @@ var (
 		"As",
 		"Asc",
 		"Client",
+		"Clock",
 		"config",
 		"Count",
+		"CountEdges",
 		"Debug",
 		"Desc",
 		"Driver",
@@ -1886,14 +2879,19 @@ var (
 		"Mutator",
 		"Op",
 		"Option",
+		"OrderBy",
 		"OrderFunc",
 		"Max",
 		"Mean",
 		"Min",
 		"Sum",
+		"PartitionBy",
 		"Policy",
 		"Query",
+		"Rank",
+		"RowNumber",
 		"Value",
+		"WindowOption",
 	)
 	// private fields used by the different builders.
 	privateField = names(
@@ -5,9 +5,16 @@
 package gen
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/entlineage"
+	"entgo.io/ent/schema/entpartition"
+	"entgo.io/ent/schema/entretention"
 	"entgo.io/ent/schema/field"
 
 	"github.com/stretchr/testify/require"
@@ -70,6 +77,96 @@ func TestType(t *testing.T) {
 	})
 	require.EqualError(err, "field name cannot be empty", "empty field name")
 
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "count", Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: map[string]interface{}{"EntSQL": map[string]interface{}{"compression": "gzip"}}},
+		},
+	})
+	require.EqualError(err, "compressed field \"count\" must be a string, bytes or JSON field without a GoType", "compressed int field")
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "count", Info: &field.TypeInfo{Type: field.TypeInt}, Codec: true},
+		},
+	})
+	require.EqualError(err, "encoded field \"count\" must be a string, bytes or JSON field without a GoType", "encoded int field")
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "body", Info: &field.TypeInfo{Type: field.TypeString}, Codec: true, Annotations: map[string]interface{}{"EntSQL": map[string]interface{}{"compression": "gzip"}}},
+		},
+	})
+	require.EqualError(err, "encoded field \"body\" cannot be compressed", "encoded and compressed field")
+	typ, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "secret", Info: &field.TypeInfo{Type: field.TypeString}, Codec: true},
+			{Name: "meta", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]string"}, Codec: true},
+		},
+	})
+	require.NoError(err)
+	require.True(typ.HasCodecs())
+	secret, meta := typ.Fields[0], typ.Fields[1]
+	require.True(secret.Encoded())
+	require.Equal("[]byte", secret.ScanType())
+	require.Equal("SecretCodec", secret.CodecName())
+	require.Equal("sqlcodec.Value(t.SecretCodec, v)", secret.EncodeValue("t", "v"))
+	require.Equal("sqlcodec.JSON(t.MetaCodec, v)", meta.EncodeValue("t", "v"))
+	require.Equal("sqlcodec.Decode(t.SecretCodec, b)", secret.DecodeValue("t", "b"))
+
+	softDelete := map[string]interface{}{"EntSQL": map[string]interface{}{"soft_delete": "deleted_at"}}
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{Name: "T", Annotations: softDelete})
+	require.EqualError(err, "soft-delete field \"deleted_at\" was not found in schema T", "missing soft-delete field")
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "deleted_at", Info: &field.TypeInfo{Type: field.TypeTime}, Optional: true},
+		},
+		Annotations: softDelete,
+	})
+	require.EqualError(err, "soft-delete field T.deleted_at must be an optional and nillable time field", "non-nillable soft-delete field")
+	typ, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "deleted_at", Info: &field.TypeInfo{Type: field.TypeTime}, Optional: true, Nillable: true},
+		},
+		Annotations: softDelete,
+	})
+	require.NoError(err)
+	require.Equal("deleted_at", typ.SoftDeleteField().Name)
+
+	version := map[string]interface{}{"EntSQL": map[string]interface{}{"version": "version"}}
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{Name: "T", Annotations: version})
+	require.EqualError(err, "version field \"version\" was not found in schema T", "missing version field")
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "version", Info: &field.TypeInfo{Type: field.TypeInt64}, Default: true},
+		},
+		Annotations: version,
+	})
+	require.EqualError(err, "version field T.version must be an immutable int or int64 field with a default value", "mutable version field")
+	typ, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "version", Info: &field.TypeInfo{Type: field.TypeInt64}, Default: true, Immutable: true},
+		},
+		Annotations: version,
+	})
+	require.NoError(err)
+	require.Equal("version", typ.VersionField().Name)
+	require.Nil(typ.SoftDeleteField())
+
+	archive := map[string]interface{}{"EntSQL": map[string]interface{}{"archive": true}}
+	_, err = NewType(&Config{Package: "entc/gen", Storage: &Storage{Name: "gremlin"}}, &load.Schema{Name: "T", Annotations: archive})
+	require.EqualError(err, "archive table of schema T is not supported by storage \"gremlin\"")
+	typ, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{Name: "T", Annotations: archive})
+	require.NoError(err)
+	require.Equal("ts_archive", typ.ArchiveTable())
+	require.Empty(typ.ArchiveEdges())
+
 	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
 		Name: "T",
 		Fields: []*load.Field{
@@ -249,6 +346,245 @@ func TestType_AddIndex(t *testing.T) {
 	require.NoError(t, err, "valid index on M2O relation and field")
 }
 
+func TestType_OrderFields(t *testing.T) {
+	fields := []*load.Field{
+		{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+		{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}},
+		{Name: "meta", Info: &field.TypeInfo{Type: field.TypeJSON}},
+	}
+	typ, err := NewType(&Config{}, &load.Schema{Name: "User", Fields: fields})
+	require.NoError(t, err)
+	require.NoError(t, typ.AddIndex(&load.Index{Fields: []string{"age", "name"}}))
+	var names []string
+	for _, f := range typ.OrderFields() {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"id", "email", "age"}, names, "id and fields that lead an index")
+
+	typ, err = NewType(&Config{}, &load.Schema{Name: "User", Fields: fields, Annotations: map[string]interface{}{
+		field.Annotation{}.Name(): field.OrderFields("name", "id"),
+	}})
+	require.NoError(t, err)
+	names = names[:0]
+	for _, f := range typ.OrderFields() {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"name", "id"}, names)
+
+	_, err = NewType(&Config{}, &load.Schema{Name: "User", Fields: fields, Annotations: map[string]interface{}{
+		field.Annotation{}.Name(): field.OrderFields("unknown"),
+	}})
+	require.EqualError(t, err, `unknown order field "unknown" for type "User"`)
+	_, err = NewType(&Config{}, &load.Schema{Name: "User", Fields: fields, Annotations: map[string]interface{}{
+		field.Annotation{}.Name(): field.OrderFields("meta"),
+	}})
+	require.EqualError(t, err, `json field "meta" cannot be used as an order field of type "User"`)
+
+	compressed := append(fields, &load.Field{Name: "body", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true, Annotations: map[string]interface{}{"EntSQL": map[string]interface{}{"compression": "gzip"}}})
+	typ, err = NewType(&Config{}, &load.Schema{Name: "User", Fields: compressed})
+	require.NoError(t, err)
+	names = names[:0]
+	for _, f := range typ.OrderFields() {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"id", "email"}, names, "compressed fields are excluded")
+	require.Empty(t, typ.fields["body"].Ops(), "compressed fields have no value predicates")
+	_, err = NewType(&Config{}, &load.Schema{Name: "User", Fields: compressed, Annotations: map[string]interface{}{
+		field.Annotation{}.Name(): field.OrderFields("body"),
+	}})
+	require.EqualError(t, err, `encoded field "body" cannot be used as an order field of type "User"`)
+
+	encoded := append(fields, &load.Field{Name: "ssn", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true, Optional: true, Codec: true})
+	typ, err = NewType(&Config{}, &load.Schema{Name: "User", Fields: encoded})
+	require.NoError(t, err)
+	names = names[:0]
+	for _, f := range typ.OrderFields() {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"id", "email"}, names, "encoded fields are excluded")
+	require.Equal(t, nillableOps, typ.fields["ssn"].Ops(), "encoded fields can be checked only for NULL")
+}
+
+func TestType_PaginationFields(t *testing.T) {
+	fields := []*load.Field{
+		{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		{Name: "nickname", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+		{Name: "secret", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true},
+	}
+	typ, err := NewType(&Config{}, &load.Schema{Name: "User", Fields: fields, Annotations: map[string]interface{}{
+		field.Annotation{}.Name(): field.OrderFields("name", "nickname", "secret", "id"),
+	}})
+	require.NoError(t, err)
+	var names []string
+	for _, f := range typ.PaginationFields() {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"id", "name"}, names, "id first, and no optional or sensitive fields")
+}
+
+func TestField_Lineage(t *testing.T) {
+	fields := []*load.Field{
+		{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: map[string]interface{}{
+			entlineage.Annotation{}.Name(): entlineage.Annotation{Classification: entlineage.Confidential, Retention: 24 * time.Hour},
+		}},
+		{Name: "team", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: map[string]interface{}{
+			entlineage.Annotation{}.Name(): entlineage.Owner("platform"),
+		}},
+	}
+	typ, err := NewType(&Config{}, &load.Schema{Name: "User", Fields: fields})
+	require.NoError(t, err)
+	require.Nil(t, typ.ID.Lineage())
+	require.Nil(t, typ.Fields[0].Lineage())
+	require.Equal(t, &entlineage.Annotation{Classification: entlineage.Confidential, Retention: 24 * time.Hour}, typ.Fields[1].Lineage())
+
+	typ, err = NewType(&Config{}, &load.Schema{Name: "User", Fields: fields, Annotations: map[string]interface{}{
+		entlineage.Annotation{}.Name(): entlineage.Owner("identity"),
+	}})
+	require.NoError(t, err)
+	require.Equal(t, &entlineage.Annotation{Owner: "identity"}, typ.ID.Lineage())
+	require.Equal(t, &entlineage.Annotation{Owner: "identity"}, typ.Fields[0].Lineage())
+	require.Equal(t, &entlineage.Annotation{Owner: "identity", Classification: entlineage.Confidential, Retention: 24 * time.Hour}, typ.Fields[1].Lineage())
+	require.Equal(t, &entlineage.Annotation{Owner: "platform"}, typ.Fields[2].Lineage(), "field annotation overrides the schema annotation")
+}
+
+func TestType_RetentionPolicy(t *testing.T) {
+	fields := []*load.Field{
+		{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+		{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}},
+	}
+	typ, err := NewType(&Config{}, &load.Schema{Name: "User", Fields: fields})
+	require.NoError(t, err)
+	require.Nil(t, typ.RetentionPolicy())
+
+	tests := []struct {
+		ant *entretention.Annotation
+		err string
+	}{
+		{ant: &entretention.Annotation{Field: "created_at", Window: time.Hour}},
+		{ant: entretention.Anonymize("created_at", time.Hour, "email")},
+		{ant: entretention.Delete("updated_at", time.Hour), err: `retention field "updated_at" was not found in schema User`},
+		{ant: entretention.Delete("name", time.Hour), err: "retention field User.name must be a time field"},
+		{ant: entretention.Archive("created_at", 0), err: "retention window of schema User must be positive"},
+		{ant: &entretention.Annotation{Field: "created_at", Window: time.Hour, Strategy: "purge"}, err: `unknown retention strategy "purge" for schema User`},
+		{ant: entretention.Anonymize("created_at", time.Hour), err: "anonymize retention of schema User requires at least one field"},
+		{ant: entretention.Anonymize("created_at", time.Hour, "name"), err: "anonymized field User.name must be an optional and mutable field, other than the retention field"},
+		{ant: &entretention.Annotation{Field: "created_at", Window: time.Hour, Fields: []string{"email"}}, err: `retention strategy "delete" of schema User does not accept fields`},
+	}
+	for _, tt := range tests {
+		typ, err := NewType(&Config{}, &load.Schema{Name: "User", Fields: fields, Annotations: map[string]interface{}{
+			tt.ant.Name(): tt.ant,
+		}})
+		if tt.err != "" {
+			require.EqualError(t, err, tt.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, "created_at", typ.RetentionPolicy().Field)
+		require.NotEmpty(t, typ.RetentionPolicy().Strategy)
+	}
+}
+
+func TestType_TimeRange(t *testing.T) {
+	fields := []*load.Field{
+		{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		{Name: "deleted_at", Info: &field.TypeInfo{Type: field.TypeTime}, Optional: true},
+		{Name: "occurred_at", Info: &field.TypeInfo{Type: field.TypeTime}},
+	}
+	typ, err := NewType(&Config{}, &load.Schema{Name: "Event", Fields: fields})
+	require.NoError(t, err)
+	require.Nil(t, typ.TimeRange())
+	require.Nil(t, typ.TimeRangeField())
+
+	tests := []struct {
+		ant *entpartition.Annotation
+		err string
+	}{
+		{ant: entpartition.TimeRange("occurred_at", 0)},
+		{ant: entpartition.TimeRange("occurred_at", 24*time.Hour)},
+		{ant: entpartition.TimeRange("created_at", 0), err: `partition field "created_at" was not found in schema Event`},
+		{ant: entpartition.TimeRange("name", 0), err: "partition field Event.name must be a required time field"},
+		{ant: entpartition.TimeRange("deleted_at", 0), err: "partition field Event.deleted_at must be a required time field"},
+		{ant: entpartition.TimeRange("occurred_at", -time.Hour), err: "partition max range of schema Event must not be negative"},
+	}
+	for _, tt := range tests {
+		typ, err := NewType(&Config{}, &load.Schema{Name: "Event", Fields: fields, Annotations: map[string]interface{}{
+			tt.ant.Name(): tt.ant,
+		}})
+		if tt.err != "" {
+			require.EqualError(t, err, tt.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tt.ant, typ.TimeRange())
+		require.Equal(t, "occurred_at", typ.TimeRangeField().Name)
+	}
+}
+
+func TestType_QueryDefaults(t *testing.T) {
+	fields := []*load.Field{
+		{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}},
+		{Name: "active", Info: &field.TypeInfo{Type: field.TypeBool}},
+		{Name: "meta", Info: &field.TypeInfo{Type: field.TypeJSON}},
+		{Name: "deleted_at", Info: &field.TypeInfo{Type: field.TypeTime}, Optional: true, Nillable: true},
+	}
+	typ, err := NewType(&Config{}, &load.Schema{Name: "T", Fields: fields})
+	require.NoError(t, err)
+	require.Nil(t, typ.QueryDefaults())
+	require.False(t, typ.HasScopedPredicates())
+
+	var ant schema.Annotation = entsql.Annotation{}
+	for _, a := range []*entsql.Annotation{
+		entsql.DefaultOrder("-age", "id"),
+		entsql.DefaultLimit(10),
+		entsql.DefaultWhere("active", "=", true),
+		entsql.DefaultWhere("name", "<>", "a8m"),
+		entsql.DefaultWhere("age", ">=", 18),
+		entsql.DefaultWhere("deleted_at", "IS NULL", nil),
+	} {
+		ant = ant.(schema.Merger).Merge(a)
+	}
+	typ, err = NewType(&Config{}, &load.Schema{Name: "T", Fields: fields, Annotations: map[string]interface{}{ant.Name(): ant}})
+	require.NoError(t, err)
+	require.True(t, typ.HasScopedPredicates())
+	d := typ.QueryDefaults()
+	require.Equal(t, 10, d.Limit)
+	require.Len(t, d.Order, 2)
+	require.Equal(t, "age", d.Order[0].Field.Name)
+	require.True(t, d.Order[0].Desc)
+	require.Equal(t, "id", d.Order[1].Field.Name)
+	require.False(t, d.Order[1].Desc)
+	require.Equal(t, []*DefaultPredicate{
+		{Field: typ.fields["active"], Func: "EQ", Value: "true"},
+		{Field: typ.fields["name"], Func: "NEQ", Value: `"a8m"`},
+		{Field: typ.fields["age"], Func: "GTE", Value: "18"},
+		{Field: typ.fields["deleted_at"], Func: "IsNull"},
+	}, d.Where)
+
+	tests := []struct {
+		ant *entsql.Annotation
+		err string
+	}{
+		{ant: entsql.DefaultOrder("created_at"), err: `default order field "created_at" was not found in schema T`},
+		{ant: entsql.DefaultOrder("-meta"), err: "json field T.meta cannot be used in the default order"},
+		{ant: entsql.DefaultLimit(-1), err: "default limit of schema T must not be negative"},
+		{ant: entsql.DefaultWhere("status", "=", "a"), err: `default predicate field "status" was not found in schema T`},
+		{ant: entsql.DefaultWhere("name", "LIKE", "a"), err: `unknown operator "LIKE" in default predicate of T.name`},
+		{ant: entsql.DefaultWhere("name", "IS NULL", "a"), err: `operator "IS NULL" in default predicate of T.name does not accept a value`},
+		{ant: entsql.DefaultWhere("age", "=", "a"), err: `invalid value "a" in default predicate of T.age`},
+		{ant: entsql.DefaultWhere("name", "=", 1), err: "invalid value 1 in default predicate of T.name"},
+	}
+	for _, tt := range tests {
+		_, err := NewType(&Config{}, &load.Schema{Name: "T", Fields: fields, Annotations: map[string]interface{}{tt.ant.Name(): tt.ant}})
+		require.EqualError(t, err, tt.err)
+	}
+	_, err = NewType(&Config{Storage: drivers[1]}, &load.Schema{Name: "T", Fields: fields, Annotations: map[string]interface{}{ant.Name(): ant}})
+	require.EqualError(t, err, `query defaults of schema T are not supported by storage "gremlin"`)
+}
+
 func TestField_Constant(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -279,6 +615,27 @@ func TestField_DefaultName(t *testing.T) {
 	}
 }
 
+func TestField_FieldOps(t *testing.T) {
+	tests := []struct {
+		typ                     *field.TypeInfo
+		greater, bitwise, appnd bool
+	}{
+		{&field.TypeInfo{Type: field.TypeInt}, true, true, false},
+		{&field.TypeInfo{Type: field.TypeUint8}, true, true, false},
+		{&field.TypeInfo{Type: field.TypeFloat64}, true, false, false},
+		{&field.TypeInfo{Type: field.TypeString}, false, false, false},
+		{&field.TypeInfo{Type: field.TypeInt64, RType: &field.RType{Kind: reflect.Struct, Ident: "sql.NullInt64"}}, false, false, false},
+		{&field.TypeInfo{Type: field.TypeJSON, RType: &field.RType{Kind: reflect.Slice, Ident: "[]string"}}, false, false, true},
+		{&field.TypeInfo{Type: field.TypeJSON, RType: &field.RType{Kind: reflect.Map, Ident: "map[string]int"}}, false, false, false},
+	}
+	for _, tt := range tests {
+		f := Field{Name: "f", Type: tt.typ}
+		require.Equal(t, tt.greater, f.SupportsMutationGreater(), tt.typ)
+		require.Equal(t, tt.bitwise, f.SupportsMutationBitwise(), tt.typ)
+		require.Equal(t, tt.appnd, f.SupportsMutationAppend(), tt.typ)
+	}
+}
+
 func TestField_incremental(t *testing.T) {
 	tests := []struct {
 		annotations map[string]interface{}
@@ -296,6 +653,98 @@ func TestField_incremental(t *testing.T) {
 	}
 }
 
+func TestField_Sequence(t *testing.T) {
+	seq := dict("EntSQL", dict("sequence", dict("name", "numbers", "start", 1000)))
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "id", Info: &field.TypeInfo{Type: field.TypeInt64}, Annotations: seq},
+			{Name: "number", Info: &field.TypeInfo{Type: field.TypeInt64}, Optional: true, Annotations: seq},
+		},
+	})
+	require.NoError(t, err)
+	pk := typ.ID.PK()
+	require.False(t, pk.Increment, "IDs that are drawn from sequences are not identity columns")
+	require.Equal(t, &entsql.Sequence{Name: "numbers", Start: 1000}, pk.Sequence)
+	require.Equal(t, &entsql.Sequence{Name: "numbers", Start: 1000}, typ.Fields[0].Column().Sequence)
+	require.True(t, typ.Fields[0].DatabaseDefault())
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "number", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: seq}},
+	})
+	require.EqualError(t, err, `field "number" with sequence "numbers" must be an integer field`)
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "number", Info: &field.TypeInfo{Type: field.TypeInt}, Default: true, DefaultValue: 1, Annotations: seq}},
+	})
+	require.EqualError(t, err, `field "number" with sequence "numbers" cannot have a default value`)
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "number", Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: dict("EntSQL", dict("sequence", dict()))}},
+	})
+	require.EqualError(t, err, `missing sequence name for field "number"`)
+}
+
+func TestField_Identity(t *testing.T) {
+	identity := dict("EntSQL", dict("identity", dict("start", 1000)))
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "id", Info: &field.TypeInfo{Type: field.TypeInt64}, Annotations: identity}},
+	})
+	require.NoError(t, err)
+	pk := typ.ID.PK()
+	require.True(t, pk.Increment)
+	require.Equal(t, &entsql.Identity{Start: 1000}, pk.Identity)
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "number", Info: &field.TypeInfo{Type: field.TypeInt64}, Annotations: identity}},
+	})
+	require.EqualError(t, err, `identity options are supported only by integer ID fields, got field "number"`)
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "id", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: identity}},
+	})
+	require.Error(t, err)
+}
+
+func TestField_Generated(t *testing.T) {
+	generated := dict("EntSQL", dict("generated", dict("expr", "lower(email)")))
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "domain", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: generated},
+		},
+	})
+	require.NoError(t, err)
+	f := typ.Fields[1]
+	require.True(t, f.Generated())
+	require.True(t, f.Optional, "generated fields are implicitly optional")
+	require.True(t, f.Immutable, "generated fields are implicitly immutable")
+	require.True(t, f.DatabaseDefault())
+	require.Equal(t, &entsql.Generated{Expr: "lower(email)"}, f.Column().Generated)
+	require.Equal(t, []*Field{typ.Fields[0]}, typ.CreateFields())
+	require.Equal(t, []*Field{typ.Fields[0]}, typ.MutableFields())
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "domain", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: dict("EntSQL", dict("generated", dict()))}},
+	})
+	require.EqualError(t, err, `missing expression for generated field "domain"`)
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "domain", Info: &field.TypeInfo{Type: field.TypeString}, Default: true, DefaultValue: "entgo.io", Annotations: generated}},
+	})
+	require.EqualError(t, err, `generated field "domain" cannot have a default value`)
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "id", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: generated}},
+	})
+	require.EqualError(t, err, `id field "id" cannot be generated`)
+}
+
 func TestBuilderField(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -333,6 +782,8 @@ func TestValidSchemaName(t *testing.T) {
 	require.Error(t, err)
 	err = ValidSchemaName("Mutation")
 	require.Error(t, err)
+	err = ValidSchemaName("Rank")
+	require.Error(t, err)
 	err = ValidSchemaName("Boring")
 	require.NoError(t, err)
 	err = ValidSchemaName("Order")
@@ -0,0 +1,767 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/entc/gen"
+
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	"ariga.io/atlas/sql/sqlite"
+	"github.com/go-openapi/inflect"
+)
+
+type (
+	// generator converts the tables of a database schema to ent schemas.
+	generator struct {
+		*config
+		dialect string
+		nodes   map[*schema.Table]*node
+		// joins holds the tables that are imported as M2M edges.
+		joins   []*schema.Table
+		skipped map[string]string
+	}
+
+	// node is an ent schema that is generated from a table.
+	node struct {
+		table *schema.Table
+		name  string
+		// id is the primary-key column, and idType is the
+		// field constructor of its type (e.g. "field.Int").
+		id      *schema.Column
+		idType  string
+		idField *expr
+		// columns maps the columns to their field names, and
+		// refs maps the edge-field columns to the referenced nodes.
+		columns map[*schema.Column]string
+		refs    map[*schema.Column]*node
+		fields  []*expr
+		edges   []*expr
+		indexes []*expr
+		// used holds the names of the fields and the edges.
+		used    map[string]bool
+		imports map[string]bool
+	}
+
+	// expr is a Go expression of a builder chain, like:
+	// field.String("name").MaxLen(10).Optional().
+	expr struct {
+		calls []string
+	}
+)
+
+// chain returns a new builder chain that starts with the given call.
+func chain(format string, args ...interface{}) *expr {
+	return &expr{calls: []string{fmt.Sprintf(format, args...)}}
+}
+
+// Add adds a method call to the chain.
+func (e *expr) Add(format string, args ...interface{}) *expr {
+	e.calls = append(e.calls, fmt.Sprintf(format, args...))
+	return e
+}
+
+// String returns the Go code of the chain, with one call per line.
+func (e *expr) String() string {
+	return strings.Join(e.calls, ".\n")
+}
+
+// Generate generates the ent schemas for the tables of the given database schema. The dialect
+// is used for annotating columns with database-specific types. Tables that cannot be converted
+// to ent schemas, like tables without a primary-key or with a composite one that are not join
+// tables, are skipped and reported in the result.
+func Generate(dialect string, s *schema.Schema, opts ...Option) (*Result, error) {
+	g := &generator{
+		config:  newConfig(opts),
+		dialect: dialect,
+		nodes:   make(map[*schema.Table]*node),
+		skipped: make(map[string]string),
+	}
+	tables := make([]*schema.Table, 0, len(s.Tables))
+	for _, t := range s.Tables {
+		if g.included(t.Name) {
+			tables = append(tables, t)
+		}
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Name < tables[j].Name })
+	names := make(map[string]string)
+	for _, t := range tables {
+		if isJoinTable(t) {
+			g.joins = append(g.joins, t)
+			continue
+		}
+		n, err := g.node(t)
+		if err != nil {
+			g.skipped[t.Name] = err.Error()
+			continue
+		}
+		if other, ok := names[n.name]; ok {
+			g.skipped[t.Name] = fmt.Sprintf("schema %s was already generated for table %q", n.name, other)
+			continue
+		}
+		names[n.name] = t.Name
+		g.nodes[t] = n
+	}
+	// Join tables are imported as M2M edges only if
+	// both of the tables they reference were imported.
+	joins := g.joins[:0]
+	for _, t := range g.joins {
+		if g.nodes[t.ForeignKeys[0].RefTable] != nil && g.nodes[t.ForeignKeys[1].RefTable] != nil {
+			joins = append(joins, t)
+			continue
+		}
+		g.skipped[t.Name] = "join table references tables that were not imported"
+	}
+	g.joins = joins
+	nodes := g.sorted()
+	for _, n := range nodes {
+		g.edgeFields(n)
+	}
+	for _, n := range nodes {
+		g.fields(n)
+		g.indexes(n)
+	}
+	for _, n := range nodes {
+		g.fkEdges(n)
+	}
+	for _, t := range g.joins {
+		g.m2mEdges(t)
+	}
+	res := &Result{Files: make(map[string][]byte), Skipped: g.skipped}
+	for _, n := range nodes {
+		b, err := n.format()
+		if err != nil {
+			return nil, err
+		}
+		res.Files[strings.ToLower(n.name)+".go"] = b
+	}
+	return res, nil
+}
+
+// sorted returns the generated nodes sorted by their table names.
+func (g *generator) sorted() []*node {
+	nodes := make([]*node, 0, len(g.nodes))
+	for _, n := range g.nodes {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].table.Name < nodes[j].table.Name })
+	return nodes
+}
+
+// node creates the node of the given table, and its id field.
+func (g *generator) node(t *schema.Table) (*node, error) {
+	if t.PrimaryKey == nil || len(t.PrimaryKey.Parts) == 0 {
+		return nil, fmt.Errorf("table has no primary-key")
+	}
+	if len(t.PrimaryKey.Parts) > 1 {
+		return nil, fmt.Errorf("table has a composite primary-key")
+	}
+	id := t.PrimaryKey.Parts[0].C
+	if id == nil {
+		return nil, fmt.Errorf("primary-key is an expression")
+	}
+	name := inflect.Camelize(inflect.Singularize(t.Name))
+	if err := gen.ValidSchemaName(name); err != nil {
+		return nil, err
+	}
+	n := &node{
+		table:   t,
+		name:    name,
+		id:      id,
+		columns: make(map[*schema.Column]string),
+		refs:    make(map[*schema.Column]*node),
+		used:    map[string]bool{"id": true},
+		imports: map[string]bool{"entgo.io/ent": true},
+	}
+	switch id.Type.Type.(type) {
+	case *schema.IntegerType, *postgres.SerialType:
+		n.idType = "field.Int"
+		if id.Name != "id" {
+			n.idField = chain("field.Int(%q)", "id")
+		}
+	case *schema.StringType:
+		n.idType = "field.String"
+		n.idField = chain("field.String(%q)", "id")
+		if size := id.Type.Type.(*schema.StringType).Size; size > 0 {
+			n.idField.Add("MaxLen(%d)", size)
+		}
+	case *postgres.UUIDType, *sqlite.UUIDType:
+		n.idType = "field.UUID"
+		n.idField = chain("field.UUID(%q, uuid.UUID{})", "id")
+		n.imports["github.com/google/uuid"] = true
+	default:
+		return nil, fmt.Errorf("unsupported primary-key type %q", id.Type.Raw)
+	}
+	if n.idField != nil && id.Name != "id" {
+		n.idField.Add("StorageKey(%q)", id.Name)
+	}
+	for _, c := range t.Columns {
+		if c == id {
+			continue
+		}
+		name := fieldName(c.Name)
+		if n.used[name] {
+			return nil, fmt.Errorf("column %q conflicts with another column", c.Name)
+		}
+		n.used[name] = true
+		n.columns[c] = name
+	}
+	return n, nil
+}
+
+// edgeFields collects the columns of the node that reference the id of another node.
+func (g *generator) edgeFields(n *node) {
+	for _, fk := range n.table.ForeignKeys {
+		if ref, ok := g.reference(fk); ok && n.columns[fk.Columns[0]] != "" && n.refs[fk.Columns[0]] == nil {
+			n.refs[fk.Columns[0]] = ref
+		}
+	}
+}
+
+// reference returns the node that is referenced by the given
+// foreign-key, if it references the id of an imported node.
+func (g *generator) reference(fk *schema.ForeignKey) (*node, bool) {
+	if len(fk.Columns) != 1 || len(fk.RefColumns) != 1 {
+		return nil, false
+	}
+	ref, ok := g.nodes[fk.RefTable]
+	if !ok || ref.id.Name != fk.RefColumns[0].Name {
+		return nil, false
+	}
+	return ref, true
+}
+
+// fields generates the fields of the node.
+func (g *generator) fields(n *node) {
+	if n.idField != nil {
+		n.fields = append(n.fields, n.idField)
+	}
+	for _, c := range n.table.Columns {
+		name, ok := n.columns[c]
+		if !ok {
+			continue
+		}
+		var f *expr
+		if ref, ok := n.refs[c]; ok {
+			f = g.edgeField(n, ref, name)
+		} else {
+			f = g.field(n, c, name)
+		}
+		if c.Type.Null {
+			f.Add("Optional()")
+			if _, ok := n.refs[c]; !ok && nillable(c.Type.Type) {
+				f.Add("Nillable()")
+			}
+		}
+		if unique(n.table, c) {
+			f.Add("Unique()")
+		}
+		if _, ok := n.refs[c]; !ok {
+			g.defaults(n, f, c)
+		}
+		if name != c.Name {
+			f.Add("StorageKey(%q)", c.Name)
+		}
+		if comment := commentOf(c.Attrs); comment != "" {
+			f.Add("Comment(%q)", comment)
+		}
+		n.fields = append(n.fields, f)
+	}
+}
+
+// edgeField returns the field of an edge-field column. Its type is the id type of the referenced node.
+func (g *generator) edgeField(n, ref *node, name string) *expr {
+	if ref.idType == "field.UUID" {
+		n.imports["github.com/google/uuid"] = true
+		return chain("field.UUID(%q, uuid.UUID{})", name)
+	}
+	return chain("%s(%q)", ref.idType, name)
+}
+
+// field returns the field of the given column.
+func (g *generator) field(n *node, c *schema.Column, name string) *expr {
+	switch t := c.Type.Type.(type) {
+	case *schema.BoolType:
+		return chain("field.Bool(%q)", name)
+	case *schema.IntegerType:
+		return chain("field.%s(%q)", g.intType(t), name)
+	case *postgres.SerialType:
+		return chain("field.Int(%q)", name)
+	case *schema.FloatType:
+		if t.T == "real" || t.T == "float4" {
+			return chain("field.Float32(%q)", name)
+		}
+		return chain("field.Float(%q)", name)
+	case *schema.DecimalType:
+		return g.schemaType(n, chain("field.Float(%q)", name), c)
+	case *schema.StringType:
+		if strings.Contains(t.T, "text") || t.T == "clob" {
+			return chain("field.Text(%q)", name)
+		}
+		f := chain("field.String(%q)", name)
+		if t.Size > 0 {
+			f.Add("MaxLen(%d)", t.Size)
+		}
+		return f
+	case *schema.BinaryType:
+		return chain("field.Bytes(%q)", name)
+	case *schema.TimeType:
+		f := chain("field.Time(%q)", name)
+		if t.T == "date" || t.T == "time" {
+			g.schemaType(n, f, c)
+		}
+		return f
+	case *schema.JSONType:
+		n.imports["encoding/json"] = true
+		return chain("field.JSON(%q, json.RawMessage{})", name)
+	case *schema.EnumType:
+		values := make([]string, len(t.Values))
+		for i, v := range t.Values {
+			values[i] = strconv.Quote(v)
+		}
+		return chain("field.Enum(%q)", name).Add("Values(%s)", strings.Join(values, ", "))
+	case *postgres.UUIDType, *sqlite.UUIDType:
+		n.imports["github.com/google/uuid"] = true
+		return chain("field.UUID(%q, uuid.UUID{})", name)
+	case *schema.SpatialType:
+		return g.schemaType(n, chain("field.Bytes(%q)", name), c)
+	default:
+		// Types that do not have a Go type in ent (e.g. network, interval or array
+		// types) are scanned as strings, and keep their database type in migrations.
+		return g.schemaType(n, chain("field.String(%q)", name), c)
+	}
+}
+
+// intType returns the ent type of the given integer type.
+func (g *generator) intType(t *schema.IntegerType) string {
+	// All integer types in SQLite have the same storage class.
+	if g.dialect == dialect.SQLite {
+		return "Int"
+	}
+	var typ string
+	switch strings.ToLower(t.T) {
+	case "tinyint":
+		typ = "Int8"
+	case "smallint", "int2":
+		typ = "Int16"
+	case "int", "int4", "integer", "mediumint":
+		typ = "Int32"
+	case "bigint", "int8":
+		typ = "Int64"
+	}
+	switch {
+	case t.Unsigned:
+		return "Uint" + strings.TrimPrefix(typ, "Int")
+	case typ == "Int64", typ == "":
+		return "Int"
+	default:
+		return typ
+	}
+}
+
+// schemaType sets the database type of the column for the dialect of the import.
+func (g *generator) schemaType(n *node, f *expr, c *schema.Column) *expr {
+	raw := c.Type.Raw
+	if raw == "" {
+		return f
+	}
+	n.imports["entgo.io/ent/dialect"] = true
+	return f.Add("SchemaType(map[string]string{\n%s: %q,\n})", dialectConst(g.dialect), raw)
+}
+
+var (
+	// now matches the expressions that set the current time.
+	now = regexp.MustCompile(`(?i)^(current_timestamp|now|localtimestamp|datetime\('now'\))(\(\d*\))?$`)
+	// cast matches the type casts of PostgreSQL literals.
+	cast = regexp.MustCompile(`::[\w ]+$`)
+)
+
+// defaults sets the default values of the field of the given column. Default values
+// that cannot be represented in Go are set as database defaults using annotations.
+func (g *generator) defaults(n *node, f *expr, c *schema.Column) {
+	for _, a := range c.Attrs {
+		if u, ok := a.(*mysql.OnUpdate); ok && now.MatchString(u.A) {
+			if _, ok := c.Type.Type.(*schema.TimeType); ok {
+				n.imports["time"] = true
+				f.Add("UpdateDefault(time.Now)")
+			}
+		}
+	}
+	var v string
+	switch x := c.Default.(type) {
+	case *schema.Literal:
+		v = x.V
+	case *schema.RawExpr:
+		v = x.X
+	default:
+		return
+	}
+	v = strings.TrimSpace(cast.ReplaceAllString(v, ""))
+	switch t := c.Type.Type.(type) {
+	case *schema.TimeType:
+		if now.MatchString(v) {
+			n.imports["time"] = true
+			f.Add("Default(time.Now)")
+			return
+		}
+	case *schema.BoolType:
+		switch strings.ToLower(unquote(v)) {
+		case "true", "1":
+			f.Add("Default(true)")
+			return
+		case "false", "0":
+			f.Add("Default(false)")
+			return
+		}
+	case *schema.IntegerType, *schema.FloatType:
+		if _, err := strconv.ParseFloat(unquote(v), 64); err == nil {
+			f.Add("Default(%s)", unquote(v))
+			return
+		}
+	case *schema.StringType, *schema.EnumType:
+		if s, ok := quoted(v); ok {
+			f.Add("Default(%q)", s)
+			return
+		}
+		// MySQL returns the string literals unquoted.
+		if _, ok := c.Default.(*schema.Literal); ok && g.dialect == dialect.MySQL {
+			f.Add("Default(%q)", v)
+			return
+		}
+		if e, ok := t.(*schema.EnumType); ok {
+			for _, ev := range e.Values {
+				if ev == v {
+					f.Add("Default(%q)", v)
+					return
+				}
+			}
+		}
+	}
+	n.imports["entgo.io/ent/dialect/entsql"] = true
+	f.Add("Annotations(&entsql.Annotation{\nDefault: %q,\n})", v)
+}
+
+// indexes generates the indexes of the node. Unique indexes that
+// are defined on one column are generated as unique fields.
+func (g *generator) indexes(n *node) {
+	for _, idx := range n.table.Indexes {
+		if len(idx.Parts) == 1 && idx.Unique {
+			continue
+		}
+		names := make([]string, 0, len(idx.Parts))
+		for _, p := range idx.Parts {
+			if p.C == nil || n.columns[p.C] == "" {
+				break
+			}
+			names = append(names, strconv.Quote(n.columns[p.C]))
+		}
+		// Indexes on expressions or on the
+		// primary-key column are not supported.
+		if len(names) != len(idx.Parts) {
+			continue
+		}
+		i := chain("index.Fields(%s)", strings.Join(names, ", "))
+		if idx.Unique {
+			i.Add("Unique()")
+		}
+		if idx.Name != "" {
+			i.Add("StorageKey(%q)", idx.Name)
+		}
+		n.imports["entgo.io/ent/schema/index"] = true
+		n.indexes = append(n.indexes, i)
+	}
+}
+
+// fkEdges generates the O2O and O2M edges of the foreign-keys of the node. The edge-fields
+// and the inverse edges are defined on the node, and the assoc edges on the referenced ones.
+func (g *generator) fkEdges(n *node) {
+	for _, c := range n.table.Columns {
+		ref, ok := n.refs[c]
+		if !ok {
+			continue
+		}
+		field := n.columns[c]
+		o2o := unique(n.table, c)
+		candidates := []string{strings.TrimSuffix(field, "_id"), snake(ref.name), field + "_edge"}
+		if candidates[0] == field {
+			candidates = candidates[1:]
+		}
+		inverse := n.pick(candidates...)
+		edgeName := snake(inflect.Pluralize(n.name))
+		switch {
+		case o2o:
+			edgeName = snake(n.name)
+		case n == ref && inverse == "parent":
+			edgeName = "children"
+		}
+		assoc := ref.pick(edgeName, inverse+"_"+edgeName)
+		e := chain("edge.To(%q, %s.Type)", assoc, n.name)
+		if o2o {
+			e.Add("Unique()")
+		}
+		ref.addEdge(e)
+		e = chain("edge.From(%q, %s.Type)", inverse, ref.name).
+			Add("Ref(%q)", assoc).
+			Add("Unique()")
+		if !c.Type.Null {
+			e.Add("Required()")
+		}
+		n.addEdge(e.Add("Field(%q)", field))
+	}
+}
+
+// m2mEdges generates the M2M edges of the given join table.
+func (g *generator) m2mEdges(t *schema.Table) {
+	fk1, fk2 := t.ForeignKeys[0], t.ForeignKeys[1]
+	if fk1.Columns[0] != t.Columns[0] {
+		fk1, fk2 = fk2, fk1
+	}
+	var (
+		c1, c2 = fk1.Columns[0], fk2.Columns[0]
+		n1, n2 = g.nodes[fk1.RefTable], g.nodes[fk2.RefTable]
+		// Edges are named by the types they point to, or
+		// by the join table columns for self-references.
+		to, from = snake(inflect.Pluralize(n2.name)), snake(inflect.Pluralize(n1.name))
+	)
+	if n1 == n2 {
+		to, from = inflect.Pluralize(fieldName(strings.TrimSuffix(c2.Name, "_id"))), inflect.Pluralize(fieldName(strings.TrimSuffix(c1.Name, "_id")))
+	}
+	to = n1.pick(to, fieldName(t.Name))
+	from = n2.pick(from, fieldName(t.Name)+"_inverse")
+	n1.addEdge(chain("edge.To(%q, %s.Type)", to, n2.name).
+		Add("StorageKey(edge.Table(%q), edge.Columns(%q, %q))", t.Name, c1.Name, c2.Name))
+	n2.addEdge(chain("edge.From(%q, %s.Type)", from, n1.name).Add("Ref(%q)", to))
+}
+
+// pick returns the first name of the given candidates that is
+// not used by the fields or edges of the node, and marks it used.
+func (n *node) pick(candidates ...string) string {
+	name := candidates[0]
+	for i := 0; n.used[name]; i++ {
+		if i < len(candidates) {
+			name = candidates[i]
+		} else {
+			name = fmt.Sprintf("%s_%d", candidates[0], i-len(candidates)+2)
+		}
+	}
+	n.used[name] = true
+	return name
+}
+
+// addEdge adds an edge to the node.
+func (n *node) addEdge(e *expr) {
+	n.imports["entgo.io/ent/schema/edge"] = true
+	n.edges = append(n.edges, e)
+}
+
+// format returns the formatted source of the schema file of the node.
+func (n *node) format() ([]byte, error) {
+	if len(n.fields) > 0 {
+		n.imports["entgo.io/ent/schema/field"] = true
+	}
+	table := n.table.Name
+	if snake(inflect.Pluralize(n.name)) == table {
+		table = ""
+	} else {
+		n.imports["entgo.io/ent/schema"] = true
+		n.imports["entgo.io/ent/dialect/entsql"] = true
+	}
+	var std, ext []string
+	for path := range n.imports {
+		if strings.Contains(path, ".") {
+			ext = append(ext, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(ext)
+	b := bytes.NewBuffer(nil)
+	if err := schemaTmpl.Execute(b, struct {
+		Name, Table            string
+		Fields, Edges, Indexes []*expr
+		Std, Ext               []string
+	}{n.name, table, n.fields, n.edges, n.indexes, std, ext}); err != nil {
+		return nil, fmt.Errorf("importer: executing template of %s: %w", n.name, err)
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("importer: formatting schema %s: %w", n.name, err)
+	}
+	return src, nil
+}
+
+// isJoinTable reports if the given table is a join table of an M2M edge. That is, a table that holds
+// only two columns, that are used as foreign-keys, and as the primary-key of the table (if it exists).
+func isJoinTable(t *schema.Table) bool {
+	if len(t.Columns) != 2 || len(t.ForeignKeys) != 2 {
+		return false
+	}
+	for i, fk := range t.ForeignKeys {
+		if len(fk.Columns) != 1 || len(fk.RefColumns) != 1 || fk.Columns[0] != t.Columns[i] && fk.Columns[0] != t.Columns[1-i] {
+			return false
+		}
+	}
+	if t.ForeignKeys[0].Columns[0] == t.ForeignKeys[1].Columns[0] {
+		return false
+	}
+	return t.PrimaryKey == nil || len(t.PrimaryKey.Parts) == 2
+}
+
+// unique reports if the given column has a unique index on it.
+func unique(t *schema.Table, c *schema.Column) bool {
+	for _, idx := range t.Indexes {
+		if idx.Unique && len(idx.Parts) == 1 && idx.Parts[0].C == c {
+			return true
+		}
+	}
+	return false
+}
+
+// nillable reports if the fields of the given type can be nillable.
+func nillable(t schema.Type) bool {
+	switch t.(type) {
+	case *schema.JSONType, *schema.BinaryType, *schema.SpatialType:
+		return false
+	default:
+		return true
+	}
+}
+
+// commentOf returns the comment of the given attributes.
+func commentOf(attrs []schema.Attr) string {
+	for _, a := range attrs {
+		if c, ok := a.(*schema.Comment); ok {
+			return c.Text
+		}
+	}
+	return ""
+}
+
+// dialectConst returns the Go constant of the given dialect.
+func dialectConst(name string) string {
+	switch name {
+	case dialect.MySQL:
+		return "dialect.MySQL"
+	case dialect.Postgres:
+		return "dialect.Postgres"
+	case dialect.SQLite:
+		return "dialect.SQLite"
+	default:
+		return strconv.Quote(name)
+	}
+}
+
+// unquote returns the given value without its quotes, if it is quoted.
+func unquote(v string) string {
+	if s, ok := quoted(v); ok {
+		return s
+	}
+	return v
+}
+
+// quoted returns the value of a quoted SQL string literal.
+func quoted(v string) (string, bool) {
+	if len(v) < 2 || v[0] != '\'' || v[len(v)-1] != '\'' {
+		return "", false
+	}
+	return strings.ReplaceAll(v[1:len(v)-1], "''", "'"), true
+}
+
+// fieldName returns the field name of the given column. Columns
+// that are not in snake_case are converted, and keep their name
+// as a storage key.
+func fieldName(column string) string {
+	name := snake(strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, column))
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "f_" + name
+	}
+	return name
+}
+
+// snake converts the given name to snake_case.
+func snake(s string) string {
+	return inflect.Underscore(s)
+}
+
+// schemaTmpl is the template of the generated schema files.
+var schemaTmpl = template.Must(template.New("schema").Parse(`package schema
+
+import (
+	{{- range $.Std }}
+	"{{ . }}"
+	{{- end }}
+	{{ range $.Ext }}
+	"{{ . }}"
+	{{- end }}
+)
+
+// {{ $.Name }} holds the schema definition for the {{ $.Name }} entity.
+type {{ $.Name }} struct {
+	ent.Schema
+}
+{{- with $.Table }}
+
+// Annotations of the {{ $.Name }}.
+func ({{ $.Name }}) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: {{ printf "%q" . }}},
+	}
+}
+{{- end }}
+
+// Fields of the {{ $.Name }}.
+func ({{ $.Name }}) Fields() []ent.Field {
+	{{- with $.Fields }}
+	return []ent.Field{
+		{{- range . }}
+		{{ . }},
+		{{- end }}
+	}
+	{{- else }}
+	return nil
+	{{- end }}
+}
+
+// Edges of the {{ $.Name }}.
+func ({{ $.Name }}) Edges() []ent.Edge {
+	{{- with $.Edges }}
+	return []ent.Edge{
+		{{- range . }}
+		{{ . }},
+		{{- end }}
+	}
+	{{- else }}
+	return nil
+	{{- end }}
+}
+{{- with $.Indexes }}
+
+// Indexes of the {{ $.Name }}.
+func ({{ $.Name }}) Indexes() []ent.Index {
+	return []ent.Index{
+		{{- range . }}
+		{{ . }},
+		{{- end }}
+	}
+}
+{{- end }}
+`))
@@ -0,0 +1,160 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package importer generates ent schemas from the schema of an existing database. The tables of
+// the database are inspected, and converted to ent schemas with their fields, indexes and edges.
+// O2O and O2M edges are inferred from foreign-keys, and M2M edges from join tables (tables that
+// hold only two foreign-keys). For example:
+//
+//	drv, err := sql.Open(dialect.MySQL, "root:pass@tcp(localhost:3306)/test?parseTime=True")
+//	if err != nil {
+//		return err
+//	}
+//	res, err := importer.Import(ctx, drv, "./ent/schema")
+//	if err != nil {
+//		return err
+//	}
+//	for table, reason := range res.Skipped {
+//		log.Printf("table %q was not imported: %s", table, reason)
+//	}
+//
+package importer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	"ariga.io/atlas/sql/sqlite"
+)
+
+type (
+	// Option allows configuring the import using functional options.
+	Option func(*config)
+
+	// config holds the configuration of an import.
+	config struct {
+		tables  []string
+		exclude map[string]bool
+	}
+)
+
+// Tables limits the import to the given tables. Foreign-keys that
+// reference tables that were not imported are imported as fields.
+func Tables(names ...string) Option {
+	return func(c *config) {
+		c.tables = append(c.tables, names...)
+	}
+}
+
+// ExcludeTables excludes the given tables from the import.
+func ExcludeTables(names ...string) Option {
+	return func(c *config) {
+		for _, name := range names {
+			c.exclude[name] = true
+		}
+	}
+}
+
+// newConfig returns the configuration of the given options.
+func newConfig(opts []Option) *config {
+	c := &config{exclude: make(map[string]bool)}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// included reports if the given table should be imported.
+func (c *config) included(name string) bool {
+	if c.exclude[name] {
+		return false
+	}
+	if len(c.tables) == 0 {
+		return true
+	}
+	for _, t := range c.tables {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Result holds the result of an import.
+type Result struct {
+	// Files holds the formatted source of the generated
+	// schema files, keyed by their file names.
+	Files map[string][]byte
+	// Skipped holds the tables that were not imported,
+	// and the reasons for skipping them.
+	Skipped map[string]string
+}
+
+// Inspect inspects the schema of the database that is connected to the given driver.
+// The supported dialects are MySQL (and MariaDB), PostgreSQL and SQLite.
+func Inspect(ctx context.Context, drv *entsql.Driver, opts ...Option) (*schema.Schema, error) {
+	var open func(schema.ExecQuerier) (migrate.Driver, error)
+	switch d := drv.Dialect(); d {
+	case dialect.MySQL:
+		open = mysql.Open
+	case dialect.Postgres:
+		open = postgres.Open
+	case dialect.SQLite:
+		open = sqlite.Open
+	default:
+		return nil, fmt.Errorf("importer: unsupported dialect %q", d)
+	}
+	at, err := open(drv.DB())
+	if err != nil {
+		return nil, fmt.Errorf("importer: opening atlas driver: %w", err)
+	}
+	s, err := at.InspectSchema(ctx, "", &schema.InspectOptions{Tables: newConfig(opts).tables})
+	if err != nil {
+		return nil, fmt.Errorf("importer: inspecting schema: %w", err)
+	}
+	return s, nil
+}
+
+// Import inspects the database that is connected to the given driver, and writes
+// the generated schemas to the target directory. Existing files are not overridden,
+// and an error is returned if one of the generated files already exists.
+func Import(ctx context.Context, drv *entsql.Driver, target string, opts ...Option) (*Result, error) {
+	s, err := Inspect(ctx, drv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res, err := Generate(drv.Dialect(), s, opts...)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(res.Files))
+	for name := range res.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(target, name)); err == nil {
+			return nil, fmt.Errorf("importer: schema file %q already exists", name)
+		}
+	}
+	if err := os.MkdirAll(target, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("importer: creating schema directory: %w", err)
+	}
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(target, name), res.Files[name], 0644); err != nil {
+			return nil, fmt.Errorf("importer: writing schema file: %w", err)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package importer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/schema"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+const tables = `
+CREATE TABLE users (oid integer PRIMARY KEY AUTOINCREMENT, name varchar(100) NOT NULL DEFAULT 'a8m', age integer, active bool NOT NULL DEFAULT true, created_at datetime DEFAULT CURRENT_TIMESTAMP, parent_id integer REFERENCES users(oid));
+CREATE TABLE pets (id varchar(36) PRIMARY KEY, nickname text, owner_id integer NOT NULL REFERENCES users(oid));
+CREATE UNIQUE INDEX pets_nickname ON pets(nickname);
+CREATE INDEX pets_owner_nickname ON pets(owner_id, nickname);
+CREATE TABLE groups (id integer PRIMARY KEY, name text NOT NULL);
+CREATE TABLE group_users (group_id integer REFERENCES groups(id), user_id integer REFERENCES users(oid), PRIMARY KEY(group_id, user_id));
+CREATE TABLE user_friends (user_id integer REFERENCES users(oid), friend_id integer REFERENCES users(oid), PRIMARY KEY(user_id, friend_id));
+CREATE TABLE logs (a integer, b integer, PRIMARY KEY(a, b));
+`
+
+func TestImport(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:importer?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	_, err = drv.DB().ExecContext(ctx, tables)
+	require.NoError(t, err)
+
+	target := filepath.Join(t.TempDir(), "schema")
+	res, err := Import(ctx, drv, target)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"logs": "table has a composite primary-key"}, res.Skipped)
+	files, err := os.ReadDir(target)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	for i, name := range []string{"group.go", "pet.go", "user.go"} {
+		require.Equal(t, name, files[i].Name())
+	}
+	pet, err := os.ReadFile(filepath.Join(target, "pet.go"))
+	require.NoError(t, err)
+	require.Equal(t, `package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Pet holds the schema definition for the Pet entity.
+type Pet struct {
+	ent.Schema
+}
+
+// Fields of the Pet.
+func (Pet) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36),
+		field.Text("nickname").
+			Optional().
+			Nillable().
+			Unique(),
+		field.Int("owner_id"),
+	}
+}
+
+// Edges of the Pet.
+func (Pet) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("pets").
+			Unique().
+			Required().
+			Field("owner_id"),
+	}
+}
+
+// Indexes of the Pet.
+func (Pet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "nickname").
+			StorageKey("pets_owner_nickname"),
+	}
+}
+`, string(pet))
+
+	user, err := os.ReadFile(filepath.Join(target, "user.go"))
+	require.NoError(t, err)
+	for _, s := range []string{
+		"field.Int(\"id\").\n\t\t\tStorageKey(\"oid\")",
+		"field.String(\"name\").\n\t\t\tMaxLen(100).\n\t\t\tDefault(\"a8m\")",
+		"field.Bool(\"active\").\n\t\t\tDefault(true)",
+		"field.Time(\"created_at\").\n\t\t\tOptional().\n\t\t\tNillable().\n\t\t\tDefault(time.Now)",
+		"edge.To(\"pets\", Pet.Type)",
+		"edge.To(\"children\", User.Type)",
+		"edge.From(\"parent\", User.Type).\n\t\t\tRef(\"children\").\n\t\t\tUnique().\n\t\t\tField(\"parent_id\")",
+		"edge.From(\"groups\", Group.Type).\n\t\t\tRef(\"users\")",
+		"edge.To(\"friends\", User.Type).\n\t\t\tStorageKey(edge.Table(\"user_friends\"), edge.Columns(\"user_id\", \"friend_id\"))",
+		"edge.From(\"users\", User.Type).\n\t\t\tRef(\"friends\")",
+	} {
+		require.Contains(t, string(user), s)
+	}
+	group, err := os.ReadFile(filepath.Join(target, "group.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(group), "edge.To(\"users\", User.Type).\n\t\t\tStorageKey(edge.Table(\"group_users\"), edge.Columns(\"group_id\", \"user_id\"))")
+
+	_, err = Import(ctx, drv, target)
+	require.EqualError(t, err, `importer: schema file "group.go" already exists`)
+
+	// Foreign-keys to tables that were not imported are imported as fields.
+	s, err := Inspect(ctx, drv, Tables("pets", "groups", "group_users"))
+	require.NoError(t, err)
+	res, err = Generate(dialect.SQLite, s)
+	require.NoError(t, err)
+	require.Len(t, res.Files, 2)
+	require.Equal(t, map[string]string{"group_users": "join table references tables that were not imported"}, res.Skipped)
+	require.Contains(t, string(res.Files["pet.go"]), "field.Int(\"owner_id\"),")
+	require.NotContains(t, string(res.Files["pet.go"]), "edge.")
+
+	res, err = Generate(dialect.SQLite, s, ExcludeTables("groups", "group_users"))
+	require.NoError(t, err)
+	require.Len(t, res.Files, 1)
+	require.Contains(t, res.Files, "pet.go")
+}
+
+func TestGenerate(t *testing.T) {
+	var (
+		id    = &schema.Column{Name: "id", Type: &schema.ColumnType{Type: &schema.IntegerType{T: "bigint"}, Raw: "bigint"}}
+		table = &schema.Table{
+			Name: "user_info",
+			Columns: []*schema.Column{
+				id,
+				{Name: "age", Type: &schema.ColumnType{Type: &schema.IntegerType{T: "int", Unsigned: true}, Raw: "int unsigned"}, Default: &schema.Literal{V: "1"}},
+				{Name: "Level", Type: &schema.ColumnType{Type: &schema.IntegerType{T: "tinyint"}, Raw: "tinyint"}},
+				{Name: "balance", Type: &schema.ColumnType{Type: &schema.DecimalType{T: "decimal", Precision: 10, Scale: 2}, Raw: "decimal(10,2)"}},
+				{Name: "status", Type: &schema.ColumnType{Type: &schema.EnumType{T: "enum", Values: []string{"on", "off"}}, Raw: "enum('on','off')"}, Default: &schema.Literal{V: "on"}},
+				{Name: "props", Type: &schema.ColumnType{Type: &schema.JSONType{T: "json"}, Raw: "json", Null: true}},
+				{Name: "ip", Type: &schema.ColumnType{Type: &schema.StringType{T: "varchar", Size: 45}, Raw: "varchar(45)"}, Default: &schema.RawExpr{X: "inet6_ntoa(0)"}, Attrs: []schema.Attr{&schema.Comment{Text: "last login address"}}},
+				{Name: "updated_at", Type: &schema.ColumnType{Type: &schema.TimeType{T: "timestamp"}, Raw: "timestamp"}, Default: &schema.RawExpr{X: "CURRENT_TIMESTAMP"}, Attrs: []schema.Attr{&mysql.OnUpdate{A: "CURRENT_TIMESTAMP"}}},
+			},
+		}
+	)
+	table.PrimaryKey = &schema.Index{Unique: true, Table: table, Parts: []*schema.IndexPart{{C: id}}}
+	res, err := Generate(dialect.MySQL, &schema.Schema{Tables: []*schema.Table{table}})
+	require.NoError(t, err)
+	require.Empty(t, res.Skipped)
+	require.Equal(t, `package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// UserInfo holds the schema definition for the UserInfo entity.
+type UserInfo struct {
+	ent.Schema
+}
+
+// Annotations of the UserInfo.
+func (UserInfo) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "user_info"},
+	}
+}
+
+// Fields of the UserInfo.
+func (UserInfo) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("age").
+			Default(1),
+		field.Int8("level").
+			StorageKey("Level"),
+		field.Float("balance").
+			SchemaType(map[string]string{
+				dialect.MySQL: "decimal(10,2)",
+			}),
+		field.Enum("status").
+			Values("on", "off").
+			Default("on"),
+		field.JSON("props", json.RawMessage{}).
+			Optional(),
+		field.String("ip").
+			MaxLen(45).
+			Annotations(&entsql.Annotation{
+				Default: "inet6_ntoa(0)",
+			}).
+			Comment("last login address"),
+		field.Time("updated_at").
+			UpdateDefault(time.Now).
+			Default(time.Now),
+	}
+}
+
+// Edges of the UserInfo.
+func (UserInfo) Edges() []ent.Edge {
+	return nil
+}
+`, string(res.Files["userinfo.go"]))
+}
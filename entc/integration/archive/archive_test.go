@@ -0,0 +1,108 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package archive
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/archive/ent"
+	"entgo.io/ent/entc/integration/archive/ent/item"
+	"entgo.io/ent/entc/integration/archive/ent/order"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	old := time.Now().AddDate(-2, 0, 0)
+	a8m := client.Customer.Create().SetName("a8m").SaveX(ctx)
+	o1 := client.Order.Create().SetNumber("1").SetTotal(10).SetPlacedAt(old).SetCustomer(a8m).SaveX(ctx)
+	o2 := client.Order.Create().SetNumber("2").SetTotal(20).SetPlacedAt(old).SetCustomer(a8m).SaveX(ctx)
+	o3 := client.Order.Create().SetNumber("3").SetTotal(30).SetCustomer(a8m).SaveX(ctx)
+	client.Item.Create().SetSku("a").SetOrder(o1).ExecX(ctx)
+	client.Item.Create().SetSku("b").SetOrder(o1).ExecX(ctx)
+	client.Item.Create().SetSku("c").SetOrder(o2).ExecX(ctx)
+	client.Item.Create().SetSku("d").SetOrder(o3).ExecX(ctx)
+
+	t.Log("Unknown edges are rejected")
+	_, err = client.Order.ArchiveWhere(ctx, order.PlacedAtLT(time.Now().AddDate(-1, 0, 0)), order.EdgeCustomer)
+	require.EqualError(t, err, `ent: edge "customer" of Order cannot be archived`)
+	_, err = client.Item.ArchiveWhere(ctx, item.SkuEQ("a"), item.EdgeOrder)
+	require.Error(t, err)
+	require.Equal(t, 3, client.Order.Query().CountX(ctx))
+
+	t.Log("Matched entities are moved to the archive table with their owned edges")
+	n, err := client.Order.ArchiveWhere(ctx, order.PlacedAtLT(time.Now().AddDate(-1, 0, 0)), order.EdgeItems)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, []string{"3"}, client.Order.Query().Select(order.FieldNumber).StringsX(ctx))
+	require.Equal(t, []string{"d"}, client.Item.Query().Select(item.FieldSku).StringsX(ctx))
+	require.Equal(t, 1, a8m.QueryOrders().CountX(ctx))
+
+	t.Log("Archived entities are read using OnlyArchived or IncludeArchived")
+	archived := client.Order.Query().OnlyArchived().Order(ent.Asc(order.FieldNumber)).AllX(ctx)
+	require.Len(t, archived, 2)
+	require.Equal(t, o1.ID, archived[0].ID)
+	require.Equal(t, o1.Total, archived[0].Total)
+	require.Equal(t, o2.ID, archived[1].ID)
+	require.Equal(t, 3, client.Order.Query().IncludeArchived().CountX(ctx))
+	require.Equal(t, []string{"1", "2", "3"}, client.Order.Query().IncludeArchived().Order(ent.Asc(order.FieldNumber)).Select(order.FieldNumber).StringsX(ctx))
+	require.Equal(t, []float64{20, 30}, client.Order.Query().IncludeArchived().Where(order.TotalGT(15)).Order(ent.Asc(order.FieldTotal)).Select(order.FieldTotal).Float64sX(ctx))
+	require.Equal(t, 3, client.Item.Query().OnlyArchived().Where(item.HasOrder()).CountX(ctx), "foreign-keys are archived with the rows")
+	require.Equal(t, []string{"a", "b", "c"}, client.Item.Query().OnlyArchived().Order(ent.Asc(item.FieldSku)).Select(item.FieldSku).StringsX(ctx))
+
+	t.Log("Archiving in a transaction is rolled back with it")
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	n, err = tx.Order.ArchiveWhere(ctx, order.NumberEQ("3"), order.EdgeItems)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Zero(t, tx.Order.Query().CountX(ctx))
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 1, client.Order.Query().CountX(ctx))
+	require.Equal(t, 2, client.Order.Query().OnlyArchived().CountX(ctx))
+
+	t.Log("Predicates that do not match any entity do not archive anything")
+	n, err = client.Order.ArchiveWhere(ctx, order.NumberEQ("4"))
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestArchiveBatches(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:batches?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	old := time.Now().AddDate(-2, 0, 0)
+	a8m := client.Customer.Create().SetName("a8m").SaveX(ctx)
+	for i := 0; i < 5; i++ {
+		o := client.Order.Create().SetNumber(fmt.Sprint(i)).SetTotal(10).SetPlacedAt(old).SetCustomer(a8m).SaveX(ctx)
+		client.Item.Create().SetSku(fmt.Sprint(i)).SetOrder(o).ExecX(ctx)
+	}
+
+	limit := sqlgraph.MaxParams[dialect.SQLite]
+	defer func() { sqlgraph.MaxParams[dialect.SQLite] = limit }()
+	sqlgraph.MaxParams[dialect.SQLite] = 2
+	n, err := client.Order.ArchiveWhere(ctx, order.PlacedAtLT(time.Now()), order.EdgeItems)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+	require.Zero(t, client.Order.Query().CountX(ctx))
+	require.Zero(t, client.Item.Query().CountX(ctx))
+	require.Equal(t, 5, client.Order.Query().OnlyArchived().CountX(ctx))
+	require.Equal(t, 5, client.Item.Query().OnlyArchived().CountX(ctx))
+}
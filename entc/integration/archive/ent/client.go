@@ -0,0 +1,754 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"entgo.io/ent/entc/integration/archive/ent/migrate"
+	"entgo.io/ent/entc/integration/archive/ent/predicate"
+
+	"entgo.io/ent/entc/integration/archive/ent/customer"
+	"entgo.io/ent/entc/integration/archive/ent/item"
+	"entgo.io/ent/entc/integration/archive/ent/order"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
+	// Item is the client for interacting with the Item builders.
+	Item *ItemClient
+	// Order is the client for interacting with the Order builders.
+	Order *OrderClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	client.AddExtension(ent.RegisteredExtensions()...)
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.Customer = NewCustomerClient(c.config)
+	c.Item = NewItemClient(c.config)
+	c.Order = NewOrderClient(c.config)
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:      ctx,
+		config:   cfg,
+		Customer: NewCustomerClient(cfg),
+		Item:     NewItemClient(cfg),
+		Order:    NewOrderClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:      ctx,
+		config:   cfg,
+		Customer: NewCustomerClient(cfg),
+		Item:     NewItemClient(cfg),
+		Order:    NewOrderClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		Customer.
+//		Query().
+//		Count(ctx)
+//
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	if c.readDriver != nil {
+		cfg.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+// The read driver of the client is closed as well, if it was configured.
+func (c *Client) Close() error {
+	err := c.driver.Close()
+	if _, ok := c.driver.(*txDriver); !ok && c.readDriver != nil {
+		if rerr := c.readDriver.Close(); err == nil {
+			err = rerr
+		}
+	}
+	return err
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.Customer.Use(hooks...)
+	c.Item.Use(hooks...)
+	c.Order.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Customer.Intercept(interceptors...)
+	c.Item.Intercept(interceptors...)
+	c.Order.Intercept(interceptors...)
+}
+
+// AddExtension adds the given extensions to the client. Their hooks and interceptors are added to all the
+// entity clients, and their drivers wrap the drivers of the client. Extensions that decorate the driver
+// should be added before the client is used, as entity clients that were obtained before the call (e.g.
+// `users := client.User`) keep using the previous driver. For example:
+//
+//	client.AddExtension(cache.NewExtension(cache.WithTTL(time.Minute)))
+//
+func (c *Client) AddExtension(exts ...ent.Extension) {
+	if len(exts) == 0 {
+		return
+	}
+	for _, ext := range exts {
+		if drv := ext.Driver(c.driver); drv != nil {
+			c.driver = drv
+		}
+		if c.readDriver != nil {
+			if drv := ext.Driver(c.readDriver); drv != nil {
+				c.readDriver = drv
+			}
+		}
+	}
+	c.extensions = append(c.extensions[:len(c.extensions):len(c.extensions)], exts...)
+	c.init()
+	for _, ext := range exts {
+		c.Use(ext.Hooks()...)
+		c.Intercept(ext.Interceptors()...)
+	}
+}
+
+// HealthCheck runs the health checks of the extensions that were added to the client,
+// and returns the first error that was reported.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	for _, ext := range c.extensions {
+		if err := ext.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("ent: extension %q: %w", ext.Name(), err)
+		}
+	}
+	return nil
+}
+
+// WithOptions returns a new client that is derived from c and configured with the given options.
+// Hooks that are registered on the new client using Use are not added to c (and vice versa). For
+// example, creating a client for trusted background jobs:
+//
+//	client.WithOptions(ent.ReplaceHooks(AuditHook()), ent.SkipPrivacy()).
+//		Customer.
+//		Delete().
+//		Exec(ctx)
+//
+func (c *Client) WithOptions(opts ...Option) *Client {
+	cfg := c.config
+	cfg.hooks = &hooks{
+		Customer: c.hooks.Customer[:len(c.hooks.Customer):len(c.hooks.Customer)],
+		Item:     c.hooks.Item[:len(c.hooks.Item):len(c.hooks.Item)],
+		Order:    c.hooks.Order[:len(c.hooks.Order):len(c.hooks.Order)],
+	}
+	cfg.inters = &inters{
+		Customer: c.inters.Customer[:len(c.inters.Customer):len(c.inters.Customer)],
+		Item:     c.inters.Item[:len(c.inters.Item):len(c.inters.Item)],
+		Order:    c.inters.Order[:len(c.inters.Order):len(c.inters.Order)],
+	}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// archiveRows copies the rows of the given table that match the predicate to its archive table,
+// deletes them from the table, and returns their number. The predicate function is called for
+// each of the statements.
+func archiveRows(ctx context.Context, drv dialect.Driver, table, archive string, columns []string, pred func() *sql.Predicate) (int, error) {
+	builder := sql.Dialect(drv.Dialect())
+	query, args := builder.Insert(archive).
+		Columns(columns...).
+		Select(builder.Select(columns...).From(builder.Table(table)).Where(pred())).
+		Query()
+	if err := drv.Exec(ctx, query, args, nil); err != nil {
+		return 0, err
+	}
+	var res sql.Result
+	query, args = builder.Delete(table).Where(pred()).Query()
+	if err := drv.Exec(ctx, query, args, &res); err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+// CustomerClient is a client for the Customer schema.
+type CustomerClient struct {
+	config
+}
+
+// NewCustomerClient returns a client for the Customer from the given config.
+func NewCustomerClient(c config) *CustomerClient {
+	return &CustomerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `customer.Hooks(f(g(h())))`.
+func (c *CustomerClient) Use(hooks ...Hook) {
+	c.hooks.Customer = append(c.hooks.Customer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `customer.Intercept(f(g(h())))`.
+func (c *CustomerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Customer = append(c.inters.Customer, interceptors...)
+}
+
+// Create returns a builder for creating a Customer entity.
+func (c *CustomerClient) Create() *CustomerCreate {
+	mutation := newCustomerMutation(c.config, OpCreate)
+	return &CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Customer entities.
+func (c *CustomerClient) CreateBulk(builders ...*CustomerCreate) *CustomerCreateBulk {
+	return &CustomerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Customer.
+func (c *CustomerClient) Update() *CustomerUpdate {
+	mutation := newCustomerMutation(c.config, OpUpdate)
+	return &CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CustomerClient) UpdateOne(cu *Customer) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomer(cu))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CustomerClient) UpdateOneID(id int) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomerID(id))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Customer.
+func (c *CustomerClient) Delete() *CustomerDelete {
+	mutation := newCustomerMutation(c.config, OpDelete)
+	return &CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CustomerClient) DeleteOne(cu *Customer) *CustomerDeleteOne {
+	return c.DeleteOneID(cu.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *CustomerClient) DeleteOneID(id int) *CustomerDeleteOne {
+	builder := c.Delete().Where(customer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CustomerDeleteOne{builder}
+}
+
+// Query returns a query builder for Customer.
+func (c *CustomerClient) Query() *CustomerQuery {
+	return &CustomerQuery{
+		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Customer entity by its id.
+func (c *CustomerClient) Get(ctx context.Context, id int) (*Customer, error) {
+	node, err := c.Query().Where(customer.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CustomerClient) GetX(ctx context.Context, id int) *Customer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrders queries the orders edge of a Customer.
+func (c *CustomerClient) QueryOrders(cu *Customer) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cu.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customer.Table, customer.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, customer.OrdersTable, customer.OrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(cu.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Interceptors returns the client interceptors.
+func (c *CustomerClient) Interceptors() []Interceptor {
+	return c.inters.Customer
+}
+
+// Hooks returns the client hooks.
+func (c *CustomerClient) Hooks() []Hook {
+	return c.hooks.Customer
+}
+
+// ItemClient is a client for the Item schema.
+type ItemClient struct {
+	config
+}
+
+// NewItemClient returns a client for the Item from the given config.
+func NewItemClient(c config) *ItemClient {
+	return &ItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `item.Hooks(f(g(h())))`.
+func (c *ItemClient) Use(hooks ...Hook) {
+	c.hooks.Item = append(c.hooks.Item, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
+func (c *ItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Item = append(c.inters.Item, interceptors...)
+}
+
+// Create returns a builder for creating a Item entity.
+func (c *ItemClient) Create() *ItemCreate {
+	mutation := newItemMutation(c.config, OpCreate)
+	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Item entities.
+func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
+	return &ItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Item.
+func (c *ItemClient) Update() *ItemUpdate {
+	mutation := newItemMutation(c.config, OpUpdate)
+	return &ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemClient) UpdateOne(i *Item) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItem(i))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemClient) UpdateOneID(id int) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItemID(id))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Item.
+func (c *ItemClient) Delete() *ItemDelete {
+	mutation := newItemMutation(c.config, OpDelete)
+	return &ItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemClient) DeleteOne(i *Item) *ItemDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ItemClient) DeleteOneID(id int) *ItemDeleteOne {
+	builder := c.Delete().Where(item.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemDeleteOne{builder}
+}
+
+// Query returns a query builder for Item.
+func (c *ItemClient) Query() *ItemQuery {
+	return &ItemQuery{
+		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Item entity by its id.
+func (c *ItemClient) Get(ctx context.Context, id int) (*Item, error) {
+	node, err := c.Query().Where(item.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemClient) GetX(ctx context.Context, id int) *Item {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a Item.
+func (c *ItemClient) QueryOrder(i *Item) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, item.OrderTable, item.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemClient) Interceptors() []Interceptor {
+	return c.inters.Item
+}
+
+// Hooks returns the client hooks.
+func (c *ItemClient) Hooks() []Hook {
+	return c.hooks.Item
+}
+
+// ArchiveWhere moves the Item entities that match the given predicate from the "items" table
+// to the "items_archive" table, and returns their number. The entities of the given edges
+// are moved with them to their archive tables. Archived entities can be read using the IncludeArchived and
+// OnlyArchived options of the query builder.
+//
+// The rows are moved using INSERT ... SELECT and DELETE statements in one transaction (unless the client
+// is transactional), and therefore, the hooks and privacy policies of the mutations are not applied. Note
+// that foreign-keys that reference the moved rows are handled by their ON DELETE actions (e.g. the rows of
+// the join tables are deleted), and are not restored if the rows are moved back.
+func (c *ItemClient) ArchiveWhere(ctx context.Context, pred predicate.Item, edges ...string) (int, error) {
+	if len(edges) > 0 {
+		return 0, fmt.Errorf("ent: edge %q of Item cannot be archived", edges[0])
+	}
+	var n int
+	err := withConfigTx(ctx, c.config, func(cfg config) error {
+		ids, err := NewItemClient(cfg).Query().Where(pred).IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		values := make([]interface{}, len(ids))
+		for i := range ids {
+			values[i] = ids[i]
+		}
+		// Each statement holds one argument per row. Therefore, the rows are moved in
+		// batches to avoid exceeding the maximum number of arguments of the dialect.
+		size := sqlgraph.MaxParams[cfg.driver.Dialect()]
+		if size <= 0 {
+			size = len(values)
+		}
+		for start := 0; start < len(values); start += size {
+			end := start + size
+			if end > len(values) {
+				end = len(values)
+			}
+			batch := values[start:end]
+			moved, err := archiveRows(ctx, cfg.driver, item.Table, item.ArchiveTable, append(item.Columns[:len(item.Columns):len(item.Columns)], item.ForeignKeys...), func() *sql.Predicate {
+				return sql.In(item.FieldID, batch...)
+			})
+			if err != nil {
+				return err
+			}
+			n += moved
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// OrderClient is a client for the Order schema.
+type OrderClient struct {
+	config
+}
+
+// NewOrderClient returns a client for the Order from the given config.
+func NewOrderClient(c config) *OrderClient {
+	return &OrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `order.Hooks(f(g(h())))`.
+func (c *OrderClient) Use(hooks ...Hook) {
+	c.hooks.Order = append(c.hooks.Order, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `order.Intercept(f(g(h())))`.
+func (c *OrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Order = append(c.inters.Order, interceptors...)
+}
+
+// Create returns a builder for creating a Order entity.
+func (c *OrderClient) Create() *OrderCreate {
+	mutation := newOrderMutation(c.config, OpCreate)
+	return &OrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Order entities.
+func (c *OrderClient) CreateBulk(builders ...*OrderCreate) *OrderCreateBulk {
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Order.
+func (c *OrderClient) Update() *OrderUpdate {
+	mutation := newOrderMutation(c.config, OpUpdate)
+	return &OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderClient) UpdateOne(o *Order) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrder(o))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderClient) UpdateOneID(id int) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrderID(id))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Order.
+func (c *OrderClient) Delete() *OrderDelete {
+	mutation := newOrderMutation(c.config, OpDelete)
+	return &OrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderClient) DeleteOne(o *Order) *OrderDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *OrderClient) DeleteOneID(id int) *OrderDeleteOne {
+	builder := c.Delete().Where(order.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderDeleteOne{builder}
+}
+
+// Query returns a query builder for Order.
+func (c *OrderClient) Query() *OrderQuery {
+	return &OrderQuery{
+		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Order entity by its id.
+func (c *OrderClient) Get(ctx context.Context, id int) (*Order, error) {
+	node, err := c.Query().Where(order.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderClient) GetX(ctx context.Context, id int) *Order {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCustomer queries the customer edge of a Order.
+func (c *OrderClient) QueryCustomer(o *Order) *CustomerQuery {
+	query := (&CustomerClient{config: c.config}).Query()
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.CustomerTable, order.CustomerColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a Order.
+func (c *OrderClient) QueryItems(o *Order) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.ItemsTable, order.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderClient) Interceptors() []Interceptor {
+	return c.inters.Order
+}
+
+// Hooks returns the client hooks.
+func (c *OrderClient) Hooks() []Hook {
+	return c.hooks.Order
+}
+
+// ArchiveWhere moves the Order entities that match the given predicate from the "orders" table
+// to the "orders_archive" table, and returns their number. The entities of the given edges (e.g. order.EdgeItems)
+// are moved with them to their archive tables. Archived entities can be read using the IncludeArchived and
+// OnlyArchived options of the query builder.
+//
+// The rows are moved using INSERT ... SELECT and DELETE statements in one transaction (unless the client
+// is transactional), and therefore, the hooks and privacy policies of the mutations are not applied. Note
+// that foreign-keys that reference the moved rows are handled by their ON DELETE actions (e.g. the rows of
+// the join tables are deleted), and are not restored if the rows are moved back.
+func (c *OrderClient) ArchiveWhere(ctx context.Context, pred predicate.Order, edges ...string) (int, error) {
+	for _, e := range edges {
+		switch e {
+		case order.EdgeItems:
+		default:
+			return 0, fmt.Errorf("ent: edge %q of Order cannot be archived", e)
+		}
+	}
+	var n int
+	err := withConfigTx(ctx, c.config, func(cfg config) error {
+		ids, err := NewOrderClient(cfg).Query().Where(pred).IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		values := make([]interface{}, len(ids))
+		for i := range ids {
+			values[i] = ids[i]
+		}
+		// Each statement holds one argument per row. Therefore, the rows are moved in
+		// batches to avoid exceeding the maximum number of arguments of the dialect.
+		size := sqlgraph.MaxParams[cfg.driver.Dialect()]
+		if size <= 0 {
+			size = len(values)
+		}
+		for start := 0; start < len(values); start += size {
+			end := start + size
+			if end > len(values) {
+				end = len(values)
+			}
+			batch := values[start:end]
+			for _, e := range edges {
+				switch e {
+				case order.EdgeItems:
+					_, err = archiveRows(ctx, cfg.driver, item.Table, item.ArchiveTable, append(item.Columns[:len(item.Columns):len(item.Columns)], item.ForeignKeys...), func() *sql.Predicate {
+						return sql.In(order.ItemsColumn, batch...)
+					})
+				}
+				if err != nil {
+					return err
+				}
+			}
+			moved, err := archiveRows(ctx, cfg.driver, order.Table, order.ArchiveTable, append(order.Columns[:len(order.Columns):len(order.Columns)], order.ForeignKeys...), func() *sql.Predicate {
+				return sql.In(order.FieldID, batch...)
+			})
+			if err != nil {
+				return err
+			}
+			n += moved
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
@@ -0,0 +1,728 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/archive/ent/customer"
+	"entgo.io/ent/entc/integration/archive/ent/order"
+	"entgo.io/ent/entc/integration/archive/ent/predicate"
+	"entgo.io/ent/schema/field"
+)
+
+// CustomerQuery is the builder for querying Customer entities.
+type CustomerQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	inters     []Interceptor
+	predicates []predicate.Customer
+	withOrders *OrderQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CustomerQuery builder.
+func (cq *CustomerQuery) Where(ps ...predicate.Customer) *CustomerQuery {
+	cq.predicates = append(cq.predicates, ps...)
+	return cq
+}
+
+// Limit adds a limit step to the query.
+func (cq *CustomerQuery) Limit(limit int) *CustomerQuery {
+	cq.limit = &limit
+	return cq
+}
+
+// Offset adds an offset step to the query.
+func (cq *CustomerQuery) Offset(offset int) *CustomerQuery {
+	cq.offset = &offset
+	return cq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cq *CustomerQuery) Unique(unique bool) *CustomerQuery {
+	cq.unique = &unique
+	return cq
+}
+
+// Order adds an order step to the query.
+func (cq *CustomerQuery) Order(o ...OrderFunc) *CustomerQuery {
+	cq.order = append(cq.order, o...)
+	return cq
+}
+
+// QueryOrders chains the current query on the "orders" edge.
+func (cq *CustomerQuery) QueryOrders() *OrderQuery {
+	query := (&OrderClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customer.Table, customer.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, customer.OrdersTable, customer.OrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Customer entity from the query.
+// Returns a *NotFoundError when no Customer was found.
+func (cq *CustomerQuery) First(ctx context.Context) (*Customer, error) {
+	nodes, err := cq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, cq.sqlNotFound()
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cq *CustomerQuery) FirstX(ctx context.Context) *Customer {
+	node, err := cq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Customer ID from the query.
+// Returns a *NotFoundError when no Customer ID was found.
+func (cq *CustomerQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = cq.sqlNotFound()
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cq *CustomerQuery) FirstIDX(ctx context.Context) int {
+	id, err := cq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Customer entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Customer entity is found.
+// Returns a *NotFoundError when no Customer entities are found.
+func (cq *CustomerQuery) Only(ctx context.Context) (*Customer, error) {
+	nodes, err := cq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, cq.sqlNotFound()
+	default:
+		return nil, &NotSingularError{customer.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cq *CustomerQuery) OnlyX(ctx context.Context) *Customer {
+	node, err := cq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Customer ID in the query.
+// Returns a *NotSingularError when more than one Customer ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cq *CustomerQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = cq.sqlNotFound()
+	default:
+		err = &NotSingularError{customer.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cq *CustomerQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Customers.
+func (cq *CustomerQuery) All(ctx context.Context) ([]*Customer, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	v, err := cq.intercept(ctx, "All", func(ctx context.Context, query *CustomerQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Customer)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Customer", v)
+	}
+	return nodes, nil
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cq *CustomerQuery) AllX(ctx context.Context) []*Customer {
+	nodes, err := cq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Customer IDs.
+func (cq *CustomerQuery) IDs(ctx context.Context) ([]int, error) {
+	v, err := cq.intercept(ctx, "IDs", func(ctx context.Context, query *CustomerQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(customer.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cq *CustomerQuery) IDsX(ctx context.Context) []int {
+	ids, err := cq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cq *CustomerQuery) Count(ctx context.Context) (int, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	v, err := cq.intercept(ctx, "Count", func(ctx context.Context, query *CustomerQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cq *CustomerQuery) CountX(ctx context.Context) int {
+	count, err := cq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cq *CustomerQuery) Exist(ctx context.Context) (bool, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	v, err := cq.intercept(ctx, "Exist", func(ctx context.Context, query *CustomerQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (cq *CustomerQuery) intercept(ctx context.Context, op string, fn func(context.Context, *CustomerQuery) (Value, error)) (Value, error) {
+	if len(cq.inters) == 0 {
+		return fn(ctx, cq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeCustomer,
+		Op:     op,
+		Limit:  cq.limit,
+		Offset: cq.offset,
+		Unique: cq.unique,
+		Fields: cq.fields,
+	}
+	for _, p := range cq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range cq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if cq.withOrders != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, customer.EdgeOrders)
+		qc.EdgeQueries[customer.EdgeOrders] = cq.withOrders
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CustomerQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *CustomerQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, cq, qc, qr, cq.inters)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cq *CustomerQuery) ExistX(ctx context.Context) bool {
+	exist, err := cq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CustomerQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cq *CustomerQuery) Clone() *CustomerQuery {
+	if cq == nil {
+		return nil
+	}
+	return &CustomerQuery{
+		config:     cq.config,
+		limit:      cq.limit,
+		offset:     cq.offset,
+		order:      append([]OrderFunc{}, cq.order...),
+		inters:     append([]Interceptor{}, cq.inters...),
+		predicates: append([]predicate.Customer{}, cq.predicates...),
+		withOrders: cq.withOrders.Clone(),
+		// clone intermediate query.
+		sql:    cq.sql.Clone(),
+		path:   cq.path,
+		unique: cq.unique,
+	}
+}
+
+// WithOrders tells the query-builder to eager-load the nodes that are connected to
+// the "orders" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CustomerQuery) WithOrders(opts ...func(*OrderQuery)) *CustomerQuery {
+	query := (&OrderClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withOrders = query
+	return cq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Customer.Query().
+//		GroupBy(customer.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (cq *CustomerQuery) GroupBy(field string, fields ...string) *CustomerGroupBy {
+	grbuild := &CustomerGroupBy{config: cq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cq.sqlQuery(ctx), nil
+	}
+	grbuild.label = customer.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Customer.Query().
+//		Select(customer.FieldName).
+//		Scan(ctx, &v)
+//
+func (cq *CustomerQuery) Select(fields ...string) *CustomerSelect {
+	cq.fields = append(cq.fields, fields...)
+	selbuild := &CustomerSelect{CustomerQuery: cq}
+	selbuild.label = customer.Label
+	selbuild.flds, selbuild.scan = &cq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (cq *CustomerQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range cq.fields {
+		if !customer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	for _, inter := range cq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cq); err != nil {
+				return err
+			}
+		}
+	}
+	if cq.path != nil {
+		prev, err := cq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cq.sql = prev
+	}
+	return nil
+}
+
+func (cq *CustomerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Customer, error) {
+	var (
+		nodes       = []*Customer{}
+		_spec       = cq.querySpec()
+		loadedTypes = [1]bool{
+			cq.withOrders != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Customer).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Customer{config: cq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cq.withOrders; query != nil {
+		if err := cq.loadOrders(ctx, query, nodes,
+			func(n *Customer) { n.Edges.Orders = []*Order{} },
+			func(n *Customer, e *Order) { n.Edges.Orders = append(n.Edges.Orders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cq *CustomerQuery) loadOrders(ctx context.Context, query *OrderQuery, nodes []*Customer, init func(*Customer), assign func(*Customer, *Order)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Customer)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Order(func(s *sql.Selector) {
+		s.Where(sql.InValues(customer.OrdersColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.customer_orders
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "customer_orders" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "customer_orders" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (cq *CustomerQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cq.querySpec()
+	_spec.Node.Columns = cq.fields
+	if len(cq.fields) > 0 {
+		_spec.Unique = cq.unique != nil && *cq.unique
+	}
+	return sqlgraph.CountNodes(ctx, cq.queryDriver(), _spec)
+}
+
+func (cq *CustomerQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := cq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (cq *CustomerQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customer.Table,
+			Columns: customer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customer.FieldID,
+			},
+		},
+		From:   cq.sql,
+		Unique: true,
+	}
+	if unique := cq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := cq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customer.FieldID)
+		for i := range fields {
+			if fields[i] != customer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cq *CustomerQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cq.driver.Dialect())
+	t1 := builder.Table(customer.Table)
+	columns := cq.fields
+	if len(columns) == 0 {
+		columns = customer.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cq.sql != nil {
+		selector = cq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cq.unique != nil && *cq.unique {
+		selector.Distinct()
+	}
+	for _, p := range cq.predicates {
+		p(selector)
+	}
+	for _, p := range cq.order {
+		p(selector)
+	}
+	if offset := cq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (cq *CustomerQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: customer.Label}
+	if len(cq.predicates) > 0 {
+		selector := sql.Dialect(cq.driver.Dialect()).Select().From(sql.Table(customer.Table))
+		for _, p := range cq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the CustomerQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (cq *CustomerQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Customer, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Customer(ps[i]))
+	}
+	cq.predicates = append(cq.predicates, wps...)
+}
+
+// CustomerGroupBy is the group-by builder for Customer entities.
+type CustomerGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cgb *CustomerGroupBy) Aggregate(fns ...AggregateFunc) *CustomerGroupBy {
+	cgb.fns = append(cgb.fns, fns...)
+	return cgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cgb *CustomerGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cgb.sql = query
+	return cgb.sqlScan(ctx, v)
+}
+
+func (cgb *CustomerGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cgb.fields {
+		if !customer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cgb.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cgb *CustomerGroupBy) sqlQuery() *sql.Selector {
+	selector := cgb.sql.Select()
+	aggregation := make([]string, 0, len(cgb.fns))
+	for _, fn := range cgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(cgb.fields)+len(cgb.fns))
+		for _, f := range cgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(cgb.fields...)...)
+}
+
+// CustomerSelect is the builder for selecting fields of Customer entities.
+type CustomerSelect struct {
+	*CustomerQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cs *CustomerSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cs.sql = cs.CustomerQuery.sqlQuery(ctx)
+	return cs.sqlScan(ctx, v)
+}
+
+func (cs *CustomerSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cs.sql.Query()
+	if err := cs.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,112 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// CustomersColumns holds the columns for the "customers" table.
+	CustomersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+	}
+	// CustomersTable holds the schema information for the "customers" table.
+	CustomersTable = &schema.Table{
+		Name:       "customers",
+		Columns:    CustomersColumns,
+		PrimaryKey: []*schema.Column{CustomersColumns[0]},
+	}
+	// ItemsColumns holds the columns for the "items" table.
+	ItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sku", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt, Default: 1},
+		{Name: "order_items", Type: field.TypeInt, Nullable: true},
+	}
+	// ItemsTable holds the schema information for the "items" table.
+	ItemsTable = &schema.Table{
+		Name:       "items",
+		Columns:    ItemsColumns,
+		PrimaryKey: []*schema.Column{ItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "items_orders_items",
+				Columns:    []*schema.Column{ItemsColumns[3]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// OrdersColumns holds the columns for the "orders" table.
+	OrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString, Unique: true},
+		{Name: "total", Type: field.TypeFloat64},
+		{Name: "placed_at", Type: field.TypeTime},
+		{Name: "customer_orders", Type: field.TypeInt, Nullable: true},
+	}
+	// OrdersTable holds the schema information for the "orders" table.
+	OrdersTable = &schema.Table{
+		Name:       "orders",
+		Columns:    OrdersColumns,
+		PrimaryKey: []*schema.Column{OrdersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "orders_customers_orders",
+				Columns:    []*schema.Column{OrdersColumns[4]},
+				RefColumns: []*schema.Column{CustomersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ItemsArchiveColumns holds the columns for the "items_archive" table.
+	ItemsArchiveColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt},
+		{Name: "sku", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt, Default: 1},
+		{Name: "order_items", Type: field.TypeInt, Nullable: true},
+	}
+	// ItemsArchiveTable holds the schema information for the "items_archive" table.
+	ItemsArchiveTable = &schema.Table{
+		Name:       "items_archive",
+		Columns:    ItemsArchiveColumns,
+		PrimaryKey: []*schema.Column{ItemsArchiveColumns[0]},
+	}
+	// OrdersArchiveColumns holds the columns for the "orders_archive" table.
+	OrdersArchiveColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt},
+		{Name: "number", Type: field.TypeString},
+		{Name: "total", Type: field.TypeFloat64},
+		{Name: "placed_at", Type: field.TypeTime},
+		{Name: "customer_orders", Type: field.TypeInt, Nullable: true},
+	}
+	// OrdersArchiveTable holds the schema information for the "orders_archive" table.
+	OrdersArchiveTable = &schema.Table{
+		Name:       "orders_archive",
+		Columns:    OrdersArchiveColumns,
+		PrimaryKey: []*schema.Column{OrdersArchiveColumns[0]},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		CustomersTable,
+		ItemsTable,
+		OrdersTable,
+		ItemsArchiveTable,
+		OrdersArchiveTable,
+	}
+)
+
+func init() {
+	ItemsTable.ForeignKeys[0].RefTable = OrdersTable
+	ItemsTable.Annotation = &entsql.Annotation{}
+	OrdersTable.ForeignKeys[0].RefTable = CustomersTable
+	OrdersTable.Annotation = &entsql.Annotation{}
+}
@@ -0,0 +1,720 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/archive/ent/customer"
+	"entgo.io/ent/entc/integration/archive/ent/item"
+	"entgo.io/ent/entc/integration/archive/ent/order"
+	"entgo.io/ent/entc/integration/archive/ent/predicate"
+	"entgo.io/ent/schema/field"
+)
+
+// OrderUpdate is the builder for updating Order entities.
+type OrderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderMutation
+}
+
+// Where appends a list predicates to the OrderUpdate builder.
+func (ou *OrderUpdate) Where(ps ...predicate.Order) *OrderUpdate {
+	ou.mutation.Where(ps...)
+	return ou
+}
+
+// SetNumber sets the "number" field.
+func (ou *OrderUpdate) SetNumber(s string) *OrderUpdate {
+	ou.mutation.SetNumber(s)
+	return ou
+}
+
+// SetTotal sets the "total" field.
+func (ou *OrderUpdate) SetTotal(f float64) *OrderUpdate {
+	ou.mutation.ResetTotal()
+	ou.mutation.SetTotal(f)
+	return ou
+}
+
+// AddTotal adds f to the "total" field.
+func (ou *OrderUpdate) AddTotal(f float64) *OrderUpdate {
+	ou.mutation.AddTotal(f)
+	return ou
+}
+
+// SetTotalIfGreater sets the "total" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ou *OrderUpdate) SetTotalIfGreater(f float64) *OrderUpdate {
+	ou.mutation.SetTotalIfGreater(f)
+	return ou
+}
+
+// SetPlacedAt sets the "placed_at" field.
+func (ou *OrderUpdate) SetPlacedAt(t time.Time) *OrderUpdate {
+	ou.mutation.SetPlacedAt(t)
+	return ou
+}
+
+// SetNillablePlacedAt sets the "placed_at" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillablePlacedAt(t *time.Time) *OrderUpdate {
+	if t != nil {
+		ou.SetPlacedAt(*t)
+	}
+	return ou
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (ou *OrderUpdate) SetCustomerID(id int) *OrderUpdate {
+	ou.mutation.SetCustomerID(id)
+	return ou
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (ou *OrderUpdate) SetNillableCustomerID(id *int) *OrderUpdate {
+	if id != nil {
+		ou = ou.SetCustomerID(*id)
+	}
+	return ou
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (ou *OrderUpdate) SetCustomer(c *Customer) *OrderUpdate {
+	return ou.SetCustomerID(c.ID)
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by IDs.
+func (ou *OrderUpdate) AddItemIDs(ids ...int) *OrderUpdate {
+	ou.mutation.AddItemIDs(ids...)
+	return ou
+}
+
+// AddItems adds the "items" edges to the Item entity.
+func (ou *OrderUpdate) AddItems(i ...*Item) *OrderUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ou.AddItemIDs(ids...)
+}
+
+// Mutation returns the OrderMutation object of the builder.
+func (ou *OrderUpdate) Mutation() *OrderMutation {
+	return ou.mutation
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (ou *OrderUpdate) ClearCustomer() *OrderUpdate {
+	ou.mutation.ClearCustomer()
+	return ou
+}
+
+// ClearItems clears all "items" edges to the Item entity.
+func (ou *OrderUpdate) ClearItems() *OrderUpdate {
+	ou.mutation.ClearItems()
+	return ou
+}
+
+// RemoveItemIDs removes the "items" edge to Item entities by IDs.
+func (ou *OrderUpdate) RemoveItemIDs(ids ...int) *OrderUpdate {
+	ou.mutation.RemoveItemIDs(ids...)
+	return ou
+}
+
+// RemoveItems removes "items" edges to Item entities.
+func (ou *OrderUpdate) RemoveItems(i ...*Item) *OrderUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ou.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ou *OrderUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ou.hooks) == 0 {
+		affected, err = ou.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OrderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ou.mutation = mutation
+			affected, err = ou.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ou.hooks) - 1; i >= 0; i-- {
+			if ou.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ou.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ou.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ou *OrderUpdate) SaveX(ctx context.Context) int {
+	affected, err := ou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ou *OrderUpdate) Exec(ctx context.Context) error {
+	_, err := ou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ou *OrderUpdate) ExecX(ctx context.Context) {
+	if err := ou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   order.Table,
+			Columns: order.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: order.FieldID,
+			},
+		},
+	}
+	if ps := ou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ou.mutation.Number(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: order.FieldNumber,
+		})
+	}
+	if value, ok := ou.mutation.Total(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: order.FieldTotal,
+		})
+	}
+	if value, ok := ou.mutation.AddedTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: order.FieldTotal,
+		})
+	}
+	if value, ok := ou.mutation.TotalIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: order.FieldTotal,
+		})
+	}
+	if value, ok := ou.mutation.PlacedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: order.FieldPlacedAt,
+		})
+	}
+	if ou.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.CustomerTable,
+			Columns: []string{order.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.CustomerTable,
+			Columns: []string{order.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: item.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedItemsIDs(); len(nodes) > 0 && !ou.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: item.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: item.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label: order.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OrderUpdateOne is the builder for updating a single Order entity.
+type OrderUpdateOne struct {
+	config
+	fields     []string
+	conditions []predicate.Order
+	hooks      []Hook
+	mutation   *OrderMutation
+}
+
+// Where appends a list of conditions to the OrderUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ouo *OrderUpdateOne) Where(ps ...predicate.Order) *OrderUpdateOne {
+	ouo.conditions = append(ouo.conditions, ps...)
+	return ouo
+}
+
+// SetNumber sets the "number" field.
+func (ouo *OrderUpdateOne) SetNumber(s string) *OrderUpdateOne {
+	ouo.mutation.SetNumber(s)
+	return ouo
+}
+
+// SetTotal sets the "total" field.
+func (ouo *OrderUpdateOne) SetTotal(f float64) *OrderUpdateOne {
+	ouo.mutation.ResetTotal()
+	ouo.mutation.SetTotal(f)
+	return ouo
+}
+
+// AddTotal adds f to the "total" field.
+func (ouo *OrderUpdateOne) AddTotal(f float64) *OrderUpdateOne {
+	ouo.mutation.AddTotal(f)
+	return ouo
+}
+
+// SetTotalIfGreater sets the "total" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ouo *OrderUpdateOne) SetTotalIfGreater(f float64) *OrderUpdateOne {
+	ouo.mutation.SetTotalIfGreater(f)
+	return ouo
+}
+
+// SetPlacedAt sets the "placed_at" field.
+func (ouo *OrderUpdateOne) SetPlacedAt(t time.Time) *OrderUpdateOne {
+	ouo.mutation.SetPlacedAt(t)
+	return ouo
+}
+
+// SetNillablePlacedAt sets the "placed_at" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillablePlacedAt(t *time.Time) *OrderUpdateOne {
+	if t != nil {
+		ouo.SetPlacedAt(*t)
+	}
+	return ouo
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (ouo *OrderUpdateOne) SetCustomerID(id int) *OrderUpdateOne {
+	ouo.mutation.SetCustomerID(id)
+	return ouo
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableCustomerID(id *int) *OrderUpdateOne {
+	if id != nil {
+		ouo = ouo.SetCustomerID(*id)
+	}
+	return ouo
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (ouo *OrderUpdateOne) SetCustomer(c *Customer) *OrderUpdateOne {
+	return ouo.SetCustomerID(c.ID)
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by IDs.
+func (ouo *OrderUpdateOne) AddItemIDs(ids ...int) *OrderUpdateOne {
+	ouo.mutation.AddItemIDs(ids...)
+	return ouo
+}
+
+// AddItems adds the "items" edges to the Item entity.
+func (ouo *OrderUpdateOne) AddItems(i ...*Item) *OrderUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ouo.AddItemIDs(ids...)
+}
+
+// Mutation returns the OrderMutation object of the builder.
+func (ouo *OrderUpdateOne) Mutation() *OrderMutation {
+	return ouo.mutation
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (ouo *OrderUpdateOne) ClearCustomer() *OrderUpdateOne {
+	ouo.mutation.ClearCustomer()
+	return ouo
+}
+
+// ClearItems clears all "items" edges to the Item entity.
+func (ouo *OrderUpdateOne) ClearItems() *OrderUpdateOne {
+	ouo.mutation.ClearItems()
+	return ouo
+}
+
+// RemoveItemIDs removes the "items" edge to Item entities by IDs.
+func (ouo *OrderUpdateOne) RemoveItemIDs(ids ...int) *OrderUpdateOne {
+	ouo.mutation.RemoveItemIDs(ids...)
+	return ouo
+}
+
+// RemoveItems removes "items" edges to Item entities.
+func (ouo *OrderUpdateOne) RemoveItems(i ...*Item) *OrderUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ouo.RemoveItemIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ouo *OrderUpdateOne) Select(field string, fields ...string) *OrderUpdateOne {
+	ouo.fields = append([]string{field}, fields...)
+	return ouo
+}
+
+// Save executes the query and returns the updated Order entity.
+func (ouo *OrderUpdateOne) Save(ctx context.Context) (*Order, error) {
+	var (
+		err  error
+		node *Order
+	)
+	if len(ouo.hooks) == 0 {
+		node, err = ouo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OrderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ouo.mutation = mutation
+			node, err = ouo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ouo.hooks) - 1; i >= 0; i-- {
+			if ouo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ouo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ouo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Order)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from OrderMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ouo *OrderUpdateOne) SaveX(ctx context.Context) *Order {
+	node, err := ouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ouo *OrderUpdateOne) Exec(ctx context.Context) error {
+	_, err := ouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ouo *OrderUpdateOne) ExecX(ctx context.Context) {
+	if err := ouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   order.Table,
+			Columns: order.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: order.FieldID,
+			},
+		},
+	}
+	id, ok := ouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Order.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, order.FieldID)
+		for _, f := range fields {
+			if !order.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != order.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ps := ouo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ouo.mutation.Number(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: order.FieldNumber,
+		})
+	}
+	if value, ok := ouo.mutation.Total(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: order.FieldTotal,
+		})
+	}
+	if value, ok := ouo.mutation.AddedTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: order.FieldTotal,
+		})
+	}
+	if value, ok := ouo.mutation.TotalIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: order.FieldTotal,
+		})
+	}
+	if value, ok := ouo.mutation.PlacedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: order.FieldPlacedAt,
+		})
+	}
+	if ouo.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.CustomerTable,
+			Columns: []string{order.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.CustomerTable,
+			Columns: []string{order.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: item.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !ouo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: item.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: item.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Order{config: ouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label: order.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: order.Label, id: _spec.Node.ID.Value, version: e.Version()}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Item holds the schema definition for the Item entity.
+type Item struct {
+	ent.Schema
+}
+
+// Fields of the Item.
+func (Item) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("sku"),
+		field.Int("quantity").
+			Default(1),
+	}
+}
+
+// Edges of the Item.
+func (Item) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("items").
+			Unique(),
+	}
+}
+
+// Annotations of the Item.
+func (Item) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Archive(),
+	}
+}
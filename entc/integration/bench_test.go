@@ -0,0 +1,112 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entbench"
+	"entgo.io/ent/entc/integration/ent"
+	"entgo.io/ent/entc/integration/ent/migrate"
+	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/user"
+)
+
+// benchScenarios returns the standard entbench scenarios for the integration schema.
+func benchScenarios(client *ent.Client) []entbench.Scenario {
+	var batch int
+	return []entbench.Scenario{
+		{
+			Name: entbench.BulkInsert,
+			Run: func(ctx context.Context) error {
+				batch++
+				builders := make([]*ent.CardCreate, 100)
+				for i := range builders {
+					builders[i] = client.Card.Create().SetNumber(fmt.Sprintf("%d-%d", batch, i))
+				}
+				return client.Card.CreateBulk(builders...).Exec(ctx)
+			},
+		},
+		{
+			Name: entbench.EagerLoad,
+			Setup: func(ctx context.Context) error {
+				// Benchmarks call the setup on each round.
+				if n, err := client.User.Query().Count(ctx); err != nil || n > 0 {
+					return err
+				}
+				info, err := client.GroupInfo.Create().SetDesc("desc").Save(ctx)
+				if err != nil {
+					return err
+				}
+				g, err := client.Group.Create().SetName("Group").SetExpire(time.Now().Add(time.Hour)).SetInfo(info).Save(ctx)
+				if err != nil {
+					return err
+				}
+				for i := 0; i < 50; i++ {
+					u, err := client.User.Create().SetName(fmt.Sprintf("user%d", i)).SetAge(i).AddGroups(g).Save(ctx)
+					if err != nil {
+						return err
+					}
+					for j := 0; j < 3; j++ {
+						if err := client.Pet.Create().SetName(fmt.Sprintf("pet%d", j)).SetOwner(u).Exec(ctx); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			},
+			Run: func(ctx context.Context) error {
+				_, err := client.User.Query().
+					WithPets(func(q *ent.PetQuery) {
+						q.WithOwner()
+					}).
+					WithGroups(func(q *ent.GroupQuery) {
+						q.WithInfo()
+					}).
+					All(ctx)
+				return err
+			},
+		},
+		{
+			Name: entbench.PredicateScan,
+			Run: func(ctx context.Context) error {
+				_, err := client.User.Query().
+					Where(
+						user.Or(
+							user.And(user.AgeGT(10), user.NameHasPrefix("user1")),
+							user.And(user.AgeLT(40), user.Not(user.NameContains("2"))),
+						),
+						user.HasPetsWith(pet.NameIn("pet0", "pet1")),
+						user.Not(user.HasSpouse()),
+					).
+					All(ctx)
+				return err
+			},
+		},
+	}
+}
+
+func BenchmarkScenarios(b *testing.B) {
+	drv, err := entsql.Open(dialect.SQLite, "file:bench?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		b.Fatal(err)
+	}
+	counter := entbench.CountQueries(drv)
+	client := ent.NewClient(ent.Driver(counter))
+	defer client.Close()
+	if err := client.Schema.Create(context.Background(), migrate.WithGlobalUniqueID(true)); err != nil {
+		b.Fatal(err)
+	}
+	for _, s := range benchScenarios(client) {
+		b.Run(s.Name, func(b *testing.B) {
+			entbench.Benchmark(b, s, counter)
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"entgo.io/ent/entc/integration/cascadelete/ent/post"
 	"entgo.io/ent/entc/integration/cascadelete/ent/user"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -38,10 +39,11 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
+	client.AddExtension(ent.RegisteredExtensions()...)
 	return client
 }
 
@@ -124,14 +126,24 @@ func (c *Client) Debug() *Client {
 	}
 	cfg := c.config
 	cfg.driver = dialect.Debug(c.driver, c.log)
+	if c.readDriver != nil {
+		cfg.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
 	client := &Client{config: cfg}
 	client.init()
 	return client
 }
 
 // Close closes the database connection and prevents new queries from starting.
+// The read driver of the client is closed as well, if it was configured.
 func (c *Client) Close() error {
-	return c.driver.Close()
+	err := c.driver.Close()
+	if _, ok := c.driver.(*txDriver); !ok && c.readDriver != nil {
+		if rerr := c.readDriver.Close(); err == nil {
+			err = rerr
+		}
+	}
+	return err
 }
 
 // Use adds the mutation hooks to all the entity clients.
@@ -142,6 +154,81 @@ func (c *Client) Use(hooks ...Hook) {
 	c.User.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Comment.Intercept(interceptors...)
+	c.Post.Intercept(interceptors...)
+	c.User.Intercept(interceptors...)
+}
+
+// AddExtension adds the given extensions to the client. Their hooks and interceptors are added to all the
+// entity clients, and their drivers wrap the drivers of the client. Extensions that decorate the driver
+// should be added before the client is used, as entity clients that were obtained before the call (e.g.
+// `users := client.User`) keep using the previous driver. For example:
+//
+//	client.AddExtension(cache.NewExtension(cache.WithTTL(time.Minute)))
+//
+func (c *Client) AddExtension(exts ...ent.Extension) {
+	if len(exts) == 0 {
+		return
+	}
+	for _, ext := range exts {
+		if drv := ext.Driver(c.driver); drv != nil {
+			c.driver = drv
+		}
+		if c.readDriver != nil {
+			if drv := ext.Driver(c.readDriver); drv != nil {
+				c.readDriver = drv
+			}
+		}
+	}
+	c.extensions = append(c.extensions[:len(c.extensions):len(c.extensions)], exts...)
+	c.init()
+	for _, ext := range exts {
+		c.Use(ext.Hooks()...)
+		c.Intercept(ext.Interceptors()...)
+	}
+}
+
+// HealthCheck runs the health checks of the extensions that were added to the client,
+// and returns the first error that was reported.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	for _, ext := range c.extensions {
+		if err := ext.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("ent: extension %q: %w", ext.Name(), err)
+		}
+	}
+	return nil
+}
+
+// WithOptions returns a new client that is derived from c and configured with the given options.
+// Hooks that are registered on the new client using Use are not added to c (and vice versa). For
+// example, creating a client for trusted background jobs:
+//
+//	client.WithOptions(ent.ReplaceHooks(AuditHook()), ent.SkipPrivacy()).
+//		Comment.
+//		Delete().
+//		Exec(ctx)
+//
+func (c *Client) WithOptions(opts ...Option) *Client {
+	cfg := c.config
+	cfg.hooks = &hooks{
+		Comment: c.hooks.Comment[:len(c.hooks.Comment):len(c.hooks.Comment)],
+		Post:    c.hooks.Post[:len(c.hooks.Post):len(c.hooks.Post)],
+		User:    c.hooks.User[:len(c.hooks.User):len(c.hooks.User)],
+	}
+	cfg.inters = &inters{
+		Comment: c.inters.Comment[:len(c.inters.Comment):len(c.inters.Comment)],
+		Post:    c.inters.Post[:len(c.inters.Post):len(c.inters.Post)],
+		User:    c.inters.User[:len(c.inters.User):len(c.inters.User)],
+	}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
 // CommentClient is a client for the Comment schema.
 type CommentClient struct {
 	config
@@ -158,6 +245,12 @@ func (c *CommentClient) Use(hooks ...Hook) {
 	c.hooks.Comment = append(c.hooks.Comment, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
+func (c *CommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
+}
+
 // Create returns a builder for creating a Comment entity.
 func (c *CommentClient) Create() *CommentCreate {
 	mutation := newCommentMutation(c.config, OpCreate)
@@ -210,12 +303,17 @@ func (c *CommentClient) DeleteOneID(id int) *CommentDeleteOne {
 func (c *CommentClient) Query() *CommentQuery {
 	return &CommentQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Comment entity by its id.
 func (c *CommentClient) Get(ctx context.Context, id int) (*Comment, error) {
-	return c.Query().Where(comment.ID(id)).Only(ctx)
+	node, err := c.Query().Where(comment.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -229,7 +327,7 @@ func (c *CommentClient) GetX(ctx context.Context, id int) *Comment {
 
 // QueryPost queries the post edge of a Comment.
 func (c *CommentClient) QueryPost(co *Comment) *PostQuery {
-	query := &PostQuery{config: c.config}
+	query := (&PostClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := co.ID
 		step := sqlgraph.NewStep(
@@ -243,6 +341,11 @@ func (c *CommentClient) QueryPost(co *Comment) *PostQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *CommentClient) Interceptors() []Interceptor {
+	return c.inters.Comment
+}
+
 // Hooks returns the client hooks.
 func (c *CommentClient) Hooks() []Hook {
 	return c.hooks.Comment
@@ -264,6 +367,12 @@ func (c *PostClient) Use(hooks ...Hook) {
 	c.hooks.Post = append(c.hooks.Post, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
+func (c *PostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Post = append(c.inters.Post, interceptors...)
+}
+
 // Create returns a builder for creating a Post entity.
 func (c *PostClient) Create() *PostCreate {
 	mutation := newPostMutation(c.config, OpCreate)
@@ -316,12 +425,17 @@ func (c *PostClient) DeleteOneID(id int) *PostDeleteOne {
 func (c *PostClient) Query() *PostQuery {
 	return &PostQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Post entity by its id.
 func (c *PostClient) Get(ctx context.Context, id int) (*Post, error) {
-	return c.Query().Where(post.ID(id)).Only(ctx)
+	node, err := c.Query().Where(post.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -335,7 +449,7 @@ func (c *PostClient) GetX(ctx context.Context, id int) *Post {
 
 // QueryAuthor queries the author edge of a Post.
 func (c *PostClient) QueryAuthor(po *Post) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := po.ID
 		step := sqlgraph.NewStep(
@@ -351,7 +465,7 @@ func (c *PostClient) QueryAuthor(po *Post) *UserQuery {
 
 // QueryComments queries the comments edge of a Post.
 func (c *PostClient) QueryComments(po *Post) *CommentQuery {
-	query := &CommentQuery{config: c.config}
+	query := (&CommentClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := po.ID
 		step := sqlgraph.NewStep(
@@ -365,6 +479,11 @@ func (c *PostClient) QueryComments(po *Post) *CommentQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *PostClient) Interceptors() []Interceptor {
+	return c.inters.Post
+}
+
 // Hooks returns the client hooks.
 func (c *PostClient) Hooks() []Hook {
 	return c.hooks.Post
@@ -386,6 +505,12 @@ func (c *UserClient) Use(hooks ...Hook) {
 	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a builder for creating a User entity.
 func (c *UserClient) Create() *UserCreate {
 	mutation := newUserMutation(c.config, OpCreate)
@@ -438,12 +563,17 @@ func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
 func (c *UserClient) Query() *UserQuery {
 	return &UserQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a User entity by its id.
 func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+	node, err := c.Query().Where(user.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -457,7 +587,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 
 // QueryPosts queries the posts edge of a User.
 func (c *UserClient) QueryPosts(u *User) *PostQuery {
-	query := &PostQuery{config: c.config}
+	query := (&PostClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -471,6 +601,11 @@ func (c *UserClient) QueryPosts(u *User) *PostQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
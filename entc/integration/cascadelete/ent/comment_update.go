@@ -190,7 +190,7 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{comment.Label}
+			err = &NotFoundError{label: comment.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -202,9 +202,18 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // CommentUpdateOne is the builder for updating a single Comment entity.
 type CommentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CommentMutation
+	fields     []string
+	conditions []predicate.Comment
+	hooks      []Hook
+	mutation   *CommentMutation
+}
+
+// Where appends a list of conditions to the CommentUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
+	cuo.conditions = append(cuo.conditions, ps...)
+	return cuo
 }
 
 // SetText sets the "text" field.
@@ -351,6 +360,13 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 			}
 		}
 	}
+	if ps := cuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := cuo.mutation.Text(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -398,7 +414,9 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{comment.Label}
+			err = &NotFoundError{label: comment.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: comment.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
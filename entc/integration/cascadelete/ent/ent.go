@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -21,14 +22,20 @@ import (
 
 // ent aliases to avoid import conflicts in user's code.
 type (
-	Op         = ent.Op
-	Hook       = ent.Hook
-	Value      = ent.Value
-	Query      = ent.Query
-	Policy     = ent.Policy
-	Mutator    = ent.Mutator
-	Mutation   = ent.Mutation
-	MutateFunc = ent.MutateFunc
+	Op            = ent.Op
+	Hook          = ent.Hook
+	Value         = ent.Value
+	Query         = ent.Query
+	Policy        = ent.Policy
+	Querier       = ent.Querier
+	QuerierFunc   = ent.QuerierFunc
+	Interceptor   = ent.Interceptor
+	InterceptFunc = ent.InterceptFunc
+	Traverser     = ent.Traverser
+	TraverseFunc  = ent.TraverseFunc
+	Mutator       = ent.Mutator
+	Mutation      = ent.Mutation
+	MutateFunc    = ent.MutateFunc
 )
 
 // OrderFunc applies an ordering on the sql selector.
@@ -151,6 +158,136 @@ func Sum(field string) AggregateFunc {
 	}
 }
 
+// CountEdges counts the neighbors of each entity in the given edge and sums them up for each
+// group, using a correlated subquery. For example, counting the pets of the users of each group:
+//
+//	GroupBy(field1).
+//	Aggregate(ent.CountEdges(edge1)).
+//	Scan(ctx, &v)
+//
+func CountEdges(edge string) AggregateFunc {
+	return func(s *sql.Selector) string {
+		step, err := neighborsStep(s.TableName(), edge)
+		if err != nil {
+			s.AddError(&ValidationError{Name: edge, err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		return sql.Sum(sqlgraph.CountNeighbors(s, step))
+	}
+}
+
+// neighborsStep returns the path-step of the given edge of the given table.
+func neighborsStep(table, edge string) (*sqlgraph.Step, error) {
+	switch table {
+	case comment.Table:
+		switch edge {
+		case comment.EdgePost:
+			return sqlgraph.NewStep(
+				sqlgraph.From(comment.Table, comment.FieldID),
+				sqlgraph.To(post.Table, post.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, comment.PostTable, comment.PostColumn),
+			), nil
+		}
+	case post.Table:
+		switch edge {
+		case post.EdgeAuthor:
+			return sqlgraph.NewStep(
+				sqlgraph.From(post.Table, post.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, post.AuthorTable, post.AuthorColumn),
+			), nil
+		case post.EdgeComments:
+			return sqlgraph.NewStep(
+				sqlgraph.From(post.Table, post.FieldID),
+				sqlgraph.To(comment.Table, comment.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, post.CommentsTable, post.CommentsColumn),
+			), nil
+		}
+	case user.Table:
+		switch edge {
+		case user.EdgePosts:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(post.Table, post.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, user.PostsTable, user.PostsColumn),
+			), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown edge %q for table %q", edge, table)
+}
+
+// WindowOption configures the window of a window function.
+type WindowOption func(*sql.Selector, *sql.WindowBuilder)
+
+// PartitionBy divides the rows of the window function into partitions by the given fields.
+func PartitionBy(fields ...string) WindowOption {
+	return func(s *sql.Selector, w *sql.WindowBuilder) {
+		check := columnChecker(s.TableName())
+		columns := make([]string, 0, len(fields))
+		for _, f := range fields {
+			if err := check(f); err != nil {
+				s.AddError(&ValidationError{Name: f, err: fmt.Errorf("ent: %w", err)})
+			}
+			columns = append(columns, s.C(f))
+		}
+		w.PartitionBy(columns...)
+	}
+}
+
+// OrderBy sorts the rows of each partition of the window function by the given fields.
+// Fields that are prefixed with "-" are sorted in descending order (e.g. "-created_at").
+func OrderBy(fields ...string) WindowOption {
+	return func(s *sql.Selector, w *sql.WindowBuilder) {
+		check := columnChecker(s.TableName())
+		for _, f := range fields {
+			order := sql.Asc
+			if strings.HasPrefix(f, "-") {
+				f, order = f[1:], sql.Desc
+			}
+			if err := check(f); err != nil {
+				s.AddError(&ValidationError{Name: f, err: fmt.Errorf("ent: %w", err)})
+			}
+			w.OrderBy(order(s.C(f)))
+		}
+	}
+}
+
+// RowNumber applies the ROW_NUMBER() window function on the rows of the query. For example:
+//
+//	GroupBy(field1, field2).
+//	Aggregate(ent.RowNumber(ent.PartitionBy(field1), ent.OrderBy(field2))).
+//	Scan(ctx, &v)
+//
+func RowNumber(opts ...WindowOption) AggregateFunc {
+	return func(s *sql.Selector) string {
+		w := sql.RowNumber()
+		w.SetDialect(s.Dialect())
+		for _, opt := range opts {
+			opt(s, w)
+		}
+		query, _ := w.Query()
+		return query
+	}
+}
+
+// Rank applies the RANK() window function on the rows of the query. For example:
+//
+//	GroupBy(field1, field2).
+//	Aggregate(ent.Rank(ent.PartitionBy(field1), ent.OrderBy(field2))).
+//	Scan(ctx, &v)
+//
+func Rank(opts ...WindowOption) AggregateFunc {
+	return func(s *sql.Selector) string {
+		w := sql.Rank()
+		w.SetDialect(s.Dialect())
+		for _, opt := range opts {
+			opt(s, w)
+		}
+		query, _ := w.Query()
+		return query
+	}
+}
+
 // ValidationError returns when validating a field or edge fails.
 type ValidationError struct {
 	Name string // Field or edge name.
@@ -179,11 +316,52 @@ func IsValidationError(err error) bool {
 // NotFoundError returns when trying to fetch a specific entity and it was not found in the database.
 type NotFoundError struct {
 	label string
+	// id holds the searched ID, if the entity was fetched by its ID.
+	id interface{}
+	// predicate holds a summary of the query predicates (without their arguments), if available.
+	predicate string
 }
 
+// ErrNotFound matches all *NotFoundError errors when used with errors.Is. For example:
+//
+//	if errors.Is(err, ent.ErrNotFound) {
+//		w.WriteHeader(http.StatusNotFound)
+//	}
+//
+var ErrNotFound = &NotFoundError{}
+
 // Error implements the error interface.
 func (e *NotFoundError) Error() string {
-	return "ent: " + e.label + " not found"
+	switch {
+	case e.id != nil:
+		return fmt.Sprintf("ent: %s not found (id=%v)", e.label, e.id)
+	case e.predicate != "":
+		return fmt.Sprintf("ent: %s not found (where %s)", e.label, e.predicate)
+	default:
+		return "ent: " + e.label + " not found"
+	}
+}
+
+// Is reports whether the target is ErrNotFound, or a *NotFoundError of the same entity.
+func (e *NotFoundError) Is(target error) bool {
+	t, ok := target.(*NotFoundError)
+	return ok && (t.label == "" || t.label == e.label)
+}
+
+// Label returns the label of the entity that was not found.
+func (e *NotFoundError) Label() string {
+	return e.label
+}
+
+// ID returns the searched ID, or nil if the entity was not fetched by its ID.
+func (e *NotFoundError) ID() interface{} {
+	return e.id
+}
+
+// Predicate returns a summary of the query predicates, or an empty string if it is not available.
+// Note that the arguments of the predicates are omitted, and only their placeholders are returned.
+func (e *NotFoundError) Predicate() string {
+	return e.predicate
 }
 
 // IsNotFound returns a boolean indicating whether the error is a not found error.
@@ -268,6 +446,57 @@ func IsConstraintError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// ConflictError returns when trying to update an entity that was changed since it was
+// loaded, i.e. its version in the database is different than the expected version, or
+// when the entity does not match the conditions of the update (see UpdateOne.Where).
+type ConflictError struct {
+	label string
+	// id holds the ID of the entity, if it has a single ID field.
+	id interface{}
+	// version holds the expected version of the entity, or
+	// nil if it does not match the conditions of the update.
+	version interface{}
+}
+
+// Error implements the error interface.
+func (e *ConflictError) Error() string {
+	if e.version == nil {
+		if e.id != nil {
+			return fmt.Sprintf("ent: %s does not match the conditions of the update (id=%v)", e.label, e.id)
+		}
+		return fmt.Sprintf("ent: %s does not match the conditions of the update", e.label)
+	}
+	if e.id != nil {
+		return fmt.Sprintf("ent: %s was changed since version %v (id=%v)", e.label, e.version, e.id)
+	}
+	return fmt.Sprintf("ent: %s was changed since version %v", e.label, e.version)
+}
+
+// Label returns the label of the entity that was changed.
+func (e *ConflictError) Label() string {
+	return e.label
+}
+
+// ID returns the ID of the entity, or nil if it does not have a single ID field.
+func (e *ConflictError) ID() interface{} {
+	return e.id
+}
+
+// Version returns the version that was expected for the entity, or
+// nil if the entity does not match the conditions of the update.
+func (e *ConflictError) Version() interface{} {
+	return e.version
+}
+
+// IsConflict returns a boolean indicating whether the error is a conflict error.
+func IsConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *ConflictError
+	return errors.As(err, &e)
+}
+
 // selector embedded by the different Select/GroupBy builders.
 type selector struct {
 	label string
@@ -313,7 +542,7 @@ func (s *selector) String(ctx context.Context) (_ string, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Strings returned %d results when one was expected", len(v))
 	}
@@ -360,7 +589,7 @@ func (s *selector) Int(ctx context.Context) (_ int, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Ints returned %d results when one was expected", len(v))
 	}
@@ -407,7 +636,7 @@ func (s *selector) Float64(ctx context.Context) (_ float64, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Float64s returned %d results when one was expected", len(v))
 	}
@@ -454,7 +683,7 @@ func (s *selector) Bool(ctx context.Context) (_ bool, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Bools returned %d results when one was expected", len(v))
 	}
@@ -470,5 +699,17 @@ func (s *selector) BoolX(ctx context.Context) bool {
 	return v
 }
 
+// withInterceptors executes the given querier with the given interceptors, where the first interceptor
+// is the outermost one. The QueryContext of the query is available in the context of the interceptors.
+func withInterceptors(ctx context.Context, q Query, qc *ent.QueryContext, qr Querier, inters []Interceptor) (Value, error) {
+	for i := len(inters) - 1; i >= 0; i-- {
+		if inters[i] == nil {
+			return nil, fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		qr = inters[i].Intercept(qr)
+	}
+	return qr.Query(ent.NewQueryContext(ctx, qc), q)
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
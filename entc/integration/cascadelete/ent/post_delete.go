@@ -107,7 +107,7 @@ func (pdo *PostDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{post.Label}
+		return &NotFoundError{label: post.Label}
 	default:
 		return nil
 	}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/cascadelete/ent/comment"
@@ -29,6 +30,7 @@ type PostQuery struct {
 	unique       *bool
 	order        []OrderFunc
 	fields       []string
+	inters       []Interceptor
 	predicates   []predicate.Post
 	withAuthor   *UserQuery
 	withComments *CommentQuery
@@ -70,7 +72,7 @@ func (pq *PostQuery) Order(o ...OrderFunc) *PostQuery {
 
 // QueryAuthor chains the current query on the "author" edge.
 func (pq *PostQuery) QueryAuthor() *UserQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -92,7 +94,7 @@ func (pq *PostQuery) QueryAuthor() *UserQuery {
 
 // QueryComments chains the current query on the "comments" edge.
 func (pq *PostQuery) QueryComments() *CommentQuery {
-	query := &CommentQuery{config: pq.config}
+	query := (&CommentClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -120,7 +122,7 @@ func (pq *PostQuery) First(ctx context.Context) (*Post, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{post.Label}
+		return nil, pq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -142,7 +144,7 @@ func (pq *PostQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{post.Label}
+		err = pq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -169,7 +171,7 @@ func (pq *PostQuery) Only(ctx context.Context) (*Post, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{post.Label}
+		return nil, pq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{post.Label}
 	}
@@ -196,7 +198,7 @@ func (pq *PostQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{post.Label}
+		err = pq.sqlNotFound()
 	default:
 		err = &NotSingularError{post.Label}
 	}
@@ -217,7 +219,17 @@ func (pq *PostQuery) All(ctx context.Context) ([]*Post, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return pq.sqlAll(ctx)
+	v, err := pq.intercept(ctx, "All", func(ctx context.Context, query *PostQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Post)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Post", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -231,10 +243,20 @@ func (pq *PostQuery) AllX(ctx context.Context) []*Post {
 
 // IDs executes the query and returns a list of Post IDs.
 func (pq *PostQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := pq.Select(post.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := pq.intercept(ctx, "IDs", func(ctx context.Context, query *PostQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(post.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -252,7 +274,17 @@ func (pq *PostQuery) Count(ctx context.Context) (int, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return pq.sqlCount(ctx)
+	v, err := pq.intercept(ctx, "Count", func(ctx context.Context, query *PostQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -269,7 +301,61 @@ func (pq *PostQuery) Exist(ctx context.Context) (bool, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return pq.sqlExist(ctx)
+	v, err := pq.intercept(ctx, "Exist", func(ctx context.Context, query *PostQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (pq *PostQuery) intercept(ctx context.Context, op string, fn func(context.Context, *PostQuery) (Value, error)) (Value, error) {
+	if len(pq.inters) == 0 {
+		return fn(ctx, pq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypePost,
+		Op:     op,
+		Limit:  pq.limit,
+		Offset: pq.offset,
+		Unique: pq.unique,
+		Fields: pq.fields,
+	}
+	for _, p := range pq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range pq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if pq.withAuthor != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, post.EdgeAuthor)
+		qc.EdgeQueries[post.EdgeAuthor] = pq.withAuthor
+	}
+	if pq.withComments != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, post.EdgeComments)
+		qc.EdgeQueries[post.EdgeComments] = pq.withComments
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PostQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *PostQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, pq, qc, qr, pq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -292,6 +378,7 @@ func (pq *PostQuery) Clone() *PostQuery {
 		limit:        pq.limit,
 		offset:       pq.offset,
 		order:        append([]OrderFunc{}, pq.order...),
+		inters:       append([]Interceptor{}, pq.inters...),
 		predicates:   append([]predicate.Post{}, pq.predicates...),
 		withAuthor:   pq.withAuthor.Clone(),
 		withComments: pq.withComments.Clone(),
@@ -305,7 +392,7 @@ func (pq *PostQuery) Clone() *PostQuery {
 // WithAuthor tells the query-builder to eager-load the nodes that are connected to
 // the "author" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PostQuery) WithAuthor(opts ...func(*UserQuery)) *PostQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -316,7 +403,7 @@ func (pq *PostQuery) WithAuthor(opts ...func(*UserQuery)) *PostQuery {
 // WithComments tells the query-builder to eager-load the nodes that are connected to
 // the "comments" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PostQuery) WithComments(opts ...func(*CommentQuery)) *PostQuery {
-	query := &CommentQuery{config: pq.config}
+	query := (&CommentClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -380,6 +467,16 @@ func (pq *PostQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range pq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pq); err != nil {
+				return err
+			}
+		}
+	}
 	if pq.path != nil {
 		prev, err := pq.path(ctx)
 		if err != nil {
@@ -411,7 +508,7 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, pq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -493,7 +590,7 @@ func (pq *PostQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(pq.fields) > 0 {
 		_spec.Unique = pq.unique != nil && *pq.unique
 	}
-	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, pq.queryDriver(), _spec)
 }
 
 func (pq *PostQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -584,6 +681,32 @@ func (pq *PostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (pq *PostQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: post.Label}
+	if len(pq.predicates) > 0 {
+		selector := sql.Dialect(pq.driver.Dialect()).Select().From(sql.Table(post.Table))
+		for _, p := range pq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the PostQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (pq *PostQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Post, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Post(ps[i]))
+	}
+	pq.predicates = append(pq.predicates, wps...)
+}
+
 // PostGroupBy is the group-by builder for Post entities.
 type PostGroupBy struct {
 	config
@@ -623,7 +746,7 @@ func (pgb *PostGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := pgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := pgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -669,7 +792,7 @@ func (ps *PostSelect) Scan(ctx context.Context, v interface{}) error {
 func (ps *PostSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ps.sql.Query()
-	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ps.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -289,7 +289,7 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{post.Label}
+			err = &NotFoundError{label: post.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -301,9 +301,18 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // PostUpdateOne is the builder for updating a single Post entity.
 type PostUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PostMutation
+	fields     []string
+	conditions []predicate.Post
+	hooks      []Hook
+	mutation   *PostMutation
+}
+
+// Where appends a list of conditions to the PostUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (puo *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
+	puo.conditions = append(puo.conditions, ps...)
+	return puo
 }
 
 // SetText sets the "text" field.
@@ -494,6 +503,13 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 			}
 		}
 	}
+	if ps := puo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := puo.mutation.Text(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -595,7 +611,9 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{post.Label}
+			err = &NotFoundError{label: post.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: post.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
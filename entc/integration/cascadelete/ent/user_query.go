@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/cascadelete/ent/post"
@@ -28,6 +29,7 @@ type UserQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.User
 	withPosts  *PostQuery
 	// intermediate query (i.e. traversal path).
@@ -68,7 +70,7 @@ func (uq *UserQuery) Order(o ...OrderFunc) *UserQuery {
 
 // QueryPosts chains the current query on the "posts" edge.
 func (uq *UserQuery) QueryPosts() *PostQuery {
-	query := &PostQuery{config: uq.config}
+	query := (&PostClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -96,7 +98,7 @@ func (uq *UserQuery) First(ctx context.Context) (*User, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{user.Label}
+		return nil, uq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -118,7 +120,7 @@ func (uq *UserQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{user.Label}
+		err = uq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -145,7 +147,7 @@ func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{user.Label}
+		return nil, uq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{user.Label}
 	}
@@ -172,7 +174,7 @@ func (uq *UserQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{user.Label}
+		err = uq.sqlNotFound()
 	default:
 		err = &NotSingularError{user.Label}
 	}
@@ -193,7 +195,17 @@ func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return uq.sqlAll(ctx)
+	v, err := uq.intercept(ctx, "All", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*User)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*User", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -207,10 +219,20 @@ func (uq *UserQuery) AllX(ctx context.Context) []*User {
 
 // IDs executes the query and returns a list of User IDs.
 func (uq *UserQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := uq.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := uq.intercept(ctx, "IDs", func(ctx context.Context, query *UserQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -228,7 +250,17 @@ func (uq *UserQuery) Count(ctx context.Context) (int, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return uq.sqlCount(ctx)
+	v, err := uq.intercept(ctx, "Count", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -245,7 +277,54 @@ func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return uq.sqlExist(ctx)
+	v, err := uq.intercept(ctx, "Exist", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (uq *UserQuery) intercept(ctx context.Context, op string, fn func(context.Context, *UserQuery) (Value, error)) (Value, error) {
+	if len(uq.inters) == 0 {
+		return fn(ctx, uq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeUser,
+		Op:     op,
+		Limit:  uq.limit,
+		Offset: uq.offset,
+		Unique: uq.unique,
+		Fields: uq.fields,
+	}
+	for _, p := range uq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range uq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if uq.withPosts != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgePosts)
+		qc.EdgeQueries[user.EdgePosts] = uq.withPosts
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *UserQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, uq, qc, qr, uq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -268,6 +347,7 @@ func (uq *UserQuery) Clone() *UserQuery {
 		limit:      uq.limit,
 		offset:     uq.offset,
 		order:      append([]OrderFunc{}, uq.order...),
+		inters:     append([]Interceptor{}, uq.inters...),
 		predicates: append([]predicate.User{}, uq.predicates...),
 		withPosts:  uq.withPosts.Clone(),
 		// clone intermediate query.
@@ -280,7 +360,7 @@ func (uq *UserQuery) Clone() *UserQuery {
 // WithPosts tells the query-builder to eager-load the nodes that are connected to
 // the "posts" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithPosts(opts ...func(*PostQuery)) *UserQuery {
-	query := &PostQuery{config: uq.config}
+	query := (&PostClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -344,6 +424,16 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range uq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, uq); err != nil {
+				return err
+			}
+		}
+	}
 	if uq.path != nil {
 		prev, err := uq.path(ctx)
 		if err != nil {
@@ -374,7 +464,7 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, uq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -424,7 +514,7 @@ func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(uq.fields) > 0 {
 		_spec.Unique = uq.unique != nil && *uq.unique
 	}
-	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, uq.queryDriver(), _spec)
 }
 
 func (uq *UserQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -515,6 +605,32 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (uq *UserQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: user.Label}
+	if len(uq.predicates) > 0 {
+		selector := sql.Dialect(uq.driver.Dialect()).Select().From(sql.Table(user.Table))
+		for _, p := range uq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the UserQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (uq *UserQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.User, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.User(ps[i]))
+	}
+	uq.predicates = append(uq.predicates, wps...)
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	config
@@ -554,7 +670,7 @@ func (ugb *UserGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ugb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ugb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -600,7 +716,7 @@ func (us *UserSelect) Scan(ctx context.Context, v interface{}) error {
 func (us *UserSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := us.sql.Query()
-	if err := us.driver.Query(ctx, query, args, rows); err != nil {
+	if err := us.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -222,7 +222,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -234,9 +234,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields     []string
+	conditions []predicate.User
+	hooks      []Hook
+	mutation   *UserMutation
+}
+
+// Where appends a list of conditions to the UserUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.conditions = append(uuo.conditions, ps...)
+	return uuo
 }
 
 // SetName sets the "name" field.
@@ -396,6 +405,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			}
 		}
 	}
+	if ps := uuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -462,7 +478,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: user.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
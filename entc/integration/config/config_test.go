@@ -15,8 +15,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/config/ent"
 	"entgo.io/ent/entc/integration/config/ent/migrate"
 	"entgo.io/ent/entc/integration/config/ent/schema"
@@ -66,6 +68,38 @@ func TestSchemaConfig(t *testing.T) {
 	})
 }
 
+func TestDatabaseDefaults(t *testing.T) {
+	drv, err := sql.Open("sqlite3", "file:defaults?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+	client := ent.NewClient(ent.Driver(drv))
+	require.NoError(t, client.Schema.Create(ctx))
+
+	// Values of columns with database defaults are returned on creation.
+	u := client.User.Create().SetID(1).SaveX(ctx)
+	require.Equal(t, "active", u.Status)
+	u = client.User.Create().SetID(2).SetStatus("pending").SaveX(ctx)
+	require.Equal(t, "pending", u.Status)
+
+	// Bulk creation is chunked, and the returned values are assigned to their entities.
+	limit := sqlgraph.MaxParams[dialect.SQLite]
+	defer func() { sqlgraph.MaxParams[dialect.SQLite] = limit }()
+	sqlgraph.MaxParams[dialect.SQLite] = 30
+	builders := make([]*ent.UserCreate, 100)
+	for i := range builders {
+		builders[i] = client.User.Create().SetID(i + 10).SetName(fmt.Sprintf("user-%d", i))
+	}
+	users, err := client.User.CreateBulk(builders...).Save(ctx)
+	require.NoError(t, err)
+	require.Len(t, users, 100)
+	for i, u := range users {
+		require.Equal(t, i+10, u.ID)
+		require.Equal(t, "active", u.Status)
+	}
+	require.Equal(t, 102, client.User.Query().CountX(ctx))
+}
+
 func TestMySQL(t *testing.T) {
 	for version, port := range map[string]int{"56": 3306, "57": 3307, "8": 3308} {
 		t.Run(version, func(t *testing.T) {
@@ -16,6 +16,7 @@ import (
 
 	"entgo.io/ent/entc/integration/config/ent/user"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 )
@@ -31,10 +32,11 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
+	client.AddExtension(ent.RegisteredExtensions()...)
 	return client
 }
 
@@ -111,14 +113,24 @@ func (c *Client) Debug() *Client {
 	}
 	cfg := c.config
 	cfg.driver = dialect.Debug(c.driver, c.log)
+	if c.readDriver != nil {
+		cfg.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
 	client := &Client{config: cfg}
 	client.init()
 	return client
 }
 
 // Close closes the database connection and prevents new queries from starting.
+// The read driver of the client is closed as well, if it was configured.
 func (c *Client) Close() error {
-	return c.driver.Close()
+	err := c.driver.Close()
+	if _, ok := c.driver.(*txDriver); !ok && c.readDriver != nil {
+		if rerr := c.readDriver.Close(); err == nil {
+			err = rerr
+		}
+	}
+	return err
 }
 
 // Use adds the mutation hooks to all the entity clients.
@@ -127,6 +139,75 @@ func (c *Client) Use(hooks ...Hook) {
 	c.User.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.User.Intercept(interceptors...)
+}
+
+// AddExtension adds the given extensions to the client. Their hooks and interceptors are added to all the
+// entity clients, and their drivers wrap the drivers of the client. Extensions that decorate the driver
+// should be added before the client is used, as entity clients that were obtained before the call (e.g.
+// `users := client.User`) keep using the previous driver. For example:
+//
+//	client.AddExtension(cache.NewExtension(cache.WithTTL(time.Minute)))
+//
+func (c *Client) AddExtension(exts ...ent.Extension) {
+	if len(exts) == 0 {
+		return
+	}
+	for _, ext := range exts {
+		if drv := ext.Driver(c.driver); drv != nil {
+			c.driver = drv
+		}
+		if c.readDriver != nil {
+			if drv := ext.Driver(c.readDriver); drv != nil {
+				c.readDriver = drv
+			}
+		}
+	}
+	c.extensions = append(c.extensions[:len(c.extensions):len(c.extensions)], exts...)
+	c.init()
+	for _, ext := range exts {
+		c.Use(ext.Hooks()...)
+		c.Intercept(ext.Interceptors()...)
+	}
+}
+
+// HealthCheck runs the health checks of the extensions that were added to the client,
+// and returns the first error that was reported.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	for _, ext := range c.extensions {
+		if err := ext.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("ent: extension %q: %w", ext.Name(), err)
+		}
+	}
+	return nil
+}
+
+// WithOptions returns a new client that is derived from c and configured with the given options.
+// Hooks that are registered on the new client using Use are not added to c (and vice versa). For
+// example, creating a client for trusted background jobs:
+//
+//	client.WithOptions(ent.ReplaceHooks(AuditHook()), ent.SkipPrivacy()).
+//		User.
+//		Delete().
+//		Exec(ctx)
+//
+func (c *Client) WithOptions(opts ...Option) *Client {
+	cfg := c.config
+	cfg.hooks = &hooks{
+		User: c.hooks.User[:len(c.hooks.User):len(c.hooks.User)],
+	}
+	cfg.inters = &inters{
+		User: c.inters.User[:len(c.inters.User):len(c.inters.User)],
+	}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -143,6 +224,12 @@ func (c *UserClient) Use(hooks ...Hook) {
 	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a builder for creating a User entity.
 func (c *UserClient) Create() *UserCreate {
 	mutation := newUserMutation(c.config, OpCreate)
@@ -195,12 +282,17 @@ func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
 func (c *UserClient) Query() *UserQuery {
 	return &UserQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a User entity by its id.
 func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+	node, err := c.Query().Where(user.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -212,6 +304,11 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -16,8 +16,9 @@ var (
 	// UsersColumns holds the columns for the "Users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "user_id", Type: field.TypeInt},
-		{Name: "name", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 128, Comment: "Name of the user.\nComment line1\nComment line2"},
 		{Name: "label", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Nullable: true, Default: "active"},
 	}
 	// UsersTable holds the schema information for the "Users" table.
 	UsersTable = &schema.Table{
@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/entc/integration/config/ent/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -38,6 +39,7 @@ type UserMutation struct {
 	id            *int
 	name          *string
 	label         *string
+	status        *string
 	clearedFields map[string]struct{}
 	done          bool
 	oldValue      func(context.Context) (*User, error)
@@ -151,6 +153,7 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 // SetName sets the "name" field.
 func (m *UserMutation) SetName(s string) {
 	m.name = &s
+	delete(m.clearedFields, user.FieldName)
 }
 
 // Name returns the value of the "name" field in the mutation.
@@ -200,6 +203,7 @@ func (m *UserMutation) ResetName() {
 // SetLabel sets the "label" field.
 func (m *UserMutation) SetLabel(s string) {
 	m.label = &s
+	delete(m.clearedFields, user.FieldLabel)
 }
 
 // Label returns the value of the "label" field in the mutation.
@@ -246,11 +250,71 @@ func (m *UserMutation) ResetLabel() {
 	delete(m.clearedFields, user.FieldLabel)
 }
 
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(s string) {
+	m.status = &s
+	delete(m.clearedFields, user.FieldStatus)
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *UserMutation) ClearStatus() {
+	m.status = nil
+	m.clearedFields[user.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *UserMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[user.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
+	delete(m.clearedFields, user.FieldStatus)
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -265,13 +329,16 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 3)
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
 	if m.label != nil {
 		fields = append(fields, user.FieldLabel)
 	}
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
+	}
 	return fields
 }
 
@@ -284,6 +351,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Name()
 	case user.FieldLabel:
 		return m.Label()
+	case user.FieldStatus:
+		return m.Status()
 	}
 	return nil, false
 }
@@ -297,6 +366,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldName(ctx)
 	case user.FieldLabel:
 		return m.OldLabel(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -320,6 +391,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetLabel(v)
 		return nil
+	case user.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -356,6 +434,9 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldLabel) {
 		fields = append(fields, user.FieldLabel)
 	}
+	if m.FieldCleared(user.FieldStatus) {
+		fields = append(fields, user.FieldStatus)
+	}
 	return fields
 }
 
@@ -376,6 +457,9 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldLabel:
 		m.ClearLabel()
 		return nil
+	case user.FieldStatus:
+		m.ClearStatus()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -390,6 +474,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldLabel:
 		m.ResetLabel()
 		return nil
+	case user.FieldStatus:
+		m.ResetStatus()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -49,6 +49,11 @@ Comment line1
 Comment line2`),
 		field.String("label").
 			Optional(),
+		field.String("status").
+			Optional().
+			Annotations(entsql.Annotation{
+				Default: "active",
+			}),
 	}
 }
 
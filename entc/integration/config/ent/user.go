@@ -25,6 +25,8 @@ type User struct {
 	Name string `json:"name,omitempty"`
 	// Label holds the value of the "label" field.
 	Label string `json:"label,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -34,7 +36,7 @@ func (*User) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldName, user.FieldLabel:
+		case user.FieldName, user.FieldLabel, user.FieldStatus:
 			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type User", columns[i])
@@ -69,6 +71,12 @@ func (u *User) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				u.Label = value.String
 			}
+		case user.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				u.Status = value.String
+			}
 		}
 	}
 	return nil
@@ -102,6 +110,9 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("label=")
 	builder.WriteString(u.Label)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(u.Status)
 	builder.WriteByte(')')
 	return builder.String()
 }
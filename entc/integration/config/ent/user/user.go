@@ -15,6 +15,8 @@ const (
 	FieldName = "name"
 	// FieldLabel holds the string denoting the label field in the database.
 	FieldLabel = "label"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// Table holds the table name of the user in the database.
 	Table = "Users"
 )
@@ -24,6 +26,7 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldLabel,
+	FieldStatus,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
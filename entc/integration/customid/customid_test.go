@@ -19,6 +19,7 @@ import (
 	"entgo.io/ent/entc/integration/customid/ent/blob"
 	"entgo.io/ent/entc/integration/customid/ent/doc"
 	"entgo.io/ent/entc/integration/customid/ent/intsid"
+	"entgo.io/ent/entc/integration/customid/ent/invoice"
 	"entgo.io/ent/entc/integration/customid/ent/pet"
 	"entgo.io/ent/entc/integration/customid/ent/token"
 	"entgo.io/ent/entc/integration/customid/ent/user"
@@ -54,6 +55,7 @@ func TestMySQL(t *testing.T) {
 			err = client.Schema.Create(context.Background(), schema.WithHooks(clearDefault, skipBytesID))
 			require.NoError(t, err)
 			CustomID(t, client)
+			CompositeID(t, client)
 		})
 	}
 }
@@ -79,6 +81,7 @@ func TestPostgres(t *testing.T) {
 			require.NoError(t, err)
 			CustomID(t, client)
 			BytesID(t, client)
+			CompositeID(t, client)
 		})
 	}
 }
@@ -90,6 +93,7 @@ func TestSQLite(t *testing.T) {
 	require.NoError(t, client.Schema.Create(context.Background(), schema.WithHooks(clearDefault)))
 	CustomID(t, client)
 	BytesID(t, client)
+	CompositeID(t, client)
 }
 
 func CustomID(t *testing.T, client *ent.Client) {
@@ -305,3 +309,32 @@ func expectOnePetsIndex(next schema.Differ) schema.Differ {
 		return changes, err
 	})
 }
+
+func CompositeID(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetID(100).SaveX(ctx)
+	client.Invoice.Create().SetTenant(1).SetNumber("A-1").SetTotal(10).SetOwner(a8m).ExecX(ctx)
+	client.Invoice.Create().SetTenant(2).SetNumber("A-1").SetTotal(20).ExecX(ctx)
+	err := client.Invoice.Create().SetTenant(1).SetNumber("A-1").Exec(ctx)
+	require.True(t, ent.IsConstraintError(err), "duplicate composite id")
+
+	inv := client.Invoice.GetX(ctx, 1, "A-1")
+	require.Equal(t, 10.0, inv.Total)
+	require.Equal(t, a8m.ID, inv.QueryOwner().OnlyIDX(ctx))
+	require.Equal(t, 1, a8m.QueryInvoices().CountX(ctx))
+	_, err = client.Invoice.Get(ctx, 3, "A-1")
+	require.True(t, ent.IsNotFound(err))
+
+	inv = client.Invoice.UpdateOneID(2, "A-1").AddTotal(5).SaveX(ctx)
+	require.Equal(t, 2, inv.Tenant)
+	require.Equal(t, 25.0, inv.Total)
+	require.Equal(t, 10.0, client.Invoice.GetX(ctx, 1, "A-1").Total, "other tenant was not updated")
+	inv = client.Invoice.UpdateOne(inv).SetOwner(a8m).SaveX(ctx)
+	require.Equal(t, 2, a8m.QueryInvoices().CountX(ctx))
+
+	client.Invoice.DeleteOne(inv).ExecX(ctx)
+	err = client.Invoice.DeleteOneID(2, "A-1").Exec(ctx)
+	require.True(t, ent.IsNotFound(err))
+	client.Invoice.DeleteOneID(1, "A-1").ExecX(ctx)
+	require.Zero(t, client.Invoice.Query().Where(invoice.Tenant(1)).CountX(ctx))
+}
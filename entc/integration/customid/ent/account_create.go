@@ -266,6 +266,7 @@ type (
 	//  one Account node.
 	AccountUpsertOne struct {
 		create *AccountCreate
+		err    error // first validation error of the update values.
 	}
 
 	// AccountUpsert is the "OnConflict" setter.
@@ -336,8 +337,12 @@ func (u *AccountUpsertOne) Update(set func(*AccountUpsert)) *AccountUpsertOne {
 	return u
 }
 
-// SetEmail sets the "email" field.
+// SetEmail sets the "email" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *AccountUpsertOne) SetEmail(v string) *AccountUpsertOne {
+	if err := account.EmailValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)}
+	}
 	return u.Update(func(s *AccountUpsert) {
 		s.SetEmail(v)
 	})
@@ -352,6 +357,9 @@ func (u *AccountUpsertOne) UpdateEmail() *AccountUpsertOne {
 
 // Exec executes the query.
 func (u *AccountUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for AccountCreate.OnConflict")
 	}
@@ -360,7 +368,7 @@ func (u *AccountUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *AccountUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -372,6 +380,9 @@ func (u *AccountUpsertOne) ID(ctx context.Context) (id sid.ID, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: AccountUpsertOne.ID is not supported by MySQL driver. Use AccountUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -511,6 +522,7 @@ func (acb *AccountCreateBulk) OnConflictColumns(columns ...string) *AccountUpser
 // a bulk of Account nodes.
 type AccountUpsertBulk struct {
 	create *AccountCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -566,8 +578,12 @@ func (u *AccountUpsertBulk) Update(set func(*AccountUpsert)) *AccountUpsertBulk
 	return u
 }
 
-// SetEmail sets the "email" field.
+// SetEmail sets the "email" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *AccountUpsertBulk) SetEmail(v string) *AccountUpsertBulk {
+	if err := account.EmailValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)}
+	}
 	return u.Update(func(s *AccountUpsert) {
 		s.SetEmail(v)
 	})
@@ -582,6 +598,9 @@ func (u *AccountUpsertBulk) UpdateEmail() *AccountUpsertBulk {
 
 // Exec executes the query.
 func (u *AccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccountCreateBulk instead", i)
@@ -595,7 +614,7 @@ func (u *AccountUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *AccountUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
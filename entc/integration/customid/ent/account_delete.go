@@ -107,7 +107,7 @@ func (ado *AccountDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{account.Label}
+		return &NotFoundError{label: account.Label}
 	default:
 		return nil
 	}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/account"
@@ -29,6 +30,7 @@ type AccountQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Account
 	withToken  *TokenQuery
 	// intermediate query (i.e. traversal path).
@@ -69,7 +71,7 @@ func (aq *AccountQuery) Order(o ...OrderFunc) *AccountQuery {
 
 // QueryToken chains the current query on the "token" edge.
 func (aq *AccountQuery) QueryToken() *TokenQuery {
-	query := &TokenQuery{config: aq.config}
+	query := (&TokenClient{config: aq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := aq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -97,7 +99,7 @@ func (aq *AccountQuery) First(ctx context.Context) (*Account, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{account.Label}
+		return nil, aq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -119,7 +121,7 @@ func (aq *AccountQuery) FirstID(ctx context.Context) (id sid.ID, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{account.Label}
+		err = aq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -146,7 +148,7 @@ func (aq *AccountQuery) Only(ctx context.Context) (*Account, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{account.Label}
+		return nil, aq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{account.Label}
 	}
@@ -173,7 +175,7 @@ func (aq *AccountQuery) OnlyID(ctx context.Context) (id sid.ID, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{account.Label}
+		err = aq.sqlNotFound()
 	default:
 		err = &NotSingularError{account.Label}
 	}
@@ -194,7 +196,17 @@ func (aq *AccountQuery) All(ctx context.Context) ([]*Account, error) {
 	if err := aq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return aq.sqlAll(ctx)
+	v, err := aq.intercept(ctx, "All", func(ctx context.Context, query *AccountQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Account)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Account", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -208,10 +220,20 @@ func (aq *AccountQuery) AllX(ctx context.Context) []*Account {
 
 // IDs executes the query and returns a list of Account IDs.
 func (aq *AccountQuery) IDs(ctx context.Context) ([]sid.ID, error) {
-	var ids []sid.ID
-	if err := aq.Select(account.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := aq.intercept(ctx, "IDs", func(ctx context.Context, query *AccountQuery) (Value, error) {
+		var ids []sid.ID
+		if err := query.Select(account.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]sid.ID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []sid.ID", v)
+	}
 	return ids, nil
 }
 
@@ -229,7 +251,17 @@ func (aq *AccountQuery) Count(ctx context.Context) (int, error) {
 	if err := aq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return aq.sqlCount(ctx)
+	v, err := aq.intercept(ctx, "Count", func(ctx context.Context, query *AccountQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -246,7 +278,54 @@ func (aq *AccountQuery) Exist(ctx context.Context) (bool, error) {
 	if err := aq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return aq.sqlExist(ctx)
+	v, err := aq.intercept(ctx, "Exist", func(ctx context.Context, query *AccountQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (aq *AccountQuery) intercept(ctx context.Context, op string, fn func(context.Context, *AccountQuery) (Value, error)) (Value, error) {
+	if len(aq.inters) == 0 {
+		return fn(ctx, aq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeAccount,
+		Op:     op,
+		Limit:  aq.limit,
+		Offset: aq.offset,
+		Unique: aq.unique,
+		Fields: aq.fields,
+	}
+	for _, p := range aq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range aq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if aq.withToken != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, account.EdgeToken)
+		qc.EdgeQueries[account.EdgeToken] = aq.withToken
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*AccountQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *AccountQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, aq, qc, qr, aq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -269,6 +348,7 @@ func (aq *AccountQuery) Clone() *AccountQuery {
 		limit:      aq.limit,
 		offset:     aq.offset,
 		order:      append([]OrderFunc{}, aq.order...),
+		inters:     append([]Interceptor{}, aq.inters...),
 		predicates: append([]predicate.Account{}, aq.predicates...),
 		withToken:  aq.withToken.Clone(),
 		// clone intermediate query.
@@ -281,7 +361,7 @@ func (aq *AccountQuery) Clone() *AccountQuery {
 // WithToken tells the query-builder to eager-load the nodes that are connected to
 // the "token" edge. The optional arguments are used to configure the query builder of the edge.
 func (aq *AccountQuery) WithToken(opts ...func(*TokenQuery)) *AccountQuery {
-	query := &TokenQuery{config: aq.config}
+	query := (&TokenClient{config: aq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -345,6 +425,16 @@ func (aq *AccountQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range aq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, aq); err != nil {
+				return err
+			}
+		}
+	}
 	if aq.path != nil {
 		prev, err := aq.path(ctx)
 		if err != nil {
@@ -375,7 +465,7 @@ func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, aq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, aq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -429,7 +519,7 @@ func (aq *AccountQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(aq.fields) > 0 {
 		_spec.Unique = aq.unique != nil && *aq.unique
 	}
-	return sqlgraph.CountNodes(ctx, aq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, aq.queryDriver(), _spec)
 }
 
 func (aq *AccountQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -520,6 +610,32 @@ func (aq *AccountQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (aq *AccountQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: account.Label}
+	if len(aq.predicates) > 0 {
+		selector := sql.Dialect(aq.driver.Dialect()).Select().From(sql.Table(account.Table))
+		for _, p := range aq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the AccountQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (aq *AccountQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Account, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Account(ps[i]))
+	}
+	aq.predicates = append(aq.predicates, wps...)
+}
+
 // AccountGroupBy is the group-by builder for Account entities.
 type AccountGroupBy struct {
 	config
@@ -559,7 +675,7 @@ func (agb *AccountGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := agb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := agb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -605,7 +721,7 @@ func (as *AccountSelect) Scan(ctx context.Context, v interface{}) error {
 func (as *AccountSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := as.sql.Query()
-	if err := as.driver.Query(ctx, query, args, rows); err != nil {
+	if err := as.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -22,9 +22,10 @@ import (
 // BlobCreate is the builder for creating a Blob entity.
 type BlobCreate struct {
 	config
-	mutation *BlobMutation
-	hooks    []Hook
-	conflict []sql.ConflictOption
+	mutation     *BlobMutation
+	hooks        []Hook
+	linksThrough func(*BlobLinkCreate)
+	conflict     []sql.ConflictOption
 }
 
 // SetUUID sets the "uuid" field.
@@ -103,6 +104,20 @@ func (bc *BlobCreate) AddLinks(b ...*Blob) *BlobCreate {
 	return bc.AddLinkIDs(ids...)
 }
 
+// SetLinksThrough sets a function that configures the BlobLink entities (i.e. the rows of the edge schema)
+// that are created for the "links" edges added by the builder. For example, for setting their fields:
+//
+//	SetLinksThrough(func(c *BlobLinkCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Blob entities are set by the builder,
+// and the hooks and validators of the BlobLink type are not executed on these entities.
+func (bc *BlobCreate) SetLinksThrough(fn func(*BlobLinkCreate)) *BlobCreate {
+	bc.linksThrough = fn
+	return bc
+}
+
 // Mutation returns the BlobMutation object of the builder.
 func (bc *BlobCreate) Mutation() *BlobMutation {
 	return bc.mutation
@@ -293,6 +308,11 @@ func (bc *BlobCreate) createSpec() (*Blob, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &BlobLinkCreate{config: bc.config, mutation: newBlobLinkMutation(bc.config, OpCreate)}
+		if bc.linksThrough != nil {
+			bc.linksThrough(createE)
+			createE.mutation.ResetBlobID()
+			createE.mutation.ResetLinkID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -344,6 +364,7 @@ type (
 	//  one Blob node.
 	BlobUpsertOne struct {
 		create *BlobCreate
+		err    error // first validation error of the update values.
 	}
 
 	// BlobUpsert is the "OnConflict" setter.
@@ -469,6 +490,9 @@ func (u *BlobUpsertOne) UpdateCount() *BlobUpsertOne {
 
 // Exec executes the query.
 func (u *BlobUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for BlobCreate.OnConflict")
 	}
@@ -477,7 +501,7 @@ func (u *BlobUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *BlobUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -489,6 +513,9 @@ func (u *BlobUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: BlobUpsertOne.ID is not supported by MySQL driver. Use BlobUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -628,6 +655,7 @@ func (bcb *BlobCreateBulk) OnConflictColumns(columns ...string) *BlobUpsertBulk
 // a bulk of Blob nodes.
 type BlobUpsertBulk struct {
 	create *BlobCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -720,6 +748,9 @@ func (u *BlobUpsertBulk) UpdateCount() *BlobUpsertBulk {
 
 // Exec executes the query.
 func (u *BlobUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BlobCreateBulk instead", i)
@@ -733,7 +764,7 @@ func (u *BlobUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *BlobUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
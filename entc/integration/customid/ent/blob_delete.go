@@ -107,7 +107,7 @@ func (bdo *BlobDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{blob.Label}
+		return &NotFoundError{label: blob.Label}
 	default:
 		return nil
 	}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/blob"
@@ -29,6 +30,7 @@ type BlobQuery struct {
 	unique        *bool
 	order         []OrderFunc
 	fields        []string
+	inters        []Interceptor
 	predicates    []predicate.Blob
 	withParent    *BlobQuery
 	withLinks     *BlobQuery
@@ -72,7 +74,7 @@ func (bq *BlobQuery) Order(o ...OrderFunc) *BlobQuery {
 
 // QueryParent chains the current query on the "parent" edge.
 func (bq *BlobQuery) QueryParent() *BlobQuery {
-	query := &BlobQuery{config: bq.config}
+	query := (&BlobClient{config: bq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := bq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -94,7 +96,7 @@ func (bq *BlobQuery) QueryParent() *BlobQuery {
 
 // QueryLinks chains the current query on the "links" edge.
 func (bq *BlobQuery) QueryLinks() *BlobQuery {
-	query := &BlobQuery{config: bq.config}
+	query := (&BlobClient{config: bq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := bq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -116,7 +118,7 @@ func (bq *BlobQuery) QueryLinks() *BlobQuery {
 
 // QueryBlobLinks chains the current query on the "blob_links" edge.
 func (bq *BlobQuery) QueryBlobLinks() *BlobLinkQuery {
-	query := &BlobLinkQuery{config: bq.config}
+	query := (&BlobLinkClient{config: bq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := bq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -144,7 +146,7 @@ func (bq *BlobQuery) First(ctx context.Context) (*Blob, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{blob.Label}
+		return nil, bq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -166,7 +168,7 @@ func (bq *BlobQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{blob.Label}
+		err = bq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -193,7 +195,7 @@ func (bq *BlobQuery) Only(ctx context.Context) (*Blob, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{blob.Label}
+		return nil, bq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{blob.Label}
 	}
@@ -220,7 +222,7 @@ func (bq *BlobQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{blob.Label}
+		err = bq.sqlNotFound()
 	default:
 		err = &NotSingularError{blob.Label}
 	}
@@ -241,7 +243,17 @@ func (bq *BlobQuery) All(ctx context.Context) ([]*Blob, error) {
 	if err := bq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return bq.sqlAll(ctx)
+	v, err := bq.intercept(ctx, "All", func(ctx context.Context, query *BlobQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Blob)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Blob", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -255,10 +267,20 @@ func (bq *BlobQuery) AllX(ctx context.Context) []*Blob {
 
 // IDs executes the query and returns a list of Blob IDs.
 func (bq *BlobQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	var ids []uuid.UUID
-	if err := bq.Select(blob.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := bq.intercept(ctx, "IDs", func(ctx context.Context, query *BlobQuery) (Value, error) {
+		var ids []uuid.UUID
+		if err := query.Select(blob.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]uuid.UUID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []uuid.UUID", v)
+	}
 	return ids, nil
 }
 
@@ -276,7 +298,17 @@ func (bq *BlobQuery) Count(ctx context.Context) (int, error) {
 	if err := bq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return bq.sqlCount(ctx)
+	v, err := bq.intercept(ctx, "Count", func(ctx context.Context, query *BlobQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -293,7 +325,68 @@ func (bq *BlobQuery) Exist(ctx context.Context) (bool, error) {
 	if err := bq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return bq.sqlExist(ctx)
+	v, err := bq.intercept(ctx, "Exist", func(ctx context.Context, query *BlobQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (bq *BlobQuery) intercept(ctx context.Context, op string, fn func(context.Context, *BlobQuery) (Value, error)) (Value, error) {
+	if len(bq.inters) == 0 {
+		return fn(ctx, bq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeBlob,
+		Op:     op,
+		Limit:  bq.limit,
+		Offset: bq.offset,
+		Unique: bq.unique,
+		Fields: bq.fields,
+	}
+	for _, p := range bq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range bq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if bq.withParent != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, blob.EdgeParent)
+		qc.EdgeQueries[blob.EdgeParent] = bq.withParent
+	}
+	if bq.withLinks != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, blob.EdgeLinks)
+		qc.EdgeQueries[blob.EdgeLinks] = bq.withLinks
+	}
+	if bq.withBlobLinks != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, blob.EdgeBlobLinks)
+		qc.EdgeQueries[blob.EdgeBlobLinks] = bq.withBlobLinks
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*BlobQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *BlobQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, bq, qc, qr, bq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -316,6 +409,7 @@ func (bq *BlobQuery) Clone() *BlobQuery {
 		limit:         bq.limit,
 		offset:        bq.offset,
 		order:         append([]OrderFunc{}, bq.order...),
+		inters:        append([]Interceptor{}, bq.inters...),
 		predicates:    append([]predicate.Blob{}, bq.predicates...),
 		withParent:    bq.withParent.Clone(),
 		withLinks:     bq.withLinks.Clone(),
@@ -330,7 +424,7 @@ func (bq *BlobQuery) Clone() *BlobQuery {
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (bq *BlobQuery) WithParent(opts ...func(*BlobQuery)) *BlobQuery {
-	query := &BlobQuery{config: bq.config}
+	query := (&BlobClient{config: bq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -341,7 +435,7 @@ func (bq *BlobQuery) WithParent(opts ...func(*BlobQuery)) *BlobQuery {
 // WithLinks tells the query-builder to eager-load the nodes that are connected to
 // the "links" edge. The optional arguments are used to configure the query builder of the edge.
 func (bq *BlobQuery) WithLinks(opts ...func(*BlobQuery)) *BlobQuery {
-	query := &BlobQuery{config: bq.config}
+	query := (&BlobClient{config: bq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -352,7 +446,7 @@ func (bq *BlobQuery) WithLinks(opts ...func(*BlobQuery)) *BlobQuery {
 // WithBlobLinks tells the query-builder to eager-load the nodes that are connected to
 // the "blob_links" edge. The optional arguments are used to configure the query builder of the edge.
 func (bq *BlobQuery) WithBlobLinks(opts ...func(*BlobLinkQuery)) *BlobQuery {
-	query := &BlobLinkQuery{config: bq.config}
+	query := (&BlobLinkClient{config: bq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -416,6 +510,16 @@ func (bq *BlobQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range bq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, bq); err != nil {
+				return err
+			}
+		}
+	}
 	if bq.path != nil {
 		prev, err := bq.path(ctx)
 		if err != nil {
@@ -455,7 +559,7 @@ func (bq *BlobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blob, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, bq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -602,7 +706,7 @@ func (bq *BlobQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(bq.fields) > 0 {
 		_spec.Unique = bq.unique != nil && *bq.unique
 	}
-	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, bq.queryDriver(), _spec)
 }
 
 func (bq *BlobQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -693,6 +797,32 @@ func (bq *BlobQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (bq *BlobQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: blob.Label}
+	if len(bq.predicates) > 0 {
+		selector := sql.Dialect(bq.driver.Dialect()).Select().From(sql.Table(blob.Table))
+		for _, p := range bq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the BlobQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (bq *BlobQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Blob, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Blob(ps[i]))
+	}
+	bq.predicates = append(bq.predicates, wps...)
+}
+
 // BlobGroupBy is the group-by builder for Blob entities.
 type BlobGroupBy struct {
 	config
@@ -732,7 +862,7 @@ func (bgb *BlobGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := bgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := bgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -778,7 +908,7 @@ func (bs *BlobSelect) Scan(ctx context.Context, v interface{}) error {
 func (bs *BlobSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := bs.sql.Query()
-	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := bs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
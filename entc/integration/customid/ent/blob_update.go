@@ -22,8 +22,9 @@ import (
 // BlobUpdate is the builder for updating Blob entities.
 type BlobUpdate struct {
 	config
-	hooks    []Hook
-	mutation *BlobMutation
+	hooks        []Hook
+	mutation     *BlobMutation
+	linksThrough func(*BlobLinkCreate)
 }
 
 // Where appends a list predicates to the BlobUpdate builder.
@@ -67,6 +68,25 @@ func (bu *BlobUpdate) AddCount(i int) *BlobUpdate {
 	return bu
 }
 
+// SetCountIfGreater sets the "count" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (bu *BlobUpdate) SetCountIfGreater(i int) *BlobUpdate {
+	bu.mutation.SetCountIfGreater(i)
+	return bu
+}
+
+// BitAndCount applies a bitwise AND with i on the "count" field in the database.
+func (bu *BlobUpdate) BitAndCount(i int) *BlobUpdate {
+	bu.mutation.BitAndCount(i)
+	return bu
+}
+
+// BitOrCount applies a bitwise OR with i on the "count" field in the database.
+func (bu *BlobUpdate) BitOrCount(i int) *BlobUpdate {
+	bu.mutation.BitOrCount(i)
+	return bu
+}
+
 // SetParentID sets the "parent" edge to the Blob entity by ID.
 func (bu *BlobUpdate) SetParentID(id uuid.UUID) *BlobUpdate {
 	bu.mutation.SetParentID(id)
@@ -101,6 +121,20 @@ func (bu *BlobUpdate) AddLinks(b ...*Blob) *BlobUpdate {
 	return bu.AddLinkIDs(ids...)
 }
 
+// SetLinksThrough sets a function that configures the BlobLink entities (i.e. the rows of the edge schema)
+// that are created for the "links" edges added by the builder. For example, for setting their fields:
+//
+//	SetLinksThrough(func(c *BlobLinkCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Blob entities are set by the builder,
+// and the hooks and validators of the BlobLink type are not executed on these entities.
+func (bu *BlobUpdate) SetLinksThrough(fn func(*BlobLinkCreate)) *BlobUpdate {
+	bu.linksThrough = fn
+	return bu
+}
+
 // Mutation returns the BlobMutation object of the builder.
 func (bu *BlobUpdate) Mutation() *BlobMutation {
 	return bu.mutation
@@ -226,6 +260,27 @@ func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: blob.FieldCount,
 		})
 	}
+	if value, ok := bu.mutation.CountIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldCount,
+		})
+	}
+	if value, ok := bu.mutation.CountBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldCount,
+		})
+	}
+	if value, ok := bu.mutation.CountBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldCount,
+		})
+	}
 	if bu.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -322,6 +377,11 @@ func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &BlobLinkCreate{config: bu.config, mutation: newBlobLinkMutation(bu.config, OpCreate)}
+		if bu.linksThrough != nil {
+			bu.linksThrough(createE)
+			createE.mutation.ResetBlobID()
+			createE.mutation.ResetLinkID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -329,7 +389,7 @@ func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{blob.Label}
+			err = &NotFoundError{label: blob.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -341,9 +401,19 @@ func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // BlobUpdateOne is the builder for updating a single Blob entity.
 type BlobUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *BlobMutation
+	fields       []string
+	conditions   []predicate.Blob
+	hooks        []Hook
+	mutation     *BlobMutation
+	linksThrough func(*BlobLinkCreate)
+}
+
+// Where appends a list of conditions to the BlobUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (buo *BlobUpdateOne) Where(ps ...predicate.Blob) *BlobUpdateOne {
+	buo.conditions = append(buo.conditions, ps...)
+	return buo
 }
 
 // SetUUID sets the "uuid" field.
@@ -381,6 +451,25 @@ func (buo *BlobUpdateOne) AddCount(i int) *BlobUpdateOne {
 	return buo
 }
 
+// SetCountIfGreater sets the "count" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (buo *BlobUpdateOne) SetCountIfGreater(i int) *BlobUpdateOne {
+	buo.mutation.SetCountIfGreater(i)
+	return buo
+}
+
+// BitAndCount applies a bitwise AND with i on the "count" field in the database.
+func (buo *BlobUpdateOne) BitAndCount(i int) *BlobUpdateOne {
+	buo.mutation.BitAndCount(i)
+	return buo
+}
+
+// BitOrCount applies a bitwise OR with i on the "count" field in the database.
+func (buo *BlobUpdateOne) BitOrCount(i int) *BlobUpdateOne {
+	buo.mutation.BitOrCount(i)
+	return buo
+}
+
 // SetParentID sets the "parent" edge to the Blob entity by ID.
 func (buo *BlobUpdateOne) SetParentID(id uuid.UUID) *BlobUpdateOne {
 	buo.mutation.SetParentID(id)
@@ -415,6 +504,20 @@ func (buo *BlobUpdateOne) AddLinks(b ...*Blob) *BlobUpdateOne {
 	return buo.AddLinkIDs(ids...)
 }
 
+// SetLinksThrough sets a function that configures the BlobLink entities (i.e. the rows of the edge schema)
+// that are created for the "links" edges added by the builder. For example, for setting their fields:
+//
+//	SetLinksThrough(func(c *BlobLinkCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Blob entities are set by the builder,
+// and the hooks and validators of the BlobLink type are not executed on these entities.
+func (buo *BlobUpdateOne) SetLinksThrough(fn func(*BlobLinkCreate)) *BlobUpdateOne {
+	buo.linksThrough = fn
+	return buo
+}
+
 // Mutation returns the BlobMutation object of the builder.
 func (buo *BlobUpdateOne) Mutation() *BlobMutation {
 	return buo.mutation
@@ -549,6 +652,13 @@ func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error)
 			}
 		}
 	}
+	if ps := buo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := buo.mutation.UUID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeUUID,
@@ -570,6 +680,27 @@ func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error)
 			Column: blob.FieldCount,
 		})
 	}
+	if value, ok := buo.mutation.CountIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldCount,
+		})
+	}
+	if value, ok := buo.mutation.CountBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldCount,
+		})
+	}
+	if value, ok := buo.mutation.CountBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldCount,
+		})
+	}
 	if buo.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -666,6 +797,11 @@ func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error)
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &BlobLinkCreate{config: buo.config, mutation: newBlobLinkMutation(buo.config, OpCreate)}
+		if buo.linksThrough != nil {
+			buo.linksThrough(createE)
+			createE.mutation.ResetBlobID()
+			createE.mutation.ResetLinkID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -676,7 +812,9 @@ func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{blob.Label}
+			err = &NotFoundError{label: blob.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: blob.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -140,7 +140,7 @@ func (blc *BlobLinkCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (blc *BlobLinkCreate) defaults() {
 	if _, ok := blc.mutation.CreatedAt(); !ok {
-		v := bloblink.DefaultCreatedAt()
+		v := blc.config.now(bloblink.DefaultCreatedAt)
 		blc.mutation.SetCreatedAt(v)
 	}
 }
@@ -278,6 +278,7 @@ type (
 	//  one BlobLink node.
 	BlobLinkUpsertOne struct {
 		create *BlobLinkCreate
+		err    error // first validation error of the update values.
 	}
 
 	// BlobLinkUpsert is the "OnConflict" setter.
@@ -408,6 +409,9 @@ func (u *BlobLinkUpsertOne) UpdateLinkID() *BlobLinkUpsertOne {
 
 // Exec executes the query.
 func (u *BlobLinkUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for BlobLinkCreate.OnConflict")
 	}
@@ -416,7 +420,7 @@ func (u *BlobLinkUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *BlobLinkUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -543,6 +547,7 @@ func (blcb *BlobLinkCreateBulk) OnConflictColumns(columns ...string) *BlobLinkUp
 // a bulk of BlobLink nodes.
 type BlobLinkUpsertBulk struct {
 	create *BlobLinkCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -631,6 +636,9 @@ func (u *BlobLinkUpsertBulk) UpdateLinkID() *BlobLinkUpsertBulk {
 
 // Exec executes the query.
 func (u *BlobLinkUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BlobLinkCreateBulk instead", i)
@@ -644,7 +652,7 @@ func (u *BlobLinkUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *BlobLinkUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -102,7 +102,7 @@ func (bldo *BlobLinkDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{bloblink.Label}
+		return &NotFoundError{label: bloblink.Label}
 	default:
 		return nil
 	}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/blob"
@@ -27,6 +28,7 @@ type BlobLinkQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.BlobLink
 	withBlob   *BlobQuery
 	withLink   *BlobQuery
@@ -68,7 +70,7 @@ func (blq *BlobLinkQuery) Order(o ...OrderFunc) *BlobLinkQuery {
 
 // QueryBlob chains the current query on the "blob" edge.
 func (blq *BlobLinkQuery) QueryBlob() *BlobQuery {
-	query := &BlobQuery{config: blq.config}
+	query := (&BlobClient{config: blq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := blq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -90,7 +92,7 @@ func (blq *BlobLinkQuery) QueryBlob() *BlobQuery {
 
 // QueryLink chains the current query on the "link" edge.
 func (blq *BlobLinkQuery) QueryLink() *BlobQuery {
-	query := &BlobQuery{config: blq.config}
+	query := (&BlobClient{config: blq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := blq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -118,7 +120,7 @@ func (blq *BlobLinkQuery) First(ctx context.Context) (*BlobLink, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{bloblink.Label}
+		return nil, blq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -144,7 +146,7 @@ func (blq *BlobLinkQuery) Only(ctx context.Context) (*BlobLink, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{bloblink.Label}
+		return nil, blq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{bloblink.Label}
 	}
@@ -164,7 +166,17 @@ func (blq *BlobLinkQuery) All(ctx context.Context) ([]*BlobLink, error) {
 	if err := blq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return blq.sqlAll(ctx)
+	v, err := blq.intercept(ctx, "All", func(ctx context.Context, query *BlobLinkQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*BlobLink)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*BlobLink", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -181,7 +193,17 @@ func (blq *BlobLinkQuery) Count(ctx context.Context) (int, error) {
 	if err := blq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return blq.sqlCount(ctx)
+	v, err := blq.intercept(ctx, "Count", func(ctx context.Context, query *BlobLinkQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -198,7 +220,61 @@ func (blq *BlobLinkQuery) Exist(ctx context.Context) (bool, error) {
 	if err := blq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return blq.sqlExist(ctx)
+	v, err := blq.intercept(ctx, "Exist", func(ctx context.Context, query *BlobLinkQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (blq *BlobLinkQuery) intercept(ctx context.Context, op string, fn func(context.Context, *BlobLinkQuery) (Value, error)) (Value, error) {
+	if len(blq.inters) == 0 {
+		return fn(ctx, blq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeBlobLink,
+		Op:     op,
+		Limit:  blq.limit,
+		Offset: blq.offset,
+		Unique: blq.unique,
+		Fields: blq.fields,
+	}
+	for _, p := range blq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range blq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if blq.withBlob != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, bloblink.EdgeBlob)
+		qc.EdgeQueries[bloblink.EdgeBlob] = blq.withBlob
+	}
+	if blq.withLink != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, bloblink.EdgeLink)
+		qc.EdgeQueries[bloblink.EdgeLink] = blq.withLink
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*BlobLinkQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *BlobLinkQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, blq, qc, qr, blq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -221,6 +297,7 @@ func (blq *BlobLinkQuery) Clone() *BlobLinkQuery {
 		limit:      blq.limit,
 		offset:     blq.offset,
 		order:      append([]OrderFunc{}, blq.order...),
+		inters:     append([]Interceptor{}, blq.inters...),
 		predicates: append([]predicate.BlobLink{}, blq.predicates...),
 		withBlob:   blq.withBlob.Clone(),
 		withLink:   blq.withLink.Clone(),
@@ -234,7 +311,7 @@ func (blq *BlobLinkQuery) Clone() *BlobLinkQuery {
 // WithBlob tells the query-builder to eager-load the nodes that are connected to
 // the "blob" edge. The optional arguments are used to configure the query builder of the edge.
 func (blq *BlobLinkQuery) WithBlob(opts ...func(*BlobQuery)) *BlobLinkQuery {
-	query := &BlobQuery{config: blq.config}
+	query := (&BlobClient{config: blq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -245,7 +322,7 @@ func (blq *BlobLinkQuery) WithBlob(opts ...func(*BlobQuery)) *BlobLinkQuery {
 // WithLink tells the query-builder to eager-load the nodes that are connected to
 // the "link" edge. The optional arguments are used to configure the query builder of the edge.
 func (blq *BlobLinkQuery) WithLink(opts ...func(*BlobQuery)) *BlobLinkQuery {
-	query := &BlobQuery{config: blq.config}
+	query := (&BlobClient{config: blq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -309,6 +386,16 @@ func (blq *BlobLinkQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range blq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, blq); err != nil {
+				return err
+			}
+		}
+	}
 	if blq.path != nil {
 		prev, err := blq.path(ctx)
 		if err != nil {
@@ -340,7 +427,7 @@ func (blq *BlobLinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Bl
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, blq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, blq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -418,7 +505,7 @@ func (blq *BlobLinkQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := blq.querySpec()
 	_spec.Unique = false
 	_spec.Node.Columns = nil
-	return sqlgraph.CountNodes(ctx, blq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, blq.queryDriver(), _spec)
 }
 
 func (blq *BlobLinkQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -502,6 +589,32 @@ func (blq *BlobLinkQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (blq *BlobLinkQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: bloblink.Label}
+	if len(blq.predicates) > 0 {
+		selector := sql.Dialect(blq.driver.Dialect()).Select().From(sql.Table(bloblink.Table))
+		for _, p := range blq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the BlobLinkQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (blq *BlobLinkQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.BlobLink, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.BlobLink(ps[i]))
+	}
+	blq.predicates = append(blq.predicates, wps...)
+}
+
 // BlobLinkGroupBy is the group-by builder for BlobLink entities.
 type BlobLinkGroupBy struct {
 	config
@@ -541,7 +654,7 @@ func (blgb *BlobLinkGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := blgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := blgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -587,7 +700,7 @@ func (bls *BlobLinkSelect) Scan(ctx context.Context, v interface{}) error {
 func (bls *BlobLinkSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := bls.sql.Query()
-	if err := bls.driver.Query(ctx, query, args, rows); err != nil {
+	if err := bls.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
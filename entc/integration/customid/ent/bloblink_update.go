@@ -261,7 +261,7 @@ func (blu *BlobLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, blu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{bloblink.Label}
+			err = &NotFoundError{label: bloblink.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -273,9 +273,18 @@ func (blu *BlobLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // BlobLinkUpdateOne is the builder for updating a single BlobLink entity.
 type BlobLinkUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *BlobLinkMutation
+	fields     []string
+	conditions []predicate.BlobLink
+	hooks      []Hook
+	mutation   *BlobLinkMutation
+}
+
+// Where appends a list of conditions to the BlobLinkUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (bluo *BlobLinkUpdateOne) Where(ps ...predicate.BlobLink) *BlobLinkUpdateOne {
+	bluo.conditions = append(bluo.conditions, ps...)
+	return bluo
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -458,6 +467,13 @@ func (bluo *BlobLinkUpdateOne) sqlSave(ctx context.Context) (_node *BlobLink, er
 			}
 		}
 	}
+	if ps := bluo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := bluo.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -540,7 +556,9 @@ func (bluo *BlobLinkUpdateOne) sqlSave(ctx context.Context) (_node *BlobLink, er
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, bluo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{bloblink.Label}
+			err = &NotFoundError{label: bloblink.Label}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: bloblink.Label, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
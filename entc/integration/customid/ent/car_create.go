@@ -303,6 +303,7 @@ type (
 	//  one Car node.
 	CarUpsertOne struct {
 		create *CarCreate
+		err    error // first validation error of the update values.
 	}
 
 	// CarUpsert is the "OnConflict" setter.
@@ -421,8 +422,12 @@ func (u *CarUpsertOne) Update(set func(*CarUpsert)) *CarUpsertOne {
 	return u
 }
 
-// SetBeforeID sets the "before_id" field.
+// SetBeforeID sets the "before_id" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *CarUpsertOne) SetBeforeID(v float64) *CarUpsertOne {
+	if err := car.BeforeIDValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "before_id", err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)}
+	}
 	return u.Update(func(s *CarUpsert) {
 		s.SetBeforeID(v)
 	})
@@ -449,8 +454,12 @@ func (u *CarUpsertOne) ClearBeforeID() *CarUpsertOne {
 	})
 }
 
-// SetAfterID sets the "after_id" field.
+// SetAfterID sets the "after_id" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *CarUpsertOne) SetAfterID(v float64) *CarUpsertOne {
+	if err := car.AfterIDValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "after_id", err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)}
+	}
 	return u.Update(func(s *CarUpsert) {
 		s.SetAfterID(v)
 	})
@@ -493,6 +502,9 @@ func (u *CarUpsertOne) UpdateModel() *CarUpsertOne {
 
 // Exec executes the query.
 func (u *CarUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for CarCreate.OnConflict")
 	}
@@ -501,13 +513,16 @@ func (u *CarUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *CarUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *CarUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -650,6 +665,7 @@ func (ccb *CarCreateBulk) OnConflictColumns(columns ...string) *CarUpsertBulk {
 // a bulk of Car nodes.
 type CarUpsertBulk struct {
 	create *CarCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -705,8 +721,12 @@ func (u *CarUpsertBulk) Update(set func(*CarUpsert)) *CarUpsertBulk {
 	return u
 }
 
-// SetBeforeID sets the "before_id" field.
+// SetBeforeID sets the "before_id" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *CarUpsertBulk) SetBeforeID(v float64) *CarUpsertBulk {
+	if err := car.BeforeIDValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "before_id", err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)}
+	}
 	return u.Update(func(s *CarUpsert) {
 		s.SetBeforeID(v)
 	})
@@ -733,8 +753,12 @@ func (u *CarUpsertBulk) ClearBeforeID() *CarUpsertBulk {
 	})
 }
 
-// SetAfterID sets the "after_id" field.
+// SetAfterID sets the "after_id" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *CarUpsertBulk) SetAfterID(v float64) *CarUpsertBulk {
+	if err := car.AfterIDValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "after_id", err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)}
+	}
 	return u.Update(func(s *CarUpsert) {
 		s.SetAfterID(v)
 	})
@@ -777,6 +801,9 @@ func (u *CarUpsertBulk) UpdateModel() *CarUpsertBulk {
 
 // Exec executes the query.
 func (u *CarUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CarCreateBulk instead", i)
@@ -790,7 +817,7 @@ func (u *CarUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *CarUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -107,7 +107,7 @@ func (cdo *CarDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{car.Label}
+		return &NotFoundError{label: car.Label}
 	default:
 		return nil
 	}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/car"
@@ -27,6 +28,7 @@ type CarQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Car
 	withOwner  *PetQuery
 	withFKs    bool
@@ -68,7 +70,7 @@ func (cq *CarQuery) Order(o ...OrderFunc) *CarQuery {
 
 // QueryOwner chains the current query on the "owner" edge.
 func (cq *CarQuery) QueryOwner() *PetQuery {
-	query := &PetQuery{config: cq.config}
+	query := (&PetClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -96,7 +98,7 @@ func (cq *CarQuery) First(ctx context.Context) (*Car, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{car.Label}
+		return nil, cq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -118,7 +120,7 @@ func (cq *CarQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{car.Label}
+		err = cq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -145,7 +147,7 @@ func (cq *CarQuery) Only(ctx context.Context) (*Car, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{car.Label}
+		return nil, cq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{car.Label}
 	}
@@ -172,7 +174,7 @@ func (cq *CarQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{car.Label}
+		err = cq.sqlNotFound()
 	default:
 		err = &NotSingularError{car.Label}
 	}
@@ -193,7 +195,17 @@ func (cq *CarQuery) All(ctx context.Context) ([]*Car, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.sqlAll(ctx)
+	v, err := cq.intercept(ctx, "All", func(ctx context.Context, query *CarQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Car)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Car", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -207,10 +219,20 @@ func (cq *CarQuery) AllX(ctx context.Context) []*Car {
 
 // IDs executes the query and returns a list of Car IDs.
 func (cq *CarQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := cq.Select(car.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := cq.intercept(ctx, "IDs", func(ctx context.Context, query *CarQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(car.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -228,7 +250,17 @@ func (cq *CarQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.sqlCount(ctx)
+	v, err := cq.intercept(ctx, "Count", func(ctx context.Context, query *CarQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -245,7 +277,54 @@ func (cq *CarQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.sqlExist(ctx)
+	v, err := cq.intercept(ctx, "Exist", func(ctx context.Context, query *CarQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (cq *CarQuery) intercept(ctx context.Context, op string, fn func(context.Context, *CarQuery) (Value, error)) (Value, error) {
+	if len(cq.inters) == 0 {
+		return fn(ctx, cq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeCar,
+		Op:     op,
+		Limit:  cq.limit,
+		Offset: cq.offset,
+		Unique: cq.unique,
+		Fields: cq.fields,
+	}
+	for _, p := range cq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range cq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if cq.withOwner != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, car.EdgeOwner)
+		qc.EdgeQueries[car.EdgeOwner] = cq.withOwner
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CarQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *CarQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, cq, qc, qr, cq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -268,6 +347,7 @@ func (cq *CarQuery) Clone() *CarQuery {
 		limit:      cq.limit,
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		predicates: append([]predicate.Car{}, cq.predicates...),
 		withOwner:  cq.withOwner.Clone(),
 		// clone intermediate query.
@@ -280,7 +360,7 @@ func (cq *CarQuery) Clone() *CarQuery {
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CarQuery) WithOwner(opts ...func(*PetQuery)) *CarQuery {
-	query := &PetQuery{config: cq.config}
+	query := (&PetClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -344,6 +424,16 @@ func (cq *CarQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range cq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cq); err != nil {
+				return err
+			}
+		}
+	}
 	if cq.path != nil {
 		prev, err := cq.path(ctx)
 		if err != nil {
@@ -381,7 +471,7 @@ func (cq *CarQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Car, err
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, cq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -432,7 +522,7 @@ func (cq *CarQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(cq.fields) > 0 {
 		_spec.Unique = cq.unique != nil && *cq.unique
 	}
-	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, cq.queryDriver(), _spec)
 }
 
 func (cq *CarQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -523,6 +613,32 @@ func (cq *CarQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (cq *CarQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: car.Label}
+	if len(cq.predicates) > 0 {
+		selector := sql.Dialect(cq.driver.Dialect()).Select().From(sql.Table(car.Table))
+		for _, p := range cq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the CarQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (cq *CarQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Car, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Car(ps[i]))
+	}
+	cq.predicates = append(cq.predicates, wps...)
+}
+
 // CarGroupBy is the group-by builder for Car entities.
 type CarGroupBy struct {
 	config
@@ -562,7 +678,7 @@ func (cgb *CarGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := cgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := cgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -608,7 +724,7 @@ func (cs *CarSelect) Scan(ctx context.Context, v interface{}) error {
 func (cs *CarSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := cs.sql.Query()
-	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := cs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
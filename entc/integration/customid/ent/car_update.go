@@ -53,6 +53,13 @@ func (cu *CarUpdate) AddBeforeID(f float64) *CarUpdate {
 	return cu
 }
 
+// SetBeforeIDIfGreater sets the "before_id" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *CarUpdate) SetBeforeIDIfGreater(f float64) *CarUpdate {
+	cu.mutation.SetBeforeIDIfGreater(f)
+	return cu
+}
+
 // ClearBeforeID clears the value of the "before_id" field.
 func (cu *CarUpdate) ClearBeforeID() *CarUpdate {
 	cu.mutation.ClearBeforeID()
@@ -80,6 +87,13 @@ func (cu *CarUpdate) AddAfterID(f float64) *CarUpdate {
 	return cu
 }
 
+// SetAfterIDIfGreater sets the "after_id" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *CarUpdate) SetAfterIDIfGreater(f float64) *CarUpdate {
+	cu.mutation.SetAfterIDIfGreater(f)
+	return cu
+}
+
 // ClearAfterID clears the value of the "after_id" field.
 func (cu *CarUpdate) ClearAfterID() *CarUpdate {
 	cu.mutation.ClearAfterID()
@@ -229,6 +243,13 @@ func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: car.FieldBeforeID,
 		})
 	}
+	if value, ok := cu.mutation.BeforeIDIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBeforeID,
+		})
+	}
 	if cu.mutation.BeforeIDCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -249,6 +270,13 @@ func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: car.FieldAfterID,
 		})
 	}
+	if value, ok := cu.mutation.AfterIDIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldAfterID,
+		})
+	}
 	if cu.mutation.AfterIDCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -299,7 +327,7 @@ func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{car.Label}
+			err = &NotFoundError{label: car.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -311,9 +339,18 @@ func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // CarUpdateOne is the builder for updating a single Car entity.
 type CarUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CarMutation
+	fields     []string
+	conditions []predicate.Car
+	hooks      []Hook
+	mutation   *CarMutation
+}
+
+// Where appends a list of conditions to the CarUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (cuo *CarUpdateOne) Where(ps ...predicate.Car) *CarUpdateOne {
+	cuo.conditions = append(cuo.conditions, ps...)
+	return cuo
 }
 
 // SetBeforeID sets the "before_id" field.
@@ -337,6 +374,13 @@ func (cuo *CarUpdateOne) AddBeforeID(f float64) *CarUpdateOne {
 	return cuo
 }
 
+// SetBeforeIDIfGreater sets the "before_id" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *CarUpdateOne) SetBeforeIDIfGreater(f float64) *CarUpdateOne {
+	cuo.mutation.SetBeforeIDIfGreater(f)
+	return cuo
+}
+
 // ClearBeforeID clears the value of the "before_id" field.
 func (cuo *CarUpdateOne) ClearBeforeID() *CarUpdateOne {
 	cuo.mutation.ClearBeforeID()
@@ -364,6 +408,13 @@ func (cuo *CarUpdateOne) AddAfterID(f float64) *CarUpdateOne {
 	return cuo
 }
 
+// SetAfterIDIfGreater sets the "after_id" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *CarUpdateOne) SetAfterIDIfGreater(f float64) *CarUpdateOne {
+	cuo.mutation.SetAfterIDIfGreater(f)
+	return cuo
+}
+
 // ClearAfterID clears the value of the "after_id" field.
 func (cuo *CarUpdateOne) ClearAfterID() *CarUpdateOne {
 	cuo.mutation.ClearAfterID()
@@ -529,6 +580,13 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
 			}
 		}
 	}
+	if ps := cuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := cuo.mutation.BeforeID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -543,6 +601,13 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
 			Column: car.FieldBeforeID,
 		})
 	}
+	if value, ok := cuo.mutation.BeforeIDIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBeforeID,
+		})
+	}
 	if cuo.mutation.BeforeIDCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -563,6 +628,13 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
 			Column: car.FieldAfterID,
 		})
 	}
+	if value, ok := cuo.mutation.AfterIDIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldAfterID,
+		})
+	}
 	if cuo.mutation.AfterIDCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -616,7 +688,9 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{car.Label}
+			err = &NotFoundError{label: car.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: car.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -25,6 +25,7 @@ import (
 	"entgo.io/ent/entc/integration/customid/ent/doc"
 	"entgo.io/ent/entc/integration/customid/ent/group"
 	"entgo.io/ent/entc/integration/customid/ent/intsid"
+	"entgo.io/ent/entc/integration/customid/ent/invoice"
 	"entgo.io/ent/entc/integration/customid/ent/mixinid"
 	"entgo.io/ent/entc/integration/customid/ent/note"
 	"entgo.io/ent/entc/integration/customid/ent/other"
@@ -34,6 +35,7 @@ import (
 	"entgo.io/ent/entc/integration/customid/ent/token"
 	"entgo.io/ent/entc/integration/customid/ent/user"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -60,6 +62,8 @@ type Client struct {
 	Group *GroupClient
 	// IntSID is the client for interacting with the IntSID builders.
 	IntSID *IntSIDClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
 	// MixinID is the client for interacting with the MixinID builders.
 	MixinID *MixinIDClient
 	// Note is the client for interacting with the Note builders.
@@ -80,10 +84,11 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
+	client.AddExtension(ent.RegisteredExtensions()...)
 	return client
 }
 
@@ -97,6 +102,7 @@ func (c *Client) init() {
 	c.Doc = NewDocClient(c.config)
 	c.Group = NewGroupClient(c.config)
 	c.IntSID = NewIntSIDClient(c.config)
+	c.Invoice = NewInvoiceClient(c.config)
 	c.MixinID = NewMixinIDClient(c.config)
 	c.Note = NewNoteClient(c.config)
 	c.Other = NewOtherClient(c.config)
@@ -146,6 +152,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Doc:      NewDocClient(cfg),
 		Group:    NewGroupClient(cfg),
 		IntSID:   NewIntSIDClient(cfg),
+		Invoice:  NewInvoiceClient(cfg),
 		MixinID:  NewMixinIDClient(cfg),
 		Note:     NewNoteClient(cfg),
 		Other:    NewOtherClient(cfg),
@@ -181,6 +188,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Doc:      NewDocClient(cfg),
 		Group:    NewGroupClient(cfg),
 		IntSID:   NewIntSIDClient(cfg),
+		Invoice:  NewInvoiceClient(cfg),
 		MixinID:  NewMixinIDClient(cfg),
 		Note:     NewNoteClient(cfg),
 		Other:    NewOtherClient(cfg),
@@ -205,14 +213,24 @@ func (c *Client) Debug() *Client {
 	}
 	cfg := c.config
 	cfg.driver = dialect.Debug(c.driver, c.log)
+	if c.readDriver != nil {
+		cfg.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
 	client := &Client{config: cfg}
 	client.init()
 	return client
 }
 
 // Close closes the database connection and prevents new queries from starting.
+// The read driver of the client is closed as well, if it was configured.
 func (c *Client) Close() error {
-	return c.driver.Close()
+	err := c.driver.Close()
+	if _, ok := c.driver.(*txDriver); !ok && c.readDriver != nil {
+		if rerr := c.readDriver.Close(); err == nil {
+			err = rerr
+		}
+	}
+	return err
 }
 
 // Use adds the mutation hooks to all the entity clients.
@@ -226,6 +244,7 @@ func (c *Client) Use(hooks ...Hook) {
 	c.Doc.Use(hooks...)
 	c.Group.Use(hooks...)
 	c.IntSID.Use(hooks...)
+	c.Invoice.Use(hooks...)
 	c.MixinID.Use(hooks...)
 	c.Note.Use(hooks...)
 	c.Other.Use(hooks...)
@@ -236,6 +255,123 @@ func (c *Client) Use(hooks ...Hook) {
 	c.User.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Account.Intercept(interceptors...)
+	c.Blob.Intercept(interceptors...)
+	c.BlobLink.Intercept(interceptors...)
+	c.Car.Intercept(interceptors...)
+	c.Device.Intercept(interceptors...)
+	c.Doc.Intercept(interceptors...)
+	c.Group.Intercept(interceptors...)
+	c.IntSID.Intercept(interceptors...)
+	c.Invoice.Intercept(interceptors...)
+	c.MixinID.Intercept(interceptors...)
+	c.Note.Intercept(interceptors...)
+	c.Other.Intercept(interceptors...)
+	c.Pet.Intercept(interceptors...)
+	c.Revision.Intercept(interceptors...)
+	c.Session.Intercept(interceptors...)
+	c.Token.Intercept(interceptors...)
+	c.User.Intercept(interceptors...)
+}
+
+// AddExtension adds the given extensions to the client. Their hooks and interceptors are added to all the
+// entity clients, and their drivers wrap the drivers of the client. Extensions that decorate the driver
+// should be added before the client is used, as entity clients that were obtained before the call (e.g.
+// `users := client.User`) keep using the previous driver. For example:
+//
+//	client.AddExtension(cache.NewExtension(cache.WithTTL(time.Minute)))
+//
+func (c *Client) AddExtension(exts ...ent.Extension) {
+	if len(exts) == 0 {
+		return
+	}
+	for _, ext := range exts {
+		if drv := ext.Driver(c.driver); drv != nil {
+			c.driver = drv
+		}
+		if c.readDriver != nil {
+			if drv := ext.Driver(c.readDriver); drv != nil {
+				c.readDriver = drv
+			}
+		}
+	}
+	c.extensions = append(c.extensions[:len(c.extensions):len(c.extensions)], exts...)
+	c.init()
+	for _, ext := range exts {
+		c.Use(ext.Hooks()...)
+		c.Intercept(ext.Interceptors()...)
+	}
+}
+
+// HealthCheck runs the health checks of the extensions that were added to the client,
+// and returns the first error that was reported.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	for _, ext := range c.extensions {
+		if err := ext.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("ent: extension %q: %w", ext.Name(), err)
+		}
+	}
+	return nil
+}
+
+// WithOptions returns a new client that is derived from c and configured with the given options.
+// Hooks that are registered on the new client using Use are not added to c (and vice versa). For
+// example, creating a client for trusted background jobs:
+//
+//	client.WithOptions(ent.ReplaceHooks(AuditHook()), ent.SkipPrivacy()).
+//		Account.
+//		Delete().
+//		Exec(ctx)
+//
+func (c *Client) WithOptions(opts ...Option) *Client {
+	cfg := c.config
+	cfg.hooks = &hooks{
+		Account:  c.hooks.Account[:len(c.hooks.Account):len(c.hooks.Account)],
+		Blob:     c.hooks.Blob[:len(c.hooks.Blob):len(c.hooks.Blob)],
+		BlobLink: c.hooks.BlobLink[:len(c.hooks.BlobLink):len(c.hooks.BlobLink)],
+		Car:      c.hooks.Car[:len(c.hooks.Car):len(c.hooks.Car)],
+		Device:   c.hooks.Device[:len(c.hooks.Device):len(c.hooks.Device)],
+		Doc:      c.hooks.Doc[:len(c.hooks.Doc):len(c.hooks.Doc)],
+		Group:    c.hooks.Group[:len(c.hooks.Group):len(c.hooks.Group)],
+		IntSID:   c.hooks.IntSID[:len(c.hooks.IntSID):len(c.hooks.IntSID)],
+		Invoice:  c.hooks.Invoice[:len(c.hooks.Invoice):len(c.hooks.Invoice)],
+		MixinID:  c.hooks.MixinID[:len(c.hooks.MixinID):len(c.hooks.MixinID)],
+		Note:     c.hooks.Note[:len(c.hooks.Note):len(c.hooks.Note)],
+		Other:    c.hooks.Other[:len(c.hooks.Other):len(c.hooks.Other)],
+		Pet:      c.hooks.Pet[:len(c.hooks.Pet):len(c.hooks.Pet)],
+		Revision: c.hooks.Revision[:len(c.hooks.Revision):len(c.hooks.Revision)],
+		Session:  c.hooks.Session[:len(c.hooks.Session):len(c.hooks.Session)],
+		Token:    c.hooks.Token[:len(c.hooks.Token):len(c.hooks.Token)],
+		User:     c.hooks.User[:len(c.hooks.User):len(c.hooks.User)],
+	}
+	cfg.inters = &inters{
+		Account:  c.inters.Account[:len(c.inters.Account):len(c.inters.Account)],
+		Blob:     c.inters.Blob[:len(c.inters.Blob):len(c.inters.Blob)],
+		BlobLink: c.inters.BlobLink[:len(c.inters.BlobLink):len(c.inters.BlobLink)],
+		Car:      c.inters.Car[:len(c.inters.Car):len(c.inters.Car)],
+		Device:   c.inters.Device[:len(c.inters.Device):len(c.inters.Device)],
+		Doc:      c.inters.Doc[:len(c.inters.Doc):len(c.inters.Doc)],
+		Group:    c.inters.Group[:len(c.inters.Group):len(c.inters.Group)],
+		IntSID:   c.inters.IntSID[:len(c.inters.IntSID):len(c.inters.IntSID)],
+		Invoice:  c.inters.Invoice[:len(c.inters.Invoice):len(c.inters.Invoice)],
+		MixinID:  c.inters.MixinID[:len(c.inters.MixinID):len(c.inters.MixinID)],
+		Note:     c.inters.Note[:len(c.inters.Note):len(c.inters.Note)],
+		Other:    c.inters.Other[:len(c.inters.Other):len(c.inters.Other)],
+		Pet:      c.inters.Pet[:len(c.inters.Pet):len(c.inters.Pet)],
+		Revision: c.inters.Revision[:len(c.inters.Revision):len(c.inters.Revision)],
+		Session:  c.inters.Session[:len(c.inters.Session):len(c.inters.Session)],
+		Token:    c.inters.Token[:len(c.inters.Token):len(c.inters.Token)],
+		User:     c.inters.User[:len(c.inters.User):len(c.inters.User)],
+	}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
 // AccountClient is a client for the Account schema.
 type AccountClient struct {
 	config
@@ -252,6 +388,12 @@ func (c *AccountClient) Use(hooks ...Hook) {
 	c.hooks.Account = append(c.hooks.Account, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `account.Intercept(f(g(h())))`.
+func (c *AccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Account = append(c.inters.Account, interceptors...)
+}
+
 // Create returns a builder for creating a Account entity.
 func (c *AccountClient) Create() *AccountCreate {
 	mutation := newAccountMutation(c.config, OpCreate)
@@ -304,12 +446,17 @@ func (c *AccountClient) DeleteOneID(id sid.ID) *AccountDeleteOne {
 func (c *AccountClient) Query() *AccountQuery {
 	return &AccountQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Account entity by its id.
 func (c *AccountClient) Get(ctx context.Context, id sid.ID) (*Account, error) {
-	return c.Query().Where(account.ID(id)).Only(ctx)
+	node, err := c.Query().Where(account.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -323,7 +470,7 @@ func (c *AccountClient) GetX(ctx context.Context, id sid.ID) *Account {
 
 // QueryToken queries the token edge of a Account.
 func (c *AccountClient) QueryToken(a *Account) *TokenQuery {
-	query := &TokenQuery{config: c.config}
+	query := (&TokenClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := a.ID
 		step := sqlgraph.NewStep(
@@ -337,6 +484,11 @@ func (c *AccountClient) QueryToken(a *Account) *TokenQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *AccountClient) Interceptors() []Interceptor {
+	return c.inters.Account
+}
+
 // Hooks returns the client hooks.
 func (c *AccountClient) Hooks() []Hook {
 	return c.hooks.Account
@@ -358,6 +510,12 @@ func (c *BlobClient) Use(hooks ...Hook) {
 	c.hooks.Blob = append(c.hooks.Blob, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `blob.Intercept(f(g(h())))`.
+func (c *BlobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Blob = append(c.inters.Blob, interceptors...)
+}
+
 // Create returns a builder for creating a Blob entity.
 func (c *BlobClient) Create() *BlobCreate {
 	mutation := newBlobMutation(c.config, OpCreate)
@@ -410,12 +568,17 @@ func (c *BlobClient) DeleteOneID(id uuid.UUID) *BlobDeleteOne {
 func (c *BlobClient) Query() *BlobQuery {
 	return &BlobQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Blob entity by its id.
 func (c *BlobClient) Get(ctx context.Context, id uuid.UUID) (*Blob, error) {
-	return c.Query().Where(blob.ID(id)).Only(ctx)
+	node, err := c.Query().Where(blob.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -429,7 +592,7 @@ func (c *BlobClient) GetX(ctx context.Context, id uuid.UUID) *Blob {
 
 // QueryParent queries the parent edge of a Blob.
 func (c *BlobClient) QueryParent(b *Blob) *BlobQuery {
-	query := &BlobQuery{config: c.config}
+	query := (&BlobClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := b.ID
 		step := sqlgraph.NewStep(
@@ -445,7 +608,7 @@ func (c *BlobClient) QueryParent(b *Blob) *BlobQuery {
 
 // QueryLinks queries the links edge of a Blob.
 func (c *BlobClient) QueryLinks(b *Blob) *BlobQuery {
-	query := &BlobQuery{config: c.config}
+	query := (&BlobClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := b.ID
 		step := sqlgraph.NewStep(
@@ -461,7 +624,7 @@ func (c *BlobClient) QueryLinks(b *Blob) *BlobQuery {
 
 // QueryBlobLinks queries the blob_links edge of a Blob.
 func (c *BlobClient) QueryBlobLinks(b *Blob) *BlobLinkQuery {
-	query := &BlobLinkQuery{config: c.config}
+	query := (&BlobLinkClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := b.ID
 		step := sqlgraph.NewStep(
@@ -475,6 +638,11 @@ func (c *BlobClient) QueryBlobLinks(b *Blob) *BlobLinkQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *BlobClient) Interceptors() []Interceptor {
+	return c.inters.Blob
+}
+
 // Hooks returns the client hooks.
 func (c *BlobClient) Hooks() []Hook {
 	return c.hooks.Blob
@@ -496,6 +664,12 @@ func (c *BlobLinkClient) Use(hooks ...Hook) {
 	c.hooks.BlobLink = append(c.hooks.BlobLink, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bloblink.Intercept(f(g(h())))`.
+func (c *BlobLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BlobLink = append(c.inters.BlobLink, interceptors...)
+}
+
 // Create returns a builder for creating a BlobLink entity.
 func (c *BlobLinkClient) Create() *BlobLinkCreate {
 	mutation := newBlobLinkMutation(c.config, OpCreate)
@@ -521,17 +695,52 @@ func (c *BlobLinkClient) UpdateOne(bl *BlobLink) *BlobLinkUpdateOne {
 	return &BlobLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateOneID returns an update builder for the given composite identifier.
+func (c *BlobLinkClient) UpdateOneID(blob uuid.UUID, link uuid.UUID) *BlobLinkUpdateOne {
+	mutation := newBlobLinkMutation(c.config, OpUpdateOne)
+	mutation.blob = &blob
+	mutation.link = &link
+	return &BlobLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
 // Delete returns a delete builder for BlobLink.
 func (c *BlobLinkClient) Delete() *BlobLinkDelete {
 	mutation := newBlobLinkMutation(c.config, OpDelete)
 	return &BlobLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlobLinkClient) DeleteOne(bl *BlobLink) *BlobLinkDeleteOne {
+	return c.DeleteOneID(bl.BlobID, bl.LinkID)
+}
+
+// DeleteOneID returns a builder for deleting the entity by its composite identifier.
+func (c *BlobLinkClient) DeleteOneID(blob uuid.UUID, link uuid.UUID) *BlobLinkDeleteOne {
+	builder := c.Delete().Where(bloblink.BlobID(blob), bloblink.LinkID(link))
+	builder.mutation.op = OpDeleteOne
+	return &BlobLinkDeleteOne{builder}
+}
+
 // Query returns a query builder for BlobLink.
 func (c *BlobLinkClient) Query() *BlobLinkQuery {
 	return &BlobLinkQuery{
 		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BlobLink entity by its composite identifier.
+func (c *BlobLinkClient) Get(ctx context.Context, blob uuid.UUID, link uuid.UUID) (*BlobLink, error) {
+	return c.Query().Where(bloblink.BlobID(blob), bloblink.LinkID(link)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlobLinkClient) GetX(ctx context.Context, blob uuid.UUID, link uuid.UUID) *BlobLink {
+	obj, err := c.Get(ctx, blob, link)
+	if err != nil {
+		panic(err)
 	}
+	return obj
 }
 
 // QueryBlob queries the blob edge of a BlobLink.
@@ -548,6 +757,11 @@ func (c *BlobLinkClient) QueryLink(bl *BlobLink) *BlobQuery {
 		QueryLink()
 }
 
+// Interceptors returns the client interceptors.
+func (c *BlobLinkClient) Interceptors() []Interceptor {
+	return c.inters.BlobLink
+}
+
 // Hooks returns the client hooks.
 func (c *BlobLinkClient) Hooks() []Hook {
 	return c.hooks.BlobLink
@@ -569,6 +783,12 @@ func (c *CarClient) Use(hooks ...Hook) {
 	c.hooks.Car = append(c.hooks.Car, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `car.Intercept(f(g(h())))`.
+func (c *CarClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Car = append(c.inters.Car, interceptors...)
+}
+
 // Create returns a builder for creating a Car entity.
 func (c *CarClient) Create() *CarCreate {
 	mutation := newCarMutation(c.config, OpCreate)
@@ -621,12 +841,17 @@ func (c *CarClient) DeleteOneID(id int) *CarDeleteOne {
 func (c *CarClient) Query() *CarQuery {
 	return &CarQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Car entity by its id.
 func (c *CarClient) Get(ctx context.Context, id int) (*Car, error) {
-	return c.Query().Where(car.ID(id)).Only(ctx)
+	node, err := c.Query().Where(car.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -640,7 +865,7 @@ func (c *CarClient) GetX(ctx context.Context, id int) *Car {
 
 // QueryOwner queries the owner edge of a Car.
 func (c *CarClient) QueryOwner(ca *Car) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ca.ID
 		step := sqlgraph.NewStep(
@@ -654,6 +879,11 @@ func (c *CarClient) QueryOwner(ca *Car) *PetQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *CarClient) Interceptors() []Interceptor {
+	return c.inters.Car
+}
+
 // Hooks returns the client hooks.
 func (c *CarClient) Hooks() []Hook {
 	return c.hooks.Car
@@ -675,6 +905,12 @@ func (c *DeviceClient) Use(hooks ...Hook) {
 	c.hooks.Device = append(c.hooks.Device, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `device.Intercept(f(g(h())))`.
+func (c *DeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Device = append(c.inters.Device, interceptors...)
+}
+
 // Create returns a builder for creating a Device entity.
 func (c *DeviceClient) Create() *DeviceCreate {
 	mutation := newDeviceMutation(c.config, OpCreate)
@@ -727,12 +963,17 @@ func (c *DeviceClient) DeleteOneID(id schema.ID) *DeviceDeleteOne {
 func (c *DeviceClient) Query() *DeviceQuery {
 	return &DeviceQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Device entity by its id.
 func (c *DeviceClient) Get(ctx context.Context, id schema.ID) (*Device, error) {
-	return c.Query().Where(device.ID(id)).Only(ctx)
+	node, err := c.Query().Where(device.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -746,7 +987,7 @@ func (c *DeviceClient) GetX(ctx context.Context, id schema.ID) *Device {
 
 // QueryActiveSession queries the active_session edge of a Device.
 func (c *DeviceClient) QueryActiveSession(d *Device) *SessionQuery {
-	query := &SessionQuery{config: c.config}
+	query := (&SessionClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := d.ID
 		step := sqlgraph.NewStep(
@@ -762,7 +1003,7 @@ func (c *DeviceClient) QueryActiveSession(d *Device) *SessionQuery {
 
 // QuerySessions queries the sessions edge of a Device.
 func (c *DeviceClient) QuerySessions(d *Device) *SessionQuery {
-	query := &SessionQuery{config: c.config}
+	query := (&SessionClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := d.ID
 		step := sqlgraph.NewStep(
@@ -776,6 +1017,11 @@ func (c *DeviceClient) QuerySessions(d *Device) *SessionQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *DeviceClient) Interceptors() []Interceptor {
+	return c.inters.Device
+}
+
 // Hooks returns the client hooks.
 func (c *DeviceClient) Hooks() []Hook {
 	return c.hooks.Device
@@ -797,6 +1043,12 @@ func (c *DocClient) Use(hooks ...Hook) {
 	c.hooks.Doc = append(c.hooks.Doc, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `doc.Intercept(f(g(h())))`.
+func (c *DocClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Doc = append(c.inters.Doc, interceptors...)
+}
+
 // Create returns a builder for creating a Doc entity.
 func (c *DocClient) Create() *DocCreate {
 	mutation := newDocMutation(c.config, OpCreate)
@@ -849,12 +1101,17 @@ func (c *DocClient) DeleteOneID(id schema.DocID) *DocDeleteOne {
 func (c *DocClient) Query() *DocQuery {
 	return &DocQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Doc entity by its id.
 func (c *DocClient) Get(ctx context.Context, id schema.DocID) (*Doc, error) {
-	return c.Query().Where(doc.ID(id)).Only(ctx)
+	node, err := c.Query().Where(doc.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -868,7 +1125,7 @@ func (c *DocClient) GetX(ctx context.Context, id schema.DocID) *Doc {
 
 // QueryParent queries the parent edge of a Doc.
 func (c *DocClient) QueryParent(d *Doc) *DocQuery {
-	query := &DocQuery{config: c.config}
+	query := (&DocClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := d.ID
 		step := sqlgraph.NewStep(
@@ -884,7 +1141,7 @@ func (c *DocClient) QueryParent(d *Doc) *DocQuery {
 
 // QueryChildren queries the children edge of a Doc.
 func (c *DocClient) QueryChildren(d *Doc) *DocQuery {
-	query := &DocQuery{config: c.config}
+	query := (&DocClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := d.ID
 		step := sqlgraph.NewStep(
@@ -900,7 +1157,7 @@ func (c *DocClient) QueryChildren(d *Doc) *DocQuery {
 
 // QueryRelated queries the related edge of a Doc.
 func (c *DocClient) QueryRelated(d *Doc) *DocQuery {
-	query := &DocQuery{config: c.config}
+	query := (&DocClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := d.ID
 		step := sqlgraph.NewStep(
@@ -914,6 +1171,11 @@ func (c *DocClient) QueryRelated(d *Doc) *DocQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *DocClient) Interceptors() []Interceptor {
+	return c.inters.Doc
+}
+
 // Hooks returns the client hooks.
 func (c *DocClient) Hooks() []Hook {
 	return c.hooks.Doc
@@ -935,6 +1197,12 @@ func (c *GroupClient) Use(hooks ...Hook) {
 	c.hooks.Group = append(c.hooks.Group, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
 // Create returns a builder for creating a Group entity.
 func (c *GroupClient) Create() *GroupCreate {
 	mutation := newGroupMutation(c.config, OpCreate)
@@ -987,12 +1255,17 @@ func (c *GroupClient) DeleteOneID(id int) *GroupDeleteOne {
 func (c *GroupClient) Query() *GroupQuery {
 	return &GroupQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Group entity by its id.
 func (c *GroupClient) Get(ctx context.Context, id int) (*Group, error) {
-	return c.Query().Where(group.ID(id)).Only(ctx)
+	node, err := c.Query().Where(group.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1006,7 +1279,7 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 
 // QueryUsers queries the users edge of a Group.
 func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -1020,6 +1293,11 @@ func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
 // Hooks returns the client hooks.
 func (c *GroupClient) Hooks() []Hook {
 	return c.hooks.Group
@@ -1041,6 +1319,12 @@ func (c *IntSIDClient) Use(hooks ...Hook) {
 	c.hooks.IntSID = append(c.hooks.IntSID, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `intsid.Intercept(f(g(h())))`.
+func (c *IntSIDClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IntSID = append(c.inters.IntSID, interceptors...)
+}
+
 // Create returns a builder for creating a IntSID entity.
 func (c *IntSIDClient) Create() *IntSIDCreate {
 	mutation := newIntSIDMutation(c.config, OpCreate)
@@ -1093,12 +1377,17 @@ func (c *IntSIDClient) DeleteOneID(id sid.ID) *IntSIDDeleteOne {
 func (c *IntSIDClient) Query() *IntSIDQuery {
 	return &IntSIDQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a IntSID entity by its id.
 func (c *IntSIDClient) Get(ctx context.Context, id sid.ID) (*IntSID, error) {
-	return c.Query().Where(intsid.ID(id)).Only(ctx)
+	node, err := c.Query().Where(intsid.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1112,7 +1401,7 @@ func (c *IntSIDClient) GetX(ctx context.Context, id sid.ID) *IntSID {
 
 // QueryParent queries the parent edge of a IntSID.
 func (c *IntSIDClient) QueryParent(is *IntSID) *IntSIDQuery {
-	query := &IntSIDQuery{config: c.config}
+	query := (&IntSIDClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := is.ID
 		step := sqlgraph.NewStep(
@@ -1128,7 +1417,7 @@ func (c *IntSIDClient) QueryParent(is *IntSID) *IntSIDQuery {
 
 // QueryChildren queries the children edge of a IntSID.
 func (c *IntSIDClient) QueryChildren(is *IntSID) *IntSIDQuery {
-	query := &IntSIDQuery{config: c.config}
+	query := (&IntSIDClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := is.ID
 		step := sqlgraph.NewStep(
@@ -1142,11 +1431,128 @@ func (c *IntSIDClient) QueryChildren(is *IntSID) *IntSIDQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *IntSIDClient) Interceptors() []Interceptor {
+	return c.inters.IntSID
+}
+
 // Hooks returns the client hooks.
 func (c *IntSIDClient) Hooks() []Hook {
 	return c.hooks.IntSID
 }
 
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
+	config
+}
+
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
+func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
+}
+
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceClient) UpdateOne(i *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne)
+	mutation.tenant = &i.Tenant
+	mutation.number = &i.Number
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given composite identifier.
+func (c *InvoiceClient) UpdateOneID(tenant int, number string) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne)
+	mutation.tenant = &tenant
+	mutation.number = &number
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceClient) DeleteOne(i *Invoice) *InvoiceDeleteOne {
+	return c.DeleteOneID(i.Tenant, i.Number)
+}
+
+// DeleteOneID returns a builder for deleting the entity by its composite identifier.
+func (c *InvoiceClient) DeleteOneID(tenant int, number string) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.Tenant(tenant), invoice.Number(number))
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
+		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invoice entity by its composite identifier.
+func (c *InvoiceClient) Get(ctx context.Context, tenant int, number string) (*Invoice, error) {
+	return c.Query().Where(invoice.Tenant(tenant), invoice.Number(number)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceClient) GetX(ctx context.Context, tenant int, number string) *Invoice {
+	obj, err := c.Get(ctx, tenant, number)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Invoice.
+func (c *InvoiceClient) QueryOwner(i *Invoice) *UserQuery {
+	return c.Query().
+		Where(invoice.Tenant(i.Tenant), invoice.Number(i.Number)).
+		QueryOwner()
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceClient) Interceptors() []Interceptor {
+	return c.inters.Invoice
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceClient) Hooks() []Hook {
+	return c.hooks.Invoice
+}
+
 // MixinIDClient is a client for the MixinID schema.
 type MixinIDClient struct {
 	config
@@ -1163,6 +1569,12 @@ func (c *MixinIDClient) Use(hooks ...Hook) {
 	c.hooks.MixinID = append(c.hooks.MixinID, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mixinid.Intercept(f(g(h())))`.
+func (c *MixinIDClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MixinID = append(c.inters.MixinID, interceptors...)
+}
+
 // Create returns a builder for creating a MixinID entity.
 func (c *MixinIDClient) Create() *MixinIDCreate {
 	mutation := newMixinIDMutation(c.config, OpCreate)
@@ -1215,12 +1627,17 @@ func (c *MixinIDClient) DeleteOneID(id uuid.UUID) *MixinIDDeleteOne {
 func (c *MixinIDClient) Query() *MixinIDQuery {
 	return &MixinIDQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a MixinID entity by its id.
 func (c *MixinIDClient) Get(ctx context.Context, id uuid.UUID) (*MixinID, error) {
-	return c.Query().Where(mixinid.ID(id)).Only(ctx)
+	node, err := c.Query().Where(mixinid.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1232,6 +1649,11 @@ func (c *MixinIDClient) GetX(ctx context.Context, id uuid.UUID) *MixinID {
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *MixinIDClient) Interceptors() []Interceptor {
+	return c.inters.MixinID
+}
+
 // Hooks returns the client hooks.
 func (c *MixinIDClient) Hooks() []Hook {
 	return c.hooks.MixinID
@@ -1253,6 +1675,12 @@ func (c *NoteClient) Use(hooks ...Hook) {
 	c.hooks.Note = append(c.hooks.Note, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `note.Intercept(f(g(h())))`.
+func (c *NoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Note = append(c.inters.Note, interceptors...)
+}
+
 // Create returns a builder for creating a Note entity.
 func (c *NoteClient) Create() *NoteCreate {
 	mutation := newNoteMutation(c.config, OpCreate)
@@ -1305,12 +1733,17 @@ func (c *NoteClient) DeleteOneID(id schema.NoteID) *NoteDeleteOne {
 func (c *NoteClient) Query() *NoteQuery {
 	return &NoteQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Note entity by its id.
 func (c *NoteClient) Get(ctx context.Context, id schema.NoteID) (*Note, error) {
-	return c.Query().Where(note.ID(id)).Only(ctx)
+	node, err := c.Query().Where(note.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1324,7 +1757,7 @@ func (c *NoteClient) GetX(ctx context.Context, id schema.NoteID) *Note {
 
 // QueryParent queries the parent edge of a Note.
 func (c *NoteClient) QueryParent(n *Note) *NoteQuery {
-	query := &NoteQuery{config: c.config}
+	query := (&NoteClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := n.ID
 		step := sqlgraph.NewStep(
@@ -1340,7 +1773,7 @@ func (c *NoteClient) QueryParent(n *Note) *NoteQuery {
 
 // QueryChildren queries the children edge of a Note.
 func (c *NoteClient) QueryChildren(n *Note) *NoteQuery {
-	query := &NoteQuery{config: c.config}
+	query := (&NoteClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := n.ID
 		step := sqlgraph.NewStep(
@@ -1354,6 +1787,11 @@ func (c *NoteClient) QueryChildren(n *Note) *NoteQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *NoteClient) Interceptors() []Interceptor {
+	return c.inters.Note
+}
+
 // Hooks returns the client hooks.
 func (c *NoteClient) Hooks() []Hook {
 	return c.hooks.Note
@@ -1375,6 +1813,12 @@ func (c *OtherClient) Use(hooks ...Hook) {
 	c.hooks.Other = append(c.hooks.Other, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `other.Intercept(f(g(h())))`.
+func (c *OtherClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Other = append(c.inters.Other, interceptors...)
+}
+
 // Create returns a builder for creating a Other entity.
 func (c *OtherClient) Create() *OtherCreate {
 	mutation := newOtherMutation(c.config, OpCreate)
@@ -1427,12 +1871,17 @@ func (c *OtherClient) DeleteOneID(id sid.ID) *OtherDeleteOne {
 func (c *OtherClient) Query() *OtherQuery {
 	return &OtherQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Other entity by its id.
 func (c *OtherClient) Get(ctx context.Context, id sid.ID) (*Other, error) {
-	return c.Query().Where(other.ID(id)).Only(ctx)
+	node, err := c.Query().Where(other.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1444,6 +1893,11 @@ func (c *OtherClient) GetX(ctx context.Context, id sid.ID) *Other {
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *OtherClient) Interceptors() []Interceptor {
+	return c.inters.Other
+}
+
 // Hooks returns the client hooks.
 func (c *OtherClient) Hooks() []Hook {
 	return c.hooks.Other
@@ -1465,6 +1919,12 @@ func (c *PetClient) Use(hooks ...Hook) {
 	c.hooks.Pet = append(c.hooks.Pet, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pet.Intercept(f(g(h())))`.
+func (c *PetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Pet = append(c.inters.Pet, interceptors...)
+}
+
 // Create returns a builder for creating a Pet entity.
 func (c *PetClient) Create() *PetCreate {
 	mutation := newPetMutation(c.config, OpCreate)
@@ -1517,12 +1977,17 @@ func (c *PetClient) DeleteOneID(id string) *PetDeleteOne {
 func (c *PetClient) Query() *PetQuery {
 	return &PetQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Pet entity by its id.
 func (c *PetClient) Get(ctx context.Context, id string) (*Pet, error) {
-	return c.Query().Where(pet.ID(id)).Only(ctx)
+	node, err := c.Query().Where(pet.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1536,7 +2001,7 @@ func (c *PetClient) GetX(ctx context.Context, id string) *Pet {
 
 // QueryOwner queries the owner edge of a Pet.
 func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -1552,7 +2017,7 @@ func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
 
 // QueryCars queries the cars edge of a Pet.
 func (c *PetClient) QueryCars(pe *Pet) *CarQuery {
-	query := &CarQuery{config: c.config}
+	query := (&CarClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -1568,7 +2033,7 @@ func (c *PetClient) QueryCars(pe *Pet) *CarQuery {
 
 // QueryFriends queries the friends edge of a Pet.
 func (c *PetClient) QueryFriends(pe *Pet) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -1584,7 +2049,7 @@ func (c *PetClient) QueryFriends(pe *Pet) *PetQuery {
 
 // QueryBestFriend queries the best_friend edge of a Pet.
 func (c *PetClient) QueryBestFriend(pe *Pet) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -1598,6 +2063,11 @@ func (c *PetClient) QueryBestFriend(pe *Pet) *PetQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *PetClient) Interceptors() []Interceptor {
+	return c.inters.Pet
+}
+
 // Hooks returns the client hooks.
 func (c *PetClient) Hooks() []Hook {
 	return c.hooks.Pet
@@ -1619,6 +2089,12 @@ func (c *RevisionClient) Use(hooks ...Hook) {
 	c.hooks.Revision = append(c.hooks.Revision, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `revision.Intercept(f(g(h())))`.
+func (c *RevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Revision = append(c.inters.Revision, interceptors...)
+}
+
 // Create returns a builder for creating a Revision entity.
 func (c *RevisionClient) Create() *RevisionCreate {
 	mutation := newRevisionMutation(c.config, OpCreate)
@@ -1671,12 +2147,17 @@ func (c *RevisionClient) DeleteOneID(id string) *RevisionDeleteOne {
 func (c *RevisionClient) Query() *RevisionQuery {
 	return &RevisionQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Revision entity by its id.
 func (c *RevisionClient) Get(ctx context.Context, id string) (*Revision, error) {
-	return c.Query().Where(revision.ID(id)).Only(ctx)
+	node, err := c.Query().Where(revision.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1688,6 +2169,11 @@ func (c *RevisionClient) GetX(ctx context.Context, id string) *Revision {
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *RevisionClient) Interceptors() []Interceptor {
+	return c.inters.Revision
+}
+
 // Hooks returns the client hooks.
 func (c *RevisionClient) Hooks() []Hook {
 	return c.hooks.Revision
@@ -1709,6 +2195,12 @@ func (c *SessionClient) Use(hooks ...Hook) {
 	c.hooks.Session = append(c.hooks.Session, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
 // Create returns a builder for creating a Session entity.
 func (c *SessionClient) Create() *SessionCreate {
 	mutation := newSessionMutation(c.config, OpCreate)
@@ -1761,12 +2253,17 @@ func (c *SessionClient) DeleteOneID(id schema.ID) *SessionDeleteOne {
 func (c *SessionClient) Query() *SessionQuery {
 	return &SessionQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Session entity by its id.
 func (c *SessionClient) Get(ctx context.Context, id schema.ID) (*Session, error) {
-	return c.Query().Where(session.ID(id)).Only(ctx)
+	node, err := c.Query().Where(session.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1780,7 +2277,7 @@ func (c *SessionClient) GetX(ctx context.Context, id schema.ID) *Session {
 
 // QueryDevice queries the device edge of a Session.
 func (c *SessionClient) QueryDevice(s *Session) *DeviceQuery {
-	query := &DeviceQuery{config: c.config}
+	query := (&DeviceClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := s.ID
 		step := sqlgraph.NewStep(
@@ -1794,6 +2291,11 @@ func (c *SessionClient) QueryDevice(s *Session) *DeviceQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
+}
+
 // Hooks returns the client hooks.
 func (c *SessionClient) Hooks() []Hook {
 	return c.hooks.Session
@@ -1815,6 +2317,12 @@ func (c *TokenClient) Use(hooks ...Hook) {
 	c.hooks.Token = append(c.hooks.Token, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `token.Intercept(f(g(h())))`.
+func (c *TokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Token = append(c.inters.Token, interceptors...)
+}
+
 // Create returns a builder for creating a Token entity.
 func (c *TokenClient) Create() *TokenCreate {
 	mutation := newTokenMutation(c.config, OpCreate)
@@ -1867,12 +2375,17 @@ func (c *TokenClient) DeleteOneID(id sid.ID) *TokenDeleteOne {
 func (c *TokenClient) Query() *TokenQuery {
 	return &TokenQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Token entity by its id.
 func (c *TokenClient) Get(ctx context.Context, id sid.ID) (*Token, error) {
-	return c.Query().Where(token.ID(id)).Only(ctx)
+	node, err := c.Query().Where(token.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1886,7 +2399,7 @@ func (c *TokenClient) GetX(ctx context.Context, id sid.ID) *Token {
 
 // QueryAccount queries the account edge of a Token.
 func (c *TokenClient) QueryAccount(t *Token) *AccountQuery {
-	query := &AccountQuery{config: c.config}
+	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := t.ID
 		step := sqlgraph.NewStep(
@@ -1900,6 +2413,11 @@ func (c *TokenClient) QueryAccount(t *Token) *AccountQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *TokenClient) Interceptors() []Interceptor {
+	return c.inters.Token
+}
+
 // Hooks returns the client hooks.
 func (c *TokenClient) Hooks() []Hook {
 	return c.hooks.Token
@@ -1921,6 +2439,12 @@ func (c *UserClient) Use(hooks ...Hook) {
 	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a builder for creating a User entity.
 func (c *UserClient) Create() *UserCreate {
 	mutation := newUserMutation(c.config, OpCreate)
@@ -1973,12 +2497,17 @@ func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
 func (c *UserClient) Query() *UserQuery {
 	return &UserQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a User entity by its id.
 func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+	node, err := c.Query().Where(user.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1992,7 +2521,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 
 // QueryGroups queries the groups edge of a User.
 func (c *UserClient) QueryGroups(u *User) *GroupQuery {
-	query := &GroupQuery{config: c.config}
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -2008,7 +2537,7 @@ func (c *UserClient) QueryGroups(u *User) *GroupQuery {
 
 // QueryParent queries the parent edge of a User.
 func (c *UserClient) QueryParent(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -2024,7 +2553,7 @@ func (c *UserClient) QueryParent(u *User) *UserQuery {
 
 // QueryChildren queries the children edge of a User.
 func (c *UserClient) QueryChildren(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -2040,7 +2569,7 @@ func (c *UserClient) QueryChildren(u *User) *UserQuery {
 
 // QueryPets queries the pets edge of a User.
 func (c *UserClient) QueryPets(u *User) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -2054,6 +2583,27 @@ func (c *UserClient) QueryPets(u *User) *PetQuery {
 	return query
 }
 
+// QueryInvoices queries the invoices edge of a User.
+func (c *UserClient) QueryInvoices(u *User) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.OwnerColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InvoicesTable, user.InvoicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
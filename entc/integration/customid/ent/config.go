@@ -7,6 +7,9 @@
 package ent
 
 import (
+	"reflect"
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 )
@@ -18,12 +21,20 @@ type Option func(*config)
 type config struct {
 	// driver used for executing database requests.
 	driver dialect.Driver
+	// readDriver used for executing read queries outside of transactions, if set.
+	readDriver dialect.Driver
 	// debug enable a debug logging.
 	debug bool
 	// log used for logging on debug mode.
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+	// interceptors to execute on queries.
+	inters *inters
+	// clock used for computing the time.Now defaults of fields.
+	clock func() time.Time
+	// extensions that were added to the client.
+	extensions []ent.Extension
 }
 
 // hooks per client, for fast access.
@@ -36,6 +47,7 @@ type hooks struct {
 	Doc      []ent.Hook
 	Group    []ent.Hook
 	IntSID   []ent.Hook
+	Invoice  []ent.Hook
 	MixinID  []ent.Hook
 	Note     []ent.Hook
 	Other    []ent.Hook
@@ -46,14 +58,38 @@ type hooks struct {
 	User     []ent.Hook
 }
 
+// inters per client, for fast access.
+type inters struct {
+	Account  []ent.Interceptor
+	Blob     []ent.Interceptor
+	BlobLink []ent.Interceptor
+	Car      []ent.Interceptor
+	Device   []ent.Interceptor
+	Doc      []ent.Interceptor
+	Group    []ent.Interceptor
+	IntSID   []ent.Interceptor
+	Invoice  []ent.Interceptor
+	MixinID  []ent.Interceptor
+	Note     []ent.Interceptor
+	Other    []ent.Interceptor
+	Pet      []ent.Interceptor
+	Revision []ent.Interceptor
+	Session  []ent.Interceptor
+	Token    []ent.Interceptor
+	User     []ent.Interceptor
+}
+
 // Options applies the options on the config object.
 func (c *config) options(opts ...Option) {
 	for _, opt := range opts {
 		opt(c)
 	}
-	if c.debug {
+	if _, ok := c.driver.(*dialect.DebugDriver); c.debug && !ok {
 		c.driver = dialect.Debug(c.driver, c.log)
 	}
+	if _, ok := c.readDriver.(*dialect.DebugDriver); c.debug && c.readDriver != nil && !ok {
+		c.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
 }
 
 // Debug enables debug logging on the ent.Driver.
@@ -76,3 +112,82 @@ func Driver(driver dialect.Driver) Option {
 		c.driver = driver
 	}
 }
+
+// ReadDriver configures the driver that executes the read queries of the client (e.g. Query, Only and Count),
+// for splitting the reads and writes between a read replica and the primary database. Mutations, and queries
+// that are executed in transactions, are executed by the driver that was configured using Driver. For example:
+//
+//	client := ent.NewClient(ent.Driver(primary), ent.ReadDriver(replica))
+//
+// Note that replicas may lag behind the primary. Reads that must observe the writes of the client should
+// be executed in a transaction, or using a client that was derived using WithOptions(ReadDriver(nil)).
+func ReadDriver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.readDriver = driver
+	}
+}
+
+// Clock sets the clock of the client. Fields whose default (or update default) function is time.Now
+// use the clock instead, which allows freezing the time in tests, or backfilling entities with
+// historical timestamps. For example:
+//
+//	client := ent.NewClient(ent.Driver(drv), ent.Clock(func() time.Time {
+//		return time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+//	}))
+//
+func Clock(now func() time.Time) Option {
+	return func(c *config) {
+		c.clock = now
+	}
+}
+
+// ReplaceHooks replaces the hooks that were registered on the entity clients using Use with the given
+// hooks. It is mainly used with Client.WithOptions, for creating clients with a different set of hooks.
+// Note that the hooks and policies that are defined in the schema are not affected by this option.
+func ReplaceHooks(hs ...Hook) Option {
+	return func(c *config) {
+		c.hooks = &hooks{
+			Account:  hs[:len(hs):len(hs)],
+			Blob:     hs[:len(hs):len(hs)],
+			BlobLink: hs[:len(hs):len(hs)],
+			Car:      hs[:len(hs):len(hs)],
+			Device:   hs[:len(hs):len(hs)],
+			Doc:      hs[:len(hs):len(hs)],
+			Group:    hs[:len(hs):len(hs)],
+			IntSID:   hs[:len(hs):len(hs)],
+			Invoice:  hs[:len(hs):len(hs)],
+			MixinID:  hs[:len(hs):len(hs)],
+			Note:     hs[:len(hs):len(hs)],
+			Other:    hs[:len(hs):len(hs)],
+			Pet:      hs[:len(hs):len(hs)],
+			Revision: hs[:len(hs):len(hs)],
+			Session:  hs[:len(hs):len(hs)],
+			Token:    hs[:len(hs):len(hs)],
+			User:     hs[:len(hs):len(hs)],
+		}
+	}
+}
+
+// queryDriver returns the driver for executing read queries. It is the read
+// driver of the config, if it was set and the config is not transactional.
+func (c config) queryDriver() dialect.Driver {
+	if c.readDriver == nil {
+		return c.driver
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.driver
+	}
+	return c.readDriver
+}
+
+// timeNow holds the code pointer of time.Now, for detecting defaults that can be replaced by the clock.
+var timeNow = reflect.ValueOf(time.Now).Pointer()
+
+// now returns the value of the given time default function. Functions that
+// are time.Now are replaced by the clock of the config, if it was set.
+func (c config) now(fn func() time.Time) time.Time {
+	if c.clock != nil && reflect.ValueOf(fn).Pointer() == timeNow {
+		return c.clock()
+	}
+	return fn()
+}
@@ -282,6 +282,7 @@ type (
 	//  one Device node.
 	DeviceUpsertOne struct {
 		create *DeviceCreate
+		err    error // first validation error of the update values.
 	}
 
 	// DeviceUpsert is the "OnConflict" setter.
@@ -342,6 +343,9 @@ func (u *DeviceUpsertOne) Update(set func(*DeviceUpsert)) *DeviceUpsertOne {
 
 // Exec executes the query.
 func (u *DeviceUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for DeviceCreate.OnConflict")
 	}
@@ -350,7 +354,7 @@ func (u *DeviceUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *DeviceUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -362,6 +366,9 @@ func (u *DeviceUpsertOne) ID(ctx context.Context) (id schema.ID, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: DeviceUpsertOne.ID is not supported by MySQL driver. Use DeviceUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -496,6 +503,7 @@ func (dcb *DeviceCreateBulk) OnConflictColumns(columns ...string) *DeviceUpsertB
 // a bulk of Device nodes.
 type DeviceUpsertBulk struct {
 	create *DeviceCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -553,6 +561,9 @@ func (u *DeviceUpsertBulk) Update(set func(*DeviceUpsert)) *DeviceUpsertBulk {
 
 // Exec executes the query.
 func (u *DeviceUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeviceCreateBulk instead", i)
@@ -566,7 +577,7 @@ func (u *DeviceUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *DeviceUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -107,7 +107,7 @@ func (ddo *DeviceDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{device.Label}
+		return &NotFoundError{label: device.Label}
 	default:
 		return nil
 	}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/device"
@@ -29,6 +30,7 @@ type DeviceQuery struct {
 	unique            *bool
 	order             []OrderFunc
 	fields            []string
+	inters            []Interceptor
 	predicates        []predicate.Device
 	withActiveSession *SessionQuery
 	withSessions      *SessionQuery
@@ -71,7 +73,7 @@ func (dq *DeviceQuery) Order(o ...OrderFunc) *DeviceQuery {
 
 // QueryActiveSession chains the current query on the "active_session" edge.
 func (dq *DeviceQuery) QueryActiveSession() *SessionQuery {
-	query := &SessionQuery{config: dq.config}
+	query := (&SessionClient{config: dq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := dq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -93,7 +95,7 @@ func (dq *DeviceQuery) QueryActiveSession() *SessionQuery {
 
 // QuerySessions chains the current query on the "sessions" edge.
 func (dq *DeviceQuery) QuerySessions() *SessionQuery {
-	query := &SessionQuery{config: dq.config}
+	query := (&SessionClient{config: dq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := dq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -121,7 +123,7 @@ func (dq *DeviceQuery) First(ctx context.Context) (*Device, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{device.Label}
+		return nil, dq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -143,7 +145,7 @@ func (dq *DeviceQuery) FirstID(ctx context.Context) (id schema.ID, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{device.Label}
+		err = dq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -170,7 +172,7 @@ func (dq *DeviceQuery) Only(ctx context.Context) (*Device, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{device.Label}
+		return nil, dq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{device.Label}
 	}
@@ -197,7 +199,7 @@ func (dq *DeviceQuery) OnlyID(ctx context.Context) (id schema.ID, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{device.Label}
+		err = dq.sqlNotFound()
 	default:
 		err = &NotSingularError{device.Label}
 	}
@@ -218,7 +220,17 @@ func (dq *DeviceQuery) All(ctx context.Context) ([]*Device, error) {
 	if err := dq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return dq.sqlAll(ctx)
+	v, err := dq.intercept(ctx, "All", func(ctx context.Context, query *DeviceQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Device)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Device", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -232,10 +244,20 @@ func (dq *DeviceQuery) AllX(ctx context.Context) []*Device {
 
 // IDs executes the query and returns a list of Device IDs.
 func (dq *DeviceQuery) IDs(ctx context.Context) ([]schema.ID, error) {
-	var ids []schema.ID
-	if err := dq.Select(device.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := dq.intercept(ctx, "IDs", func(ctx context.Context, query *DeviceQuery) (Value, error) {
+		var ids []schema.ID
+		if err := query.Select(device.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]schema.ID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []schema.ID", v)
+	}
 	return ids, nil
 }
 
@@ -253,7 +275,17 @@ func (dq *DeviceQuery) Count(ctx context.Context) (int, error) {
 	if err := dq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return dq.sqlCount(ctx)
+	v, err := dq.intercept(ctx, "Count", func(ctx context.Context, query *DeviceQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -270,7 +302,61 @@ func (dq *DeviceQuery) Exist(ctx context.Context) (bool, error) {
 	if err := dq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return dq.sqlExist(ctx)
+	v, err := dq.intercept(ctx, "Exist", func(ctx context.Context, query *DeviceQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (dq *DeviceQuery) intercept(ctx context.Context, op string, fn func(context.Context, *DeviceQuery) (Value, error)) (Value, error) {
+	if len(dq.inters) == 0 {
+		return fn(ctx, dq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeDevice,
+		Op:     op,
+		Limit:  dq.limit,
+		Offset: dq.offset,
+		Unique: dq.unique,
+		Fields: dq.fields,
+	}
+	for _, p := range dq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range dq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if dq.withActiveSession != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, device.EdgeActiveSession)
+		qc.EdgeQueries[device.EdgeActiveSession] = dq.withActiveSession
+	}
+	if dq.withSessions != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, device.EdgeSessions)
+		qc.EdgeQueries[device.EdgeSessions] = dq.withSessions
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*DeviceQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *DeviceQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, dq, qc, qr, dq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -293,6 +379,7 @@ func (dq *DeviceQuery) Clone() *DeviceQuery {
 		limit:             dq.limit,
 		offset:            dq.offset,
 		order:             append([]OrderFunc{}, dq.order...),
+		inters:            append([]Interceptor{}, dq.inters...),
 		predicates:        append([]predicate.Device{}, dq.predicates...),
 		withActiveSession: dq.withActiveSession.Clone(),
 		withSessions:      dq.withSessions.Clone(),
@@ -306,7 +393,7 @@ func (dq *DeviceQuery) Clone() *DeviceQuery {
 // WithActiveSession tells the query-builder to eager-load the nodes that are connected to
 // the "active_session" edge. The optional arguments are used to configure the query builder of the edge.
 func (dq *DeviceQuery) WithActiveSession(opts ...func(*SessionQuery)) *DeviceQuery {
-	query := &SessionQuery{config: dq.config}
+	query := (&SessionClient{config: dq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -317,7 +404,7 @@ func (dq *DeviceQuery) WithActiveSession(opts ...func(*SessionQuery)) *DeviceQue
 // WithSessions tells the query-builder to eager-load the nodes that are connected to
 // the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
 func (dq *DeviceQuery) WithSessions(opts ...func(*SessionQuery)) *DeviceQuery {
-	query := &SessionQuery{config: dq.config}
+	query := (&SessionClient{config: dq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -357,6 +444,16 @@ func (dq *DeviceQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range dq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dq); err != nil {
+				return err
+			}
+		}
+	}
 	if dq.path != nil {
 		prev, err := dq.path(ctx)
 		if err != nil {
@@ -395,7 +492,7 @@ func (dq *DeviceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Devic
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, dq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, dq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -484,7 +581,7 @@ func (dq *DeviceQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(dq.fields) > 0 {
 		_spec.Unique = dq.unique != nil && *dq.unique
 	}
-	return sqlgraph.CountNodes(ctx, dq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, dq.queryDriver(), _spec)
 }
 
 func (dq *DeviceQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -575,6 +672,32 @@ func (dq *DeviceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (dq *DeviceQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: device.Label}
+	if len(dq.predicates) > 0 {
+		selector := sql.Dialect(dq.driver.Dialect()).Select().From(sql.Table(device.Table))
+		for _, p := range dq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the DeviceQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (dq *DeviceQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Device, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Device(ps[i]))
+	}
+	dq.predicates = append(dq.predicates, wps...)
+}
+
 // DeviceGroupBy is the group-by builder for Device entities.
 type DeviceGroupBy struct {
 	config
@@ -614,7 +737,7 @@ func (dgb *DeviceGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := dgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := dgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -660,7 +783,7 @@ func (ds *DeviceSelect) Scan(ctx context.Context, v interface{}) error {
 func (ds *DeviceSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ds.sql.Query()
-	if err := ds.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ds.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -343,6 +343,7 @@ type (
 	//  one Doc node.
 	DocUpsertOne struct {
 		create *DocCreate
+		err    error // first validation error of the update values.
 	}
 
 	// DocUpsert is the "OnConflict" setter.
@@ -442,6 +443,9 @@ func (u *DocUpsertOne) ClearText() *DocUpsertOne {
 
 // Exec executes the query.
 func (u *DocUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for DocCreate.OnConflict")
 	}
@@ -450,7 +454,7 @@ func (u *DocUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *DocUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -462,6 +466,9 @@ func (u *DocUpsertOne) ID(ctx context.Context) (id schema.DocID, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: DocUpsertOne.ID is not supported by MySQL driver. Use DocUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -601,6 +608,7 @@ func (dcb *DocCreateBulk) OnConflictColumns(columns ...string) *DocUpsertBulk {
 // a bulk of Doc nodes.
 type DocUpsertBulk struct {
 	create *DocCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -679,6 +687,9 @@ func (u *DocUpsertBulk) ClearText() *DocUpsertBulk {
 
 // Exec executes the query.
 func (u *DocUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DocCreateBulk instead", i)
@@ -692,7 +703,7 @@ func (u *DocUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *DocUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -107,7 +107,7 @@ func (ddo *DocDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{doc.Label}
+		return &NotFoundError{label: doc.Label}
 	default:
 		return nil
 	}
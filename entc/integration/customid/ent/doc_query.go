@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/doc"
@@ -28,6 +29,7 @@ type DocQuery struct {
 	unique       *bool
 	order        []OrderFunc
 	fields       []string
+	inters       []Interceptor
 	predicates   []predicate.Doc
 	withParent   *DocQuery
 	withChildren *DocQuery
@@ -71,7 +73,7 @@ func (dq *DocQuery) Order(o ...OrderFunc) *DocQuery {
 
 // QueryParent chains the current query on the "parent" edge.
 func (dq *DocQuery) QueryParent() *DocQuery {
-	query := &DocQuery{config: dq.config}
+	query := (&DocClient{config: dq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := dq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -93,7 +95,7 @@ func (dq *DocQuery) QueryParent() *DocQuery {
 
 // QueryChildren chains the current query on the "children" edge.
 func (dq *DocQuery) QueryChildren() *DocQuery {
-	query := &DocQuery{config: dq.config}
+	query := (&DocClient{config: dq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := dq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -115,7 +117,7 @@ func (dq *DocQuery) QueryChildren() *DocQuery {
 
 // QueryRelated chains the current query on the "related" edge.
 func (dq *DocQuery) QueryRelated() *DocQuery {
-	query := &DocQuery{config: dq.config}
+	query := (&DocClient{config: dq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := dq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -143,7 +145,7 @@ func (dq *DocQuery) First(ctx context.Context) (*Doc, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{doc.Label}
+		return nil, dq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -165,7 +167,7 @@ func (dq *DocQuery) FirstID(ctx context.Context) (id schema.DocID, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{doc.Label}
+		err = dq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -192,7 +194,7 @@ func (dq *DocQuery) Only(ctx context.Context) (*Doc, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{doc.Label}
+		return nil, dq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{doc.Label}
 	}
@@ -219,7 +221,7 @@ func (dq *DocQuery) OnlyID(ctx context.Context) (id schema.DocID, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{doc.Label}
+		err = dq.sqlNotFound()
 	default:
 		err = &NotSingularError{doc.Label}
 	}
@@ -240,7 +242,17 @@ func (dq *DocQuery) All(ctx context.Context) ([]*Doc, error) {
 	if err := dq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return dq.sqlAll(ctx)
+	v, err := dq.intercept(ctx, "All", func(ctx context.Context, query *DocQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Doc)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Doc", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -254,10 +266,20 @@ func (dq *DocQuery) AllX(ctx context.Context) []*Doc {
 
 // IDs executes the query and returns a list of Doc IDs.
 func (dq *DocQuery) IDs(ctx context.Context) ([]schema.DocID, error) {
-	var ids []schema.DocID
-	if err := dq.Select(doc.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := dq.intercept(ctx, "IDs", func(ctx context.Context, query *DocQuery) (Value, error) {
+		var ids []schema.DocID
+		if err := query.Select(doc.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]schema.DocID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []schema.DocID", v)
+	}
 	return ids, nil
 }
 
@@ -275,7 +297,17 @@ func (dq *DocQuery) Count(ctx context.Context) (int, error) {
 	if err := dq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return dq.sqlCount(ctx)
+	v, err := dq.intercept(ctx, "Count", func(ctx context.Context, query *DocQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -292,7 +324,68 @@ func (dq *DocQuery) Exist(ctx context.Context) (bool, error) {
 	if err := dq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return dq.sqlExist(ctx)
+	v, err := dq.intercept(ctx, "Exist", func(ctx context.Context, query *DocQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (dq *DocQuery) intercept(ctx context.Context, op string, fn func(context.Context, *DocQuery) (Value, error)) (Value, error) {
+	if len(dq.inters) == 0 {
+		return fn(ctx, dq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeDoc,
+		Op:     op,
+		Limit:  dq.limit,
+		Offset: dq.offset,
+		Unique: dq.unique,
+		Fields: dq.fields,
+	}
+	for _, p := range dq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range dq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if dq.withParent != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, doc.EdgeParent)
+		qc.EdgeQueries[doc.EdgeParent] = dq.withParent
+	}
+	if dq.withChildren != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, doc.EdgeChildren)
+		qc.EdgeQueries[doc.EdgeChildren] = dq.withChildren
+	}
+	if dq.withRelated != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, doc.EdgeRelated)
+		qc.EdgeQueries[doc.EdgeRelated] = dq.withRelated
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*DocQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *DocQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, dq, qc, qr, dq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -315,6 +408,7 @@ func (dq *DocQuery) Clone() *DocQuery {
 		limit:        dq.limit,
 		offset:       dq.offset,
 		order:        append([]OrderFunc{}, dq.order...),
+		inters:       append([]Interceptor{}, dq.inters...),
 		predicates:   append([]predicate.Doc{}, dq.predicates...),
 		withParent:   dq.withParent.Clone(),
 		withChildren: dq.withChildren.Clone(),
@@ -329,7 +423,7 @@ func (dq *DocQuery) Clone() *DocQuery {
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (dq *DocQuery) WithParent(opts ...func(*DocQuery)) *DocQuery {
-	query := &DocQuery{config: dq.config}
+	query := (&DocClient{config: dq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -340,7 +434,7 @@ func (dq *DocQuery) WithParent(opts ...func(*DocQuery)) *DocQuery {
 // WithChildren tells the query-builder to eager-load the nodes that are connected to
 // the "children" edge. The optional arguments are used to configure the query builder of the edge.
 func (dq *DocQuery) WithChildren(opts ...func(*DocQuery)) *DocQuery {
-	query := &DocQuery{config: dq.config}
+	query := (&DocClient{config: dq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -351,7 +445,7 @@ func (dq *DocQuery) WithChildren(opts ...func(*DocQuery)) *DocQuery {
 // WithRelated tells the query-builder to eager-load the nodes that are connected to
 // the "related" edge. The optional arguments are used to configure the query builder of the edge.
 func (dq *DocQuery) WithRelated(opts ...func(*DocQuery)) *DocQuery {
-	query := &DocQuery{config: dq.config}
+	query := (&DocClient{config: dq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -415,6 +509,16 @@ func (dq *DocQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range dq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dq); err != nil {
+				return err
+			}
+		}
+	}
 	if dq.path != nil {
 		prev, err := dq.path(ctx)
 		if err != nil {
@@ -454,7 +558,7 @@ func (dq *DocQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Doc, err
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, dq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, dq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -605,7 +709,7 @@ func (dq *DocQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(dq.fields) > 0 {
 		_spec.Unique = dq.unique != nil && *dq.unique
 	}
-	return sqlgraph.CountNodes(ctx, dq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, dq.queryDriver(), _spec)
 }
 
 func (dq *DocQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -696,6 +800,32 @@ func (dq *DocQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (dq *DocQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: doc.Label}
+	if len(dq.predicates) > 0 {
+		selector := sql.Dialect(dq.driver.Dialect()).Select().From(sql.Table(doc.Table))
+		for _, p := range dq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the DocQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (dq *DocQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Doc, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Doc(ps[i]))
+	}
+	dq.predicates = append(dq.predicates, wps...)
+}
+
 // DocGroupBy is the group-by builder for Doc entities.
 type DocGroupBy struct {
 	config
@@ -735,7 +865,7 @@ func (dgb *DocGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := dgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := dgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -781,7 +911,7 @@ func (ds *DocSelect) Scan(ctx context.Context, v interface{}) error {
 func (ds *DocSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ds.sql.Query()
-	if err := ds.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ds.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
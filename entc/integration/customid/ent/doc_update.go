@@ -384,7 +384,7 @@ func (du *DocUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{doc.Label}
+			err = &NotFoundError{label: doc.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -396,9 +396,18 @@ func (du *DocUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // DocUpdateOne is the builder for updating a single Doc entity.
 type DocUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *DocMutation
+	fields     []string
+	conditions []predicate.Doc
+	hooks      []Hook
+	mutation   *DocMutation
+}
+
+// Where appends a list of conditions to the DocUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (duo *DocUpdateOne) Where(ps ...predicate.Doc) *DocUpdateOne {
+	duo.conditions = append(duo.conditions, ps...)
+	return duo
 }
 
 // SetText sets the "text" field.
@@ -625,6 +634,13 @@ func (duo *DocUpdateOne) sqlSave(ctx context.Context) (_node *Doc, err error) {
 			}
 		}
 	}
+	if ps := duo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := duo.mutation.Text(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -786,7 +802,9 @@ func (duo *DocUpdateOne) sqlSave(ctx context.Context) (_node *Doc, err error) {
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{doc.Label}
+			err = &NotFoundError{label: doc.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: doc.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
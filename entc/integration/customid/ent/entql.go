@@ -15,6 +15,7 @@ import (
 	"entgo.io/ent/entc/integration/customid/ent/doc"
 	"entgo.io/ent/entc/integration/customid/ent/group"
 	"entgo.io/ent/entc/integration/customid/ent/intsid"
+	"entgo.io/ent/entc/integration/customid/ent/invoice"
 	"entgo.io/ent/entc/integration/customid/ent/mixinid"
 	"entgo.io/ent/entc/integration/customid/ent/note"
 	"entgo.io/ent/entc/integration/customid/ent/other"
@@ -33,7 +34,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 16)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 17)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   account.Table,
@@ -142,6 +143,19 @@ var schemaGraph = func() *sqlgraph.Schema {
 		Fields: map[string]*sqlgraph.FieldSpec{},
 	}
 	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+		},
+		Type: "Invoice",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			invoice.FieldTenant:  {Type: field.TypeInt, Column: invoice.FieldTenant},
+			invoice.FieldNumber:  {Type: field.TypeString, Column: invoice.FieldNumber},
+			invoice.FieldTotal:   {Type: field.TypeFloat64, Column: invoice.FieldTotal},
+			invoice.FieldOwnerID: {Type: field.TypeInt, Column: invoice.FieldOwnerID},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   mixinid.Table,
 			Columns: mixinid.Columns,
@@ -156,7 +170,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			mixinid.FieldMixinField: {Type: field.TypeString, Column: mixinid.FieldMixinField},
 		},
 	}
-	graph.Nodes[9] = &sqlgraph.Node{
+	graph.Nodes[10] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   note.Table,
 			Columns: note.Columns,
@@ -170,7 +184,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			note.FieldText: {Type: field.TypeString, Column: note.FieldText},
 		},
 	}
-	graph.Nodes[10] = &sqlgraph.Node{
+	graph.Nodes[11] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   other.Table,
 			Columns: other.Columns,
@@ -182,7 +196,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 		Type:   "Other",
 		Fields: map[string]*sqlgraph.FieldSpec{},
 	}
-	graph.Nodes[11] = &sqlgraph.Node{
+	graph.Nodes[12] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   pet.Table,
 			Columns: pet.Columns,
@@ -194,7 +208,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 		Type:   "Pet",
 		Fields: map[string]*sqlgraph.FieldSpec{},
 	}
-	graph.Nodes[12] = &sqlgraph.Node{
+	graph.Nodes[13] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   revision.Table,
 			Columns: revision.Columns,
@@ -206,7 +220,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 		Type:   "Revision",
 		Fields: map[string]*sqlgraph.FieldSpec{},
 	}
-	graph.Nodes[13] = &sqlgraph.Node{
+	graph.Nodes[14] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   session.Table,
 			Columns: session.Columns,
@@ -218,7 +232,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 		Type:   "Session",
 		Fields: map[string]*sqlgraph.FieldSpec{},
 	}
-	graph.Nodes[14] = &sqlgraph.Node{
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   token.Table,
 			Columns: token.Columns,
@@ -232,7 +246,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			token.FieldBody: {Type: field.TypeString, Column: token.FieldBody},
 		},
 	}
-	graph.Nodes[15] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -424,6 +438,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"IntSID",
 		"IntSID",
 	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OwnerTable,
+			Columns: []string{invoice.OwnerColumn},
+			Bidi:    false,
+		},
+		"Invoice",
+		"User",
+	)
 	graph.MustAddE(
 		"parent",
 		&sqlgraph.EdgeSpec{
@@ -568,6 +594,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"User",
 		"Pet",
 	)
+	graph.MustAddE(
+		"invoices",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvoicesTable,
+			Columns: []string{user.InvoicesColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Invoice",
+	)
 	return graph
 }()
 
@@ -587,6 +625,29 @@ func (aq *AccountQuery) Filter() *AccountFilter {
 	return &AccountFilter{config: aq.config, predicateAdder: aq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// AccountQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (aq *AccountQuery) Apply(q *entql.Query) *AccountQuery {
+	if q.Where != nil {
+		aq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			aq.Order(Desc(o.Field))
+		} else {
+			aq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		aq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		aq.Offset(*q.Offset)
+	}
+	return aq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *AccountMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -646,6 +707,29 @@ func (bq *BlobQuery) Filter() *BlobFilter {
 	return &BlobFilter{config: bq.config, predicateAdder: bq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// BlobQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (bq *BlobQuery) Apply(q *entql.Query) *BlobQuery {
+	if q.Where != nil {
+		bq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			bq.Order(Desc(o.Field))
+		} else {
+			bq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		bq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		bq.Offset(*q.Offset)
+	}
+	return bq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *BlobMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -738,6 +822,29 @@ func (blq *BlobLinkQuery) Filter() *BlobLinkFilter {
 	return &BlobLinkFilter{config: blq.config, predicateAdder: blq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// BlobLinkQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (blq *BlobLinkQuery) Apply(q *entql.Query) *BlobLinkQuery {
+	if q.Where != nil {
+		blq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			blq.Order(Desc(o.Field))
+		} else {
+			blq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		blq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		blq.Offset(*q.Offset)
+	}
+	return blq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *BlobLinkMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -816,6 +923,29 @@ func (cq *CarQuery) Filter() *CarFilter {
 	return &CarFilter{config: cq.config, predicateAdder: cq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// CarQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (cq *CarQuery) Apply(q *entql.Query) *CarQuery {
+	if q.Where != nil {
+		cq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			cq.Order(Desc(o.Field))
+		} else {
+			cq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		cq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		cq.Offset(*q.Offset)
+	}
+	return cq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *CarMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -885,6 +1015,29 @@ func (dq *DeviceQuery) Filter() *DeviceFilter {
 	return &DeviceFilter{config: dq.config, predicateAdder: dq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// DeviceQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (dq *DeviceQuery) Apply(q *entql.Query) *DeviceQuery {
+	if q.Where != nil {
+		dq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			dq.Order(Desc(o.Field))
+		} else {
+			dq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		dq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		dq.Offset(*q.Offset)
+	}
+	return dq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *DeviceMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -953,6 +1106,29 @@ func (dq *DocQuery) Filter() *DocFilter {
 	return &DocFilter{config: dq.config, predicateAdder: dq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// DocQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (dq *DocQuery) Apply(q *entql.Query) *DocQuery {
+	if q.Where != nil {
+		dq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			dq.Order(Desc(o.Field))
+		} else {
+			dq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		dq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		dq.Offset(*q.Offset)
+	}
+	return dq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *DocMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1040,6 +1216,29 @@ func (gq *GroupQuery) Filter() *GroupFilter {
 	return &GroupFilter{config: gq.config, predicateAdder: gq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// GroupQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (gq *GroupQuery) Apply(q *entql.Query) *GroupQuery {
+	if q.Where != nil {
+		gq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			gq.Order(Desc(o.Field))
+		} else {
+			gq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		gq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		gq.Offset(*q.Offset)
+	}
+	return gq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *GroupMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1094,6 +1293,29 @@ func (isq *IntSIDQuery) Filter() *IntSIDFilter {
 	return &IntSIDFilter{config: isq.config, predicateAdder: isq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// IntSIDQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (isq *IntSIDQuery) Apply(q *entql.Query) *IntSIDQuery {
+	if q.Where != nil {
+		isq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			isq.Order(Desc(o.Field))
+		} else {
+			isq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		isq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		isq.Offset(*q.Offset)
+	}
+	return isq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *IntSIDMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1152,6 +1374,98 @@ func (f *IntSIDFilter) WhereHasChildrenWith(preds ...predicate.IntSID) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (iq *InvoiceQuery) addPredicate(pred func(s *sql.Selector)) {
+	iq.predicates = append(iq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the InvoiceQuery builder.
+func (iq *InvoiceQuery) Filter() *InvoiceFilter {
+	return &InvoiceFilter{config: iq.config, predicateAdder: iq}
+}
+
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// InvoiceQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (iq *InvoiceQuery) Apply(q *entql.Query) *InvoiceQuery {
+	if q.Where != nil {
+		iq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			iq.Order(Desc(o.Field))
+		} else {
+			iq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		iq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		iq.Offset(*q.Offset)
+	}
+	return iq
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *InvoiceMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the InvoiceMutation builder.
+func (m *InvoiceMutation) Filter() *InvoiceFilter {
+	return &InvoiceFilter{config: m.config, predicateAdder: m}
+}
+
+// InvoiceFilter provides a generic filtering capability at runtime for InvoiceQuery.
+type InvoiceFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *InvoiceFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereTenant applies the entql int predicate on the tenant field.
+func (f *InvoiceFilter) WhereTenant(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldTenant))
+}
+
+// WhereNumber applies the entql string predicate on the number field.
+func (f *InvoiceFilter) WhereNumber(p entql.StringP) {
+	f.Where(p.Field(invoice.FieldNumber))
+}
+
+// WhereTotal applies the entql float64 predicate on the total field.
+func (f *InvoiceFilter) WhereTotal(p entql.Float64P) {
+	f.Where(p.Field(invoice.FieldTotal))
+}
+
+// WhereOwnerID applies the entql int predicate on the owner_id field.
+func (f *InvoiceFilter) WhereOwnerID(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldOwnerID))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *InvoiceFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *InvoiceFilter) WhereHasOwnerWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (miq *MixinIDQuery) addPredicate(pred func(s *sql.Selector)) {
 	miq.predicates = append(miq.predicates, pred)
@@ -1162,6 +1476,29 @@ func (miq *MixinIDQuery) Filter() *MixinIDFilter {
 	return &MixinIDFilter{config: miq.config, predicateAdder: miq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// MixinIDQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (miq *MixinIDQuery) Apply(q *entql.Query) *MixinIDQuery {
+	if q.Where != nil {
+		miq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			miq.Order(Desc(o.Field))
+		} else {
+			miq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		miq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		miq.Offset(*q.Offset)
+	}
+	return miq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *MixinIDMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1181,7 +1518,7 @@ type MixinIDFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MixinIDFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1212,6 +1549,29 @@ func (nq *NoteQuery) Filter() *NoteFilter {
 	return &NoteFilter{config: nq.config, predicateAdder: nq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// NoteQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (nq *NoteQuery) Apply(q *entql.Query) *NoteQuery {
+	if q.Where != nil {
+		nq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			nq.Order(Desc(o.Field))
+		} else {
+			nq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		nq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		nq.Offset(*q.Offset)
+	}
+	return nq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *NoteMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1231,7 +1591,7 @@ type NoteFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *NoteFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1285,6 +1645,29 @@ func (oq *OtherQuery) Filter() *OtherFilter {
 	return &OtherFilter{config: oq.config, predicateAdder: oq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// OtherQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (oq *OtherQuery) Apply(q *entql.Query) *OtherQuery {
+	if q.Where != nil {
+		oq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			oq.Order(Desc(o.Field))
+		} else {
+			oq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		oq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		oq.Offset(*q.Offset)
+	}
+	return oq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *OtherMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1304,7 +1687,7 @@ type OtherFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *OtherFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1325,6 +1708,29 @@ func (pq *PetQuery) Filter() *PetFilter {
 	return &PetFilter{config: pq.config, predicateAdder: pq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// PetQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (pq *PetQuery) Apply(q *entql.Query) *PetQuery {
+	if q.Where != nil {
+		pq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			pq.Order(Desc(o.Field))
+		} else {
+			pq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		pq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		pq.Offset(*q.Offset)
+	}
+	return pq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *PetMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1344,7 +1750,7 @@ type PetFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PetFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1421,6 +1827,29 @@ func (rq *RevisionQuery) Filter() *RevisionFilter {
 	return &RevisionFilter{config: rq.config, predicateAdder: rq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// RevisionQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (rq *RevisionQuery) Apply(q *entql.Query) *RevisionQuery {
+	if q.Where != nil {
+		rq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			rq.Order(Desc(o.Field))
+		} else {
+			rq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		rq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		rq.Offset(*q.Offset)
+	}
+	return rq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *RevisionMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1440,7 +1869,7 @@ type RevisionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RevisionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1461,6 +1890,29 @@ func (sq *SessionQuery) Filter() *SessionFilter {
 	return &SessionFilter{config: sq.config, predicateAdder: sq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// SessionQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (sq *SessionQuery) Apply(q *entql.Query) *SessionQuery {
+	if q.Where != nil {
+		sq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			sq.Order(Desc(o.Field))
+		} else {
+			sq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		sq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		sq.Offset(*q.Offset)
+	}
+	return sq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *SessionMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1480,7 +1932,7 @@ type SessionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SessionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1515,6 +1967,29 @@ func (tq *TokenQuery) Filter() *TokenFilter {
 	return &TokenFilter{config: tq.config, predicateAdder: tq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// TokenQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (tq *TokenQuery) Apply(q *entql.Query) *TokenQuery {
+	if q.Where != nil {
+		tq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			tq.Order(Desc(o.Field))
+		} else {
+			tq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		tq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		tq.Offset(*q.Offset)
+	}
+	return tq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *TokenMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1534,7 +2009,7 @@ type TokenFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TokenFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1574,6 +2049,29 @@ func (uq *UserQuery) Filter() *UserFilter {
 	return &UserFilter{config: uq.config, predicateAdder: uq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// UserQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (uq *UserQuery) Apply(q *entql.Query) *UserQuery {
+	if q.Where != nil {
+		uq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			uq.Order(Desc(o.Field))
+		} else {
+			uq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		uq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		uq.Offset(*q.Offset)
+	}
+	return uq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1593,7 +2091,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1659,3 +2157,17 @@ func (f *UserFilter) WhereHasPetsWith(preds ...predicate.Pet) {
 		}
 	})))
 }
+
+// WhereHasInvoices applies a predicate to check if query has an edge invoices.
+func (f *UserFilter) WhereHasInvoices() {
+	f.Where(entql.HasEdge("invoices"))
+}
+
+// WhereHasInvoicesWith applies a predicate to check if query has an edge invoices with a given conditions (other predicates).
+func (f *UserFilter) WhereHasInvoicesWith(preds ...predicate.Invoice) {
+	f.Where(entql.HasEdgeWith("invoices", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
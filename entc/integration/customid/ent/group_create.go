@@ -216,6 +216,7 @@ type (
 	//  one Group node.
 	GroupUpsertOne struct {
 		create *GroupCreate
+		err    error // first validation error of the update values.
 	}
 
 	// GroupUpsert is the "OnConflict" setter.
@@ -276,6 +277,9 @@ func (u *GroupUpsertOne) Update(set func(*GroupUpsert)) *GroupUpsertOne {
 
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for GroupCreate.OnConflict")
 	}
@@ -284,13 +288,16 @@ func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *GroupUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *GroupUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -428,6 +435,7 @@ func (gcb *GroupCreateBulk) OnConflictColumns(columns ...string) *GroupUpsertBul
 // a bulk of Group nodes.
 type GroupUpsertBulk struct {
 	create *GroupCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -485,6 +493,9 @@ func (u *GroupUpsertBulk) Update(set func(*GroupUpsert)) *GroupUpsertBulk {
 
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GroupCreateBulk instead", i)
@@ -498,7 +509,7 @@ func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *GroupUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -258,6 +258,7 @@ type (
 	//  one IntSID node.
 	IntSIDUpsertOne struct {
 		create *IntSIDCreate
+		err    error // first validation error of the update values.
 	}
 
 	// IntSIDUpsert is the "OnConflict" setter.
@@ -318,6 +319,9 @@ func (u *IntSIDUpsertOne) Update(set func(*IntSIDUpsert)) *IntSIDUpsertOne {
 
 // Exec executes the query.
 func (u *IntSIDUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for IntSIDCreate.OnConflict")
 	}
@@ -326,13 +330,16 @@ func (u *IntSIDUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *IntSIDUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *IntSIDUpsertOne) ID(ctx context.Context) (id sid.ID, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -471,6 +478,7 @@ func (iscb *IntSIDCreateBulk) OnConflictColumns(columns ...string) *IntSIDUpsert
 // a bulk of IntSID nodes.
 type IntSIDUpsertBulk struct {
 	create *IntSIDCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -528,6 +536,9 @@ func (u *IntSIDUpsertBulk) Update(set func(*IntSIDUpsert)) *IntSIDUpsertBulk {
 
 // Exec executes the query.
 func (u *IntSIDUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the IntSIDCreateBulk instead", i)
@@ -541,7 +552,7 @@ func (u *IntSIDUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *IntSIDUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -107,7 +107,7 @@ func (isdo *IntSIDDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{intsid.Label}
+		return &NotFoundError{label: intsid.Label}
 	default:
 		return nil
 	}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/intsid"
@@ -28,6 +29,7 @@ type IntSIDQuery struct {
 	unique       *bool
 	order        []OrderFunc
 	fields       []string
+	inters       []Interceptor
 	predicates   []predicate.IntSID
 	withParent   *IntSIDQuery
 	withChildren *IntSIDQuery
@@ -70,7 +72,7 @@ func (isq *IntSIDQuery) Order(o ...OrderFunc) *IntSIDQuery {
 
 // QueryParent chains the current query on the "parent" edge.
 func (isq *IntSIDQuery) QueryParent() *IntSIDQuery {
-	query := &IntSIDQuery{config: isq.config}
+	query := (&IntSIDClient{config: isq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := isq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -92,7 +94,7 @@ func (isq *IntSIDQuery) QueryParent() *IntSIDQuery {
 
 // QueryChildren chains the current query on the "children" edge.
 func (isq *IntSIDQuery) QueryChildren() *IntSIDQuery {
-	query := &IntSIDQuery{config: isq.config}
+	query := (&IntSIDClient{config: isq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := isq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -120,7 +122,7 @@ func (isq *IntSIDQuery) First(ctx context.Context) (*IntSID, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{intsid.Label}
+		return nil, isq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -142,7 +144,7 @@ func (isq *IntSIDQuery) FirstID(ctx context.Context) (id sid.ID, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{intsid.Label}
+		err = isq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -169,7 +171,7 @@ func (isq *IntSIDQuery) Only(ctx context.Context) (*IntSID, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{intsid.Label}
+		return nil, isq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{intsid.Label}
 	}
@@ -196,7 +198,7 @@ func (isq *IntSIDQuery) OnlyID(ctx context.Context) (id sid.ID, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{intsid.Label}
+		err = isq.sqlNotFound()
 	default:
 		err = &NotSingularError{intsid.Label}
 	}
@@ -217,7 +219,17 @@ func (isq *IntSIDQuery) All(ctx context.Context) ([]*IntSID, error) {
 	if err := isq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return isq.sqlAll(ctx)
+	v, err := isq.intercept(ctx, "All", func(ctx context.Context, query *IntSIDQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*IntSID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*IntSID", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -231,10 +243,20 @@ func (isq *IntSIDQuery) AllX(ctx context.Context) []*IntSID {
 
 // IDs executes the query and returns a list of IntSID IDs.
 func (isq *IntSIDQuery) IDs(ctx context.Context) ([]sid.ID, error) {
-	var ids []sid.ID
-	if err := isq.Select(intsid.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := isq.intercept(ctx, "IDs", func(ctx context.Context, query *IntSIDQuery) (Value, error) {
+		var ids []sid.ID
+		if err := query.Select(intsid.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]sid.ID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []sid.ID", v)
+	}
 	return ids, nil
 }
 
@@ -252,7 +274,17 @@ func (isq *IntSIDQuery) Count(ctx context.Context) (int, error) {
 	if err := isq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return isq.sqlCount(ctx)
+	v, err := isq.intercept(ctx, "Count", func(ctx context.Context, query *IntSIDQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -269,7 +301,61 @@ func (isq *IntSIDQuery) Exist(ctx context.Context) (bool, error) {
 	if err := isq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return isq.sqlExist(ctx)
+	v, err := isq.intercept(ctx, "Exist", func(ctx context.Context, query *IntSIDQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (isq *IntSIDQuery) intercept(ctx context.Context, op string, fn func(context.Context, *IntSIDQuery) (Value, error)) (Value, error) {
+	if len(isq.inters) == 0 {
+		return fn(ctx, isq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeIntSID,
+		Op:     op,
+		Limit:  isq.limit,
+		Offset: isq.offset,
+		Unique: isq.unique,
+		Fields: isq.fields,
+	}
+	for _, p := range isq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range isq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if isq.withParent != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, intsid.EdgeParent)
+		qc.EdgeQueries[intsid.EdgeParent] = isq.withParent
+	}
+	if isq.withChildren != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, intsid.EdgeChildren)
+		qc.EdgeQueries[intsid.EdgeChildren] = isq.withChildren
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*IntSIDQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *IntSIDQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, isq, qc, qr, isq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -292,6 +378,7 @@ func (isq *IntSIDQuery) Clone() *IntSIDQuery {
 		limit:        isq.limit,
 		offset:       isq.offset,
 		order:        append([]OrderFunc{}, isq.order...),
+		inters:       append([]Interceptor{}, isq.inters...),
 		predicates:   append([]predicate.IntSID{}, isq.predicates...),
 		withParent:   isq.withParent.Clone(),
 		withChildren: isq.withChildren.Clone(),
@@ -305,7 +392,7 @@ func (isq *IntSIDQuery) Clone() *IntSIDQuery {
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (isq *IntSIDQuery) WithParent(opts ...func(*IntSIDQuery)) *IntSIDQuery {
-	query := &IntSIDQuery{config: isq.config}
+	query := (&IntSIDClient{config: isq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -316,7 +403,7 @@ func (isq *IntSIDQuery) WithParent(opts ...func(*IntSIDQuery)) *IntSIDQuery {
 // WithChildren tells the query-builder to eager-load the nodes that are connected to
 // the "children" edge. The optional arguments are used to configure the query builder of the edge.
 func (isq *IntSIDQuery) WithChildren(opts ...func(*IntSIDQuery)) *IntSIDQuery {
-	query := &IntSIDQuery{config: isq.config}
+	query := (&IntSIDClient{config: isq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -356,6 +443,16 @@ func (isq *IntSIDQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range isq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, isq); err != nil {
+				return err
+			}
+		}
+	}
 	if isq.path != nil {
 		prev, err := isq.path(ctx)
 		if err != nil {
@@ -394,7 +491,7 @@ func (isq *IntSIDQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IntS
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, isq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, isq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -483,7 +580,7 @@ func (isq *IntSIDQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(isq.fields) > 0 {
 		_spec.Unique = isq.unique != nil && *isq.unique
 	}
-	return sqlgraph.CountNodes(ctx, isq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, isq.queryDriver(), _spec)
 }
 
 func (isq *IntSIDQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -574,6 +671,32 @@ func (isq *IntSIDQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (isq *IntSIDQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: intsid.Label}
+	if len(isq.predicates) > 0 {
+		selector := sql.Dialect(isq.driver.Dialect()).Select().From(sql.Table(intsid.Table))
+		for _, p := range isq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the IntSIDQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (isq *IntSIDQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.IntSID, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.IntSID(ps[i]))
+	}
+	isq.predicates = append(isq.predicates, wps...)
+}
+
 // IntSIDGroupBy is the group-by builder for IntSID entities.
 type IntSIDGroupBy struct {
 	config
@@ -613,7 +736,7 @@ func (isgb *IntSIDGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := isgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := isgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -659,7 +782,7 @@ func (iss *IntSIDSelect) Scan(ctx context.Context, v interface{}) error {
 func (iss *IntSIDSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := iss.sql.Query()
-	if err := iss.driver.Query(ctx, query, args, rows); err != nil {
+	if err := iss.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -261,7 +261,7 @@ func (isu *IntSIDUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, isu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{intsid.Label}
+			err = &NotFoundError{label: intsid.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -273,9 +273,18 @@ func (isu *IntSIDUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // IntSIDUpdateOne is the builder for updating a single IntSID entity.
 type IntSIDUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *IntSIDMutation
+	fields     []string
+	conditions []predicate.IntSID
+	hooks      []Hook
+	mutation   *IntSIDMutation
+}
+
+// Where appends a list of conditions to the IntSIDUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (isuo *IntSIDUpdateOne) Where(ps ...predicate.IntSID) *IntSIDUpdateOne {
+	isuo.conditions = append(isuo.conditions, ps...)
+	return isuo
 }
 
 // SetParentID sets the "parent" edge to the IntSID entity by ID.
@@ -446,6 +455,13 @@ func (isuo *IntSIDUpdateOne) sqlSave(ctx context.Context) (_node *IntSID, err er
 			}
 		}
 	}
+	if ps := isuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if isuo.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -540,7 +556,9 @@ func (isuo *IntSIDUpdateOne) sqlSave(ctx context.Context) (_node *IntSID, err er
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, isuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{intsid.Label}
+			err = &NotFoundError{label: intsid.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: intsid.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -0,0 +1,160 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/customid/ent/invoice"
+	"entgo.io/ent/entc/integration/customid/ent/user"
+)
+
+// Invoice is the model entity for the Invoice schema.
+type Invoice struct {
+	config `json:"-"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant int `json:"tenant,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// Total holds the value of the "total" field.
+	Total float64 `json:"total,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID int `json:"owner_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceQuery when eager-loading is set.
+	Edges InvoiceEdges `json:"edges"`
+}
+
+// InvoiceEdges holds the relations/edges for other nodes in the graph.
+type InvoiceEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invoice) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldTotal:
+			values[i] = new(sql.NullFloat64)
+		case invoice.FieldTenant, invoice.FieldOwnerID:
+			values[i] = new(sql.NullInt64)
+		case invoice.FieldNumber:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Invoice", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invoice fields.
+func (i *Invoice) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invoice.FieldTenant:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[j])
+			} else if value.Valid {
+				i.Tenant = int(value.Int64)
+			}
+		case invoice.FieldNumber:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[j])
+			} else if value.Valid {
+				i.Number = value.String
+			}
+		case invoice.FieldTotal:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[j])
+			} else if value.Valid {
+				i.Total = value.Float64
+			}
+		case invoice.FieldOwnerID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[j])
+			} else if value.Valid {
+				i.OwnerID = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the Invoice entity.
+func (i *Invoice) QueryOwner() *UserQuery {
+	return (&InvoiceClient{config: i.config}).QueryOwner(i)
+}
+
+// Update returns a builder for updating this Invoice.
+// Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invoice) Update() *InvoiceUpdateOne {
+	return (&InvoiceClient{config: i.config}).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invoice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invoice) Unwrap() *Invoice {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invoice is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invoice(")
+	builder.WriteString("tenant=")
+	builder.WriteString(fmt.Sprintf("%v", i.Tenant))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(i.Number)
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", i.Total))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.OwnerID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invoices is a parsable slice of Invoice.
+type Invoices []*Invoice
+
+func (i Invoices) config(cfg config) {
+	for _i := range i {
+		i[_i].config = cfg
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+const (
+	// Label holds the string label denoting the invoice type in the database.
+	Label = "invoice"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// UserFieldID holds the string denoting the ID field of the User.
+	UserFieldID = "oid"
+	// Table holds the table name of the invoice in the database.
+	Table = "invoices"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "invoices"
+	// OwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	OwnerInverseTable = "users"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "owner_id"
+)
+
+// Columns holds all SQL columns for invoice fields.
+var Columns = []string{
+	FieldTenant,
+	FieldNumber,
+	FieldTotal,
+	FieldOwnerID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal float64
+)
@@ -0,0 +1,378 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/customid/ent/predicate"
+)
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenant), v))
+	})
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNumber), v))
+	})
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotal), v))
+	})
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwnerID), v))
+	})
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenant), v))
+	})
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenant), v))
+	})
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldTenant), v...))
+	})
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldTenant), v...))
+	})
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenant), v))
+	})
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenant), v))
+	})
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenant), v))
+	})
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenant), v))
+	})
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNumber), v))
+	})
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNumber), v))
+	})
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldNumber), v...))
+	})
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldNumber), v...))
+	})
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNumber), v))
+	})
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNumber), v))
+	})
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNumber), v))
+	})
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNumber), v))
+	})
+}
+
+// NumberContains applies the Contains predicate on the "number" field.
+func NumberContains(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNumber), v))
+	})
+}
+
+// NumberHasPrefix applies the HasPrefix predicate on the "number" field.
+func NumberHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNumber), v))
+	})
+}
+
+// NumberHasSuffix applies the HasSuffix predicate on the "number" field.
+func NumberHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNumber), v))
+	})
+}
+
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNumber), v))
+	})
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNumber), v))
+	})
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotal), v))
+	})
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotal), v))
+	})
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...float64) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldTotal), v...))
+	})
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...float64) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldTotal), v...))
+	})
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTotal), v))
+	})
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTotal), v))
+	})
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTotal), v))
+	})
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTotal), v))
+	})
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldOwnerID), v...))
+	})
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldOwnerID), v...))
+	})
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOwnerID)))
+	})
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOwnerID)))
+	})
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, OwnerColumn),
+			sqlgraph.To(OwnerInverseTable, UserFieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, OwnerColumn),
+			sqlgraph.To(OwnerInverseTable, UserFieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,773 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/customid/ent/invoice"
+	"entgo.io/ent/entc/integration/customid/ent/user"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenant sets the "tenant" field.
+func (ic *InvoiceCreate) SetTenant(i int) *InvoiceCreate {
+	ic.mutation.SetTenant(i)
+	return ic
+}
+
+// SetNumber sets the "number" field.
+func (ic *InvoiceCreate) SetNumber(s string) *InvoiceCreate {
+	ic.mutation.SetNumber(s)
+	return ic
+}
+
+// SetTotal sets the "total" field.
+func (ic *InvoiceCreate) SetTotal(f float64) *InvoiceCreate {
+	ic.mutation.SetTotal(f)
+	return ic
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableTotal(f *float64) *InvoiceCreate {
+	if f != nil {
+		ic.SetTotal(*f)
+	}
+	return ic
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (ic *InvoiceCreate) SetOwnerID(i int) *InvoiceCreate {
+	ic.mutation.SetOwnerID(i)
+	return ic
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableOwnerID(i *int) *InvoiceCreate {
+	if i != nil {
+		ic.SetOwnerID(*i)
+	}
+	return ic
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (ic *InvoiceCreate) SetOwner(u *User) *InvoiceCreate {
+	return ic.SetOwnerID(u.ID)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (ic *InvoiceCreate) Mutation() *InvoiceMutation {
+	return ic.mutation
+}
+
+// Save creates the Invoice in the database.
+func (ic *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	var (
+		err  error
+		node *Invoice
+	)
+	ic.defaults()
+	if len(ic.hooks) == 0 {
+		if err = ic.check(); err != nil {
+			return nil, err
+		}
+		node, err = ic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ic.check(); err != nil {
+				return nil, err
+			}
+			ic.mutation = mutation
+			if node, err = ic.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			return node, err
+		})
+		for i := len(ic.hooks) - 1; i >= 0; i-- {
+			if ic.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ic.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ic.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Invoice)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InvoiceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InvoiceCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InvoiceCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvoiceCreate) defaults() {
+	if _, ok := ic.mutation.Total(); !ok {
+		v := invoice.DefaultTotal
+		ic.mutation.SetTotal(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvoiceCreate) check() error {
+	if _, ok := ic.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Invoice.tenant"`)}
+	}
+	if _, ok := ic.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Invoice.number"`)}
+	}
+	if _, ok := ic.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "Invoice.total"`)}
+	}
+	return nil
+}
+
+func (ic *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invoice{config: ic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invoice.Table,
+		}
+	)
+	_spec.OnConflict = ic.conflict
+	if value, ok := ic.mutation.Tenant(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTenant,
+		})
+		_node.Tenant = value
+	}
+	if value, ok := ic.mutation.Number(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldNumber,
+		})
+		_node.Number = value
+	}
+	if value, ok := ic.mutation.Total(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+		_node.Total = value
+	}
+	if nodes := ic.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OwnerTable,
+			Columns: []string{invoice.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.Create().
+//		SetTenant(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetTenant(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (ic *InvoiceCreate) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertOne {
+	ic.conflict = opts
+	return &InvoiceUpsertOne{
+		create: ic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (ic *InvoiceCreate) OnConflictColumns(columns ...string) *InvoiceUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertOne{
+		create: ic,
+	}
+}
+
+type (
+	// InvoiceUpsertOne is the builder for "upsert"-ing
+	//  one Invoice node.
+	InvoiceUpsertOne struct {
+		create *InvoiceCreate
+		err    error // first validation error of the update values.
+	}
+
+	// InvoiceUpsert is the "OnConflict" setter.
+	InvoiceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenant sets the "tenant" field.
+func (u *InvoiceUpsert) SetTenant(v int) *InvoiceUpsert {
+	u.Set(invoice.FieldTenant, v)
+	return u
+}
+
+// UpdateTenant sets the "tenant" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateTenant() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldTenant)
+	return u
+}
+
+// AddTenant adds v to the "tenant" field.
+func (u *InvoiceUpsert) AddTenant(v int) *InvoiceUpsert {
+	u.Add(invoice.FieldTenant, v)
+	return u
+}
+
+// SetNumber sets the "number" field.
+func (u *InvoiceUpsert) SetNumber(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldNumber, v)
+	return u
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateNumber() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldNumber)
+	return u
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsert) SetTotal(v float64) *InvoiceUpsert {
+	u.Set(invoice.FieldTotal, v)
+	return u
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateTotal() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldTotal)
+	return u
+}
+
+// AddTotal adds v to the "total" field.
+func (u *InvoiceUpsert) AddTotal(v float64) *InvoiceUpsert {
+	u.Add(invoice.FieldTotal, v)
+	return u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *InvoiceUpsert) SetOwnerID(v int) *InvoiceUpsert {
+	u.Set(invoice.FieldOwnerID, v)
+	return u
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateOwnerID() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldOwnerID)
+	return u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (u *InvoiceUpsert) ClearOwnerID() *InvoiceUpsert {
+	u.SetNull(invoice.FieldOwnerID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *InvoiceUpsertOne) UpdateNewValues() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(invoice.FieldTenant)
+		}
+		if _, exists := u.create.mutation.Number(); exists {
+			s.SetIgnore(invoice.FieldNumber)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+//
+func (u *InvoiceUpsertOne) Ignore() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertOne) DoNothing() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreate.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertOne) Update(set func(*InvoiceUpsert)) *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenant sets the "tenant" field.
+func (u *InvoiceUpsertOne) SetTenant(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTenant(v)
+	})
+}
+
+// AddTenant adds v to the "tenant" field.
+func (u *InvoiceUpsertOne) AddTenant(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddTenant(v)
+	})
+}
+
+// UpdateTenant sets the "tenant" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateTenant() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTenant()
+	})
+}
+
+// SetNumber sets the "number" field.
+func (u *InvoiceUpsertOne) SetNumber(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetNumber(v)
+	})
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateNumber() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateNumber()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsertOne) SetTotal(v float64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *InvoiceUpsertOne) AddTotal(v float64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateTotal() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *InvoiceUpsertOne) SetOwnerID(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateOwnerID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (u *InvoiceUpsertOne) ClearOwnerID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearOwnerID()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
+type InvoiceCreateBulk struct {
+	config
+	builders []*InvoiceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Invoice entities in the database.
+func (icb *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invoice, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetTenant(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (icb *InvoiceCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertBulk {
+	icb.conflict = opts
+	return &InvoiceUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (icb *InvoiceCreateBulk) OnConflictColumns(columns ...string) *InvoiceUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertBulk{
+		create: icb,
+	}
+}
+
+// InvoiceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invoice nodes.
+type InvoiceUpsertBulk struct {
+	create *InvoiceCreateBulk
+	err    error // first validation error of the update values.
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *InvoiceUpsertBulk) UpdateNewValues() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(invoice.FieldTenant)
+			}
+			if _, exists := b.mutation.Number(); exists {
+				s.SetIgnore(invoice.FieldNumber)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *InvoiceUpsertBulk) Ignore() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertBulk) DoNothing() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertBulk) Update(set func(*InvoiceUpsert)) *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenant sets the "tenant" field.
+func (u *InvoiceUpsertBulk) SetTenant(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTenant(v)
+	})
+}
+
+// AddTenant adds v to the "tenant" field.
+func (u *InvoiceUpsertBulk) AddTenant(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddTenant(v)
+	})
+}
+
+// UpdateTenant sets the "tenant" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateTenant() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTenant()
+	})
+}
+
+// SetNumber sets the "number" field.
+func (u *InvoiceUpsertBulk) SetNumber(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetNumber(v)
+	})
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateNumber() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateNumber()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsertBulk) SetTotal(v float64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *InvoiceUpsertBulk) AddTotal(v float64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateTotal() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *InvoiceUpsertBulk) SetOwnerID(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateOwnerID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (u *InvoiceUpsertBulk) ClearOwnerID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearOwnerID()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoiceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,632 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/customid/ent/invoice"
+	"entgo.io/ent/entc/integration/customid/ent/predicate"
+	"entgo.io/ent/entc/integration/customid/ent/user"
+)
+
+// InvoiceQuery is the builder for querying Invoice entities.
+type InvoiceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	inters     []Interceptor
+	predicates []predicate.Invoice
+	withOwner  *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvoiceQuery builder.
+func (iq *InvoiceQuery) Where(ps ...predicate.Invoice) *InvoiceQuery {
+	iq.predicates = append(iq.predicates, ps...)
+	return iq
+}
+
+// Limit adds a limit step to the query.
+func (iq *InvoiceQuery) Limit(limit int) *InvoiceQuery {
+	iq.limit = &limit
+	return iq
+}
+
+// Offset adds an offset step to the query.
+func (iq *InvoiceQuery) Offset(offset int) *InvoiceQuery {
+	iq.offset = &offset
+	return iq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iq *InvoiceQuery) Unique(unique bool) *InvoiceQuery {
+	iq.unique = &unique
+	return iq
+}
+
+// Order adds an order step to the query.
+func (iq *InvoiceQuery) Order(o ...OrderFunc) *InvoiceQuery {
+	iq.order = append(iq.order, o...)
+	return iq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (iq *InvoiceQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.OwnerColumn, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoice.OwnerTable, invoice.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Invoice entity from the query.
+// Returns a *NotFoundError when no Invoice was found.
+func (iq *InvoiceQuery) First(ctx context.Context) (*Invoice, error) {
+	nodes, err := iq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, iq.sqlNotFound()
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iq *InvoiceQuery) FirstX(ctx context.Context) *Invoice {
+	node, err := iq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// Only returns a single Invoice entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Invoice entity is found.
+// Returns a *NotFoundError when no Invoice entities are found.
+func (iq *InvoiceQuery) Only(ctx context.Context) (*Invoice, error) {
+	nodes, err := iq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, iq.sqlNotFound()
+	default:
+		return nil, &NotSingularError{invoice.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iq *InvoiceQuery) OnlyX(ctx context.Context) *Invoice {
+	node, err := iq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// All executes the query and returns a list of Invoices.
+func (iq *InvoiceQuery) All(ctx context.Context) ([]*Invoice, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	v, err := iq.intercept(ctx, "All", func(ctx context.Context, query *InvoiceQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Invoice)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Invoice", v)
+	}
+	return nodes, nil
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iq *InvoiceQuery) AllX(ctx context.Context) []*Invoice {
+	nodes, err := iq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Count returns the count of the given query.
+func (iq *InvoiceQuery) Count(ctx context.Context) (int, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	v, err := iq.intercept(ctx, "Count", func(ctx context.Context, query *InvoiceQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iq *InvoiceQuery) CountX(ctx context.Context) int {
+	count, err := iq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iq *InvoiceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	v, err := iq.intercept(ctx, "Exist", func(ctx context.Context, query *InvoiceQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (iq *InvoiceQuery) intercept(ctx context.Context, op string, fn func(context.Context, *InvoiceQuery) (Value, error)) (Value, error) {
+	if len(iq.inters) == 0 {
+		return fn(ctx, iq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeInvoice,
+		Op:     op,
+		Limit:  iq.limit,
+		Offset: iq.offset,
+		Unique: iq.unique,
+		Fields: iq.fields,
+	}
+	for _, p := range iq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range iq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if iq.withOwner != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, invoice.EdgeOwner)
+		qc.EdgeQueries[invoice.EdgeOwner] = iq.withOwner
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*InvoiceQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *InvoiceQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, iq, qc, qr, iq.inters)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iq *InvoiceQuery) ExistX(ctx context.Context) bool {
+	exist, err := iq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvoiceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iq *InvoiceQuery) Clone() *InvoiceQuery {
+	if iq == nil {
+		return nil
+	}
+	return &InvoiceQuery{
+		config:     iq.config,
+		limit:      iq.limit,
+		offset:     iq.offset,
+		order:      append([]OrderFunc{}, iq.order...),
+		inters:     append([]Interceptor{}, iq.inters...),
+		predicates: append([]predicate.Invoice{}, iq.predicates...),
+		withOwner:  iq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:    iq.sql.Clone(),
+		path:   iq.path,
+		unique: iq.unique,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *InvoiceQuery) WithOwner(opts ...func(*UserQuery)) *InvoiceQuery {
+	query := (&UserClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withOwner = query
+	return iq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant int `json:"tenant,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Invoice.Query().
+//		GroupBy(invoice.FieldTenant).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (iq *InvoiceQuery) GroupBy(field string, fields ...string) *InvoiceGroupBy {
+	grbuild := &InvoiceGroupBy{config: iq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return iq.sqlQuery(ctx), nil
+	}
+	grbuild.label = invoice.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant int `json:"tenant,omitempty"`
+//	}
+//
+//	client.Invoice.Query().
+//		Select(invoice.FieldTenant).
+//		Scan(ctx, &v)
+//
+func (iq *InvoiceQuery) Select(fields ...string) *InvoiceSelect {
+	iq.fields = append(iq.fields, fields...)
+	selbuild := &InvoiceSelect{InvoiceQuery: iq}
+	selbuild.label = invoice.Label
+	selbuild.flds, selbuild.scan = &iq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (iq *InvoiceQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range iq.fields {
+		if !invoice.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	for _, inter := range iq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iq); err != nil {
+				return err
+			}
+		}
+	}
+	if iq.path != nil {
+		prev, err := iq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iq.sql = prev
+	}
+	return nil
+}
+
+func (iq *InvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Invoice, error) {
+	var (
+		nodes       = []*Invoice{}
+		_spec       = iq.querySpec()
+		loadedTypes = [1]bool{
+			iq.withOwner != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Invoice).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Invoice{config: iq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := iq.withOwner; query != nil {
+		if err := iq.loadOwner(ctx, query, nodes, nil,
+			func(n *Invoice, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (iq *InvoiceQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Invoice)
+	for i := range nodes {
+		fk := nodes[i].OwnerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "owner_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (iq *InvoiceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iq.querySpec()
+	_spec.Unique = false
+	_spec.Node.Columns = nil
+	return sqlgraph.CountNodes(ctx, iq.queryDriver(), _spec)
+}
+
+func (iq *InvoiceQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := iq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (iq *InvoiceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+		},
+		From:   iq.sql,
+		Unique: true,
+	}
+	if unique := iq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := iq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		for i := range fields {
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+	}
+	if ps := iq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iq *InvoiceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iq.driver.Dialect())
+	t1 := builder.Table(invoice.Table)
+	columns := iq.fields
+	if len(columns) == 0 {
+		columns = invoice.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iq.sql != nil {
+		selector = iq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iq.unique != nil && *iq.unique {
+		selector.Distinct()
+	}
+	for _, p := range iq.predicates {
+		p(selector)
+	}
+	for _, p := range iq.order {
+		p(selector)
+	}
+	if offset := iq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (iq *InvoiceQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: invoice.Label}
+	if len(iq.predicates) > 0 {
+		selector := sql.Dialect(iq.driver.Dialect()).Select().From(sql.Table(invoice.Table))
+		for _, p := range iq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the InvoiceQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (iq *InvoiceQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Invoice, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Invoice(ps[i]))
+	}
+	iq.predicates = append(iq.predicates, wps...)
+}
+
+// InvoiceGroupBy is the group-by builder for Invoice entities.
+type InvoiceGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (igb *InvoiceGroupBy) Aggregate(fns ...AggregateFunc) *InvoiceGroupBy {
+	igb.fns = append(igb.fns, fns...)
+	return igb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (igb *InvoiceGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := igb.path(ctx)
+	if err != nil {
+		return err
+	}
+	igb.sql = query
+	return igb.sqlScan(ctx, v)
+}
+
+func (igb *InvoiceGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range igb.fields {
+		if !invoice.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := igb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := igb.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (igb *InvoiceGroupBy) sqlQuery() *sql.Selector {
+	selector := igb.sql.Select()
+	aggregation := make([]string, 0, len(igb.fns))
+	for _, fn := range igb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(igb.fields)+len(igb.fns))
+		for _, f := range igb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(igb.fields...)...)
+}
+
+// InvoiceSelect is the builder for selecting fields of Invoice entities.
+type InvoiceSelect struct {
+	*InvoiceQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (is *InvoiceSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := is.prepareQuery(ctx); err != nil {
+		return err
+	}
+	is.sql = is.InvoiceQuery.sqlQuery(ctx)
+	return is.sqlScan(ctx, v)
+}
+
+func (is *InvoiceSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := is.sql.Query()
+	if err := is.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
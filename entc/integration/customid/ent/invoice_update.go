@@ -0,0 +1,512 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/customid/ent/invoice"
+	"entgo.io/ent/entc/integration/customid/ent/predicate"
+	"entgo.io/ent/entc/integration/customid/ent/user"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (iu *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetTotal sets the "total" field.
+func (iu *InvoiceUpdate) SetTotal(f float64) *InvoiceUpdate {
+	iu.mutation.ResetTotal()
+	iu.mutation.SetTotal(f)
+	return iu
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableTotal(f *float64) *InvoiceUpdate {
+	if f != nil {
+		iu.SetTotal(*f)
+	}
+	return iu
+}
+
+// AddTotal adds f to the "total" field.
+func (iu *InvoiceUpdate) AddTotal(f float64) *InvoiceUpdate {
+	iu.mutation.AddTotal(f)
+	return iu
+}
+
+// SetTotalIfGreater sets the "total" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (iu *InvoiceUpdate) SetTotalIfGreater(f float64) *InvoiceUpdate {
+	iu.mutation.SetTotalIfGreater(f)
+	return iu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (iu *InvoiceUpdate) SetOwnerID(i int) *InvoiceUpdate {
+	iu.mutation.SetOwnerID(i)
+	return iu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableOwnerID(i *int) *InvoiceUpdate {
+	if i != nil {
+		iu.SetOwnerID(*i)
+	}
+	return iu
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (iu *InvoiceUpdate) ClearOwnerID() *InvoiceUpdate {
+	iu.mutation.ClearOwnerID()
+	return iu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (iu *InvoiceUpdate) SetOwner(u *User) *InvoiceUpdate {
+	return iu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iu *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return iu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (iu *InvoiceUpdate) ClearOwner() *InvoiceUpdate {
+	iu.mutation.ClearOwner()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iu.hooks) == 0 {
+		affected, err = iu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iu.mutation = mutation
+			affected, err = iu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iu.hooks) - 1; i >= 0; i-- {
+			if iu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			CompositeID: []*sqlgraph.FieldSpec{
+				{
+					Type:   field.TypeInt,
+					Column: invoice.FieldTenant,
+				},
+				{
+					Type:   field.TypeString,
+					Column: invoice.FieldNumber,
+				},
+			},
+		},
+	}
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Total(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+	}
+	if value, ok := iu.mutation.AddedTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+	}
+	if value, ok := iu.mutation.TotalIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+	}
+	if iu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OwnerTable,
+			Columns: []string{invoice.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OwnerTable,
+			Columns: []string{invoice.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label: invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields     []string
+	conditions []predicate.Invoice
+	hooks      []Hook
+	mutation   *InvoiceMutation
+}
+
+// Where appends a list of conditions to the InvoiceUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (iuo *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
+	iuo.conditions = append(iuo.conditions, ps...)
+	return iuo
+}
+
+// SetTotal sets the "total" field.
+func (iuo *InvoiceUpdateOne) SetTotal(f float64) *InvoiceUpdateOne {
+	iuo.mutation.ResetTotal()
+	iuo.mutation.SetTotal(f)
+	return iuo
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableTotal(f *float64) *InvoiceUpdateOne {
+	if f != nil {
+		iuo.SetTotal(*f)
+	}
+	return iuo
+}
+
+// AddTotal adds f to the "total" field.
+func (iuo *InvoiceUpdateOne) AddTotal(f float64) *InvoiceUpdateOne {
+	iuo.mutation.AddTotal(f)
+	return iuo
+}
+
+// SetTotalIfGreater sets the "total" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (iuo *InvoiceUpdateOne) SetTotalIfGreater(f float64) *InvoiceUpdateOne {
+	iuo.mutation.SetTotalIfGreater(f)
+	return iuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (iuo *InvoiceUpdateOne) SetOwnerID(i int) *InvoiceUpdateOne {
+	iuo.mutation.SetOwnerID(i)
+	return iuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableOwnerID(i *int) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetOwnerID(*i)
+	}
+	return iuo
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (iuo *InvoiceUpdateOne) ClearOwnerID() *InvoiceUpdateOne {
+	iuo.mutation.ClearOwnerID()
+	return iuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (iuo *InvoiceUpdateOne) SetOwner(u *User) *InvoiceUpdateOne {
+	return iuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iuo *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return iuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (iuo *InvoiceUpdateOne) ClearOwner() *InvoiceUpdateOne {
+	iuo.mutation.ClearOwner()
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvoiceUpdateOne) Select(field string, fields ...string) *InvoiceUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invoice entity.
+func (iuo *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	var (
+		err  error
+		node *Invoice
+	)
+	if len(iuo.hooks) == 0 {
+		node, err = iuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iuo.mutation = mutation
+			node, err = iuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iuo.hooks) - 1; i >= 0; i-- {
+			if iuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, iuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Invoice)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InvoiceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			CompositeID: []*sqlgraph.FieldSpec{
+				{
+					Type:   field.TypeInt,
+					Column: invoice.FieldTenant,
+				},
+				{
+					Type:   field.TypeString,
+					Column: invoice.FieldNumber,
+				},
+			},
+		},
+	}
+	if id, ok := iuo.mutation.Tenant(); !ok {
+		return nil, &ValidationError{Name: "tenant", err: errors.New(`ent: missing "Invoice.tenant" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := iuo.mutation.Number(); !ok {
+		return nil, &ValidationError{Name: "number", err: errors.New(`ent: missing "Invoice.number" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !invoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ps := iuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Total(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+	}
+	if value, ok := iuo.mutation.AddedTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+	}
+	if value, ok := iuo.mutation.TotalIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+	}
+	if iuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OwnerTable,
+			Columns: []string{invoice.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OwnerTable,
+			Columns: []string{invoice.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invoice{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label: invoice.Label}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: invoice.Label, version: e.Version()}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
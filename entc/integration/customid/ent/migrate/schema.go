@@ -160,6 +160,27 @@ var (
 			},
 		},
 	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "tenant", Type: field.TypeInt},
+		{Name: "number", Type: field.TypeString},
+		{Name: "total", Type: field.TypeFloat64, Default: 0},
+		{Name: "owner_id", Type: field.TypeInt, Nullable: true},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0], InvoicesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoices_users_invoices",
+				Columns:    []*schema.Column{InvoicesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// MixinIdsColumns holds the columns for the "mixin_ids" table.
 	MixinIdsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -403,6 +424,7 @@ var (
 		DocsTable,
 		GroupsTable,
 		IntSiDsTable,
+		InvoicesTable,
 		MixinIdsTable,
 		NotesTable,
 		OthersTable,
@@ -425,6 +447,7 @@ func init() {
 	DevicesTable.ForeignKeys[0].RefTable = SessionsTable
 	DocsTable.ForeignKeys[0].RefTable = DocsTable
 	IntSiDsTable.ForeignKeys[0].RefTable = IntSiDsTable
+	InvoicesTable.ForeignKeys[0].RefTable = UsersTable
 	NotesTable.ForeignKeys[0].RefTable = NotesTable
 	PetsTable.ForeignKeys[0].RefTable = PetsTable
 	PetsTable.ForeignKeys[1].RefTable = UsersTable
@@ -243,6 +243,7 @@ type (
 	//  one MixinID node.
 	MixinIDUpsertOne struct {
 		create *MixinIDCreate
+		err    error // first validation error of the update values.
 	}
 
 	// MixinIDUpsert is the "OnConflict" setter.
@@ -355,6 +356,9 @@ func (u *MixinIDUpsertOne) UpdateMixinField() *MixinIDUpsertOne {
 
 // Exec executes the query.
 func (u *MixinIDUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for MixinIDCreate.OnConflict")
 	}
@@ -363,7 +367,7 @@ func (u *MixinIDUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *MixinIDUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -375,6 +379,9 @@ func (u *MixinIDUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: MixinIDUpsertOne.ID is not supported by MySQL driver. Use MixinIDUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -514,6 +521,7 @@ func (micb *MixinIDCreateBulk) OnConflictColumns(columns ...string) *MixinIDUpse
 // a bulk of MixinID nodes.
 type MixinIDUpsertBulk struct {
 	create *MixinIDCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -599,6 +607,9 @@ func (u *MixinIDUpsertBulk) UpdateMixinField() *MixinIDUpsertBulk {
 
 // Exec executes the query.
 func (u *MixinIDUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MixinIDCreateBulk instead", i)
@@ -612,7 +623,7 @@ func (u *MixinIDUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *MixinIDUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
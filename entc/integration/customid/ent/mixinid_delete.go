@@ -107,7 +107,7 @@ func (mido *MixinIDDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{mixinid.Label}
+		return &NotFoundError{label: mixinid.Label}
 	default:
 		return nil
 	}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/mixinid"
@@ -27,6 +28,7 @@ type MixinIDQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.MixinID
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -72,7 +74,7 @@ func (miq *MixinIDQuery) First(ctx context.Context) (*MixinID, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{mixinid.Label}
+		return nil, miq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -94,7 +96,7 @@ func (miq *MixinIDQuery) FirstID(ctx context.Context) (id uuid.UUID, err error)
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{mixinid.Label}
+		err = miq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -121,7 +123,7 @@ func (miq *MixinIDQuery) Only(ctx context.Context) (*MixinID, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{mixinid.Label}
+		return nil, miq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{mixinid.Label}
 	}
@@ -148,7 +150,7 @@ func (miq *MixinIDQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{mixinid.Label}
+		err = miq.sqlNotFound()
 	default:
 		err = &NotSingularError{mixinid.Label}
 	}
@@ -169,7 +171,17 @@ func (miq *MixinIDQuery) All(ctx context.Context) ([]*MixinID, error) {
 	if err := miq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return miq.sqlAll(ctx)
+	v, err := miq.intercept(ctx, "All", func(ctx context.Context, query *MixinIDQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*MixinID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*MixinID", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -183,10 +195,20 @@ func (miq *MixinIDQuery) AllX(ctx context.Context) []*MixinID {
 
 // IDs executes the query and returns a list of MixinID IDs.
 func (miq *MixinIDQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	var ids []uuid.UUID
-	if err := miq.Select(mixinid.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := miq.intercept(ctx, "IDs", func(ctx context.Context, query *MixinIDQuery) (Value, error) {
+		var ids []uuid.UUID
+		if err := query.Select(mixinid.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]uuid.UUID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []uuid.UUID", v)
+	}
 	return ids, nil
 }
 
@@ -204,7 +226,17 @@ func (miq *MixinIDQuery) Count(ctx context.Context) (int, error) {
 	if err := miq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return miq.sqlCount(ctx)
+	v, err := miq.intercept(ctx, "Count", func(ctx context.Context, query *MixinIDQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -221,7 +253,47 @@ func (miq *MixinIDQuery) Exist(ctx context.Context) (bool, error) {
 	if err := miq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return miq.sqlExist(ctx)
+	v, err := miq.intercept(ctx, "Exist", func(ctx context.Context, query *MixinIDQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (miq *MixinIDQuery) intercept(ctx context.Context, op string, fn func(context.Context, *MixinIDQuery) (Value, error)) (Value, error) {
+	if len(miq.inters) == 0 {
+		return fn(ctx, miq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeMixinID,
+		Op:     op,
+		Limit:  miq.limit,
+		Offset: miq.offset,
+		Unique: miq.unique,
+		Fields: miq.fields,
+	}
+	for _, p := range miq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range miq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MixinIDQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *MixinIDQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, miq, qc, qr, miq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -244,6 +316,7 @@ func (miq *MixinIDQuery) Clone() *MixinIDQuery {
 		limit:      miq.limit,
 		offset:     miq.offset,
 		order:      append([]OrderFunc{}, miq.order...),
+		inters:     append([]Interceptor{}, miq.inters...),
 		predicates: append([]predicate.MixinID{}, miq.predicates...),
 		// clone intermediate query.
 		sql:    miq.sql.Clone(),
@@ -308,6 +381,16 @@ func (miq *MixinIDQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range miq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, miq); err != nil {
+				return err
+			}
+		}
+	}
 	if miq.path != nil {
 		prev, err := miq.path(ctx)
 		if err != nil {
@@ -334,7 +417,7 @@ func (miq *MixinIDQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mix
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, miq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, miq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -349,7 +432,7 @@ func (miq *MixinIDQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(miq.fields) > 0 {
 		_spec.Unique = miq.unique != nil && *miq.unique
 	}
-	return sqlgraph.CountNodes(ctx, miq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, miq.queryDriver(), _spec)
 }
 
 func (miq *MixinIDQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -440,6 +523,32 @@ func (miq *MixinIDQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (miq *MixinIDQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: mixinid.Label}
+	if len(miq.predicates) > 0 {
+		selector := sql.Dialect(miq.driver.Dialect()).Select().From(sql.Table(mixinid.Table))
+		for _, p := range miq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the MixinIDQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (miq *MixinIDQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.MixinID, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.MixinID(ps[i]))
+	}
+	miq.predicates = append(miq.predicates, wps...)
+}
+
 // MixinIDGroupBy is the group-by builder for MixinID entities.
 type MixinIDGroupBy struct {
 	config
@@ -479,7 +588,7 @@ func (migb *MixinIDGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := migb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := migb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -525,7 +634,7 @@ func (mis *MixinIDSelect) Scan(ctx context.Context, v interface{}) error {
 func (mis *MixinIDSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := mis.sql.Query()
-	if err := mis.driver.Query(ctx, query, args, rows); err != nil {
+	if err := mis.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
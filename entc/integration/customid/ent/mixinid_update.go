@@ -136,7 +136,7 @@ func (miu *MixinIDUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, miu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{mixinid.Label}
+			err = &NotFoundError{label: mixinid.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -148,9 +148,18 @@ func (miu *MixinIDUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // MixinIDUpdateOne is the builder for updating a single MixinID entity.
 type MixinIDUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *MixinIDMutation
+	fields     []string
+	conditions []predicate.MixinID
+	hooks      []Hook
+	mutation   *MixinIDMutation
+}
+
+// Where appends a list of conditions to the MixinIDUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (miuo *MixinIDUpdateOne) Where(ps ...predicate.MixinID) *MixinIDUpdateOne {
+	miuo.conditions = append(miuo.conditions, ps...)
+	return miuo
 }
 
 // SetSomeField sets the "some_field" field.
@@ -272,6 +281,13 @@ func (miuo *MixinIDUpdateOne) sqlSave(ctx context.Context) (_node *MixinID, err
 			}
 		}
 	}
+	if ps := miuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := miuo.mutation.SomeField(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -291,7 +307,9 @@ func (miuo *MixinIDUpdateOne) sqlSave(ctx context.Context) (_node *MixinID, err
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, miuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{mixinid.Label}
+			err = &NotFoundError{label: mixinid.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: mixinid.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -21,6 +21,7 @@ import (
 	"entgo.io/ent/entc/integration/customid/ent/doc"
 	"entgo.io/ent/entc/integration/customid/ent/group"
 	"entgo.io/ent/entc/integration/customid/ent/intsid"
+	"entgo.io/ent/entc/integration/customid/ent/invoice"
 	"entgo.io/ent/entc/integration/customid/ent/mixinid"
 	"entgo.io/ent/entc/integration/customid/ent/note"
 	"entgo.io/ent/entc/integration/customid/ent/pet"
@@ -33,6 +34,7 @@ import (
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -52,6 +54,7 @@ const (
 	TypeDoc      = "Doc"
 	TypeGroup    = "Group"
 	TypeIntSID   = "IntSID"
+	TypeInvoice  = "Invoice"
 	TypeMixinID  = "MixinID"
 	TypeNote     = "Note"
 	TypeOther    = "Other"
@@ -277,6 +280,16 @@ func (m *AccountMutation) Where(ps ...predicate.Account) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the AccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Account, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *AccountMutation) Op() Op {
 	return m.op
@@ -481,6 +494,9 @@ type BlobMutation struct {
 	uuid          *uuid.UUID
 	count         *int
 	addcount      *int
+	maxcount      *int
+	andcount      *int
+	orcount       *int
 	clearedFields map[string]struct{}
 	parent        *uuid.UUID
 	clearedparent bool
@@ -636,6 +652,9 @@ func (m *BlobMutation) ResetUUID() {
 func (m *BlobMutation) SetCount(i int) {
 	m.count = &i
 	m.addcount = nil
+	m.maxcount = nil
+	m.andcount = nil
+	m.orcount = nil
 }
 
 // Count returns the value of the "count" field in the mutation.
@@ -682,10 +701,65 @@ func (m *BlobMutation) AddedCount() (r int, exists bool) {
 	return *v, true
 }
 
+// SetCountIfGreater sets the "count" field to i in the database, only if it is greater than its stored value.
+func (m *BlobMutation) SetCountIfGreater(i int) {
+	if m.maxcount == nil || i > *m.maxcount {
+		m.maxcount = &i
+	}
+}
+
+// CountIfGreater returns the value that was set to the "count" field by SetCountIfGreater in this mutation.
+func (m *BlobMutation) CountIfGreater() (r int, exists bool) {
+	v := m.maxcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndCount applies a bitwise AND with i on the "count" field.
+func (m *BlobMutation) BitAndCount(i int) {
+	if m.andcount != nil {
+		*m.andcount &= i
+	} else {
+		m.andcount = &i
+	}
+}
+
+// CountBitAnd returns the value that was applied by BitAndCount on the "count" field in this mutation.
+func (m *BlobMutation) CountBitAnd() (r int, exists bool) {
+	v := m.andcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrCount applies a bitwise OR with i on the "count" field.
+func (m *BlobMutation) BitOrCount(i int) {
+	if m.orcount != nil {
+		*m.orcount |= i
+	} else {
+		m.orcount = &i
+	}
+}
+
+// CountBitOr returns the value that was applied by BitOrCount on the "count" field in this mutation.
+func (m *BlobMutation) CountBitOr() (r int, exists bool) {
+	v := m.orcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetCount resets all changes to the "count" field.
 func (m *BlobMutation) ResetCount() {
 	m.count = nil
 	m.addcount = nil
+	m.maxcount = nil
+	m.andcount = nil
+	m.orcount = nil
 }
 
 // SetParentID sets the "parent" edge to the Blob entity by id.
@@ -786,6 +860,16 @@ func (m *BlobMutation) Where(ps ...predicate.Blob) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the BlobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Blob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *BlobMutation) Op() Op {
 	return m.op
@@ -1199,6 +1283,16 @@ func (m *BlobLinkMutation) Where(ps ...predicate.BlobLink) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the BlobLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlobLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BlobLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *BlobLinkMutation) Op() Op {
 	return m.op
@@ -1245,7 +1339,7 @@ func (m *BlobLinkMutation) Field(name string) (ent.Value, bool) {
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
 func (m *BlobLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, errors.New("edge schema BlobLink does not support getting old values")
+	return nil, errors.New("schema BlobLink with a composite identifier does not support getting old values")
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
@@ -1438,8 +1532,10 @@ type CarMutation struct {
 	id            *int
 	before_id     *float64
 	addbefore_id  *float64
+	maxbefore_id  *float64
 	after_id      *float64
 	addafter_id   *float64
+	maxafter_id   *float64
 	model         *string
 	clearedFields map[string]struct{}
 	owner         *string
@@ -1557,6 +1653,8 @@ func (m *CarMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *CarMutation) SetBeforeID(f float64) {
 	m.before_id = &f
 	m.addbefore_id = nil
+	m.maxbefore_id = nil
+	delete(m.clearedFields, car.FieldBeforeID)
 }
 
 // BeforeID returns the value of the "before_id" field in the mutation.
@@ -1603,10 +1701,27 @@ func (m *CarMutation) AddedBeforeID() (r float64, exists bool) {
 	return *v, true
 }
 
+// SetBeforeIDIfGreater sets the "before_id" field to f in the database, only if it is greater than its stored value.
+func (m *CarMutation) SetBeforeIDIfGreater(f float64) {
+	if m.maxbefore_id == nil || f > *m.maxbefore_id {
+		m.maxbefore_id = &f
+	}
+}
+
+// BeforeIDIfGreater returns the value that was set to the "before_id" field by SetBeforeIDIfGreater in this mutation.
+func (m *CarMutation) BeforeIDIfGreater() (r float64, exists bool) {
+	v := m.maxbefore_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearBeforeID clears the value of the "before_id" field.
 func (m *CarMutation) ClearBeforeID() {
 	m.before_id = nil
 	m.addbefore_id = nil
+	m.maxbefore_id = nil
 	m.clearedFields[car.FieldBeforeID] = struct{}{}
 }
 
@@ -1620,6 +1735,7 @@ func (m *CarMutation) BeforeIDCleared() bool {
 func (m *CarMutation) ResetBeforeID() {
 	m.before_id = nil
 	m.addbefore_id = nil
+	m.maxbefore_id = nil
 	delete(m.clearedFields, car.FieldBeforeID)
 }
 
@@ -1627,6 +1743,8 @@ func (m *CarMutation) ResetBeforeID() {
 func (m *CarMutation) SetAfterID(f float64) {
 	m.after_id = &f
 	m.addafter_id = nil
+	m.maxafter_id = nil
+	delete(m.clearedFields, car.FieldAfterID)
 }
 
 // AfterID returns the value of the "after_id" field in the mutation.
@@ -1673,10 +1791,27 @@ func (m *CarMutation) AddedAfterID() (r float64, exists bool) {
 	return *v, true
 }
 
+// SetAfterIDIfGreater sets the "after_id" field to f in the database, only if it is greater than its stored value.
+func (m *CarMutation) SetAfterIDIfGreater(f float64) {
+	if m.maxafter_id == nil || f > *m.maxafter_id {
+		m.maxafter_id = &f
+	}
+}
+
+// AfterIDIfGreater returns the value that was set to the "after_id" field by SetAfterIDIfGreater in this mutation.
+func (m *CarMutation) AfterIDIfGreater() (r float64, exists bool) {
+	v := m.maxafter_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearAfterID clears the value of the "after_id" field.
 func (m *CarMutation) ClearAfterID() {
 	m.after_id = nil
 	m.addafter_id = nil
+	m.maxafter_id = nil
 	m.clearedFields[car.FieldAfterID] = struct{}{}
 }
 
@@ -1690,6 +1825,7 @@ func (m *CarMutation) AfterIDCleared() bool {
 func (m *CarMutation) ResetAfterID() {
 	m.after_id = nil
 	m.addafter_id = nil
+	m.maxafter_id = nil
 	delete(m.clearedFields, car.FieldAfterID)
 }
 
@@ -1773,6 +1909,16 @@ func (m *CarMutation) Where(ps ...predicate.Car) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the CarMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CarMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Car, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *CarMutation) Op() Op {
 	return m.op
@@ -2255,6 +2401,16 @@ func (m *DeviceMutation) Where(ps ...predicate.Device) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the DeviceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Device, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *DeviceMutation) Op() Op {
 	return m.op
@@ -2571,6 +2727,7 @@ func (m *DocMutation) IDs(ctx context.Context) ([]schema.DocID, error) {
 // SetText sets the "text" field.
 func (m *DocMutation) SetText(s string) {
 	m.text = &s
+	delete(m.clearedFields, doc.FieldText)
 }
 
 // Text returns the value of the "text" field in the mutation.
@@ -2769,6 +2926,16 @@ func (m *DocMutation) Where(ps ...predicate.Doc) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the DocMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Doc, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *DocMutation) Op() Op {
 	return m.op
@@ -3195,6 +3362,16 @@ func (m *GroupMutation) Where(ps ...predicate.Group) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *GroupMutation) Op() Op {
 	return m.op
@@ -3584,6 +3761,16 @@ func (m *IntSIDMutation) Where(ps ...predicate.IntSID) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the IntSIDMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IntSIDMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IntSID, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *IntSIDMutation) Op() Op {
 	return m.op
@@ -3772,6 +3959,577 @@ func (m *IntSIDMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown IntSID edge %s", name)
 }
 
+// InvoiceMutation represents an operation that mutates the Invoice nodes in the graph.
+type InvoiceMutation struct {
+	config
+	op            Op
+	typ           string
+	tenant        *int
+	addtenant     *int
+	maxtenant     *int
+	andtenant     *int
+	ortenant      *int
+	number        *string
+	total         *float64
+	addtotal      *float64
+	maxtotal      *float64
+	clearedFields map[string]struct{}
+	owner         *int
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*Invoice, error)
+	predicates    []predicate.Invoice
+}
+
+var _ ent.Mutation = (*InvoiceMutation)(nil)
+
+// invoiceOption allows management of the mutation configuration using functional options.
+type invoiceOption func(*InvoiceMutation)
+
+// newInvoiceMutation creates new mutation for the Invoice entity.
+func newInvoiceMutation(c config, op Op, opts ...invoiceOption) *InvoiceMutation {
+	m := &InvoiceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInvoice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InvoiceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InvoiceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetTenant sets the "tenant" field.
+func (m *InvoiceMutation) SetTenant(i int) {
+	m.tenant = &i
+	m.addtenant = nil
+	m.maxtenant = nil
+	m.andtenant = nil
+	m.ortenant = nil
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *InvoiceMutation) Tenant() (r int, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddTenant adds i to the "tenant" field.
+func (m *InvoiceMutation) AddTenant(i int) {
+	if m.addtenant != nil {
+		*m.addtenant += i
+	} else {
+		m.addtenant = &i
+	}
+}
+
+// AddedTenant returns the value that was added to the "tenant" field in this mutation.
+func (m *InvoiceMutation) AddedTenant() (r int, exists bool) {
+	v := m.addtenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// SetTenantIfGreater sets the "tenant" field to i in the database, only if it is greater than its stored value.
+func (m *InvoiceMutation) SetTenantIfGreater(i int) {
+	if m.maxtenant == nil || i > *m.maxtenant {
+		m.maxtenant = &i
+	}
+}
+
+// TenantIfGreater returns the value that was set to the "tenant" field by SetTenantIfGreater in this mutation.
+func (m *InvoiceMutation) TenantIfGreater() (r int, exists bool) {
+	v := m.maxtenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndTenant applies a bitwise AND with i on the "tenant" field.
+func (m *InvoiceMutation) BitAndTenant(i int) {
+	if m.andtenant != nil {
+		*m.andtenant &= i
+	} else {
+		m.andtenant = &i
+	}
+}
+
+// TenantBitAnd returns the value that was applied by BitAndTenant on the "tenant" field in this mutation.
+func (m *InvoiceMutation) TenantBitAnd() (r int, exists bool) {
+	v := m.andtenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrTenant applies a bitwise OR with i on the "tenant" field.
+func (m *InvoiceMutation) BitOrTenant(i int) {
+	if m.ortenant != nil {
+		*m.ortenant |= i
+	} else {
+		m.ortenant = &i
+	}
+}
+
+// TenantBitOr returns the value that was applied by BitOrTenant on the "tenant" field in this mutation.
+func (m *InvoiceMutation) TenantBitOr() (r int, exists bool) {
+	v := m.ortenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *InvoiceMutation) ResetTenant() {
+	m.tenant = nil
+	m.addtenant = nil
+	m.maxtenant = nil
+	m.andtenant = nil
+	m.ortenant = nil
+}
+
+// SetNumber sets the "number" field.
+func (m *InvoiceMutation) SetNumber(s string) {
+	m.number = &s
+}
+
+// Number returns the value of the "number" field in the mutation.
+func (m *InvoiceMutation) Number() (r string, exists bool) {
+	v := m.number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *InvoiceMutation) ResetNumber() {
+	m.number = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *InvoiceMutation) SetTotal(f float64) {
+	m.total = &f
+	m.addtotal = nil
+	m.maxtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *InvoiceMutation) Total() (r float64, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddTotal adds f to the "total" field.
+func (m *InvoiceMutation) AddTotal(f float64) {
+	if m.addtotal != nil {
+		*m.addtotal += f
+	} else {
+		m.addtotal = &f
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *InvoiceMutation) AddedTotal() (r float64, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// SetTotalIfGreater sets the "total" field to f in the database, only if it is greater than its stored value.
+func (m *InvoiceMutation) SetTotalIfGreater(f float64) {
+	if m.maxtotal == nil || f > *m.maxtotal {
+		m.maxtotal = &f
+	}
+}
+
+// TotalIfGreater returns the value that was set to the "total" field by SetTotalIfGreater in this mutation.
+func (m *InvoiceMutation) TotalIfGreater() (r float64, exists bool) {
+	v := m.maxtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *InvoiceMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+	m.maxtotal = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *InvoiceMutation) SetOwnerID(i int) {
+	m.owner = &i
+	delete(m.clearedFields, invoice.FieldOwnerID)
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *InvoiceMutation) OwnerID() (r int, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (m *InvoiceMutation) ClearOwnerID() {
+	m.owner = nil
+	m.clearedFields[invoice.FieldOwnerID] = struct{}{}
+}
+
+// OwnerIDCleared returns if the "owner_id" field was cleared in this mutation.
+func (m *InvoiceMutation) OwnerIDCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldOwnerID]
+	return ok
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *InvoiceMutation) ResetOwnerID() {
+	m.owner = nil
+	delete(m.clearedFields, invoice.FieldOwnerID)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *InvoiceMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *InvoiceMutation) OwnerCleared() bool {
+	return m.OwnerIDCleared() || m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *InvoiceMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *InvoiceMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the InvoiceMutation builder.
+func (m *InvoiceMutation) Where(ps ...predicate.Invoice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InvoiceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InvoiceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invoice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InvoiceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Invoice).
+func (m *InvoiceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InvoiceMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.tenant != nil {
+		fields = append(fields, invoice.FieldTenant)
+	}
+	if m.number != nil {
+		fields = append(fields, invoice.FieldNumber)
+	}
+	if m.total != nil {
+		fields = append(fields, invoice.FieldTotal)
+	}
+	if m.owner != nil {
+		fields = append(fields, invoice.FieldOwnerID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InvoiceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldTenant:
+		return m.Tenant()
+	case invoice.FieldNumber:
+		return m.Number()
+	case invoice.FieldTotal:
+		return m.Total()
+	case invoice.FieldOwnerID:
+		return m.OwnerID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InvoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, errors.New("schema Invoice with a composite identifier does not support getting old values")
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoiceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invoice.FieldTenant:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case invoice.FieldNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumber(v)
+		return nil
+	case invoice.FieldTotal:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case invoice.FieldOwnerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InvoiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant != nil {
+		fields = append(fields, invoice.FieldTenant)
+	}
+	if m.addtotal != nil {
+		fields = append(fields, invoice.FieldTotal)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InvoiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldTenant:
+		return m.AddedTenant()
+	case invoice.FieldTotal:
+		return m.AddedTotal()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoiceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case invoice.FieldTenant:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenant(v)
+		return nil
+	case invoice.FieldTotal:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InvoiceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(invoice.FieldOwnerID) {
+		fields = append(fields, invoice.FieldOwnerID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InvoiceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InvoiceMutation) ClearField(name string) error {
+	switch name {
+	case invoice.FieldOwnerID:
+		m.ClearOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InvoiceMutation) ResetField(name string) error {
+	switch name {
+	case invoice.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case invoice.FieldNumber:
+		m.ResetNumber()
+		return nil
+	case invoice.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case invoice.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InvoiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, invoice.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InvoiceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case invoice.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InvoiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InvoiceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InvoiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, invoice.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InvoiceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case invoice.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InvoiceMutation) ClearEdge(name string) error {
+	switch name {
+	case invoice.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InvoiceMutation) ResetEdge(name string) error {
+	switch name {
+	case invoice.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice edge %s", name)
+}
+
 // MixinIDMutation represents an operation that mutates the MixinID nodes in the graph.
 type MixinIDMutation struct {
 	config
@@ -3967,6 +4725,16 @@ func (m *MixinIDMutation) Where(ps ...predicate.MixinID) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the MixinIDMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MixinIDMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MixinID, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *MixinIDMutation) Op() Op {
 	return m.op
@@ -4268,6 +5036,7 @@ func (m *NoteMutation) IDs(ctx context.Context) ([]schema.NoteID, error) {
 // SetText sets the "text" field.
 func (m *NoteMutation) SetText(s string) {
 	m.text = &s
+	delete(m.clearedFields, note.FieldText)
 }
 
 // Text returns the value of the "text" field in the mutation.
@@ -4412,6 +5181,16 @@ func (m *NoteMutation) Where(ps ...predicate.Note) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the NoteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Note, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *NoteMutation) Op() Op {
 	return m.op
@@ -4755,6 +5534,16 @@ func (m *OtherMutation) Where(ps ...predicate.Other) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the OtherMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OtherMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Other, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *OtherMutation) Op() Op {
 	return m.op
@@ -5206,6 +5995,16 @@ func (m *PetMutation) Where(ps ...predicate.Pet) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *PetMutation) Op() Op {
 	return m.op
@@ -5559,6 +6358,16 @@ func (m *RevisionMutation) Where(ps ...predicate.Revision) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the RevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Revision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *RevisionMutation) Op() Op {
 	return m.op
@@ -5855,6 +6664,16 @@ func (m *SessionMutation) Where(ps ...predicate.Session) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *SessionMutation) Op() Op {
 	return m.op
@@ -6216,6 +7035,16 @@ func (m *TokenMutation) Where(ps ...predicate.Token) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the TokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Token, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *TokenMutation) Op() Op {
 	return m.op
@@ -6736,6 +7565,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
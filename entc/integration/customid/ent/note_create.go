@@ -309,6 +309,7 @@ type (
 	//  one Note node.
 	NoteUpsertOne struct {
 		create *NoteCreate
+		err    error // first validation error of the update values.
 	}
 
 	// NoteUpsert is the "OnConflict" setter.
@@ -408,6 +409,9 @@ func (u *NoteUpsertOne) ClearText() *NoteUpsertOne {
 
 // Exec executes the query.
 func (u *NoteUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for NoteCreate.OnConflict")
 	}
@@ -416,7 +420,7 @@ func (u *NoteUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *NoteUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -428,6 +432,9 @@ func (u *NoteUpsertOne) ID(ctx context.Context) (id schema.NoteID, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: NoteUpsertOne.ID is not supported by MySQL driver. Use NoteUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -567,6 +574,7 @@ func (ncb *NoteCreateBulk) OnConflictColumns(columns ...string) *NoteUpsertBulk
 // a bulk of Note nodes.
 type NoteUpsertBulk struct {
 	create *NoteCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -645,6 +653,9 @@ func (u *NoteUpsertBulk) ClearText() *NoteUpsertBulk {
 
 // Exec executes the query.
 func (u *NoteUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NoteCreateBulk instead", i)
@@ -658,7 +669,7 @@ func (u *NoteUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *NoteUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
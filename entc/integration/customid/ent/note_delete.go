@@ -107,7 +107,7 @@ func (ndo *NoteDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{note.Label}
+		return &NotFoundError{label: note.Label}
 	default:
 		return nil
 	}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/note"
@@ -28,6 +29,7 @@ type NoteQuery struct {
 	unique       *bool
 	order        []OrderFunc
 	fields       []string
+	inters       []Interceptor
 	predicates   []predicate.Note
 	withParent   *NoteQuery
 	withChildren *NoteQuery
@@ -70,7 +72,7 @@ func (nq *NoteQuery) Order(o ...OrderFunc) *NoteQuery {
 
 // QueryParent chains the current query on the "parent" edge.
 func (nq *NoteQuery) QueryParent() *NoteQuery {
-	query := &NoteQuery{config: nq.config}
+	query := (&NoteClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -92,7 +94,7 @@ func (nq *NoteQuery) QueryParent() *NoteQuery {
 
 // QueryChildren chains the current query on the "children" edge.
 func (nq *NoteQuery) QueryChildren() *NoteQuery {
-	query := &NoteQuery{config: nq.config}
+	query := (&NoteClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -120,7 +122,7 @@ func (nq *NoteQuery) First(ctx context.Context) (*Note, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{note.Label}
+		return nil, nq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -142,7 +144,7 @@ func (nq *NoteQuery) FirstID(ctx context.Context) (id schema.NoteID, err error)
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{note.Label}
+		err = nq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -169,7 +171,7 @@ func (nq *NoteQuery) Only(ctx context.Context) (*Note, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{note.Label}
+		return nil, nq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{note.Label}
 	}
@@ -196,7 +198,7 @@ func (nq *NoteQuery) OnlyID(ctx context.Context) (id schema.NoteID, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{note.Label}
+		err = nq.sqlNotFound()
 	default:
 		err = &NotSingularError{note.Label}
 	}
@@ -217,7 +219,17 @@ func (nq *NoteQuery) All(ctx context.Context) ([]*Note, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return nq.sqlAll(ctx)
+	v, err := nq.intercept(ctx, "All", func(ctx context.Context, query *NoteQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Note)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Note", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -231,10 +243,20 @@ func (nq *NoteQuery) AllX(ctx context.Context) []*Note {
 
 // IDs executes the query and returns a list of Note IDs.
 func (nq *NoteQuery) IDs(ctx context.Context) ([]schema.NoteID, error) {
-	var ids []schema.NoteID
-	if err := nq.Select(note.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := nq.intercept(ctx, "IDs", func(ctx context.Context, query *NoteQuery) (Value, error) {
+		var ids []schema.NoteID
+		if err := query.Select(note.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]schema.NoteID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []schema.NoteID", v)
+	}
 	return ids, nil
 }
 
@@ -252,7 +274,17 @@ func (nq *NoteQuery) Count(ctx context.Context) (int, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return nq.sqlCount(ctx)
+	v, err := nq.intercept(ctx, "Count", func(ctx context.Context, query *NoteQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -269,7 +301,61 @@ func (nq *NoteQuery) Exist(ctx context.Context) (bool, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return nq.sqlExist(ctx)
+	v, err := nq.intercept(ctx, "Exist", func(ctx context.Context, query *NoteQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (nq *NoteQuery) intercept(ctx context.Context, op string, fn func(context.Context, *NoteQuery) (Value, error)) (Value, error) {
+	if len(nq.inters) == 0 {
+		return fn(ctx, nq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeNote,
+		Op:     op,
+		Limit:  nq.limit,
+		Offset: nq.offset,
+		Unique: nq.unique,
+		Fields: nq.fields,
+	}
+	for _, p := range nq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range nq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if nq.withParent != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, note.EdgeParent)
+		qc.EdgeQueries[note.EdgeParent] = nq.withParent
+	}
+	if nq.withChildren != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, note.EdgeChildren)
+		qc.EdgeQueries[note.EdgeChildren] = nq.withChildren
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*NoteQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *NoteQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, nq, qc, qr, nq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -292,6 +378,7 @@ func (nq *NoteQuery) Clone() *NoteQuery {
 		limit:        nq.limit,
 		offset:       nq.offset,
 		order:        append([]OrderFunc{}, nq.order...),
+		inters:       append([]Interceptor{}, nq.inters...),
 		predicates:   append([]predicate.Note{}, nq.predicates...),
 		withParent:   nq.withParent.Clone(),
 		withChildren: nq.withChildren.Clone(),
@@ -305,7 +392,7 @@ func (nq *NoteQuery) Clone() *NoteQuery {
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NoteQuery) WithParent(opts ...func(*NoteQuery)) *NoteQuery {
-	query := &NoteQuery{config: nq.config}
+	query := (&NoteClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -316,7 +403,7 @@ func (nq *NoteQuery) WithParent(opts ...func(*NoteQuery)) *NoteQuery {
 // WithChildren tells the query-builder to eager-load the nodes that are connected to
 // the "children" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NoteQuery) WithChildren(opts ...func(*NoteQuery)) *NoteQuery {
-	query := &NoteQuery{config: nq.config}
+	query := (&NoteClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -380,6 +467,16 @@ func (nq *NoteQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range nq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, nq); err != nil {
+				return err
+			}
+		}
+	}
 	if nq.path != nil {
 		prev, err := nq.path(ctx)
 		if err != nil {
@@ -418,7 +515,7 @@ func (nq *NoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Note, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, nq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, nq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -507,7 +604,7 @@ func (nq *NoteQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(nq.fields) > 0 {
 		_spec.Unique = nq.unique != nil && *nq.unique
 	}
-	return sqlgraph.CountNodes(ctx, nq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, nq.queryDriver(), _spec)
 }
 
 func (nq *NoteQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -598,6 +695,32 @@ func (nq *NoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (nq *NoteQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: note.Label}
+	if len(nq.predicates) > 0 {
+		selector := sql.Dialect(nq.driver.Dialect()).Select().From(sql.Table(note.Table))
+		for _, p := range nq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the NoteQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (nq *NoteQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Note, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Note(ps[i]))
+	}
+	nq.predicates = append(nq.predicates, wps...)
+}
+
 // NoteGroupBy is the group-by builder for Note entities.
 type NoteGroupBy struct {
 	config
@@ -637,7 +760,7 @@ func (ngb *NoteGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ngb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ngb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -683,7 +806,7 @@ func (ns *NoteSelect) Scan(ctx context.Context, v interface{}) error {
 func (ns *NoteSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ns.sql.Query()
-	if err := ns.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ns.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -294,7 +294,7 @@ func (nu *NoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{note.Label}
+			err = &NotFoundError{label: note.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -306,9 +306,18 @@ func (nu *NoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // NoteUpdateOne is the builder for updating a single Note entity.
 type NoteUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *NoteMutation
+	fields     []string
+	conditions []predicate.Note
+	hooks      []Hook
+	mutation   *NoteMutation
+}
+
+// Where appends a list of conditions to the NoteUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (nuo *NoteUpdateOne) Where(ps ...predicate.Note) *NoteUpdateOne {
+	nuo.conditions = append(nuo.conditions, ps...)
+	return nuo
 }
 
 // SetText sets the "text" field.
@@ -499,6 +508,13 @@ func (nuo *NoteUpdateOne) sqlSave(ctx context.Context) (_node *Note, err error)
 			}
 		}
 	}
+	if ps := nuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := nuo.mutation.Text(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -606,7 +622,9 @@ func (nuo *NoteUpdateOne) sqlSave(ctx context.Context) (_node *Note, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{note.Label}
+			err = &NotFoundError{label: note.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: note.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
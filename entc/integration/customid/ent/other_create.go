@@ -203,6 +203,7 @@ type (
 	//  one Other node.
 	OtherUpsertOne struct {
 		create *OtherCreate
+		err    error // first validation error of the update values.
 	}
 
 	// OtherUpsert is the "OnConflict" setter.
@@ -263,6 +264,9 @@ func (u *OtherUpsertOne) Update(set func(*OtherUpsert)) *OtherUpsertOne {
 
 // Exec executes the query.
 func (u *OtherUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for OtherCreate.OnConflict")
 	}
@@ -271,7 +275,7 @@ func (u *OtherUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *OtherUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -283,6 +287,9 @@ func (u *OtherUpsertOne) ID(ctx context.Context) (id sid.ID, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: OtherUpsertOne.ID is not supported by MySQL driver. Use OtherUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -417,6 +424,7 @@ func (ocb *OtherCreateBulk) OnConflictColumns(columns ...string) *OtherUpsertBul
 // a bulk of Other nodes.
 type OtherUpsertBulk struct {
 	create *OtherCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -474,6 +482,9 @@ func (u *OtherUpsertBulk) Update(set func(*OtherUpsert)) *OtherUpsertBulk {
 
 // Exec executes the query.
 func (u *OtherUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OtherCreateBulk instead", i)
@@ -487,7 +498,7 @@ func (u *OtherUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *OtherUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
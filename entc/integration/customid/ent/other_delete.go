@@ -107,7 +107,7 @@ func (odo *OtherDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{other.Label}
+		return &NotFoundError{label: other.Label}
 	default:
 		return nil
 	}
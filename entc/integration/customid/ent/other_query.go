@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/other"
@@ -27,6 +28,7 @@ type OtherQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Other
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -72,7 +74,7 @@ func (oq *OtherQuery) First(ctx context.Context) (*Other, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{other.Label}
+		return nil, oq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -94,7 +96,7 @@ func (oq *OtherQuery) FirstID(ctx context.Context) (id sid.ID, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{other.Label}
+		err = oq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -121,7 +123,7 @@ func (oq *OtherQuery) Only(ctx context.Context) (*Other, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{other.Label}
+		return nil, oq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{other.Label}
 	}
@@ -148,7 +150,7 @@ func (oq *OtherQuery) OnlyID(ctx context.Context) (id sid.ID, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{other.Label}
+		err = oq.sqlNotFound()
 	default:
 		err = &NotSingularError{other.Label}
 	}
@@ -169,7 +171,17 @@ func (oq *OtherQuery) All(ctx context.Context) ([]*Other, error) {
 	if err := oq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return oq.sqlAll(ctx)
+	v, err := oq.intercept(ctx, "All", func(ctx context.Context, query *OtherQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Other)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Other", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -183,10 +195,20 @@ func (oq *OtherQuery) AllX(ctx context.Context) []*Other {
 
 // IDs executes the query and returns a list of Other IDs.
 func (oq *OtherQuery) IDs(ctx context.Context) ([]sid.ID, error) {
-	var ids []sid.ID
-	if err := oq.Select(other.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := oq.intercept(ctx, "IDs", func(ctx context.Context, query *OtherQuery) (Value, error) {
+		var ids []sid.ID
+		if err := query.Select(other.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]sid.ID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []sid.ID", v)
+	}
 	return ids, nil
 }
 
@@ -204,7 +226,17 @@ func (oq *OtherQuery) Count(ctx context.Context) (int, error) {
 	if err := oq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return oq.sqlCount(ctx)
+	v, err := oq.intercept(ctx, "Count", func(ctx context.Context, query *OtherQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -221,7 +253,47 @@ func (oq *OtherQuery) Exist(ctx context.Context) (bool, error) {
 	if err := oq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return oq.sqlExist(ctx)
+	v, err := oq.intercept(ctx, "Exist", func(ctx context.Context, query *OtherQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (oq *OtherQuery) intercept(ctx context.Context, op string, fn func(context.Context, *OtherQuery) (Value, error)) (Value, error) {
+	if len(oq.inters) == 0 {
+		return fn(ctx, oq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeOther,
+		Op:     op,
+		Limit:  oq.limit,
+		Offset: oq.offset,
+		Unique: oq.unique,
+		Fields: oq.fields,
+	}
+	for _, p := range oq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range oq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*OtherQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *OtherQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, oq, qc, qr, oq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -244,6 +316,7 @@ func (oq *OtherQuery) Clone() *OtherQuery {
 		limit:      oq.limit,
 		offset:     oq.offset,
 		order:      append([]OrderFunc{}, oq.order...),
+		inters:     append([]Interceptor{}, oq.inters...),
 		predicates: append([]predicate.Other{}, oq.predicates...),
 		// clone intermediate query.
 		sql:    oq.sql.Clone(),
@@ -284,6 +357,16 @@ func (oq *OtherQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range oq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, oq); err != nil {
+				return err
+			}
+		}
+	}
 	if oq.path != nil {
 		prev, err := oq.path(ctx)
 		if err != nil {
@@ -310,7 +393,7 @@ func (oq *OtherQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Other,
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, oq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, oq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -325,7 +408,7 @@ func (oq *OtherQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(oq.fields) > 0 {
 		_spec.Unique = oq.unique != nil && *oq.unique
 	}
-	return sqlgraph.CountNodes(ctx, oq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, oq.queryDriver(), _spec)
 }
 
 func (oq *OtherQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -416,6 +499,32 @@ func (oq *OtherQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (oq *OtherQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: other.Label}
+	if len(oq.predicates) > 0 {
+		selector := sql.Dialect(oq.driver.Dialect()).Select().From(sql.Table(other.Table))
+		for _, p := range oq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the OtherQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (oq *OtherQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Other, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Other(ps[i]))
+	}
+	oq.predicates = append(oq.predicates, wps...)
+}
+
 // OtherGroupBy is the group-by builder for Other entities.
 type OtherGroupBy struct {
 	config
@@ -455,7 +564,7 @@ func (ogb *OtherGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ogb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ogb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -501,7 +610,7 @@ func (os *OtherSelect) Scan(ctx context.Context, v interface{}) error {
 func (os *OtherSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := os.sql.Query()
-	if err := os.driver.Query(ctx, query, args, rows); err != nil {
+	if err := os.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
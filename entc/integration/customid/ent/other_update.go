@@ -110,7 +110,7 @@ func (ou *OtherUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{other.Label}
+			err = &NotFoundError{label: other.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -122,9 +122,18 @@ func (ou *OtherUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // OtherUpdateOne is the builder for updating a single Other entity.
 type OtherUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *OtherMutation
+	fields     []string
+	conditions []predicate.Other
+	hooks      []Hook
+	mutation   *OtherMutation
+}
+
+// Where appends a list of conditions to the OtherUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ouo *OtherUpdateOne) Where(ps ...predicate.Other) *OtherUpdateOne {
+	ouo.conditions = append(ouo.conditions, ps...)
+	return ouo
 }
 
 // Mutation returns the OtherMutation object of the builder.
@@ -234,12 +243,21 @@ func (ouo *OtherUpdateOne) sqlSave(ctx context.Context) (_node *Other, err error
 			}
 		}
 	}
+	if ps := ouo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	_node = &Other{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, ouo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{other.Label}
+			err = &NotFoundError{label: other.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: other.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -355,6 +355,7 @@ type (
 	//  one Pet node.
 	PetUpsertOne struct {
 		create *PetCreate
+		err    error // first validation error of the update values.
 	}
 
 	// PetUpsert is the "OnConflict" setter.
@@ -415,6 +416,9 @@ func (u *PetUpsertOne) Update(set func(*PetUpsert)) *PetUpsertOne {
 
 // Exec executes the query.
 func (u *PetUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for PetCreate.OnConflict")
 	}
@@ -423,7 +427,7 @@ func (u *PetUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *PetUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -435,6 +439,9 @@ func (u *PetUpsertOne) ID(ctx context.Context) (id string, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: PetUpsertOne.ID is not supported by MySQL driver. Use PetUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -569,6 +576,7 @@ func (pcb *PetCreateBulk) OnConflictColumns(columns ...string) *PetUpsertBulk {
 // a bulk of Pet nodes.
 type PetUpsertBulk struct {
 	create *PetCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -626,6 +634,9 @@ func (u *PetUpsertBulk) Update(set func(*PetUpsert)) *PetUpsertBulk {
 
 // Exec executes the query.
 func (u *PetUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PetCreateBulk instead", i)
@@ -639,7 +650,7 @@ func (u *PetUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *PetUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
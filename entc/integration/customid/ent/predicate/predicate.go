@@ -34,6 +34,9 @@ type Group func(*sql.Selector)
 // IntSID is the predicate function for intsid builders.
 type IntSID func(*sql.Selector)
 
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
 // MixinID is the predicate function for mixinid builders.
 type MixinID func(*sql.Selector)
 
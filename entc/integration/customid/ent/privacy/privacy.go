@@ -56,6 +56,21 @@ func DecisionFromContext(ctx context.Context) (error, bool) {
 	return privacy.DecisionFromContext(ctx)
 }
 
+// BypassContext creates a new context from the given parent context with a scoped bypass token
+// attach to it. The token expires when the returned context is done, or when the returned cancel
+// function is called. For example:
+//
+//	ctx, cancel := privacy.BypassContext(ctx, privacy.Bypass{
+//		Reason: "nightly cleanup job",
+//		Types:  []string{ent.TypeAccount},
+//		Ops:    ent.OpDelete,
+//	})
+//	defer cancel()
+//
+func BypassContext(parent context.Context, b Bypass) (context.Context, context.CancelFunc) {
+	return privacy.BypassContext(parent, b)
+}
+
 type (
 	// Policy groups query and mutation policies.
 	Policy = privacy.Policy
@@ -71,6 +86,11 @@ type (
 	MutationRule = privacy.MutationRule
 	// MutationPolicy combines multiple mutation rules into a single policy.
 	MutationPolicy = privacy.MutationPolicy
+
+	// Bypass defines the scope of a privacy bypass token.
+	Bypass = privacy.Bypass
+	// BypassRecord is the audit record of a bypass token usage.
+	BypassRecord = privacy.BypassRecord
 )
 
 // QueryRuleFunc type is an adapter to allow the use of
@@ -346,6 +366,30 @@ func (f IntSIDMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.IntSIDMutation", m)
 }
 
+// The InvoiceQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type InvoiceQueryRuleFunc func(context.Context, *ent.InvoiceQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f InvoiceQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InvoiceQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.InvoiceQuery", q)
+}
+
+// The InvoiceMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type InvoiceMutationRuleFunc func(context.Context, *ent.InvoiceMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f InvoiceMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.InvoiceMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InvoiceMutation", m)
+}
+
 // The MixinIDQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type MixinIDQueryRuleFunc func(context.Context, *ent.MixinIDQuery) error
@@ -589,6 +633,8 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.IntSIDQuery:
 		return q.Filter(), nil
+	case *ent.InvoiceQuery:
+		return q.Filter(), nil
 	case *ent.MixinIDQuery:
 		return q.Filter(), nil
 	case *ent.NoteQuery:
@@ -628,6 +674,8 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.IntSIDMutation:
 		return m.Filter(), nil
+	case *ent.InvoiceMutation:
+		return m.Filter(), nil
 	case *ent.MixinIDMutation:
 		return m.Filter(), nil
 	case *ent.NoteMutation:
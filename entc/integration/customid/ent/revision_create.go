@@ -185,6 +185,7 @@ type (
 	//  one Revision node.
 	RevisionUpsertOne struct {
 		create *RevisionCreate
+		err    error // first validation error of the update values.
 	}
 
 	// RevisionUpsert is the "OnConflict" setter.
@@ -245,6 +246,9 @@ func (u *RevisionUpsertOne) Update(set func(*RevisionUpsert)) *RevisionUpsertOne
 
 // Exec executes the query.
 func (u *RevisionUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for RevisionCreate.OnConflict")
 	}
@@ -253,7 +257,7 @@ func (u *RevisionUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *RevisionUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -265,6 +269,9 @@ func (u *RevisionUpsertOne) ID(ctx context.Context) (id string, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: RevisionUpsertOne.ID is not supported by MySQL driver. Use RevisionUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -398,6 +405,7 @@ func (rcb *RevisionCreateBulk) OnConflictColumns(columns ...string) *RevisionUps
 // a bulk of Revision nodes.
 type RevisionUpsertBulk struct {
 	create *RevisionCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -455,6 +463,9 @@ func (u *RevisionUpsertBulk) Update(set func(*RevisionUpsert)) *RevisionUpsertBu
 
 // Exec executes the query.
 func (u *RevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RevisionCreateBulk instead", i)
@@ -468,7 +479,7 @@ func (u *RevisionUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *RevisionUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
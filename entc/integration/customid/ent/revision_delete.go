@@ -107,7 +107,7 @@ func (rdo *RevisionDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{revision.Label}
+		return &NotFoundError{label: revision.Label}
 	default:
 		return nil
 	}
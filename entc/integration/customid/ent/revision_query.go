@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/predicate"
@@ -26,6 +27,7 @@ type RevisionQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Revision
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -71,7 +73,7 @@ func (rq *RevisionQuery) First(ctx context.Context) (*Revision, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{revision.Label}
+		return nil, rq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -93,7 +95,7 @@ func (rq *RevisionQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{revision.Label}
+		err = rq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -120,7 +122,7 @@ func (rq *RevisionQuery) Only(ctx context.Context) (*Revision, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{revision.Label}
+		return nil, rq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{revision.Label}
 	}
@@ -147,7 +149,7 @@ func (rq *RevisionQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{revision.Label}
+		err = rq.sqlNotFound()
 	default:
 		err = &NotSingularError{revision.Label}
 	}
@@ -168,7 +170,17 @@ func (rq *RevisionQuery) All(ctx context.Context) ([]*Revision, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return rq.sqlAll(ctx)
+	v, err := rq.intercept(ctx, "All", func(ctx context.Context, query *RevisionQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Revision)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Revision", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -182,10 +194,20 @@ func (rq *RevisionQuery) AllX(ctx context.Context) []*Revision {
 
 // IDs executes the query and returns a list of Revision IDs.
 func (rq *RevisionQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := rq.Select(revision.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := rq.intercept(ctx, "IDs", func(ctx context.Context, query *RevisionQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(revision.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -203,7 +225,17 @@ func (rq *RevisionQuery) Count(ctx context.Context) (int, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return rq.sqlCount(ctx)
+	v, err := rq.intercept(ctx, "Count", func(ctx context.Context, query *RevisionQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -220,7 +252,47 @@ func (rq *RevisionQuery) Exist(ctx context.Context) (bool, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return rq.sqlExist(ctx)
+	v, err := rq.intercept(ctx, "Exist", func(ctx context.Context, query *RevisionQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (rq *RevisionQuery) intercept(ctx context.Context, op string, fn func(context.Context, *RevisionQuery) (Value, error)) (Value, error) {
+	if len(rq.inters) == 0 {
+		return fn(ctx, rq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeRevision,
+		Op:     op,
+		Limit:  rq.limit,
+		Offset: rq.offset,
+		Unique: rq.unique,
+		Fields: rq.fields,
+	}
+	for _, p := range rq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range rq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*RevisionQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *RevisionQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, rq, qc, qr, rq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -243,6 +315,7 @@ func (rq *RevisionQuery) Clone() *RevisionQuery {
 		limit:      rq.limit,
 		offset:     rq.offset,
 		order:      append([]OrderFunc{}, rq.order...),
+		inters:     append([]Interceptor{}, rq.inters...),
 		predicates: append([]predicate.Revision{}, rq.predicates...),
 		// clone intermediate query.
 		sql:    rq.sql.Clone(),
@@ -283,6 +356,16 @@ func (rq *RevisionQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range rq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rq); err != nil {
+				return err
+			}
+		}
+	}
 	if rq.path != nil {
 		prev, err := rq.path(ctx)
 		if err != nil {
@@ -309,7 +392,7 @@ func (rq *RevisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Rev
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, rq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -324,7 +407,7 @@ func (rq *RevisionQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(rq.fields) > 0 {
 		_spec.Unique = rq.unique != nil && *rq.unique
 	}
-	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, rq.queryDriver(), _spec)
 }
 
 func (rq *RevisionQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -415,6 +498,32 @@ func (rq *RevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (rq *RevisionQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: revision.Label}
+	if len(rq.predicates) > 0 {
+		selector := sql.Dialect(rq.driver.Dialect()).Select().From(sql.Table(revision.Table))
+		for _, p := range rq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the RevisionQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (rq *RevisionQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Revision, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Revision(ps[i]))
+	}
+	rq.predicates = append(rq.predicates, wps...)
+}
+
 // RevisionGroupBy is the group-by builder for Revision entities.
 type RevisionGroupBy struct {
 	config
@@ -454,7 +563,7 @@ func (rgb *RevisionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := rgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -500,7 +609,7 @@ func (rs *RevisionSelect) Scan(ctx context.Context, v interface{}) error {
 func (rs *RevisionSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := rs.sql.Query()
-	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -0,0 +1,47 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+// Its primary key is composed of the tenant and the invoice number.
+type Invoice struct {
+	ent.Schema
+}
+
+// Annotations of the Invoice.
+func (Invoice) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("tenant", "number"),
+	}
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant"),
+		field.String("number"),
+		field.Float("total").
+			Default(0),
+		field.Int("owner_id").
+			Optional(),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("invoices").
+			Field("owner_id").
+			Unique(),
+	}
+}
@@ -248,6 +248,7 @@ type (
 	//  one Session node.
 	SessionUpsertOne struct {
 		create *SessionCreate
+		err    error // first validation error of the update values.
 	}
 
 	// SessionUpsert is the "OnConflict" setter.
@@ -308,6 +309,9 @@ func (u *SessionUpsertOne) Update(set func(*SessionUpsert)) *SessionUpsertOne {
 
 // Exec executes the query.
 func (u *SessionUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for SessionCreate.OnConflict")
 	}
@@ -316,7 +320,7 @@ func (u *SessionUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *SessionUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -328,6 +332,9 @@ func (u *SessionUpsertOne) ID(ctx context.Context) (id schema.ID, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: SessionUpsertOne.ID is not supported by MySQL driver. Use SessionUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -462,6 +469,7 @@ func (scb *SessionCreateBulk) OnConflictColumns(columns ...string) *SessionUpser
 // a bulk of Session nodes.
 type SessionUpsertBulk struct {
 	create *SessionCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -519,6 +527,9 @@ func (u *SessionUpsertBulk) Update(set func(*SessionUpsert)) *SessionUpsertBulk
 
 // Exec executes the query.
 func (u *SessionUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SessionCreateBulk instead", i)
@@ -532,7 +543,7 @@ func (u *SessionUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *SessionUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
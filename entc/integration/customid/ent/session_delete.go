@@ -107,7 +107,7 @@ func (sdo *SessionDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{session.Label}
+		return &NotFoundError{label: session.Label}
 	default:
 		return nil
 	}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/device"
@@ -28,6 +29,7 @@ type SessionQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Session
 	withDevice *DeviceQuery
 	withFKs    bool
@@ -69,7 +71,7 @@ func (sq *SessionQuery) Order(o ...OrderFunc) *SessionQuery {
 
 // QueryDevice chains the current query on the "device" edge.
 func (sq *SessionQuery) QueryDevice() *DeviceQuery {
-	query := &DeviceQuery{config: sq.config}
+	query := (&DeviceClient{config: sq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := sq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -97,7 +99,7 @@ func (sq *SessionQuery) First(ctx context.Context) (*Session, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{session.Label}
+		return nil, sq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -119,7 +121,7 @@ func (sq *SessionQuery) FirstID(ctx context.Context) (id schema.ID, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{session.Label}
+		err = sq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -146,7 +148,7 @@ func (sq *SessionQuery) Only(ctx context.Context) (*Session, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{session.Label}
+		return nil, sq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{session.Label}
 	}
@@ -173,7 +175,7 @@ func (sq *SessionQuery) OnlyID(ctx context.Context) (id schema.ID, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{session.Label}
+		err = sq.sqlNotFound()
 	default:
 		err = &NotSingularError{session.Label}
 	}
@@ -194,7 +196,17 @@ func (sq *SessionQuery) All(ctx context.Context) ([]*Session, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return sq.sqlAll(ctx)
+	v, err := sq.intercept(ctx, "All", func(ctx context.Context, query *SessionQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Session)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Session", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -208,10 +220,20 @@ func (sq *SessionQuery) AllX(ctx context.Context) []*Session {
 
 // IDs executes the query and returns a list of Session IDs.
 func (sq *SessionQuery) IDs(ctx context.Context) ([]schema.ID, error) {
-	var ids []schema.ID
-	if err := sq.Select(session.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := sq.intercept(ctx, "IDs", func(ctx context.Context, query *SessionQuery) (Value, error) {
+		var ids []schema.ID
+		if err := query.Select(session.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]schema.ID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []schema.ID", v)
+	}
 	return ids, nil
 }
 
@@ -229,7 +251,17 @@ func (sq *SessionQuery) Count(ctx context.Context) (int, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return sq.sqlCount(ctx)
+	v, err := sq.intercept(ctx, "Count", func(ctx context.Context, query *SessionQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -246,7 +278,54 @@ func (sq *SessionQuery) Exist(ctx context.Context) (bool, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return sq.sqlExist(ctx)
+	v, err := sq.intercept(ctx, "Exist", func(ctx context.Context, query *SessionQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (sq *SessionQuery) intercept(ctx context.Context, op string, fn func(context.Context, *SessionQuery) (Value, error)) (Value, error) {
+	if len(sq.inters) == 0 {
+		return fn(ctx, sq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeSession,
+		Op:     op,
+		Limit:  sq.limit,
+		Offset: sq.offset,
+		Unique: sq.unique,
+		Fields: sq.fields,
+	}
+	for _, p := range sq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range sq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if sq.withDevice != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, session.EdgeDevice)
+		qc.EdgeQueries[session.EdgeDevice] = sq.withDevice
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*SessionQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *SessionQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, sq, qc, qr, sq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -269,6 +348,7 @@ func (sq *SessionQuery) Clone() *SessionQuery {
 		limit:      sq.limit,
 		offset:     sq.offset,
 		order:      append([]OrderFunc{}, sq.order...),
+		inters:     append([]Interceptor{}, sq.inters...),
 		predicates: append([]predicate.Session{}, sq.predicates...),
 		withDevice: sq.withDevice.Clone(),
 		// clone intermediate query.
@@ -281,7 +361,7 @@ func (sq *SessionQuery) Clone() *SessionQuery {
 // WithDevice tells the query-builder to eager-load the nodes that are connected to
 // the "device" edge. The optional arguments are used to configure the query builder of the edge.
 func (sq *SessionQuery) WithDevice(opts ...func(*DeviceQuery)) *SessionQuery {
-	query := &DeviceQuery{config: sq.config}
+	query := (&DeviceClient{config: sq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -321,6 +401,16 @@ func (sq *SessionQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range sq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sq); err != nil {
+				return err
+			}
+		}
+	}
 	if sq.path != nil {
 		prev, err := sq.path(ctx)
 		if err != nil {
@@ -358,7 +448,7 @@ func (sq *SessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sess
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, sq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, sq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -409,7 +499,7 @@ func (sq *SessionQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(sq.fields) > 0 {
 		_spec.Unique = sq.unique != nil && *sq.unique
 	}
-	return sqlgraph.CountNodes(ctx, sq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, sq.queryDriver(), _spec)
 }
 
 func (sq *SessionQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -500,6 +590,32 @@ func (sq *SessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (sq *SessionQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: session.Label}
+	if len(sq.predicates) > 0 {
+		selector := sql.Dialect(sq.driver.Dialect()).Select().From(sql.Table(session.Table))
+		for _, p := range sq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the SessionQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (sq *SessionQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Session, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Session(ps[i]))
+	}
+	sq.predicates = append(sq.predicates, wps...)
+}
+
 // SessionGroupBy is the group-by builder for Session entities.
 type SessionGroupBy struct {
 	config
@@ -539,7 +655,7 @@ func (sgb *SessionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := sgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := sgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -585,7 +701,7 @@ func (ss *SessionSelect) Scan(ctx context.Context, v interface{}) error {
 func (ss *SessionSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ss.sql.Query()
-	if err := ss.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ss.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -266,6 +266,7 @@ type (
 	//  one Token node.
 	TokenUpsertOne struct {
 		create *TokenCreate
+		err    error // first validation error of the update values.
 	}
 
 	// TokenUpsert is the "OnConflict" setter.
@@ -336,8 +337,12 @@ func (u *TokenUpsertOne) Update(set func(*TokenUpsert)) *TokenUpsertOne {
 	return u
 }
 
-// SetBody sets the "body" field.
+// SetBody sets the "body" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *TokenUpsertOne) SetBody(v string) *TokenUpsertOne {
+	if err := token.BodyValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Token.body": %w`, err)}
+	}
 	return u.Update(func(s *TokenUpsert) {
 		s.SetBody(v)
 	})
@@ -352,6 +357,9 @@ func (u *TokenUpsertOne) UpdateBody() *TokenUpsertOne {
 
 // Exec executes the query.
 func (u *TokenUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for TokenCreate.OnConflict")
 	}
@@ -360,7 +368,7 @@ func (u *TokenUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TokenUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -372,6 +380,9 @@ func (u *TokenUpsertOne) ID(ctx context.Context) (id sid.ID, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: TokenUpsertOne.ID is not supported by MySQL driver. Use TokenUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -511,6 +522,7 @@ func (tcb *TokenCreateBulk) OnConflictColumns(columns ...string) *TokenUpsertBul
 // a bulk of Token nodes.
 type TokenUpsertBulk struct {
 	create *TokenCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -566,8 +578,12 @@ func (u *TokenUpsertBulk) Update(set func(*TokenUpsert)) *TokenUpsertBulk {
 	return u
 }
 
-// SetBody sets the "body" field.
+// SetBody sets the "body" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *TokenUpsertBulk) SetBody(v string) *TokenUpsertBulk {
+	if err := token.BodyValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Token.body": %w`, err)}
+	}
 	return u.Update(func(s *TokenUpsert) {
 		s.SetBody(v)
 	})
@@ -582,6 +598,9 @@ func (u *TokenUpsertBulk) UpdateBody() *TokenUpsertBulk {
 
 // Exec executes the query.
 func (u *TokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TokenCreateBulk instead", i)
@@ -595,7 +614,7 @@ func (u *TokenUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TokenUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -107,7 +107,7 @@ func (tdo *TokenDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{token.Label}
+		return &NotFoundError{label: token.Label}
 	default:
 		return nil
 	}
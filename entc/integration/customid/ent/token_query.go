@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/account"
@@ -28,6 +29,7 @@ type TokenQuery struct {
 	unique      *bool
 	order       []OrderFunc
 	fields      []string
+	inters      []Interceptor
 	predicates  []predicate.Token
 	withAccount *AccountQuery
 	withFKs     bool
@@ -69,7 +71,7 @@ func (tq *TokenQuery) Order(o ...OrderFunc) *TokenQuery {
 
 // QueryAccount chains the current query on the "account" edge.
 func (tq *TokenQuery) QueryAccount() *AccountQuery {
-	query := &AccountQuery{config: tq.config}
+	query := (&AccountClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -97,7 +99,7 @@ func (tq *TokenQuery) First(ctx context.Context) (*Token, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{token.Label}
+		return nil, tq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -119,7 +121,7 @@ func (tq *TokenQuery) FirstID(ctx context.Context) (id sid.ID, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{token.Label}
+		err = tq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -146,7 +148,7 @@ func (tq *TokenQuery) Only(ctx context.Context) (*Token, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{token.Label}
+		return nil, tq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{token.Label}
 	}
@@ -173,7 +175,7 @@ func (tq *TokenQuery) OnlyID(ctx context.Context) (id sid.ID, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{token.Label}
+		err = tq.sqlNotFound()
 	default:
 		err = &NotSingularError{token.Label}
 	}
@@ -194,7 +196,17 @@ func (tq *TokenQuery) All(ctx context.Context) ([]*Token, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.sqlAll(ctx)
+	v, err := tq.intercept(ctx, "All", func(ctx context.Context, query *TokenQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Token)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Token", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -208,10 +220,20 @@ func (tq *TokenQuery) AllX(ctx context.Context) []*Token {
 
 // IDs executes the query and returns a list of Token IDs.
 func (tq *TokenQuery) IDs(ctx context.Context) ([]sid.ID, error) {
-	var ids []sid.ID
-	if err := tq.Select(token.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := tq.intercept(ctx, "IDs", func(ctx context.Context, query *TokenQuery) (Value, error) {
+		var ids []sid.ID
+		if err := query.Select(token.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]sid.ID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []sid.ID", v)
+	}
 	return ids, nil
 }
 
@@ -229,7 +251,17 @@ func (tq *TokenQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.sqlCount(ctx)
+	v, err := tq.intercept(ctx, "Count", func(ctx context.Context, query *TokenQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -246,7 +278,54 @@ func (tq *TokenQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.sqlExist(ctx)
+	v, err := tq.intercept(ctx, "Exist", func(ctx context.Context, query *TokenQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (tq *TokenQuery) intercept(ctx context.Context, op string, fn func(context.Context, *TokenQuery) (Value, error)) (Value, error) {
+	if len(tq.inters) == 0 {
+		return fn(ctx, tq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeToken,
+		Op:     op,
+		Limit:  tq.limit,
+		Offset: tq.offset,
+		Unique: tq.unique,
+		Fields: tq.fields,
+	}
+	for _, p := range tq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range tq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if tq.withAccount != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, token.EdgeAccount)
+		qc.EdgeQueries[token.EdgeAccount] = tq.withAccount
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TokenQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *TokenQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, tq, qc, qr, tq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -269,6 +348,7 @@ func (tq *TokenQuery) Clone() *TokenQuery {
 		limit:       tq.limit,
 		offset:      tq.offset,
 		order:       append([]OrderFunc{}, tq.order...),
+		inters:      append([]Interceptor{}, tq.inters...),
 		predicates:  append([]predicate.Token{}, tq.predicates...),
 		withAccount: tq.withAccount.Clone(),
 		// clone intermediate query.
@@ -281,7 +361,7 @@ func (tq *TokenQuery) Clone() *TokenQuery {
 // WithAccount tells the query-builder to eager-load the nodes that are connected to
 // the "account" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TokenQuery) WithAccount(opts ...func(*AccountQuery)) *TokenQuery {
-	query := &AccountQuery{config: tq.config}
+	query := (&AccountClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -345,6 +425,16 @@ func (tq *TokenQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range tq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tq); err != nil {
+				return err
+			}
+		}
+	}
 	if tq.path != nil {
 		prev, err := tq.path(ctx)
 		if err != nil {
@@ -382,7 +472,7 @@ func (tq *TokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Token,
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, tq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, tq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -433,7 +523,7 @@ func (tq *TokenQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(tq.fields) > 0 {
 		_spec.Unique = tq.unique != nil && *tq.unique
 	}
-	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, tq.queryDriver(), _spec)
 }
 
 func (tq *TokenQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -524,6 +614,32 @@ func (tq *TokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (tq *TokenQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: token.Label}
+	if len(tq.predicates) > 0 {
+		selector := sql.Dialect(tq.driver.Dialect()).Select().From(sql.Table(token.Table))
+		for _, p := range tq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the TokenQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (tq *TokenQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Token, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Token(ps[i]))
+	}
+	tq.predicates = append(tq.predicates, wps...)
+}
+
 // TokenGroupBy is the group-by builder for Token entities.
 type TokenGroupBy struct {
 	config
@@ -563,7 +679,7 @@ func (tgb *TokenGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := tgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := tgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -609,7 +725,7 @@ func (ts *TokenSelect) Scan(ctx context.Context, v interface{}) error {
 func (ts *TokenSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ts.sql.Query()
-	if err := ts.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ts.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
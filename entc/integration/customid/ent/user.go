@@ -35,9 +35,11 @@ type UserEdges struct {
 	Children []*User `json:"children,omitempty"`
 	// Pets holds the value of the pets edge.
 	Pets []*Pet `json:"pets,omitempty"`
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice `json:"invoices,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // GroupsOrErr returns the Groups value or an error if the edge
@@ -80,6 +82,15 @@ func (e UserEdges) PetsOrErr() ([]*Pet, error) {
 	return nil, &NotLoadedError{edge: "pets"}
 }
 
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[4] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -142,6 +153,11 @@ func (u *User) QueryPets() *PetQuery {
 	return (&UserClient{config: u.config}).QueryPets(u)
 }
 
+// QueryInvoices queries the "invoices" edge of the User entity.
+func (u *User) QueryInvoices() *InvoiceQuery {
+	return (&UserClient{config: u.config}).QueryInvoices(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
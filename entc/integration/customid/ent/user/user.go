@@ -19,6 +19,8 @@ const (
 	EdgeChildren = "children"
 	// EdgePets holds the string denoting the pets edge name in mutations.
 	EdgePets = "pets"
+	// EdgeInvoices holds the string denoting the invoices edge name in mutations.
+	EdgeInvoices = "invoices"
 	// GroupFieldID holds the string denoting the ID field of the Group.
 	GroupFieldID = "id"
 	// PetFieldID holds the string denoting the ID field of the Pet.
@@ -45,6 +47,13 @@ const (
 	PetsInverseTable = "pets"
 	// PetsColumn is the table column denoting the pets relation/edge.
 	PetsColumn = "user_pets"
+	// InvoicesTable is the table that holds the invoices relation/edge.
+	InvoicesTable = "invoices"
+	// InvoicesInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoicesInverseTable = "invoices"
+	// InvoicesColumn is the table column denoting the invoices relation/edge.
+	InvoicesColumn = "owner_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -324,6 +324,7 @@ type (
 	//  one User node.
 	UserUpsertOne struct {
 		create *UserCreate
+		err    error // first validation error of the update values.
 	}
 
 	// UserUpsert is the "OnConflict" setter.
@@ -384,6 +385,9 @@ func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
 
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for UserCreate.OnConflict")
 	}
@@ -392,13 +396,16 @@ func (u *UserUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *UserUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *UserUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -536,6 +543,7 @@ func (ucb *UserCreateBulk) OnConflictColumns(columns ...string) *UserUpsertBulk
 // a bulk of User nodes.
 type UserUpsertBulk struct {
 	create *UserCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -593,6 +601,9 @@ func (u *UserUpsertBulk) Update(set func(*UserUpsert)) *UserUpsertBulk {
 
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserCreateBulk instead", i)
@@ -606,7 +617,7 @@ func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *UserUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -15,8 +15,11 @@ import (
 	"entgo.io/ent/entc/integration/edgefield/ent/migrate"
 	"entgo.io/ent/entc/integration/edgefield/ent/node"
 	"entgo.io/ent/entc/integration/edgefield/ent/pet"
+	"entgo.io/ent/entc/integration/edgefield/ent/post"
 	"entgo.io/ent/entc/integration/edgefield/ent/rental"
 	"entgo.io/ent/entc/integration/edgefield/ent/user"
+	idtype "entgo.io/ent/entc/integration/idtype/ent"
+	iduser "entgo.io/ent/entc/integration/idtype/ent/user"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
@@ -118,3 +121,84 @@ func TestEdgeField(t *testing.T) {
 		head = curr
 	}
 }
+
+func TestExternalEdge(t *testing.T) {
+	ctx := context.Background()
+	client, err := ent.Open(dialect.SQLite, "file:ext?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(ctx))
+	users, err := idtype.Open(dialect.SQLite, "file:extusers?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer users.Close()
+	require.NoError(t, users.Schema.Create(ctx))
+
+	a8m := users.User.Create().SetName("a8m").SaveX(ctx)
+	nati := users.User.Create().SetName("nati").SaveX(ctx)
+	var calls int
+	loader := ent.PostReviewerLoaderFunc(func(ctx context.Context, ids []uint64) (map[uint64]*idtype.User, error) {
+		calls++
+		vs, err := users.User.Query().Where(iduser.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[uint64]*idtype.User, len(vs))
+		for _, v := range vs {
+			m[v.ID] = v
+		}
+		return m, nil
+	})
+	p1 := client.Post.Create().SetText("p1").SetReviewerID(a8m.ID).SaveX(ctx)
+	client.Post.Create().SetText("p2").SetReviewerID(nati.ID).SaveX(ctx)
+	client.Post.Create().SetText("p3").SetReviewerID(a8m.ID).SaveX(ctx)
+	r, err := p1.LoadReviewer(ctx, loader)
+	require.NoError(t, err)
+	require.Equal(t, "a8m", r.Name)
+
+	calls = 0
+	reviewers, err := ent.Posts(client.Post.Query().AllX(ctx)).LoadReviewer(ctx, loader)
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.Len(t, reviewers, 2)
+	require.Equal(t, "nati", reviewers[nati.ID].Name)
+
+	p4 := client.Post.Create().SetText("p4").SetReviewerID(a8m.ID + nati.ID).SaveX(ctx)
+	_, err = p4.LoadReviewer(ctx, loader)
+	require.True(t, ent.IsNotFound(err))
+}
+
+func TestPolymorphicEdge(t *testing.T) {
+	ctx := context.Background()
+	client, err := ent.Open(dialect.SQLite, "file:poly?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	p := client.Pet.Create().SaveX(ctx)
+	c := client.Car.Create().SaveX(ctx)
+	p1 := client.Post.Create().SetText("p1").SetSubject(p).SaveX(ctx)
+	s, err := p1.QuerySubject(ctx)
+	require.NoError(t, err)
+	sp, ok := s.AsPet()
+	require.True(t, ok)
+	require.Equal(t, p.ID, sp.ID)
+	_, ok = s.AsCar()
+	require.False(t, ok)
+
+	// Setting the edge to another type clears the previous one.
+	p1 = p1.Update().SetSubject(c).SaveX(ctx)
+	s, err = p1.QuerySubject(ctx)
+	require.NoError(t, err)
+	sc, ok := s.AsCar()
+	require.True(t, ok)
+	require.Equal(t, c.ID, sc.ID)
+	require.False(t, p1.QuerySubjectPet().ExistX(ctx))
+	require.Equal(t, 1, client.Post.Query().Where(post.HasSubjectCar()).CountX(ctx))
+
+	client.Post.Update().ClearSubject().ExecX(ctx)
+	_, err = p1.QuerySubject(ctx)
+	require.True(t, ent.IsNotFound(err))
+	p2 := client.Post.Create().SetText("p2").SaveX(ctx)
+	_, err = p2.QuerySubject(ctx)
+	require.True(t, ent.IsNotFound(err))
+}
@@ -91,6 +91,17 @@ func (c *Car) QueryRentals() *RentalQuery {
 	return (&CarClient{config: c.config}).QueryRentals(c)
 }
 
+// AsCar returns the Car entity. The As methods of Car implement
+// the interfaces of the polymorphic edges that point to it.
+func (c *Car) AsCar() (*Car, bool) {
+	return c, true
+}
+
+// AsPet returns false, as the Car entity is not a Pet.
+func (*Car) AsPet() (*Pet, bool) {
+	return nil, false
+}
+
 // Update returns a builder for updating this Car.
 // Note that you need to call Car.Unwrap() before calling this method if this Car
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/card"
@@ -27,6 +28,7 @@ type CardQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Card
 	withOwner  *UserQuery
 	// intermediate query (i.e. traversal path).
@@ -67,7 +69,7 @@ func (cq *CardQuery) Order(o ...OrderFunc) *CardQuery {
 
 // QueryOwner chains the current query on the "owner" edge.
 func (cq *CardQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: cq.config}
+	query := (&UserClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -95,7 +97,7 @@ func (cq *CardQuery) First(ctx context.Context) (*Card, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{card.Label}
+		return nil, cq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -117,7 +119,7 @@ func (cq *CardQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{card.Label}
+		err = cq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -144,7 +146,7 @@ func (cq *CardQuery) Only(ctx context.Context) (*Card, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{card.Label}
+		return nil, cq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{card.Label}
 	}
@@ -171,7 +173,7 @@ func (cq *CardQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{card.Label}
+		err = cq.sqlNotFound()
 	default:
 		err = &NotSingularError{card.Label}
 	}
@@ -192,7 +194,17 @@ func (cq *CardQuery) All(ctx context.Context) ([]*Card, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.sqlAll(ctx)
+	v, err := cq.intercept(ctx, "All", func(ctx context.Context, query *CardQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Card)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Card", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -206,10 +218,20 @@ func (cq *CardQuery) AllX(ctx context.Context) []*Card {
 
 // IDs executes the query and returns a list of Card IDs.
 func (cq *CardQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := cq.Select(card.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := cq.intercept(ctx, "IDs", func(ctx context.Context, query *CardQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(card.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -227,7 +249,17 @@ func (cq *CardQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.sqlCount(ctx)
+	v, err := cq.intercept(ctx, "Count", func(ctx context.Context, query *CardQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -244,7 +276,54 @@ func (cq *CardQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.sqlExist(ctx)
+	v, err := cq.intercept(ctx, "Exist", func(ctx context.Context, query *CardQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (cq *CardQuery) intercept(ctx context.Context, op string, fn func(context.Context, *CardQuery) (Value, error)) (Value, error) {
+	if len(cq.inters) == 0 {
+		return fn(ctx, cq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeCard,
+		Op:     op,
+		Limit:  cq.limit,
+		Offset: cq.offset,
+		Unique: cq.unique,
+		Fields: cq.fields,
+	}
+	for _, p := range cq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range cq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if cq.withOwner != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, card.EdgeOwner)
+		qc.EdgeQueries[card.EdgeOwner] = cq.withOwner
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CardQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *CardQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, cq, qc, qr, cq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -267,6 +346,7 @@ func (cq *CardQuery) Clone() *CardQuery {
 		limit:      cq.limit,
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		predicates: append([]predicate.Card{}, cq.predicates...),
 		withOwner:  cq.withOwner.Clone(),
 		// clone intermediate query.
@@ -279,7 +359,7 @@ func (cq *CardQuery) Clone() *CardQuery {
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CardQuery) WithOwner(opts ...func(*UserQuery)) *CardQuery {
-	query := &UserQuery{config: cq.config}
+	query := (&UserClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -343,6 +423,16 @@ func (cq *CardQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range cq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cq); err != nil {
+				return err
+			}
+		}
+	}
 	if cq.path != nil {
 		prev, err := cq.path(ctx)
 		if err != nil {
@@ -373,7 +463,7 @@ func (cq *CardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Card, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, cq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -421,7 +511,7 @@ func (cq *CardQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(cq.fields) > 0 {
 		_spec.Unique = cq.unique != nil && *cq.unique
 	}
-	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, cq.queryDriver(), _spec)
 }
 
 func (cq *CardQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -512,6 +602,32 @@ func (cq *CardQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (cq *CardQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: card.Label}
+	if len(cq.predicates) > 0 {
+		selector := sql.Dialect(cq.driver.Dialect()).Select().From(sql.Table(card.Table))
+		for _, p := range cq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the CardQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (cq *CardQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Card, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Card(ps[i]))
+	}
+	cq.predicates = append(cq.predicates, wps...)
+}
+
 // CardGroupBy is the group-by builder for Card entities.
 type CardGroupBy struct {
 	config
@@ -551,7 +667,7 @@ func (cgb *CardGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := cgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := cgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -597,7 +713,7 @@ func (cs *CardSelect) Scan(ctx context.Context, v interface{}) error {
 func (cs *CardSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := cs.sql.Query()
-	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := cs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
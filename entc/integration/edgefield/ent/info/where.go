@@ -9,6 +9,7 @@ package info
 import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/edgefield/ent/predicate"
 )
 
@@ -83,6 +84,126 @@ func IDLTE(id int) predicate.Info {
 	})
 }
 
+// ContentHasKey applies the HasKey predicate on the "content" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func ContentHasKey(path string) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldContent), sqljson.DotPath(path)))
+	})
+}
+
+// ContentValueIsNull applies the ValueIsNull predicate on the "content" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func ContentValueIsNull(path string) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldContent), sqljson.DotPath(path)))
+	})
+}
+
+// ContentValueEQ applies the ValueEQ predicate on the JSON value of the "content" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentValueEQ(path string, v interface{}) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldContent), v, sqljson.DotPath(path)))
+	})
+}
+
+// ContentValueNEQ applies the ValueNEQ predicate on the JSON value of the "content" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentValueNEQ(path string, v interface{}) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldContent), v, sqljson.DotPath(path)))
+	})
+}
+
+// ContentValueGT applies the ValueGT predicate on the JSON value of the "content" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentValueGT(path string, v interface{}) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldContent), v, sqljson.DotPath(path)))
+	})
+}
+
+// ContentValueGTE applies the ValueGTE predicate on the JSON value of the "content" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentValueGTE(path string, v interface{}) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldContent), v, sqljson.DotPath(path)))
+	})
+}
+
+// ContentValueLT applies the ValueLT predicate on the JSON value of the "content" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentValueLT(path string, v interface{}) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldContent), v, sqljson.DotPath(path)))
+	})
+}
+
+// ContentValueLTE applies the ValueLTE predicate on the JSON value of the "content" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentValueLTE(path string, v interface{}) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldContent), v, sqljson.DotPath(path)))
+	})
+}
+
+// ContentValueContains applies the ValueContains predicate on the JSON value of the "content" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentValueContains(path string, v interface{}) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldContent), v, sqljson.DotPath(path)))
+	})
+}
+
+// ContentLenEQ applies the LenEQ predicate on the length of the JSON array of the "content"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentLenEQ(path string, n int) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldContent), n, sqljson.DotPath(path)))
+	})
+}
+
+// ContentLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "content"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentLenNEQ(path string, n int) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldContent), n, sqljson.DotPath(path)))
+	})
+}
+
+// ContentLenGT applies the LenGT predicate on the length of the JSON array of the "content"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentLenGT(path string, n int) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldContent), n, sqljson.DotPath(path)))
+	})
+}
+
+// ContentLenGTE applies the LenGTE predicate on the length of the JSON array of the "content"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentLenGTE(path string, n int) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldContent), n, sqljson.DotPath(path)))
+	})
+}
+
+// ContentLenLT applies the LenLT predicate on the length of the JSON array of the "content"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentLenLT(path string, n int) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldContent), n, sqljson.DotPath(path)))
+	})
+}
+
+// ContentLenLTE applies the LenLTE predicate on the length of the JSON array of the "content"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func ContentLenLTE(path string, n int) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldContent), n, sqljson.DotPath(path)))
+	})
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Info {
 	return predicate.Info(func(s *sql.Selector) {
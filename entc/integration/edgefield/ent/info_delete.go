@@ -107,7 +107,7 @@ func (ido *InfoDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{info.Label}
+		return &NotFoundError{label: info.Label}
 	default:
 		return nil
 	}
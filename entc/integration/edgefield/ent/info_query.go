@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/info"
@@ -27,6 +28,7 @@ type InfoQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Info
 	withUser   *UserQuery
 	// intermediate query (i.e. traversal path).
@@ -67,7 +69,7 @@ func (iq *InfoQuery) Order(o ...OrderFunc) *InfoQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (iq *InfoQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: iq.config}
+	query := (&UserClient{config: iq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := iq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -95,7 +97,7 @@ func (iq *InfoQuery) First(ctx context.Context) (*Info, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{info.Label}
+		return nil, iq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -117,7 +119,7 @@ func (iq *InfoQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{info.Label}
+		err = iq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -144,7 +146,7 @@ func (iq *InfoQuery) Only(ctx context.Context) (*Info, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{info.Label}
+		return nil, iq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{info.Label}
 	}
@@ -171,7 +173,7 @@ func (iq *InfoQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{info.Label}
+		err = iq.sqlNotFound()
 	default:
 		err = &NotSingularError{info.Label}
 	}
@@ -192,7 +194,17 @@ func (iq *InfoQuery) All(ctx context.Context) ([]*Info, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return iq.sqlAll(ctx)
+	v, err := iq.intercept(ctx, "All", func(ctx context.Context, query *InfoQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Info)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Info", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -206,10 +218,20 @@ func (iq *InfoQuery) AllX(ctx context.Context) []*Info {
 
 // IDs executes the query and returns a list of Info IDs.
 func (iq *InfoQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := iq.Select(info.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := iq.intercept(ctx, "IDs", func(ctx context.Context, query *InfoQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(info.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -227,7 +249,17 @@ func (iq *InfoQuery) Count(ctx context.Context) (int, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return iq.sqlCount(ctx)
+	v, err := iq.intercept(ctx, "Count", func(ctx context.Context, query *InfoQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -244,7 +276,54 @@ func (iq *InfoQuery) Exist(ctx context.Context) (bool, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return iq.sqlExist(ctx)
+	v, err := iq.intercept(ctx, "Exist", func(ctx context.Context, query *InfoQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (iq *InfoQuery) intercept(ctx context.Context, op string, fn func(context.Context, *InfoQuery) (Value, error)) (Value, error) {
+	if len(iq.inters) == 0 {
+		return fn(ctx, iq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeInfo,
+		Op:     op,
+		Limit:  iq.limit,
+		Offset: iq.offset,
+		Unique: iq.unique,
+		Fields: iq.fields,
+	}
+	for _, p := range iq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range iq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if iq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, info.EdgeUser)
+		qc.EdgeQueries[info.EdgeUser] = iq.withUser
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*InfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *InfoQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, iq, qc, qr, iq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -267,6 +346,7 @@ func (iq *InfoQuery) Clone() *InfoQuery {
 		limit:      iq.limit,
 		offset:     iq.offset,
 		order:      append([]OrderFunc{}, iq.order...),
+		inters:     append([]Interceptor{}, iq.inters...),
 		predicates: append([]predicate.Info{}, iq.predicates...),
 		withUser:   iq.withUser.Clone(),
 		// clone intermediate query.
@@ -279,7 +359,7 @@ func (iq *InfoQuery) Clone() *InfoQuery {
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (iq *InfoQuery) WithUser(opts ...func(*UserQuery)) *InfoQuery {
-	query := &UserQuery{config: iq.config}
+	query := (&UserClient{config: iq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -343,6 +423,16 @@ func (iq *InfoQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range iq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iq); err != nil {
+				return err
+			}
+		}
+	}
 	if iq.path != nil {
 		prev, err := iq.path(ctx)
 		if err != nil {
@@ -373,7 +463,7 @@ func (iq *InfoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Info, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, iq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, iq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -421,7 +511,7 @@ func (iq *InfoQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(iq.fields) > 0 {
 		_spec.Unique = iq.unique != nil && *iq.unique
 	}
-	return sqlgraph.CountNodes(ctx, iq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, iq.queryDriver(), _spec)
 }
 
 func (iq *InfoQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -512,6 +602,32 @@ func (iq *InfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (iq *InfoQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: info.Label}
+	if len(iq.predicates) > 0 {
+		selector := sql.Dialect(iq.driver.Dialect()).Select().From(sql.Table(info.Table))
+		for _, p := range iq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the InfoQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (iq *InfoQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Info, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Info(ps[i]))
+	}
+	iq.predicates = append(iq.predicates, wps...)
+}
+
 // InfoGroupBy is the group-by builder for Info entities.
 type InfoGroupBy struct {
 	config
@@ -551,7 +667,7 @@ func (igb *InfoGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := igb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := igb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -597,7 +713,7 @@ func (is *InfoSelect) Scan(ctx context.Context, v interface{}) error {
 func (is *InfoSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := is.sql.Query()
-	if err := is.driver.Query(ctx, query, args, rows); err != nil {
+	if err := is.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -39,6 +39,12 @@ func (iu *InfoUpdate) SetContent(jm json.RawMessage) *InfoUpdate {
 	return iu
 }
 
+// AppendContent appends jm to the "content" field in the database.
+func (iu *InfoUpdate) AppendContent(jm json.RawMessage) *InfoUpdate {
+	iu.mutation.AppendContent(jm)
+	return iu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (iu *InfoUpdate) SetUserID(id int) *InfoUpdate {
 	iu.mutation.SetUserID(id)
@@ -148,6 +154,13 @@ func (iu *InfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: info.FieldContent,
 		})
 	}
+	if value, ok := iu.mutation.AppendedContent(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: info.FieldContent,
+		})
+	}
 	if iu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -185,7 +198,7 @@ func (iu *InfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{info.Label}
+			err = &NotFoundError{label: info.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -197,9 +210,18 @@ func (iu *InfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // InfoUpdateOne is the builder for updating a single Info entity.
 type InfoUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *InfoMutation
+	fields     []string
+	conditions []predicate.Info
+	hooks      []Hook
+	mutation   *InfoMutation
+}
+
+// Where appends a list of conditions to the InfoUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (iuo *InfoUpdateOne) Where(ps ...predicate.Info) *InfoUpdateOne {
+	iuo.conditions = append(iuo.conditions, ps...)
+	return iuo
 }
 
 // SetContent sets the "content" field.
@@ -208,6 +230,12 @@ func (iuo *InfoUpdateOne) SetContent(jm json.RawMessage) *InfoUpdateOne {
 	return iuo
 }
 
+// AppendContent appends jm to the "content" field in the database.
+func (iuo *InfoUpdateOne) AppendContent(jm json.RawMessage) *InfoUpdateOne {
+	iuo.mutation.AppendContent(jm)
+	return iuo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (iuo *InfoUpdateOne) SetUserID(id int) *InfoUpdateOne {
 	iuo.mutation.SetUserID(id)
@@ -340,6 +368,13 @@ func (iuo *InfoUpdateOne) sqlSave(ctx context.Context) (_node *Info, err error)
 			}
 		}
 	}
+	if ps := iuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := iuo.mutation.Content(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -347,6 +382,13 @@ func (iuo *InfoUpdateOne) sqlSave(ctx context.Context) (_node *Info, err error)
 			Column: info.FieldContent,
 		})
 	}
+	if value, ok := iuo.mutation.AppendedContent(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: info.FieldContent,
+		})
+	}
 	if iuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -387,7 +429,9 @@ func (iuo *InfoUpdateOne) sqlSave(ctx context.Context) (_node *Info, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{info.Label}
+			err = &NotFoundError{label: info.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: info.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
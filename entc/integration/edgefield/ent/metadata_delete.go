@@ -107,7 +107,7 @@ func (mdo *MetadataDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{metadata.Label}
+		return &NotFoundError{label: metadata.Label}
 	default:
 		return nil
 	}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/metadata"
@@ -28,6 +29,7 @@ type MetadataQuery struct {
 	unique       *bool
 	order        []OrderFunc
 	fields       []string
+	inters       []Interceptor
 	predicates   []predicate.Metadata
 	withUser     *UserQuery
 	withChildren *MetadataQuery
@@ -70,7 +72,7 @@ func (mq *MetadataQuery) Order(o ...OrderFunc) *MetadataQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (mq *MetadataQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: mq.config}
+	query := (&UserClient{config: mq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := mq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -92,7 +94,7 @@ func (mq *MetadataQuery) QueryUser() *UserQuery {
 
 // QueryChildren chains the current query on the "children" edge.
 func (mq *MetadataQuery) QueryChildren() *MetadataQuery {
-	query := &MetadataQuery{config: mq.config}
+	query := (&MetadataClient{config: mq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := mq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -114,7 +116,7 @@ func (mq *MetadataQuery) QueryChildren() *MetadataQuery {
 
 // QueryParent chains the current query on the "parent" edge.
 func (mq *MetadataQuery) QueryParent() *MetadataQuery {
-	query := &MetadataQuery{config: mq.config}
+	query := (&MetadataClient{config: mq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := mq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -142,7 +144,7 @@ func (mq *MetadataQuery) First(ctx context.Context) (*Metadata, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{metadata.Label}
+		return nil, mq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -164,7 +166,7 @@ func (mq *MetadataQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{metadata.Label}
+		err = mq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -191,7 +193,7 @@ func (mq *MetadataQuery) Only(ctx context.Context) (*Metadata, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{metadata.Label}
+		return nil, mq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{metadata.Label}
 	}
@@ -218,7 +220,7 @@ func (mq *MetadataQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{metadata.Label}
+		err = mq.sqlNotFound()
 	default:
 		err = &NotSingularError{metadata.Label}
 	}
@@ -239,7 +241,17 @@ func (mq *MetadataQuery) All(ctx context.Context) ([]*Metadata, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return mq.sqlAll(ctx)
+	v, err := mq.intercept(ctx, "All", func(ctx context.Context, query *MetadataQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Metadata)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Metadata", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -253,10 +265,20 @@ func (mq *MetadataQuery) AllX(ctx context.Context) []*Metadata {
 
 // IDs executes the query and returns a list of Metadata IDs.
 func (mq *MetadataQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := mq.Select(metadata.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := mq.intercept(ctx, "IDs", func(ctx context.Context, query *MetadataQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(metadata.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -274,7 +296,17 @@ func (mq *MetadataQuery) Count(ctx context.Context) (int, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return mq.sqlCount(ctx)
+	v, err := mq.intercept(ctx, "Count", func(ctx context.Context, query *MetadataQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -291,7 +323,68 @@ func (mq *MetadataQuery) Exist(ctx context.Context) (bool, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return mq.sqlExist(ctx)
+	v, err := mq.intercept(ctx, "Exist", func(ctx context.Context, query *MetadataQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (mq *MetadataQuery) intercept(ctx context.Context, op string, fn func(context.Context, *MetadataQuery) (Value, error)) (Value, error) {
+	if len(mq.inters) == 0 {
+		return fn(ctx, mq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeMetadata,
+		Op:     op,
+		Limit:  mq.limit,
+		Offset: mq.offset,
+		Unique: mq.unique,
+		Fields: mq.fields,
+	}
+	for _, p := range mq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range mq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if mq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, metadata.EdgeUser)
+		qc.EdgeQueries[metadata.EdgeUser] = mq.withUser
+	}
+	if mq.withChildren != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, metadata.EdgeChildren)
+		qc.EdgeQueries[metadata.EdgeChildren] = mq.withChildren
+	}
+	if mq.withParent != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, metadata.EdgeParent)
+		qc.EdgeQueries[metadata.EdgeParent] = mq.withParent
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MetadataQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *MetadataQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, mq, qc, qr, mq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -314,6 +407,7 @@ func (mq *MetadataQuery) Clone() *MetadataQuery {
 		limit:        mq.limit,
 		offset:       mq.offset,
 		order:        append([]OrderFunc{}, mq.order...),
+		inters:       append([]Interceptor{}, mq.inters...),
 		predicates:   append([]predicate.Metadata{}, mq.predicates...),
 		withUser:     mq.withUser.Clone(),
 		withChildren: mq.withChildren.Clone(),
@@ -328,7 +422,7 @@ func (mq *MetadataQuery) Clone() *MetadataQuery {
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (mq *MetadataQuery) WithUser(opts ...func(*UserQuery)) *MetadataQuery {
-	query := &UserQuery{config: mq.config}
+	query := (&UserClient{config: mq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -339,7 +433,7 @@ func (mq *MetadataQuery) WithUser(opts ...func(*UserQuery)) *MetadataQuery {
 // WithChildren tells the query-builder to eager-load the nodes that are connected to
 // the "children" edge. The optional arguments are used to configure the query builder of the edge.
 func (mq *MetadataQuery) WithChildren(opts ...func(*MetadataQuery)) *MetadataQuery {
-	query := &MetadataQuery{config: mq.config}
+	query := (&MetadataClient{config: mq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -350,7 +444,7 @@ func (mq *MetadataQuery) WithChildren(opts ...func(*MetadataQuery)) *MetadataQue
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (mq *MetadataQuery) WithParent(opts ...func(*MetadataQuery)) *MetadataQuery {
-	query := &MetadataQuery{config: mq.config}
+	query := (&MetadataClient{config: mq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -414,6 +508,16 @@ func (mq *MetadataQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range mq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mq); err != nil {
+				return err
+			}
+		}
+	}
 	if mq.path != nil {
 		prev, err := mq.path(ctx)
 		if err != nil {
@@ -446,7 +550,7 @@ func (mq *MetadataQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Met
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, mq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, mq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -560,7 +664,7 @@ func (mq *MetadataQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(mq.fields) > 0 {
 		_spec.Unique = mq.unique != nil && *mq.unique
 	}
-	return sqlgraph.CountNodes(ctx, mq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, mq.queryDriver(), _spec)
 }
 
 func (mq *MetadataQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -651,6 +755,32 @@ func (mq *MetadataQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (mq *MetadataQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: metadata.Label}
+	if len(mq.predicates) > 0 {
+		selector := sql.Dialect(mq.driver.Dialect()).Select().From(sql.Table(metadata.Table))
+		for _, p := range mq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the MetadataQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (mq *MetadataQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Metadata, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Metadata(ps[i]))
+	}
+	mq.predicates = append(mq.predicates, wps...)
+}
+
 // MetadataGroupBy is the group-by builder for Metadata entities.
 type MetadataGroupBy struct {
 	config
@@ -690,7 +820,7 @@ func (mgb *MetadataGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := mgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := mgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -736,7 +866,7 @@ func (ms *MetadataSelect) Scan(ctx context.Context, v interface{}) error {
 func (ms *MetadataSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ms.sql.Query()
-	if err := ms.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ms.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
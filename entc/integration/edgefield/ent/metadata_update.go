@@ -53,6 +53,25 @@ func (mu *MetadataUpdate) AddAge(i int) *MetadataUpdate {
 	return mu
 }
 
+// SetAgeIfGreater sets the "age" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (mu *MetadataUpdate) SetAgeIfGreater(i int) *MetadataUpdate {
+	mu.mutation.SetAgeIfGreater(i)
+	return mu
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field in the database.
+func (mu *MetadataUpdate) BitAndAge(i int) *MetadataUpdate {
+	mu.mutation.BitAndAge(i)
+	return mu
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field in the database.
+func (mu *MetadataUpdate) BitOrAge(i int) *MetadataUpdate {
+	mu.mutation.BitOrAge(i)
+	return mu
+}
+
 // SetParentID sets the "parent_id" field.
 func (mu *MetadataUpdate) SetParentID(i int) *MetadataUpdate {
 	mu.mutation.SetParentID(i)
@@ -236,6 +255,27 @@ func (mu *MetadataUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: metadata.FieldAge,
 		})
 	}
+	if value, ok := mu.mutation.AgeIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: metadata.FieldAge,
+		})
+	}
+	if value, ok := mu.mutation.AgeBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: metadata.FieldAge,
+		})
+	}
+	if value, ok := mu.mutation.AgeBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: metadata.FieldAge,
+		})
+	}
 	if mu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -362,7 +402,7 @@ func (mu *MetadataUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{metadata.Label}
+			err = &NotFoundError{label: metadata.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -374,9 +414,18 @@ func (mu *MetadataUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // MetadataUpdateOne is the builder for updating a single Metadata entity.
 type MetadataUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *MetadataMutation
+	fields     []string
+	conditions []predicate.Metadata
+	hooks      []Hook
+	mutation   *MetadataMutation
+}
+
+// Where appends a list of conditions to the MetadataUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (muo *MetadataUpdateOne) Where(ps ...predicate.Metadata) *MetadataUpdateOne {
+	muo.conditions = append(muo.conditions, ps...)
+	return muo
 }
 
 // SetAge sets the "age" field.
@@ -400,6 +449,25 @@ func (muo *MetadataUpdateOne) AddAge(i int) *MetadataUpdateOne {
 	return muo
 }
 
+// SetAgeIfGreater sets the "age" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (muo *MetadataUpdateOne) SetAgeIfGreater(i int) *MetadataUpdateOne {
+	muo.mutation.SetAgeIfGreater(i)
+	return muo
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field in the database.
+func (muo *MetadataUpdateOne) BitAndAge(i int) *MetadataUpdateOne {
+	muo.mutation.BitAndAge(i)
+	return muo
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field in the database.
+func (muo *MetadataUpdateOne) BitOrAge(i int) *MetadataUpdateOne {
+	muo.mutation.BitOrAge(i)
+	return muo
+}
+
 // SetParentID sets the "parent_id" field.
 func (muo *MetadataUpdateOne) SetParentID(i int) *MetadataUpdateOne {
 	muo.mutation.SetParentID(i)
@@ -599,6 +667,13 @@ func (muo *MetadataUpdateOne) sqlSave(ctx context.Context) (_node *Metadata, err
 			}
 		}
 	}
+	if ps := muo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := muo.mutation.Age(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -613,6 +688,27 @@ func (muo *MetadataUpdateOne) sqlSave(ctx context.Context) (_node *Metadata, err
 			Column: metadata.FieldAge,
 		})
 	}
+	if value, ok := muo.mutation.AgeIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: metadata.FieldAge,
+		})
+	}
+	if value, ok := muo.mutation.AgeBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: metadata.FieldAge,
+		})
+	}
+	if value, ok := muo.mutation.AgeBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: metadata.FieldAge,
+		})
+	}
 	if muo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -742,7 +838,9 @@ func (muo *MetadataUpdateOne) sqlSave(ctx context.Context) (_node *Metadata, err
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{metadata.Label}
+			err = &NotFoundError{label: metadata.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: metadata.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
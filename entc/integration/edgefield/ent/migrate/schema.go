@@ -138,7 +138,10 @@ var (
 	PostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "text", Type: field.TypeString},
+		{Name: "reviewer_id", Type: field.TypeUint64, Nullable: true},
 		{Name: "author_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"sqlite3": "integer"}},
+		{Name: "post_subject_pet", Type: field.TypeInt, Nullable: true, Comment: "Subject is the pet or the car the post is about."},
+		{Name: "post_subject_car", Type: field.TypeUUID, Nullable: true, Comment: "Subject is the pet or the car the post is about."},
 	}
 	// PostsTable holds the schema information for the "posts" table.
 	PostsTable = &schema.Table{
@@ -148,16 +151,28 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "posts_users_author",
-				Columns:    []*schema.Column{PostsColumns[2]},
+				Columns:    []*schema.Column{PostsColumns[3]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "posts_pets_subject_pet",
+				Columns:    []*schema.Column{PostsColumns[4]},
+				RefColumns: []*schema.Column{PetsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "posts_cars_subject_car",
+				Columns:    []*schema.Column{PostsColumns[5]},
+				RefColumns: []*schema.Column{CarsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "post_author_id_text",
 				Unique:  false,
-				Columns: []*schema.Column{PostsColumns[2], PostsColumns[1]},
+				Columns: []*schema.Column{PostsColumns[3], PostsColumns[1]},
 			},
 		},
 	}
@@ -243,6 +258,8 @@ func init() {
 	NodesTable.ForeignKeys[0].RefTable = NodesTable
 	PetsTable.ForeignKeys[0].RefTable = UsersTable
 	PostsTable.ForeignKeys[0].RefTable = UsersTable
+	PostsTable.ForeignKeys[1].RefTable = PetsTable
+	PostsTable.ForeignKeys[2].RefTable = CarsTable
 	RentalsTable.ForeignKeys[0].RefTable = CarsTable
 	RentalsTable.ForeignKeys[1].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = UsersTable
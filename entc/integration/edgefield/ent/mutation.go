@@ -27,6 +27,7 @@ import (
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -172,6 +173,7 @@ func (m *CarMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 // SetNumber sets the "number" field.
 func (m *CarMutation) SetNumber(s string) {
 	m.number = &s
+	delete(m.clearedFields, car.FieldNumber)
 }
 
 // Number returns the value of the "number" field in the mutation.
@@ -277,6 +279,16 @@ func (m *CarMutation) Where(ps ...predicate.Car) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the CarMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CarMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Car, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *CarMutation) Op() Op {
 	return m.op
@@ -597,6 +609,7 @@ func (m *CardMutation) IDs(ctx context.Context) ([]int, error) {
 // SetNumber sets the "number" field.
 func (m *CardMutation) SetNumber(s string) {
 	m.number = &s
+	delete(m.clearedFields, card.FieldNumber)
 }
 
 // Number returns the value of the "number" field in the mutation.
@@ -646,6 +659,7 @@ func (m *CardMutation) ResetNumber() {
 // SetOwnerID sets the "owner_id" field.
 func (m *CardMutation) SetOwnerID(i int) {
 	m.owner = &i
+	delete(m.clearedFields, card.FieldOwnerID)
 }
 
 // OwnerID returns the value of the "owner_id" field in the mutation.
@@ -723,6 +737,16 @@ func (m *CardMutation) Where(ps ...predicate.Card) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the CardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Card, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *CardMutation) Op() Op {
 	return m.op
@@ -948,16 +972,17 @@ func (m *CardMutation) ResetEdge(name string) error {
 // InfoMutation represents an operation that mutates the Info nodes in the graph.
 type InfoMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	content       *json.RawMessage
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Info, error)
-	predicates    []predicate.Info
+	op              Op
+	typ             string
+	id              *int
+	content         *json.RawMessage
+	appendedcontent json.RawMessage
+	clearedFields   map[string]struct{}
+	user            *int
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*Info, error)
+	predicates      []predicate.Info
 }
 
 var _ ent.Mutation = (*InfoMutation)(nil)
@@ -1067,6 +1092,7 @@ func (m *InfoMutation) IDs(ctx context.Context) ([]int, error) {
 // SetContent sets the "content" field.
 func (m *InfoMutation) SetContent(jm json.RawMessage) {
 	m.content = &jm
+	m.appendedcontent = nil
 }
 
 // Content returns the value of the "content" field in the mutation.
@@ -1095,9 +1121,23 @@ func (m *InfoMutation) OldContent(ctx context.Context) (v json.RawMessage, err e
 	return oldValue.Content, nil
 }
 
+// AppendContent adds jm to the "content" field.
+func (m *InfoMutation) AppendContent(jm json.RawMessage) {
+	m.appendedcontent = append(m.appendedcontent, jm...)
+}
+
+// AppendedContent returns the list of values that were appended to the "content" field in this mutation.
+func (m *InfoMutation) AppendedContent() (json.RawMessage, bool) {
+	if len(m.appendedcontent) == 0 {
+		return nil, false
+	}
+	return m.appendedcontent, true
+}
+
 // ResetContent resets all changes to the "content" field.
 func (m *InfoMutation) ResetContent() {
 	m.content = nil
+	m.appendedcontent = nil
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
@@ -1144,6 +1184,16 @@ func (m *InfoMutation) Where(ps ...predicate.Info) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the InfoMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InfoMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Info, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *InfoMutation) Op() Op {
 	return m.op
@@ -1339,6 +1389,9 @@ type MetadataMutation struct {
 	id              *int
 	age             *int
 	addage          *int
+	maxage          *int
+	andage          *int
+	orage           *int
 	clearedFields   map[string]struct{}
 	user            *int
 	cleareduser     bool
@@ -1460,6 +1513,9 @@ func (m *MetadataMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *MetadataMutation) SetAge(i int) {
 	m.age = &i
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 }
 
 // Age returns the value of the "age" field in the mutation.
@@ -1506,15 +1562,71 @@ func (m *MetadataMutation) AddedAge() (r int, exists bool) {
 	return *v, true
 }
 
+// SetAgeIfGreater sets the "age" field to i in the database, only if it is greater than its stored value.
+func (m *MetadataMutation) SetAgeIfGreater(i int) {
+	if m.maxage == nil || i > *m.maxage {
+		m.maxage = &i
+	}
+}
+
+// AgeIfGreater returns the value that was set to the "age" field by SetAgeIfGreater in this mutation.
+func (m *MetadataMutation) AgeIfGreater() (r int, exists bool) {
+	v := m.maxage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field.
+func (m *MetadataMutation) BitAndAge(i int) {
+	if m.andage != nil {
+		*m.andage &= i
+	} else {
+		m.andage = &i
+	}
+}
+
+// AgeBitAnd returns the value that was applied by BitAndAge on the "age" field in this mutation.
+func (m *MetadataMutation) AgeBitAnd() (r int, exists bool) {
+	v := m.andage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field.
+func (m *MetadataMutation) BitOrAge(i int) {
+	if m.orage != nil {
+		*m.orage |= i
+	} else {
+		m.orage = &i
+	}
+}
+
+// AgeBitOr returns the value that was applied by BitOrAge on the "age" field in this mutation.
+func (m *MetadataMutation) AgeBitOr() (r int, exists bool) {
+	v := m.orage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetAge resets all changes to the "age" field.
 func (m *MetadataMutation) ResetAge() {
 	m.age = nil
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 }
 
 // SetParentID sets the "parent_id" field.
 func (m *MetadataMutation) SetParentID(i int) {
 	m.parent = &i
+	delete(m.clearedFields, metadata.FieldParentID)
 }
 
 // ParentID returns the value of the "parent_id" field in the mutation.
@@ -1685,6 +1797,16 @@ func (m *MetadataMutation) Where(ps ...predicate.Metadata) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the MetadataMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Metadata, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *MetadataMutation) Op() Op {
 	return m.op
@@ -1965,6 +2087,9 @@ type NodeMutation struct {
 	id            *int
 	value         *int
 	addvalue      *int
+	maxvalue      *int
+	andvalue      *int
+	orvalue       *int
 	clearedFields map[string]struct{}
 	prev          *int
 	clearedprev   bool
@@ -2077,6 +2202,9 @@ func (m *NodeMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *NodeMutation) SetValue(i int) {
 	m.value = &i
 	m.addvalue = nil
+	m.maxvalue = nil
+	m.andvalue = nil
+	m.orvalue = nil
 }
 
 // Value returns the value of the "value" field in the mutation.
@@ -2123,15 +2251,71 @@ func (m *NodeMutation) AddedValue() (r int, exists bool) {
 	return *v, true
 }
 
+// SetValueIfGreater sets the "value" field to i in the database, only if it is greater than its stored value.
+func (m *NodeMutation) SetValueIfGreater(i int) {
+	if m.maxvalue == nil || i > *m.maxvalue {
+		m.maxvalue = &i
+	}
+}
+
+// ValueIfGreater returns the value that was set to the "value" field by SetValueIfGreater in this mutation.
+func (m *NodeMutation) ValueIfGreater() (r int, exists bool) {
+	v := m.maxvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndValue applies a bitwise AND with i on the "value" field.
+func (m *NodeMutation) BitAndValue(i int) {
+	if m.andvalue != nil {
+		*m.andvalue &= i
+	} else {
+		m.andvalue = &i
+	}
+}
+
+// ValueBitAnd returns the value that was applied by BitAndValue on the "value" field in this mutation.
+func (m *NodeMutation) ValueBitAnd() (r int, exists bool) {
+	v := m.andvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrValue applies a bitwise OR with i on the "value" field.
+func (m *NodeMutation) BitOrValue(i int) {
+	if m.orvalue != nil {
+		*m.orvalue |= i
+	} else {
+		m.orvalue = &i
+	}
+}
+
+// ValueBitOr returns the value that was applied by BitOrValue on the "value" field in this mutation.
+func (m *NodeMutation) ValueBitOr() (r int, exists bool) {
+	v := m.orvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetValue resets all changes to the "value" field.
 func (m *NodeMutation) ResetValue() {
 	m.value = nil
 	m.addvalue = nil
+	m.maxvalue = nil
+	m.andvalue = nil
+	m.orvalue = nil
 }
 
 // SetPrevID sets the "prev_id" field.
 func (m *NodeMutation) SetPrevID(i int) {
 	m.prev = &i
+	delete(m.clearedFields, node.FieldPrevID)
 }
 
 // PrevID returns the value of the "prev_id" field in the mutation.
@@ -2248,6 +2432,16 @@ func (m *NodeMutation) Where(ps ...predicate.Node) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Node, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *NodeMutation) Op() Op {
 	return m.op
@@ -2609,6 +2803,7 @@ func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
 // SetOwnerID sets the "owner_id" field.
 func (m *PetMutation) SetOwnerID(i int) {
 	m.owner = &i
+	delete(m.clearedFields, pet.FieldOwnerID)
 }
 
 // OwnerID returns the value of the "owner_id" field in the mutation.
@@ -2686,6 +2881,16 @@ func (m *PetMutation) Where(ps ...predicate.Pet) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *PetMutation) Op() Op {
 	return m.op
@@ -2888,16 +3093,25 @@ func (m *PetMutation) ResetEdge(name string) error {
 // PostMutation represents an operation that mutates the Post nodes in the graph.
 type PostMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	text          *string
-	clearedFields map[string]struct{}
-	author        *int
-	clearedauthor bool
-	done          bool
-	oldValue      func(context.Context) (*Post, error)
-	predicates    []predicate.Post
+	op                 Op
+	typ                string
+	id                 *int
+	text               *string
+	reviewer_id        *uint64
+	addreviewer_id     *int64
+	maxreviewer_id     *uint64
+	andreviewer_id     *uint64
+	orreviewer_id      *uint64
+	clearedFields      map[string]struct{}
+	author             *int
+	clearedauthor      bool
+	subject_pet        *int
+	clearedsubject_pet bool
+	subject_car        *uuid.UUID
+	clearedsubject_car bool
+	done               bool
+	oldValue           func(context.Context) (*Post, error)
+	predicates         []predicate.Post
 }
 
 var _ ent.Mutation = (*PostMutation)(nil)
@@ -3037,6 +3251,7 @@ func (m *PostMutation) ResetText() {
 // SetAuthorID sets the "author_id" field.
 func (m *PostMutation) SetAuthorID(i int) {
 	m.author = &i
+	delete(m.clearedFields, post.FieldAuthorID)
 }
 
 // AuthorID returns the value of the "author_id" field in the mutation.
@@ -3083,6 +3298,138 @@ func (m *PostMutation) ResetAuthorID() {
 	delete(m.clearedFields, post.FieldAuthorID)
 }
 
+// SetReviewerID sets the "reviewer_id" field.
+func (m *PostMutation) SetReviewerID(u uint64) {
+	m.reviewer_id = &u
+	m.addreviewer_id = nil
+	m.maxreviewer_id = nil
+	m.andreviewer_id = nil
+	m.orreviewer_id = nil
+	delete(m.clearedFields, post.FieldReviewerID)
+}
+
+// ReviewerID returns the value of the "reviewer_id" field in the mutation.
+func (m *PostMutation) ReviewerID() (r uint64, exists bool) {
+	v := m.reviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewerID returns the old "reviewer_id" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldReviewerID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewerID: %w", err)
+	}
+	return oldValue.ReviewerID, nil
+}
+
+// AddReviewerID adds u to the "reviewer_id" field.
+func (m *PostMutation) AddReviewerID(u int64) {
+	if m.addreviewer_id != nil {
+		*m.addreviewer_id += u
+	} else {
+		m.addreviewer_id = &u
+	}
+}
+
+// AddedReviewerID returns the value that was added to the "reviewer_id" field in this mutation.
+func (m *PostMutation) AddedReviewerID() (r int64, exists bool) {
+	v := m.addreviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// SetReviewerIDIfGreater sets the "reviewer_id" field to u in the database, only if it is greater than its stored value.
+func (m *PostMutation) SetReviewerIDIfGreater(u uint64) {
+	if m.maxreviewer_id == nil || u > *m.maxreviewer_id {
+		m.maxreviewer_id = &u
+	}
+}
+
+// ReviewerIDIfGreater returns the value that was set to the "reviewer_id" field by SetReviewerIDIfGreater in this mutation.
+func (m *PostMutation) ReviewerIDIfGreater() (r uint64, exists bool) {
+	v := m.maxreviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndReviewerID applies a bitwise AND with u on the "reviewer_id" field.
+func (m *PostMutation) BitAndReviewerID(u uint64) {
+	if m.andreviewer_id != nil {
+		*m.andreviewer_id &= u
+	} else {
+		m.andreviewer_id = &u
+	}
+}
+
+// ReviewerIDBitAnd returns the value that was applied by BitAndReviewerID on the "reviewer_id" field in this mutation.
+func (m *PostMutation) ReviewerIDBitAnd() (r uint64, exists bool) {
+	v := m.andreviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrReviewerID applies a bitwise OR with u on the "reviewer_id" field.
+func (m *PostMutation) BitOrReviewerID(u uint64) {
+	if m.orreviewer_id != nil {
+		*m.orreviewer_id |= u
+	} else {
+		m.orreviewer_id = &u
+	}
+}
+
+// ReviewerIDBitOr returns the value that was applied by BitOrReviewerID on the "reviewer_id" field in this mutation.
+func (m *PostMutation) ReviewerIDBitOr() (r uint64, exists bool) {
+	v := m.orreviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (m *PostMutation) ClearReviewerID() {
+	m.reviewer_id = nil
+	m.addreviewer_id = nil
+	m.maxreviewer_id = nil
+	m.andreviewer_id = nil
+	m.orreviewer_id = nil
+	m.clearedFields[post.FieldReviewerID] = struct{}{}
+}
+
+// ReviewerIDCleared returns if the "reviewer_id" field was cleared in this mutation.
+func (m *PostMutation) ReviewerIDCleared() bool {
+	_, ok := m.clearedFields[post.FieldReviewerID]
+	return ok
+}
+
+// ResetReviewerID resets all changes to the "reviewer_id" field.
+func (m *PostMutation) ResetReviewerID() {
+	m.reviewer_id = nil
+	m.addreviewer_id = nil
+	m.maxreviewer_id = nil
+	m.andreviewer_id = nil
+	m.orreviewer_id = nil
+	delete(m.clearedFields, post.FieldReviewerID)
+}
+
 // ClearAuthor clears the "author" edge to the User entity.
 func (m *PostMutation) ClearAuthor() {
 	m.clearedauthor = true
@@ -3109,11 +3456,99 @@ func (m *PostMutation) ResetAuthor() {
 	m.clearedauthor = false
 }
 
+// SetSubjectPetID sets the "subject_pet" edge to the Pet entity by id.
+func (m *PostMutation) SetSubjectPetID(id int) {
+	m.subject_pet = &id
+}
+
+// ClearSubjectPet clears the "subject_pet" edge to the Pet entity.
+func (m *PostMutation) ClearSubjectPet() {
+	m.clearedsubject_pet = true
+}
+
+// SubjectPetCleared reports if the "subject_pet" edge to the Pet entity was cleared.
+func (m *PostMutation) SubjectPetCleared() bool {
+	return m.clearedsubject_pet
+}
+
+// SubjectPetID returns the "subject_pet" edge ID in the mutation.
+func (m *PostMutation) SubjectPetID() (id int, exists bool) {
+	if m.subject_pet != nil {
+		return *m.subject_pet, true
+	}
+	return
+}
+
+// SubjectPetIDs returns the "subject_pet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SubjectPetID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) SubjectPetIDs() (ids []int) {
+	if id := m.subject_pet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSubjectPet resets all changes to the "subject_pet" edge.
+func (m *PostMutation) ResetSubjectPet() {
+	m.subject_pet = nil
+	m.clearedsubject_pet = false
+}
+
+// SetSubjectCarID sets the "subject_car" edge to the Car entity by id.
+func (m *PostMutation) SetSubjectCarID(id uuid.UUID) {
+	m.subject_car = &id
+}
+
+// ClearSubjectCar clears the "subject_car" edge to the Car entity.
+func (m *PostMutation) ClearSubjectCar() {
+	m.clearedsubject_car = true
+}
+
+// SubjectCarCleared reports if the "subject_car" edge to the Car entity was cleared.
+func (m *PostMutation) SubjectCarCleared() bool {
+	return m.clearedsubject_car
+}
+
+// SubjectCarID returns the "subject_car" edge ID in the mutation.
+func (m *PostMutation) SubjectCarID() (id uuid.UUID, exists bool) {
+	if m.subject_car != nil {
+		return *m.subject_car, true
+	}
+	return
+}
+
+// SubjectCarIDs returns the "subject_car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SubjectCarID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) SubjectCarIDs() (ids []uuid.UUID) {
+	if id := m.subject_car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSubjectCar resets all changes to the "subject_car" edge.
+func (m *PostMutation) ResetSubjectCar() {
+	m.subject_car = nil
+	m.clearedsubject_car = false
+}
+
 // Where appends a list predicates to the PostMutation builder.
 func (m *PostMutation) Where(ps ...predicate.Post) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Post, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *PostMutation) Op() Op {
 	return m.op
@@ -3128,13 +3563,16 @@ func (m *PostMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PostMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 3)
 	if m.text != nil {
 		fields = append(fields, post.FieldText)
 	}
 	if m.author != nil {
 		fields = append(fields, post.FieldAuthorID)
 	}
+	if m.reviewer_id != nil {
+		fields = append(fields, post.FieldReviewerID)
+	}
 	return fields
 }
 
@@ -3147,6 +3585,8 @@ func (m *PostMutation) Field(name string) (ent.Value, bool) {
 		return m.Text()
 	case post.FieldAuthorID:
 		return m.AuthorID()
+	case post.FieldReviewerID:
+		return m.ReviewerID()
 	}
 	return nil, false
 }
@@ -3160,6 +3600,8 @@ func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldText(ctx)
 	case post.FieldAuthorID:
 		return m.OldAuthorID(ctx)
+	case post.FieldReviewerID:
+		return m.OldReviewerID(ctx)
 	}
 	return nil, fmt.Errorf("unknown Post field %s", name)
 }
@@ -3183,6 +3625,13 @@ func (m *PostMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAuthorID(v)
 		return nil
+	case post.FieldReviewerID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewerID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Post field %s", name)
 }
@@ -3191,6 +3640,9 @@ func (m *PostMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *PostMutation) AddedFields() []string {
 	var fields []string
+	if m.addreviewer_id != nil {
+		fields = append(fields, post.FieldReviewerID)
+	}
 	return fields
 }
 
@@ -3199,6 +3651,8 @@ func (m *PostMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case post.FieldReviewerID:
+		return m.AddedReviewerID()
 	}
 	return nil, false
 }
@@ -3208,6 +3662,13 @@ func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *PostMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case post.FieldReviewerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReviewerID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Post numeric field %s", name)
 }
@@ -3219,6 +3680,9 @@ func (m *PostMutation) ClearedFields() []string {
 	if m.FieldCleared(post.FieldAuthorID) {
 		fields = append(fields, post.FieldAuthorID)
 	}
+	if m.FieldCleared(post.FieldReviewerID) {
+		fields = append(fields, post.FieldReviewerID)
+	}
 	return fields
 }
 
@@ -3236,6 +3700,9 @@ func (m *PostMutation) ClearField(name string) error {
 	case post.FieldAuthorID:
 		m.ClearAuthorID()
 		return nil
+	case post.FieldReviewerID:
+		m.ClearReviewerID()
+		return nil
 	}
 	return fmt.Errorf("unknown Post nullable field %s", name)
 }
@@ -3250,16 +3717,25 @@ func (m *PostMutation) ResetField(name string) error {
 	case post.FieldAuthorID:
 		m.ResetAuthorID()
 		return nil
+	case post.FieldReviewerID:
+		m.ResetReviewerID()
+		return nil
 	}
 	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *PostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	if m.author != nil {
 		edges = append(edges, post.EdgeAuthor)
 	}
+	if m.subject_pet != nil {
+		edges = append(edges, post.EdgeSubjectPet)
+	}
+	if m.subject_car != nil {
+		edges = append(edges, post.EdgeSubjectCar)
+	}
 	return edges
 }
 
@@ -3271,13 +3747,21 @@ func (m *PostMutation) AddedIDs(name string) []ent.Value {
 		if id := m.author; id != nil {
 			return []ent.Value{*id}
 		}
+	case post.EdgeSubjectPet:
+		if id := m.subject_pet; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeSubjectCar:
+		if id := m.subject_car; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *PostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	return edges
 }
 
@@ -3291,10 +3775,16 @@ func (m *PostMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *PostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	if m.clearedauthor {
 		edges = append(edges, post.EdgeAuthor)
 	}
+	if m.clearedsubject_pet {
+		edges = append(edges, post.EdgeSubjectPet)
+	}
+	if m.clearedsubject_car {
+		edges = append(edges, post.EdgeSubjectCar)
+	}
 	return edges
 }
 
@@ -3304,6 +3794,10 @@ func (m *PostMutation) EdgeCleared(name string) bool {
 	switch name {
 	case post.EdgeAuthor:
 		return m.clearedauthor
+	case post.EdgeSubjectPet:
+		return m.clearedsubject_pet
+	case post.EdgeSubjectCar:
+		return m.clearedsubject_car
 	}
 	return false
 }
@@ -3315,6 +3809,12 @@ func (m *PostMutation) ClearEdge(name string) error {
 	case post.EdgeAuthor:
 		m.ClearAuthor()
 		return nil
+	case post.EdgeSubjectPet:
+		m.ClearSubjectPet()
+		return nil
+	case post.EdgeSubjectCar:
+		m.ClearSubjectCar()
+		return nil
 	}
 	return fmt.Errorf("unknown Post unique edge %s", name)
 }
@@ -3326,6 +3826,12 @@ func (m *PostMutation) ResetEdge(name string) error {
 	case post.EdgeAuthor:
 		m.ResetAuthor()
 		return nil
+	case post.EdgeSubjectPet:
+		m.ResetSubjectPet()
+		return nil
+	case post.EdgeSubjectCar:
+		m.ResetSubjectCar()
+		return nil
 	}
 	return fmt.Errorf("unknown Post edge %s", name)
 }
@@ -3610,6 +4116,16 @@ func (m *RentalMutation) Where(ps ...predicate.Rental) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the RentalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RentalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Rental, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *RentalMutation) Op() Op {
 	return m.op
@@ -3991,6 +4507,7 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 // SetParentID sets the "parent_id" field.
 func (m *UserMutation) SetParentID(i int) {
 	m.parent = &i
+	delete(m.clearedFields, user.FieldParentID)
 }
 
 // ParentID returns the value of the "parent_id" field in the mutation.
@@ -4040,6 +4557,7 @@ func (m *UserMutation) ResetParentID() {
 // SetSpouseID sets the "spouse_id" field.
 func (m *UserMutation) SetSpouseID(i int) {
 	m.spouse = &i
+	delete(m.clearedFields, user.FieldSpouseID)
 }
 
 // SpouseID returns the value of the "spouse_id" field in the mutation.
@@ -4437,6 +4955,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -93,6 +93,17 @@ func (pe *Pet) QueryOwner() *UserQuery {
 	return (&PetClient{config: pe.config}).QueryOwner(pe)
 }
 
+// AsCar returns false, as the Pet entity is not a Car.
+func (*Pet) AsCar() (*Car, bool) {
+	return nil, false
+}
+
+// AsPet returns the Pet entity. The As methods of Pet implement
+// the interfaces of the polymorphic edges that point to it.
+func (pe *Pet) AsPet() (*Pet, bool) {
+	return pe, true
+}
+
 // Update returns a builder for updating this Pet.
 // Note that you need to call Pet.Unwrap() before calling this method if this Pet
 // was returned from a transaction, and the transaction was committed or rolled back.
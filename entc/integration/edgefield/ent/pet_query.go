@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/pet"
@@ -27,6 +28,7 @@ type PetQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Pet
 	withOwner  *UserQuery
 	// intermediate query (i.e. traversal path).
@@ -67,7 +69,7 @@ func (pq *PetQuery) Order(o ...OrderFunc) *PetQuery {
 
 // QueryOwner chains the current query on the "owner" edge.
 func (pq *PetQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -95,7 +97,7 @@ func (pq *PetQuery) First(ctx context.Context) (*Pet, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{pet.Label}
+		return nil, pq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -117,7 +119,7 @@ func (pq *PetQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{pet.Label}
+		err = pq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -144,7 +146,7 @@ func (pq *PetQuery) Only(ctx context.Context) (*Pet, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{pet.Label}
+		return nil, pq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{pet.Label}
 	}
@@ -171,7 +173,7 @@ func (pq *PetQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{pet.Label}
+		err = pq.sqlNotFound()
 	default:
 		err = &NotSingularError{pet.Label}
 	}
@@ -192,7 +194,17 @@ func (pq *PetQuery) All(ctx context.Context) ([]*Pet, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return pq.sqlAll(ctx)
+	v, err := pq.intercept(ctx, "All", func(ctx context.Context, query *PetQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Pet)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Pet", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -206,10 +218,20 @@ func (pq *PetQuery) AllX(ctx context.Context) []*Pet {
 
 // IDs executes the query and returns a list of Pet IDs.
 func (pq *PetQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := pq.Select(pet.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := pq.intercept(ctx, "IDs", func(ctx context.Context, query *PetQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(pet.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -227,7 +249,17 @@ func (pq *PetQuery) Count(ctx context.Context) (int, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return pq.sqlCount(ctx)
+	v, err := pq.intercept(ctx, "Count", func(ctx context.Context, query *PetQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -244,7 +276,54 @@ func (pq *PetQuery) Exist(ctx context.Context) (bool, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return pq.sqlExist(ctx)
+	v, err := pq.intercept(ctx, "Exist", func(ctx context.Context, query *PetQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (pq *PetQuery) intercept(ctx context.Context, op string, fn func(context.Context, *PetQuery) (Value, error)) (Value, error) {
+	if len(pq.inters) == 0 {
+		return fn(ctx, pq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypePet,
+		Op:     op,
+		Limit:  pq.limit,
+		Offset: pq.offset,
+		Unique: pq.unique,
+		Fields: pq.fields,
+	}
+	for _, p := range pq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range pq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if pq.withOwner != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, pet.EdgeOwner)
+		qc.EdgeQueries[pet.EdgeOwner] = pq.withOwner
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *PetQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, pq, qc, qr, pq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -267,6 +346,7 @@ func (pq *PetQuery) Clone() *PetQuery {
 		limit:      pq.limit,
 		offset:     pq.offset,
 		order:      append([]OrderFunc{}, pq.order...),
+		inters:     append([]Interceptor{}, pq.inters...),
 		predicates: append([]predicate.Pet{}, pq.predicates...),
 		withOwner:  pq.withOwner.Clone(),
 		// clone intermediate query.
@@ -279,7 +359,7 @@ func (pq *PetQuery) Clone() *PetQuery {
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PetQuery) WithOwner(opts ...func(*UserQuery)) *PetQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -343,6 +423,16 @@ func (pq *PetQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range pq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pq); err != nil {
+				return err
+			}
+		}
+	}
 	if pq.path != nil {
 		prev, err := pq.path(ctx)
 		if err != nil {
@@ -373,7 +463,7 @@ func (pq *PetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pet, err
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, pq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -421,7 +511,7 @@ func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(pq.fields) > 0 {
 		_spec.Unique = pq.unique != nil && *pq.unique
 	}
-	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, pq.queryDriver(), _spec)
 }
 
 func (pq *PetQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -512,6 +602,32 @@ func (pq *PetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (pq *PetQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: pet.Label}
+	if len(pq.predicates) > 0 {
+		selector := sql.Dialect(pq.driver.Dialect()).Select().From(sql.Table(pet.Table))
+		for _, p := range pq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the PetQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (pq *PetQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Pet, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Pet(ps[i]))
+	}
+	pq.predicates = append(pq.predicates, wps...)
+}
+
 // PetGroupBy is the group-by builder for Pet entities.
 type PetGroupBy struct {
 	config
@@ -551,7 +667,7 @@ func (pgb *PetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := pgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := pgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -597,7 +713,7 @@ func (ps *PetSelect) Scan(ctx context.Context, v interface{}) error {
 func (ps *PetSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ps.sql.Query()
-	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ps.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -7,12 +7,17 @@
 package ent
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/edgefield/ent/car"
+	"entgo.io/ent/entc/integration/edgefield/ent/pet"
 	"entgo.io/ent/entc/integration/edgefield/ent/post"
 	"entgo.io/ent/entc/integration/edgefield/ent/user"
+	idtypeent "entgo.io/ent/entc/integration/idtype/ent"
+	"github.com/google/uuid"
 )
 
 // Post is the model entity for the Post schema.
@@ -24,18 +29,26 @@ type Post struct {
 	Text string `json:"text,omitempty"`
 	// AuthorID holds the value of the "author_id" field.
 	AuthorID *int `json:"author_id,omitempty"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID uint64 `json:"reviewer_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PostQuery when eager-loading is set.
-	Edges PostEdges `json:"edges"`
+	Edges            PostEdges `json:"edges"`
+	post_subject_pet *int
+	post_subject_car *uuid.UUID
 }
 
 // PostEdges holds the relations/edges for other nodes in the graph.
 type PostEdges struct {
 	// Author holds the value of the author edge.
 	Author *User `json:"author,omitempty"`
+	// Subject is the pet or the car the post is about.
+	SubjectPet *Pet `json:"subject_pet,omitempty"`
+	// Subject is the pet or the car the post is about.
+	SubjectCar *Car `json:"subject_car,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // AuthorOrErr returns the Author value or an error if the edge
@@ -51,15 +64,45 @@ func (e PostEdges) AuthorOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "author"}
 }
 
+// SubjectPetOrErr returns the SubjectPet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostEdges) SubjectPetOrErr() (*Pet, error) {
+	if e.loadedTypes[1] {
+		if e.SubjectPet == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: pet.Label}
+		}
+		return e.SubjectPet, nil
+	}
+	return nil, &NotLoadedError{edge: "subject_pet"}
+}
+
+// SubjectCarOrErr returns the SubjectCar value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostEdges) SubjectCarOrErr() (*Car, error) {
+	if e.loadedTypes[2] {
+		if e.SubjectCar == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.SubjectCar, nil
+	}
+	return nil, &NotLoadedError{edge: "subject_car"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Post) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case post.FieldID, post.FieldAuthorID:
+		case post.FieldID, post.FieldAuthorID, post.FieldReviewerID:
 			values[i] = new(sql.NullInt64)
 		case post.FieldText:
 			values[i] = new(sql.NullString)
+		case post.ForeignKeys[0]: // post_subject_pet
+			values[i] = new(sql.NullInt64)
+		case post.ForeignKeys[1]: // post_subject_car
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Post", columns[i])
 		}
@@ -94,6 +137,26 @@ func (po *Post) assignValues(columns []string, values []interface{}) error {
 				po.AuthorID = new(int)
 				*po.AuthorID = int(value.Int64)
 			}
+		case post.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				po.ReviewerID = uint64(value.Int64)
+			}
+		case post.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field post_subject_pet", value)
+			} else if value.Valid {
+				po.post_subject_pet = new(int)
+				*po.post_subject_pet = int(value.Int64)
+			}
+		case post.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_subject_car", values[i])
+			} else if value.Valid {
+				po.post_subject_car = new(uuid.UUID)
+				*po.post_subject_car = *value.S.(*uuid.UUID)
+			}
 		}
 	}
 	return nil
@@ -104,6 +167,71 @@ func (po *Post) QueryAuthor() *UserQuery {
 	return (&PostClient{config: po.config}).QueryAuthor(po)
 }
 
+// QuerySubjectPet queries the "subject_pet" edge of the Post entity.
+func (po *Post) QuerySubjectPet() *PetQuery {
+	return (&PostClient{config: po.config}).QuerySubjectPet(po)
+}
+
+// QuerySubjectCar queries the "subject_car" edge of the Post entity.
+func (po *Post) QuerySubjectCar() *CarQuery {
+	return (&PostClient{config: po.config}).QuerySubjectCar(po)
+}
+
+// PostReviewerLoader loads the entities of the "reviewer" external edge of Post by their ids.
+// It is implemented by the client or package that owns the referenced entities.
+type PostReviewerLoader interface {
+	LoadPostReviewer(context.Context, []uint64) (map[uint64]*idtypeent.User, error)
+}
+
+// The PostReviewerLoaderFunc type is an adapter to allow the use of ordinary functions as PostReviewerLoader.
+type PostReviewerLoaderFunc func(context.Context, []uint64) (map[uint64]*idtypeent.User, error)
+
+// LoadPostReviewer calls f(ctx, ids).
+func (f PostReviewerLoaderFunc) LoadPostReviewer(ctx context.Context, ids []uint64) (map[uint64]*idtypeent.User, error) {
+	return f(ctx, ids)
+}
+
+// LoadReviewer loads the entity of the "reviewer" external edge of the Post using the given loader.
+// Reviewer is a user of the idtype client.
+func (po *Post) LoadReviewer(ctx context.Context, loader PostReviewerLoader) (*idtypeent.User, error) {
+	id := po.ReviewerID
+	vs, err := loader.LoadPostReviewer(ctx, []uint64{id})
+	if err != nil {
+		return nil, err
+	}
+	v, ok := vs[id]
+	if !ok {
+		return nil, &NotFoundError{label: "reviewer", id: id}
+	}
+	return v, nil
+}
+
+// PostSubject is the interface of the entities that the "subject" polymorphic edge of Post points to.
+// It is implemented by *Pet and *Car.
+type PostSubject interface {
+	// AsPet returns the entity as a *Pet, or false if it is not one.
+	AsPet() (*Pet, bool)
+	// AsCar returns the entity as a *Car, or false if it is not one.
+	AsCar() (*Car, bool)
+}
+
+// QuerySubject queries the "subject" polymorphic edge of the Post entity, and returns the
+// entity it points to, or a *NotFoundError if the edge is not set. The edges to the types are queried
+// in the order they were defined, until the entity is found.
+func (po *Post) QuerySubject(ctx context.Context) (PostSubject, error) {
+	if v, err := po.QuerySubjectPet().Only(ctx); err == nil {
+		return v, nil
+	} else if !IsNotFound(err) {
+		return nil, err
+	}
+	if v, err := po.QuerySubjectCar().Only(ctx); err == nil {
+		return v, nil
+	} else if !IsNotFound(err) {
+		return nil, err
+	}
+	return nil, &NotFoundError{label: "subject"}
+}
+
 // Update returns a builder for updating this Post.
 // Note that you need to call Post.Unwrap() before calling this method if this Post
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -134,6 +262,9 @@ func (po *Post) String() string {
 		builder.WriteString("author_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("reviewer_id=")
+	builder.WriteString(fmt.Sprintf("%v", po.ReviewerID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -146,3 +277,21 @@ func (po Posts) config(cfg config) {
 		po[_i].config = cfg
 	}
 }
+
+// LoadReviewer loads the entities of the "reviewer" external edge of the Posts using
+// the given loader in one batch, and returns them mapped by their ids.
+func (po Posts) LoadReviewer(ctx context.Context, loader PostReviewerLoader) (map[uint64]*idtypeent.User, error) {
+	ids := make([]uint64, 0, len(po))
+	seen := make(map[uint64]struct{}, len(po))
+	for _, _e := range po {
+		id := _e.ReviewerID
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return make(map[uint64]*idtypeent.User), nil
+	}
+	return loader.LoadPostReviewer(ctx, ids)
+}
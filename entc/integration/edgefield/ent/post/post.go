@@ -15,8 +15,14 @@ const (
 	FieldText = "text"
 	// FieldAuthorID holds the string denoting the author_id field in the database.
 	FieldAuthorID = "author_id"
+	// FieldReviewerID holds the string denoting the reviewer_id field in the database.
+	FieldReviewerID = "reviewer_id"
 	// EdgeAuthor holds the string denoting the author edge name in mutations.
 	EdgeAuthor = "author"
+	// EdgeSubjectPet holds the string denoting the subject_pet edge name in mutations.
+	EdgeSubjectPet = "subject_pet"
+	// EdgeSubjectCar holds the string denoting the subject_car edge name in mutations.
+	EdgeSubjectCar = "subject_car"
 	// Table holds the table name of the post in the database.
 	Table = "posts"
 	// AuthorTable is the table that holds the author relation/edge.
@@ -26,6 +32,20 @@ const (
 	AuthorInverseTable = "users"
 	// AuthorColumn is the table column denoting the author relation/edge.
 	AuthorColumn = "author_id"
+	// SubjectPetTable is the table that holds the subject_pet relation/edge.
+	SubjectPetTable = "posts"
+	// SubjectPetInverseTable is the table name for the Pet entity.
+	// It exists in this package in order to avoid circular dependency with the "pet" package.
+	SubjectPetInverseTable = "pets"
+	// SubjectPetColumn is the table column denoting the subject_pet relation/edge.
+	SubjectPetColumn = "post_subject_pet"
+	// SubjectCarTable is the table that holds the subject_car relation/edge.
+	SubjectCarTable = "posts"
+	// SubjectCarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	SubjectCarInverseTable = "cars"
+	// SubjectCarColumn is the table column denoting the subject_car relation/edge.
+	SubjectCarColumn = "post_subject_car"
 )
 
 // Columns holds all SQL columns for post fields.
@@ -33,6 +53,14 @@ var Columns = []string{
 	FieldID,
 	FieldText,
 	FieldAuthorID,
+	FieldReviewerID,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "posts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"post_subject_pet",
+	"post_subject_car",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -42,5 +70,10 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
@@ -12,9 +12,12 @@ import (
 	"fmt"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/edgefield/ent/car"
+	"entgo.io/ent/entc/integration/edgefield/ent/pet"
 	"entgo.io/ent/entc/integration/edgefield/ent/post"
 	"entgo.io/ent/entc/integration/edgefield/ent/user"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // PostCreate is the builder for creating a Post entity.
@@ -44,11 +47,73 @@ func (pc *PostCreate) SetNillableAuthorID(i *int) *PostCreate {
 	return pc
 }
 
+// SetReviewerID sets the "reviewer_id" field.
+func (pc *PostCreate) SetReviewerID(u uint64) *PostCreate {
+	pc.mutation.SetReviewerID(u)
+	return pc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (pc *PostCreate) SetNillableReviewerID(u *uint64) *PostCreate {
+	if u != nil {
+		pc.SetReviewerID(*u)
+	}
+	return pc
+}
+
 // SetAuthor sets the "author" edge to the User entity.
 func (pc *PostCreate) SetAuthor(u *User) *PostCreate {
 	return pc.SetAuthorID(u.ID)
 }
 
+// SetSubjectPetID sets the "subject_pet" edge to the Pet entity by ID.
+func (pc *PostCreate) SetSubjectPetID(id int) *PostCreate {
+	pc.mutation.SetSubjectPetID(id)
+	return pc
+}
+
+// SetNillableSubjectPetID sets the "subject_pet" edge to the Pet entity by ID if the given value is not nil.
+func (pc *PostCreate) SetNillableSubjectPetID(id *int) *PostCreate {
+	if id != nil {
+		pc = pc.SetSubjectPetID(*id)
+	}
+	return pc
+}
+
+// SetSubjectPet sets the "subject_pet" edge to the Pet entity.
+func (pc *PostCreate) SetSubjectPet(p *Pet) *PostCreate {
+	return pc.SetSubjectPetID(p.ID)
+}
+
+// SetSubjectCarID sets the "subject_car" edge to the Car entity by ID.
+func (pc *PostCreate) SetSubjectCarID(id uuid.UUID) *PostCreate {
+	pc.mutation.SetSubjectCarID(id)
+	return pc
+}
+
+// SetNillableSubjectCarID sets the "subject_car" edge to the Car entity by ID if the given value is not nil.
+func (pc *PostCreate) SetNillableSubjectCarID(id *uuid.UUID) *PostCreate {
+	if id != nil {
+		pc = pc.SetSubjectCarID(*id)
+	}
+	return pc
+}
+
+// SetSubjectCar sets the "subject_car" edge to the Car entity.
+func (pc *PostCreate) SetSubjectCar(c *Car) *PostCreate {
+	return pc.SetSubjectCarID(c.ID)
+}
+
+// SetSubject sets the "subject" polymorphic edge to the given entity.
+func (pc *PostCreate) SetSubject(v PostSubject) *PostCreate {
+	if e, ok := v.AsPet(); ok {
+		pc.mutation.SetSubjectPetID(e.ID)
+	} else if e, ok := v.AsCar(); ok {
+		pc.mutation.SetSubjectCarID(e.ID)
+	}
+	return pc
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pc *PostCreate) Mutation() *PostMutation {
 	return pc.mutation
@@ -163,6 +228,14 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		})
 		_node.Text = value
 	}
+	if value, ok := pc.mutation.ReviewerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+		_node.ReviewerID = value
+	}
 	if nodes := pc.mutation.AuthorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -183,6 +256,46 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_node.AuthorID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.SubjectPetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   post.SubjectPetTable,
+			Columns: []string{post.SubjectPetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: pet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_subject_pet = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.SubjectCarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   post.SubjectCarTable,
+			Columns: []string{post.SubjectCarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_subject_car = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,24 +11,32 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/edgefield/ent/car"
+	"entgo.io/ent/entc/integration/edgefield/ent/pet"
 	"entgo.io/ent/entc/integration/edgefield/ent/post"
 	"entgo.io/ent/entc/integration/edgefield/ent/predicate"
 	"entgo.io/ent/entc/integration/edgefield/ent/user"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // PostQuery is the builder for querying Post entities.
 type PostQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.Post
-	withAuthor *UserQuery
+	limit          *int
+	offset         *int
+	unique         *bool
+	order          []OrderFunc
+	fields         []string
+	inters         []Interceptor
+	predicates     []predicate.Post
+	withAuthor     *UserQuery
+	withSubjectPet *PetQuery
+	withSubjectCar *CarQuery
+	withFKs        bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -67,7 +75,7 @@ func (pq *PostQuery) Order(o ...OrderFunc) *PostQuery {
 
 // QueryAuthor chains the current query on the "author" edge.
 func (pq *PostQuery) QueryAuthor() *UserQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -87,6 +95,50 @@ func (pq *PostQuery) QueryAuthor() *UserQuery {
 	return query
 }
 
+// QuerySubjectPet chains the current query on the "subject_pet" edge.
+func (pq *PostQuery) QuerySubjectPet() *PetQuery {
+	query := (&PetClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(pet.Table, pet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, post.SubjectPetTable, post.SubjectPetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySubjectCar chains the current query on the "subject_car" edge.
+func (pq *PostQuery) QuerySubjectCar() *CarQuery {
+	query := (&CarClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, post.SubjectCarTable, post.SubjectCarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Post entity from the query.
 // Returns a *NotFoundError when no Post was found.
 func (pq *PostQuery) First(ctx context.Context) (*Post, error) {
@@ -95,7 +147,7 @@ func (pq *PostQuery) First(ctx context.Context) (*Post, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{post.Label}
+		return nil, pq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -117,7 +169,7 @@ func (pq *PostQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{post.Label}
+		err = pq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -144,7 +196,7 @@ func (pq *PostQuery) Only(ctx context.Context) (*Post, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{post.Label}
+		return nil, pq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{post.Label}
 	}
@@ -171,7 +223,7 @@ func (pq *PostQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{post.Label}
+		err = pq.sqlNotFound()
 	default:
 		err = &NotSingularError{post.Label}
 	}
@@ -192,7 +244,17 @@ func (pq *PostQuery) All(ctx context.Context) ([]*Post, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return pq.sqlAll(ctx)
+	v, err := pq.intercept(ctx, "All", func(ctx context.Context, query *PostQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Post)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Post", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -206,10 +268,20 @@ func (pq *PostQuery) AllX(ctx context.Context) []*Post {
 
 // IDs executes the query and returns a list of Post IDs.
 func (pq *PostQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := pq.Select(post.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := pq.intercept(ctx, "IDs", func(ctx context.Context, query *PostQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(post.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -227,7 +299,17 @@ func (pq *PostQuery) Count(ctx context.Context) (int, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return pq.sqlCount(ctx)
+	v, err := pq.intercept(ctx, "Count", func(ctx context.Context, query *PostQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -244,7 +326,68 @@ func (pq *PostQuery) Exist(ctx context.Context) (bool, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return pq.sqlExist(ctx)
+	v, err := pq.intercept(ctx, "Exist", func(ctx context.Context, query *PostQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (pq *PostQuery) intercept(ctx context.Context, op string, fn func(context.Context, *PostQuery) (Value, error)) (Value, error) {
+	if len(pq.inters) == 0 {
+		return fn(ctx, pq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypePost,
+		Op:     op,
+		Limit:  pq.limit,
+		Offset: pq.offset,
+		Unique: pq.unique,
+		Fields: pq.fields,
+	}
+	for _, p := range pq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range pq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if pq.withAuthor != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, post.EdgeAuthor)
+		qc.EdgeQueries[post.EdgeAuthor] = pq.withAuthor
+	}
+	if pq.withSubjectPet != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, post.EdgeSubjectPet)
+		qc.EdgeQueries[post.EdgeSubjectPet] = pq.withSubjectPet
+	}
+	if pq.withSubjectCar != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, post.EdgeSubjectCar)
+		qc.EdgeQueries[post.EdgeSubjectCar] = pq.withSubjectCar
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PostQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *PostQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, pq, qc, qr, pq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -263,12 +406,15 @@ func (pq *PostQuery) Clone() *PostQuery {
 		return nil
 	}
 	return &PostQuery{
-		config:     pq.config,
-		limit:      pq.limit,
-		offset:     pq.offset,
-		order:      append([]OrderFunc{}, pq.order...),
-		predicates: append([]predicate.Post{}, pq.predicates...),
-		withAuthor: pq.withAuthor.Clone(),
+		config:         pq.config,
+		limit:          pq.limit,
+		offset:         pq.offset,
+		order:          append([]OrderFunc{}, pq.order...),
+		inters:         append([]Interceptor{}, pq.inters...),
+		predicates:     append([]predicate.Post{}, pq.predicates...),
+		withAuthor:     pq.withAuthor.Clone(),
+		withSubjectPet: pq.withSubjectPet.Clone(),
+		withSubjectCar: pq.withSubjectCar.Clone(),
 		// clone intermediate query.
 		sql:    pq.sql.Clone(),
 		path:   pq.path,
@@ -279,7 +425,7 @@ func (pq *PostQuery) Clone() *PostQuery {
 // WithAuthor tells the query-builder to eager-load the nodes that are connected to
 // the "author" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PostQuery) WithAuthor(opts ...func(*UserQuery)) *PostQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -287,6 +433,28 @@ func (pq *PostQuery) WithAuthor(opts ...func(*UserQuery)) *PostQuery {
 	return pq
 }
 
+// WithSubjectPet tells the query-builder to eager-load the nodes that are connected to
+// the "subject_pet" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PostQuery) WithSubjectPet(opts ...func(*PetQuery)) *PostQuery {
+	query := (&PetClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withSubjectPet = query
+	return pq
+}
+
+// WithSubjectCar tells the query-builder to eager-load the nodes that are connected to
+// the "subject_car" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PostQuery) WithSubjectCar(opts ...func(*CarQuery)) *PostQuery {
+	query := (&CarClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withSubjectCar = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -343,6 +511,16 @@ func (pq *PostQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range pq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pq); err != nil {
+				return err
+			}
+		}
+	}
 	if pq.path != nil {
 		prev, err := pq.path(ctx)
 		if err != nil {
@@ -356,11 +534,20 @@ func (pq *PostQuery) prepareQuery(ctx context.Context) error {
 func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, error) {
 	var (
 		nodes       = []*Post{}
+		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			pq.withAuthor != nil,
+			pq.withSubjectPet != nil,
+			pq.withSubjectCar != nil,
 		}
 	)
+	if pq.withAuthor != nil || pq.withSubjectPet != nil || pq.withSubjectCar != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, post.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Post).scanValues(nil, columns)
 	}
@@ -373,7 +560,7 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, pq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -385,6 +572,18 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 			return nil, err
 		}
 	}
+	if query := pq.withSubjectPet; query != nil {
+		if err := pq.loadSubjectPet(ctx, query, nodes, nil,
+			func(n *Post, e *Pet) { n.Edges.SubjectPet = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withSubjectCar; query != nil {
+		if err := pq.loadSubjectCar(ctx, query, nodes, nil,
+			func(n *Post, e *Car) { n.Edges.SubjectCar = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -417,6 +616,64 @@ func (pq *PostQuery) loadAuthor(ctx context.Context, query *UserQuery, nodes []*
 	}
 	return nil
 }
+func (pq *PostQuery) loadSubjectPet(ctx context.Context, query *PetQuery, nodes []*Post, init func(*Post), assign func(*Post, *Pet)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Post)
+	for i := range nodes {
+		if nodes[i].post_subject_pet == nil {
+			continue
+		}
+		fk := *nodes[i].post_subject_pet
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(pet.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "post_subject_pet" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pq *PostQuery) loadSubjectCar(ctx context.Context, query *CarQuery, nodes []*Post, init func(*Post), assign func(*Post, *Car)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Post)
+	for i := range nodes {
+		if nodes[i].post_subject_car == nil {
+			continue
+		}
+		fk := *nodes[i].post_subject_car
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(car.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "post_subject_car" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (pq *PostQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
@@ -424,7 +681,7 @@ func (pq *PostQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(pq.fields) > 0 {
 		_spec.Unique = pq.unique != nil && *pq.unique
 	}
-	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, pq.queryDriver(), _spec)
 }
 
 func (pq *PostQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -515,6 +772,32 @@ func (pq *PostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (pq *PostQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: post.Label}
+	if len(pq.predicates) > 0 {
+		selector := sql.Dialect(pq.driver.Dialect()).Select().From(sql.Table(post.Table))
+		for _, p := range pq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the PostQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (pq *PostQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Post, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Post(ps[i]))
+	}
+	pq.predicates = append(pq.predicates, wps...)
+}
+
 // PostGroupBy is the group-by builder for Post entities.
 type PostGroupBy struct {
 	config
@@ -554,7 +837,7 @@ func (pgb *PostGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := pgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := pgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -600,7 +883,7 @@ func (ps *PostSelect) Scan(ctx context.Context, v interface{}) error {
 func (ps *PostSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ps.sql.Query()
-	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ps.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -13,10 +13,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/edgefield/ent/car"
+	"entgo.io/ent/entc/integration/edgefield/ent/pet"
 	"entgo.io/ent/entc/integration/edgefield/ent/post"
 	"entgo.io/ent/entc/integration/edgefield/ent/predicate"
 	"entgo.io/ent/entc/integration/edgefield/ent/user"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // PostUpdate is the builder for updating Post entities.
@@ -58,11 +61,114 @@ func (pu *PostUpdate) ClearAuthorID() *PostUpdate {
 	return pu
 }
 
+// SetReviewerID sets the "reviewer_id" field.
+func (pu *PostUpdate) SetReviewerID(u uint64) *PostUpdate {
+	pu.mutation.ResetReviewerID()
+	pu.mutation.SetReviewerID(u)
+	return pu
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableReviewerID(u *uint64) *PostUpdate {
+	if u != nil {
+		pu.SetReviewerID(*u)
+	}
+	return pu
+}
+
+// AddReviewerID adds u to the "reviewer_id" field.
+func (pu *PostUpdate) AddReviewerID(u int64) *PostUpdate {
+	pu.mutation.AddReviewerID(u)
+	return pu
+}
+
+// SetReviewerIDIfGreater sets the "reviewer_id" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (pu *PostUpdate) SetReviewerIDIfGreater(u uint64) *PostUpdate {
+	pu.mutation.SetReviewerIDIfGreater(u)
+	return pu
+}
+
+// BitAndReviewerID applies a bitwise AND with u on the "reviewer_id" field in the database.
+func (pu *PostUpdate) BitAndReviewerID(u uint64) *PostUpdate {
+	pu.mutation.BitAndReviewerID(u)
+	return pu
+}
+
+// BitOrReviewerID applies a bitwise OR with u on the "reviewer_id" field in the database.
+func (pu *PostUpdate) BitOrReviewerID(u uint64) *PostUpdate {
+	pu.mutation.BitOrReviewerID(u)
+	return pu
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (pu *PostUpdate) ClearReviewerID() *PostUpdate {
+	pu.mutation.ClearReviewerID()
+	return pu
+}
+
 // SetAuthor sets the "author" edge to the User entity.
 func (pu *PostUpdate) SetAuthor(u *User) *PostUpdate {
 	return pu.SetAuthorID(u.ID)
 }
 
+// SetSubjectPetID sets the "subject_pet" edge to the Pet entity by ID.
+func (pu *PostUpdate) SetSubjectPetID(id int) *PostUpdate {
+	pu.mutation.SetSubjectPetID(id)
+	return pu
+}
+
+// SetNillableSubjectPetID sets the "subject_pet" edge to the Pet entity by ID if the given value is not nil.
+func (pu *PostUpdate) SetNillableSubjectPetID(id *int) *PostUpdate {
+	if id != nil {
+		pu = pu.SetSubjectPetID(*id)
+	}
+	return pu
+}
+
+// SetSubjectPet sets the "subject_pet" edge to the Pet entity.
+func (pu *PostUpdate) SetSubjectPet(p *Pet) *PostUpdate {
+	return pu.SetSubjectPetID(p.ID)
+}
+
+// SetSubjectCarID sets the "subject_car" edge to the Car entity by ID.
+func (pu *PostUpdate) SetSubjectCarID(id uuid.UUID) *PostUpdate {
+	pu.mutation.SetSubjectCarID(id)
+	return pu
+}
+
+// SetNillableSubjectCarID sets the "subject_car" edge to the Car entity by ID if the given value is not nil.
+func (pu *PostUpdate) SetNillableSubjectCarID(id *uuid.UUID) *PostUpdate {
+	if id != nil {
+		pu = pu.SetSubjectCarID(*id)
+	}
+	return pu
+}
+
+// SetSubjectCar sets the "subject_car" edge to the Car entity.
+func (pu *PostUpdate) SetSubjectCar(c *Car) *PostUpdate {
+	return pu.SetSubjectCarID(c.ID)
+}
+
+// SetSubject sets the "subject" polymorphic edge to the given entity, and clears its edges to the other types.
+func (pu *PostUpdate) SetSubject(v PostSubject) *PostUpdate {
+	if e, ok := v.AsPet(); ok {
+		pu.mutation.SetSubjectPetID(e.ID)
+		pu.mutation.ClearSubjectCar()
+	} else if e, ok := v.AsCar(); ok {
+		pu.mutation.SetSubjectCarID(e.ID)
+		pu.mutation.ClearSubjectPet()
+	}
+	return pu
+}
+
+// ClearSubject clears the "subject" polymorphic edge.
+func (pu *PostUpdate) ClearSubject() *PostUpdate {
+	pu.mutation.ClearSubjectPet()
+	pu.mutation.ClearSubjectCar()
+	return pu
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pu *PostUpdate) Mutation() *PostMutation {
 	return pu.mutation
@@ -74,6 +180,18 @@ func (pu *PostUpdate) ClearAuthor() *PostUpdate {
 	return pu
 }
 
+// ClearSubjectPet clears the "subject_pet" edge to the Pet entity.
+func (pu *PostUpdate) ClearSubjectPet() *PostUpdate {
+	pu.mutation.ClearSubjectPet()
+	return pu
+}
+
+// ClearSubjectCar clears the "subject_car" edge to the Car entity.
+func (pu *PostUpdate) ClearSubjectCar() *PostUpdate {
+	pu.mutation.ClearSubjectCar()
+	return pu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PostUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -153,6 +271,47 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: post.FieldText,
 		})
 	}
+	if value, ok := pu.mutation.ReviewerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+	}
+	if value, ok := pu.mutation.AddedReviewerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+	}
+	if value, ok := pu.mutation.ReviewerIDIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+	}
+	if value, ok := pu.mutation.ReviewerIDBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+	}
+	if value, ok := pu.mutation.ReviewerIDBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+	}
+	if pu.mutation.ReviewerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Column: post.FieldReviewerID,
+		})
+	}
 	if pu.mutation.AuthorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -188,9 +347,79 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.SubjectPetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   post.SubjectPetTable,
+			Columns: []string{post.SubjectPetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: pet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.SubjectPetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   post.SubjectPetTable,
+			Columns: []string{post.SubjectPetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: pet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.SubjectCarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   post.SubjectCarTable,
+			Columns: []string{post.SubjectCarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.SubjectCarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   post.SubjectCarTable,
+			Columns: []string{post.SubjectCarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{post.Label}
+			err = &NotFoundError{label: post.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -202,9 +431,18 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // PostUpdateOne is the builder for updating a single Post entity.
 type PostUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PostMutation
+	fields     []string
+	conditions []predicate.Post
+	hooks      []Hook
+	mutation   *PostMutation
+}
+
+// Where appends a list of conditions to the PostUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (puo *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
+	puo.conditions = append(puo.conditions, ps...)
+	return puo
 }
 
 // SetText sets the "text" field.
@@ -233,11 +471,114 @@ func (puo *PostUpdateOne) ClearAuthorID() *PostUpdateOne {
 	return puo
 }
 
+// SetReviewerID sets the "reviewer_id" field.
+func (puo *PostUpdateOne) SetReviewerID(u uint64) *PostUpdateOne {
+	puo.mutation.ResetReviewerID()
+	puo.mutation.SetReviewerID(u)
+	return puo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableReviewerID(u *uint64) *PostUpdateOne {
+	if u != nil {
+		puo.SetReviewerID(*u)
+	}
+	return puo
+}
+
+// AddReviewerID adds u to the "reviewer_id" field.
+func (puo *PostUpdateOne) AddReviewerID(u int64) *PostUpdateOne {
+	puo.mutation.AddReviewerID(u)
+	return puo
+}
+
+// SetReviewerIDIfGreater sets the "reviewer_id" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (puo *PostUpdateOne) SetReviewerIDIfGreater(u uint64) *PostUpdateOne {
+	puo.mutation.SetReviewerIDIfGreater(u)
+	return puo
+}
+
+// BitAndReviewerID applies a bitwise AND with u on the "reviewer_id" field in the database.
+func (puo *PostUpdateOne) BitAndReviewerID(u uint64) *PostUpdateOne {
+	puo.mutation.BitAndReviewerID(u)
+	return puo
+}
+
+// BitOrReviewerID applies a bitwise OR with u on the "reviewer_id" field in the database.
+func (puo *PostUpdateOne) BitOrReviewerID(u uint64) *PostUpdateOne {
+	puo.mutation.BitOrReviewerID(u)
+	return puo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (puo *PostUpdateOne) ClearReviewerID() *PostUpdateOne {
+	puo.mutation.ClearReviewerID()
+	return puo
+}
+
 // SetAuthor sets the "author" edge to the User entity.
 func (puo *PostUpdateOne) SetAuthor(u *User) *PostUpdateOne {
 	return puo.SetAuthorID(u.ID)
 }
 
+// SetSubjectPetID sets the "subject_pet" edge to the Pet entity by ID.
+func (puo *PostUpdateOne) SetSubjectPetID(id int) *PostUpdateOne {
+	puo.mutation.SetSubjectPetID(id)
+	return puo
+}
+
+// SetNillableSubjectPetID sets the "subject_pet" edge to the Pet entity by ID if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableSubjectPetID(id *int) *PostUpdateOne {
+	if id != nil {
+		puo = puo.SetSubjectPetID(*id)
+	}
+	return puo
+}
+
+// SetSubjectPet sets the "subject_pet" edge to the Pet entity.
+func (puo *PostUpdateOne) SetSubjectPet(p *Pet) *PostUpdateOne {
+	return puo.SetSubjectPetID(p.ID)
+}
+
+// SetSubjectCarID sets the "subject_car" edge to the Car entity by ID.
+func (puo *PostUpdateOne) SetSubjectCarID(id uuid.UUID) *PostUpdateOne {
+	puo.mutation.SetSubjectCarID(id)
+	return puo
+}
+
+// SetNillableSubjectCarID sets the "subject_car" edge to the Car entity by ID if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableSubjectCarID(id *uuid.UUID) *PostUpdateOne {
+	if id != nil {
+		puo = puo.SetSubjectCarID(*id)
+	}
+	return puo
+}
+
+// SetSubjectCar sets the "subject_car" edge to the Car entity.
+func (puo *PostUpdateOne) SetSubjectCar(c *Car) *PostUpdateOne {
+	return puo.SetSubjectCarID(c.ID)
+}
+
+// SetSubject sets the "subject" polymorphic edge to the given entity, and clears its edges to the other types.
+func (puo *PostUpdateOne) SetSubject(v PostSubject) *PostUpdateOne {
+	if e, ok := v.AsPet(); ok {
+		puo.mutation.SetSubjectPetID(e.ID)
+		puo.mutation.ClearSubjectCar()
+	} else if e, ok := v.AsCar(); ok {
+		puo.mutation.SetSubjectCarID(e.ID)
+		puo.mutation.ClearSubjectPet()
+	}
+	return puo
+}
+
+// ClearSubject clears the "subject" polymorphic edge.
+func (puo *PostUpdateOne) ClearSubject() *PostUpdateOne {
+	puo.mutation.ClearSubjectPet()
+	puo.mutation.ClearSubjectCar()
+	return puo
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (puo *PostUpdateOne) Mutation() *PostMutation {
 	return puo.mutation
@@ -249,6 +590,18 @@ func (puo *PostUpdateOne) ClearAuthor() *PostUpdateOne {
 	return puo
 }
 
+// ClearSubjectPet clears the "subject_pet" edge to the Pet entity.
+func (puo *PostUpdateOne) ClearSubjectPet() *PostUpdateOne {
+	puo.mutation.ClearSubjectPet()
+	return puo
+}
+
+// ClearSubjectCar clears the "subject_car" edge to the Car entity.
+func (puo *PostUpdateOne) ClearSubjectCar() *PostUpdateOne {
+	puo.mutation.ClearSubjectCar()
+	return puo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (puo *PostUpdateOne) Select(field string, fields ...string) *PostUpdateOne {
@@ -351,6 +704,13 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 			}
 		}
 	}
+	if ps := puo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := puo.mutation.Text(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -358,6 +718,47 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 			Column: post.FieldText,
 		})
 	}
+	if value, ok := puo.mutation.ReviewerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+	}
+	if value, ok := puo.mutation.AddedReviewerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+	}
+	if value, ok := puo.mutation.ReviewerIDIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+	}
+	if value, ok := puo.mutation.ReviewerIDBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+	}
+	if value, ok := puo.mutation.ReviewerIDBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: post.FieldReviewerID,
+		})
+	}
+	if puo.mutation.ReviewerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Column: post.FieldReviewerID,
+		})
+	}
 	if puo.mutation.AuthorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -393,12 +794,84 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.SubjectPetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   post.SubjectPetTable,
+			Columns: []string{post.SubjectPetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: pet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.SubjectPetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   post.SubjectPetTable,
+			Columns: []string{post.SubjectPetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: pet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.SubjectCarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   post.SubjectCarTable,
+			Columns: []string{post.SubjectCarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.SubjectCarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   post.SubjectCarTable,
+			Columns: []string{post.SubjectCarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Post{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{post.Label}
+			err = &NotFoundError{label: post.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: post.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
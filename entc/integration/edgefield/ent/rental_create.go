@@ -141,7 +141,7 @@ func (rc *RentalCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (rc *RentalCreate) defaults() {
 	if _, ok := rc.mutation.Date(); !ok {
-		v := rental.DefaultDate()
+		v := rc.config.now(rental.DefaultDate)
 		rc.mutation.SetDate(v)
 	}
 }
@@ -107,7 +107,7 @@ func (rdo *RentalDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{rental.Label}
+		return &NotFoundError{label: rental.Label}
 	default:
 		return nil
 	}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/car"
@@ -29,6 +30,7 @@ type RentalQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Rental
 	withUser   *UserQuery
 	withCar    *CarQuery
@@ -70,7 +72,7 @@ func (rq *RentalQuery) Order(o ...OrderFunc) *RentalQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (rq *RentalQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: rq.config}
+	query := (&UserClient{config: rq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := rq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -92,7 +94,7 @@ func (rq *RentalQuery) QueryUser() *UserQuery {
 
 // QueryCar chains the current query on the "car" edge.
 func (rq *RentalQuery) QueryCar() *CarQuery {
-	query := &CarQuery{config: rq.config}
+	query := (&CarClient{config: rq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := rq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -120,7 +122,7 @@ func (rq *RentalQuery) First(ctx context.Context) (*Rental, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{rental.Label}
+		return nil, rq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -142,7 +144,7 @@ func (rq *RentalQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{rental.Label}
+		err = rq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -169,7 +171,7 @@ func (rq *RentalQuery) Only(ctx context.Context) (*Rental, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{rental.Label}
+		return nil, rq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{rental.Label}
 	}
@@ -196,7 +198,7 @@ func (rq *RentalQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{rental.Label}
+		err = rq.sqlNotFound()
 	default:
 		err = &NotSingularError{rental.Label}
 	}
@@ -217,7 +219,17 @@ func (rq *RentalQuery) All(ctx context.Context) ([]*Rental, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return rq.sqlAll(ctx)
+	v, err := rq.intercept(ctx, "All", func(ctx context.Context, query *RentalQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Rental)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Rental", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -231,10 +243,20 @@ func (rq *RentalQuery) AllX(ctx context.Context) []*Rental {
 
 // IDs executes the query and returns a list of Rental IDs.
 func (rq *RentalQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := rq.Select(rental.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := rq.intercept(ctx, "IDs", func(ctx context.Context, query *RentalQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(rental.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -252,7 +274,17 @@ func (rq *RentalQuery) Count(ctx context.Context) (int, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return rq.sqlCount(ctx)
+	v, err := rq.intercept(ctx, "Count", func(ctx context.Context, query *RentalQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -269,7 +301,61 @@ func (rq *RentalQuery) Exist(ctx context.Context) (bool, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return rq.sqlExist(ctx)
+	v, err := rq.intercept(ctx, "Exist", func(ctx context.Context, query *RentalQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (rq *RentalQuery) intercept(ctx context.Context, op string, fn func(context.Context, *RentalQuery) (Value, error)) (Value, error) {
+	if len(rq.inters) == 0 {
+		return fn(ctx, rq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeRental,
+		Op:     op,
+		Limit:  rq.limit,
+		Offset: rq.offset,
+		Unique: rq.unique,
+		Fields: rq.fields,
+	}
+	for _, p := range rq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range rq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if rq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, rental.EdgeUser)
+		qc.EdgeQueries[rental.EdgeUser] = rq.withUser
+	}
+	if rq.withCar != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, rental.EdgeCar)
+		qc.EdgeQueries[rental.EdgeCar] = rq.withCar
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*RentalQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *RentalQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, rq, qc, qr, rq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -292,6 +378,7 @@ func (rq *RentalQuery) Clone() *RentalQuery {
 		limit:      rq.limit,
 		offset:     rq.offset,
 		order:      append([]OrderFunc{}, rq.order...),
+		inters:     append([]Interceptor{}, rq.inters...),
 		predicates: append([]predicate.Rental{}, rq.predicates...),
 		withUser:   rq.withUser.Clone(),
 		withCar:    rq.withCar.Clone(),
@@ -305,7 +392,7 @@ func (rq *RentalQuery) Clone() *RentalQuery {
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (rq *RentalQuery) WithUser(opts ...func(*UserQuery)) *RentalQuery {
-	query := &UserQuery{config: rq.config}
+	query := (&UserClient{config: rq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -316,7 +403,7 @@ func (rq *RentalQuery) WithUser(opts ...func(*UserQuery)) *RentalQuery {
 // WithCar tells the query-builder to eager-load the nodes that are connected to
 // the "car" edge. The optional arguments are used to configure the query builder of the edge.
 func (rq *RentalQuery) WithCar(opts ...func(*CarQuery)) *RentalQuery {
-	query := &CarQuery{config: rq.config}
+	query := (&CarClient{config: rq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -380,6 +467,16 @@ func (rq *RentalQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range rq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rq); err != nil {
+				return err
+			}
+		}
+	}
 	if rq.path != nil {
 		prev, err := rq.path(ctx)
 		if err != nil {
@@ -411,7 +508,7 @@ func (rq *RentalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Renta
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, rq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -491,7 +588,7 @@ func (rq *RentalQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(rq.fields) > 0 {
 		_spec.Unique = rq.unique != nil && *rq.unique
 	}
-	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, rq.queryDriver(), _spec)
 }
 
 func (rq *RentalQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -582,6 +679,32 @@ func (rq *RentalQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (rq *RentalQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: rental.Label}
+	if len(rq.predicates) > 0 {
+		selector := sql.Dialect(rq.driver.Dialect()).Select().From(sql.Table(rental.Table))
+		for _, p := range rq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the RentalQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (rq *RentalQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Rental, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Rental(ps[i]))
+	}
+	rq.predicates = append(rq.predicates, wps...)
+}
+
 // RentalGroupBy is the group-by builder for Rental entities.
 type RentalGroupBy struct {
 	config
@@ -621,7 +744,7 @@ func (rgb *RentalGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := rgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -667,7 +790,7 @@ func (rs *RentalSelect) Scan(ctx context.Context, v interface{}) error {
 func (rs *RentalSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := rs.sql.Query()
-	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
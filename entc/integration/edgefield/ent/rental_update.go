@@ -256,7 +256,7 @@ func (ru *RentalUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{rental.Label}
+			err = &NotFoundError{label: rental.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -268,9 +268,18 @@ func (ru *RentalUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // RentalUpdateOne is the builder for updating a single Rental entity.
 type RentalUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *RentalMutation
+	fields     []string
+	conditions []predicate.Rental
+	hooks      []Hook
+	mutation   *RentalMutation
+}
+
+// Where appends a list of conditions to the RentalUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ruo *RentalUpdateOne) Where(ps ...predicate.Rental) *RentalUpdateOne {
+	ruo.conditions = append(ruo.conditions, ps...)
+	return ruo
 }
 
 // SetDate sets the "date" field.
@@ -445,6 +454,13 @@ func (ruo *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err err
 			}
 		}
 	}
+	if ps := ruo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := ruo.mutation.Date(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -527,7 +543,9 @@ func (ruo *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err err
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{rental.Label}
+			err = &NotFoundError{label: rental.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: rental.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
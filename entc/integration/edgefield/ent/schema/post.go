@@ -6,6 +6,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	idtype "entgo.io/ent/entc/integration/idtype/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -23,6 +24,8 @@ func (Post) Fields() []ent.Field {
 		field.Int("author_id").
 			Optional().
 			Nillable(),
+		field.Uint64("reviewer_id").
+			Optional(),
 	}
 }
 
@@ -32,6 +35,11 @@ func (Post) Edges() []ent.Edge {
 		edge.To("author", User.Type).
 			Field("author_id").
 			Unique(),
+		edge.External("reviewer", idtype.User{}).
+			Field("reviewer_id").
+			Comment("Reviewer is a user of the idtype client."),
+		edge.Polymorphic("subject", Pet.Type, Car.Type).
+			Comment("Subject is the pet or the car the post is about."),
 	}
 }
 
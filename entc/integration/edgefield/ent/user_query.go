@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/card"
@@ -32,6 +33,7 @@ type UserQuery struct {
 	unique       *bool
 	order        []OrderFunc
 	fields       []string
+	inters       []Interceptor
 	predicates   []predicate.User
 	withPets     *PetQuery
 	withParent   *UserQuery
@@ -79,7 +81,7 @@ func (uq *UserQuery) Order(o ...OrderFunc) *UserQuery {
 
 // QueryPets chains the current query on the "pets" edge.
 func (uq *UserQuery) QueryPets() *PetQuery {
-	query := &PetQuery{config: uq.config}
+	query := (&PetClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -101,7 +103,7 @@ func (uq *UserQuery) QueryPets() *PetQuery {
 
 // QueryParent chains the current query on the "parent" edge.
 func (uq *UserQuery) QueryParent() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -123,7 +125,7 @@ func (uq *UserQuery) QueryParent() *UserQuery {
 
 // QueryChildren chains the current query on the "children" edge.
 func (uq *UserQuery) QueryChildren() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -145,7 +147,7 @@ func (uq *UserQuery) QueryChildren() *UserQuery {
 
 // QuerySpouse chains the current query on the "spouse" edge.
 func (uq *UserQuery) QuerySpouse() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -167,7 +169,7 @@ func (uq *UserQuery) QuerySpouse() *UserQuery {
 
 // QueryCard chains the current query on the "card" edge.
 func (uq *UserQuery) QueryCard() *CardQuery {
-	query := &CardQuery{config: uq.config}
+	query := (&CardClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -189,7 +191,7 @@ func (uq *UserQuery) QueryCard() *CardQuery {
 
 // QueryMetadata chains the current query on the "metadata" edge.
 func (uq *UserQuery) QueryMetadata() *MetadataQuery {
-	query := &MetadataQuery{config: uq.config}
+	query := (&MetadataClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -211,7 +213,7 @@ func (uq *UserQuery) QueryMetadata() *MetadataQuery {
 
 // QueryInfo chains the current query on the "info" edge.
 func (uq *UserQuery) QueryInfo() *InfoQuery {
-	query := &InfoQuery{config: uq.config}
+	query := (&InfoClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -233,7 +235,7 @@ func (uq *UserQuery) QueryInfo() *InfoQuery {
 
 // QueryRentals chains the current query on the "rentals" edge.
 func (uq *UserQuery) QueryRentals() *RentalQuery {
-	query := &RentalQuery{config: uq.config}
+	query := (&RentalClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -261,7 +263,7 @@ func (uq *UserQuery) First(ctx context.Context) (*User, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{user.Label}
+		return nil, uq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -283,7 +285,7 @@ func (uq *UserQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{user.Label}
+		err = uq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -310,7 +312,7 @@ func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{user.Label}
+		return nil, uq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{user.Label}
 	}
@@ -337,7 +339,7 @@ func (uq *UserQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{user.Label}
+		err = uq.sqlNotFound()
 	default:
 		err = &NotSingularError{user.Label}
 	}
@@ -358,7 +360,17 @@ func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return uq.sqlAll(ctx)
+	v, err := uq.intercept(ctx, "All", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*User)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*User", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -372,10 +384,20 @@ func (uq *UserQuery) AllX(ctx context.Context) []*User {
 
 // IDs executes the query and returns a list of User IDs.
 func (uq *UserQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := uq.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := uq.intercept(ctx, "IDs", func(ctx context.Context, query *UserQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -393,7 +415,17 @@ func (uq *UserQuery) Count(ctx context.Context) (int, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return uq.sqlCount(ctx)
+	v, err := uq.intercept(ctx, "Count", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -410,7 +442,103 @@ func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return uq.sqlExist(ctx)
+	v, err := uq.intercept(ctx, "Exist", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (uq *UserQuery) intercept(ctx context.Context, op string, fn func(context.Context, *UserQuery) (Value, error)) (Value, error) {
+	if len(uq.inters) == 0 {
+		return fn(ctx, uq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeUser,
+		Op:     op,
+		Limit:  uq.limit,
+		Offset: uq.offset,
+		Unique: uq.unique,
+		Fields: uq.fields,
+	}
+	for _, p := range uq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range uq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if uq.withPets != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgePets)
+		qc.EdgeQueries[user.EdgePets] = uq.withPets
+	}
+	if uq.withParent != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeParent)
+		qc.EdgeQueries[user.EdgeParent] = uq.withParent
+	}
+	if uq.withChildren != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeChildren)
+		qc.EdgeQueries[user.EdgeChildren] = uq.withChildren
+	}
+	if uq.withSpouse != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeSpouse)
+		qc.EdgeQueries[user.EdgeSpouse] = uq.withSpouse
+	}
+	if uq.withCard != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeCard)
+		qc.EdgeQueries[user.EdgeCard] = uq.withCard
+	}
+	if uq.withMetadata != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeMetadata)
+		qc.EdgeQueries[user.EdgeMetadata] = uq.withMetadata
+	}
+	if uq.withInfo != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeInfo)
+		qc.EdgeQueries[user.EdgeInfo] = uq.withInfo
+	}
+	if uq.withRentals != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeRentals)
+		qc.EdgeQueries[user.EdgeRentals] = uq.withRentals
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *UserQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, uq, qc, qr, uq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -433,6 +561,7 @@ func (uq *UserQuery) Clone() *UserQuery {
 		limit:        uq.limit,
 		offset:       uq.offset,
 		order:        append([]OrderFunc{}, uq.order...),
+		inters:       append([]Interceptor{}, uq.inters...),
 		predicates:   append([]predicate.User{}, uq.predicates...),
 		withPets:     uq.withPets.Clone(),
 		withParent:   uq.withParent.Clone(),
@@ -452,7 +581,7 @@ func (uq *UserQuery) Clone() *UserQuery {
 // WithPets tells the query-builder to eager-load the nodes that are connected to
 // the "pets" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithPets(opts ...func(*PetQuery)) *UserQuery {
-	query := &PetQuery{config: uq.config}
+	query := (&PetClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -463,7 +592,7 @@ func (uq *UserQuery) WithPets(opts ...func(*PetQuery)) *UserQuery {
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithParent(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -474,7 +603,7 @@ func (uq *UserQuery) WithParent(opts ...func(*UserQuery)) *UserQuery {
 // WithChildren tells the query-builder to eager-load the nodes that are connected to
 // the "children" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithChildren(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -485,7 +614,7 @@ func (uq *UserQuery) WithChildren(opts ...func(*UserQuery)) *UserQuery {
 // WithSpouse tells the query-builder to eager-load the nodes that are connected to
 // the "spouse" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithSpouse(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -496,7 +625,7 @@ func (uq *UserQuery) WithSpouse(opts ...func(*UserQuery)) *UserQuery {
 // WithCard tells the query-builder to eager-load the nodes that are connected to
 // the "card" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithCard(opts ...func(*CardQuery)) *UserQuery {
-	query := &CardQuery{config: uq.config}
+	query := (&CardClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -507,7 +636,7 @@ func (uq *UserQuery) WithCard(opts ...func(*CardQuery)) *UserQuery {
 // WithMetadata tells the query-builder to eager-load the nodes that are connected to
 // the "metadata" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithMetadata(opts ...func(*MetadataQuery)) *UserQuery {
-	query := &MetadataQuery{config: uq.config}
+	query := (&MetadataClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -518,7 +647,7 @@ func (uq *UserQuery) WithMetadata(opts ...func(*MetadataQuery)) *UserQuery {
 // WithInfo tells the query-builder to eager-load the nodes that are connected to
 // the "info" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithInfo(opts ...func(*InfoQuery)) *UserQuery {
-	query := &InfoQuery{config: uq.config}
+	query := (&InfoClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -529,7 +658,7 @@ func (uq *UserQuery) WithInfo(opts ...func(*InfoQuery)) *UserQuery {
 // WithRentals tells the query-builder to eager-load the nodes that are connected to
 // the "rentals" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithRentals(opts ...func(*RentalQuery)) *UserQuery {
-	query := &RentalQuery{config: uq.config}
+	query := (&RentalClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -593,6 +722,16 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range uq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, uq); err != nil {
+				return err
+			}
+		}
+	}
 	if uq.path != nil {
 		prev, err := uq.path(ctx)
 		if err != nil {
@@ -630,7 +769,7 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, uq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -906,7 +1045,7 @@ func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(uq.fields) > 0 {
 		_spec.Unique = uq.unique != nil && *uq.unique
 	}
-	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, uq.queryDriver(), _spec)
 }
 
 func (uq *UserQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -997,6 +1136,32 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (uq *UserQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: user.Label}
+	if len(uq.predicates) > 0 {
+		selector := sql.Dialect(uq.driver.Dialect()).Select().From(sql.Table(user.Table))
+		for _, p := range uq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the UserQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (uq *UserQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.User, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.User(ps[i]))
+	}
+	uq.predicates = append(uq.predicates, wps...)
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	config
@@ -1036,7 +1201,7 @@ func (ugb *UserGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ugb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ugb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -1082,7 +1247,7 @@ func (us *UserSelect) Scan(ctx context.Context, v interface{}) error {
 func (us *UserSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := us.sql.Query()
-	if err := us.driver.Query(ctx, query, args, rows); err != nil {
+	if err := us.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
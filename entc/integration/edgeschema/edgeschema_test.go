@@ -184,6 +184,31 @@ func TestEdgeSchemaBidiWithID(t *testing.T) {
 		require.Equal(t, nat.ID, f1.FriendID)
 	}
 	require.Equal(t, 2, client.Friendship.Query().CountX(ctx), "bidirectional edges create 2 records in the join table")
+
+	t.Log("Fields of the join table are set by the builders")
+	alex := client.User.Create().
+		SetName("alex").
+		AddFriends(nat).
+		SetFriendsThrough(func(c *ent.FriendshipCreate) {
+			c.SetWeight(10).SetUserID(a8m.ID)
+		}).
+		SaveX(ctx)
+	ws := client.Friendship.Query().Where(friendship.Or(friendship.UserID(alex.ID), friendship.FriendID(alex.ID))).Select(friendship.FieldWeight).IntsX(ctx)
+	require.Equal(t, []int{10, 10}, ws, "edge-fields are set by the builder")
+	a8m.Update().
+		AddFriends(alex).
+		SetFriendsThrough(func(c *ent.FriendshipCreate) {
+			c.SetWeight(5)
+		}).
+		ExecX(ctx)
+	f2 := alex.QueryFriendships().Where(friendship.FriendID(a8m.ID)).OnlyX(ctx)
+	require.Equal(t, 5, f2.Weight)
+	require.False(t, f2.CreatedAt.IsZero())
+	require.Equal(t, a8m.ID, f2.QueryFriend().OnlyIDX(ctx))
+	client.User.UpdateOne(alex).RemoveFriends(a8m).SetFriendsThrough(func(*ent.FriendshipCreate) {
+		require.FailNow(t, "function must not be called for removed edges")
+	}).ExecX(ctx)
+	require.Zero(t, alex.QueryFriendships().Where(friendship.FriendID(a8m.ID)).CountX(ctx))
 }
 
 func TestEdgeSchemaBidiCompositeID(t *testing.T) {
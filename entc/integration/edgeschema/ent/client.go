@@ -29,6 +29,7 @@ import (
 	"entgo.io/ent/entc/integration/edgeschema/ent/usergroup"
 	"entgo.io/ent/entc/integration/edgeschema/ent/usertweet"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -69,10 +70,11 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
+	client.AddExtension(ent.RegisteredExtensions()...)
 	return client
 }
 
@@ -185,14 +187,24 @@ func (c *Client) Debug() *Client {
 	}
 	cfg := c.config
 	cfg.driver = dialect.Debug(c.driver, c.log)
+	if c.readDriver != nil {
+		cfg.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
 	client := &Client{config: cfg}
 	client.init()
 	return client
 }
 
 // Close closes the database connection and prevents new queries from starting.
+// The read driver of the client is closed as well, if it was configured.
 func (c *Client) Close() error {
-	return c.driver.Close()
+	err := c.driver.Close()
+	if _, ok := c.driver.(*txDriver); !ok && c.readDriver != nil {
+		if rerr := c.readDriver.Close(); err == nil {
+			err = rerr
+		}
+	}
+	return err
 }
 
 // Use adds the mutation hooks to all the entity clients.
@@ -213,6 +225,111 @@ func (c *Client) Use(hooks ...Hook) {
 	c.UserTweet.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Friendship.Intercept(interceptors...)
+	c.Group.Intercept(interceptors...)
+	c.Relationship.Intercept(interceptors...)
+	c.RelationshipInfo.Intercept(interceptors...)
+	c.Role.Intercept(interceptors...)
+	c.RoleUser.Intercept(interceptors...)
+	c.Tag.Intercept(interceptors...)
+	c.Tweet.Intercept(interceptors...)
+	c.TweetLike.Intercept(interceptors...)
+	c.TweetTag.Intercept(interceptors...)
+	c.User.Intercept(interceptors...)
+	c.UserGroup.Intercept(interceptors...)
+	c.UserTweet.Intercept(interceptors...)
+}
+
+// AddExtension adds the given extensions to the client. Their hooks and interceptors are added to all the
+// entity clients, and their drivers wrap the drivers of the client. Extensions that decorate the driver
+// should be added before the client is used, as entity clients that were obtained before the call (e.g.
+// `users := client.User`) keep using the previous driver. For example:
+//
+//	client.AddExtension(cache.NewExtension(cache.WithTTL(time.Minute)))
+//
+func (c *Client) AddExtension(exts ...ent.Extension) {
+	if len(exts) == 0 {
+		return
+	}
+	for _, ext := range exts {
+		if drv := ext.Driver(c.driver); drv != nil {
+			c.driver = drv
+		}
+		if c.readDriver != nil {
+			if drv := ext.Driver(c.readDriver); drv != nil {
+				c.readDriver = drv
+			}
+		}
+	}
+	c.extensions = append(c.extensions[:len(c.extensions):len(c.extensions)], exts...)
+	c.init()
+	for _, ext := range exts {
+		c.Use(ext.Hooks()...)
+		c.Intercept(ext.Interceptors()...)
+	}
+}
+
+// HealthCheck runs the health checks of the extensions that were added to the client,
+// and returns the first error that was reported.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	for _, ext := range c.extensions {
+		if err := ext.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("ent: extension %q: %w", ext.Name(), err)
+		}
+	}
+	return nil
+}
+
+// WithOptions returns a new client that is derived from c and configured with the given options.
+// Hooks that are registered on the new client using Use are not added to c (and vice versa). For
+// example, creating a client for trusted background jobs:
+//
+//	client.WithOptions(ent.ReplaceHooks(AuditHook()), ent.SkipPrivacy()).
+//		Friendship.
+//		Delete().
+//		Exec(ctx)
+//
+func (c *Client) WithOptions(opts ...Option) *Client {
+	cfg := c.config
+	cfg.hooks = &hooks{
+		Friendship:       c.hooks.Friendship[:len(c.hooks.Friendship):len(c.hooks.Friendship)],
+		Group:            c.hooks.Group[:len(c.hooks.Group):len(c.hooks.Group)],
+		Relationship:     c.hooks.Relationship[:len(c.hooks.Relationship):len(c.hooks.Relationship)],
+		RelationshipInfo: c.hooks.RelationshipInfo[:len(c.hooks.RelationshipInfo):len(c.hooks.RelationshipInfo)],
+		Role:             c.hooks.Role[:len(c.hooks.Role):len(c.hooks.Role)],
+		RoleUser:         c.hooks.RoleUser[:len(c.hooks.RoleUser):len(c.hooks.RoleUser)],
+		Tag:              c.hooks.Tag[:len(c.hooks.Tag):len(c.hooks.Tag)],
+		Tweet:            c.hooks.Tweet[:len(c.hooks.Tweet):len(c.hooks.Tweet)],
+		TweetLike:        c.hooks.TweetLike[:len(c.hooks.TweetLike):len(c.hooks.TweetLike)],
+		TweetTag:         c.hooks.TweetTag[:len(c.hooks.TweetTag):len(c.hooks.TweetTag)],
+		User:             c.hooks.User[:len(c.hooks.User):len(c.hooks.User)],
+		UserGroup:        c.hooks.UserGroup[:len(c.hooks.UserGroup):len(c.hooks.UserGroup)],
+		UserTweet:        c.hooks.UserTweet[:len(c.hooks.UserTweet):len(c.hooks.UserTweet)],
+	}
+	cfg.inters = &inters{
+		Friendship:       c.inters.Friendship[:len(c.inters.Friendship):len(c.inters.Friendship)],
+		Group:            c.inters.Group[:len(c.inters.Group):len(c.inters.Group)],
+		Relationship:     c.inters.Relationship[:len(c.inters.Relationship):len(c.inters.Relationship)],
+		RelationshipInfo: c.inters.RelationshipInfo[:len(c.inters.RelationshipInfo):len(c.inters.RelationshipInfo)],
+		Role:             c.inters.Role[:len(c.inters.Role):len(c.inters.Role)],
+		RoleUser:         c.inters.RoleUser[:len(c.inters.RoleUser):len(c.inters.RoleUser)],
+		Tag:              c.inters.Tag[:len(c.inters.Tag):len(c.inters.Tag)],
+		Tweet:            c.inters.Tweet[:len(c.inters.Tweet):len(c.inters.Tweet)],
+		TweetLike:        c.inters.TweetLike[:len(c.inters.TweetLike):len(c.inters.TweetLike)],
+		TweetTag:         c.inters.TweetTag[:len(c.inters.TweetTag):len(c.inters.TweetTag)],
+		User:             c.inters.User[:len(c.inters.User):len(c.inters.User)],
+		UserGroup:        c.inters.UserGroup[:len(c.inters.UserGroup):len(c.inters.UserGroup)],
+		UserTweet:        c.inters.UserTweet[:len(c.inters.UserTweet):len(c.inters.UserTweet)],
+	}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
 // FriendshipClient is a client for the Friendship schema.
 type FriendshipClient struct {
 	config
@@ -229,6 +346,12 @@ func (c *FriendshipClient) Use(hooks ...Hook) {
 	c.hooks.Friendship = append(c.hooks.Friendship, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `friendship.Intercept(f(g(h())))`.
+func (c *FriendshipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Friendship = append(c.inters.Friendship, interceptors...)
+}
+
 // Create returns a builder for creating a Friendship entity.
 func (c *FriendshipClient) Create() *FriendshipCreate {
 	mutation := newFriendshipMutation(c.config, OpCreate)
@@ -281,12 +404,17 @@ func (c *FriendshipClient) DeleteOneID(id int) *FriendshipDeleteOne {
 func (c *FriendshipClient) Query() *FriendshipQuery {
 	return &FriendshipQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Friendship entity by its id.
 func (c *FriendshipClient) Get(ctx context.Context, id int) (*Friendship, error) {
-	return c.Query().Where(friendship.ID(id)).Only(ctx)
+	node, err := c.Query().Where(friendship.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -300,7 +428,7 @@ func (c *FriendshipClient) GetX(ctx context.Context, id int) *Friendship {
 
 // QueryUser queries the user edge of a Friendship.
 func (c *FriendshipClient) QueryUser(f *Friendship) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := f.ID
 		step := sqlgraph.NewStep(
@@ -316,7 +444,7 @@ func (c *FriendshipClient) QueryUser(f *Friendship) *UserQuery {
 
 // QueryFriend queries the friend edge of a Friendship.
 func (c *FriendshipClient) QueryFriend(f *Friendship) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := f.ID
 		step := sqlgraph.NewStep(
@@ -330,6 +458,11 @@ func (c *FriendshipClient) QueryFriend(f *Friendship) *UserQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *FriendshipClient) Interceptors() []Interceptor {
+	return c.inters.Friendship
+}
+
 // Hooks returns the client hooks.
 func (c *FriendshipClient) Hooks() []Hook {
 	return c.hooks.Friendship
@@ -351,6 +484,12 @@ func (c *GroupClient) Use(hooks ...Hook) {
 	c.hooks.Group = append(c.hooks.Group, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
 // Create returns a builder for creating a Group entity.
 func (c *GroupClient) Create() *GroupCreate {
 	mutation := newGroupMutation(c.config, OpCreate)
@@ -403,12 +542,17 @@ func (c *GroupClient) DeleteOneID(id int) *GroupDeleteOne {
 func (c *GroupClient) Query() *GroupQuery {
 	return &GroupQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Group entity by its id.
 func (c *GroupClient) Get(ctx context.Context, id int) (*Group, error) {
-	return c.Query().Where(group.ID(id)).Only(ctx)
+	node, err := c.Query().Where(group.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -422,7 +566,7 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 
 // QueryUsers queries the users edge of a Group.
 func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -438,7 +582,7 @@ func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
 
 // QueryJoinedUsers queries the joined_users edge of a Group.
 func (c *GroupClient) QueryJoinedUsers(gr *Group) *UserGroupQuery {
-	query := &UserGroupQuery{config: c.config}
+	query := (&UserGroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -452,6 +596,11 @@ func (c *GroupClient) QueryJoinedUsers(gr *Group) *UserGroupQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
 // Hooks returns the client hooks.
 func (c *GroupClient) Hooks() []Hook {
 	return c.hooks.Group
@@ -473,6 +622,12 @@ func (c *RelationshipClient) Use(hooks ...Hook) {
 	c.hooks.Relationship = append(c.hooks.Relationship, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `relationship.Intercept(f(g(h())))`.
+func (c *RelationshipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Relationship = append(c.inters.Relationship, interceptors...)
+}
+
 // Create returns a builder for creating a Relationship entity.
 func (c *RelationshipClient) Create() *RelationshipCreate {
 	mutation := newRelationshipMutation(c.config, OpCreate)
@@ -498,19 +653,54 @@ func (c *RelationshipClient) UpdateOne(r *Relationship) *RelationshipUpdateOne {
 	return &RelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateOneID returns an update builder for the given composite identifier.
+func (c *RelationshipClient) UpdateOneID(user int, relative int) *RelationshipUpdateOne {
+	mutation := newRelationshipMutation(c.config, OpUpdateOne)
+	mutation.user = &user
+	mutation.relative = &relative
+	return &RelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
 // Delete returns a delete builder for Relationship.
 func (c *RelationshipClient) Delete() *RelationshipDelete {
 	mutation := newRelationshipMutation(c.config, OpDelete)
 	return &RelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RelationshipClient) DeleteOne(r *Relationship) *RelationshipDeleteOne {
+	return c.DeleteOneID(r.UserID, r.RelativeID)
+}
+
+// DeleteOneID returns a builder for deleting the entity by its composite identifier.
+func (c *RelationshipClient) DeleteOneID(user int, relative int) *RelationshipDeleteOne {
+	builder := c.Delete().Where(relationship.UserID(user), relationship.RelativeID(relative))
+	builder.mutation.op = OpDeleteOne
+	return &RelationshipDeleteOne{builder}
+}
+
 // Query returns a query builder for Relationship.
 func (c *RelationshipClient) Query() *RelationshipQuery {
 	return &RelationshipQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
+// Get returns a Relationship entity by its composite identifier.
+func (c *RelationshipClient) Get(ctx context.Context, user int, relative int) (*Relationship, error) {
+	return c.Query().Where(relationship.UserID(user), relationship.RelativeID(relative)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RelationshipClient) GetX(ctx context.Context, user int, relative int) *Relationship {
+	obj, err := c.Get(ctx, user, relative)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
 // QueryUser queries the user edge of a Relationship.
 func (c *RelationshipClient) QueryUser(r *Relationship) *UserQuery {
 	return c.Query().
@@ -532,6 +722,11 @@ func (c *RelationshipClient) QueryInfo(r *Relationship) *RelationshipInfoQuery {
 		QueryInfo()
 }
 
+// Interceptors returns the client interceptors.
+func (c *RelationshipClient) Interceptors() []Interceptor {
+	return c.inters.Relationship
+}
+
 // Hooks returns the client hooks.
 func (c *RelationshipClient) Hooks() []Hook {
 	return c.hooks.Relationship
@@ -553,6 +748,12 @@ func (c *RelationshipInfoClient) Use(hooks ...Hook) {
 	c.hooks.RelationshipInfo = append(c.hooks.RelationshipInfo, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `relationshipinfo.Intercept(f(g(h())))`.
+func (c *RelationshipInfoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RelationshipInfo = append(c.inters.RelationshipInfo, interceptors...)
+}
+
 // Create returns a builder for creating a RelationshipInfo entity.
 func (c *RelationshipInfoClient) Create() *RelationshipInfoCreate {
 	mutation := newRelationshipInfoMutation(c.config, OpCreate)
@@ -605,12 +806,17 @@ func (c *RelationshipInfoClient) DeleteOneID(id int) *RelationshipInfoDeleteOne
 func (c *RelationshipInfoClient) Query() *RelationshipInfoQuery {
 	return &RelationshipInfoQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a RelationshipInfo entity by its id.
 func (c *RelationshipInfoClient) Get(ctx context.Context, id int) (*RelationshipInfo, error) {
-	return c.Query().Where(relationshipinfo.ID(id)).Only(ctx)
+	node, err := c.Query().Where(relationshipinfo.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -622,6 +828,11 @@ func (c *RelationshipInfoClient) GetX(ctx context.Context, id int) *Relationship
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *RelationshipInfoClient) Interceptors() []Interceptor {
+	return c.inters.RelationshipInfo
+}
+
 // Hooks returns the client hooks.
 func (c *RelationshipInfoClient) Hooks() []Hook {
 	return c.hooks.RelationshipInfo
@@ -643,6 +854,12 @@ func (c *RoleClient) Use(hooks ...Hook) {
 	c.hooks.Role = append(c.hooks.Role, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
 // Create returns a builder for creating a Role entity.
 func (c *RoleClient) Create() *RoleCreate {
 	mutation := newRoleMutation(c.config, OpCreate)
@@ -695,12 +912,17 @@ func (c *RoleClient) DeleteOneID(id int) *RoleDeleteOne {
 func (c *RoleClient) Query() *RoleQuery {
 	return &RoleQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Role entity by its id.
 func (c *RoleClient) Get(ctx context.Context, id int) (*Role, error) {
-	return c.Query().Where(role.ID(id)).Only(ctx)
+	node, err := c.Query().Where(role.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -714,7 +936,7 @@ func (c *RoleClient) GetX(ctx context.Context, id int) *Role {
 
 // QueryUser queries the user edge of a Role.
 func (c *RoleClient) QueryUser(r *Role) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := r.ID
 		step := sqlgraph.NewStep(
@@ -730,7 +952,7 @@ func (c *RoleClient) QueryUser(r *Role) *UserQuery {
 
 // QueryRolesUsers queries the roles_users edge of a Role.
 func (c *RoleClient) QueryRolesUsers(r *Role) *RoleUserQuery {
-	query := &RoleUserQuery{config: c.config}
+	query := (&RoleUserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := r.ID
 		step := sqlgraph.NewStep(
@@ -744,6 +966,11 @@ func (c *RoleClient) QueryRolesUsers(r *Role) *RoleUserQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
+}
+
 // Hooks returns the client hooks.
 func (c *RoleClient) Hooks() []Hook {
 	return c.hooks.Role
@@ -765,6 +992,12 @@ func (c *RoleUserClient) Use(hooks ...Hook) {
 	c.hooks.RoleUser = append(c.hooks.RoleUser, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roleuser.Intercept(f(g(h())))`.
+func (c *RoleUserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleUser = append(c.inters.RoleUser, interceptors...)
+}
+
 // Create returns a builder for creating a RoleUser entity.
 func (c *RoleUserClient) Create() *RoleUserCreate {
 	mutation := newRoleUserMutation(c.config, OpCreate)
@@ -790,19 +1023,54 @@ func (c *RoleUserClient) UpdateOne(ru *RoleUser) *RoleUserUpdateOne {
 	return &RoleUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateOneID returns an update builder for the given composite identifier.
+func (c *RoleUserClient) UpdateOneID(user int, role int) *RoleUserUpdateOne {
+	mutation := newRoleUserMutation(c.config, OpUpdateOne)
+	mutation.user = &user
+	mutation.role = &role
+	return &RoleUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
 // Delete returns a delete builder for RoleUser.
 func (c *RoleUserClient) Delete() *RoleUserDelete {
 	mutation := newRoleUserMutation(c.config, OpDelete)
 	return &RoleUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleUserClient) DeleteOne(ru *RoleUser) *RoleUserDeleteOne {
+	return c.DeleteOneID(ru.UserID, ru.RoleID)
+}
+
+// DeleteOneID returns a builder for deleting the entity by its composite identifier.
+func (c *RoleUserClient) DeleteOneID(user int, role int) *RoleUserDeleteOne {
+	builder := c.Delete().Where(roleuser.UserID(user), roleuser.RoleID(role))
+	builder.mutation.op = OpDeleteOne
+	return &RoleUserDeleteOne{builder}
+}
+
 // Query returns a query builder for RoleUser.
 func (c *RoleUserClient) Query() *RoleUserQuery {
 	return &RoleUserQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
+// Get returns a RoleUser entity by its composite identifier.
+func (c *RoleUserClient) Get(ctx context.Context, user int, role int) (*RoleUser, error) {
+	return c.Query().Where(roleuser.UserID(user), roleuser.RoleID(role)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleUserClient) GetX(ctx context.Context, user int, role int) *RoleUser {
+	obj, err := c.Get(ctx, user, role)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
 // QueryRole queries the role edge of a RoleUser.
 func (c *RoleUserClient) QueryRole(ru *RoleUser) *RoleQuery {
 	return c.Query().
@@ -817,6 +1085,11 @@ func (c *RoleUserClient) QueryUser(ru *RoleUser) *UserQuery {
 		QueryUser()
 }
 
+// Interceptors returns the client interceptors.
+func (c *RoleUserClient) Interceptors() []Interceptor {
+	return c.inters.RoleUser
+}
+
 // Hooks returns the client hooks.
 func (c *RoleUserClient) Hooks() []Hook {
 	return c.hooks.RoleUser
@@ -838,6 +1111,12 @@ func (c *TagClient) Use(hooks ...Hook) {
 	c.hooks.Tag = append(c.hooks.Tag, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
+}
+
 // Create returns a builder for creating a Tag entity.
 func (c *TagClient) Create() *TagCreate {
 	mutation := newTagMutation(c.config, OpCreate)
@@ -890,12 +1169,17 @@ func (c *TagClient) DeleteOneID(id int) *TagDeleteOne {
 func (c *TagClient) Query() *TagQuery {
 	return &TagQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Tag entity by its id.
 func (c *TagClient) Get(ctx context.Context, id int) (*Tag, error) {
-	return c.Query().Where(tag.ID(id)).Only(ctx)
+	node, err := c.Query().Where(tag.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -909,7 +1193,7 @@ func (c *TagClient) GetX(ctx context.Context, id int) *Tag {
 
 // QueryTweets queries the tweets edge of a Tag.
 func (c *TagClient) QueryTweets(t *Tag) *TweetQuery {
-	query := &TweetQuery{config: c.config}
+	query := (&TweetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := t.ID
 		step := sqlgraph.NewStep(
@@ -925,7 +1209,7 @@ func (c *TagClient) QueryTweets(t *Tag) *TweetQuery {
 
 // QueryTweetTags queries the tweet_tags edge of a Tag.
 func (c *TagClient) QueryTweetTags(t *Tag) *TweetTagQuery {
-	query := &TweetTagQuery{config: c.config}
+	query := (&TweetTagClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := t.ID
 		step := sqlgraph.NewStep(
@@ -939,6 +1223,11 @@ func (c *TagClient) QueryTweetTags(t *Tag) *TweetTagQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
+}
+
 // Hooks returns the client hooks.
 func (c *TagClient) Hooks() []Hook {
 	return c.hooks.Tag
@@ -960,6 +1249,12 @@ func (c *TweetClient) Use(hooks ...Hook) {
 	c.hooks.Tweet = append(c.hooks.Tweet, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tweet.Intercept(f(g(h())))`.
+func (c *TweetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tweet = append(c.inters.Tweet, interceptors...)
+}
+
 // Create returns a builder for creating a Tweet entity.
 func (c *TweetClient) Create() *TweetCreate {
 	mutation := newTweetMutation(c.config, OpCreate)
@@ -1012,12 +1307,17 @@ func (c *TweetClient) DeleteOneID(id int) *TweetDeleteOne {
 func (c *TweetClient) Query() *TweetQuery {
 	return &TweetQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Tweet entity by its id.
 func (c *TweetClient) Get(ctx context.Context, id int) (*Tweet, error) {
-	return c.Query().Where(tweet.ID(id)).Only(ctx)
+	node, err := c.Query().Where(tweet.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1031,7 +1331,7 @@ func (c *TweetClient) GetX(ctx context.Context, id int) *Tweet {
 
 // QueryLikedUsers queries the liked_users edge of a Tweet.
 func (c *TweetClient) QueryLikedUsers(t *Tweet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := t.ID
 		step := sqlgraph.NewStep(
@@ -1047,7 +1347,7 @@ func (c *TweetClient) QueryLikedUsers(t *Tweet) *UserQuery {
 
 // QueryUser queries the user edge of a Tweet.
 func (c *TweetClient) QueryUser(t *Tweet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := t.ID
 		step := sqlgraph.NewStep(
@@ -1063,7 +1363,7 @@ func (c *TweetClient) QueryUser(t *Tweet) *UserQuery {
 
 // QueryTags queries the tags edge of a Tweet.
 func (c *TweetClient) QueryTags(t *Tweet) *TagQuery {
-	query := &TagQuery{config: c.config}
+	query := (&TagClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := t.ID
 		step := sqlgraph.NewStep(
@@ -1079,7 +1379,7 @@ func (c *TweetClient) QueryTags(t *Tweet) *TagQuery {
 
 // QueryLikes queries the likes edge of a Tweet.
 func (c *TweetClient) QueryLikes(t *Tweet) *TweetLikeQuery {
-	query := &TweetLikeQuery{config: c.config}
+	query := (&TweetLikeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := t.ID
 		step := sqlgraph.NewStep(
@@ -1095,7 +1395,7 @@ func (c *TweetClient) QueryLikes(t *Tweet) *TweetLikeQuery {
 
 // QueryTweetUser queries the tweet_user edge of a Tweet.
 func (c *TweetClient) QueryTweetUser(t *Tweet) *UserTweetQuery {
-	query := &UserTweetQuery{config: c.config}
+	query := (&UserTweetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := t.ID
 		step := sqlgraph.NewStep(
@@ -1111,7 +1411,7 @@ func (c *TweetClient) QueryTweetUser(t *Tweet) *UserTweetQuery {
 
 // QueryTweetTags queries the tweet_tags edge of a Tweet.
 func (c *TweetClient) QueryTweetTags(t *Tweet) *TweetTagQuery {
-	query := &TweetTagQuery{config: c.config}
+	query := (&TweetTagClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := t.ID
 		step := sqlgraph.NewStep(
@@ -1125,6 +1425,11 @@ func (c *TweetClient) QueryTweetTags(t *Tweet) *TweetTagQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *TweetClient) Interceptors() []Interceptor {
+	return c.inters.Tweet
+}
+
 // Hooks returns the client hooks.
 func (c *TweetClient) Hooks() []Hook {
 	return c.hooks.Tweet
@@ -1146,6 +1451,12 @@ func (c *TweetLikeClient) Use(hooks ...Hook) {
 	c.hooks.TweetLike = append(c.hooks.TweetLike, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tweetlike.Intercept(f(g(h())))`.
+func (c *TweetLikeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TweetLike = append(c.inters.TweetLike, interceptors...)
+}
+
 // Create returns a builder for creating a TweetLike entity.
 func (c *TweetLikeClient) Create() *TweetLikeCreate {
 	mutation := newTweetLikeMutation(c.config, OpCreate)
@@ -1171,19 +1482,54 @@ func (c *TweetLikeClient) UpdateOne(tl *TweetLike) *TweetLikeUpdateOne {
 	return &TweetLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateOneID returns an update builder for the given composite identifier.
+func (c *TweetLikeClient) UpdateOneID(user int, tweet int) *TweetLikeUpdateOne {
+	mutation := newTweetLikeMutation(c.config, OpUpdateOne)
+	mutation.user = &user
+	mutation.tweet = &tweet
+	return &TweetLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
 // Delete returns a delete builder for TweetLike.
 func (c *TweetLikeClient) Delete() *TweetLikeDelete {
 	mutation := newTweetLikeMutation(c.config, OpDelete)
 	return &TweetLikeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TweetLikeClient) DeleteOne(tl *TweetLike) *TweetLikeDeleteOne {
+	return c.DeleteOneID(tl.UserID, tl.TweetID)
+}
+
+// DeleteOneID returns a builder for deleting the entity by its composite identifier.
+func (c *TweetLikeClient) DeleteOneID(user int, tweet int) *TweetLikeDeleteOne {
+	builder := c.Delete().Where(tweetlike.UserID(user), tweetlike.TweetID(tweet))
+	builder.mutation.op = OpDeleteOne
+	return &TweetLikeDeleteOne{builder}
+}
+
 // Query returns a query builder for TweetLike.
 func (c *TweetLikeClient) Query() *TweetLikeQuery {
 	return &TweetLikeQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
+// Get returns a TweetLike entity by its composite identifier.
+func (c *TweetLikeClient) Get(ctx context.Context, user int, tweet int) (*TweetLike, error) {
+	return c.Query().Where(tweetlike.UserID(user), tweetlike.TweetID(tweet)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TweetLikeClient) GetX(ctx context.Context, user int, tweet int) *TweetLike {
+	obj, err := c.Get(ctx, user, tweet)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
 // QueryTweet queries the tweet edge of a TweetLike.
 func (c *TweetLikeClient) QueryTweet(tl *TweetLike) *TweetQuery {
 	return c.Query().
@@ -1198,9 +1544,17 @@ func (c *TweetLikeClient) QueryUser(tl *TweetLike) *UserQuery {
 		QueryUser()
 }
 
+// Interceptors returns the client interceptors.
+func (c *TweetLikeClient) Interceptors() []Interceptor {
+	return c.inters.TweetLike
+}
+
 // Hooks returns the client hooks.
 func (c *TweetLikeClient) Hooks() []Hook {
 	hooks := c.hooks.TweetLike
+	if c.skipPrivacy {
+		hooks = append([]Hook{allowPrivacy}, hooks...)
+	}
 	return append(hooks[:len(hooks):len(hooks)], tweetlike.Hooks[:]...)
 }
 
@@ -1220,6 +1574,12 @@ func (c *TweetTagClient) Use(hooks ...Hook) {
 	c.hooks.TweetTag = append(c.hooks.TweetTag, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tweettag.Intercept(f(g(h())))`.
+func (c *TweetTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TweetTag = append(c.inters.TweetTag, interceptors...)
+}
+
 // Create returns a builder for creating a TweetTag entity.
 func (c *TweetTagClient) Create() *TweetTagCreate {
 	mutation := newTweetTagMutation(c.config, OpCreate)
@@ -1272,12 +1632,17 @@ func (c *TweetTagClient) DeleteOneID(id uuid.UUID) *TweetTagDeleteOne {
 func (c *TweetTagClient) Query() *TweetTagQuery {
 	return &TweetTagQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a TweetTag entity by its id.
 func (c *TweetTagClient) Get(ctx context.Context, id uuid.UUID) (*TweetTag, error) {
-	return c.Query().Where(tweettag.ID(id)).Only(ctx)
+	node, err := c.Query().Where(tweettag.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1291,7 +1656,7 @@ func (c *TweetTagClient) GetX(ctx context.Context, id uuid.UUID) *TweetTag {
 
 // QueryTag queries the tag edge of a TweetTag.
 func (c *TweetTagClient) QueryTag(tt *TweetTag) *TagQuery {
-	query := &TagQuery{config: c.config}
+	query := (&TagClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := tt.ID
 		step := sqlgraph.NewStep(
@@ -1307,7 +1672,7 @@ func (c *TweetTagClient) QueryTag(tt *TweetTag) *TagQuery {
 
 // QueryTweet queries the tweet edge of a TweetTag.
 func (c *TweetTagClient) QueryTweet(tt *TweetTag) *TweetQuery {
-	query := &TweetQuery{config: c.config}
+	query := (&TweetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := tt.ID
 		step := sqlgraph.NewStep(
@@ -1321,6 +1686,11 @@ func (c *TweetTagClient) QueryTweet(tt *TweetTag) *TweetQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *TweetTagClient) Interceptors() []Interceptor {
+	return c.inters.TweetTag
+}
+
 // Hooks returns the client hooks.
 func (c *TweetTagClient) Hooks() []Hook {
 	return c.hooks.TweetTag
@@ -1342,6 +1712,12 @@ func (c *UserClient) Use(hooks ...Hook) {
 	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a builder for creating a User entity.
 func (c *UserClient) Create() *UserCreate {
 	mutation := newUserMutation(c.config, OpCreate)
@@ -1394,12 +1770,17 @@ func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
 func (c *UserClient) Query() *UserQuery {
 	return &UserQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a User entity by its id.
 func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+	node, err := c.Query().Where(user.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1413,7 +1794,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 
 // QueryGroups queries the groups edge of a User.
 func (c *UserClient) QueryGroups(u *User) *GroupQuery {
-	query := &GroupQuery{config: c.config}
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1429,7 +1810,7 @@ func (c *UserClient) QueryGroups(u *User) *GroupQuery {
 
 // QueryFriends queries the friends edge of a User.
 func (c *UserClient) QueryFriends(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1445,7 +1826,7 @@ func (c *UserClient) QueryFriends(u *User) *UserQuery {
 
 // QueryRelatives queries the relatives edge of a User.
 func (c *UserClient) QueryRelatives(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1461,7 +1842,7 @@ func (c *UserClient) QueryRelatives(u *User) *UserQuery {
 
 // QueryLikedTweets queries the liked_tweets edge of a User.
 func (c *UserClient) QueryLikedTweets(u *User) *TweetQuery {
-	query := &TweetQuery{config: c.config}
+	query := (&TweetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1477,7 +1858,7 @@ func (c *UserClient) QueryLikedTweets(u *User) *TweetQuery {
 
 // QueryTweets queries the tweets edge of a User.
 func (c *UserClient) QueryTweets(u *User) *TweetQuery {
-	query := &TweetQuery{config: c.config}
+	query := (&TweetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1493,7 +1874,7 @@ func (c *UserClient) QueryTweets(u *User) *TweetQuery {
 
 // QueryRoles queries the roles edge of a User.
 func (c *UserClient) QueryRoles(u *User) *RoleQuery {
-	query := &RoleQuery{config: c.config}
+	query := (&RoleClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1509,7 +1890,7 @@ func (c *UserClient) QueryRoles(u *User) *RoleQuery {
 
 // QueryJoinedGroups queries the joined_groups edge of a User.
 func (c *UserClient) QueryJoinedGroups(u *User) *UserGroupQuery {
-	query := &UserGroupQuery{config: c.config}
+	query := (&UserGroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1525,7 +1906,7 @@ func (c *UserClient) QueryJoinedGroups(u *User) *UserGroupQuery {
 
 // QueryFriendships queries the friendships edge of a User.
 func (c *UserClient) QueryFriendships(u *User) *FriendshipQuery {
-	query := &FriendshipQuery{config: c.config}
+	query := (&FriendshipClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1541,7 +1922,7 @@ func (c *UserClient) QueryFriendships(u *User) *FriendshipQuery {
 
 // QueryRelationship queries the relationship edge of a User.
 func (c *UserClient) QueryRelationship(u *User) *RelationshipQuery {
-	query := &RelationshipQuery{config: c.config}
+	query := (&RelationshipClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1557,7 +1938,7 @@ func (c *UserClient) QueryRelationship(u *User) *RelationshipQuery {
 
 // QueryLikes queries the likes edge of a User.
 func (c *UserClient) QueryLikes(u *User) *TweetLikeQuery {
-	query := &TweetLikeQuery{config: c.config}
+	query := (&TweetLikeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1573,7 +1954,7 @@ func (c *UserClient) QueryLikes(u *User) *TweetLikeQuery {
 
 // QueryUserTweets queries the user_tweets edge of a User.
 func (c *UserClient) QueryUserTweets(u *User) *UserTweetQuery {
-	query := &UserTweetQuery{config: c.config}
+	query := (&UserTweetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1589,7 +1970,7 @@ func (c *UserClient) QueryUserTweets(u *User) *UserTweetQuery {
 
 // QueryRolesUsers queries the roles_users edge of a User.
 func (c *UserClient) QueryRolesUsers(u *User) *RoleUserQuery {
-	query := &RoleUserQuery{config: c.config}
+	query := (&RoleUserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1603,9 +1984,17 @@ func (c *UserClient) QueryRolesUsers(u *User) *RoleUserQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	hooks := c.hooks.User
+	if c.skipPrivacy {
+		hooks = append([]Hook{allowPrivacy}, hooks...)
+	}
 	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
 }
 
@@ -1625,6 +2014,12 @@ func (c *UserGroupClient) Use(hooks ...Hook) {
 	c.hooks.UserGroup = append(c.hooks.UserGroup, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usergroup.Intercept(f(g(h())))`.
+func (c *UserGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserGroup = append(c.inters.UserGroup, interceptors...)
+}
+
 // Create returns a builder for creating a UserGroup entity.
 func (c *UserGroupClient) Create() *UserGroupCreate {
 	mutation := newUserGroupMutation(c.config, OpCreate)
@@ -1677,12 +2072,17 @@ func (c *UserGroupClient) DeleteOneID(id int) *UserGroupDeleteOne {
 func (c *UserGroupClient) Query() *UserGroupQuery {
 	return &UserGroupQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a UserGroup entity by its id.
 func (c *UserGroupClient) Get(ctx context.Context, id int) (*UserGroup, error) {
-	return c.Query().Where(usergroup.ID(id)).Only(ctx)
+	node, err := c.Query().Where(usergroup.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1696,7 +2096,7 @@ func (c *UserGroupClient) GetX(ctx context.Context, id int) *UserGroup {
 
 // QueryUser queries the user edge of a UserGroup.
 func (c *UserGroupClient) QueryUser(ug *UserGroup) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ug.ID
 		step := sqlgraph.NewStep(
@@ -1712,7 +2112,7 @@ func (c *UserGroupClient) QueryUser(ug *UserGroup) *UserQuery {
 
 // QueryGroup queries the group edge of a UserGroup.
 func (c *UserGroupClient) QueryGroup(ug *UserGroup) *GroupQuery {
-	query := &GroupQuery{config: c.config}
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ug.ID
 		step := sqlgraph.NewStep(
@@ -1726,6 +2126,11 @@ func (c *UserGroupClient) QueryGroup(ug *UserGroup) *GroupQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *UserGroupClient) Interceptors() []Interceptor {
+	return c.inters.UserGroup
+}
+
 // Hooks returns the client hooks.
 func (c *UserGroupClient) Hooks() []Hook {
 	return c.hooks.UserGroup
@@ -1747,6 +2152,12 @@ func (c *UserTweetClient) Use(hooks ...Hook) {
 	c.hooks.UserTweet = append(c.hooks.UserTweet, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usertweet.Intercept(f(g(h())))`.
+func (c *UserTweetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserTweet = append(c.inters.UserTweet, interceptors...)
+}
+
 // Create returns a builder for creating a UserTweet entity.
 func (c *UserTweetClient) Create() *UserTweetCreate {
 	mutation := newUserTweetMutation(c.config, OpCreate)
@@ -1799,12 +2210,17 @@ func (c *UserTweetClient) DeleteOneID(id int) *UserTweetDeleteOne {
 func (c *UserTweetClient) Query() *UserTweetQuery {
 	return &UserTweetQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a UserTweet entity by its id.
 func (c *UserTweetClient) Get(ctx context.Context, id int) (*UserTweet, error) {
-	return c.Query().Where(usertweet.ID(id)).Only(ctx)
+	node, err := c.Query().Where(usertweet.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1818,7 +2234,7 @@ func (c *UserTweetClient) GetX(ctx context.Context, id int) *UserTweet {
 
 // QueryUser queries the user edge of a UserTweet.
 func (c *UserTweetClient) QueryUser(ut *UserTweet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ut.ID
 		step := sqlgraph.NewStep(
@@ -1834,7 +2250,7 @@ func (c *UserTweetClient) QueryUser(ut *UserTweet) *UserQuery {
 
 // QueryTweet queries the tweet edge of a UserTweet.
 func (c *UserTweetClient) QueryTweet(ut *UserTweet) *TweetQuery {
-	query := &TweetQuery{config: c.config}
+	query := (&TweetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ut.ID
 		step := sqlgraph.NewStep(
@@ -1848,6 +2264,11 @@ func (c *UserTweetClient) QueryTweet(ut *UserTweet) *TweetQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *UserTweetClient) Interceptors() []Interceptor {
+	return c.inters.UserTweet
+}
+
 // Hooks returns the client hooks.
 func (c *UserTweetClient) Hooks() []Hook {
 	return c.hooks.UserTweet
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -31,14 +32,20 @@ import (
 
 // ent aliases to avoid import conflicts in user's code.
 type (
-	Op         = ent.Op
-	Hook       = ent.Hook
-	Value      = ent.Value
-	Query      = ent.Query
-	Policy     = ent.Policy
-	Mutator    = ent.Mutator
-	Mutation   = ent.Mutation
-	MutateFunc = ent.MutateFunc
+	Op            = ent.Op
+	Hook          = ent.Hook
+	Value         = ent.Value
+	Query         = ent.Query
+	Policy        = ent.Policy
+	Querier       = ent.Querier
+	QuerierFunc   = ent.QuerierFunc
+	Interceptor   = ent.Interceptor
+	InterceptFunc = ent.InterceptFunc
+	Traverser     = ent.Traverser
+	TraverseFunc  = ent.TraverseFunc
+	Mutator       = ent.Mutator
+	Mutation      = ent.Mutation
+	MutateFunc    = ent.MutateFunc
 )
 
 // OrderFunc applies an ordering on the sql selector.
@@ -171,6 +178,292 @@ func Sum(field string) AggregateFunc {
 	}
 }
 
+// CountEdges counts the neighbors of each entity in the given edge and sums them up for each
+// group, using a correlated subquery. For example, counting the pets of the users of each group:
+//
+//	GroupBy(field1).
+//	Aggregate(ent.CountEdges(edge1)).
+//	Scan(ctx, &v)
+//
+func CountEdges(edge string) AggregateFunc {
+	return func(s *sql.Selector) string {
+		step, err := neighborsStep(s.TableName(), edge)
+		if err != nil {
+			s.AddError(&ValidationError{Name: edge, err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		return sql.Sum(sqlgraph.CountNeighbors(s, step))
+	}
+}
+
+// neighborsStep returns the path-step of the given edge of the given table.
+func neighborsStep(table, edge string) (*sqlgraph.Step, error) {
+	switch table {
+	case friendship.Table:
+		switch edge {
+		case friendship.EdgeUser:
+			return sqlgraph.NewStep(
+				sqlgraph.From(friendship.Table, friendship.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, friendship.UserTable, friendship.UserColumn),
+			), nil
+		case friendship.EdgeFriend:
+			return sqlgraph.NewStep(
+				sqlgraph.From(friendship.Table, friendship.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, friendship.FriendTable, friendship.FriendColumn),
+			), nil
+		}
+	case group.Table:
+		switch edge {
+		case group.EdgeUsers:
+			return sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, group.UsersTable, group.UsersPrimaryKey...),
+			), nil
+		case group.EdgeJoinedUsers:
+			return sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(usergroup.Table, usergroup.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, group.JoinedUsersTable, group.JoinedUsersColumn),
+			), nil
+		}
+	case role.Table:
+		switch edge {
+		case role.EdgeUser:
+			return sqlgraph.NewStep(
+				sqlgraph.From(role.Table, role.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, role.UserTable, role.UserPrimaryKey...),
+			), nil
+		}
+	case tag.Table:
+		switch edge {
+		case tag.EdgeTweets:
+			return sqlgraph.NewStep(
+				sqlgraph.From(tag.Table, tag.FieldID),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, tag.TweetsTable, tag.TweetsPrimaryKey...),
+			), nil
+		case tag.EdgeTweetTags:
+			return sqlgraph.NewStep(
+				sqlgraph.From(tag.Table, tag.FieldID),
+				sqlgraph.To(tweettag.Table, tweettag.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, tag.TweetTagsTable, tag.TweetTagsColumn),
+			), nil
+		}
+	case tweet.Table:
+		switch edge {
+		case tweet.EdgeLikedUsers:
+			return sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, tweet.LikedUsersTable, tweet.LikedUsersPrimaryKey...),
+			), nil
+		case tweet.EdgeUser:
+			return sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, tweet.UserTable, tweet.UserPrimaryKey...),
+			), nil
+		case tweet.EdgeTags:
+			return sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(tag.Table, tag.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, tweet.TagsTable, tweet.TagsPrimaryKey...),
+			), nil
+		case tweet.EdgeTweetUser:
+			return sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(usertweet.Table, usertweet.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, tweet.TweetUserTable, tweet.TweetUserColumn),
+			), nil
+		case tweet.EdgeTweetTags:
+			return sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(tweettag.Table, tweettag.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, tweet.TweetTagsTable, tweet.TweetTagsColumn),
+			), nil
+		}
+	case tweettag.Table:
+		switch edge {
+		case tweettag.EdgeTag:
+			return sqlgraph.NewStep(
+				sqlgraph.From(tweettag.Table, tweettag.FieldID),
+				sqlgraph.To(tag.Table, tag.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, tweettag.TagTable, tweettag.TagColumn),
+			), nil
+		case tweettag.EdgeTweet:
+			return sqlgraph.NewStep(
+				sqlgraph.From(tweettag.Table, tweettag.FieldID),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, tweettag.TweetTable, tweettag.TweetColumn),
+			), nil
+		}
+	case user.Table:
+		switch edge {
+		case user.EdgeGroups:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(group.Table, group.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.GroupsTable, user.GroupsPrimaryKey...),
+			), nil
+		case user.EdgeFriends:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
+			), nil
+		case user.EdgeRelatives:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.RelativesTable, user.RelativesPrimaryKey...),
+			), nil
+		case user.EdgeLikedTweets:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.LikedTweetsTable, user.LikedTweetsPrimaryKey...),
+			), nil
+		case user.EdgeTweets:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.TweetsTable, user.TweetsPrimaryKey...),
+			), nil
+		case user.EdgeRoles:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(role.Table, role.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.RolesTable, user.RolesPrimaryKey...),
+			), nil
+		case user.EdgeJoinedGroups:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(usergroup.Table, usergroup.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.JoinedGroupsTable, user.JoinedGroupsColumn),
+			), nil
+		case user.EdgeFriendships:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(friendship.Table, friendship.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.FriendshipsTable, user.FriendshipsColumn),
+			), nil
+		case user.EdgeUserTweets:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(usertweet.Table, usertweet.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.UserTweetsTable, user.UserTweetsColumn),
+			), nil
+		}
+	case usergroup.Table:
+		switch edge {
+		case usergroup.EdgeUser:
+			return sqlgraph.NewStep(
+				sqlgraph.From(usergroup.Table, usergroup.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, usergroup.UserTable, usergroup.UserColumn),
+			), nil
+		case usergroup.EdgeGroup:
+			return sqlgraph.NewStep(
+				sqlgraph.From(usergroup.Table, usergroup.FieldID),
+				sqlgraph.To(group.Table, group.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, usergroup.GroupTable, usergroup.GroupColumn),
+			), nil
+		}
+	case usertweet.Table:
+		switch edge {
+		case usertweet.EdgeUser:
+			return sqlgraph.NewStep(
+				sqlgraph.From(usertweet.Table, usertweet.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, usertweet.UserTable, usertweet.UserColumn),
+			), nil
+		case usertweet.EdgeTweet:
+			return sqlgraph.NewStep(
+				sqlgraph.From(usertweet.Table, usertweet.FieldID),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, usertweet.TweetTable, usertweet.TweetColumn),
+			), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown edge %q for table %q", edge, table)
+}
+
+// WindowOption configures the window of a window function.
+type WindowOption func(*sql.Selector, *sql.WindowBuilder)
+
+// PartitionBy divides the rows of the window function into partitions by the given fields.
+func PartitionBy(fields ...string) WindowOption {
+	return func(s *sql.Selector, w *sql.WindowBuilder) {
+		check := columnChecker(s.TableName())
+		columns := make([]string, 0, len(fields))
+		for _, f := range fields {
+			if err := check(f); err != nil {
+				s.AddError(&ValidationError{Name: f, err: fmt.Errorf("ent: %w", err)})
+			}
+			columns = append(columns, s.C(f))
+		}
+		w.PartitionBy(columns...)
+	}
+}
+
+// OrderBy sorts the rows of each partition of the window function by the given fields.
+// Fields that are prefixed with "-" are sorted in descending order (e.g. "-created_at").
+func OrderBy(fields ...string) WindowOption {
+	return func(s *sql.Selector, w *sql.WindowBuilder) {
+		check := columnChecker(s.TableName())
+		for _, f := range fields {
+			order := sql.Asc
+			if strings.HasPrefix(f, "-") {
+				f, order = f[1:], sql.Desc
+			}
+			if err := check(f); err != nil {
+				s.AddError(&ValidationError{Name: f, err: fmt.Errorf("ent: %w", err)})
+			}
+			w.OrderBy(order(s.C(f)))
+		}
+	}
+}
+
+// RowNumber applies the ROW_NUMBER() window function on the rows of the query. For example:
+//
+//	GroupBy(field1, field2).
+//	Aggregate(ent.RowNumber(ent.PartitionBy(field1), ent.OrderBy(field2))).
+//	Scan(ctx, &v)
+//
+func RowNumber(opts ...WindowOption) AggregateFunc {
+	return func(s *sql.Selector) string {
+		w := sql.RowNumber()
+		w.SetDialect(s.Dialect())
+		for _, opt := range opts {
+			opt(s, w)
+		}
+		query, _ := w.Query()
+		return query
+	}
+}
+
+// Rank applies the RANK() window function on the rows of the query. For example:
+//
+//	GroupBy(field1, field2).
+//	Aggregate(ent.Rank(ent.PartitionBy(field1), ent.OrderBy(field2))).
+//	Scan(ctx, &v)
+//
+func Rank(opts ...WindowOption) AggregateFunc {
+	return func(s *sql.Selector) string {
+		w := sql.Rank()
+		w.SetDialect(s.Dialect())
+		for _, opt := range opts {
+			opt(s, w)
+		}
+		query, _ := w.Query()
+		return query
+	}
+}
+
 // ValidationError returns when validating a field or edge fails.
 type ValidationError struct {
 	Name string // Field or edge name.
@@ -199,11 +492,52 @@ func IsValidationError(err error) bool {
 // NotFoundError returns when trying to fetch a specific entity and it was not found in the database.
 type NotFoundError struct {
 	label string
+	// id holds the searched ID, if the entity was fetched by its ID.
+	id interface{}
+	// predicate holds a summary of the query predicates (without their arguments), if available.
+	predicate string
 }
 
+// ErrNotFound matches all *NotFoundError errors when used with errors.Is. For example:
+//
+//	if errors.Is(err, ent.ErrNotFound) {
+//		w.WriteHeader(http.StatusNotFound)
+//	}
+//
+var ErrNotFound = &NotFoundError{}
+
 // Error implements the error interface.
 func (e *NotFoundError) Error() string {
-	return "ent: " + e.label + " not found"
+	switch {
+	case e.id != nil:
+		return fmt.Sprintf("ent: %s not found (id=%v)", e.label, e.id)
+	case e.predicate != "":
+		return fmt.Sprintf("ent: %s not found (where %s)", e.label, e.predicate)
+	default:
+		return "ent: " + e.label + " not found"
+	}
+}
+
+// Is reports whether the target is ErrNotFound, or a *NotFoundError of the same entity.
+func (e *NotFoundError) Is(target error) bool {
+	t, ok := target.(*NotFoundError)
+	return ok && (t.label == "" || t.label == e.label)
+}
+
+// Label returns the label of the entity that was not found.
+func (e *NotFoundError) Label() string {
+	return e.label
+}
+
+// ID returns the searched ID, or nil if the entity was not fetched by its ID.
+func (e *NotFoundError) ID() interface{} {
+	return e.id
+}
+
+// Predicate returns a summary of the query predicates, or an empty string if it is not available.
+// Note that the arguments of the predicates are omitted, and only their placeholders are returned.
+func (e *NotFoundError) Predicate() string {
+	return e.predicate
 }
 
 // IsNotFound returns a boolean indicating whether the error is a not found error.
@@ -288,6 +622,57 @@ func IsConstraintError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// ConflictError returns when trying to update an entity that was changed since it was
+// loaded, i.e. its version in the database is different than the expected version, or
+// when the entity does not match the conditions of the update (see UpdateOne.Where).
+type ConflictError struct {
+	label string
+	// id holds the ID of the entity, if it has a single ID field.
+	id interface{}
+	// version holds the expected version of the entity, or
+	// nil if it does not match the conditions of the update.
+	version interface{}
+}
+
+// Error implements the error interface.
+func (e *ConflictError) Error() string {
+	if e.version == nil {
+		if e.id != nil {
+			return fmt.Sprintf("ent: %s does not match the conditions of the update (id=%v)", e.label, e.id)
+		}
+		return fmt.Sprintf("ent: %s does not match the conditions of the update", e.label)
+	}
+	if e.id != nil {
+		return fmt.Sprintf("ent: %s was changed since version %v (id=%v)", e.label, e.version, e.id)
+	}
+	return fmt.Sprintf("ent: %s was changed since version %v", e.label, e.version)
+}
+
+// Label returns the label of the entity that was changed.
+func (e *ConflictError) Label() string {
+	return e.label
+}
+
+// ID returns the ID of the entity, or nil if it does not have a single ID field.
+func (e *ConflictError) ID() interface{} {
+	return e.id
+}
+
+// Version returns the version that was expected for the entity, or
+// nil if the entity does not match the conditions of the update.
+func (e *ConflictError) Version() interface{} {
+	return e.version
+}
+
+// IsConflict returns a boolean indicating whether the error is a conflict error.
+func IsConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *ConflictError
+	return errors.As(err, &e)
+}
+
 // selector embedded by the different Select/GroupBy builders.
 type selector struct {
 	label string
@@ -333,7 +718,7 @@ func (s *selector) String(ctx context.Context) (_ string, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Strings returned %d results when one was expected", len(v))
 	}
@@ -380,7 +765,7 @@ func (s *selector) Int(ctx context.Context) (_ int, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Ints returned %d results when one was expected", len(v))
 	}
@@ -427,7 +812,7 @@ func (s *selector) Float64(ctx context.Context) (_ float64, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Float64s returned %d results when one was expected", len(v))
 	}
@@ -474,7 +859,7 @@ func (s *selector) Bool(ctx context.Context) (_ bool, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Bools returned %d results when one was expected", len(v))
 	}
@@ -490,5 +875,17 @@ func (s *selector) BoolX(ctx context.Context) bool {
 	return v
 }
 
+// withInterceptors executes the given querier with the given interceptors, where the first interceptor
+// is the outermost one. The QueryContext of the query is available in the context of the interceptors.
+func withInterceptors(ctx context.Context, q Query, qc *ent.QueryContext, qr Querier, inters []Interceptor) (Value, error) {
+	for i := len(inters) - 1; i >= 0; i-- {
+		if inters[i] == nil {
+			return nil, fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		qr = inters[i].Intercept(qr)
+	}
+	return qr.Query(ent.NewQueryContext(ctx, qc), q)
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
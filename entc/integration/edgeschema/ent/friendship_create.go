@@ -159,7 +159,7 @@ func (fc *FriendshipCreate) defaults() {
 		fc.mutation.SetWeight(v)
 	}
 	if _, ok := fc.mutation.CreatedAt(); !ok {
-		v := friendship.DefaultCreatedAt()
+		v := fc.config.now(friendship.DefaultCreatedAt)
 		fc.mutation.SetCreatedAt(v)
 	}
 }
@@ -314,6 +314,7 @@ type (
 	//  one Friendship node.
 	FriendshipUpsertOne struct {
 		create *FriendshipCreate
+		err    error // first validation error of the update values.
 	}
 
 	// FriendshipUpsert is the "OnConflict" setter.
@@ -483,6 +484,9 @@ func (u *FriendshipUpsertOne) UpdateFriendID() *FriendshipUpsertOne {
 
 // Exec executes the query.
 func (u *FriendshipUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for FriendshipCreate.OnConflict")
 	}
@@ -491,13 +495,16 @@ func (u *FriendshipUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *FriendshipUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *FriendshipUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -641,6 +648,7 @@ func (fcb *FriendshipCreateBulk) OnConflictColumns(columns ...string) *Friendshi
 // a bulk of Friendship nodes.
 type FriendshipUpsertBulk struct {
 	create *FriendshipCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -750,6 +758,9 @@ func (u *FriendshipUpsertBulk) UpdateFriendID() *FriendshipUpsertBulk {
 
 // Exec executes the query.
 func (u *FriendshipUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FriendshipCreateBulk instead", i)
@@ -763,7 +774,7 @@ func (u *FriendshipUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *FriendshipUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
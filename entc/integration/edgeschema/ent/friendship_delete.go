@@ -107,7 +107,7 @@ func (fdo *FriendshipDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{friendship.Label}
+		return &NotFoundError{label: friendship.Label}
 	default:
 		return nil
 	}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/friendship"
@@ -27,6 +28,7 @@ type FriendshipQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Friendship
 	withUser   *UserQuery
 	withFriend *UserQuery
@@ -68,7 +70,7 @@ func (fq *FriendshipQuery) Order(o ...OrderFunc) *FriendshipQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (fq *FriendshipQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -90,7 +92,7 @@ func (fq *FriendshipQuery) QueryUser() *UserQuery {
 
 // QueryFriend chains the current query on the "friend" edge.
 func (fq *FriendshipQuery) QueryFriend() *UserQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -118,7 +120,7 @@ func (fq *FriendshipQuery) First(ctx context.Context) (*Friendship, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{friendship.Label}
+		return nil, fq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -140,7 +142,7 @@ func (fq *FriendshipQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{friendship.Label}
+		err = fq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -167,7 +169,7 @@ func (fq *FriendshipQuery) Only(ctx context.Context) (*Friendship, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{friendship.Label}
+		return nil, fq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{friendship.Label}
 	}
@@ -194,7 +196,7 @@ func (fq *FriendshipQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{friendship.Label}
+		err = fq.sqlNotFound()
 	default:
 		err = &NotSingularError{friendship.Label}
 	}
@@ -215,7 +217,17 @@ func (fq *FriendshipQuery) All(ctx context.Context) ([]*Friendship, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return fq.sqlAll(ctx)
+	v, err := fq.intercept(ctx, "All", func(ctx context.Context, query *FriendshipQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Friendship)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Friendship", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -229,10 +241,20 @@ func (fq *FriendshipQuery) AllX(ctx context.Context) []*Friendship {
 
 // IDs executes the query and returns a list of Friendship IDs.
 func (fq *FriendshipQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := fq.Select(friendship.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := fq.intercept(ctx, "IDs", func(ctx context.Context, query *FriendshipQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(friendship.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -250,7 +272,17 @@ func (fq *FriendshipQuery) Count(ctx context.Context) (int, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return fq.sqlCount(ctx)
+	v, err := fq.intercept(ctx, "Count", func(ctx context.Context, query *FriendshipQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -267,7 +299,61 @@ func (fq *FriendshipQuery) Exist(ctx context.Context) (bool, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return fq.sqlExist(ctx)
+	v, err := fq.intercept(ctx, "Exist", func(ctx context.Context, query *FriendshipQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (fq *FriendshipQuery) intercept(ctx context.Context, op string, fn func(context.Context, *FriendshipQuery) (Value, error)) (Value, error) {
+	if len(fq.inters) == 0 {
+		return fn(ctx, fq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeFriendship,
+		Op:     op,
+		Limit:  fq.limit,
+		Offset: fq.offset,
+		Unique: fq.unique,
+		Fields: fq.fields,
+	}
+	for _, p := range fq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range fq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if fq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, friendship.EdgeUser)
+		qc.EdgeQueries[friendship.EdgeUser] = fq.withUser
+	}
+	if fq.withFriend != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, friendship.EdgeFriend)
+		qc.EdgeQueries[friendship.EdgeFriend] = fq.withFriend
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FriendshipQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *FriendshipQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, fq, qc, qr, fq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -290,6 +376,7 @@ func (fq *FriendshipQuery) Clone() *FriendshipQuery {
 		limit:      fq.limit,
 		offset:     fq.offset,
 		order:      append([]OrderFunc{}, fq.order...),
+		inters:     append([]Interceptor{}, fq.inters...),
 		predicates: append([]predicate.Friendship{}, fq.predicates...),
 		withUser:   fq.withUser.Clone(),
 		withFriend: fq.withFriend.Clone(),
@@ -303,7 +390,7 @@ func (fq *FriendshipQuery) Clone() *FriendshipQuery {
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FriendshipQuery) WithUser(opts ...func(*UserQuery)) *FriendshipQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -314,7 +401,7 @@ func (fq *FriendshipQuery) WithUser(opts ...func(*UserQuery)) *FriendshipQuery {
 // WithFriend tells the query-builder to eager-load the nodes that are connected to
 // the "friend" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FriendshipQuery) WithFriend(opts ...func(*UserQuery)) *FriendshipQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -378,6 +465,16 @@ func (fq *FriendshipQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range fq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fq); err != nil {
+				return err
+			}
+		}
+	}
 	if fq.path != nil {
 		prev, err := fq.path(ctx)
 		if err != nil {
@@ -409,7 +506,7 @@ func (fq *FriendshipQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*F
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, fq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, fq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -489,7 +586,7 @@ func (fq *FriendshipQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(fq.fields) > 0 {
 		_spec.Unique = fq.unique != nil && *fq.unique
 	}
-	return sqlgraph.CountNodes(ctx, fq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, fq.queryDriver(), _spec)
 }
 
 func (fq *FriendshipQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -580,6 +677,32 @@ func (fq *FriendshipQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (fq *FriendshipQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: friendship.Label}
+	if len(fq.predicates) > 0 {
+		selector := sql.Dialect(fq.driver.Dialect()).Select().From(sql.Table(friendship.Table))
+		for _, p := range fq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the FriendshipQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (fq *FriendshipQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Friendship, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Friendship(ps[i]))
+	}
+	fq.predicates = append(fq.predicates, wps...)
+}
+
 // FriendshipGroupBy is the group-by builder for Friendship entities.
 type FriendshipGroupBy struct {
 	config
@@ -619,7 +742,7 @@ func (fgb *FriendshipGroupBy) sqlScan(ctx context.Context, v interface{}) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := fgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := fgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -665,7 +788,7 @@ func (fs *FriendshipSelect) Scan(ctx context.Context, v interface{}) error {
 func (fs *FriendshipSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := fs.sql.Query()
-	if err := fs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := fs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
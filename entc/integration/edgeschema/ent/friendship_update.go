@@ -54,6 +54,25 @@ func (fu *FriendshipUpdate) AddWeight(i int) *FriendshipUpdate {
 	return fu
 }
 
+// SetWeightIfGreater sets the "weight" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (fu *FriendshipUpdate) SetWeightIfGreater(i int) *FriendshipUpdate {
+	fu.mutation.SetWeightIfGreater(i)
+	return fu
+}
+
+// BitAndWeight applies a bitwise AND with i on the "weight" field in the database.
+func (fu *FriendshipUpdate) BitAndWeight(i int) *FriendshipUpdate {
+	fu.mutation.BitAndWeight(i)
+	return fu
+}
+
+// BitOrWeight applies a bitwise OR with i on the "weight" field in the database.
+func (fu *FriendshipUpdate) BitOrWeight(i int) *FriendshipUpdate {
+	fu.mutation.BitOrWeight(i)
+	return fu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fu *FriendshipUpdate) SetCreatedAt(t time.Time) *FriendshipUpdate {
 	fu.mutation.SetCreatedAt(t)
@@ -210,6 +229,27 @@ func (fu *FriendshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: friendship.FieldWeight,
 		})
 	}
+	if value, ok := fu.mutation.WeightIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: friendship.FieldWeight,
+		})
+	}
+	if value, ok := fu.mutation.WeightBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: friendship.FieldWeight,
+		})
+	}
+	if value, ok := fu.mutation.WeightBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: friendship.FieldWeight,
+		})
+	}
 	if value, ok := fu.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -289,7 +329,7 @@ func (fu *FriendshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{friendship.Label}
+			err = &NotFoundError{label: friendship.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -301,9 +341,18 @@ func (fu *FriendshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // FriendshipUpdateOne is the builder for updating a single Friendship entity.
 type FriendshipUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FriendshipMutation
+	fields     []string
+	conditions []predicate.Friendship
+	hooks      []Hook
+	mutation   *FriendshipMutation
+}
+
+// Where appends a list of conditions to the FriendshipUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (fuo *FriendshipUpdateOne) Where(ps ...predicate.Friendship) *FriendshipUpdateOne {
+	fuo.conditions = append(fuo.conditions, ps...)
+	return fuo
 }
 
 // SetWeight sets the "weight" field.
@@ -327,6 +376,25 @@ func (fuo *FriendshipUpdateOne) AddWeight(i int) *FriendshipUpdateOne {
 	return fuo
 }
 
+// SetWeightIfGreater sets the "weight" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (fuo *FriendshipUpdateOne) SetWeightIfGreater(i int) *FriendshipUpdateOne {
+	fuo.mutation.SetWeightIfGreater(i)
+	return fuo
+}
+
+// BitAndWeight applies a bitwise AND with i on the "weight" field in the database.
+func (fuo *FriendshipUpdateOne) BitAndWeight(i int) *FriendshipUpdateOne {
+	fuo.mutation.BitAndWeight(i)
+	return fuo
+}
+
+// BitOrWeight applies a bitwise OR with i on the "weight" field in the database.
+func (fuo *FriendshipUpdateOne) BitOrWeight(i int) *FriendshipUpdateOne {
+	fuo.mutation.BitOrWeight(i)
+	return fuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fuo *FriendshipUpdateOne) SetCreatedAt(t time.Time) *FriendshipUpdateOne {
 	fuo.mutation.SetCreatedAt(t)
@@ -499,6 +567,13 @@ func (fuo *FriendshipUpdateOne) sqlSave(ctx context.Context) (_node *Friendship,
 			}
 		}
 	}
+	if ps := fuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := fuo.mutation.Weight(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -513,6 +588,27 @@ func (fuo *FriendshipUpdateOne) sqlSave(ctx context.Context) (_node *Friendship,
 			Column: friendship.FieldWeight,
 		})
 	}
+	if value, ok := fuo.mutation.WeightIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: friendship.FieldWeight,
+		})
+	}
+	if value, ok := fuo.mutation.WeightBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: friendship.FieldWeight,
+		})
+	}
+	if value, ok := fuo.mutation.WeightBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: friendship.FieldWeight,
+		})
+	}
 	if value, ok := fuo.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -595,7 +691,9 @@ func (fuo *FriendshipUpdateOne) sqlSave(ctx context.Context) (_node *Friendship,
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{friendship.Label}
+			err = &NotFoundError{label: friendship.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: friendship.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
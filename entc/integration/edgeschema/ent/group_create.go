@@ -22,9 +22,10 @@ import (
 // GroupCreate is the builder for creating a Group entity.
 type GroupCreate struct {
 	config
-	mutation *GroupMutation
-	hooks    []Hook
-	conflict []sql.ConflictOption
+	mutation     *GroupMutation
+	hooks        []Hook
+	usersThrough func(*UserGroupCreate)
+	conflict     []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -56,6 +57,20 @@ func (gc *GroupCreate) AddUsers(u ...*User) *GroupCreate {
 	return gc.AddUserIDs(ids...)
 }
 
+// SetUsersThrough sets a function that configures the UserGroup entities (i.e. the rows of the edge schema)
+// that are created for the "users" edges added by the builder. For example, for setting their fields:
+//
+//	SetUsersThrough(func(c *UserGroupCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the UserGroup type are not executed on these entities.
+func (gc *GroupCreate) SetUsersThrough(fn func(*UserGroupCreate)) *GroupCreate {
+	gc.usersThrough = fn
+	return gc
+}
+
 // AddJoinedUserIDs adds the "joined_users" edge to the UserGroup entity by IDs.
 func (gc *GroupCreate) AddJoinedUserIDs(ids ...int) *GroupCreate {
 	gc.mutation.AddJoinedUserIDs(ids...)
@@ -213,6 +228,11 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserGroupCreate{config: gc.config, mutation: newUserGroupMutation(gc.config, OpCreate)}
+		if gc.usersThrough != nil {
+			gc.usersThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetGroupID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -283,6 +303,7 @@ type (
 	//  one Group node.
 	GroupUpsertOne struct {
 		create *GroupCreate
+		err    error // first validation error of the update values.
 	}
 
 	// GroupUpsert is the "OnConflict" setter.
@@ -361,6 +382,9 @@ func (u *GroupUpsertOne) UpdateName() *GroupUpsertOne {
 
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for GroupCreate.OnConflict")
 	}
@@ -369,13 +393,16 @@ func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *GroupUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *GroupUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -519,6 +546,7 @@ func (gcb *GroupCreateBulk) OnConflictColumns(columns ...string) *GroupUpsertBul
 // a bulk of Group nodes.
 type GroupUpsertBulk struct {
 	create *GroupCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -579,6 +607,9 @@ func (u *GroupUpsertBulk) UpdateName() *GroupUpsertBulk {
 
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GroupCreateBulk instead", i)
@@ -592,7 +623,7 @@ func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *GroupUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
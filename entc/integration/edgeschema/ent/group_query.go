@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/group"
@@ -29,6 +30,7 @@ type GroupQuery struct {
 	unique          *bool
 	order           []OrderFunc
 	fields          []string
+	inters          []Interceptor
 	predicates      []predicate.Group
 	withUsers       *UserQuery
 	withJoinedUsers *UserGroupQuery
@@ -70,7 +72,7 @@ func (gq *GroupQuery) Order(o ...OrderFunc) *GroupQuery {
 
 // QueryUsers chains the current query on the "users" edge.
 func (gq *GroupQuery) QueryUsers() *UserQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -92,7 +94,7 @@ func (gq *GroupQuery) QueryUsers() *UserQuery {
 
 // QueryJoinedUsers chains the current query on the "joined_users" edge.
 func (gq *GroupQuery) QueryJoinedUsers() *UserGroupQuery {
-	query := &UserGroupQuery{config: gq.config}
+	query := (&UserGroupClient{config: gq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -120,7 +122,7 @@ func (gq *GroupQuery) First(ctx context.Context) (*Group, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{group.Label}
+		return nil, gq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -142,7 +144,7 @@ func (gq *GroupQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{group.Label}
+		err = gq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -169,7 +171,7 @@ func (gq *GroupQuery) Only(ctx context.Context) (*Group, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{group.Label}
+		return nil, gq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{group.Label}
 	}
@@ -196,7 +198,7 @@ func (gq *GroupQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{group.Label}
+		err = gq.sqlNotFound()
 	default:
 		err = &NotSingularError{group.Label}
 	}
@@ -217,7 +219,17 @@ func (gq *GroupQuery) All(ctx context.Context) ([]*Group, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return gq.sqlAll(ctx)
+	v, err := gq.intercept(ctx, "All", func(ctx context.Context, query *GroupQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Group)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Group", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -231,10 +243,20 @@ func (gq *GroupQuery) AllX(ctx context.Context) []*Group {
 
 // IDs executes the query and returns a list of Group IDs.
 func (gq *GroupQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := gq.Select(group.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := gq.intercept(ctx, "IDs", func(ctx context.Context, query *GroupQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(group.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -252,7 +274,17 @@ func (gq *GroupQuery) Count(ctx context.Context) (int, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return gq.sqlCount(ctx)
+	v, err := gq.intercept(ctx, "Count", func(ctx context.Context, query *GroupQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -269,7 +301,61 @@ func (gq *GroupQuery) Exist(ctx context.Context) (bool, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return gq.sqlExist(ctx)
+	v, err := gq.intercept(ctx, "Exist", func(ctx context.Context, query *GroupQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (gq *GroupQuery) intercept(ctx context.Context, op string, fn func(context.Context, *GroupQuery) (Value, error)) (Value, error) {
+	if len(gq.inters) == 0 {
+		return fn(ctx, gq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeGroup,
+		Op:     op,
+		Limit:  gq.limit,
+		Offset: gq.offset,
+		Unique: gq.unique,
+		Fields: gq.fields,
+	}
+	for _, p := range gq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range gq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if gq.withUsers != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, group.EdgeUsers)
+		qc.EdgeQueries[group.EdgeUsers] = gq.withUsers
+	}
+	if gq.withJoinedUsers != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, group.EdgeJoinedUsers)
+		qc.EdgeQueries[group.EdgeJoinedUsers] = gq.withJoinedUsers
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *GroupQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, gq, qc, qr, gq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -292,6 +378,7 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 		limit:           gq.limit,
 		offset:          gq.offset,
 		order:           append([]OrderFunc{}, gq.order...),
+		inters:          append([]Interceptor{}, gq.inters...),
 		predicates:      append([]predicate.Group{}, gq.predicates...),
 		withUsers:       gq.withUsers.Clone(),
 		withJoinedUsers: gq.withJoinedUsers.Clone(),
@@ -305,7 +392,7 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 // WithUsers tells the query-builder to eager-load the nodes that are connected to
 // the "users" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithUsers(opts ...func(*UserQuery)) *GroupQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -316,7 +403,7 @@ func (gq *GroupQuery) WithUsers(opts ...func(*UserQuery)) *GroupQuery {
 // WithJoinedUsers tells the query-builder to eager-load the nodes that are connected to
 // the "joined_users" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithJoinedUsers(opts ...func(*UserGroupQuery)) *GroupQuery {
-	query := &UserGroupQuery{config: gq.config}
+	query := (&UserGroupClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -380,6 +467,16 @@ func (gq *GroupQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range gq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gq); err != nil {
+				return err
+			}
+		}
+	}
 	if gq.path != nil {
 		prev, err := gq.path(ctx)
 		if err != nil {
@@ -411,7 +508,7 @@ func (gq *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, gq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, gq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -523,7 +620,7 @@ func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(gq.fields) > 0 {
 		_spec.Unique = gq.unique != nil && *gq.unique
 	}
-	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, gq.queryDriver(), _spec)
 }
 
 func (gq *GroupQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -614,6 +711,32 @@ func (gq *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (gq *GroupQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: group.Label}
+	if len(gq.predicates) > 0 {
+		selector := sql.Dialect(gq.driver.Dialect()).Select().From(sql.Table(group.Table))
+		for _, p := range gq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the GroupQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (gq *GroupQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Group, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Group(ps[i]))
+	}
+	gq.predicates = append(gq.predicates, wps...)
+}
+
 // GroupGroupBy is the group-by builder for Group entities.
 type GroupGroupBy struct {
 	config
@@ -653,7 +776,7 @@ func (ggb *GroupGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ggb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ggb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -699,7 +822,7 @@ func (gs *GroupSelect) Scan(ctx context.Context, v interface{}) error {
 func (gs *GroupSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := gs.sql.Query()
-	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := gs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
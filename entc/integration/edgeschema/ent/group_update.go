@@ -23,8 +23,9 @@ import (
 // GroupUpdate is the builder for updating Group entities.
 type GroupUpdate struct {
 	config
-	hooks    []Hook
-	mutation *GroupMutation
+	hooks        []Hook
+	mutation     *GroupMutation
+	usersThrough func(*UserGroupCreate)
 }
 
 // Where appends a list predicates to the GroupUpdate builder.
@@ -62,6 +63,20 @@ func (gu *GroupUpdate) AddUsers(u ...*User) *GroupUpdate {
 	return gu.AddUserIDs(ids...)
 }
 
+// SetUsersThrough sets a function that configures the UserGroup entities (i.e. the rows of the edge schema)
+// that are created for the "users" edges added by the builder. For example, for setting their fields:
+//
+//	SetUsersThrough(func(c *UserGroupCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the UserGroup type are not executed on these entities.
+func (gu *GroupUpdate) SetUsersThrough(fn func(*UserGroupCreate)) *GroupUpdate {
+	gu.usersThrough = fn
+	return gu
+}
+
 // AddJoinedUserIDs adds the "joined_users" edge to the UserGroup entity by IDs.
 func (gu *GroupUpdate) AddJoinedUserIDs(ids ...int) *GroupUpdate {
 	gu.mutation.AddJoinedUserIDs(ids...)
@@ -264,6 +279,11 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserGroupCreate{config: gu.config, mutation: newUserGroupMutation(gu.config, OpCreate)}
+		if gu.usersThrough != nil {
+			gu.usersThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetGroupID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -325,7 +345,7 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{group.Label}
+			err = &NotFoundError{label: group.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -337,9 +357,19 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // GroupUpdateOne is the builder for updating a single Group entity.
 type GroupUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GroupMutation
+	fields       []string
+	conditions   []predicate.Group
+	hooks        []Hook
+	mutation     *GroupMutation
+	usersThrough func(*UserGroupCreate)
+}
+
+// Where appends a list of conditions to the GroupUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
+	guo.conditions = append(guo.conditions, ps...)
+	return guo
 }
 
 // SetName sets the "name" field.
@@ -371,6 +401,20 @@ func (guo *GroupUpdateOne) AddUsers(u ...*User) *GroupUpdateOne {
 	return guo.AddUserIDs(ids...)
 }
 
+// SetUsersThrough sets a function that configures the UserGroup entities (i.e. the rows of the edge schema)
+// that are created for the "users" edges added by the builder. For example, for setting their fields:
+//
+//	SetUsersThrough(func(c *UserGroupCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the UserGroup type are not executed on these entities.
+func (guo *GroupUpdateOne) SetUsersThrough(fn func(*UserGroupCreate)) *GroupUpdateOne {
+	guo.usersThrough = fn
+	return guo
+}
+
 // AddJoinedUserIDs adds the "joined_users" edge to the UserGroup entity by IDs.
 func (guo *GroupUpdateOne) AddJoinedUserIDs(ids ...int) *GroupUpdateOne {
 	guo.mutation.AddJoinedUserIDs(ids...)
@@ -535,6 +579,13 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 			}
 		}
 	}
+	if ps := guo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := guo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -603,6 +654,11 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserGroupCreate{config: guo.config, mutation: newUserGroupMutation(guo.config, OpCreate)}
+		if guo.usersThrough != nil {
+			guo.usersThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetGroupID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -667,7 +723,9 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{group.Label}
+			err = &NotFoundError{label: group.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: group.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -30,6 +30,7 @@ import (
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -64,6 +65,9 @@ type FriendshipMutation struct {
 	id            *int
 	weight        *int
 	addweight     *int
+	maxweight     *int
+	andweight     *int
+	orweight      *int
 	created_at    *time.Time
 	clearedFields map[string]struct{}
 	user          *int
@@ -177,6 +181,9 @@ func (m *FriendshipMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *FriendshipMutation) SetWeight(i int) {
 	m.weight = &i
 	m.addweight = nil
+	m.maxweight = nil
+	m.andweight = nil
+	m.orweight = nil
 }
 
 // Weight returns the value of the "weight" field in the mutation.
@@ -223,10 +230,65 @@ func (m *FriendshipMutation) AddedWeight() (r int, exists bool) {
 	return *v, true
 }
 
+// SetWeightIfGreater sets the "weight" field to i in the database, only if it is greater than its stored value.
+func (m *FriendshipMutation) SetWeightIfGreater(i int) {
+	if m.maxweight == nil || i > *m.maxweight {
+		m.maxweight = &i
+	}
+}
+
+// WeightIfGreater returns the value that was set to the "weight" field by SetWeightIfGreater in this mutation.
+func (m *FriendshipMutation) WeightIfGreater() (r int, exists bool) {
+	v := m.maxweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndWeight applies a bitwise AND with i on the "weight" field.
+func (m *FriendshipMutation) BitAndWeight(i int) {
+	if m.andweight != nil {
+		*m.andweight &= i
+	} else {
+		m.andweight = &i
+	}
+}
+
+// WeightBitAnd returns the value that was applied by BitAndWeight on the "weight" field in this mutation.
+func (m *FriendshipMutation) WeightBitAnd() (r int, exists bool) {
+	v := m.andweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrWeight applies a bitwise OR with i on the "weight" field.
+func (m *FriendshipMutation) BitOrWeight(i int) {
+	if m.orweight != nil {
+		*m.orweight |= i
+	} else {
+		m.orweight = &i
+	}
+}
+
+// WeightBitOr returns the value that was applied by BitOrWeight on the "weight" field in this mutation.
+func (m *FriendshipMutation) WeightBitOr() (r int, exists bool) {
+	v := m.orweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetWeight resets all changes to the "weight" field.
 func (m *FriendshipMutation) ResetWeight() {
 	m.weight = nil
 	m.addweight = nil
+	m.maxweight = nil
+	m.andweight = nil
+	m.orweight = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -394,6 +456,16 @@ func (m *FriendshipMutation) Where(ps ...predicate.Friendship) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the FriendshipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FriendshipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Friendship, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *FriendshipMutation) Op() Op {
 	return m.op
@@ -931,6 +1003,16 @@ func (m *GroupMutation) Where(ps ...predicate.Group) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *GroupMutation) Op() Op {
 	return m.op
@@ -1159,6 +1241,9 @@ type RelationshipMutation struct {
 	typ             string
 	weight          *int
 	addweight       *int
+	maxweight       *int
+	andweight       *int
+	orweight        *int
 	clearedFields   map[string]struct{}
 	user            *int
 	cleareduser     bool
@@ -1213,6 +1298,9 @@ func (m RelationshipMutation) Tx() (*Tx, error) {
 func (m *RelationshipMutation) SetWeight(i int) {
 	m.weight = &i
 	m.addweight = nil
+	m.maxweight = nil
+	m.andweight = nil
+	m.orweight = nil
 }
 
 // Weight returns the value of the "weight" field in the mutation.
@@ -1242,10 +1330,65 @@ func (m *RelationshipMutation) AddedWeight() (r int, exists bool) {
 	return *v, true
 }
 
+// SetWeightIfGreater sets the "weight" field to i in the database, only if it is greater than its stored value.
+func (m *RelationshipMutation) SetWeightIfGreater(i int) {
+	if m.maxweight == nil || i > *m.maxweight {
+		m.maxweight = &i
+	}
+}
+
+// WeightIfGreater returns the value that was set to the "weight" field by SetWeightIfGreater in this mutation.
+func (m *RelationshipMutation) WeightIfGreater() (r int, exists bool) {
+	v := m.maxweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndWeight applies a bitwise AND with i on the "weight" field.
+func (m *RelationshipMutation) BitAndWeight(i int) {
+	if m.andweight != nil {
+		*m.andweight &= i
+	} else {
+		m.andweight = &i
+	}
+}
+
+// WeightBitAnd returns the value that was applied by BitAndWeight on the "weight" field in this mutation.
+func (m *RelationshipMutation) WeightBitAnd() (r int, exists bool) {
+	v := m.andweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrWeight applies a bitwise OR with i on the "weight" field.
+func (m *RelationshipMutation) BitOrWeight(i int) {
+	if m.orweight != nil {
+		*m.orweight |= i
+	} else {
+		m.orweight = &i
+	}
+}
+
+// WeightBitOr returns the value that was applied by BitOrWeight on the "weight" field in this mutation.
+func (m *RelationshipMutation) WeightBitOr() (r int, exists bool) {
+	v := m.orweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetWeight resets all changes to the "weight" field.
 func (m *RelationshipMutation) ResetWeight() {
 	m.weight = nil
 	m.addweight = nil
+	m.maxweight = nil
+	m.andweight = nil
+	m.orweight = nil
 }
 
 // SetUserID sets the "user_id" field.
@@ -1289,6 +1432,7 @@ func (m *RelationshipMutation) ResetRelativeID() {
 // SetInfoID sets the "info_id" field.
 func (m *RelationshipMutation) SetInfoID(i int) {
 	m.info = &i
+	delete(m.clearedFields, relationship.FieldInfoID)
 }
 
 // InfoID returns the value of the "info_id" field in the mutation.
@@ -1401,6 +1545,16 @@ func (m *RelationshipMutation) Where(ps ...predicate.Relationship) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the RelationshipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RelationshipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Relationship, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *RelationshipMutation) Op() Op {
 	return m.op
@@ -1452,7 +1606,7 @@ func (m *RelationshipMutation) Field(name string) (ent.Value, bool) {
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
 func (m *RelationshipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, errors.New("edge schema Relationship does not support getting old values")
+	return nil, errors.New("schema Relationship with a composite identifier does not support getting old values")
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
@@ -1841,6 +1995,16 @@ func (m *RelationshipInfoMutation) Where(ps ...predicate.RelationshipInfo) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the RelationshipInfoMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RelationshipInfoMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RelationshipInfo, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *RelationshipInfoMutation) Op() Op {
 	return m.op
@@ -2246,6 +2410,16 @@ func (m *RoleMutation) Where(ps ...predicate.Role) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *RoleMutation) Op() Op {
 	return m.op
@@ -2626,6 +2800,16 @@ func (m *RoleUserMutation) Where(ps ...predicate.RoleUser) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the RoleUserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleUserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleUser, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *RoleUserMutation) Op() Op {
 	return m.op
@@ -2672,7 +2856,7 @@ func (m *RoleUserMutation) Field(name string) (ent.Value, bool) {
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
 func (m *RoleUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, errors.New("edge schema RoleUser does not support getting old values")
+	return nil, errors.New("schema RoleUser with a composite identifier does not support getting old values")
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
@@ -3126,6 +3310,16 @@ func (m *TagMutation) Where(ps ...predicate.Tag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *TagMutation) Op() Op {
 	return m.op
@@ -3784,6 +3978,16 @@ func (m *TweetMutation) Where(ps ...predicate.Tweet) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the TweetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TweetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tweet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *TweetMutation) Op() Op {
 	return m.op
@@ -4251,6 +4455,16 @@ func (m *TweetLikeMutation) Where(ps ...predicate.TweetLike) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the TweetLikeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TweetLikeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TweetLike, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *TweetLikeMutation) Op() Op {
 	return m.op
@@ -4297,7 +4511,7 @@ func (m *TweetLikeMutation) Field(name string) (ent.Value, bool) {
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
 func (m *TweetLikeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, errors.New("edge schema TweetLike does not support getting old values")
+	return nil, errors.New("schema TweetLike with a composite identifier does not support getting old values")
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
@@ -4771,6 +4985,16 @@ func (m *TweetTagMutation) Where(ps ...predicate.TweetTag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the TweetTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TweetTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TweetTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *TweetTagMutation) Op() Op {
 	return m.op
@@ -5678,6 +5902,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -6361,6 +6595,16 @@ func (m *UserGroupMutation) Where(ps ...predicate.UserGroup) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserGroupMutation) Op() Op {
 	return m.op
@@ -6883,6 +7127,16 @@ func (m *UserTweetMutation) Where(ps ...predicate.UserTweet) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserTweetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserTweetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserTweet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserTweetMutation) Op() Op {
 	return m.op
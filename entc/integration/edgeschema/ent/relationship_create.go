@@ -316,6 +316,7 @@ type (
 	//  one Relationship node.
 	RelationshipUpsertOne struct {
 		create *RelationshipCreate
+		err    error // first validation error of the update values.
 	}
 
 	// RelationshipUpsert is the "OnConflict" setter.
@@ -498,6 +499,9 @@ func (u *RelationshipUpsertOne) ClearInfoID() *RelationshipUpsertOne {
 
 // Exec executes the query.
 func (u *RelationshipUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for RelationshipCreate.OnConflict")
 	}
@@ -506,7 +510,7 @@ func (u *RelationshipUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *RelationshipUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -633,6 +637,7 @@ func (rcb *RelationshipCreateBulk) OnConflictColumns(columns ...string) *Relatio
 // a bulk of Relationship nodes.
 type RelationshipUpsertBulk struct {
 	create *RelationshipCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -749,6 +754,9 @@ func (u *RelationshipUpsertBulk) ClearInfoID() *RelationshipUpsertBulk {
 
 // Exec executes the query.
 func (u *RelationshipUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RelationshipCreateBulk instead", i)
@@ -762,7 +770,7 @@ func (u *RelationshipUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *RelationshipUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -102,7 +102,7 @@ func (rdo *RelationshipDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{relationship.Label}
+		return &NotFoundError{label: relationship.Label}
 	default:
 		return nil
 	}
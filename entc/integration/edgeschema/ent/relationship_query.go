@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/predicate"
@@ -27,6 +28,7 @@ type RelationshipQuery struct {
 	unique       *bool
 	order        []OrderFunc
 	fields       []string
+	inters       []Interceptor
 	predicates   []predicate.Relationship
 	withUser     *UserQuery
 	withRelative *UserQuery
@@ -69,7 +71,7 @@ func (rq *RelationshipQuery) Order(o ...OrderFunc) *RelationshipQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (rq *RelationshipQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: rq.config}
+	query := (&UserClient{config: rq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := rq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -91,7 +93,7 @@ func (rq *RelationshipQuery) QueryUser() *UserQuery {
 
 // QueryRelative chains the current query on the "relative" edge.
 func (rq *RelationshipQuery) QueryRelative() *UserQuery {
-	query := &UserQuery{config: rq.config}
+	query := (&UserClient{config: rq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := rq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -113,7 +115,7 @@ func (rq *RelationshipQuery) QueryRelative() *UserQuery {
 
 // QueryInfo chains the current query on the "info" edge.
 func (rq *RelationshipQuery) QueryInfo() *RelationshipInfoQuery {
-	query := &RelationshipInfoQuery{config: rq.config}
+	query := (&RelationshipInfoClient{config: rq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := rq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -141,7 +143,7 @@ func (rq *RelationshipQuery) First(ctx context.Context) (*Relationship, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{relationship.Label}
+		return nil, rq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -167,7 +169,7 @@ func (rq *RelationshipQuery) Only(ctx context.Context) (*Relationship, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{relationship.Label}
+		return nil, rq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{relationship.Label}
 	}
@@ -187,7 +189,17 @@ func (rq *RelationshipQuery) All(ctx context.Context) ([]*Relationship, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return rq.sqlAll(ctx)
+	v, err := rq.intercept(ctx, "All", func(ctx context.Context, query *RelationshipQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Relationship)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Relationship", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -204,7 +216,17 @@ func (rq *RelationshipQuery) Count(ctx context.Context) (int, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return rq.sqlCount(ctx)
+	v, err := rq.intercept(ctx, "Count", func(ctx context.Context, query *RelationshipQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -221,7 +243,68 @@ func (rq *RelationshipQuery) Exist(ctx context.Context) (bool, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return rq.sqlExist(ctx)
+	v, err := rq.intercept(ctx, "Exist", func(ctx context.Context, query *RelationshipQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (rq *RelationshipQuery) intercept(ctx context.Context, op string, fn func(context.Context, *RelationshipQuery) (Value, error)) (Value, error) {
+	if len(rq.inters) == 0 {
+		return fn(ctx, rq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeRelationship,
+		Op:     op,
+		Limit:  rq.limit,
+		Offset: rq.offset,
+		Unique: rq.unique,
+		Fields: rq.fields,
+	}
+	for _, p := range rq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range rq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if rq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, relationship.EdgeUser)
+		qc.EdgeQueries[relationship.EdgeUser] = rq.withUser
+	}
+	if rq.withRelative != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, relationship.EdgeRelative)
+		qc.EdgeQueries[relationship.EdgeRelative] = rq.withRelative
+	}
+	if rq.withInfo != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, relationship.EdgeInfo)
+		qc.EdgeQueries[relationship.EdgeInfo] = rq.withInfo
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*RelationshipQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *RelationshipQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, rq, qc, qr, rq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -244,6 +327,7 @@ func (rq *RelationshipQuery) Clone() *RelationshipQuery {
 		limit:        rq.limit,
 		offset:       rq.offset,
 		order:        append([]OrderFunc{}, rq.order...),
+		inters:       append([]Interceptor{}, rq.inters...),
 		predicates:   append([]predicate.Relationship{}, rq.predicates...),
 		withUser:     rq.withUser.Clone(),
 		withRelative: rq.withRelative.Clone(),
@@ -258,7 +342,7 @@ func (rq *RelationshipQuery) Clone() *RelationshipQuery {
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (rq *RelationshipQuery) WithUser(opts ...func(*UserQuery)) *RelationshipQuery {
-	query := &UserQuery{config: rq.config}
+	query := (&UserClient{config: rq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -269,7 +353,7 @@ func (rq *RelationshipQuery) WithUser(opts ...func(*UserQuery)) *RelationshipQue
 // WithRelative tells the query-builder to eager-load the nodes that are connected to
 // the "relative" edge. The optional arguments are used to configure the query builder of the edge.
 func (rq *RelationshipQuery) WithRelative(opts ...func(*UserQuery)) *RelationshipQuery {
-	query := &UserQuery{config: rq.config}
+	query := (&UserClient{config: rq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -280,7 +364,7 @@ func (rq *RelationshipQuery) WithRelative(opts ...func(*UserQuery)) *Relationshi
 // WithInfo tells the query-builder to eager-load the nodes that are connected to
 // the "info" edge. The optional arguments are used to configure the query builder of the edge.
 func (rq *RelationshipQuery) WithInfo(opts ...func(*RelationshipInfoQuery)) *RelationshipQuery {
-	query := &RelationshipInfoQuery{config: rq.config}
+	query := (&RelationshipInfoClient{config: rq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -344,6 +428,16 @@ func (rq *RelationshipQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range rq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rq); err != nil {
+				return err
+			}
+		}
+	}
 	if rq.path != nil {
 		prev, err := rq.path(ctx)
 		if err != nil {
@@ -376,7 +470,7 @@ func (rq *RelationshipQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, rq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -486,7 +580,7 @@ func (rq *RelationshipQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rq.querySpec()
 	_spec.Unique = false
 	_spec.Node.Columns = nil
-	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, rq.queryDriver(), _spec)
 }
 
 func (rq *RelationshipQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -570,6 +664,32 @@ func (rq *RelationshipQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (rq *RelationshipQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: relationship.Label}
+	if len(rq.predicates) > 0 {
+		selector := sql.Dialect(rq.driver.Dialect()).Select().From(sql.Table(relationship.Table))
+		for _, p := range rq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the RelationshipQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (rq *RelationshipQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Relationship, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Relationship(ps[i]))
+	}
+	rq.predicates = append(rq.predicates, wps...)
+}
+
 // RelationshipGroupBy is the group-by builder for Relationship entities.
 type RelationshipGroupBy struct {
 	config
@@ -609,7 +729,7 @@ func (rgb *RelationshipGroupBy) sqlScan(ctx context.Context, v interface{}) erro
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := rgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -655,7 +775,7 @@ func (rs *RelationshipSelect) Scan(ctx context.Context, v interface{}) error {
 func (rs *RelationshipSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := rs.sql.Query()
-	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -54,6 +54,25 @@ func (ru *RelationshipUpdate) AddWeight(i int) *RelationshipUpdate {
 	return ru
 }
 
+// SetWeightIfGreater sets the "weight" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ru *RelationshipUpdate) SetWeightIfGreater(i int) *RelationshipUpdate {
+	ru.mutation.SetWeightIfGreater(i)
+	return ru
+}
+
+// BitAndWeight applies a bitwise AND with i on the "weight" field in the database.
+func (ru *RelationshipUpdate) BitAndWeight(i int) *RelationshipUpdate {
+	ru.mutation.BitAndWeight(i)
+	return ru
+}
+
+// BitOrWeight applies a bitwise OR with i on the "weight" field in the database.
+func (ru *RelationshipUpdate) BitOrWeight(i int) *RelationshipUpdate {
+	ru.mutation.BitOrWeight(i)
+	return ru
+}
+
 // SetUserID sets the "user_id" field.
 func (ru *RelationshipUpdate) SetUserID(i int) *RelationshipUpdate {
 	ru.mutation.SetUserID(i)
@@ -233,6 +252,27 @@ func (ru *RelationshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: relationship.FieldWeight,
 		})
 	}
+	if value, ok := ru.mutation.WeightIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: relationship.FieldWeight,
+		})
+	}
+	if value, ok := ru.mutation.WeightBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: relationship.FieldWeight,
+		})
+	}
+	if value, ok := ru.mutation.WeightBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: relationship.FieldWeight,
+		})
+	}
 	if ru.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -340,7 +380,7 @@ func (ru *RelationshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{relationship.Label}
+			err = &NotFoundError{label: relationship.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -352,9 +392,18 @@ func (ru *RelationshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // RelationshipUpdateOne is the builder for updating a single Relationship entity.
 type RelationshipUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *RelationshipMutation
+	fields     []string
+	conditions []predicate.Relationship
+	hooks      []Hook
+	mutation   *RelationshipMutation
+}
+
+// Where appends a list of conditions to the RelationshipUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ruo *RelationshipUpdateOne) Where(ps ...predicate.Relationship) *RelationshipUpdateOne {
+	ruo.conditions = append(ruo.conditions, ps...)
+	return ruo
 }
 
 // SetWeight sets the "weight" field.
@@ -378,6 +427,25 @@ func (ruo *RelationshipUpdateOne) AddWeight(i int) *RelationshipUpdateOne {
 	return ruo
 }
 
+// SetWeightIfGreater sets the "weight" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ruo *RelationshipUpdateOne) SetWeightIfGreater(i int) *RelationshipUpdateOne {
+	ruo.mutation.SetWeightIfGreater(i)
+	return ruo
+}
+
+// BitAndWeight applies a bitwise AND with i on the "weight" field in the database.
+func (ruo *RelationshipUpdateOne) BitAndWeight(i int) *RelationshipUpdateOne {
+	ruo.mutation.BitAndWeight(i)
+	return ruo
+}
+
+// BitOrWeight applies a bitwise OR with i on the "weight" field in the database.
+func (ruo *RelationshipUpdateOne) BitOrWeight(i int) *RelationshipUpdateOne {
+	ruo.mutation.BitOrWeight(i)
+	return ruo
+}
+
 // SetUserID sets the "user_id" field.
 func (ruo *RelationshipUpdateOne) SetUserID(i int) *RelationshipUpdateOne {
 	ruo.mutation.SetUserID(i)
@@ -575,6 +643,13 @@ func (ruo *RelationshipUpdateOne) sqlSave(ctx context.Context) (_node *Relations
 			}
 		}
 	}
+	if ps := ruo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := ruo.mutation.Weight(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -589,6 +664,27 @@ func (ruo *RelationshipUpdateOne) sqlSave(ctx context.Context) (_node *Relations
 			Column: relationship.FieldWeight,
 		})
 	}
+	if value, ok := ruo.mutation.WeightIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: relationship.FieldWeight,
+		})
+	}
+	if value, ok := ruo.mutation.WeightBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: relationship.FieldWeight,
+		})
+	}
+	if value, ok := ruo.mutation.WeightBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: relationship.FieldWeight,
+		})
+	}
 	if ruo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -699,7 +795,9 @@ func (ruo *RelationshipUpdateOne) sqlSave(ctx context.Context) (_node *Relations
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{relationship.Label}
+			err = &NotFoundError{label: relationship.Label}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: relationship.Label, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
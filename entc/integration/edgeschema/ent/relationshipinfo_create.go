@@ -192,6 +192,7 @@ type (
 	//  one RelationshipInfo node.
 	RelationshipInfoUpsertOne struct {
 		create *RelationshipInfoCreate
+		err    error // first validation error of the update values.
 	}
 
 	// RelationshipInfoUpsert is the "OnConflict" setter.
@@ -270,6 +271,9 @@ func (u *RelationshipInfoUpsertOne) UpdateText() *RelationshipInfoUpsertOne {
 
 // Exec executes the query.
 func (u *RelationshipInfoUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for RelationshipInfoCreate.OnConflict")
 	}
@@ -278,13 +282,16 @@ func (u *RelationshipInfoUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *RelationshipInfoUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *RelationshipInfoUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -427,6 +434,7 @@ func (ricb *RelationshipInfoCreateBulk) OnConflictColumns(columns ...string) *Re
 // a bulk of RelationshipInfo nodes.
 type RelationshipInfoUpsertBulk struct {
 	create *RelationshipInfoCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -487,6 +495,9 @@ func (u *RelationshipInfoUpsertBulk) UpdateText() *RelationshipInfoUpsertBulk {
 
 // Exec executes the query.
 func (u *RelationshipInfoUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RelationshipInfoCreateBulk instead", i)
@@ -500,7 +511,7 @@ func (u *RelationshipInfoUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *RelationshipInfoUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
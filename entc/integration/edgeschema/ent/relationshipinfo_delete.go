@@ -107,7 +107,7 @@ func (rido *RelationshipInfoDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{relationshipinfo.Label}
+		return &NotFoundError{label: relationshipinfo.Label}
 	default:
 		return nil
 	}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/predicate"
@@ -26,6 +27,7 @@ type RelationshipInfoQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.RelationshipInfo
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -71,7 +73,7 @@ func (riq *RelationshipInfoQuery) First(ctx context.Context) (*RelationshipInfo,
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{relationshipinfo.Label}
+		return nil, riq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -93,7 +95,7 @@ func (riq *RelationshipInfoQuery) FirstID(ctx context.Context) (id int, err erro
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{relationshipinfo.Label}
+		err = riq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -120,7 +122,7 @@ func (riq *RelationshipInfoQuery) Only(ctx context.Context) (*RelationshipInfo,
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{relationshipinfo.Label}
+		return nil, riq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{relationshipinfo.Label}
 	}
@@ -147,7 +149,7 @@ func (riq *RelationshipInfoQuery) OnlyID(ctx context.Context) (id int, err error
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{relationshipinfo.Label}
+		err = riq.sqlNotFound()
 	default:
 		err = &NotSingularError{relationshipinfo.Label}
 	}
@@ -168,7 +170,17 @@ func (riq *RelationshipInfoQuery) All(ctx context.Context) ([]*RelationshipInfo,
 	if err := riq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return riq.sqlAll(ctx)
+	v, err := riq.intercept(ctx, "All", func(ctx context.Context, query *RelationshipInfoQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*RelationshipInfo)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*RelationshipInfo", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -182,10 +194,20 @@ func (riq *RelationshipInfoQuery) AllX(ctx context.Context) []*RelationshipInfo
 
 // IDs executes the query and returns a list of RelationshipInfo IDs.
 func (riq *RelationshipInfoQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := riq.Select(relationshipinfo.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := riq.intercept(ctx, "IDs", func(ctx context.Context, query *RelationshipInfoQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(relationshipinfo.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -203,7 +225,17 @@ func (riq *RelationshipInfoQuery) Count(ctx context.Context) (int, error) {
 	if err := riq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return riq.sqlCount(ctx)
+	v, err := riq.intercept(ctx, "Count", func(ctx context.Context, query *RelationshipInfoQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -220,7 +252,47 @@ func (riq *RelationshipInfoQuery) Exist(ctx context.Context) (bool, error) {
 	if err := riq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return riq.sqlExist(ctx)
+	v, err := riq.intercept(ctx, "Exist", func(ctx context.Context, query *RelationshipInfoQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (riq *RelationshipInfoQuery) intercept(ctx context.Context, op string, fn func(context.Context, *RelationshipInfoQuery) (Value, error)) (Value, error) {
+	if len(riq.inters) == 0 {
+		return fn(ctx, riq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeRelationshipInfo,
+		Op:     op,
+		Limit:  riq.limit,
+		Offset: riq.offset,
+		Unique: riq.unique,
+		Fields: riq.fields,
+	}
+	for _, p := range riq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range riq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*RelationshipInfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *RelationshipInfoQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, riq, qc, qr, riq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -243,6 +315,7 @@ func (riq *RelationshipInfoQuery) Clone() *RelationshipInfoQuery {
 		limit:      riq.limit,
 		offset:     riq.offset,
 		order:      append([]OrderFunc{}, riq.order...),
+		inters:     append([]Interceptor{}, riq.inters...),
 		predicates: append([]predicate.RelationshipInfo{}, riq.predicates...),
 		// clone intermediate query.
 		sql:    riq.sql.Clone(),
@@ -307,6 +380,16 @@ func (riq *RelationshipInfoQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range riq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, riq); err != nil {
+				return err
+			}
+		}
+	}
 	if riq.path != nil {
 		prev, err := riq.path(ctx)
 		if err != nil {
@@ -333,7 +416,7 @@ func (riq *RelationshipInfoQuery) sqlAll(ctx context.Context, hooks ...queryHook
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, riq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, riq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -348,7 +431,7 @@ func (riq *RelationshipInfoQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(riq.fields) > 0 {
 		_spec.Unique = riq.unique != nil && *riq.unique
 	}
-	return sqlgraph.CountNodes(ctx, riq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, riq.queryDriver(), _spec)
 }
 
 func (riq *RelationshipInfoQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -439,6 +522,32 @@ func (riq *RelationshipInfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (riq *RelationshipInfoQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: relationshipinfo.Label}
+	if len(riq.predicates) > 0 {
+		selector := sql.Dialect(riq.driver.Dialect()).Select().From(sql.Table(relationshipinfo.Table))
+		for _, p := range riq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the RelationshipInfoQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (riq *RelationshipInfoQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.RelationshipInfo, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.RelationshipInfo(ps[i]))
+	}
+	riq.predicates = append(riq.predicates, wps...)
+}
+
 // RelationshipInfoGroupBy is the group-by builder for RelationshipInfo entities.
 type RelationshipInfoGroupBy struct {
 	config
@@ -478,7 +587,7 @@ func (rigb *RelationshipInfoGroupBy) sqlScan(ctx context.Context, v interface{})
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := rigb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rigb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -524,7 +633,7 @@ func (ris *RelationshipInfoSelect) Scan(ctx context.Context, v interface{}) erro
 func (ris *RelationshipInfoSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ris.sql.Query()
-	if err := ris.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ris.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
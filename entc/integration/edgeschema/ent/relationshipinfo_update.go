@@ -123,7 +123,7 @@ func (riu *RelationshipInfoUpdate) sqlSave(ctx context.Context) (n int, err erro
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, riu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{relationshipinfo.Label}
+			err = &NotFoundError{label: relationshipinfo.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -135,9 +135,18 @@ func (riu *RelationshipInfoUpdate) sqlSave(ctx context.Context) (n int, err erro
 // RelationshipInfoUpdateOne is the builder for updating a single RelationshipInfo entity.
 type RelationshipInfoUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *RelationshipInfoMutation
+	fields     []string
+	conditions []predicate.RelationshipInfo
+	hooks      []Hook
+	mutation   *RelationshipInfoMutation
+}
+
+// Where appends a list of conditions to the RelationshipInfoUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (riuo *RelationshipInfoUpdateOne) Where(ps ...predicate.RelationshipInfo) *RelationshipInfoUpdateOne {
+	riuo.conditions = append(riuo.conditions, ps...)
+	return riuo
 }
 
 // SetText sets the "text" field.
@@ -253,6 +262,13 @@ func (riuo *RelationshipInfoUpdateOne) sqlSave(ctx context.Context) (_node *Rela
 			}
 		}
 	}
+	if ps := riuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := riuo.mutation.Text(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -265,7 +281,9 @@ func (riuo *RelationshipInfoUpdateOne) sqlSave(ctx context.Context) (_node *Rela
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, riuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{relationshipinfo.Label}
+			err = &NotFoundError{label: relationshipinfo.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: relationshipinfo.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -22,9 +22,10 @@ import (
 // RoleCreate is the builder for creating a Role entity.
 type RoleCreate struct {
 	config
-	mutation *RoleMutation
-	hooks    []Hook
-	conflict []sql.ConflictOption
+	mutation    *RoleMutation
+	hooks       []Hook
+	userThrough func(*RoleUserCreate)
+	conflict    []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -62,6 +63,20 @@ func (rc *RoleCreate) AddUser(u ...*User) *RoleCreate {
 	return rc.AddUserIDs(ids...)
 }
 
+// SetUserThrough sets a function that configures the RoleUser entities (i.e. the rows of the edge schema)
+// that are created for the "user" edges added by the builder. For example, for setting their fields:
+//
+//	SetUserThrough(func(c *RoleUserCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the RoleUser type are not executed on these entities.
+func (rc *RoleCreate) SetUserThrough(fn func(*RoleUserCreate)) *RoleCreate {
+	rc.userThrough = fn
+	return rc
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (rc *RoleCreate) Mutation() *RoleMutation {
 	return rc.mutation
@@ -140,7 +155,7 @@ func (rc *RoleCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (rc *RoleCreate) defaults() {
 	if _, ok := rc.mutation.CreatedAt(); !ok {
-		v := role.DefaultCreatedAt()
+		v := rc.config.now(role.DefaultCreatedAt)
 		rc.mutation.SetCreatedAt(v)
 	}
 }
@@ -215,6 +230,11 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &RoleUserCreate{config: rc.config, mutation: newRoleUserMutation(rc.config, OpCreate)}
+		if rc.userThrough != nil {
+			rc.userThrough(createE)
+			createE.mutation.ResetRoleID()
+			createE.mutation.ResetUserID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -266,6 +286,7 @@ type (
 	//  one Role node.
 	RoleUpsertOne struct {
 		create *RoleCreate
+		err    error // first validation error of the update values.
 	}
 
 	// RoleUpsert is the "OnConflict" setter.
@@ -370,6 +391,9 @@ func (u *RoleUpsertOne) UpdateCreatedAt() *RoleUpsertOne {
 
 // Exec executes the query.
 func (u *RoleUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for RoleCreate.OnConflict")
 	}
@@ -378,13 +402,16 @@ func (u *RoleUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *RoleUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *RoleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -528,6 +555,7 @@ func (rcb *RoleCreateBulk) OnConflictColumns(columns ...string) *RoleUpsertBulk
 // a bulk of Role nodes.
 type RoleUpsertBulk struct {
 	create *RoleCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -602,6 +630,9 @@ func (u *RoleUpsertBulk) UpdateCreatedAt() *RoleUpsertBulk {
 
 // Exec executes the query.
 func (u *RoleUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RoleCreateBulk instead", i)
@@ -615,7 +646,7 @@ func (u *RoleUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *RoleUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
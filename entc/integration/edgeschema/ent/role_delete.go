@@ -107,7 +107,7 @@ func (rdo *RoleDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{role.Label}
+		return &NotFoundError{label: role.Label}
 	default:
 		return nil
 	}
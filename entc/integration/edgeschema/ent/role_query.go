@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/predicate"
@@ -29,6 +30,7 @@ type RoleQuery struct {
 	unique         *bool
 	order          []OrderFunc
 	fields         []string
+	inters         []Interceptor
 	predicates     []predicate.Role
 	withUser       *UserQuery
 	withRolesUsers *RoleUserQuery
@@ -70,7 +72,7 @@ func (rq *RoleQuery) Order(o ...OrderFunc) *RoleQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (rq *RoleQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: rq.config}
+	query := (&UserClient{config: rq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := rq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -92,7 +94,7 @@ func (rq *RoleQuery) QueryUser() *UserQuery {
 
 // QueryRolesUsers chains the current query on the "roles_users" edge.
 func (rq *RoleQuery) QueryRolesUsers() *RoleUserQuery {
-	query := &RoleUserQuery{config: rq.config}
+	query := (&RoleUserClient{config: rq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := rq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -120,7 +122,7 @@ func (rq *RoleQuery) First(ctx context.Context) (*Role, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{role.Label}
+		return nil, rq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -142,7 +144,7 @@ func (rq *RoleQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{role.Label}
+		err = rq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -169,7 +171,7 @@ func (rq *RoleQuery) Only(ctx context.Context) (*Role, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{role.Label}
+		return nil, rq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{role.Label}
 	}
@@ -196,7 +198,7 @@ func (rq *RoleQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{role.Label}
+		err = rq.sqlNotFound()
 	default:
 		err = &NotSingularError{role.Label}
 	}
@@ -217,7 +219,17 @@ func (rq *RoleQuery) All(ctx context.Context) ([]*Role, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return rq.sqlAll(ctx)
+	v, err := rq.intercept(ctx, "All", func(ctx context.Context, query *RoleQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Role)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Role", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -231,10 +243,20 @@ func (rq *RoleQuery) AllX(ctx context.Context) []*Role {
 
 // IDs executes the query and returns a list of Role IDs.
 func (rq *RoleQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := rq.Select(role.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := rq.intercept(ctx, "IDs", func(ctx context.Context, query *RoleQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(role.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -252,7 +274,17 @@ func (rq *RoleQuery) Count(ctx context.Context) (int, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return rq.sqlCount(ctx)
+	v, err := rq.intercept(ctx, "Count", func(ctx context.Context, query *RoleQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -269,7 +301,61 @@ func (rq *RoleQuery) Exist(ctx context.Context) (bool, error) {
 	if err := rq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return rq.sqlExist(ctx)
+	v, err := rq.intercept(ctx, "Exist", func(ctx context.Context, query *RoleQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (rq *RoleQuery) intercept(ctx context.Context, op string, fn func(context.Context, *RoleQuery) (Value, error)) (Value, error) {
+	if len(rq.inters) == 0 {
+		return fn(ctx, rq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeRole,
+		Op:     op,
+		Limit:  rq.limit,
+		Offset: rq.offset,
+		Unique: rq.unique,
+		Fields: rq.fields,
+	}
+	for _, p := range rq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range rq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if rq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, role.EdgeUser)
+		qc.EdgeQueries[role.EdgeUser] = rq.withUser
+	}
+	if rq.withRolesUsers != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, role.EdgeRolesUsers)
+		qc.EdgeQueries[role.EdgeRolesUsers] = rq.withRolesUsers
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*RoleQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *RoleQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, rq, qc, qr, rq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -292,6 +378,7 @@ func (rq *RoleQuery) Clone() *RoleQuery {
 		limit:          rq.limit,
 		offset:         rq.offset,
 		order:          append([]OrderFunc{}, rq.order...),
+		inters:         append([]Interceptor{}, rq.inters...),
 		predicates:     append([]predicate.Role{}, rq.predicates...),
 		withUser:       rq.withUser.Clone(),
 		withRolesUsers: rq.withRolesUsers.Clone(),
@@ -305,7 +392,7 @@ func (rq *RoleQuery) Clone() *RoleQuery {
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (rq *RoleQuery) WithUser(opts ...func(*UserQuery)) *RoleQuery {
-	query := &UserQuery{config: rq.config}
+	query := (&UserClient{config: rq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -316,7 +403,7 @@ func (rq *RoleQuery) WithUser(opts ...func(*UserQuery)) *RoleQuery {
 // WithRolesUsers tells the query-builder to eager-load the nodes that are connected to
 // the "roles_users" edge. The optional arguments are used to configure the query builder of the edge.
 func (rq *RoleQuery) WithRolesUsers(opts ...func(*RoleUserQuery)) *RoleQuery {
-	query := &RoleUserQuery{config: rq.config}
+	query := (&RoleUserClient{config: rq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -380,6 +467,16 @@ func (rq *RoleQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range rq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rq); err != nil {
+				return err
+			}
+		}
+	}
 	if rq.path != nil {
 		prev, err := rq.path(ctx)
 		if err != nil {
@@ -411,7 +508,7 @@ func (rq *RoleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Role, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, rq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -523,7 +620,7 @@ func (rq *RoleQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(rq.fields) > 0 {
 		_spec.Unique = rq.unique != nil && *rq.unique
 	}
-	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, rq.queryDriver(), _spec)
 }
 
 func (rq *RoleQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -614,6 +711,32 @@ func (rq *RoleQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (rq *RoleQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: role.Label}
+	if len(rq.predicates) > 0 {
+		selector := sql.Dialect(rq.driver.Dialect()).Select().From(sql.Table(role.Table))
+		for _, p := range rq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the RoleQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (rq *RoleQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Role, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Role(ps[i]))
+	}
+	rq.predicates = append(rq.predicates, wps...)
+}
+
 // RoleGroupBy is the group-by builder for Role entities.
 type RoleGroupBy struct {
 	config
@@ -653,7 +776,7 @@ func (rgb *RoleGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := rgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -699,7 +822,7 @@ func (rs *RoleSelect) Scan(ctx context.Context, v interface{}) error {
 func (rs *RoleSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := rs.sql.Query()
-	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -23,8 +23,9 @@ import (
 // RoleUpdate is the builder for updating Role entities.
 type RoleUpdate struct {
 	config
-	hooks    []Hook
-	mutation *RoleMutation
+	hooks       []Hook
+	mutation    *RoleMutation
+	userThrough func(*RoleUserCreate)
 }
 
 // Where appends a list predicates to the RoleUpdate builder.
@@ -68,6 +69,20 @@ func (ru *RoleUpdate) AddUser(u ...*User) *RoleUpdate {
 	return ru.AddUserIDs(ids...)
 }
 
+// SetUserThrough sets a function that configures the RoleUser entities (i.e. the rows of the edge schema)
+// that are created for the "user" edges added by the builder. For example, for setting their fields:
+//
+//	SetUserThrough(func(c *RoleUserCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the RoleUser type are not executed on these entities.
+func (ru *RoleUpdate) SetUserThrough(fn func(*RoleUserCreate)) *RoleUpdate {
+	ru.userThrough = fn
+	return ru
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ru *RoleUpdate) Mutation() *RoleMutation {
 	return ru.mutation
@@ -241,6 +256,11 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &RoleUserCreate{config: ru.config, mutation: newRoleUserMutation(ru.config, OpCreate)}
+		if ru.userThrough != nil {
+			ru.userThrough(createE)
+			createE.mutation.ResetRoleID()
+			createE.mutation.ResetUserID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -248,7 +268,7 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{role.Label}
+			err = &NotFoundError{label: role.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -260,9 +280,19 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // RoleUpdateOne is the builder for updating a single Role entity.
 type RoleUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *RoleMutation
+	fields      []string
+	conditions  []predicate.Role
+	hooks       []Hook
+	mutation    *RoleMutation
+	userThrough func(*RoleUserCreate)
+}
+
+// Where appends a list of conditions to the RoleUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ruo *RoleUpdateOne) Where(ps ...predicate.Role) *RoleUpdateOne {
+	ruo.conditions = append(ruo.conditions, ps...)
+	return ruo
 }
 
 // SetName sets the "name" field.
@@ -300,6 +330,20 @@ func (ruo *RoleUpdateOne) AddUser(u ...*User) *RoleUpdateOne {
 	return ruo.AddUserIDs(ids...)
 }
 
+// SetUserThrough sets a function that configures the RoleUser entities (i.e. the rows of the edge schema)
+// that are created for the "user" edges added by the builder. For example, for setting their fields:
+//
+//	SetUserThrough(func(c *RoleUserCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the RoleUser type are not executed on these entities.
+func (ruo *RoleUpdateOne) SetUserThrough(fn func(*RoleUserCreate)) *RoleUpdateOne {
+	ruo.userThrough = fn
+	return ruo
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ruo *RoleUpdateOne) Mutation() *RoleMutation {
 	return ruo.mutation
@@ -428,6 +472,13 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 			}
 		}
 	}
+	if ps := ruo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := ruo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -503,6 +554,11 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &RoleUserCreate{config: ruo.config, mutation: newRoleUserMutation(ruo.config, OpCreate)}
+		if ruo.userThrough != nil {
+			ruo.userThrough(createE)
+			createE.mutation.ResetRoleID()
+			createE.mutation.ResetUserID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -513,7 +569,9 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{role.Label}
+			err = &NotFoundError{label: role.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: role.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -140,7 +140,7 @@ func (ruc *RoleUserCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (ruc *RoleUserCreate) defaults() {
 	if _, ok := ruc.mutation.CreatedAt(); !ok {
-		v := roleuser.DefaultCreatedAt()
+		v := ruc.config.now(roleuser.DefaultCreatedAt)
 		ruc.mutation.SetCreatedAt(v)
 	}
 }
@@ -278,6 +278,7 @@ type (
 	//  one RoleUser node.
 	RoleUserUpsertOne struct {
 		create *RoleUserCreate
+		err    error // first validation error of the update values.
 	}
 
 	// RoleUserUpsert is the "OnConflict" setter.
@@ -408,6 +409,9 @@ func (u *RoleUserUpsertOne) UpdateUserID() *RoleUserUpsertOne {
 
 // Exec executes the query.
 func (u *RoleUserUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for RoleUserCreate.OnConflict")
 	}
@@ -416,7 +420,7 @@ func (u *RoleUserUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *RoleUserUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -543,6 +547,7 @@ func (rucb *RoleUserCreateBulk) OnConflictColumns(columns ...string) *RoleUserUp
 // a bulk of RoleUser nodes.
 type RoleUserUpsertBulk struct {
 	create *RoleUserCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -631,6 +636,9 @@ func (u *RoleUserUpsertBulk) UpdateUserID() *RoleUserUpsertBulk {
 
 // Exec executes the query.
 func (u *RoleUserUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RoleUserCreateBulk instead", i)
@@ -644,7 +652,7 @@ func (u *RoleUserUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *RoleUserUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -102,7 +102,7 @@ func (rudo *RoleUserDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{roleuser.Label}
+		return &NotFoundError{label: roleuser.Label}
 	default:
 		return nil
 	}
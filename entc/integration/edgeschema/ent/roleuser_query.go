@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/predicate"
@@ -27,6 +28,7 @@ type RoleUserQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.RoleUser
 	withRole   *RoleQuery
 	withUser   *UserQuery
@@ -68,7 +70,7 @@ func (ruq *RoleUserQuery) Order(o ...OrderFunc) *RoleUserQuery {
 
 // QueryRole chains the current query on the "role" edge.
 func (ruq *RoleUserQuery) QueryRole() *RoleQuery {
-	query := &RoleQuery{config: ruq.config}
+	query := (&RoleClient{config: ruq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := ruq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -90,7 +92,7 @@ func (ruq *RoleUserQuery) QueryRole() *RoleQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (ruq *RoleUserQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: ruq.config}
+	query := (&UserClient{config: ruq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := ruq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -118,7 +120,7 @@ func (ruq *RoleUserQuery) First(ctx context.Context) (*RoleUser, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{roleuser.Label}
+		return nil, ruq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -144,7 +146,7 @@ func (ruq *RoleUserQuery) Only(ctx context.Context) (*RoleUser, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{roleuser.Label}
+		return nil, ruq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{roleuser.Label}
 	}
@@ -164,7 +166,17 @@ func (ruq *RoleUserQuery) All(ctx context.Context) ([]*RoleUser, error) {
 	if err := ruq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ruq.sqlAll(ctx)
+	v, err := ruq.intercept(ctx, "All", func(ctx context.Context, query *RoleUserQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*RoleUser)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*RoleUser", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -181,7 +193,17 @@ func (ruq *RoleUserQuery) Count(ctx context.Context) (int, error) {
 	if err := ruq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ruq.sqlCount(ctx)
+	v, err := ruq.intercept(ctx, "Count", func(ctx context.Context, query *RoleUserQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -198,7 +220,61 @@ func (ruq *RoleUserQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ruq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ruq.sqlExist(ctx)
+	v, err := ruq.intercept(ctx, "Exist", func(ctx context.Context, query *RoleUserQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (ruq *RoleUserQuery) intercept(ctx context.Context, op string, fn func(context.Context, *RoleUserQuery) (Value, error)) (Value, error) {
+	if len(ruq.inters) == 0 {
+		return fn(ctx, ruq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeRoleUser,
+		Op:     op,
+		Limit:  ruq.limit,
+		Offset: ruq.offset,
+		Unique: ruq.unique,
+		Fields: ruq.fields,
+	}
+	for _, p := range ruq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range ruq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if ruq.withRole != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, roleuser.EdgeRole)
+		qc.EdgeQueries[roleuser.EdgeRole] = ruq.withRole
+	}
+	if ruq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, roleuser.EdgeUser)
+		qc.EdgeQueries[roleuser.EdgeUser] = ruq.withUser
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*RoleUserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *RoleUserQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, ruq, qc, qr, ruq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -221,6 +297,7 @@ func (ruq *RoleUserQuery) Clone() *RoleUserQuery {
 		limit:      ruq.limit,
 		offset:     ruq.offset,
 		order:      append([]OrderFunc{}, ruq.order...),
+		inters:     append([]Interceptor{}, ruq.inters...),
 		predicates: append([]predicate.RoleUser{}, ruq.predicates...),
 		withRole:   ruq.withRole.Clone(),
 		withUser:   ruq.withUser.Clone(),
@@ -234,7 +311,7 @@ func (ruq *RoleUserQuery) Clone() *RoleUserQuery {
 // WithRole tells the query-builder to eager-load the nodes that are connected to
 // the "role" edge. The optional arguments are used to configure the query builder of the edge.
 func (ruq *RoleUserQuery) WithRole(opts ...func(*RoleQuery)) *RoleUserQuery {
-	query := &RoleQuery{config: ruq.config}
+	query := (&RoleClient{config: ruq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -245,7 +322,7 @@ func (ruq *RoleUserQuery) WithRole(opts ...func(*RoleQuery)) *RoleUserQuery {
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (ruq *RoleUserQuery) WithUser(opts ...func(*UserQuery)) *RoleUserQuery {
-	query := &UserQuery{config: ruq.config}
+	query := (&UserClient{config: ruq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -309,6 +386,16 @@ func (ruq *RoleUserQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range ruq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ruq); err != nil {
+				return err
+			}
+		}
+	}
 	if ruq.path != nil {
 		prev, err := ruq.path(ctx)
 		if err != nil {
@@ -340,7 +427,7 @@ func (ruq *RoleUserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ro
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, ruq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, ruq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -418,7 +505,7 @@ func (ruq *RoleUserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ruq.querySpec()
 	_spec.Unique = false
 	_spec.Node.Columns = nil
-	return sqlgraph.CountNodes(ctx, ruq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, ruq.queryDriver(), _spec)
 }
 
 func (ruq *RoleUserQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -502,6 +589,32 @@ func (ruq *RoleUserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (ruq *RoleUserQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: roleuser.Label}
+	if len(ruq.predicates) > 0 {
+		selector := sql.Dialect(ruq.driver.Dialect()).Select().From(sql.Table(roleuser.Table))
+		for _, p := range ruq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the RoleUserQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (ruq *RoleUserQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.RoleUser, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.RoleUser(ps[i]))
+	}
+	ruq.predicates = append(ruq.predicates, wps...)
+}
+
 // RoleUserGroupBy is the group-by builder for RoleUser entities.
 type RoleUserGroupBy struct {
 	config
@@ -541,7 +654,7 @@ func (rugb *RoleUserGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := rugb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rugb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -587,7 +700,7 @@ func (rus *RoleUserSelect) Scan(ctx context.Context, v interface{}) error {
 func (rus *RoleUserSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := rus.sql.Query()
-	if err := rus.driver.Query(ctx, query, args, rows); err != nil {
+	if err := rus.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
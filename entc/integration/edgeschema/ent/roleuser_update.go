@@ -261,7 +261,7 @@ func (ruu *RoleUserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ruu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{roleuser.Label}
+			err = &NotFoundError{label: roleuser.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -273,9 +273,18 @@ func (ruu *RoleUserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // RoleUserUpdateOne is the builder for updating a single RoleUser entity.
 type RoleUserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *RoleUserMutation
+	fields     []string
+	conditions []predicate.RoleUser
+	hooks      []Hook
+	mutation   *RoleUserMutation
+}
+
+// Where appends a list of conditions to the RoleUserUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ruuo *RoleUserUpdateOne) Where(ps ...predicate.RoleUser) *RoleUserUpdateOne {
+	ruuo.conditions = append(ruuo.conditions, ps...)
+	return ruuo
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -458,6 +467,13 @@ func (ruuo *RoleUserUpdateOne) sqlSave(ctx context.Context) (_node *RoleUser, er
 			}
 		}
 	}
+	if ps := ruuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := ruuo.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -540,7 +556,9 @@ func (ruuo *RoleUserUpdateOne) sqlSave(ctx context.Context) (_node *RoleUser, er
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, ruuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{roleuser.Label}
+			err = &NotFoundError{label: roleuser.Label}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: roleuser.Label, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
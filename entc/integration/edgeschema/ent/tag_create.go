@@ -23,9 +23,10 @@ import (
 // TagCreate is the builder for creating a Tag entity.
 type TagCreate struct {
 	config
-	mutation *TagMutation
-	hooks    []Hook
-	conflict []sql.ConflictOption
+	mutation      *TagMutation
+	hooks         []Hook
+	tweetsThrough func(*TweetTagCreate)
+	conflict      []sql.ConflictOption
 }
 
 // SetValue sets the "value" field.
@@ -49,6 +50,20 @@ func (tc *TagCreate) AddTweets(t ...*Tweet) *TagCreate {
 	return tc.AddTweetIDs(ids...)
 }
 
+// SetTweetsThrough sets a function that configures the TweetTag entities (i.e. the rows of the edge schema)
+// that are created for the "tweets" edges added by the builder. For example, for setting their fields:
+//
+//	SetTweetsThrough(func(c *TweetTagCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tweet entities are set by the builder,
+// and the hooks and validators of the TweetTag type are not executed on these entities.
+func (tc *TagCreate) SetTweetsThrough(fn func(*TweetTagCreate)) *TagCreate {
+	tc.tweetsThrough = fn
+	return tc
+}
+
 // AddTweetTagIDs adds the "tweet_tags" edge to the TweetTag entity by IDs.
 func (tc *TagCreate) AddTweetTagIDs(ids ...uuid.UUID) *TagCreate {
 	tc.mutation.AddTweetTagIDs(ids...)
@@ -197,6 +212,11 @@ func (tc *TagCreate) createSpec() (*Tag, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetTagCreate{config: tc.config, mutation: newTweetTagMutation(tc.config, OpCreate)}
+		if tc.tweetsThrough != nil {
+			tc.tweetsThrough(createE)
+			createE.mutation.ResetTagID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -270,6 +290,7 @@ type (
 	//  one Tag node.
 	TagUpsertOne struct {
 		create *TagCreate
+		err    error // first validation error of the update values.
 	}
 
 	// TagUpsert is the "OnConflict" setter.
@@ -348,6 +369,9 @@ func (u *TagUpsertOne) UpdateValue() *TagUpsertOne {
 
 // Exec executes the query.
 func (u *TagUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for TagCreate.OnConflict")
 	}
@@ -356,13 +380,16 @@ func (u *TagUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TagUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *TagUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -505,6 +532,7 @@ func (tcb *TagCreateBulk) OnConflictColumns(columns ...string) *TagUpsertBulk {
 // a bulk of Tag nodes.
 type TagUpsertBulk struct {
 	create *TagCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -565,6 +593,9 @@ func (u *TagUpsertBulk) UpdateValue() *TagUpsertBulk {
 
 // Exec executes the query.
 func (u *TagUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TagCreateBulk instead", i)
@@ -578,7 +609,7 @@ func (u *TagUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TagUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -107,7 +107,7 @@ func (tdo *TagDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{tag.Label}
+		return &NotFoundError{label: tag.Label}
 	default:
 		return nil
 	}
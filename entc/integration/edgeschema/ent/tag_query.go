@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/predicate"
@@ -29,6 +30,7 @@ type TagQuery struct {
 	unique        *bool
 	order         []OrderFunc
 	fields        []string
+	inters        []Interceptor
 	predicates    []predicate.Tag
 	withTweets    *TweetQuery
 	withTweetTags *TweetTagQuery
@@ -70,7 +72,7 @@ func (tq *TagQuery) Order(o ...OrderFunc) *TagQuery {
 
 // QueryTweets chains the current query on the "tweets" edge.
 func (tq *TagQuery) QueryTweets() *TweetQuery {
-	query := &TweetQuery{config: tq.config}
+	query := (&TweetClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -92,7 +94,7 @@ func (tq *TagQuery) QueryTweets() *TweetQuery {
 
 // QueryTweetTags chains the current query on the "tweet_tags" edge.
 func (tq *TagQuery) QueryTweetTags() *TweetTagQuery {
-	query := &TweetTagQuery{config: tq.config}
+	query := (&TweetTagClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -120,7 +122,7 @@ func (tq *TagQuery) First(ctx context.Context) (*Tag, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{tag.Label}
+		return nil, tq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -142,7 +144,7 @@ func (tq *TagQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{tag.Label}
+		err = tq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -169,7 +171,7 @@ func (tq *TagQuery) Only(ctx context.Context) (*Tag, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{tag.Label}
+		return nil, tq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{tag.Label}
 	}
@@ -196,7 +198,7 @@ func (tq *TagQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{tag.Label}
+		err = tq.sqlNotFound()
 	default:
 		err = &NotSingularError{tag.Label}
 	}
@@ -217,7 +219,17 @@ func (tq *TagQuery) All(ctx context.Context) ([]*Tag, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.sqlAll(ctx)
+	v, err := tq.intercept(ctx, "All", func(ctx context.Context, query *TagQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Tag)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Tag", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -231,10 +243,20 @@ func (tq *TagQuery) AllX(ctx context.Context) []*Tag {
 
 // IDs executes the query and returns a list of Tag IDs.
 func (tq *TagQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := tq.Select(tag.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := tq.intercept(ctx, "IDs", func(ctx context.Context, query *TagQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(tag.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -252,7 +274,17 @@ func (tq *TagQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.sqlCount(ctx)
+	v, err := tq.intercept(ctx, "Count", func(ctx context.Context, query *TagQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -269,7 +301,61 @@ func (tq *TagQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.sqlExist(ctx)
+	v, err := tq.intercept(ctx, "Exist", func(ctx context.Context, query *TagQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (tq *TagQuery) intercept(ctx context.Context, op string, fn func(context.Context, *TagQuery) (Value, error)) (Value, error) {
+	if len(tq.inters) == 0 {
+		return fn(ctx, tq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeTag,
+		Op:     op,
+		Limit:  tq.limit,
+		Offset: tq.offset,
+		Unique: tq.unique,
+		Fields: tq.fields,
+	}
+	for _, p := range tq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range tq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if tq.withTweets != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tag.EdgeTweets)
+		qc.EdgeQueries[tag.EdgeTweets] = tq.withTweets
+	}
+	if tq.withTweetTags != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tag.EdgeTweetTags)
+		qc.EdgeQueries[tag.EdgeTweetTags] = tq.withTweetTags
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TagQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *TagQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, tq, qc, qr, tq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -292,6 +378,7 @@ func (tq *TagQuery) Clone() *TagQuery {
 		limit:         tq.limit,
 		offset:        tq.offset,
 		order:         append([]OrderFunc{}, tq.order...),
+		inters:        append([]Interceptor{}, tq.inters...),
 		predicates:    append([]predicate.Tag{}, tq.predicates...),
 		withTweets:    tq.withTweets.Clone(),
 		withTweetTags: tq.withTweetTags.Clone(),
@@ -305,7 +392,7 @@ func (tq *TagQuery) Clone() *TagQuery {
 // WithTweets tells the query-builder to eager-load the nodes that are connected to
 // the "tweets" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TagQuery) WithTweets(opts ...func(*TweetQuery)) *TagQuery {
-	query := &TweetQuery{config: tq.config}
+	query := (&TweetClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -316,7 +403,7 @@ func (tq *TagQuery) WithTweets(opts ...func(*TweetQuery)) *TagQuery {
 // WithTweetTags tells the query-builder to eager-load the nodes that are connected to
 // the "tweet_tags" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TagQuery) WithTweetTags(opts ...func(*TweetTagQuery)) *TagQuery {
-	query := &TweetTagQuery{config: tq.config}
+	query := (&TweetTagClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -380,6 +467,16 @@ func (tq *TagQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range tq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tq); err != nil {
+				return err
+			}
+		}
+	}
 	if tq.path != nil {
 		prev, err := tq.path(ctx)
 		if err != nil {
@@ -411,7 +508,7 @@ func (tq *TagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tag, err
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, tq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, tq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -523,7 +620,7 @@ func (tq *TagQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(tq.fields) > 0 {
 		_spec.Unique = tq.unique != nil && *tq.unique
 	}
-	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, tq.queryDriver(), _spec)
 }
 
 func (tq *TagQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -614,6 +711,32 @@ func (tq *TagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (tq *TagQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: tag.Label}
+	if len(tq.predicates) > 0 {
+		selector := sql.Dialect(tq.driver.Dialect()).Select().From(sql.Table(tag.Table))
+		for _, p := range tq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the TagQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (tq *TagQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Tag, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Tag(ps[i]))
+	}
+	tq.predicates = append(tq.predicates, wps...)
+}
+
 // TagGroupBy is the group-by builder for Tag entities.
 type TagGroupBy struct {
 	config
@@ -653,7 +776,7 @@ func (tgb *TagGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := tgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := tgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -699,7 +822,7 @@ func (ts *TagSelect) Scan(ctx context.Context, v interface{}) error {
 func (ts *TagSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ts.sql.Query()
-	if err := ts.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ts.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -24,8 +24,9 @@ import (
 // TagUpdate is the builder for updating Tag entities.
 type TagUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TagMutation
+	hooks         []Hook
+	mutation      *TagMutation
+	tweetsThrough func(*TweetTagCreate)
 }
 
 // Where appends a list predicates to the TagUpdate builder.
@@ -55,6 +56,20 @@ func (tu *TagUpdate) AddTweets(t ...*Tweet) *TagUpdate {
 	return tu.AddTweetIDs(ids...)
 }
 
+// SetTweetsThrough sets a function that configures the TweetTag entities (i.e. the rows of the edge schema)
+// that are created for the "tweets" edges added by the builder. For example, for setting their fields:
+//
+//	SetTweetsThrough(func(c *TweetTagCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tweet entities are set by the builder,
+// and the hooks and validators of the TweetTag type are not executed on these entities.
+func (tu *TagUpdate) SetTweetsThrough(fn func(*TweetTagCreate)) *TagUpdate {
+	tu.tweetsThrough = fn
+	return tu
+}
+
 // AddTweetTagIDs adds the "tweet_tags" edge to the TweetTag entity by IDs.
 func (tu *TagUpdate) AddTweetTagIDs(ids ...uuid.UUID) *TagUpdate {
 	tu.mutation.AddTweetTagIDs(ids...)
@@ -263,6 +278,11 @@ func (tu *TagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetTagCreate{config: tu.config, mutation: newTweetTagMutation(tu.config, OpCreate)}
+		if tu.tweetsThrough != nil {
+			tu.tweetsThrough(createE)
+			createE.mutation.ResetTagID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -327,7 +347,7 @@ func (tu *TagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tag.Label}
+			err = &NotFoundError{label: tag.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -339,9 +359,19 @@ func (tu *TagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // TagUpdateOne is the builder for updating a single Tag entity.
 type TagUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TagMutation
+	fields        []string
+	conditions    []predicate.Tag
+	hooks         []Hook
+	mutation      *TagMutation
+	tweetsThrough func(*TweetTagCreate)
+}
+
+// Where appends a list of conditions to the TagUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (tuo *TagUpdateOne) Where(ps ...predicate.Tag) *TagUpdateOne {
+	tuo.conditions = append(tuo.conditions, ps...)
+	return tuo
 }
 
 // SetValue sets the "value" field.
@@ -365,6 +395,20 @@ func (tuo *TagUpdateOne) AddTweets(t ...*Tweet) *TagUpdateOne {
 	return tuo.AddTweetIDs(ids...)
 }
 
+// SetTweetsThrough sets a function that configures the TweetTag entities (i.e. the rows of the edge schema)
+// that are created for the "tweets" edges added by the builder. For example, for setting their fields:
+//
+//	SetTweetsThrough(func(c *TweetTagCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tweet entities are set by the builder,
+// and the hooks and validators of the TweetTag type are not executed on these entities.
+func (tuo *TagUpdateOne) SetTweetsThrough(fn func(*TweetTagCreate)) *TagUpdateOne {
+	tuo.tweetsThrough = fn
+	return tuo
+}
+
 // AddTweetTagIDs adds the "tweet_tags" edge to the TweetTag entity by IDs.
 func (tuo *TagUpdateOne) AddTweetTagIDs(ids ...uuid.UUID) *TagUpdateOne {
 	tuo.mutation.AddTweetTagIDs(ids...)
@@ -529,6 +573,13 @@ func (tuo *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 			}
 		}
 	}
+	if ps := tuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := tuo.mutation.Value(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -603,6 +654,11 @@ func (tuo *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetTagCreate{config: tuo.config, mutation: newTweetTagMutation(tuo.config, OpCreate)}
+		if tuo.tweetsThrough != nil {
+			tuo.tweetsThrough(createE)
+			createE.mutation.ResetTagID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -670,7 +726,9 @@ func (tuo *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tag.Label}
+			err = &NotFoundError{label: tag.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: tag.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
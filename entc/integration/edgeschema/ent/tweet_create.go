@@ -25,9 +25,12 @@ import (
 // TweetCreate is the builder for creating a Tweet entity.
 type TweetCreate struct {
 	config
-	mutation *TweetMutation
-	hooks    []Hook
-	conflict []sql.ConflictOption
+	mutation           *TweetMutation
+	hooks              []Hook
+	liked_usersThrough func(*TweetLikeCreate)
+	userThrough        func(*UserTweetCreate)
+	tagsThrough        func(*TweetTagCreate)
+	conflict           []sql.ConflictOption
 }
 
 // SetText sets the "text" field.
@@ -51,6 +54,20 @@ func (tc *TweetCreate) AddLikedUsers(u ...*User) *TweetCreate {
 	return tc.AddLikedUserIDs(ids...)
 }
 
+// SetLikedUsersThrough sets a function that configures the TweetLike entities (i.e. the rows of the edge schema)
+// that are created for the "liked_users" edges added by the builder. For example, for setting their fields:
+//
+//	SetLikedUsersThrough(func(c *TweetLikeCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the TweetLike type are not executed on these entities.
+func (tc *TweetCreate) SetLikedUsersThrough(fn func(*TweetLikeCreate)) *TweetCreate {
+	tc.liked_usersThrough = fn
+	return tc
+}
+
 // AddUserIDs adds the "user" edge to the User entity by IDs.
 func (tc *TweetCreate) AddUserIDs(ids ...int) *TweetCreate {
 	tc.mutation.AddUserIDs(ids...)
@@ -66,6 +83,20 @@ func (tc *TweetCreate) AddUser(u ...*User) *TweetCreate {
 	return tc.AddUserIDs(ids...)
 }
 
+// SetUserThrough sets a function that configures the UserTweet entities (i.e. the rows of the edge schema)
+// that are created for the "user" edges added by the builder. For example, for setting their fields:
+//
+//	SetUserThrough(func(c *UserTweetCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the UserTweet type are not executed on these entities.
+func (tc *TweetCreate) SetUserThrough(fn func(*UserTweetCreate)) *TweetCreate {
+	tc.userThrough = fn
+	return tc
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (tc *TweetCreate) AddTagIDs(ids ...int) *TweetCreate {
 	tc.mutation.AddTagIDs(ids...)
@@ -81,6 +112,20 @@ func (tc *TweetCreate) AddTags(t ...*Tag) *TweetCreate {
 	return tc.AddTagIDs(ids...)
 }
 
+// SetTagsThrough sets a function that configures the TweetTag entities (i.e. the rows of the edge schema)
+// that are created for the "tags" edges added by the builder. For example, for setting their fields:
+//
+//	SetTagsThrough(func(c *TweetTagCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tag entities are set by the builder,
+// and the hooks and validators of the TweetTag type are not executed on these entities.
+func (tc *TweetCreate) SetTagsThrough(fn func(*TweetTagCreate)) *TweetCreate {
+	tc.tagsThrough = fn
+	return tc
+}
+
 // AddTweetUserIDs adds the "tweet_user" edge to the UserTweet entity by IDs.
 func (tc *TweetCreate) AddTweetUserIDs(ids ...int) *TweetCreate {
 	tc.mutation.AddTweetUserIDs(ids...)
@@ -244,6 +289,11 @@ func (tc *TweetCreate) createSpec() (*Tweet, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetLikeCreate{config: tc.config, mutation: newTweetLikeMutation(tc.config, OpCreate)}
+		if tc.liked_usersThrough != nil {
+			tc.liked_usersThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		_ = createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -267,6 +317,11 @@ func (tc *TweetCreate) createSpec() (*Tweet, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserTweetCreate{config: tc.config, mutation: newUserTweetMutation(tc.config, OpCreate)}
+		if tc.userThrough != nil {
+			tc.userThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -290,6 +345,11 @@ func (tc *TweetCreate) createSpec() (*Tweet, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetTagCreate{config: tc.config, mutation: newTweetTagMutation(tc.config, OpCreate)}
+		if tc.tagsThrough != nil {
+			tc.tagsThrough(createE)
+			createE.mutation.ResetTagID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -382,6 +442,7 @@ type (
 	//  one Tweet node.
 	TweetUpsertOne struct {
 		create *TweetCreate
+		err    error // first validation error of the update values.
 	}
 
 	// TweetUpsert is the "OnConflict" setter.
@@ -460,6 +521,9 @@ func (u *TweetUpsertOne) UpdateText() *TweetUpsertOne {
 
 // Exec executes the query.
 func (u *TweetUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for TweetCreate.OnConflict")
 	}
@@ -468,13 +532,16 @@ func (u *TweetUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TweetUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *TweetUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -617,6 +684,7 @@ func (tcb *TweetCreateBulk) OnConflictColumns(columns ...string) *TweetUpsertBul
 // a bulk of Tweet nodes.
 type TweetUpsertBulk struct {
 	create *TweetCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -677,6 +745,9 @@ func (u *TweetUpsertBulk) UpdateText() *TweetUpsertBulk {
 
 // Exec executes the query.
 func (u *TweetUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TweetCreateBulk instead", i)
@@ -690,7 +761,7 @@ func (u *TweetUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TweetUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
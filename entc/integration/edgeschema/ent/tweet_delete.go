@@ -107,7 +107,7 @@ func (tdo *TweetDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{tweet.Label}
+		return &NotFoundError{label: tweet.Label}
 	default:
 		return nil
 	}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/predicate"
@@ -32,6 +33,7 @@ type TweetQuery struct {
 	unique         *bool
 	order          []OrderFunc
 	fields         []string
+	inters         []Interceptor
 	predicates     []predicate.Tweet
 	withLikedUsers *UserQuery
 	withUser       *UserQuery
@@ -77,7 +79,7 @@ func (tq *TweetQuery) Order(o ...OrderFunc) *TweetQuery {
 
 // QueryLikedUsers chains the current query on the "liked_users" edge.
 func (tq *TweetQuery) QueryLikedUsers() *UserQuery {
-	query := &UserQuery{config: tq.config}
+	query := (&UserClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -99,7 +101,7 @@ func (tq *TweetQuery) QueryLikedUsers() *UserQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (tq *TweetQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: tq.config}
+	query := (&UserClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -121,7 +123,7 @@ func (tq *TweetQuery) QueryUser() *UserQuery {
 
 // QueryTags chains the current query on the "tags" edge.
 func (tq *TweetQuery) QueryTags() *TagQuery {
-	query := &TagQuery{config: tq.config}
+	query := (&TagClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -143,7 +145,7 @@ func (tq *TweetQuery) QueryTags() *TagQuery {
 
 // QueryLikes chains the current query on the "likes" edge.
 func (tq *TweetQuery) QueryLikes() *TweetLikeQuery {
-	query := &TweetLikeQuery{config: tq.config}
+	query := (&TweetLikeClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -165,7 +167,7 @@ func (tq *TweetQuery) QueryLikes() *TweetLikeQuery {
 
 // QueryTweetUser chains the current query on the "tweet_user" edge.
 func (tq *TweetQuery) QueryTweetUser() *UserTweetQuery {
-	query := &UserTweetQuery{config: tq.config}
+	query := (&UserTweetClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -187,7 +189,7 @@ func (tq *TweetQuery) QueryTweetUser() *UserTweetQuery {
 
 // QueryTweetTags chains the current query on the "tweet_tags" edge.
 func (tq *TweetQuery) QueryTweetTags() *TweetTagQuery {
-	query := &TweetTagQuery{config: tq.config}
+	query := (&TweetTagClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -215,7 +217,7 @@ func (tq *TweetQuery) First(ctx context.Context) (*Tweet, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{tweet.Label}
+		return nil, tq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -237,7 +239,7 @@ func (tq *TweetQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{tweet.Label}
+		err = tq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -264,7 +266,7 @@ func (tq *TweetQuery) Only(ctx context.Context) (*Tweet, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{tweet.Label}
+		return nil, tq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{tweet.Label}
 	}
@@ -291,7 +293,7 @@ func (tq *TweetQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{tweet.Label}
+		err = tq.sqlNotFound()
 	default:
 		err = &NotSingularError{tweet.Label}
 	}
@@ -312,7 +314,17 @@ func (tq *TweetQuery) All(ctx context.Context) ([]*Tweet, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.sqlAll(ctx)
+	v, err := tq.intercept(ctx, "All", func(ctx context.Context, query *TweetQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Tweet)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Tweet", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -326,10 +338,20 @@ func (tq *TweetQuery) AllX(ctx context.Context) []*Tweet {
 
 // IDs executes the query and returns a list of Tweet IDs.
 func (tq *TweetQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := tq.Select(tweet.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := tq.intercept(ctx, "IDs", func(ctx context.Context, query *TweetQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(tweet.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -347,7 +369,17 @@ func (tq *TweetQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.sqlCount(ctx)
+	v, err := tq.intercept(ctx, "Count", func(ctx context.Context, query *TweetQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -364,7 +396,89 @@ func (tq *TweetQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.sqlExist(ctx)
+	v, err := tq.intercept(ctx, "Exist", func(ctx context.Context, query *TweetQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (tq *TweetQuery) intercept(ctx context.Context, op string, fn func(context.Context, *TweetQuery) (Value, error)) (Value, error) {
+	if len(tq.inters) == 0 {
+		return fn(ctx, tq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeTweet,
+		Op:     op,
+		Limit:  tq.limit,
+		Offset: tq.offset,
+		Unique: tq.unique,
+		Fields: tq.fields,
+	}
+	for _, p := range tq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range tq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if tq.withLikedUsers != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tweet.EdgeLikedUsers)
+		qc.EdgeQueries[tweet.EdgeLikedUsers] = tq.withLikedUsers
+	}
+	if tq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tweet.EdgeUser)
+		qc.EdgeQueries[tweet.EdgeUser] = tq.withUser
+	}
+	if tq.withTags != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tweet.EdgeTags)
+		qc.EdgeQueries[tweet.EdgeTags] = tq.withTags
+	}
+	if tq.withLikes != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tweet.EdgeLikes)
+		qc.EdgeQueries[tweet.EdgeLikes] = tq.withLikes
+	}
+	if tq.withTweetUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tweet.EdgeTweetUser)
+		qc.EdgeQueries[tweet.EdgeTweetUser] = tq.withTweetUser
+	}
+	if tq.withTweetTags != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tweet.EdgeTweetTags)
+		qc.EdgeQueries[tweet.EdgeTweetTags] = tq.withTweetTags
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TweetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *TweetQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, tq, qc, qr, tq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -387,6 +501,7 @@ func (tq *TweetQuery) Clone() *TweetQuery {
 		limit:          tq.limit,
 		offset:         tq.offset,
 		order:          append([]OrderFunc{}, tq.order...),
+		inters:         append([]Interceptor{}, tq.inters...),
 		predicates:     append([]predicate.Tweet{}, tq.predicates...),
 		withLikedUsers: tq.withLikedUsers.Clone(),
 		withUser:       tq.withUser.Clone(),
@@ -404,7 +519,7 @@ func (tq *TweetQuery) Clone() *TweetQuery {
 // WithLikedUsers tells the query-builder to eager-load the nodes that are connected to
 // the "liked_users" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TweetQuery) WithLikedUsers(opts ...func(*UserQuery)) *TweetQuery {
-	query := &UserQuery{config: tq.config}
+	query := (&UserClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -415,7 +530,7 @@ func (tq *TweetQuery) WithLikedUsers(opts ...func(*UserQuery)) *TweetQuery {
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TweetQuery) WithUser(opts ...func(*UserQuery)) *TweetQuery {
-	query := &UserQuery{config: tq.config}
+	query := (&UserClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -426,7 +541,7 @@ func (tq *TweetQuery) WithUser(opts ...func(*UserQuery)) *TweetQuery {
 // WithTags tells the query-builder to eager-load the nodes that are connected to
 // the "tags" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TweetQuery) WithTags(opts ...func(*TagQuery)) *TweetQuery {
-	query := &TagQuery{config: tq.config}
+	query := (&TagClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -437,7 +552,7 @@ func (tq *TweetQuery) WithTags(opts ...func(*TagQuery)) *TweetQuery {
 // WithLikes tells the query-builder to eager-load the nodes that are connected to
 // the "likes" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TweetQuery) WithLikes(opts ...func(*TweetLikeQuery)) *TweetQuery {
-	query := &TweetLikeQuery{config: tq.config}
+	query := (&TweetLikeClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -448,7 +563,7 @@ func (tq *TweetQuery) WithLikes(opts ...func(*TweetLikeQuery)) *TweetQuery {
 // WithTweetUser tells the query-builder to eager-load the nodes that are connected to
 // the "tweet_user" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TweetQuery) WithTweetUser(opts ...func(*UserTweetQuery)) *TweetQuery {
-	query := &UserTweetQuery{config: tq.config}
+	query := (&UserTweetClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -459,7 +574,7 @@ func (tq *TweetQuery) WithTweetUser(opts ...func(*UserTweetQuery)) *TweetQuery {
 // WithTweetTags tells the query-builder to eager-load the nodes that are connected to
 // the "tweet_tags" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TweetQuery) WithTweetTags(opts ...func(*TweetTagQuery)) *TweetQuery {
-	query := &TweetTagQuery{config: tq.config}
+	query := (&TweetTagClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -523,6 +638,16 @@ func (tq *TweetQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range tq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tq); err != nil {
+				return err
+			}
+		}
+	}
 	if tq.path != nil {
 		prev, err := tq.path(ctx)
 		if err != nil {
@@ -558,7 +683,7 @@ func (tq *TweetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tweet,
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, tq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, tq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -862,7 +987,7 @@ func (tq *TweetQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(tq.fields) > 0 {
 		_spec.Unique = tq.unique != nil && *tq.unique
 	}
-	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, tq.queryDriver(), _spec)
 }
 
 func (tq *TweetQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -953,6 +1078,32 @@ func (tq *TweetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (tq *TweetQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: tweet.Label}
+	if len(tq.predicates) > 0 {
+		selector := sql.Dialect(tq.driver.Dialect()).Select().From(sql.Table(tweet.Table))
+		for _, p := range tq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the TweetQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (tq *TweetQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Tweet, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Tweet(ps[i]))
+	}
+	tq.predicates = append(tq.predicates, wps...)
+}
+
 // TweetGroupBy is the group-by builder for Tweet entities.
 type TweetGroupBy struct {
 	config
@@ -992,7 +1143,7 @@ func (tgb *TweetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := tgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := tgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -1038,7 +1189,7 @@ func (ts *TweetSelect) Scan(ctx context.Context, v interface{}) error {
 func (ts *TweetSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ts.sql.Query()
-	if err := ts.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ts.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
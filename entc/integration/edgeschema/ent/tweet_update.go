@@ -26,8 +26,11 @@ import (
 // TweetUpdate is the builder for updating Tweet entities.
 type TweetUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TweetMutation
+	hooks              []Hook
+	mutation           *TweetMutation
+	liked_usersThrough func(*TweetLikeCreate)
+	userThrough        func(*UserTweetCreate)
+	tagsThrough        func(*TweetTagCreate)
 }
 
 // Where appends a list predicates to the TweetUpdate builder.
@@ -57,6 +60,20 @@ func (tu *TweetUpdate) AddLikedUsers(u ...*User) *TweetUpdate {
 	return tu.AddLikedUserIDs(ids...)
 }
 
+// SetLikedUsersThrough sets a function that configures the TweetLike entities (i.e. the rows of the edge schema)
+// that are created for the "liked_users" edges added by the builder. For example, for setting their fields:
+//
+//	SetLikedUsersThrough(func(c *TweetLikeCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the TweetLike type are not executed on these entities.
+func (tu *TweetUpdate) SetLikedUsersThrough(fn func(*TweetLikeCreate)) *TweetUpdate {
+	tu.liked_usersThrough = fn
+	return tu
+}
+
 // AddUserIDs adds the "user" edge to the User entity by IDs.
 func (tu *TweetUpdate) AddUserIDs(ids ...int) *TweetUpdate {
 	tu.mutation.AddUserIDs(ids...)
@@ -72,6 +89,20 @@ func (tu *TweetUpdate) AddUser(u ...*User) *TweetUpdate {
 	return tu.AddUserIDs(ids...)
 }
 
+// SetUserThrough sets a function that configures the UserTweet entities (i.e. the rows of the edge schema)
+// that are created for the "user" edges added by the builder. For example, for setting their fields:
+//
+//	SetUserThrough(func(c *UserTweetCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the UserTweet type are not executed on these entities.
+func (tu *TweetUpdate) SetUserThrough(fn func(*UserTweetCreate)) *TweetUpdate {
+	tu.userThrough = fn
+	return tu
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (tu *TweetUpdate) AddTagIDs(ids ...int) *TweetUpdate {
 	tu.mutation.AddTagIDs(ids...)
@@ -87,6 +118,20 @@ func (tu *TweetUpdate) AddTags(t ...*Tag) *TweetUpdate {
 	return tu.AddTagIDs(ids...)
 }
 
+// SetTagsThrough sets a function that configures the TweetTag entities (i.e. the rows of the edge schema)
+// that are created for the "tags" edges added by the builder. For example, for setting their fields:
+//
+//	SetTagsThrough(func(c *TweetTagCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tag entities are set by the builder,
+// and the hooks and validators of the TweetTag type are not executed on these entities.
+func (tu *TweetUpdate) SetTagsThrough(fn func(*TweetTagCreate)) *TweetUpdate {
+	tu.tagsThrough = fn
+	return tu
+}
+
 // AddTweetUserIDs adds the "tweet_user" edge to the UserTweet entity by IDs.
 func (tu *TweetUpdate) AddTweetUserIDs(ids ...int) *TweetUpdate {
 	tu.mutation.AddTweetUserIDs(ids...)
@@ -367,6 +412,11 @@ func (tu *TweetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetLikeCreate{config: tu.config, mutation: newTweetLikeMutation(tu.config, OpCreate)}
+		if tu.liked_usersThrough != nil {
+			tu.liked_usersThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		_ = createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -433,6 +483,11 @@ func (tu *TweetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserTweetCreate{config: tu.config, mutation: newUserTweetMutation(tu.config, OpCreate)}
+		if tu.userThrough != nil {
+			tu.userThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -505,6 +560,11 @@ func (tu *TweetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetTagCreate{config: tu.config, mutation: newTweetTagMutation(tu.config, OpCreate)}
+		if tu.tagsThrough != nil {
+			tu.tagsThrough(createE)
+			createE.mutation.ResetTagID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -623,7 +683,7 @@ func (tu *TweetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tweet.Label}
+			err = &NotFoundError{label: tweet.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -635,9 +695,21 @@ func (tu *TweetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // TweetUpdateOne is the builder for updating a single Tweet entity.
 type TweetUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TweetMutation
+	fields             []string
+	conditions         []predicate.Tweet
+	hooks              []Hook
+	mutation           *TweetMutation
+	liked_usersThrough func(*TweetLikeCreate)
+	userThrough        func(*UserTweetCreate)
+	tagsThrough        func(*TweetTagCreate)
+}
+
+// Where appends a list of conditions to the TweetUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (tuo *TweetUpdateOne) Where(ps ...predicate.Tweet) *TweetUpdateOne {
+	tuo.conditions = append(tuo.conditions, ps...)
+	return tuo
 }
 
 // SetText sets the "text" field.
@@ -661,6 +733,20 @@ func (tuo *TweetUpdateOne) AddLikedUsers(u ...*User) *TweetUpdateOne {
 	return tuo.AddLikedUserIDs(ids...)
 }
 
+// SetLikedUsersThrough sets a function that configures the TweetLike entities (i.e. the rows of the edge schema)
+// that are created for the "liked_users" edges added by the builder. For example, for setting their fields:
+//
+//	SetLikedUsersThrough(func(c *TweetLikeCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the TweetLike type are not executed on these entities.
+func (tuo *TweetUpdateOne) SetLikedUsersThrough(fn func(*TweetLikeCreate)) *TweetUpdateOne {
+	tuo.liked_usersThrough = fn
+	return tuo
+}
+
 // AddUserIDs adds the "user" edge to the User entity by IDs.
 func (tuo *TweetUpdateOne) AddUserIDs(ids ...int) *TweetUpdateOne {
 	tuo.mutation.AddUserIDs(ids...)
@@ -676,6 +762,20 @@ func (tuo *TweetUpdateOne) AddUser(u ...*User) *TweetUpdateOne {
 	return tuo.AddUserIDs(ids...)
 }
 
+// SetUserThrough sets a function that configures the UserTweet entities (i.e. the rows of the edge schema)
+// that are created for the "user" edges added by the builder. For example, for setting their fields:
+//
+//	SetUserThrough(func(c *UserTweetCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the UserTweet type are not executed on these entities.
+func (tuo *TweetUpdateOne) SetUserThrough(fn func(*UserTweetCreate)) *TweetUpdateOne {
+	tuo.userThrough = fn
+	return tuo
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (tuo *TweetUpdateOne) AddTagIDs(ids ...int) *TweetUpdateOne {
 	tuo.mutation.AddTagIDs(ids...)
@@ -691,6 +791,20 @@ func (tuo *TweetUpdateOne) AddTags(t ...*Tag) *TweetUpdateOne {
 	return tuo.AddTagIDs(ids...)
 }
 
+// SetTagsThrough sets a function that configures the TweetTag entities (i.e. the rows of the edge schema)
+// that are created for the "tags" edges added by the builder. For example, for setting their fields:
+//
+//	SetTagsThrough(func(c *TweetTagCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tag entities are set by the builder,
+// and the hooks and validators of the TweetTag type are not executed on these entities.
+func (tuo *TweetUpdateOne) SetTagsThrough(fn func(*TweetTagCreate)) *TweetUpdateOne {
+	tuo.tagsThrough = fn
+	return tuo
+}
+
 // AddTweetUserIDs adds the "tweet_user" edge to the UserTweet entity by IDs.
 func (tuo *TweetUpdateOne) AddTweetUserIDs(ids ...int) *TweetUpdateOne {
 	tuo.mutation.AddTweetUserIDs(ids...)
@@ -933,6 +1047,13 @@ func (tuo *TweetUpdateOne) sqlSave(ctx context.Context) (_node *Tweet, err error
 			}
 		}
 	}
+	if ps := tuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := tuo.mutation.Text(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -1001,6 +1122,11 @@ func (tuo *TweetUpdateOne) sqlSave(ctx context.Context) (_node *Tweet, err error
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetLikeCreate{config: tuo.config, mutation: newTweetLikeMutation(tuo.config, OpCreate)}
+		if tuo.liked_usersThrough != nil {
+			tuo.liked_usersThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		_ = createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -1067,6 +1193,11 @@ func (tuo *TweetUpdateOne) sqlSave(ctx context.Context) (_node *Tweet, err error
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserTweetCreate{config: tuo.config, mutation: newUserTweetMutation(tuo.config, OpCreate)}
+		if tuo.userThrough != nil {
+			tuo.userThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -1139,6 +1270,11 @@ func (tuo *TweetUpdateOne) sqlSave(ctx context.Context) (_node *Tweet, err error
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetTagCreate{config: tuo.config, mutation: newTweetTagMutation(tuo.config, OpCreate)}
+		if tuo.tagsThrough != nil {
+			tuo.tagsThrough(createE)
+			createE.mutation.ResetTagID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -1260,7 +1396,9 @@ func (tuo *TweetUpdateOne) sqlSave(ctx context.Context) (_node *Tweet, err error
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tweet.Label}
+			err = &NotFoundError{label: tweet.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: tweet.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
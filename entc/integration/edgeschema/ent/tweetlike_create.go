@@ -145,7 +145,7 @@ func (tlc *TweetLikeCreate) defaults() error {
 		if tweetlike.DefaultLikedAt == nil {
 			return fmt.Errorf("ent: uninitialized tweetlike.DefaultLikedAt (forgotten import ent/runtime?)")
 		}
-		v := tweetlike.DefaultLikedAt()
+		v := tlc.config.now(tweetlike.DefaultLikedAt)
 		tlc.mutation.SetLikedAt(v)
 	}
 	return nil
@@ -284,6 +284,7 @@ type (
 	//  one TweetLike node.
 	TweetLikeUpsertOne struct {
 		create *TweetLikeCreate
+		err    error // first validation error of the update values.
 	}
 
 	// TweetLikeUpsert is the "OnConflict" setter.
@@ -414,6 +415,9 @@ func (u *TweetLikeUpsertOne) UpdateTweetID() *TweetLikeUpsertOne {
 
 // Exec executes the query.
 func (u *TweetLikeUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for TweetLikeCreate.OnConflict")
 	}
@@ -422,7 +426,7 @@ func (u *TweetLikeUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TweetLikeUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -549,6 +553,7 @@ func (tlcb *TweetLikeCreateBulk) OnConflictColumns(columns ...string) *TweetLike
 // a bulk of TweetLike nodes.
 type TweetLikeUpsertBulk struct {
 	create *TweetLikeCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -637,6 +642,9 @@ func (u *TweetLikeUpsertBulk) UpdateTweetID() *TweetLikeUpsertBulk {
 
 // Exec executes the query.
 func (u *TweetLikeUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TweetLikeCreateBulk instead", i)
@@ -650,7 +658,7 @@ func (u *TweetLikeUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TweetLikeUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
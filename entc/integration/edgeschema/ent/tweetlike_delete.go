@@ -102,7 +102,7 @@ func (tldo *TweetLikeDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{tweetlike.Label}
+		return &NotFoundError{label: tweetlike.Label}
 	default:
 		return nil
 	}
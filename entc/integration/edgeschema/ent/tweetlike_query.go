@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/predicate"
@@ -28,6 +29,7 @@ type TweetLikeQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.TweetLike
 	withTweet  *TweetQuery
 	withUser   *UserQuery
@@ -69,7 +71,7 @@ func (tlq *TweetLikeQuery) Order(o ...OrderFunc) *TweetLikeQuery {
 
 // QueryTweet chains the current query on the "tweet" edge.
 func (tlq *TweetLikeQuery) QueryTweet() *TweetQuery {
-	query := &TweetQuery{config: tlq.config}
+	query := (&TweetClient{config: tlq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tlq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -91,7 +93,7 @@ func (tlq *TweetLikeQuery) QueryTweet() *TweetQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (tlq *TweetLikeQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: tlq.config}
+	query := (&UserClient{config: tlq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tlq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -119,7 +121,7 @@ func (tlq *TweetLikeQuery) First(ctx context.Context) (*TweetLike, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{tweetlike.Label}
+		return nil, tlq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -145,7 +147,7 @@ func (tlq *TweetLikeQuery) Only(ctx context.Context) (*TweetLike, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{tweetlike.Label}
+		return nil, tlq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{tweetlike.Label}
 	}
@@ -165,7 +167,17 @@ func (tlq *TweetLikeQuery) All(ctx context.Context) ([]*TweetLike, error) {
 	if err := tlq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tlq.sqlAll(ctx)
+	v, err := tlq.intercept(ctx, "All", func(ctx context.Context, query *TweetLikeQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*TweetLike)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*TweetLike", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -182,7 +194,17 @@ func (tlq *TweetLikeQuery) Count(ctx context.Context) (int, error) {
 	if err := tlq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tlq.sqlCount(ctx)
+	v, err := tlq.intercept(ctx, "Count", func(ctx context.Context, query *TweetLikeQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -199,7 +221,61 @@ func (tlq *TweetLikeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tlq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tlq.sqlExist(ctx)
+	v, err := tlq.intercept(ctx, "Exist", func(ctx context.Context, query *TweetLikeQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (tlq *TweetLikeQuery) intercept(ctx context.Context, op string, fn func(context.Context, *TweetLikeQuery) (Value, error)) (Value, error) {
+	if len(tlq.inters) == 0 {
+		return fn(ctx, tlq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeTweetLike,
+		Op:     op,
+		Limit:  tlq.limit,
+		Offset: tlq.offset,
+		Unique: tlq.unique,
+		Fields: tlq.fields,
+	}
+	for _, p := range tlq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range tlq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if tlq.withTweet != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tweetlike.EdgeTweet)
+		qc.EdgeQueries[tweetlike.EdgeTweet] = tlq.withTweet
+	}
+	if tlq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tweetlike.EdgeUser)
+		qc.EdgeQueries[tweetlike.EdgeUser] = tlq.withUser
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TweetLikeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *TweetLikeQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, tlq, qc, qr, tlq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -222,6 +298,7 @@ func (tlq *TweetLikeQuery) Clone() *TweetLikeQuery {
 		limit:      tlq.limit,
 		offset:     tlq.offset,
 		order:      append([]OrderFunc{}, tlq.order...),
+		inters:     append([]Interceptor{}, tlq.inters...),
 		predicates: append([]predicate.TweetLike{}, tlq.predicates...),
 		withTweet:  tlq.withTweet.Clone(),
 		withUser:   tlq.withUser.Clone(),
@@ -235,7 +312,7 @@ func (tlq *TweetLikeQuery) Clone() *TweetLikeQuery {
 // WithTweet tells the query-builder to eager-load the nodes that are connected to
 // the "tweet" edge. The optional arguments are used to configure the query builder of the edge.
 func (tlq *TweetLikeQuery) WithTweet(opts ...func(*TweetQuery)) *TweetLikeQuery {
-	query := &TweetQuery{config: tlq.config}
+	query := (&TweetClient{config: tlq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -246,7 +323,7 @@ func (tlq *TweetLikeQuery) WithTweet(opts ...func(*TweetQuery)) *TweetLikeQuery
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (tlq *TweetLikeQuery) WithUser(opts ...func(*UserQuery)) *TweetLikeQuery {
-	query := &UserQuery{config: tlq.config}
+	query := (&UserClient{config: tlq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -310,6 +387,16 @@ func (tlq *TweetLikeQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range tlq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tlq); err != nil {
+				return err
+			}
+		}
+	}
 	if tlq.path != nil {
 		prev, err := tlq.path(ctx)
 		if err != nil {
@@ -320,7 +407,7 @@ func (tlq *TweetLikeQuery) prepareQuery(ctx context.Context) error {
 	if tweetlike.Policy == nil {
 		return errors.New("ent: uninitialized tweetlike.Policy (forgotten import ent/runtime?)")
 	}
-	if err := tweetlike.Policy.EvalQuery(ctx, tlq); err != nil {
+	if err := tweetlike.Policy.EvalQuery(tlq.privacyContext(ctx), tlq); err != nil {
 		return err
 	}
 	return nil
@@ -347,7 +434,7 @@ func (tlq *TweetLikeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*T
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, tlq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, tlq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -425,7 +512,7 @@ func (tlq *TweetLikeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tlq.querySpec()
 	_spec.Unique = false
 	_spec.Node.Columns = nil
-	return sqlgraph.CountNodes(ctx, tlq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, tlq.queryDriver(), _spec)
 }
 
 func (tlq *TweetLikeQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -509,6 +596,32 @@ func (tlq *TweetLikeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (tlq *TweetLikeQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: tweetlike.Label}
+	if len(tlq.predicates) > 0 {
+		selector := sql.Dialect(tlq.driver.Dialect()).Select().From(sql.Table(tweetlike.Table))
+		for _, p := range tlq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the TweetLikeQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (tlq *TweetLikeQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.TweetLike, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.TweetLike(ps[i]))
+	}
+	tlq.predicates = append(tlq.predicates, wps...)
+}
+
 // TweetLikeGroupBy is the group-by builder for TweetLike entities.
 type TweetLikeGroupBy struct {
 	config
@@ -548,7 +661,7 @@ func (tlgb *TweetLikeGroupBy) sqlScan(ctx context.Context, v interface{}) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := tlgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := tlgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -594,7 +707,7 @@ func (tls *TweetLikeSelect) Scan(ctx context.Context, v interface{}) error {
 func (tls *TweetLikeSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := tls.sql.Query()
-	if err := tls.driver.Query(ctx, query, args, rows); err != nil {
+	if err := tls.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
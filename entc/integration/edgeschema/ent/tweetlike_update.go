@@ -261,7 +261,7 @@ func (tlu *TweetLikeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tlu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tweetlike.Label}
+			err = &NotFoundError{label: tweetlike.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -273,9 +273,18 @@ func (tlu *TweetLikeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // TweetLikeUpdateOne is the builder for updating a single TweetLike entity.
 type TweetLikeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TweetLikeMutation
+	fields     []string
+	conditions []predicate.TweetLike
+	hooks      []Hook
+	mutation   *TweetLikeMutation
+}
+
+// Where appends a list of conditions to the TweetLikeUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (tluo *TweetLikeUpdateOne) Where(ps ...predicate.TweetLike) *TweetLikeUpdateOne {
+	tluo.conditions = append(tluo.conditions, ps...)
+	return tluo
 }
 
 // SetLikedAt sets the "liked_at" field.
@@ -458,6 +467,13 @@ func (tluo *TweetLikeUpdateOne) sqlSave(ctx context.Context) (_node *TweetLike,
 			}
 		}
 	}
+	if ps := tluo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := tluo.mutation.LikedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -540,7 +556,9 @@ func (tluo *TweetLikeUpdateOne) sqlSave(ctx context.Context) (_node *TweetLike,
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, tluo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tweetlike.Label}
+			err = &NotFoundError{label: tweetlike.Label}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: tweetlike.Label, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -158,7 +158,7 @@ func (ttc *TweetTagCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (ttc *TweetTagCreate) defaults() {
 	if _, ok := ttc.mutation.AddedAt(); !ok {
-		v := tweettag.DefaultAddedAt()
+		v := ttc.config.now(tweettag.DefaultAddedAt)
 		ttc.mutation.SetAddedAt(v)
 	}
 	if _, ok := ttc.mutation.ID(); !ok {
@@ -315,6 +315,7 @@ type (
 	//  one TweetTag node.
 	TweetTagUpsertOne struct {
 		create *TweetTagCreate
+		err    error // first validation error of the update values.
 	}
 
 	// TweetTagUpsert is the "OnConflict" setter.
@@ -453,6 +454,9 @@ func (u *TweetTagUpsertOne) UpdateTweetID() *TweetTagUpsertOne {
 
 // Exec executes the query.
 func (u *TweetTagUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for TweetTagCreate.OnConflict")
 	}
@@ -461,7 +465,7 @@ func (u *TweetTagUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TweetTagUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -473,6 +477,9 @@ func (u *TweetTagUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: TweetTagUpsertOne.ID is not supported by MySQL driver. Use TweetTagUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -612,6 +619,7 @@ func (ttcb *TweetTagCreateBulk) OnConflictColumns(columns ...string) *TweetTagUp
 // a bulk of TweetTag nodes.
 type TweetTagUpsertBulk struct {
 	create *TweetTagCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -711,6 +719,9 @@ func (u *TweetTagUpsertBulk) UpdateTweetID() *TweetTagUpsertBulk {
 
 // Exec executes the query.
 func (u *TweetTagUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TweetTagCreateBulk instead", i)
@@ -724,7 +735,7 @@ func (u *TweetTagUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TweetTagUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -107,7 +107,7 @@ func (ttdo *TweetTagDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{tweettag.Label}
+		return &NotFoundError{label: tweettag.Label}
 	default:
 		return nil
 	}
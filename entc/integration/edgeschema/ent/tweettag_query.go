@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/predicate"
@@ -29,6 +30,7 @@ type TweetTagQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.TweetTag
 	withTag    *TagQuery
 	withTweet  *TweetQuery
@@ -70,7 +72,7 @@ func (ttq *TweetTagQuery) Order(o ...OrderFunc) *TweetTagQuery {
 
 // QueryTag chains the current query on the "tag" edge.
 func (ttq *TweetTagQuery) QueryTag() *TagQuery {
-	query := &TagQuery{config: ttq.config}
+	query := (&TagClient{config: ttq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := ttq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -92,7 +94,7 @@ func (ttq *TweetTagQuery) QueryTag() *TagQuery {
 
 // QueryTweet chains the current query on the "tweet" edge.
 func (ttq *TweetTagQuery) QueryTweet() *TweetQuery {
-	query := &TweetQuery{config: ttq.config}
+	query := (&TweetClient{config: ttq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := ttq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -120,7 +122,7 @@ func (ttq *TweetTagQuery) First(ctx context.Context) (*TweetTag, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{tweettag.Label}
+		return nil, ttq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -142,7 +144,7 @@ func (ttq *TweetTagQuery) FirstID(ctx context.Context) (id uuid.UUID, err error)
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{tweettag.Label}
+		err = ttq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -169,7 +171,7 @@ func (ttq *TweetTagQuery) Only(ctx context.Context) (*TweetTag, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{tweettag.Label}
+		return nil, ttq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{tweettag.Label}
 	}
@@ -196,7 +198,7 @@ func (ttq *TweetTagQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error)
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{tweettag.Label}
+		err = ttq.sqlNotFound()
 	default:
 		err = &NotSingularError{tweettag.Label}
 	}
@@ -217,7 +219,17 @@ func (ttq *TweetTagQuery) All(ctx context.Context) ([]*TweetTag, error) {
 	if err := ttq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ttq.sqlAll(ctx)
+	v, err := ttq.intercept(ctx, "All", func(ctx context.Context, query *TweetTagQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*TweetTag)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*TweetTag", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -231,10 +243,20 @@ func (ttq *TweetTagQuery) AllX(ctx context.Context) []*TweetTag {
 
 // IDs executes the query and returns a list of TweetTag IDs.
 func (ttq *TweetTagQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	var ids []uuid.UUID
-	if err := ttq.Select(tweettag.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := ttq.intercept(ctx, "IDs", func(ctx context.Context, query *TweetTagQuery) (Value, error) {
+		var ids []uuid.UUID
+		if err := query.Select(tweettag.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]uuid.UUID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []uuid.UUID", v)
+	}
 	return ids, nil
 }
 
@@ -252,7 +274,17 @@ func (ttq *TweetTagQuery) Count(ctx context.Context) (int, error) {
 	if err := ttq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ttq.sqlCount(ctx)
+	v, err := ttq.intercept(ctx, "Count", func(ctx context.Context, query *TweetTagQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -269,7 +301,61 @@ func (ttq *TweetTagQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ttq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ttq.sqlExist(ctx)
+	v, err := ttq.intercept(ctx, "Exist", func(ctx context.Context, query *TweetTagQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (ttq *TweetTagQuery) intercept(ctx context.Context, op string, fn func(context.Context, *TweetTagQuery) (Value, error)) (Value, error) {
+	if len(ttq.inters) == 0 {
+		return fn(ctx, ttq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeTweetTag,
+		Op:     op,
+		Limit:  ttq.limit,
+		Offset: ttq.offset,
+		Unique: ttq.unique,
+		Fields: ttq.fields,
+	}
+	for _, p := range ttq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range ttq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if ttq.withTag != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tweettag.EdgeTag)
+		qc.EdgeQueries[tweettag.EdgeTag] = ttq.withTag
+	}
+	if ttq.withTweet != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, tweettag.EdgeTweet)
+		qc.EdgeQueries[tweettag.EdgeTweet] = ttq.withTweet
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TweetTagQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *TweetTagQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, ttq, qc, qr, ttq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -292,6 +378,7 @@ func (ttq *TweetTagQuery) Clone() *TweetTagQuery {
 		limit:      ttq.limit,
 		offset:     ttq.offset,
 		order:      append([]OrderFunc{}, ttq.order...),
+		inters:     append([]Interceptor{}, ttq.inters...),
 		predicates: append([]predicate.TweetTag{}, ttq.predicates...),
 		withTag:    ttq.withTag.Clone(),
 		withTweet:  ttq.withTweet.Clone(),
@@ -305,7 +392,7 @@ func (ttq *TweetTagQuery) Clone() *TweetTagQuery {
 // WithTag tells the query-builder to eager-load the nodes that are connected to
 // the "tag" edge. The optional arguments are used to configure the query builder of the edge.
 func (ttq *TweetTagQuery) WithTag(opts ...func(*TagQuery)) *TweetTagQuery {
-	query := &TagQuery{config: ttq.config}
+	query := (&TagClient{config: ttq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -316,7 +403,7 @@ func (ttq *TweetTagQuery) WithTag(opts ...func(*TagQuery)) *TweetTagQuery {
 // WithTweet tells the query-builder to eager-load the nodes that are connected to
 // the "tweet" edge. The optional arguments are used to configure the query builder of the edge.
 func (ttq *TweetTagQuery) WithTweet(opts ...func(*TweetQuery)) *TweetTagQuery {
-	query := &TweetQuery{config: ttq.config}
+	query := (&TweetClient{config: ttq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -380,6 +467,16 @@ func (ttq *TweetTagQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range ttq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ttq); err != nil {
+				return err
+			}
+		}
+	}
 	if ttq.path != nil {
 		prev, err := ttq.path(ctx)
 		if err != nil {
@@ -411,7 +508,7 @@ func (ttq *TweetTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tw
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, ttq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, ttq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -491,7 +588,7 @@ func (ttq *TweetTagQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(ttq.fields) > 0 {
 		_spec.Unique = ttq.unique != nil && *ttq.unique
 	}
-	return sqlgraph.CountNodes(ctx, ttq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, ttq.queryDriver(), _spec)
 }
 
 func (ttq *TweetTagQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -582,6 +679,32 @@ func (ttq *TweetTagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (ttq *TweetTagQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: tweettag.Label}
+	if len(ttq.predicates) > 0 {
+		selector := sql.Dialect(ttq.driver.Dialect()).Select().From(sql.Table(tweettag.Table))
+		for _, p := range ttq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the TweetTagQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (ttq *TweetTagQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.TweetTag, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.TweetTag(ps[i]))
+	}
+	ttq.predicates = append(ttq.predicates, wps...)
+}
+
 // TweetTagGroupBy is the group-by builder for TweetTag entities.
 type TweetTagGroupBy struct {
 	config
@@ -621,7 +744,7 @@ func (ttgb *TweetTagGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ttgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ttgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -667,7 +790,7 @@ func (tts *TweetTagSelect) Scan(ctx context.Context, v interface{}) error {
 func (tts *TweetTagSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := tts.sql.Query()
-	if err := tts.driver.Query(ctx, query, args, rows); err != nil {
+	if err := tts.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
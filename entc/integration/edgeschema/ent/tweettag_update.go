@@ -255,7 +255,7 @@ func (ttu *TweetTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ttu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tweettag.Label}
+			err = &NotFoundError{label: tweettag.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -267,9 +267,18 @@ func (ttu *TweetTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // TweetTagUpdateOne is the builder for updating a single TweetTag entity.
 type TweetTagUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TweetTagMutation
+	fields     []string
+	conditions []predicate.TweetTag
+	hooks      []Hook
+	mutation   *TweetTagMutation
+}
+
+// Where appends a list of conditions to the TweetTagUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ttuo *TweetTagUpdateOne) Where(ps ...predicate.TweetTag) *TweetTagUpdateOne {
+	ttuo.conditions = append(ttuo.conditions, ps...)
+	return ttuo
 }
 
 // SetAddedAt sets the "added_at" field.
@@ -444,6 +453,13 @@ func (ttuo *TweetTagUpdateOne) sqlSave(ctx context.Context) (_node *TweetTag, er
 			}
 		}
 	}
+	if ps := ttuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := ttuo.mutation.AddedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -526,7 +542,9 @@ func (ttuo *TweetTagUpdateOne) sqlSave(ctx context.Context) (_node *TweetTag, er
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, ttuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tweettag.Label}
+			err = &NotFoundError{label: tweettag.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: tweettag.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -26,9 +26,15 @@ import (
 // UserCreate is the builder for creating a User entity.
 type UserCreate struct {
 	config
-	mutation *UserMutation
-	hooks    []Hook
-	conflict []sql.ConflictOption
+	mutation            *UserMutation
+	hooks               []Hook
+	groupsThrough       func(*UserGroupCreate)
+	friendsThrough      func(*FriendshipCreate)
+	relativesThrough    func(*RelationshipCreate)
+	liked_tweetsThrough func(*TweetLikeCreate)
+	tweetsThrough       func(*UserTweetCreate)
+	rolesThrough        func(*RoleUserCreate)
+	conflict            []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -60,6 +66,20 @@ func (uc *UserCreate) AddGroups(g ...*Group) *UserCreate {
 	return uc.AddGroupIDs(ids...)
 }
 
+// SetGroupsThrough sets a function that configures the UserGroup entities (i.e. the rows of the edge schema)
+// that are created for the "groups" edges added by the builder. For example, for setting their fields:
+//
+//	SetGroupsThrough(func(c *UserGroupCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Group entities are set by the builder,
+// and the hooks and validators of the UserGroup type are not executed on these entities.
+func (uc *UserCreate) SetGroupsThrough(fn func(*UserGroupCreate)) *UserCreate {
+	uc.groupsThrough = fn
+	return uc
+}
+
 // AddFriendIDs adds the "friends" edge to the User entity by IDs.
 func (uc *UserCreate) AddFriendIDs(ids ...int) *UserCreate {
 	uc.mutation.AddFriendIDs(ids...)
@@ -75,6 +95,20 @@ func (uc *UserCreate) AddFriends(u ...*User) *UserCreate {
 	return uc.AddFriendIDs(ids...)
 }
 
+// SetFriendsThrough sets a function that configures the Friendship entities (i.e. the rows of the edge schema)
+// that are created for the "friends" edges added by the builder. For example, for setting their fields:
+//
+//	SetFriendsThrough(func(c *FriendshipCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the Friendship type are not executed on these entities.
+func (uc *UserCreate) SetFriendsThrough(fn func(*FriendshipCreate)) *UserCreate {
+	uc.friendsThrough = fn
+	return uc
+}
+
 // AddRelativeIDs adds the "relatives" edge to the User entity by IDs.
 func (uc *UserCreate) AddRelativeIDs(ids ...int) *UserCreate {
 	uc.mutation.AddRelativeIDs(ids...)
@@ -90,6 +124,20 @@ func (uc *UserCreate) AddRelatives(u ...*User) *UserCreate {
 	return uc.AddRelativeIDs(ids...)
 }
 
+// SetRelativesThrough sets a function that configures the Relationship entities (i.e. the rows of the edge schema)
+// that are created for the "relatives" edges added by the builder. For example, for setting their fields:
+//
+//	SetRelativesThrough(func(c *RelationshipCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the Relationship type are not executed on these entities.
+func (uc *UserCreate) SetRelativesThrough(fn func(*RelationshipCreate)) *UserCreate {
+	uc.relativesThrough = fn
+	return uc
+}
+
 // AddLikedTweetIDs adds the "liked_tweets" edge to the Tweet entity by IDs.
 func (uc *UserCreate) AddLikedTweetIDs(ids ...int) *UserCreate {
 	uc.mutation.AddLikedTweetIDs(ids...)
@@ -105,6 +153,20 @@ func (uc *UserCreate) AddLikedTweets(t ...*Tweet) *UserCreate {
 	return uc.AddLikedTweetIDs(ids...)
 }
 
+// SetLikedTweetsThrough sets a function that configures the TweetLike entities (i.e. the rows of the edge schema)
+// that are created for the "liked_tweets" edges added by the builder. For example, for setting their fields:
+//
+//	SetLikedTweetsThrough(func(c *TweetLikeCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tweet entities are set by the builder,
+// and the hooks and validators of the TweetLike type are not executed on these entities.
+func (uc *UserCreate) SetLikedTweetsThrough(fn func(*TweetLikeCreate)) *UserCreate {
+	uc.liked_tweetsThrough = fn
+	return uc
+}
+
 // AddTweetIDs adds the "tweets" edge to the Tweet entity by IDs.
 func (uc *UserCreate) AddTweetIDs(ids ...int) *UserCreate {
 	uc.mutation.AddTweetIDs(ids...)
@@ -120,6 +182,20 @@ func (uc *UserCreate) AddTweets(t ...*Tweet) *UserCreate {
 	return uc.AddTweetIDs(ids...)
 }
 
+// SetTweetsThrough sets a function that configures the UserTweet entities (i.e. the rows of the edge schema)
+// that are created for the "tweets" edges added by the builder. For example, for setting their fields:
+//
+//	SetTweetsThrough(func(c *UserTweetCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tweet entities are set by the builder,
+// and the hooks and validators of the UserTweet type are not executed on these entities.
+func (uc *UserCreate) SetTweetsThrough(fn func(*UserTweetCreate)) *UserCreate {
+	uc.tweetsThrough = fn
+	return uc
+}
+
 // AddRoleIDs adds the "roles" edge to the Role entity by IDs.
 func (uc *UserCreate) AddRoleIDs(ids ...int) *UserCreate {
 	uc.mutation.AddRoleIDs(ids...)
@@ -135,6 +211,20 @@ func (uc *UserCreate) AddRoles(r ...*Role) *UserCreate {
 	return uc.AddRoleIDs(ids...)
 }
 
+// SetRolesThrough sets a function that configures the RoleUser entities (i.e. the rows of the edge schema)
+// that are created for the "roles" edges added by the builder. For example, for setting their fields:
+//
+//	SetRolesThrough(func(c *RoleUserCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Role entities are set by the builder,
+// and the hooks and validators of the RoleUser type are not executed on these entities.
+func (uc *UserCreate) SetRolesThrough(fn func(*RoleUserCreate)) *UserCreate {
+	uc.rolesThrough = fn
+	return uc
+}
+
 // AddJoinedGroupIDs adds the "joined_groups" edge to the UserGroup entity by IDs.
 func (uc *UserCreate) AddJoinedGroupIDs(ids ...int) *UserCreate {
 	uc.mutation.AddJoinedGroupIDs(ids...)
@@ -325,6 +415,11 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserGroupCreate{config: uc.config, mutation: newUserGroupMutation(uc.config, OpCreate)}
+		if uc.groupsThrough != nil {
+			uc.groupsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetGroupID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -348,6 +443,11 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &FriendshipCreate{config: uc.config, mutation: newFriendshipMutation(uc.config, OpCreate)}
+		if uc.friendsThrough != nil {
+			uc.friendsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetFriendID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -371,6 +471,11 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &RelationshipCreate{config: uc.config, mutation: newRelationshipMutation(uc.config, OpCreate)}
+		if uc.relativesThrough != nil {
+			uc.relativesThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetRelativeID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -394,6 +499,11 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetLikeCreate{config: uc.config, mutation: newTweetLikeMutation(uc.config, OpCreate)}
+		if uc.liked_tweetsThrough != nil {
+			uc.liked_tweetsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		_ = createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -417,6 +527,11 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserTweetCreate{config: uc.config, mutation: newUserTweetMutation(uc.config, OpCreate)}
+		if uc.tweetsThrough != nil {
+			uc.tweetsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -440,6 +555,11 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &RoleUserCreate{config: uc.config, mutation: newRoleUserMutation(uc.config, OpCreate)}
+		if uc.rolesThrough != nil {
+			uc.rolesThrough(createE)
+			createE.mutation.ResetRoleID()
+			createE.mutation.ResetUserID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -548,6 +668,7 @@ type (
 	//  one User node.
 	UserUpsertOne struct {
 		create *UserCreate
+		err    error // first validation error of the update values.
 	}
 
 	// UserUpsert is the "OnConflict" setter.
@@ -626,6 +747,9 @@ func (u *UserUpsertOne) UpdateName() *UserUpsertOne {
 
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for UserCreate.OnConflict")
 	}
@@ -634,13 +758,16 @@ func (u *UserUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *UserUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *UserUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -784,6 +911,7 @@ func (ucb *UserCreateBulk) OnConflictColumns(columns ...string) *UserUpsertBulk
 // a bulk of User nodes.
 type UserUpsertBulk struct {
 	create *UserCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -844,6 +972,9 @@ func (u *UserUpsertBulk) UpdateName() *UserUpsertBulk {
 
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserCreateBulk instead", i)
@@ -857,7 +988,7 @@ func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *UserUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/friendship"
@@ -37,6 +38,7 @@ type UserQuery struct {
 	unique           *bool
 	order            []OrderFunc
 	fields           []string
+	inters           []Interceptor
 	predicates       []predicate.User
 	withGroups       *GroupQuery
 	withFriends      *UserQuery
@@ -88,7 +90,7 @@ func (uq *UserQuery) Order(o ...OrderFunc) *UserQuery {
 
 // QueryGroups chains the current query on the "groups" edge.
 func (uq *UserQuery) QueryGroups() *GroupQuery {
-	query := &GroupQuery{config: uq.config}
+	query := (&GroupClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -110,7 +112,7 @@ func (uq *UserQuery) QueryGroups() *GroupQuery {
 
 // QueryFriends chains the current query on the "friends" edge.
 func (uq *UserQuery) QueryFriends() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -132,7 +134,7 @@ func (uq *UserQuery) QueryFriends() *UserQuery {
 
 // QueryRelatives chains the current query on the "relatives" edge.
 func (uq *UserQuery) QueryRelatives() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -154,7 +156,7 @@ func (uq *UserQuery) QueryRelatives() *UserQuery {
 
 // QueryLikedTweets chains the current query on the "liked_tweets" edge.
 func (uq *UserQuery) QueryLikedTweets() *TweetQuery {
-	query := &TweetQuery{config: uq.config}
+	query := (&TweetClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -176,7 +178,7 @@ func (uq *UserQuery) QueryLikedTweets() *TweetQuery {
 
 // QueryTweets chains the current query on the "tweets" edge.
 func (uq *UserQuery) QueryTweets() *TweetQuery {
-	query := &TweetQuery{config: uq.config}
+	query := (&TweetClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -198,7 +200,7 @@ func (uq *UserQuery) QueryTweets() *TweetQuery {
 
 // QueryRoles chains the current query on the "roles" edge.
 func (uq *UserQuery) QueryRoles() *RoleQuery {
-	query := &RoleQuery{config: uq.config}
+	query := (&RoleClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -220,7 +222,7 @@ func (uq *UserQuery) QueryRoles() *RoleQuery {
 
 // QueryJoinedGroups chains the current query on the "joined_groups" edge.
 func (uq *UserQuery) QueryJoinedGroups() *UserGroupQuery {
-	query := &UserGroupQuery{config: uq.config}
+	query := (&UserGroupClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -242,7 +244,7 @@ func (uq *UserQuery) QueryJoinedGroups() *UserGroupQuery {
 
 // QueryFriendships chains the current query on the "friendships" edge.
 func (uq *UserQuery) QueryFriendships() *FriendshipQuery {
-	query := &FriendshipQuery{config: uq.config}
+	query := (&FriendshipClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -264,7 +266,7 @@ func (uq *UserQuery) QueryFriendships() *FriendshipQuery {
 
 // QueryRelationship chains the current query on the "relationship" edge.
 func (uq *UserQuery) QueryRelationship() *RelationshipQuery {
-	query := &RelationshipQuery{config: uq.config}
+	query := (&RelationshipClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -286,7 +288,7 @@ func (uq *UserQuery) QueryRelationship() *RelationshipQuery {
 
 // QueryLikes chains the current query on the "likes" edge.
 func (uq *UserQuery) QueryLikes() *TweetLikeQuery {
-	query := &TweetLikeQuery{config: uq.config}
+	query := (&TweetLikeClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -308,7 +310,7 @@ func (uq *UserQuery) QueryLikes() *TweetLikeQuery {
 
 // QueryUserTweets chains the current query on the "user_tweets" edge.
 func (uq *UserQuery) QueryUserTweets() *UserTweetQuery {
-	query := &UserTweetQuery{config: uq.config}
+	query := (&UserTweetClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -330,7 +332,7 @@ func (uq *UserQuery) QueryUserTweets() *UserTweetQuery {
 
 // QueryRolesUsers chains the current query on the "roles_users" edge.
 func (uq *UserQuery) QueryRolesUsers() *RoleUserQuery {
-	query := &RoleUserQuery{config: uq.config}
+	query := (&RoleUserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -358,7 +360,7 @@ func (uq *UserQuery) First(ctx context.Context) (*User, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{user.Label}
+		return nil, uq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -380,7 +382,7 @@ func (uq *UserQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{user.Label}
+		err = uq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -407,7 +409,7 @@ func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{user.Label}
+		return nil, uq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{user.Label}
 	}
@@ -434,7 +436,7 @@ func (uq *UserQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{user.Label}
+		err = uq.sqlNotFound()
 	default:
 		err = &NotSingularError{user.Label}
 	}
@@ -455,7 +457,17 @@ func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return uq.sqlAll(ctx)
+	v, err := uq.intercept(ctx, "All", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*User)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*User", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -469,10 +481,20 @@ func (uq *UserQuery) AllX(ctx context.Context) []*User {
 
 // IDs executes the query and returns a list of User IDs.
 func (uq *UserQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := uq.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := uq.intercept(ctx, "IDs", func(ctx context.Context, query *UserQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -490,7 +512,17 @@ func (uq *UserQuery) Count(ctx context.Context) (int, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return uq.sqlCount(ctx)
+	v, err := uq.intercept(ctx, "Count", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -507,7 +539,131 @@ func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return uq.sqlExist(ctx)
+	v, err := uq.intercept(ctx, "Exist", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (uq *UserQuery) intercept(ctx context.Context, op string, fn func(context.Context, *UserQuery) (Value, error)) (Value, error) {
+	if len(uq.inters) == 0 {
+		return fn(ctx, uq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeUser,
+		Op:     op,
+		Limit:  uq.limit,
+		Offset: uq.offset,
+		Unique: uq.unique,
+		Fields: uq.fields,
+	}
+	for _, p := range uq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range uq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if uq.withGroups != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeGroups)
+		qc.EdgeQueries[user.EdgeGroups] = uq.withGroups
+	}
+	if uq.withFriends != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeFriends)
+		qc.EdgeQueries[user.EdgeFriends] = uq.withFriends
+	}
+	if uq.withRelatives != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeRelatives)
+		qc.EdgeQueries[user.EdgeRelatives] = uq.withRelatives
+	}
+	if uq.withLikedTweets != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeLikedTweets)
+		qc.EdgeQueries[user.EdgeLikedTweets] = uq.withLikedTweets
+	}
+	if uq.withTweets != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeTweets)
+		qc.EdgeQueries[user.EdgeTweets] = uq.withTweets
+	}
+	if uq.withRoles != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeRoles)
+		qc.EdgeQueries[user.EdgeRoles] = uq.withRoles
+	}
+	if uq.withJoinedGroups != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeJoinedGroups)
+		qc.EdgeQueries[user.EdgeJoinedGroups] = uq.withJoinedGroups
+	}
+	if uq.withFriendships != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeFriendships)
+		qc.EdgeQueries[user.EdgeFriendships] = uq.withFriendships
+	}
+	if uq.withRelationship != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeRelationship)
+		qc.EdgeQueries[user.EdgeRelationship] = uq.withRelationship
+	}
+	if uq.withLikes != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeLikes)
+		qc.EdgeQueries[user.EdgeLikes] = uq.withLikes
+	}
+	if uq.withUserTweets != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeUserTweets)
+		qc.EdgeQueries[user.EdgeUserTweets] = uq.withUserTweets
+	}
+	if uq.withRolesUsers != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeRolesUsers)
+		qc.EdgeQueries[user.EdgeRolesUsers] = uq.withRolesUsers
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *UserQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, uq, qc, qr, uq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -530,6 +686,7 @@ func (uq *UserQuery) Clone() *UserQuery {
 		limit:            uq.limit,
 		offset:           uq.offset,
 		order:            append([]OrderFunc{}, uq.order...),
+		inters:           append([]Interceptor{}, uq.inters...),
 		predicates:       append([]predicate.User{}, uq.predicates...),
 		withGroups:       uq.withGroups.Clone(),
 		withFriends:      uq.withFriends.Clone(),
@@ -553,7 +710,7 @@ func (uq *UserQuery) Clone() *UserQuery {
 // WithGroups tells the query-builder to eager-load the nodes that are connected to
 // the "groups" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithGroups(opts ...func(*GroupQuery)) *UserQuery {
-	query := &GroupQuery{config: uq.config}
+	query := (&GroupClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -564,7 +721,7 @@ func (uq *UserQuery) WithGroups(opts ...func(*GroupQuery)) *UserQuery {
 // WithFriends tells the query-builder to eager-load the nodes that are connected to
 // the "friends" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithFriends(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -575,7 +732,7 @@ func (uq *UserQuery) WithFriends(opts ...func(*UserQuery)) *UserQuery {
 // WithRelatives tells the query-builder to eager-load the nodes that are connected to
 // the "relatives" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithRelatives(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -586,7 +743,7 @@ func (uq *UserQuery) WithRelatives(opts ...func(*UserQuery)) *UserQuery {
 // WithLikedTweets tells the query-builder to eager-load the nodes that are connected to
 // the "liked_tweets" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithLikedTweets(opts ...func(*TweetQuery)) *UserQuery {
-	query := &TweetQuery{config: uq.config}
+	query := (&TweetClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -597,7 +754,7 @@ func (uq *UserQuery) WithLikedTweets(opts ...func(*TweetQuery)) *UserQuery {
 // WithTweets tells the query-builder to eager-load the nodes that are connected to
 // the "tweets" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithTweets(opts ...func(*TweetQuery)) *UserQuery {
-	query := &TweetQuery{config: uq.config}
+	query := (&TweetClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -608,7 +765,7 @@ func (uq *UserQuery) WithTweets(opts ...func(*TweetQuery)) *UserQuery {
 // WithRoles tells the query-builder to eager-load the nodes that are connected to
 // the "roles" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithRoles(opts ...func(*RoleQuery)) *UserQuery {
-	query := &RoleQuery{config: uq.config}
+	query := (&RoleClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -619,7 +776,7 @@ func (uq *UserQuery) WithRoles(opts ...func(*RoleQuery)) *UserQuery {
 // WithJoinedGroups tells the query-builder to eager-load the nodes that are connected to
 // the "joined_groups" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithJoinedGroups(opts ...func(*UserGroupQuery)) *UserQuery {
-	query := &UserGroupQuery{config: uq.config}
+	query := (&UserGroupClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -630,7 +787,7 @@ func (uq *UserQuery) WithJoinedGroups(opts ...func(*UserGroupQuery)) *UserQuery
 // WithFriendships tells the query-builder to eager-load the nodes that are connected to
 // the "friendships" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithFriendships(opts ...func(*FriendshipQuery)) *UserQuery {
-	query := &FriendshipQuery{config: uq.config}
+	query := (&FriendshipClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -641,7 +798,7 @@ func (uq *UserQuery) WithFriendships(opts ...func(*FriendshipQuery)) *UserQuery
 // WithRelationship tells the query-builder to eager-load the nodes that are connected to
 // the "relationship" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithRelationship(opts ...func(*RelationshipQuery)) *UserQuery {
-	query := &RelationshipQuery{config: uq.config}
+	query := (&RelationshipClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -652,7 +809,7 @@ func (uq *UserQuery) WithRelationship(opts ...func(*RelationshipQuery)) *UserQue
 // WithLikes tells the query-builder to eager-load the nodes that are connected to
 // the "likes" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithLikes(opts ...func(*TweetLikeQuery)) *UserQuery {
-	query := &TweetLikeQuery{config: uq.config}
+	query := (&TweetLikeClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -663,7 +820,7 @@ func (uq *UserQuery) WithLikes(opts ...func(*TweetLikeQuery)) *UserQuery {
 // WithUserTweets tells the query-builder to eager-load the nodes that are connected to
 // the "user_tweets" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithUserTweets(opts ...func(*UserTweetQuery)) *UserQuery {
-	query := &UserTweetQuery{config: uq.config}
+	query := (&UserTweetClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -674,7 +831,7 @@ func (uq *UserQuery) WithUserTweets(opts ...func(*UserTweetQuery)) *UserQuery {
 // WithRolesUsers tells the query-builder to eager-load the nodes that are connected to
 // the "roles_users" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithRolesUsers(opts ...func(*RoleUserQuery)) *UserQuery {
-	query := &RoleUserQuery{config: uq.config}
+	query := (&RoleUserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -738,6 +895,16 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range uq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, uq); err != nil {
+				return err
+			}
+		}
+	}
 	if uq.path != nil {
 		prev, err := uq.path(ctx)
 		if err != nil {
@@ -748,7 +915,7 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 	if user.Policy == nil {
 		return errors.New("ent: uninitialized user.Policy (forgotten import ent/runtime?)")
 	}
-	if err := user.Policy.EvalQuery(ctx, uq); err != nil {
+	if err := user.Policy.EvalQuery(uq.privacyContext(ctx), uq); err != nil {
 		return err
 	}
 	return nil
@@ -785,7 +952,7 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, uq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -1377,7 +1544,7 @@ func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(uq.fields) > 0 {
 		_spec.Unique = uq.unique != nil && *uq.unique
 	}
-	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, uq.queryDriver(), _spec)
 }
 
 func (uq *UserQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -1468,6 +1635,32 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (uq *UserQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: user.Label}
+	if len(uq.predicates) > 0 {
+		selector := sql.Dialect(uq.driver.Dialect()).Select().From(sql.Table(user.Table))
+		for _, p := range uq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the UserQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (uq *UserQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.User, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.User(ps[i]))
+	}
+	uq.predicates = append(uq.predicates, wps...)
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	config
@@ -1507,7 +1700,7 @@ func (ugb *UserGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ugb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ugb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -1553,7 +1746,7 @@ func (us *UserSelect) Scan(ctx context.Context, v interface{}) error {
 func (us *UserSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := us.sql.Query()
-	if err := us.driver.Query(ctx, query, args, rows); err != nil {
+	if err := us.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -27,8 +27,14 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks               []Hook
+	mutation            *UserMutation
+	groupsThrough       func(*UserGroupCreate)
+	friendsThrough      func(*FriendshipCreate)
+	relativesThrough    func(*RelationshipCreate)
+	liked_tweetsThrough func(*TweetLikeCreate)
+	tweetsThrough       func(*UserTweetCreate)
+	rolesThrough        func(*RoleUserCreate)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -66,6 +72,20 @@ func (uu *UserUpdate) AddGroups(g ...*Group) *UserUpdate {
 	return uu.AddGroupIDs(ids...)
 }
 
+// SetGroupsThrough sets a function that configures the UserGroup entities (i.e. the rows of the edge schema)
+// that are created for the "groups" edges added by the builder. For example, for setting their fields:
+//
+//	SetGroupsThrough(func(c *UserGroupCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Group entities are set by the builder,
+// and the hooks and validators of the UserGroup type are not executed on these entities.
+func (uu *UserUpdate) SetGroupsThrough(fn func(*UserGroupCreate)) *UserUpdate {
+	uu.groupsThrough = fn
+	return uu
+}
+
 // AddFriendIDs adds the "friends" edge to the User entity by IDs.
 func (uu *UserUpdate) AddFriendIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddFriendIDs(ids...)
@@ -81,6 +101,20 @@ func (uu *UserUpdate) AddFriends(u ...*User) *UserUpdate {
 	return uu.AddFriendIDs(ids...)
 }
 
+// SetFriendsThrough sets a function that configures the Friendship entities (i.e. the rows of the edge schema)
+// that are created for the "friends" edges added by the builder. For example, for setting their fields:
+//
+//	SetFriendsThrough(func(c *FriendshipCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the Friendship type are not executed on these entities.
+func (uu *UserUpdate) SetFriendsThrough(fn func(*FriendshipCreate)) *UserUpdate {
+	uu.friendsThrough = fn
+	return uu
+}
+
 // AddRelativeIDs adds the "relatives" edge to the User entity by IDs.
 func (uu *UserUpdate) AddRelativeIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddRelativeIDs(ids...)
@@ -96,6 +130,20 @@ func (uu *UserUpdate) AddRelatives(u ...*User) *UserUpdate {
 	return uu.AddRelativeIDs(ids...)
 }
 
+// SetRelativesThrough sets a function that configures the Relationship entities (i.e. the rows of the edge schema)
+// that are created for the "relatives" edges added by the builder. For example, for setting their fields:
+//
+//	SetRelativesThrough(func(c *RelationshipCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the Relationship type are not executed on these entities.
+func (uu *UserUpdate) SetRelativesThrough(fn func(*RelationshipCreate)) *UserUpdate {
+	uu.relativesThrough = fn
+	return uu
+}
+
 // AddLikedTweetIDs adds the "liked_tweets" edge to the Tweet entity by IDs.
 func (uu *UserUpdate) AddLikedTweetIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddLikedTweetIDs(ids...)
@@ -111,6 +159,20 @@ func (uu *UserUpdate) AddLikedTweets(t ...*Tweet) *UserUpdate {
 	return uu.AddLikedTweetIDs(ids...)
 }
 
+// SetLikedTweetsThrough sets a function that configures the TweetLike entities (i.e. the rows of the edge schema)
+// that are created for the "liked_tweets" edges added by the builder. For example, for setting their fields:
+//
+//	SetLikedTweetsThrough(func(c *TweetLikeCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tweet entities are set by the builder,
+// and the hooks and validators of the TweetLike type are not executed on these entities.
+func (uu *UserUpdate) SetLikedTweetsThrough(fn func(*TweetLikeCreate)) *UserUpdate {
+	uu.liked_tweetsThrough = fn
+	return uu
+}
+
 // AddTweetIDs adds the "tweets" edge to the Tweet entity by IDs.
 func (uu *UserUpdate) AddTweetIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddTweetIDs(ids...)
@@ -126,6 +188,20 @@ func (uu *UserUpdate) AddTweets(t ...*Tweet) *UserUpdate {
 	return uu.AddTweetIDs(ids...)
 }
 
+// SetTweetsThrough sets a function that configures the UserTweet entities (i.e. the rows of the edge schema)
+// that are created for the "tweets" edges added by the builder. For example, for setting their fields:
+//
+//	SetTweetsThrough(func(c *UserTweetCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tweet entities are set by the builder,
+// and the hooks and validators of the UserTweet type are not executed on these entities.
+func (uu *UserUpdate) SetTweetsThrough(fn func(*UserTweetCreate)) *UserUpdate {
+	uu.tweetsThrough = fn
+	return uu
+}
+
 // AddRoleIDs adds the "roles" edge to the Role entity by IDs.
 func (uu *UserUpdate) AddRoleIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddRoleIDs(ids...)
@@ -141,6 +217,20 @@ func (uu *UserUpdate) AddRoles(r ...*Role) *UserUpdate {
 	return uu.AddRoleIDs(ids...)
 }
 
+// SetRolesThrough sets a function that configures the RoleUser entities (i.e. the rows of the edge schema)
+// that are created for the "roles" edges added by the builder. For example, for setting their fields:
+//
+//	SetRolesThrough(func(c *RoleUserCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Role entities are set by the builder,
+// and the hooks and validators of the RoleUser type are not executed on these entities.
+func (uu *UserUpdate) SetRolesThrough(fn func(*RoleUserCreate)) *UserUpdate {
+	uu.rolesThrough = fn
+	return uu
+}
+
 // AddJoinedGroupIDs adds the "joined_groups" edge to the UserGroup entity by IDs.
 func (uu *UserUpdate) AddJoinedGroupIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddJoinedGroupIDs(ids...)
@@ -520,6 +610,11 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserGroupCreate{config: uu.config, mutation: newUserGroupMutation(uu.config, OpCreate)}
+		if uu.groupsThrough != nil {
+			uu.groupsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetGroupID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -586,6 +681,11 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &FriendshipCreate{config: uu.config, mutation: newFriendshipMutation(uu.config, OpCreate)}
+		if uu.friendsThrough != nil {
+			uu.friendsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetFriendID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -652,6 +752,11 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &RelationshipCreate{config: uu.config, mutation: newRelationshipMutation(uu.config, OpCreate)}
+		if uu.relativesThrough != nil {
+			uu.relativesThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetRelativeID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -718,6 +823,11 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetLikeCreate{config: uu.config, mutation: newTweetLikeMutation(uu.config, OpCreate)}
+		if uu.liked_tweetsThrough != nil {
+			uu.liked_tweetsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		_ = createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -784,6 +894,11 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserTweetCreate{config: uu.config, mutation: newUserTweetMutation(uu.config, OpCreate)}
+		if uu.tweetsThrough != nil {
+			uu.tweetsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -850,6 +965,11 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &RoleUserCreate{config: uu.config, mutation: newRoleUserMutation(uu.config, OpCreate)}
+		if uu.rolesThrough != nil {
+			uu.rolesThrough(createE)
+			createE.mutation.ResetRoleID()
+			createE.mutation.ResetUserID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -1019,7 +1139,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -1031,9 +1151,24 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields              []string
+	conditions          []predicate.User
+	hooks               []Hook
+	mutation            *UserMutation
+	groupsThrough       func(*UserGroupCreate)
+	friendsThrough      func(*FriendshipCreate)
+	relativesThrough    func(*RelationshipCreate)
+	liked_tweetsThrough func(*TweetLikeCreate)
+	tweetsThrough       func(*UserTweetCreate)
+	rolesThrough        func(*RoleUserCreate)
+}
+
+// Where appends a list of conditions to the UserUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.conditions = append(uuo.conditions, ps...)
+	return uuo
 }
 
 // SetName sets the "name" field.
@@ -1065,6 +1200,20 @@ func (uuo *UserUpdateOne) AddGroups(g ...*Group) *UserUpdateOne {
 	return uuo.AddGroupIDs(ids...)
 }
 
+// SetGroupsThrough sets a function that configures the UserGroup entities (i.e. the rows of the edge schema)
+// that are created for the "groups" edges added by the builder. For example, for setting their fields:
+//
+//	SetGroupsThrough(func(c *UserGroupCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Group entities are set by the builder,
+// and the hooks and validators of the UserGroup type are not executed on these entities.
+func (uuo *UserUpdateOne) SetGroupsThrough(fn func(*UserGroupCreate)) *UserUpdateOne {
+	uuo.groupsThrough = fn
+	return uuo
+}
+
 // AddFriendIDs adds the "friends" edge to the User entity by IDs.
 func (uuo *UserUpdateOne) AddFriendIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddFriendIDs(ids...)
@@ -1080,6 +1229,20 @@ func (uuo *UserUpdateOne) AddFriends(u ...*User) *UserUpdateOne {
 	return uuo.AddFriendIDs(ids...)
 }
 
+// SetFriendsThrough sets a function that configures the Friendship entities (i.e. the rows of the edge schema)
+// that are created for the "friends" edges added by the builder. For example, for setting their fields:
+//
+//	SetFriendsThrough(func(c *FriendshipCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the Friendship type are not executed on these entities.
+func (uuo *UserUpdateOne) SetFriendsThrough(fn func(*FriendshipCreate)) *UserUpdateOne {
+	uuo.friendsThrough = fn
+	return uuo
+}
+
 // AddRelativeIDs adds the "relatives" edge to the User entity by IDs.
 func (uuo *UserUpdateOne) AddRelativeIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddRelativeIDs(ids...)
@@ -1095,6 +1258,20 @@ func (uuo *UserUpdateOne) AddRelatives(u ...*User) *UserUpdateOne {
 	return uuo.AddRelativeIDs(ids...)
 }
 
+// SetRelativesThrough sets a function that configures the Relationship entities (i.e. the rows of the edge schema)
+// that are created for the "relatives" edges added by the builder. For example, for setting their fields:
+//
+//	SetRelativesThrough(func(c *RelationshipCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the User entities are set by the builder,
+// and the hooks and validators of the Relationship type are not executed on these entities.
+func (uuo *UserUpdateOne) SetRelativesThrough(fn func(*RelationshipCreate)) *UserUpdateOne {
+	uuo.relativesThrough = fn
+	return uuo
+}
+
 // AddLikedTweetIDs adds the "liked_tweets" edge to the Tweet entity by IDs.
 func (uuo *UserUpdateOne) AddLikedTweetIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddLikedTweetIDs(ids...)
@@ -1110,6 +1287,20 @@ func (uuo *UserUpdateOne) AddLikedTweets(t ...*Tweet) *UserUpdateOne {
 	return uuo.AddLikedTweetIDs(ids...)
 }
 
+// SetLikedTweetsThrough sets a function that configures the TweetLike entities (i.e. the rows of the edge schema)
+// that are created for the "liked_tweets" edges added by the builder. For example, for setting their fields:
+//
+//	SetLikedTweetsThrough(func(c *TweetLikeCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tweet entities are set by the builder,
+// and the hooks and validators of the TweetLike type are not executed on these entities.
+func (uuo *UserUpdateOne) SetLikedTweetsThrough(fn func(*TweetLikeCreate)) *UserUpdateOne {
+	uuo.liked_tweetsThrough = fn
+	return uuo
+}
+
 // AddTweetIDs adds the "tweets" edge to the Tweet entity by IDs.
 func (uuo *UserUpdateOne) AddTweetIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddTweetIDs(ids...)
@@ -1125,6 +1316,20 @@ func (uuo *UserUpdateOne) AddTweets(t ...*Tweet) *UserUpdateOne {
 	return uuo.AddTweetIDs(ids...)
 }
 
+// SetTweetsThrough sets a function that configures the UserTweet entities (i.e. the rows of the edge schema)
+// that are created for the "tweets" edges added by the builder. For example, for setting their fields:
+//
+//	SetTweetsThrough(func(c *UserTweetCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Tweet entities are set by the builder,
+// and the hooks and validators of the UserTweet type are not executed on these entities.
+func (uuo *UserUpdateOne) SetTweetsThrough(fn func(*UserTweetCreate)) *UserUpdateOne {
+	uuo.tweetsThrough = fn
+	return uuo
+}
+
 // AddRoleIDs adds the "roles" edge to the Role entity by IDs.
 func (uuo *UserUpdateOne) AddRoleIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddRoleIDs(ids...)
@@ -1140,6 +1345,20 @@ func (uuo *UserUpdateOne) AddRoles(r ...*Role) *UserUpdateOne {
 	return uuo.AddRoleIDs(ids...)
 }
 
+// SetRolesThrough sets a function that configures the RoleUser entities (i.e. the rows of the edge schema)
+// that are created for the "roles" edges added by the builder. For example, for setting their fields:
+//
+//	SetRolesThrough(func(c *RoleUserCreate) {
+//		// ...
+//	})
+//
+// Note that the edge-fields that reference the Role entities are set by the builder,
+// and the hooks and validators of the RoleUser type are not executed on these entities.
+func (uuo *UserUpdateOne) SetRolesThrough(fn func(*RoleUserCreate)) *UserUpdateOne {
+	uuo.rolesThrough = fn
+	return uuo
+}
+
 // AddJoinedGroupIDs adds the "joined_groups" edge to the UserGroup entity by IDs.
 func (uuo *UserUpdateOne) AddJoinedGroupIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddJoinedGroupIDs(ids...)
@@ -1481,6 +1700,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			}
 		}
 	}
+	if ps := uuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -1549,6 +1775,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserGroupCreate{config: uuo.config, mutation: newUserGroupMutation(uuo.config, OpCreate)}
+		if uuo.groupsThrough != nil {
+			uuo.groupsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetGroupID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -1615,6 +1846,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &FriendshipCreate{config: uuo.config, mutation: newFriendshipMutation(uuo.config, OpCreate)}
+		if uuo.friendsThrough != nil {
+			uuo.friendsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetFriendID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -1681,6 +1917,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &RelationshipCreate{config: uuo.config, mutation: newRelationshipMutation(uuo.config, OpCreate)}
+		if uuo.relativesThrough != nil {
+			uuo.relativesThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetRelativeID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -1747,6 +1988,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &TweetLikeCreate{config: uuo.config, mutation: newTweetLikeMutation(uuo.config, OpCreate)}
+		if uuo.liked_tweetsThrough != nil {
+			uuo.liked_tweetsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		_ = createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -1813,6 +2059,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &UserTweetCreate{config: uuo.config, mutation: newUserTweetMutation(uuo.config, OpCreate)}
+		if uuo.tweetsThrough != nil {
+			uuo.tweetsThrough(createE)
+			createE.mutation.ResetUserID()
+			createE.mutation.ResetTweetID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -1879,6 +2130,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		createE := &RoleUserCreate{config: uuo.config, mutation: newRoleUserMutation(uuo.config, OpCreate)}
+		if uuo.rolesThrough != nil {
+			uuo.rolesThrough(createE)
+			createE.mutation.ResetRoleID()
+			createE.mutation.ResetUserID()
+		}
 		createE.defaults()
 		_, specE := createE.createSpec()
 		edge.Target.Fields = specE.Fields
@@ -2051,7 +2307,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: user.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -142,7 +142,7 @@ func (ugc *UserGroupCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (ugc *UserGroupCreate) defaults() {
 	if _, ok := ugc.mutation.JoinedAt(); !ok {
-		v := usergroup.DefaultJoinedAt()
+		v := ugc.config.now(usergroup.DefaultJoinedAt)
 		ugc.mutation.SetJoinedAt(v)
 	}
 }
@@ -286,6 +286,7 @@ type (
 	//  one UserGroup node.
 	UserGroupUpsertOne struct {
 		create *UserGroupCreate
+		err    error // first validation error of the update values.
 	}
 
 	// UserGroupUpsert is the "OnConflict" setter.
@@ -416,6 +417,9 @@ func (u *UserGroupUpsertOne) UpdateGroupID() *UserGroupUpsertOne {
 
 // Exec executes the query.
 func (u *UserGroupUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for UserGroupCreate.OnConflict")
 	}
@@ -424,13 +428,16 @@ func (u *UserGroupUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *UserGroupUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *UserGroupUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -574,6 +581,7 @@ func (ugcb *UserGroupCreateBulk) OnConflictColumns(columns ...string) *UserGroup
 // a bulk of UserGroup nodes.
 type UserGroupUpsertBulk struct {
 	create *UserGroupCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -662,6 +670,9 @@ func (u *UserGroupUpsertBulk) UpdateGroupID() *UserGroupUpsertBulk {
 
 // Exec executes the query.
 func (u *UserGroupUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserGroupCreateBulk instead", i)
@@ -675,7 +686,7 @@ func (u *UserGroupUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *UserGroupUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
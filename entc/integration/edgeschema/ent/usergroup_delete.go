@@ -107,7 +107,7 @@ func (ugdo *UserGroupDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{usergroup.Label}
+		return &NotFoundError{label: usergroup.Label}
 	default:
 		return nil
 	}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/group"
@@ -28,6 +29,7 @@ type UserGroupQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.UserGroup
 	withUser   *UserQuery
 	withGroup  *GroupQuery
@@ -69,7 +71,7 @@ func (ugq *UserGroupQuery) Order(o ...OrderFunc) *UserGroupQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (ugq *UserGroupQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: ugq.config}
+	query := (&UserClient{config: ugq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := ugq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -91,7 +93,7 @@ func (ugq *UserGroupQuery) QueryUser() *UserQuery {
 
 // QueryGroup chains the current query on the "group" edge.
 func (ugq *UserGroupQuery) QueryGroup() *GroupQuery {
-	query := &GroupQuery{config: ugq.config}
+	query := (&GroupClient{config: ugq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := ugq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -119,7 +121,7 @@ func (ugq *UserGroupQuery) First(ctx context.Context) (*UserGroup, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{usergroup.Label}
+		return nil, ugq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -141,7 +143,7 @@ func (ugq *UserGroupQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{usergroup.Label}
+		err = ugq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -168,7 +170,7 @@ func (ugq *UserGroupQuery) Only(ctx context.Context) (*UserGroup, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{usergroup.Label}
+		return nil, ugq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{usergroup.Label}
 	}
@@ -195,7 +197,7 @@ func (ugq *UserGroupQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{usergroup.Label}
+		err = ugq.sqlNotFound()
 	default:
 		err = &NotSingularError{usergroup.Label}
 	}
@@ -216,7 +218,17 @@ func (ugq *UserGroupQuery) All(ctx context.Context) ([]*UserGroup, error) {
 	if err := ugq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ugq.sqlAll(ctx)
+	v, err := ugq.intercept(ctx, "All", func(ctx context.Context, query *UserGroupQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*UserGroup)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*UserGroup", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -230,10 +242,20 @@ func (ugq *UserGroupQuery) AllX(ctx context.Context) []*UserGroup {
 
 // IDs executes the query and returns a list of UserGroup IDs.
 func (ugq *UserGroupQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := ugq.Select(usergroup.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := ugq.intercept(ctx, "IDs", func(ctx context.Context, query *UserGroupQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(usergroup.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -251,7 +273,17 @@ func (ugq *UserGroupQuery) Count(ctx context.Context) (int, error) {
 	if err := ugq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ugq.sqlCount(ctx)
+	v, err := ugq.intercept(ctx, "Count", func(ctx context.Context, query *UserGroupQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -268,7 +300,61 @@ func (ugq *UserGroupQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ugq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ugq.sqlExist(ctx)
+	v, err := ugq.intercept(ctx, "Exist", func(ctx context.Context, query *UserGroupQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (ugq *UserGroupQuery) intercept(ctx context.Context, op string, fn func(context.Context, *UserGroupQuery) (Value, error)) (Value, error) {
+	if len(ugq.inters) == 0 {
+		return fn(ctx, ugq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeUserGroup,
+		Op:     op,
+		Limit:  ugq.limit,
+		Offset: ugq.offset,
+		Unique: ugq.unique,
+		Fields: ugq.fields,
+	}
+	for _, p := range ugq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range ugq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if ugq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, usergroup.EdgeUser)
+		qc.EdgeQueries[usergroup.EdgeUser] = ugq.withUser
+	}
+	if ugq.withGroup != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, usergroup.EdgeGroup)
+		qc.EdgeQueries[usergroup.EdgeGroup] = ugq.withGroup
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserGroupQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *UserGroupQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, ugq, qc, qr, ugq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -291,6 +377,7 @@ func (ugq *UserGroupQuery) Clone() *UserGroupQuery {
 		limit:      ugq.limit,
 		offset:     ugq.offset,
 		order:      append([]OrderFunc{}, ugq.order...),
+		inters:     append([]Interceptor{}, ugq.inters...),
 		predicates: append([]predicate.UserGroup{}, ugq.predicates...),
 		withUser:   ugq.withUser.Clone(),
 		withGroup:  ugq.withGroup.Clone(),
@@ -304,7 +391,7 @@ func (ugq *UserGroupQuery) Clone() *UserGroupQuery {
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (ugq *UserGroupQuery) WithUser(opts ...func(*UserQuery)) *UserGroupQuery {
-	query := &UserQuery{config: ugq.config}
+	query := (&UserClient{config: ugq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -315,7 +402,7 @@ func (ugq *UserGroupQuery) WithUser(opts ...func(*UserQuery)) *UserGroupQuery {
 // WithGroup tells the query-builder to eager-load the nodes that are connected to
 // the "group" edge. The optional arguments are used to configure the query builder of the edge.
 func (ugq *UserGroupQuery) WithGroup(opts ...func(*GroupQuery)) *UserGroupQuery {
-	query := &GroupQuery{config: ugq.config}
+	query := (&GroupClient{config: ugq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -379,6 +466,16 @@ func (ugq *UserGroupQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range ugq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ugq); err != nil {
+				return err
+			}
+		}
+	}
 	if ugq.path != nil {
 		prev, err := ugq.path(ctx)
 		if err != nil {
@@ -410,7 +507,7 @@ func (ugq *UserGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*U
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, ugq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, ugq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -490,7 +587,7 @@ func (ugq *UserGroupQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(ugq.fields) > 0 {
 		_spec.Unique = ugq.unique != nil && *ugq.unique
 	}
-	return sqlgraph.CountNodes(ctx, ugq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, ugq.queryDriver(), _spec)
 }
 
 func (ugq *UserGroupQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -581,6 +678,32 @@ func (ugq *UserGroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (ugq *UserGroupQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: usergroup.Label}
+	if len(ugq.predicates) > 0 {
+		selector := sql.Dialect(ugq.driver.Dialect()).Select().From(sql.Table(usergroup.Table))
+		for _, p := range ugq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the UserGroupQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (ugq *UserGroupQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.UserGroup, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.UserGroup(ps[i]))
+	}
+	ugq.predicates = append(ugq.predicates, wps...)
+}
+
 // UserGroupGroupBy is the group-by builder for UserGroup entities.
 type UserGroupGroupBy struct {
 	config
@@ -620,7 +743,7 @@ func (uggb *UserGroupGroupBy) sqlScan(ctx context.Context, v interface{}) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := uggb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := uggb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -666,7 +789,7 @@ func (ugs *UserGroupSelect) Scan(ctx context.Context, v interface{}) error {
 func (ugs *UserGroupSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ugs.sql.Query()
-	if err := ugs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ugs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
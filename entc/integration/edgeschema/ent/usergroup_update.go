@@ -255,7 +255,7 @@ func (ugu *UserGroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ugu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{usergroup.Label}
+			err = &NotFoundError{label: usergroup.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -267,9 +267,18 @@ func (ugu *UserGroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserGroupUpdateOne is the builder for updating a single UserGroup entity.
 type UserGroupUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserGroupMutation
+	fields     []string
+	conditions []predicate.UserGroup
+	hooks      []Hook
+	mutation   *UserGroupMutation
+}
+
+// Where appends a list of conditions to the UserGroupUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (uguo *UserGroupUpdateOne) Where(ps ...predicate.UserGroup) *UserGroupUpdateOne {
+	uguo.conditions = append(uguo.conditions, ps...)
+	return uguo
 }
 
 // SetJoinedAt sets the "joined_at" field.
@@ -444,6 +453,13 @@ func (uguo *UserGroupUpdateOne) sqlSave(ctx context.Context) (_node *UserGroup,
 			}
 		}
 	}
+	if ps := uguo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uguo.mutation.JoinedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -526,7 +542,9 @@ func (uguo *UserGroupUpdateOne) sqlSave(ctx context.Context) (_node *UserGroup,
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, uguo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{usergroup.Label}
+			err = &NotFoundError{label: usergroup.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: usergroup.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
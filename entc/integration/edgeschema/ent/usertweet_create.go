@@ -142,7 +142,7 @@ func (utc *UserTweetCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (utc *UserTweetCreate) defaults() {
 	if _, ok := utc.mutation.CreatedAt(); !ok {
-		v := usertweet.DefaultCreatedAt()
+		v := utc.config.now(usertweet.DefaultCreatedAt)
 		utc.mutation.SetCreatedAt(v)
 	}
 }
@@ -286,6 +286,7 @@ type (
 	//  one UserTweet node.
 	UserTweetUpsertOne struct {
 		create *UserTweetCreate
+		err    error // first validation error of the update values.
 	}
 
 	// UserTweetUpsert is the "OnConflict" setter.
@@ -416,6 +417,9 @@ func (u *UserTweetUpsertOne) UpdateTweetID() *UserTweetUpsertOne {
 
 // Exec executes the query.
 func (u *UserTweetUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for UserTweetCreate.OnConflict")
 	}
@@ -424,13 +428,16 @@ func (u *UserTweetUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *UserTweetUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *UserTweetUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -574,6 +581,7 @@ func (utcb *UserTweetCreateBulk) OnConflictColumns(columns ...string) *UserTweet
 // a bulk of UserTweet nodes.
 type UserTweetUpsertBulk struct {
 	create *UserTweetCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -662,6 +670,9 @@ func (u *UserTweetUpsertBulk) UpdateTweetID() *UserTweetUpsertBulk {
 
 // Exec executes the query.
 func (u *UserTweetUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserTweetCreateBulk instead", i)
@@ -675,7 +686,7 @@ func (u *UserTweetUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *UserTweetUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
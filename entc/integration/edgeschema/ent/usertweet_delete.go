@@ -107,7 +107,7 @@ func (utdo *UserTweetDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{usertweet.Label}
+		return &NotFoundError{label: usertweet.Label}
 	default:
 		return nil
 	}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgeschema/ent/predicate"
@@ -28,6 +29,7 @@ type UserTweetQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.UserTweet
 	withUser   *UserQuery
 	withTweet  *TweetQuery
@@ -69,7 +71,7 @@ func (utq *UserTweetQuery) Order(o ...OrderFunc) *UserTweetQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (utq *UserTweetQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: utq.config}
+	query := (&UserClient{config: utq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := utq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -91,7 +93,7 @@ func (utq *UserTweetQuery) QueryUser() *UserQuery {
 
 // QueryTweet chains the current query on the "tweet" edge.
 func (utq *UserTweetQuery) QueryTweet() *TweetQuery {
-	query := &TweetQuery{config: utq.config}
+	query := (&TweetClient{config: utq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := utq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -119,7 +121,7 @@ func (utq *UserTweetQuery) First(ctx context.Context) (*UserTweet, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{usertweet.Label}
+		return nil, utq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -141,7 +143,7 @@ func (utq *UserTweetQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{usertweet.Label}
+		err = utq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -168,7 +170,7 @@ func (utq *UserTweetQuery) Only(ctx context.Context) (*UserTweet, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{usertweet.Label}
+		return nil, utq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{usertweet.Label}
 	}
@@ -195,7 +197,7 @@ func (utq *UserTweetQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{usertweet.Label}
+		err = utq.sqlNotFound()
 	default:
 		err = &NotSingularError{usertweet.Label}
 	}
@@ -216,7 +218,17 @@ func (utq *UserTweetQuery) All(ctx context.Context) ([]*UserTweet, error) {
 	if err := utq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return utq.sqlAll(ctx)
+	v, err := utq.intercept(ctx, "All", func(ctx context.Context, query *UserTweetQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*UserTweet)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*UserTweet", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -230,10 +242,20 @@ func (utq *UserTweetQuery) AllX(ctx context.Context) []*UserTweet {
 
 // IDs executes the query and returns a list of UserTweet IDs.
 func (utq *UserTweetQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := utq.Select(usertweet.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := utq.intercept(ctx, "IDs", func(ctx context.Context, query *UserTweetQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(usertweet.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -251,7 +273,17 @@ func (utq *UserTweetQuery) Count(ctx context.Context) (int, error) {
 	if err := utq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return utq.sqlCount(ctx)
+	v, err := utq.intercept(ctx, "Count", func(ctx context.Context, query *UserTweetQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -268,7 +300,61 @@ func (utq *UserTweetQuery) Exist(ctx context.Context) (bool, error) {
 	if err := utq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return utq.sqlExist(ctx)
+	v, err := utq.intercept(ctx, "Exist", func(ctx context.Context, query *UserTweetQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (utq *UserTweetQuery) intercept(ctx context.Context, op string, fn func(context.Context, *UserTweetQuery) (Value, error)) (Value, error) {
+	if len(utq.inters) == 0 {
+		return fn(ctx, utq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeUserTweet,
+		Op:     op,
+		Limit:  utq.limit,
+		Offset: utq.offset,
+		Unique: utq.unique,
+		Fields: utq.fields,
+	}
+	for _, p := range utq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range utq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if utq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, usertweet.EdgeUser)
+		qc.EdgeQueries[usertweet.EdgeUser] = utq.withUser
+	}
+	if utq.withTweet != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, usertweet.EdgeTweet)
+		qc.EdgeQueries[usertweet.EdgeTweet] = utq.withTweet
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserTweetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *UserTweetQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, utq, qc, qr, utq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -291,6 +377,7 @@ func (utq *UserTweetQuery) Clone() *UserTweetQuery {
 		limit:      utq.limit,
 		offset:     utq.offset,
 		order:      append([]OrderFunc{}, utq.order...),
+		inters:     append([]Interceptor{}, utq.inters...),
 		predicates: append([]predicate.UserTweet{}, utq.predicates...),
 		withUser:   utq.withUser.Clone(),
 		withTweet:  utq.withTweet.Clone(),
@@ -304,7 +391,7 @@ func (utq *UserTweetQuery) Clone() *UserTweetQuery {
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (utq *UserTweetQuery) WithUser(opts ...func(*UserQuery)) *UserTweetQuery {
-	query := &UserQuery{config: utq.config}
+	query := (&UserClient{config: utq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -315,7 +402,7 @@ func (utq *UserTweetQuery) WithUser(opts ...func(*UserQuery)) *UserTweetQuery {
 // WithTweet tells the query-builder to eager-load the nodes that are connected to
 // the "tweet" edge. The optional arguments are used to configure the query builder of the edge.
 func (utq *UserTweetQuery) WithTweet(opts ...func(*TweetQuery)) *UserTweetQuery {
-	query := &TweetQuery{config: utq.config}
+	query := (&TweetClient{config: utq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -379,6 +466,16 @@ func (utq *UserTweetQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range utq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, utq); err != nil {
+				return err
+			}
+		}
+	}
 	if utq.path != nil {
 		prev, err := utq.path(ctx)
 		if err != nil {
@@ -410,7 +507,7 @@ func (utq *UserTweetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*U
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, utq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, utq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -490,7 +587,7 @@ func (utq *UserTweetQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(utq.fields) > 0 {
 		_spec.Unique = utq.unique != nil && *utq.unique
 	}
-	return sqlgraph.CountNodes(ctx, utq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, utq.queryDriver(), _spec)
 }
 
 func (utq *UserTweetQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -581,6 +678,32 @@ func (utq *UserTweetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (utq *UserTweetQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: usertweet.Label}
+	if len(utq.predicates) > 0 {
+		selector := sql.Dialect(utq.driver.Dialect()).Select().From(sql.Table(usertweet.Table))
+		for _, p := range utq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the UserTweetQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (utq *UserTweetQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.UserTweet, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.UserTweet(ps[i]))
+	}
+	utq.predicates = append(utq.predicates, wps...)
+}
+
 // UserTweetGroupBy is the group-by builder for UserTweet entities.
 type UserTweetGroupBy struct {
 	config
@@ -620,7 +743,7 @@ func (utgb *UserTweetGroupBy) sqlScan(ctx context.Context, v interface{}) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := utgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := utgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -666,7 +789,7 @@ func (uts *UserTweetSelect) Scan(ctx context.Context, v interface{}) error {
 func (uts *UserTweetSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := uts.sql.Query()
-	if err := uts.driver.Query(ctx, query, args, rows); err != nil {
+	if err := uts.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
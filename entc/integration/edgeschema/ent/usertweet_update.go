@@ -255,7 +255,7 @@ func (utu *UserTweetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, utu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{usertweet.Label}
+			err = &NotFoundError{label: usertweet.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -267,9 +267,18 @@ func (utu *UserTweetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserTweetUpdateOne is the builder for updating a single UserTweet entity.
 type UserTweetUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserTweetMutation
+	fields     []string
+	conditions []predicate.UserTweet
+	hooks      []Hook
+	mutation   *UserTweetMutation
+}
+
+// Where appends a list of conditions to the UserTweetUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (utuo *UserTweetUpdateOne) Where(ps ...predicate.UserTweet) *UserTweetUpdateOne {
+	utuo.conditions = append(utuo.conditions, ps...)
+	return utuo
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -444,6 +453,13 @@ func (utuo *UserTweetUpdateOne) sqlSave(ctx context.Context) (_node *UserTweet,
 			}
 		}
 	}
+	if ps := utuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := utuo.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -526,7 +542,9 @@ func (utuo *UserTweetUpdateOne) sqlSave(ctx context.Context) (_node *UserTweet,
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, utuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{usertweet.Label}
+			err = &NotFoundError{label: usertweet.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: usertweet.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -7,7 +7,9 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -17,6 +19,7 @@ import (
 )
 
 // Card is the model entity for the Card schema.
+// Card holds the payment cards of the users.
 type Card struct {
 	config `json:"-"`
 	// ID of the ent.
@@ -35,6 +38,9 @@ type Card struct {
 	// The values are being populated by the CardQuery when eager-loading is set.
 	Edges     CardEdges `json:"edges" mashraki:"edges"`
 	user_card *int
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[5]bool
 
 	// StaticField defined by templates.
 	StaticField string `json:"boring,omitempty"`
@@ -203,6 +209,182 @@ func (c *Card) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the Card and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (c *Card) Diff(other *Card) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(c.CreateTime, other.CreateTime) {
+		changes = append(changes, FieldChange{Field: card.FieldCreateTime, Old: c.CreateTime, New: other.CreateTime})
+	}
+	if !reflect.DeepEqual(c.UpdateTime, other.UpdateTime) {
+		changes = append(changes, FieldChange{Field: card.FieldUpdateTime, Old: c.UpdateTime, New: other.UpdateTime})
+	}
+	if !reflect.DeepEqual(c.Balance, other.Balance) {
+		changes = append(changes, FieldChange{Field: card.FieldBalance, Old: c.Balance, New: other.Balance})
+	}
+	if !reflect.DeepEqual(c.Number, other.Number) {
+		changes = append(changes, FieldChange{Field: card.FieldNumber, Old: c.Number, New: other.Number})
+	}
+	if !reflect.DeepEqual(c.Name, other.Name) {
+		changes = append(changes, FieldChange{Field: card.FieldName, Old: c.Name, New: other.Name})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the Card that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (c *Card) ApplyMask(paths ...string) (*Card, error) {
+	if len(paths) == 0 {
+		masked := *c
+		return &masked, nil
+	}
+	masked := &Card{config: c.config, ID: c.ID}
+	// Nested paths of the masked edges. A nil
+	// slice means that the entire edge is masked.
+	edges := make(map[string][]string)
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case card.FieldID:
+		case "create_time":
+			masked.CreateTime = c.CreateTime
+		case "update_time":
+			masked.UpdateTime = c.UpdateTime
+		case "balance":
+			masked.Balance = c.Balance
+		case "number":
+			masked.Number = c.Number
+		case "name":
+			masked.Name = c.Name
+		case card.EdgeOwner, card.EdgeSpec:
+			if name == p {
+				edges[name] = nil
+			} else if sub, ok := edges[name]; !ok || sub != nil {
+				edges[name] = append(sub, p[len(name)+1:])
+			}
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type Card", p)
+		}
+	}
+	if sub, ok := edges[card.EdgeOwner]; ok {
+		masked.Edges.loadedTypes[0] = c.Edges.loadedTypes[0]
+		if n := c.Edges.Owner; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Owner = mn
+		}
+	}
+	if sub, ok := edges[card.EdgeSpec]; ok {
+		masked.Edges.loadedTypes[1] = c.Edges.loadedTypes[1]
+		for _, n := range c.Edges.Spec {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Spec = append(masked.Edges.Spec, mn)
+		}
+	}
+	return masked, nil
+}
+
+// CardPatch describes a partial update of a Card entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type CardPatch struct {
+	// UpdateTime holds the new value of the "update_time" field.
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// Balance holds the new value of the "balance" field.
+	Balance *float64 `json:"balance,omitempty"`
+	// Name holds the new value of the "name" field.
+	Name *string `json:"name,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, card.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *CardPatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p CardPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.UpdateTime != nil {
+		fields["update_time"] = *p.UpdateTime
+	}
+	if p.Balance != nil {
+		fields["balance"] = *p.Balance
+	}
+	if p.Name != nil {
+		fields["name"] = *p.Name
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		case card.FieldName:
+			fields["name"] = nil
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional Card field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *CardPatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "update_time":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Card field %q cannot be null", name)
+			}
+			p.UpdateTime = new(time.Time)
+			if err := json.Unmarshal(raw, p.UpdateTime); err != nil {
+				return fmt.Errorf("ent: decoding Card field %q: %w", name, err)
+			}
+		case "balance":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Card field %q cannot be null", name)
+			}
+			p.Balance = new(float64)
+			if err := json.Unmarshal(raw, p.Balance); err != nil {
+				return fmt.Errorf("ent: decoding Card field %q: %w", name, err)
+			}
+		case "name":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, card.FieldName)
+				continue
+			}
+			p.Name = new(string)
+			if err := json.Unmarshal(raw, p.Name); err != nil {
+				return fmt.Errorf("ent: decoding Card field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable Card field %q", name)
+		}
+	}
+	return nil
+}
+
 // NamedSpec returns the Spec named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (c *Card) NamedSpec(name string) ([]*Spec, error) {
@@ -227,6 +409,107 @@ func (c *Card) appendNamedSpec(name string, edges ...*Spec) {
 	}
 }
 
+// Selected reports whether the given field was loaded into the Card, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	c := client.Card.Query().Select(card.FieldCreateTime).FirstX(ctx)
+//	c.Selected(card.FieldCreateTime) // true
+//
+func (c *Card) Selected(field string) bool {
+	switch field {
+	case card.FieldID:
+		return true
+	case card.FieldCreateTime:
+		return c.selectedFields == nil || c.selectedFields[0]
+	case card.FieldUpdateTime:
+		return c.selectedFields == nil || c.selectedFields[1]
+	case card.FieldBalance:
+		return c.selectedFields == nil || c.selectedFields[2]
+	case card.FieldNumber:
+		return c.selectedFields == nil || c.selectedFields[3]
+	case card.FieldName:
+		return c.selectedFields == nil || c.selectedFields[4]
+	}
+	return false
+}
+
+// CreateTimeOrErr returns the value of the "create_time" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Card.
+func (c *Card) CreateTimeOrErr() (time.Time, error) {
+	if c.selectedFields != nil && !c.selectedFields[0] {
+		var zero time.Time
+		return zero, &NotSelectedError{label: card.Label, field: card.FieldCreateTime}
+	}
+	return c.CreateTime, nil
+}
+
+// UpdateTimeOrErr returns the value of the "update_time" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Card.
+func (c *Card) UpdateTimeOrErr() (time.Time, error) {
+	if c.selectedFields != nil && !c.selectedFields[1] {
+		var zero time.Time
+		return zero, &NotSelectedError{label: card.Label, field: card.FieldUpdateTime}
+	}
+	return c.UpdateTime, nil
+}
+
+// BalanceOrErr returns the value of the "balance" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Card.
+func (c *Card) BalanceOrErr() (float64, error) {
+	if c.selectedFields != nil && !c.selectedFields[2] {
+		var zero float64
+		return zero, &NotSelectedError{label: card.Label, field: card.FieldBalance}
+	}
+	return c.Balance, nil
+}
+
+// NumberOrErr returns the value of the "number" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Card.
+func (c *Card) NumberOrErr() (string, error) {
+	if c.selectedFields != nil && !c.selectedFields[3] {
+		var zero string
+		return zero, &NotSelectedError{label: card.Label, field: card.FieldNumber}
+	}
+	return c.Number, nil
+}
+
+// NameOrErr returns the value of the "name" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Card.
+func (c *Card) NameOrErr() (string, error) {
+	if c.selectedFields != nil && !c.selectedFields[4] {
+		var zero string
+		return zero, &NotSelectedError{label: card.Label, field: card.FieldName}
+	}
+	return c.Name, nil
+}
+
+// selectFields returns the fields of the Card that are included in the given
+// columns, or nil if all of them are included.
+func (*Card) selectFields(columns []string) *[5]bool {
+	var selected [5]bool
+	for _, c := range columns {
+		switch c {
+		case card.FieldCreateTime:
+			selected[0] = true
+		case card.FieldUpdateTime:
+			selected[1] = true
+		case card.FieldBalance:
+			selected[2] = true
+		case card.FieldNumber:
+			selected[3] = true
+		case card.FieldName:
+			selected[4] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // Cards is a parsable slice of Card.
 type Cards []*Card
 
@@ -8,6 +8,9 @@ package card
 
 import (
 	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
 )
 
 const (
@@ -97,4 +100,71 @@ var (
 	NameValidator func(string) error
 )
 
+// fieldInfos describes the fields of the Card type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "create_time",
+		Column:      FieldCreateTime,
+		StructField: "CreateTime",
+		Type:        field.TypeTime,
+		GoType:      "time.Time",
+		Immutable:   true,
+		Default:     true,
+	},
+	{
+		Name:        "update_time",
+		Column:      FieldUpdateTime,
+		StructField: "UpdateTime",
+		Type:        field.TypeTime,
+		GoType:      "time.Time",
+		Default:     true,
+	},
+	{
+		Name:        "balance",
+		Column:      FieldBalance,
+		StructField: "Balance",
+		Type:        field.TypeFloat64,
+		GoType:      "float64",
+		Default:     true,
+	},
+	{
+		Name:        "number",
+		Column:      FieldNumber,
+		StructField: "Number",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Immutable:   true,
+	},
+	{
+		Name:        "name",
+		Column:      FieldName,
+		StructField: "Name",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+		Comment:     "Name exactly as written on card.",
+	},
+}
+
+// Fields returns the information of the Card fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+	"number",
+}
+
 // comment from another template.
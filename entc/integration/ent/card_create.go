@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -202,11 +203,11 @@ func (cc *CardCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (cc *CardCreate) defaults() {
 	if _, ok := cc.mutation.CreateTime(); !ok {
-		v := card.DefaultCreateTime()
+		v := cc.config.now(card.DefaultCreateTime)
 		cc.mutation.SetCreateTime(v)
 	}
 	if _, ok := cc.mutation.UpdateTime(); !ok {
-		v := card.DefaultUpdateTime()
+		v := cc.config.now(card.DefaultUpdateTime)
 		cc.mutation.SetUpdateTime(v)
 	}
 	if _, ok := cc.mutation.Balance(); !ok {
@@ -349,6 +350,68 @@ func (cc *CardCreate) createSpec() (*Card, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// CardInsertSelect is the builder for creating Card entities from the result of a query.
+type CardInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the Card table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+// For example:
+//
+//	client.Card.CreateFromQuery(query.Select(...)).
+//		Columns(card.FieldCreateTime).
+//		Exec(ctx)
+//
+func (cis *CardInsertSelect) Columns(columns ...string) *CardInsertSelect {
+	cis.columns = append(cis.columns, columns...)
+	return cis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (cis *CardInsertSelect) Exec(ctx context.Context) (int, error) {
+	if cis.query == nil {
+		return 0, errors.New("ent: missing query for Card.CreateFromQuery")
+	}
+	if err := cis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := cis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := cis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !card.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for Card.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: card.Table, Columns: card.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, cis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cis *CardInsertSelect) ExecX(ctx context.Context) int {
+	n, err := cis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -392,6 +455,7 @@ type (
 	//  one Card node.
 	CardUpsertOne struct {
 		create *CardCreate
+		err    error // first validation error of the update values.
 	}
 
 	// CardUpsert is the "OnConflict" setter.
@@ -571,8 +635,12 @@ func (u *CardUpsertOne) UpdateBalance() *CardUpsertOne {
 	})
 }
 
-// SetNumber sets the "number" field.
+// SetNumber sets the "number" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *CardUpsertOne) SetNumber(v string) *CardUpsertOne {
+	if err := card.NumberValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)}
+	}
 	return u.Update(func(s *CardUpsert) {
 		s.SetNumber(v)
 	})
@@ -585,8 +653,12 @@ func (u *CardUpsertOne) UpdateNumber() *CardUpsertOne {
 	})
 }
 
-// SetName sets the "name" field.
+// SetName sets the "name" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *CardUpsertOne) SetName(v string) *CardUpsertOne {
+	if err := card.NameValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+	}
 	return u.Update(func(s *CardUpsert) {
 		s.SetName(v)
 	})
@@ -608,6 +680,9 @@ func (u *CardUpsertOne) ClearName() *CardUpsertOne {
 
 // Exec executes the query.
 func (u *CardUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for CardCreate.OnConflict")
 	}
@@ -616,13 +691,16 @@ func (u *CardUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *CardUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *CardUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -639,6 +717,43 @@ func (u *CardUpsertOne) IDX(ctx context.Context) int {
 	return id
 }
 
+// SaveAsync adds the creation of the Card entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (cc *CardCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, cc.config, cc.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the Card creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (cc *CardCreate) IdempotencyKey(key string) *CardCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &cc.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*Card)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from CardMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id int
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&CardClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	cc.hooks = append([]Hook{hook}, cc.hooks...)
+	return cc
+}
+
 // CardCreateBulk is the builder for creating many Card entities in bulk.
 type CardCreateBulk struct {
 	config
@@ -725,6 +840,78 @@ func (ccb *CardCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// CardBulkResult holds the result of creating one of the Card entities using CardCreateBulk.ContinueOnError.
+type CardBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *Card
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the Card entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.Card.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (ccb *CardCreateBulk) ContinueOnError(ctx context.Context) ([]CardBulkResult, error) {
+	client := &Client{config: ccb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]CardBulkResult, len(ccb.builders))
+	for i, builder := range ccb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = ccb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -766,6 +953,7 @@ func (ccb *CardCreateBulk) OnConflictColumns(columns ...string) *CardUpsertBulk
 // a bulk of Card nodes.
 type CardUpsertBulk struct {
 	create *CardCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -869,8 +1057,12 @@ func (u *CardUpsertBulk) UpdateBalance() *CardUpsertBulk {
 	})
 }
 
-// SetNumber sets the "number" field.
+// SetNumber sets the "number" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *CardUpsertBulk) SetNumber(v string) *CardUpsertBulk {
+	if err := card.NumberValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)}
+	}
 	return u.Update(func(s *CardUpsert) {
 		s.SetNumber(v)
 	})
@@ -883,8 +1075,12 @@ func (u *CardUpsertBulk) UpdateNumber() *CardUpsertBulk {
 	})
 }
 
-// SetName sets the "name" field.
+// SetName sets the "name" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *CardUpsertBulk) SetName(v string) *CardUpsertBulk {
+	if err := card.NameValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+	}
 	return u.Update(func(s *CardUpsert) {
 		s.SetName(v)
 	})
@@ -906,6 +1102,9 @@ func (u *CardUpsertBulk) ClearName() *CardUpsertBulk {
 
 // Exec executes the query.
 func (u *CardUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CardCreateBulk instead", i)
@@ -919,7 +1118,7 @@ func (u *CardUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *CardUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -95,6 +95,19 @@ func (cd *CardDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the Card entities that are joined with the rows of the given table, on the given columns
+// of the Card table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.Card.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (cd *CardDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *CardDelete {
+	cd.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return cd
+}
+
 // CardDeleteOne is the builder for deleting a single Card entity.
 type CardDeleteOne struct {
 	cd *CardDelete
@@ -107,7 +120,7 @@ func (cdo *CardDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{card.Label}
+		return &NotFoundError{label: card.Label}
 	default:
 		return nil
 	}
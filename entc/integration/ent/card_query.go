@@ -9,12 +9,18 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqltime"
 	"entgo.io/ent/entc/integration/ent/card"
 	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/spec"
@@ -30,10 +36,12 @@ type CardQuery struct {
 	unique        *bool
 	order         []OrderFunc
 	fields        []string
+	inters        []Interceptor
 	predicates    []predicate.Card
 	withOwner     *UserQuery
 	withSpec      *SpecQuery
 	withFKs       bool
+	loadStrategy  LoadStrategy
 	modifiers     []func(*sql.Selector)
 	withNamedSpec map[string]*SpecQuery
 	// intermediate query (i.e. traversal path).
@@ -74,7 +82,7 @@ func (cq *CardQuery) Order(o ...OrderFunc) *CardQuery {
 
 // QueryOwner chains the current query on the "owner" edge.
 func (cq *CardQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: cq.config}
+	query := (&UserClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -96,7 +104,7 @@ func (cq *CardQuery) QueryOwner() *UserQuery {
 
 // QuerySpec chains the current query on the "spec" edge.
 func (cq *CardQuery) QuerySpec() *SpecQuery {
-	query := &SpecQuery{config: cq.config}
+	query := (&SpecClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -124,7 +132,7 @@ func (cq *CardQuery) First(ctx context.Context) (*Card, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{card.Label}
+		return nil, cq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -146,7 +154,7 @@ func (cq *CardQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{card.Label}
+		err = cq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -165,6 +173,11 @@ func (cq *CardQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one Card entity is found.
 // Returns a *NotFoundError when no Card entities are found.
 func (cq *CardQuery) Only(ctx context.Context) (*Card, error) {
+	if cq.singleflight != nil && cq.withOwner == nil && cq.withSpec == nil && len(cq.withNamedSpec) == 0 {
+		if _, ok := cq.driver.(*txDriver); !ok {
+			return cq.onlyShared(ctx)
+		}
+	}
 	nodes, err := cq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -173,7 +186,7 @@ func (cq *CardQuery) Only(ctx context.Context) (*Card, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{card.Label}
+		return nil, cq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{card.Label}
 	}
@@ -200,7 +213,7 @@ func (cq *CardQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{card.Label}
+		err = cq.sqlNotFound()
 	default:
 		err = &NotSingularError{card.Label}
 	}
@@ -221,7 +234,20 @@ func (cq *CardQuery) All(ctx context.Context) ([]*Card, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.sqlAll(ctx)
+	if p := cq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return cq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := cq.intercept(ctx, "All", func(ctx context.Context, query *CardQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Card)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Card", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -235,10 +261,20 @@ func (cq *CardQuery) AllX(ctx context.Context) []*Card {
 
 // IDs executes the query and returns a list of Card IDs.
 func (cq *CardQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := cq.Select(card.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := cq.intercept(ctx, "IDs", func(ctx context.Context, query *CardQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(card.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -256,7 +292,17 @@ func (cq *CardQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.sqlCount(ctx)
+	v, err := cq.intercept(ctx, "Count", func(ctx context.Context, query *CardQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -273,7 +319,61 @@ func (cq *CardQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.sqlExist(ctx)
+	v, err := cq.intercept(ctx, "Exist", func(ctx context.Context, query *CardQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (cq *CardQuery) intercept(ctx context.Context, op string, fn func(context.Context, *CardQuery) (Value, error)) (Value, error) {
+	if len(cq.inters) == 0 {
+		return fn(ctx, cq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeCard,
+		Op:     op,
+		Limit:  cq.limit,
+		Offset: cq.offset,
+		Unique: cq.unique,
+		Fields: cq.fields,
+	}
+	for _, p := range cq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range cq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if cq.withOwner != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, card.EdgeOwner)
+		qc.EdgeQueries[card.EdgeOwner] = cq.withOwner
+	}
+	if cq.withSpec != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, card.EdgeSpec)
+		qc.EdgeQueries[card.EdgeSpec] = cq.withSpec
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CardQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *CardQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, cq, qc, qr, cq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -296,20 +396,22 @@ func (cq *CardQuery) Clone() *CardQuery {
 		limit:      cq.limit,
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		predicates: append([]predicate.Card{}, cq.predicates...),
 		withOwner:  cq.withOwner.Clone(),
 		withSpec:   cq.withSpec.Clone(),
 		// clone intermediate query.
-		sql:    cq.sql.Clone(),
-		path:   cq.path,
-		unique: cq.unique,
+		sql:          cq.sql.Clone(),
+		path:         cq.path,
+		unique:       cq.unique,
+		loadStrategy: cq.loadStrategy,
 	}
 }
 
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CardQuery) WithOwner(opts ...func(*UserQuery)) *CardQuery {
-	query := &UserQuery{config: cq.config}
+	query := (&UserClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -320,7 +422,7 @@ func (cq *CardQuery) WithOwner(opts ...func(*UserQuery)) *CardQuery {
 // WithSpec tells the query-builder to eager-load the nodes that are connected to
 // the "spec" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CardQuery) WithSpec(opts ...func(*SpecQuery)) *CardQuery {
-	query := &SpecQuery{config: cq.config}
+	query := (&SpecClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -384,6 +486,16 @@ func (cq *CardQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range cq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cq); err != nil {
+				return err
+			}
+		}
+	}
 	if cq.path != nil {
 		prev, err := cq.path(ctx)
 		if err != nil {
@@ -410,6 +522,12 @@ func (cq *CardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Card, e
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, card.ForeignKeys...)
 	}
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[5]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Card).scanValues(nil, columns)
 	}
@@ -417,15 +535,84 @@ func (cq *CardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Card, e
 		node := &Card{config: cq.config}
 		nodes = append(nodes, node)
 		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*Card).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(cq.modifiers) > 0 {
 		_spec.Modifiers = cq.modifiers
 	}
+	if query := cq.withOwner; query != nil && query.joinable() {
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		var (
+			joined   = query.sqlQuery(ctx)
+			jcolumns = query.querySpec().Node.Columns
+			scan     = _spec.ScanValues
+			assign   = _spec.Assign
+		)
+		selected := (*User).selectFields(nil, jcolumns)
+		joined.Select(joined.Columns(jcolumns...)...)
+		_spec.Modifiers = append(_spec.Modifiers[:len(_spec.Modifiers):len(_spec.Modifiers)], func(s *sql.Selector) {
+			s.LeftJoin(joined).On(s.C(card.OwnerColumn), joined.C(user.FieldID))
+			for _, c := range jcolumns {
+				s.AppendSelect(sql.As(joined.C(c), card.EdgeOwner+"__"+c))
+			}
+		})
+		// The columns of the joined edge are selected last, and are scanned
+		// using nullable scanners, as they are NULL if the edge does not exist.
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			n := len(columns) - len(jcolumns)
+			values, err := scan(columns[:n])
+			if err != nil {
+				return nil, err
+			}
+			jvalues, err := (*User).scanValues(nil, jcolumns)
+			if err != nil {
+				return nil, err
+			}
+			for i := range jvalues {
+				if s, ok := jvalues[i].(interface{ Scan(interface{}) error }); ok {
+					jvalues[i] = &sql.NullScanner{S: s}
+				}
+			}
+			return append(values, jvalues...), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			n := len(columns) - len(jcolumns)
+			if err := assign(columns[:n], values[:n]); err != nil {
+				return err
+			}
+			if id, ok := values[n].(*sql.NullScanner); ok && !id.Valid {
+				return nil
+			}
+			jvalues := make([]interface{}, len(jcolumns))
+			for i, v := range values[n:] {
+				if s, ok := v.(*sql.NullScanner); ok {
+					v = s.S
+				}
+				jvalues[i] = v
+			}
+			neighbor := &User{config: cq.config}
+			neighbor.selectedFields = selected
+			if err := neighbor.assignValues(jcolumns, jvalues); err != nil {
+				return err
+			}
+			nodes[len(nodes)-1].Edges.Owner = neighbor
+			return nil
+		}
+		// The edge is loaded by the join, and not by an additional query.
+		clone := *cq
+		clone.withOwner = nil
+		cq = &clone
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, cq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -548,7 +735,7 @@ func (cq *CardQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(cq.fields) > 0 {
 		_spec.Unique = cq.unique != nil && *cq.unique
 	}
-	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, cq.queryDriver(), _spec)
 }
 
 func (cq *CardQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -642,6 +829,415 @@ func (cq *CardQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (cq *CardQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: card.Label}
+	if len(cq.predicates) > 0 {
+		selector := sql.Dialect(cq.driver.Dialect()).Select().From(sql.Table(card.Table))
+		for _, p := range cq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the CardQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (cq *CardQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Card, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Card(ps[i]))
+	}
+	cq.predicates = append(cq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (cq *CardQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := cq.querySpec()
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = cq.unique != nil && *cq.unique
+	return sqlgraph.EstimateNodes(ctx, cq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (cq *CardQuery) CountEstimateX(ctx context.Context) int {
+	count, err := cq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// Card rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (cq *CardQuery) Sample(percent float64) *CardQuery {
+	cq.predicates = append(cq.predicates, sql.Sample(percent))
+	return cq
+}
+
+// CardIterator iterates over the results of a Card query, and decodes them one at a time.
+type CardIterator struct {
+	ctx   context.Context
+	query *CardQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*Card
+	node  *Card
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the Card entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.Card.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (cq *CardQuery) Iterate(ctx context.Context, opts ...IterateOption) (*CardIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := cq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &CardIterator{ctx: ctx, query: cq, batch: o.batch}
+	var (
+		withFKs     = cq.withFKs
+		_spec       = cq.querySpec()
+		loadedTypes = [2]bool{
+			cq.withOwner != nil,
+			cq.withSpec != nil,
+		}
+	)
+	if cq.withOwner != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, card.ForeignKeys...)
+	}
+	var (
+		selected *[5]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Card).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Card{config: cq.config}
+		it.nodes = append(it.nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*Card).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, cq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (cq *CardQuery) iterateLoad(ctx context.Context, nodes []*Card) ([]*Card, error) {
+	query := *cq
+	cq = &query
+	cq.withOwner = cq.withOwner.Clone()
+	cq.withSpec = cq.withSpec.Clone()
+	if named := cq.withNamedSpec; named != nil {
+		cq.withNamedSpec = make(map[string]*SpecQuery, len(named))
+		for name, q := range named {
+			cq.withNamedSpec[name] = q.Clone()
+		}
+	}
+	if query := cq.withOwner; query != nil {
+		if err := cq.loadOwner(ctx, query, nodes, nil,
+			func(n *Card, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withSpec; query != nil {
+		if err := cq.loadSpec(ctx, query, nodes,
+			func(n *Card) { n.Edges.Spec = []*Spec{} },
+			func(n *Card, e *Spec) { n.Edges.Spec = append(n.Edges.Spec, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range cq.withNamedSpec {
+		if err := cq.loadSpec(ctx, query, nodes,
+			func(n *Card) { n.appendNamedSpec(name) },
+			func(n *Card, e *Spec) { n.appendNamedSpec(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// Next advances the iterator to the next Card, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *CardIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*Card, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current Card of the iterator.
+func (it *CardIterator) Value() *Card {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *CardIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *CardIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// Join returns a builder for joining the Card entities with the entities of the given table, that
+// belongs to one of the types that are connected to Card by an edge. The results are returned
+// as typed rows holding both entities. Note that the joined entities are queried using their own query
+// builder, and therefore, their privacy policies are applied as usual.
+//
+//	rows, err := client.Card.Query().
+//		Join(user.Table).
+//		On(left, right).
+//		All(ctx)
+//
+func (cq *CardQuery) Join(table string) *CardJoin {
+	return &CardJoin{query: cq, table: table}
+}
+
+// CardJoinRow is a row that is returned by CardJoin. It holds the Card entity,
+// and the joined entity in the field of its type. The rest of the fields are nil.
+type CardJoinRow struct {
+	Card *Card
+	User *User
+	Spec *Spec
+}
+
+// CardJoin is the builder for joining Card entities with the entities of another type.
+type CardJoin struct {
+	query   *CardQuery
+	table   string
+	on      []string
+	columns []string
+}
+
+// On sets the columns of the join condition. The left column belongs
+// to Card, and the right column belongs to the joined table.
+func (cj *CardJoin) On(left, right string) *CardJoin {
+	cj.on = []string{left, right}
+	return cj
+}
+
+// Select sets the columns of the joined table to be selected. By default, all columns are selected.
+func (cj *CardJoin) Select(columns ...string) *CardJoin {
+	cj.columns = append(cj.columns, columns...)
+	return cj
+}
+
+// All executes the join query and returns the joined rows.
+func (cj *CardJoin) All(ctx context.Context) ([]*CardJoinRow, error) {
+	if len(cj.on) != 2 {
+		return nil, &ValidationError{Name: cj.table, err: errors.New("ent: missing join condition for Card")}
+	}
+	if !card.ValidColumn(cj.on[0]) {
+		return nil, &ValidationError{Name: cj.on[0], err: fmt.Errorf("ent: invalid join column %q for Card", cj.on[0])}
+	}
+	var (
+		joined   *sql.Selector
+		jcolumns []string
+		scan     func(*CardJoinRow) ([]interface{}, func([]interface{}) error, error)
+	)
+	switch cj.table {
+	case user.Table:
+		if !user.ValidColumn(cj.on[1]) {
+			return nil, &ValidationError{Name: cj.on[1], err: fmt.Errorf("ent: invalid join column %q for User", cj.on[1])}
+		}
+		query := (&UserClient{config: cj.query.config}).Query()
+		if len(cj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{user.FieldID, cj.on[1]}, cj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = user.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *CardJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.User = &User{config: cj.query.config}
+			values, err := r.User.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.User.assignValues(jcolumns, values) }, err
+		}
+	case spec.Table:
+		if !spec.ValidColumn(cj.on[1]) {
+			return nil, &ValidationError{Name: cj.on[1], err: fmt.Errorf("ent: invalid join column %q for Spec", cj.on[1])}
+		}
+		query := (&SpecClient{config: cj.query.config}).Query()
+		if len(cj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{spec.FieldID, cj.on[1]}, cj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = spec.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *CardJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.Spec = &Spec{config: cj.query.config}
+			values, err := r.Spec.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.Spec.assignValues(jcolumns, values) }, err
+		}
+	default:
+		return nil, &ValidationError{Name: cj.table, err: fmt.Errorf("ent: cannot join Card with table %q", cj.table)}
+	}
+	if err := cj.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := cj.query.fields
+	if len(columns) == 0 {
+		columns = card.Columns
+	}
+	selector := cj.query.sqlQuery(ctx)
+	selector.Join(joined).On(selector.C(cj.on[0]), joined.C(cj.on[1]))
+	selector.AppendSelect(joined.Columns(jcolumns...)...)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cj.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*CardJoinRow
+	for rows.Next() {
+		r := &CardJoinRow{Card: &Card{config: cj.query.config}}
+		values, err := r.Card.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		jvalues, assign, err := scan(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(append(values, jvalues...)...); err != nil {
+			return nil, err
+		}
+		if err := r.Card.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		if err := assign(jvalues); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cj *CardJoin) AllX(ctx context.Context) []*CardJoinRow {
+	rows, err := cj.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// LoadStrategy sets the strategy for eager-loading the Card entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (cq *CardQuery) LoadStrategy(s LoadStrategy) *CardQuery {
+	cq.loadStrategy = s
+	return cq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (cq *CardQuery) joinable() bool {
+	if cq.loadStrategy != LoadStrategyJoin || cq.limit != nil || cq.offset != nil {
+		return false
+	}
+	if cq.withOwner != nil {
+		return false
+	}
+	if cq.withSpec != nil || len(cq.withNamedSpec) > 0 {
+		return false
+	}
+	if len(cq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range cq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -677,7 +1273,7 @@ func (cq *CardQuery) Modify(modifiers ...func(s *sql.Selector)) *CardSelect {
 // WithNamedSpec tells the query-builder to eager-load the nodes that are connected to the "spec"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (cq *CardQuery) WithNamedSpec(name string, opts ...func(*SpecQuery)) *CardQuery {
-	query := &SpecQuery{config: cq.config}
+	query := (&SpecClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -688,6 +1284,451 @@ func (cq *CardQuery) WithNamedSpec(name string, opts ...func(*SpecQuery)) *CardQ
 	return cq
 }
 
+// Project returns a builder for querying the given fields into CardProjection structs,
+// instead of full Card entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.Card.Query().
+//		Project(card.FieldID, card.FieldCreateTime).
+//		All(ctx)
+//
+func (cq *CardQuery) Project(fields ...string) *CardProject {
+	cq.fields = append(cq.fields, fields...)
+	return &CardProject{query: cq}
+}
+
+// CardProjection is a lightweight projection of the Card entity that is returned by
+// CardProject. Fields that were not selected by the query hold their zero values.
+type CardProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Balance holds the value of the "balance" field.
+	Balance float64 `json:"balance,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"-"`
+	// Name exactly as written on card.
+	Name string `json:"name,omitempty"`
+}
+
+// CardProject is the builder for querying Card fields into projections.
+type CardProject struct {
+	query *CardQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (cp *CardProject) All(ctx context.Context) ([]*CardProjection, error) {
+	if err := cp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := cp.query.fields
+	if len(columns) == 0 {
+		columns = card.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := cp.query.sqlQuery(ctx).Query()
+	if err := cp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &Card{}
+		result []*CardProjection
+	)
+	for rows.Next() {
+		*e = Card{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &CardProjection{
+			ID:         e.ID,
+			CreateTime: e.CreateTime,
+			UpdateTime: e.UpdateTime,
+			Balance:    e.Balance,
+			Number:     e.Number,
+			Name:       e.Name,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cp *CardProject) AllX(ctx context.Context) []*CardProjection {
+	items, err := cp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (cq *CardQuery) onlyShared(ctx context.Context) (*Card, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	cq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *cq
+	kq.sql = cq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if cq.singleflightScope != nil {
+		scope = cq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := cq.singleflight.DoChan(singleflightKey(scope, card.Label, query, args), func() (interface{}, error) {
+		nodes, err := cq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, cq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{card.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*Card)
+		return &node, nil
+	}
+}
+
+// BucketBy groups the query results into time buckets of the given time field, and is used with
+// aggregate functions for time-series queries. The interval format is "<n> <unit>", for example,
+// "1 hour" or "15 minutes", and the supported units are: second, minute, hour, day, week, month
+// and year (the last two do not support multiple units).
+//
+//	buckets, err := client.Card.Query().
+//		BucketBy(card.FieldCreateTime, "1 hour").
+//		Aggregate(ent.Count()).
+//		Buckets(ctx)
+//
+func (cq *CardQuery) BucketBy(field, interval string) *CardBucketBy {
+	bbuild := &CardBucketBy{config: cq.config, field: field, interval: interval}
+	bbuild.path = func(ctx context.Context) (*sql.Selector, error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cq.sqlQuery(ctx), nil
+	}
+	return bbuild
+}
+
+// CardBucketBy is the builder for aggregating Card entities by time buckets.
+type CardBucketBy struct {
+	config
+	field    string
+	interval string
+	fns      []AggregateFunc
+	path     func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the bucket-by query.
+func (cbb *CardBucketBy) Aggregate(fns ...AggregateFunc) *CardBucketBy {
+	cbb.fns = append(cbb.fns, fns...)
+	return cbb
+}
+
+// Buckets executes the query and returns the time buckets ordered by their start time.
+func (cbb *CardBucketBy) Buckets(ctx context.Context) ([]*TimeBucket, error) {
+	switch cbb.field {
+	case card.FieldCreateTime, card.FieldUpdateTime:
+	default:
+		return nil, &ValidationError{Name: cbb.field, err: fmt.Errorf("invalid time field %q for bucket-by", cbb.field)}
+	}
+	interval, err := sqltime.ParseInterval(cbb.interval)
+	if err != nil {
+		return nil, err
+	}
+	selector, err := cbb.path(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bucket := sqltime.Bucket(selector.Dialect(), selector.C(cbb.field), interval)
+	columns := make([]string, 0, len(cbb.fns)+1)
+	columns = append(columns, bucket)
+	for _, fn := range cbb.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...).GroupBy(bucket).OrderBy(bucket)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cbb.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanTimeBuckets(rows, len(cbb.fns))
+}
+
+// BucketsX is like Buckets, but panics if an error occurs.
+func (cbb *CardBucketBy) BucketsX(ctx context.Context) []*TimeBucket {
+	buckets, err := cbb.Buckets(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return buckets
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.Card.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (cq *CardQuery) SelectMask(paths ...string) *CardSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case card.FieldID:
+		case card.EdgeOwner, card.EdgeSpec:
+		case "create_time":
+			fields = append(fields, card.FieldCreateTime)
+		case "update_time":
+			fields = append(fields, card.FieldUpdateTime)
+		case "balance":
+			fields = append(fields, card.FieldBalance)
+		case "number":
+			fields = append(fields, card.FieldNumber)
+		case "name":
+			fields = append(fields, card.FieldName)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, card.FieldID)
+	}
+	return cq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the card.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (cq *CardQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = card.FieldID
+	case "number":
+		column = card.FieldNumber
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering Card`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		cq.Order(Asc(column))
+	case DirectionDesc:
+		cq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// CardPage is a page of Card entities returned by the Paginate method.
+type CardPage struct {
+	Nodes []*Card `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of Card entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.Card.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.Card.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (cq *CardQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*CardPage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case card.FieldID:
+			order.column = card.FieldID
+		case card.FieldNumber:
+			order.column = card.FieldNumber
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating Card`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != card.FieldID {
+		order := paginateOrder{name: card.FieldID, column: card.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := cq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &CardPage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case card.FieldID:
+				values[j] = n.ID
+			case card.FieldNumber:
+				values[j] = n.Number
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (cq *CardQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case card.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		case card.FieldNumber:
+			var v string
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (cq *CardQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*Card, error) {
+	if cq.limit != nil {
+		return cq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	cq.limit = &limit
+	defer func() { cq.limit = nil }()
+	nodes, err := cq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: card.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // CardGroupBy is the group-by builder for Card entities.
 type CardGroupBy struct {
 	config
@@ -727,7 +1768,7 @@ func (cgb *CardGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := cgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := cgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -773,7 +1814,7 @@ func (cs *CardSelect) Scan(ctx context.Context, v interface{}) error {
 func (cs *CardSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := cs.sql.Query()
-	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := cs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
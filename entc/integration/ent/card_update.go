@@ -61,6 +61,13 @@ func (cu *CardUpdate) AddBalance(f float64) *CardUpdate {
 	return cu
 }
 
+// SetBalanceIfGreater sets the "balance" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *CardUpdate) SetBalanceIfGreater(f float64) *CardUpdate {
+	cu.mutation.SetBalanceIfGreater(f)
+	return cu
+}
+
 // SetName sets the "name" field.
 func (cu *CardUpdate) SetName(s string) *CardUpdate {
 	cu.mutation.SetName(s)
@@ -211,7 +218,7 @@ func (cu *CardUpdate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (cu *CardUpdate) defaults() {
 	if _, ok := cu.mutation.UpdateTime(); !ok {
-		v := card.UpdateDefaultUpdateTime()
+		v := cu.config.now(card.UpdateDefaultUpdateTime)
 		cu.mutation.SetUpdateTime(v)
 	}
 }
@@ -265,6 +272,13 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: card.FieldBalance,
 		})
 	}
+	if value, ok := cu.mutation.BalanceIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: card.FieldBalance,
+		})
+	}
 	if value, ok := cu.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -369,7 +383,7 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{card.Label}
+			err = &NotFoundError{label: card.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -381,9 +395,18 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // CardUpdateOne is the builder for updating a single Card entity.
 type CardUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CardMutation
+	fields     []string
+	conditions []predicate.Card
+	hooks      []Hook
+	mutation   *CardMutation
+}
+
+// Where appends a list of conditions to the CardUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (cuo *CardUpdateOne) Where(ps ...predicate.Card) *CardUpdateOne {
+	cuo.conditions = append(cuo.conditions, ps...)
+	return cuo
 }
 
 // SetUpdateTime sets the "update_time" field.
@@ -413,6 +436,13 @@ func (cuo *CardUpdateOne) AddBalance(f float64) *CardUpdateOne {
 	return cuo
 }
 
+// SetBalanceIfGreater sets the "balance" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *CardUpdateOne) SetBalanceIfGreater(f float64) *CardUpdateOne {
+	cuo.mutation.SetBalanceIfGreater(f)
+	return cuo
+}
+
 // SetName sets the "name" field.
 func (cuo *CardUpdateOne) SetName(s string) *CardUpdateOne {
 	cuo.mutation.SetName(s)
@@ -576,7 +606,7 @@ func (cuo *CardUpdateOne) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (cuo *CardUpdateOne) defaults() {
 	if _, ok := cuo.mutation.UpdateTime(); !ok {
-		v := card.UpdateDefaultUpdateTime()
+		v := cuo.config.now(card.UpdateDefaultUpdateTime)
 		cuo.mutation.SetUpdateTime(v)
 	}
 }
@@ -626,6 +656,13 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error)
 			}
 		}
 	}
+	if ps := cuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := cuo.mutation.UpdateTime(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -647,6 +684,13 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error)
 			Column: card.FieldBalance,
 		})
 	}
+	if value, ok := cuo.mutation.BalanceIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: card.FieldBalance,
+		})
+	}
 	if value, ok := cuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -754,7 +798,9 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{card.Label}
+			err = &NotFoundError{label: card.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: card.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -762,3 +808,106 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error)
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the Card entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (cuo *CardUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "Card.id" for update`)}
+	}
+	return enqueueAsync(ctx, cuo.config, cuo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Card.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (cu *CardUpdate) SetChanged(changes []FieldChange) (*CardUpdate, error) {
+	if err := setCardChanged(cu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return cu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Card.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (cuo *CardUpdateOne) SetChanged(changes []FieldChange) (*CardUpdateOne, error) {
+	if err := setCardChanged(cuo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return cuo, nil
+}
+
+// setCardChanged applies the given field changes on the Card mutation.
+func setCardChanged(m *CardMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		switch c.Field {
+		case card.FieldCreateTime, card.FieldNumber:
+			return fmt.Errorf("ent: immutable field %q cannot be changed", c.Field)
+		}
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the Card entities that are joined with the rows of the given table, on the given columns
+// of the Card table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.Card.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (cu *CardUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *CardUpdate {
+	cu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return cu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Card schema.
+func (cu *CardUpdate) ApplyPatch(p *CardPatch) (*CardUpdate, error) {
+	if err := applyCardPatch(cu.mutation, p); err != nil {
+		return nil, err
+	}
+	return cu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Card schema.
+func (cuo *CardUpdateOne) ApplyPatch(p *CardPatch) (*CardUpdateOne, error) {
+	if err := applyCardPatch(cuo.mutation, p); err != nil {
+		return nil, err
+	}
+	return cuo, nil
+}
+
+// applyCardPatch applies the given patch on the Card mutation.
+func applyCardPatch(m *CardMutation, p *CardPatch) error {
+	if p.UpdateTime != nil {
+		m.SetUpdateTime(*p.UpdateTime)
+	}
+	if p.Balance != nil {
+		m.SetBalance(*p.Balance)
+	}
+	if p.Name != nil {
+		m.SetName(*p.Name)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
@@ -8,9 +8,11 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 
 	"entgo.io/ent/entc/integration/ent/migrate"
 
@@ -30,9 +32,13 @@ import (
 	enttask "entgo.io/ent/entc/integration/ent/task"
 	"entgo.io/ent/entc/integration/ent/user"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	sqlschema "entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/entc/integration/ent/predicate"
 )
 
 // Client is the client that holds all ent builders.
@@ -75,10 +81,11 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
+	client.AddExtension(ent.RegisteredExtensions()...)
 	return client
 }
 
@@ -120,8 +127,8 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
-	if _, ok := c.driver.(*txDriver); ok {
-		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	if parent, ok := c.driver.(*txDriver); ok {
+		return c.savepointTx(ctx, parent)
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -152,8 +159,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 
 // BeginTx returns a transactional client with specified options.
 func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
-	if _, ok := c.driver.(*txDriver); ok {
-		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	if parent, ok := c.driver.(*txDriver); ok {
+		if opts != nil && *opts != (sql.TxOptions{}) {
+			return nil, errors.New("ent: cannot start a transaction with options within a transaction")
+		}
+		return c.savepointTx(ctx, parent)
 	}
 	tx, err := c.driver.(interface {
 		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
@@ -197,14 +207,24 @@ func (c *Client) Debug() *Client {
 	}
 	cfg := c.config
 	cfg.driver = dialect.Debug(c.driver, c.log)
+	if c.readDriver != nil {
+		cfg.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
 	client := &Client{config: cfg}
 	client.init()
 	return client
 }
 
 // Close closes the database connection and prevents new queries from starting.
+// The read driver of the client is closed as well, if it was configured.
 func (c *Client) Close() error {
-	return c.driver.Close()
+	err := c.driver.Close()
+	if _, ok := c.driver.(*txDriver); !ok && c.readDriver != nil {
+		if rerr := c.readDriver.Close(); err == nil {
+			err = rerr
+		}
+	}
+	return err
 }
 
 // Use adds the mutation hooks to all the entity clients.
@@ -227,6 +247,117 @@ func (c *Client) Use(hooks ...Hook) {
 	c.User.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Card.Intercept(interceptors...)
+	c.Comment.Intercept(interceptors...)
+	c.FieldType.Intercept(interceptors...)
+	c.File.Intercept(interceptors...)
+	c.FileType.Intercept(interceptors...)
+	c.Goods.Intercept(interceptors...)
+	c.Group.Intercept(interceptors...)
+	c.GroupInfo.Intercept(interceptors...)
+	c.Item.Intercept(interceptors...)
+	c.License.Intercept(interceptors...)
+	c.Node.Intercept(interceptors...)
+	c.Pet.Intercept(interceptors...)
+	c.Spec.Intercept(interceptors...)
+	c.Task.Intercept(interceptors...)
+	c.User.Intercept(interceptors...)
+}
+
+// AddExtension adds the given extensions to the client. Their hooks and interceptors are added to all the
+// entity clients, and their drivers wrap the drivers of the client. Extensions that decorate the driver
+// should be added before the client is used, as entity clients that were obtained before the call (e.g.
+// `users := client.User`) keep using the previous driver. For example:
+//
+//	client.AddExtension(cache.NewExtension(cache.WithTTL(time.Minute)))
+//
+func (c *Client) AddExtension(exts ...ent.Extension) {
+	if len(exts) == 0 {
+		return
+	}
+	for _, ext := range exts {
+		if drv := ext.Driver(c.driver); drv != nil {
+			c.driver = drv
+		}
+		if c.readDriver != nil {
+			if drv := ext.Driver(c.readDriver); drv != nil {
+				c.readDriver = drv
+			}
+		}
+	}
+	c.extensions = append(c.extensions[:len(c.extensions):len(c.extensions)], exts...)
+	c.init()
+	for _, ext := range exts {
+		c.Use(ext.Hooks()...)
+		c.Intercept(ext.Interceptors()...)
+	}
+}
+
+// HealthCheck runs the health checks of the extensions that were added to the client,
+// and returns the first error that was reported.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	for _, ext := range c.extensions {
+		if err := ext.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("ent: extension %q: %w", ext.Name(), err)
+		}
+	}
+	return nil
+}
+
+// WithOptions returns a new client that is derived from c and configured with the given options.
+// Hooks that are registered on the new client using Use are not added to c (and vice versa). For
+// example, creating a client for trusted background jobs:
+//
+//	client.WithOptions(ent.ReplaceHooks(AuditHook()), ent.SkipPrivacy()).
+//		Card.
+//		Delete().
+//		Exec(ctx)
+//
+func (c *Client) WithOptions(opts ...Option) *Client {
+	cfg := c.config
+	cfg.hooks = &hooks{
+		Card:      c.hooks.Card[:len(c.hooks.Card):len(c.hooks.Card)],
+		Comment:   c.hooks.Comment[:len(c.hooks.Comment):len(c.hooks.Comment)],
+		FieldType: c.hooks.FieldType[:len(c.hooks.FieldType):len(c.hooks.FieldType)],
+		File:      c.hooks.File[:len(c.hooks.File):len(c.hooks.File)],
+		FileType:  c.hooks.FileType[:len(c.hooks.FileType):len(c.hooks.FileType)],
+		Goods:     c.hooks.Goods[:len(c.hooks.Goods):len(c.hooks.Goods)],
+		Group:     c.hooks.Group[:len(c.hooks.Group):len(c.hooks.Group)],
+		GroupInfo: c.hooks.GroupInfo[:len(c.hooks.GroupInfo):len(c.hooks.GroupInfo)],
+		Item:      c.hooks.Item[:len(c.hooks.Item):len(c.hooks.Item)],
+		License:   c.hooks.License[:len(c.hooks.License):len(c.hooks.License)],
+		Node:      c.hooks.Node[:len(c.hooks.Node):len(c.hooks.Node)],
+		Pet:       c.hooks.Pet[:len(c.hooks.Pet):len(c.hooks.Pet)],
+		Spec:      c.hooks.Spec[:len(c.hooks.Spec):len(c.hooks.Spec)],
+		Task:      c.hooks.Task[:len(c.hooks.Task):len(c.hooks.Task)],
+		User:      c.hooks.User[:len(c.hooks.User):len(c.hooks.User)],
+	}
+	cfg.inters = &inters{
+		Card:      c.inters.Card[:len(c.inters.Card):len(c.inters.Card)],
+		Comment:   c.inters.Comment[:len(c.inters.Comment):len(c.inters.Comment)],
+		FieldType: c.inters.FieldType[:len(c.inters.FieldType):len(c.inters.FieldType)],
+		File:      c.inters.File[:len(c.inters.File):len(c.inters.File)],
+		FileType:  c.inters.FileType[:len(c.inters.FileType):len(c.inters.FileType)],
+		Goods:     c.inters.Goods[:len(c.inters.Goods):len(c.inters.Goods)],
+		Group:     c.inters.Group[:len(c.inters.Group):len(c.inters.Group)],
+		GroupInfo: c.inters.GroupInfo[:len(c.inters.GroupInfo):len(c.inters.GroupInfo)],
+		Item:      c.inters.Item[:len(c.inters.Item):len(c.inters.Item)],
+		License:   c.inters.License[:len(c.inters.License):len(c.inters.License)],
+		Node:      c.inters.Node[:len(c.inters.Node):len(c.inters.Node)],
+		Pet:       c.inters.Pet[:len(c.inters.Pet):len(c.inters.Pet)],
+		Spec:      c.inters.Spec[:len(c.inters.Spec):len(c.inters.Spec)],
+		Task:      c.inters.Task[:len(c.inters.Task):len(c.inters.Task)],
+		User:      c.inters.User[:len(c.inters.User):len(c.inters.User)],
+	}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
 // Dialect returns the driver dialect.
 func (c *Client) Dialect() string {
 	return c.driver.Dialect()
@@ -237,6 +368,61 @@ func (c *Client) Driver() dialect.Driver {
 	return c.driver
 }
 
+// CreateIdempotencyTable creates the table of the idempotency keys, if it does not exist.
+func (c *Client) CreateIdempotencyTable(ctx context.Context) error {
+	return c.idempotencyStore().Create(ctx, c.driver)
+}
+
+// Snapshot returns a read-only transactional client, whose queries observe the database at a single point
+// in time (established by its first query). It is useful for exporting multiple entities consistently, while
+// other clients keep writing to the database. The transaction is opened with the REPEATABLE READ isolation
+// level (SQLite transactions are always serializable), and must be ended using Rollback once the export is done.
+//
+//	snap, err := client.Snapshot(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer snap.Rollback()
+//	users, err := snap.User.Query().All(ctx)
+//	// ...
+//	groups, err := snap.Group.Query().All(ctx)
+//
+func (c *Client) Snapshot(ctx context.Context) (*Tx, error) {
+	opts := &sql.TxOptions{Isolation: stdsql.LevelRepeatableRead, ReadOnly: true}
+	// SQLite transactions are serializable, and its drivers do not necessarily support these options.
+	if c.driver.Dialect() == dialect.SQLite {
+		opts = &sql.TxOptions{}
+	}
+	return c.BeginTx(ctx, opts)
+}
+
+// TruncateOption allows configuring the Truncate calls of the clients.
+type TruncateOption func(*sqlgraph.TruncateSpec)
+
+// Cascade truncates the tables that reference the truncated table using foreign-keys,
+// directly or indirectly, as well. For example:
+//
+//	client.User.Truncate(ctx, ent.Cascade)
+//
+func Cascade(s *sqlgraph.TruncateSpec) {
+	s.Cascade = true
+}
+
+// ResetAll removes all rows from the tables of the graph, and resets their identity (auto-increment)
+// sequences. It is mostly useful for resetting the database between tests, as it is usually faster
+// than dropping and recreating the schema. Note that in MySQL, TRUNCATE causes an implicit commit,
+// and therefore, it cannot be rolled back.
+func (c *Client) ResetAll(ctx context.Context) error {
+	names := make([]string, len(migrate.Tables))
+	for i, t := range migrate.Tables {
+		names[i] = t.Name
+	}
+	return sqlgraph.Truncate(ctx, c.driver, &sqlgraph.TruncateSpec{
+		Tables:  sqlschema.Dependents(migrate.Tables, names...),
+		Cascade: true,
+	})
+}
+
 // CardClient is a client for the Card schema.
 type CardClient struct {
 	config
@@ -253,6 +439,12 @@ func (c *CardClient) Use(hooks ...Hook) {
 	c.hooks.Card = append(c.hooks.Card, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `card.Intercept(f(g(h())))`.
+func (c *CardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Card = append(c.inters.Card, interceptors...)
+}
+
 // Create returns a builder for creating a Card entity.
 func (c *CardClient) Create() *CardCreate {
 	mutation := newCardMutation(c.config, OpCreate)
@@ -305,12 +497,17 @@ func (c *CardClient) DeleteOneID(id int) *CardDeleteOne {
 func (c *CardClient) Query() *CardQuery {
 	return &CardQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Card entity by its id.
 func (c *CardClient) Get(ctx context.Context, id int) (*Card, error) {
-	return c.Query().Where(card.ID(id)).Only(ctx)
+	node, err := c.Query().Where(card.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -324,7 +521,7 @@ func (c *CardClient) GetX(ctx context.Context, id int) *Card {
 
 // QueryOwner queries the owner edge of a Card.
 func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ca.ID
 		step := sqlgraph.NewStep(
@@ -340,7 +537,7 @@ func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
 
 // QuerySpec queries the spec edge of a Card.
 func (c *CardClient) QuerySpec(ca *Card) *SpecQuery {
-	query := &SpecQuery{config: c.config}
+	query := (&SpecClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ca.ID
 		step := sqlgraph.NewStep(
@@ -354,11 +551,266 @@ func (c *CardClient) QuerySpec(ca *Card) *SpecQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *CardClient) Interceptors() []Interceptor {
+	return c.inters.Card
+}
+
 // Hooks returns the client hooks.
 func (c *CardClient) Hooks() []Hook {
 	return c.hooks.Card
 }
 
+// FindDuplicates returns the groups of Card entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *CardClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding Card duplicates")
+	}
+	for _, f := range fields {
+		if !card.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(card.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(card.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(card.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *CardClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the Card entities with the duplicates ids into the Card with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *CardClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(card.Label, o.transfer)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{card.FieldUpdateTime, card.FieldBalance, card.FieldName}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewCardClient(cfg)
+		nodes := make([]*Card, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(card.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of Card merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newCardMutation(cfg, OpUpdateOne, withCard(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only Card entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *CardClient) GetOrCreate(ctx context.Context, ps []predicate.Card, setters ...func(*CardCreate)) (*Card, error) {
+	return c.getOrCreate(ctx, (*CardQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first Card entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *CardClient) FirstOrCreate(ctx context.Context, ps []predicate.Card, setters ...func(*CardCreate)) (*Card, error) {
+	return c.getOrCreate(ctx, (*CardQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the Card entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.Card.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *CardClient) LockOrCreate(ctx context.Context, ps []predicate.Card, setters ...func(*CardCreate)) (*Card, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *CardQuery, ctx context.Context) (*Card, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *CardClient) getOrCreate(ctx context.Context, get func(*CardQuery, context.Context) (*Card, error), ps []predicate.Card, setters []func(*CardCreate)) (*Card, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*Card, error) {
+		return get((&CardClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *CardClient) createOrRollback(ctx context.Context, create *CardCreate) (*Card, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating Card entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *CardClient) CreateFromQuery(query InsertSelectQuery) *CardInsertSelect {
+	return &CardInsertSelect{config: c.config, query: query}
+}
+
+// transfer rewires the selected edges of the Card with the "from" id to the Card with the "to" id.
+func (c *CardClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	return nil
+}
+
+// Truncate removes all Card entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *CardClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{card.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, card.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // CommentClient is a client for the Comment schema.
 type CommentClient struct {
 	config
@@ -375,6 +827,12 @@ func (c *CommentClient) Use(hooks ...Hook) {
 	c.hooks.Comment = append(c.hooks.Comment, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
+func (c *CommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
+}
+
 // Create returns a builder for creating a Comment entity.
 func (c *CommentClient) Create() *CommentCreate {
 	mutation := newCommentMutation(c.config, OpCreate)
@@ -427,12 +885,17 @@ func (c *CommentClient) DeleteOneID(id int) *CommentDeleteOne {
 func (c *CommentClient) Query() *CommentQuery {
 	return &CommentQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Comment entity by its id.
 func (c *CommentClient) Get(ctx context.Context, id int) (*Comment, error) {
-	return c.Query().Where(comment.ID(id)).Only(ctx)
+	node, err := c.Query().Where(comment.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -444,11 +907,275 @@ func (c *CommentClient) GetX(ctx context.Context, id int) *Comment {
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *CommentClient) Interceptors() []Interceptor {
+	return c.inters.Comment
+}
+
 // Hooks returns the client hooks.
 func (c *CommentClient) Hooks() []Hook {
 	return c.hooks.Comment
 }
 
+// FindDuplicates returns the groups of Comment entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *CommentClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding Comment duplicates")
+	}
+	for _, f := range fields {
+		if !comment.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(comment.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(comment.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(comment.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *CommentClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the Comment entities with the duplicates ids into the Comment with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *CommentClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(comment.Label, o.transfer)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{comment.FieldUniqueInt, comment.FieldUniqueFloat, comment.FieldNillableInt, comment.FieldTable, comment.FieldDir}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewCommentClient(cfg)
+		nodes := make([]*Comment, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(comment.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of Comment merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newCommentMutation(cfg, OpUpdateOne, withComment(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only Comment entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// For example:
+//
+//	v, err := client.Comment.GetOrCreate(ctx,
+//		[]predicate.Comment{comment.UniqueInt(value)},
+//		func(c *CommentCreate) {
+//			c.SetUniqueInt(value)
+//		},
+//	)
+//
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *CommentClient) GetOrCreate(ctx context.Context, ps []predicate.Comment, setters ...func(*CommentCreate)) (*Comment, error) {
+	return c.getOrCreate(ctx, (*CommentQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first Comment entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *CommentClient) FirstOrCreate(ctx context.Context, ps []predicate.Comment, setters ...func(*CommentCreate)) (*Comment, error) {
+	return c.getOrCreate(ctx, (*CommentQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the Comment entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.Comment.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *CommentClient) LockOrCreate(ctx context.Context, ps []predicate.Comment, setters ...func(*CommentCreate)) (*Comment, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *CommentQuery, ctx context.Context) (*Comment, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *CommentClient) getOrCreate(ctx context.Context, get func(*CommentQuery, context.Context) (*Comment, error), ps []predicate.Comment, setters []func(*CommentCreate)) (*Comment, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*Comment, error) {
+		return get((&CommentClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *CommentClient) createOrRollback(ctx context.Context, create *CommentCreate) (*Comment, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating Comment entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *CommentClient) CreateFromQuery(query InsertSelectQuery) *CommentInsertSelect {
+	return &CommentInsertSelect{config: c.config, query: query}
+}
+
+// transfer rewires the selected edges of the Comment with the "from" id to the Comment with the "to" id.
+func (c *CommentClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	return nil
+}
+
+// Truncate removes all Comment entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *CommentClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{comment.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, comment.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // FieldTypeClient is a client for the FieldType schema.
 type FieldTypeClient struct {
 	config
@@ -465,6 +1192,12 @@ func (c *FieldTypeClient) Use(hooks ...Hook) {
 	c.hooks.FieldType = append(c.hooks.FieldType, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fieldtype.Intercept(f(g(h())))`.
+func (c *FieldTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FieldType = append(c.inters.FieldType, interceptors...)
+}
+
 // Create returns a builder for creating a FieldType entity.
 func (c *FieldTypeClient) Create() *FieldTypeCreate {
 	mutation := newFieldTypeMutation(c.config, OpCreate)
@@ -517,12 +1250,17 @@ func (c *FieldTypeClient) DeleteOneID(id int) *FieldTypeDeleteOne {
 func (c *FieldTypeClient) Query() *FieldTypeQuery {
 	return &FieldTypeQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a FieldType entity by its id.
 func (c *FieldTypeClient) Get(ctx context.Context, id int) (*FieldType, error) {
-	return c.Query().Where(fieldtype.ID(id)).Only(ctx)
+	node, err := c.Query().Where(fieldtype.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -534,11 +1272,266 @@ func (c *FieldTypeClient) GetX(ctx context.Context, id int) *FieldType {
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *FieldTypeClient) Interceptors() []Interceptor {
+	return c.inters.FieldType
+}
+
 // Hooks returns the client hooks.
 func (c *FieldTypeClient) Hooks() []Hook {
 	return c.hooks.FieldType
 }
 
+// FindDuplicates returns the groups of FieldType entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *FieldTypeClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding FieldType duplicates")
+	}
+	for _, f := range fields {
+		if !fieldtype.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(fieldtype.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(fieldtype.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(fieldtype.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *FieldTypeClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the FieldType entities with the duplicates ids into the FieldType with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *FieldTypeClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(fieldtype.Label, o.transfer)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{fieldtype.FieldInt, fieldtype.FieldInt8, fieldtype.FieldInt16, fieldtype.FieldInt32, fieldtype.FieldInt64, fieldtype.FieldOptionalInt, fieldtype.FieldOptionalInt8, fieldtype.FieldOptionalInt16, fieldtype.FieldOptionalInt32, fieldtype.FieldOptionalInt64, fieldtype.FieldNillableInt, fieldtype.FieldNillableInt8, fieldtype.FieldNillableInt16, fieldtype.FieldNillableInt32, fieldtype.FieldNillableInt64, fieldtype.FieldValidateOptionalInt32, fieldtype.FieldOptionalUint, fieldtype.FieldOptionalUint8, fieldtype.FieldOptionalUint16, fieldtype.FieldOptionalUint32, fieldtype.FieldOptionalUint64, fieldtype.FieldState, fieldtype.FieldOptionalFloat, fieldtype.FieldOptionalFloat32, fieldtype.FieldText, fieldtype.FieldDatetime, fieldtype.FieldDecimal, fieldtype.FieldLinkOther, fieldtype.FieldLinkOtherFunc, fieldtype.FieldMAC, fieldtype.FieldStringArray, fieldtype.FieldPassword, fieldtype.FieldStringScanner, fieldtype.FieldDuration, fieldtype.FieldDir, fieldtype.FieldNdir, fieldtype.FieldStr, fieldtype.FieldNullStr, fieldtype.FieldLink, fieldtype.FieldNullLink, fieldtype.FieldActive, fieldtype.FieldNullActive, fieldtype.FieldDeleted, fieldtype.FieldDeletedAt, fieldtype.FieldRawData, fieldtype.FieldSensitive, fieldtype.FieldIP, fieldtype.FieldNullInt64, fieldtype.FieldSchemaInt, fieldtype.FieldSchemaInt8, fieldtype.FieldSchemaInt64, fieldtype.FieldSchemaFloat, fieldtype.FieldSchemaFloat32, fieldtype.FieldNullFloat, fieldtype.FieldRole, fieldtype.FieldPriority, fieldtype.FieldOptionalUUID, fieldtype.FieldNillableUUID, fieldtype.FieldStrings, fieldtype.FieldPair, fieldtype.FieldNilPair, fieldtype.FieldVstring, fieldtype.FieldTriple, fieldtype.FieldBigInt, fieldtype.FieldPasswordOther}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewFieldTypeClient(cfg)
+		nodes := make([]*FieldType, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(fieldtype.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of FieldType merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newFieldTypeMutation(cfg, OpUpdateOne, withFieldType(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only FieldType entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *FieldTypeClient) GetOrCreate(ctx context.Context, ps []predicate.FieldType, setters ...func(*FieldTypeCreate)) (*FieldType, error) {
+	return c.getOrCreate(ctx, (*FieldTypeQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first FieldType entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *FieldTypeClient) FirstOrCreate(ctx context.Context, ps []predicate.FieldType, setters ...func(*FieldTypeCreate)) (*FieldType, error) {
+	return c.getOrCreate(ctx, (*FieldTypeQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the FieldType entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.FieldType.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *FieldTypeClient) LockOrCreate(ctx context.Context, ps []predicate.FieldType, setters ...func(*FieldTypeCreate)) (*FieldType, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *FieldTypeQuery, ctx context.Context) (*FieldType, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *FieldTypeClient) getOrCreate(ctx context.Context, get func(*FieldTypeQuery, context.Context) (*FieldType, error), ps []predicate.FieldType, setters []func(*FieldTypeCreate)) (*FieldType, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*FieldType, error) {
+		return get((&FieldTypeClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *FieldTypeClient) createOrRollback(ctx context.Context, create *FieldTypeCreate) (*FieldType, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating FieldType entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *FieldTypeClient) CreateFromQuery(query InsertSelectQuery) *FieldTypeInsertSelect {
+	return &FieldTypeInsertSelect{config: c.config, query: query}
+}
+
+// transfer rewires the selected edges of the FieldType with the "from" id to the FieldType with the "to" id.
+func (c *FieldTypeClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	return nil
+}
+
+// Truncate removes all FieldType entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *FieldTypeClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{fieldtype.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, fieldtype.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // FileClient is a client for the File schema.
 type FileClient struct {
 	config
@@ -555,6 +1548,12 @@ func (c *FileClient) Use(hooks ...Hook) {
 	c.hooks.File = append(c.hooks.File, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `file.Intercept(f(g(h())))`.
+func (c *FileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.File = append(c.inters.File, interceptors...)
+}
+
 // Create returns a builder for creating a File entity.
 func (c *FileClient) Create() *FileCreate {
 	mutation := newFileMutation(c.config, OpCreate)
@@ -607,12 +1606,17 @@ func (c *FileClient) DeleteOneID(id int) *FileDeleteOne {
 func (c *FileClient) Query() *FileQuery {
 	return &FileQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a File entity by its id.
 func (c *FileClient) Get(ctx context.Context, id int) (*File, error) {
-	return c.Query().Where(file.ID(id)).Only(ctx)
+	node, err := c.Query().Where(file.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -626,7 +1630,7 @@ func (c *FileClient) GetX(ctx context.Context, id int) *File {
 
 // QueryOwner queries the owner edge of a File.
 func (c *FileClient) QueryOwner(f *File) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := f.ID
 		step := sqlgraph.NewStep(
@@ -642,7 +1646,7 @@ func (c *FileClient) QueryOwner(f *File) *UserQuery {
 
 // QueryType queries the type edge of a File.
 func (c *FileClient) QueryType(f *File) *FileTypeQuery {
-	query := &FileTypeQuery{config: c.config}
+	query := (&FileTypeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := f.ID
 		step := sqlgraph.NewStep(
@@ -658,7 +1662,7 @@ func (c *FileClient) QueryType(f *File) *FileTypeQuery {
 
 // QueryField queries the field edge of a File.
 func (c *FileClient) QueryField(f *File) *FieldTypeQuery {
-	query := &FieldTypeQuery{config: c.config}
+	query := (&FieldTypeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := f.ID
 		step := sqlgraph.NewStep(
@@ -672,13 +1676,305 @@ func (c *FileClient) QueryField(f *File) *FieldTypeQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *FileClient) Interceptors() []Interceptor {
+	return c.inters.File
+}
+
 // Hooks returns the client hooks.
 func (c *FileClient) Hooks() []Hook {
 	return c.hooks.File
 }
 
-// FileTypeClient is a client for the FileType schema.
-type FileTypeClient struct {
+// FindDuplicates returns the groups of File entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *FileClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding File duplicates")
+	}
+	for _, f := range fields {
+		if !file.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(file.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(file.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(file.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *FileClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the File entities with the duplicates ids into the File with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *FileClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(file.Label, o.transfer, file.EdgeField)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{file.FieldSize, file.FieldName, file.FieldUser, file.FieldGroup, file.FieldOp}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewFileClient(cfg)
+		nodes := make([]*File, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(file.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of File merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newFileMutation(cfg, OpUpdateOne, withFile(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only File entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *FileClient) GetOrCreate(ctx context.Context, ps []predicate.File, setters ...func(*FileCreate)) (*File, error) {
+	return c.getOrCreate(ctx, (*FileQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first File entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *FileClient) FirstOrCreate(ctx context.Context, ps []predicate.File, setters ...func(*FileCreate)) (*File, error) {
+	return c.getOrCreate(ctx, (*FileQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the File entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.File.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *FileClient) LockOrCreate(ctx context.Context, ps []predicate.File, setters ...func(*FileCreate)) (*File, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *FileQuery, ctx context.Context) (*File, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *FileClient) getOrCreate(ctx context.Context, get func(*FileQuery, context.Context) (*File, error), ps []predicate.File, setters []func(*FileCreate)) (*File, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*File, error) {
+		return get((&FileClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *FileClient) createOrRollback(ctx context.Context, create *FileCreate) (*File, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating File entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *FileClient) CreateFromQuery(query InsertSelectQuery) *FileInsertSelect {
+	return &FileInsertSelect{config: c.config, query: query}
+}
+
+// TransferOwnership moves the entities that are owned by the File with the "from" id to the File with
+// the "to" id, by rewiring its edges (field) in a single transaction. Use the TransferEdges
+// and TransferSkipEdges options to choose the edges to rewire. Mutations are executed using the builders of
+// the clients, and therefore, their hooks and privacy policies are applied.
+func (c *FileClient) TransferOwnership(ctx context.Context, from, to int, opts ...TransferOption) error {
+	edges, err := transferEdges(file.Label, opts, file.EdgeField)
+	if err != nil {
+		return err
+	}
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewFileClient(cfg)
+		if n, err := client.Query().Where(file.IDIn(from, to)).Count(ctx); err != nil {
+			return err
+		} else if n != 2 {
+			return &NotFoundError{label: file.Label}
+		}
+		return client.transfer(ctx, from, to, edges)
+	})
+}
+
+// transfer rewires the selected edges of the File with the "from" id to the File with the "to" id.
+func (c *FileClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	if edges[file.EdgeField] {
+		ids, err := c.Query().Where(file.ID(from)).QueryField().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		add := ids
+		if len(ids) > 0 {
+			if err := c.UpdateOneID(from).RemoveFieldIDs(ids...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if len(add) > 0 {
+			if err := c.UpdateOneID(to).AddFieldIDs(add...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Truncate removes all File entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *FileClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{file.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, file.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
+// FileTypeClient is a client for the FileType schema.
+type FileTypeClient struct {
 	config
 }
 
@@ -693,6 +1989,12 @@ func (c *FileTypeClient) Use(hooks ...Hook) {
 	c.hooks.FileType = append(c.hooks.FileType, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `filetype.Intercept(f(g(h())))`.
+func (c *FileTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileType = append(c.inters.FileType, interceptors...)
+}
+
 // Create returns a builder for creating a FileType entity.
 func (c *FileTypeClient) Create() *FileTypeCreate {
 	mutation := newFileTypeMutation(c.config, OpCreate)
@@ -745,12 +2047,17 @@ func (c *FileTypeClient) DeleteOneID(id int) *FileTypeDeleteOne {
 func (c *FileTypeClient) Query() *FileTypeQuery {
 	return &FileTypeQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a FileType entity by its id.
 func (c *FileTypeClient) Get(ctx context.Context, id int) (*FileType, error) {
-	return c.Query().Where(filetype.ID(id)).Only(ctx)
+	node, err := c.Query().Where(filetype.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -764,7 +2071,7 @@ func (c *FileTypeClient) GetX(ctx context.Context, id int) *FileType {
 
 // QueryFiles queries the files edge of a FileType.
 func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
-	query := &FileQuery{config: c.config}
+	query := (&FileClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ft.ID
 		step := sqlgraph.NewStep(
@@ -778,11 +2085,304 @@ func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *FileTypeClient) Interceptors() []Interceptor {
+	return c.inters.FileType
+}
+
 // Hooks returns the client hooks.
 func (c *FileTypeClient) Hooks() []Hook {
 	return c.hooks.FileType
 }
 
+// FindDuplicates returns the groups of FileType entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *FileTypeClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding FileType duplicates")
+	}
+	for _, f := range fields {
+		if !filetype.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(filetype.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(filetype.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(filetype.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *FileTypeClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the FileType entities with the duplicates ids into the FileType with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *FileTypeClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(filetype.Label, o.transfer, filetype.EdgeFiles)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{filetype.FieldName, filetype.FieldType, filetype.FieldState}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewFileTypeClient(cfg)
+		nodes := make([]*FileType, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(filetype.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of FileType merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newFileTypeMutation(cfg, OpUpdateOne, withFileType(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only FileType entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// For example:
+//
+//	v, err := client.FileType.GetOrCreate(ctx,
+//		[]predicate.FileType{filetype.Name(value)},
+//		func(c *FileTypeCreate) {
+//			c.SetName(value)
+//		},
+//	)
+//
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *FileTypeClient) GetOrCreate(ctx context.Context, ps []predicate.FileType, setters ...func(*FileTypeCreate)) (*FileType, error) {
+	return c.getOrCreate(ctx, (*FileTypeQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first FileType entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *FileTypeClient) FirstOrCreate(ctx context.Context, ps []predicate.FileType, setters ...func(*FileTypeCreate)) (*FileType, error) {
+	return c.getOrCreate(ctx, (*FileTypeQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the FileType entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.FileType.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *FileTypeClient) LockOrCreate(ctx context.Context, ps []predicate.FileType, setters ...func(*FileTypeCreate)) (*FileType, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *FileTypeQuery, ctx context.Context) (*FileType, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *FileTypeClient) getOrCreate(ctx context.Context, get func(*FileTypeQuery, context.Context) (*FileType, error), ps []predicate.FileType, setters []func(*FileTypeCreate)) (*FileType, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*FileType, error) {
+		return get((&FileTypeClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *FileTypeClient) createOrRollback(ctx context.Context, create *FileTypeCreate) (*FileType, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating FileType entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *FileTypeClient) CreateFromQuery(query InsertSelectQuery) *FileTypeInsertSelect {
+	return &FileTypeInsertSelect{config: c.config, query: query}
+}
+
+// TransferOwnership moves the entities that are owned by the FileType with the "from" id to the FileType with
+// the "to" id, by rewiring its edges (files) in a single transaction. Use the TransferEdges
+// and TransferSkipEdges options to choose the edges to rewire. Mutations are executed using the builders of
+// the clients, and therefore, their hooks and privacy policies are applied.
+func (c *FileTypeClient) TransferOwnership(ctx context.Context, from, to int, opts ...TransferOption) error {
+	edges, err := transferEdges(filetype.Label, opts, filetype.EdgeFiles)
+	if err != nil {
+		return err
+	}
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewFileTypeClient(cfg)
+		if n, err := client.Query().Where(filetype.IDIn(from, to)).Count(ctx); err != nil {
+			return err
+		} else if n != 2 {
+			return &NotFoundError{label: filetype.Label}
+		}
+		return client.transfer(ctx, from, to, edges)
+	})
+}
+
+// transfer rewires the selected edges of the FileType with the "from" id to the FileType with the "to" id.
+func (c *FileTypeClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	if edges[filetype.EdgeFiles] {
+		err := NewFileClient(c.config).Update().
+			Where(file.HasTypeWith(filetype.ID(from))).
+			SetTypeID(to).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Truncate removes all FileType entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *FileTypeClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{filetype.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, filetype.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // GoodsClient is a client for the Goods schema.
 type GoodsClient struct {
 	config
@@ -799,6 +2399,12 @@ func (c *GoodsClient) Use(hooks ...Hook) {
 	c.hooks.Goods = append(c.hooks.Goods, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goods.Intercept(f(g(h())))`.
+func (c *GoodsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Goods = append(c.inters.Goods, interceptors...)
+}
+
 // Create returns a builder for creating a Goods entity.
 func (c *GoodsClient) Create() *GoodsCreate {
 	mutation := newGoodsMutation(c.config, OpCreate)
@@ -851,12 +2457,17 @@ func (c *GoodsClient) DeleteOneID(id int) *GoodsDeleteOne {
 func (c *GoodsClient) Query() *GoodsQuery {
 	return &GoodsQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Goods entity by its id.
 func (c *GoodsClient) Get(ctx context.Context, id int) (*Goods, error) {
-	return c.Query().Where(goods.ID(id)).Only(ctx)
+	node, err := c.Query().Where(goods.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -868,11 +2479,266 @@ func (c *GoodsClient) GetX(ctx context.Context, id int) *Goods {
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *GoodsClient) Interceptors() []Interceptor {
+	return c.inters.Goods
+}
+
 // Hooks returns the client hooks.
 func (c *GoodsClient) Hooks() []Hook {
 	return c.hooks.Goods
 }
 
+// FindDuplicates returns the groups of Goods entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *GoodsClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding Goods duplicates")
+	}
+	for _, f := range fields {
+		if !goods.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(goods.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(goods.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(goods.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *GoodsClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the Goods entities with the duplicates ids into the Goods with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *GoodsClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(goods.Label, o.transfer)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewGoodsClient(cfg)
+		nodes := make([]*Goods, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(goods.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of Goods merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newGoodsMutation(cfg, OpUpdateOne, withGoods(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only Goods entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *GoodsClient) GetOrCreate(ctx context.Context, ps []predicate.Goods, setters ...func(*GoodsCreate)) (*Goods, error) {
+	return c.getOrCreate(ctx, (*GoodsQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first Goods entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *GoodsClient) FirstOrCreate(ctx context.Context, ps []predicate.Goods, setters ...func(*GoodsCreate)) (*Goods, error) {
+	return c.getOrCreate(ctx, (*GoodsQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the Goods entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.Goods.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *GoodsClient) LockOrCreate(ctx context.Context, ps []predicate.Goods, setters ...func(*GoodsCreate)) (*Goods, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *GoodsQuery, ctx context.Context) (*Goods, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *GoodsClient) getOrCreate(ctx context.Context, get func(*GoodsQuery, context.Context) (*Goods, error), ps []predicate.Goods, setters []func(*GoodsCreate)) (*Goods, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*Goods, error) {
+		return get((&GoodsClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *GoodsClient) createOrRollback(ctx context.Context, create *GoodsCreate) (*Goods, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating Goods entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *GoodsClient) CreateFromQuery(query InsertSelectQuery) *GoodsInsertSelect {
+	return &GoodsInsertSelect{config: c.config, query: query}
+}
+
+// transfer rewires the selected edges of the Goods with the "from" id to the Goods with the "to" id.
+func (c *GoodsClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	return nil
+}
+
+// Truncate removes all Goods entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *GoodsClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{goods.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, goods.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // GroupClient is a client for the Group schema.
 type GroupClient struct {
 	config
@@ -889,6 +2755,12 @@ func (c *GroupClient) Use(hooks ...Hook) {
 	c.hooks.Group = append(c.hooks.Group, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
 // Create returns a builder for creating a Group entity.
 func (c *GroupClient) Create() *GroupCreate {
 	mutation := newGroupMutation(c.config, OpCreate)
@@ -941,12 +2813,17 @@ func (c *GroupClient) DeleteOneID(id int) *GroupDeleteOne {
 func (c *GroupClient) Query() *GroupQuery {
 	return &GroupQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Group entity by its id.
 func (c *GroupClient) Get(ctx context.Context, id int) (*Group, error) {
-	return c.Query().Where(group.ID(id)).Only(ctx)
+	node, err := c.Query().Where(group.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -960,7 +2837,7 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 
 // QueryFiles queries the files edge of a Group.
 func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
-	query := &FileQuery{config: c.config}
+	query := (&FileClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -976,7 +2853,7 @@ func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
 
 // QueryBlocked queries the blocked edge of a Group.
 func (c *GroupClient) QueryBlocked(gr *Group) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -992,7 +2869,8 @@ func (c *GroupClient) QueryBlocked(gr *Group) *UserQuery {
 
 // QueryUsers queries the users edge of a Group.
 func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
+	query.edgeOrder = []OrderFunc{Desc(user.FieldName), Asc(user.FieldID)}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -1008,7 +2886,7 @@ func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
 
 // QueryInfo queries the info edge of a Group.
 func (c *GroupClient) QueryInfo(gr *Group) *GroupInfoQuery {
-	query := &GroupInfoQuery{config: c.config}
+	query := (&GroupInfoClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -1022,31 +2900,346 @@ func (c *GroupClient) QueryInfo(gr *Group) *GroupInfoQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
 // Hooks returns the client hooks.
 func (c *GroupClient) Hooks() []Hook {
 	return c.hooks.Group
 }
 
-// GroupInfoClient is a client for the GroupInfo schema.
-type GroupInfoClient struct {
-	config
-}
-
-// NewGroupInfoClient returns a client for the GroupInfo from the given config.
-func NewGroupInfoClient(c config) *GroupInfoClient {
-	return &GroupInfoClient{config: c}
+// FindDuplicates returns the groups of Group entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *GroupClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding Group duplicates")
+	}
+	for _, f := range fields {
+		if !group.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(group.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(group.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(group.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
 }
 
-// Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `groupinfo.Hooks(f(g(h())))`.
-func (c *GroupInfoClient) Use(hooks ...Hook) {
-	c.hooks.GroupInfo = append(c.hooks.GroupInfo, hooks...)
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *GroupClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
 }
 
-// Create returns a builder for creating a GroupInfo entity.
-func (c *GroupInfoClient) Create() *GroupInfoCreate {
-	mutation := newGroupInfoMutation(c.config, OpCreate)
-	return &GroupInfoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Merge merges the Group entities with the duplicates ids into the Group with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *GroupClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(group.Label, o.transfer, group.EdgeFiles, group.EdgeBlocked)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{group.FieldActive, group.FieldExpire, group.FieldType, group.FieldMaxUsers, group.FieldName}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewGroupClient(cfg)
+		nodes := make([]*Group, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(group.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of Group merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newGroupMutation(cfg, OpUpdateOne, withGroup(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only Group entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *GroupClient) GetOrCreate(ctx context.Context, ps []predicate.Group, setters ...func(*GroupCreate)) (*Group, error) {
+	return c.getOrCreate(ctx, (*GroupQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first Group entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *GroupClient) FirstOrCreate(ctx context.Context, ps []predicate.Group, setters ...func(*GroupCreate)) (*Group, error) {
+	return c.getOrCreate(ctx, (*GroupQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the Group entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.Group.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *GroupClient) LockOrCreate(ctx context.Context, ps []predicate.Group, setters ...func(*GroupCreate)) (*Group, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *GroupQuery, ctx context.Context) (*Group, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *GroupClient) getOrCreate(ctx context.Context, get func(*GroupQuery, context.Context) (*Group, error), ps []predicate.Group, setters []func(*GroupCreate)) (*Group, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*Group, error) {
+		return get((&GroupClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *GroupClient) createOrRollback(ctx context.Context, create *GroupCreate) (*Group, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating Group entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *GroupClient) CreateFromQuery(query InsertSelectQuery) *GroupInsertSelect {
+	return &GroupInsertSelect{config: c.config, query: query}
+}
+
+// TransferOwnership moves the entities that are owned by the Group with the "from" id to the Group with
+// the "to" id, by rewiring its edges (files, blocked) in a single transaction. Use the TransferEdges
+// and TransferSkipEdges options to choose the edges to rewire. Mutations are executed using the builders of
+// the clients, and therefore, their hooks and privacy policies are applied.
+func (c *GroupClient) TransferOwnership(ctx context.Context, from, to int, opts ...TransferOption) error {
+	edges, err := transferEdges(group.Label, opts, group.EdgeFiles, group.EdgeBlocked)
+	if err != nil {
+		return err
+	}
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewGroupClient(cfg)
+		if n, err := client.Query().Where(group.IDIn(from, to)).Count(ctx); err != nil {
+			return err
+		} else if n != 2 {
+			return &NotFoundError{label: group.Label}
+		}
+		return client.transfer(ctx, from, to, edges)
+	})
+}
+
+// transfer rewires the selected edges of the Group with the "from" id to the Group with the "to" id.
+func (c *GroupClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	if edges[group.EdgeFiles] {
+		ids, err := c.Query().Where(group.ID(from)).QueryFiles().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		add := ids
+		if len(ids) > 0 {
+			if err := c.UpdateOneID(from).RemoveFileIDs(ids...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if len(add) > 0 {
+			if err := c.UpdateOneID(to).AddFileIDs(add...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	if edges[group.EdgeBlocked] {
+		ids, err := c.Query().Where(group.ID(from)).QueryBlocked().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		add := ids
+		if len(ids) > 0 {
+			if err := c.UpdateOneID(from).RemoveBlockedIDs(ids...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if len(add) > 0 {
+			if err := c.UpdateOneID(to).AddBlockedIDs(add...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Truncate removes all Group entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *GroupClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{group.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, group.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
+// GroupInfoClient is a client for the GroupInfo schema.
+type GroupInfoClient struct {
+	config
+}
+
+// NewGroupInfoClient returns a client for the GroupInfo from the given config.
+func NewGroupInfoClient(c config) *GroupInfoClient {
+	return &GroupInfoClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupinfo.Hooks(f(g(h())))`.
+func (c *GroupInfoClient) Use(hooks ...Hook) {
+	c.hooks.GroupInfo = append(c.hooks.GroupInfo, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupinfo.Intercept(f(g(h())))`.
+func (c *GroupInfoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupInfo = append(c.inters.GroupInfo, interceptors...)
+}
+
+// Create returns a builder for creating a GroupInfo entity.
+func (c *GroupInfoClient) Create() *GroupInfoCreate {
+	mutation := newGroupInfoMutation(c.config, OpCreate)
+	return &GroupInfoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // CreateBulk returns a builder for creating a bulk of GroupInfo entities.
@@ -1095,12 +3288,17 @@ func (c *GroupInfoClient) DeleteOneID(id int) *GroupInfoDeleteOne {
 func (c *GroupInfoClient) Query() *GroupInfoQuery {
 	return &GroupInfoQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a GroupInfo entity by its id.
 func (c *GroupInfoClient) Get(ctx context.Context, id int) (*GroupInfo, error) {
-	return c.Query().Where(groupinfo.ID(id)).Only(ctx)
+	node, err := c.Query().Where(groupinfo.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1114,7 +3312,7 @@ func (c *GroupInfoClient) GetX(ctx context.Context, id int) *GroupInfo {
 
 // QueryGroups queries the groups edge of a GroupInfo.
 func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
-	query := &GroupQuery{config: c.config}
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gi.ID
 		step := sqlgraph.NewStep(
@@ -1128,11 +3326,266 @@ func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *GroupInfoClient) Interceptors() []Interceptor {
+	return c.inters.GroupInfo
+}
+
 // Hooks returns the client hooks.
 func (c *GroupInfoClient) Hooks() []Hook {
 	return c.hooks.GroupInfo
 }
 
+// FindDuplicates returns the groups of GroupInfo entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *GroupInfoClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding GroupInfo duplicates")
+	}
+	for _, f := range fields {
+		if !groupinfo.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(groupinfo.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(groupinfo.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(groupinfo.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *GroupInfoClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the GroupInfo entities with the duplicates ids into the GroupInfo with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *GroupInfoClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(groupinfo.Label, o.transfer)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{groupinfo.FieldDesc, groupinfo.FieldMaxUsers}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewGroupInfoClient(cfg)
+		nodes := make([]*GroupInfo, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(groupinfo.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of GroupInfo merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newGroupInfoMutation(cfg, OpUpdateOne, withGroupInfo(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only GroupInfo entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *GroupInfoClient) GetOrCreate(ctx context.Context, ps []predicate.GroupInfo, setters ...func(*GroupInfoCreate)) (*GroupInfo, error) {
+	return c.getOrCreate(ctx, (*GroupInfoQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first GroupInfo entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *GroupInfoClient) FirstOrCreate(ctx context.Context, ps []predicate.GroupInfo, setters ...func(*GroupInfoCreate)) (*GroupInfo, error) {
+	return c.getOrCreate(ctx, (*GroupInfoQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the GroupInfo entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.GroupInfo.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *GroupInfoClient) LockOrCreate(ctx context.Context, ps []predicate.GroupInfo, setters ...func(*GroupInfoCreate)) (*GroupInfo, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *GroupInfoQuery, ctx context.Context) (*GroupInfo, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *GroupInfoClient) getOrCreate(ctx context.Context, get func(*GroupInfoQuery, context.Context) (*GroupInfo, error), ps []predicate.GroupInfo, setters []func(*GroupInfoCreate)) (*GroupInfo, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*GroupInfo, error) {
+		return get((&GroupInfoClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *GroupInfoClient) createOrRollback(ctx context.Context, create *GroupInfoCreate) (*GroupInfo, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating GroupInfo entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *GroupInfoClient) CreateFromQuery(query InsertSelectQuery) *GroupInfoInsertSelect {
+	return &GroupInfoInsertSelect{config: c.config, query: query}
+}
+
+// transfer rewires the selected edges of the GroupInfo with the "from" id to the GroupInfo with the "to" id.
+func (c *GroupInfoClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	return nil
+}
+
+// Truncate removes all GroupInfo entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *GroupInfoClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{groupinfo.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, groupinfo.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // ItemClient is a client for the Item schema.
 type ItemClient struct {
 	config
@@ -1149,6 +3602,12 @@ func (c *ItemClient) Use(hooks ...Hook) {
 	c.hooks.Item = append(c.hooks.Item, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
+func (c *ItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Item = append(c.inters.Item, interceptors...)
+}
+
 // Create returns a builder for creating a Item entity.
 func (c *ItemClient) Create() *ItemCreate {
 	mutation := newItemMutation(c.config, OpCreate)
@@ -1201,12 +3660,17 @@ func (c *ItemClient) DeleteOneID(id string) *ItemDeleteOne {
 func (c *ItemClient) Query() *ItemQuery {
 	return &ItemQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Item entity by its id.
 func (c *ItemClient) Get(ctx context.Context, id string) (*Item, error) {
-	return c.Query().Where(item.ID(id)).Only(ctx)
+	node, err := c.Query().Where(item.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1218,9 +3682,275 @@ func (c *ItemClient) GetX(ctx context.Context, id string) *Item {
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *ItemClient) Interceptors() []Interceptor {
+	return c.inters.Item
+}
+
 // Hooks returns the client hooks.
 func (c *ItemClient) Hooks() []Hook {
-	return c.hooks.Item
+	return append([]Hook{readOnlyAPI(
+		readOnlyField{name: item.FieldToken, defaults: true},
+	)}, c.hooks.Item...)
+}
+
+// FindDuplicates returns the groups of Item entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *ItemClient) FindDuplicates(ctx context.Context, fields ...string) ([][]string, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding Item duplicates")
+	}
+	for _, f := range fields {
+		if !item.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(item.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(item.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(item.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]string
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id string
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *ItemClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]string {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the Item entities with the duplicates ids into the Item with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *ItemClient) Merge(ctx context.Context, survivor string, duplicates []string, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(item.Label, o.transfer)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{item.FieldText, item.FieldToken}
+	}
+	ids := append([]string{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewItemClient(cfg)
+		nodes := make([]*Item, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(item.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of Item merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newItemMutation(cfg, OpUpdateOne, withItem(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only Item entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// For example:
+//
+//	v, err := client.Item.GetOrCreate(ctx,
+//		[]predicate.Item{item.Text(value)},
+//		func(c *ItemCreate) {
+//			c.SetText(value)
+//		},
+//	)
+//
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *ItemClient) GetOrCreate(ctx context.Context, ps []predicate.Item, setters ...func(*ItemCreate)) (*Item, error) {
+	return c.getOrCreate(ctx, (*ItemQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first Item entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *ItemClient) FirstOrCreate(ctx context.Context, ps []predicate.Item, setters ...func(*ItemCreate)) (*Item, error) {
+	return c.getOrCreate(ctx, (*ItemQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the Item entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.Item.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *ItemClient) LockOrCreate(ctx context.Context, ps []predicate.Item, setters ...func(*ItemCreate)) (*Item, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *ItemQuery, ctx context.Context) (*Item, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *ItemClient) getOrCreate(ctx context.Context, get func(*ItemQuery, context.Context) (*Item, error), ps []predicate.Item, setters []func(*ItemCreate)) (*Item, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*Item, error) {
+		return get((&ItemClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *ItemClient) createOrRollback(ctx context.Context, create *ItemCreate) (*Item, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating Item entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *ItemClient) CreateFromQuery(query InsertSelectQuery) *ItemInsertSelect {
+	return &ItemInsertSelect{config: c.config, query: query}
+}
+
+// transfer rewires the selected edges of the Item with the "from" id to the Item with the "to" id.
+func (c *ItemClient) transfer(ctx context.Context, from, to string, edges map[string]bool) error {
+	return nil
+}
+
+// Truncate removes all Item entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *ItemClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{item.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, item.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
 }
 
 // LicenseClient is a client for the License schema.
@@ -1239,6 +3969,12 @@ func (c *LicenseClient) Use(hooks ...Hook) {
 	c.hooks.License = append(c.hooks.License, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `license.Intercept(f(g(h())))`.
+func (c *LicenseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.License = append(c.inters.License, interceptors...)
+}
+
 // Create returns a builder for creating a License entity.
 func (c *LicenseClient) Create() *LicenseCreate {
 	mutation := newLicenseMutation(c.config, OpCreate)
@@ -1291,12 +4027,17 @@ func (c *LicenseClient) DeleteOneID(id int) *LicenseDeleteOne {
 func (c *LicenseClient) Query() *LicenseQuery {
 	return &LicenseQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a License entity by its id.
 func (c *LicenseClient) Get(ctx context.Context, id int) (*License, error) {
-	return c.Query().Where(license.ID(id)).Only(ctx)
+	node, err := c.Query().Where(license.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1308,11 +4049,266 @@ func (c *LicenseClient) GetX(ctx context.Context, id int) *License {
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *LicenseClient) Interceptors() []Interceptor {
+	return c.inters.License
+}
+
 // Hooks returns the client hooks.
 func (c *LicenseClient) Hooks() []Hook {
 	return c.hooks.License
 }
 
+// FindDuplicates returns the groups of License entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *LicenseClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding License duplicates")
+	}
+	for _, f := range fields {
+		if !license.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(license.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(license.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(license.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *LicenseClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the License entities with the duplicates ids into the License with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *LicenseClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(license.Label, o.transfer)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewLicenseClient(cfg)
+		nodes := make([]*License, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(license.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of License merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newLicenseMutation(cfg, OpUpdateOne, withLicense(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only License entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *LicenseClient) GetOrCreate(ctx context.Context, ps []predicate.License, setters ...func(*LicenseCreate)) (*License, error) {
+	return c.getOrCreate(ctx, (*LicenseQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first License entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *LicenseClient) FirstOrCreate(ctx context.Context, ps []predicate.License, setters ...func(*LicenseCreate)) (*License, error) {
+	return c.getOrCreate(ctx, (*LicenseQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the License entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.License.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *LicenseClient) LockOrCreate(ctx context.Context, ps []predicate.License, setters ...func(*LicenseCreate)) (*License, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *LicenseQuery, ctx context.Context) (*License, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *LicenseClient) getOrCreate(ctx context.Context, get func(*LicenseQuery, context.Context) (*License, error), ps []predicate.License, setters []func(*LicenseCreate)) (*License, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*License, error) {
+		return get((&LicenseClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *LicenseClient) createOrRollback(ctx context.Context, create *LicenseCreate) (*License, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating License entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *LicenseClient) CreateFromQuery(query InsertSelectQuery) *LicenseInsertSelect {
+	return &LicenseInsertSelect{config: c.config, query: query}
+}
+
+// transfer rewires the selected edges of the License with the "from" id to the License with the "to" id.
+func (c *LicenseClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	return nil
+}
+
+// Truncate removes all License entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *LicenseClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{license.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, license.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // NodeClient is a client for the Node schema.
 type NodeClient struct {
 	config
@@ -1329,6 +4325,12 @@ func (c *NodeClient) Use(hooks ...Hook) {
 	c.hooks.Node = append(c.hooks.Node, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `node.Intercept(f(g(h())))`.
+func (c *NodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Node = append(c.inters.Node, interceptors...)
+}
+
 // Create returns a builder for creating a Node entity.
 func (c *NodeClient) Create() *NodeCreate {
 	mutation := newNodeMutation(c.config, OpCreate)
@@ -1381,12 +4383,17 @@ func (c *NodeClient) DeleteOneID(id int) *NodeDeleteOne {
 func (c *NodeClient) Query() *NodeQuery {
 	return &NodeQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Node entity by its id.
 func (c *NodeClient) Get(ctx context.Context, id int) (*Node, error) {
-	return c.Query().Where(node.ID(id)).Only(ctx)
+	node, err := c.Query().Where(node.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1400,7 +4407,7 @@ func (c *NodeClient) GetX(ctx context.Context, id int) *Node {
 
 // QueryPrev queries the prev edge of a Node.
 func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
-	query := &NodeQuery{config: c.config}
+	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := n.ID
 		step := sqlgraph.NewStep(
@@ -1416,7 +4423,7 @@ func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
 
 // QueryNext queries the next edge of a Node.
 func (c *NodeClient) QueryNext(n *Node) *NodeQuery {
-	query := &NodeQuery{config: c.config}
+	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := n.ID
 		step := sqlgraph.NewStep(
@@ -1430,11 +4437,295 @@ func (c *NodeClient) QueryNext(n *Node) *NodeQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *NodeClient) Interceptors() []Interceptor {
+	return c.inters.Node
+}
+
 // Hooks returns the client hooks.
 func (c *NodeClient) Hooks() []Hook {
 	return c.hooks.Node
 }
 
+// FindDuplicates returns the groups of Node entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *NodeClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding Node duplicates")
+	}
+	for _, f := range fields {
+		if !node.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(node.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(node.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(node.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *NodeClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the Node entities with the duplicates ids into the Node with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *NodeClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(node.Label, o.transfer, node.EdgeNext)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{node.FieldValue}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewNodeClient(cfg)
+		nodes := make([]*Node, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(node.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of Node merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newNodeMutation(cfg, OpUpdateOne, withNode(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only Node entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *NodeClient) GetOrCreate(ctx context.Context, ps []predicate.Node, setters ...func(*NodeCreate)) (*Node, error) {
+	return c.getOrCreate(ctx, (*NodeQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first Node entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *NodeClient) FirstOrCreate(ctx context.Context, ps []predicate.Node, setters ...func(*NodeCreate)) (*Node, error) {
+	return c.getOrCreate(ctx, (*NodeQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the Node entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.Node.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *NodeClient) LockOrCreate(ctx context.Context, ps []predicate.Node, setters ...func(*NodeCreate)) (*Node, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *NodeQuery, ctx context.Context) (*Node, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *NodeClient) getOrCreate(ctx context.Context, get func(*NodeQuery, context.Context) (*Node, error), ps []predicate.Node, setters []func(*NodeCreate)) (*Node, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*Node, error) {
+		return get((&NodeClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *NodeClient) createOrRollback(ctx context.Context, create *NodeCreate) (*Node, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating Node entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *NodeClient) CreateFromQuery(query InsertSelectQuery) *NodeInsertSelect {
+	return &NodeInsertSelect{config: c.config, query: query}
+}
+
+// TransferOwnership moves the entities that are owned by the Node with the "from" id to the Node with
+// the "to" id, by rewiring its edges (next) in a single transaction. Use the TransferEdges
+// and TransferSkipEdges options to choose the edges to rewire. Mutations are executed using the builders of
+// the clients, and therefore, their hooks and privacy policies are applied.
+func (c *NodeClient) TransferOwnership(ctx context.Context, from, to int, opts ...TransferOption) error {
+	edges, err := transferEdges(node.Label, opts, node.EdgeNext)
+	if err != nil {
+		return err
+	}
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewNodeClient(cfg)
+		if n, err := client.Query().Where(node.IDIn(from, to)).Count(ctx); err != nil {
+			return err
+		} else if n != 2 {
+			return &NotFoundError{label: node.Label}
+		}
+		return client.transfer(ctx, from, to, edges)
+	})
+}
+
+// transfer rewires the selected edges of the Node with the "from" id to the Node with the "to" id.
+func (c *NodeClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	if edges[node.EdgeNext] {
+		err := NewNodeClient(c.config).Update().
+			Where(node.HasPrevWith(node.ID(from)), node.IDNEQ(to)).
+			SetPrevID(to).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Truncate removes all Node entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *NodeClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{node.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, node.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // PetClient is a client for the Pet schema.
 type PetClient struct {
 	config
@@ -1451,6 +4742,12 @@ func (c *PetClient) Use(hooks ...Hook) {
 	c.hooks.Pet = append(c.hooks.Pet, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pet.Intercept(f(g(h())))`.
+func (c *PetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Pet = append(c.inters.Pet, interceptors...)
+}
+
 // Create returns a builder for creating a Pet entity.
 func (c *PetClient) Create() *PetCreate {
 	mutation := newPetMutation(c.config, OpCreate)
@@ -1503,12 +4800,17 @@ func (c *PetClient) DeleteOneID(id int) *PetDeleteOne {
 func (c *PetClient) Query() *PetQuery {
 	return &PetQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Pet entity by its id.
 func (c *PetClient) Get(ctx context.Context, id int) (*Pet, error) {
-	return c.Query().Where(pet.ID(id)).Only(ctx)
+	node, err := c.Query().Where(pet.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1522,7 +4824,7 @@ func (c *PetClient) GetX(ctx context.Context, id int) *Pet {
 
 // QueryTeam queries the team edge of a Pet.
 func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -1538,7 +4840,7 @@ func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
 
 // QueryOwner queries the owner edge of a Pet.
 func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -1552,11 +4854,266 @@ func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *PetClient) Interceptors() []Interceptor {
+	return c.inters.Pet
+}
+
 // Hooks returns the client hooks.
 func (c *PetClient) Hooks() []Hook {
 	return c.hooks.Pet
 }
 
+// FindDuplicates returns the groups of Pet entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *PetClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding Pet duplicates")
+	}
+	for _, f := range fields {
+		if !pet.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(pet.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(pet.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(pet.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *PetClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the Pet entities with the duplicates ids into the Pet with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *PetClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(pet.Label, o.transfer)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{pet.FieldAge, pet.FieldName, pet.FieldUUID, pet.FieldNickname, pet.FieldTrained}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewPetClient(cfg)
+		nodes := make([]*Pet, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(pet.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of Pet merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newPetMutation(cfg, OpUpdateOne, withPet(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only Pet entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *PetClient) GetOrCreate(ctx context.Context, ps []predicate.Pet, setters ...func(*PetCreate)) (*Pet, error) {
+	return c.getOrCreate(ctx, (*PetQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first Pet entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *PetClient) FirstOrCreate(ctx context.Context, ps []predicate.Pet, setters ...func(*PetCreate)) (*Pet, error) {
+	return c.getOrCreate(ctx, (*PetQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the Pet entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.Pet.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *PetClient) LockOrCreate(ctx context.Context, ps []predicate.Pet, setters ...func(*PetCreate)) (*Pet, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *PetQuery, ctx context.Context) (*Pet, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *PetClient) getOrCreate(ctx context.Context, get func(*PetQuery, context.Context) (*Pet, error), ps []predicate.Pet, setters []func(*PetCreate)) (*Pet, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*Pet, error) {
+		return get((&PetClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *PetClient) createOrRollback(ctx context.Context, create *PetCreate) (*Pet, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating Pet entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *PetClient) CreateFromQuery(query InsertSelectQuery) *PetInsertSelect {
+	return &PetInsertSelect{config: c.config, query: query}
+}
+
+// transfer rewires the selected edges of the Pet with the "from" id to the Pet with the "to" id.
+func (c *PetClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	return nil
+}
+
+// Truncate removes all Pet entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *PetClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{pet.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, pet.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // SpecClient is a client for the Spec schema.
 type SpecClient struct {
 	config
@@ -1573,6 +5130,12 @@ func (c *SpecClient) Use(hooks ...Hook) {
 	c.hooks.Spec = append(c.hooks.Spec, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spec.Intercept(f(g(h())))`.
+func (c *SpecClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Spec = append(c.inters.Spec, interceptors...)
+}
+
 // Create returns a builder for creating a Spec entity.
 func (c *SpecClient) Create() *SpecCreate {
 	mutation := newSpecMutation(c.config, OpCreate)
@@ -1625,12 +5188,17 @@ func (c *SpecClient) DeleteOneID(id int) *SpecDeleteOne {
 func (c *SpecClient) Query() *SpecQuery {
 	return &SpecQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Spec entity by its id.
 func (c *SpecClient) Get(ctx context.Context, id int) (*Spec, error) {
-	return c.Query().Where(spec.ID(id)).Only(ctx)
+	node, err := c.Query().Where(spec.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1644,7 +5212,7 @@ func (c *SpecClient) GetX(ctx context.Context, id int) *Spec {
 
 // QueryCard queries the card edge of a Spec.
 func (c *SpecClient) QueryCard(s *Spec) *CardQuery {
-	query := &CardQuery{config: c.config}
+	query := (&CardClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := s.ID
 		step := sqlgraph.NewStep(
@@ -1658,11 +5226,310 @@ func (c *SpecClient) QueryCard(s *Spec) *CardQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *SpecClient) Interceptors() []Interceptor {
+	return c.inters.Spec
+}
+
 // Hooks returns the client hooks.
 func (c *SpecClient) Hooks() []Hook {
 	return c.hooks.Spec
 }
 
+// FindDuplicates returns the groups of Spec entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *SpecClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding Spec duplicates")
+	}
+	for _, f := range fields {
+		if !spec.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(spec.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(spec.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(spec.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *SpecClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the Spec entities with the duplicates ids into the Spec with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *SpecClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(spec.Label, o.transfer, spec.EdgeCard)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewSpecClient(cfg)
+		nodes := make([]*Spec, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(spec.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of Spec merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newSpecMutation(cfg, OpUpdateOne, withSpec(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only Spec entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *SpecClient) GetOrCreate(ctx context.Context, ps []predicate.Spec, setters ...func(*SpecCreate)) (*Spec, error) {
+	return c.getOrCreate(ctx, (*SpecQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first Spec entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *SpecClient) FirstOrCreate(ctx context.Context, ps []predicate.Spec, setters ...func(*SpecCreate)) (*Spec, error) {
+	return c.getOrCreate(ctx, (*SpecQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the Spec entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.Spec.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *SpecClient) LockOrCreate(ctx context.Context, ps []predicate.Spec, setters ...func(*SpecCreate)) (*Spec, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *SpecQuery, ctx context.Context) (*Spec, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *SpecClient) getOrCreate(ctx context.Context, get func(*SpecQuery, context.Context) (*Spec, error), ps []predicate.Spec, setters []func(*SpecCreate)) (*Spec, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*Spec, error) {
+		return get((&SpecClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *SpecClient) createOrRollback(ctx context.Context, create *SpecCreate) (*Spec, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating Spec entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *SpecClient) CreateFromQuery(query InsertSelectQuery) *SpecInsertSelect {
+	return &SpecInsertSelect{config: c.config, query: query}
+}
+
+// TransferOwnership moves the entities that are owned by the Spec with the "from" id to the Spec with
+// the "to" id, by rewiring its edges (card) in a single transaction. Use the TransferEdges
+// and TransferSkipEdges options to choose the edges to rewire. Mutations are executed using the builders of
+// the clients, and therefore, their hooks and privacy policies are applied.
+func (c *SpecClient) TransferOwnership(ctx context.Context, from, to int, opts ...TransferOption) error {
+	edges, err := transferEdges(spec.Label, opts, spec.EdgeCard)
+	if err != nil {
+		return err
+	}
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewSpecClient(cfg)
+		if n, err := client.Query().Where(spec.IDIn(from, to)).Count(ctx); err != nil {
+			return err
+		} else if n != 2 {
+			return &NotFoundError{label: spec.Label}
+		}
+		return client.transfer(ctx, from, to, edges)
+	})
+}
+
+// transfer rewires the selected edges of the Spec with the "from" id to the Spec with the "to" id.
+func (c *SpecClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	if edges[spec.EdgeCard] {
+		ids, err := c.Query().Where(spec.ID(from)).QueryCard().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		linked, err := c.Query().Where(spec.ID(to)).QueryCard().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		add, err := c.Query().Where(spec.ID(from)).QueryCard().Where(card.IDNotIn(linked...)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			if err := c.UpdateOneID(from).RemoveCardIDs(ids...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if len(add) > 0 {
+			if err := c.UpdateOneID(to).AddCardIDs(add...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Truncate removes all Spec entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *SpecClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{spec.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, spec.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // TaskClient is a client for the Task schema.
 type TaskClient struct {
 	config
@@ -1679,6 +5546,12 @@ func (c *TaskClient) Use(hooks ...Hook) {
 	c.hooks.Task = append(c.hooks.Task, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `enttask.Intercept(f(g(h())))`.
+func (c *TaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Task = append(c.inters.Task, interceptors...)
+}
+
 // Create returns a builder for creating a Task entity.
 func (c *TaskClient) Create() *TaskCreate {
 	mutation := newTaskMutation(c.config, OpCreate)
@@ -1731,12 +5604,17 @@ func (c *TaskClient) DeleteOneID(id int) *TaskDeleteOne {
 func (c *TaskClient) Query() *TaskQuery {
 	return &TaskQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Task entity by its id.
 func (c *TaskClient) Get(ctx context.Context, id int) (*Task, error) {
-	return c.Query().Where(enttask.ID(id)).Only(ctx)
+	node, err := c.Query().Where(enttask.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1748,11 +5626,266 @@ func (c *TaskClient) GetX(ctx context.Context, id int) *Task {
 	return obj
 }
 
+// Interceptors returns the client interceptors.
+func (c *TaskClient) Interceptors() []Interceptor {
+	return c.inters.Task
+}
+
 // Hooks returns the client hooks.
 func (c *TaskClient) Hooks() []Hook {
 	return c.hooks.Task
 }
 
+// FindDuplicates returns the groups of Task entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *TaskClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding Task duplicates")
+	}
+	for _, f := range fields {
+		if !enttask.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(enttask.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(enttask.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(enttask.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *TaskClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the Task entities with the duplicates ids into the Task with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *TaskClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(enttask.Label, o.transfer)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{enttask.FieldPriority, enttask.FieldPriorities}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewTaskClient(cfg)
+		nodes := make([]*Task, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(enttask.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of Task merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newTaskMutation(cfg, OpUpdateOne, withTask(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only Task entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *TaskClient) GetOrCreate(ctx context.Context, ps []predicate.Task, setters ...func(*TaskCreate)) (*Task, error) {
+	return c.getOrCreate(ctx, (*TaskQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first Task entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *TaskClient) FirstOrCreate(ctx context.Context, ps []predicate.Task, setters ...func(*TaskCreate)) (*Task, error) {
+	return c.getOrCreate(ctx, (*TaskQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the Task entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.Task.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *TaskClient) LockOrCreate(ctx context.Context, ps []predicate.Task, setters ...func(*TaskCreate)) (*Task, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *TaskQuery, ctx context.Context) (*Task, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *TaskClient) getOrCreate(ctx context.Context, get func(*TaskQuery, context.Context) (*Task, error), ps []predicate.Task, setters []func(*TaskCreate)) (*Task, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*Task, error) {
+		return get((&TaskClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *TaskClient) createOrRollback(ctx context.Context, create *TaskCreate) (*Task, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating Task entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *TaskClient) CreateFromQuery(query InsertSelectQuery) *TaskInsertSelect {
+	return &TaskInsertSelect{config: c.config, query: query}
+}
+
+// transfer rewires the selected edges of the Task with the "from" id to the Task with the "to" id.
+func (c *TaskClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	return nil
+}
+
+// Truncate removes all Task entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *TaskClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{enttask.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, enttask.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1769,6 +5902,12 @@ func (c *UserClient) Use(hooks ...Hook) {
 	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a builder for creating a User entity.
 func (c *UserClient) Create() *UserCreate {
 	mutation := newUserMutation(c.config, OpCreate)
@@ -1821,12 +5960,17 @@ func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
 func (c *UserClient) Query() *UserQuery {
 	return &UserQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a User entity by its id.
 func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+	node, err := c.Query().Where(user.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -1840,7 +5984,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 
 // QueryCard queries the card edge of a User.
 func (c *UserClient) QueryCard(u *User) *CardQuery {
-	query := &CardQuery{config: c.config}
+	query := (&CardClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1856,7 +6000,7 @@ func (c *UserClient) QueryCard(u *User) *CardQuery {
 
 // QueryPets queries the pets edge of a User.
 func (c *UserClient) QueryPets(u *User) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1872,7 +6016,7 @@ func (c *UserClient) QueryPets(u *User) *PetQuery {
 
 // QueryFiles queries the files edge of a User.
 func (c *UserClient) QueryFiles(u *User) *FileQuery {
-	query := &FileQuery{config: c.config}
+	query := (&FileClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1888,7 +6032,7 @@ func (c *UserClient) QueryFiles(u *User) *FileQuery {
 
 // QueryGroups queries the groups edge of a User.
 func (c *UserClient) QueryGroups(u *User) *GroupQuery {
-	query := &GroupQuery{config: c.config}
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1904,7 +6048,7 @@ func (c *UserClient) QueryGroups(u *User) *GroupQuery {
 
 // QueryFriends queries the friends edge of a User.
 func (c *UserClient) QueryFriends(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1920,7 +6064,7 @@ func (c *UserClient) QueryFriends(u *User) *UserQuery {
 
 // QueryFollowers queries the followers edge of a User.
 func (c *UserClient) QueryFollowers(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1936,7 +6080,7 @@ func (c *UserClient) QueryFollowers(u *User) *UserQuery {
 
 // QueryFollowing queries the following edge of a User.
 func (c *UserClient) QueryFollowing(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1952,7 +6096,7 @@ func (c *UserClient) QueryFollowing(u *User) *UserQuery {
 
 // QueryTeam queries the team edge of a User.
 func (c *UserClient) QueryTeam(u *User) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1968,7 +6112,7 @@ func (c *UserClient) QueryTeam(u *User) *PetQuery {
 
 // QuerySpouse queries the spouse edge of a User.
 func (c *UserClient) QuerySpouse(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1984,7 +6128,7 @@ func (c *UserClient) QuerySpouse(u *User) *UserQuery {
 
 // QueryChildren queries the children edge of a User.
 func (c *UserClient) QueryChildren(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -2000,7 +6144,7 @@ func (c *UserClient) QueryChildren(u *User) *UserQuery {
 
 // QueryParent queries the parent edge of a User.
 func (c *UserClient) QueryParent(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -2014,7 +6158,377 @@ func (c *UserClient) QueryParent(u *User) *UserQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+	return append([]Hook{readOnlyAPI(
+		readOnlyField{name: user.FieldRole, defaults: true, value: user.DefaultRole},
+	)}, c.hooks.User...)
+}
+
+// FindDuplicates returns the groups of User entities that hold the same values in the given fields (e.g. an email).
+// Groups are ordered by the values of the fields, and the ids of each group are ordered in ascending order. Note that
+// NULL values are not considered equal, and entities with NULL values are not returned.
+func (c *UserClient) FindDuplicates(ctx context.Context, fields ...string) ([][]int, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("ent: missing fields for finding User duplicates")
+	}
+	for _, f := range fields {
+		if !user.ValidColumn(f) {
+			return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for finding duplicates", f)}
+		}
+	}
+	query := c.Query()
+	if err := query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	dups := sql.Dialect(c.driver.Dialect()).
+		Select(fields...).
+		From(sql.Table(user.Table)).
+		GroupBy(fields...).
+		Having(sql.GT(sql.Count("*"), 1)).
+		As("duplicates")
+	selector.Join(dups)
+	columns := []string{selector.C(user.FieldID)}
+	for _, f := range fields {
+		selector.On(selector.C(f), dups.C(f)).OrderBy(selector.C(f))
+		columns = append(columns, selector.C(f))
+	}
+	selector.Select(columns...).OrderBy(selector.C(user.FieldID))
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := c.queryDriver().Query(ctx, q, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		groups [][]int
+		prev   []interface{}
+	)
+	for rows.Next() {
+		var id int
+		values := make([]interface{}, len(fields))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || !reflect.DeepEqual(prev, values) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+		prev = values
+	}
+	return groups, rows.Err()
+}
+
+// FindDuplicatesX is like FindDuplicates, but panics if an error occurs.
+func (c *UserClient) FindDuplicatesX(ctx context.Context, fields ...string) [][]int {
+	groups, err := c.FindDuplicates(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// Merge merges the User entities with the duplicates ids into the User with the survivor id, in a single
+// transaction. The edges of the duplicates are rewired to the survivor (like TransferOwnership), the fields of the
+// survivor are resolved from the values of all entities (see MergeResolver), and the duplicates are deleted.
+func (c *UserClient) Merge(ctx context.Context, survivor int, duplicates []int, opts ...MergeOption) error {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	edges, err := transferEdges(user.Label, o.transfer, user.EdgeCard, user.EdgePets, user.EdgeFiles, user.EdgeGroups, user.EdgeFollowing, user.EdgeTeam)
+	if err != nil {
+		return err
+	}
+	fields := o.fields
+	if len(fields) == 0 {
+		fields = []string{user.FieldOptionalInt, user.FieldAge, user.FieldName, user.FieldLast, user.FieldNickname, user.FieldAddress, user.FieldPhone, user.FieldPassword, user.FieldRole, user.FieldEmployment, user.FieldSSOCert}
+	}
+	ids := append([]int{survivor}, duplicates...)
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewUserClient(cfg)
+		nodes := make([]*User, 0, len(ids))
+		for _, id := range ids {
+			node, err := client.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if n, err := client.Query().Where(user.IDIn(ids...)).Count(ctx); err != nil {
+			return err
+		} else if n != len(ids) {
+			return errors.New("ent: survivor and duplicates of User merge must be distinct")
+		}
+		for _, node := range nodes[1:] {
+			if err := client.transfer(ctx, node.ID, survivor, edges); err != nil {
+				return err
+			}
+			if err := client.DeleteOneID(node.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		update := client.UpdateOne(nodes[0])
+		for _, f := range fields {
+			values := make([]Value, len(nodes))
+			for i, node := range nodes {
+				v, err := newUserMutation(cfg, OpUpdateOne, withUser(node)).OldField(ctx, f)
+				if err != nil {
+					return err
+				}
+				values[i] = mergeValue(v)
+			}
+			if v, ok := o.resolve(f, values); ok {
+				if err := update.mutation.SetField(f, v); err != nil {
+					return err
+				}
+			}
+		}
+		if len(update.mutation.Fields()) == 0 {
+			return nil
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// GetOrCreate returns the only User entity that matches the given predicates, or creates it using the
+// given setters if no entity matches them. A *NotSingularError is returned if more than one entity matches.
+// For example:
+//
+//	v, err := client.User.GetOrCreate(ctx,
+//		[]predicate.User{user.Nickname(value)},
+//		func(c *UserCreate) {
+//			c.SetNickname(value)
+//		},
+//	)
+//
+// Concurrent calls are resolved by the unique constraints of the table. The entity is created only if it
+// was not found, and if its creation fails on a constraint error, it is queried again and returned if it
+// was created by another caller in the meantime. Therefore, the predicates should match the fields of a
+// unique index that are set by the setters. Otherwise, concurrent calls may create duplicate entities.
+func (c *UserClient) GetOrCreate(ctx context.Context, ps []predicate.User, setters ...func(*UserCreate)) (*User, error) {
+	return c.getOrCreate(ctx, (*UserQuery).Only, ps, setters)
+}
+
+// FirstOrCreate is like GetOrCreate, but returns the first User entity that matches
+// the given predicates, instead of failing if more than one entity matches them.
+func (c *UserClient) FirstOrCreate(ctx context.Context, ps []predicate.User, setters ...func(*UserCreate)) (*User, error) {
+	return c.getOrCreate(ctx, (*UserQuery).First, ps, setters)
+}
+
+// LockOrCreate is like GetOrCreate, but locks the User entity using SELECT ... FOR UPDATE until the
+// transaction ends, and can be used only by transactional clients. An entity that was created by the call
+// is locked by the transaction as well. It is useful for serializing the access to rows that are unique
+// per key (e.g. the settings of a tenant). For example:
+//
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	v, err := tx.User.LockOrCreate(ctx, ps, setters...)
+//
+// SQLite does not support row-level locks, and its transactions lock the database on their first
+// write. Therefore, the entities are queried without the FOR UPDATE clause in SQLite.
+func (c *UserClient) LockOrCreate(ctx context.Context, ps []predicate.User, setters ...func(*UserCreate)) (*User, error) {
+	if _, ok := c.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: LockOrCreate must be called on a transactional client")
+	}
+	return c.getOrCreate(ctx, func(q *UserQuery, ctx context.Context) (*User, error) {
+		if q.driver.Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}, ps, setters)
+}
+
+func (c *UserClient) getOrCreate(ctx context.Context, get func(*UserQuery, context.Context) (*User, error), ps []predicate.User, setters []func(*UserCreate)) (*User, error) {
+	// Entities are queried using the primary driver, as read replicas may not
+	// contain the entities that were created by the concurrent callers yet.
+	cfg := c.config
+	cfg.readDriver = nil
+	query := func() (*User, error) {
+		return get((&UserClient{config: cfg}).Query().Where(ps...), ctx)
+	}
+	if node, err := query(); !IsNotFound(err) {
+		return node, err
+	}
+	create := c.Create()
+	for _, set := range setters {
+		set(create)
+	}
+	node, err := c.createOrRollback(ctx, create)
+	if !IsConstraintError(err) {
+		return node, err
+	}
+	// The entity may have been created by a concurrent caller.
+	if node, qerr := query(); qerr == nil {
+		return node, nil
+	}
+	return nil, err
+}
+
+// createOrRollback creates the entity in a savepoint if the client is transactional, as a failed
+// statement aborts the transaction in some databases (e.g. PostgreSQL), and it cannot be used for
+// querying the entity that was created by the concurrent caller.
+func (c *UserClient) createOrRollback(ctx context.Context, create *UserCreate) (*User, error) {
+	parent, ok := c.driver.(*txDriver)
+	if !ok {
+		return create.Save(ctx)
+	}
+	sp, err := (&Client{config: c.config}).savepointTx(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	create.config, create.mutation.config = sp.config, sp.config
+	node, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollbackTx(sp, err)
+	}
+	if err := sp.Commit(); err != nil {
+		return nil, err
+	}
+	node.config = c.config
+	return node, nil
+}
+
+// CreateFromQuery returns a builder for creating User entities from the result of the given query,
+// using one INSERT INTO ... SELECT statement. The selected columns of the query are inserted into the columns
+// of the table by their order. Note that the rows are copied by the database, and therefore, the hooks and the
+// defaults of the create builders are not applied.
+func (c *UserClient) CreateFromQuery(query InsertSelectQuery) *UserInsertSelect {
+	return &UserInsertSelect{config: c.config, query: query}
+}
+
+// TransferOwnership moves the entities that are owned by the User with the "from" id to the User with
+// the "to" id, by rewiring its edges (card, pets, files, groups, following, team) in a single transaction. Use the TransferEdges
+// and TransferSkipEdges options to choose the edges to rewire. Mutations are executed using the builders of
+// the clients, and therefore, their hooks and privacy policies are applied.
+func (c *UserClient) TransferOwnership(ctx context.Context, from, to int, opts ...TransferOption) error {
+	edges, err := transferEdges(user.Label, opts, user.EdgeCard, user.EdgePets, user.EdgeFiles, user.EdgeGroups, user.EdgeFollowing, user.EdgeTeam)
+	if err != nil {
+		return err
+	}
+	return withConfigTx(ctx, c.config, func(cfg config) error {
+		client := NewUserClient(cfg)
+		if n, err := client.Query().Where(user.IDIn(from, to)).Count(ctx); err != nil {
+			return err
+		} else if n != 2 {
+			return &NotFoundError{label: user.Label}
+		}
+		return client.transfer(ctx, from, to, edges)
+	})
+}
+
+// transfer rewires the selected edges of the User with the "from" id to the User with the "to" id.
+func (c *UserClient) transfer(ctx context.Context, from, to int, edges map[string]bool) error {
+	if edges[user.EdgeCard] {
+		err := NewCardClient(c.config).Update().
+			Where(card.HasOwnerWith(user.ID(from))).
+			SetOwnerID(to).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if edges[user.EdgePets] {
+		err := NewPetClient(c.config).Update().
+			Where(pet.HasOwnerWith(user.ID(from))).
+			SetOwnerID(to).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if edges[user.EdgeFiles] {
+		err := NewFileClient(c.config).Update().
+			Where(file.HasOwnerWith(user.ID(from))).
+			SetOwnerID(to).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if edges[user.EdgeGroups] {
+		ids, err := c.Query().Where(user.ID(from)).QueryGroups().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		linked, err := c.Query().Where(user.ID(to)).QueryGroups().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		add, err := c.Query().Where(user.ID(from)).QueryGroups().Where(group.IDNotIn(linked...)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			if err := c.UpdateOneID(from).RemoveGroupIDs(ids...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if len(add) > 0 {
+			if err := c.UpdateOneID(to).AddGroupIDs(add...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	if edges[user.EdgeFollowing] {
+		ids, err := c.Query().Where(user.ID(from)).QueryFollowing().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		linked, err := c.Query().Where(user.ID(to)).QueryFollowing().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		add, err := c.Query().Where(user.ID(from)).QueryFollowing().Where(user.IDNotIn(linked...), user.IDNEQ(to)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			if err := c.UpdateOneID(from).RemoveFollowingIDs(ids...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if len(add) > 0 {
+			if err := c.UpdateOneID(to).AddFollowingIDs(add...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	if edges[user.EdgeTeam] {
+		err := NewPetClient(c.config).Update().
+			Where(pet.HasTeamWith(user.ID(from))).
+			SetTeamID(to).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Truncate removes all User entities, and resets the identity sequence of the table. Unless the Cascade
+// option is used, it fails if the table is referenced by other tables (in MySQL and PostgreSQL). Note that the
+// rows are removed by the database, and therefore, the hooks of the delete builders are not executed.
+func (c *UserClient) Truncate(ctx context.Context, opts ...TruncateOption) error {
+	_spec := &sqlgraph.TruncateSpec{}
+	for _, opt := range opts {
+		opt(_spec)
+	}
+	_spec.Tables = []string{user.Table}
+	if _spec.Cascade {
+		_spec.Tables = sqlschema.Dependents(migrate.Tables, user.Table)
+	}
+	return sqlgraph.Truncate(ctx, c.driver, _spec)
 }
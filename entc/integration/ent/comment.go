@@ -9,6 +9,7 @@ package ent
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -31,6 +32,9 @@ type Comment struct {
 	Table string `json:"table,omitempty"`
 	// Dir holds the value of the "dir" field.
 	Dir schemadir.Dir `json:"dir,omitempty"`
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[5]bool
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -148,6 +152,293 @@ func (c *Comment) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the Comment and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (c *Comment) Diff(other *Comment) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(c.UniqueInt, other.UniqueInt) {
+		changes = append(changes, FieldChange{Field: comment.FieldUniqueInt, Old: c.UniqueInt, New: other.UniqueInt})
+	}
+	if !reflect.DeepEqual(c.UniqueFloat, other.UniqueFloat) {
+		changes = append(changes, FieldChange{Field: comment.FieldUniqueFloat, Old: c.UniqueFloat, New: other.UniqueFloat})
+	}
+	if !reflect.DeepEqual(c.NillableInt, other.NillableInt) {
+		change := FieldChange{Field: comment.FieldNillableInt}
+		if c.NillableInt != nil {
+			change.Old = *c.NillableInt
+		}
+		if other.NillableInt != nil {
+			change.New = *other.NillableInt
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(c.Table, other.Table) {
+		changes = append(changes, FieldChange{Field: comment.FieldTable, Old: c.Table, New: other.Table})
+	}
+	if !reflect.DeepEqual(c.Dir, other.Dir) {
+		changes = append(changes, FieldChange{Field: comment.FieldDir, Old: c.Dir, New: other.Dir})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the Comment that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (c *Comment) ApplyMask(paths ...string) (*Comment, error) {
+	if len(paths) == 0 {
+		masked := *c
+		return &masked, nil
+	}
+	masked := &Comment{config: c.config, ID: c.ID}
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case comment.FieldID:
+		case "unique_int":
+			masked.UniqueInt = c.UniqueInt
+		case "unique_float":
+			masked.UniqueFloat = c.UniqueFloat
+		case "nillable_int":
+			masked.NillableInt = c.NillableInt
+		case "table":
+			masked.Table = c.Table
+		case "dir":
+			masked.Dir = c.Dir
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type Comment", p)
+		}
+	}
+	return masked, nil
+}
+
+// CommentPatch describes a partial update of a Comment entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type CommentPatch struct {
+	// UniqueInt holds the new value of the "unique_int" field.
+	UniqueInt *int `json:"unique_int,omitempty"`
+	// UniqueFloat holds the new value of the "unique_float" field.
+	UniqueFloat *float64 `json:"unique_float,omitempty"`
+	// NillableInt holds the new value of the "nillable_int" field.
+	NillableInt *int `json:"nillable_int,omitempty"`
+	// Table holds the new value of the "table" field.
+	Table *string `json:"table,omitempty"`
+	// Dir holds the new value of the "dir" field.
+	Dir *schemadir.Dir `json:"dir,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, comment.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *CommentPatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p CommentPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.UniqueInt != nil {
+		fields["unique_int"] = *p.UniqueInt
+	}
+	if p.UniqueFloat != nil {
+		fields["unique_float"] = *p.UniqueFloat
+	}
+	if p.NillableInt != nil {
+		fields["nillable_int"] = *p.NillableInt
+	}
+	if p.Table != nil {
+		fields["table"] = *p.Table
+	}
+	if p.Dir != nil {
+		fields["dir"] = *p.Dir
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		case comment.FieldNillableInt:
+			fields["nillable_int"] = nil
+		case comment.FieldTable:
+			fields["table"] = nil
+		case comment.FieldDir:
+			fields["dir"] = nil
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional Comment field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *CommentPatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "unique_int":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Comment field %q cannot be null", name)
+			}
+			p.UniqueInt = new(int)
+			if err := json.Unmarshal(raw, p.UniqueInt); err != nil {
+				return fmt.Errorf("ent: decoding Comment field %q: %w", name, err)
+			}
+		case "unique_float":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Comment field %q cannot be null", name)
+			}
+			p.UniqueFloat = new(float64)
+			if err := json.Unmarshal(raw, p.UniqueFloat); err != nil {
+				return fmt.Errorf("ent: decoding Comment field %q: %w", name, err)
+			}
+		case "nillable_int":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, comment.FieldNillableInt)
+				continue
+			}
+			p.NillableInt = new(int)
+			if err := json.Unmarshal(raw, p.NillableInt); err != nil {
+				return fmt.Errorf("ent: decoding Comment field %q: %w", name, err)
+			}
+		case "table":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, comment.FieldTable)
+				continue
+			}
+			p.Table = new(string)
+			if err := json.Unmarshal(raw, p.Table); err != nil {
+				return fmt.Errorf("ent: decoding Comment field %q: %w", name, err)
+			}
+		case "dir":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, comment.FieldDir)
+				continue
+			}
+			p.Dir = new(schemadir.Dir)
+			if err := json.Unmarshal(raw, p.Dir); err != nil {
+				return fmt.Errorf("ent: decoding Comment field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable Comment field %q", name)
+		}
+	}
+	return nil
+}
+
+// Selected reports whether the given field was loaded into the Comment, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	c := client.Comment.Query().Select(comment.FieldUniqueInt).FirstX(ctx)
+//	c.Selected(comment.FieldUniqueInt) // true
+//
+func (c *Comment) Selected(field string) bool {
+	switch field {
+	case comment.FieldID:
+		return true
+	case comment.FieldUniqueInt:
+		return c.selectedFields == nil || c.selectedFields[0]
+	case comment.FieldUniqueFloat:
+		return c.selectedFields == nil || c.selectedFields[1]
+	case comment.FieldNillableInt:
+		return c.selectedFields == nil || c.selectedFields[2]
+	case comment.FieldTable:
+		return c.selectedFields == nil || c.selectedFields[3]
+	case comment.FieldDir:
+		return c.selectedFields == nil || c.selectedFields[4]
+	}
+	return false
+}
+
+// UniqueIntOrErr returns the value of the "unique_int" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Comment.
+func (c *Comment) UniqueIntOrErr() (int, error) {
+	if c.selectedFields != nil && !c.selectedFields[0] {
+		var zero int
+		return zero, &NotSelectedError{label: comment.Label, field: comment.FieldUniqueInt}
+	}
+	return c.UniqueInt, nil
+}
+
+// UniqueFloatOrErr returns the value of the "unique_float" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Comment.
+func (c *Comment) UniqueFloatOrErr() (float64, error) {
+	if c.selectedFields != nil && !c.selectedFields[1] {
+		var zero float64
+		return zero, &NotSelectedError{label: comment.Label, field: comment.FieldUniqueFloat}
+	}
+	return c.UniqueFloat, nil
+}
+
+// NillableIntOrErr returns the value of the "nillable_int" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Comment.
+func (c *Comment) NillableIntOrErr() (*int, error) {
+	if c.selectedFields != nil && !c.selectedFields[2] {
+		var zero *int
+		return zero, &NotSelectedError{label: comment.Label, field: comment.FieldNillableInt}
+	}
+	return c.NillableInt, nil
+}
+
+// TableOrErr returns the value of the "table" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Comment.
+func (c *Comment) TableOrErr() (string, error) {
+	if c.selectedFields != nil && !c.selectedFields[3] {
+		var zero string
+		return zero, &NotSelectedError{label: comment.Label, field: comment.FieldTable}
+	}
+	return c.Table, nil
+}
+
+// DirOrErr returns the value of the "dir" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Comment.
+func (c *Comment) DirOrErr() (schemadir.Dir, error) {
+	if c.selectedFields != nil && !c.selectedFields[4] {
+		var zero schemadir.Dir
+		return zero, &NotSelectedError{label: comment.Label, field: comment.FieldDir}
+	}
+	return c.Dir, nil
+}
+
+// selectFields returns the fields of the Comment that are included in the given
+// columns, or nil if all of them are included.
+func (*Comment) selectFields(columns []string) *[5]bool {
+	var selected [5]bool
+	for _, c := range columns {
+		switch c {
+		case comment.FieldUniqueInt:
+			selected[0] = true
+		case comment.FieldUniqueFloat:
+			selected[1] = true
+		case comment.FieldNillableInt:
+			selected[2] = true
+		case comment.FieldTable:
+			selected[3] = true
+		case comment.FieldDir:
+			selected[4] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // Comments is a parsable slice of Comment.
 type Comments []*Comment
 
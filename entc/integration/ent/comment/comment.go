@@ -6,6 +6,11 @@
 
 package comment
 
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the comment type in the database.
 	Label = "comment"
@@ -45,4 +50,71 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// fieldInfos describes the fields of the Comment type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "unique_int",
+		Column:      FieldUniqueInt,
+		StructField: "UniqueInt",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Unique:      true,
+	},
+	{
+		Name:        "unique_float",
+		Column:      FieldUniqueFloat,
+		StructField: "UniqueFloat",
+		Type:        field.TypeFloat64,
+		GoType:      "float64",
+		Unique:      true,
+	},
+	{
+		Name:        "nillable_int",
+		Column:      FieldNillableInt,
+		StructField: "NillableInt",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "table",
+		Column:      FieldTable,
+		StructField: "Table",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+	},
+	{
+		Name:        "dir",
+		Column:      FieldDir,
+		StructField: "Dir",
+		Type:        field.TypeJSON,
+		GoType:      "schemadir.Dir",
+		Optional:    true,
+	},
+}
+
+// Fields returns the information of the Comment fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+	"unique_int",
+	"unique_float",
+}
+
 // comment from another template.
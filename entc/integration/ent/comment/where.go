@@ -8,6 +8,7 @@ package comment
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/ent/predicate"
 )
 
@@ -436,6 +437,126 @@ func DirNotNil() predicate.Comment {
 	})
 }
 
+// DirHasKey applies the HasKey predicate on the "dir" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func DirHasKey(path string) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldDir), sqljson.DotPath(path)))
+	})
+}
+
+// DirValueIsNull applies the ValueIsNull predicate on the "dir" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func DirValueIsNull(path string) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldDir), sqljson.DotPath(path)))
+	})
+}
+
+// DirValueEQ applies the ValueEQ predicate on the JSON value of the "dir" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirValueEQ(path string, v interface{}) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldDir), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirValueNEQ applies the ValueNEQ predicate on the JSON value of the "dir" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirValueNEQ(path string, v interface{}) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldDir), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirValueGT applies the ValueGT predicate on the JSON value of the "dir" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirValueGT(path string, v interface{}) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldDir), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirValueGTE applies the ValueGTE predicate on the JSON value of the "dir" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirValueGTE(path string, v interface{}) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldDir), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirValueLT applies the ValueLT predicate on the JSON value of the "dir" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirValueLT(path string, v interface{}) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldDir), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirValueLTE applies the ValueLTE predicate on the JSON value of the "dir" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirValueLTE(path string, v interface{}) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldDir), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirValueContains applies the ValueContains predicate on the JSON value of the "dir" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirValueContains(path string, v interface{}) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldDir), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirLenEQ applies the LenEQ predicate on the length of the JSON array of the "dir"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirLenEQ(path string, n int) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldDir), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "dir"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirLenNEQ(path string, n int) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldDir), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirLenGT applies the LenGT predicate on the length of the JSON array of the "dir"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirLenGT(path string, n int) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldDir), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirLenGTE applies the LenGTE predicate on the length of the JSON array of the "dir"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirLenGTE(path string, n int) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldDir), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirLenLT applies the LenLT predicate on the length of the JSON array of the "dir"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirLenLT(path string, n int) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldDir), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirLenLTE applies the LenLTE predicate on the length of the JSON array of the "dir"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirLenLTE(path string, n int) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldDir), n, sqljson.DotPath(path)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Comment) predicate.Comment {
 	return predicate.Comment(func(s *sql.Selector) {
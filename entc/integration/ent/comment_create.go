@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -233,6 +234,68 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// CommentInsertSelect is the builder for creating Comment entities from the result of a query.
+type CommentInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the Comment table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+// For example:
+//
+//	client.Comment.CreateFromQuery(query.Select(...)).
+//		Columns(comment.FieldUniqueInt).
+//		Exec(ctx)
+//
+func (cis *CommentInsertSelect) Columns(columns ...string) *CommentInsertSelect {
+	cis.columns = append(cis.columns, columns...)
+	return cis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (cis *CommentInsertSelect) Exec(ctx context.Context) (int, error) {
+	if cis.query == nil {
+		return 0, errors.New("ent: missing query for Comment.CreateFromQuery")
+	}
+	if err := cis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := cis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := cis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !comment.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for Comment.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: comment.Table, Columns: comment.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, cis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cis *CommentInsertSelect) ExecX(ctx context.Context) int {
+	n, err := cis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -276,6 +339,7 @@ type (
 	//  one Comment node.
 	CommentUpsertOne struct {
 		create *CommentCreate
+		err    error // first validation error of the update values.
 	}
 
 	// CommentUpsert is the "OnConflict" setter.
@@ -536,6 +600,9 @@ func (u *CommentUpsertOne) ClearDir() *CommentUpsertOne {
 
 // Exec executes the query.
 func (u *CommentUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for CommentCreate.OnConflict")
 	}
@@ -544,13 +611,16 @@ func (u *CommentUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *CommentUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *CommentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -567,6 +637,43 @@ func (u *CommentUpsertOne) IDX(ctx context.Context) int {
 	return id
 }
 
+// SaveAsync adds the creation of the Comment entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (cc *CommentCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, cc.config, cc.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the Comment creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (cc *CommentCreate) IdempotencyKey(key string) *CommentCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &cc.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*Comment)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from CommentMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id int
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&CommentClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	cc.hooks = append([]Hook{hook}, cc.hooks...)
+	return cc
+}
+
 // CommentCreateBulk is the builder for creating many Comment entities in bulk.
 type CommentCreateBulk struct {
 	config
@@ -652,6 +759,78 @@ func (ccb *CommentCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// CommentBulkResult holds the result of creating one of the Comment entities using CommentCreateBulk.ContinueOnError.
+type CommentBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *Comment
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the Comment entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.Comment.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (ccb *CommentCreateBulk) ContinueOnError(ctx context.Context) ([]CommentBulkResult, error) {
+	client := &Client{config: ccb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]CommentBulkResult, len(ccb.builders))
+	for i, builder := range ccb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = ccb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -693,6 +872,7 @@ func (ccb *CommentCreateBulk) OnConflictColumns(columns ...string) *CommentUpser
 // a bulk of Comment nodes.
 type CommentUpsertBulk struct {
 	create *CommentCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -851,6 +1031,9 @@ func (u *CommentUpsertBulk) ClearDir() *CommentUpsertBulk {
 
 // Exec executes the query.
 func (u *CommentUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CommentCreateBulk instead", i)
@@ -864,7 +1047,7 @@ func (u *CommentUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *CommentUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
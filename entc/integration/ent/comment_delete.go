@@ -95,6 +95,19 @@ func (cd *CommentDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the Comment entities that are joined with the rows of the given table, on the given columns
+// of the Comment table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.Comment.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (cd *CommentDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *CommentDelete {
+	cd.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return cd
+}
+
 // CommentDeleteOne is the builder for deleting a single Comment entity.
 type CommentDeleteOne struct {
 	cd *CommentDelete
@@ -107,7 +120,7 @@ func (cdo *CommentDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{comment.Label}
+		return &NotFoundError{label: comment.Label}
 	default:
 		return nil
 	}
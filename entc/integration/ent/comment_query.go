@@ -8,27 +8,33 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/comment"
 	"entgo.io/ent/entc/integration/ent/predicate"
+	schemadir "entgo.io/ent/entc/integration/ent/schema/dir"
 	"entgo.io/ent/schema/field"
 )
 
 // CommentQuery is the builder for querying Comment entities.
 type CommentQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.Comment
-	modifiers  []func(*sql.Selector)
+	limit        *int
+	offset       *int
+	unique       *bool
+	order        []OrderFunc
+	fields       []string
+	inters       []Interceptor
+	predicates   []predicate.Comment
+	loadStrategy LoadStrategy
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -73,7 +79,7 @@ func (cq *CommentQuery) First(ctx context.Context) (*Comment, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{comment.Label}
+		return nil, cq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -95,7 +101,7 @@ func (cq *CommentQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{comment.Label}
+		err = cq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -114,6 +120,11 @@ func (cq *CommentQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one Comment entity is found.
 // Returns a *NotFoundError when no Comment entities are found.
 func (cq *CommentQuery) Only(ctx context.Context) (*Comment, error) {
+	if cq.singleflight != nil {
+		if _, ok := cq.driver.(*txDriver); !ok {
+			return cq.onlyShared(ctx)
+		}
+	}
 	nodes, err := cq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -122,7 +133,7 @@ func (cq *CommentQuery) Only(ctx context.Context) (*Comment, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{comment.Label}
+		return nil, cq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{comment.Label}
 	}
@@ -149,7 +160,7 @@ func (cq *CommentQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{comment.Label}
+		err = cq.sqlNotFound()
 	default:
 		err = &NotSingularError{comment.Label}
 	}
@@ -170,7 +181,20 @@ func (cq *CommentQuery) All(ctx context.Context) ([]*Comment, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.sqlAll(ctx)
+	if p := cq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return cq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := cq.intercept(ctx, "All", func(ctx context.Context, query *CommentQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Comment)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Comment", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -184,10 +208,20 @@ func (cq *CommentQuery) AllX(ctx context.Context) []*Comment {
 
 // IDs executes the query and returns a list of Comment IDs.
 func (cq *CommentQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := cq.Select(comment.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := cq.intercept(ctx, "IDs", func(ctx context.Context, query *CommentQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(comment.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -205,7 +239,17 @@ func (cq *CommentQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.sqlCount(ctx)
+	v, err := cq.intercept(ctx, "Count", func(ctx context.Context, query *CommentQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -222,7 +266,47 @@ func (cq *CommentQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.sqlExist(ctx)
+	v, err := cq.intercept(ctx, "Exist", func(ctx context.Context, query *CommentQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (cq *CommentQuery) intercept(ctx context.Context, op string, fn func(context.Context, *CommentQuery) (Value, error)) (Value, error) {
+	if len(cq.inters) == 0 {
+		return fn(ctx, cq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeComment,
+		Op:     op,
+		Limit:  cq.limit,
+		Offset: cq.offset,
+		Unique: cq.unique,
+		Fields: cq.fields,
+	}
+	for _, p := range cq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range cq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CommentQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *CommentQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, cq, qc, qr, cq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -245,11 +329,13 @@ func (cq *CommentQuery) Clone() *CommentQuery {
 		limit:      cq.limit,
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		predicates: append([]predicate.Comment{}, cq.predicates...),
 		// clone intermediate query.
-		sql:    cq.sql.Clone(),
-		path:   cq.path,
-		unique: cq.unique,
+		sql:          cq.sql.Clone(),
+		path:         cq.path,
+		unique:       cq.unique,
+		loadStrategy: cq.loadStrategy,
 	}
 }
 
@@ -309,6 +395,16 @@ func (cq *CommentQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range cq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cq); err != nil {
+				return err
+			}
+		}
+	}
 	if cq.path != nil {
 		prev, err := cq.path(ctx)
 		if err != nil {
@@ -324,12 +420,22 @@ func (cq *CommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comm
 		nodes = []*Comment{}
 		_spec = cq.querySpec()
 	)
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[5]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Comment).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []interface{}) error {
 		node := &Comment{config: cq.config}
 		nodes = append(nodes, node)
+		if !computed {
+			selected, computed = (*Comment).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(cq.modifiers) > 0 {
@@ -338,7 +444,7 @@ func (cq *CommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comm
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, cq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -356,7 +462,7 @@ func (cq *CommentQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(cq.fields) > 0 {
 		_spec.Unique = cq.unique != nil && *cq.unique
 	}
-	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, cq.queryDriver(), _spec)
 }
 
 func (cq *CommentQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -450,6 +556,200 @@ func (cq *CommentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (cq *CommentQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: comment.Label}
+	if len(cq.predicates) > 0 {
+		selector := sql.Dialect(cq.driver.Dialect()).Select().From(sql.Table(comment.Table))
+		for _, p := range cq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the CommentQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (cq *CommentQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Comment, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Comment(ps[i]))
+	}
+	cq.predicates = append(cq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (cq *CommentQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := cq.querySpec()
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = cq.unique != nil && *cq.unique
+	return sqlgraph.EstimateNodes(ctx, cq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (cq *CommentQuery) CountEstimateX(ctx context.Context) int {
+	count, err := cq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// Comment rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (cq *CommentQuery) Sample(percent float64) *CommentQuery {
+	cq.predicates = append(cq.predicates, sql.Sample(percent))
+	return cq
+}
+
+// CommentIterator iterates over the results of a Comment query, and decodes them one at a time.
+type CommentIterator struct {
+	ctx   context.Context
+	query *CommentQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*Comment
+	node  *Comment
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the Comment entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.Comment.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (cq *CommentQuery) Iterate(ctx context.Context, opts ...IterateOption) (*CommentIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := cq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &CommentIterator{ctx: ctx, query: cq, batch: o.batch}
+	var (
+		_spec = cq.querySpec()
+	)
+	var (
+		selected *[5]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Comment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Comment{config: cq.config}
+		it.nodes = append(it.nodes, node)
+		if !computed {
+			selected, computed = (*Comment).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, cq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (cq *CommentQuery) iterateLoad(ctx context.Context, nodes []*Comment) ([]*Comment, error) {
+	return nodes, nil
+}
+
+// Next advances the iterator to the next Comment, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *CommentIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*Comment, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current Comment of the iterator.
+func (it *CommentIterator) Value() *Comment {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *CommentIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *CommentIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// LoadStrategy sets the strategy for eager-loading the Comment entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (cq *CommentQuery) LoadStrategy(s LoadStrategy) *CommentQuery {
+	cq.loadStrategy = s
+	return cq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (cq *CommentQuery) joinable() bool {
+	if cq.loadStrategy != LoadStrategyJoin || cq.limit != nil || cq.offset != nil {
+		return false
+	}
+	if len(cq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range cq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -482,6 +782,381 @@ func (cq *CommentQuery) Modify(modifiers ...func(s *sql.Selector)) *CommentSelec
 	return cq.Select()
 }
 
+// Project returns a builder for querying the given fields into CommentProjection structs,
+// instead of full Comment entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.Comment.Query().
+//		Project(comment.FieldID, comment.FieldUniqueInt).
+//		All(ctx)
+//
+func (cq *CommentQuery) Project(fields ...string) *CommentProject {
+	cq.fields = append(cq.fields, fields...)
+	return &CommentProject{query: cq}
+}
+
+// CommentProjection is a lightweight projection of the Comment entity that is returned by
+// CommentProject. Fields that were not selected by the query hold their zero values.
+type CommentProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UniqueInt holds the value of the "unique_int" field.
+	UniqueInt int `json:"unique_int,omitempty"`
+	// UniqueFloat holds the value of the "unique_float" field.
+	UniqueFloat float64 `json:"unique_float,omitempty"`
+	// NillableInt holds the value of the "nillable_int" field.
+	NillableInt *int `json:"nillable_int,omitempty"`
+	// Table holds the value of the "table" field.
+	Table string `json:"table,omitempty"`
+	// Dir holds the value of the "dir" field.
+	Dir schemadir.Dir `json:"dir,omitempty"`
+}
+
+// CommentProject is the builder for querying Comment fields into projections.
+type CommentProject struct {
+	query *CommentQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (cp *CommentProject) All(ctx context.Context) ([]*CommentProjection, error) {
+	if err := cp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := cp.query.fields
+	if len(columns) == 0 {
+		columns = comment.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := cp.query.sqlQuery(ctx).Query()
+	if err := cp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &Comment{}
+		result []*CommentProjection
+	)
+	for rows.Next() {
+		*e = Comment{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &CommentProjection{
+			ID:          e.ID,
+			UniqueInt:   e.UniqueInt,
+			UniqueFloat: e.UniqueFloat,
+			NillableInt: e.NillableInt,
+			Table:       e.Table,
+			Dir:         e.Dir,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cp *CommentProject) AllX(ctx context.Context) []*CommentProjection {
+	items, err := cp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (cq *CommentQuery) onlyShared(ctx context.Context) (*Comment, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	cq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *cq
+	kq.sql = cq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if cq.singleflightScope != nil {
+		scope = cq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := cq.singleflight.DoChan(singleflightKey(scope, comment.Label, query, args), func() (interface{}, error) {
+		nodes, err := cq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, cq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{comment.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*Comment)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.Comment.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (cq *CommentQuery) SelectMask(paths ...string) *CommentSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case comment.FieldID:
+		case "unique_int":
+			fields = append(fields, comment.FieldUniqueInt)
+		case "unique_float":
+			fields = append(fields, comment.FieldUniqueFloat)
+		case "nillable_int":
+			fields = append(fields, comment.FieldNillableInt)
+		case "table":
+			fields = append(fields, comment.FieldTable)
+		case "dir":
+			fields = append(fields, comment.FieldDir)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, comment.FieldID)
+	}
+	return cq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the comment.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (cq *CommentQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = comment.FieldID
+	case "unique_int":
+		column = comment.FieldUniqueInt
+	case "unique_float":
+		column = comment.FieldUniqueFloat
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering Comment`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		cq.Order(Asc(column))
+	case DirectionDesc:
+		cq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// CommentPage is a page of Comment entities returned by the Paginate method.
+type CommentPage struct {
+	Nodes []*Comment `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of Comment entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.Comment.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.Comment.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (cq *CommentQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*CommentPage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case comment.FieldID:
+			order.column = comment.FieldID
+		case comment.FieldUniqueInt:
+			order.column = comment.FieldUniqueInt
+		case comment.FieldUniqueFloat:
+			order.column = comment.FieldUniqueFloat
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating Comment`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != comment.FieldID {
+		order := paginateOrder{name: comment.FieldID, column: comment.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := cq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &CommentPage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case comment.FieldID:
+				values[j] = n.ID
+			case comment.FieldUniqueInt:
+				values[j] = n.UniqueInt
+			case comment.FieldUniqueFloat:
+				values[j] = n.UniqueFloat
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (cq *CommentQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case comment.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		case comment.FieldUniqueInt:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		case comment.FieldUniqueFloat:
+			var v float64
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (cq *CommentQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*Comment, error) {
+	if cq.limit != nil {
+		return cq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	cq.limit = &limit
+	defer func() { cq.limit = nil }()
+	nodes, err := cq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: comment.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // CommentGroupBy is the group-by builder for Comment entities.
 type CommentGroupBy struct {
 	config
@@ -521,7 +1196,7 @@ func (cgb *CommentGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := cgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := cgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -567,7 +1242,7 @@ func (cs *CommentSelect) Scan(ctx context.Context, v interface{}) error {
 func (cs *CommentSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := cs.sql.Query()
-	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := cs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
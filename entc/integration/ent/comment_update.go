@@ -45,6 +45,25 @@ func (cu *CommentUpdate) AddUniqueInt(i int) *CommentUpdate {
 	return cu
 }
 
+// SetUniqueIntIfGreater sets the "unique_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *CommentUpdate) SetUniqueIntIfGreater(i int) *CommentUpdate {
+	cu.mutation.SetUniqueIntIfGreater(i)
+	return cu
+}
+
+// BitAndUniqueInt applies a bitwise AND with i on the "unique_int" field in the database.
+func (cu *CommentUpdate) BitAndUniqueInt(i int) *CommentUpdate {
+	cu.mutation.BitAndUniqueInt(i)
+	return cu
+}
+
+// BitOrUniqueInt applies a bitwise OR with i on the "unique_int" field in the database.
+func (cu *CommentUpdate) BitOrUniqueInt(i int) *CommentUpdate {
+	cu.mutation.BitOrUniqueInt(i)
+	return cu
+}
+
 // SetUniqueFloat sets the "unique_float" field.
 func (cu *CommentUpdate) SetUniqueFloat(f float64) *CommentUpdate {
 	cu.mutation.ResetUniqueFloat()
@@ -58,6 +77,13 @@ func (cu *CommentUpdate) AddUniqueFloat(f float64) *CommentUpdate {
 	return cu
 }
 
+// SetUniqueFloatIfGreater sets the "unique_float" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *CommentUpdate) SetUniqueFloatIfGreater(f float64) *CommentUpdate {
+	cu.mutation.SetUniqueFloatIfGreater(f)
+	return cu
+}
+
 // SetNillableInt sets the "nillable_int" field.
 func (cu *CommentUpdate) SetNillableInt(i int) *CommentUpdate {
 	cu.mutation.ResetNillableInt()
@@ -79,6 +105,25 @@ func (cu *CommentUpdate) AddNillableInt(i int) *CommentUpdate {
 	return cu
 }
 
+// SetNillableIntIfGreater sets the "nillable_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *CommentUpdate) SetNillableIntIfGreater(i int) *CommentUpdate {
+	cu.mutation.SetNillableIntIfGreater(i)
+	return cu
+}
+
+// BitAndNillableInt applies a bitwise AND with i on the "nillable_int" field in the database.
+func (cu *CommentUpdate) BitAndNillableInt(i int) *CommentUpdate {
+	cu.mutation.BitAndNillableInt(i)
+	return cu
+}
+
+// BitOrNillableInt applies a bitwise OR with i on the "nillable_int" field in the database.
+func (cu *CommentUpdate) BitOrNillableInt(i int) *CommentUpdate {
+	cu.mutation.BitOrNillableInt(i)
+	return cu
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (cu *CommentUpdate) ClearNillableInt() *CommentUpdate {
 	cu.mutation.ClearNillableInt()
@@ -216,6 +261,27 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: comment.FieldUniqueInt,
 		})
 	}
+	if value, ok := cu.mutation.UniqueIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldUniqueInt,
+		})
+	}
+	if value, ok := cu.mutation.UniqueIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldUniqueInt,
+		})
+	}
+	if value, ok := cu.mutation.UniqueIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldUniqueInt,
+		})
+	}
 	if value, ok := cu.mutation.UniqueFloat(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -230,6 +296,13 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: comment.FieldUniqueFloat,
 		})
 	}
+	if value, ok := cu.mutation.UniqueFloatIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: comment.FieldUniqueFloat,
+		})
+	}
 	if value, ok := cu.mutation.NillableInt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -244,6 +317,27 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: comment.FieldNillableInt,
 		})
 	}
+	if value, ok := cu.mutation.NillableIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldNillableInt,
+		})
+	}
+	if value, ok := cu.mutation.NillableIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldNillableInt,
+		})
+	}
+	if value, ok := cu.mutation.NillableIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldNillableInt,
+		})
+	}
 	if cu.mutation.NillableIntCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -278,7 +372,7 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{comment.Label}
+			err = &NotFoundError{label: comment.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -290,9 +384,18 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // CommentUpdateOne is the builder for updating a single Comment entity.
 type CommentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CommentMutation
+	fields     []string
+	conditions []predicate.Comment
+	hooks      []Hook
+	mutation   *CommentMutation
+}
+
+// Where appends a list of conditions to the CommentUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
+	cuo.conditions = append(cuo.conditions, ps...)
+	return cuo
 }
 
 // SetUniqueInt sets the "unique_int" field.
@@ -308,6 +411,25 @@ func (cuo *CommentUpdateOne) AddUniqueInt(i int) *CommentUpdateOne {
 	return cuo
 }
 
+// SetUniqueIntIfGreater sets the "unique_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *CommentUpdateOne) SetUniqueIntIfGreater(i int) *CommentUpdateOne {
+	cuo.mutation.SetUniqueIntIfGreater(i)
+	return cuo
+}
+
+// BitAndUniqueInt applies a bitwise AND with i on the "unique_int" field in the database.
+func (cuo *CommentUpdateOne) BitAndUniqueInt(i int) *CommentUpdateOne {
+	cuo.mutation.BitAndUniqueInt(i)
+	return cuo
+}
+
+// BitOrUniqueInt applies a bitwise OR with i on the "unique_int" field in the database.
+func (cuo *CommentUpdateOne) BitOrUniqueInt(i int) *CommentUpdateOne {
+	cuo.mutation.BitOrUniqueInt(i)
+	return cuo
+}
+
 // SetUniqueFloat sets the "unique_float" field.
 func (cuo *CommentUpdateOne) SetUniqueFloat(f float64) *CommentUpdateOne {
 	cuo.mutation.ResetUniqueFloat()
@@ -321,6 +443,13 @@ func (cuo *CommentUpdateOne) AddUniqueFloat(f float64) *CommentUpdateOne {
 	return cuo
 }
 
+// SetUniqueFloatIfGreater sets the "unique_float" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *CommentUpdateOne) SetUniqueFloatIfGreater(f float64) *CommentUpdateOne {
+	cuo.mutation.SetUniqueFloatIfGreater(f)
+	return cuo
+}
+
 // SetNillableInt sets the "nillable_int" field.
 func (cuo *CommentUpdateOne) SetNillableInt(i int) *CommentUpdateOne {
 	cuo.mutation.ResetNillableInt()
@@ -342,6 +471,25 @@ func (cuo *CommentUpdateOne) AddNillableInt(i int) *CommentUpdateOne {
 	return cuo
 }
 
+// SetNillableIntIfGreater sets the "nillable_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *CommentUpdateOne) SetNillableIntIfGreater(i int) *CommentUpdateOne {
+	cuo.mutation.SetNillableIntIfGreater(i)
+	return cuo
+}
+
+// BitAndNillableInt applies a bitwise AND with i on the "nillable_int" field in the database.
+func (cuo *CommentUpdateOne) BitAndNillableInt(i int) *CommentUpdateOne {
+	cuo.mutation.BitAndNillableInt(i)
+	return cuo
+}
+
+// BitOrNillableInt applies a bitwise OR with i on the "nillable_int" field in the database.
+func (cuo *CommentUpdateOne) BitOrNillableInt(i int) *CommentUpdateOne {
+	cuo.mutation.BitOrNillableInt(i)
+	return cuo
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (cuo *CommentUpdateOne) ClearNillableInt() *CommentUpdateOne {
 	cuo.mutation.ClearNillableInt()
@@ -495,6 +643,13 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 			}
 		}
 	}
+	if ps := cuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := cuo.mutation.UniqueInt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -509,6 +664,27 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 			Column: comment.FieldUniqueInt,
 		})
 	}
+	if value, ok := cuo.mutation.UniqueIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldUniqueInt,
+		})
+	}
+	if value, ok := cuo.mutation.UniqueIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldUniqueInt,
+		})
+	}
+	if value, ok := cuo.mutation.UniqueIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldUniqueInt,
+		})
+	}
 	if value, ok := cuo.mutation.UniqueFloat(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -523,6 +699,13 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 			Column: comment.FieldUniqueFloat,
 		})
 	}
+	if value, ok := cuo.mutation.UniqueFloatIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: comment.FieldUniqueFloat,
+		})
+	}
 	if value, ok := cuo.mutation.NillableInt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -537,6 +720,27 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 			Column: comment.FieldNillableInt,
 		})
 	}
+	if value, ok := cuo.mutation.NillableIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldNillableInt,
+		})
+	}
+	if value, ok := cuo.mutation.NillableIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldNillableInt,
+		})
+	}
+	if value, ok := cuo.mutation.NillableIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldNillableInt,
+		})
+	}
 	if cuo.mutation.NillableIntCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -574,7 +778,9 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{comment.Label}
+			err = &NotFoundError{label: comment.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: comment.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -582,3 +788,108 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the Comment entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (cuo *CommentUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "Comment.id" for update`)}
+	}
+	return enqueueAsync(ctx, cuo.config, cuo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Comment.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (cu *CommentUpdate) SetChanged(changes []FieldChange) (*CommentUpdate, error) {
+	if err := setCommentChanged(cu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return cu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Comment.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (cuo *CommentUpdateOne) SetChanged(changes []FieldChange) (*CommentUpdateOne, error) {
+	if err := setCommentChanged(cuo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return cuo, nil
+}
+
+// setCommentChanged applies the given field changes on the Comment mutation.
+func setCommentChanged(m *CommentMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the Comment entities that are joined with the rows of the given table, on the given columns
+// of the Comment table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.Comment.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (cu *CommentUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *CommentUpdate {
+	cu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return cu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Comment schema.
+func (cu *CommentUpdate) ApplyPatch(p *CommentPatch) (*CommentUpdate, error) {
+	if err := applyCommentPatch(cu.mutation, p); err != nil {
+		return nil, err
+	}
+	return cu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Comment schema.
+func (cuo *CommentUpdateOne) ApplyPatch(p *CommentPatch) (*CommentUpdateOne, error) {
+	if err := applyCommentPatch(cuo.mutation, p); err != nil {
+		return nil, err
+	}
+	return cuo, nil
+}
+
+// applyCommentPatch applies the given patch on the Comment mutation.
+func applyCommentPatch(m *CommentMutation, p *CommentPatch) error {
+	if p.UniqueInt != nil {
+		m.SetUniqueInt(*p.UniqueInt)
+	}
+	if p.UniqueFloat != nil {
+		m.SetUniqueFloat(*p.UniqueFloat)
+	}
+	if p.NillableInt != nil {
+		m.SetNillableInt(*p.NillableInt)
+	}
+	if p.Table != nil {
+		m.SetTable(*p.Table)
+	}
+	if p.Dir != nil {
+		m.SetDir(*p.Dir)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
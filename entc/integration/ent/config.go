@@ -10,9 +10,15 @@ import (
 	"context"
 	stdsql "database/sql"
 	"fmt"
+	"reflect"
+	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/sqlidem"
+	"entgo.io/ent/dialect/sql/sqlqueue"
+	"golang.org/x/sync/singleflight"
 )
 
 // Option function to configure the client.
@@ -22,12 +28,35 @@ type Option func(*config)
 type config struct {
 	// driver used for executing database requests.
 	driver dialect.Driver
+	// readDriver used for executing read queries outside of transactions, if set.
+	readDriver dialect.Driver
 	// debug enable a debug logging.
 	debug bool
 	// log used for logging on debug mode.
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+	// interceptors to execute on queries.
+	inters *inters
+	// clock used for computing the time.Now defaults of fields.
+	clock func() time.Time
+	// extensions that were added to the client.
+	extensions []ent.Extension
+
+	// queryLimit is the policy for queries executed without a limit.
+	queryLimit *QueryLimitPolicy
+
+	// async is the queue of mutations that are executed asynchronously.
+	async *sqlqueue.Queue
+
+	// idempotency is the store of idempotency keys.
+	idempotency *sqlidem.Store
+
+	// singleflight coalesces identical concurrent Only calls.
+	singleflight *singleflight.Group
+	// singleflightScope returns the scope of the caller. Calls
+	// are coalesced only between callers with the same scope.
+	singleflightScope func(context.Context) string
 }
 
 // hooks per client, for fast access.
@@ -49,14 +78,36 @@ type hooks struct {
 	User      []ent.Hook
 }
 
+// inters per client, for fast access.
+type inters struct {
+	Card      []ent.Interceptor
+	Comment   []ent.Interceptor
+	FieldType []ent.Interceptor
+	File      []ent.Interceptor
+	FileType  []ent.Interceptor
+	Goods     []ent.Interceptor
+	Group     []ent.Interceptor
+	GroupInfo []ent.Interceptor
+	Item      []ent.Interceptor
+	License   []ent.Interceptor
+	Node      []ent.Interceptor
+	Pet       []ent.Interceptor
+	Spec      []ent.Interceptor
+	Task      []ent.Interceptor
+	User      []ent.Interceptor
+}
+
 // Options applies the options on the config object.
 func (c *config) options(opts ...Option) {
 	for _, opt := range opts {
 		opt(c)
 	}
-	if c.debug {
+	if _, ok := c.driver.(*dialect.DebugDriver); c.debug && !ok {
 		c.driver = dialect.Debug(c.driver, c.log)
 	}
+	if _, ok := c.readDriver.(*dialect.DebugDriver); c.debug && c.readDriver != nil && !ok {
+		c.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
 }
 
 // Debug enables debug logging on the ent.Driver.
@@ -80,6 +131,164 @@ func Driver(driver dialect.Driver) Option {
 	}
 }
 
+// ReadDriver configures the driver that executes the read queries of the client (e.g. Query, Only and Count),
+// for splitting the reads and writes between a read replica and the primary database. Mutations, and queries
+// that are executed in transactions, are executed by the driver that was configured using Driver. For example:
+//
+//	client := ent.NewClient(ent.Driver(primary), ent.ReadDriver(replica))
+//
+// Note that replicas may lag behind the primary. Reads that must observe the writes of the client should
+// be executed in a transaction, or using a client that was derived using WithOptions(ReadDriver(nil)).
+func ReadDriver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.readDriver = driver
+	}
+}
+
+// Clock sets the clock of the client. Fields whose default (or update default) function is time.Now
+// use the clock instead, which allows freezing the time in tests, or backfilling entities with
+// historical timestamps. For example:
+//
+//	client := ent.NewClient(ent.Driver(drv), ent.Clock(func() time.Time {
+//		return time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+//	}))
+//
+func Clock(now func() time.Time) Option {
+	return func(c *config) {
+		c.clock = now
+	}
+}
+
+// ReplaceHooks replaces the hooks that were registered on the entity clients using Use with the given
+// hooks. It is mainly used with Client.WithOptions, for creating clients with a different set of hooks.
+// Note that the hooks and policies that are defined in the schema are not affected by this option.
+func ReplaceHooks(hs ...Hook) Option {
+	return func(c *config) {
+		c.hooks = &hooks{
+			Card:      hs[:len(hs):len(hs)],
+			Comment:   hs[:len(hs):len(hs)],
+			FieldType: hs[:len(hs):len(hs)],
+			File:      hs[:len(hs):len(hs)],
+			FileType:  hs[:len(hs):len(hs)],
+			Goods:     hs[:len(hs):len(hs)],
+			Group:     hs[:len(hs):len(hs)],
+			GroupInfo: hs[:len(hs):len(hs)],
+			Item:      hs[:len(hs):len(hs)],
+			License:   hs[:len(hs):len(hs)],
+			Node:      hs[:len(hs):len(hs)],
+			Pet:       hs[:len(hs):len(hs)],
+			Spec:      hs[:len(hs):len(hs)],
+			Task:      hs[:len(hs):len(hs)],
+			User:      hs[:len(hs):len(hs)],
+		}
+	}
+}
+
+// queryDriver returns the driver for executing read queries. It is the read
+// driver of the config, if it was set and the config is not transactional.
+func (c config) queryDriver() dialect.Driver {
+	if c.readDriver == nil {
+		return c.driver
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.driver
+	}
+	return c.readDriver
+}
+
+// timeNow holds the code pointer of time.Now, for detecting defaults that can be replaced by the clock.
+var timeNow = reflect.ValueOf(time.Now).Pointer()
+
+// now returns the value of the given time default function. Functions that
+// are time.Now are replaced by the clock of the config, if it was set.
+func (c config) now(fn func() time.Time) time.Time {
+	if c.clock != nil && reflect.ValueOf(fn).Pointer() == timeNow {
+		return c.clock()
+	}
+	return fn()
+}
+
+// QueryLimit configures the client with a policy for All calls on queries executed without a Limit.
+//
+//	client := ent.NewClient(ent.Driver(drv), ent.QueryLimit(ent.QueryLimitPolicy{Max: 1000, Reject: true}))
+//
+func QueryLimit(p QueryLimitPolicy) Option {
+	return func(c *config) {
+		c.queryLimit = &p
+	}
+}
+
+// AsyncQueue configures the queue that is used for storing the mutations of SaveAsync calls.
+// The default is a queue with the default options of the sqlqueue package.
+func AsyncQueue(q *sqlqueue.Queue) Option {
+	return func(c *config) {
+		c.async = q
+	}
+}
+
+// IdempotencyStore configures the store of the keys that are set using the IdempotencyKey method of the
+// create builders. The default is a store with the default options of the sqlidem package (24 hours TTL).
+func IdempotencyStore(s *sqlidem.Store) Option {
+	return func(c *config) {
+		c.idempotency = s
+	}
+}
+
+// Singleflight configures the client to coalesce identical concurrent Get and Only calls (same SQL
+// and arguments) into a single database query, and share its result between the callers. This is
+// useful for protecting the database from a stampede of reads on cache misses.
+//
+// Note that the callers receive a shallow copy of the same entity, and that the query is executed
+// with the values of the context of the first caller, but without its cancellation and deadline.
+// Queries that eager-load edges, or that are executed inside a transaction are not coalesced.
+func Singleflight() Option {
+	return func(c *config) {
+		c.singleflight = &singleflight.Group{}
+	}
+}
+
+// SingleflightScope is like Singleflight, but coalesces the calls only between callers with the same
+// scope, as returned by the given function for their context. It should be used if the driver depends
+// on values of the context (e.g. a session or a tenant), that are not reflected in the query itself.
+//
+//	client := ent.NewClient(ent.Driver(drv), ent.SingleflightScope(func(ctx context.Context) string {
+//		return tenantFromContext(ctx)
+//	}))
+//
+func SingleflightScope(scope func(context.Context) string) Option {
+	return func(c *config) {
+		c.singleflight = &singleflight.Group{}
+		c.singleflightScope = scope
+	}
+}
+
+// singleflightKey returns the key of a shared query. The arguments are encoded with their types and
+// lengths, as their default formatting is ambiguous (e.g. the arguments "a b", "c" and "a", "b c").
+func singleflightKey(scope, label, query string, args []interface{}) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d:%s:%s:%d:%s", len(scope), scope, label, len(query), query)
+	for _, arg := range args {
+		v := fmt.Sprint(arg)
+		fmt.Fprintf(&b, ":%T:%d:%s", arg, len(v), v)
+	}
+	return b.String()
+}
+
+// detachedContext holds the values of its parent context, but not its cancellation and deadline.
+// It is used for executing shared queries, that must not be canceled by one of their callers.
+type detachedContext struct {
+	context.Context
+}
+
+// Deadline implements the context.Context interface.
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+// Done implements the context.Context interface.
+func (detachedContext) Done() <-chan struct{} { return nil }
+
+// Err implements the context.Context interface.
+func (detachedContext) Err() error { return nil }
+
 // ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
 // See, database/sql#DB.ExecContext for more information.
 func (c *config) ExecContext(ctx context.Context, query string, args ...interface{}) (stdsql.Result, error) {
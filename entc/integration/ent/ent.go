@@ -8,12 +8,18 @@ package ent
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqlidem"
 	"entgo.io/ent/entc/integration/ent/card"
 	"entgo.io/ent/entc/integration/ent/comment"
 	"entgo.io/ent/entc/integration/ent/fieldtype"
@@ -33,14 +39,20 @@ import (
 
 // ent aliases to avoid import conflicts in user's code.
 type (
-	Op         = ent.Op
-	Hook       = ent.Hook
-	Value      = ent.Value
-	Query      = ent.Query
-	Policy     = ent.Policy
-	Mutator    = ent.Mutator
-	Mutation   = ent.Mutation
-	MutateFunc = ent.MutateFunc
+	Op            = ent.Op
+	Hook          = ent.Hook
+	Value         = ent.Value
+	Query         = ent.Query
+	Policy        = ent.Policy
+	Querier       = ent.Querier
+	QuerierFunc   = ent.QuerierFunc
+	Interceptor   = ent.Interceptor
+	InterceptFunc = ent.InterceptFunc
+	Traverser     = ent.Traverser
+	TraverseFunc  = ent.TraverseFunc
+	Mutator       = ent.Mutator
+	Mutation      = ent.Mutation
+	MutateFunc    = ent.MutateFunc
 )
 
 // OrderFunc applies an ordering on the sql selector.
@@ -175,6 +187,292 @@ func Sum(field string) AggregateFunc {
 	}
 }
 
+// CountEdges counts the neighbors of each entity in the given edge and sums them up for each
+// group, using a correlated subquery. For example, counting the pets of the users of each group:
+//
+//	GroupBy(field1).
+//	Aggregate(ent.CountEdges(edge1)).
+//	Scan(ctx, &v)
+//
+func CountEdges(edge string) AggregateFunc {
+	return func(s *sql.Selector) string {
+		step, err := neighborsStep(s.TableName(), edge)
+		if err != nil {
+			s.AddError(&ValidationError{Name: edge, err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		return sql.Sum(sqlgraph.CountNeighbors(s, step))
+	}
+}
+
+// neighborsStep returns the path-step of the given edge of the given table.
+func neighborsStep(table, edge string) (*sqlgraph.Step, error) {
+	switch table {
+	case card.Table:
+		switch edge {
+		case card.EdgeOwner:
+			return sqlgraph.NewStep(
+				sqlgraph.From(card.Table, card.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, true, card.OwnerTable, card.OwnerColumn),
+			), nil
+		case card.EdgeSpec:
+			return sqlgraph.NewStep(
+				sqlgraph.From(card.Table, card.FieldID),
+				sqlgraph.To(spec.Table, spec.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, card.SpecTable, card.SpecPrimaryKey...),
+			), nil
+		}
+	case file.Table:
+		switch edge {
+		case file.EdgeOwner:
+			return sqlgraph.NewStep(
+				sqlgraph.From(file.Table, file.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, file.OwnerTable, file.OwnerColumn),
+			), nil
+		case file.EdgeType:
+			return sqlgraph.NewStep(
+				sqlgraph.From(file.Table, file.FieldID),
+				sqlgraph.To(filetype.Table, filetype.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, file.TypeTable, file.TypeColumn),
+			), nil
+		case file.EdgeField:
+			return sqlgraph.NewStep(
+				sqlgraph.From(file.Table, file.FieldID),
+				sqlgraph.To(fieldtype.Table, fieldtype.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, file.FieldTable, file.FieldColumn),
+			), nil
+		}
+	case filetype.Table:
+		switch edge {
+		case filetype.EdgeFiles:
+			return sqlgraph.NewStep(
+				sqlgraph.From(filetype.Table, filetype.FieldID),
+				sqlgraph.To(file.Table, file.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, filetype.FilesTable, filetype.FilesColumn),
+			), nil
+		}
+	case group.Table:
+		switch edge {
+		case group.EdgeFiles:
+			return sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(file.Table, file.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, group.FilesTable, group.FilesColumn),
+			), nil
+		case group.EdgeBlocked:
+			return sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, group.BlockedTable, group.BlockedColumn),
+			), nil
+		case group.EdgeUsers:
+			return sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, group.UsersTable, group.UsersPrimaryKey...),
+			), nil
+		case group.EdgeInfo:
+			return sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(groupinfo.Table, groupinfo.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, group.InfoTable, group.InfoColumn),
+			), nil
+		}
+	case groupinfo.Table:
+		switch edge {
+		case groupinfo.EdgeGroups:
+			return sqlgraph.NewStep(
+				sqlgraph.From(groupinfo.Table, groupinfo.FieldID),
+				sqlgraph.To(group.Table, group.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, groupinfo.GroupsTable, groupinfo.GroupsColumn),
+			), nil
+		}
+	case node.Table:
+		switch edge {
+		case node.EdgePrev:
+			return sqlgraph.NewStep(
+				sqlgraph.From(node.Table, node.FieldID),
+				sqlgraph.To(node.Table, node.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, true, node.PrevTable, node.PrevColumn),
+			), nil
+		case node.EdgeNext:
+			return sqlgraph.NewStep(
+				sqlgraph.From(node.Table, node.FieldID),
+				sqlgraph.To(node.Table, node.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, node.NextTable, node.NextColumn),
+			), nil
+		}
+	case pet.Table:
+		switch edge {
+		case pet.EdgeTeam:
+			return sqlgraph.NewStep(
+				sqlgraph.From(pet.Table, pet.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, true, pet.TeamTable, pet.TeamColumn),
+			), nil
+		case pet.EdgeOwner:
+			return sqlgraph.NewStep(
+				sqlgraph.From(pet.Table, pet.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, pet.OwnerTable, pet.OwnerColumn),
+			), nil
+		}
+	case spec.Table:
+		switch edge {
+		case spec.EdgeCard:
+			return sqlgraph.NewStep(
+				sqlgraph.From(spec.Table, spec.FieldID),
+				sqlgraph.To(card.Table, card.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, spec.CardTable, spec.CardPrimaryKey...),
+			), nil
+		}
+	case user.Table:
+		switch edge {
+		case user.EdgeCard:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(card.Table, card.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, user.CardTable, user.CardColumn),
+			), nil
+		case user.EdgePets:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(pet.Table, pet.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, user.PetsTable, user.PetsColumn),
+			), nil
+		case user.EdgeFiles:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(file.Table, file.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, user.FilesTable, user.FilesColumn),
+			), nil
+		case user.EdgeGroups:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(group.Table, group.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.GroupsTable, user.GroupsPrimaryKey...),
+			), nil
+		case user.EdgeFriends:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
+			), nil
+		case user.EdgeFollowers:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, user.FollowersTable, user.FollowersPrimaryKey...),
+			), nil
+		case user.EdgeFollowing:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.FollowingTable, user.FollowingPrimaryKey...),
+			), nil
+		case user.EdgeTeam:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(pet.Table, pet.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, user.TeamTable, user.TeamColumn),
+			), nil
+		case user.EdgeSpouse:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, user.SpouseTable, user.SpouseColumn),
+			), nil
+		case user.EdgeChildren:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.ChildrenTable, user.ChildrenColumn),
+			), nil
+		case user.EdgeParent:
+			return sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, user.ParentTable, user.ParentColumn),
+			), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown edge %q for table %q", edge, table)
+}
+
+// WindowOption configures the window of a window function.
+type WindowOption func(*sql.Selector, *sql.WindowBuilder)
+
+// PartitionBy divides the rows of the window function into partitions by the given fields.
+func PartitionBy(fields ...string) WindowOption {
+	return func(s *sql.Selector, w *sql.WindowBuilder) {
+		check := columnChecker(s.TableName())
+		columns := make([]string, 0, len(fields))
+		for _, f := range fields {
+			if err := check(f); err != nil {
+				s.AddError(&ValidationError{Name: f, err: fmt.Errorf("ent: %w", err)})
+			}
+			columns = append(columns, s.C(f))
+		}
+		w.PartitionBy(columns...)
+	}
+}
+
+// OrderBy sorts the rows of each partition of the window function by the given fields.
+// Fields that are prefixed with "-" are sorted in descending order (e.g. "-created_at").
+func OrderBy(fields ...string) WindowOption {
+	return func(s *sql.Selector, w *sql.WindowBuilder) {
+		check := columnChecker(s.TableName())
+		for _, f := range fields {
+			order := sql.Asc
+			if strings.HasPrefix(f, "-") {
+				f, order = f[1:], sql.Desc
+			}
+			if err := check(f); err != nil {
+				s.AddError(&ValidationError{Name: f, err: fmt.Errorf("ent: %w", err)})
+			}
+			w.OrderBy(order(s.C(f)))
+		}
+	}
+}
+
+// RowNumber applies the ROW_NUMBER() window function on the rows of the query. For example:
+//
+//	GroupBy(field1, field2).
+//	Aggregate(ent.RowNumber(ent.PartitionBy(field1), ent.OrderBy(field2))).
+//	Scan(ctx, &v)
+//
+func RowNumber(opts ...WindowOption) AggregateFunc {
+	return func(s *sql.Selector) string {
+		w := sql.RowNumber()
+		w.SetDialect(s.Dialect())
+		for _, opt := range opts {
+			opt(s, w)
+		}
+		query, _ := w.Query()
+		return query
+	}
+}
+
+// Rank applies the RANK() window function on the rows of the query. For example:
+//
+//	GroupBy(field1, field2).
+//	Aggregate(ent.Rank(ent.PartitionBy(field1), ent.OrderBy(field2))).
+//	Scan(ctx, &v)
+//
+func Rank(opts ...WindowOption) AggregateFunc {
+	return func(s *sql.Selector) string {
+		w := sql.Rank()
+		w.SetDialect(s.Dialect())
+		for _, opt := range opts {
+			opt(s, w)
+		}
+		query, _ := w.Query()
+		return query
+	}
+}
+
 // ValidationError returns when validating a field or edge fails.
 type ValidationError struct {
 	Name string // Field or edge name.
@@ -203,11 +501,52 @@ func IsValidationError(err error) bool {
 // NotFoundError returns when trying to fetch a specific entity and it was not found in the database.
 type NotFoundError struct {
 	label string
+	// id holds the searched ID, if the entity was fetched by its ID.
+	id interface{}
+	// predicate holds a summary of the query predicates (without their arguments), if available.
+	predicate string
 }
 
+// ErrNotFound matches all *NotFoundError errors when used with errors.Is. For example:
+//
+//	if errors.Is(err, ent.ErrNotFound) {
+//		w.WriteHeader(http.StatusNotFound)
+//	}
+//
+var ErrNotFound = &NotFoundError{}
+
 // Error implements the error interface.
 func (e *NotFoundError) Error() string {
-	return "ent: " + e.label + " not found"
+	switch {
+	case e.id != nil:
+		return fmt.Sprintf("ent: %s not found (id=%v)", e.label, e.id)
+	case e.predicate != "":
+		return fmt.Sprintf("ent: %s not found (where %s)", e.label, e.predicate)
+	default:
+		return "ent: " + e.label + " not found"
+	}
+}
+
+// Is reports whether the target is ErrNotFound, or a *NotFoundError of the same entity.
+func (e *NotFoundError) Is(target error) bool {
+	t, ok := target.(*NotFoundError)
+	return ok && (t.label == "" || t.label == e.label)
+}
+
+// Label returns the label of the entity that was not found.
+func (e *NotFoundError) Label() string {
+	return e.label
+}
+
+// ID returns the searched ID, or nil if the entity was not fetched by its ID.
+func (e *NotFoundError) ID() interface{} {
+	return e.id
+}
+
+// Predicate returns a summary of the query predicates, or an empty string if it is not available.
+// Note that the arguments of the predicates are omitted, and only their placeholders are returned.
+func (e *NotFoundError) Predicate() string {
+	return e.predicate
 }
 
 // IsNotFound returns a boolean indicating whether the error is a not found error.
@@ -292,6 +631,128 @@ func IsConstraintError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// ConflictError returns when trying to update an entity that was changed since it was
+// loaded, i.e. its version in the database is different than the expected version, or
+// when the entity does not match the conditions of the update (see UpdateOne.Where).
+type ConflictError struct {
+	label string
+	// id holds the ID of the entity, if it has a single ID field.
+	id interface{}
+	// version holds the expected version of the entity, or
+	// nil if it does not match the conditions of the update.
+	version interface{}
+}
+
+// Error implements the error interface.
+func (e *ConflictError) Error() string {
+	if e.version == nil {
+		if e.id != nil {
+			return fmt.Sprintf("ent: %s does not match the conditions of the update (id=%v)", e.label, e.id)
+		}
+		return fmt.Sprintf("ent: %s does not match the conditions of the update", e.label)
+	}
+	if e.id != nil {
+		return fmt.Sprintf("ent: %s was changed since version %v (id=%v)", e.label, e.version, e.id)
+	}
+	return fmt.Sprintf("ent: %s was changed since version %v", e.label, e.version)
+}
+
+// Label returns the label of the entity that was changed.
+func (e *ConflictError) Label() string {
+	return e.label
+}
+
+// ID returns the ID of the entity, or nil if it does not have a single ID field.
+func (e *ConflictError) ID() interface{} {
+	return e.id
+}
+
+// Version returns the version that was expected for the entity, or
+// nil if the entity does not match the conditions of the update.
+func (e *ConflictError) Version() interface{} {
+	return e.version
+}
+
+// IsConflict returns a boolean indicating whether the error is a conflict error.
+func IsConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *ConflictError
+	return errors.As(err, &e)
+}
+
+type apiCtxKey struct{}
+
+// APIContext returns a new context that marks the mutations executed with it as mutations
+// of the public API. These mutations fail if they set or clear fields that were annotated
+// as read-only in the public API (entfield.ReadOnlyAPI), while hooks and internal code can
+// still set these fields using a context that was not marked.
+func APIContext(parent context.Context) context.Context {
+	return context.WithValue(parent, apiCtxKey{}, true)
+}
+
+// IsAPIContext reports if the given context was created using APIContext.
+func IsAPIContext(ctx context.Context) bool {
+	api, _ := ctx.Value(apiCtxKey{}).(bool)
+	return api
+}
+
+// readOnlyField describes a field that is read-only in the public API.
+type readOnlyField struct {
+	name string
+	// defaults indicates if the field has a default value on creation, and value
+	// holds it, unless it is generated by a function (e.g. DefaultFunc). Fields with
+	// default values are set before the hooks are executed, and therefore, they are
+	// allowed on creation if they hold their default value. Fields with a default
+	// function are checked by the create builders before their defaults are set
+	// (see checkReadOnlyDefaults).
+	defaults bool
+	value    Value
+	// updateDefault indicates if the field has a default value on update.
+	updateDefault bool
+}
+
+// readOnlyAPI returns a hook that rejects the mutations of the public
+// API that set, add to or clear one of the given read-only fields.
+func readOnlyAPI(fields ...readOnlyField) Hook {
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			if !IsAPIContext(ctx) {
+				return next.Mutate(ctx, m)
+			}
+			create := m.Op().Is(OpCreate)
+			for _, f := range fields {
+				v, set := m.Field(f.name)
+				switch {
+				case create && f.defaults && (f.value == nil || reflect.DeepEqual(v, f.value)):
+				case !create && f.updateDefault:
+				default:
+					if _, added := m.AddedField(f.name); set || added || m.FieldCleared(f.name) {
+						return nil, &ValidationError{Name: f.name, err: fmt.Errorf("ent: field %q of %s is read-only in the API", f.name, m.Type())}
+					}
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// checkReadOnlyDefaults rejects the creations of the public API that set one of the given read-only
+// fields explicitly. It is called by the create builders before the defaults are set, because values
+// that were generated by default functions cannot be told apart from explicit values in the hooks.
+func checkReadOnlyDefaults(ctx context.Context, m Mutation, fields ...string) error {
+	if !IsAPIContext(ctx) {
+		return nil
+	}
+	for _, f := range fields {
+		if _, set := m.Field(f); set {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: field %q of %s is read-only in the API", f, m.Type())}
+		}
+	}
+	return nil
+}
+
 // selector embedded by the different Select/GroupBy builders.
 type selector struct {
 	label string
@@ -337,7 +798,7 @@ func (s *selector) String(ctx context.Context) (_ string, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Strings returned %d results when one was expected", len(v))
 	}
@@ -384,7 +845,7 @@ func (s *selector) Int(ctx context.Context) (_ int, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Ints returned %d results when one was expected", len(v))
 	}
@@ -431,7 +892,7 @@ func (s *selector) Float64(ctx context.Context) (_ float64, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Float64s returned %d results when one was expected", len(v))
 	}
@@ -478,7 +939,7 @@ func (s *selector) Bool(ctx context.Context) (_ bool, err error) {
 	case 1:
 		return v[0], nil
 	case 0:
-		err = &NotFoundError{s.label}
+		err = &NotFoundError{label: s.label}
 	default:
 		err = fmt.Errorf("ent: Bools returned %d results when one was expected", len(v))
 	}
@@ -494,5 +955,574 @@ func (s *selector) BoolX(ctx context.Context) bool {
 	return v
 }
 
+// withInterceptors executes the given querier with the given interceptors, where the first interceptor
+// is the outermost one. The QueryContext of the query is available in the context of the interceptors.
+func withInterceptors(ctx context.Context, q Query, qc *ent.QueryContext, qr Querier, inters []Interceptor) (Value, error) {
+	for i := len(inters) - 1; i >= 0; i-- {
+		if inters[i] == nil {
+			return nil, fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		qr = inters[i].Intercept(qr)
+	}
+	return qr.Query(ent.NewQueryContext(ctx, qc), q)
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
+
+// MergeOption configures the Merge methods of the clients.
+type MergeOption func(*mergeOptions)
+
+// mergeOptions holds the configuration of a merge.
+type mergeOptions struct {
+	transfer  []TransferOption
+	fields    []string
+	resolvers map[string]func([]Value) Value
+}
+
+// MergeTransfer configures the rewiring of the edges of the duplicates. For example:
+//
+//	client.User.Merge(ctx, survivor, dups, ent.MergeTransfer(ent.TransferSkipEdges(user.EdgeCard)))
+//
+func MergeTransfer(opts ...TransferOption) MergeOption {
+	return func(o *mergeOptions) {
+		o.transfer = append(o.transfer, opts...)
+	}
+}
+
+// MergeFields limits the fields of the survivor that are resolved by the merge.
+// By default, all mutable fields are resolved.
+func MergeFields(fields ...string) MergeOption {
+	return func(o *mergeOptions) {
+		o.fields = append(o.fields, fields...)
+	}
+}
+
+// MergeResolver sets the function that resolves the value of the given field. The function receives
+// the values of the survivor and the duplicates (in their order), where empty values (nil or zero) are
+// nil, and returns the value of the survivor. Returning nil keeps the value of the survivor.
+//
+// The default resolver keeps the value of the survivor, or uses the first value of the duplicates if it
+// is empty. i.e. it fills the empty fields of the survivor.
+func MergeResolver(field string, fn func(values []Value) Value) MergeOption {
+	return func(o *mergeOptions) {
+		if o.resolvers == nil {
+			o.resolvers = make(map[string]func([]Value) Value)
+		}
+		o.resolvers[field] = fn
+	}
+}
+
+// resolve returns the resolved value of the field, and reports if it should be set on the survivor.
+func (o *mergeOptions) resolve(field string, values []Value) (Value, bool) {
+	if fn, ok := o.resolvers[field]; ok {
+		v := mergeValue(fn(values))
+		return v, v != nil
+	}
+	if values[0] != nil {
+		return nil, false
+	}
+	for _, v := range values[1:] {
+		if v != nil {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// mergeValue returns the given value with its pointer dereferenced, or nil if it is empty (nil or zero).
+func mergeValue(v Value) Value {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || rv.IsZero() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+// FieldChange describes a field that holds different values in two copies of the
+// same entity. Values of nillable fields are dereferenced, and a nil value means
+// that the field is NULL.
+type FieldChange struct {
+	// Field is the name of the changed field.
+	Field string
+	// Old and New hold the value of the field in the compared entities.
+	Old, New Value
+}
+
+// FieldConflict describes a field that was changed to different values on both
+// sides of a three-way merge. See MergeChanges for more info.
+type FieldConflict struct {
+	// Field is the name of the conflicting field.
+	Field string
+	// Base holds the common value of the field, and Local and
+	// Remote hold the values it was changed to on each side.
+	Base, Local, Remote Value
+}
+
+// MergeChanges merges two sets of changes that were computed against the same base entity.
+// For example, base.Diff(local) and base.Diff(remote). Fields that were changed only on one
+// side, or were changed to the same value on both sides, are returned in the merged changes.
+// Fields that were changed to different values are returned as conflicts, and it is up to
+// the caller to resolve them.
+func MergeChanges(local, remote []FieldChange) (merged []FieldChange, conflicts []FieldConflict) {
+	unmatched := make(map[string]FieldChange, len(remote))
+	for _, c := range remote {
+		unmatched[c.Field] = c
+	}
+	for _, l := range local {
+		r, ok := unmatched[l.Field]
+		switch {
+		case !ok, reflect.DeepEqual(l.New, r.New):
+			merged = append(merged, l)
+		default:
+			conflicts = append(conflicts, FieldConflict{Field: l.Field, Base: l.Old, Local: l.New, Remote: r.New})
+		}
+		delete(unmatched, l.Field)
+	}
+	for _, r := range remote {
+		if _, ok := unmatched[r.Field]; ok {
+			merged = append(merged, r)
+		}
+	}
+	return merged, conflicts
+}
+
+// FieldInfos returns the information of the fields of all types in the schema, keyed by their type names.
+// It allows generic code (e.g. data-governance tools) to iterate the fields of all types, and their lineage
+// information (e.g. owner team or data classification), at runtime.
+func FieldInfos() map[string][]ent.FieldInfo {
+	return map[string][]ent.FieldInfo{
+		"Card":      card.Fields(),
+		"Comment":   comment.Fields(),
+		"FieldType": fieldtype.Fields(),
+		"File":      file.Fields(),
+		"FileType":  filetype.Fields(),
+		"Goods":     goods.Fields(),
+		"Group":     group.Fields(),
+		"GroupInfo": groupinfo.Fields(),
+		"Item":      item.Fields(),
+		"License":   license.Fields(),
+		"Node":      node.Fields(),
+		"Pet":       pet.Fields(),
+		"Spec":      spec.Fields(),
+		"Task":      enttask.Fields(),
+		"User":      user.Fields(),
+	}
+}
+
+// defaultIdempotencyStore is the store used by clients that were not configured with the IdempotencyStore option.
+var defaultIdempotencyStore = sqlidem.New()
+
+// idempotencyStore returns the idempotency store of the config.
+func (c config) idempotencyStore() *sqlidem.Store {
+	if c.idempotency != nil {
+		return c.idempotency
+	}
+	return defaultIdempotencyStore
+}
+
+// saveIdempotent executes the given mutator with an idempotency key. The key is claimed and completed in
+// the same transaction the mutation is executed in (the transaction of the builder, or a new one), and
+// replays of the key return the entity that was created by the original call.
+func saveIdempotent(ctx context.Context, cfg *config, key string, m Mutation, next Mutator, id func(Value) (string, error), get func(context.Context, config, string) (Value, error)) (v Value, err error) {
+	if _, ok := cfg.driver.(*txDriver); !ok {
+		drv := cfg.driver
+		tx, terr := newTx(ctx, drv)
+		if terr != nil {
+			return nil, terr
+		}
+		// Execute the builder using the transactional driver.
+		cfg.driver = tx
+		defer func() {
+			cfg.driver = drv
+			if err != nil {
+				if rerr := tx.tx.Rollback(); rerr != nil {
+					err = fmt.Errorf("%w: %v", err, rerr)
+				}
+				return
+			}
+			err = tx.tx.Commit()
+		}()
+	}
+	store := cfg.idempotencyStore()
+	res, claimed, err := store.Claim(ctx, cfg.driver, m.Type(), key)
+	switch {
+	case err != nil:
+		return nil, err
+	case !claimed:
+		return get(ctx, *cfg, res)
+	}
+	if v, err = next.Mutate(ctx, m); err != nil {
+		// Release the key in case the mutation was executed in the
+		// transaction of the caller, and it is not rolled back.
+		_ = store.Release(ctx, cfg.driver, m.Type(), key)
+		return nil, err
+	}
+	if res, err = id(v); err != nil {
+		return nil, err
+	}
+	if err := store.Complete(ctx, cfg.driver, m.Type(), key, res); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// InsertSelectQuery is implemented by the query and select builders of the entities, and holds the
+// query whose result is inserted into a table by the CreateFromQuery builders. For example:
+//
+//	client.Backup.CreateFromQuery(client.User.Query().Select(user.FieldName, user.FieldAge))
+//
+type InsertSelectQuery interface {
+	prepareQuery(context.Context) error
+	sqlQuery(context.Context) *sql.Selector
+}
+
+// IterateOption configures the Iterate methods of the query builders.
+type IterateOption func(*iterateOptions)
+
+// iterateOptions holds the configuration of an iterator.
+type iterateOptions struct {
+	batch int
+}
+
+// IterateBatch sets the number of entities that are decoded before their edges are eager-loaded,
+// and therefore, the maximum number of entities that are held in memory by an iterator. Defaults to 1000.
+func IterateBatch(n int) IterateOption {
+	return func(o *iterateOptions) {
+		o.batch = n
+	}
+}
+
+// newIterateOptions returns the configuration of an iterator from the given options.
+func newIterateOptions(opts []IterateOption) (*iterateOptions, error) {
+	o := &iterateOptions{batch: 1000}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.batch <= 0 {
+		return nil, fmt.Errorf("ent: invalid iterate batch size %d", o.batch)
+	}
+	return o, nil
+}
+
+// LoadStrategy defines how the edges of the queries are eager-loaded. It is set on the query of
+// the edge, using the options of its With method. For example:
+//
+//	client.Pet.Query().
+//		WithOwner(func(q *UserQuery) {
+//			q.LoadStrategy(LoadStrategyJoin)
+//		}).
+//		All(ctx)
+//
+type LoadStrategy uint8
+
+const (
+	// LoadStrategyQuery loads the edge using an additional query, after the entities
+	// that reference it were queried. This is the default strategy.
+	LoadStrategyQuery LoadStrategy = iota
+	// LoadStrategyJoin loads unique edges whose foreign-key resides in the table of the entities that
+	// reference them (e.g. M2O edges) using a LEFT JOIN, in the same query of the entities. The strategy
+	// falls back to LoadStrategyQuery for other edges, and for queries that eager-load their own edges,
+	// use limit, offset or modifiers, or have interceptors that are not traversers.
+	LoadStrategyJoin
+)
+
+// Direction is the direction of a dynamic ordering (e.g. OrderByField or PaginateOrder).
+type Direction string
+
+const (
+	// DirectionAsc orders the rows in ascending order.
+	DirectionAsc Direction = "ASC"
+	// DirectionDesc orders the rows in descending order.
+	DirectionDesc Direction = "DESC"
+)
+
+// ParseDirection parses the given string (e.g. an API parameter) as
+// an order direction. The value "asc" or "desc" is case-insensitive.
+func ParseDirection(s string) (Direction, error) {
+	switch d := Direction(strings.ToUpper(s)); d {
+	case DirectionAsc, DirectionDesc:
+		return d, nil
+	default:
+		return "", fmt.Errorf("ent: invalid order direction %q", s)
+	}
+}
+
+// Cursor is an opaque cursor that points to an entity in the pages returned by the Paginate methods
+// of the queries. It holds the values of the entity in the order fields of the pagination, and is
+// URL-safe. Therefore, it can be passed as is in the parameters of REST or gRPC APIs.
+type Cursor string
+
+// PageInfo holds the information of a page returned by the Paginate methods of the queries.
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *Cursor `json:"startCursor,omitempty"`
+	EndCursor       *Cursor `json:"endCursor,omitempty"`
+}
+
+// PaginateOption configures the Paginate methods of the queries.
+type PaginateOption func(*paginateOptions)
+
+// paginateOptions holds the configuration of a pagination.
+type paginateOptions struct {
+	orders []paginateOrder
+}
+
+// paginateOrder is a key of the pagination. The name holds the field name
+// (given by the option), and the column is resolved by the queries.
+type paginateOrder struct {
+	name, column string
+	desc         bool
+}
+
+// PaginateOrder orders the pages by the field with the given name (e.g. an API parameter). The
+// field must be one of the order fields of the schema that are not optional, and the pages are
+// ordered by the ID field last, in order to make the order of the entities stable. For example:
+//
+//	client.User.Query().Paginate(ctx, after, &first, nil, nil, ent.PaginateOrder(user.FieldName, ent.DirectionAsc))
+//
+func PaginateOrder(field string, dir Direction) PaginateOption {
+	return func(o *paginateOptions) {
+		o.orders = append(o.orders, paginateOrder{name: field, desc: dir == DirectionDesc})
+	}
+}
+
+// newPaginateOptions validates the pagination arguments, and returns the options of the pagination.
+func newPaginateOptions(first, last *int, opts []PaginateOption) (*paginateOptions, error) {
+	switch {
+	case first != nil && last != nil:
+		return nil, &ValidationError{Name: "last", err: errors.New("ent: first and last cannot be used together for pagination")}
+	case first != nil && *first < 0:
+		return nil, &ValidationError{Name: "first", err: fmt.Errorf("ent: first must be a non-negative integer, got %d", *first)}
+	case last != nil && *last < 0:
+		return nil, &ValidationError{Name: "last", err: fmt.Errorf("ent: last must be a non-negative integer, got %d", *last)}
+	}
+	o := &paginateOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o, nil
+}
+
+// cursorData is the encoded form of a Cursor.
+type cursorData struct {
+	Fields []string          `json:"f"`
+	Values []json.RawMessage `json:"v"`
+}
+
+// encodeCursor encodes the given values of the order keys as a cursor.
+func encodeCursor(orders []paginateOrder, values []interface{}) (Cursor, error) {
+	data := cursorData{Fields: make([]string, len(orders)), Values: make([]json.RawMessage, len(values))}
+	for i, o := range orders {
+		data.Fields[i] = o.column
+		v, err := json.Marshal(values[i])
+		if err != nil {
+			return "", err
+		}
+		data.Values[i] = v
+	}
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return Cursor(base64.RawURLEncoding.EncodeToString(buf)), nil
+}
+
+// decodeCursor decodes the given cursor, and returns the raw values of the order keys.
+// It fails if the cursor is malformed, or if it was created for pages in a different order.
+func decodeCursor(c Cursor, orders []paginateOrder) ([]json.RawMessage, error) {
+	var data cursorData
+	buf, err := base64.RawURLEncoding.DecodeString(string(c))
+	if err == nil {
+		err = json.Unmarshal(buf, &data)
+	}
+	if err != nil {
+		return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor: %w", err)}
+	}
+	if len(data.Fields) != len(orders) || len(data.Values) != len(orders) {
+		return nil, &ValidationError{Name: "cursor", err: errors.New("ent: cursor does not match the order of the pagination")}
+	}
+	for i, o := range orders {
+		if data.Fields[i] != o.column {
+			return nil, &ValidationError{Name: "cursor", err: errors.New("ent: cursor does not match the order of the pagination")}
+		}
+	}
+	return data.Values, nil
+}
+
+// cursorPredicate returns the keyset predicate that matches the rows after the
+// given values of the order keys, or before them if the before argument is true.
+func cursorPredicate(orders []paginateOrder, values []interface{}, before bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		ors := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			ands := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				ands = append(ands, sql.EQ(s.C(orders[j].column), values[j]))
+			}
+			if o.desc != before {
+				ands = append(ands, sql.LT(s.C(o.column), values[i]))
+			} else {
+				ands = append(ands, sql.GT(s.C(o.column), values[i]))
+			}
+			ors[i] = sql.And(ands...)
+		}
+		s.Where(sql.Or(ors...))
+	}
+}
+
+// QueryLimitPolicy defines how All calls on queries without an explicit Limit are handled by the client,
+// in order to prevent accidental loads of entire tables. Note that the policy applies only to the root
+// query, and not to the edges it eager-loads, or to queries executed with a SkipQueryLimit context.
+type QueryLimitPolicy struct {
+	// Max is the maximum number of rows returned by All calls on queries without a Limit.
+	Max int
+	// Reject makes All calls that exceed Max to fail with a *QueryLimitError,
+	// instead of truncating their results to Max rows.
+	Reject bool
+}
+
+// QueryLimitError is returned by All calls that exceed the Max of a rejecting QueryLimitPolicy.
+type QueryLimitError struct {
+	label string
+	max   int
+}
+
+// Error implements the error interface.
+func (e *QueryLimitError) Error() string {
+	return fmt.Sprintf("ent: %s query without limit exceeded the maximum of %d rows", e.label, e.max)
+}
+
+// IsQueryLimitError returns a boolean indicating whether the error is a query limit error.
+func IsQueryLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *QueryLimitError
+	return errors.As(err, &e)
+}
+
+type queryLimitCtxKey struct{}
+
+// SkipQueryLimit returns a new context that skips the QueryLimitPolicy of the client
+// for the queries executed with it.
+func SkipQueryLimit(parent context.Context) context.Context {
+	return context.WithValue(parent, queryLimitCtxKey{}, true)
+}
+
+// skipQueryLimit reports if the QueryLimitPolicy should be skipped for the given context.
+func skipQueryLimit(ctx context.Context) bool {
+	skip, _ := ctx.Value(queryLimitCtxKey{}).(bool)
+	return skip
+}
+
+// NotSelectedError returns when trying to get a field that was not selected by the query.
+type NotSelectedError struct {
+	label string
+	field string
+}
+
+// Error implements the error interface.
+func (e *NotSelectedError) Error() string {
+	return "ent: " + e.label + "." + e.field + " field was not selected"
+}
+
+// IsNotSelected returns a boolean indicating whether the error is a not selected error.
+func IsNotSelected(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *NotSelectedError
+	return errors.As(err, &e)
+}
+
+// TimeBucket is a row returned by the BucketBy builders.
+type TimeBucket struct {
+	// Time is the start time of the bucket.
+	Time time.Time
+	// Values holds the results of the aggregation functions,
+	// in the order they were passed to Aggregate.
+	Values []float64
+}
+
+// scanTimeBuckets scans the rows returned by the BucketBy builders,
+// where the first column holds the bucket start time (Unix timestamp).
+func scanTimeBuckets(rows *sql.Rows, n int) ([]*TimeBucket, error) {
+	var buckets []*TimeBucket
+	for rows.Next() {
+		var (
+			start  sql.NullFloat64
+			values = make([]sql.NullFloat64, n)
+			dest   = make([]interface{}, 0, n+1)
+		)
+		dest = append(dest, &start)
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		b := &TimeBucket{Time: time.Unix(int64(start.Float64), 0), Values: make([]float64, n)}
+		for i := range values {
+			b.Values[i] = values[i].Float64
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, rows.Err()
+}
+
+// TransferOption configures the TransferOwnership methods of the clients.
+type TransferOption func(*transferOptions)
+
+// transferOptions holds the edges that were included or excluded by the options.
+type transferOptions struct {
+	include, exclude []string
+}
+
+// TransferEdges limits the transfer to the given edges. For example:
+//
+//	client.User.TransferOwnership(ctx, from, to, ent.TransferEdges(user.EdgePets))
+//
+func TransferEdges(edges ...string) TransferOption {
+	return func(o *transferOptions) {
+		o.include = append(o.include, edges...)
+	}
+}
+
+// TransferSkipEdges excludes the given edges from the transfer.
+func TransferSkipEdges(edges ...string) TransferOption {
+	return func(o *transferOptions) {
+		o.exclude = append(o.exclude, edges...)
+	}
+}
+
+// transferEdges returns the edges to transfer from the given owning edges of the type. An
+// error is returned if the options reference edges that cannot be transferred.
+func transferEdges(typ string, opts []TransferOption, edges ...string) (map[string]bool, error) {
+	o := &transferOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	selected := make(map[string]bool, len(edges))
+	for _, e := range edges {
+		selected[e] = len(o.include) == 0
+	}
+	for _, names := range [][]string{o.include, o.exclude} {
+		for _, e := range names {
+			if _, ok := selected[e]; !ok {
+				return nil, fmt.Errorf("ent: edge %q of type %s cannot be transferred", e, typ)
+			}
+		}
+	}
+	for _, e := range o.include {
+		selected[e] = true
+	}
+	for _, e := range o.exclude {
+		selected[e] = false
+	}
+	return selected, nil
+}
@@ -237,7 +237,8 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Item",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			item.FieldText: {Type: field.TypeString, Column: item.FieldText},
+			item.FieldText:  {Type: field.TypeString, Column: item.FieldText},
+			item.FieldToken: {Type: field.TypeString, Column: item.FieldToken},
 		},
 	}
 	graph.Nodes[9] = &sqlgraph.Node{
@@ -678,6 +679,29 @@ func (cq *CardQuery) Filter() *CardFilter {
 	return &CardFilter{config: cq.config, predicateAdder: cq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// CardQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (cq *CardQuery) Apply(q *entql.Query) *CardQuery {
+	if q.Where != nil {
+		cq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			cq.Order(Desc(o.Field))
+		} else {
+			cq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		cq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		cq.Offset(*q.Offset)
+	}
+	return cq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *CardMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -771,6 +795,29 @@ func (cq *CommentQuery) Filter() *CommentFilter {
 	return &CommentFilter{config: cq.config, predicateAdder: cq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// CommentQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (cq *CommentQuery) Apply(q *entql.Query) *CommentQuery {
+	if q.Where != nil {
+		cq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			cq.Order(Desc(o.Field))
+		} else {
+			cq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		cq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		cq.Offset(*q.Offset)
+	}
+	return cq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *CommentMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -836,6 +883,29 @@ func (ftq *FieldTypeQuery) Filter() *FieldTypeFilter {
 	return &FieldTypeFilter{config: ftq.config, predicateAdder: ftq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// FieldTypeQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (ftq *FieldTypeQuery) Apply(q *entql.Query) *FieldTypeQuery {
+	if q.Where != nil {
+		ftq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			ftq.Order(Desc(o.Field))
+		} else {
+			ftq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		ftq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		ftq.Offset(*q.Offset)
+	}
+	return ftq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *FieldTypeMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1201,6 +1271,29 @@ func (fq *FileQuery) Filter() *FileFilter {
 	return &FileFilter{config: fq.config, predicateAdder: fq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// FileQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (fq *FileQuery) Apply(q *entql.Query) *FileQuery {
+	if q.Where != nil {
+		fq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			fq.Order(Desc(o.Field))
+		} else {
+			fq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		fq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		fq.Offset(*q.Offset)
+	}
+	return fq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *FileMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1308,6 +1401,29 @@ func (ftq *FileTypeQuery) Filter() *FileTypeFilter {
 	return &FileTypeFilter{config: ftq.config, predicateAdder: ftq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// FileTypeQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (ftq *FileTypeQuery) Apply(q *entql.Query) *FileTypeQuery {
+	if q.Where != nil {
+		ftq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			ftq.Order(Desc(o.Field))
+		} else {
+			ftq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		ftq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		ftq.Offset(*q.Offset)
+	}
+	return ftq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *FileTypeMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1377,6 +1493,29 @@ func (gq *GoodsQuery) Filter() *GoodsFilter {
 	return &GoodsFilter{config: gq.config, predicateAdder: gq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// GoodsQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (gq *GoodsQuery) Apply(q *entql.Query) *GoodsQuery {
+	if q.Where != nil {
+		gq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			gq.Order(Desc(o.Field))
+		} else {
+			gq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		gq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		gq.Offset(*q.Offset)
+	}
+	return gq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *GoodsMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1417,6 +1556,29 @@ func (gq *GroupQuery) Filter() *GroupFilter {
 	return &GroupFilter{config: gq.config, predicateAdder: gq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// GroupQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (gq *GroupQuery) Apply(q *entql.Query) *GroupQuery {
+	if q.Where != nil {
+		gq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			gq.Order(Desc(o.Field))
+		} else {
+			gq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		gq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		gq.Offset(*q.Offset)
+	}
+	return gq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *GroupMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1538,6 +1700,29 @@ func (giq *GroupInfoQuery) Filter() *GroupInfoFilter {
 	return &GroupInfoFilter{config: giq.config, predicateAdder: giq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// GroupInfoQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (giq *GroupInfoQuery) Apply(q *entql.Query) *GroupInfoQuery {
+	if q.Where != nil {
+		giq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			giq.Order(Desc(o.Field))
+		} else {
+			giq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		giq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		giq.Offset(*q.Offset)
+	}
+	return giq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *GroupInfoMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1602,6 +1787,29 @@ func (iq *ItemQuery) Filter() *ItemFilter {
 	return &ItemFilter{config: iq.config, predicateAdder: iq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// ItemQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (iq *ItemQuery) Apply(q *entql.Query) *ItemQuery {
+	if q.Where != nil {
+		iq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			iq.Order(Desc(o.Field))
+		} else {
+			iq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		iq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		iq.Offset(*q.Offset)
+	}
+	return iq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *ItemMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1637,6 +1845,11 @@ func (f *ItemFilter) WhereText(p entql.StringP) {
 	f.Where(p.Field(item.FieldText))
 }
 
+// WhereToken applies the entql string predicate on the token field.
+func (f *ItemFilter) WhereToken(p entql.StringP) {
+	f.Where(p.Field(item.FieldToken))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (lq *LicenseQuery) addPredicate(pred func(s *sql.Selector)) {
 	lq.predicates = append(lq.predicates, pred)
@@ -1647,6 +1860,29 @@ func (lq *LicenseQuery) Filter() *LicenseFilter {
 	return &LicenseFilter{config: lq.config, predicateAdder: lq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// LicenseQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (lq *LicenseQuery) Apply(q *entql.Query) *LicenseQuery {
+	if q.Where != nil {
+		lq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			lq.Order(Desc(o.Field))
+		} else {
+			lq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		lq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		lq.Offset(*q.Offset)
+	}
+	return lq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *LicenseMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1687,6 +1923,29 @@ func (nq *NodeQuery) Filter() *NodeFilter {
 	return &NodeFilter{config: nq.config, predicateAdder: nq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// NodeQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (nq *NodeQuery) Apply(q *entql.Query) *NodeQuery {
+	if q.Where != nil {
+		nq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			nq.Order(Desc(o.Field))
+		} else {
+			nq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		nq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		nq.Offset(*q.Offset)
+	}
+	return nq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *NodeMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1760,6 +2019,29 @@ func (pq *PetQuery) Filter() *PetFilter {
 	return &PetFilter{config: pq.config, predicateAdder: pq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// PetQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (pq *PetQuery) Apply(q *entql.Query) *PetQuery {
+	if q.Where != nil {
+		pq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			pq.Order(Desc(o.Field))
+		} else {
+			pq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		pq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		pq.Offset(*q.Offset)
+	}
+	return pq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *PetMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1853,6 +2135,29 @@ func (sq *SpecQuery) Filter() *SpecFilter {
 	return &SpecFilter{config: sq.config, predicateAdder: sq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// SpecQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (sq *SpecQuery) Apply(q *entql.Query) *SpecQuery {
+	if q.Where != nil {
+		sq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			sq.Order(Desc(o.Field))
+		} else {
+			sq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		sq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		sq.Offset(*q.Offset)
+	}
+	return sq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *SpecMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1907,6 +2212,29 @@ func (tq *TaskQuery) Filter() *TaskFilter {
 	return &TaskFilter{config: tq.config, predicateAdder: tq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// TaskQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (tq *TaskQuery) Apply(q *entql.Query) *TaskQuery {
+	if q.Where != nil {
+		tq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			tq.Order(Desc(o.Field))
+		} else {
+			tq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		tq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		tq.Offset(*q.Offset)
+	}
+	return tq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *TaskMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -1957,6 +2285,29 @@ func (uq *UserQuery) Filter() *UserFilter {
 	return &UserFilter{config: uq.config, predicateAdder: uq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// UserQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (uq *UserQuery) Apply(q *entql.Query) *UserQuery {
+	if q.Where != nil {
+		uq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			uq.Order(Desc(o.Field))
+		} else {
+			uq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		uq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		uq.Offset(*q.Offset)
+	}
+	return uq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"reflect"
 	"strings"
 	"time"
 
@@ -157,6 +158,9 @@ type FieldType struct {
 	// PasswordOther holds the value of the "password_other" field.
 	PasswordOther schema.Password `json:"-"`
 	file_field    *int
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[65]bool
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -884,6 +888,2416 @@ func (ft *FieldType) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the FieldType and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (ft *FieldType) Diff(other *FieldType) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(ft.Int, other.Int) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldInt, Old: ft.Int, New: other.Int})
+	}
+	if !reflect.DeepEqual(ft.Int8, other.Int8) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldInt8, Old: ft.Int8, New: other.Int8})
+	}
+	if !reflect.DeepEqual(ft.Int16, other.Int16) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldInt16, Old: ft.Int16, New: other.Int16})
+	}
+	if !reflect.DeepEqual(ft.Int32, other.Int32) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldInt32, Old: ft.Int32, New: other.Int32})
+	}
+	if !reflect.DeepEqual(ft.Int64, other.Int64) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldInt64, Old: ft.Int64, New: other.Int64})
+	}
+	if !reflect.DeepEqual(ft.OptionalInt, other.OptionalInt) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalInt, Old: ft.OptionalInt, New: other.OptionalInt})
+	}
+	if !reflect.DeepEqual(ft.OptionalInt8, other.OptionalInt8) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalInt8, Old: ft.OptionalInt8, New: other.OptionalInt8})
+	}
+	if !reflect.DeepEqual(ft.OptionalInt16, other.OptionalInt16) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalInt16, Old: ft.OptionalInt16, New: other.OptionalInt16})
+	}
+	if !reflect.DeepEqual(ft.OptionalInt32, other.OptionalInt32) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalInt32, Old: ft.OptionalInt32, New: other.OptionalInt32})
+	}
+	if !reflect.DeepEqual(ft.OptionalInt64, other.OptionalInt64) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalInt64, Old: ft.OptionalInt64, New: other.OptionalInt64})
+	}
+	if !reflect.DeepEqual(ft.NillableInt, other.NillableInt) {
+		change := FieldChange{Field: fieldtype.FieldNillableInt}
+		if ft.NillableInt != nil {
+			change.Old = *ft.NillableInt
+		}
+		if other.NillableInt != nil {
+			change.New = *other.NillableInt
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(ft.NillableInt8, other.NillableInt8) {
+		change := FieldChange{Field: fieldtype.FieldNillableInt8}
+		if ft.NillableInt8 != nil {
+			change.Old = *ft.NillableInt8
+		}
+		if other.NillableInt8 != nil {
+			change.New = *other.NillableInt8
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(ft.NillableInt16, other.NillableInt16) {
+		change := FieldChange{Field: fieldtype.FieldNillableInt16}
+		if ft.NillableInt16 != nil {
+			change.Old = *ft.NillableInt16
+		}
+		if other.NillableInt16 != nil {
+			change.New = *other.NillableInt16
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(ft.NillableInt32, other.NillableInt32) {
+		change := FieldChange{Field: fieldtype.FieldNillableInt32}
+		if ft.NillableInt32 != nil {
+			change.Old = *ft.NillableInt32
+		}
+		if other.NillableInt32 != nil {
+			change.New = *other.NillableInt32
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(ft.NillableInt64, other.NillableInt64) {
+		change := FieldChange{Field: fieldtype.FieldNillableInt64}
+		if ft.NillableInt64 != nil {
+			change.Old = *ft.NillableInt64
+		}
+		if other.NillableInt64 != nil {
+			change.New = *other.NillableInt64
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(ft.ValidateOptionalInt32, other.ValidateOptionalInt32) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldValidateOptionalInt32, Old: ft.ValidateOptionalInt32, New: other.ValidateOptionalInt32})
+	}
+	if !reflect.DeepEqual(ft.OptionalUint, other.OptionalUint) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalUint, Old: ft.OptionalUint, New: other.OptionalUint})
+	}
+	if !reflect.DeepEqual(ft.OptionalUint8, other.OptionalUint8) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalUint8, Old: ft.OptionalUint8, New: other.OptionalUint8})
+	}
+	if !reflect.DeepEqual(ft.OptionalUint16, other.OptionalUint16) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalUint16, Old: ft.OptionalUint16, New: other.OptionalUint16})
+	}
+	if !reflect.DeepEqual(ft.OptionalUint32, other.OptionalUint32) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalUint32, Old: ft.OptionalUint32, New: other.OptionalUint32})
+	}
+	if !reflect.DeepEqual(ft.OptionalUint64, other.OptionalUint64) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalUint64, Old: ft.OptionalUint64, New: other.OptionalUint64})
+	}
+	if !reflect.DeepEqual(ft.State, other.State) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldState, Old: ft.State, New: other.State})
+	}
+	if !reflect.DeepEqual(ft.OptionalFloat, other.OptionalFloat) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalFloat, Old: ft.OptionalFloat, New: other.OptionalFloat})
+	}
+	if !reflect.DeepEqual(ft.OptionalFloat32, other.OptionalFloat32) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalFloat32, Old: ft.OptionalFloat32, New: other.OptionalFloat32})
+	}
+	if !reflect.DeepEqual(ft.Text, other.Text) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldText, Old: ft.Text, New: other.Text})
+	}
+	if !reflect.DeepEqual(ft.Datetime, other.Datetime) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldDatetime, Old: ft.Datetime, New: other.Datetime})
+	}
+	if !reflect.DeepEqual(ft.Decimal, other.Decimal) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldDecimal, Old: ft.Decimal, New: other.Decimal})
+	}
+	if !reflect.DeepEqual(ft.LinkOther, other.LinkOther) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldLinkOther, Old: ft.LinkOther, New: other.LinkOther})
+	}
+	if !reflect.DeepEqual(ft.LinkOtherFunc, other.LinkOtherFunc) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldLinkOtherFunc, Old: ft.LinkOtherFunc, New: other.LinkOtherFunc})
+	}
+	if !reflect.DeepEqual(ft.MAC, other.MAC) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldMAC, Old: ft.MAC, New: other.MAC})
+	}
+	if !reflect.DeepEqual(ft.StringArray, other.StringArray) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldStringArray, Old: ft.StringArray, New: other.StringArray})
+	}
+	if !reflect.DeepEqual(ft.Password, other.Password) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldPassword, Old: ft.Password, New: other.Password})
+	}
+	if !reflect.DeepEqual(ft.StringScanner, other.StringScanner) {
+		change := FieldChange{Field: fieldtype.FieldStringScanner}
+		if ft.StringScanner != nil {
+			change.Old = *ft.StringScanner
+		}
+		if other.StringScanner != nil {
+			change.New = *other.StringScanner
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(ft.Duration, other.Duration) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldDuration, Old: ft.Duration, New: other.Duration})
+	}
+	if !reflect.DeepEqual(ft.Dir, other.Dir) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldDir, Old: ft.Dir, New: other.Dir})
+	}
+	if !reflect.DeepEqual(ft.Ndir, other.Ndir) {
+		change := FieldChange{Field: fieldtype.FieldNdir}
+		if ft.Ndir != nil {
+			change.Old = *ft.Ndir
+		}
+		if other.Ndir != nil {
+			change.New = *other.Ndir
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(ft.Str, other.Str) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldStr, Old: ft.Str, New: other.Str})
+	}
+	if !reflect.DeepEqual(ft.NullStr, other.NullStr) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNullStr, Old: ft.NullStr, New: other.NullStr})
+	}
+	if !reflect.DeepEqual(ft.Link, other.Link) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldLink, Old: ft.Link, New: other.Link})
+	}
+	if !reflect.DeepEqual(ft.NullLink, other.NullLink) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNullLink, Old: ft.NullLink, New: other.NullLink})
+	}
+	if !reflect.DeepEqual(ft.Active, other.Active) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldActive, Old: ft.Active, New: other.Active})
+	}
+	if !reflect.DeepEqual(ft.NullActive, other.NullActive) {
+		change := FieldChange{Field: fieldtype.FieldNullActive}
+		if ft.NullActive != nil {
+			change.Old = *ft.NullActive
+		}
+		if other.NullActive != nil {
+			change.New = *other.NullActive
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(ft.Deleted, other.Deleted) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldDeleted, Old: ft.Deleted, New: other.Deleted})
+	}
+	if !reflect.DeepEqual(ft.DeletedAt, other.DeletedAt) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldDeletedAt, Old: ft.DeletedAt, New: other.DeletedAt})
+	}
+	if !reflect.DeepEqual(ft.RawData, other.RawData) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldRawData, Old: ft.RawData, New: other.RawData})
+	}
+	if !reflect.DeepEqual(ft.Sensitive, other.Sensitive) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldSensitive, Old: ft.Sensitive, New: other.Sensitive})
+	}
+	if !reflect.DeepEqual(ft.IP, other.IP) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldIP, Old: ft.IP, New: other.IP})
+	}
+	if !reflect.DeepEqual(ft.NullInt64, other.NullInt64) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNullInt64, Old: ft.NullInt64, New: other.NullInt64})
+	}
+	if !reflect.DeepEqual(ft.SchemaInt, other.SchemaInt) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldSchemaInt, Old: ft.SchemaInt, New: other.SchemaInt})
+	}
+	if !reflect.DeepEqual(ft.SchemaInt8, other.SchemaInt8) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldSchemaInt8, Old: ft.SchemaInt8, New: other.SchemaInt8})
+	}
+	if !reflect.DeepEqual(ft.SchemaInt64, other.SchemaInt64) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldSchemaInt64, Old: ft.SchemaInt64, New: other.SchemaInt64})
+	}
+	if !reflect.DeepEqual(ft.SchemaFloat, other.SchemaFloat) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldSchemaFloat, Old: ft.SchemaFloat, New: other.SchemaFloat})
+	}
+	if !reflect.DeepEqual(ft.SchemaFloat32, other.SchemaFloat32) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldSchemaFloat32, Old: ft.SchemaFloat32, New: other.SchemaFloat32})
+	}
+	if !reflect.DeepEqual(ft.NullFloat, other.NullFloat) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNullFloat, Old: ft.NullFloat, New: other.NullFloat})
+	}
+	if !reflect.DeepEqual(ft.Role, other.Role) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldRole, Old: ft.Role, New: other.Role})
+	}
+	if !reflect.DeepEqual(ft.Priority, other.Priority) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldPriority, Old: ft.Priority, New: other.Priority})
+	}
+	if !reflect.DeepEqual(ft.OptionalUUID, other.OptionalUUID) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalUUID, Old: ft.OptionalUUID, New: other.OptionalUUID})
+	}
+	if !reflect.DeepEqual(ft.NillableUUID, other.NillableUUID) {
+		change := FieldChange{Field: fieldtype.FieldNillableUUID}
+		if ft.NillableUUID != nil {
+			change.Old = *ft.NillableUUID
+		}
+		if other.NillableUUID != nil {
+			change.New = *other.NillableUUID
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(ft.Strings, other.Strings) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldStrings, Old: ft.Strings, New: other.Strings})
+	}
+	if !reflect.DeepEqual(ft.Pair, other.Pair) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldPair, Old: ft.Pair, New: other.Pair})
+	}
+	if !reflect.DeepEqual(ft.NilPair, other.NilPair) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNilPair, Old: ft.NilPair, New: other.NilPair})
+	}
+	if !reflect.DeepEqual(ft.Vstring, other.Vstring) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldVstring, Old: ft.Vstring, New: other.Vstring})
+	}
+	if !reflect.DeepEqual(ft.Triple, other.Triple) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldTriple, Old: ft.Triple, New: other.Triple})
+	}
+	if !reflect.DeepEqual(ft.BigInt, other.BigInt) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldBigInt, Old: ft.BigInt, New: other.BigInt})
+	}
+	if !reflect.DeepEqual(ft.PasswordOther, other.PasswordOther) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldPasswordOther, Old: ft.PasswordOther, New: other.PasswordOther})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the FieldType that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (ft *FieldType) ApplyMask(paths ...string) (*FieldType, error) {
+	if len(paths) == 0 {
+		masked := *ft
+		return &masked, nil
+	}
+	masked := &FieldType{config: ft.config, ID: ft.ID}
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case fieldtype.FieldID:
+		case "int":
+			masked.Int = ft.Int
+		case "int8":
+			masked.Int8 = ft.Int8
+		case "int16":
+			masked.Int16 = ft.Int16
+		case "int32":
+			masked.Int32 = ft.Int32
+		case "int64":
+			masked.Int64 = ft.Int64
+		case "optional_int":
+			masked.OptionalInt = ft.OptionalInt
+		case "optional_int8":
+			masked.OptionalInt8 = ft.OptionalInt8
+		case "optional_int16":
+			masked.OptionalInt16 = ft.OptionalInt16
+		case "optional_int32":
+			masked.OptionalInt32 = ft.OptionalInt32
+		case "optional_int64":
+			masked.OptionalInt64 = ft.OptionalInt64
+		case "nillable_int":
+			masked.NillableInt = ft.NillableInt
+		case "nillable_int8":
+			masked.NillableInt8 = ft.NillableInt8
+		case "nillable_int16":
+			masked.NillableInt16 = ft.NillableInt16
+		case "nillable_int32":
+			masked.NillableInt32 = ft.NillableInt32
+		case "nillable_int64":
+			masked.NillableInt64 = ft.NillableInt64
+		case "validate_optional_int32":
+			masked.ValidateOptionalInt32 = ft.ValidateOptionalInt32
+		case "optional_uint":
+			masked.OptionalUint = ft.OptionalUint
+		case "optional_uint8":
+			masked.OptionalUint8 = ft.OptionalUint8
+		case "optional_uint16":
+			masked.OptionalUint16 = ft.OptionalUint16
+		case "optional_uint32":
+			masked.OptionalUint32 = ft.OptionalUint32
+		case "optional_uint64":
+			masked.OptionalUint64 = ft.OptionalUint64
+		case "state":
+			masked.State = ft.State
+		case "optional_float":
+			masked.OptionalFloat = ft.OptionalFloat
+		case "optional_float32":
+			masked.OptionalFloat32 = ft.OptionalFloat32
+		case "text":
+			masked.Text = ft.Text
+		case "datetime":
+			masked.Datetime = ft.Datetime
+		case "decimal":
+			masked.Decimal = ft.Decimal
+		case "link_other":
+			masked.LinkOther = ft.LinkOther
+		case "link_other_func":
+			masked.LinkOtherFunc = ft.LinkOtherFunc
+		case "mac":
+			masked.MAC = ft.MAC
+		case "string_array":
+			masked.StringArray = ft.StringArray
+		case "password":
+			masked.Password = ft.Password
+		case "string_scanner":
+			masked.StringScanner = ft.StringScanner
+		case "duration":
+			masked.Duration = ft.Duration
+		case "dir":
+			masked.Dir = ft.Dir
+		case "ndir":
+			masked.Ndir = ft.Ndir
+		case "str":
+			masked.Str = ft.Str
+		case "null_str":
+			masked.NullStr = ft.NullStr
+		case "link":
+			masked.Link = ft.Link
+		case "null_link":
+			masked.NullLink = ft.NullLink
+		case "active":
+			masked.Active = ft.Active
+		case "null_active":
+			masked.NullActive = ft.NullActive
+		case "deleted":
+			masked.Deleted = ft.Deleted
+		case "deleted_at":
+			masked.DeletedAt = ft.DeletedAt
+		case "raw_data":
+			masked.RawData = ft.RawData
+		case "sensitive":
+			masked.Sensitive = ft.Sensitive
+		case "ip":
+			masked.IP = ft.IP
+		case "null_int64":
+			masked.NullInt64 = ft.NullInt64
+		case "schema_int":
+			masked.SchemaInt = ft.SchemaInt
+		case "schema_int8":
+			masked.SchemaInt8 = ft.SchemaInt8
+		case "schema_int64":
+			masked.SchemaInt64 = ft.SchemaInt64
+		case "schema_float":
+			masked.SchemaFloat = ft.SchemaFloat
+		case "schema_float32":
+			masked.SchemaFloat32 = ft.SchemaFloat32
+		case "null_float":
+			masked.NullFloat = ft.NullFloat
+		case "role":
+			masked.Role = ft.Role
+		case "priority":
+			masked.Priority = ft.Priority
+		case "optional_uuid":
+			masked.OptionalUUID = ft.OptionalUUID
+		case "nillable_uuid":
+			masked.NillableUUID = ft.NillableUUID
+		case "strings":
+			masked.Strings = ft.Strings
+		case "pair":
+			masked.Pair = ft.Pair
+		case "nil_pair":
+			masked.NilPair = ft.NilPair
+		case "vstring":
+			masked.Vstring = ft.Vstring
+		case "triple":
+			masked.Triple = ft.Triple
+		case "big_int":
+			masked.BigInt = ft.BigInt
+		case "password_other":
+			masked.PasswordOther = ft.PasswordOther
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type FieldType", p)
+		}
+	}
+	return masked, nil
+}
+
+// FieldTypePatch describes a partial update of a FieldType entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type FieldTypePatch struct {
+	// Int holds the new value of the "int" field.
+	Int *int `json:"int,omitempty"`
+	// Int8 holds the new value of the "int8" field.
+	Int8 *int8 `json:"int8,omitempty"`
+	// Int16 holds the new value of the "int16" field.
+	Int16 *int16 `json:"int16,omitempty"`
+	// Int32 holds the new value of the "int32" field.
+	Int32 *int32 `json:"int32,omitempty"`
+	// Int64 holds the new value of the "int64" field.
+	Int64 *int64 `json:"int64,omitempty"`
+	// OptionalInt holds the new value of the "optional_int" field.
+	OptionalInt *int `json:"optional_int,omitempty"`
+	// OptionalInt8 holds the new value of the "optional_int8" field.
+	OptionalInt8 *int8 `json:"optional_int8,omitempty"`
+	// OptionalInt16 holds the new value of the "optional_int16" field.
+	OptionalInt16 *int16 `json:"optional_int16,omitempty"`
+	// OptionalInt32 holds the new value of the "optional_int32" field.
+	OptionalInt32 *int32 `json:"optional_int32,omitempty"`
+	// OptionalInt64 holds the new value of the "optional_int64" field.
+	OptionalInt64 *int64 `json:"optional_int64,omitempty"`
+	// NillableInt holds the new value of the "nillable_int" field.
+	NillableInt *int `json:"nillable_int,omitempty"`
+	// NillableInt8 holds the new value of the "nillable_int8" field.
+	NillableInt8 *int8 `json:"nillable_int8,omitempty"`
+	// NillableInt16 holds the new value of the "nillable_int16" field.
+	NillableInt16 *int16 `json:"nillable_int16,omitempty"`
+	// NillableInt32 holds the new value of the "nillable_int32" field.
+	NillableInt32 *int32 `json:"nillable_int32,omitempty"`
+	// NillableInt64 holds the new value of the "nillable_int64" field.
+	NillableInt64 *int64 `json:"nillable_int64,omitempty"`
+	// ValidateOptionalInt32 holds the new value of the "validate_optional_int32" field.
+	ValidateOptionalInt32 *int32 `json:"validate_optional_int32,omitempty"`
+	// OptionalUint holds the new value of the "optional_uint" field.
+	OptionalUint *uint `json:"optional_uint,omitempty"`
+	// OptionalUint8 holds the new value of the "optional_uint8" field.
+	OptionalUint8 *uint8 `json:"optional_uint8,omitempty"`
+	// OptionalUint16 holds the new value of the "optional_uint16" field.
+	OptionalUint16 *uint16 `json:"optional_uint16,omitempty"`
+	// OptionalUint32 holds the new value of the "optional_uint32" field.
+	OptionalUint32 *uint32 `json:"optional_uint32,omitempty"`
+	// OptionalUint64 holds the new value of the "optional_uint64" field.
+	OptionalUint64 *uint64 `json:"optional_uint64,omitempty"`
+	// State holds the new value of the "state" field.
+	State *fieldtype.State `json:"state,omitempty"`
+	// OptionalFloat holds the new value of the "optional_float" field.
+	OptionalFloat *float64 `json:"optional_float,omitempty"`
+	// OptionalFloat32 holds the new value of the "optional_float32" field.
+	OptionalFloat32 *float32 `json:"optional_float32,omitempty"`
+	// Text holds the new value of the "text" field.
+	Text *string `json:"text,omitempty"`
+	// Datetime holds the new value of the "datetime" field.
+	Datetime *time.Time `json:"datetime,omitempty"`
+	// Decimal holds the new value of the "decimal" field.
+	Decimal *float64 `json:"decimal,omitempty"`
+	// LinkOther holds the new value of the "link_other" field.
+	LinkOther **schema.Link `json:"link_other,omitempty"`
+	// LinkOtherFunc holds the new value of the "link_other_func" field.
+	LinkOtherFunc **schema.Link `json:"link_other_func,omitempty"`
+	// MAC holds the new value of the "mac" field.
+	MAC *schema.MAC `json:"mac,omitempty"`
+	// StringArray holds the new value of the "string_array" field.
+	StringArray *schema.Strings `json:"string_array,omitempty"`
+	// Password holds the new value of the "password" field.
+	Password *string `json:"password,omitempty"`
+	// StringScanner holds the new value of the "string_scanner" field.
+	StringScanner *schema.StringScanner `json:"string_scanner,omitempty"`
+	// Duration holds the new value of the "duration" field.
+	Duration *time.Duration `json:"duration,omitempty"`
+	// Dir holds the new value of the "dir" field.
+	Dir *http.Dir `json:"dir,omitempty"`
+	// Ndir holds the new value of the "ndir" field.
+	Ndir *http.Dir `json:"ndir,omitempty"`
+	// Str holds the new value of the "str" field.
+	Str *sql.NullString `json:"str,omitempty"`
+	// NullStr holds the new value of the "null_str" field.
+	NullStr **sql.NullString `json:"null_str,omitempty"`
+	// Link holds the new value of the "link" field.
+	Link *schema.Link `json:"link,omitempty"`
+	// NullLink holds the new value of the "null_link" field.
+	NullLink **schema.Link `json:"null_link,omitempty"`
+	// Active holds the new value of the "active" field.
+	Active *schema.Status `json:"active,omitempty"`
+	// NullActive holds the new value of the "null_active" field.
+	NullActive *schema.Status `json:"null_active,omitempty"`
+	// Deleted holds the new value of the "deleted" field.
+	Deleted **sql.NullBool `json:"deleted,omitempty"`
+	// DeletedAt holds the new value of the "deleted_at" field.
+	DeletedAt **sql.NullTime `json:"deleted_at,omitempty"`
+	// RawData holds the new value of the "raw_data" field.
+	RawData *[]byte `json:"raw_data,omitempty"`
+	// Sensitive holds the new value of the "sensitive" field.
+	Sensitive *[]byte `json:"sensitive,omitempty"`
+	// IP holds the new value of the "ip" field.
+	IP *net.IP `json:"ip,omitempty"`
+	// NullInt64 holds the new value of the "null_int64" field.
+	NullInt64 **sql.NullInt64 `json:"null_int64,omitempty"`
+	// SchemaInt holds the new value of the "schema_int" field.
+	SchemaInt *schema.Int `json:"schema_int,omitempty"`
+	// SchemaInt8 holds the new value of the "schema_int8" field.
+	SchemaInt8 *schema.Int8 `json:"schema_int8,omitempty"`
+	// SchemaInt64 holds the new value of the "schema_int64" field.
+	SchemaInt64 *schema.Int64 `json:"schema_int64,omitempty"`
+	// SchemaFloat holds the new value of the "schema_float" field.
+	SchemaFloat *schema.Float64 `json:"schema_float,omitempty"`
+	// SchemaFloat32 holds the new value of the "schema_float32" field.
+	SchemaFloat32 *schema.Float32 `json:"schema_float32,omitempty"`
+	// NullFloat holds the new value of the "null_float" field.
+	NullFloat **sql.NullFloat64 `json:"null_float,omitempty"`
+	// Role holds the new value of the "role" field.
+	Role *role.Role `json:"role,omitempty"`
+	// Priority holds the new value of the "priority" field.
+	Priority *role.Priority `json:"priority,omitempty"`
+	// OptionalUUID holds the new value of the "optional_uuid" field.
+	OptionalUUID *uuid.UUID `json:"optional_uuid,omitempty"`
+	// NillableUUID holds the new value of the "nillable_uuid" field.
+	NillableUUID *uuid.UUID `json:"nillable_uuid,omitempty"`
+	// Strings holds the new value of the "strings" field.
+	Strings *[]string `json:"strings,omitempty"`
+	// Pair holds the new value of the "pair" field.
+	Pair *schema.Pair `json:"pair,omitempty"`
+	// NilPair holds the new value of the "nil_pair" field.
+	NilPair **schema.Pair `json:"nil_pair,omitempty"`
+	// Vstring holds the new value of the "vstring" field.
+	Vstring *schema.VString `json:"vstring,omitempty"`
+	// Triple holds the new value of the "triple" field.
+	Triple *schema.Triple `json:"triple,omitempty"`
+	// BigInt holds the new value of the "big_int" field.
+	BigInt *schema.BigInt `json:"big_int,omitempty"`
+	// PasswordOther holds the new value of the "password_other" field.
+	PasswordOther *schema.Password `json:"password_other,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, fieldtype.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *FieldTypePatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p FieldTypePatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.Int != nil {
+		fields["int"] = *p.Int
+	}
+	if p.Int8 != nil {
+		fields["int8"] = *p.Int8
+	}
+	if p.Int16 != nil {
+		fields["int16"] = *p.Int16
+	}
+	if p.Int32 != nil {
+		fields["int32"] = *p.Int32
+	}
+	if p.Int64 != nil {
+		fields["int64"] = *p.Int64
+	}
+	if p.OptionalInt != nil {
+		fields["optional_int"] = *p.OptionalInt
+	}
+	if p.OptionalInt8 != nil {
+		fields["optional_int8"] = *p.OptionalInt8
+	}
+	if p.OptionalInt16 != nil {
+		fields["optional_int16"] = *p.OptionalInt16
+	}
+	if p.OptionalInt32 != nil {
+		fields["optional_int32"] = *p.OptionalInt32
+	}
+	if p.OptionalInt64 != nil {
+		fields["optional_int64"] = *p.OptionalInt64
+	}
+	if p.NillableInt != nil {
+		fields["nillable_int"] = *p.NillableInt
+	}
+	if p.NillableInt8 != nil {
+		fields["nillable_int8"] = *p.NillableInt8
+	}
+	if p.NillableInt16 != nil {
+		fields["nillable_int16"] = *p.NillableInt16
+	}
+	if p.NillableInt32 != nil {
+		fields["nillable_int32"] = *p.NillableInt32
+	}
+	if p.NillableInt64 != nil {
+		fields["nillable_int64"] = *p.NillableInt64
+	}
+	if p.ValidateOptionalInt32 != nil {
+		fields["validate_optional_int32"] = *p.ValidateOptionalInt32
+	}
+	if p.OptionalUint != nil {
+		fields["optional_uint"] = *p.OptionalUint
+	}
+	if p.OptionalUint8 != nil {
+		fields["optional_uint8"] = *p.OptionalUint8
+	}
+	if p.OptionalUint16 != nil {
+		fields["optional_uint16"] = *p.OptionalUint16
+	}
+	if p.OptionalUint32 != nil {
+		fields["optional_uint32"] = *p.OptionalUint32
+	}
+	if p.OptionalUint64 != nil {
+		fields["optional_uint64"] = *p.OptionalUint64
+	}
+	if p.State != nil {
+		fields["state"] = *p.State
+	}
+	if p.OptionalFloat != nil {
+		fields["optional_float"] = *p.OptionalFloat
+	}
+	if p.OptionalFloat32 != nil {
+		fields["optional_float32"] = *p.OptionalFloat32
+	}
+	if p.Text != nil {
+		fields["text"] = *p.Text
+	}
+	if p.Datetime != nil {
+		fields["datetime"] = *p.Datetime
+	}
+	if p.Decimal != nil {
+		fields["decimal"] = *p.Decimal
+	}
+	if p.LinkOther != nil {
+		fields["link_other"] = *p.LinkOther
+	}
+	if p.LinkOtherFunc != nil {
+		fields["link_other_func"] = *p.LinkOtherFunc
+	}
+	if p.MAC != nil {
+		fields["mac"] = *p.MAC
+	}
+	if p.StringArray != nil {
+		fields["string_array"] = *p.StringArray
+	}
+	if p.StringScanner != nil {
+		fields["string_scanner"] = *p.StringScanner
+	}
+	if p.Duration != nil {
+		fields["duration"] = *p.Duration
+	}
+	if p.Dir != nil {
+		fields["dir"] = *p.Dir
+	}
+	if p.Ndir != nil {
+		fields["ndir"] = *p.Ndir
+	}
+	if p.Str != nil {
+		fields["str"] = *p.Str
+	}
+	if p.NullStr != nil {
+		fields["null_str"] = *p.NullStr
+	}
+	if p.Link != nil {
+		fields["link"] = *p.Link
+	}
+	if p.NullLink != nil {
+		fields["null_link"] = *p.NullLink
+	}
+	if p.Active != nil {
+		fields["active"] = *p.Active
+	}
+	if p.NullActive != nil {
+		fields["null_active"] = *p.NullActive
+	}
+	if p.Deleted != nil {
+		fields["deleted"] = *p.Deleted
+	}
+	if p.DeletedAt != nil {
+		fields["deleted_at"] = *p.DeletedAt
+	}
+	if p.RawData != nil {
+		fields["raw_data"] = *p.RawData
+	}
+	if p.IP != nil {
+		fields["ip"] = *p.IP
+	}
+	if p.NullInt64 != nil {
+		fields["null_int64"] = *p.NullInt64
+	}
+	if p.SchemaInt != nil {
+		fields["schema_int"] = *p.SchemaInt
+	}
+	if p.SchemaInt8 != nil {
+		fields["schema_int8"] = *p.SchemaInt8
+	}
+	if p.SchemaInt64 != nil {
+		fields["schema_int64"] = *p.SchemaInt64
+	}
+	if p.SchemaFloat != nil {
+		fields["schema_float"] = *p.SchemaFloat
+	}
+	if p.SchemaFloat32 != nil {
+		fields["schema_float32"] = *p.SchemaFloat32
+	}
+	if p.NullFloat != nil {
+		fields["null_float"] = *p.NullFloat
+	}
+	if p.Role != nil {
+		fields["role"] = *p.Role
+	}
+	if p.Priority != nil {
+		fields["priority"] = *p.Priority
+	}
+	if p.OptionalUUID != nil {
+		fields["optional_uuid"] = *p.OptionalUUID
+	}
+	if p.NillableUUID != nil {
+		fields["nillable_uuid"] = *p.NillableUUID
+	}
+	if p.Strings != nil {
+		fields["strings"] = *p.Strings
+	}
+	if p.Pair != nil {
+		fields["pair"] = *p.Pair
+	}
+	if p.NilPair != nil {
+		fields["nil_pair"] = *p.NilPair
+	}
+	if p.Vstring != nil {
+		fields["vstring"] = *p.Vstring
+	}
+	if p.Triple != nil {
+		fields["triple"] = *p.Triple
+	}
+	if p.BigInt != nil {
+		fields["big_int"] = *p.BigInt
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		case fieldtype.FieldOptionalInt:
+			fields["optional_int"] = nil
+		case fieldtype.FieldOptionalInt8:
+			fields["optional_int8"] = nil
+		case fieldtype.FieldOptionalInt16:
+			fields["optional_int16"] = nil
+		case fieldtype.FieldOptionalInt32:
+			fields["optional_int32"] = nil
+		case fieldtype.FieldOptionalInt64:
+			fields["optional_int64"] = nil
+		case fieldtype.FieldNillableInt:
+			fields["nillable_int"] = nil
+		case fieldtype.FieldNillableInt8:
+			fields["nillable_int8"] = nil
+		case fieldtype.FieldNillableInt16:
+			fields["nillable_int16"] = nil
+		case fieldtype.FieldNillableInt32:
+			fields["nillable_int32"] = nil
+		case fieldtype.FieldNillableInt64:
+			fields["nillable_int64"] = nil
+		case fieldtype.FieldValidateOptionalInt32:
+			fields["validate_optional_int32"] = nil
+		case fieldtype.FieldOptionalUint:
+			fields["optional_uint"] = nil
+		case fieldtype.FieldOptionalUint8:
+			fields["optional_uint8"] = nil
+		case fieldtype.FieldOptionalUint16:
+			fields["optional_uint16"] = nil
+		case fieldtype.FieldOptionalUint32:
+			fields["optional_uint32"] = nil
+		case fieldtype.FieldOptionalUint64:
+			fields["optional_uint64"] = nil
+		case fieldtype.FieldState:
+			fields["state"] = nil
+		case fieldtype.FieldOptionalFloat:
+			fields["optional_float"] = nil
+		case fieldtype.FieldOptionalFloat32:
+			fields["optional_float32"] = nil
+		case fieldtype.FieldText:
+			fields["text"] = nil
+		case fieldtype.FieldDatetime:
+			fields["datetime"] = nil
+		case fieldtype.FieldDecimal:
+			fields["decimal"] = nil
+		case fieldtype.FieldLinkOther:
+			fields["link_other"] = nil
+		case fieldtype.FieldLinkOtherFunc:
+			fields["link_other_func"] = nil
+		case fieldtype.FieldMAC:
+			fields["mac"] = nil
+		case fieldtype.FieldStringArray:
+			fields["string_array"] = nil
+		case fieldtype.FieldPassword:
+			fields["password"] = nil
+		case fieldtype.FieldStringScanner:
+			fields["string_scanner"] = nil
+		case fieldtype.FieldDuration:
+			fields["duration"] = nil
+		case fieldtype.FieldNdir:
+			fields["ndir"] = nil
+		case fieldtype.FieldStr:
+			fields["str"] = nil
+		case fieldtype.FieldNullStr:
+			fields["null_str"] = nil
+		case fieldtype.FieldLink:
+			fields["link"] = nil
+		case fieldtype.FieldNullLink:
+			fields["null_link"] = nil
+		case fieldtype.FieldActive:
+			fields["active"] = nil
+		case fieldtype.FieldNullActive:
+			fields["null_active"] = nil
+		case fieldtype.FieldDeleted:
+			fields["deleted"] = nil
+		case fieldtype.FieldDeletedAt:
+			fields["deleted_at"] = nil
+		case fieldtype.FieldRawData:
+			fields["raw_data"] = nil
+		case fieldtype.FieldSensitive:
+			fields["sensitive"] = nil
+		case fieldtype.FieldIP:
+			fields["ip"] = nil
+		case fieldtype.FieldNullInt64:
+			fields["null_int64"] = nil
+		case fieldtype.FieldSchemaInt:
+			fields["schema_int"] = nil
+		case fieldtype.FieldSchemaInt8:
+			fields["schema_int8"] = nil
+		case fieldtype.FieldSchemaInt64:
+			fields["schema_int64"] = nil
+		case fieldtype.FieldSchemaFloat:
+			fields["schema_float"] = nil
+		case fieldtype.FieldSchemaFloat32:
+			fields["schema_float32"] = nil
+		case fieldtype.FieldNullFloat:
+			fields["null_float"] = nil
+		case fieldtype.FieldPriority:
+			fields["priority"] = nil
+		case fieldtype.FieldOptionalUUID:
+			fields["optional_uuid"] = nil
+		case fieldtype.FieldNillableUUID:
+			fields["nillable_uuid"] = nil
+		case fieldtype.FieldStrings:
+			fields["strings"] = nil
+		case fieldtype.FieldNilPair:
+			fields["nil_pair"] = nil
+		case fieldtype.FieldBigInt:
+			fields["big_int"] = nil
+		case fieldtype.FieldPasswordOther:
+			fields["password_other"] = nil
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional FieldType field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *FieldTypePatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "int":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FieldType field %q cannot be null", name)
+			}
+			p.Int = new(int)
+			if err := json.Unmarshal(raw, p.Int); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "int8":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FieldType field %q cannot be null", name)
+			}
+			p.Int8 = new(int8)
+			if err := json.Unmarshal(raw, p.Int8); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "int16":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FieldType field %q cannot be null", name)
+			}
+			p.Int16 = new(int16)
+			if err := json.Unmarshal(raw, p.Int16); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "int32":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FieldType field %q cannot be null", name)
+			}
+			p.Int32 = new(int32)
+			if err := json.Unmarshal(raw, p.Int32); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "int64":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FieldType field %q cannot be null", name)
+			}
+			p.Int64 = new(int64)
+			if err := json.Unmarshal(raw, p.Int64); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_int":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalInt)
+				continue
+			}
+			p.OptionalInt = new(int)
+			if err := json.Unmarshal(raw, p.OptionalInt); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_int8":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalInt8)
+				continue
+			}
+			p.OptionalInt8 = new(int8)
+			if err := json.Unmarshal(raw, p.OptionalInt8); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_int16":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalInt16)
+				continue
+			}
+			p.OptionalInt16 = new(int16)
+			if err := json.Unmarshal(raw, p.OptionalInt16); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_int32":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalInt32)
+				continue
+			}
+			p.OptionalInt32 = new(int32)
+			if err := json.Unmarshal(raw, p.OptionalInt32); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_int64":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalInt64)
+				continue
+			}
+			p.OptionalInt64 = new(int64)
+			if err := json.Unmarshal(raw, p.OptionalInt64); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "nillable_int":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNillableInt)
+				continue
+			}
+			p.NillableInt = new(int)
+			if err := json.Unmarshal(raw, p.NillableInt); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "nillable_int8":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNillableInt8)
+				continue
+			}
+			p.NillableInt8 = new(int8)
+			if err := json.Unmarshal(raw, p.NillableInt8); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "nillable_int16":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNillableInt16)
+				continue
+			}
+			p.NillableInt16 = new(int16)
+			if err := json.Unmarshal(raw, p.NillableInt16); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "nillable_int32":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNillableInt32)
+				continue
+			}
+			p.NillableInt32 = new(int32)
+			if err := json.Unmarshal(raw, p.NillableInt32); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "nillable_int64":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNillableInt64)
+				continue
+			}
+			p.NillableInt64 = new(int64)
+			if err := json.Unmarshal(raw, p.NillableInt64); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "validate_optional_int32":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldValidateOptionalInt32)
+				continue
+			}
+			p.ValidateOptionalInt32 = new(int32)
+			if err := json.Unmarshal(raw, p.ValidateOptionalInt32); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_uint":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUint)
+				continue
+			}
+			p.OptionalUint = new(uint)
+			if err := json.Unmarshal(raw, p.OptionalUint); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_uint8":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUint8)
+				continue
+			}
+			p.OptionalUint8 = new(uint8)
+			if err := json.Unmarshal(raw, p.OptionalUint8); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_uint16":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUint16)
+				continue
+			}
+			p.OptionalUint16 = new(uint16)
+			if err := json.Unmarshal(raw, p.OptionalUint16); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_uint32":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUint32)
+				continue
+			}
+			p.OptionalUint32 = new(uint32)
+			if err := json.Unmarshal(raw, p.OptionalUint32); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_uint64":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUint64)
+				continue
+			}
+			p.OptionalUint64 = new(uint64)
+			if err := json.Unmarshal(raw, p.OptionalUint64); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "state":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldState)
+				continue
+			}
+			p.State = new(fieldtype.State)
+			if err := json.Unmarshal(raw, p.State); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_float":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalFloat)
+				continue
+			}
+			p.OptionalFloat = new(float64)
+			if err := json.Unmarshal(raw, p.OptionalFloat); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_float32":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalFloat32)
+				continue
+			}
+			p.OptionalFloat32 = new(float32)
+			if err := json.Unmarshal(raw, p.OptionalFloat32); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "text":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldText)
+				continue
+			}
+			p.Text = new(string)
+			if err := json.Unmarshal(raw, p.Text); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "datetime":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldDatetime)
+				continue
+			}
+			p.Datetime = new(time.Time)
+			if err := json.Unmarshal(raw, p.Datetime); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "decimal":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldDecimal)
+				continue
+			}
+			p.Decimal = new(float64)
+			if err := json.Unmarshal(raw, p.Decimal); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "link_other":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldLinkOther)
+				continue
+			}
+			p.LinkOther = new(*schema.Link)
+			if err := json.Unmarshal(raw, p.LinkOther); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "link_other_func":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldLinkOtherFunc)
+				continue
+			}
+			p.LinkOtherFunc = new(*schema.Link)
+			if err := json.Unmarshal(raw, p.LinkOtherFunc); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "mac":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldMAC)
+				continue
+			}
+			p.MAC = new(schema.MAC)
+			if err := json.Unmarshal(raw, p.MAC); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "string_array":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldStringArray)
+				continue
+			}
+			p.StringArray = new(schema.Strings)
+			if err := json.Unmarshal(raw, p.StringArray); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "password":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldPassword)
+				continue
+			}
+			p.Password = new(string)
+			if err := json.Unmarshal(raw, p.Password); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "string_scanner":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldStringScanner)
+				continue
+			}
+			p.StringScanner = new(schema.StringScanner)
+			if err := json.Unmarshal(raw, p.StringScanner); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "duration":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldDuration)
+				continue
+			}
+			p.Duration = new(time.Duration)
+			if err := json.Unmarshal(raw, p.Duration); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "dir":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FieldType field %q cannot be null", name)
+			}
+			p.Dir = new(http.Dir)
+			if err := json.Unmarshal(raw, p.Dir); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "ndir":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNdir)
+				continue
+			}
+			p.Ndir = new(http.Dir)
+			if err := json.Unmarshal(raw, p.Ndir); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "str":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldStr)
+				continue
+			}
+			p.Str = new(sql.NullString)
+			if err := json.Unmarshal(raw, p.Str); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "null_str":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNullStr)
+				continue
+			}
+			p.NullStr = new(*sql.NullString)
+			if err := json.Unmarshal(raw, p.NullStr); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "link":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldLink)
+				continue
+			}
+			p.Link = new(schema.Link)
+			if err := json.Unmarshal(raw, p.Link); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "null_link":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNullLink)
+				continue
+			}
+			p.NullLink = new(*schema.Link)
+			if err := json.Unmarshal(raw, p.NullLink); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "active":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldActive)
+				continue
+			}
+			p.Active = new(schema.Status)
+			if err := json.Unmarshal(raw, p.Active); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "null_active":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNullActive)
+				continue
+			}
+			p.NullActive = new(schema.Status)
+			if err := json.Unmarshal(raw, p.NullActive); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "deleted":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldDeleted)
+				continue
+			}
+			p.Deleted = new(*sql.NullBool)
+			if err := json.Unmarshal(raw, p.Deleted); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "deleted_at":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldDeletedAt)
+				continue
+			}
+			p.DeletedAt = new(*sql.NullTime)
+			if err := json.Unmarshal(raw, p.DeletedAt); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "raw_data":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldRawData)
+				continue
+			}
+			p.RawData = new([]byte)
+			if err := json.Unmarshal(raw, p.RawData); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "sensitive":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldSensitive)
+				continue
+			}
+			p.Sensitive = new([]byte)
+			if err := json.Unmarshal(raw, p.Sensitive); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "ip":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldIP)
+				continue
+			}
+			p.IP = new(net.IP)
+			if err := json.Unmarshal(raw, p.IP); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "null_int64":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNullInt64)
+				continue
+			}
+			p.NullInt64 = new(*sql.NullInt64)
+			if err := json.Unmarshal(raw, p.NullInt64); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "schema_int":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldSchemaInt)
+				continue
+			}
+			p.SchemaInt = new(schema.Int)
+			if err := json.Unmarshal(raw, p.SchemaInt); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "schema_int8":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldSchemaInt8)
+				continue
+			}
+			p.SchemaInt8 = new(schema.Int8)
+			if err := json.Unmarshal(raw, p.SchemaInt8); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "schema_int64":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldSchemaInt64)
+				continue
+			}
+			p.SchemaInt64 = new(schema.Int64)
+			if err := json.Unmarshal(raw, p.SchemaInt64); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "schema_float":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldSchemaFloat)
+				continue
+			}
+			p.SchemaFloat = new(schema.Float64)
+			if err := json.Unmarshal(raw, p.SchemaFloat); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "schema_float32":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldSchemaFloat32)
+				continue
+			}
+			p.SchemaFloat32 = new(schema.Float32)
+			if err := json.Unmarshal(raw, p.SchemaFloat32); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "null_float":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNullFloat)
+				continue
+			}
+			p.NullFloat = new(*sql.NullFloat64)
+			if err := json.Unmarshal(raw, p.NullFloat); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "role":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FieldType field %q cannot be null", name)
+			}
+			p.Role = new(role.Role)
+			if err := json.Unmarshal(raw, p.Role); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "priority":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldPriority)
+				continue
+			}
+			p.Priority = new(role.Priority)
+			if err := json.Unmarshal(raw, p.Priority); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "optional_uuid":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUUID)
+				continue
+			}
+			p.OptionalUUID = new(uuid.UUID)
+			if err := json.Unmarshal(raw, p.OptionalUUID); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "nillable_uuid":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNillableUUID)
+				continue
+			}
+			p.NillableUUID = new(uuid.UUID)
+			if err := json.Unmarshal(raw, p.NillableUUID); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "strings":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldStrings)
+				continue
+			}
+			p.Strings = new([]string)
+			if err := json.Unmarshal(raw, p.Strings); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "pair":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FieldType field %q cannot be null", name)
+			}
+			p.Pair = new(schema.Pair)
+			if err := json.Unmarshal(raw, p.Pair); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "nil_pair":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldNilPair)
+				continue
+			}
+			p.NilPair = new(*schema.Pair)
+			if err := json.Unmarshal(raw, p.NilPair); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "vstring":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FieldType field %q cannot be null", name)
+			}
+			p.Vstring = new(schema.VString)
+			if err := json.Unmarshal(raw, p.Vstring); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "triple":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FieldType field %q cannot be null", name)
+			}
+			p.Triple = new(schema.Triple)
+			if err := json.Unmarshal(raw, p.Triple); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "big_int":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldBigInt)
+				continue
+			}
+			p.BigInt = new(schema.BigInt)
+			if err := json.Unmarshal(raw, p.BigInt); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		case "password_other":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, fieldtype.FieldPasswordOther)
+				continue
+			}
+			p.PasswordOther = new(schema.Password)
+			if err := json.Unmarshal(raw, p.PasswordOther); err != nil {
+				return fmt.Errorf("ent: decoding FieldType field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable FieldType field %q", name)
+		}
+	}
+	return nil
+}
+
+// Selected reports whether the given field was loaded into the FieldType, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	ft := client.FieldType.Query().Select(fieldtype.FieldInt).FirstX(ctx)
+//	ft.Selected(fieldtype.FieldInt) // true
+//
+func (ft *FieldType) Selected(field string) bool {
+	switch field {
+	case fieldtype.FieldID:
+		return true
+	case fieldtype.FieldInt:
+		return ft.selectedFields == nil || ft.selectedFields[0]
+	case fieldtype.FieldInt8:
+		return ft.selectedFields == nil || ft.selectedFields[1]
+	case fieldtype.FieldInt16:
+		return ft.selectedFields == nil || ft.selectedFields[2]
+	case fieldtype.FieldInt32:
+		return ft.selectedFields == nil || ft.selectedFields[3]
+	case fieldtype.FieldInt64:
+		return ft.selectedFields == nil || ft.selectedFields[4]
+	case fieldtype.FieldOptionalInt:
+		return ft.selectedFields == nil || ft.selectedFields[5]
+	case fieldtype.FieldOptionalInt8:
+		return ft.selectedFields == nil || ft.selectedFields[6]
+	case fieldtype.FieldOptionalInt16:
+		return ft.selectedFields == nil || ft.selectedFields[7]
+	case fieldtype.FieldOptionalInt32:
+		return ft.selectedFields == nil || ft.selectedFields[8]
+	case fieldtype.FieldOptionalInt64:
+		return ft.selectedFields == nil || ft.selectedFields[9]
+	case fieldtype.FieldNillableInt:
+		return ft.selectedFields == nil || ft.selectedFields[10]
+	case fieldtype.FieldNillableInt8:
+		return ft.selectedFields == nil || ft.selectedFields[11]
+	case fieldtype.FieldNillableInt16:
+		return ft.selectedFields == nil || ft.selectedFields[12]
+	case fieldtype.FieldNillableInt32:
+		return ft.selectedFields == nil || ft.selectedFields[13]
+	case fieldtype.FieldNillableInt64:
+		return ft.selectedFields == nil || ft.selectedFields[14]
+	case fieldtype.FieldValidateOptionalInt32:
+		return ft.selectedFields == nil || ft.selectedFields[15]
+	case fieldtype.FieldOptionalUint:
+		return ft.selectedFields == nil || ft.selectedFields[16]
+	case fieldtype.FieldOptionalUint8:
+		return ft.selectedFields == nil || ft.selectedFields[17]
+	case fieldtype.FieldOptionalUint16:
+		return ft.selectedFields == nil || ft.selectedFields[18]
+	case fieldtype.FieldOptionalUint32:
+		return ft.selectedFields == nil || ft.selectedFields[19]
+	case fieldtype.FieldOptionalUint64:
+		return ft.selectedFields == nil || ft.selectedFields[20]
+	case fieldtype.FieldState:
+		return ft.selectedFields == nil || ft.selectedFields[21]
+	case fieldtype.FieldOptionalFloat:
+		return ft.selectedFields == nil || ft.selectedFields[22]
+	case fieldtype.FieldOptionalFloat32:
+		return ft.selectedFields == nil || ft.selectedFields[23]
+	case fieldtype.FieldText:
+		return ft.selectedFields == nil || ft.selectedFields[24]
+	case fieldtype.FieldDatetime:
+		return ft.selectedFields == nil || ft.selectedFields[25]
+	case fieldtype.FieldDecimal:
+		return ft.selectedFields == nil || ft.selectedFields[26]
+	case fieldtype.FieldLinkOther:
+		return ft.selectedFields == nil || ft.selectedFields[27]
+	case fieldtype.FieldLinkOtherFunc:
+		return ft.selectedFields == nil || ft.selectedFields[28]
+	case fieldtype.FieldMAC:
+		return ft.selectedFields == nil || ft.selectedFields[29]
+	case fieldtype.FieldStringArray:
+		return ft.selectedFields == nil || ft.selectedFields[30]
+	case fieldtype.FieldPassword:
+		return ft.selectedFields == nil || ft.selectedFields[31]
+	case fieldtype.FieldStringScanner:
+		return ft.selectedFields == nil || ft.selectedFields[32]
+	case fieldtype.FieldDuration:
+		return ft.selectedFields == nil || ft.selectedFields[33]
+	case fieldtype.FieldDir:
+		return ft.selectedFields == nil || ft.selectedFields[34]
+	case fieldtype.FieldNdir:
+		return ft.selectedFields == nil || ft.selectedFields[35]
+	case fieldtype.FieldStr:
+		return ft.selectedFields == nil || ft.selectedFields[36]
+	case fieldtype.FieldNullStr:
+		return ft.selectedFields == nil || ft.selectedFields[37]
+	case fieldtype.FieldLink:
+		return ft.selectedFields == nil || ft.selectedFields[38]
+	case fieldtype.FieldNullLink:
+		return ft.selectedFields == nil || ft.selectedFields[39]
+	case fieldtype.FieldActive:
+		return ft.selectedFields == nil || ft.selectedFields[40]
+	case fieldtype.FieldNullActive:
+		return ft.selectedFields == nil || ft.selectedFields[41]
+	case fieldtype.FieldDeleted:
+		return ft.selectedFields == nil || ft.selectedFields[42]
+	case fieldtype.FieldDeletedAt:
+		return ft.selectedFields == nil || ft.selectedFields[43]
+	case fieldtype.FieldRawData:
+		return ft.selectedFields == nil || ft.selectedFields[44]
+	case fieldtype.FieldSensitive:
+		return ft.selectedFields == nil || ft.selectedFields[45]
+	case fieldtype.FieldIP:
+		return ft.selectedFields == nil || ft.selectedFields[46]
+	case fieldtype.FieldNullInt64:
+		return ft.selectedFields == nil || ft.selectedFields[47]
+	case fieldtype.FieldSchemaInt:
+		return ft.selectedFields == nil || ft.selectedFields[48]
+	case fieldtype.FieldSchemaInt8:
+		return ft.selectedFields == nil || ft.selectedFields[49]
+	case fieldtype.FieldSchemaInt64:
+		return ft.selectedFields == nil || ft.selectedFields[50]
+	case fieldtype.FieldSchemaFloat:
+		return ft.selectedFields == nil || ft.selectedFields[51]
+	case fieldtype.FieldSchemaFloat32:
+		return ft.selectedFields == nil || ft.selectedFields[52]
+	case fieldtype.FieldNullFloat:
+		return ft.selectedFields == nil || ft.selectedFields[53]
+	case fieldtype.FieldRole:
+		return ft.selectedFields == nil || ft.selectedFields[54]
+	case fieldtype.FieldPriority:
+		return ft.selectedFields == nil || ft.selectedFields[55]
+	case fieldtype.FieldOptionalUUID:
+		return ft.selectedFields == nil || ft.selectedFields[56]
+	case fieldtype.FieldNillableUUID:
+		return ft.selectedFields == nil || ft.selectedFields[57]
+	case fieldtype.FieldStrings:
+		return ft.selectedFields == nil || ft.selectedFields[58]
+	case fieldtype.FieldPair:
+		return ft.selectedFields == nil || ft.selectedFields[59]
+	case fieldtype.FieldNilPair:
+		return ft.selectedFields == nil || ft.selectedFields[60]
+	case fieldtype.FieldVstring:
+		return ft.selectedFields == nil || ft.selectedFields[61]
+	case fieldtype.FieldTriple:
+		return ft.selectedFields == nil || ft.selectedFields[62]
+	case fieldtype.FieldBigInt:
+		return ft.selectedFields == nil || ft.selectedFields[63]
+	case fieldtype.FieldPasswordOther:
+		return ft.selectedFields == nil || ft.selectedFields[64]
+	}
+	return false
+}
+
+// IntOrErr returns the value of the "int" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) IntOrErr() (int, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[0] {
+		var zero int
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldInt}
+	}
+	return ft.Int, nil
+}
+
+// Int8OrErr returns the value of the "int8" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) Int8OrErr() (int8, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[1] {
+		var zero int8
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldInt8}
+	}
+	return ft.Int8, nil
+}
+
+// Int16OrErr returns the value of the "int16" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) Int16OrErr() (int16, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[2] {
+		var zero int16
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldInt16}
+	}
+	return ft.Int16, nil
+}
+
+// Int32OrErr returns the value of the "int32" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) Int32OrErr() (int32, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[3] {
+		var zero int32
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldInt32}
+	}
+	return ft.Int32, nil
+}
+
+// Int64OrErr returns the value of the "int64" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) Int64OrErr() (int64, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[4] {
+		var zero int64
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldInt64}
+	}
+	return ft.Int64, nil
+}
+
+// OptionalIntOrErr returns the value of the "optional_int" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalIntOrErr() (int, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[5] {
+		var zero int
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalInt}
+	}
+	return ft.OptionalInt, nil
+}
+
+// OptionalInt8OrErr returns the value of the "optional_int8" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalInt8OrErr() (int8, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[6] {
+		var zero int8
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalInt8}
+	}
+	return ft.OptionalInt8, nil
+}
+
+// OptionalInt16OrErr returns the value of the "optional_int16" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalInt16OrErr() (int16, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[7] {
+		var zero int16
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalInt16}
+	}
+	return ft.OptionalInt16, nil
+}
+
+// OptionalInt32OrErr returns the value of the "optional_int32" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalInt32OrErr() (int32, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[8] {
+		var zero int32
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalInt32}
+	}
+	return ft.OptionalInt32, nil
+}
+
+// OptionalInt64OrErr returns the value of the "optional_int64" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalInt64OrErr() (int64, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[9] {
+		var zero int64
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalInt64}
+	}
+	return ft.OptionalInt64, nil
+}
+
+// NillableIntOrErr returns the value of the "nillable_int" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NillableIntOrErr() (*int, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[10] {
+		var zero *int
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNillableInt}
+	}
+	return ft.NillableInt, nil
+}
+
+// NillableInt8OrErr returns the value of the "nillable_int8" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NillableInt8OrErr() (*int8, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[11] {
+		var zero *int8
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNillableInt8}
+	}
+	return ft.NillableInt8, nil
+}
+
+// NillableInt16OrErr returns the value of the "nillable_int16" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NillableInt16OrErr() (*int16, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[12] {
+		var zero *int16
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNillableInt16}
+	}
+	return ft.NillableInt16, nil
+}
+
+// NillableInt32OrErr returns the value of the "nillable_int32" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NillableInt32OrErr() (*int32, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[13] {
+		var zero *int32
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNillableInt32}
+	}
+	return ft.NillableInt32, nil
+}
+
+// NillableInt64OrErr returns the value of the "nillable_int64" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NillableInt64OrErr() (*int64, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[14] {
+		var zero *int64
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNillableInt64}
+	}
+	return ft.NillableInt64, nil
+}
+
+// ValidateOptionalInt32OrErr returns the value of the "validate_optional_int32" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) ValidateOptionalInt32OrErr() (int32, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[15] {
+		var zero int32
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldValidateOptionalInt32}
+	}
+	return ft.ValidateOptionalInt32, nil
+}
+
+// OptionalUintOrErr returns the value of the "optional_uint" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalUintOrErr() (uint, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[16] {
+		var zero uint
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalUint}
+	}
+	return ft.OptionalUint, nil
+}
+
+// OptionalUint8OrErr returns the value of the "optional_uint8" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalUint8OrErr() (uint8, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[17] {
+		var zero uint8
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalUint8}
+	}
+	return ft.OptionalUint8, nil
+}
+
+// OptionalUint16OrErr returns the value of the "optional_uint16" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalUint16OrErr() (uint16, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[18] {
+		var zero uint16
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalUint16}
+	}
+	return ft.OptionalUint16, nil
+}
+
+// OptionalUint32OrErr returns the value of the "optional_uint32" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalUint32OrErr() (uint32, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[19] {
+		var zero uint32
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalUint32}
+	}
+	return ft.OptionalUint32, nil
+}
+
+// OptionalUint64OrErr returns the value of the "optional_uint64" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalUint64OrErr() (uint64, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[20] {
+		var zero uint64
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalUint64}
+	}
+	return ft.OptionalUint64, nil
+}
+
+// StateOrErr returns the value of the "state" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) StateOrErr() (fieldtype.State, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[21] {
+		var zero fieldtype.State
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldState}
+	}
+	return ft.State, nil
+}
+
+// OptionalFloatOrErr returns the value of the "optional_float" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalFloatOrErr() (float64, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[22] {
+		var zero float64
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalFloat}
+	}
+	return ft.OptionalFloat, nil
+}
+
+// OptionalFloat32OrErr returns the value of the "optional_float32" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalFloat32OrErr() (float32, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[23] {
+		var zero float32
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalFloat32}
+	}
+	return ft.OptionalFloat32, nil
+}
+
+// TextOrErr returns the value of the "text" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) TextOrErr() (string, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[24] {
+		var zero string
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldText}
+	}
+	return ft.Text, nil
+}
+
+// DatetimeOrErr returns the value of the "datetime" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) DatetimeOrErr() (time.Time, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[25] {
+		var zero time.Time
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldDatetime}
+	}
+	return ft.Datetime, nil
+}
+
+// DecimalOrErr returns the value of the "decimal" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) DecimalOrErr() (float64, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[26] {
+		var zero float64
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldDecimal}
+	}
+	return ft.Decimal, nil
+}
+
+// LinkOtherOrErr returns the value of the "link_other" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) LinkOtherOrErr() (*schema.Link, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[27] {
+		var zero *schema.Link
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldLinkOther}
+	}
+	return ft.LinkOther, nil
+}
+
+// LinkOtherFuncOrErr returns the value of the "link_other_func" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) LinkOtherFuncOrErr() (*schema.Link, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[28] {
+		var zero *schema.Link
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldLinkOtherFunc}
+	}
+	return ft.LinkOtherFunc, nil
+}
+
+// MACOrErr returns the value of the "mac" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) MACOrErr() (schema.MAC, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[29] {
+		var zero schema.MAC
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldMAC}
+	}
+	return ft.MAC, nil
+}
+
+// StringArrayOrErr returns the value of the "string_array" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) StringArrayOrErr() (schema.Strings, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[30] {
+		var zero schema.Strings
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldStringArray}
+	}
+	return ft.StringArray, nil
+}
+
+// PasswordOrErr returns the value of the "password" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) PasswordOrErr() (string, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[31] {
+		var zero string
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldPassword}
+	}
+	return ft.Password, nil
+}
+
+// StringScannerOrErr returns the value of the "string_scanner" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) StringScannerOrErr() (*schema.StringScanner, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[32] {
+		var zero *schema.StringScanner
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldStringScanner}
+	}
+	return ft.StringScanner, nil
+}
+
+// DurationOrErr returns the value of the "duration" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) DurationOrErr() (time.Duration, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[33] {
+		var zero time.Duration
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldDuration}
+	}
+	return ft.Duration, nil
+}
+
+// DirOrErr returns the value of the "dir" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) DirOrErr() (http.Dir, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[34] {
+		var zero http.Dir
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldDir}
+	}
+	return ft.Dir, nil
+}
+
+// NdirOrErr returns the value of the "ndir" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NdirOrErr() (*http.Dir, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[35] {
+		var zero *http.Dir
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNdir}
+	}
+	return ft.Ndir, nil
+}
+
+// StrOrErr returns the value of the "str" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) StrOrErr() (sql.NullString, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[36] {
+		var zero sql.NullString
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldStr}
+	}
+	return ft.Str, nil
+}
+
+// NullStrOrErr returns the value of the "null_str" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NullStrOrErr() (*sql.NullString, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[37] {
+		var zero *sql.NullString
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNullStr}
+	}
+	return ft.NullStr, nil
+}
+
+// LinkOrErr returns the value of the "link" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) LinkOrErr() (schema.Link, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[38] {
+		var zero schema.Link
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldLink}
+	}
+	return ft.Link, nil
+}
+
+// NullLinkOrErr returns the value of the "null_link" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NullLinkOrErr() (*schema.Link, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[39] {
+		var zero *schema.Link
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNullLink}
+	}
+	return ft.NullLink, nil
+}
+
+// ActiveOrErr returns the value of the "active" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) ActiveOrErr() (schema.Status, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[40] {
+		var zero schema.Status
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldActive}
+	}
+	return ft.Active, nil
+}
+
+// NullActiveOrErr returns the value of the "null_active" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NullActiveOrErr() (*schema.Status, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[41] {
+		var zero *schema.Status
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNullActive}
+	}
+	return ft.NullActive, nil
+}
+
+// DeletedOrErr returns the value of the "deleted" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) DeletedOrErr() (*sql.NullBool, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[42] {
+		var zero *sql.NullBool
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldDeleted}
+	}
+	return ft.Deleted, nil
+}
+
+// DeletedAtOrErr returns the value of the "deleted_at" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) DeletedAtOrErr() (*sql.NullTime, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[43] {
+		var zero *sql.NullTime
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldDeletedAt}
+	}
+	return ft.DeletedAt, nil
+}
+
+// RawDataOrErr returns the value of the "raw_data" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) RawDataOrErr() ([]byte, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[44] {
+		var zero []byte
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldRawData}
+	}
+	return ft.RawData, nil
+}
+
+// SensitiveOrErr returns the value of the "sensitive" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) SensitiveOrErr() ([]byte, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[45] {
+		var zero []byte
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldSensitive}
+	}
+	return ft.Sensitive, nil
+}
+
+// IPOrErr returns the value of the "ip" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) IPOrErr() (net.IP, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[46] {
+		var zero net.IP
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldIP}
+	}
+	return ft.IP, nil
+}
+
+// NullInt64OrErr returns the value of the "null_int64" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NullInt64OrErr() (*sql.NullInt64, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[47] {
+		var zero *sql.NullInt64
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNullInt64}
+	}
+	return ft.NullInt64, nil
+}
+
+// SchemaIntOrErr returns the value of the "schema_int" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) SchemaIntOrErr() (schema.Int, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[48] {
+		var zero schema.Int
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldSchemaInt}
+	}
+	return ft.SchemaInt, nil
+}
+
+// SchemaInt8OrErr returns the value of the "schema_int8" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) SchemaInt8OrErr() (schema.Int8, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[49] {
+		var zero schema.Int8
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldSchemaInt8}
+	}
+	return ft.SchemaInt8, nil
+}
+
+// SchemaInt64OrErr returns the value of the "schema_int64" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) SchemaInt64OrErr() (schema.Int64, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[50] {
+		var zero schema.Int64
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldSchemaInt64}
+	}
+	return ft.SchemaInt64, nil
+}
+
+// SchemaFloatOrErr returns the value of the "schema_float" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) SchemaFloatOrErr() (schema.Float64, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[51] {
+		var zero schema.Float64
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldSchemaFloat}
+	}
+	return ft.SchemaFloat, nil
+}
+
+// SchemaFloat32OrErr returns the value of the "schema_float32" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) SchemaFloat32OrErr() (schema.Float32, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[52] {
+		var zero schema.Float32
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldSchemaFloat32}
+	}
+	return ft.SchemaFloat32, nil
+}
+
+// NullFloatOrErr returns the value of the "null_float" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NullFloatOrErr() (*sql.NullFloat64, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[53] {
+		var zero *sql.NullFloat64
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNullFloat}
+	}
+	return ft.NullFloat, nil
+}
+
+// RoleOrErr returns the value of the "role" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) RoleOrErr() (role.Role, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[54] {
+		var zero role.Role
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldRole}
+	}
+	return ft.Role, nil
+}
+
+// PriorityOrErr returns the value of the "priority" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) PriorityOrErr() (role.Priority, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[55] {
+		var zero role.Priority
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldPriority}
+	}
+	return ft.Priority, nil
+}
+
+// OptionalUUIDOrErr returns the value of the "optional_uuid" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) OptionalUUIDOrErr() (uuid.UUID, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[56] {
+		var zero uuid.UUID
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldOptionalUUID}
+	}
+	return ft.OptionalUUID, nil
+}
+
+// NillableUUIDOrErr returns the value of the "nillable_uuid" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NillableUUIDOrErr() (*uuid.UUID, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[57] {
+		var zero *uuid.UUID
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNillableUUID}
+	}
+	return ft.NillableUUID, nil
+}
+
+// StringsOrErr returns the value of the "strings" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) StringsOrErr() ([]string, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[58] {
+		var zero []string
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldStrings}
+	}
+	return ft.Strings, nil
+}
+
+// PairOrErr returns the value of the "pair" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) PairOrErr() (schema.Pair, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[59] {
+		var zero schema.Pair
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldPair}
+	}
+	return ft.Pair, nil
+}
+
+// NilPairOrErr returns the value of the "nil_pair" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) NilPairOrErr() (*schema.Pair, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[60] {
+		var zero *schema.Pair
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldNilPair}
+	}
+	return ft.NilPair, nil
+}
+
+// VstringOrErr returns the value of the "vstring" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) VstringOrErr() (schema.VString, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[61] {
+		var zero schema.VString
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldVstring}
+	}
+	return ft.Vstring, nil
+}
+
+// TripleOrErr returns the value of the "triple" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) TripleOrErr() (schema.Triple, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[62] {
+		var zero schema.Triple
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldTriple}
+	}
+	return ft.Triple, nil
+}
+
+// BigIntOrErr returns the value of the "big_int" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) BigIntOrErr() (schema.BigInt, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[63] {
+		var zero schema.BigInt
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldBigInt}
+	}
+	return ft.BigInt, nil
+}
+
+// PasswordOtherOrErr returns the value of the "password_other" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FieldType.
+func (ft *FieldType) PasswordOtherOrErr() (schema.Password, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[64] {
+		var zero schema.Password
+		return zero, &NotSelectedError{label: fieldtype.Label, field: fieldtype.FieldPasswordOther}
+	}
+	return ft.PasswordOther, nil
+}
+
+// selectFields returns the fields of the FieldType that are included in the given
+// columns, or nil if all of them are included.
+func (*FieldType) selectFields(columns []string) *[65]bool {
+	var selected [65]bool
+	for _, c := range columns {
+		switch c {
+		case fieldtype.FieldInt:
+			selected[0] = true
+		case fieldtype.FieldInt8:
+			selected[1] = true
+		case fieldtype.FieldInt16:
+			selected[2] = true
+		case fieldtype.FieldInt32:
+			selected[3] = true
+		case fieldtype.FieldInt64:
+			selected[4] = true
+		case fieldtype.FieldOptionalInt:
+			selected[5] = true
+		case fieldtype.FieldOptionalInt8:
+			selected[6] = true
+		case fieldtype.FieldOptionalInt16:
+			selected[7] = true
+		case fieldtype.FieldOptionalInt32:
+			selected[8] = true
+		case fieldtype.FieldOptionalInt64:
+			selected[9] = true
+		case fieldtype.FieldNillableInt:
+			selected[10] = true
+		case fieldtype.FieldNillableInt8:
+			selected[11] = true
+		case fieldtype.FieldNillableInt16:
+			selected[12] = true
+		case fieldtype.FieldNillableInt32:
+			selected[13] = true
+		case fieldtype.FieldNillableInt64:
+			selected[14] = true
+		case fieldtype.FieldValidateOptionalInt32:
+			selected[15] = true
+		case fieldtype.FieldOptionalUint:
+			selected[16] = true
+		case fieldtype.FieldOptionalUint8:
+			selected[17] = true
+		case fieldtype.FieldOptionalUint16:
+			selected[18] = true
+		case fieldtype.FieldOptionalUint32:
+			selected[19] = true
+		case fieldtype.FieldOptionalUint64:
+			selected[20] = true
+		case fieldtype.FieldState:
+			selected[21] = true
+		case fieldtype.FieldOptionalFloat:
+			selected[22] = true
+		case fieldtype.FieldOptionalFloat32:
+			selected[23] = true
+		case fieldtype.FieldText:
+			selected[24] = true
+		case fieldtype.FieldDatetime:
+			selected[25] = true
+		case fieldtype.FieldDecimal:
+			selected[26] = true
+		case fieldtype.FieldLinkOther:
+			selected[27] = true
+		case fieldtype.FieldLinkOtherFunc:
+			selected[28] = true
+		case fieldtype.FieldMAC:
+			selected[29] = true
+		case fieldtype.FieldStringArray:
+			selected[30] = true
+		case fieldtype.FieldPassword:
+			selected[31] = true
+		case fieldtype.FieldStringScanner:
+			selected[32] = true
+		case fieldtype.FieldDuration:
+			selected[33] = true
+		case fieldtype.FieldDir:
+			selected[34] = true
+		case fieldtype.FieldNdir:
+			selected[35] = true
+		case fieldtype.FieldStr:
+			selected[36] = true
+		case fieldtype.FieldNullStr:
+			selected[37] = true
+		case fieldtype.FieldLink:
+			selected[38] = true
+		case fieldtype.FieldNullLink:
+			selected[39] = true
+		case fieldtype.FieldActive:
+			selected[40] = true
+		case fieldtype.FieldNullActive:
+			selected[41] = true
+		case fieldtype.FieldDeleted:
+			selected[42] = true
+		case fieldtype.FieldDeletedAt:
+			selected[43] = true
+		case fieldtype.FieldRawData:
+			selected[44] = true
+		case fieldtype.FieldSensitive:
+			selected[45] = true
+		case fieldtype.FieldIP:
+			selected[46] = true
+		case fieldtype.FieldNullInt64:
+			selected[47] = true
+		case fieldtype.FieldSchemaInt:
+			selected[48] = true
+		case fieldtype.FieldSchemaInt8:
+			selected[49] = true
+		case fieldtype.FieldSchemaInt64:
+			selected[50] = true
+		case fieldtype.FieldSchemaFloat:
+			selected[51] = true
+		case fieldtype.FieldSchemaFloat32:
+			selected[52] = true
+		case fieldtype.FieldNullFloat:
+			selected[53] = true
+		case fieldtype.FieldRole:
+			selected[54] = true
+		case fieldtype.FieldPriority:
+			selected[55] = true
+		case fieldtype.FieldOptionalUUID:
+			selected[56] = true
+		case fieldtype.FieldNillableUUID:
+			selected[57] = true
+		case fieldtype.FieldStrings:
+			selected[58] = true
+		case fieldtype.FieldPair:
+			selected[59] = true
+		case fieldtype.FieldNilPair:
+			selected[60] = true
+		case fieldtype.FieldVstring:
+			selected[61] = true
+		case fieldtype.FieldTriple:
+			selected[62] = true
+		case fieldtype.FieldBigInt:
+			selected[63] = true
+		case fieldtype.FieldPasswordOther:
+			selected[64] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // FieldTypes is a parsable slice of FieldType.
 type FieldTypes []*FieldType
 
@@ -12,9 +12,11 @@ import (
 	"net/http"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/entc/integration/ent/role"
 	"entgo.io/ent/entc/integration/ent/schema"
+	"entgo.io/ent/schema/field"
 )
 
 const (
@@ -338,4 +340,568 @@ func (s State) Ptr() *State {
 	return &s
 }
 
+// fieldInfos describes the fields of the FieldType type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "int",
+		Column:      FieldInt,
+		StructField: "Int",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "int8",
+		Column:      FieldInt8,
+		StructField: "Int8",
+		Type:        field.TypeInt8,
+		GoType:      "int8",
+	},
+	{
+		Name:        "int16",
+		Column:      FieldInt16,
+		StructField: "Int16",
+		Type:        field.TypeInt16,
+		GoType:      "int16",
+	},
+	{
+		Name:        "int32",
+		Column:      FieldInt32,
+		StructField: "Int32",
+		Type:        field.TypeInt32,
+		GoType:      "int32",
+	},
+	{
+		Name:        "int64",
+		Column:      FieldInt64,
+		StructField: "Int64",
+		Type:        field.TypeInt64,
+		GoType:      "int64",
+	},
+	{
+		Name:        "optional_int",
+		Column:      FieldOptionalInt,
+		StructField: "OptionalInt",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Optional:    true,
+	},
+	{
+		Name:        "optional_int8",
+		Column:      FieldOptionalInt8,
+		StructField: "OptionalInt8",
+		Type:        field.TypeInt8,
+		GoType:      "int8",
+		Optional:    true,
+	},
+	{
+		Name:        "optional_int16",
+		Column:      FieldOptionalInt16,
+		StructField: "OptionalInt16",
+		Type:        field.TypeInt16,
+		GoType:      "int16",
+		Optional:    true,
+	},
+	{
+		Name:        "optional_int32",
+		Column:      FieldOptionalInt32,
+		StructField: "OptionalInt32",
+		Type:        field.TypeInt32,
+		GoType:      "int32",
+		Optional:    true,
+	},
+	{
+		Name:        "optional_int64",
+		Column:      FieldOptionalInt64,
+		StructField: "OptionalInt64",
+		Type:        field.TypeInt64,
+		GoType:      "int64",
+		Optional:    true,
+	},
+	{
+		Name:        "nillable_int",
+		Column:      FieldNillableInt,
+		StructField: "NillableInt",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "nillable_int8",
+		Column:      FieldNillableInt8,
+		StructField: "NillableInt8",
+		Type:        field.TypeInt8,
+		GoType:      "int8",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "nillable_int16",
+		Column:      FieldNillableInt16,
+		StructField: "NillableInt16",
+		Type:        field.TypeInt16,
+		GoType:      "int16",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "nillable_int32",
+		Column:      FieldNillableInt32,
+		StructField: "NillableInt32",
+		Type:        field.TypeInt32,
+		GoType:      "int32",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "nillable_int64",
+		Column:      FieldNillableInt64,
+		StructField: "NillableInt64",
+		Type:        field.TypeInt64,
+		GoType:      "int64",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "validate_optional_int32",
+		Column:      FieldValidateOptionalInt32,
+		StructField: "ValidateOptionalInt32",
+		Type:        field.TypeInt32,
+		GoType:      "int32",
+		Optional:    true,
+	},
+	{
+		Name:        "optional_uint",
+		Column:      FieldOptionalUint,
+		StructField: "OptionalUint",
+		Type:        field.TypeUint,
+		GoType:      "uint",
+		Optional:    true,
+	},
+	{
+		Name:        "optional_uint8",
+		Column:      FieldOptionalUint8,
+		StructField: "OptionalUint8",
+		Type:        field.TypeUint8,
+		GoType:      "uint8",
+		Optional:    true,
+	},
+	{
+		Name:        "optional_uint16",
+		Column:      FieldOptionalUint16,
+		StructField: "OptionalUint16",
+		Type:        field.TypeUint16,
+		GoType:      "uint16",
+		Optional:    true,
+	},
+	{
+		Name:        "optional_uint32",
+		Column:      FieldOptionalUint32,
+		StructField: "OptionalUint32",
+		Type:        field.TypeUint32,
+		GoType:      "uint32",
+		Optional:    true,
+	},
+	{
+		Name:        "optional_uint64",
+		Column:      FieldOptionalUint64,
+		StructField: "OptionalUint64",
+		Type:        field.TypeUint64,
+		GoType:      "uint64",
+		Optional:    true,
+	},
+	{
+		Name:        "state",
+		Column:      FieldState,
+		StructField: "State",
+		Type:        field.TypeEnum,
+		GoType:      "fieldtype.State",
+		Enums:       []string{"on", "off"},
+		Optional:    true,
+	},
+	{
+		Name:        "optional_float",
+		Column:      FieldOptionalFloat,
+		StructField: "OptionalFloat",
+		Type:        field.TypeFloat64,
+		GoType:      "float64",
+		Optional:    true,
+	},
+	{
+		Name:        "optional_float32",
+		Column:      FieldOptionalFloat32,
+		StructField: "OptionalFloat32",
+		Type:        field.TypeFloat32,
+		GoType:      "float32",
+		Optional:    true,
+	},
+	{
+		Name:        "text",
+		Column:      FieldText,
+		StructField: "Text",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+	},
+	{
+		Name:        "datetime",
+		Column:      FieldDatetime,
+		StructField: "Datetime",
+		Type:        field.TypeTime,
+		GoType:      "time.Time",
+		Optional:    true,
+	},
+	{
+		Name:        "decimal",
+		Column:      FieldDecimal,
+		StructField: "Decimal",
+		Type:        field.TypeFloat64,
+		GoType:      "float64",
+		Optional:    true,
+	},
+	{
+		Name:        "link_other",
+		Column:      FieldLinkOther,
+		StructField: "LinkOther",
+		Type:        field.TypeOther,
+		GoType:      "*schema.Link",
+		Optional:    true,
+		Default:     true,
+	},
+	{
+		Name:        "link_other_func",
+		Column:      FieldLinkOtherFunc,
+		StructField: "LinkOtherFunc",
+		Type:        field.TypeOther,
+		GoType:      "*schema.Link",
+		Optional:    true,
+		Default:     true,
+	},
+	{
+		Name:        "mac",
+		Column:      FieldMAC,
+		StructField: "MAC",
+		Type:        field.TypeString,
+		GoType:      "schema.MAC",
+		Optional:    true,
+	},
+	{
+		Name:        "string_array",
+		Column:      FieldStringArray,
+		StructField: "StringArray",
+		Type:        field.TypeOther,
+		GoType:      "schema.Strings",
+		Optional:    true,
+	},
+	{
+		Name:        "password",
+		Column:      FieldPassword,
+		StructField: "Password",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+		Sensitive:   true,
+	},
+	{
+		Name:        "string_scanner",
+		Column:      FieldStringScanner,
+		StructField: "StringScanner",
+		Type:        field.TypeString,
+		GoType:      "schema.StringScanner",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "duration",
+		Column:      FieldDuration,
+		StructField: "Duration",
+		Type:        field.TypeInt64,
+		GoType:      "time.Duration",
+		Optional:    true,
+	},
+	{
+		Name:        "dir",
+		Column:      FieldDir,
+		StructField: "Dir",
+		Type:        field.TypeString,
+		GoType:      "http.Dir",
+		Default:     true,
+	},
+	{
+		Name:        "ndir",
+		Column:      FieldNdir,
+		StructField: "Ndir",
+		Type:        field.TypeString,
+		GoType:      "http.Dir",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "str",
+		Column:      FieldStr,
+		StructField: "Str",
+		Type:        field.TypeString,
+		GoType:      "sql.NullString",
+		Optional:    true,
+		Default:     true,
+	},
+	{
+		Name:        "null_str",
+		Column:      FieldNullStr,
+		StructField: "NullStr",
+		Type:        field.TypeString,
+		GoType:      "*sql.NullString",
+		Optional:    true,
+		Nillable:    true,
+		Default:     true,
+	},
+	{
+		Name:        "link",
+		Column:      FieldLink,
+		StructField: "Link",
+		Type:        field.TypeString,
+		GoType:      "schema.Link",
+		Optional:    true,
+	},
+	{
+		Name:        "null_link",
+		Column:      FieldNullLink,
+		StructField: "NullLink",
+		Type:        field.TypeString,
+		GoType:      "*schema.Link",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "active",
+		Column:      FieldActive,
+		StructField: "Active",
+		Type:        field.TypeBool,
+		GoType:      "schema.Status",
+		Optional:    true,
+	},
+	{
+		Name:        "null_active",
+		Column:      FieldNullActive,
+		StructField: "NullActive",
+		Type:        field.TypeBool,
+		GoType:      "schema.Status",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "deleted",
+		Column:      FieldDeleted,
+		StructField: "Deleted",
+		Type:        field.TypeBool,
+		GoType:      "*sql.NullBool",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "deleted_at",
+		Column:      FieldDeletedAt,
+		StructField: "DeletedAt",
+		Type:        field.TypeTime,
+		GoType:      "*sql.NullTime",
+		Optional:    true,
+		Default:     true,
+	},
+	{
+		Name:        "raw_data",
+		Column:      FieldRawData,
+		StructField: "RawData",
+		Type:        field.TypeBytes,
+		GoType:      "[]byte",
+		Optional:    true,
+	},
+	{
+		Name:        "sensitive",
+		Column:      FieldSensitive,
+		StructField: "Sensitive",
+		Type:        field.TypeBytes,
+		GoType:      "[]byte",
+		Optional:    true,
+		Sensitive:   true,
+	},
+	{
+		Name:        "ip",
+		Column:      FieldIP,
+		StructField: "IP",
+		Type:        field.TypeBytes,
+		GoType:      "net.IP",
+		Optional:    true,
+		Default:     true,
+	},
+	{
+		Name:        "null_int64",
+		Column:      FieldNullInt64,
+		StructField: "NullInt64",
+		Type:        field.TypeInt,
+		GoType:      "*sql.NullInt64",
+		Optional:    true,
+	},
+	{
+		Name:        "schema_int",
+		Column:      FieldSchemaInt,
+		StructField: "SchemaInt",
+		Type:        field.TypeInt,
+		GoType:      "schema.Int",
+		Optional:    true,
+	},
+	{
+		Name:        "schema_int8",
+		Column:      FieldSchemaInt8,
+		StructField: "SchemaInt8",
+		Type:        field.TypeInt8,
+		GoType:      "schema.Int8",
+		Optional:    true,
+	},
+	{
+		Name:        "schema_int64",
+		Column:      FieldSchemaInt64,
+		StructField: "SchemaInt64",
+		Type:        field.TypeInt64,
+		GoType:      "schema.Int64",
+		Optional:    true,
+	},
+	{
+		Name:        "schema_float",
+		Column:      FieldSchemaFloat,
+		StructField: "SchemaFloat",
+		Type:        field.TypeFloat64,
+		GoType:      "schema.Float64",
+		Optional:    true,
+	},
+	{
+		Name:        "schema_float32",
+		Column:      FieldSchemaFloat32,
+		StructField: "SchemaFloat32",
+		Type:        field.TypeFloat32,
+		GoType:      "schema.Float32",
+		Optional:    true,
+	},
+	{
+		Name:        "null_float",
+		Column:      FieldNullFloat,
+		StructField: "NullFloat",
+		Type:        field.TypeFloat64,
+		GoType:      "*sql.NullFloat64",
+		Optional:    true,
+	},
+	{
+		Name:        "role",
+		Column:      FieldRole,
+		StructField: "Role",
+		Type:        field.TypeEnum,
+		GoType:      "role.Role",
+		Enums:       []string{"ADMIN", "OWNER", "USER", "READ", "WRITE", "READ+WRITE"},
+		Default:     true,
+	},
+	{
+		Name:        "priority",
+		Column:      FieldPriority,
+		StructField: "Priority",
+		Type:        field.TypeEnum,
+		GoType:      "role.Priority",
+		Enums:       []string{"UNKNOWN", "LOW", "HIGH"},
+		Optional:    true,
+	},
+	{
+		Name:        "optional_uuid",
+		Column:      FieldOptionalUUID,
+		StructField: "OptionalUUID",
+		Type:        field.TypeUUID,
+		GoType:      "uuid.UUID",
+		Optional:    true,
+	},
+	{
+		Name:        "nillable_uuid",
+		Column:      FieldNillableUUID,
+		StructField: "NillableUUID",
+		Type:        field.TypeUUID,
+		GoType:      "uuid.UUID",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "strings",
+		Column:      FieldStrings,
+		StructField: "Strings",
+		Type:        field.TypeJSON,
+		GoType:      "[]string",
+		Optional:    true,
+	},
+	{
+		Name:        "pair",
+		Column:      FieldPair,
+		StructField: "Pair",
+		Type:        field.TypeBytes,
+		GoType:      "schema.Pair",
+		Default:     true,
+	},
+	{
+		Name:        "nil_pair",
+		Column:      FieldNilPair,
+		StructField: "NilPair",
+		Type:        field.TypeBytes,
+		GoType:      "*schema.Pair",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "vstring",
+		Column:      FieldVstring,
+		StructField: "Vstring",
+		Type:        field.TypeString,
+		GoType:      "schema.VString",
+		Default:     true,
+	},
+	{
+		Name:        "triple",
+		Column:      FieldTriple,
+		StructField: "Triple",
+		Type:        field.TypeString,
+		GoType:      "schema.Triple",
+		Default:     true,
+	},
+	{
+		Name:        "big_int",
+		Column:      FieldBigInt,
+		StructField: "BigInt",
+		Type:        field.TypeInt,
+		GoType:      "schema.BigInt",
+		Optional:    true,
+	},
+	{
+		Name:        "password_other",
+		Column:      FieldPasswordOther,
+		StructField: "PasswordOther",
+		Type:        field.TypeOther,
+		GoType:      "schema.Password",
+		Optional:    true,
+		Sensitive:   true,
+	},
+}
+
+// Fields returns the information of the FieldType fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+}
+
 // comment from another template.
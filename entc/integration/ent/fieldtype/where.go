@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/role"
 	"entgo.io/ent/entc/integration/ent/schema"
@@ -5649,6 +5650,126 @@ func PasswordOtherNotNil() predicate.FieldType {
 	})
 }
 
+// StringsHasKey applies the HasKey predicate on the "strings" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func StringsHasKey(path string) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldStrings), sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueIsNull applies the ValueIsNull predicate on the "strings" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func StringsValueIsNull(path string) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldStrings), sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueEQ applies the ValueEQ predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueEQ(path string, v interface{}) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueNEQ applies the ValueNEQ predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueNEQ(path string, v interface{}) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueGT applies the ValueGT predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueGT(path string, v interface{}) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueGTE applies the ValueGTE predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueGTE(path string, v interface{}) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueLT applies the ValueLT predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueLT(path string, v interface{}) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueLTE applies the ValueLTE predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueLTE(path string, v interface{}) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueContains applies the ValueContains predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueContains(path string, v interface{}) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenEQ applies the LenEQ predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenEQ(path string, n int) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenNEQ(path string, n int) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenGT applies the LenGT predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenGT(path string, n int) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenGTE applies the LenGTE predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenGTE(path string, n int) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenLT applies the LenLT predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenLT(path string, n int) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenLTE applies the LenLTE predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenLTE(path string, n int) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.FieldType) predicate.FieldType {
 	return predicate.FieldType(func(s *sql.Selector) {
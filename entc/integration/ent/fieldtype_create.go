@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -1540,6 +1541,68 @@ func (ftc *FieldTypeCreate) createSpec() (*FieldType, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// FieldTypeInsertSelect is the builder for creating FieldType entities from the result of a query.
+type FieldTypeInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the FieldType table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+// For example:
+//
+//	client.FieldType.CreateFromQuery(query.Select(...)).
+//		Columns(fieldtype.FieldInt).
+//		Exec(ctx)
+//
+func (ftis *FieldTypeInsertSelect) Columns(columns ...string) *FieldTypeInsertSelect {
+	ftis.columns = append(ftis.columns, columns...)
+	return ftis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (ftis *FieldTypeInsertSelect) Exec(ctx context.Context) (int, error) {
+	if ftis.query == nil {
+		return 0, errors.New("ent: missing query for FieldType.CreateFromQuery")
+	}
+	if err := ftis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := ftis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := ftis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !fieldtype.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for FieldType.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: fieldtype.Table, Columns: fieldtype.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, ftis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftis *FieldTypeInsertSelect) ExecX(ctx context.Context) int {
+	n, err := ftis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -1583,6 +1646,7 @@ type (
 	//  one FieldType node.
 	FieldTypeUpsertOne struct {
 		create *FieldTypeCreate
+		err    error // first validation error of the update values.
 	}
 
 	// FieldTypeUpsert is the "OnConflict" setter.
@@ -3314,8 +3378,12 @@ func (u *FieldTypeUpsertOne) ClearNillableInt64() *FieldTypeUpsertOne {
 	})
 }
 
-// SetValidateOptionalInt32 sets the "validate_optional_int32" field.
+// SetValidateOptionalInt32 sets the "validate_optional_int32" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertOne) SetValidateOptionalInt32(v int32) *FieldTypeUpsertOne {
+	if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetValidateOptionalInt32(v)
 	})
@@ -3482,8 +3550,12 @@ func (u *FieldTypeUpsertOne) ClearOptionalUint64() *FieldTypeUpsertOne {
 	})
 }
 
-// SetState sets the "state" field.
+// SetState sets the "state" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertOne) SetState(v fieldtype.State) *FieldTypeUpsertOne {
+	if err := fieldtype.StateValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetState(v)
 	})
@@ -3671,8 +3743,12 @@ func (u *FieldTypeUpsertOne) ClearLinkOtherFunc() *FieldTypeUpsertOne {
 	})
 }
 
-// SetMAC sets the "mac" field.
+// SetMAC sets the "mac" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertOne) SetMAC(v schema.MAC) *FieldTypeUpsertOne {
+	if err := fieldtype.MACValidator(v.String()); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetMAC(v)
 	})
@@ -3797,8 +3873,12 @@ func (u *FieldTypeUpsertOne) UpdateDir() *FieldTypeUpsertOne {
 	})
 }
 
-// SetNdir sets the "ndir" field.
+// SetNdir sets the "ndir" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertOne) SetNdir(v http.Dir) *FieldTypeUpsertOne {
+	if err := fieldtype.NdirValidator(string(v)); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetNdir(v)
 	})
@@ -3860,8 +3940,12 @@ func (u *FieldTypeUpsertOne) ClearNullStr() *FieldTypeUpsertOne {
 	})
 }
 
-// SetLink sets the "link" field.
+// SetLink sets the "link" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertOne) SetLink(v schema.Link) *FieldTypeUpsertOne {
+	if err := fieldtype.LinkValidator(v.String()); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetLink(v)
 	})
@@ -3986,8 +4070,12 @@ func (u *FieldTypeUpsertOne) ClearDeletedAt() *FieldTypeUpsertOne {
 	})
 }
 
-// SetRawData sets the "raw_data" field.
+// SetRawData sets the "raw_data" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertOne) SetRawData(v []byte) *FieldTypeUpsertOne {
+	if err := fieldtype.RawDataValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetRawData(v)
 	})
@@ -4028,8 +4116,12 @@ func (u *FieldTypeUpsertOne) ClearSensitive() *FieldTypeUpsertOne {
 	})
 }
 
-// SetIP sets the "ip" field.
+// SetIP sets the "ip" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertOne) SetIP(v net.IP) *FieldTypeUpsertOne {
+	if err := fieldtype.IPValidator([]byte(v)); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetIP(v)
 	})
@@ -4231,8 +4323,12 @@ func (u *FieldTypeUpsertOne) ClearNullFloat() *FieldTypeUpsertOne {
 	})
 }
 
-// SetRole sets the "role" field.
+// SetRole sets the "role" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertOne) SetRole(v role.Role) *FieldTypeUpsertOne {
+	if err := fieldtype.RoleValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetRole(v)
 	})
@@ -4245,8 +4341,12 @@ func (u *FieldTypeUpsertOne) UpdateRole() *FieldTypeUpsertOne {
 	})
 }
 
-// SetPriority sets the "priority" field.
+// SetPriority sets the "priority" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertOne) SetPriority(v role.Priority) *FieldTypeUpsertOne {
+	if err := fieldtype.PriorityValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetPriority(v)
 	})
@@ -4443,6 +4543,9 @@ func (u *FieldTypeUpsertOne) ClearPasswordOther() *FieldTypeUpsertOne {
 
 // Exec executes the query.
 func (u *FieldTypeUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for FieldTypeCreate.OnConflict")
 	}
@@ -4451,13 +4554,16 @@ func (u *FieldTypeUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *FieldTypeUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *FieldTypeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -4474,6 +4580,43 @@ func (u *FieldTypeUpsertOne) IDX(ctx context.Context) int {
 	return id
 }
 
+// SaveAsync adds the creation of the FieldType entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (ftc *FieldTypeCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, ftc.config, ftc.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the FieldType creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (ftc *FieldTypeCreate) IdempotencyKey(key string) *FieldTypeCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &ftc.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*FieldType)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from FieldTypeMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id int
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&FieldTypeClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	ftc.hooks = append([]Hook{hook}, ftc.hooks...)
+	return ftc
+}
+
 // FieldTypeCreateBulk is the builder for creating many FieldType entities in bulk.
 type FieldTypeCreateBulk struct {
 	config
@@ -4560,6 +4703,78 @@ func (ftcb *FieldTypeCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// FieldTypeBulkResult holds the result of creating one of the FieldType entities using FieldTypeCreateBulk.ContinueOnError.
+type FieldTypeBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *FieldType
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the FieldType entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.FieldType.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (ftcb *FieldTypeCreateBulk) ContinueOnError(ctx context.Context) ([]FieldTypeBulkResult, error) {
+	client := &Client{config: ftcb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]FieldTypeBulkResult, len(ftcb.builders))
+	for i, builder := range ftcb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = ftcb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -4601,6 +4816,7 @@ func (ftcb *FieldTypeCreateBulk) OnConflictColumns(columns ...string) *FieldType
 // a bulk of FieldType nodes.
 type FieldTypeUpsertBulk struct {
 	create *FieldTypeCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -5030,8 +5246,12 @@ func (u *FieldTypeUpsertBulk) ClearNillableInt64() *FieldTypeUpsertBulk {
 	})
 }
 
-// SetValidateOptionalInt32 sets the "validate_optional_int32" field.
+// SetValidateOptionalInt32 sets the "validate_optional_int32" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertBulk) SetValidateOptionalInt32(v int32) *FieldTypeUpsertBulk {
+	if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetValidateOptionalInt32(v)
 	})
@@ -5198,8 +5418,12 @@ func (u *FieldTypeUpsertBulk) ClearOptionalUint64() *FieldTypeUpsertBulk {
 	})
 }
 
-// SetState sets the "state" field.
+// SetState sets the "state" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertBulk) SetState(v fieldtype.State) *FieldTypeUpsertBulk {
+	if err := fieldtype.StateValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetState(v)
 	})
@@ -5387,8 +5611,12 @@ func (u *FieldTypeUpsertBulk) ClearLinkOtherFunc() *FieldTypeUpsertBulk {
 	})
 }
 
-// SetMAC sets the "mac" field.
+// SetMAC sets the "mac" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertBulk) SetMAC(v schema.MAC) *FieldTypeUpsertBulk {
+	if err := fieldtype.MACValidator(v.String()); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetMAC(v)
 	})
@@ -5513,8 +5741,12 @@ func (u *FieldTypeUpsertBulk) UpdateDir() *FieldTypeUpsertBulk {
 	})
 }
 
-// SetNdir sets the "ndir" field.
+// SetNdir sets the "ndir" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertBulk) SetNdir(v http.Dir) *FieldTypeUpsertBulk {
+	if err := fieldtype.NdirValidator(string(v)); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetNdir(v)
 	})
@@ -5576,8 +5808,12 @@ func (u *FieldTypeUpsertBulk) ClearNullStr() *FieldTypeUpsertBulk {
 	})
 }
 
-// SetLink sets the "link" field.
+// SetLink sets the "link" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertBulk) SetLink(v schema.Link) *FieldTypeUpsertBulk {
+	if err := fieldtype.LinkValidator(v.String()); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetLink(v)
 	})
@@ -5702,8 +5938,12 @@ func (u *FieldTypeUpsertBulk) ClearDeletedAt() *FieldTypeUpsertBulk {
 	})
 }
 
-// SetRawData sets the "raw_data" field.
+// SetRawData sets the "raw_data" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertBulk) SetRawData(v []byte) *FieldTypeUpsertBulk {
+	if err := fieldtype.RawDataValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetRawData(v)
 	})
@@ -5744,8 +5984,12 @@ func (u *FieldTypeUpsertBulk) ClearSensitive() *FieldTypeUpsertBulk {
 	})
 }
 
-// SetIP sets the "ip" field.
+// SetIP sets the "ip" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertBulk) SetIP(v net.IP) *FieldTypeUpsertBulk {
+	if err := fieldtype.IPValidator([]byte(v)); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetIP(v)
 	})
@@ -5947,8 +6191,12 @@ func (u *FieldTypeUpsertBulk) ClearNullFloat() *FieldTypeUpsertBulk {
 	})
 }
 
-// SetRole sets the "role" field.
+// SetRole sets the "role" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertBulk) SetRole(v role.Role) *FieldTypeUpsertBulk {
+	if err := fieldtype.RoleValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetRole(v)
 	})
@@ -5961,8 +6209,12 @@ func (u *FieldTypeUpsertBulk) UpdateRole() *FieldTypeUpsertBulk {
 	})
 }
 
-// SetPriority sets the "priority" field.
+// SetPriority sets the "priority" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FieldTypeUpsertBulk) SetPriority(v role.Priority) *FieldTypeUpsertBulk {
+	if err := fieldtype.PriorityValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+	}
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.SetPriority(v)
 	})
@@ -6159,6 +6411,9 @@ func (u *FieldTypeUpsertBulk) ClearPasswordOther() *FieldTypeUpsertBulk {
 
 // Exec executes the query.
 func (u *FieldTypeUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FieldTypeCreateBulk instead", i)
@@ -6172,7 +6427,7 @@ func (u *FieldTypeUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *FieldTypeUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -95,6 +95,19 @@ func (ftd *FieldTypeDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the FieldType entities that are joined with the rows of the given table, on the given columns
+// of the FieldType table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.FieldType.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (ftd *FieldTypeDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *FieldTypeDelete {
+	ftd.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return ftd
+}
+
 // FieldTypeDeleteOne is the builder for deleting a single FieldType entity.
 type FieldTypeDeleteOne struct {
 	ftd *FieldTypeDelete
@@ -107,7 +120,7 @@ func (ftdo *FieldTypeDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{fieldtype.Label}
+		return &NotFoundError{label: fieldtype.Label}
 	default:
 		return nil
 	}
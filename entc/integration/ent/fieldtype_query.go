@@ -8,28 +8,40 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"net"
+	"net/http"
+	"strings"
+	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqltime"
 	"entgo.io/ent/entc/integration/ent/fieldtype"
 	"entgo.io/ent/entc/integration/ent/predicate"
+	"entgo.io/ent/entc/integration/ent/role"
+	"entgo.io/ent/entc/integration/ent/schema"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // FieldTypeQuery is the builder for querying FieldType entities.
 type FieldTypeQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.FieldType
-	withFKs    bool
-	modifiers  []func(*sql.Selector)
+	limit        *int
+	offset       *int
+	unique       *bool
+	order        []OrderFunc
+	fields       []string
+	inters       []Interceptor
+	predicates   []predicate.FieldType
+	withFKs      bool
+	loadStrategy LoadStrategy
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -74,7 +86,7 @@ func (ftq *FieldTypeQuery) First(ctx context.Context) (*FieldType, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{fieldtype.Label}
+		return nil, ftq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -96,7 +108,7 @@ func (ftq *FieldTypeQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{fieldtype.Label}
+		err = ftq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -115,6 +127,11 @@ func (ftq *FieldTypeQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one FieldType entity is found.
 // Returns a *NotFoundError when no FieldType entities are found.
 func (ftq *FieldTypeQuery) Only(ctx context.Context) (*FieldType, error) {
+	if ftq.singleflight != nil {
+		if _, ok := ftq.driver.(*txDriver); !ok {
+			return ftq.onlyShared(ctx)
+		}
+	}
 	nodes, err := ftq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -123,7 +140,7 @@ func (ftq *FieldTypeQuery) Only(ctx context.Context) (*FieldType, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{fieldtype.Label}
+		return nil, ftq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{fieldtype.Label}
 	}
@@ -150,7 +167,7 @@ func (ftq *FieldTypeQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{fieldtype.Label}
+		err = ftq.sqlNotFound()
 	default:
 		err = &NotSingularError{fieldtype.Label}
 	}
@@ -171,7 +188,20 @@ func (ftq *FieldTypeQuery) All(ctx context.Context) ([]*FieldType, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ftq.sqlAll(ctx)
+	if p := ftq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return ftq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := ftq.intercept(ctx, "All", func(ctx context.Context, query *FieldTypeQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*FieldType)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*FieldType", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -185,10 +215,20 @@ func (ftq *FieldTypeQuery) AllX(ctx context.Context) []*FieldType {
 
 // IDs executes the query and returns a list of FieldType IDs.
 func (ftq *FieldTypeQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := ftq.Select(fieldtype.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := ftq.intercept(ctx, "IDs", func(ctx context.Context, query *FieldTypeQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(fieldtype.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -206,7 +246,17 @@ func (ftq *FieldTypeQuery) Count(ctx context.Context) (int, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ftq.sqlCount(ctx)
+	v, err := ftq.intercept(ctx, "Count", func(ctx context.Context, query *FieldTypeQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -223,7 +273,47 @@ func (ftq *FieldTypeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ftq.sqlExist(ctx)
+	v, err := ftq.intercept(ctx, "Exist", func(ctx context.Context, query *FieldTypeQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (ftq *FieldTypeQuery) intercept(ctx context.Context, op string, fn func(context.Context, *FieldTypeQuery) (Value, error)) (Value, error) {
+	if len(ftq.inters) == 0 {
+		return fn(ctx, ftq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeFieldType,
+		Op:     op,
+		Limit:  ftq.limit,
+		Offset: ftq.offset,
+		Unique: ftq.unique,
+		Fields: ftq.fields,
+	}
+	for _, p := range ftq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range ftq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FieldTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *FieldTypeQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, ftq, qc, qr, ftq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -246,11 +336,13 @@ func (ftq *FieldTypeQuery) Clone() *FieldTypeQuery {
 		limit:      ftq.limit,
 		offset:     ftq.offset,
 		order:      append([]OrderFunc{}, ftq.order...),
+		inters:     append([]Interceptor{}, ftq.inters...),
 		predicates: append([]predicate.FieldType{}, ftq.predicates...),
 		// clone intermediate query.
-		sql:    ftq.sql.Clone(),
-		path:   ftq.path,
-		unique: ftq.unique,
+		sql:          ftq.sql.Clone(),
+		path:         ftq.path,
+		unique:       ftq.unique,
+		loadStrategy: ftq.loadStrategy,
 	}
 }
 
@@ -310,6 +402,16 @@ func (ftq *FieldTypeQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range ftq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ftq); err != nil {
+				return err
+			}
+		}
+	}
 	if ftq.path != nil {
 		prev, err := ftq.path(ctx)
 		if err != nil {
@@ -329,12 +431,22 @@ func (ftq *FieldTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*F
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, fieldtype.ForeignKeys...)
 	}
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[65]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*FieldType).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []interface{}) error {
 		node := &FieldType{config: ftq.config}
 		nodes = append(nodes, node)
+		if !computed {
+			selected, computed = (*FieldType).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(ftq.modifiers) > 0 {
@@ -343,7 +455,7 @@ func (ftq *FieldTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*F
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, ftq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, ftq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -361,7 +473,7 @@ func (ftq *FieldTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(ftq.fields) > 0 {
 		_spec.Unique = ftq.unique != nil && *ftq.unique
 	}
-	return sqlgraph.CountNodes(ctx, ftq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, ftq.queryDriver(), _spec)
 }
 
 func (ftq *FieldTypeQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -455,6 +567,204 @@ func (ftq *FieldTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (ftq *FieldTypeQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: fieldtype.Label}
+	if len(ftq.predicates) > 0 {
+		selector := sql.Dialect(ftq.driver.Dialect()).Select().From(sql.Table(fieldtype.Table))
+		for _, p := range ftq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the FieldTypeQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (ftq *FieldTypeQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.FieldType, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.FieldType(ps[i]))
+	}
+	ftq.predicates = append(ftq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (ftq *FieldTypeQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := ftq.querySpec()
+	if len(ftq.modifiers) > 0 {
+		_spec.Modifiers = ftq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = ftq.unique != nil && *ftq.unique
+	return sqlgraph.EstimateNodes(ctx, ftq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (ftq *FieldTypeQuery) CountEstimateX(ctx context.Context) int {
+	count, err := ftq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// FieldType rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (ftq *FieldTypeQuery) Sample(percent float64) *FieldTypeQuery {
+	ftq.predicates = append(ftq.predicates, sql.Sample(percent))
+	return ftq
+}
+
+// FieldTypeIterator iterates over the results of a FieldType query, and decodes them one at a time.
+type FieldTypeIterator struct {
+	ctx   context.Context
+	query *FieldTypeQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*FieldType
+	node  *FieldType
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the FieldType entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.FieldType.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (ftq *FieldTypeQuery) Iterate(ctx context.Context, opts ...IterateOption) (*FieldTypeIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &FieldTypeIterator{ctx: ctx, query: ftq, batch: o.batch}
+	var (
+		withFKs = ftq.withFKs
+		_spec   = ftq.querySpec()
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, fieldtype.ForeignKeys...)
+	}
+	var (
+		selected *[65]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*FieldType).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &FieldType{config: ftq.config}
+		it.nodes = append(it.nodes, node)
+		if !computed {
+			selected, computed = (*FieldType).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(ftq.modifiers) > 0 {
+		_spec.Modifiers = ftq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, ftq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (ftq *FieldTypeQuery) iterateLoad(ctx context.Context, nodes []*FieldType) ([]*FieldType, error) {
+	return nodes, nil
+}
+
+// Next advances the iterator to the next FieldType, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *FieldTypeIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*FieldType, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current FieldType of the iterator.
+func (it *FieldTypeIterator) Value() *FieldType {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *FieldTypeIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *FieldTypeIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// LoadStrategy sets the strategy for eager-loading the FieldType entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (ftq *FieldTypeQuery) LoadStrategy(s LoadStrategy) *FieldTypeQuery {
+	ftq.loadStrategy = s
+	return ftq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (ftq *FieldTypeQuery) joinable() bool {
+	if ftq.loadStrategy != LoadStrategyJoin || ftq.limit != nil || ftq.offset != nil {
+		return false
+	}
+	if len(ftq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range ftq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -487,6 +797,740 @@ func (ftq *FieldTypeQuery) Modify(modifiers ...func(s *sql.Selector)) *FieldType
 	return ftq.Select()
 }
 
+// Project returns a builder for querying the given fields into FieldTypeProjection structs,
+// instead of full FieldType entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.FieldType.Query().
+//		Project(fieldtype.FieldID, fieldtype.FieldInt).
+//		All(ctx)
+//
+func (ftq *FieldTypeQuery) Project(fields ...string) *FieldTypeProject {
+	ftq.fields = append(ftq.fields, fields...)
+	return &FieldTypeProject{query: ftq}
+}
+
+// FieldTypeProjection is a lightweight projection of the FieldType entity that is returned by
+// FieldTypeProject. Fields that were not selected by the query hold their zero values.
+type FieldTypeProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Int holds the value of the "int" field.
+	Int int `json:"int,omitempty"`
+	// Int8 holds the value of the "int8" field.
+	Int8 int8 `json:"int8,omitempty"`
+	// Int16 holds the value of the "int16" field.
+	Int16 int16 `json:"int16,omitempty"`
+	// Int32 holds the value of the "int32" field.
+	Int32 int32 `json:"int32,omitempty"`
+	// Int64 holds the value of the "int64" field.
+	Int64 int64 `json:"int64,omitempty"`
+	// OptionalInt holds the value of the "optional_int" field.
+	OptionalInt int `json:"optional_int,omitempty"`
+	// OptionalInt8 holds the value of the "optional_int8" field.
+	OptionalInt8 int8 `json:"optional_int8,omitempty"`
+	// OptionalInt16 holds the value of the "optional_int16" field.
+	OptionalInt16 int16 `json:"optional_int16,omitempty"`
+	// OptionalInt32 holds the value of the "optional_int32" field.
+	OptionalInt32 int32 `json:"optional_int32,omitempty"`
+	// OptionalInt64 holds the value of the "optional_int64" field.
+	OptionalInt64 int64 `json:"optional_int64,omitempty"`
+	// NillableInt holds the value of the "nillable_int" field.
+	NillableInt *int `json:"nillable_int,omitempty"`
+	// NillableInt8 holds the value of the "nillable_int8" field.
+	NillableInt8 *int8 `json:"nillable_int8,omitempty"`
+	// NillableInt16 holds the value of the "nillable_int16" field.
+	NillableInt16 *int16 `json:"nillable_int16,omitempty"`
+	// NillableInt32 holds the value of the "nillable_int32" field.
+	NillableInt32 *int32 `json:"nillable_int32,omitempty"`
+	// NillableInt64 holds the value of the "nillable_int64" field.
+	NillableInt64 *int64 `json:"nillable_int64,omitempty"`
+	// ValidateOptionalInt32 holds the value of the "validate_optional_int32" field.
+	ValidateOptionalInt32 int32 `json:"validate_optional_int32,omitempty"`
+	// OptionalUint holds the value of the "optional_uint" field.
+	OptionalUint uint `json:"optional_uint,omitempty"`
+	// OptionalUint8 holds the value of the "optional_uint8" field.
+	OptionalUint8 uint8 `json:"optional_uint8,omitempty"`
+	// OptionalUint16 holds the value of the "optional_uint16" field.
+	OptionalUint16 uint16 `json:"optional_uint16,omitempty"`
+	// OptionalUint32 holds the value of the "optional_uint32" field.
+	OptionalUint32 uint32 `json:"optional_uint32,omitempty"`
+	// OptionalUint64 holds the value of the "optional_uint64" field.
+	OptionalUint64 uint64 `json:"optional_uint64,omitempty"`
+	// State holds the value of the "state" field.
+	State fieldtype.State `json:"state,omitempty"`
+	// OptionalFloat holds the value of the "optional_float" field.
+	OptionalFloat float64 `json:"optional_float,omitempty"`
+	// OptionalFloat32 holds the value of the "optional_float32" field.
+	OptionalFloat32 float32 `json:"optional_float32,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Datetime holds the value of the "datetime" field.
+	Datetime time.Time `json:"datetime,omitempty"`
+	// Decimal holds the value of the "decimal" field.
+	Decimal float64 `json:"decimal,omitempty"`
+	// LinkOther holds the value of the "link_other" field.
+	LinkOther *schema.Link `json:"link_other,omitempty"`
+	// LinkOtherFunc holds the value of the "link_other_func" field.
+	LinkOtherFunc *schema.Link `json:"link_other_func,omitempty"`
+	// MAC holds the value of the "mac" field.
+	MAC schema.MAC `json:"mac,omitempty"`
+	// StringArray holds the value of the "string_array" field.
+	StringArray schema.Strings `json:"string_array,omitempty"`
+	// Password holds the value of the "password" field.
+	Password string `json:"-"`
+	// StringScanner holds the value of the "string_scanner" field.
+	StringScanner *schema.StringScanner `json:"string_scanner,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Dir holds the value of the "dir" field.
+	Dir http.Dir `json:"dir,omitempty"`
+	// Ndir holds the value of the "ndir" field.
+	Ndir *http.Dir `json:"ndir,omitempty"`
+	// Str holds the value of the "str" field.
+	Str sql.NullString `json:"str,omitempty"`
+	// NullStr holds the value of the "null_str" field.
+	NullStr *sql.NullString `json:"null_str,omitempty"`
+	// Link holds the value of the "link" field.
+	Link schema.Link `json:"link,omitempty"`
+	// NullLink holds the value of the "null_link" field.
+	NullLink *schema.Link `json:"null_link,omitempty"`
+	// Active holds the value of the "active" field.
+	Active schema.Status `json:"active,omitempty"`
+	// NullActive holds the value of the "null_active" field.
+	NullActive *schema.Status `json:"null_active,omitempty"`
+	// Deleted holds the value of the "deleted" field.
+	Deleted *sql.NullBool `json:"deleted,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *sql.NullTime `json:"deleted_at,omitempty"`
+	// RawData holds the value of the "raw_data" field.
+	RawData []byte `json:"raw_data,omitempty"`
+	// Sensitive holds the value of the "sensitive" field.
+	Sensitive []byte `json:"-"`
+	// IP holds the value of the "ip" field.
+	IP net.IP `json:"ip,omitempty"`
+	// NullInt64 holds the value of the "null_int64" field.
+	NullInt64 *sql.NullInt64 `json:"null_int64,omitempty"`
+	// SchemaInt holds the value of the "schema_int" field.
+	SchemaInt schema.Int `json:"schema_int,omitempty"`
+	// SchemaInt8 holds the value of the "schema_int8" field.
+	SchemaInt8 schema.Int8 `json:"schema_int8,omitempty"`
+	// SchemaInt64 holds the value of the "schema_int64" field.
+	SchemaInt64 schema.Int64 `json:"schema_int64,omitempty"`
+	// SchemaFloat holds the value of the "schema_float" field.
+	SchemaFloat schema.Float64 `json:"schema_float,omitempty"`
+	// SchemaFloat32 holds the value of the "schema_float32" field.
+	SchemaFloat32 schema.Float32 `json:"schema_float32,omitempty"`
+	// NullFloat holds the value of the "null_float" field.
+	NullFloat *sql.NullFloat64 `json:"null_float,omitempty"`
+	// Role holds the value of the "role" field.
+	Role role.Role `json:"role,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority role.Priority `json:"priority,omitempty"`
+	// OptionalUUID holds the value of the "optional_uuid" field.
+	OptionalUUID uuid.UUID `json:"optional_uuid,omitempty"`
+	// NillableUUID holds the value of the "nillable_uuid" field.
+	NillableUUID *uuid.UUID `json:"nillable_uuid,omitempty"`
+	// Strings holds the value of the "strings" field.
+	Strings []string `json:"strings,omitempty"`
+	// Pair holds the value of the "pair" field.
+	Pair schema.Pair `json:"pair,omitempty"`
+	// NilPair holds the value of the "nil_pair" field.
+	NilPair *schema.Pair `json:"nil_pair,omitempty"`
+	// Vstring holds the value of the "vstring" field.
+	Vstring schema.VString `json:"vstring,omitempty"`
+	// Triple holds the value of the "triple" field.
+	Triple schema.Triple `json:"triple,omitempty"`
+	// BigInt holds the value of the "big_int" field.
+	BigInt schema.BigInt `json:"big_int,omitempty"`
+	// PasswordOther holds the value of the "password_other" field.
+	PasswordOther schema.Password `json:"-"`
+}
+
+// FieldTypeProject is the builder for querying FieldType fields into projections.
+type FieldTypeProject struct {
+	query *FieldTypeQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (ftp *FieldTypeProject) All(ctx context.Context) ([]*FieldTypeProjection, error) {
+	if err := ftp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := ftp.query.fields
+	if len(columns) == 0 {
+		columns = fieldtype.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := ftp.query.sqlQuery(ctx).Query()
+	if err := ftp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &FieldType{}
+		result []*FieldTypeProjection
+	)
+	for rows.Next() {
+		*e = FieldType{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &FieldTypeProjection{
+			ID:                    e.ID,
+			Int:                   e.Int,
+			Int8:                  e.Int8,
+			Int16:                 e.Int16,
+			Int32:                 e.Int32,
+			Int64:                 e.Int64,
+			OptionalInt:           e.OptionalInt,
+			OptionalInt8:          e.OptionalInt8,
+			OptionalInt16:         e.OptionalInt16,
+			OptionalInt32:         e.OptionalInt32,
+			OptionalInt64:         e.OptionalInt64,
+			NillableInt:           e.NillableInt,
+			NillableInt8:          e.NillableInt8,
+			NillableInt16:         e.NillableInt16,
+			NillableInt32:         e.NillableInt32,
+			NillableInt64:         e.NillableInt64,
+			ValidateOptionalInt32: e.ValidateOptionalInt32,
+			OptionalUint:          e.OptionalUint,
+			OptionalUint8:         e.OptionalUint8,
+			OptionalUint16:        e.OptionalUint16,
+			OptionalUint32:        e.OptionalUint32,
+			OptionalUint64:        e.OptionalUint64,
+			State:                 e.State,
+			OptionalFloat:         e.OptionalFloat,
+			OptionalFloat32:       e.OptionalFloat32,
+			Text:                  e.Text,
+			Datetime:              e.Datetime,
+			Decimal:               e.Decimal,
+			LinkOther:             e.LinkOther,
+			LinkOtherFunc:         e.LinkOtherFunc,
+			MAC:                   e.MAC,
+			StringArray:           e.StringArray,
+			Password:              e.Password,
+			StringScanner:         e.StringScanner,
+			Duration:              e.Duration,
+			Dir:                   e.Dir,
+			Ndir:                  e.Ndir,
+			Str:                   e.Str,
+			NullStr:               e.NullStr,
+			Link:                  e.Link,
+			NullLink:              e.NullLink,
+			Active:                e.Active,
+			NullActive:            e.NullActive,
+			Deleted:               e.Deleted,
+			DeletedAt:             e.DeletedAt,
+			RawData:               e.RawData,
+			Sensitive:             e.Sensitive,
+			IP:                    e.IP,
+			NullInt64:             e.NullInt64,
+			SchemaInt:             e.SchemaInt,
+			SchemaInt8:            e.SchemaInt8,
+			SchemaInt64:           e.SchemaInt64,
+			SchemaFloat:           e.SchemaFloat,
+			SchemaFloat32:         e.SchemaFloat32,
+			NullFloat:             e.NullFloat,
+			Role:                  e.Role,
+			Priority:              e.Priority,
+			OptionalUUID:          e.OptionalUUID,
+			NillableUUID:          e.NillableUUID,
+			Strings:               e.Strings,
+			Pair:                  e.Pair,
+			NilPair:               e.NilPair,
+			Vstring:               e.Vstring,
+			Triple:                e.Triple,
+			BigInt:                e.BigInt,
+			PasswordOther:         e.PasswordOther,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ftp *FieldTypeProject) AllX(ctx context.Context) []*FieldTypeProjection {
+	items, err := ftp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (ftq *FieldTypeQuery) onlyShared(ctx context.Context) (*FieldType, error) {
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ftq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *ftq
+	kq.sql = ftq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if ftq.singleflightScope != nil {
+		scope = ftq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := ftq.singleflight.DoChan(singleflightKey(scope, fieldtype.Label, query, args), func() (interface{}, error) {
+		nodes, err := ftq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, ftq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{fieldtype.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*FieldType)
+		return &node, nil
+	}
+}
+
+// BucketBy groups the query results into time buckets of the given time field, and is used with
+// aggregate functions for time-series queries. The interval format is "<n> <unit>", for example,
+// "1 hour" or "15 minutes", and the supported units are: second, minute, hour, day, week, month
+// and year (the last two do not support multiple units).
+//
+//	buckets, err := client.FieldType.Query().
+//		BucketBy(fieldtype.FieldDatetime, "1 hour").
+//		Aggregate(ent.Count()).
+//		Buckets(ctx)
+//
+func (ftq *FieldTypeQuery) BucketBy(field, interval string) *FieldTypeBucketBy {
+	bbuild := &FieldTypeBucketBy{config: ftq.config, field: field, interval: interval}
+	bbuild.path = func(ctx context.Context) (*sql.Selector, error) {
+		if err := ftq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ftq.sqlQuery(ctx), nil
+	}
+	return bbuild
+}
+
+// FieldTypeBucketBy is the builder for aggregating FieldType entities by time buckets.
+type FieldTypeBucketBy struct {
+	config
+	field    string
+	interval string
+	fns      []AggregateFunc
+	path     func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the bucket-by query.
+func (ftbb *FieldTypeBucketBy) Aggregate(fns ...AggregateFunc) *FieldTypeBucketBy {
+	ftbb.fns = append(ftbb.fns, fns...)
+	return ftbb
+}
+
+// Buckets executes the query and returns the time buckets ordered by their start time.
+func (ftbb *FieldTypeBucketBy) Buckets(ctx context.Context) ([]*TimeBucket, error) {
+	switch ftbb.field {
+	case fieldtype.FieldDatetime, fieldtype.FieldDeletedAt:
+	default:
+		return nil, &ValidationError{Name: ftbb.field, err: fmt.Errorf("invalid time field %q for bucket-by", ftbb.field)}
+	}
+	interval, err := sqltime.ParseInterval(ftbb.interval)
+	if err != nil {
+		return nil, err
+	}
+	selector, err := ftbb.path(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bucket := sqltime.Bucket(selector.Dialect(), selector.C(ftbb.field), interval)
+	columns := make([]string, 0, len(ftbb.fns)+1)
+	columns = append(columns, bucket)
+	for _, fn := range ftbb.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...).GroupBy(bucket).OrderBy(bucket)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ftbb.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanTimeBuckets(rows, len(ftbb.fns))
+}
+
+// BucketsX is like Buckets, but panics if an error occurs.
+func (ftbb *FieldTypeBucketBy) BucketsX(ctx context.Context) []*TimeBucket {
+	buckets, err := ftbb.Buckets(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return buckets
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.FieldType.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (ftq *FieldTypeQuery) SelectMask(paths ...string) *FieldTypeSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case fieldtype.FieldID:
+		case "int":
+			fields = append(fields, fieldtype.FieldInt)
+		case "int8":
+			fields = append(fields, fieldtype.FieldInt8)
+		case "int16":
+			fields = append(fields, fieldtype.FieldInt16)
+		case "int32":
+			fields = append(fields, fieldtype.FieldInt32)
+		case "int64":
+			fields = append(fields, fieldtype.FieldInt64)
+		case "optional_int":
+			fields = append(fields, fieldtype.FieldOptionalInt)
+		case "optional_int8":
+			fields = append(fields, fieldtype.FieldOptionalInt8)
+		case "optional_int16":
+			fields = append(fields, fieldtype.FieldOptionalInt16)
+		case "optional_int32":
+			fields = append(fields, fieldtype.FieldOptionalInt32)
+		case "optional_int64":
+			fields = append(fields, fieldtype.FieldOptionalInt64)
+		case "nillable_int":
+			fields = append(fields, fieldtype.FieldNillableInt)
+		case "nillable_int8":
+			fields = append(fields, fieldtype.FieldNillableInt8)
+		case "nillable_int16":
+			fields = append(fields, fieldtype.FieldNillableInt16)
+		case "nillable_int32":
+			fields = append(fields, fieldtype.FieldNillableInt32)
+		case "nillable_int64":
+			fields = append(fields, fieldtype.FieldNillableInt64)
+		case "validate_optional_int32":
+			fields = append(fields, fieldtype.FieldValidateOptionalInt32)
+		case "optional_uint":
+			fields = append(fields, fieldtype.FieldOptionalUint)
+		case "optional_uint8":
+			fields = append(fields, fieldtype.FieldOptionalUint8)
+		case "optional_uint16":
+			fields = append(fields, fieldtype.FieldOptionalUint16)
+		case "optional_uint32":
+			fields = append(fields, fieldtype.FieldOptionalUint32)
+		case "optional_uint64":
+			fields = append(fields, fieldtype.FieldOptionalUint64)
+		case "state":
+			fields = append(fields, fieldtype.FieldState)
+		case "optional_float":
+			fields = append(fields, fieldtype.FieldOptionalFloat)
+		case "optional_float32":
+			fields = append(fields, fieldtype.FieldOptionalFloat32)
+		case "text":
+			fields = append(fields, fieldtype.FieldText)
+		case "datetime":
+			fields = append(fields, fieldtype.FieldDatetime)
+		case "decimal":
+			fields = append(fields, fieldtype.FieldDecimal)
+		case "link_other":
+			fields = append(fields, fieldtype.FieldLinkOther)
+		case "link_other_func":
+			fields = append(fields, fieldtype.FieldLinkOtherFunc)
+		case "mac":
+			fields = append(fields, fieldtype.FieldMAC)
+		case "string_array":
+			fields = append(fields, fieldtype.FieldStringArray)
+		case "password":
+			fields = append(fields, fieldtype.FieldPassword)
+		case "string_scanner":
+			fields = append(fields, fieldtype.FieldStringScanner)
+		case "duration":
+			fields = append(fields, fieldtype.FieldDuration)
+		case "dir":
+			fields = append(fields, fieldtype.FieldDir)
+		case "ndir":
+			fields = append(fields, fieldtype.FieldNdir)
+		case "str":
+			fields = append(fields, fieldtype.FieldStr)
+		case "null_str":
+			fields = append(fields, fieldtype.FieldNullStr)
+		case "link":
+			fields = append(fields, fieldtype.FieldLink)
+		case "null_link":
+			fields = append(fields, fieldtype.FieldNullLink)
+		case "active":
+			fields = append(fields, fieldtype.FieldActive)
+		case "null_active":
+			fields = append(fields, fieldtype.FieldNullActive)
+		case "deleted":
+			fields = append(fields, fieldtype.FieldDeleted)
+		case "deleted_at":
+			fields = append(fields, fieldtype.FieldDeletedAt)
+		case "raw_data":
+			fields = append(fields, fieldtype.FieldRawData)
+		case "sensitive":
+			fields = append(fields, fieldtype.FieldSensitive)
+		case "ip":
+			fields = append(fields, fieldtype.FieldIP)
+		case "null_int64":
+			fields = append(fields, fieldtype.FieldNullInt64)
+		case "schema_int":
+			fields = append(fields, fieldtype.FieldSchemaInt)
+		case "schema_int8":
+			fields = append(fields, fieldtype.FieldSchemaInt8)
+		case "schema_int64":
+			fields = append(fields, fieldtype.FieldSchemaInt64)
+		case "schema_float":
+			fields = append(fields, fieldtype.FieldSchemaFloat)
+		case "schema_float32":
+			fields = append(fields, fieldtype.FieldSchemaFloat32)
+		case "null_float":
+			fields = append(fields, fieldtype.FieldNullFloat)
+		case "role":
+			fields = append(fields, fieldtype.FieldRole)
+		case "priority":
+			fields = append(fields, fieldtype.FieldPriority)
+		case "optional_uuid":
+			fields = append(fields, fieldtype.FieldOptionalUUID)
+		case "nillable_uuid":
+			fields = append(fields, fieldtype.FieldNillableUUID)
+		case "strings":
+			fields = append(fields, fieldtype.FieldStrings)
+		case "pair":
+			fields = append(fields, fieldtype.FieldPair)
+		case "nil_pair":
+			fields = append(fields, fieldtype.FieldNilPair)
+		case "vstring":
+			fields = append(fields, fieldtype.FieldVstring)
+		case "triple":
+			fields = append(fields, fieldtype.FieldTriple)
+		case "big_int":
+			fields = append(fields, fieldtype.FieldBigInt)
+		case "password_other":
+			fields = append(fields, fieldtype.FieldPasswordOther)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, fieldtype.FieldID)
+	}
+	return ftq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the fieldtype.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (ftq *FieldTypeQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = fieldtype.FieldID
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering FieldType`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		ftq.Order(Asc(column))
+	case DirectionDesc:
+		ftq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// FieldTypePage is a page of FieldType entities returned by the Paginate method.
+type FieldTypePage struct {
+	Nodes []*FieldType `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of FieldType entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.FieldType.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.FieldType.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (ftq *FieldTypeQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*FieldTypePage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case fieldtype.FieldID:
+			order.column = fieldtype.FieldID
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating FieldType`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != fieldtype.FieldID {
+		order := paginateOrder{name: fieldtype.FieldID, column: fieldtype.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := ftq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &FieldTypePage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case fieldtype.FieldID:
+				values[j] = n.ID
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (ftq *FieldTypeQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case fieldtype.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (ftq *FieldTypeQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*FieldType, error) {
+	if ftq.limit != nil {
+		return ftq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	ftq.limit = &limit
+	defer func() { ftq.limit = nil }()
+	nodes, err := ftq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: fieldtype.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // FieldTypeGroupBy is the group-by builder for FieldType entities.
 type FieldTypeGroupBy struct {
 	config
@@ -526,7 +1570,7 @@ func (ftgb *FieldTypeGroupBy) sqlScan(ctx context.Context, v interface{}) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ftgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ftgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -572,7 +1616,7 @@ func (fts *FieldTypeSelect) Scan(ctx context.Context, v interface{}) error {
 func (fts *FieldTypeSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := fts.sql.Query()
-	if err := fts.driver.Query(ctx, query, args, rows); err != nil {
+	if err := fts.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -50,6 +50,25 @@ func (ftu *FieldTypeUpdate) AddInt(i int) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetIntIfGreater sets the "int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetIntIfGreater(i int) *FieldTypeUpdate {
+	ftu.mutation.SetIntIfGreater(i)
+	return ftu
+}
+
+// BitAndInt applies a bitwise AND with i on the "int" field in the database.
+func (ftu *FieldTypeUpdate) BitAndInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitAndInt(i)
+	return ftu
+}
+
+// BitOrInt applies a bitwise OR with i on the "int" field in the database.
+func (ftu *FieldTypeUpdate) BitOrInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitOrInt(i)
+	return ftu
+}
+
 // SetInt8 sets the "int8" field.
 func (ftu *FieldTypeUpdate) SetInt8(i int8) *FieldTypeUpdate {
 	ftu.mutation.ResetInt8()
@@ -63,6 +82,25 @@ func (ftu *FieldTypeUpdate) AddInt8(i int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetInt8IfGreater sets the "int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetInt8IfGreater(i int8) *FieldTypeUpdate {
+	ftu.mutation.SetInt8IfGreater(i)
+	return ftu
+}
+
+// BitAndInt8 applies a bitwise AND with i on the "int8" field in the database.
+func (ftu *FieldTypeUpdate) BitAndInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitAndInt8(i)
+	return ftu
+}
+
+// BitOrInt8 applies a bitwise OR with i on the "int8" field in the database.
+func (ftu *FieldTypeUpdate) BitOrInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitOrInt8(i)
+	return ftu
+}
+
 // SetInt16 sets the "int16" field.
 func (ftu *FieldTypeUpdate) SetInt16(i int16) *FieldTypeUpdate {
 	ftu.mutation.ResetInt16()
@@ -76,6 +114,25 @@ func (ftu *FieldTypeUpdate) AddInt16(i int16) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetInt16IfGreater sets the "int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetInt16IfGreater(i int16) *FieldTypeUpdate {
+	ftu.mutation.SetInt16IfGreater(i)
+	return ftu
+}
+
+// BitAndInt16 applies a bitwise AND with i on the "int16" field in the database.
+func (ftu *FieldTypeUpdate) BitAndInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitAndInt16(i)
+	return ftu
+}
+
+// BitOrInt16 applies a bitwise OR with i on the "int16" field in the database.
+func (ftu *FieldTypeUpdate) BitOrInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitOrInt16(i)
+	return ftu
+}
+
 // SetInt32 sets the "int32" field.
 func (ftu *FieldTypeUpdate) SetInt32(i int32) *FieldTypeUpdate {
 	ftu.mutation.ResetInt32()
@@ -89,6 +146,25 @@ func (ftu *FieldTypeUpdate) AddInt32(i int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetInt32IfGreater sets the "int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetInt32IfGreater(i int32) *FieldTypeUpdate {
+	ftu.mutation.SetInt32IfGreater(i)
+	return ftu
+}
+
+// BitAndInt32 applies a bitwise AND with i on the "int32" field in the database.
+func (ftu *FieldTypeUpdate) BitAndInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitAndInt32(i)
+	return ftu
+}
+
+// BitOrInt32 applies a bitwise OR with i on the "int32" field in the database.
+func (ftu *FieldTypeUpdate) BitOrInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitOrInt32(i)
+	return ftu
+}
+
 // SetInt64 sets the "int64" field.
 func (ftu *FieldTypeUpdate) SetInt64(i int64) *FieldTypeUpdate {
 	ftu.mutation.ResetInt64()
@@ -102,6 +178,25 @@ func (ftu *FieldTypeUpdate) AddInt64(i int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetInt64IfGreater sets the "int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetInt64IfGreater(i int64) *FieldTypeUpdate {
+	ftu.mutation.SetInt64IfGreater(i)
+	return ftu
+}
+
+// BitAndInt64 applies a bitwise AND with i on the "int64" field in the database.
+func (ftu *FieldTypeUpdate) BitAndInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitAndInt64(i)
+	return ftu
+}
+
+// BitOrInt64 applies a bitwise OR with i on the "int64" field in the database.
+func (ftu *FieldTypeUpdate) BitOrInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitOrInt64(i)
+	return ftu
+}
+
 // SetOptionalInt sets the "optional_int" field.
 func (ftu *FieldTypeUpdate) SetOptionalInt(i int) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt()
@@ -123,6 +218,25 @@ func (ftu *FieldTypeUpdate) AddOptionalInt(i int) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalIntIfGreater sets the "optional_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalIntIfGreater(i int) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalIntIfGreater(i)
+	return ftu
+}
+
+// BitAndOptionalInt applies a bitwise AND with i on the "optional_int" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalInt(i)
+	return ftu
+}
+
+// BitOrOptionalInt applies a bitwise OR with i on the "optional_int" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalInt(i)
+	return ftu
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (ftu *FieldTypeUpdate) ClearOptionalInt() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalInt()
@@ -150,6 +264,25 @@ func (ftu *FieldTypeUpdate) AddOptionalInt8(i int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalInt8IfGreater sets the "optional_int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalInt8IfGreater(i int8) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalInt8IfGreater(i)
+	return ftu
+}
+
+// BitAndOptionalInt8 applies a bitwise AND with i on the "optional_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalInt8(i)
+	return ftu
+}
+
+// BitOrOptionalInt8 applies a bitwise OR with i on the "optional_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalInt8(i)
+	return ftu
+}
+
 // ClearOptionalInt8 clears the value of the "optional_int8" field.
 func (ftu *FieldTypeUpdate) ClearOptionalInt8() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalInt8()
@@ -177,6 +310,25 @@ func (ftu *FieldTypeUpdate) AddOptionalInt16(i int16) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalInt16IfGreater sets the "optional_int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalInt16IfGreater(i int16) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalInt16IfGreater(i)
+	return ftu
+}
+
+// BitAndOptionalInt16 applies a bitwise AND with i on the "optional_int16" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalInt16(i)
+	return ftu
+}
+
+// BitOrOptionalInt16 applies a bitwise OR with i on the "optional_int16" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalInt16(i)
+	return ftu
+}
+
 // ClearOptionalInt16 clears the value of the "optional_int16" field.
 func (ftu *FieldTypeUpdate) ClearOptionalInt16() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalInt16()
@@ -204,6 +356,25 @@ func (ftu *FieldTypeUpdate) AddOptionalInt32(i int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalInt32IfGreater sets the "optional_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalInt32IfGreater(i int32) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalInt32IfGreater(i)
+	return ftu
+}
+
+// BitAndOptionalInt32 applies a bitwise AND with i on the "optional_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalInt32(i)
+	return ftu
+}
+
+// BitOrOptionalInt32 applies a bitwise OR with i on the "optional_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalInt32(i)
+	return ftu
+}
+
 // ClearOptionalInt32 clears the value of the "optional_int32" field.
 func (ftu *FieldTypeUpdate) ClearOptionalInt32() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalInt32()
@@ -231,6 +402,25 @@ func (ftu *FieldTypeUpdate) AddOptionalInt64(i int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalInt64IfGreater sets the "optional_int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalInt64IfGreater(i int64) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalInt64IfGreater(i)
+	return ftu
+}
+
+// BitAndOptionalInt64 applies a bitwise AND with i on the "optional_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalInt64(i)
+	return ftu
+}
+
+// BitOrOptionalInt64 applies a bitwise OR with i on the "optional_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalInt64(i)
+	return ftu
+}
+
 // ClearOptionalInt64 clears the value of the "optional_int64" field.
 func (ftu *FieldTypeUpdate) ClearOptionalInt64() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalInt64()
@@ -258,6 +448,25 @@ func (ftu *FieldTypeUpdate) AddNillableInt(i int) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetNillableIntIfGreater sets the "nillable_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetNillableIntIfGreater(i int) *FieldTypeUpdate {
+	ftu.mutation.SetNillableIntIfGreater(i)
+	return ftu
+}
+
+// BitAndNillableInt applies a bitwise AND with i on the "nillable_int" field in the database.
+func (ftu *FieldTypeUpdate) BitAndNillableInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitAndNillableInt(i)
+	return ftu
+}
+
+// BitOrNillableInt applies a bitwise OR with i on the "nillable_int" field in the database.
+func (ftu *FieldTypeUpdate) BitOrNillableInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitOrNillableInt(i)
+	return ftu
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (ftu *FieldTypeUpdate) ClearNillableInt() *FieldTypeUpdate {
 	ftu.mutation.ClearNillableInt()
@@ -285,6 +494,25 @@ func (ftu *FieldTypeUpdate) AddNillableInt8(i int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetNillableInt8IfGreater sets the "nillable_int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetNillableInt8IfGreater(i int8) *FieldTypeUpdate {
+	ftu.mutation.SetNillableInt8IfGreater(i)
+	return ftu
+}
+
+// BitAndNillableInt8 applies a bitwise AND with i on the "nillable_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitAndNillableInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitAndNillableInt8(i)
+	return ftu
+}
+
+// BitOrNillableInt8 applies a bitwise OR with i on the "nillable_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitOrNillableInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitOrNillableInt8(i)
+	return ftu
+}
+
 // ClearNillableInt8 clears the value of the "nillable_int8" field.
 func (ftu *FieldTypeUpdate) ClearNillableInt8() *FieldTypeUpdate {
 	ftu.mutation.ClearNillableInt8()
@@ -312,6 +540,25 @@ func (ftu *FieldTypeUpdate) AddNillableInt16(i int16) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetNillableInt16IfGreater sets the "nillable_int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetNillableInt16IfGreater(i int16) *FieldTypeUpdate {
+	ftu.mutation.SetNillableInt16IfGreater(i)
+	return ftu
+}
+
+// BitAndNillableInt16 applies a bitwise AND with i on the "nillable_int16" field in the database.
+func (ftu *FieldTypeUpdate) BitAndNillableInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitAndNillableInt16(i)
+	return ftu
+}
+
+// BitOrNillableInt16 applies a bitwise OR with i on the "nillable_int16" field in the database.
+func (ftu *FieldTypeUpdate) BitOrNillableInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitOrNillableInt16(i)
+	return ftu
+}
+
 // ClearNillableInt16 clears the value of the "nillable_int16" field.
 func (ftu *FieldTypeUpdate) ClearNillableInt16() *FieldTypeUpdate {
 	ftu.mutation.ClearNillableInt16()
@@ -339,6 +586,25 @@ func (ftu *FieldTypeUpdate) AddNillableInt32(i int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetNillableInt32IfGreater sets the "nillable_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetNillableInt32IfGreater(i int32) *FieldTypeUpdate {
+	ftu.mutation.SetNillableInt32IfGreater(i)
+	return ftu
+}
+
+// BitAndNillableInt32 applies a bitwise AND with i on the "nillable_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitAndNillableInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitAndNillableInt32(i)
+	return ftu
+}
+
+// BitOrNillableInt32 applies a bitwise OR with i on the "nillable_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitOrNillableInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitOrNillableInt32(i)
+	return ftu
+}
+
 // ClearNillableInt32 clears the value of the "nillable_int32" field.
 func (ftu *FieldTypeUpdate) ClearNillableInt32() *FieldTypeUpdate {
 	ftu.mutation.ClearNillableInt32()
@@ -366,6 +632,25 @@ func (ftu *FieldTypeUpdate) AddNillableInt64(i int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetNillableInt64IfGreater sets the "nillable_int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetNillableInt64IfGreater(i int64) *FieldTypeUpdate {
+	ftu.mutation.SetNillableInt64IfGreater(i)
+	return ftu
+}
+
+// BitAndNillableInt64 applies a bitwise AND with i on the "nillable_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitAndNillableInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitAndNillableInt64(i)
+	return ftu
+}
+
+// BitOrNillableInt64 applies a bitwise OR with i on the "nillable_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitOrNillableInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitOrNillableInt64(i)
+	return ftu
+}
+
 // ClearNillableInt64 clears the value of the "nillable_int64" field.
 func (ftu *FieldTypeUpdate) ClearNillableInt64() *FieldTypeUpdate {
 	ftu.mutation.ClearNillableInt64()
@@ -393,6 +678,25 @@ func (ftu *FieldTypeUpdate) AddValidateOptionalInt32(i int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetValidateOptionalInt32IfGreater sets the "validate_optional_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetValidateOptionalInt32IfGreater(i int32) *FieldTypeUpdate {
+	ftu.mutation.SetValidateOptionalInt32IfGreater(i)
+	return ftu
+}
+
+// BitAndValidateOptionalInt32 applies a bitwise AND with i on the "validate_optional_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitAndValidateOptionalInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitAndValidateOptionalInt32(i)
+	return ftu
+}
+
+// BitOrValidateOptionalInt32 applies a bitwise OR with i on the "validate_optional_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitOrValidateOptionalInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitOrValidateOptionalInt32(i)
+	return ftu
+}
+
 // ClearValidateOptionalInt32 clears the value of the "validate_optional_int32" field.
 func (ftu *FieldTypeUpdate) ClearValidateOptionalInt32() *FieldTypeUpdate {
 	ftu.mutation.ClearValidateOptionalInt32()
@@ -420,6 +724,25 @@ func (ftu *FieldTypeUpdate) AddOptionalUint(u int) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalUintIfGreater sets the "optional_uint" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalUintIfGreater(u uint) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalUintIfGreater(u)
+	return ftu
+}
+
+// BitAndOptionalUint applies a bitwise AND with u on the "optional_uint" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalUint(u uint) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalUint(u)
+	return ftu
+}
+
+// BitOrOptionalUint applies a bitwise OR with u on the "optional_uint" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalUint(u uint) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalUint(u)
+	return ftu
+}
+
 // ClearOptionalUint clears the value of the "optional_uint" field.
 func (ftu *FieldTypeUpdate) ClearOptionalUint() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalUint()
@@ -447,6 +770,25 @@ func (ftu *FieldTypeUpdate) AddOptionalUint8(u int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalUint8IfGreater sets the "optional_uint8" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalUint8IfGreater(u uint8) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalUint8IfGreater(u)
+	return ftu
+}
+
+// BitAndOptionalUint8 applies a bitwise AND with u on the "optional_uint8" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalUint8(u uint8) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalUint8(u)
+	return ftu
+}
+
+// BitOrOptionalUint8 applies a bitwise OR with u on the "optional_uint8" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalUint8(u uint8) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalUint8(u)
+	return ftu
+}
+
 // ClearOptionalUint8 clears the value of the "optional_uint8" field.
 func (ftu *FieldTypeUpdate) ClearOptionalUint8() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalUint8()
@@ -474,6 +816,25 @@ func (ftu *FieldTypeUpdate) AddOptionalUint16(u int16) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalUint16IfGreater sets the "optional_uint16" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalUint16IfGreater(u uint16) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalUint16IfGreater(u)
+	return ftu
+}
+
+// BitAndOptionalUint16 applies a bitwise AND with u on the "optional_uint16" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalUint16(u uint16) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalUint16(u)
+	return ftu
+}
+
+// BitOrOptionalUint16 applies a bitwise OR with u on the "optional_uint16" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalUint16(u uint16) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalUint16(u)
+	return ftu
+}
+
 // ClearOptionalUint16 clears the value of the "optional_uint16" field.
 func (ftu *FieldTypeUpdate) ClearOptionalUint16() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalUint16()
@@ -501,6 +862,25 @@ func (ftu *FieldTypeUpdate) AddOptionalUint32(u int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalUint32IfGreater sets the "optional_uint32" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalUint32IfGreater(u uint32) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalUint32IfGreater(u)
+	return ftu
+}
+
+// BitAndOptionalUint32 applies a bitwise AND with u on the "optional_uint32" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalUint32(u uint32) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalUint32(u)
+	return ftu
+}
+
+// BitOrOptionalUint32 applies a bitwise OR with u on the "optional_uint32" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalUint32(u uint32) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalUint32(u)
+	return ftu
+}
+
 // ClearOptionalUint32 clears the value of the "optional_uint32" field.
 func (ftu *FieldTypeUpdate) ClearOptionalUint32() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalUint32()
@@ -528,6 +908,25 @@ func (ftu *FieldTypeUpdate) AddOptionalUint64(u int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalUint64IfGreater sets the "optional_uint64" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalUint64IfGreater(u uint64) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalUint64IfGreater(u)
+	return ftu
+}
+
+// BitAndOptionalUint64 applies a bitwise AND with u on the "optional_uint64" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalUint64(u uint64) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalUint64(u)
+	return ftu
+}
+
+// BitOrOptionalUint64 applies a bitwise OR with u on the "optional_uint64" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalUint64(u uint64) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalUint64(u)
+	return ftu
+}
+
 // ClearOptionalUint64 clears the value of the "optional_uint64" field.
 func (ftu *FieldTypeUpdate) ClearOptionalUint64() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalUint64()
@@ -575,6 +974,13 @@ func (ftu *FieldTypeUpdate) AddOptionalFloat(f float64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalFloatIfGreater sets the "optional_float" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalFloatIfGreater(f float64) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalFloatIfGreater(f)
+	return ftu
+}
+
 // ClearOptionalFloat clears the value of the "optional_float" field.
 func (ftu *FieldTypeUpdate) ClearOptionalFloat() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalFloat()
@@ -602,6 +1008,13 @@ func (ftu *FieldTypeUpdate) AddOptionalFloat32(f float32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalFloat32IfGreater sets the "optional_float32" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalFloat32IfGreater(f float32) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalFloat32IfGreater(f)
+	return ftu
+}
+
 // ClearOptionalFloat32 clears the value of the "optional_float32" field.
 func (ftu *FieldTypeUpdate) ClearOptionalFloat32() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalFloat32()
@@ -669,6 +1082,13 @@ func (ftu *FieldTypeUpdate) AddDecimal(f float64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetDecimalIfGreater sets the "decimal" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetDecimalIfGreater(f float64) *FieldTypeUpdate {
+	ftu.mutation.SetDecimalIfGreater(f)
+	return ftu
+}
+
 // ClearDecimal clears the value of the "decimal" field.
 func (ftu *FieldTypeUpdate) ClearDecimal() *FieldTypeUpdate {
 	ftu.mutation.ClearDecimal()
@@ -784,6 +1204,25 @@ func (ftu *FieldTypeUpdate) AddDuration(t time.Duration) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetDurationIfGreater sets the "duration" field to t, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetDurationIfGreater(t time.Duration) *FieldTypeUpdate {
+	ftu.mutation.SetDurationIfGreater(t)
+	return ftu
+}
+
+// BitAndDuration applies a bitwise AND with t on the "duration" field in the database.
+func (ftu *FieldTypeUpdate) BitAndDuration(t time.Duration) *FieldTypeUpdate {
+	ftu.mutation.BitAndDuration(t)
+	return ftu
+}
+
+// BitOrDuration applies a bitwise OR with t on the "duration" field in the database.
+func (ftu *FieldTypeUpdate) BitOrDuration(t time.Duration) *FieldTypeUpdate {
+	ftu.mutation.BitOrDuration(t)
+	return ftu
+}
+
 // ClearDuration clears the value of the "duration" field.
 func (ftu *FieldTypeUpdate) ClearDuration() *FieldTypeUpdate {
 	ftu.mutation.ClearDuration()
@@ -1021,6 +1460,25 @@ func (ftu *FieldTypeUpdate) AddSchemaInt(s schema.Int) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetSchemaIntIfGreater sets the "schema_int" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetSchemaIntIfGreater(s schema.Int) *FieldTypeUpdate {
+	ftu.mutation.SetSchemaIntIfGreater(s)
+	return ftu
+}
+
+// BitAndSchemaInt applies a bitwise AND with s on the "schema_int" field in the database.
+func (ftu *FieldTypeUpdate) BitAndSchemaInt(s schema.Int) *FieldTypeUpdate {
+	ftu.mutation.BitAndSchemaInt(s)
+	return ftu
+}
+
+// BitOrSchemaInt applies a bitwise OR with s on the "schema_int" field in the database.
+func (ftu *FieldTypeUpdate) BitOrSchemaInt(s schema.Int) *FieldTypeUpdate {
+	ftu.mutation.BitOrSchemaInt(s)
+	return ftu
+}
+
 // ClearSchemaInt clears the value of the "schema_int" field.
 func (ftu *FieldTypeUpdate) ClearSchemaInt() *FieldTypeUpdate {
 	ftu.mutation.ClearSchemaInt()
@@ -1048,6 +1506,25 @@ func (ftu *FieldTypeUpdate) AddSchemaInt8(s schema.Int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetSchemaInt8IfGreater sets the "schema_int8" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetSchemaInt8IfGreater(s schema.Int8) *FieldTypeUpdate {
+	ftu.mutation.SetSchemaInt8IfGreater(s)
+	return ftu
+}
+
+// BitAndSchemaInt8 applies a bitwise AND with s on the "schema_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitAndSchemaInt8(s schema.Int8) *FieldTypeUpdate {
+	ftu.mutation.BitAndSchemaInt8(s)
+	return ftu
+}
+
+// BitOrSchemaInt8 applies a bitwise OR with s on the "schema_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitOrSchemaInt8(s schema.Int8) *FieldTypeUpdate {
+	ftu.mutation.BitOrSchemaInt8(s)
+	return ftu
+}
+
 // ClearSchemaInt8 clears the value of the "schema_int8" field.
 func (ftu *FieldTypeUpdate) ClearSchemaInt8() *FieldTypeUpdate {
 	ftu.mutation.ClearSchemaInt8()
@@ -1075,6 +1552,25 @@ func (ftu *FieldTypeUpdate) AddSchemaInt64(s schema.Int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetSchemaInt64IfGreater sets the "schema_int64" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetSchemaInt64IfGreater(s schema.Int64) *FieldTypeUpdate {
+	ftu.mutation.SetSchemaInt64IfGreater(s)
+	return ftu
+}
+
+// BitAndSchemaInt64 applies a bitwise AND with s on the "schema_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitAndSchemaInt64(s schema.Int64) *FieldTypeUpdate {
+	ftu.mutation.BitAndSchemaInt64(s)
+	return ftu
+}
+
+// BitOrSchemaInt64 applies a bitwise OR with s on the "schema_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitOrSchemaInt64(s schema.Int64) *FieldTypeUpdate {
+	ftu.mutation.BitOrSchemaInt64(s)
+	return ftu
+}
+
 // ClearSchemaInt64 clears the value of the "schema_int64" field.
 func (ftu *FieldTypeUpdate) ClearSchemaInt64() *FieldTypeUpdate {
 	ftu.mutation.ClearSchemaInt64()
@@ -1102,6 +1598,13 @@ func (ftu *FieldTypeUpdate) AddSchemaFloat(s schema.Float64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetSchemaFloatIfGreater sets the "schema_float" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetSchemaFloatIfGreater(s schema.Float64) *FieldTypeUpdate {
+	ftu.mutation.SetSchemaFloatIfGreater(s)
+	return ftu
+}
+
 // ClearSchemaFloat clears the value of the "schema_float" field.
 func (ftu *FieldTypeUpdate) ClearSchemaFloat() *FieldTypeUpdate {
 	ftu.mutation.ClearSchemaFloat()
@@ -1129,6 +1632,13 @@ func (ftu *FieldTypeUpdate) AddSchemaFloat32(s schema.Float32) *FieldTypeUpdate
 	return ftu
 }
 
+// SetSchemaFloat32IfGreater sets the "schema_float32" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetSchemaFloat32IfGreater(s schema.Float32) *FieldTypeUpdate {
+	ftu.mutation.SetSchemaFloat32IfGreater(s)
+	return ftu
+}
+
 // ClearSchemaFloat32 clears the value of the "schema_float32" field.
 func (ftu *FieldTypeUpdate) ClearSchemaFloat32() *FieldTypeUpdate {
 	ftu.mutation.ClearSchemaFloat32()
@@ -1227,6 +1737,12 @@ func (ftu *FieldTypeUpdate) SetStrings(s []string) *FieldTypeUpdate {
 	return ftu
 }
 
+// AppendStrings appends s to the "strings" field in the database.
+func (ftu *FieldTypeUpdate) AppendStrings(s []string) *FieldTypeUpdate {
+	ftu.mutation.AppendStrings(s)
+	return ftu
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (ftu *FieldTypeUpdate) ClearStrings() *FieldTypeUpdate {
 	ftu.mutation.ClearStrings()
@@ -1498,21 +2014,63 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldInt,
 		})
 	}
-	if value, ok := ftu.mutation.Int8(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt8,
+	if value, ok := ftu.mutation.IntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
 			Value:  value,
-			Column: fieldtype.FieldInt8,
+			Column: fieldtype.FieldInt,
 		})
 	}
-	if value, ok := ftu.mutation.AddedInt8(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt8,
+	if value, ok := ftu.mutation.IntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
 			Value:  value,
-			Column: fieldtype.FieldInt8,
+			Column: fieldtype.FieldInt,
 		})
 	}
-	if value, ok := ftu.mutation.Int16(); ok {
+	if value, ok := ftu.mutation.IntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldInt,
+		})
+	}
+	if value, ok := ftu.mutation.Int8(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldInt8,
+		})
+	}
+	if value, ok := ftu.mutation.AddedInt8(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldInt8,
+		})
+	}
+	if value, ok := ftu.mutation.Int8IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldInt8,
+		})
+	}
+	if value, ok := ftu.mutation.Int8BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldInt8,
+		})
+	}
+	if value, ok := ftu.mutation.Int8BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldInt8,
+		})
+	}
+	if value, ok := ftu.mutation.Int16(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt16,
 			Value:  value,
@@ -1526,6 +2084,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldInt16,
 		})
 	}
+	if value, ok := ftu.mutation.Int16IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldInt16,
+		})
+	}
+	if value, ok := ftu.mutation.Int16BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldInt16,
+		})
+	}
+	if value, ok := ftu.mutation.Int16BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldInt16,
+		})
+	}
 	if value, ok := ftu.mutation.Int32(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -1540,6 +2119,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldInt32,
 		})
 	}
+	if value, ok := ftu.mutation.Int32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldInt32,
+		})
+	}
+	if value, ok := ftu.mutation.Int32BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldInt32,
+		})
+	}
+	if value, ok := ftu.mutation.Int32BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldInt32,
+		})
+	}
 	if value, ok := ftu.mutation.Int64(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -1554,6 +2154,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldInt64,
 		})
 	}
+	if value, ok := ftu.mutation.Int64IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldInt64,
+		})
+	}
+	if value, ok := ftu.mutation.Int64BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldInt64,
+		})
+	}
+	if value, ok := ftu.mutation.Int64BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldInt64,
+		})
+	}
 	if value, ok := ftu.mutation.OptionalInt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -1568,6 +2189,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalInt,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt,
+		})
+	}
 	if ftu.mutation.OptionalIntCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -1588,6 +2230,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalInt8,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalInt8IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt8,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalInt8BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt8,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalInt8BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt8,
+		})
+	}
 	if ftu.mutation.OptionalInt8Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt8,
@@ -1608,6 +2271,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalInt16,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalInt16IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt16,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalInt16BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt16,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalInt16BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt16,
+		})
+	}
 	if ftu.mutation.OptionalInt16Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt16,
@@ -1628,6 +2312,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalInt32,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalInt32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt32,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalInt32BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt32,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalInt32BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt32,
+		})
+	}
 	if ftu.mutation.OptionalInt32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -1648,6 +2353,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalInt64,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalInt64IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt64,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalInt64BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt64,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalInt64BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt64,
+		})
+	}
 	if ftu.mutation.OptionalInt64Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -1668,6 +2394,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldNillableInt,
 		})
 	}
+	if value, ok := ftu.mutation.NillableIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt,
+		})
+	}
+	if value, ok := ftu.mutation.NillableIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt,
+		})
+	}
+	if value, ok := ftu.mutation.NillableIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt,
+		})
+	}
 	if ftu.mutation.NillableIntCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -1688,6 +2435,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldNillableInt8,
 		})
 	}
+	if value, ok := ftu.mutation.NillableInt8IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt8,
+		})
+	}
+	if value, ok := ftu.mutation.NillableInt8BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt8,
+		})
+	}
+	if value, ok := ftu.mutation.NillableInt8BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt8,
+		})
+	}
 	if ftu.mutation.NillableInt8Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt8,
@@ -1708,6 +2476,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldNillableInt16,
 		})
 	}
+	if value, ok := ftu.mutation.NillableInt16IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt16,
+		})
+	}
+	if value, ok := ftu.mutation.NillableInt16BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt16,
+		})
+	}
+	if value, ok := ftu.mutation.NillableInt16BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt16,
+		})
+	}
 	if ftu.mutation.NillableInt16Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt16,
@@ -1728,6 +2517,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldNillableInt32,
 		})
 	}
+	if value, ok := ftu.mutation.NillableInt32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt32,
+		})
+	}
+	if value, ok := ftu.mutation.NillableInt32BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt32,
+		})
+	}
+	if value, ok := ftu.mutation.NillableInt32BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt32,
+		})
+	}
 	if ftu.mutation.NillableInt32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -1748,6 +2558,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldNillableInt64,
 		})
 	}
+	if value, ok := ftu.mutation.NillableInt64IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt64,
+		})
+	}
+	if value, ok := ftu.mutation.NillableInt64BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt64,
+		})
+	}
+	if value, ok := ftu.mutation.NillableInt64BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt64,
+		})
+	}
 	if ftu.mutation.NillableInt64Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -1768,6 +2599,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldValidateOptionalInt32,
 		})
 	}
+	if value, ok := ftu.mutation.ValidateOptionalInt32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldValidateOptionalInt32,
+		})
+	}
+	if value, ok := ftu.mutation.ValidateOptionalInt32BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldValidateOptionalInt32,
+		})
+	}
+	if value, ok := ftu.mutation.ValidateOptionalInt32BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldValidateOptionalInt32,
+		})
+	}
 	if ftu.mutation.ValidateOptionalInt32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -1788,6 +2640,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalUint,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalUintIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalUintBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalUintBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint,
+		})
+	}
 	if ftu.mutation.OptionalUintCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint,
@@ -1808,6 +2681,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalUint8,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalUint8IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint8,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalUint8BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint8,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalUint8BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint8,
+		})
+	}
 	if ftu.mutation.OptionalUint8Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint8,
@@ -1828,6 +2722,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalUint16,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalUint16IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint16,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalUint16BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint16,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalUint16BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint16,
+		})
+	}
 	if ftu.mutation.OptionalUint16Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint16,
@@ -1848,6 +2763,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalUint32,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalUint32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint32,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalUint32BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint32,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalUint32BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint32,
+		})
+	}
 	if ftu.mutation.OptionalUint32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint32,
@@ -1868,6 +2804,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalUint64,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalUint64IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint64,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalUint64BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint64,
+		})
+	}
+	if value, ok := ftu.mutation.OptionalUint64BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint64,
+		})
+	}
 	if ftu.mutation.OptionalUint64Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint64,
@@ -1901,6 +2858,13 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalFloat,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalFloatIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalFloat,
+		})
+	}
 	if ftu.mutation.OptionalFloatCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -1921,6 +2885,13 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldOptionalFloat32,
 		})
 	}
+	if value, ok := ftu.mutation.OptionalFloat32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalFloat32,
+		})
+	}
 	if ftu.mutation.OptionalFloat32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat32,
@@ -1967,6 +2938,13 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldDecimal,
 		})
 	}
+	if value, ok := ftu.mutation.DecimalIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: fieldtype.FieldDecimal,
+		})
+	}
 	if ftu.mutation.DecimalCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -2065,6 +3043,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldDuration,
 		})
 	}
+	if value, ok := ftu.mutation.DurationIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldDuration,
+		})
+	}
+	if value, ok := ftu.mutation.DurationBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldDuration,
+		})
+	}
+	if value, ok := ftu.mutation.DurationBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldDuration,
+		})
+	}
 	if ftu.mutation.DurationCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -2261,6 +3260,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldSchemaInt,
 		})
 	}
+	if value, ok := ftu.mutation.SchemaIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt,
+		})
+	}
+	if value, ok := ftu.mutation.SchemaIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt,
+		})
+	}
+	if value, ok := ftu.mutation.SchemaIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt,
+		})
+	}
 	if ftu.mutation.SchemaIntCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -2281,6 +3301,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldSchemaInt8,
 		})
 	}
+	if value, ok := ftu.mutation.SchemaInt8IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt8,
+		})
+	}
+	if value, ok := ftu.mutation.SchemaInt8BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt8,
+		})
+	}
+	if value, ok := ftu.mutation.SchemaInt8BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt8,
+		})
+	}
 	if ftu.mutation.SchemaInt8Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt8,
@@ -2301,6 +3342,27 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldSchemaInt64,
 		})
 	}
+	if value, ok := ftu.mutation.SchemaInt64IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt64,
+		})
+	}
+	if value, ok := ftu.mutation.SchemaInt64BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt64,
+		})
+	}
+	if value, ok := ftu.mutation.SchemaInt64BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt64,
+		})
+	}
 	if ftu.mutation.SchemaInt64Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -2321,6 +3383,13 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldSchemaFloat,
 		})
 	}
+	if value, ok := ftu.mutation.SchemaFloatIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: fieldtype.FieldSchemaFloat,
+		})
+	}
 	if ftu.mutation.SchemaFloatCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -2341,6 +3410,13 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldSchemaFloat32,
 		})
 	}
+	if value, ok := ftu.mutation.SchemaFloat32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat32,
+			Value:  value,
+			Column: fieldtype.FieldSchemaFloat32,
+		})
+	}
 	if ftu.mutation.SchemaFloat32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat32,
@@ -2413,6 +3489,13 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldStrings,
 		})
 	}
+	if value, ok := ftu.mutation.AppendedStrings(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: fieldtype.FieldStrings,
+		})
+	}
 	if ftu.mutation.StringsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -2488,7 +3571,7 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ftu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{fieldtype.Label}
+			err = &NotFoundError{label: fieldtype.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -2500,21 +3583,49 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // FieldTypeUpdateOne is the builder for updating a single FieldType entity.
 type FieldTypeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FieldTypeMutation
+	fields     []string
+	conditions []predicate.FieldType
+	hooks      []Hook
+	mutation   *FieldTypeMutation
+}
+
+// Where appends a list of conditions to the FieldTypeUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ftuo *FieldTypeUpdateOne) Where(ps ...predicate.FieldType) *FieldTypeUpdateOne {
+	ftuo.conditions = append(ftuo.conditions, ps...)
+	return ftuo
+}
+
+// SetInt sets the "int" field.
+func (ftuo *FieldTypeUpdateOne) SetInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt()
+	ftuo.mutation.SetInt(i)
+	return ftuo
+}
+
+// AddInt adds i to the "int" field.
+func (ftuo *FieldTypeUpdateOne) AddInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.AddInt(i)
+	return ftuo
+}
+
+// SetIntIfGreater sets the "int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetIntIfGreater(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.SetIntIfGreater(i)
+	return ftuo
 }
 
-// SetInt sets the "int" field.
-func (ftuo *FieldTypeUpdateOne) SetInt(i int) *FieldTypeUpdateOne {
-	ftuo.mutation.ResetInt()
-	ftuo.mutation.SetInt(i)
+// BitAndInt applies a bitwise AND with i on the "int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndInt(i)
 	return ftuo
 }
 
-// AddInt adds i to the "int" field.
-func (ftuo *FieldTypeUpdateOne) AddInt(i int) *FieldTypeUpdateOne {
-	ftuo.mutation.AddInt(i)
+// BitOrInt applies a bitwise OR with i on the "int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrInt(i)
 	return ftuo
 }
 
@@ -2531,6 +3642,25 @@ func (ftuo *FieldTypeUpdateOne) AddInt8(i int8) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetInt8IfGreater sets the "int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetInt8IfGreater(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.SetInt8IfGreater(i)
+	return ftuo
+}
+
+// BitAndInt8 applies a bitwise AND with i on the "int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndInt8(i)
+	return ftuo
+}
+
+// BitOrInt8 applies a bitwise OR with i on the "int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrInt8(i)
+	return ftuo
+}
+
 // SetInt16 sets the "int16" field.
 func (ftuo *FieldTypeUpdateOne) SetInt16(i int16) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt16()
@@ -2544,6 +3674,25 @@ func (ftuo *FieldTypeUpdateOne) AddInt16(i int16) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetInt16IfGreater sets the "int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetInt16IfGreater(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.SetInt16IfGreater(i)
+	return ftuo
+}
+
+// BitAndInt16 applies a bitwise AND with i on the "int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndInt16(i)
+	return ftuo
+}
+
+// BitOrInt16 applies a bitwise OR with i on the "int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrInt16(i)
+	return ftuo
+}
+
 // SetInt32 sets the "int32" field.
 func (ftuo *FieldTypeUpdateOne) SetInt32(i int32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt32()
@@ -2557,6 +3706,25 @@ func (ftuo *FieldTypeUpdateOne) AddInt32(i int32) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetInt32IfGreater sets the "int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetInt32IfGreater(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetInt32IfGreater(i)
+	return ftuo
+}
+
+// BitAndInt32 applies a bitwise AND with i on the "int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndInt32(i)
+	return ftuo
+}
+
+// BitOrInt32 applies a bitwise OR with i on the "int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrInt32(i)
+	return ftuo
+}
+
 // SetInt64 sets the "int64" field.
 func (ftuo *FieldTypeUpdateOne) SetInt64(i int64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt64()
@@ -2570,6 +3738,25 @@ func (ftuo *FieldTypeUpdateOne) AddInt64(i int64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetInt64IfGreater sets the "int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetInt64IfGreater(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetInt64IfGreater(i)
+	return ftuo
+}
+
+// BitAndInt64 applies a bitwise AND with i on the "int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndInt64(i)
+	return ftuo
+}
+
+// BitOrInt64 applies a bitwise OR with i on the "int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrInt64(i)
+	return ftuo
+}
+
 // SetOptionalInt sets the "optional_int" field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt(i int) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt()
@@ -2591,6 +3778,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalInt(i int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalIntIfGreater sets the "optional_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalIntIfGreater(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalIntIfGreater(i)
+	return ftuo
+}
+
+// BitAndOptionalInt applies a bitwise AND with i on the "optional_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalInt(i)
+	return ftuo
+}
+
+// BitOrOptionalInt applies a bitwise OR with i on the "optional_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalInt(i)
+	return ftuo
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalInt() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalInt()
@@ -2618,6 +3824,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalInt8(i int8) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalInt8IfGreater sets the "optional_int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalInt8IfGreater(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalInt8IfGreater(i)
+	return ftuo
+}
+
+// BitAndOptionalInt8 applies a bitwise AND with i on the "optional_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalInt8(i)
+	return ftuo
+}
+
+// BitOrOptionalInt8 applies a bitwise OR with i on the "optional_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalInt8(i)
+	return ftuo
+}
+
 // ClearOptionalInt8 clears the value of the "optional_int8" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalInt8() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalInt8()
@@ -2645,6 +3870,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalInt16(i int16) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalInt16IfGreater sets the "optional_int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalInt16IfGreater(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalInt16IfGreater(i)
+	return ftuo
+}
+
+// BitAndOptionalInt16 applies a bitwise AND with i on the "optional_int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalInt16(i)
+	return ftuo
+}
+
+// BitOrOptionalInt16 applies a bitwise OR with i on the "optional_int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalInt16(i)
+	return ftuo
+}
+
 // ClearOptionalInt16 clears the value of the "optional_int16" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalInt16() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalInt16()
@@ -2672,6 +3916,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalInt32(i int32) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalInt32IfGreater sets the "optional_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalInt32IfGreater(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalInt32IfGreater(i)
+	return ftuo
+}
+
+// BitAndOptionalInt32 applies a bitwise AND with i on the "optional_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalInt32(i)
+	return ftuo
+}
+
+// BitOrOptionalInt32 applies a bitwise OR with i on the "optional_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalInt32(i)
+	return ftuo
+}
+
 // ClearOptionalInt32 clears the value of the "optional_int32" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalInt32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalInt32()
@@ -2699,6 +3962,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalInt64(i int64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalInt64IfGreater sets the "optional_int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalInt64IfGreater(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalInt64IfGreater(i)
+	return ftuo
+}
+
+// BitAndOptionalInt64 applies a bitwise AND with i on the "optional_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalInt64(i)
+	return ftuo
+}
+
+// BitOrOptionalInt64 applies a bitwise OR with i on the "optional_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalInt64(i)
+	return ftuo
+}
+
 // ClearOptionalInt64 clears the value of the "optional_int64" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalInt64() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalInt64()
@@ -2726,6 +4008,25 @@ func (ftuo *FieldTypeUpdateOne) AddNillableInt(i int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetNillableIntIfGreater sets the "nillable_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetNillableIntIfGreater(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.SetNillableIntIfGreater(i)
+	return ftuo
+}
+
+// BitAndNillableInt applies a bitwise AND with i on the "nillable_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndNillableInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndNillableInt(i)
+	return ftuo
+}
+
+// BitOrNillableInt applies a bitwise OR with i on the "nillable_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrNillableInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrNillableInt(i)
+	return ftuo
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (ftuo *FieldTypeUpdateOne) ClearNillableInt() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearNillableInt()
@@ -2753,6 +4054,25 @@ func (ftuo *FieldTypeUpdateOne) AddNillableInt8(i int8) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetNillableInt8IfGreater sets the "nillable_int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetNillableInt8IfGreater(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.SetNillableInt8IfGreater(i)
+	return ftuo
+}
+
+// BitAndNillableInt8 applies a bitwise AND with i on the "nillable_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndNillableInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndNillableInt8(i)
+	return ftuo
+}
+
+// BitOrNillableInt8 applies a bitwise OR with i on the "nillable_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrNillableInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrNillableInt8(i)
+	return ftuo
+}
+
 // ClearNillableInt8 clears the value of the "nillable_int8" field.
 func (ftuo *FieldTypeUpdateOne) ClearNillableInt8() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearNillableInt8()
@@ -2780,6 +4100,25 @@ func (ftuo *FieldTypeUpdateOne) AddNillableInt16(i int16) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetNillableInt16IfGreater sets the "nillable_int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetNillableInt16IfGreater(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.SetNillableInt16IfGreater(i)
+	return ftuo
+}
+
+// BitAndNillableInt16 applies a bitwise AND with i on the "nillable_int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndNillableInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndNillableInt16(i)
+	return ftuo
+}
+
+// BitOrNillableInt16 applies a bitwise OR with i on the "nillable_int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrNillableInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrNillableInt16(i)
+	return ftuo
+}
+
 // ClearNillableInt16 clears the value of the "nillable_int16" field.
 func (ftuo *FieldTypeUpdateOne) ClearNillableInt16() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearNillableInt16()
@@ -2807,6 +4146,25 @@ func (ftuo *FieldTypeUpdateOne) AddNillableInt32(i int32) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetNillableInt32IfGreater sets the "nillable_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetNillableInt32IfGreater(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetNillableInt32IfGreater(i)
+	return ftuo
+}
+
+// BitAndNillableInt32 applies a bitwise AND with i on the "nillable_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndNillableInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndNillableInt32(i)
+	return ftuo
+}
+
+// BitOrNillableInt32 applies a bitwise OR with i on the "nillable_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrNillableInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrNillableInt32(i)
+	return ftuo
+}
+
 // ClearNillableInt32 clears the value of the "nillable_int32" field.
 func (ftuo *FieldTypeUpdateOne) ClearNillableInt32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearNillableInt32()
@@ -2834,6 +4192,25 @@ func (ftuo *FieldTypeUpdateOne) AddNillableInt64(i int64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetNillableInt64IfGreater sets the "nillable_int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetNillableInt64IfGreater(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetNillableInt64IfGreater(i)
+	return ftuo
+}
+
+// BitAndNillableInt64 applies a bitwise AND with i on the "nillable_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndNillableInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndNillableInt64(i)
+	return ftuo
+}
+
+// BitOrNillableInt64 applies a bitwise OR with i on the "nillable_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrNillableInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrNillableInt64(i)
+	return ftuo
+}
+
 // ClearNillableInt64 clears the value of the "nillable_int64" field.
 func (ftuo *FieldTypeUpdateOne) ClearNillableInt64() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearNillableInt64()
@@ -2861,6 +4238,25 @@ func (ftuo *FieldTypeUpdateOne) AddValidateOptionalInt32(i int32) *FieldTypeUpda
 	return ftuo
 }
 
+// SetValidateOptionalInt32IfGreater sets the "validate_optional_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetValidateOptionalInt32IfGreater(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetValidateOptionalInt32IfGreater(i)
+	return ftuo
+}
+
+// BitAndValidateOptionalInt32 applies a bitwise AND with i on the "validate_optional_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndValidateOptionalInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndValidateOptionalInt32(i)
+	return ftuo
+}
+
+// BitOrValidateOptionalInt32 applies a bitwise OR with i on the "validate_optional_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrValidateOptionalInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrValidateOptionalInt32(i)
+	return ftuo
+}
+
 // ClearValidateOptionalInt32 clears the value of the "validate_optional_int32" field.
 func (ftuo *FieldTypeUpdateOne) ClearValidateOptionalInt32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearValidateOptionalInt32()
@@ -2888,6 +4284,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalUint(u int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalUintIfGreater sets the "optional_uint" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalUintIfGreater(u uint) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalUintIfGreater(u)
+	return ftuo
+}
+
+// BitAndOptionalUint applies a bitwise AND with u on the "optional_uint" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalUint(u uint) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalUint(u)
+	return ftuo
+}
+
+// BitOrOptionalUint applies a bitwise OR with u on the "optional_uint" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalUint(u uint) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalUint(u)
+	return ftuo
+}
+
 // ClearOptionalUint clears the value of the "optional_uint" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalUint() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalUint()
@@ -2915,6 +4330,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalUint8(u int8) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalUint8IfGreater sets the "optional_uint8" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalUint8IfGreater(u uint8) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalUint8IfGreater(u)
+	return ftuo
+}
+
+// BitAndOptionalUint8 applies a bitwise AND with u on the "optional_uint8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalUint8(u uint8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalUint8(u)
+	return ftuo
+}
+
+// BitOrOptionalUint8 applies a bitwise OR with u on the "optional_uint8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalUint8(u uint8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalUint8(u)
+	return ftuo
+}
+
 // ClearOptionalUint8 clears the value of the "optional_uint8" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalUint8() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalUint8()
@@ -2942,6 +4376,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalUint16(u int16) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalUint16IfGreater sets the "optional_uint16" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalUint16IfGreater(u uint16) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalUint16IfGreater(u)
+	return ftuo
+}
+
+// BitAndOptionalUint16 applies a bitwise AND with u on the "optional_uint16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalUint16(u uint16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalUint16(u)
+	return ftuo
+}
+
+// BitOrOptionalUint16 applies a bitwise OR with u on the "optional_uint16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalUint16(u uint16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalUint16(u)
+	return ftuo
+}
+
 // ClearOptionalUint16 clears the value of the "optional_uint16" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalUint16() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalUint16()
@@ -2969,6 +4422,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalUint32(u int32) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalUint32IfGreater sets the "optional_uint32" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalUint32IfGreater(u uint32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalUint32IfGreater(u)
+	return ftuo
+}
+
+// BitAndOptionalUint32 applies a bitwise AND with u on the "optional_uint32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalUint32(u uint32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalUint32(u)
+	return ftuo
+}
+
+// BitOrOptionalUint32 applies a bitwise OR with u on the "optional_uint32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalUint32(u uint32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalUint32(u)
+	return ftuo
+}
+
 // ClearOptionalUint32 clears the value of the "optional_uint32" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalUint32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalUint32()
@@ -2996,6 +4468,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalUint64(u int64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalUint64IfGreater sets the "optional_uint64" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalUint64IfGreater(u uint64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalUint64IfGreater(u)
+	return ftuo
+}
+
+// BitAndOptionalUint64 applies a bitwise AND with u on the "optional_uint64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalUint64(u uint64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalUint64(u)
+	return ftuo
+}
+
+// BitOrOptionalUint64 applies a bitwise OR with u on the "optional_uint64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalUint64(u uint64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalUint64(u)
+	return ftuo
+}
+
 // ClearOptionalUint64 clears the value of the "optional_uint64" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalUint64() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalUint64()
@@ -3043,6 +4534,13 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalFloat(f float64) *FieldTypeUpdateOne
 	return ftuo
 }
 
+// SetOptionalFloatIfGreater sets the "optional_float" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalFloatIfGreater(f float64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalFloatIfGreater(f)
+	return ftuo
+}
+
 // ClearOptionalFloat clears the value of the "optional_float" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalFloat() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalFloat()
@@ -3070,6 +4568,13 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalFloat32(f float32) *FieldTypeUpdateOn
 	return ftuo
 }
 
+// SetOptionalFloat32IfGreater sets the "optional_float32" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalFloat32IfGreater(f float32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalFloat32IfGreater(f)
+	return ftuo
+}
+
 // ClearOptionalFloat32 clears the value of the "optional_float32" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalFloat32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalFloat32()
@@ -3137,6 +4642,13 @@ func (ftuo *FieldTypeUpdateOne) AddDecimal(f float64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetDecimalIfGreater sets the "decimal" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetDecimalIfGreater(f float64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetDecimalIfGreater(f)
+	return ftuo
+}
+
 // ClearDecimal clears the value of the "decimal" field.
 func (ftuo *FieldTypeUpdateOne) ClearDecimal() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearDecimal()
@@ -3252,6 +4764,25 @@ func (ftuo *FieldTypeUpdateOne) AddDuration(t time.Duration) *FieldTypeUpdateOne
 	return ftuo
 }
 
+// SetDurationIfGreater sets the "duration" field to t, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetDurationIfGreater(t time.Duration) *FieldTypeUpdateOne {
+	ftuo.mutation.SetDurationIfGreater(t)
+	return ftuo
+}
+
+// BitAndDuration applies a bitwise AND with t on the "duration" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndDuration(t time.Duration) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndDuration(t)
+	return ftuo
+}
+
+// BitOrDuration applies a bitwise OR with t on the "duration" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrDuration(t time.Duration) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrDuration(t)
+	return ftuo
+}
+
 // ClearDuration clears the value of the "duration" field.
 func (ftuo *FieldTypeUpdateOne) ClearDuration() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearDuration()
@@ -3489,6 +5020,25 @@ func (ftuo *FieldTypeUpdateOne) AddSchemaInt(s schema.Int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetSchemaIntIfGreater sets the "schema_int" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetSchemaIntIfGreater(s schema.Int) *FieldTypeUpdateOne {
+	ftuo.mutation.SetSchemaIntIfGreater(s)
+	return ftuo
+}
+
+// BitAndSchemaInt applies a bitwise AND with s on the "schema_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndSchemaInt(s schema.Int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndSchemaInt(s)
+	return ftuo
+}
+
+// BitOrSchemaInt applies a bitwise OR with s on the "schema_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrSchemaInt(s schema.Int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrSchemaInt(s)
+	return ftuo
+}
+
 // ClearSchemaInt clears the value of the "schema_int" field.
 func (ftuo *FieldTypeUpdateOne) ClearSchemaInt() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearSchemaInt()
@@ -3516,6 +5066,25 @@ func (ftuo *FieldTypeUpdateOne) AddSchemaInt8(s schema.Int8) *FieldTypeUpdateOne
 	return ftuo
 }
 
+// SetSchemaInt8IfGreater sets the "schema_int8" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetSchemaInt8IfGreater(s schema.Int8) *FieldTypeUpdateOne {
+	ftuo.mutation.SetSchemaInt8IfGreater(s)
+	return ftuo
+}
+
+// BitAndSchemaInt8 applies a bitwise AND with s on the "schema_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndSchemaInt8(s schema.Int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndSchemaInt8(s)
+	return ftuo
+}
+
+// BitOrSchemaInt8 applies a bitwise OR with s on the "schema_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrSchemaInt8(s schema.Int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrSchemaInt8(s)
+	return ftuo
+}
+
 // ClearSchemaInt8 clears the value of the "schema_int8" field.
 func (ftuo *FieldTypeUpdateOne) ClearSchemaInt8() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearSchemaInt8()
@@ -3543,6 +5112,25 @@ func (ftuo *FieldTypeUpdateOne) AddSchemaInt64(s schema.Int64) *FieldTypeUpdateO
 	return ftuo
 }
 
+// SetSchemaInt64IfGreater sets the "schema_int64" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetSchemaInt64IfGreater(s schema.Int64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetSchemaInt64IfGreater(s)
+	return ftuo
+}
+
+// BitAndSchemaInt64 applies a bitwise AND with s on the "schema_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndSchemaInt64(s schema.Int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndSchemaInt64(s)
+	return ftuo
+}
+
+// BitOrSchemaInt64 applies a bitwise OR with s on the "schema_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrSchemaInt64(s schema.Int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrSchemaInt64(s)
+	return ftuo
+}
+
 // ClearSchemaInt64 clears the value of the "schema_int64" field.
 func (ftuo *FieldTypeUpdateOne) ClearSchemaInt64() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearSchemaInt64()
@@ -3570,6 +5158,13 @@ func (ftuo *FieldTypeUpdateOne) AddSchemaFloat(s schema.Float64) *FieldTypeUpdat
 	return ftuo
 }
 
+// SetSchemaFloatIfGreater sets the "schema_float" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetSchemaFloatIfGreater(s schema.Float64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetSchemaFloatIfGreater(s)
+	return ftuo
+}
+
 // ClearSchemaFloat clears the value of the "schema_float" field.
 func (ftuo *FieldTypeUpdateOne) ClearSchemaFloat() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearSchemaFloat()
@@ -3597,6 +5192,13 @@ func (ftuo *FieldTypeUpdateOne) AddSchemaFloat32(s schema.Float32) *FieldTypeUpd
 	return ftuo
 }
 
+// SetSchemaFloat32IfGreater sets the "schema_float32" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetSchemaFloat32IfGreater(s schema.Float32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetSchemaFloat32IfGreater(s)
+	return ftuo
+}
+
 // ClearSchemaFloat32 clears the value of the "schema_float32" field.
 func (ftuo *FieldTypeUpdateOne) ClearSchemaFloat32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearSchemaFloat32()
@@ -3695,6 +5297,12 @@ func (ftuo *FieldTypeUpdateOne) SetStrings(s []string) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// AppendStrings appends s to the "strings" field in the database.
+func (ftuo *FieldTypeUpdateOne) AppendStrings(s []string) *FieldTypeUpdateOne {
+	ftuo.mutation.AppendStrings(s)
+	return ftuo
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (ftuo *FieldTypeUpdateOne) ClearStrings() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearStrings()
@@ -3982,15 +5590,43 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			}
 		}
 	}
-	if value, ok := ftuo.mutation.Int(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+	if ps := ftuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ftuo.mutation.Int(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldInt,
+		})
+	}
+	if value, ok := ftuo.mutation.AddedInt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldInt,
+		})
+	}
+	if value, ok := ftuo.mutation.IntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
 			Value:  value,
 			Column: fieldtype.FieldInt,
 		})
 	}
-	if value, ok := ftuo.mutation.AddedInt(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+	if value, ok := ftuo.mutation.IntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldInt,
+		})
+	}
+	if value, ok := ftuo.mutation.IntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
 			Value:  value,
 			Column: fieldtype.FieldInt,
@@ -4010,6 +5646,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldInt8,
 		})
 	}
+	if value, ok := ftuo.mutation.Int8IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldInt8,
+		})
+	}
+	if value, ok := ftuo.mutation.Int8BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldInt8,
+		})
+	}
+	if value, ok := ftuo.mutation.Int8BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldInt8,
+		})
+	}
 	if value, ok := ftuo.mutation.Int16(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt16,
@@ -4024,6 +5681,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldInt16,
 		})
 	}
+	if value, ok := ftuo.mutation.Int16IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldInt16,
+		})
+	}
+	if value, ok := ftuo.mutation.Int16BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldInt16,
+		})
+	}
+	if value, ok := ftuo.mutation.Int16BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldInt16,
+		})
+	}
 	if value, ok := ftuo.mutation.Int32(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -4038,6 +5716,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldInt32,
 		})
 	}
+	if value, ok := ftuo.mutation.Int32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldInt32,
+		})
+	}
+	if value, ok := ftuo.mutation.Int32BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldInt32,
+		})
+	}
+	if value, ok := ftuo.mutation.Int32BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldInt32,
+		})
+	}
 	if value, ok := ftuo.mutation.Int64(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -4052,6 +5751,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldInt64,
 		})
 	}
+	if value, ok := ftuo.mutation.Int64IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldInt64,
+		})
+	}
+	if value, ok := ftuo.mutation.Int64BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldInt64,
+		})
+	}
+	if value, ok := ftuo.mutation.Int64BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldInt64,
+		})
+	}
 	if value, ok := ftuo.mutation.OptionalInt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -4066,6 +5786,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalInt,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt,
+		})
+	}
 	if ftuo.mutation.OptionalIntCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -4086,6 +5827,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalInt8,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalInt8IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt8,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalInt8BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt8,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalInt8BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt8,
+		})
+	}
 	if ftuo.mutation.OptionalInt8Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt8,
@@ -4106,6 +5868,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalInt16,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalInt16IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt16,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalInt16BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt16,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalInt16BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt16,
+		})
+	}
 	if ftuo.mutation.OptionalInt16Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt16,
@@ -4126,6 +5909,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalInt32,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalInt32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt32,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalInt32BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt32,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalInt32BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt32,
+		})
+	}
 	if ftuo.mutation.OptionalInt32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -4146,6 +5950,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalInt64,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalInt64IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt64,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalInt64BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt64,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalInt64BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt64,
+		})
+	}
 	if ftuo.mutation.OptionalInt64Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -4166,6 +5991,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldNillableInt,
 		})
 	}
+	if value, ok := ftuo.mutation.NillableIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt,
+		})
+	}
+	if value, ok := ftuo.mutation.NillableIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt,
+		})
+	}
+	if value, ok := ftuo.mutation.NillableIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt,
+		})
+	}
 	if ftuo.mutation.NillableIntCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -4186,6 +6032,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldNillableInt8,
 		})
 	}
+	if value, ok := ftuo.mutation.NillableInt8IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt8,
+		})
+	}
+	if value, ok := ftuo.mutation.NillableInt8BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt8,
+		})
+	}
+	if value, ok := ftuo.mutation.NillableInt8BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt8,
+		})
+	}
 	if ftuo.mutation.NillableInt8Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt8,
@@ -4206,6 +6073,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldNillableInt16,
 		})
 	}
+	if value, ok := ftuo.mutation.NillableInt16IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt16,
+		})
+	}
+	if value, ok := ftuo.mutation.NillableInt16BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt16,
+		})
+	}
+	if value, ok := ftuo.mutation.NillableInt16BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt16,
+		})
+	}
 	if ftuo.mutation.NillableInt16Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt16,
@@ -4226,6 +6114,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldNillableInt32,
 		})
 	}
+	if value, ok := ftuo.mutation.NillableInt32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt32,
+		})
+	}
+	if value, ok := ftuo.mutation.NillableInt32BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt32,
+		})
+	}
+	if value, ok := ftuo.mutation.NillableInt32BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt32,
+		})
+	}
 	if ftuo.mutation.NillableInt32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -4246,6 +6155,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldNillableInt64,
 		})
 	}
+	if value, ok := ftuo.mutation.NillableInt64IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt64,
+		})
+	}
+	if value, ok := ftuo.mutation.NillableInt64BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt64,
+		})
+	}
+	if value, ok := ftuo.mutation.NillableInt64BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt64,
+		})
+	}
 	if ftuo.mutation.NillableInt64Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -4266,21 +6196,63 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldValidateOptionalInt32,
 		})
 	}
+	if value, ok := ftuo.mutation.ValidateOptionalInt32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldValidateOptionalInt32,
+		})
+	}
+	if value, ok := ftuo.mutation.ValidateOptionalInt32BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldValidateOptionalInt32,
+		})
+	}
+	if value, ok := ftuo.mutation.ValidateOptionalInt32BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldValidateOptionalInt32,
+		})
+	}
 	if ftuo.mutation.ValidateOptionalInt32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
 			Column: fieldtype.FieldValidateOptionalInt32,
 		})
 	}
-	if value, ok := ftuo.mutation.OptionalUint(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+	if value, ok := ftuo.mutation.OptionalUint(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint,
+		})
+	}
+	if value, ok := ftuo.mutation.AddedOptionalUint(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalUintIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalUintBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint,
 			Value:  value,
 			Column: fieldtype.FieldOptionalUint,
 		})
 	}
-	if value, ok := ftuo.mutation.AddedOptionalUint(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+	if value, ok := ftuo.mutation.OptionalUintBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint,
 			Value:  value,
 			Column: fieldtype.FieldOptionalUint,
@@ -4306,6 +6278,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalUint8,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalUint8IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint8,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalUint8BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint8,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalUint8BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint8,
+		})
+	}
 	if ftuo.mutation.OptionalUint8Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint8,
@@ -4326,6 +6319,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalUint16,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalUint16IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint16,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalUint16BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint16,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalUint16BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint16,
+		})
+	}
 	if ftuo.mutation.OptionalUint16Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint16,
@@ -4346,6 +6360,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalUint32,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalUint32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint32,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalUint32BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint32,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalUint32BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint32,
+		})
+	}
 	if ftuo.mutation.OptionalUint32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint32,
@@ -4366,6 +6401,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalUint64,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalUint64IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint64,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalUint64BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint64,
+		})
+	}
+	if value, ok := ftuo.mutation.OptionalUint64BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint64,
+		})
+	}
 	if ftuo.mutation.OptionalUint64Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint64,
@@ -4399,6 +6455,13 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalFloat,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalFloatIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalFloat,
+		})
+	}
 	if ftuo.mutation.OptionalFloatCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -4419,6 +6482,13 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldOptionalFloat32,
 		})
 	}
+	if value, ok := ftuo.mutation.OptionalFloat32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalFloat32,
+		})
+	}
 	if ftuo.mutation.OptionalFloat32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat32,
@@ -4465,6 +6535,13 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldDecimal,
 		})
 	}
+	if value, ok := ftuo.mutation.DecimalIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: fieldtype.FieldDecimal,
+		})
+	}
 	if ftuo.mutation.DecimalCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -4563,6 +6640,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldDuration,
 		})
 	}
+	if value, ok := ftuo.mutation.DurationIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldDuration,
+		})
+	}
+	if value, ok := ftuo.mutation.DurationBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldDuration,
+		})
+	}
+	if value, ok := ftuo.mutation.DurationBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldDuration,
+		})
+	}
 	if ftuo.mutation.DurationCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -4759,6 +6857,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldSchemaInt,
 		})
 	}
+	if value, ok := ftuo.mutation.SchemaIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt,
+		})
+	}
+	if value, ok := ftuo.mutation.SchemaIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt,
+		})
+	}
+	if value, ok := ftuo.mutation.SchemaIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt,
+		})
+	}
 	if ftuo.mutation.SchemaIntCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -4779,6 +6898,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldSchemaInt8,
 		})
 	}
+	if value, ok := ftuo.mutation.SchemaInt8IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt8,
+		})
+	}
+	if value, ok := ftuo.mutation.SchemaInt8BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt8,
+		})
+	}
+	if value, ok := ftuo.mutation.SchemaInt8BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt8,
+		})
+	}
 	if ftuo.mutation.SchemaInt8Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt8,
@@ -4799,6 +6939,27 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldSchemaInt64,
 		})
 	}
+	if value, ok := ftuo.mutation.SchemaInt64IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt64,
+		})
+	}
+	if value, ok := ftuo.mutation.SchemaInt64BitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt64,
+		})
+	}
+	if value, ok := ftuo.mutation.SchemaInt64BitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldSchemaInt64,
+		})
+	}
 	if ftuo.mutation.SchemaInt64Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -4819,6 +6980,13 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldSchemaFloat,
 		})
 	}
+	if value, ok := ftuo.mutation.SchemaFloatIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: fieldtype.FieldSchemaFloat,
+		})
+	}
 	if ftuo.mutation.SchemaFloatCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -4839,6 +7007,13 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldSchemaFloat32,
 		})
 	}
+	if value, ok := ftuo.mutation.SchemaFloat32IfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat32,
+			Value:  value,
+			Column: fieldtype.FieldSchemaFloat32,
+		})
+	}
 	if ftuo.mutation.SchemaFloat32Cleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat32,
@@ -4911,6 +7086,13 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldStrings,
 		})
 	}
+	if value, ok := ftuo.mutation.AppendedStrings(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: fieldtype.FieldStrings,
+		})
+	}
 	if ftuo.mutation.StringsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -4989,7 +7171,9 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, ftuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{fieldtype.Label}
+			err = &NotFoundError{label: fieldtype.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: fieldtype.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -4997,3 +7181,288 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the FieldType entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (ftuo *FieldTypeUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := ftuo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "FieldType.id" for update`)}
+	}
+	return enqueueAsync(ctx, ftuo.config, ftuo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by FieldType.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (ftu *FieldTypeUpdate) SetChanged(changes []FieldChange) (*FieldTypeUpdate, error) {
+	if err := setFieldTypeChanged(ftu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return ftu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by FieldType.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (ftuo *FieldTypeUpdateOne) SetChanged(changes []FieldChange) (*FieldTypeUpdateOne, error) {
+	if err := setFieldTypeChanged(ftuo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return ftuo, nil
+}
+
+// setFieldTypeChanged applies the given field changes on the FieldType mutation.
+func setFieldTypeChanged(m *FieldTypeMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the FieldType entities that are joined with the rows of the given table, on the given columns
+// of the FieldType table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.FieldType.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (ftu *FieldTypeUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *FieldTypeUpdate {
+	ftu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return ftu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the FieldType schema.
+func (ftu *FieldTypeUpdate) ApplyPatch(p *FieldTypePatch) (*FieldTypeUpdate, error) {
+	if err := applyFieldTypePatch(ftu.mutation, p); err != nil {
+		return nil, err
+	}
+	return ftu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the FieldType schema.
+func (ftuo *FieldTypeUpdateOne) ApplyPatch(p *FieldTypePatch) (*FieldTypeUpdateOne, error) {
+	if err := applyFieldTypePatch(ftuo.mutation, p); err != nil {
+		return nil, err
+	}
+	return ftuo, nil
+}
+
+// applyFieldTypePatch applies the given patch on the FieldType mutation.
+func applyFieldTypePatch(m *FieldTypeMutation, p *FieldTypePatch) error {
+	if p.Int != nil {
+		m.SetInt(*p.Int)
+	}
+	if p.Int8 != nil {
+		m.SetInt8(*p.Int8)
+	}
+	if p.Int16 != nil {
+		m.SetInt16(*p.Int16)
+	}
+	if p.Int32 != nil {
+		m.SetInt32(*p.Int32)
+	}
+	if p.Int64 != nil {
+		m.SetInt64(*p.Int64)
+	}
+	if p.OptionalInt != nil {
+		m.SetOptionalInt(*p.OptionalInt)
+	}
+	if p.OptionalInt8 != nil {
+		m.SetOptionalInt8(*p.OptionalInt8)
+	}
+	if p.OptionalInt16 != nil {
+		m.SetOptionalInt16(*p.OptionalInt16)
+	}
+	if p.OptionalInt32 != nil {
+		m.SetOptionalInt32(*p.OptionalInt32)
+	}
+	if p.OptionalInt64 != nil {
+		m.SetOptionalInt64(*p.OptionalInt64)
+	}
+	if p.NillableInt != nil {
+		m.SetNillableInt(*p.NillableInt)
+	}
+	if p.NillableInt8 != nil {
+		m.SetNillableInt8(*p.NillableInt8)
+	}
+	if p.NillableInt16 != nil {
+		m.SetNillableInt16(*p.NillableInt16)
+	}
+	if p.NillableInt32 != nil {
+		m.SetNillableInt32(*p.NillableInt32)
+	}
+	if p.NillableInt64 != nil {
+		m.SetNillableInt64(*p.NillableInt64)
+	}
+	if p.ValidateOptionalInt32 != nil {
+		m.SetValidateOptionalInt32(*p.ValidateOptionalInt32)
+	}
+	if p.OptionalUint != nil {
+		m.SetOptionalUint(*p.OptionalUint)
+	}
+	if p.OptionalUint8 != nil {
+		m.SetOptionalUint8(*p.OptionalUint8)
+	}
+	if p.OptionalUint16 != nil {
+		m.SetOptionalUint16(*p.OptionalUint16)
+	}
+	if p.OptionalUint32 != nil {
+		m.SetOptionalUint32(*p.OptionalUint32)
+	}
+	if p.OptionalUint64 != nil {
+		m.SetOptionalUint64(*p.OptionalUint64)
+	}
+	if p.State != nil {
+		m.SetState(*p.State)
+	}
+	if p.OptionalFloat != nil {
+		m.SetOptionalFloat(*p.OptionalFloat)
+	}
+	if p.OptionalFloat32 != nil {
+		m.SetOptionalFloat32(*p.OptionalFloat32)
+	}
+	if p.Text != nil {
+		m.SetText(*p.Text)
+	}
+	if p.Datetime != nil {
+		m.SetDatetime(*p.Datetime)
+	}
+	if p.Decimal != nil {
+		m.SetDecimal(*p.Decimal)
+	}
+	if p.LinkOther != nil {
+		m.SetLinkOther(*p.LinkOther)
+	}
+	if p.LinkOtherFunc != nil {
+		m.SetLinkOtherFunc(*p.LinkOtherFunc)
+	}
+	if p.MAC != nil {
+		m.SetMAC(*p.MAC)
+	}
+	if p.StringArray != nil {
+		m.SetStringArray(*p.StringArray)
+	}
+	if p.Password != nil {
+		m.SetPassword(*p.Password)
+	}
+	if p.StringScanner != nil {
+		m.SetStringScanner(*p.StringScanner)
+	}
+	if p.Duration != nil {
+		m.SetDuration(*p.Duration)
+	}
+	if p.Dir != nil {
+		m.SetDir(*p.Dir)
+	}
+	if p.Ndir != nil {
+		m.SetNdir(*p.Ndir)
+	}
+	if p.Str != nil {
+		m.SetStr(*p.Str)
+	}
+	if p.NullStr != nil {
+		m.SetNullStr(*p.NullStr)
+	}
+	if p.Link != nil {
+		m.SetLink(*p.Link)
+	}
+	if p.NullLink != nil {
+		m.SetNullLink(*p.NullLink)
+	}
+	if p.Active != nil {
+		m.SetActive(*p.Active)
+	}
+	if p.NullActive != nil {
+		m.SetNullActive(*p.NullActive)
+	}
+	if p.Deleted != nil {
+		m.SetDeleted(*p.Deleted)
+	}
+	if p.DeletedAt != nil {
+		m.SetDeletedAt(*p.DeletedAt)
+	}
+	if p.RawData != nil {
+		m.SetRawData(*p.RawData)
+	}
+	if p.Sensitive != nil {
+		m.SetSensitive(*p.Sensitive)
+	}
+	if p.IP != nil {
+		m.SetIP(*p.IP)
+	}
+	if p.NullInt64 != nil {
+		m.SetNullInt64(*p.NullInt64)
+	}
+	if p.SchemaInt != nil {
+		m.SetSchemaInt(*p.SchemaInt)
+	}
+	if p.SchemaInt8 != nil {
+		m.SetSchemaInt8(*p.SchemaInt8)
+	}
+	if p.SchemaInt64 != nil {
+		m.SetSchemaInt64(*p.SchemaInt64)
+	}
+	if p.SchemaFloat != nil {
+		m.SetSchemaFloat(*p.SchemaFloat)
+	}
+	if p.SchemaFloat32 != nil {
+		m.SetSchemaFloat32(*p.SchemaFloat32)
+	}
+	if p.NullFloat != nil {
+		m.SetNullFloat(*p.NullFloat)
+	}
+	if p.Role != nil {
+		m.SetRole(*p.Role)
+	}
+	if p.Priority != nil {
+		m.SetPriority(*p.Priority)
+	}
+	if p.OptionalUUID != nil {
+		m.SetOptionalUUID(*p.OptionalUUID)
+	}
+	if p.NillableUUID != nil {
+		m.SetNillableUUID(*p.NillableUUID)
+	}
+	if p.Strings != nil {
+		m.SetStrings(*p.Strings)
+	}
+	if p.Pair != nil {
+		m.SetPair(*p.Pair)
+	}
+	if p.NilPair != nil {
+		m.SetNilPair(*p.NilPair)
+	}
+	if p.Vstring != nil {
+		m.SetVstring(*p.Vstring)
+	}
+	if p.Triple != nil {
+		m.SetTriple(*p.Triple)
+	}
+	if p.BigInt != nil {
+		m.SetBigInt(*p.BigInt)
+	}
+	if p.PasswordOther != nil {
+		m.SetPasswordOther(*p.PasswordOther)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
@@ -7,7 +7,9 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -37,6 +39,9 @@ type File struct {
 	file_type_files *int
 	group_files     *int
 	user_files      *int
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[5]bool
 }
 
 // FileEdges holds the relations/edges for other nodes in the graph.
@@ -241,6 +246,231 @@ func (f *File) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the File and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (f *File) Diff(other *File) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(f.Size, other.Size) {
+		changes = append(changes, FieldChange{Field: file.FieldSize, Old: f.Size, New: other.Size})
+	}
+	if !reflect.DeepEqual(f.Name, other.Name) {
+		changes = append(changes, FieldChange{Field: file.FieldName, Old: f.Name, New: other.Name})
+	}
+	if !reflect.DeepEqual(f.User, other.User) {
+		change := FieldChange{Field: file.FieldUser}
+		if f.User != nil {
+			change.Old = *f.User
+		}
+		if other.User != nil {
+			change.New = *other.User
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(f.Group, other.Group) {
+		changes = append(changes, FieldChange{Field: file.FieldGroup, Old: f.Group, New: other.Group})
+	}
+	if !reflect.DeepEqual(f.Op, other.Op) {
+		changes = append(changes, FieldChange{Field: file.FieldOp, Old: f.Op, New: other.Op})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the File that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (f *File) ApplyMask(paths ...string) (*File, error) {
+	if len(paths) == 0 {
+		masked := *f
+		return &masked, nil
+	}
+	masked := &File{config: f.config, ID: f.ID}
+	// Nested paths of the masked edges. A nil
+	// slice means that the entire edge is masked.
+	edges := make(map[string][]string)
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case file.FieldID:
+		case "size":
+			masked.Size = f.Size
+		case "name":
+			masked.Name = f.Name
+		case "user":
+			masked.User = f.User
+		case "group":
+			masked.Group = f.Group
+		case "op":
+			masked.Op = f.Op
+		case file.EdgeOwner, file.EdgeType, file.EdgeField:
+			if name == p {
+				edges[name] = nil
+			} else if sub, ok := edges[name]; !ok || sub != nil {
+				edges[name] = append(sub, p[len(name)+1:])
+			}
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type File", p)
+		}
+	}
+	if sub, ok := edges[file.EdgeOwner]; ok {
+		masked.Edges.loadedTypes[0] = f.Edges.loadedTypes[0]
+		if n := f.Edges.Owner; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Owner = mn
+		}
+	}
+	if sub, ok := edges[file.EdgeType]; ok {
+		masked.Edges.loadedTypes[1] = f.Edges.loadedTypes[1]
+		if n := f.Edges.Type; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Type = mn
+		}
+	}
+	if sub, ok := edges[file.EdgeField]; ok {
+		masked.Edges.loadedTypes[2] = f.Edges.loadedTypes[2]
+		for _, n := range f.Edges.Field {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Field = append(masked.Edges.Field, mn)
+		}
+	}
+	return masked, nil
+}
+
+// FilePatch describes a partial update of a File entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type FilePatch struct {
+	// Size holds the new value of the "size" field.
+	Size *int `json:"size,omitempty"`
+	// Name holds the new value of the "name" field.
+	Name *string `json:"name,omitempty"`
+	// User holds the new value of the "user" field.
+	User *string `json:"user,omitempty"`
+	// Group holds the new value of the "group" field.
+	Group *string `json:"group,omitempty"`
+	// Op holds the new value of the "op" field.
+	Op *bool `json:"op,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, file.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *FilePatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p FilePatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.Size != nil {
+		fields["size"] = *p.Size
+	}
+	if p.Name != nil {
+		fields["name"] = *p.Name
+	}
+	if p.User != nil {
+		fields["user"] = *p.User
+	}
+	if p.Group != nil {
+		fields["group"] = *p.Group
+	}
+	if p.Op != nil {
+		fields["op"] = *p.Op
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		case file.FieldUser:
+			fields["user"] = nil
+		case file.FieldGroup:
+			fields["group"] = nil
+		case file.FieldOp:
+			fields["op"] = nil
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional File field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *FilePatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "size":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: File field %q cannot be null", name)
+			}
+			p.Size = new(int)
+			if err := json.Unmarshal(raw, p.Size); err != nil {
+				return fmt.Errorf("ent: decoding File field %q: %w", name, err)
+			}
+		case "name":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: File field %q cannot be null", name)
+			}
+			p.Name = new(string)
+			if err := json.Unmarshal(raw, p.Name); err != nil {
+				return fmt.Errorf("ent: decoding File field %q: %w", name, err)
+			}
+		case "user":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, file.FieldUser)
+				continue
+			}
+			p.User = new(string)
+			if err := json.Unmarshal(raw, p.User); err != nil {
+				return fmt.Errorf("ent: decoding File field %q: %w", name, err)
+			}
+		case "group":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, file.FieldGroup)
+				continue
+			}
+			p.Group = new(string)
+			if err := json.Unmarshal(raw, p.Group); err != nil {
+				return fmt.Errorf("ent: decoding File field %q: %w", name, err)
+			}
+		case "op":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, file.FieldOp)
+				continue
+			}
+			p.Op = new(bool)
+			if err := json.Unmarshal(raw, p.Op); err != nil {
+				return fmt.Errorf("ent: decoding File field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable File field %q", name)
+		}
+	}
+	return nil
+}
+
 // NamedField returns the Field named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (f *File) NamedField(name string) ([]*FieldType, error) {
@@ -265,6 +495,107 @@ func (f *File) appendNamedField(name string, edges ...*FieldType) {
 	}
 }
 
+// Selected reports whether the given field was loaded into the File, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	f := client.File.Query().Select(file.FieldSize).FirstX(ctx)
+//	f.Selected(file.FieldSize) // true
+//
+func (f *File) Selected(field string) bool {
+	switch field {
+	case file.FieldID:
+		return true
+	case file.FieldSize:
+		return f.selectedFields == nil || f.selectedFields[0]
+	case file.FieldName:
+		return f.selectedFields == nil || f.selectedFields[1]
+	case file.FieldUser:
+		return f.selectedFields == nil || f.selectedFields[2]
+	case file.FieldGroup:
+		return f.selectedFields == nil || f.selectedFields[3]
+	case file.FieldOp:
+		return f.selectedFields == nil || f.selectedFields[4]
+	}
+	return false
+}
+
+// SizeOrErr returns the value of the "size" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the File.
+func (f *File) SizeOrErr() (int, error) {
+	if f.selectedFields != nil && !f.selectedFields[0] {
+		var zero int
+		return zero, &NotSelectedError{label: file.Label, field: file.FieldSize}
+	}
+	return f.Size, nil
+}
+
+// NameOrErr returns the value of the "name" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the File.
+func (f *File) NameOrErr() (string, error) {
+	if f.selectedFields != nil && !f.selectedFields[1] {
+		var zero string
+		return zero, &NotSelectedError{label: file.Label, field: file.FieldName}
+	}
+	return f.Name, nil
+}
+
+// UserOrErr returns the value of the "user" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the File.
+func (f *File) UserOrErr() (*string, error) {
+	if f.selectedFields != nil && !f.selectedFields[2] {
+		var zero *string
+		return zero, &NotSelectedError{label: file.Label, field: file.FieldUser}
+	}
+	return f.User, nil
+}
+
+// GroupOrErr returns the value of the "group" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the File.
+func (f *File) GroupOrErr() (string, error) {
+	if f.selectedFields != nil && !f.selectedFields[3] {
+		var zero string
+		return zero, &NotSelectedError{label: file.Label, field: file.FieldGroup}
+	}
+	return f.Group, nil
+}
+
+// OpOrErr returns the value of the "op" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the File.
+func (f *File) OpOrErr() (bool, error) {
+	if f.selectedFields != nil && !f.selectedFields[4] {
+		var zero bool
+		return zero, &NotSelectedError{label: file.Label, field: file.FieldOp}
+	}
+	return f.Op, nil
+}
+
+// selectFields returns the fields of the File that are included in the given
+// columns, or nil if all of them are included.
+func (*File) selectFields(columns []string) *[5]bool {
+	var selected [5]bool
+	for _, c := range columns {
+		switch c {
+		case file.FieldSize:
+			selected[0] = true
+		case file.FieldName:
+			selected[1] = true
+		case file.FieldUser:
+			selected[2] = true
+		case file.FieldGroup:
+			selected[3] = true
+		case file.FieldOp:
+			selected[4] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // Files is a parsable slice of File.
 type Files []*File
 
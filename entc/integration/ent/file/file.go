@@ -6,6 +6,11 @@
 
 package file
 
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the file type in the database.
 	Label = "file"
@@ -92,4 +97,69 @@ var (
 	SizeValidator func(int) error
 )
 
+// fieldInfos describes the fields of the File type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "size",
+		Column:      FieldSize,
+		StructField: "Size",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Default:     true,
+	},
+	{
+		Name:        "name",
+		Column:      FieldName,
+		StructField: "Name",
+		Type:        field.TypeString,
+		GoType:      "string",
+	},
+	{
+		Name:        "user",
+		Column:      FieldUser,
+		StructField: "User",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "group",
+		Column:      FieldGroup,
+		StructField: "Group",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+	},
+	{
+		Name:        "op",
+		Column:      FieldOp,
+		StructField: "Op",
+		Type:        field.TypeBool,
+		GoType:      "bool",
+		Optional:    true,
+	},
+}
+
+// Fields returns the information of the File fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+	"name",
+}
+
 // comment from another template.
@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -369,6 +370,68 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// FileInsertSelect is the builder for creating File entities from the result of a query.
+type FileInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the File table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+// For example:
+//
+//	client.File.CreateFromQuery(query.Select(...)).
+//		Columns(file.FieldSize).
+//		Exec(ctx)
+//
+func (fis *FileInsertSelect) Columns(columns ...string) *FileInsertSelect {
+	fis.columns = append(fis.columns, columns...)
+	return fis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (fis *FileInsertSelect) Exec(ctx context.Context) (int, error) {
+	if fis.query == nil {
+		return 0, errors.New("ent: missing query for File.CreateFromQuery")
+	}
+	if err := fis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := fis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := fis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !file.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for File.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: file.Table, Columns: file.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, fis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fis *FileInsertSelect) ExecX(ctx context.Context) int {
+	n, err := fis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -412,6 +475,7 @@ type (
 	//  one File node.
 	FileUpsertOne struct {
 		create *FileCreate
+		err    error // first validation error of the update values.
 	}
 
 	// FileUpsert is the "OnConflict" setter.
@@ -546,8 +610,12 @@ func (u *FileUpsertOne) Update(set func(*FileUpsert)) *FileUpsertOne {
 	return u
 }
 
-// SetSize sets the "size" field.
+// SetSize sets the "size" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FileUpsertOne) SetSize(v int) *FileUpsertOne {
+	if err := file.SizeValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+	}
 	return u.Update(func(s *FileUpsert) {
 		s.SetSize(v)
 	})
@@ -646,6 +714,9 @@ func (u *FileUpsertOne) ClearOp() *FileUpsertOne {
 
 // Exec executes the query.
 func (u *FileUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for FileCreate.OnConflict")
 	}
@@ -654,13 +725,16 @@ func (u *FileUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *FileUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *FileUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -677,6 +751,43 @@ func (u *FileUpsertOne) IDX(ctx context.Context) int {
 	return id
 }
 
+// SaveAsync adds the creation of the File entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (fc *FileCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, fc.config, fc.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the File creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (fc *FileCreate) IdempotencyKey(key string) *FileCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &fc.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*File)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from FileMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id int
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&FileClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	fc.hooks = append([]Hook{hook}, fc.hooks...)
+	return fc
+}
+
 // FileCreateBulk is the builder for creating many File entities in bulk.
 type FileCreateBulk struct {
 	config
@@ -763,6 +874,78 @@ func (fcb *FileCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// FileBulkResult holds the result of creating one of the File entities using FileCreateBulk.ContinueOnError.
+type FileBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *File
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the File entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.File.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (fcb *FileCreateBulk) ContinueOnError(ctx context.Context) ([]FileBulkResult, error) {
+	client := &Client{config: fcb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]FileBulkResult, len(fcb.builders))
+	for i, builder := range fcb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = fcb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -804,6 +987,7 @@ func (fcb *FileCreateBulk) OnConflictColumns(columns ...string) *FileUpsertBulk
 // a bulk of File nodes.
 type FileUpsertBulk struct {
 	create *FileCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -848,8 +1032,12 @@ func (u *FileUpsertBulk) Update(set func(*FileUpsert)) *FileUpsertBulk {
 	return u
 }
 
-// SetSize sets the "size" field.
+// SetSize sets the "size" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FileUpsertBulk) SetSize(v int) *FileUpsertBulk {
+	if err := file.SizeValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+	}
 	return u.Update(func(s *FileUpsert) {
 		s.SetSize(v)
 	})
@@ -948,6 +1136,9 @@ func (u *FileUpsertBulk) ClearOp() *FileUpsertBulk {
 
 // Exec executes the query.
 func (u *FileUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FileCreateBulk instead", i)
@@ -961,7 +1152,7 @@ func (u *FileUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *FileUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -95,6 +95,19 @@ func (fd *FileDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the File entities that are joined with the rows of the given table, on the given columns
+// of the File table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.File.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (fd *FileDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *FileDelete {
+	fd.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return fd
+}
+
 // FileDeleteOne is the builder for deleting a single File entity.
 type FileDeleteOne struct {
 	fd *FileDelete
@@ -107,7 +120,7 @@ func (fdo *FileDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{file.Label}
+		return &NotFoundError{label: file.Label}
 	default:
 		return nil
 	}
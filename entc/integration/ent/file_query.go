@@ -9,9 +9,13 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -31,11 +35,13 @@ type FileQuery struct {
 	unique         *bool
 	order          []OrderFunc
 	fields         []string
+	inters         []Interceptor
 	predicates     []predicate.File
 	withOwner      *UserQuery
 	withType       *FileTypeQuery
 	withField      *FieldTypeQuery
 	withFKs        bool
+	loadStrategy   LoadStrategy
 	modifiers      []func(*sql.Selector)
 	withNamedField map[string]*FieldTypeQuery
 	// intermediate query (i.e. traversal path).
@@ -76,7 +82,7 @@ func (fq *FileQuery) Order(o ...OrderFunc) *FileQuery {
 
 // QueryOwner chains the current query on the "owner" edge.
 func (fq *FileQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -98,7 +104,7 @@ func (fq *FileQuery) QueryOwner() *UserQuery {
 
 // QueryType chains the current query on the "type" edge.
 func (fq *FileQuery) QueryType() *FileTypeQuery {
-	query := &FileTypeQuery{config: fq.config}
+	query := (&FileTypeClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -120,7 +126,7 @@ func (fq *FileQuery) QueryType() *FileTypeQuery {
 
 // QueryField chains the current query on the "field" edge.
 func (fq *FileQuery) QueryField() *FieldTypeQuery {
-	query := &FieldTypeQuery{config: fq.config}
+	query := (&FieldTypeClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -148,7 +154,7 @@ func (fq *FileQuery) First(ctx context.Context) (*File, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{file.Label}
+		return nil, fq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -170,7 +176,7 @@ func (fq *FileQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{file.Label}
+		err = fq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -189,6 +195,11 @@ func (fq *FileQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one File entity is found.
 // Returns a *NotFoundError when no File entities are found.
 func (fq *FileQuery) Only(ctx context.Context) (*File, error) {
+	if fq.singleflight != nil && fq.withOwner == nil && fq.withType == nil && fq.withField == nil && len(fq.withNamedField) == 0 {
+		if _, ok := fq.driver.(*txDriver); !ok {
+			return fq.onlyShared(ctx)
+		}
+	}
 	nodes, err := fq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -197,7 +208,7 @@ func (fq *FileQuery) Only(ctx context.Context) (*File, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{file.Label}
+		return nil, fq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{file.Label}
 	}
@@ -224,7 +235,7 @@ func (fq *FileQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{file.Label}
+		err = fq.sqlNotFound()
 	default:
 		err = &NotSingularError{file.Label}
 	}
@@ -245,7 +256,20 @@ func (fq *FileQuery) All(ctx context.Context) ([]*File, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return fq.sqlAll(ctx)
+	if p := fq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return fq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := fq.intercept(ctx, "All", func(ctx context.Context, query *FileQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*File)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*File", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -259,10 +283,20 @@ func (fq *FileQuery) AllX(ctx context.Context) []*File {
 
 // IDs executes the query and returns a list of File IDs.
 func (fq *FileQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := fq.Select(file.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := fq.intercept(ctx, "IDs", func(ctx context.Context, query *FileQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(file.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -280,7 +314,17 @@ func (fq *FileQuery) Count(ctx context.Context) (int, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return fq.sqlCount(ctx)
+	v, err := fq.intercept(ctx, "Count", func(ctx context.Context, query *FileQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -297,7 +341,68 @@ func (fq *FileQuery) Exist(ctx context.Context) (bool, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return fq.sqlExist(ctx)
+	v, err := fq.intercept(ctx, "Exist", func(ctx context.Context, query *FileQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (fq *FileQuery) intercept(ctx context.Context, op string, fn func(context.Context, *FileQuery) (Value, error)) (Value, error) {
+	if len(fq.inters) == 0 {
+		return fn(ctx, fq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeFile,
+		Op:     op,
+		Limit:  fq.limit,
+		Offset: fq.offset,
+		Unique: fq.unique,
+		Fields: fq.fields,
+	}
+	for _, p := range fq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range fq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if fq.withOwner != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, file.EdgeOwner)
+		qc.EdgeQueries[file.EdgeOwner] = fq.withOwner
+	}
+	if fq.withType != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, file.EdgeType)
+		qc.EdgeQueries[file.EdgeType] = fq.withType
+	}
+	if fq.withField != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, file.EdgeField)
+		qc.EdgeQueries[file.EdgeField] = fq.withField
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *FileQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, fq, qc, qr, fq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -320,21 +425,23 @@ func (fq *FileQuery) Clone() *FileQuery {
 		limit:      fq.limit,
 		offset:     fq.offset,
 		order:      append([]OrderFunc{}, fq.order...),
+		inters:     append([]Interceptor{}, fq.inters...),
 		predicates: append([]predicate.File{}, fq.predicates...),
 		withOwner:  fq.withOwner.Clone(),
 		withType:   fq.withType.Clone(),
 		withField:  fq.withField.Clone(),
 		// clone intermediate query.
-		sql:    fq.sql.Clone(),
-		path:   fq.path,
-		unique: fq.unique,
+		sql:          fq.sql.Clone(),
+		path:         fq.path,
+		unique:       fq.unique,
+		loadStrategy: fq.loadStrategy,
 	}
 }
 
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithOwner(opts ...func(*UserQuery)) *FileQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -345,7 +452,7 @@ func (fq *FileQuery) WithOwner(opts ...func(*UserQuery)) *FileQuery {
 // WithType tells the query-builder to eager-load the nodes that are connected to
 // the "type" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithType(opts ...func(*FileTypeQuery)) *FileQuery {
-	query := &FileTypeQuery{config: fq.config}
+	query := (&FileTypeClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -356,7 +463,7 @@ func (fq *FileQuery) WithType(opts ...func(*FileTypeQuery)) *FileQuery {
 // WithField tells the query-builder to eager-load the nodes that are connected to
 // the "field" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithField(opts ...func(*FieldTypeQuery)) *FileQuery {
-	query := &FieldTypeQuery{config: fq.config}
+	query := (&FieldTypeClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -420,6 +527,16 @@ func (fq *FileQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range fq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fq); err != nil {
+				return err
+			}
+		}
+	}
 	if fq.path != nil {
 		prev, err := fq.path(ctx)
 		if err != nil {
@@ -447,6 +564,12 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, file.ForeignKeys...)
 	}
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[5]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*File).scanValues(nil, columns)
 	}
@@ -454,15 +577,149 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 		node := &File{config: fq.config}
 		nodes = append(nodes, node)
 		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*File).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(fq.modifiers) > 0 {
 		_spec.Modifiers = fq.modifiers
 	}
+	if query := fq.withOwner; query != nil && query.joinable() {
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		var (
+			joined   = query.sqlQuery(ctx)
+			jcolumns = query.querySpec().Node.Columns
+			scan     = _spec.ScanValues
+			assign   = _spec.Assign
+		)
+		selected := (*User).selectFields(nil, jcolumns)
+		joined.Select(joined.Columns(jcolumns...)...)
+		_spec.Modifiers = append(_spec.Modifiers[:len(_spec.Modifiers):len(_spec.Modifiers)], func(s *sql.Selector) {
+			s.LeftJoin(joined).On(s.C(file.OwnerColumn), joined.C(user.FieldID))
+			for _, c := range jcolumns {
+				s.AppendSelect(sql.As(joined.C(c), file.EdgeOwner+"__"+c))
+			}
+		})
+		// The columns of the joined edge are selected last, and are scanned
+		// using nullable scanners, as they are NULL if the edge does not exist.
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			n := len(columns) - len(jcolumns)
+			values, err := scan(columns[:n])
+			if err != nil {
+				return nil, err
+			}
+			jvalues, err := (*User).scanValues(nil, jcolumns)
+			if err != nil {
+				return nil, err
+			}
+			for i := range jvalues {
+				if s, ok := jvalues[i].(interface{ Scan(interface{}) error }); ok {
+					jvalues[i] = &sql.NullScanner{S: s}
+				}
+			}
+			return append(values, jvalues...), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			n := len(columns) - len(jcolumns)
+			if err := assign(columns[:n], values[:n]); err != nil {
+				return err
+			}
+			if id, ok := values[n].(*sql.NullScanner); ok && !id.Valid {
+				return nil
+			}
+			jvalues := make([]interface{}, len(jcolumns))
+			for i, v := range values[n:] {
+				if s, ok := v.(*sql.NullScanner); ok {
+					v = s.S
+				}
+				jvalues[i] = v
+			}
+			neighbor := &User{config: fq.config}
+			neighbor.selectedFields = selected
+			if err := neighbor.assignValues(jcolumns, jvalues); err != nil {
+				return err
+			}
+			nodes[len(nodes)-1].Edges.Owner = neighbor
+			return nil
+		}
+		// The edge is loaded by the join, and not by an additional query.
+		clone := *fq
+		clone.withOwner = nil
+		fq = &clone
+	}
+	if query := fq.withType; query != nil && query.joinable() {
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		var (
+			joined   = query.sqlQuery(ctx)
+			jcolumns = query.querySpec().Node.Columns
+			scan     = _spec.ScanValues
+			assign   = _spec.Assign
+		)
+		selected := (*FileType).selectFields(nil, jcolumns)
+		joined.Select(joined.Columns(jcolumns...)...)
+		_spec.Modifiers = append(_spec.Modifiers[:len(_spec.Modifiers):len(_spec.Modifiers)], func(s *sql.Selector) {
+			s.LeftJoin(joined).On(s.C(file.TypeColumn), joined.C(filetype.FieldID))
+			for _, c := range jcolumns {
+				s.AppendSelect(sql.As(joined.C(c), file.EdgeType+"__"+c))
+			}
+		})
+		// The columns of the joined edge are selected last, and are scanned
+		// using nullable scanners, as they are NULL if the edge does not exist.
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			n := len(columns) - len(jcolumns)
+			values, err := scan(columns[:n])
+			if err != nil {
+				return nil, err
+			}
+			jvalues, err := (*FileType).scanValues(nil, jcolumns)
+			if err != nil {
+				return nil, err
+			}
+			for i := range jvalues {
+				if s, ok := jvalues[i].(interface{ Scan(interface{}) error }); ok {
+					jvalues[i] = &sql.NullScanner{S: s}
+				}
+			}
+			return append(values, jvalues...), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			n := len(columns) - len(jcolumns)
+			if err := assign(columns[:n], values[:n]); err != nil {
+				return err
+			}
+			if id, ok := values[n].(*sql.NullScanner); ok && !id.Valid {
+				return nil
+			}
+			jvalues := make([]interface{}, len(jcolumns))
+			for i, v := range values[n:] {
+				if s, ok := v.(*sql.NullScanner); ok {
+					v = s.S
+				}
+				jvalues[i] = v
+			}
+			neighbor := &FileType{config: fq.config}
+			neighbor.selectedFields = selected
+			if err := neighbor.assignValues(jcolumns, jvalues); err != nil {
+				return err
+			}
+			nodes[len(nodes)-1].Edges.Type = neighbor
+			return nil
+		}
+		// The edge is loaded by the join, and not by an additional query.
+		clone := *fq
+		clone.withType = nil
+		fq = &clone
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, fq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, fq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -596,7 +853,7 @@ func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(fq.fields) > 0 {
 		_spec.Unique = fq.unique != nil && *fq.unique
 	}
-	return sqlgraph.CountNodes(ctx, fq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, fq.queryDriver(), _spec)
 }
 
 func (fq *FileQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -690,6 +947,454 @@ func (fq *FileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (fq *FileQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: file.Label}
+	if len(fq.predicates) > 0 {
+		selector := sql.Dialect(fq.driver.Dialect()).Select().From(sql.Table(file.Table))
+		for _, p := range fq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the FileQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (fq *FileQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.File, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.File(ps[i]))
+	}
+	fq.predicates = append(fq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (fq *FileQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := fq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := fq.querySpec()
+	if len(fq.modifiers) > 0 {
+		_spec.Modifiers = fq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = fq.unique != nil && *fq.unique
+	return sqlgraph.EstimateNodes(ctx, fq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (fq *FileQuery) CountEstimateX(ctx context.Context) int {
+	count, err := fq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// File rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (fq *FileQuery) Sample(percent float64) *FileQuery {
+	fq.predicates = append(fq.predicates, sql.Sample(percent))
+	return fq
+}
+
+// FileIterator iterates over the results of a File query, and decodes them one at a time.
+type FileIterator struct {
+	ctx   context.Context
+	query *FileQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*File
+	node  *File
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the File entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.File.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (fq *FileQuery) Iterate(ctx context.Context, opts ...IterateOption) (*FileIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := fq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &FileIterator{ctx: ctx, query: fq, batch: o.batch}
+	var (
+		withFKs     = fq.withFKs
+		_spec       = fq.querySpec()
+		loadedTypes = [3]bool{
+			fq.withOwner != nil,
+			fq.withType != nil,
+			fq.withField != nil,
+		}
+	)
+	if fq.withOwner != nil || fq.withType != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, file.ForeignKeys...)
+	}
+	var (
+		selected *[5]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*File).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &File{config: fq.config}
+		it.nodes = append(it.nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*File).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(fq.modifiers) > 0 {
+		_spec.Modifiers = fq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, fq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (fq *FileQuery) iterateLoad(ctx context.Context, nodes []*File) ([]*File, error) {
+	query := *fq
+	fq = &query
+	fq.withOwner = fq.withOwner.Clone()
+	fq.withType = fq.withType.Clone()
+	fq.withField = fq.withField.Clone()
+	if named := fq.withNamedField; named != nil {
+		fq.withNamedField = make(map[string]*FieldTypeQuery, len(named))
+		for name, q := range named {
+			fq.withNamedField[name] = q.Clone()
+		}
+	}
+	if query := fq.withOwner; query != nil {
+		if err := fq.loadOwner(ctx, query, nodes, nil,
+			func(n *File, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fq.withType; query != nil {
+		if err := fq.loadType(ctx, query, nodes, nil,
+			func(n *File, e *FileType) { n.Edges.Type = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fq.withField; query != nil {
+		if err := fq.loadField(ctx, query, nodes,
+			func(n *File) { n.Edges.Field = []*FieldType{} },
+			func(n *File, e *FieldType) { n.Edges.Field = append(n.Edges.Field, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range fq.withNamedField {
+		if err := fq.loadField(ctx, query, nodes,
+			func(n *File) { n.appendNamedField(name) },
+			func(n *File, e *FieldType) { n.appendNamedField(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// Next advances the iterator to the next File, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *FileIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*File, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current File of the iterator.
+func (it *FileIterator) Value() *File {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *FileIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *FileIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// Join returns a builder for joining the File entities with the entities of the given table, that
+// belongs to one of the types that are connected to File by an edge. The results are returned
+// as typed rows holding both entities. Note that the joined entities are queried using their own query
+// builder, and therefore, their privacy policies are applied as usual.
+//
+//	rows, err := client.File.Query().
+//		Join(user.Table).
+//		On(left, right).
+//		All(ctx)
+//
+func (fq *FileQuery) Join(table string) *FileJoin {
+	return &FileJoin{query: fq, table: table}
+}
+
+// FileJoinRow is a row that is returned by FileJoin. It holds the File entity,
+// and the joined entity in the field of its type. The rest of the fields are nil.
+type FileJoinRow struct {
+	File      *File
+	User      *User
+	FileType  *FileType
+	FieldType *FieldType
+}
+
+// FileJoin is the builder for joining File entities with the entities of another type.
+type FileJoin struct {
+	query   *FileQuery
+	table   string
+	on      []string
+	columns []string
+}
+
+// On sets the columns of the join condition. The left column belongs
+// to File, and the right column belongs to the joined table.
+func (fj *FileJoin) On(left, right string) *FileJoin {
+	fj.on = []string{left, right}
+	return fj
+}
+
+// Select sets the columns of the joined table to be selected. By default, all columns are selected.
+func (fj *FileJoin) Select(columns ...string) *FileJoin {
+	fj.columns = append(fj.columns, columns...)
+	return fj
+}
+
+// All executes the join query and returns the joined rows.
+func (fj *FileJoin) All(ctx context.Context) ([]*FileJoinRow, error) {
+	if len(fj.on) != 2 {
+		return nil, &ValidationError{Name: fj.table, err: errors.New("ent: missing join condition for File")}
+	}
+	if !file.ValidColumn(fj.on[0]) {
+		return nil, &ValidationError{Name: fj.on[0], err: fmt.Errorf("ent: invalid join column %q for File", fj.on[0])}
+	}
+	var (
+		joined   *sql.Selector
+		jcolumns []string
+		scan     func(*FileJoinRow) ([]interface{}, func([]interface{}) error, error)
+	)
+	switch fj.table {
+	case user.Table:
+		if !user.ValidColumn(fj.on[1]) {
+			return nil, &ValidationError{Name: fj.on[1], err: fmt.Errorf("ent: invalid join column %q for User", fj.on[1])}
+		}
+		query := (&UserClient{config: fj.query.config}).Query()
+		if len(fj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{user.FieldID, fj.on[1]}, fj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = user.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *FileJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.User = &User{config: fj.query.config}
+			values, err := r.User.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.User.assignValues(jcolumns, values) }, err
+		}
+	case filetype.Table:
+		if !filetype.ValidColumn(fj.on[1]) {
+			return nil, &ValidationError{Name: fj.on[1], err: fmt.Errorf("ent: invalid join column %q for FileType", fj.on[1])}
+		}
+		query := (&FileTypeClient{config: fj.query.config}).Query()
+		if len(fj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{filetype.FieldID, fj.on[1]}, fj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = filetype.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *FileJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.FileType = &FileType{config: fj.query.config}
+			values, err := r.FileType.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.FileType.assignValues(jcolumns, values) }, err
+		}
+	case fieldtype.Table:
+		if !fieldtype.ValidColumn(fj.on[1]) {
+			return nil, &ValidationError{Name: fj.on[1], err: fmt.Errorf("ent: invalid join column %q for FieldType", fj.on[1])}
+		}
+		query := (&FieldTypeClient{config: fj.query.config}).Query()
+		if len(fj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{fieldtype.FieldID, fj.on[1]}, fj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = fieldtype.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *FileJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.FieldType = &FieldType{config: fj.query.config}
+			values, err := r.FieldType.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.FieldType.assignValues(jcolumns, values) }, err
+		}
+	default:
+		return nil, &ValidationError{Name: fj.table, err: fmt.Errorf("ent: cannot join File with table %q", fj.table)}
+	}
+	if err := fj.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := fj.query.fields
+	if len(columns) == 0 {
+		columns = file.Columns
+	}
+	selector := fj.query.sqlQuery(ctx)
+	selector.Join(joined).On(selector.C(fj.on[0]), joined.C(fj.on[1]))
+	selector.AppendSelect(joined.Columns(jcolumns...)...)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fj.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*FileJoinRow
+	for rows.Next() {
+		r := &FileJoinRow{File: &File{config: fj.query.config}}
+		values, err := r.File.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		jvalues, assign, err := scan(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(append(values, jvalues...)...); err != nil {
+			return nil, err
+		}
+		if err := r.File.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		if err := assign(jvalues); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fj *FileJoin) AllX(ctx context.Context) []*FileJoinRow {
+	rows, err := fj.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// LoadStrategy sets the strategy for eager-loading the File entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (fq *FileQuery) LoadStrategy(s LoadStrategy) *FileQuery {
+	fq.loadStrategy = s
+	return fq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (fq *FileQuery) joinable() bool {
+	if fq.loadStrategy != LoadStrategyJoin || fq.limit != nil || fq.offset != nil {
+		return false
+	}
+	if fq.withOwner != nil {
+		return false
+	}
+	if fq.withType != nil {
+		return false
+	}
+	if fq.withField != nil || len(fq.withNamedField) > 0 {
+		return false
+	}
+	if len(fq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range fq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -725,7 +1430,7 @@ func (fq *FileQuery) Modify(modifiers ...func(s *sql.Selector)) *FileSelect {
 // WithNamedField tells the query-builder to eager-load the nodes that are connected to the "field"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithNamedField(name string, opts ...func(*FieldTypeQuery)) *FileQuery {
-	query := &FieldTypeQuery{config: fq.config}
+	query := (&FieldTypeClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -736,6 +1441,372 @@ func (fq *FileQuery) WithNamedField(name string, opts ...func(*FieldTypeQuery))
 	return fq
 }
 
+// Project returns a builder for querying the given fields into FileProjection structs,
+// instead of full File entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.File.Query().
+//		Project(file.FieldID, file.FieldSize).
+//		All(ctx)
+//
+func (fq *FileQuery) Project(fields ...string) *FileProject {
+	fq.fields = append(fq.fields, fields...)
+	return &FileProject{query: fq}
+}
+
+// FileProjection is a lightweight projection of the File entity that is returned by
+// FileProject. Fields that were not selected by the query hold their zero values.
+type FileProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int `json:"size,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// User holds the value of the "user" field.
+	User *string `json:"user,omitempty"`
+	// Group holds the value of the "group" field.
+	Group string `json:"group,omitempty"`
+	// Op holds the value of the "op" field.
+	Op bool `json:"op,omitempty"`
+}
+
+// FileProject is the builder for querying File fields into projections.
+type FileProject struct {
+	query *FileQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (fp *FileProject) All(ctx context.Context) ([]*FileProjection, error) {
+	if err := fp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := fp.query.fields
+	if len(columns) == 0 {
+		columns = file.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := fp.query.sqlQuery(ctx).Query()
+	if err := fp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &File{}
+		result []*FileProjection
+	)
+	for rows.Next() {
+		*e = File{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &FileProjection{
+			ID:    e.ID,
+			Size:  e.Size,
+			Name:  e.Name,
+			User:  e.User,
+			Group: e.Group,
+			Op:    e.Op,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fp *FileProject) AllX(ctx context.Context) []*FileProjection {
+	items, err := fp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (fq *FileQuery) onlyShared(ctx context.Context) (*File, error) {
+	if err := fq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	fq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *fq
+	kq.sql = fq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if fq.singleflightScope != nil {
+		scope = fq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := fq.singleflight.DoChan(singleflightKey(scope, file.Label, query, args), func() (interface{}, error) {
+		nodes, err := fq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, fq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{file.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*File)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.File.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (fq *FileQuery) SelectMask(paths ...string) *FileSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case file.FieldID:
+		case file.EdgeOwner, file.EdgeType, file.EdgeField:
+		case "size":
+			fields = append(fields, file.FieldSize)
+		case "name":
+			fields = append(fields, file.FieldName)
+		case "user":
+			fields = append(fields, file.FieldUser)
+		case "group":
+			fields = append(fields, file.FieldGroup)
+		case "op":
+			fields = append(fields, file.FieldOp)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, file.FieldID)
+	}
+	return fq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the file.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (fq *FileQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = file.FieldID
+	case "name":
+		column = file.FieldName
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering File`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		fq.Order(Asc(column))
+	case DirectionDesc:
+		fq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// FilePage is a page of File entities returned by the Paginate method.
+type FilePage struct {
+	Nodes []*File `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of File entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.File.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.File.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (fq *FileQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*FilePage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case file.FieldID:
+			order.column = file.FieldID
+		case file.FieldName:
+			order.column = file.FieldName
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating File`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != file.FieldID {
+		order := paginateOrder{name: file.FieldID, column: file.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := fq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &FilePage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case file.FieldID:
+				values[j] = n.ID
+			case file.FieldName:
+				values[j] = n.Name
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (fq *FileQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case file.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		case file.FieldName:
+			var v string
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (fq *FileQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*File, error) {
+	if fq.limit != nil {
+		return fq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	fq.limit = &limit
+	defer func() { fq.limit = nil }()
+	nodes, err := fq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: file.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // FileGroupBy is the group-by builder for File entities.
 type FileGroupBy struct {
 	config
@@ -775,7 +1846,7 @@ func (fgb *FileGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := fgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := fgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -821,7 +1892,7 @@ func (fs *FileSelect) Scan(ctx context.Context, v interface{}) error {
 func (fs *FileSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := fs.sql.Query()
-	if err := fs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := fs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
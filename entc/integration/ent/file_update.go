@@ -55,6 +55,25 @@ func (fu *FileUpdate) AddSize(i int) *FileUpdate {
 	return fu
 }
 
+// SetSizeIfGreater sets the "size" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (fu *FileUpdate) SetSizeIfGreater(i int) *FileUpdate {
+	fu.mutation.SetSizeIfGreater(i)
+	return fu
+}
+
+// BitAndSize applies a bitwise AND with i on the "size" field in the database.
+func (fu *FileUpdate) BitAndSize(i int) *FileUpdate {
+	fu.mutation.BitAndSize(i)
+	return fu
+}
+
+// BitOrSize applies a bitwise OR with i on the "size" field in the database.
+func (fu *FileUpdate) BitOrSize(i int) *FileUpdate {
+	fu.mutation.BitOrSize(i)
+	return fu
+}
+
 // SetName sets the "name" field.
 func (fu *FileUpdate) SetName(s string) *FileUpdate {
 	fu.mutation.SetName(s)
@@ -314,6 +333,27 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: file.FieldSize,
 		})
 	}
+	if value, ok := fu.mutation.SizeIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldSize,
+		})
+	}
+	if value, ok := fu.mutation.SizeBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldSize,
+		})
+	}
+	if value, ok := fu.mutation.SizeBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldSize,
+		})
+	}
 	if value, ok := fu.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -486,7 +526,7 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{file.Label}
+			err = &NotFoundError{label: file.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -498,9 +538,18 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // FileUpdateOne is the builder for updating a single File entity.
 type FileUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FileMutation
+	fields     []string
+	conditions []predicate.File
+	hooks      []Hook
+	mutation   *FileMutation
+}
+
+// Where appends a list of conditions to the FileUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (fuo *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
+	fuo.conditions = append(fuo.conditions, ps...)
+	return fuo
 }
 
 // SetSize sets the "size" field.
@@ -524,6 +573,25 @@ func (fuo *FileUpdateOne) AddSize(i int) *FileUpdateOne {
 	return fuo
 }
 
+// SetSizeIfGreater sets the "size" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (fuo *FileUpdateOne) SetSizeIfGreater(i int) *FileUpdateOne {
+	fuo.mutation.SetSizeIfGreater(i)
+	return fuo
+}
+
+// BitAndSize applies a bitwise AND with i on the "size" field in the database.
+func (fuo *FileUpdateOne) BitAndSize(i int) *FileUpdateOne {
+	fuo.mutation.BitAndSize(i)
+	return fuo
+}
+
+// BitOrSize applies a bitwise OR with i on the "size" field in the database.
+func (fuo *FileUpdateOne) BitOrSize(i int) *FileUpdateOne {
+	fuo.mutation.BitOrSize(i)
+	return fuo
+}
+
 // SetName sets the "name" field.
 func (fuo *FileUpdateOne) SetName(s string) *FileUpdateOne {
 	fuo.mutation.SetName(s)
@@ -799,6 +867,13 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 			}
 		}
 	}
+	if ps := fuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := fuo.mutation.Size(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -813,6 +888,27 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 			Column: file.FieldSize,
 		})
 	}
+	if value, ok := fuo.mutation.SizeIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldSize,
+		})
+	}
+	if value, ok := fuo.mutation.SizeBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldSize,
+		})
+	}
+	if value, ok := fuo.mutation.SizeBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldSize,
+		})
+	}
 	if value, ok := fuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -988,7 +1084,9 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{file.Label}
+			err = &NotFoundError{label: file.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: file.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -996,3 +1094,108 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the File entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (fuo *FileUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := fuo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "File.id" for update`)}
+	}
+	return enqueueAsync(ctx, fuo.config, fuo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by File.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (fu *FileUpdate) SetChanged(changes []FieldChange) (*FileUpdate, error) {
+	if err := setFileChanged(fu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return fu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by File.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (fuo *FileUpdateOne) SetChanged(changes []FieldChange) (*FileUpdateOne, error) {
+	if err := setFileChanged(fuo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return fuo, nil
+}
+
+// setFileChanged applies the given field changes on the File mutation.
+func setFileChanged(m *FileMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the File entities that are joined with the rows of the given table, on the given columns
+// of the File table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.File.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (fu *FileUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *FileUpdate {
+	fu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return fu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the File schema.
+func (fu *FileUpdate) ApplyPatch(p *FilePatch) (*FileUpdate, error) {
+	if err := applyFilePatch(fu.mutation, p); err != nil {
+		return nil, err
+	}
+	return fu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the File schema.
+func (fuo *FileUpdateOne) ApplyPatch(p *FilePatch) (*FileUpdateOne, error) {
+	if err := applyFilePatch(fuo.mutation, p); err != nil {
+		return nil, err
+	}
+	return fuo, nil
+}
+
+// applyFilePatch applies the given patch on the File mutation.
+func applyFilePatch(m *FileMutation, p *FilePatch) error {
+	if p.Size != nil {
+		m.SetSize(*p.Size)
+	}
+	if p.Name != nil {
+		m.SetName(*p.Name)
+	}
+	if p.User != nil {
+		m.SetUser(*p.User)
+	}
+	if p.Group != nil {
+		m.SetGroup(*p.Group)
+	}
+	if p.Op != nil {
+		m.SetOp(*p.Op)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
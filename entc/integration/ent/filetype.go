@@ -7,7 +7,9 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -28,6 +30,9 @@ type FileType struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileTypeQuery when eager-loading is set.
 	Edges FileTypeEdges `json:"edges"`
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[3]bool
 }
 
 // FileTypeEdges holds the relations/edges for other nodes in the graph.
@@ -142,6 +147,159 @@ func (ft *FileType) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the FileType and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (ft *FileType) Diff(other *FileType) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(ft.Name, other.Name) {
+		changes = append(changes, FieldChange{Field: filetype.FieldName, Old: ft.Name, New: other.Name})
+	}
+	if !reflect.DeepEqual(ft.Type, other.Type) {
+		changes = append(changes, FieldChange{Field: filetype.FieldType, Old: ft.Type, New: other.Type})
+	}
+	if !reflect.DeepEqual(ft.State, other.State) {
+		changes = append(changes, FieldChange{Field: filetype.FieldState, Old: ft.State, New: other.State})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the FileType that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (ft *FileType) ApplyMask(paths ...string) (*FileType, error) {
+	if len(paths) == 0 {
+		masked := *ft
+		return &masked, nil
+	}
+	masked := &FileType{config: ft.config, ID: ft.ID}
+	// Nested paths of the masked edges. A nil
+	// slice means that the entire edge is masked.
+	edges := make(map[string][]string)
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case filetype.FieldID:
+		case "name":
+			masked.Name = ft.Name
+		case "type":
+			masked.Type = ft.Type
+		case "state":
+			masked.State = ft.State
+		case filetype.EdgeFiles:
+			if name == p {
+				edges[name] = nil
+			} else if sub, ok := edges[name]; !ok || sub != nil {
+				edges[name] = append(sub, p[len(name)+1:])
+			}
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type FileType", p)
+		}
+	}
+	if sub, ok := edges[filetype.EdgeFiles]; ok {
+		masked.Edges.loadedTypes[0] = ft.Edges.loadedTypes[0]
+		for _, n := range ft.Edges.Files {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Files = append(masked.Edges.Files, mn)
+		}
+	}
+	return masked, nil
+}
+
+// FileTypePatch describes a partial update of a FileType entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type FileTypePatch struct {
+	// Name holds the new value of the "name" field.
+	Name *string `json:"name,omitempty"`
+	// Type holds the new value of the "type" field.
+	Type *filetype.Type `json:"type,omitempty"`
+	// State holds the new value of the "state" field.
+	State *filetype.State `json:"state,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, filetype.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *FileTypePatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p FileTypePatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.Name != nil {
+		fields["name"] = *p.Name
+	}
+	if p.Type != nil {
+		fields["type"] = *p.Type
+	}
+	if p.State != nil {
+		fields["state"] = *p.State
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional FileType field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *FileTypePatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "name":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FileType field %q cannot be null", name)
+			}
+			p.Name = new(string)
+			if err := json.Unmarshal(raw, p.Name); err != nil {
+				return fmt.Errorf("ent: decoding FileType field %q: %w", name, err)
+			}
+		case "type":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FileType field %q cannot be null", name)
+			}
+			p.Type = new(filetype.Type)
+			if err := json.Unmarshal(raw, p.Type); err != nil {
+				return fmt.Errorf("ent: decoding FileType field %q: %w", name, err)
+			}
+		case "state":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: FileType field %q cannot be null", name)
+			}
+			p.State = new(filetype.State)
+			if err := json.Unmarshal(raw, p.State); err != nil {
+				return fmt.Errorf("ent: decoding FileType field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable FileType field %q", name)
+		}
+	}
+	return nil
+}
+
 // NamedFiles returns the Files named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (ft *FileType) NamedFiles(name string) ([]*File, error) {
@@ -166,6 +324,79 @@ func (ft *FileType) appendNamedFiles(name string, edges ...*File) {
 	}
 }
 
+// Selected reports whether the given field was loaded into the FileType, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	ft := client.FileType.Query().Select(filetype.FieldName).FirstX(ctx)
+//	ft.Selected(filetype.FieldName) // true
+//
+func (ft *FileType) Selected(field string) bool {
+	switch field {
+	case filetype.FieldID:
+		return true
+	case filetype.FieldName:
+		return ft.selectedFields == nil || ft.selectedFields[0]
+	case filetype.FieldType:
+		return ft.selectedFields == nil || ft.selectedFields[1]
+	case filetype.FieldState:
+		return ft.selectedFields == nil || ft.selectedFields[2]
+	}
+	return false
+}
+
+// NameOrErr returns the value of the "name" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FileType.
+func (ft *FileType) NameOrErr() (string, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[0] {
+		var zero string
+		return zero, &NotSelectedError{label: filetype.Label, field: filetype.FieldName}
+	}
+	return ft.Name, nil
+}
+
+// TypeOrErr returns the value of the "type" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FileType.
+func (ft *FileType) TypeOrErr() (filetype.Type, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[1] {
+		var zero filetype.Type
+		return zero, &NotSelectedError{label: filetype.Label, field: filetype.FieldType}
+	}
+	return ft.Type, nil
+}
+
+// StateOrErr returns the value of the "state" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the FileType.
+func (ft *FileType) StateOrErr() (filetype.State, error) {
+	if ft.selectedFields != nil && !ft.selectedFields[2] {
+		var zero filetype.State
+		return zero, &NotSelectedError{label: filetype.Label, field: filetype.FieldState}
+	}
+	return ft.State, nil
+}
+
+// selectFields returns the fields of the FileType that are included in the given
+// columns, or nil if all of them are included.
+func (*FileType) selectFields(columns []string) *[3]bool {
+	var selected [3]bool
+	for _, c := range columns {
+		switch c {
+		case filetype.FieldName:
+			selected[0] = true
+		case filetype.FieldType:
+			selected[1] = true
+		case filetype.FieldState:
+			selected[2] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // FileTypes is a parsable slice of FileType.
 type FileTypes []*FileType
 
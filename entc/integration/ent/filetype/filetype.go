@@ -8,6 +8,9 @@ package filetype
 
 import (
 	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
 )
 
 const (
@@ -115,4 +118,55 @@ func (s State) Ptr() *State {
 	return &s
 }
 
+// fieldInfos describes the fields of the FileType type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "name",
+		Column:      FieldName,
+		StructField: "Name",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Unique:      true,
+	},
+	{
+		Name:        "type",
+		Column:      FieldType,
+		StructField: "Type",
+		Type:        field.TypeEnum,
+		GoType:      "filetype.Type",
+		Enums:       []string{"png", "svg", "jpg"},
+		Default:     true,
+	},
+	{
+		Name:        "state",
+		Column:      FieldState,
+		StructField: "State",
+		Type:        field.TypeEnum,
+		GoType:      "filetype.State",
+		Enums:       []string{"ON", "OFF"},
+		Default:     true,
+	},
+}
+
+// Fields returns the information of the FileType fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+	"name",
+}
+
 // comment from another template.
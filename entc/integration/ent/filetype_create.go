@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -257,6 +258,68 @@ func (ftc *FileTypeCreate) createSpec() (*FileType, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// FileTypeInsertSelect is the builder for creating FileType entities from the result of a query.
+type FileTypeInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the FileType table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+// For example:
+//
+//	client.FileType.CreateFromQuery(query.Select(...)).
+//		Columns(filetype.FieldName).
+//		Exec(ctx)
+//
+func (ftis *FileTypeInsertSelect) Columns(columns ...string) *FileTypeInsertSelect {
+	ftis.columns = append(ftis.columns, columns...)
+	return ftis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (ftis *FileTypeInsertSelect) Exec(ctx context.Context) (int, error) {
+	if ftis.query == nil {
+		return 0, errors.New("ent: missing query for FileType.CreateFromQuery")
+	}
+	if err := ftis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := ftis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := ftis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !filetype.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for FileType.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: filetype.Table, Columns: filetype.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, ftis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftis *FileTypeInsertSelect) ExecX(ctx context.Context) int {
+	n, err := ftis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -300,6 +363,7 @@ type (
 	//  one FileType node.
 	FileTypeUpsertOne struct {
 		create *FileTypeCreate
+		err    error // first validation error of the update values.
 	}
 
 	// FileTypeUpsert is the "OnConflict" setter.
@@ -400,8 +464,12 @@ func (u *FileTypeUpsertOne) UpdateName() *FileTypeUpsertOne {
 	})
 }
 
-// SetType sets the "type" field.
+// SetType sets the "type" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FileTypeUpsertOne) SetType(v filetype.Type) *FileTypeUpsertOne {
+	if err := filetype.TypeValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)}
+	}
 	return u.Update(func(s *FileTypeUpsert) {
 		s.SetType(v)
 	})
@@ -414,8 +482,12 @@ func (u *FileTypeUpsertOne) UpdateType() *FileTypeUpsertOne {
 	})
 }
 
-// SetState sets the "state" field.
+// SetState sets the "state" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FileTypeUpsertOne) SetState(v filetype.State) *FileTypeUpsertOne {
+	if err := filetype.StateValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)}
+	}
 	return u.Update(func(s *FileTypeUpsert) {
 		s.SetState(v)
 	})
@@ -430,6 +502,9 @@ func (u *FileTypeUpsertOne) UpdateState() *FileTypeUpsertOne {
 
 // Exec executes the query.
 func (u *FileTypeUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for FileTypeCreate.OnConflict")
 	}
@@ -438,13 +513,16 @@ func (u *FileTypeUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *FileTypeUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *FileTypeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -461,6 +539,43 @@ func (u *FileTypeUpsertOne) IDX(ctx context.Context) int {
 	return id
 }
 
+// SaveAsync adds the creation of the FileType entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (ftc *FileTypeCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, ftc.config, ftc.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the FileType creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (ftc *FileTypeCreate) IdempotencyKey(key string) *FileTypeCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &ftc.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*FileType)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from FileTypeMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id int
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&FileTypeClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	ftc.hooks = append([]Hook{hook}, ftc.hooks...)
+	return ftc
+}
+
 // FileTypeCreateBulk is the builder for creating many FileType entities in bulk.
 type FileTypeCreateBulk struct {
 	config
@@ -547,6 +662,78 @@ func (ftcb *FileTypeCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// FileTypeBulkResult holds the result of creating one of the FileType entities using FileTypeCreateBulk.ContinueOnError.
+type FileTypeBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *FileType
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the FileType entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.FileType.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (ftcb *FileTypeCreateBulk) ContinueOnError(ctx context.Context) ([]FileTypeBulkResult, error) {
+	client := &Client{config: ftcb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]FileTypeBulkResult, len(ftcb.builders))
+	for i, builder := range ftcb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = ftcb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -588,6 +775,7 @@ func (ftcb *FileTypeCreateBulk) OnConflictColumns(columns ...string) *FileTypeUp
 // a bulk of FileType nodes.
 type FileTypeUpsertBulk struct {
 	create *FileTypeCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -646,8 +834,12 @@ func (u *FileTypeUpsertBulk) UpdateName() *FileTypeUpsertBulk {
 	})
 }
 
-// SetType sets the "type" field.
+// SetType sets the "type" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FileTypeUpsertBulk) SetType(v filetype.Type) *FileTypeUpsertBulk {
+	if err := filetype.TypeValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)}
+	}
 	return u.Update(func(s *FileTypeUpsert) {
 		s.SetType(v)
 	})
@@ -660,8 +852,12 @@ func (u *FileTypeUpsertBulk) UpdateType() *FileTypeUpsertBulk {
 	})
 }
 
-// SetState sets the "state" field.
+// SetState sets the "state" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *FileTypeUpsertBulk) SetState(v filetype.State) *FileTypeUpsertBulk {
+	if err := filetype.StateValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)}
+	}
 	return u.Update(func(s *FileTypeUpsert) {
 		s.SetState(v)
 	})
@@ -676,6 +872,9 @@ func (u *FileTypeUpsertBulk) UpdateState() *FileTypeUpsertBulk {
 
 // Exec executes the query.
 func (u *FileTypeUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FileTypeCreateBulk instead", i)
@@ -689,7 +888,7 @@ func (u *FileTypeUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *FileTypeUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -95,6 +95,19 @@ func (ftd *FileTypeDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the FileType entities that are joined with the rows of the given table, on the given columns
+// of the FileType table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.FileType.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (ftd *FileTypeDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *FileTypeDelete {
+	ftd.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return ftd
+}
+
 // FileTypeDeleteOne is the builder for deleting a single FileType entity.
 type FileTypeDeleteOne struct {
 	ftd *FileTypeDelete
@@ -107,7 +120,7 @@ func (ftdo *FileTypeDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{filetype.Label}
+		return &NotFoundError{label: filetype.Label}
 	default:
 		return nil
 	}
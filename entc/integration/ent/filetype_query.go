@@ -9,9 +9,13 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -29,8 +33,10 @@ type FileTypeQuery struct {
 	unique         *bool
 	order          []OrderFunc
 	fields         []string
+	inters         []Interceptor
 	predicates     []predicate.FileType
 	withFiles      *FileQuery
+	loadStrategy   LoadStrategy
 	modifiers      []func(*sql.Selector)
 	withNamedFiles map[string]*FileQuery
 	// intermediate query (i.e. traversal path).
@@ -71,7 +77,7 @@ func (ftq *FileTypeQuery) Order(o ...OrderFunc) *FileTypeQuery {
 
 // QueryFiles chains the current query on the "files" edge.
 func (ftq *FileTypeQuery) QueryFiles() *FileQuery {
-	query := &FileQuery{config: ftq.config}
+	query := (&FileClient{config: ftq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := ftq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -99,7 +105,7 @@ func (ftq *FileTypeQuery) First(ctx context.Context) (*FileType, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{filetype.Label}
+		return nil, ftq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -121,7 +127,7 @@ func (ftq *FileTypeQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{filetype.Label}
+		err = ftq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -140,6 +146,11 @@ func (ftq *FileTypeQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one FileType entity is found.
 // Returns a *NotFoundError when no FileType entities are found.
 func (ftq *FileTypeQuery) Only(ctx context.Context) (*FileType, error) {
+	if ftq.singleflight != nil && ftq.withFiles == nil && len(ftq.withNamedFiles) == 0 {
+		if _, ok := ftq.driver.(*txDriver); !ok {
+			return ftq.onlyShared(ctx)
+		}
+	}
 	nodes, err := ftq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -148,7 +159,7 @@ func (ftq *FileTypeQuery) Only(ctx context.Context) (*FileType, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{filetype.Label}
+		return nil, ftq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{filetype.Label}
 	}
@@ -175,7 +186,7 @@ func (ftq *FileTypeQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{filetype.Label}
+		err = ftq.sqlNotFound()
 	default:
 		err = &NotSingularError{filetype.Label}
 	}
@@ -196,7 +207,20 @@ func (ftq *FileTypeQuery) All(ctx context.Context) ([]*FileType, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ftq.sqlAll(ctx)
+	if p := ftq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return ftq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := ftq.intercept(ctx, "All", func(ctx context.Context, query *FileTypeQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*FileType)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*FileType", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -210,10 +234,20 @@ func (ftq *FileTypeQuery) AllX(ctx context.Context) []*FileType {
 
 // IDs executes the query and returns a list of FileType IDs.
 func (ftq *FileTypeQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := ftq.Select(filetype.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := ftq.intercept(ctx, "IDs", func(ctx context.Context, query *FileTypeQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(filetype.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -231,7 +265,17 @@ func (ftq *FileTypeQuery) Count(ctx context.Context) (int, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ftq.sqlCount(ctx)
+	v, err := ftq.intercept(ctx, "Count", func(ctx context.Context, query *FileTypeQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -248,7 +292,54 @@ func (ftq *FileTypeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ftq.sqlExist(ctx)
+	v, err := ftq.intercept(ctx, "Exist", func(ctx context.Context, query *FileTypeQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (ftq *FileTypeQuery) intercept(ctx context.Context, op string, fn func(context.Context, *FileTypeQuery) (Value, error)) (Value, error) {
+	if len(ftq.inters) == 0 {
+		return fn(ctx, ftq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeFileType,
+		Op:     op,
+		Limit:  ftq.limit,
+		Offset: ftq.offset,
+		Unique: ftq.unique,
+		Fields: ftq.fields,
+	}
+	for _, p := range ftq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range ftq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if ftq.withFiles != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, filetype.EdgeFiles)
+		qc.EdgeQueries[filetype.EdgeFiles] = ftq.withFiles
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *FileTypeQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, ftq, qc, qr, ftq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -271,19 +362,21 @@ func (ftq *FileTypeQuery) Clone() *FileTypeQuery {
 		limit:      ftq.limit,
 		offset:     ftq.offset,
 		order:      append([]OrderFunc{}, ftq.order...),
+		inters:     append([]Interceptor{}, ftq.inters...),
 		predicates: append([]predicate.FileType{}, ftq.predicates...),
 		withFiles:  ftq.withFiles.Clone(),
 		// clone intermediate query.
-		sql:    ftq.sql.Clone(),
-		path:   ftq.path,
-		unique: ftq.unique,
+		sql:          ftq.sql.Clone(),
+		path:         ftq.path,
+		unique:       ftq.unique,
+		loadStrategy: ftq.loadStrategy,
 	}
 }
 
 // WithFiles tells the query-builder to eager-load the nodes that are connected to
 // the "files" edge. The optional arguments are used to configure the query builder of the edge.
 func (ftq *FileTypeQuery) WithFiles(opts ...func(*FileQuery)) *FileTypeQuery {
-	query := &FileQuery{config: ftq.config}
+	query := (&FileClient{config: ftq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -347,6 +440,16 @@ func (ftq *FileTypeQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range ftq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ftq); err != nil {
+				return err
+			}
+		}
+	}
 	if ftq.path != nil {
 		prev, err := ftq.path(ctx)
 		if err != nil {
@@ -365,6 +468,12 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fi
 			ftq.withFiles != nil,
 		}
 	)
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[3]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*FileType).scanValues(nil, columns)
 	}
@@ -372,6 +481,10 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fi
 		node := &FileType{config: ftq.config}
 		nodes = append(nodes, node)
 		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*FileType).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(ftq.modifiers) > 0 {
@@ -380,7 +493,7 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fi
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, ftq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, ftq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -444,7 +557,7 @@ func (ftq *FileTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(ftq.fields) > 0 {
 		_spec.Unique = ftq.unique != nil && *ftq.unique
 	}
-	return sqlgraph.CountNodes(ctx, ftq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, ftq.queryDriver(), _spec)
 }
 
 func (ftq *FileTypeQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -538,6 +651,369 @@ func (ftq *FileTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (ftq *FileTypeQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: filetype.Label}
+	if len(ftq.predicates) > 0 {
+		selector := sql.Dialect(ftq.driver.Dialect()).Select().From(sql.Table(filetype.Table))
+		for _, p := range ftq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the FileTypeQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (ftq *FileTypeQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.FileType, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.FileType(ps[i]))
+	}
+	ftq.predicates = append(ftq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (ftq *FileTypeQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := ftq.querySpec()
+	if len(ftq.modifiers) > 0 {
+		_spec.Modifiers = ftq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = ftq.unique != nil && *ftq.unique
+	return sqlgraph.EstimateNodes(ctx, ftq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (ftq *FileTypeQuery) CountEstimateX(ctx context.Context) int {
+	count, err := ftq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// FileType rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (ftq *FileTypeQuery) Sample(percent float64) *FileTypeQuery {
+	ftq.predicates = append(ftq.predicates, sql.Sample(percent))
+	return ftq
+}
+
+// FileTypeIterator iterates over the results of a FileType query, and decodes them one at a time.
+type FileTypeIterator struct {
+	ctx   context.Context
+	query *FileTypeQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*FileType
+	node  *FileType
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the FileType entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.FileType.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (ftq *FileTypeQuery) Iterate(ctx context.Context, opts ...IterateOption) (*FileTypeIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &FileTypeIterator{ctx: ctx, query: ftq, batch: o.batch}
+	var (
+		_spec       = ftq.querySpec()
+		loadedTypes = [1]bool{
+			ftq.withFiles != nil,
+		}
+	)
+	var (
+		selected *[3]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*FileType).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &FileType{config: ftq.config}
+		it.nodes = append(it.nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*FileType).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(ftq.modifiers) > 0 {
+		_spec.Modifiers = ftq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, ftq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (ftq *FileTypeQuery) iterateLoad(ctx context.Context, nodes []*FileType) ([]*FileType, error) {
+	query := *ftq
+	ftq = &query
+	ftq.withFiles = ftq.withFiles.Clone()
+	if named := ftq.withNamedFiles; named != nil {
+		ftq.withNamedFiles = make(map[string]*FileQuery, len(named))
+		for name, q := range named {
+			ftq.withNamedFiles[name] = q.Clone()
+		}
+	}
+	if query := ftq.withFiles; query != nil {
+		if err := ftq.loadFiles(ctx, query, nodes,
+			func(n *FileType) { n.Edges.Files = []*File{} },
+			func(n *FileType, e *File) { n.Edges.Files = append(n.Edges.Files, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range ftq.withNamedFiles {
+		if err := ftq.loadFiles(ctx, query, nodes,
+			func(n *FileType) { n.appendNamedFiles(name) },
+			func(n *FileType, e *File) { n.appendNamedFiles(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// Next advances the iterator to the next FileType, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *FileTypeIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*FileType, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current FileType of the iterator.
+func (it *FileTypeIterator) Value() *FileType {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *FileTypeIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *FileTypeIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// Join returns a builder for joining the FileType entities with the entities of the given table, that
+// belongs to one of the types that are connected to FileType by an edge. The results are returned
+// as typed rows holding both entities. Note that the joined entities are queried using their own query
+// builder, and therefore, their privacy policies are applied as usual.
+//
+//	rows, err := client.FileType.Query().
+//		Join(file.Table).
+//		On(left, right).
+//		All(ctx)
+//
+func (ftq *FileTypeQuery) Join(table string) *FileTypeJoin {
+	return &FileTypeJoin{query: ftq, table: table}
+}
+
+// FileTypeJoinRow is a row that is returned by FileTypeJoin. It holds the FileType entity,
+// and the joined entity in the field of its type. The rest of the fields are nil.
+type FileTypeJoinRow struct {
+	FileType *FileType
+	File     *File
+}
+
+// FileTypeJoin is the builder for joining FileType entities with the entities of another type.
+type FileTypeJoin struct {
+	query   *FileTypeQuery
+	table   string
+	on      []string
+	columns []string
+}
+
+// On sets the columns of the join condition. The left column belongs
+// to FileType, and the right column belongs to the joined table.
+func (ftj *FileTypeJoin) On(left, right string) *FileTypeJoin {
+	ftj.on = []string{left, right}
+	return ftj
+}
+
+// Select sets the columns of the joined table to be selected. By default, all columns are selected.
+func (ftj *FileTypeJoin) Select(columns ...string) *FileTypeJoin {
+	ftj.columns = append(ftj.columns, columns...)
+	return ftj
+}
+
+// All executes the join query and returns the joined rows.
+func (ftj *FileTypeJoin) All(ctx context.Context) ([]*FileTypeJoinRow, error) {
+	if len(ftj.on) != 2 {
+		return nil, &ValidationError{Name: ftj.table, err: errors.New("ent: missing join condition for FileType")}
+	}
+	if !filetype.ValidColumn(ftj.on[0]) {
+		return nil, &ValidationError{Name: ftj.on[0], err: fmt.Errorf("ent: invalid join column %q for FileType", ftj.on[0])}
+	}
+	var (
+		joined   *sql.Selector
+		jcolumns []string
+		scan     func(*FileTypeJoinRow) ([]interface{}, func([]interface{}) error, error)
+	)
+	switch ftj.table {
+	case file.Table:
+		if !file.ValidColumn(ftj.on[1]) {
+			return nil, &ValidationError{Name: ftj.on[1], err: fmt.Errorf("ent: invalid join column %q for File", ftj.on[1])}
+		}
+		query := (&FileClient{config: ftj.query.config}).Query()
+		if len(ftj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{file.FieldID, ftj.on[1]}, ftj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = file.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *FileTypeJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.File = &File{config: ftj.query.config}
+			values, err := r.File.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.File.assignValues(jcolumns, values) }, err
+		}
+	default:
+		return nil, &ValidationError{Name: ftj.table, err: fmt.Errorf("ent: cannot join FileType with table %q", ftj.table)}
+	}
+	if err := ftj.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := ftj.query.fields
+	if len(columns) == 0 {
+		columns = filetype.Columns
+	}
+	selector := ftj.query.sqlQuery(ctx)
+	selector.Join(joined).On(selector.C(ftj.on[0]), joined.C(ftj.on[1]))
+	selector.AppendSelect(joined.Columns(jcolumns...)...)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ftj.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*FileTypeJoinRow
+	for rows.Next() {
+		r := &FileTypeJoinRow{FileType: &FileType{config: ftj.query.config}}
+		values, err := r.FileType.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		jvalues, assign, err := scan(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(append(values, jvalues...)...); err != nil {
+			return nil, err
+		}
+		if err := r.FileType.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		if err := assign(jvalues); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ftj *FileTypeJoin) AllX(ctx context.Context) []*FileTypeJoinRow {
+	rows, err := ftj.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// LoadStrategy sets the strategy for eager-loading the FileType entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (ftq *FileTypeQuery) LoadStrategy(s LoadStrategy) *FileTypeQuery {
+	ftq.loadStrategy = s
+	return ftq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (ftq *FileTypeQuery) joinable() bool {
+	if ftq.loadStrategy != LoadStrategyJoin || ftq.limit != nil || ftq.offset != nil {
+		return false
+	}
+	if ftq.withFiles != nil || len(ftq.withNamedFiles) > 0 {
+		return false
+	}
+	if len(ftq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range ftq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -573,7 +1049,7 @@ func (ftq *FileTypeQuery) Modify(modifiers ...func(s *sql.Selector)) *FileTypeSe
 // WithNamedFiles tells the query-builder to eager-load the nodes that are connected to the "files"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (ftq *FileTypeQuery) WithNamedFiles(name string, opts ...func(*FileQuery)) *FileTypeQuery {
-	query := &FileQuery{config: ftq.config}
+	query := (&FileClient{config: ftq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -584,6 +1060,362 @@ func (ftq *FileTypeQuery) WithNamedFiles(name string, opts ...func(*FileQuery))
 	return ftq
 }
 
+// Project returns a builder for querying the given fields into FileTypeProjection structs,
+// instead of full FileType entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.FileType.Query().
+//		Project(filetype.FieldID, filetype.FieldName).
+//		All(ctx)
+//
+func (ftq *FileTypeQuery) Project(fields ...string) *FileTypeProject {
+	ftq.fields = append(ftq.fields, fields...)
+	return &FileTypeProject{query: ftq}
+}
+
+// FileTypeProjection is a lightweight projection of the FileType entity that is returned by
+// FileTypeProject. Fields that were not selected by the query hold their zero values.
+type FileTypeProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Type holds the value of the "type" field.
+	Type filetype.Type `json:"type,omitempty"`
+	// State holds the value of the "state" field.
+	State filetype.State `json:"state,omitempty"`
+}
+
+// FileTypeProject is the builder for querying FileType fields into projections.
+type FileTypeProject struct {
+	query *FileTypeQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (ftp *FileTypeProject) All(ctx context.Context) ([]*FileTypeProjection, error) {
+	if err := ftp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := ftp.query.fields
+	if len(columns) == 0 {
+		columns = filetype.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := ftp.query.sqlQuery(ctx).Query()
+	if err := ftp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &FileType{}
+		result []*FileTypeProjection
+	)
+	for rows.Next() {
+		*e = FileType{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &FileTypeProjection{
+			ID:    e.ID,
+			Name:  e.Name,
+			Type:  e.Type,
+			State: e.State,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ftp *FileTypeProject) AllX(ctx context.Context) []*FileTypeProjection {
+	items, err := ftp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (ftq *FileTypeQuery) onlyShared(ctx context.Context) (*FileType, error) {
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ftq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *ftq
+	kq.sql = ftq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if ftq.singleflightScope != nil {
+		scope = ftq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := ftq.singleflight.DoChan(singleflightKey(scope, filetype.Label, query, args), func() (interface{}, error) {
+		nodes, err := ftq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, ftq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{filetype.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*FileType)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.FileType.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (ftq *FileTypeQuery) SelectMask(paths ...string) *FileTypeSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case filetype.FieldID:
+		case filetype.EdgeFiles:
+		case "name":
+			fields = append(fields, filetype.FieldName)
+		case "type":
+			fields = append(fields, filetype.FieldType)
+		case "state":
+			fields = append(fields, filetype.FieldState)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, filetype.FieldID)
+	}
+	return ftq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the filetype.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (ftq *FileTypeQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = filetype.FieldID
+	case "name":
+		column = filetype.FieldName
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering FileType`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		ftq.Order(Asc(column))
+	case DirectionDesc:
+		ftq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// FileTypePage is a page of FileType entities returned by the Paginate method.
+type FileTypePage struct {
+	Nodes []*FileType `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of FileType entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.FileType.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.FileType.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (ftq *FileTypeQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*FileTypePage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case filetype.FieldID:
+			order.column = filetype.FieldID
+		case filetype.FieldName:
+			order.column = filetype.FieldName
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating FileType`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != filetype.FieldID {
+		order := paginateOrder{name: filetype.FieldID, column: filetype.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := ftq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &FileTypePage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case filetype.FieldID:
+				values[j] = n.ID
+			case filetype.FieldName:
+				values[j] = n.Name
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (ftq *FileTypeQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case filetype.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		case filetype.FieldName:
+			var v string
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (ftq *FileTypeQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*FileType, error) {
+	if ftq.limit != nil {
+		return ftq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	ftq.limit = &limit
+	defer func() { ftq.limit = nil }()
+	nodes, err := ftq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: filetype.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // FileTypeGroupBy is the group-by builder for FileType entities.
 type FileTypeGroupBy struct {
 	config
@@ -623,7 +1455,7 @@ func (ftgb *FileTypeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ftgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ftgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -669,7 +1501,7 @@ func (fts *FileTypeSelect) Scan(ctx context.Context, v interface{}) error {
 func (fts *FileTypeSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := fts.sql.Query()
-	if err := fts.driver.Query(ctx, query, args, rows); err != nil {
+	if err := fts.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
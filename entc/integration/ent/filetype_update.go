@@ -277,7 +277,7 @@ func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ftu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{filetype.Label}
+			err = &NotFoundError{label: filetype.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -289,9 +289,18 @@ func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // FileTypeUpdateOne is the builder for updating a single FileType entity.
 type FileTypeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FileTypeMutation
+	fields     []string
+	conditions []predicate.FileType
+	hooks      []Hook
+	mutation   *FileTypeMutation
+}
+
+// Where appends a list of conditions to the FileTypeUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ftuo *FileTypeUpdateOne) Where(ps ...predicate.FileType) *FileTypeUpdateOne {
+	ftuo.conditions = append(ftuo.conditions, ps...)
+	return ftuo
 }
 
 // SetName sets the "name" field.
@@ -492,6 +501,13 @@ func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (_node *FileType, er
 			}
 		}
 	}
+	if ps := ftuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := ftuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -572,7 +588,9 @@ func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (_node *FileType, er
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, ftuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{filetype.Label}
+			err = &NotFoundError{label: filetype.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: filetype.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -580,3 +598,102 @@ func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (_node *FileType, er
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the FileType entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (ftuo *FileTypeUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := ftuo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "FileType.id" for update`)}
+	}
+	return enqueueAsync(ctx, ftuo.config, ftuo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by FileType.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (ftu *FileTypeUpdate) SetChanged(changes []FieldChange) (*FileTypeUpdate, error) {
+	if err := setFileTypeChanged(ftu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return ftu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by FileType.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (ftuo *FileTypeUpdateOne) SetChanged(changes []FieldChange) (*FileTypeUpdateOne, error) {
+	if err := setFileTypeChanged(ftuo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return ftuo, nil
+}
+
+// setFileTypeChanged applies the given field changes on the FileType mutation.
+func setFileTypeChanged(m *FileTypeMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the FileType entities that are joined with the rows of the given table, on the given columns
+// of the FileType table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.FileType.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (ftu *FileTypeUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *FileTypeUpdate {
+	ftu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return ftu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the FileType schema.
+func (ftu *FileTypeUpdate) ApplyPatch(p *FileTypePatch) (*FileTypeUpdate, error) {
+	if err := applyFileTypePatch(ftu.mutation, p); err != nil {
+		return nil, err
+	}
+	return ftu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the FileType schema.
+func (ftuo *FileTypeUpdateOne) ApplyPatch(p *FileTypePatch) (*FileTypeUpdateOne, error) {
+	if err := applyFileTypePatch(ftuo.mutation, p); err != nil {
+		return nil, err
+	}
+	return ftuo, nil
+}
+
+// applyFileTypePatch applies the given patch on the FileType mutation.
+func applyFileTypePatch(m *FileTypeMutation, p *FileTypePatch) error {
+	if p.Name != nil {
+		m.SetName(*p.Name)
+	}
+	if p.Type != nil {
+		m.SetType(*p.Type)
+	}
+	if p.State != nil {
+		m.SetState(*p.State)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
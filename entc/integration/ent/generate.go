@@ -4,4 +4,4 @@
 
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature entql,sql/modifier,sql/lock,sql/upsert,sql/execquery,namedges --template ./template --header "// Copyright 2019-present Facebook Inc. All rights reserved.\n// This source code is licensed under the Apache 2.0 license found\n// in the LICENSE file in the root directory of this source tree.\n\n// Code generated by ent, DO NOT EDIT." ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature entql,sql/modifier,sql/lock,sql/upsert,sql/execquery,namedges,diff,sql/timebucket,sql/estimate,querylimit,sql/singleflight,sql/async,sql/idempotency,fieldmask,entmiddleware,patch,fieldinfo,orderfield,sql/join,sql/projection,sql/transfer,sql/dedup,sql/snapshot,sql/pagination,sql/iterate,sql/selected,sql/insertselect,sql/savepoint,sql/truncate,sql/loadstrategy,sql/getorcreate --template ./template --header "// Copyright 2019-present Facebook Inc. All rights reserved.\n// This source code is licensed under the Apache 2.0 license found\n// in the LICENSE file in the root directory of this source tree.\n\n// Code generated by ent, DO NOT EDIT." ./schema
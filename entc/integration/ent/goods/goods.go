@@ -6,6 +6,11 @@
 
 package goods
 
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the goods type in the database.
 	Label = "goods"
@@ -30,4 +35,28 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// fieldInfos describes the fields of the Goods type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+}
+
+// Fields returns the information of the Goods fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+}
+
 // comment from another template.
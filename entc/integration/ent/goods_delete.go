@@ -95,6 +95,19 @@ func (gd *GoodsDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the Goods entities that are joined with the rows of the given table, on the given columns
+// of the Goods table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.Goods.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (gd *GoodsDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *GoodsDelete {
+	gd.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return gd
+}
+
 // GoodsDeleteOne is the builder for deleting a single Goods entity.
 type GoodsDeleteOne struct {
 	gd *GoodsDelete
@@ -107,7 +120,7 @@ func (gdo *GoodsDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{goods.Label}
+		return &NotFoundError{label: goods.Label}
 	default:
 		return nil
 	}
@@ -8,9 +8,12 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -22,13 +25,15 @@ import (
 // GoodsQuery is the builder for querying Goods entities.
 type GoodsQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.Goods
-	modifiers  []func(*sql.Selector)
+	limit        *int
+	offset       *int
+	unique       *bool
+	order        []OrderFunc
+	fields       []string
+	inters       []Interceptor
+	predicates   []predicate.Goods
+	loadStrategy LoadStrategy
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -73,7 +78,7 @@ func (gq *GoodsQuery) First(ctx context.Context) (*Goods, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{goods.Label}
+		return nil, gq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -95,7 +100,7 @@ func (gq *GoodsQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{goods.Label}
+		err = gq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -114,6 +119,11 @@ func (gq *GoodsQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one Goods entity is found.
 // Returns a *NotFoundError when no Goods entities are found.
 func (gq *GoodsQuery) Only(ctx context.Context) (*Goods, error) {
+	if gq.singleflight != nil {
+		if _, ok := gq.driver.(*txDriver); !ok {
+			return gq.onlyShared(ctx)
+		}
+	}
 	nodes, err := gq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -122,7 +132,7 @@ func (gq *GoodsQuery) Only(ctx context.Context) (*Goods, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{goods.Label}
+		return nil, gq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{goods.Label}
 	}
@@ -149,7 +159,7 @@ func (gq *GoodsQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{goods.Label}
+		err = gq.sqlNotFound()
 	default:
 		err = &NotSingularError{goods.Label}
 	}
@@ -170,7 +180,20 @@ func (gq *GoodsQuery) All(ctx context.Context) ([]*Goods, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return gq.sqlAll(ctx)
+	if p := gq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return gq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := gq.intercept(ctx, "All", func(ctx context.Context, query *GoodsQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Goods)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Goods", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -184,10 +207,20 @@ func (gq *GoodsQuery) AllX(ctx context.Context) []*Goods {
 
 // IDs executes the query and returns a list of Goods IDs.
 func (gq *GoodsQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := gq.Select(goods.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := gq.intercept(ctx, "IDs", func(ctx context.Context, query *GoodsQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(goods.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -205,7 +238,17 @@ func (gq *GoodsQuery) Count(ctx context.Context) (int, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return gq.sqlCount(ctx)
+	v, err := gq.intercept(ctx, "Count", func(ctx context.Context, query *GoodsQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -222,7 +265,47 @@ func (gq *GoodsQuery) Exist(ctx context.Context) (bool, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return gq.sqlExist(ctx)
+	v, err := gq.intercept(ctx, "Exist", func(ctx context.Context, query *GoodsQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (gq *GoodsQuery) intercept(ctx context.Context, op string, fn func(context.Context, *GoodsQuery) (Value, error)) (Value, error) {
+	if len(gq.inters) == 0 {
+		return fn(ctx, gq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeGoods,
+		Op:     op,
+		Limit:  gq.limit,
+		Offset: gq.offset,
+		Unique: gq.unique,
+		Fields: gq.fields,
+	}
+	for _, p := range gq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range gq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GoodsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *GoodsQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, gq, qc, qr, gq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -245,11 +328,13 @@ func (gq *GoodsQuery) Clone() *GoodsQuery {
 		limit:      gq.limit,
 		offset:     gq.offset,
 		order:      append([]OrderFunc{}, gq.order...),
+		inters:     append([]Interceptor{}, gq.inters...),
 		predicates: append([]predicate.Goods{}, gq.predicates...),
 		// clone intermediate query.
-		sql:    gq.sql.Clone(),
-		path:   gq.path,
-		unique: gq.unique,
+		sql:          gq.sql.Clone(),
+		path:         gq.path,
+		unique:       gq.unique,
+		loadStrategy: gq.loadStrategy,
 	}
 }
 
@@ -285,6 +370,16 @@ func (gq *GoodsQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range gq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gq); err != nil {
+				return err
+			}
+		}
+	}
 	if gq.path != nil {
 		prev, err := gq.path(ctx)
 		if err != nil {
@@ -314,7 +409,7 @@ func (gq *GoodsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Goods,
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, gq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, gq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -332,7 +427,7 @@ func (gq *GoodsQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(gq.fields) > 0 {
 		_spec.Unique = gq.unique != nil && *gq.unique
 	}
-	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, gq.queryDriver(), _spec)
 }
 
 func (gq *GoodsQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -426,6 +521,192 @@ func (gq *GoodsQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (gq *GoodsQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: goods.Label}
+	if len(gq.predicates) > 0 {
+		selector := sql.Dialect(gq.driver.Dialect()).Select().From(sql.Table(goods.Table))
+		for _, p := range gq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the GoodsQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (gq *GoodsQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Goods, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Goods(ps[i]))
+	}
+	gq.predicates = append(gq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (gq *GoodsQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := gq.querySpec()
+	if len(gq.modifiers) > 0 {
+		_spec.Modifiers = gq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = gq.unique != nil && *gq.unique
+	return sqlgraph.EstimateNodes(ctx, gq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (gq *GoodsQuery) CountEstimateX(ctx context.Context) int {
+	count, err := gq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// Goods rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (gq *GoodsQuery) Sample(percent float64) *GoodsQuery {
+	gq.predicates = append(gq.predicates, sql.Sample(percent))
+	return gq
+}
+
+// GoodsIterator iterates over the results of a Goods query, and decodes them one at a time.
+type GoodsIterator struct {
+	ctx   context.Context
+	query *GoodsQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*Goods
+	node  *Goods
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the Goods entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.Goods.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (gq *GoodsQuery) Iterate(ctx context.Context, opts ...IterateOption) (*GoodsIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := gq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &GoodsIterator{ctx: ctx, query: gq, batch: o.batch}
+	var (
+		_spec = gq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Goods).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Goods{config: gq.config}
+		it.nodes = append(it.nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(gq.modifiers) > 0 {
+		_spec.Modifiers = gq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, gq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (gq *GoodsQuery) iterateLoad(ctx context.Context, nodes []*Goods) ([]*Goods, error) {
+	return nodes, nil
+}
+
+// Next advances the iterator to the next Goods, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *GoodsIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*Goods, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current Goods of the iterator.
+func (it *GoodsIterator) Value() *Goods {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *GoodsIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *GoodsIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// LoadStrategy sets the strategy for eager-loading the Goods entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (gq *GoodsQuery) LoadStrategy(s LoadStrategy) *GoodsQuery {
+	gq.loadStrategy = s
+	return gq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (gq *GoodsQuery) joinable() bool {
+	if gq.loadStrategy != LoadStrategyJoin || gq.limit != nil || gq.offset != nil {
+		return false
+	}
+	if len(gq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range gq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -458,6 +739,336 @@ func (gq *GoodsQuery) Modify(modifiers ...func(s *sql.Selector)) *GoodsSelect {
 	return gq.Select()
 }
 
+// Project returns a builder for querying the given fields into GoodsProjection structs,
+// instead of full Goods entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.Goods.Query().
+//		Project(goods.FieldID).
+//		All(ctx)
+//
+func (gq *GoodsQuery) Project(fields ...string) *GoodsProject {
+	gq.fields = append(gq.fields, fields...)
+	return &GoodsProject{query: gq}
+}
+
+// GoodsProjection is a lightweight projection of the Goods entity that is returned by
+// GoodsProject. Fields that were not selected by the query hold their zero values.
+type GoodsProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+}
+
+// GoodsProject is the builder for querying Goods fields into projections.
+type GoodsProject struct {
+	query *GoodsQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (gp *GoodsProject) All(ctx context.Context) ([]*GoodsProjection, error) {
+	if err := gp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := gp.query.fields
+	if len(columns) == 0 {
+		columns = goods.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := gp.query.sqlQuery(ctx).Query()
+	if err := gp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &Goods{}
+		result []*GoodsProjection
+	)
+	for rows.Next() {
+		*e = Goods{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &GoodsProjection{
+			ID: e.ID,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gp *GoodsProject) AllX(ctx context.Context) []*GoodsProjection {
+	items, err := gp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (gq *GoodsQuery) onlyShared(ctx context.Context) (*Goods, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	gq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *gq
+	kq.sql = gq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if gq.singleflightScope != nil {
+		scope = gq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := gq.singleflight.DoChan(singleflightKey(scope, goods.Label, query, args), func() (interface{}, error) {
+		nodes, err := gq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, gq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{goods.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*Goods)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.Goods.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (gq *GoodsQuery) SelectMask(paths ...string) *GoodsSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case goods.FieldID:
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, goods.FieldID)
+	}
+	return gq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the goods.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (gq *GoodsQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = goods.FieldID
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering Goods`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		gq.Order(Asc(column))
+	case DirectionDesc:
+		gq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// GoodsPage is a page of Goods entities returned by the Paginate method.
+type GoodsPage struct {
+	Nodes []*Goods `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of Goods entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.Goods.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.Goods.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (gq *GoodsQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*GoodsPage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case goods.FieldID:
+			order.column = goods.FieldID
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating Goods`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != goods.FieldID {
+		order := paginateOrder{name: goods.FieldID, column: goods.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := gq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &GoodsPage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case goods.FieldID:
+				values[j] = n.ID
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (gq *GoodsQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case goods.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (gq *GoodsQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*Goods, error) {
+	if gq.limit != nil {
+		return gq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	gq.limit = &limit
+	defer func() { gq.limit = nil }()
+	nodes, err := gq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: goods.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // GoodsGroupBy is the group-by builder for Goods entities.
 type GoodsGroupBy struct {
 	config
@@ -497,7 +1108,7 @@ func (ggb *GoodsGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ggb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ggb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -543,7 +1154,7 @@ func (gs *GoodsSelect) Scan(ctx context.Context, v interface{}) error {
 func (gs *GoodsSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := gs.sql.Query()
-	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := gs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -110,7 +110,7 @@ func (gu *GoodsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{goods.Label}
+			err = &NotFoundError{label: goods.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -122,9 +122,18 @@ func (gu *GoodsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // GoodsUpdateOne is the builder for updating a single Goods entity.
 type GoodsUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GoodsMutation
+	fields     []string
+	conditions []predicate.Goods
+	hooks      []Hook
+	mutation   *GoodsMutation
+}
+
+// Where appends a list of conditions to the GoodsUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (guo *GoodsUpdateOne) Where(ps ...predicate.Goods) *GoodsUpdateOne {
+	guo.conditions = append(guo.conditions, ps...)
+	return guo
 }
 
 // Mutation returns the GoodsMutation object of the builder.
@@ -234,12 +243,21 @@ func (guo *GoodsUpdateOne) sqlSave(ctx context.Context) (_node *Goods, err error
 			}
 		}
 	}
+	if ps := guo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	_node = &Goods{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{goods.Label}
+			err = &NotFoundError{label: goods.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: goods.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -247,3 +265,93 @@ func (guo *GoodsUpdateOne) sqlSave(ctx context.Context) (_node *Goods, err error
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the Goods entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (guo *GoodsUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := guo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "Goods.id" for update`)}
+	}
+	return enqueueAsync(ctx, guo.config, guo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Goods.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (gu *GoodsUpdate) SetChanged(changes []FieldChange) (*GoodsUpdate, error) {
+	if err := setGoodsChanged(gu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return gu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Goods.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (guo *GoodsUpdateOne) SetChanged(changes []FieldChange) (*GoodsUpdateOne, error) {
+	if err := setGoodsChanged(guo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return guo, nil
+}
+
+// setGoodsChanged applies the given field changes on the Goods mutation.
+func setGoodsChanged(m *GoodsMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the Goods entities that are joined with the rows of the given table, on the given columns
+// of the Goods table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.Goods.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (gu *GoodsUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *GoodsUpdate {
+	gu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return gu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Goods schema.
+func (gu *GoodsUpdate) ApplyPatch(p *GoodsPatch) (*GoodsUpdate, error) {
+	if err := applyGoodsPatch(gu.mutation, p); err != nil {
+		return nil, err
+	}
+	return gu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Goods schema.
+func (guo *GoodsUpdateOne) ApplyPatch(p *GoodsPatch) (*GoodsUpdateOne, error) {
+	if err := applyGoodsPatch(guo.mutation, p); err != nil {
+		return nil, err
+	}
+	return guo, nil
+}
+
+// applyGoodsPatch applies the given patch on the Goods mutation.
+func applyGoodsPatch(m *GoodsMutation, p *GoodsPatch) error {
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
@@ -7,7 +7,9 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -35,6 +37,9 @@ type Group struct {
 	// The values are being populated by the GroupQuery when eager-loading is set.
 	Edges      GroupEdges `json:"edges"`
 	group_info *int
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[5]bool
 }
 
 // GroupEdges holds the relations/edges for other nodes in the graph.
@@ -237,6 +242,238 @@ func (gr *Group) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the Group and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (gr *Group) Diff(other *Group) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(gr.Active, other.Active) {
+		changes = append(changes, FieldChange{Field: group.FieldActive, Old: gr.Active, New: other.Active})
+	}
+	if !reflect.DeepEqual(gr.Expire, other.Expire) {
+		changes = append(changes, FieldChange{Field: group.FieldExpire, Old: gr.Expire, New: other.Expire})
+	}
+	if !reflect.DeepEqual(gr.Type, other.Type) {
+		change := FieldChange{Field: group.FieldType}
+		if gr.Type != nil {
+			change.Old = *gr.Type
+		}
+		if other.Type != nil {
+			change.New = *other.Type
+		}
+		changes = append(changes, change)
+	}
+	if !reflect.DeepEqual(gr.MaxUsers, other.MaxUsers) {
+		changes = append(changes, FieldChange{Field: group.FieldMaxUsers, Old: gr.MaxUsers, New: other.MaxUsers})
+	}
+	if !reflect.DeepEqual(gr.Name, other.Name) {
+		changes = append(changes, FieldChange{Field: group.FieldName, Old: gr.Name, New: other.Name})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the Group that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (gr *Group) ApplyMask(paths ...string) (*Group, error) {
+	if len(paths) == 0 {
+		masked := *gr
+		return &masked, nil
+	}
+	masked := &Group{config: gr.config, ID: gr.ID}
+	// Nested paths of the masked edges. A nil
+	// slice means that the entire edge is masked.
+	edges := make(map[string][]string)
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case group.FieldID:
+		case "active":
+			masked.Active = gr.Active
+		case "expire":
+			masked.Expire = gr.Expire
+		case "type":
+			masked.Type = gr.Type
+		case "max_users":
+			masked.MaxUsers = gr.MaxUsers
+		case "name":
+			masked.Name = gr.Name
+		case group.EdgeFiles, group.EdgeBlocked, group.EdgeUsers, group.EdgeInfo:
+			if name == p {
+				edges[name] = nil
+			} else if sub, ok := edges[name]; !ok || sub != nil {
+				edges[name] = append(sub, p[len(name)+1:])
+			}
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type Group", p)
+		}
+	}
+	if sub, ok := edges[group.EdgeFiles]; ok {
+		masked.Edges.loadedTypes[0] = gr.Edges.loadedTypes[0]
+		for _, n := range gr.Edges.Files {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Files = append(masked.Edges.Files, mn)
+		}
+	}
+	if sub, ok := edges[group.EdgeBlocked]; ok {
+		masked.Edges.loadedTypes[1] = gr.Edges.loadedTypes[1]
+		for _, n := range gr.Edges.Blocked {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Blocked = append(masked.Edges.Blocked, mn)
+		}
+	}
+	if sub, ok := edges[group.EdgeUsers]; ok {
+		masked.Edges.loadedTypes[2] = gr.Edges.loadedTypes[2]
+		for _, n := range gr.Edges.Users {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Users = append(masked.Edges.Users, mn)
+		}
+	}
+	if sub, ok := edges[group.EdgeInfo]; ok {
+		masked.Edges.loadedTypes[3] = gr.Edges.loadedTypes[3]
+		if n := gr.Edges.Info; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Info = mn
+		}
+	}
+	return masked, nil
+}
+
+// GroupPatch describes a partial update of a Group entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type GroupPatch struct {
+	// Active holds the new value of the "active" field.
+	Active *bool `json:"active,omitempty"`
+	// Expire holds the new value of the "expire" field.
+	Expire *time.Time `json:"expire,omitempty"`
+	// Type holds the new value of the "type" field.
+	Type *string `json:"type,omitempty"`
+	// MaxUsers holds the new value of the "max_users" field.
+	MaxUsers *int `json:"max_users,omitempty"`
+	// Name holds the new value of the "name" field.
+	Name *string `json:"name,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, group.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *GroupPatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p GroupPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.Active != nil {
+		fields["active"] = *p.Active
+	}
+	if p.Expire != nil {
+		fields["expire"] = *p.Expire
+	}
+	if p.Type != nil {
+		fields["type"] = *p.Type
+	}
+	if p.MaxUsers != nil {
+		fields["max_users"] = *p.MaxUsers
+	}
+	if p.Name != nil {
+		fields["name"] = *p.Name
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		case group.FieldType:
+			fields["type"] = nil
+		case group.FieldMaxUsers:
+			fields["max_users"] = nil
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional Group field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *GroupPatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "active":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Group field %q cannot be null", name)
+			}
+			p.Active = new(bool)
+			if err := json.Unmarshal(raw, p.Active); err != nil {
+				return fmt.Errorf("ent: decoding Group field %q: %w", name, err)
+			}
+		case "expire":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Group field %q cannot be null", name)
+			}
+			p.Expire = new(time.Time)
+			if err := json.Unmarshal(raw, p.Expire); err != nil {
+				return fmt.Errorf("ent: decoding Group field %q: %w", name, err)
+			}
+		case "type":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, group.FieldType)
+				continue
+			}
+			p.Type = new(string)
+			if err := json.Unmarshal(raw, p.Type); err != nil {
+				return fmt.Errorf("ent: decoding Group field %q: %w", name, err)
+			}
+		case "max_users":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, group.FieldMaxUsers)
+				continue
+			}
+			p.MaxUsers = new(int)
+			if err := json.Unmarshal(raw, p.MaxUsers); err != nil {
+				return fmt.Errorf("ent: decoding Group field %q: %w", name, err)
+			}
+		case "name":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Group field %q cannot be null", name)
+			}
+			p.Name = new(string)
+			if err := json.Unmarshal(raw, p.Name); err != nil {
+				return fmt.Errorf("ent: decoding Group field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable Group field %q", name)
+		}
+	}
+	return nil
+}
+
 // NamedFiles returns the Files named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (gr *Group) NamedFiles(name string) ([]*File, error) {
@@ -309,6 +546,107 @@ func (gr *Group) appendNamedUsers(name string, edges ...*User) {
 	}
 }
 
+// Selected reports whether the given field was loaded into the Group, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	gr := client.Group.Query().Select(group.FieldActive).FirstX(ctx)
+//	gr.Selected(group.FieldActive) // true
+//
+func (gr *Group) Selected(field string) bool {
+	switch field {
+	case group.FieldID:
+		return true
+	case group.FieldActive:
+		return gr.selectedFields == nil || gr.selectedFields[0]
+	case group.FieldExpire:
+		return gr.selectedFields == nil || gr.selectedFields[1]
+	case group.FieldType:
+		return gr.selectedFields == nil || gr.selectedFields[2]
+	case group.FieldMaxUsers:
+		return gr.selectedFields == nil || gr.selectedFields[3]
+	case group.FieldName:
+		return gr.selectedFields == nil || gr.selectedFields[4]
+	}
+	return false
+}
+
+// ActiveOrErr returns the value of the "active" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Group.
+func (gr *Group) ActiveOrErr() (bool, error) {
+	if gr.selectedFields != nil && !gr.selectedFields[0] {
+		var zero bool
+		return zero, &NotSelectedError{label: group.Label, field: group.FieldActive}
+	}
+	return gr.Active, nil
+}
+
+// ExpireOrErr returns the value of the "expire" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Group.
+func (gr *Group) ExpireOrErr() (time.Time, error) {
+	if gr.selectedFields != nil && !gr.selectedFields[1] {
+		var zero time.Time
+		return zero, &NotSelectedError{label: group.Label, field: group.FieldExpire}
+	}
+	return gr.Expire, nil
+}
+
+// TypeOrErr returns the value of the "type" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Group.
+func (gr *Group) TypeOrErr() (*string, error) {
+	if gr.selectedFields != nil && !gr.selectedFields[2] {
+		var zero *string
+		return zero, &NotSelectedError{label: group.Label, field: group.FieldType}
+	}
+	return gr.Type, nil
+}
+
+// MaxUsersOrErr returns the value of the "max_users" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Group.
+func (gr *Group) MaxUsersOrErr() (int, error) {
+	if gr.selectedFields != nil && !gr.selectedFields[3] {
+		var zero int
+		return zero, &NotSelectedError{label: group.Label, field: group.FieldMaxUsers}
+	}
+	return gr.MaxUsers, nil
+}
+
+// NameOrErr returns the value of the "name" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Group.
+func (gr *Group) NameOrErr() (string, error) {
+	if gr.selectedFields != nil && !gr.selectedFields[4] {
+		var zero string
+		return zero, &NotSelectedError{label: group.Label, field: group.FieldName}
+	}
+	return gr.Name, nil
+}
+
+// selectFields returns the fields of the Group that are included in the given
+// columns, or nil if all of them are included.
+func (*Group) selectFields(columns []string) *[5]bool {
+	var selected [5]bool
+	for _, c := range columns {
+		switch c {
+		case group.FieldActive:
+			selected[0] = true
+		case group.FieldExpire:
+			selected[1] = true
+		case group.FieldType:
+			selected[2] = true
+		case group.FieldMaxUsers:
+			selected[3] = true
+		case group.FieldName:
+			selected[4] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // Groups is a parsable slice of Group.
 type Groups []*Group
 
@@ -6,6 +6,11 @@
 
 package group
 
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the group type in the database.
 	Label = "group"
@@ -109,4 +114,69 @@ var (
 	NameValidator func(string) error
 )
 
+// fieldInfos describes the fields of the Group type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "active",
+		Column:      FieldActive,
+		StructField: "Active",
+		Type:        field.TypeBool,
+		GoType:      "bool",
+		Default:     true,
+	},
+	{
+		Name:        "expire",
+		Column:      FieldExpire,
+		StructField: "Expire",
+		Type:        field.TypeTime,
+		GoType:      "time.Time",
+	},
+	{
+		Name:        "type",
+		Column:      FieldType,
+		StructField: "Type",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+		Nillable:    true,
+	},
+	{
+		Name:        "max_users",
+		Column:      FieldMaxUsers,
+		StructField: "MaxUsers",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Optional:    true,
+		Default:     true,
+	},
+	{
+		Name:        "name",
+		Column:      FieldName,
+		StructField: "Name",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Comment:     "Name field with multiple validators",
+	},
+}
+
+// Fields returns the information of the Group fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+}
+
 // comment from another template.
@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -403,6 +404,68 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// GroupInsertSelect is the builder for creating Group entities from the result of a query.
+type GroupInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the Group table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+// For example:
+//
+//	client.Group.CreateFromQuery(query.Select(...)).
+//		Columns(group.FieldActive).
+//		Exec(ctx)
+//
+func (gis *GroupInsertSelect) Columns(columns ...string) *GroupInsertSelect {
+	gis.columns = append(gis.columns, columns...)
+	return gis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (gis *GroupInsertSelect) Exec(ctx context.Context) (int, error) {
+	if gis.query == nil {
+		return 0, errors.New("ent: missing query for Group.CreateFromQuery")
+	}
+	if err := gis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := gis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := gis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !group.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for Group.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: group.Table, Columns: group.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, gis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gis *GroupInsertSelect) ExecX(ctx context.Context) int {
+	n, err := gis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -446,6 +509,7 @@ type (
 	//  one Group node.
 	GroupUpsertOne struct {
 		create *GroupCreate
+		err    error // first validation error of the update values.
 	}
 
 	// GroupUpsert is the "OnConflict" setter.
@@ -602,8 +666,12 @@ func (u *GroupUpsertOne) UpdateExpire() *GroupUpsertOne {
 	})
 }
 
-// SetType sets the "type" field.
+// SetType sets the "type" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *GroupUpsertOne) SetType(v string) *GroupUpsertOne {
+	if err := group.TypeValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+	}
 	return u.Update(func(s *GroupUpsert) {
 		s.SetType(v)
 	})
@@ -623,8 +691,12 @@ func (u *GroupUpsertOne) ClearType() *GroupUpsertOne {
 	})
 }
 
-// SetMaxUsers sets the "max_users" field.
+// SetMaxUsers sets the "max_users" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *GroupUpsertOne) SetMaxUsers(v int) *GroupUpsertOne {
+	if err := group.MaxUsersValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+	}
 	return u.Update(func(s *GroupUpsert) {
 		s.SetMaxUsers(v)
 	})
@@ -651,8 +723,12 @@ func (u *GroupUpsertOne) ClearMaxUsers() *GroupUpsertOne {
 	})
 }
 
-// SetName sets the "name" field.
+// SetName sets the "name" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *GroupUpsertOne) SetName(v string) *GroupUpsertOne {
+	if err := group.NameValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+	}
 	return u.Update(func(s *GroupUpsert) {
 		s.SetName(v)
 	})
@@ -667,6 +743,9 @@ func (u *GroupUpsertOne) UpdateName() *GroupUpsertOne {
 
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for GroupCreate.OnConflict")
 	}
@@ -675,13 +754,16 @@ func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *GroupUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *GroupUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -698,6 +780,43 @@ func (u *GroupUpsertOne) IDX(ctx context.Context) int {
 	return id
 }
 
+// SaveAsync adds the creation of the Group entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (gc *GroupCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, gc.config, gc.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the Group creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (gc *GroupCreate) IdempotencyKey(key string) *GroupCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &gc.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*Group)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from GroupMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id int
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&GroupClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	gc.hooks = append([]Hook{hook}, gc.hooks...)
+	return gc
+}
+
 // GroupCreateBulk is the builder for creating many Group entities in bulk.
 type GroupCreateBulk struct {
 	config
@@ -784,6 +903,78 @@ func (gcb *GroupCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// GroupBulkResult holds the result of creating one of the Group entities using GroupCreateBulk.ContinueOnError.
+type GroupBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *Group
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the Group entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.Group.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (gcb *GroupCreateBulk) ContinueOnError(ctx context.Context) ([]GroupBulkResult, error) {
+	client := &Client{config: gcb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]GroupBulkResult, len(gcb.builders))
+	for i, builder := range gcb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = gcb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -825,6 +1016,7 @@ func (gcb *GroupCreateBulk) OnConflictColumns(columns ...string) *GroupUpsertBul
 // a bulk of Group nodes.
 type GroupUpsertBulk struct {
 	create *GroupCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -897,8 +1089,12 @@ func (u *GroupUpsertBulk) UpdateExpire() *GroupUpsertBulk {
 	})
 }
 
-// SetType sets the "type" field.
+// SetType sets the "type" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *GroupUpsertBulk) SetType(v string) *GroupUpsertBulk {
+	if err := group.TypeValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+	}
 	return u.Update(func(s *GroupUpsert) {
 		s.SetType(v)
 	})
@@ -918,8 +1114,12 @@ func (u *GroupUpsertBulk) ClearType() *GroupUpsertBulk {
 	})
 }
 
-// SetMaxUsers sets the "max_users" field.
+// SetMaxUsers sets the "max_users" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *GroupUpsertBulk) SetMaxUsers(v int) *GroupUpsertBulk {
+	if err := group.MaxUsersValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+	}
 	return u.Update(func(s *GroupUpsert) {
 		s.SetMaxUsers(v)
 	})
@@ -946,8 +1146,12 @@ func (u *GroupUpsertBulk) ClearMaxUsers() *GroupUpsertBulk {
 	})
 }
 
-// SetName sets the "name" field.
+// SetName sets the "name" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *GroupUpsertBulk) SetName(v string) *GroupUpsertBulk {
+	if err := group.NameValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+	}
 	return u.Update(func(s *GroupUpsert) {
 		s.SetName(v)
 	})
@@ -962,6 +1166,9 @@ func (u *GroupUpsertBulk) UpdateName() *GroupUpsertBulk {
 
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GroupCreateBulk instead", i)
@@ -975,7 +1182,7 @@ func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *GroupUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
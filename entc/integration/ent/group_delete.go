@@ -95,6 +95,19 @@ func (gd *GroupDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the Group entities that are joined with the rows of the given table, on the given columns
+// of the Group table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.Group.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (gd *GroupDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *GroupDelete {
+	gd.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return gd
+}
+
 // GroupDeleteOne is the builder for deleting a single Group entity.
 type GroupDeleteOne struct {
 	gd *GroupDelete
@@ -107,7 +120,7 @@ func (gdo *GroupDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{group.Label}
+		return &NotFoundError{label: group.Label}
 	default:
 		return nil
 	}
@@ -9,12 +9,18 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqltime"
 	"entgo.io/ent/entc/integration/ent/file"
 	"entgo.io/ent/entc/integration/ent/group"
 	"entgo.io/ent/entc/integration/ent/groupinfo"
@@ -31,12 +37,14 @@ type GroupQuery struct {
 	unique           *bool
 	order            []OrderFunc
 	fields           []string
+	inters           []Interceptor
 	predicates       []predicate.Group
 	withFiles        *FileQuery
 	withBlocked      *UserQuery
 	withUsers        *UserQuery
 	withInfo         *GroupInfoQuery
 	withFKs          bool
+	loadStrategy     LoadStrategy
 	modifiers        []func(*sql.Selector)
 	withNamedFiles   map[string]*FileQuery
 	withNamedBlocked map[string]*UserQuery
@@ -79,7 +87,7 @@ func (gq *GroupQuery) Order(o ...OrderFunc) *GroupQuery {
 
 // QueryFiles chains the current query on the "files" edge.
 func (gq *GroupQuery) QueryFiles() *FileQuery {
-	query := &FileQuery{config: gq.config}
+	query := (&FileClient{config: gq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -101,7 +109,7 @@ func (gq *GroupQuery) QueryFiles() *FileQuery {
 
 // QueryBlocked chains the current query on the "blocked" edge.
 func (gq *GroupQuery) QueryBlocked() *UserQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -123,7 +131,8 @@ func (gq *GroupQuery) QueryBlocked() *UserQuery {
 
 // QueryUsers chains the current query on the "users" edge.
 func (gq *GroupQuery) QueryUsers() *UserQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
+	query.edgeOrder = []OrderFunc{Desc(user.FieldName), Asc(user.FieldID)}
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -145,7 +154,7 @@ func (gq *GroupQuery) QueryUsers() *UserQuery {
 
 // QueryInfo chains the current query on the "info" edge.
 func (gq *GroupQuery) QueryInfo() *GroupInfoQuery {
-	query := &GroupInfoQuery{config: gq.config}
+	query := (&GroupInfoClient{config: gq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -173,7 +182,7 @@ func (gq *GroupQuery) First(ctx context.Context) (*Group, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{group.Label}
+		return nil, gq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -195,7 +204,7 @@ func (gq *GroupQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{group.Label}
+		err = gq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -214,6 +223,11 @@ func (gq *GroupQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one Group entity is found.
 // Returns a *NotFoundError when no Group entities are found.
 func (gq *GroupQuery) Only(ctx context.Context) (*Group, error) {
+	if gq.singleflight != nil && gq.withFiles == nil && len(gq.withNamedFiles) == 0 && gq.withBlocked == nil && len(gq.withNamedBlocked) == 0 && gq.withUsers == nil && len(gq.withNamedUsers) == 0 && gq.withInfo == nil {
+		if _, ok := gq.driver.(*txDriver); !ok {
+			return gq.onlyShared(ctx)
+		}
+	}
 	nodes, err := gq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -222,7 +236,7 @@ func (gq *GroupQuery) Only(ctx context.Context) (*Group, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{group.Label}
+		return nil, gq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{group.Label}
 	}
@@ -249,7 +263,7 @@ func (gq *GroupQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{group.Label}
+		err = gq.sqlNotFound()
 	default:
 		err = &NotSingularError{group.Label}
 	}
@@ -270,7 +284,20 @@ func (gq *GroupQuery) All(ctx context.Context) ([]*Group, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return gq.sqlAll(ctx)
+	if p := gq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return gq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := gq.intercept(ctx, "All", func(ctx context.Context, query *GroupQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Group)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Group", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -284,10 +311,20 @@ func (gq *GroupQuery) AllX(ctx context.Context) []*Group {
 
 // IDs executes the query and returns a list of Group IDs.
 func (gq *GroupQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := gq.Select(group.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := gq.intercept(ctx, "IDs", func(ctx context.Context, query *GroupQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(group.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -305,7 +342,17 @@ func (gq *GroupQuery) Count(ctx context.Context) (int, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return gq.sqlCount(ctx)
+	v, err := gq.intercept(ctx, "Count", func(ctx context.Context, query *GroupQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -322,7 +369,75 @@ func (gq *GroupQuery) Exist(ctx context.Context) (bool, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return gq.sqlExist(ctx)
+	v, err := gq.intercept(ctx, "Exist", func(ctx context.Context, query *GroupQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (gq *GroupQuery) intercept(ctx context.Context, op string, fn func(context.Context, *GroupQuery) (Value, error)) (Value, error) {
+	if len(gq.inters) == 0 {
+		return fn(ctx, gq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeGroup,
+		Op:     op,
+		Limit:  gq.limit,
+		Offset: gq.offset,
+		Unique: gq.unique,
+		Fields: gq.fields,
+	}
+	for _, p := range gq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range gq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if gq.withFiles != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, group.EdgeFiles)
+		qc.EdgeQueries[group.EdgeFiles] = gq.withFiles
+	}
+	if gq.withBlocked != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, group.EdgeBlocked)
+		qc.EdgeQueries[group.EdgeBlocked] = gq.withBlocked
+	}
+	if gq.withUsers != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, group.EdgeUsers)
+		qc.EdgeQueries[group.EdgeUsers] = gq.withUsers
+	}
+	if gq.withInfo != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, group.EdgeInfo)
+		qc.EdgeQueries[group.EdgeInfo] = gq.withInfo
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *GroupQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, gq, qc, qr, gq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -345,22 +460,24 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 		limit:       gq.limit,
 		offset:      gq.offset,
 		order:       append([]OrderFunc{}, gq.order...),
+		inters:      append([]Interceptor{}, gq.inters...),
 		predicates:  append([]predicate.Group{}, gq.predicates...),
 		withFiles:   gq.withFiles.Clone(),
 		withBlocked: gq.withBlocked.Clone(),
 		withUsers:   gq.withUsers.Clone(),
 		withInfo:    gq.withInfo.Clone(),
 		// clone intermediate query.
-		sql:    gq.sql.Clone(),
-		path:   gq.path,
-		unique: gq.unique,
+		sql:          gq.sql.Clone(),
+		path:         gq.path,
+		unique:       gq.unique,
+		loadStrategy: gq.loadStrategy,
 	}
 }
 
 // WithFiles tells the query-builder to eager-load the nodes that are connected to
 // the "files" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithFiles(opts ...func(*FileQuery)) *GroupQuery {
-	query := &FileQuery{config: gq.config}
+	query := (&FileClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -371,7 +488,7 @@ func (gq *GroupQuery) WithFiles(opts ...func(*FileQuery)) *GroupQuery {
 // WithBlocked tells the query-builder to eager-load the nodes that are connected to
 // the "blocked" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithBlocked(opts ...func(*UserQuery)) *GroupQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -382,7 +499,7 @@ func (gq *GroupQuery) WithBlocked(opts ...func(*UserQuery)) *GroupQuery {
 // WithUsers tells the query-builder to eager-load the nodes that are connected to
 // the "users" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithUsers(opts ...func(*UserQuery)) *GroupQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -393,7 +510,7 @@ func (gq *GroupQuery) WithUsers(opts ...func(*UserQuery)) *GroupQuery {
 // WithInfo tells the query-builder to eager-load the nodes that are connected to
 // the "info" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithInfo(opts ...func(*GroupInfoQuery)) *GroupQuery {
-	query := &GroupInfoQuery{config: gq.config}
+	query := (&GroupInfoClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -457,6 +574,16 @@ func (gq *GroupQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range gq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gq); err != nil {
+				return err
+			}
+		}
+	}
 	if gq.path != nil {
 		prev, err := gq.path(ctx)
 		if err != nil {
@@ -485,6 +612,12 @@ func (gq *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, group.ForeignKeys...)
 	}
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[5]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Group).scanValues(nil, columns)
 	}
@@ -492,15 +625,84 @@ func (gq *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 		node := &Group{config: gq.config}
 		nodes = append(nodes, node)
 		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*Group).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(gq.modifiers) > 0 {
 		_spec.Modifiers = gq.modifiers
 	}
+	if query := gq.withInfo; query != nil && query.joinable() {
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		var (
+			joined   = query.sqlQuery(ctx)
+			jcolumns = query.querySpec().Node.Columns
+			scan     = _spec.ScanValues
+			assign   = _spec.Assign
+		)
+		selected := (*GroupInfo).selectFields(nil, jcolumns)
+		joined.Select(joined.Columns(jcolumns...)...)
+		_spec.Modifiers = append(_spec.Modifiers[:len(_spec.Modifiers):len(_spec.Modifiers)], func(s *sql.Selector) {
+			s.LeftJoin(joined).On(s.C(group.InfoColumn), joined.C(groupinfo.FieldID))
+			for _, c := range jcolumns {
+				s.AppendSelect(sql.As(joined.C(c), group.EdgeInfo+"__"+c))
+			}
+		})
+		// The columns of the joined edge are selected last, and are scanned
+		// using nullable scanners, as they are NULL if the edge does not exist.
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			n := len(columns) - len(jcolumns)
+			values, err := scan(columns[:n])
+			if err != nil {
+				return nil, err
+			}
+			jvalues, err := (*GroupInfo).scanValues(nil, jcolumns)
+			if err != nil {
+				return nil, err
+			}
+			for i := range jvalues {
+				if s, ok := jvalues[i].(interface{ Scan(interface{}) error }); ok {
+					jvalues[i] = &sql.NullScanner{S: s}
+				}
+			}
+			return append(values, jvalues...), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			n := len(columns) - len(jcolumns)
+			if err := assign(columns[:n], values[:n]); err != nil {
+				return err
+			}
+			if id, ok := values[n].(*sql.NullScanner); ok && !id.Valid {
+				return nil
+			}
+			jvalues := make([]interface{}, len(jcolumns))
+			for i, v := range values[n:] {
+				if s, ok := v.(*sql.NullScanner); ok {
+					v = s.S
+				}
+				jvalues[i] = v
+			}
+			neighbor := &GroupInfo{config: gq.config}
+			neighbor.selectedFields = selected
+			if err := neighbor.assignValues(jcolumns, jvalues); err != nil {
+				return err
+			}
+			nodes[len(nodes)-1].Edges.Info = neighbor
+			return nil
+		}
+		// The edge is loaded by the join, and not by an additional query.
+		clone := *gq
+		clone.withInfo = nil
+		gq = &clone
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, gq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, gq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -620,6 +822,8 @@ func (gq *GroupQuery) loadBlocked(ctx context.Context, query *UserQuery, nodes [
 	return nil
 }
 func (gq *GroupQuery) loadUsers(ctx context.Context, query *UserQuery, nodes []*Group, init func(*Group), assign func(*Group, *User)) error {
+	// The default order of the edge is applied, unless the query was ordered explicitly.
+	query.edgeOrder = []OrderFunc{Desc(user.FieldName), Asc(user.FieldID)}
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[int]*Group)
 	nids := make(map[int]map[*Group]struct{})
@@ -713,7 +917,7 @@ func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(gq.fields) > 0 {
 		_spec.Unique = gq.unique != nil && *gq.unique
 	}
-	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, gq.queryDriver(), _spec)
 }
 
 func (gq *GroupQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -807,6 +1011,493 @@ func (gq *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (gq *GroupQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: group.Label}
+	if len(gq.predicates) > 0 {
+		selector := sql.Dialect(gq.driver.Dialect()).Select().From(sql.Table(group.Table))
+		for _, p := range gq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the GroupQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (gq *GroupQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Group, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Group(ps[i]))
+	}
+	gq.predicates = append(gq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (gq *GroupQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := gq.querySpec()
+	if len(gq.modifiers) > 0 {
+		_spec.Modifiers = gq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = gq.unique != nil && *gq.unique
+	return sqlgraph.EstimateNodes(ctx, gq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (gq *GroupQuery) CountEstimateX(ctx context.Context) int {
+	count, err := gq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// Group rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (gq *GroupQuery) Sample(percent float64) *GroupQuery {
+	gq.predicates = append(gq.predicates, sql.Sample(percent))
+	return gq
+}
+
+// GroupIterator iterates over the results of a Group query, and decodes them one at a time.
+type GroupIterator struct {
+	ctx   context.Context
+	query *GroupQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*Group
+	node  *Group
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the Group entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.Group.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (gq *GroupQuery) Iterate(ctx context.Context, opts ...IterateOption) (*GroupIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := gq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &GroupIterator{ctx: ctx, query: gq, batch: o.batch}
+	var (
+		withFKs     = gq.withFKs
+		_spec       = gq.querySpec()
+		loadedTypes = [4]bool{
+			gq.withFiles != nil,
+			gq.withBlocked != nil,
+			gq.withUsers != nil,
+			gq.withInfo != nil,
+		}
+	)
+	if gq.withInfo != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, group.ForeignKeys...)
+	}
+	var (
+		selected *[5]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Group).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Group{config: gq.config}
+		it.nodes = append(it.nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*Group).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(gq.modifiers) > 0 {
+		_spec.Modifiers = gq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, gq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (gq *GroupQuery) iterateLoad(ctx context.Context, nodes []*Group) ([]*Group, error) {
+	query := *gq
+	gq = &query
+	gq.withFiles = gq.withFiles.Clone()
+	if named := gq.withNamedFiles; named != nil {
+		gq.withNamedFiles = make(map[string]*FileQuery, len(named))
+		for name, q := range named {
+			gq.withNamedFiles[name] = q.Clone()
+		}
+	}
+	gq.withBlocked = gq.withBlocked.Clone()
+	if named := gq.withNamedBlocked; named != nil {
+		gq.withNamedBlocked = make(map[string]*UserQuery, len(named))
+		for name, q := range named {
+			gq.withNamedBlocked[name] = q.Clone()
+		}
+	}
+	gq.withUsers = gq.withUsers.Clone()
+	if named := gq.withNamedUsers; named != nil {
+		gq.withNamedUsers = make(map[string]*UserQuery, len(named))
+		for name, q := range named {
+			gq.withNamedUsers[name] = q.Clone()
+		}
+	}
+	gq.withInfo = gq.withInfo.Clone()
+	if query := gq.withFiles; query != nil {
+		if err := gq.loadFiles(ctx, query, nodes,
+			func(n *Group) { n.Edges.Files = []*File{} },
+			func(n *Group, e *File) { n.Edges.Files = append(n.Edges.Files, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withBlocked; query != nil {
+		if err := gq.loadBlocked(ctx, query, nodes,
+			func(n *Group) { n.Edges.Blocked = []*User{} },
+			func(n *Group, e *User) { n.Edges.Blocked = append(n.Edges.Blocked, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withUsers; query != nil {
+		if err := gq.loadUsers(ctx, query, nodes,
+			func(n *Group) { n.Edges.Users = []*User{} },
+			func(n *Group, e *User) { n.Edges.Users = append(n.Edges.Users, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withInfo; query != nil {
+		if err := gq.loadInfo(ctx, query, nodes, nil,
+			func(n *Group, e *GroupInfo) { n.Edges.Info = e }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range gq.withNamedFiles {
+		if err := gq.loadFiles(ctx, query, nodes,
+			func(n *Group) { n.appendNamedFiles(name) },
+			func(n *Group, e *File) { n.appendNamedFiles(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range gq.withNamedBlocked {
+		if err := gq.loadBlocked(ctx, query, nodes,
+			func(n *Group) { n.appendNamedBlocked(name) },
+			func(n *Group, e *User) { n.appendNamedBlocked(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range gq.withNamedUsers {
+		if err := gq.loadUsers(ctx, query, nodes,
+			func(n *Group) { n.appendNamedUsers(name) },
+			func(n *Group, e *User) { n.appendNamedUsers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// Next advances the iterator to the next Group, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *GroupIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*Group, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current Group of the iterator.
+func (it *GroupIterator) Value() *Group {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *GroupIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *GroupIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// Join returns a builder for joining the Group entities with the entities of the given table, that
+// belongs to one of the types that are connected to Group by an edge. The results are returned
+// as typed rows holding both entities. Note that the joined entities are queried using their own query
+// builder, and therefore, their privacy policies are applied as usual.
+//
+//	rows, err := client.Group.Query().
+//		Join(file.Table).
+//		On(left, right).
+//		All(ctx)
+//
+func (gq *GroupQuery) Join(table string) *GroupJoin {
+	return &GroupJoin{query: gq, table: table}
+}
+
+// GroupJoinRow is a row that is returned by GroupJoin. It holds the Group entity,
+// and the joined entity in the field of its type. The rest of the fields are nil.
+type GroupJoinRow struct {
+	Group     *Group
+	File      *File
+	User      *User
+	GroupInfo *GroupInfo
+}
+
+// GroupJoin is the builder for joining Group entities with the entities of another type.
+type GroupJoin struct {
+	query   *GroupQuery
+	table   string
+	on      []string
+	columns []string
+}
+
+// On sets the columns of the join condition. The left column belongs
+// to Group, and the right column belongs to the joined table.
+func (gj *GroupJoin) On(left, right string) *GroupJoin {
+	gj.on = []string{left, right}
+	return gj
+}
+
+// Select sets the columns of the joined table to be selected. By default, all columns are selected.
+func (gj *GroupJoin) Select(columns ...string) *GroupJoin {
+	gj.columns = append(gj.columns, columns...)
+	return gj
+}
+
+// All executes the join query and returns the joined rows.
+func (gj *GroupJoin) All(ctx context.Context) ([]*GroupJoinRow, error) {
+	if len(gj.on) != 2 {
+		return nil, &ValidationError{Name: gj.table, err: errors.New("ent: missing join condition for Group")}
+	}
+	if !group.ValidColumn(gj.on[0]) {
+		return nil, &ValidationError{Name: gj.on[0], err: fmt.Errorf("ent: invalid join column %q for Group", gj.on[0])}
+	}
+	var (
+		joined   *sql.Selector
+		jcolumns []string
+		scan     func(*GroupJoinRow) ([]interface{}, func([]interface{}) error, error)
+	)
+	switch gj.table {
+	case file.Table:
+		if !file.ValidColumn(gj.on[1]) {
+			return nil, &ValidationError{Name: gj.on[1], err: fmt.Errorf("ent: invalid join column %q for File", gj.on[1])}
+		}
+		query := (&FileClient{config: gj.query.config}).Query()
+		if len(gj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{file.FieldID, gj.on[1]}, gj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = file.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *GroupJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.File = &File{config: gj.query.config}
+			values, err := r.File.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.File.assignValues(jcolumns, values) }, err
+		}
+	case user.Table:
+		if !user.ValidColumn(gj.on[1]) {
+			return nil, &ValidationError{Name: gj.on[1], err: fmt.Errorf("ent: invalid join column %q for User", gj.on[1])}
+		}
+		query := (&UserClient{config: gj.query.config}).Query()
+		if len(gj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{user.FieldID, gj.on[1]}, gj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = user.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *GroupJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.User = &User{config: gj.query.config}
+			values, err := r.User.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.User.assignValues(jcolumns, values) }, err
+		}
+	case groupinfo.Table:
+		if !groupinfo.ValidColumn(gj.on[1]) {
+			return nil, &ValidationError{Name: gj.on[1], err: fmt.Errorf("ent: invalid join column %q for GroupInfo", gj.on[1])}
+		}
+		query := (&GroupInfoClient{config: gj.query.config}).Query()
+		if len(gj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{groupinfo.FieldID, gj.on[1]}, gj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = groupinfo.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *GroupJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.GroupInfo = &GroupInfo{config: gj.query.config}
+			values, err := r.GroupInfo.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.GroupInfo.assignValues(jcolumns, values) }, err
+		}
+	default:
+		return nil, &ValidationError{Name: gj.table, err: fmt.Errorf("ent: cannot join Group with table %q", gj.table)}
+	}
+	if err := gj.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := gj.query.fields
+	if len(columns) == 0 {
+		columns = group.Columns
+	}
+	selector := gj.query.sqlQuery(ctx)
+	selector.Join(joined).On(selector.C(gj.on[0]), joined.C(gj.on[1]))
+	selector.AppendSelect(joined.Columns(jcolumns...)...)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gj.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*GroupJoinRow
+	for rows.Next() {
+		r := &GroupJoinRow{Group: &Group{config: gj.query.config}}
+		values, err := r.Group.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		jvalues, assign, err := scan(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(append(values, jvalues...)...); err != nil {
+			return nil, err
+		}
+		if err := r.Group.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		if err := assign(jvalues); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gj *GroupJoin) AllX(ctx context.Context) []*GroupJoinRow {
+	rows, err := gj.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// LoadStrategy sets the strategy for eager-loading the Group entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (gq *GroupQuery) LoadStrategy(s LoadStrategy) *GroupQuery {
+	gq.loadStrategy = s
+	return gq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (gq *GroupQuery) joinable() bool {
+	if gq.loadStrategy != LoadStrategyJoin || gq.limit != nil || gq.offset != nil {
+		return false
+	}
+	if gq.withFiles != nil || len(gq.withNamedFiles) > 0 {
+		return false
+	}
+	if gq.withBlocked != nil || len(gq.withNamedBlocked) > 0 {
+		return false
+	}
+	if gq.withUsers != nil || len(gq.withNamedUsers) > 0 {
+		return false
+	}
+	if gq.withInfo != nil {
+		return false
+	}
+	if len(gq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range gq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -842,7 +1533,7 @@ func (gq *GroupQuery) Modify(modifiers ...func(s *sql.Selector)) *GroupSelect {
 // WithNamedFiles tells the query-builder to eager-load the nodes that are connected to the "files"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithNamedFiles(name string, opts ...func(*FileQuery)) *GroupQuery {
-	query := &FileQuery{config: gq.config}
+	query := (&FileClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -856,7 +1547,7 @@ func (gq *GroupQuery) WithNamedFiles(name string, opts ...func(*FileQuery)) *Gro
 // WithNamedBlocked tells the query-builder to eager-load the nodes that are connected to the "blocked"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithNamedBlocked(name string, opts ...func(*UserQuery)) *GroupQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -870,7 +1561,7 @@ func (gq *GroupQuery) WithNamedBlocked(name string, opts ...func(*UserQuery)) *G
 // WithNamedUsers tells the query-builder to eager-load the nodes that are connected to the "users"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithNamedUsers(name string, opts ...func(*UserQuery)) *GroupQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -881,6 +1572,441 @@ func (gq *GroupQuery) WithNamedUsers(name string, opts ...func(*UserQuery)) *Gro
 	return gq
 }
 
+// Project returns a builder for querying the given fields into GroupProjection structs,
+// instead of full Group entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.Group.Query().
+//		Project(group.FieldID, group.FieldActive).
+//		All(ctx)
+//
+func (gq *GroupQuery) Project(fields ...string) *GroupProject {
+	gq.fields = append(gq.fields, fields...)
+	return &GroupProject{query: gq}
+}
+
+// GroupProjection is a lightweight projection of the Group entity that is returned by
+// GroupProject. Fields that were not selected by the query hold their zero values.
+type GroupProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// Expire holds the value of the "expire" field.
+	Expire time.Time `json:"expire,omitempty"`
+	// Type holds the value of the "type" field.
+	Type *string `json:"type,omitempty"`
+	// MaxUsers holds the value of the "max_users" field.
+	MaxUsers int `json:"max_users,omitempty"`
+	// Name field with multiple validators
+	Name string `json:"name,omitempty"`
+}
+
+// GroupProject is the builder for querying Group fields into projections.
+type GroupProject struct {
+	query *GroupQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (gp *GroupProject) All(ctx context.Context) ([]*GroupProjection, error) {
+	if err := gp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := gp.query.fields
+	if len(columns) == 0 {
+		columns = group.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := gp.query.sqlQuery(ctx).Query()
+	if err := gp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &Group{}
+		result []*GroupProjection
+	)
+	for rows.Next() {
+		*e = Group{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &GroupProjection{
+			ID:       e.ID,
+			Active:   e.Active,
+			Expire:   e.Expire,
+			Type:     e.Type,
+			MaxUsers: e.MaxUsers,
+			Name:     e.Name,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gp *GroupProject) AllX(ctx context.Context) []*GroupProjection {
+	items, err := gp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (gq *GroupQuery) onlyShared(ctx context.Context) (*Group, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	gq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *gq
+	kq.sql = gq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if gq.singleflightScope != nil {
+		scope = gq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := gq.singleflight.DoChan(singleflightKey(scope, group.Label, query, args), func() (interface{}, error) {
+		nodes, err := gq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, gq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{group.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*Group)
+		return &node, nil
+	}
+}
+
+// BucketBy groups the query results into time buckets of the given time field, and is used with
+// aggregate functions for time-series queries. The interval format is "<n> <unit>", for example,
+// "1 hour" or "15 minutes", and the supported units are: second, minute, hour, day, week, month
+// and year (the last two do not support multiple units).
+//
+//	buckets, err := client.Group.Query().
+//		BucketBy(group.FieldExpire, "1 hour").
+//		Aggregate(ent.Count()).
+//		Buckets(ctx)
+//
+func (gq *GroupQuery) BucketBy(field, interval string) *GroupBucketBy {
+	bbuild := &GroupBucketBy{config: gq.config, field: field, interval: interval}
+	bbuild.path = func(ctx context.Context) (*sql.Selector, error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return gq.sqlQuery(ctx), nil
+	}
+	return bbuild
+}
+
+// GroupBucketBy is the builder for aggregating Group entities by time buckets.
+type GroupBucketBy struct {
+	config
+	field    string
+	interval string
+	fns      []AggregateFunc
+	path     func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the bucket-by query.
+func (gbb *GroupBucketBy) Aggregate(fns ...AggregateFunc) *GroupBucketBy {
+	gbb.fns = append(gbb.fns, fns...)
+	return gbb
+}
+
+// Buckets executes the query and returns the time buckets ordered by their start time.
+func (gbb *GroupBucketBy) Buckets(ctx context.Context) ([]*TimeBucket, error) {
+	switch gbb.field {
+	case group.FieldExpire:
+	default:
+		return nil, &ValidationError{Name: gbb.field, err: fmt.Errorf("invalid time field %q for bucket-by", gbb.field)}
+	}
+	interval, err := sqltime.ParseInterval(gbb.interval)
+	if err != nil {
+		return nil, err
+	}
+	selector, err := gbb.path(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bucket := sqltime.Bucket(selector.Dialect(), selector.C(gbb.field), interval)
+	columns := make([]string, 0, len(gbb.fns)+1)
+	columns = append(columns, bucket)
+	for _, fn := range gbb.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...).GroupBy(bucket).OrderBy(bucket)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gbb.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanTimeBuckets(rows, len(gbb.fns))
+}
+
+// BucketsX is like Buckets, but panics if an error occurs.
+func (gbb *GroupBucketBy) BucketsX(ctx context.Context) []*TimeBucket {
+	buckets, err := gbb.Buckets(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return buckets
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.Group.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (gq *GroupQuery) SelectMask(paths ...string) *GroupSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case group.FieldID:
+		case group.EdgeFiles, group.EdgeBlocked, group.EdgeUsers, group.EdgeInfo:
+		case "active":
+			fields = append(fields, group.FieldActive)
+		case "expire":
+			fields = append(fields, group.FieldExpire)
+		case "type":
+			fields = append(fields, group.FieldType)
+		case "max_users":
+			fields = append(fields, group.FieldMaxUsers)
+		case "name":
+			fields = append(fields, group.FieldName)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, group.FieldID)
+	}
+	return gq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the group.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (gq *GroupQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = group.FieldID
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering Group`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		gq.Order(Asc(column))
+	case DirectionDesc:
+		gq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// GroupPage is a page of Group entities returned by the Paginate method.
+type GroupPage struct {
+	Nodes []*Group `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of Group entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.Group.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.Group.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (gq *GroupQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*GroupPage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case group.FieldID:
+			order.column = group.FieldID
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating Group`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != group.FieldID {
+		order := paginateOrder{name: group.FieldID, column: group.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := gq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &GroupPage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case group.FieldID:
+				values[j] = n.ID
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (gq *GroupQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case group.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (gq *GroupQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*Group, error) {
+	if gq.limit != nil {
+		return gq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	gq.limit = &limit
+	defer func() { gq.limit = nil }()
+	nodes, err := gq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: group.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // GroupGroupBy is the group-by builder for Group entities.
 type GroupGroupBy struct {
 	config
@@ -920,7 +2046,7 @@ func (ggb *GroupGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ggb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ggb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -966,7 +2092,7 @@ func (gs *GroupSelect) Scan(ctx context.Context, v interface{}) error {
 func (gs *GroupSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := gs.sql.Query()
-	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := gs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -96,6 +96,25 @@ func (gu *GroupUpdate) AddMaxUsers(i int) *GroupUpdate {
 	return gu
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (gu *GroupUpdate) SetMaxUsersIfGreater(i int) *GroupUpdate {
+	gu.mutation.SetMaxUsersIfGreater(i)
+	return gu
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field in the database.
+func (gu *GroupUpdate) BitAndMaxUsers(i int) *GroupUpdate {
+	gu.mutation.BitAndMaxUsers(i)
+	return gu
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field in the database.
+func (gu *GroupUpdate) BitOrMaxUsers(i int) *GroupUpdate {
+	gu.mutation.BitOrMaxUsers(i)
+	return gu
+}
+
 // ClearMaxUsers clears the value of the "max_users" field.
 func (gu *GroupUpdate) ClearMaxUsers() *GroupUpdate {
 	gu.mutation.ClearMaxUsers()
@@ -380,6 +399,27 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: group.FieldMaxUsers,
 		})
 	}
+	if value, ok := gu.mutation.MaxUsersIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
+	if value, ok := gu.mutation.MaxUsersBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
+	if value, ok := gu.mutation.MaxUsersBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
 	if gu.mutation.MaxUsersCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -592,7 +632,7 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{group.Label}
+			err = &NotFoundError{label: group.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -604,9 +644,18 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // GroupUpdateOne is the builder for updating a single Group entity.
 type GroupUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GroupMutation
+	fields     []string
+	conditions []predicate.Group
+	hooks      []Hook
+	mutation   *GroupMutation
+}
+
+// Where appends a list of conditions to the GroupUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
+	guo.conditions = append(guo.conditions, ps...)
+	return guo
 }
 
 // SetActive sets the "active" field.
@@ -670,6 +719,25 @@ func (guo *GroupUpdateOne) AddMaxUsers(i int) *GroupUpdateOne {
 	return guo
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (guo *GroupUpdateOne) SetMaxUsersIfGreater(i int) *GroupUpdateOne {
+	guo.mutation.SetMaxUsersIfGreater(i)
+	return guo
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field in the database.
+func (guo *GroupUpdateOne) BitAndMaxUsers(i int) *GroupUpdateOne {
+	guo.mutation.BitAndMaxUsers(i)
+	return guo
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field in the database.
+func (guo *GroupUpdateOne) BitOrMaxUsers(i int) *GroupUpdateOne {
+	guo.mutation.BitOrMaxUsers(i)
+	return guo
+}
+
 // ClearMaxUsers clears the value of the "max_users" field.
 func (guo *GroupUpdateOne) ClearMaxUsers() *GroupUpdateOne {
 	guo.mutation.ClearMaxUsers()
@@ -943,6 +1011,13 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 			}
 		}
 	}
+	if ps := guo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := guo.mutation.Active(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -984,6 +1059,27 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 			Column: group.FieldMaxUsers,
 		})
 	}
+	if value, ok := guo.mutation.MaxUsersIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
+	if value, ok := guo.mutation.MaxUsersBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
+	if value, ok := guo.mutation.MaxUsersBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
 	if guo.mutation.MaxUsersCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -1199,7 +1295,9 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{group.Label}
+			err = &NotFoundError{label: group.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: group.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -1207,3 +1305,108 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the Group entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (guo *GroupUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := guo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "Group.id" for update`)}
+	}
+	return enqueueAsync(ctx, guo.config, guo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Group.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (gu *GroupUpdate) SetChanged(changes []FieldChange) (*GroupUpdate, error) {
+	if err := setGroupChanged(gu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return gu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Group.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (guo *GroupUpdateOne) SetChanged(changes []FieldChange) (*GroupUpdateOne, error) {
+	if err := setGroupChanged(guo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return guo, nil
+}
+
+// setGroupChanged applies the given field changes on the Group mutation.
+func setGroupChanged(m *GroupMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the Group entities that are joined with the rows of the given table, on the given columns
+// of the Group table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.Group.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (gu *GroupUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *GroupUpdate {
+	gu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return gu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Group schema.
+func (gu *GroupUpdate) ApplyPatch(p *GroupPatch) (*GroupUpdate, error) {
+	if err := applyGroupPatch(gu.mutation, p); err != nil {
+		return nil, err
+	}
+	return gu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Group schema.
+func (guo *GroupUpdateOne) ApplyPatch(p *GroupPatch) (*GroupUpdateOne, error) {
+	if err := applyGroupPatch(guo.mutation, p); err != nil {
+		return nil, err
+	}
+	return guo, nil
+}
+
+// applyGroupPatch applies the given patch on the Group mutation.
+func applyGroupPatch(m *GroupMutation, p *GroupPatch) error {
+	if p.Active != nil {
+		m.SetActive(*p.Active)
+	}
+	if p.Expire != nil {
+		m.SetExpire(*p.Expire)
+	}
+	if p.Type != nil {
+		m.SetType(*p.Type)
+	}
+	if p.MaxUsers != nil {
+		m.SetMaxUsers(*p.MaxUsers)
+	}
+	if p.Name != nil {
+		m.SetName(*p.Name)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
@@ -7,7 +7,9 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -26,6 +28,9 @@ type GroupInfo struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupInfoQuery when eager-loading is set.
 	Edges GroupInfoEdges `json:"edges"`
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[2]bool
 }
 
 // GroupInfoEdges holds the relations/edges for other nodes in the graph.
@@ -131,6 +136,141 @@ func (gi *GroupInfo) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the GroupInfo and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (gi *GroupInfo) Diff(other *GroupInfo) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(gi.Desc, other.Desc) {
+		changes = append(changes, FieldChange{Field: groupinfo.FieldDesc, Old: gi.Desc, New: other.Desc})
+	}
+	if !reflect.DeepEqual(gi.MaxUsers, other.MaxUsers) {
+		changes = append(changes, FieldChange{Field: groupinfo.FieldMaxUsers, Old: gi.MaxUsers, New: other.MaxUsers})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the GroupInfo that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (gi *GroupInfo) ApplyMask(paths ...string) (*GroupInfo, error) {
+	if len(paths) == 0 {
+		masked := *gi
+		return &masked, nil
+	}
+	masked := &GroupInfo{config: gi.config, ID: gi.ID}
+	// Nested paths of the masked edges. A nil
+	// slice means that the entire edge is masked.
+	edges := make(map[string][]string)
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case groupinfo.FieldID:
+		case "desc":
+			masked.Desc = gi.Desc
+		case "max_users":
+			masked.MaxUsers = gi.MaxUsers
+		case groupinfo.EdgeGroups:
+			if name == p {
+				edges[name] = nil
+			} else if sub, ok := edges[name]; !ok || sub != nil {
+				edges[name] = append(sub, p[len(name)+1:])
+			}
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type GroupInfo", p)
+		}
+	}
+	if sub, ok := edges[groupinfo.EdgeGroups]; ok {
+		masked.Edges.loadedTypes[0] = gi.Edges.loadedTypes[0]
+		for _, n := range gi.Edges.Groups {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Groups = append(masked.Edges.Groups, mn)
+		}
+	}
+	return masked, nil
+}
+
+// GroupInfoPatch describes a partial update of a GroupInfo entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type GroupInfoPatch struct {
+	// Desc holds the new value of the "desc" field.
+	Desc *string `json:"desc,omitempty"`
+	// MaxUsers holds the new value of the "max_users" field.
+	MaxUsers *int `json:"max_users,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, groupinfo.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *GroupInfoPatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p GroupInfoPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.Desc != nil {
+		fields["desc"] = *p.Desc
+	}
+	if p.MaxUsers != nil {
+		fields["max_users"] = *p.MaxUsers
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional GroupInfo field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *GroupInfoPatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "desc":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: GroupInfo field %q cannot be null", name)
+			}
+			p.Desc = new(string)
+			if err := json.Unmarshal(raw, p.Desc); err != nil {
+				return fmt.Errorf("ent: decoding GroupInfo field %q: %w", name, err)
+			}
+		case "max_users":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: GroupInfo field %q cannot be null", name)
+			}
+			p.MaxUsers = new(int)
+			if err := json.Unmarshal(raw, p.MaxUsers); err != nil {
+				return fmt.Errorf("ent: decoding GroupInfo field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable GroupInfo field %q", name)
+		}
+	}
+	return nil
+}
+
 // NamedGroups returns the Groups named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (gi *GroupInfo) NamedGroups(name string) ([]*Group, error) {
@@ -155,6 +295,65 @@ func (gi *GroupInfo) appendNamedGroups(name string, edges ...*Group) {
 	}
 }
 
+// Selected reports whether the given field was loaded into the GroupInfo, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	gi := client.GroupInfo.Query().Select(groupinfo.FieldDesc).FirstX(ctx)
+//	gi.Selected(groupinfo.FieldDesc) // true
+//
+func (gi *GroupInfo) Selected(field string) bool {
+	switch field {
+	case groupinfo.FieldID:
+		return true
+	case groupinfo.FieldDesc:
+		return gi.selectedFields == nil || gi.selectedFields[0]
+	case groupinfo.FieldMaxUsers:
+		return gi.selectedFields == nil || gi.selectedFields[1]
+	}
+	return false
+}
+
+// DescOrErr returns the value of the "desc" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the GroupInfo.
+func (gi *GroupInfo) DescOrErr() (string, error) {
+	if gi.selectedFields != nil && !gi.selectedFields[0] {
+		var zero string
+		return zero, &NotSelectedError{label: groupinfo.Label, field: groupinfo.FieldDesc}
+	}
+	return gi.Desc, nil
+}
+
+// MaxUsersOrErr returns the value of the "max_users" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the GroupInfo.
+func (gi *GroupInfo) MaxUsersOrErr() (int, error) {
+	if gi.selectedFields != nil && !gi.selectedFields[1] {
+		var zero int
+		return zero, &NotSelectedError{label: groupinfo.Label, field: groupinfo.FieldMaxUsers}
+	}
+	return gi.MaxUsers, nil
+}
+
+// selectFields returns the fields of the GroupInfo that are included in the given
+// columns, or nil if all of them are included.
+func (*GroupInfo) selectFields(columns []string) *[2]bool {
+	var selected [2]bool
+	for _, c := range columns {
+		switch c {
+		case groupinfo.FieldDesc:
+			selected[0] = true
+		case groupinfo.FieldMaxUsers:
+			selected[1] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // GroupInfos is a parsable slice of GroupInfo.
 type GroupInfos []*GroupInfo
 
@@ -6,6 +6,11 @@
 
 package groupinfo
 
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the groupinfo type in the database.
 	Label = "group_info"
@@ -50,4 +55,43 @@ var (
 	DefaultMaxUsers int
 )
 
+// fieldInfos describes the fields of the GroupInfo type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "desc",
+		Column:      FieldDesc,
+		StructField: "Desc",
+		Type:        field.TypeString,
+		GoType:      "string",
+	},
+	{
+		Name:        "max_users",
+		Column:      FieldMaxUsers,
+		StructField: "MaxUsers",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Default:     true,
+	},
+}
+
+// Fields returns the information of the GroupInfo fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+}
+
 // comment from another template.
@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -218,6 +219,68 @@ func (gic *GroupInfoCreate) createSpec() (*GroupInfo, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// GroupInfoInsertSelect is the builder for creating GroupInfo entities from the result of a query.
+type GroupInfoInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the GroupInfo table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+// For example:
+//
+//	client.GroupInfo.CreateFromQuery(query.Select(...)).
+//		Columns(groupinfo.FieldDesc).
+//		Exec(ctx)
+//
+func (giis *GroupInfoInsertSelect) Columns(columns ...string) *GroupInfoInsertSelect {
+	giis.columns = append(giis.columns, columns...)
+	return giis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (giis *GroupInfoInsertSelect) Exec(ctx context.Context) (int, error) {
+	if giis.query == nil {
+		return 0, errors.New("ent: missing query for GroupInfo.CreateFromQuery")
+	}
+	if err := giis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := giis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := giis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !groupinfo.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for GroupInfo.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: groupinfo.Table, Columns: groupinfo.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, giis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (giis *GroupInfoInsertSelect) ExecX(ctx context.Context) int {
+	n, err := giis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -261,6 +324,7 @@ type (
 	//  one GroupInfo node.
 	GroupInfoUpsertOne struct {
 		create *GroupInfoCreate
+		err    error // first validation error of the update values.
 	}
 
 	// GroupInfoUpsert is the "OnConflict" setter.
@@ -378,6 +442,9 @@ func (u *GroupInfoUpsertOne) UpdateMaxUsers() *GroupInfoUpsertOne {
 
 // Exec executes the query.
 func (u *GroupInfoUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for GroupInfoCreate.OnConflict")
 	}
@@ -386,13 +453,16 @@ func (u *GroupInfoUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *GroupInfoUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *GroupInfoUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -409,6 +479,43 @@ func (u *GroupInfoUpsertOne) IDX(ctx context.Context) int {
 	return id
 }
 
+// SaveAsync adds the creation of the GroupInfo entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (gic *GroupInfoCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, gic.config, gic.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the GroupInfo creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (gic *GroupInfoCreate) IdempotencyKey(key string) *GroupInfoCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &gic.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*GroupInfo)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from GroupInfoMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id int
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&GroupInfoClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	gic.hooks = append([]Hook{hook}, gic.hooks...)
+	return gic
+}
+
 // GroupInfoCreateBulk is the builder for creating many GroupInfo entities in bulk.
 type GroupInfoCreateBulk struct {
 	config
@@ -495,6 +602,78 @@ func (gicb *GroupInfoCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// GroupInfoBulkResult holds the result of creating one of the GroupInfo entities using GroupInfoCreateBulk.ContinueOnError.
+type GroupInfoBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *GroupInfo
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the GroupInfo entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.GroupInfo.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (gicb *GroupInfoCreateBulk) ContinueOnError(ctx context.Context) ([]GroupInfoBulkResult, error) {
+	client := &Client{config: gicb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]GroupInfoBulkResult, len(gicb.builders))
+	for i, builder := range gicb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = gicb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -536,6 +715,7 @@ func (gicb *GroupInfoCreateBulk) OnConflictColumns(columns ...string) *GroupInfo
 // a bulk of GroupInfo nodes.
 type GroupInfoUpsertBulk struct {
 	create *GroupInfoCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -617,6 +797,9 @@ func (u *GroupInfoUpsertBulk) UpdateMaxUsers() *GroupInfoUpsertBulk {
 
 // Exec executes the query.
 func (u *GroupInfoUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GroupInfoCreateBulk instead", i)
@@ -630,7 +813,7 @@ func (u *GroupInfoUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *GroupInfoUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -95,6 +95,19 @@ func (gid *GroupInfoDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the GroupInfo entities that are joined with the rows of the given table, on the given columns
+// of the GroupInfo table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.GroupInfo.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (gid *GroupInfoDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *GroupInfoDelete {
+	gid.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return gid
+}
+
 // GroupInfoDeleteOne is the builder for deleting a single GroupInfo entity.
 type GroupInfoDeleteOne struct {
 	gid *GroupInfoDelete
@@ -107,7 +120,7 @@ func (gido *GroupInfoDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{groupinfo.Label}
+		return &NotFoundError{label: groupinfo.Label}
 	default:
 		return nil
 	}
@@ -9,9 +9,13 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -29,8 +33,10 @@ type GroupInfoQuery struct {
 	unique          *bool
 	order           []OrderFunc
 	fields          []string
+	inters          []Interceptor
 	predicates      []predicate.GroupInfo
 	withGroups      *GroupQuery
+	loadStrategy    LoadStrategy
 	modifiers       []func(*sql.Selector)
 	withNamedGroups map[string]*GroupQuery
 	// intermediate query (i.e. traversal path).
@@ -71,7 +77,7 @@ func (giq *GroupInfoQuery) Order(o ...OrderFunc) *GroupInfoQuery {
 
 // QueryGroups chains the current query on the "groups" edge.
 func (giq *GroupInfoQuery) QueryGroups() *GroupQuery {
-	query := &GroupQuery{config: giq.config}
+	query := (&GroupClient{config: giq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := giq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -99,7 +105,7 @@ func (giq *GroupInfoQuery) First(ctx context.Context) (*GroupInfo, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{groupinfo.Label}
+		return nil, giq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -121,7 +127,7 @@ func (giq *GroupInfoQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{groupinfo.Label}
+		err = giq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -140,6 +146,11 @@ func (giq *GroupInfoQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one GroupInfo entity is found.
 // Returns a *NotFoundError when no GroupInfo entities are found.
 func (giq *GroupInfoQuery) Only(ctx context.Context) (*GroupInfo, error) {
+	if giq.singleflight != nil && giq.withGroups == nil && len(giq.withNamedGroups) == 0 {
+		if _, ok := giq.driver.(*txDriver); !ok {
+			return giq.onlyShared(ctx)
+		}
+	}
 	nodes, err := giq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -148,7 +159,7 @@ func (giq *GroupInfoQuery) Only(ctx context.Context) (*GroupInfo, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{groupinfo.Label}
+		return nil, giq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{groupinfo.Label}
 	}
@@ -175,7 +186,7 @@ func (giq *GroupInfoQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{groupinfo.Label}
+		err = giq.sqlNotFound()
 	default:
 		err = &NotSingularError{groupinfo.Label}
 	}
@@ -196,7 +207,20 @@ func (giq *GroupInfoQuery) All(ctx context.Context) ([]*GroupInfo, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return giq.sqlAll(ctx)
+	if p := giq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return giq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := giq.intercept(ctx, "All", func(ctx context.Context, query *GroupInfoQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*GroupInfo)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*GroupInfo", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -210,10 +234,20 @@ func (giq *GroupInfoQuery) AllX(ctx context.Context) []*GroupInfo {
 
 // IDs executes the query and returns a list of GroupInfo IDs.
 func (giq *GroupInfoQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := giq.Select(groupinfo.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := giq.intercept(ctx, "IDs", func(ctx context.Context, query *GroupInfoQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(groupinfo.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -231,7 +265,17 @@ func (giq *GroupInfoQuery) Count(ctx context.Context) (int, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return giq.sqlCount(ctx)
+	v, err := giq.intercept(ctx, "Count", func(ctx context.Context, query *GroupInfoQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -248,7 +292,54 @@ func (giq *GroupInfoQuery) Exist(ctx context.Context) (bool, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return giq.sqlExist(ctx)
+	v, err := giq.intercept(ctx, "Exist", func(ctx context.Context, query *GroupInfoQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (giq *GroupInfoQuery) intercept(ctx context.Context, op string, fn func(context.Context, *GroupInfoQuery) (Value, error)) (Value, error) {
+	if len(giq.inters) == 0 {
+		return fn(ctx, giq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeGroupInfo,
+		Op:     op,
+		Limit:  giq.limit,
+		Offset: giq.offset,
+		Unique: giq.unique,
+		Fields: giq.fields,
+	}
+	for _, p := range giq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range giq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if giq.withGroups != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, groupinfo.EdgeGroups)
+		qc.EdgeQueries[groupinfo.EdgeGroups] = giq.withGroups
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupInfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *GroupInfoQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, giq, qc, qr, giq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -271,19 +362,21 @@ func (giq *GroupInfoQuery) Clone() *GroupInfoQuery {
 		limit:      giq.limit,
 		offset:     giq.offset,
 		order:      append([]OrderFunc{}, giq.order...),
+		inters:     append([]Interceptor{}, giq.inters...),
 		predicates: append([]predicate.GroupInfo{}, giq.predicates...),
 		withGroups: giq.withGroups.Clone(),
 		// clone intermediate query.
-		sql:    giq.sql.Clone(),
-		path:   giq.path,
-		unique: giq.unique,
+		sql:          giq.sql.Clone(),
+		path:         giq.path,
+		unique:       giq.unique,
+		loadStrategy: giq.loadStrategy,
 	}
 }
 
 // WithGroups tells the query-builder to eager-load the nodes that are connected to
 // the "groups" edge. The optional arguments are used to configure the query builder of the edge.
 func (giq *GroupInfoQuery) WithGroups(opts ...func(*GroupQuery)) *GroupInfoQuery {
-	query := &GroupQuery{config: giq.config}
+	query := (&GroupClient{config: giq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -347,6 +440,16 @@ func (giq *GroupInfoQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range giq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, giq); err != nil {
+				return err
+			}
+		}
+	}
 	if giq.path != nil {
 		prev, err := giq.path(ctx)
 		if err != nil {
@@ -365,6 +468,12 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*G
 			giq.withGroups != nil,
 		}
 	)
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[2]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*GroupInfo).scanValues(nil, columns)
 	}
@@ -372,6 +481,10 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*G
 		node := &GroupInfo{config: giq.config}
 		nodes = append(nodes, node)
 		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*GroupInfo).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(giq.modifiers) > 0 {
@@ -380,7 +493,7 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*G
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, giq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, giq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -444,7 +557,7 @@ func (giq *GroupInfoQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(giq.fields) > 0 {
 		_spec.Unique = giq.unique != nil && *giq.unique
 	}
-	return sqlgraph.CountNodes(ctx, giq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, giq.queryDriver(), _spec)
 }
 
 func (giq *GroupInfoQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -538,6 +651,369 @@ func (giq *GroupInfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (giq *GroupInfoQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: groupinfo.Label}
+	if len(giq.predicates) > 0 {
+		selector := sql.Dialect(giq.driver.Dialect()).Select().From(sql.Table(groupinfo.Table))
+		for _, p := range giq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the GroupInfoQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (giq *GroupInfoQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.GroupInfo, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.GroupInfo(ps[i]))
+	}
+	giq.predicates = append(giq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (giq *GroupInfoQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := giq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := giq.querySpec()
+	if len(giq.modifiers) > 0 {
+		_spec.Modifiers = giq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = giq.unique != nil && *giq.unique
+	return sqlgraph.EstimateNodes(ctx, giq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (giq *GroupInfoQuery) CountEstimateX(ctx context.Context) int {
+	count, err := giq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// GroupInfo rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (giq *GroupInfoQuery) Sample(percent float64) *GroupInfoQuery {
+	giq.predicates = append(giq.predicates, sql.Sample(percent))
+	return giq
+}
+
+// GroupInfoIterator iterates over the results of a GroupInfo query, and decodes them one at a time.
+type GroupInfoIterator struct {
+	ctx   context.Context
+	query *GroupInfoQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*GroupInfo
+	node  *GroupInfo
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the GroupInfo entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.GroupInfo.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (giq *GroupInfoQuery) Iterate(ctx context.Context, opts ...IterateOption) (*GroupInfoIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := giq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &GroupInfoIterator{ctx: ctx, query: giq, batch: o.batch}
+	var (
+		_spec       = giq.querySpec()
+		loadedTypes = [1]bool{
+			giq.withGroups != nil,
+		}
+	)
+	var (
+		selected *[2]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*GroupInfo).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &GroupInfo{config: giq.config}
+		it.nodes = append(it.nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*GroupInfo).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(giq.modifiers) > 0 {
+		_spec.Modifiers = giq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, giq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (giq *GroupInfoQuery) iterateLoad(ctx context.Context, nodes []*GroupInfo) ([]*GroupInfo, error) {
+	query := *giq
+	giq = &query
+	giq.withGroups = giq.withGroups.Clone()
+	if named := giq.withNamedGroups; named != nil {
+		giq.withNamedGroups = make(map[string]*GroupQuery, len(named))
+		for name, q := range named {
+			giq.withNamedGroups[name] = q.Clone()
+		}
+	}
+	if query := giq.withGroups; query != nil {
+		if err := giq.loadGroups(ctx, query, nodes,
+			func(n *GroupInfo) { n.Edges.Groups = []*Group{} },
+			func(n *GroupInfo, e *Group) { n.Edges.Groups = append(n.Edges.Groups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range giq.withNamedGroups {
+		if err := giq.loadGroups(ctx, query, nodes,
+			func(n *GroupInfo) { n.appendNamedGroups(name) },
+			func(n *GroupInfo, e *Group) { n.appendNamedGroups(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// Next advances the iterator to the next GroupInfo, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *GroupInfoIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*GroupInfo, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current GroupInfo of the iterator.
+func (it *GroupInfoIterator) Value() *GroupInfo {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *GroupInfoIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *GroupInfoIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// Join returns a builder for joining the GroupInfo entities with the entities of the given table, that
+// belongs to one of the types that are connected to GroupInfo by an edge. The results are returned
+// as typed rows holding both entities. Note that the joined entities are queried using their own query
+// builder, and therefore, their privacy policies are applied as usual.
+//
+//	rows, err := client.GroupInfo.Query().
+//		Join(group.Table).
+//		On(left, right).
+//		All(ctx)
+//
+func (giq *GroupInfoQuery) Join(table string) *GroupInfoJoin {
+	return &GroupInfoJoin{query: giq, table: table}
+}
+
+// GroupInfoJoinRow is a row that is returned by GroupInfoJoin. It holds the GroupInfo entity,
+// and the joined entity in the field of its type. The rest of the fields are nil.
+type GroupInfoJoinRow struct {
+	GroupInfo *GroupInfo
+	Group     *Group
+}
+
+// GroupInfoJoin is the builder for joining GroupInfo entities with the entities of another type.
+type GroupInfoJoin struct {
+	query   *GroupInfoQuery
+	table   string
+	on      []string
+	columns []string
+}
+
+// On sets the columns of the join condition. The left column belongs
+// to GroupInfo, and the right column belongs to the joined table.
+func (gij *GroupInfoJoin) On(left, right string) *GroupInfoJoin {
+	gij.on = []string{left, right}
+	return gij
+}
+
+// Select sets the columns of the joined table to be selected. By default, all columns are selected.
+func (gij *GroupInfoJoin) Select(columns ...string) *GroupInfoJoin {
+	gij.columns = append(gij.columns, columns...)
+	return gij
+}
+
+// All executes the join query and returns the joined rows.
+func (gij *GroupInfoJoin) All(ctx context.Context) ([]*GroupInfoJoinRow, error) {
+	if len(gij.on) != 2 {
+		return nil, &ValidationError{Name: gij.table, err: errors.New("ent: missing join condition for GroupInfo")}
+	}
+	if !groupinfo.ValidColumn(gij.on[0]) {
+		return nil, &ValidationError{Name: gij.on[0], err: fmt.Errorf("ent: invalid join column %q for GroupInfo", gij.on[0])}
+	}
+	var (
+		joined   *sql.Selector
+		jcolumns []string
+		scan     func(*GroupInfoJoinRow) ([]interface{}, func([]interface{}) error, error)
+	)
+	switch gij.table {
+	case group.Table:
+		if !group.ValidColumn(gij.on[1]) {
+			return nil, &ValidationError{Name: gij.on[1], err: fmt.Errorf("ent: invalid join column %q for Group", gij.on[1])}
+		}
+		query := (&GroupClient{config: gij.query.config}).Query()
+		if len(gij.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{group.FieldID, gij.on[1]}, gij.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = group.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *GroupInfoJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.Group = &Group{config: gij.query.config}
+			values, err := r.Group.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.Group.assignValues(jcolumns, values) }, err
+		}
+	default:
+		return nil, &ValidationError{Name: gij.table, err: fmt.Errorf("ent: cannot join GroupInfo with table %q", gij.table)}
+	}
+	if err := gij.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := gij.query.fields
+	if len(columns) == 0 {
+		columns = groupinfo.Columns
+	}
+	selector := gij.query.sqlQuery(ctx)
+	selector.Join(joined).On(selector.C(gij.on[0]), joined.C(gij.on[1]))
+	selector.AppendSelect(joined.Columns(jcolumns...)...)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gij.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*GroupInfoJoinRow
+	for rows.Next() {
+		r := &GroupInfoJoinRow{GroupInfo: &GroupInfo{config: gij.query.config}}
+		values, err := r.GroupInfo.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		jvalues, assign, err := scan(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(append(values, jvalues...)...); err != nil {
+			return nil, err
+		}
+		if err := r.GroupInfo.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		if err := assign(jvalues); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gij *GroupInfoJoin) AllX(ctx context.Context) []*GroupInfoJoinRow {
+	rows, err := gij.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// LoadStrategy sets the strategy for eager-loading the GroupInfo entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (giq *GroupInfoQuery) LoadStrategy(s LoadStrategy) *GroupInfoQuery {
+	giq.loadStrategy = s
+	return giq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (giq *GroupInfoQuery) joinable() bool {
+	if giq.loadStrategy != LoadStrategyJoin || giq.limit != nil || giq.offset != nil {
+		return false
+	}
+	if giq.withGroups != nil || len(giq.withNamedGroups) > 0 {
+		return false
+	}
+	if len(giq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range giq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -573,7 +1049,7 @@ func (giq *GroupInfoQuery) Modify(modifiers ...func(s *sql.Selector)) *GroupInfo
 // WithNamedGroups tells the query-builder to eager-load the nodes that are connected to the "groups"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (giq *GroupInfoQuery) WithNamedGroups(name string, opts ...func(*GroupQuery)) *GroupInfoQuery {
-	query := &GroupQuery{config: giq.config}
+	query := (&GroupClient{config: giq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -584,6 +1060,347 @@ func (giq *GroupInfoQuery) WithNamedGroups(name string, opts ...func(*GroupQuery
 	return giq
 }
 
+// Project returns a builder for querying the given fields into GroupInfoProjection structs,
+// instead of full GroupInfo entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.GroupInfo.Query().
+//		Project(groupinfo.FieldID, groupinfo.FieldDesc).
+//		All(ctx)
+//
+func (giq *GroupInfoQuery) Project(fields ...string) *GroupInfoProject {
+	giq.fields = append(giq.fields, fields...)
+	return &GroupInfoProject{query: giq}
+}
+
+// GroupInfoProjection is a lightweight projection of the GroupInfo entity that is returned by
+// GroupInfoProject. Fields that were not selected by the query hold their zero values.
+type GroupInfoProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Desc holds the value of the "desc" field.
+	Desc string `json:"desc,omitempty"`
+	// MaxUsers holds the value of the "max_users" field.
+	MaxUsers int `json:"max_users,omitempty"`
+}
+
+// GroupInfoProject is the builder for querying GroupInfo fields into projections.
+type GroupInfoProject struct {
+	query *GroupInfoQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (gip *GroupInfoProject) All(ctx context.Context) ([]*GroupInfoProjection, error) {
+	if err := gip.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := gip.query.fields
+	if len(columns) == 0 {
+		columns = groupinfo.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := gip.query.sqlQuery(ctx).Query()
+	if err := gip.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &GroupInfo{}
+		result []*GroupInfoProjection
+	)
+	for rows.Next() {
+		*e = GroupInfo{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &GroupInfoProjection{
+			ID:       e.ID,
+			Desc:     e.Desc,
+			MaxUsers: e.MaxUsers,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gip *GroupInfoProject) AllX(ctx context.Context) []*GroupInfoProjection {
+	items, err := gip.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (giq *GroupInfoQuery) onlyShared(ctx context.Context) (*GroupInfo, error) {
+	if err := giq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	giq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *giq
+	kq.sql = giq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if giq.singleflightScope != nil {
+		scope = giq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := giq.singleflight.DoChan(singleflightKey(scope, groupinfo.Label, query, args), func() (interface{}, error) {
+		nodes, err := giq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, giq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{groupinfo.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*GroupInfo)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.GroupInfo.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (giq *GroupInfoQuery) SelectMask(paths ...string) *GroupInfoSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case groupinfo.FieldID:
+		case groupinfo.EdgeGroups:
+		case "desc":
+			fields = append(fields, groupinfo.FieldDesc)
+		case "max_users":
+			fields = append(fields, groupinfo.FieldMaxUsers)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, groupinfo.FieldID)
+	}
+	return giq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the groupinfo.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (giq *GroupInfoQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = groupinfo.FieldID
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering GroupInfo`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		giq.Order(Asc(column))
+	case DirectionDesc:
+		giq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// GroupInfoPage is a page of GroupInfo entities returned by the Paginate method.
+type GroupInfoPage struct {
+	Nodes []*GroupInfo `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of GroupInfo entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.GroupInfo.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.GroupInfo.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (giq *GroupInfoQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*GroupInfoPage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case groupinfo.FieldID:
+			order.column = groupinfo.FieldID
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating GroupInfo`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != groupinfo.FieldID {
+		order := paginateOrder{name: groupinfo.FieldID, column: groupinfo.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := giq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &GroupInfoPage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case groupinfo.FieldID:
+				values[j] = n.ID
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (giq *GroupInfoQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case groupinfo.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (giq *GroupInfoQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*GroupInfo, error) {
+	if giq.limit != nil {
+		return giq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	giq.limit = &limit
+	defer func() { giq.limit = nil }()
+	nodes, err := giq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: groupinfo.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // GroupInfoGroupBy is the group-by builder for GroupInfo entities.
 type GroupInfoGroupBy struct {
 	config
@@ -623,7 +1440,7 @@ func (gigb *GroupInfoGroupBy) sqlScan(ctx context.Context, v interface{}) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := gigb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := gigb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -669,7 +1486,7 @@ func (gis *GroupInfoSelect) Scan(ctx context.Context, v interface{}) error {
 func (gis *GroupInfoSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := gis.sql.Query()
-	if err := gis.driver.Query(ctx, query, args, rows); err != nil {
+	if err := gis.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -59,6 +59,25 @@ func (giu *GroupInfoUpdate) AddMaxUsers(i int) *GroupInfoUpdate {
 	return giu
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (giu *GroupInfoUpdate) SetMaxUsersIfGreater(i int) *GroupInfoUpdate {
+	giu.mutation.SetMaxUsersIfGreater(i)
+	return giu
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field in the database.
+func (giu *GroupInfoUpdate) BitAndMaxUsers(i int) *GroupInfoUpdate {
+	giu.mutation.BitAndMaxUsers(i)
+	return giu
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field in the database.
+func (giu *GroupInfoUpdate) BitOrMaxUsers(i int) *GroupInfoUpdate {
+	giu.mutation.BitOrMaxUsers(i)
+	return giu
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (giu *GroupInfoUpdate) AddGroupIDs(ids ...int) *GroupInfoUpdate {
 	giu.mutation.AddGroupIDs(ids...)
@@ -193,6 +212,27 @@ func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: groupinfo.FieldMaxUsers,
 		})
 	}
+	if value, ok := giu.mutation.MaxUsersIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: groupinfo.FieldMaxUsers,
+		})
+	}
+	if value, ok := giu.mutation.MaxUsersBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: groupinfo.FieldMaxUsers,
+		})
+	}
+	if value, ok := giu.mutation.MaxUsersBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: groupinfo.FieldMaxUsers,
+		})
+	}
 	if giu.mutation.GroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -249,7 +289,7 @@ func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, giu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{groupinfo.Label}
+			err = &NotFoundError{label: groupinfo.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -261,9 +301,18 @@ func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // GroupInfoUpdateOne is the builder for updating a single GroupInfo entity.
 type GroupInfoUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GroupInfoMutation
+	fields     []string
+	conditions []predicate.GroupInfo
+	hooks      []Hook
+	mutation   *GroupInfoMutation
+}
+
+// Where appends a list of conditions to the GroupInfoUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (giuo *GroupInfoUpdateOne) Where(ps ...predicate.GroupInfo) *GroupInfoUpdateOne {
+	giuo.conditions = append(giuo.conditions, ps...)
+	return giuo
 }
 
 // SetDesc sets the "desc" field.
@@ -293,6 +342,25 @@ func (giuo *GroupInfoUpdateOne) AddMaxUsers(i int) *GroupInfoUpdateOne {
 	return giuo
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (giuo *GroupInfoUpdateOne) SetMaxUsersIfGreater(i int) *GroupInfoUpdateOne {
+	giuo.mutation.SetMaxUsersIfGreater(i)
+	return giuo
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field in the database.
+func (giuo *GroupInfoUpdateOne) BitAndMaxUsers(i int) *GroupInfoUpdateOne {
+	giuo.mutation.BitAndMaxUsers(i)
+	return giuo
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field in the database.
+func (giuo *GroupInfoUpdateOne) BitOrMaxUsers(i int) *GroupInfoUpdateOne {
+	giuo.mutation.BitOrMaxUsers(i)
+	return giuo
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (giuo *GroupInfoUpdateOne) AddGroupIDs(ids ...int) *GroupInfoUpdateOne {
 	giuo.mutation.AddGroupIDs(ids...)
@@ -436,6 +504,13 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (_node *GroupInfo,
 			}
 		}
 	}
+	if ps := giuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := giuo.mutation.Desc(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -457,6 +532,27 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (_node *GroupInfo,
 			Column: groupinfo.FieldMaxUsers,
 		})
 	}
+	if value, ok := giuo.mutation.MaxUsersIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: groupinfo.FieldMaxUsers,
+		})
+	}
+	if value, ok := giuo.mutation.MaxUsersBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: groupinfo.FieldMaxUsers,
+		})
+	}
+	if value, ok := giuo.mutation.MaxUsersBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: groupinfo.FieldMaxUsers,
+		})
+	}
 	if giuo.mutation.GroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -516,7 +612,9 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (_node *GroupInfo,
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, giuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{groupinfo.Label}
+			err = &NotFoundError{label: groupinfo.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: groupinfo.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -524,3 +622,99 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (_node *GroupInfo,
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the GroupInfo entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (giuo *GroupInfoUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := giuo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupInfo.id" for update`)}
+	}
+	return enqueueAsync(ctx, giuo.config, giuo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by GroupInfo.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (giu *GroupInfoUpdate) SetChanged(changes []FieldChange) (*GroupInfoUpdate, error) {
+	if err := setGroupInfoChanged(giu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return giu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by GroupInfo.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (giuo *GroupInfoUpdateOne) SetChanged(changes []FieldChange) (*GroupInfoUpdateOne, error) {
+	if err := setGroupInfoChanged(giuo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return giuo, nil
+}
+
+// setGroupInfoChanged applies the given field changes on the GroupInfo mutation.
+func setGroupInfoChanged(m *GroupInfoMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the GroupInfo entities that are joined with the rows of the given table, on the given columns
+// of the GroupInfo table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.GroupInfo.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (giu *GroupInfoUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *GroupInfoUpdate {
+	giu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return giu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the GroupInfo schema.
+func (giu *GroupInfoUpdate) ApplyPatch(p *GroupInfoPatch) (*GroupInfoUpdate, error) {
+	if err := applyGroupInfoPatch(giu.mutation, p); err != nil {
+		return nil, err
+	}
+	return giu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the GroupInfo schema.
+func (giuo *GroupInfoUpdateOne) ApplyPatch(p *GroupInfoPatch) (*GroupInfoUpdateOne, error) {
+	if err := applyGroupInfoPatch(giuo.mutation, p); err != nil {
+		return nil, err
+	}
+	return giuo, nil
+}
+
+// applyGroupInfoPatch applies the given patch on the GroupInfo mutation.
+func applyGroupInfoPatch(m *GroupInfoMutation, p *GroupInfoPatch) error {
+	if p.Desc != nil {
+		m.SetDesc(*p.Desc)
+	}
+	if p.MaxUsers != nil {
+		m.SetMaxUsers(*p.MaxUsers)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
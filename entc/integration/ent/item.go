@@ -7,7 +7,9 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -21,6 +23,11 @@ type Item struct {
 	ID string `json:"id,omitempty"`
 	// Text holds the value of the "text" field.
 	Text string `json:"text,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[2]bool
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -28,7 +35,7 @@ func (*Item) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case item.FieldID, item.FieldText:
+		case item.FieldID, item.FieldText, item.FieldToken:
 			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Item", columns[i])
@@ -57,6 +64,12 @@ func (i *Item) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				i.Text = value.String
 			}
+		case item.FieldToken:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[j])
+			} else if value.Valid {
+				i.Token = value.String
+			}
 		}
 	}
 	return nil
@@ -87,10 +100,178 @@ func (i *Item) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
 	builder.WriteString("text=")
 	builder.WriteString(i.Text)
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(i.Token)
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the Item and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (i *Item) Diff(other *Item) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(i.Text, other.Text) {
+		changes = append(changes, FieldChange{Field: item.FieldText, Old: i.Text, New: other.Text})
+	}
+	if !reflect.DeepEqual(i.Token, other.Token) {
+		changes = append(changes, FieldChange{Field: item.FieldToken, Old: i.Token, New: other.Token})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the Item that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (i *Item) ApplyMask(paths ...string) (*Item, error) {
+	if len(paths) == 0 {
+		masked := *i
+		return &masked, nil
+	}
+	masked := &Item{config: i.config, ID: i.ID}
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case item.FieldID:
+		case "text":
+			masked.Text = i.Text
+		case "token":
+			masked.Token = i.Token
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type Item", p)
+		}
+	}
+	return masked, nil
+}
+
+// ItemPatch describes a partial update of a Item entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type ItemPatch struct {
+	// Text holds the new value of the "text" field.
+	Text *string `json:"text,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, item.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *ItemPatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p ItemPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.Text != nil {
+		fields["text"] = *p.Text
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		case item.FieldText:
+			fields["text"] = nil
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional Item field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *ItemPatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "text":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, item.FieldText)
+				continue
+			}
+			p.Text = new(string)
+			if err := json.Unmarshal(raw, p.Text); err != nil {
+				return fmt.Errorf("ent: decoding Item field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable Item field %q", name)
+		}
+	}
+	return nil
+}
+
+// Selected reports whether the given field was loaded into the Item, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	i := client.Item.Query().Select(item.FieldText).FirstX(ctx)
+//	i.Selected(item.FieldText) // true
+//
+func (i *Item) Selected(field string) bool {
+	switch field {
+	case item.FieldID:
+		return true
+	case item.FieldText:
+		return i.selectedFields == nil || i.selectedFields[0]
+	case item.FieldToken:
+		return i.selectedFields == nil || i.selectedFields[1]
+	}
+	return false
+}
+
+// TextOrErr returns the value of the "text" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Item.
+func (i *Item) TextOrErr() (string, error) {
+	if i.selectedFields != nil && !i.selectedFields[0] {
+		var zero string
+		return zero, &NotSelectedError{label: item.Label, field: item.FieldText}
+	}
+	return i.Text, nil
+}
+
+// TokenOrErr returns the value of the "token" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Item.
+func (i *Item) TokenOrErr() (string, error) {
+	if i.selectedFields != nil && !i.selectedFields[1] {
+		var zero string
+		return zero, &NotSelectedError{label: item.Label, field: item.FieldToken}
+	}
+	return i.Token, nil
+}
+
+// selectFields returns the fields of the Item that are included in the given
+// columns, or nil if all of them are included.
+func (*Item) selectFields(columns []string) *[2]bool {
+	var selected [2]bool
+	for _, c := range columns {
+		switch c {
+		case item.FieldText:
+			selected[0] = true
+		case item.FieldToken:
+			selected[1] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // Items is a parsable slice of Item.
 type Items []*Item
 
@@ -6,6 +6,11 @@
 
 package item
 
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the item type in the database.
 	Label = "item"
@@ -13,6 +18,8 @@ const (
 	FieldID = "id"
 	// FieldText holds the string denoting the text field in the database.
 	FieldText = "text"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
 	// Table holds the table name of the item in the database.
 	Table = "items"
 )
@@ -21,6 +28,7 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldText,
+	FieldToken,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -36,10 +44,55 @@ func ValidColumn(column string) bool {
 var (
 	// TextValidator is a validator for the "text" field. It is called by the builders before save.
 	TextValidator func(string) error
+	// DefaultToken holds the default value on creation for the "token" field.
+	DefaultToken func() string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
 
+// fieldInfos describes the fields of the Item type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Default:     true,
+	},
+	{
+		Name:        "text",
+		Column:      FieldText,
+		StructField: "Text",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+		Unique:      true,
+	},
+	{
+		Name:        "token",
+		Column:      FieldToken,
+		StructField: "Token",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Default:     true,
+	},
+}
+
+// Fields returns the information of the Item fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+	"text",
+}
+
 // comment from another template.
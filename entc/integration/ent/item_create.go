@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -40,6 +41,20 @@ func (ic *ItemCreate) SetNillableText(s *string) *ItemCreate {
 	return ic
 }
 
+// SetToken sets the "token" field.
+func (ic *ItemCreate) SetToken(s string) *ItemCreate {
+	ic.mutation.SetToken(s)
+	return ic
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableToken(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetToken(*s)
+	}
+	return ic
+}
+
 // SetID sets the "id" field.
 func (ic *ItemCreate) SetID(s string) *ItemCreate {
 	ic.mutation.SetID(s)
@@ -65,6 +80,9 @@ func (ic *ItemCreate) Save(ctx context.Context) (*Item, error) {
 		err  error
 		node *Item
 	)
+	if err := checkReadOnlyDefaults(ctx, ic.mutation, item.FieldToken); err != nil {
+		return nil, err
+	}
 	ic.defaults()
 	if len(ic.hooks) == 0 {
 		if err = ic.check(); err != nil {
@@ -131,6 +149,10 @@ func (ic *ItemCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ic *ItemCreate) defaults() {
+	if _, ok := ic.mutation.Token(); !ok {
+		v := item.DefaultToken()
+		ic.mutation.SetToken(v)
+	}
 	if _, ok := ic.mutation.ID(); !ok {
 		v := item.DefaultID()
 		ic.mutation.SetID(v)
@@ -144,6 +166,9 @@ func (ic *ItemCreate) check() error {
 			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
 		}
 	}
+	if _, ok := ic.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Item.token"`)}
+	}
 	if v, ok := ic.mutation.ID(); ok {
 		if err := item.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)}
@@ -194,9 +219,79 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		})
 		_node.Text = value
 	}
+	if value, ok := ic.mutation.Token(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: item.FieldToken,
+		})
+		_node.Token = value
+	}
 	return _node, _spec
 }
 
+// ItemInsertSelect is the builder for creating Item entities from the result of a query.
+type ItemInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the Item table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+// For example:
+//
+//	client.Item.CreateFromQuery(query.Select(...)).
+//		Columns(item.FieldText).
+//		Exec(ctx)
+//
+func (iis *ItemInsertSelect) Columns(columns ...string) *ItemInsertSelect {
+	iis.columns = append(iis.columns, columns...)
+	return iis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (iis *ItemInsertSelect) Exec(ctx context.Context) (int, error) {
+	if iis.query == nil {
+		return 0, errors.New("ent: missing query for Item.CreateFromQuery")
+	}
+	if err := iis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := iis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := iis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !item.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for Item.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: item.Table, Columns: item.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, iis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iis *ItemInsertSelect) ExecX(ctx context.Context) int {
+	n, err := iis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -240,6 +335,7 @@ type (
 	//  one Item node.
 	ItemUpsertOne struct {
 		create *ItemCreate
+		err    error // first validation error of the update values.
 	}
 
 	// ItemUpsert is the "OnConflict" setter.
@@ -266,6 +362,18 @@ func (u *ItemUpsert) ClearText() *ItemUpsert {
 	return u
 }
 
+// SetToken sets the "token" field.
+func (u *ItemUpsert) SetToken(v string) *ItemUpsert {
+	u.Set(item.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateToken() *ItemUpsert {
+	u.SetExcluded(item.FieldToken)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -316,8 +424,12 @@ func (u *ItemUpsertOne) Update(set func(*ItemUpsert)) *ItemUpsertOne {
 	return u
 }
 
-// SetText sets the "text" field.
+// SetText sets the "text" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *ItemUpsertOne) SetText(v string) *ItemUpsertOne {
+	if err := item.TextValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
+	}
 	return u.Update(func(s *ItemUpsert) {
 		s.SetText(v)
 	})
@@ -337,8 +449,25 @@ func (u *ItemUpsertOne) ClearText() *ItemUpsertOne {
 	})
 }
 
+// SetToken sets the "token" field.
+func (u *ItemUpsertOne) SetToken(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateToken() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateToken()
+	})
+}
+
 // Exec executes the query.
 func (u *ItemUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for ItemCreate.OnConflict")
 	}
@@ -347,7 +476,7 @@ func (u *ItemUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *ItemUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -359,6 +488,9 @@ func (u *ItemUpsertOne) ID(ctx context.Context) (id string, err error) {
 		// fields from the database since MySQL does not support the RETURNING clause.
 		return id, errors.New("ent: ItemUpsertOne.ID is not supported by MySQL driver. Use ItemUpsertOne.Exec instead")
 	}
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -375,6 +507,43 @@ func (u *ItemUpsertOne) IDX(ctx context.Context) string {
 	return id
 }
 
+// SaveAsync adds the creation of the Item entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (ic *ItemCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, ic.config, ic.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the Item creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (ic *ItemCreate) IdempotencyKey(key string) *ItemCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &ic.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*Item)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from ItemMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id string
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&ItemClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	ic.hooks = append([]Hook{hook}, ic.hooks...)
+	return ic
+}
+
 // ItemCreateBulk is the builder for creating many Item entities in bulk.
 type ItemCreateBulk struct {
 	config
@@ -387,6 +556,11 @@ func (icb *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
 	nodes := make([]*Item, len(icb.builders))
 	mutators := make([]Mutator, len(icb.builders))
+	for _, builder := range icb.builders {
+		if err := checkReadOnlyDefaults(ctx, builder.mutation, item.FieldToken); err != nil {
+			return nil, err
+		}
+	}
 	for i := range icb.builders {
 		func(i int, root context.Context) {
 			builder := icb.builders[i]
@@ -457,6 +631,78 @@ func (icb *ItemCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// ItemBulkResult holds the result of creating one of the Item entities using ItemCreateBulk.ContinueOnError.
+type ItemBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *Item
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the Item entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.Item.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (icb *ItemCreateBulk) ContinueOnError(ctx context.Context) ([]ItemBulkResult, error) {
+	client := &Client{config: icb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]ItemBulkResult, len(icb.builders))
+	for i, builder := range icb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = icb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -498,6 +744,7 @@ func (icb *ItemCreateBulk) OnConflictColumns(columns ...string) *ItemUpsertBulk
 // a bulk of Item nodes.
 type ItemUpsertBulk struct {
 	create *ItemCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -553,8 +800,12 @@ func (u *ItemUpsertBulk) Update(set func(*ItemUpsert)) *ItemUpsertBulk {
 	return u
 }
 
-// SetText sets the "text" field.
+// SetText sets the "text" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *ItemUpsertBulk) SetText(v string) *ItemUpsertBulk {
+	if err := item.TextValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
+	}
 	return u.Update(func(s *ItemUpsert) {
 		s.SetText(v)
 	})
@@ -574,8 +825,25 @@ func (u *ItemUpsertBulk) ClearText() *ItemUpsertBulk {
 	})
 }
 
+// SetToken sets the "token" field.
+func (u *ItemUpsertBulk) SetToken(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateToken() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateToken()
+	})
+}
+
 // Exec executes the query.
 func (u *ItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ItemCreateBulk instead", i)
@@ -589,7 +857,7 @@ func (u *ItemUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *ItemUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -95,6 +95,19 @@ func (id *ItemDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the Item entities that are joined with the rows of the given table, on the given columns
+// of the Item table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.Item.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (id *ItemDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *ItemDelete {
+	id.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return id
+}
+
 // ItemDeleteOne is the builder for deleting a single Item entity.
 type ItemDeleteOne struct {
 	id *ItemDelete
@@ -107,7 +120,7 @@ func (ido *ItemDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{item.Label}
+		return &NotFoundError{label: item.Label}
 	default:
 		return nil
 	}
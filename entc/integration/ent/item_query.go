@@ -8,9 +8,12 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -22,13 +25,15 @@ import (
 // ItemQuery is the builder for querying Item entities.
 type ItemQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.Item
-	modifiers  []func(*sql.Selector)
+	limit        *int
+	offset       *int
+	unique       *bool
+	order        []OrderFunc
+	fields       []string
+	inters       []Interceptor
+	predicates   []predicate.Item
+	loadStrategy LoadStrategy
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -73,7 +78,7 @@ func (iq *ItemQuery) First(ctx context.Context) (*Item, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{item.Label}
+		return nil, iq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -95,7 +100,7 @@ func (iq *ItemQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{item.Label}
+		err = iq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -114,6 +119,11 @@ func (iq *ItemQuery) FirstIDX(ctx context.Context) string {
 // Returns a *NotSingularError when more than one Item entity is found.
 // Returns a *NotFoundError when no Item entities are found.
 func (iq *ItemQuery) Only(ctx context.Context) (*Item, error) {
+	if iq.singleflight != nil {
+		if _, ok := iq.driver.(*txDriver); !ok {
+			return iq.onlyShared(ctx)
+		}
+	}
 	nodes, err := iq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -122,7 +132,7 @@ func (iq *ItemQuery) Only(ctx context.Context) (*Item, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{item.Label}
+		return nil, iq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{item.Label}
 	}
@@ -149,7 +159,7 @@ func (iq *ItemQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{item.Label}
+		err = iq.sqlNotFound()
 	default:
 		err = &NotSingularError{item.Label}
 	}
@@ -170,7 +180,20 @@ func (iq *ItemQuery) All(ctx context.Context) ([]*Item, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return iq.sqlAll(ctx)
+	if p := iq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return iq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := iq.intercept(ctx, "All", func(ctx context.Context, query *ItemQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Item)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Item", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -184,10 +207,20 @@ func (iq *ItemQuery) AllX(ctx context.Context) []*Item {
 
 // IDs executes the query and returns a list of Item IDs.
 func (iq *ItemQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := iq.Select(item.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := iq.intercept(ctx, "IDs", func(ctx context.Context, query *ItemQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(item.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -205,7 +238,17 @@ func (iq *ItemQuery) Count(ctx context.Context) (int, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return iq.sqlCount(ctx)
+	v, err := iq.intercept(ctx, "Count", func(ctx context.Context, query *ItemQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -222,7 +265,47 @@ func (iq *ItemQuery) Exist(ctx context.Context) (bool, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return iq.sqlExist(ctx)
+	v, err := iq.intercept(ctx, "Exist", func(ctx context.Context, query *ItemQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (iq *ItemQuery) intercept(ctx context.Context, op string, fn func(context.Context, *ItemQuery) (Value, error)) (Value, error) {
+	if len(iq.inters) == 0 {
+		return fn(ctx, iq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeItem,
+		Op:     op,
+		Limit:  iq.limit,
+		Offset: iq.offset,
+		Unique: iq.unique,
+		Fields: iq.fields,
+	}
+	for _, p := range iq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range iq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*ItemQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *ItemQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, iq, qc, qr, iq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -245,11 +328,13 @@ func (iq *ItemQuery) Clone() *ItemQuery {
 		limit:      iq.limit,
 		offset:     iq.offset,
 		order:      append([]OrderFunc{}, iq.order...),
+		inters:     append([]Interceptor{}, iq.inters...),
 		predicates: append([]predicate.Item{}, iq.predicates...),
 		// clone intermediate query.
-		sql:    iq.sql.Clone(),
-		path:   iq.path,
-		unique: iq.unique,
+		sql:          iq.sql.Clone(),
+		path:         iq.path,
+		unique:       iq.unique,
+		loadStrategy: iq.loadStrategy,
 	}
 }
 
@@ -309,6 +394,16 @@ func (iq *ItemQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range iq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iq); err != nil {
+				return err
+			}
+		}
+	}
 	if iq.path != nil {
 		prev, err := iq.path(ctx)
 		if err != nil {
@@ -324,12 +419,22 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 		nodes = []*Item{}
 		_spec = iq.querySpec()
 	)
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[2]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Item).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []interface{}) error {
 		node := &Item{config: iq.config}
 		nodes = append(nodes, node)
+		if !computed {
+			selected, computed = (*Item).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(iq.modifiers) > 0 {
@@ -338,7 +443,7 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, iq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, iq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -356,7 +461,7 @@ func (iq *ItemQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(iq.fields) > 0 {
 		_spec.Unique = iq.unique != nil && *iq.unique
 	}
-	return sqlgraph.CountNodes(ctx, iq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, iq.queryDriver(), _spec)
 }
 
 func (iq *ItemQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -450,6 +555,200 @@ func (iq *ItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (iq *ItemQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: item.Label}
+	if len(iq.predicates) > 0 {
+		selector := sql.Dialect(iq.driver.Dialect()).Select().From(sql.Table(item.Table))
+		for _, p := range iq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the ItemQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (iq *ItemQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Item, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Item(ps[i]))
+	}
+	iq.predicates = append(iq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (iq *ItemQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := iq.querySpec()
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = iq.unique != nil && *iq.unique
+	return sqlgraph.EstimateNodes(ctx, iq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (iq *ItemQuery) CountEstimateX(ctx context.Context) int {
+	count, err := iq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// Item rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (iq *ItemQuery) Sample(percent float64) *ItemQuery {
+	iq.predicates = append(iq.predicates, sql.Sample(percent))
+	return iq
+}
+
+// ItemIterator iterates over the results of a Item query, and decodes them one at a time.
+type ItemIterator struct {
+	ctx   context.Context
+	query *ItemQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*Item
+	node  *Item
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the Item entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.Item.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (iq *ItemQuery) Iterate(ctx context.Context, opts ...IterateOption) (*ItemIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := iq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &ItemIterator{ctx: ctx, query: iq, batch: o.batch}
+	var (
+		_spec = iq.querySpec()
+	)
+	var (
+		selected *[2]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Item).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Item{config: iq.config}
+		it.nodes = append(it.nodes, node)
+		if !computed {
+			selected, computed = (*Item).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, iq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (iq *ItemQuery) iterateLoad(ctx context.Context, nodes []*Item) ([]*Item, error) {
+	return nodes, nil
+}
+
+// Next advances the iterator to the next Item, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *ItemIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*Item, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current Item of the iterator.
+func (it *ItemIterator) Value() *Item {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *ItemIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *ItemIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// LoadStrategy sets the strategy for eager-loading the Item entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (iq *ItemQuery) LoadStrategy(s LoadStrategy) *ItemQuery {
+	iq.loadStrategy = s
+	return iq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (iq *ItemQuery) joinable() bool {
+	if iq.loadStrategy != LoadStrategyJoin || iq.limit != nil || iq.offset != nil {
+		return false
+	}
+	if len(iq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range iq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -482,6 +781,348 @@ func (iq *ItemQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemSelect {
 	return iq.Select()
 }
 
+// Project returns a builder for querying the given fields into ItemProjection structs,
+// instead of full Item entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.Item.Query().
+//		Project(item.FieldID, item.FieldText).
+//		All(ctx)
+//
+func (iq *ItemQuery) Project(fields ...string) *ItemProject {
+	iq.fields = append(iq.fields, fields...)
+	return &ItemProject{query: iq}
+}
+
+// ItemProjection is a lightweight projection of the Item entity that is returned by
+// ItemProject. Fields that were not selected by the query hold their zero values.
+type ItemProjection struct {
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+}
+
+// ItemProject is the builder for querying Item fields into projections.
+type ItemProject struct {
+	query *ItemQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (ip *ItemProject) All(ctx context.Context) ([]*ItemProjection, error) {
+	if err := ip.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := ip.query.fields
+	if len(columns) == 0 {
+		columns = item.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := ip.query.sqlQuery(ctx).Query()
+	if err := ip.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &Item{}
+		result []*ItemProjection
+	)
+	for rows.Next() {
+		*e = Item{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &ItemProjection{
+			ID:    e.ID,
+			Text:  e.Text,
+			Token: e.Token,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ip *ItemProject) AllX(ctx context.Context) []*ItemProjection {
+	items, err := ip.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (iq *ItemQuery) onlyShared(ctx context.Context) (*Item, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	iq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *iq
+	kq.sql = iq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if iq.singleflightScope != nil {
+		scope = iq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := iq.singleflight.DoChan(singleflightKey(scope, item.Label, query, args), func() (interface{}, error) {
+		nodes, err := iq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, iq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{item.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*Item)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.Item.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (iq *ItemQuery) SelectMask(paths ...string) *ItemSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case item.FieldID:
+		case "text":
+			fields = append(fields, item.FieldText)
+		case "token":
+			fields = append(fields, item.FieldToken)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, item.FieldID)
+	}
+	return iq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the item.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (iq *ItemQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = item.FieldID
+	case "text":
+		column = item.FieldText
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering Item`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		iq.Order(Asc(column))
+	case DirectionDesc:
+		iq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// ItemPage is a page of Item entities returned by the Paginate method.
+type ItemPage struct {
+	Nodes []*Item `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of Item entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.Item.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.Item.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (iq *ItemQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*ItemPage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case item.FieldID:
+			order.column = item.FieldID
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating Item`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != item.FieldID {
+		order := paginateOrder{name: item.FieldID, column: item.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := iq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &ItemPage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case item.FieldID:
+				values[j] = n.ID
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (iq *ItemQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case item.FieldID:
+			var v string
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (iq *ItemQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*Item, error) {
+	if iq.limit != nil {
+		return iq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	iq.limit = &limit
+	defer func() { iq.limit = nil }()
+	nodes, err := iq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: item.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // ItemGroupBy is the group-by builder for Item entities.
 type ItemGroupBy struct {
 	config
@@ -521,7 +1162,7 @@ func (igb *ItemGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := igb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := igb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -567,7 +1208,7 @@ func (is *ItemSelect) Scan(ctx context.Context, v interface{}) error {
 func (is *ItemSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := is.sql.Query()
-	if err := is.driver.Query(ctx, query, args, rows); err != nil {
+	if err := is.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
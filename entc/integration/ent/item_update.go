@@ -51,6 +51,20 @@ func (iu *ItemUpdate) ClearText() *ItemUpdate {
 	return iu
 }
 
+// SetToken sets the "token" field.
+func (iu *ItemUpdate) SetToken(s string) *ItemUpdate {
+	iu.mutation.SetToken(s)
+	return iu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableToken(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetToken(*s)
+	}
+	return iu
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iu *ItemUpdate) Mutation() *ItemMutation {
 	return iu.mutation
@@ -157,9 +171,16 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: item.FieldText,
 		})
 	}
+	if value, ok := iu.mutation.Token(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: item.FieldToken,
+		})
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{item.Label}
+			err = &NotFoundError{label: item.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -171,9 +192,18 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // ItemUpdateOne is the builder for updating a single Item entity.
 type ItemUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ItemMutation
+	fields     []string
+	conditions []predicate.Item
+	hooks      []Hook
+	mutation   *ItemMutation
+}
+
+// Where appends a list of conditions to the ItemUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (iuo *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
+	iuo.conditions = append(iuo.conditions, ps...)
+	return iuo
 }
 
 // SetText sets the "text" field.
@@ -196,6 +226,20 @@ func (iuo *ItemUpdateOne) ClearText() *ItemUpdateOne {
 	return iuo
 }
 
+// SetToken sets the "token" field.
+func (iuo *ItemUpdateOne) SetToken(s string) *ItemUpdateOne {
+	iuo.mutation.SetToken(s)
+	return iuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableToken(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetToken(*s)
+	}
+	return iuo
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iuo *ItemUpdateOne) Mutation() *ItemMutation {
 	return iuo.mutation
@@ -319,6 +363,13 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 			}
 		}
 	}
+	if ps := iuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := iuo.mutation.Text(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -332,12 +383,21 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 			Column: item.FieldText,
 		})
 	}
+	if value, ok := iuo.mutation.Token(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: item.FieldToken,
+		})
+	}
 	_node = &Item{config: iuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{item.Label}
+			err = &NotFoundError{label: item.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: item.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -345,3 +405,96 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the Item entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (iuo *ItemUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "Item.id" for update`)}
+	}
+	return enqueueAsync(ctx, iuo.config, iuo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Item.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (iu *ItemUpdate) SetChanged(changes []FieldChange) (*ItemUpdate, error) {
+	if err := setItemChanged(iu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return iu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Item.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (iuo *ItemUpdateOne) SetChanged(changes []FieldChange) (*ItemUpdateOne, error) {
+	if err := setItemChanged(iuo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return iuo, nil
+}
+
+// setItemChanged applies the given field changes on the Item mutation.
+func setItemChanged(m *ItemMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the Item entities that are joined with the rows of the given table, on the given columns
+// of the Item table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.Item.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (iu *ItemUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *ItemUpdate {
+	iu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return iu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Item schema.
+func (iu *ItemUpdate) ApplyPatch(p *ItemPatch) (*ItemUpdate, error) {
+	if err := applyItemPatch(iu.mutation, p); err != nil {
+		return nil, err
+	}
+	return iu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Item schema.
+func (iuo *ItemUpdateOne) ApplyPatch(p *ItemPatch) (*ItemUpdateOne, error) {
+	if err := applyItemPatch(iuo.mutation, p); err != nil {
+		return nil, err
+	}
+	return iuo, nil
+}
+
+// applyItemPatch applies the given patch on the Item mutation.
+func applyItemPatch(m *ItemMutation, p *ItemPatch) error {
+	if p.Text != nil {
+		m.SetText(*p.Text)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
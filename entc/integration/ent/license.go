@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -81,6 +82,86 @@ func (l *License) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the License and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (l *License) Diff(other *License) []FieldChange {
+	var changes []FieldChange
+	return changes
+}
+
+// ApplyMask returns a copy of the License that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (l *License) ApplyMask(paths ...string) (*License, error) {
+	if len(paths) == 0 {
+		masked := *l
+		return &masked, nil
+	}
+	masked := &License{config: l.config, ID: l.ID}
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case license.FieldID:
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type License", p)
+		}
+	}
+	return masked, nil
+}
+
+// LicensePatch describes a partial update of a License entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type LicensePatch struct {
+	// Cleared holds the names of the fields that are cleared. For example, license.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *LicensePatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p LicensePatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	for _, c := range p.Cleared {
+		switch c {
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional License field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *LicensePatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name := range fields {
+		switch name {
+		default:
+			return fmt.Errorf("ent: unknown or immutable License field %q", name)
+		}
+	}
+	return nil
+}
+
 // Licenses is a parsable slice of License.
 type Licenses []*License
 
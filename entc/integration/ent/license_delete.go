@@ -95,6 +95,19 @@ func (ld *LicenseDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the License entities that are joined with the rows of the given table, on the given columns
+// of the License table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.License.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (ld *LicenseDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *LicenseDelete {
+	ld.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return ld
+}
+
 // LicenseDeleteOne is the builder for deleting a single License entity.
 type LicenseDeleteOne struct {
 	ld *LicenseDelete
@@ -107,7 +120,7 @@ func (ldo *LicenseDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{license.Label}
+		return &NotFoundError{label: license.Label}
 	default:
 		return nil
 	}
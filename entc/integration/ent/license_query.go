@@ -8,9 +8,12 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -22,13 +25,15 @@ import (
 // LicenseQuery is the builder for querying License entities.
 type LicenseQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.License
-	modifiers  []func(*sql.Selector)
+	limit        *int
+	offset       *int
+	unique       *bool
+	order        []OrderFunc
+	fields       []string
+	inters       []Interceptor
+	predicates   []predicate.License
+	loadStrategy LoadStrategy
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -73,7 +78,7 @@ func (lq *LicenseQuery) First(ctx context.Context) (*License, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{license.Label}
+		return nil, lq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -95,7 +100,7 @@ func (lq *LicenseQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{license.Label}
+		err = lq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -114,6 +119,11 @@ func (lq *LicenseQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one License entity is found.
 // Returns a *NotFoundError when no License entities are found.
 func (lq *LicenseQuery) Only(ctx context.Context) (*License, error) {
+	if lq.singleflight != nil {
+		if _, ok := lq.driver.(*txDriver); !ok {
+			return lq.onlyShared(ctx)
+		}
+	}
 	nodes, err := lq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -122,7 +132,7 @@ func (lq *LicenseQuery) Only(ctx context.Context) (*License, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{license.Label}
+		return nil, lq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{license.Label}
 	}
@@ -149,7 +159,7 @@ func (lq *LicenseQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{license.Label}
+		err = lq.sqlNotFound()
 	default:
 		err = &NotSingularError{license.Label}
 	}
@@ -170,7 +180,20 @@ func (lq *LicenseQuery) All(ctx context.Context) ([]*License, error) {
 	if err := lq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return lq.sqlAll(ctx)
+	if p := lq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return lq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := lq.intercept(ctx, "All", func(ctx context.Context, query *LicenseQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*License)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*License", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -184,10 +207,20 @@ func (lq *LicenseQuery) AllX(ctx context.Context) []*License {
 
 // IDs executes the query and returns a list of License IDs.
 func (lq *LicenseQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := lq.Select(license.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := lq.intercept(ctx, "IDs", func(ctx context.Context, query *LicenseQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(license.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -205,7 +238,17 @@ func (lq *LicenseQuery) Count(ctx context.Context) (int, error) {
 	if err := lq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return lq.sqlCount(ctx)
+	v, err := lq.intercept(ctx, "Count", func(ctx context.Context, query *LicenseQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -222,7 +265,47 @@ func (lq *LicenseQuery) Exist(ctx context.Context) (bool, error) {
 	if err := lq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return lq.sqlExist(ctx)
+	v, err := lq.intercept(ctx, "Exist", func(ctx context.Context, query *LicenseQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (lq *LicenseQuery) intercept(ctx context.Context, op string, fn func(context.Context, *LicenseQuery) (Value, error)) (Value, error) {
+	if len(lq.inters) == 0 {
+		return fn(ctx, lq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeLicense,
+		Op:     op,
+		Limit:  lq.limit,
+		Offset: lq.offset,
+		Unique: lq.unique,
+		Fields: lq.fields,
+	}
+	for _, p := range lq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range lq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*LicenseQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *LicenseQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, lq, qc, qr, lq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -245,11 +328,13 @@ func (lq *LicenseQuery) Clone() *LicenseQuery {
 		limit:      lq.limit,
 		offset:     lq.offset,
 		order:      append([]OrderFunc{}, lq.order...),
+		inters:     append([]Interceptor{}, lq.inters...),
 		predicates: append([]predicate.License{}, lq.predicates...),
 		// clone intermediate query.
-		sql:    lq.sql.Clone(),
-		path:   lq.path,
-		unique: lq.unique,
+		sql:          lq.sql.Clone(),
+		path:         lq.path,
+		unique:       lq.unique,
+		loadStrategy: lq.loadStrategy,
 	}
 }
 
@@ -285,6 +370,16 @@ func (lq *LicenseQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range lq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lq); err != nil {
+				return err
+			}
+		}
+	}
 	if lq.path != nil {
 		prev, err := lq.path(ctx)
 		if err != nil {
@@ -314,7 +409,7 @@ func (lq *LicenseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Lice
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, lq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, lq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -332,7 +427,7 @@ func (lq *LicenseQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(lq.fields) > 0 {
 		_spec.Unique = lq.unique != nil && *lq.unique
 	}
-	return sqlgraph.CountNodes(ctx, lq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, lq.queryDriver(), _spec)
 }
 
 func (lq *LicenseQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -426,6 +521,192 @@ func (lq *LicenseQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (lq *LicenseQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: license.Label}
+	if len(lq.predicates) > 0 {
+		selector := sql.Dialect(lq.driver.Dialect()).Select().From(sql.Table(license.Table))
+		for _, p := range lq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the LicenseQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (lq *LicenseQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.License, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.License(ps[i]))
+	}
+	lq.predicates = append(lq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (lq *LicenseQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := lq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := lq.querySpec()
+	if len(lq.modifiers) > 0 {
+		_spec.Modifiers = lq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = lq.unique != nil && *lq.unique
+	return sqlgraph.EstimateNodes(ctx, lq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (lq *LicenseQuery) CountEstimateX(ctx context.Context) int {
+	count, err := lq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// License rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (lq *LicenseQuery) Sample(percent float64) *LicenseQuery {
+	lq.predicates = append(lq.predicates, sql.Sample(percent))
+	return lq
+}
+
+// LicenseIterator iterates over the results of a License query, and decodes them one at a time.
+type LicenseIterator struct {
+	ctx   context.Context
+	query *LicenseQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*License
+	node  *License
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the License entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.License.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (lq *LicenseQuery) Iterate(ctx context.Context, opts ...IterateOption) (*LicenseIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := lq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &LicenseIterator{ctx: ctx, query: lq, batch: o.batch}
+	var (
+		_spec = lq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*License).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &License{config: lq.config}
+		it.nodes = append(it.nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(lq.modifiers) > 0 {
+		_spec.Modifiers = lq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, lq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (lq *LicenseQuery) iterateLoad(ctx context.Context, nodes []*License) ([]*License, error) {
+	return nodes, nil
+}
+
+// Next advances the iterator to the next License, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *LicenseIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*License, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current License of the iterator.
+func (it *LicenseIterator) Value() *License {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *LicenseIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *LicenseIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// LoadStrategy sets the strategy for eager-loading the License entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (lq *LicenseQuery) LoadStrategy(s LoadStrategy) *LicenseQuery {
+	lq.loadStrategy = s
+	return lq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (lq *LicenseQuery) joinable() bool {
+	if lq.loadStrategy != LoadStrategyJoin || lq.limit != nil || lq.offset != nil {
+		return false
+	}
+	if len(lq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range lq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -458,6 +739,336 @@ func (lq *LicenseQuery) Modify(modifiers ...func(s *sql.Selector)) *LicenseSelec
 	return lq.Select()
 }
 
+// Project returns a builder for querying the given fields into LicenseProjection structs,
+// instead of full License entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.License.Query().
+//		Project(license.FieldID).
+//		All(ctx)
+//
+func (lq *LicenseQuery) Project(fields ...string) *LicenseProject {
+	lq.fields = append(lq.fields, fields...)
+	return &LicenseProject{query: lq}
+}
+
+// LicenseProjection is a lightweight projection of the License entity that is returned by
+// LicenseProject. Fields that were not selected by the query hold their zero values.
+type LicenseProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+}
+
+// LicenseProject is the builder for querying License fields into projections.
+type LicenseProject struct {
+	query *LicenseQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (lp *LicenseProject) All(ctx context.Context) ([]*LicenseProjection, error) {
+	if err := lp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := lp.query.fields
+	if len(columns) == 0 {
+		columns = license.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := lp.query.sqlQuery(ctx).Query()
+	if err := lp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &License{}
+		result []*LicenseProjection
+	)
+	for rows.Next() {
+		*e = License{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &LicenseProjection{
+			ID: e.ID,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lp *LicenseProject) AllX(ctx context.Context) []*LicenseProjection {
+	items, err := lp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (lq *LicenseQuery) onlyShared(ctx context.Context) (*License, error) {
+	if err := lq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	lq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *lq
+	kq.sql = lq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if lq.singleflightScope != nil {
+		scope = lq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := lq.singleflight.DoChan(singleflightKey(scope, license.Label, query, args), func() (interface{}, error) {
+		nodes, err := lq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, lq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{license.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*License)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.License.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (lq *LicenseQuery) SelectMask(paths ...string) *LicenseSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case license.FieldID:
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, license.FieldID)
+	}
+	return lq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the license.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (lq *LicenseQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = license.FieldID
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering License`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		lq.Order(Asc(column))
+	case DirectionDesc:
+		lq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// LicensePage is a page of License entities returned by the Paginate method.
+type LicensePage struct {
+	Nodes []*License `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of License entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.License.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.License.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (lq *LicenseQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*LicensePage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case license.FieldID:
+			order.column = license.FieldID
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating License`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != license.FieldID {
+		order := paginateOrder{name: license.FieldID, column: license.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := lq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &LicensePage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case license.FieldID:
+				values[j] = n.ID
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (lq *LicenseQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case license.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (lq *LicenseQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*License, error) {
+	if lq.limit != nil {
+		return lq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	lq.limit = &limit
+	defer func() { lq.limit = nil }()
+	nodes, err := lq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: license.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // LicenseGroupBy is the group-by builder for License entities.
 type LicenseGroupBy struct {
 	config
@@ -497,7 +1108,7 @@ func (lgb *LicenseGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := lgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := lgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -543,7 +1154,7 @@ func (ls *LicenseSelect) Scan(ctx context.Context, v interface{}) error {
 func (ls *LicenseSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ls.sql.Query()
-	if err := ls.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ls.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -110,7 +110,7 @@ func (lu *LicenseUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{license.Label}
+			err = &NotFoundError{label: license.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -122,9 +122,18 @@ func (lu *LicenseUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // LicenseUpdateOne is the builder for updating a single License entity.
 type LicenseUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LicenseMutation
+	fields     []string
+	conditions []predicate.License
+	hooks      []Hook
+	mutation   *LicenseMutation
+}
+
+// Where appends a list of conditions to the LicenseUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (luo *LicenseUpdateOne) Where(ps ...predicate.License) *LicenseUpdateOne {
+	luo.conditions = append(luo.conditions, ps...)
+	return luo
 }
 
 // Mutation returns the LicenseMutation object of the builder.
@@ -234,12 +243,21 @@ func (luo *LicenseUpdateOne) sqlSave(ctx context.Context) (_node *License, err e
 			}
 		}
 	}
+	if ps := luo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	_node = &License{config: luo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{license.Label}
+			err = &NotFoundError{label: license.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: license.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -247,3 +265,93 @@ func (luo *LicenseUpdateOne) sqlSave(ctx context.Context) (_node *License, err e
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the License entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (luo *LicenseUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "License.id" for update`)}
+	}
+	return enqueueAsync(ctx, luo.config, luo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by License.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (lu *LicenseUpdate) SetChanged(changes []FieldChange) (*LicenseUpdate, error) {
+	if err := setLicenseChanged(lu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return lu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by License.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (luo *LicenseUpdateOne) SetChanged(changes []FieldChange) (*LicenseUpdateOne, error) {
+	if err := setLicenseChanged(luo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return luo, nil
+}
+
+// setLicenseChanged applies the given field changes on the License mutation.
+func setLicenseChanged(m *LicenseMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the License entities that are joined with the rows of the given table, on the given columns
+// of the License table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.License.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (lu *LicenseUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *LicenseUpdate {
+	lu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return lu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the License schema.
+func (lu *LicenseUpdate) ApplyPatch(p *LicensePatch) (*LicenseUpdate, error) {
+	if err := applyLicensePatch(lu.mutation, p); err != nil {
+		return nil, err
+	}
+	return lu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the License schema.
+func (luo *LicenseUpdateOne) ApplyPatch(p *LicensePatch) (*LicenseUpdateOne, error) {
+	if err := applyLicensePatch(luo.mutation, p); err != nil {
+		return nil, err
+	}
+	return luo, nil
+}
+
+// applyLicensePatch applies the given patch on the License mutation.
+func applyLicensePatch(m *LicenseMutation, p *LicensePatch) error {
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
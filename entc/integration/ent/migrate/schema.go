@@ -20,12 +20,13 @@ var (
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "balance", Type: field.TypeFloat64, Default: 0},
 		{Name: "number", Type: field.TypeString},
-		{Name: "name", Type: field.TypeString, Nullable: true},
-		{Name: "user_card", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "Name exactly as written on card."},
+		{Name: "user_card", Type: field.TypeInt, Unique: true, Nullable: true, Comment: "Cards associated with this user. O2O edge"},
 	}
 	// CardsTable holds the schema information for the "cards" table.
 	CardsTable = &schema.Table{
 		Name:       "cards",
+		Comment:    "Card holds the payment cards of the users.",
 		Columns:    CardsColumns,
 		PrimaryKey: []*schema.Column{CardsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
@@ -248,7 +249,7 @@ var (
 		{Name: "expire", Type: field.TypeTime},
 		{Name: "type", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "max_users", Type: field.TypeInt, Nullable: true, Default: 10},
-		{Name: "name", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Comment: "Name field with multiple validators"},
 		{Name: "group_info", Type: field.TypeInt},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
@@ -281,6 +282,7 @@ var (
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 64},
 		{Name: "text", Type: field.TypeString, Unique: true, Nullable: true, Size: 128},
+		{Name: "token", Type: field.TypeString},
 	}
 	// ItemsTable holds the schema information for the "items" table.
 	ItemsTable = &schema.Table{
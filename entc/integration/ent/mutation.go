@@ -15,7 +15,6 @@ import (
 	"sync"
 	"time"
 
-	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/entc/integration/ent/card"
 	"entgo.io/ent/entc/integration/ent/comment"
 	"entgo.io/ent/entc/integration/ent/fieldtype"
@@ -37,6 +36,7 @@ import (
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -75,6 +75,7 @@ type CardMutation struct {
 	update_time   *time.Time
 	balance       *float64
 	addbalance    *float64
+	maxbalance    *float64
 	number        *string
 	name          *string
 	clearedFields map[string]struct{}
@@ -262,6 +263,7 @@ func (m *CardMutation) ResetUpdateTime() {
 func (m *CardMutation) SetBalance(f float64) {
 	m.balance = &f
 	m.addbalance = nil
+	m.maxbalance = nil
 }
 
 // Balance returns the value of the "balance" field in the mutation.
@@ -308,10 +310,27 @@ func (m *CardMutation) AddedBalance() (r float64, exists bool) {
 	return *v, true
 }
 
+// SetBalanceIfGreater sets the "balance" field to f in the database, only if it is greater than its stored value.
+func (m *CardMutation) SetBalanceIfGreater(f float64) {
+	if m.maxbalance == nil || f > *m.maxbalance {
+		m.maxbalance = &f
+	}
+}
+
+// BalanceIfGreater returns the value that was set to the "balance" field by SetBalanceIfGreater in this mutation.
+func (m *CardMutation) BalanceIfGreater() (r float64, exists bool) {
+	v := m.maxbalance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetBalance resets all changes to the "balance" field.
 func (m *CardMutation) ResetBalance() {
 	m.balance = nil
 	m.addbalance = nil
+	m.maxbalance = nil
 }
 
 // SetNumber sets the "number" field.
@@ -353,6 +372,7 @@ func (m *CardMutation) ResetNumber() {
 // SetName sets the "name" field.
 func (m *CardMutation) SetName(s string) {
 	m.name = &s
+	delete(m.clearedFields, card.FieldName)
 }
 
 // Name returns the value of the "name" field in the mutation.
@@ -497,6 +517,16 @@ func (m *CardMutation) Where(ps ...predicate.Card) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the CardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Card, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *CardMutation) Op() Op {
 	return m.op
@@ -802,6 +832,25 @@ func (m *CardMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Card edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a CardPatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *CardMutation) ToPatch() *CardPatch {
+	p := &CardPatch{}
+	if v, ok := m.UpdateTime(); ok {
+		p.UpdateTime = &v
+	}
+	if v, ok := m.Balance(); ok {
+		p.Balance = &v
+	}
+	if v, ok := m.Name(); ok {
+		p.Name = &v
+	}
+	if m.NameCleared() {
+		p.Cleared = append(p.Cleared, card.FieldName)
+	}
+	return p
+}
+
 // CommentMutation represents an operation that mutates the Comment nodes in the graph.
 type CommentMutation struct {
 	config
@@ -810,10 +859,17 @@ type CommentMutation struct {
 	id              *int
 	unique_int      *int
 	addunique_int   *int
+	maxunique_int   *int
+	andunique_int   *int
+	orunique_int    *int
 	unique_float    *float64
 	addunique_float *float64
+	maxunique_float *float64
 	nillable_int    *int
 	addnillable_int *int
+	maxnillable_int *int
+	andnillable_int *int
+	ornillable_int  *int
 	table           *string
 	dir             *schemadir.Dir
 	clearedFields   map[string]struct{}
@@ -924,6 +980,9 @@ func (m *CommentMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *CommentMutation) SetUniqueInt(i int) {
 	m.unique_int = &i
 	m.addunique_int = nil
+	m.maxunique_int = nil
+	m.andunique_int = nil
+	m.orunique_int = nil
 }
 
 // UniqueInt returns the value of the "unique_int" field in the mutation.
@@ -970,16 +1029,72 @@ func (m *CommentMutation) AddedUniqueInt() (r int, exists bool) {
 	return *v, true
 }
 
+// SetUniqueIntIfGreater sets the "unique_int" field to i in the database, only if it is greater than its stored value.
+func (m *CommentMutation) SetUniqueIntIfGreater(i int) {
+	if m.maxunique_int == nil || i > *m.maxunique_int {
+		m.maxunique_int = &i
+	}
+}
+
+// UniqueIntIfGreater returns the value that was set to the "unique_int" field by SetUniqueIntIfGreater in this mutation.
+func (m *CommentMutation) UniqueIntIfGreater() (r int, exists bool) {
+	v := m.maxunique_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndUniqueInt applies a bitwise AND with i on the "unique_int" field.
+func (m *CommentMutation) BitAndUniqueInt(i int) {
+	if m.andunique_int != nil {
+		*m.andunique_int &= i
+	} else {
+		m.andunique_int = &i
+	}
+}
+
+// UniqueIntBitAnd returns the value that was applied by BitAndUniqueInt on the "unique_int" field in this mutation.
+func (m *CommentMutation) UniqueIntBitAnd() (r int, exists bool) {
+	v := m.andunique_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrUniqueInt applies a bitwise OR with i on the "unique_int" field.
+func (m *CommentMutation) BitOrUniqueInt(i int) {
+	if m.orunique_int != nil {
+		*m.orunique_int |= i
+	} else {
+		m.orunique_int = &i
+	}
+}
+
+// UniqueIntBitOr returns the value that was applied by BitOrUniqueInt on the "unique_int" field in this mutation.
+func (m *CommentMutation) UniqueIntBitOr() (r int, exists bool) {
+	v := m.orunique_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetUniqueInt resets all changes to the "unique_int" field.
 func (m *CommentMutation) ResetUniqueInt() {
 	m.unique_int = nil
 	m.addunique_int = nil
+	m.maxunique_int = nil
+	m.andunique_int = nil
+	m.orunique_int = nil
 }
 
 // SetUniqueFloat sets the "unique_float" field.
 func (m *CommentMutation) SetUniqueFloat(f float64) {
 	m.unique_float = &f
 	m.addunique_float = nil
+	m.maxunique_float = nil
 }
 
 // UniqueFloat returns the value of the "unique_float" field in the mutation.
@@ -1026,16 +1141,37 @@ func (m *CommentMutation) AddedUniqueFloat() (r float64, exists bool) {
 	return *v, true
 }
 
+// SetUniqueFloatIfGreater sets the "unique_float" field to f in the database, only if it is greater than its stored value.
+func (m *CommentMutation) SetUniqueFloatIfGreater(f float64) {
+	if m.maxunique_float == nil || f > *m.maxunique_float {
+		m.maxunique_float = &f
+	}
+}
+
+// UniqueFloatIfGreater returns the value that was set to the "unique_float" field by SetUniqueFloatIfGreater in this mutation.
+func (m *CommentMutation) UniqueFloatIfGreater() (r float64, exists bool) {
+	v := m.maxunique_float
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetUniqueFloat resets all changes to the "unique_float" field.
 func (m *CommentMutation) ResetUniqueFloat() {
 	m.unique_float = nil
 	m.addunique_float = nil
+	m.maxunique_float = nil
 }
 
 // SetNillableInt sets the "nillable_int" field.
 func (m *CommentMutation) SetNillableInt(i int) {
 	m.nillable_int = &i
 	m.addnillable_int = nil
+	m.maxnillable_int = nil
+	m.andnillable_int = nil
+	m.ornillable_int = nil
+	delete(m.clearedFields, comment.FieldNillableInt)
 }
 
 // NillableInt returns the value of the "nillable_int" field in the mutation.
@@ -1082,10 +1218,65 @@ func (m *CommentMutation) AddedNillableInt() (r int, exists bool) {
 	return *v, true
 }
 
+// SetNillableIntIfGreater sets the "nillable_int" field to i in the database, only if it is greater than its stored value.
+func (m *CommentMutation) SetNillableIntIfGreater(i int) {
+	if m.maxnillable_int == nil || i > *m.maxnillable_int {
+		m.maxnillable_int = &i
+	}
+}
+
+// NillableIntIfGreater returns the value that was set to the "nillable_int" field by SetNillableIntIfGreater in this mutation.
+func (m *CommentMutation) NillableIntIfGreater() (r int, exists bool) {
+	v := m.maxnillable_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndNillableInt applies a bitwise AND with i on the "nillable_int" field.
+func (m *CommentMutation) BitAndNillableInt(i int) {
+	if m.andnillable_int != nil {
+		*m.andnillable_int &= i
+	} else {
+		m.andnillable_int = &i
+	}
+}
+
+// NillableIntBitAnd returns the value that was applied by BitAndNillableInt on the "nillable_int" field in this mutation.
+func (m *CommentMutation) NillableIntBitAnd() (r int, exists bool) {
+	v := m.andnillable_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrNillableInt applies a bitwise OR with i on the "nillable_int" field.
+func (m *CommentMutation) BitOrNillableInt(i int) {
+	if m.ornillable_int != nil {
+		*m.ornillable_int |= i
+	} else {
+		m.ornillable_int = &i
+	}
+}
+
+// NillableIntBitOr returns the value that was applied by BitOrNillableInt on the "nillable_int" field in this mutation.
+func (m *CommentMutation) NillableIntBitOr() (r int, exists bool) {
+	v := m.ornillable_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (m *CommentMutation) ClearNillableInt() {
 	m.nillable_int = nil
 	m.addnillable_int = nil
+	m.maxnillable_int = nil
+	m.andnillable_int = nil
+	m.ornillable_int = nil
 	m.clearedFields[comment.FieldNillableInt] = struct{}{}
 }
 
@@ -1099,12 +1290,16 @@ func (m *CommentMutation) NillableIntCleared() bool {
 func (m *CommentMutation) ResetNillableInt() {
 	m.nillable_int = nil
 	m.addnillable_int = nil
+	m.maxnillable_int = nil
+	m.andnillable_int = nil
+	m.ornillable_int = nil
 	delete(m.clearedFields, comment.FieldNillableInt)
 }
 
 // SetTable sets the "table" field.
 func (m *CommentMutation) SetTable(s string) {
 	m.table = &s
+	delete(m.clearedFields, comment.FieldTable)
 }
 
 // Table returns the value of the "table" field in the mutation.
@@ -1154,6 +1349,7 @@ func (m *CommentMutation) ResetTable() {
 // SetDir sets the "dir" field.
 func (m *CommentMutation) SetDir(s schemadir.Dir) {
 	m.dir = &s
+	delete(m.clearedFields, comment.FieldDir)
 }
 
 // Dir returns the value of the "dir" field in the mutation.
@@ -1205,6 +1401,16 @@ func (m *CommentMutation) Where(ps ...predicate.Comment) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *CommentMutation) Op() Op {
 	return m.op
@@ -1492,6 +1698,37 @@ func (m *CommentMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Comment edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a CommentPatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *CommentMutation) ToPatch() *CommentPatch {
+	p := &CommentPatch{}
+	if v, ok := m.UniqueInt(); ok {
+		p.UniqueInt = &v
+	}
+	if v, ok := m.UniqueFloat(); ok {
+		p.UniqueFloat = &v
+	}
+	if v, ok := m.NillableInt(); ok {
+		p.NillableInt = &v
+	}
+	if m.NillableIntCleared() {
+		p.Cleared = append(p.Cleared, comment.FieldNillableInt)
+	}
+	if v, ok := m.Table(); ok {
+		p.Table = &v
+	}
+	if m.TableCleared() {
+		p.Cleared = append(p.Cleared, comment.FieldTable)
+	}
+	if v, ok := m.Dir(); ok {
+		p.Dir = &v
+	}
+	if m.DirCleared() {
+		p.Cleared = append(p.Cleared, comment.FieldDir)
+	}
+	return p
+}
+
 // FieldTypeMutation represents an operation that mutates the FieldType nodes in the graph.
 type FieldTypeMutation struct {
 	config
@@ -1500,55 +1737,121 @@ type FieldTypeMutation struct {
 	id                         *int
 	int                        *int
 	addint                     *int
+	maxint                     *int
+	andint                     *int
+	orint                      *int
 	int8                       *int8
 	addint8                    *int8
+	maxint8                    *int8
+	andint8                    *int8
+	orint8                     *int8
 	int16                      *int16
 	addint16                   *int16
+	maxint16                   *int16
+	andint16                   *int16
+	orint16                    *int16
 	int32                      *int32
 	addint32                   *int32
+	maxint32                   *int32
+	andint32                   *int32
+	orint32                    *int32
 	int64                      *int64
 	addint64                   *int64
+	maxint64                   *int64
+	andint64                   *int64
+	orint64                    *int64
 	optional_int               *int
 	addoptional_int            *int
+	maxoptional_int            *int
+	andoptional_int            *int
+	oroptional_int             *int
 	optional_int8              *int8
 	addoptional_int8           *int8
+	maxoptional_int8           *int8
+	andoptional_int8           *int8
+	oroptional_int8            *int8
 	optional_int16             *int16
 	addoptional_int16          *int16
+	maxoptional_int16          *int16
+	andoptional_int16          *int16
+	oroptional_int16           *int16
 	optional_int32             *int32
 	addoptional_int32          *int32
+	maxoptional_int32          *int32
+	andoptional_int32          *int32
+	oroptional_int32           *int32
 	optional_int64             *int64
 	addoptional_int64          *int64
+	maxoptional_int64          *int64
+	andoptional_int64          *int64
+	oroptional_int64           *int64
 	nillable_int               *int
 	addnillable_int            *int
+	maxnillable_int            *int
+	andnillable_int            *int
+	ornillable_int             *int
 	nillable_int8              *int8
 	addnillable_int8           *int8
+	maxnillable_int8           *int8
+	andnillable_int8           *int8
+	ornillable_int8            *int8
 	nillable_int16             *int16
 	addnillable_int16          *int16
+	maxnillable_int16          *int16
+	andnillable_int16          *int16
+	ornillable_int16           *int16
 	nillable_int32             *int32
 	addnillable_int32          *int32
+	maxnillable_int32          *int32
+	andnillable_int32          *int32
+	ornillable_int32           *int32
 	nillable_int64             *int64
 	addnillable_int64          *int64
+	maxnillable_int64          *int64
+	andnillable_int64          *int64
+	ornillable_int64           *int64
 	validate_optional_int32    *int32
 	addvalidate_optional_int32 *int32
+	maxvalidate_optional_int32 *int32
+	andvalidate_optional_int32 *int32
+	orvalidate_optional_int32  *int32
 	optional_uint              *uint
 	addoptional_uint           *int
+	maxoptional_uint           *uint
+	andoptional_uint           *uint
+	oroptional_uint            *uint
 	optional_uint8             *uint8
 	addoptional_uint8          *int8
+	maxoptional_uint8          *uint8
+	andoptional_uint8          *uint8
+	oroptional_uint8           *uint8
 	optional_uint16            *uint16
 	addoptional_uint16         *int16
+	maxoptional_uint16         *uint16
+	andoptional_uint16         *uint16
+	oroptional_uint16          *uint16
 	optional_uint32            *uint32
 	addoptional_uint32         *int32
+	maxoptional_uint32         *uint32
+	andoptional_uint32         *uint32
+	oroptional_uint32          *uint32
 	optional_uint64            *uint64
 	addoptional_uint64         *int64
+	maxoptional_uint64         *uint64
+	andoptional_uint64         *uint64
+	oroptional_uint64          *uint64
 	state                      *fieldtype.State
 	optional_float             *float64
 	addoptional_float          *float64
+	maxoptional_float          *float64
 	optional_float32           *float32
 	addoptional_float32        *float32
+	maxoptional_float32        *float32
 	text                       *string
 	datetime                   *time.Time
 	decimal                    *float64
 	adddecimal                 *float64
+	maxdecimal                 *float64
 	link_other                 **schema.Link
 	link_other_func            **schema.Link
 	mac                        *schema.MAC
@@ -1557,6 +1860,9 @@ type FieldTypeMutation struct {
 	string_scanner             *schema.StringScanner
 	duration                   *time.Duration
 	addduration                *time.Duration
+	maxduration                *time.Duration
+	andduration                *time.Duration
+	orduration                 *time.Duration
 	dir                        *http.Dir
 	ndir                       *http.Dir
 	str                        *sql.NullString
@@ -1573,20 +1879,32 @@ type FieldTypeMutation struct {
 	null_int64                 **sql.NullInt64
 	schema_int                 *schema.Int
 	addschema_int              *schema.Int
+	maxschema_int              *schema.Int
+	andschema_int              *schema.Int
+	orschema_int               *schema.Int
 	schema_int8                *schema.Int8
 	addschema_int8             *schema.Int8
+	maxschema_int8             *schema.Int8
+	andschema_int8             *schema.Int8
+	orschema_int8              *schema.Int8
 	schema_int64               *schema.Int64
 	addschema_int64            *schema.Int64
+	maxschema_int64            *schema.Int64
+	andschema_int64            *schema.Int64
+	orschema_int64             *schema.Int64
 	schema_float               *schema.Float64
 	addschema_float            *schema.Float64
+	maxschema_float            *schema.Float64
 	schema_float32             *schema.Float32
 	addschema_float32          *schema.Float32
+	maxschema_float32          *schema.Float32
 	null_float                 **sql.NullFloat64
 	role                       *role.Role
 	priority                   *role.Priority
 	optional_uuid              *uuid.UUID
 	nillable_uuid              *uuid.UUID
 	strings                    *[]string
+	appendedstrings            []string
 	pair                       *schema.Pair
 	nil_pair                   **schema.Pair
 	vstring                    *schema.VString
@@ -1702,6 +2020,9 @@ func (m *FieldTypeMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *FieldTypeMutation) SetInt(i int) {
 	m.int = &i
 	m.addint = nil
+	m.maxint = nil
+	m.andint = nil
+	m.orint = nil
 }
 
 // Int returns the value of the "int" field in the mutation.
@@ -1748,16 +2069,74 @@ func (m *FieldTypeMutation) AddedInt() (r int, exists bool) {
 	return *v, true
 }
 
+// SetIntIfGreater sets the "int" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetIntIfGreater(i int) {
+	if m.maxint == nil || i > *m.maxint {
+		m.maxint = &i
+	}
+}
+
+// IntIfGreater returns the value that was set to the "int" field by SetIntIfGreater in this mutation.
+func (m *FieldTypeMutation) IntIfGreater() (r int, exists bool) {
+	v := m.maxint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndInt applies a bitwise AND with i on the "int" field.
+func (m *FieldTypeMutation) BitAndInt(i int) {
+	if m.andint != nil {
+		*m.andint &= i
+	} else {
+		m.andint = &i
+	}
+}
+
+// IntBitAnd returns the value that was applied by BitAndInt on the "int" field in this mutation.
+func (m *FieldTypeMutation) IntBitAnd() (r int, exists bool) {
+	v := m.andint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrInt applies a bitwise OR with i on the "int" field.
+func (m *FieldTypeMutation) BitOrInt(i int) {
+	if m.orint != nil {
+		*m.orint |= i
+	} else {
+		m.orint = &i
+	}
+}
+
+// IntBitOr returns the value that was applied by BitOrInt on the "int" field in this mutation.
+func (m *FieldTypeMutation) IntBitOr() (r int, exists bool) {
+	v := m.orint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetInt resets all changes to the "int" field.
 func (m *FieldTypeMutation) ResetInt() {
 	m.int = nil
 	m.addint = nil
+	m.maxint = nil
+	m.andint = nil
+	m.orint = nil
 }
 
 // SetInt8 sets the "int8" field.
 func (m *FieldTypeMutation) SetInt8(i int8) {
 	m.int8 = &i
 	m.addint8 = nil
+	m.maxint8 = nil
+	m.andint8 = nil
+	m.orint8 = nil
 }
 
 // Int8 returns the value of the "int8" field in the mutation.
@@ -1804,16 +2183,74 @@ func (m *FieldTypeMutation) AddedInt8() (r int8, exists bool) {
 	return *v, true
 }
 
+// SetInt8IfGreater sets the "int8" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetInt8IfGreater(i int8) {
+	if m.maxint8 == nil || i > *m.maxint8 {
+		m.maxint8 = &i
+	}
+}
+
+// Int8IfGreater returns the value that was set to the "int8" field by SetInt8IfGreater in this mutation.
+func (m *FieldTypeMutation) Int8IfGreater() (r int8, exists bool) {
+	v := m.maxint8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndInt8 applies a bitwise AND with i on the "int8" field.
+func (m *FieldTypeMutation) BitAndInt8(i int8) {
+	if m.andint8 != nil {
+		*m.andint8 &= i
+	} else {
+		m.andint8 = &i
+	}
+}
+
+// Int8BitAnd returns the value that was applied by BitAndInt8 on the "int8" field in this mutation.
+func (m *FieldTypeMutation) Int8BitAnd() (r int8, exists bool) {
+	v := m.andint8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrInt8 applies a bitwise OR with i on the "int8" field.
+func (m *FieldTypeMutation) BitOrInt8(i int8) {
+	if m.orint8 != nil {
+		*m.orint8 |= i
+	} else {
+		m.orint8 = &i
+	}
+}
+
+// Int8BitOr returns the value that was applied by BitOrInt8 on the "int8" field in this mutation.
+func (m *FieldTypeMutation) Int8BitOr() (r int8, exists bool) {
+	v := m.orint8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetInt8 resets all changes to the "int8" field.
 func (m *FieldTypeMutation) ResetInt8() {
 	m.int8 = nil
 	m.addint8 = nil
+	m.maxint8 = nil
+	m.andint8 = nil
+	m.orint8 = nil
 }
 
 // SetInt16 sets the "int16" field.
 func (m *FieldTypeMutation) SetInt16(i int16) {
 	m.int16 = &i
 	m.addint16 = nil
+	m.maxint16 = nil
+	m.andint16 = nil
+	m.orint16 = nil
 }
 
 // Int16 returns the value of the "int16" field in the mutation.
@@ -1860,16 +2297,74 @@ func (m *FieldTypeMutation) AddedInt16() (r int16, exists bool) {
 	return *v, true
 }
 
+// SetInt16IfGreater sets the "int16" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetInt16IfGreater(i int16) {
+	if m.maxint16 == nil || i > *m.maxint16 {
+		m.maxint16 = &i
+	}
+}
+
+// Int16IfGreater returns the value that was set to the "int16" field by SetInt16IfGreater in this mutation.
+func (m *FieldTypeMutation) Int16IfGreater() (r int16, exists bool) {
+	v := m.maxint16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndInt16 applies a bitwise AND with i on the "int16" field.
+func (m *FieldTypeMutation) BitAndInt16(i int16) {
+	if m.andint16 != nil {
+		*m.andint16 &= i
+	} else {
+		m.andint16 = &i
+	}
+}
+
+// Int16BitAnd returns the value that was applied by BitAndInt16 on the "int16" field in this mutation.
+func (m *FieldTypeMutation) Int16BitAnd() (r int16, exists bool) {
+	v := m.andint16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrInt16 applies a bitwise OR with i on the "int16" field.
+func (m *FieldTypeMutation) BitOrInt16(i int16) {
+	if m.orint16 != nil {
+		*m.orint16 |= i
+	} else {
+		m.orint16 = &i
+	}
+}
+
+// Int16BitOr returns the value that was applied by BitOrInt16 on the "int16" field in this mutation.
+func (m *FieldTypeMutation) Int16BitOr() (r int16, exists bool) {
+	v := m.orint16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetInt16 resets all changes to the "int16" field.
 func (m *FieldTypeMutation) ResetInt16() {
 	m.int16 = nil
 	m.addint16 = nil
+	m.maxint16 = nil
+	m.andint16 = nil
+	m.orint16 = nil
 }
 
 // SetInt32 sets the "int32" field.
 func (m *FieldTypeMutation) SetInt32(i int32) {
 	m.int32 = &i
 	m.addint32 = nil
+	m.maxint32 = nil
+	m.andint32 = nil
+	m.orint32 = nil
 }
 
 // Int32 returns the value of the "int32" field in the mutation.
@@ -1916,16 +2411,74 @@ func (m *FieldTypeMutation) AddedInt32() (r int32, exists bool) {
 	return *v, true
 }
 
+// SetInt32IfGreater sets the "int32" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetInt32IfGreater(i int32) {
+	if m.maxint32 == nil || i > *m.maxint32 {
+		m.maxint32 = &i
+	}
+}
+
+// Int32IfGreater returns the value that was set to the "int32" field by SetInt32IfGreater in this mutation.
+func (m *FieldTypeMutation) Int32IfGreater() (r int32, exists bool) {
+	v := m.maxint32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndInt32 applies a bitwise AND with i on the "int32" field.
+func (m *FieldTypeMutation) BitAndInt32(i int32) {
+	if m.andint32 != nil {
+		*m.andint32 &= i
+	} else {
+		m.andint32 = &i
+	}
+}
+
+// Int32BitAnd returns the value that was applied by BitAndInt32 on the "int32" field in this mutation.
+func (m *FieldTypeMutation) Int32BitAnd() (r int32, exists bool) {
+	v := m.andint32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrInt32 applies a bitwise OR with i on the "int32" field.
+func (m *FieldTypeMutation) BitOrInt32(i int32) {
+	if m.orint32 != nil {
+		*m.orint32 |= i
+	} else {
+		m.orint32 = &i
+	}
+}
+
+// Int32BitOr returns the value that was applied by BitOrInt32 on the "int32" field in this mutation.
+func (m *FieldTypeMutation) Int32BitOr() (r int32, exists bool) {
+	v := m.orint32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetInt32 resets all changes to the "int32" field.
 func (m *FieldTypeMutation) ResetInt32() {
 	m.int32 = nil
 	m.addint32 = nil
+	m.maxint32 = nil
+	m.andint32 = nil
+	m.orint32 = nil
 }
 
 // SetInt64 sets the "int64" field.
 func (m *FieldTypeMutation) SetInt64(i int64) {
 	m.int64 = &i
 	m.addint64 = nil
+	m.maxint64 = nil
+	m.andint64 = nil
+	m.orint64 = nil
 }
 
 // Int64 returns the value of the "int64" field in the mutation.
@@ -1972,16 +2525,75 @@ func (m *FieldTypeMutation) AddedInt64() (r int64, exists bool) {
 	return *v, true
 }
 
+// SetInt64IfGreater sets the "int64" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetInt64IfGreater(i int64) {
+	if m.maxint64 == nil || i > *m.maxint64 {
+		m.maxint64 = &i
+	}
+}
+
+// Int64IfGreater returns the value that was set to the "int64" field by SetInt64IfGreater in this mutation.
+func (m *FieldTypeMutation) Int64IfGreater() (r int64, exists bool) {
+	v := m.maxint64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndInt64 applies a bitwise AND with i on the "int64" field.
+func (m *FieldTypeMutation) BitAndInt64(i int64) {
+	if m.andint64 != nil {
+		*m.andint64 &= i
+	} else {
+		m.andint64 = &i
+	}
+}
+
+// Int64BitAnd returns the value that was applied by BitAndInt64 on the "int64" field in this mutation.
+func (m *FieldTypeMutation) Int64BitAnd() (r int64, exists bool) {
+	v := m.andint64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrInt64 applies a bitwise OR with i on the "int64" field.
+func (m *FieldTypeMutation) BitOrInt64(i int64) {
+	if m.orint64 != nil {
+		*m.orint64 |= i
+	} else {
+		m.orint64 = &i
+	}
+}
+
+// Int64BitOr returns the value that was applied by BitOrInt64 on the "int64" field in this mutation.
+func (m *FieldTypeMutation) Int64BitOr() (r int64, exists bool) {
+	v := m.orint64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetInt64 resets all changes to the "int64" field.
 func (m *FieldTypeMutation) ResetInt64() {
 	m.int64 = nil
 	m.addint64 = nil
+	m.maxint64 = nil
+	m.andint64 = nil
+	m.orint64 = nil
 }
 
 // SetOptionalInt sets the "optional_int" field.
 func (m *FieldTypeMutation) SetOptionalInt(i int) {
 	m.optional_int = &i
 	m.addoptional_int = nil
+	m.maxoptional_int = nil
+	m.andoptional_int = nil
+	m.oroptional_int = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalInt)
 }
 
 // OptionalInt returns the value of the "optional_int" field in the mutation.
@@ -2028,11 +2640,66 @@ func (m *FieldTypeMutation) AddedOptionalInt() (r int, exists bool) {
 	return *v, true
 }
 
-// ClearOptionalInt clears the value of the "optional_int" field.
-func (m *FieldTypeMutation) ClearOptionalInt() {
-	m.optional_int = nil
-	m.addoptional_int = nil
-	m.clearedFields[fieldtype.FieldOptionalInt] = struct{}{}
+// SetOptionalIntIfGreater sets the "optional_int" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalIntIfGreater(i int) {
+	if m.maxoptional_int == nil || i > *m.maxoptional_int {
+		m.maxoptional_int = &i
+	}
+}
+
+// OptionalIntIfGreater returns the value that was set to the "optional_int" field by SetOptionalIntIfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalIntIfGreater() (r int, exists bool) {
+	v := m.maxoptional_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalInt applies a bitwise AND with i on the "optional_int" field.
+func (m *FieldTypeMutation) BitAndOptionalInt(i int) {
+	if m.andoptional_int != nil {
+		*m.andoptional_int &= i
+	} else {
+		m.andoptional_int = &i
+	}
+}
+
+// OptionalIntBitAnd returns the value that was applied by BitAndOptionalInt on the "optional_int" field in this mutation.
+func (m *FieldTypeMutation) OptionalIntBitAnd() (r int, exists bool) {
+	v := m.andoptional_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalInt applies a bitwise OR with i on the "optional_int" field.
+func (m *FieldTypeMutation) BitOrOptionalInt(i int) {
+	if m.oroptional_int != nil {
+		*m.oroptional_int |= i
+	} else {
+		m.oroptional_int = &i
+	}
+}
+
+// OptionalIntBitOr returns the value that was applied by BitOrOptionalInt on the "optional_int" field in this mutation.
+func (m *FieldTypeMutation) OptionalIntBitOr() (r int, exists bool) {
+	v := m.oroptional_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOptionalInt clears the value of the "optional_int" field.
+func (m *FieldTypeMutation) ClearOptionalInt() {
+	m.optional_int = nil
+	m.addoptional_int = nil
+	m.maxoptional_int = nil
+	m.andoptional_int = nil
+	m.oroptional_int = nil
+	m.clearedFields[fieldtype.FieldOptionalInt] = struct{}{}
 }
 
 // OptionalIntCleared returns if the "optional_int" field was cleared in this mutation.
@@ -2045,6 +2712,9 @@ func (m *FieldTypeMutation) OptionalIntCleared() bool {
 func (m *FieldTypeMutation) ResetOptionalInt() {
 	m.optional_int = nil
 	m.addoptional_int = nil
+	m.maxoptional_int = nil
+	m.andoptional_int = nil
+	m.oroptional_int = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalInt)
 }
 
@@ -2052,6 +2722,10 @@ func (m *FieldTypeMutation) ResetOptionalInt() {
 func (m *FieldTypeMutation) SetOptionalInt8(i int8) {
 	m.optional_int8 = &i
 	m.addoptional_int8 = nil
+	m.maxoptional_int8 = nil
+	m.andoptional_int8 = nil
+	m.oroptional_int8 = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalInt8)
 }
 
 // OptionalInt8 returns the value of the "optional_int8" field in the mutation.
@@ -2098,10 +2772,65 @@ func (m *FieldTypeMutation) AddedOptionalInt8() (r int8, exists bool) {
 	return *v, true
 }
 
+// SetOptionalInt8IfGreater sets the "optional_int8" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalInt8IfGreater(i int8) {
+	if m.maxoptional_int8 == nil || i > *m.maxoptional_int8 {
+		m.maxoptional_int8 = &i
+	}
+}
+
+// OptionalInt8IfGreater returns the value that was set to the "optional_int8" field by SetOptionalInt8IfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalInt8IfGreater() (r int8, exists bool) {
+	v := m.maxoptional_int8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalInt8 applies a bitwise AND with i on the "optional_int8" field.
+func (m *FieldTypeMutation) BitAndOptionalInt8(i int8) {
+	if m.andoptional_int8 != nil {
+		*m.andoptional_int8 &= i
+	} else {
+		m.andoptional_int8 = &i
+	}
+}
+
+// OptionalInt8BitAnd returns the value that was applied by BitAndOptionalInt8 on the "optional_int8" field in this mutation.
+func (m *FieldTypeMutation) OptionalInt8BitAnd() (r int8, exists bool) {
+	v := m.andoptional_int8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalInt8 applies a bitwise OR with i on the "optional_int8" field.
+func (m *FieldTypeMutation) BitOrOptionalInt8(i int8) {
+	if m.oroptional_int8 != nil {
+		*m.oroptional_int8 |= i
+	} else {
+		m.oroptional_int8 = &i
+	}
+}
+
+// OptionalInt8BitOr returns the value that was applied by BitOrOptionalInt8 on the "optional_int8" field in this mutation.
+func (m *FieldTypeMutation) OptionalInt8BitOr() (r int8, exists bool) {
+	v := m.oroptional_int8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalInt8 clears the value of the "optional_int8" field.
 func (m *FieldTypeMutation) ClearOptionalInt8() {
 	m.optional_int8 = nil
 	m.addoptional_int8 = nil
+	m.maxoptional_int8 = nil
+	m.andoptional_int8 = nil
+	m.oroptional_int8 = nil
 	m.clearedFields[fieldtype.FieldOptionalInt8] = struct{}{}
 }
 
@@ -2115,6 +2844,9 @@ func (m *FieldTypeMutation) OptionalInt8Cleared() bool {
 func (m *FieldTypeMutation) ResetOptionalInt8() {
 	m.optional_int8 = nil
 	m.addoptional_int8 = nil
+	m.maxoptional_int8 = nil
+	m.andoptional_int8 = nil
+	m.oroptional_int8 = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalInt8)
 }
 
@@ -2122,6 +2854,10 @@ func (m *FieldTypeMutation) ResetOptionalInt8() {
 func (m *FieldTypeMutation) SetOptionalInt16(i int16) {
 	m.optional_int16 = &i
 	m.addoptional_int16 = nil
+	m.maxoptional_int16 = nil
+	m.andoptional_int16 = nil
+	m.oroptional_int16 = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalInt16)
 }
 
 // OptionalInt16 returns the value of the "optional_int16" field in the mutation.
@@ -2168,10 +2904,65 @@ func (m *FieldTypeMutation) AddedOptionalInt16() (r int16, exists bool) {
 	return *v, true
 }
 
+// SetOptionalInt16IfGreater sets the "optional_int16" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalInt16IfGreater(i int16) {
+	if m.maxoptional_int16 == nil || i > *m.maxoptional_int16 {
+		m.maxoptional_int16 = &i
+	}
+}
+
+// OptionalInt16IfGreater returns the value that was set to the "optional_int16" field by SetOptionalInt16IfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalInt16IfGreater() (r int16, exists bool) {
+	v := m.maxoptional_int16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalInt16 applies a bitwise AND with i on the "optional_int16" field.
+func (m *FieldTypeMutation) BitAndOptionalInt16(i int16) {
+	if m.andoptional_int16 != nil {
+		*m.andoptional_int16 &= i
+	} else {
+		m.andoptional_int16 = &i
+	}
+}
+
+// OptionalInt16BitAnd returns the value that was applied by BitAndOptionalInt16 on the "optional_int16" field in this mutation.
+func (m *FieldTypeMutation) OptionalInt16BitAnd() (r int16, exists bool) {
+	v := m.andoptional_int16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalInt16 applies a bitwise OR with i on the "optional_int16" field.
+func (m *FieldTypeMutation) BitOrOptionalInt16(i int16) {
+	if m.oroptional_int16 != nil {
+		*m.oroptional_int16 |= i
+	} else {
+		m.oroptional_int16 = &i
+	}
+}
+
+// OptionalInt16BitOr returns the value that was applied by BitOrOptionalInt16 on the "optional_int16" field in this mutation.
+func (m *FieldTypeMutation) OptionalInt16BitOr() (r int16, exists bool) {
+	v := m.oroptional_int16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalInt16 clears the value of the "optional_int16" field.
 func (m *FieldTypeMutation) ClearOptionalInt16() {
 	m.optional_int16 = nil
 	m.addoptional_int16 = nil
+	m.maxoptional_int16 = nil
+	m.andoptional_int16 = nil
+	m.oroptional_int16 = nil
 	m.clearedFields[fieldtype.FieldOptionalInt16] = struct{}{}
 }
 
@@ -2185,6 +2976,9 @@ func (m *FieldTypeMutation) OptionalInt16Cleared() bool {
 func (m *FieldTypeMutation) ResetOptionalInt16() {
 	m.optional_int16 = nil
 	m.addoptional_int16 = nil
+	m.maxoptional_int16 = nil
+	m.andoptional_int16 = nil
+	m.oroptional_int16 = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalInt16)
 }
 
@@ -2192,6 +2986,10 @@ func (m *FieldTypeMutation) ResetOptionalInt16() {
 func (m *FieldTypeMutation) SetOptionalInt32(i int32) {
 	m.optional_int32 = &i
 	m.addoptional_int32 = nil
+	m.maxoptional_int32 = nil
+	m.andoptional_int32 = nil
+	m.oroptional_int32 = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalInt32)
 }
 
 // OptionalInt32 returns the value of the "optional_int32" field in the mutation.
@@ -2238,10 +3036,65 @@ func (m *FieldTypeMutation) AddedOptionalInt32() (r int32, exists bool) {
 	return *v, true
 }
 
+// SetOptionalInt32IfGreater sets the "optional_int32" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalInt32IfGreater(i int32) {
+	if m.maxoptional_int32 == nil || i > *m.maxoptional_int32 {
+		m.maxoptional_int32 = &i
+	}
+}
+
+// OptionalInt32IfGreater returns the value that was set to the "optional_int32" field by SetOptionalInt32IfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalInt32IfGreater() (r int32, exists bool) {
+	v := m.maxoptional_int32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalInt32 applies a bitwise AND with i on the "optional_int32" field.
+func (m *FieldTypeMutation) BitAndOptionalInt32(i int32) {
+	if m.andoptional_int32 != nil {
+		*m.andoptional_int32 &= i
+	} else {
+		m.andoptional_int32 = &i
+	}
+}
+
+// OptionalInt32BitAnd returns the value that was applied by BitAndOptionalInt32 on the "optional_int32" field in this mutation.
+func (m *FieldTypeMutation) OptionalInt32BitAnd() (r int32, exists bool) {
+	v := m.andoptional_int32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalInt32 applies a bitwise OR with i on the "optional_int32" field.
+func (m *FieldTypeMutation) BitOrOptionalInt32(i int32) {
+	if m.oroptional_int32 != nil {
+		*m.oroptional_int32 |= i
+	} else {
+		m.oroptional_int32 = &i
+	}
+}
+
+// OptionalInt32BitOr returns the value that was applied by BitOrOptionalInt32 on the "optional_int32" field in this mutation.
+func (m *FieldTypeMutation) OptionalInt32BitOr() (r int32, exists bool) {
+	v := m.oroptional_int32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalInt32 clears the value of the "optional_int32" field.
 func (m *FieldTypeMutation) ClearOptionalInt32() {
 	m.optional_int32 = nil
 	m.addoptional_int32 = nil
+	m.maxoptional_int32 = nil
+	m.andoptional_int32 = nil
+	m.oroptional_int32 = nil
 	m.clearedFields[fieldtype.FieldOptionalInt32] = struct{}{}
 }
 
@@ -2255,6 +3108,9 @@ func (m *FieldTypeMutation) OptionalInt32Cleared() bool {
 func (m *FieldTypeMutation) ResetOptionalInt32() {
 	m.optional_int32 = nil
 	m.addoptional_int32 = nil
+	m.maxoptional_int32 = nil
+	m.andoptional_int32 = nil
+	m.oroptional_int32 = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalInt32)
 }
 
@@ -2262,6 +3118,10 @@ func (m *FieldTypeMutation) ResetOptionalInt32() {
 func (m *FieldTypeMutation) SetOptionalInt64(i int64) {
 	m.optional_int64 = &i
 	m.addoptional_int64 = nil
+	m.maxoptional_int64 = nil
+	m.andoptional_int64 = nil
+	m.oroptional_int64 = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalInt64)
 }
 
 // OptionalInt64 returns the value of the "optional_int64" field in the mutation.
@@ -2308,10 +3168,65 @@ func (m *FieldTypeMutation) AddedOptionalInt64() (r int64, exists bool) {
 	return *v, true
 }
 
+// SetOptionalInt64IfGreater sets the "optional_int64" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalInt64IfGreater(i int64) {
+	if m.maxoptional_int64 == nil || i > *m.maxoptional_int64 {
+		m.maxoptional_int64 = &i
+	}
+}
+
+// OptionalInt64IfGreater returns the value that was set to the "optional_int64" field by SetOptionalInt64IfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalInt64IfGreater() (r int64, exists bool) {
+	v := m.maxoptional_int64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalInt64 applies a bitwise AND with i on the "optional_int64" field.
+func (m *FieldTypeMutation) BitAndOptionalInt64(i int64) {
+	if m.andoptional_int64 != nil {
+		*m.andoptional_int64 &= i
+	} else {
+		m.andoptional_int64 = &i
+	}
+}
+
+// OptionalInt64BitAnd returns the value that was applied by BitAndOptionalInt64 on the "optional_int64" field in this mutation.
+func (m *FieldTypeMutation) OptionalInt64BitAnd() (r int64, exists bool) {
+	v := m.andoptional_int64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalInt64 applies a bitwise OR with i on the "optional_int64" field.
+func (m *FieldTypeMutation) BitOrOptionalInt64(i int64) {
+	if m.oroptional_int64 != nil {
+		*m.oroptional_int64 |= i
+	} else {
+		m.oroptional_int64 = &i
+	}
+}
+
+// OptionalInt64BitOr returns the value that was applied by BitOrOptionalInt64 on the "optional_int64" field in this mutation.
+func (m *FieldTypeMutation) OptionalInt64BitOr() (r int64, exists bool) {
+	v := m.oroptional_int64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalInt64 clears the value of the "optional_int64" field.
 func (m *FieldTypeMutation) ClearOptionalInt64() {
 	m.optional_int64 = nil
 	m.addoptional_int64 = nil
+	m.maxoptional_int64 = nil
+	m.andoptional_int64 = nil
+	m.oroptional_int64 = nil
 	m.clearedFields[fieldtype.FieldOptionalInt64] = struct{}{}
 }
 
@@ -2325,6 +3240,9 @@ func (m *FieldTypeMutation) OptionalInt64Cleared() bool {
 func (m *FieldTypeMutation) ResetOptionalInt64() {
 	m.optional_int64 = nil
 	m.addoptional_int64 = nil
+	m.maxoptional_int64 = nil
+	m.andoptional_int64 = nil
+	m.oroptional_int64 = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalInt64)
 }
 
@@ -2332,6 +3250,10 @@ func (m *FieldTypeMutation) ResetOptionalInt64() {
 func (m *FieldTypeMutation) SetNillableInt(i int) {
 	m.nillable_int = &i
 	m.addnillable_int = nil
+	m.maxnillable_int = nil
+	m.andnillable_int = nil
+	m.ornillable_int = nil
+	delete(m.clearedFields, fieldtype.FieldNillableInt)
 }
 
 // NillableInt returns the value of the "nillable_int" field in the mutation.
@@ -2378,10 +3300,65 @@ func (m *FieldTypeMutation) AddedNillableInt() (r int, exists bool) {
 	return *v, true
 }
 
+// SetNillableIntIfGreater sets the "nillable_int" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetNillableIntIfGreater(i int) {
+	if m.maxnillable_int == nil || i > *m.maxnillable_int {
+		m.maxnillable_int = &i
+	}
+}
+
+// NillableIntIfGreater returns the value that was set to the "nillable_int" field by SetNillableIntIfGreater in this mutation.
+func (m *FieldTypeMutation) NillableIntIfGreater() (r int, exists bool) {
+	v := m.maxnillable_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndNillableInt applies a bitwise AND with i on the "nillable_int" field.
+func (m *FieldTypeMutation) BitAndNillableInt(i int) {
+	if m.andnillable_int != nil {
+		*m.andnillable_int &= i
+	} else {
+		m.andnillable_int = &i
+	}
+}
+
+// NillableIntBitAnd returns the value that was applied by BitAndNillableInt on the "nillable_int" field in this mutation.
+func (m *FieldTypeMutation) NillableIntBitAnd() (r int, exists bool) {
+	v := m.andnillable_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrNillableInt applies a bitwise OR with i on the "nillable_int" field.
+func (m *FieldTypeMutation) BitOrNillableInt(i int) {
+	if m.ornillable_int != nil {
+		*m.ornillable_int |= i
+	} else {
+		m.ornillable_int = &i
+	}
+}
+
+// NillableIntBitOr returns the value that was applied by BitOrNillableInt on the "nillable_int" field in this mutation.
+func (m *FieldTypeMutation) NillableIntBitOr() (r int, exists bool) {
+	v := m.ornillable_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (m *FieldTypeMutation) ClearNillableInt() {
 	m.nillable_int = nil
 	m.addnillable_int = nil
+	m.maxnillable_int = nil
+	m.andnillable_int = nil
+	m.ornillable_int = nil
 	m.clearedFields[fieldtype.FieldNillableInt] = struct{}{}
 }
 
@@ -2395,6 +3372,9 @@ func (m *FieldTypeMutation) NillableIntCleared() bool {
 func (m *FieldTypeMutation) ResetNillableInt() {
 	m.nillable_int = nil
 	m.addnillable_int = nil
+	m.maxnillable_int = nil
+	m.andnillable_int = nil
+	m.ornillable_int = nil
 	delete(m.clearedFields, fieldtype.FieldNillableInt)
 }
 
@@ -2402,6 +3382,10 @@ func (m *FieldTypeMutation) ResetNillableInt() {
 func (m *FieldTypeMutation) SetNillableInt8(i int8) {
 	m.nillable_int8 = &i
 	m.addnillable_int8 = nil
+	m.maxnillable_int8 = nil
+	m.andnillable_int8 = nil
+	m.ornillable_int8 = nil
+	delete(m.clearedFields, fieldtype.FieldNillableInt8)
 }
 
 // NillableInt8 returns the value of the "nillable_int8" field in the mutation.
@@ -2448,10 +3432,65 @@ func (m *FieldTypeMutation) AddedNillableInt8() (r int8, exists bool) {
 	return *v, true
 }
 
+// SetNillableInt8IfGreater sets the "nillable_int8" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetNillableInt8IfGreater(i int8) {
+	if m.maxnillable_int8 == nil || i > *m.maxnillable_int8 {
+		m.maxnillable_int8 = &i
+	}
+}
+
+// NillableInt8IfGreater returns the value that was set to the "nillable_int8" field by SetNillableInt8IfGreater in this mutation.
+func (m *FieldTypeMutation) NillableInt8IfGreater() (r int8, exists bool) {
+	v := m.maxnillable_int8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndNillableInt8 applies a bitwise AND with i on the "nillable_int8" field.
+func (m *FieldTypeMutation) BitAndNillableInt8(i int8) {
+	if m.andnillable_int8 != nil {
+		*m.andnillable_int8 &= i
+	} else {
+		m.andnillable_int8 = &i
+	}
+}
+
+// NillableInt8BitAnd returns the value that was applied by BitAndNillableInt8 on the "nillable_int8" field in this mutation.
+func (m *FieldTypeMutation) NillableInt8BitAnd() (r int8, exists bool) {
+	v := m.andnillable_int8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrNillableInt8 applies a bitwise OR with i on the "nillable_int8" field.
+func (m *FieldTypeMutation) BitOrNillableInt8(i int8) {
+	if m.ornillable_int8 != nil {
+		*m.ornillable_int8 |= i
+	} else {
+		m.ornillable_int8 = &i
+	}
+}
+
+// NillableInt8BitOr returns the value that was applied by BitOrNillableInt8 on the "nillable_int8" field in this mutation.
+func (m *FieldTypeMutation) NillableInt8BitOr() (r int8, exists bool) {
+	v := m.ornillable_int8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearNillableInt8 clears the value of the "nillable_int8" field.
 func (m *FieldTypeMutation) ClearNillableInt8() {
 	m.nillable_int8 = nil
 	m.addnillable_int8 = nil
+	m.maxnillable_int8 = nil
+	m.andnillable_int8 = nil
+	m.ornillable_int8 = nil
 	m.clearedFields[fieldtype.FieldNillableInt8] = struct{}{}
 }
 
@@ -2465,6 +3504,9 @@ func (m *FieldTypeMutation) NillableInt8Cleared() bool {
 func (m *FieldTypeMutation) ResetNillableInt8() {
 	m.nillable_int8 = nil
 	m.addnillable_int8 = nil
+	m.maxnillable_int8 = nil
+	m.andnillable_int8 = nil
+	m.ornillable_int8 = nil
 	delete(m.clearedFields, fieldtype.FieldNillableInt8)
 }
 
@@ -2472,6 +3514,10 @@ func (m *FieldTypeMutation) ResetNillableInt8() {
 func (m *FieldTypeMutation) SetNillableInt16(i int16) {
 	m.nillable_int16 = &i
 	m.addnillable_int16 = nil
+	m.maxnillable_int16 = nil
+	m.andnillable_int16 = nil
+	m.ornillable_int16 = nil
+	delete(m.clearedFields, fieldtype.FieldNillableInt16)
 }
 
 // NillableInt16 returns the value of the "nillable_int16" field in the mutation.
@@ -2518,10 +3564,65 @@ func (m *FieldTypeMutation) AddedNillableInt16() (r int16, exists bool) {
 	return *v, true
 }
 
+// SetNillableInt16IfGreater sets the "nillable_int16" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetNillableInt16IfGreater(i int16) {
+	if m.maxnillable_int16 == nil || i > *m.maxnillable_int16 {
+		m.maxnillable_int16 = &i
+	}
+}
+
+// NillableInt16IfGreater returns the value that was set to the "nillable_int16" field by SetNillableInt16IfGreater in this mutation.
+func (m *FieldTypeMutation) NillableInt16IfGreater() (r int16, exists bool) {
+	v := m.maxnillable_int16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndNillableInt16 applies a bitwise AND with i on the "nillable_int16" field.
+func (m *FieldTypeMutation) BitAndNillableInt16(i int16) {
+	if m.andnillable_int16 != nil {
+		*m.andnillable_int16 &= i
+	} else {
+		m.andnillable_int16 = &i
+	}
+}
+
+// NillableInt16BitAnd returns the value that was applied by BitAndNillableInt16 on the "nillable_int16" field in this mutation.
+func (m *FieldTypeMutation) NillableInt16BitAnd() (r int16, exists bool) {
+	v := m.andnillable_int16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrNillableInt16 applies a bitwise OR with i on the "nillable_int16" field.
+func (m *FieldTypeMutation) BitOrNillableInt16(i int16) {
+	if m.ornillable_int16 != nil {
+		*m.ornillable_int16 |= i
+	} else {
+		m.ornillable_int16 = &i
+	}
+}
+
+// NillableInt16BitOr returns the value that was applied by BitOrNillableInt16 on the "nillable_int16" field in this mutation.
+func (m *FieldTypeMutation) NillableInt16BitOr() (r int16, exists bool) {
+	v := m.ornillable_int16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearNillableInt16 clears the value of the "nillable_int16" field.
 func (m *FieldTypeMutation) ClearNillableInt16() {
 	m.nillable_int16 = nil
 	m.addnillable_int16 = nil
+	m.maxnillable_int16 = nil
+	m.andnillable_int16 = nil
+	m.ornillable_int16 = nil
 	m.clearedFields[fieldtype.FieldNillableInt16] = struct{}{}
 }
 
@@ -2535,6 +3636,9 @@ func (m *FieldTypeMutation) NillableInt16Cleared() bool {
 func (m *FieldTypeMutation) ResetNillableInt16() {
 	m.nillable_int16 = nil
 	m.addnillable_int16 = nil
+	m.maxnillable_int16 = nil
+	m.andnillable_int16 = nil
+	m.ornillable_int16 = nil
 	delete(m.clearedFields, fieldtype.FieldNillableInt16)
 }
 
@@ -2542,6 +3646,10 @@ func (m *FieldTypeMutation) ResetNillableInt16() {
 func (m *FieldTypeMutation) SetNillableInt32(i int32) {
 	m.nillable_int32 = &i
 	m.addnillable_int32 = nil
+	m.maxnillable_int32 = nil
+	m.andnillable_int32 = nil
+	m.ornillable_int32 = nil
+	delete(m.clearedFields, fieldtype.FieldNillableInt32)
 }
 
 // NillableInt32 returns the value of the "nillable_int32" field in the mutation.
@@ -2588,10 +3696,65 @@ func (m *FieldTypeMutation) AddedNillableInt32() (r int32, exists bool) {
 	return *v, true
 }
 
+// SetNillableInt32IfGreater sets the "nillable_int32" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetNillableInt32IfGreater(i int32) {
+	if m.maxnillable_int32 == nil || i > *m.maxnillable_int32 {
+		m.maxnillable_int32 = &i
+	}
+}
+
+// NillableInt32IfGreater returns the value that was set to the "nillable_int32" field by SetNillableInt32IfGreater in this mutation.
+func (m *FieldTypeMutation) NillableInt32IfGreater() (r int32, exists bool) {
+	v := m.maxnillable_int32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndNillableInt32 applies a bitwise AND with i on the "nillable_int32" field.
+func (m *FieldTypeMutation) BitAndNillableInt32(i int32) {
+	if m.andnillable_int32 != nil {
+		*m.andnillable_int32 &= i
+	} else {
+		m.andnillable_int32 = &i
+	}
+}
+
+// NillableInt32BitAnd returns the value that was applied by BitAndNillableInt32 on the "nillable_int32" field in this mutation.
+func (m *FieldTypeMutation) NillableInt32BitAnd() (r int32, exists bool) {
+	v := m.andnillable_int32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrNillableInt32 applies a bitwise OR with i on the "nillable_int32" field.
+func (m *FieldTypeMutation) BitOrNillableInt32(i int32) {
+	if m.ornillable_int32 != nil {
+		*m.ornillable_int32 |= i
+	} else {
+		m.ornillable_int32 = &i
+	}
+}
+
+// NillableInt32BitOr returns the value that was applied by BitOrNillableInt32 on the "nillable_int32" field in this mutation.
+func (m *FieldTypeMutation) NillableInt32BitOr() (r int32, exists bool) {
+	v := m.ornillable_int32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearNillableInt32 clears the value of the "nillable_int32" field.
 func (m *FieldTypeMutation) ClearNillableInt32() {
 	m.nillable_int32 = nil
 	m.addnillable_int32 = nil
+	m.maxnillable_int32 = nil
+	m.andnillable_int32 = nil
+	m.ornillable_int32 = nil
 	m.clearedFields[fieldtype.FieldNillableInt32] = struct{}{}
 }
 
@@ -2605,6 +3768,9 @@ func (m *FieldTypeMutation) NillableInt32Cleared() bool {
 func (m *FieldTypeMutation) ResetNillableInt32() {
 	m.nillable_int32 = nil
 	m.addnillable_int32 = nil
+	m.maxnillable_int32 = nil
+	m.andnillable_int32 = nil
+	m.ornillable_int32 = nil
 	delete(m.clearedFields, fieldtype.FieldNillableInt32)
 }
 
@@ -2612,6 +3778,10 @@ func (m *FieldTypeMutation) ResetNillableInt32() {
 func (m *FieldTypeMutation) SetNillableInt64(i int64) {
 	m.nillable_int64 = &i
 	m.addnillable_int64 = nil
+	m.maxnillable_int64 = nil
+	m.andnillable_int64 = nil
+	m.ornillable_int64 = nil
+	delete(m.clearedFields, fieldtype.FieldNillableInt64)
 }
 
 // NillableInt64 returns the value of the "nillable_int64" field in the mutation.
@@ -2658,10 +3828,65 @@ func (m *FieldTypeMutation) AddedNillableInt64() (r int64, exists bool) {
 	return *v, true
 }
 
+// SetNillableInt64IfGreater sets the "nillable_int64" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetNillableInt64IfGreater(i int64) {
+	if m.maxnillable_int64 == nil || i > *m.maxnillable_int64 {
+		m.maxnillable_int64 = &i
+	}
+}
+
+// NillableInt64IfGreater returns the value that was set to the "nillable_int64" field by SetNillableInt64IfGreater in this mutation.
+func (m *FieldTypeMutation) NillableInt64IfGreater() (r int64, exists bool) {
+	v := m.maxnillable_int64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndNillableInt64 applies a bitwise AND with i on the "nillable_int64" field.
+func (m *FieldTypeMutation) BitAndNillableInt64(i int64) {
+	if m.andnillable_int64 != nil {
+		*m.andnillable_int64 &= i
+	} else {
+		m.andnillable_int64 = &i
+	}
+}
+
+// NillableInt64BitAnd returns the value that was applied by BitAndNillableInt64 on the "nillable_int64" field in this mutation.
+func (m *FieldTypeMutation) NillableInt64BitAnd() (r int64, exists bool) {
+	v := m.andnillable_int64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrNillableInt64 applies a bitwise OR with i on the "nillable_int64" field.
+func (m *FieldTypeMutation) BitOrNillableInt64(i int64) {
+	if m.ornillable_int64 != nil {
+		*m.ornillable_int64 |= i
+	} else {
+		m.ornillable_int64 = &i
+	}
+}
+
+// NillableInt64BitOr returns the value that was applied by BitOrNillableInt64 on the "nillable_int64" field in this mutation.
+func (m *FieldTypeMutation) NillableInt64BitOr() (r int64, exists bool) {
+	v := m.ornillable_int64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearNillableInt64 clears the value of the "nillable_int64" field.
 func (m *FieldTypeMutation) ClearNillableInt64() {
 	m.nillable_int64 = nil
 	m.addnillable_int64 = nil
+	m.maxnillable_int64 = nil
+	m.andnillable_int64 = nil
+	m.ornillable_int64 = nil
 	m.clearedFields[fieldtype.FieldNillableInt64] = struct{}{}
 }
 
@@ -2675,6 +3900,9 @@ func (m *FieldTypeMutation) NillableInt64Cleared() bool {
 func (m *FieldTypeMutation) ResetNillableInt64() {
 	m.nillable_int64 = nil
 	m.addnillable_int64 = nil
+	m.maxnillable_int64 = nil
+	m.andnillable_int64 = nil
+	m.ornillable_int64 = nil
 	delete(m.clearedFields, fieldtype.FieldNillableInt64)
 }
 
@@ -2682,6 +3910,10 @@ func (m *FieldTypeMutation) ResetNillableInt64() {
 func (m *FieldTypeMutation) SetValidateOptionalInt32(i int32) {
 	m.validate_optional_int32 = &i
 	m.addvalidate_optional_int32 = nil
+	m.maxvalidate_optional_int32 = nil
+	m.andvalidate_optional_int32 = nil
+	m.orvalidate_optional_int32 = nil
+	delete(m.clearedFields, fieldtype.FieldValidateOptionalInt32)
 }
 
 // ValidateOptionalInt32 returns the value of the "validate_optional_int32" field in the mutation.
@@ -2728,10 +3960,65 @@ func (m *FieldTypeMutation) AddedValidateOptionalInt32() (r int32, exists bool)
 	return *v, true
 }
 
+// SetValidateOptionalInt32IfGreater sets the "validate_optional_int32" field to i in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetValidateOptionalInt32IfGreater(i int32) {
+	if m.maxvalidate_optional_int32 == nil || i > *m.maxvalidate_optional_int32 {
+		m.maxvalidate_optional_int32 = &i
+	}
+}
+
+// ValidateOptionalInt32IfGreater returns the value that was set to the "validate_optional_int32" field by SetValidateOptionalInt32IfGreater in this mutation.
+func (m *FieldTypeMutation) ValidateOptionalInt32IfGreater() (r int32, exists bool) {
+	v := m.maxvalidate_optional_int32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndValidateOptionalInt32 applies a bitwise AND with i on the "validate_optional_int32" field.
+func (m *FieldTypeMutation) BitAndValidateOptionalInt32(i int32) {
+	if m.andvalidate_optional_int32 != nil {
+		*m.andvalidate_optional_int32 &= i
+	} else {
+		m.andvalidate_optional_int32 = &i
+	}
+}
+
+// ValidateOptionalInt32BitAnd returns the value that was applied by BitAndValidateOptionalInt32 on the "validate_optional_int32" field in this mutation.
+func (m *FieldTypeMutation) ValidateOptionalInt32BitAnd() (r int32, exists bool) {
+	v := m.andvalidate_optional_int32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrValidateOptionalInt32 applies a bitwise OR with i on the "validate_optional_int32" field.
+func (m *FieldTypeMutation) BitOrValidateOptionalInt32(i int32) {
+	if m.orvalidate_optional_int32 != nil {
+		*m.orvalidate_optional_int32 |= i
+	} else {
+		m.orvalidate_optional_int32 = &i
+	}
+}
+
+// ValidateOptionalInt32BitOr returns the value that was applied by BitOrValidateOptionalInt32 on the "validate_optional_int32" field in this mutation.
+func (m *FieldTypeMutation) ValidateOptionalInt32BitOr() (r int32, exists bool) {
+	v := m.orvalidate_optional_int32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearValidateOptionalInt32 clears the value of the "validate_optional_int32" field.
 func (m *FieldTypeMutation) ClearValidateOptionalInt32() {
 	m.validate_optional_int32 = nil
 	m.addvalidate_optional_int32 = nil
+	m.maxvalidate_optional_int32 = nil
+	m.andvalidate_optional_int32 = nil
+	m.orvalidate_optional_int32 = nil
 	m.clearedFields[fieldtype.FieldValidateOptionalInt32] = struct{}{}
 }
 
@@ -2745,6 +4032,9 @@ func (m *FieldTypeMutation) ValidateOptionalInt32Cleared() bool {
 func (m *FieldTypeMutation) ResetValidateOptionalInt32() {
 	m.validate_optional_int32 = nil
 	m.addvalidate_optional_int32 = nil
+	m.maxvalidate_optional_int32 = nil
+	m.andvalidate_optional_int32 = nil
+	m.orvalidate_optional_int32 = nil
 	delete(m.clearedFields, fieldtype.FieldValidateOptionalInt32)
 }
 
@@ -2752,6 +4042,10 @@ func (m *FieldTypeMutation) ResetValidateOptionalInt32() {
 func (m *FieldTypeMutation) SetOptionalUint(u uint) {
 	m.optional_uint = &u
 	m.addoptional_uint = nil
+	m.maxoptional_uint = nil
+	m.andoptional_uint = nil
+	m.oroptional_uint = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalUint)
 }
 
 // OptionalUint returns the value of the "optional_uint" field in the mutation.
@@ -2798,10 +4092,65 @@ func (m *FieldTypeMutation) AddedOptionalUint() (r int, exists bool) {
 	return *v, true
 }
 
+// SetOptionalUintIfGreater sets the "optional_uint" field to u in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalUintIfGreater(u uint) {
+	if m.maxoptional_uint == nil || u > *m.maxoptional_uint {
+		m.maxoptional_uint = &u
+	}
+}
+
+// OptionalUintIfGreater returns the value that was set to the "optional_uint" field by SetOptionalUintIfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalUintIfGreater() (r uint, exists bool) {
+	v := m.maxoptional_uint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalUint applies a bitwise AND with u on the "optional_uint" field.
+func (m *FieldTypeMutation) BitAndOptionalUint(u uint) {
+	if m.andoptional_uint != nil {
+		*m.andoptional_uint &= u
+	} else {
+		m.andoptional_uint = &u
+	}
+}
+
+// OptionalUintBitAnd returns the value that was applied by BitAndOptionalUint on the "optional_uint" field in this mutation.
+func (m *FieldTypeMutation) OptionalUintBitAnd() (r uint, exists bool) {
+	v := m.andoptional_uint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalUint applies a bitwise OR with u on the "optional_uint" field.
+func (m *FieldTypeMutation) BitOrOptionalUint(u uint) {
+	if m.oroptional_uint != nil {
+		*m.oroptional_uint |= u
+	} else {
+		m.oroptional_uint = &u
+	}
+}
+
+// OptionalUintBitOr returns the value that was applied by BitOrOptionalUint on the "optional_uint" field in this mutation.
+func (m *FieldTypeMutation) OptionalUintBitOr() (r uint, exists bool) {
+	v := m.oroptional_uint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalUint clears the value of the "optional_uint" field.
 func (m *FieldTypeMutation) ClearOptionalUint() {
 	m.optional_uint = nil
 	m.addoptional_uint = nil
+	m.maxoptional_uint = nil
+	m.andoptional_uint = nil
+	m.oroptional_uint = nil
 	m.clearedFields[fieldtype.FieldOptionalUint] = struct{}{}
 }
 
@@ -2815,6 +4164,9 @@ func (m *FieldTypeMutation) OptionalUintCleared() bool {
 func (m *FieldTypeMutation) ResetOptionalUint() {
 	m.optional_uint = nil
 	m.addoptional_uint = nil
+	m.maxoptional_uint = nil
+	m.andoptional_uint = nil
+	m.oroptional_uint = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalUint)
 }
 
@@ -2822,6 +4174,10 @@ func (m *FieldTypeMutation) ResetOptionalUint() {
 func (m *FieldTypeMutation) SetOptionalUint8(u uint8) {
 	m.optional_uint8 = &u
 	m.addoptional_uint8 = nil
+	m.maxoptional_uint8 = nil
+	m.andoptional_uint8 = nil
+	m.oroptional_uint8 = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalUint8)
 }
 
 // OptionalUint8 returns the value of the "optional_uint8" field in the mutation.
@@ -2868,10 +4224,65 @@ func (m *FieldTypeMutation) AddedOptionalUint8() (r int8, exists bool) {
 	return *v, true
 }
 
+// SetOptionalUint8IfGreater sets the "optional_uint8" field to u in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalUint8IfGreater(u uint8) {
+	if m.maxoptional_uint8 == nil || u > *m.maxoptional_uint8 {
+		m.maxoptional_uint8 = &u
+	}
+}
+
+// OptionalUint8IfGreater returns the value that was set to the "optional_uint8" field by SetOptionalUint8IfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalUint8IfGreater() (r uint8, exists bool) {
+	v := m.maxoptional_uint8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalUint8 applies a bitwise AND with u on the "optional_uint8" field.
+func (m *FieldTypeMutation) BitAndOptionalUint8(u uint8) {
+	if m.andoptional_uint8 != nil {
+		*m.andoptional_uint8 &= u
+	} else {
+		m.andoptional_uint8 = &u
+	}
+}
+
+// OptionalUint8BitAnd returns the value that was applied by BitAndOptionalUint8 on the "optional_uint8" field in this mutation.
+func (m *FieldTypeMutation) OptionalUint8BitAnd() (r uint8, exists bool) {
+	v := m.andoptional_uint8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalUint8 applies a bitwise OR with u on the "optional_uint8" field.
+func (m *FieldTypeMutation) BitOrOptionalUint8(u uint8) {
+	if m.oroptional_uint8 != nil {
+		*m.oroptional_uint8 |= u
+	} else {
+		m.oroptional_uint8 = &u
+	}
+}
+
+// OptionalUint8BitOr returns the value that was applied by BitOrOptionalUint8 on the "optional_uint8" field in this mutation.
+func (m *FieldTypeMutation) OptionalUint8BitOr() (r uint8, exists bool) {
+	v := m.oroptional_uint8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalUint8 clears the value of the "optional_uint8" field.
 func (m *FieldTypeMutation) ClearOptionalUint8() {
 	m.optional_uint8 = nil
 	m.addoptional_uint8 = nil
+	m.maxoptional_uint8 = nil
+	m.andoptional_uint8 = nil
+	m.oroptional_uint8 = nil
 	m.clearedFields[fieldtype.FieldOptionalUint8] = struct{}{}
 }
 
@@ -2885,6 +4296,9 @@ func (m *FieldTypeMutation) OptionalUint8Cleared() bool {
 func (m *FieldTypeMutation) ResetOptionalUint8() {
 	m.optional_uint8 = nil
 	m.addoptional_uint8 = nil
+	m.maxoptional_uint8 = nil
+	m.andoptional_uint8 = nil
+	m.oroptional_uint8 = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalUint8)
 }
 
@@ -2892,6 +4306,10 @@ func (m *FieldTypeMutation) ResetOptionalUint8() {
 func (m *FieldTypeMutation) SetOptionalUint16(u uint16) {
 	m.optional_uint16 = &u
 	m.addoptional_uint16 = nil
+	m.maxoptional_uint16 = nil
+	m.andoptional_uint16 = nil
+	m.oroptional_uint16 = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalUint16)
 }
 
 // OptionalUint16 returns the value of the "optional_uint16" field in the mutation.
@@ -2938,10 +4356,65 @@ func (m *FieldTypeMutation) AddedOptionalUint16() (r int16, exists bool) {
 	return *v, true
 }
 
+// SetOptionalUint16IfGreater sets the "optional_uint16" field to u in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalUint16IfGreater(u uint16) {
+	if m.maxoptional_uint16 == nil || u > *m.maxoptional_uint16 {
+		m.maxoptional_uint16 = &u
+	}
+}
+
+// OptionalUint16IfGreater returns the value that was set to the "optional_uint16" field by SetOptionalUint16IfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalUint16IfGreater() (r uint16, exists bool) {
+	v := m.maxoptional_uint16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalUint16 applies a bitwise AND with u on the "optional_uint16" field.
+func (m *FieldTypeMutation) BitAndOptionalUint16(u uint16) {
+	if m.andoptional_uint16 != nil {
+		*m.andoptional_uint16 &= u
+	} else {
+		m.andoptional_uint16 = &u
+	}
+}
+
+// OptionalUint16BitAnd returns the value that was applied by BitAndOptionalUint16 on the "optional_uint16" field in this mutation.
+func (m *FieldTypeMutation) OptionalUint16BitAnd() (r uint16, exists bool) {
+	v := m.andoptional_uint16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalUint16 applies a bitwise OR with u on the "optional_uint16" field.
+func (m *FieldTypeMutation) BitOrOptionalUint16(u uint16) {
+	if m.oroptional_uint16 != nil {
+		*m.oroptional_uint16 |= u
+	} else {
+		m.oroptional_uint16 = &u
+	}
+}
+
+// OptionalUint16BitOr returns the value that was applied by BitOrOptionalUint16 on the "optional_uint16" field in this mutation.
+func (m *FieldTypeMutation) OptionalUint16BitOr() (r uint16, exists bool) {
+	v := m.oroptional_uint16
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalUint16 clears the value of the "optional_uint16" field.
 func (m *FieldTypeMutation) ClearOptionalUint16() {
 	m.optional_uint16 = nil
 	m.addoptional_uint16 = nil
+	m.maxoptional_uint16 = nil
+	m.andoptional_uint16 = nil
+	m.oroptional_uint16 = nil
 	m.clearedFields[fieldtype.FieldOptionalUint16] = struct{}{}
 }
 
@@ -2955,6 +4428,9 @@ func (m *FieldTypeMutation) OptionalUint16Cleared() bool {
 func (m *FieldTypeMutation) ResetOptionalUint16() {
 	m.optional_uint16 = nil
 	m.addoptional_uint16 = nil
+	m.maxoptional_uint16 = nil
+	m.andoptional_uint16 = nil
+	m.oroptional_uint16 = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalUint16)
 }
 
@@ -2962,6 +4438,10 @@ func (m *FieldTypeMutation) ResetOptionalUint16() {
 func (m *FieldTypeMutation) SetOptionalUint32(u uint32) {
 	m.optional_uint32 = &u
 	m.addoptional_uint32 = nil
+	m.maxoptional_uint32 = nil
+	m.andoptional_uint32 = nil
+	m.oroptional_uint32 = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalUint32)
 }
 
 // OptionalUint32 returns the value of the "optional_uint32" field in the mutation.
@@ -3008,10 +4488,65 @@ func (m *FieldTypeMutation) AddedOptionalUint32() (r int32, exists bool) {
 	return *v, true
 }
 
+// SetOptionalUint32IfGreater sets the "optional_uint32" field to u in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalUint32IfGreater(u uint32) {
+	if m.maxoptional_uint32 == nil || u > *m.maxoptional_uint32 {
+		m.maxoptional_uint32 = &u
+	}
+}
+
+// OptionalUint32IfGreater returns the value that was set to the "optional_uint32" field by SetOptionalUint32IfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalUint32IfGreater() (r uint32, exists bool) {
+	v := m.maxoptional_uint32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalUint32 applies a bitwise AND with u on the "optional_uint32" field.
+func (m *FieldTypeMutation) BitAndOptionalUint32(u uint32) {
+	if m.andoptional_uint32 != nil {
+		*m.andoptional_uint32 &= u
+	} else {
+		m.andoptional_uint32 = &u
+	}
+}
+
+// OptionalUint32BitAnd returns the value that was applied by BitAndOptionalUint32 on the "optional_uint32" field in this mutation.
+func (m *FieldTypeMutation) OptionalUint32BitAnd() (r uint32, exists bool) {
+	v := m.andoptional_uint32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalUint32 applies a bitwise OR with u on the "optional_uint32" field.
+func (m *FieldTypeMutation) BitOrOptionalUint32(u uint32) {
+	if m.oroptional_uint32 != nil {
+		*m.oroptional_uint32 |= u
+	} else {
+		m.oroptional_uint32 = &u
+	}
+}
+
+// OptionalUint32BitOr returns the value that was applied by BitOrOptionalUint32 on the "optional_uint32" field in this mutation.
+func (m *FieldTypeMutation) OptionalUint32BitOr() (r uint32, exists bool) {
+	v := m.oroptional_uint32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalUint32 clears the value of the "optional_uint32" field.
 func (m *FieldTypeMutation) ClearOptionalUint32() {
 	m.optional_uint32 = nil
 	m.addoptional_uint32 = nil
+	m.maxoptional_uint32 = nil
+	m.andoptional_uint32 = nil
+	m.oroptional_uint32 = nil
 	m.clearedFields[fieldtype.FieldOptionalUint32] = struct{}{}
 }
 
@@ -3025,6 +4560,9 @@ func (m *FieldTypeMutation) OptionalUint32Cleared() bool {
 func (m *FieldTypeMutation) ResetOptionalUint32() {
 	m.optional_uint32 = nil
 	m.addoptional_uint32 = nil
+	m.maxoptional_uint32 = nil
+	m.andoptional_uint32 = nil
+	m.oroptional_uint32 = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalUint32)
 }
 
@@ -3032,6 +4570,10 @@ func (m *FieldTypeMutation) ResetOptionalUint32() {
 func (m *FieldTypeMutation) SetOptionalUint64(u uint64) {
 	m.optional_uint64 = &u
 	m.addoptional_uint64 = nil
+	m.maxoptional_uint64 = nil
+	m.andoptional_uint64 = nil
+	m.oroptional_uint64 = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalUint64)
 }
 
 // OptionalUint64 returns the value of the "optional_uint64" field in the mutation.
@@ -3078,10 +4620,65 @@ func (m *FieldTypeMutation) AddedOptionalUint64() (r int64, exists bool) {
 	return *v, true
 }
 
+// SetOptionalUint64IfGreater sets the "optional_uint64" field to u in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalUint64IfGreater(u uint64) {
+	if m.maxoptional_uint64 == nil || u > *m.maxoptional_uint64 {
+		m.maxoptional_uint64 = &u
+	}
+}
+
+// OptionalUint64IfGreater returns the value that was set to the "optional_uint64" field by SetOptionalUint64IfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalUint64IfGreater() (r uint64, exists bool) {
+	v := m.maxoptional_uint64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalUint64 applies a bitwise AND with u on the "optional_uint64" field.
+func (m *FieldTypeMutation) BitAndOptionalUint64(u uint64) {
+	if m.andoptional_uint64 != nil {
+		*m.andoptional_uint64 &= u
+	} else {
+		m.andoptional_uint64 = &u
+	}
+}
+
+// OptionalUint64BitAnd returns the value that was applied by BitAndOptionalUint64 on the "optional_uint64" field in this mutation.
+func (m *FieldTypeMutation) OptionalUint64BitAnd() (r uint64, exists bool) {
+	v := m.andoptional_uint64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalUint64 applies a bitwise OR with u on the "optional_uint64" field.
+func (m *FieldTypeMutation) BitOrOptionalUint64(u uint64) {
+	if m.oroptional_uint64 != nil {
+		*m.oroptional_uint64 |= u
+	} else {
+		m.oroptional_uint64 = &u
+	}
+}
+
+// OptionalUint64BitOr returns the value that was applied by BitOrOptionalUint64 on the "optional_uint64" field in this mutation.
+func (m *FieldTypeMutation) OptionalUint64BitOr() (r uint64, exists bool) {
+	v := m.oroptional_uint64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalUint64 clears the value of the "optional_uint64" field.
 func (m *FieldTypeMutation) ClearOptionalUint64() {
 	m.optional_uint64 = nil
 	m.addoptional_uint64 = nil
+	m.maxoptional_uint64 = nil
+	m.andoptional_uint64 = nil
+	m.oroptional_uint64 = nil
 	m.clearedFields[fieldtype.FieldOptionalUint64] = struct{}{}
 }
 
@@ -3095,12 +4692,16 @@ func (m *FieldTypeMutation) OptionalUint64Cleared() bool {
 func (m *FieldTypeMutation) ResetOptionalUint64() {
 	m.optional_uint64 = nil
 	m.addoptional_uint64 = nil
+	m.maxoptional_uint64 = nil
+	m.andoptional_uint64 = nil
+	m.oroptional_uint64 = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalUint64)
 }
 
 // SetState sets the "state" field.
 func (m *FieldTypeMutation) SetState(f fieldtype.State) {
 	m.state = &f
+	delete(m.clearedFields, fieldtype.FieldState)
 }
 
 // State returns the value of the "state" field in the mutation.
@@ -3151,6 +4752,8 @@ func (m *FieldTypeMutation) ResetState() {
 func (m *FieldTypeMutation) SetOptionalFloat(f float64) {
 	m.optional_float = &f
 	m.addoptional_float = nil
+	m.maxoptional_float = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalFloat)
 }
 
 // OptionalFloat returns the value of the "optional_float" field in the mutation.
@@ -3197,10 +4800,27 @@ func (m *FieldTypeMutation) AddedOptionalFloat() (r float64, exists bool) {
 	return *v, true
 }
 
+// SetOptionalFloatIfGreater sets the "optional_float" field to f in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalFloatIfGreater(f float64) {
+	if m.maxoptional_float == nil || f > *m.maxoptional_float {
+		m.maxoptional_float = &f
+	}
+}
+
+// OptionalFloatIfGreater returns the value that was set to the "optional_float" field by SetOptionalFloatIfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalFloatIfGreater() (r float64, exists bool) {
+	v := m.maxoptional_float
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalFloat clears the value of the "optional_float" field.
 func (m *FieldTypeMutation) ClearOptionalFloat() {
 	m.optional_float = nil
 	m.addoptional_float = nil
+	m.maxoptional_float = nil
 	m.clearedFields[fieldtype.FieldOptionalFloat] = struct{}{}
 }
 
@@ -3214,6 +4834,7 @@ func (m *FieldTypeMutation) OptionalFloatCleared() bool {
 func (m *FieldTypeMutation) ResetOptionalFloat() {
 	m.optional_float = nil
 	m.addoptional_float = nil
+	m.maxoptional_float = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalFloat)
 }
 
@@ -3221,6 +4842,8 @@ func (m *FieldTypeMutation) ResetOptionalFloat() {
 func (m *FieldTypeMutation) SetOptionalFloat32(f float32) {
 	m.optional_float32 = &f
 	m.addoptional_float32 = nil
+	m.maxoptional_float32 = nil
+	delete(m.clearedFields, fieldtype.FieldOptionalFloat32)
 }
 
 // OptionalFloat32 returns the value of the "optional_float32" field in the mutation.
@@ -3267,10 +4890,27 @@ func (m *FieldTypeMutation) AddedOptionalFloat32() (r float32, exists bool) {
 	return *v, true
 }
 
+// SetOptionalFloat32IfGreater sets the "optional_float32" field to f in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetOptionalFloat32IfGreater(f float32) {
+	if m.maxoptional_float32 == nil || f > *m.maxoptional_float32 {
+		m.maxoptional_float32 = &f
+	}
+}
+
+// OptionalFloat32IfGreater returns the value that was set to the "optional_float32" field by SetOptionalFloat32IfGreater in this mutation.
+func (m *FieldTypeMutation) OptionalFloat32IfGreater() (r float32, exists bool) {
+	v := m.maxoptional_float32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalFloat32 clears the value of the "optional_float32" field.
 func (m *FieldTypeMutation) ClearOptionalFloat32() {
 	m.optional_float32 = nil
 	m.addoptional_float32 = nil
+	m.maxoptional_float32 = nil
 	m.clearedFields[fieldtype.FieldOptionalFloat32] = struct{}{}
 }
 
@@ -3284,12 +4924,14 @@ func (m *FieldTypeMutation) OptionalFloat32Cleared() bool {
 func (m *FieldTypeMutation) ResetOptionalFloat32() {
 	m.optional_float32 = nil
 	m.addoptional_float32 = nil
+	m.maxoptional_float32 = nil
 	delete(m.clearedFields, fieldtype.FieldOptionalFloat32)
 }
 
 // SetText sets the "text" field.
 func (m *FieldTypeMutation) SetText(s string) {
 	m.text = &s
+	delete(m.clearedFields, fieldtype.FieldText)
 }
 
 // Text returns the value of the "text" field in the mutation.
@@ -3339,6 +4981,7 @@ func (m *FieldTypeMutation) ResetText() {
 // SetDatetime sets the "datetime" field.
 func (m *FieldTypeMutation) SetDatetime(t time.Time) {
 	m.datetime = &t
+	delete(m.clearedFields, fieldtype.FieldDatetime)
 }
 
 // Datetime returns the value of the "datetime" field in the mutation.
@@ -3389,6 +5032,8 @@ func (m *FieldTypeMutation) ResetDatetime() {
 func (m *FieldTypeMutation) SetDecimal(f float64) {
 	m.decimal = &f
 	m.adddecimal = nil
+	m.maxdecimal = nil
+	delete(m.clearedFields, fieldtype.FieldDecimal)
 }
 
 // Decimal returns the value of the "decimal" field in the mutation.
@@ -3435,10 +5080,27 @@ func (m *FieldTypeMutation) AddedDecimal() (r float64, exists bool) {
 	return *v, true
 }
 
+// SetDecimalIfGreater sets the "decimal" field to f in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetDecimalIfGreater(f float64) {
+	if m.maxdecimal == nil || f > *m.maxdecimal {
+		m.maxdecimal = &f
+	}
+}
+
+// DecimalIfGreater returns the value that was set to the "decimal" field by SetDecimalIfGreater in this mutation.
+func (m *FieldTypeMutation) DecimalIfGreater() (r float64, exists bool) {
+	v := m.maxdecimal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearDecimal clears the value of the "decimal" field.
 func (m *FieldTypeMutation) ClearDecimal() {
 	m.decimal = nil
 	m.adddecimal = nil
+	m.maxdecimal = nil
 	m.clearedFields[fieldtype.FieldDecimal] = struct{}{}
 }
 
@@ -3452,12 +5114,14 @@ func (m *FieldTypeMutation) DecimalCleared() bool {
 func (m *FieldTypeMutation) ResetDecimal() {
 	m.decimal = nil
 	m.adddecimal = nil
+	m.maxdecimal = nil
 	delete(m.clearedFields, fieldtype.FieldDecimal)
 }
 
 // SetLinkOther sets the "link_other" field.
 func (m *FieldTypeMutation) SetLinkOther(s *schema.Link) {
 	m.link_other = &s
+	delete(m.clearedFields, fieldtype.FieldLinkOther)
 }
 
 // LinkOther returns the value of the "link_other" field in the mutation.
@@ -3507,6 +5171,7 @@ func (m *FieldTypeMutation) ResetLinkOther() {
 // SetLinkOtherFunc sets the "link_other_func" field.
 func (m *FieldTypeMutation) SetLinkOtherFunc(s *schema.Link) {
 	m.link_other_func = &s
+	delete(m.clearedFields, fieldtype.FieldLinkOtherFunc)
 }
 
 // LinkOtherFunc returns the value of the "link_other_func" field in the mutation.
@@ -3556,6 +5221,7 @@ func (m *FieldTypeMutation) ResetLinkOtherFunc() {
 // SetMAC sets the "mac" field.
 func (m *FieldTypeMutation) SetMAC(s schema.MAC) {
 	m.mac = &s
+	delete(m.clearedFields, fieldtype.FieldMAC)
 }
 
 // MAC returns the value of the "mac" field in the mutation.
@@ -3605,6 +5271,7 @@ func (m *FieldTypeMutation) ResetMAC() {
 // SetStringArray sets the "string_array" field.
 func (m *FieldTypeMutation) SetStringArray(s schema.Strings) {
 	m.string_array = &s
+	delete(m.clearedFields, fieldtype.FieldStringArray)
 }
 
 // StringArray returns the value of the "string_array" field in the mutation.
@@ -3654,6 +5321,7 @@ func (m *FieldTypeMutation) ResetStringArray() {
 // SetPassword sets the "password" field.
 func (m *FieldTypeMutation) SetPassword(s string) {
 	m.password = &s
+	delete(m.clearedFields, fieldtype.FieldPassword)
 }
 
 // Password returns the value of the "password" field in the mutation.
@@ -3703,6 +5371,7 @@ func (m *FieldTypeMutation) ResetPassword() {
 // SetStringScanner sets the "string_scanner" field.
 func (m *FieldTypeMutation) SetStringScanner(ss schema.StringScanner) {
 	m.string_scanner = &ss
+	delete(m.clearedFields, fieldtype.FieldStringScanner)
 }
 
 // StringScanner returns the value of the "string_scanner" field in the mutation.
@@ -3753,6 +5422,10 @@ func (m *FieldTypeMutation) ResetStringScanner() {
 func (m *FieldTypeMutation) SetDuration(t time.Duration) {
 	m.duration = &t
 	m.addduration = nil
+	m.maxduration = nil
+	m.andduration = nil
+	m.orduration = nil
+	delete(m.clearedFields, fieldtype.FieldDuration)
 }
 
 // Duration returns the value of the "duration" field in the mutation.
@@ -3774,25 +5447,77 @@ func (m *FieldTypeMutation) OldDuration(ctx context.Context) (v time.Duration, e
 	if m.id == nil || m.oldValue == nil {
 		return v, errors.New("OldDuration requires an ID field in the mutation")
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds t to the "duration" field.
+func (m *FieldTypeMutation) AddDuration(t time.Duration) {
+	if m.addduration != nil {
+		*m.addduration += t
+	} else {
+		m.addduration = &t
+	}
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *FieldTypeMutation) AddedDuration() (r time.Duration, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// SetDurationIfGreater sets the "duration" field to t in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetDurationIfGreater(t time.Duration) {
+	if m.maxduration == nil || t > *m.maxduration {
+		m.maxduration = &t
+	}
+}
+
+// DurationIfGreater returns the value that was set to the "duration" field by SetDurationIfGreater in this mutation.
+func (m *FieldTypeMutation) DurationIfGreater() (r time.Duration, exists bool) {
+	v := m.maxduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndDuration applies a bitwise AND with t on the "duration" field.
+func (m *FieldTypeMutation) BitAndDuration(t time.Duration) {
+	if m.andduration != nil {
+		*m.andduration &= t
+	} else {
+		m.andduration = &t
+	}
+}
+
+// DurationBitAnd returns the value that was applied by BitAndDuration on the "duration" field in this mutation.
+func (m *FieldTypeMutation) DurationBitAnd() (r time.Duration, exists bool) {
+	v := m.andduration
+	if v == nil {
+		return
 	}
-	return oldValue.Duration, nil
+	return *v, true
 }
 
-// AddDuration adds t to the "duration" field.
-func (m *FieldTypeMutation) AddDuration(t time.Duration) {
-	if m.addduration != nil {
-		*m.addduration += t
+// BitOrDuration applies a bitwise OR with t on the "duration" field.
+func (m *FieldTypeMutation) BitOrDuration(t time.Duration) {
+	if m.orduration != nil {
+		*m.orduration |= t
 	} else {
-		m.addduration = &t
+		m.orduration = &t
 	}
 }
 
-// AddedDuration returns the value that was added to the "duration" field in this mutation.
-func (m *FieldTypeMutation) AddedDuration() (r time.Duration, exists bool) {
-	v := m.addduration
+// DurationBitOr returns the value that was applied by BitOrDuration on the "duration" field in this mutation.
+func (m *FieldTypeMutation) DurationBitOr() (r time.Duration, exists bool) {
+	v := m.orduration
 	if v == nil {
 		return
 	}
@@ -3803,6 +5528,9 @@ func (m *FieldTypeMutation) AddedDuration() (r time.Duration, exists bool) {
 func (m *FieldTypeMutation) ClearDuration() {
 	m.duration = nil
 	m.addduration = nil
+	m.maxduration = nil
+	m.andduration = nil
+	m.orduration = nil
 	m.clearedFields[fieldtype.FieldDuration] = struct{}{}
 }
 
@@ -3816,6 +5544,9 @@ func (m *FieldTypeMutation) DurationCleared() bool {
 func (m *FieldTypeMutation) ResetDuration() {
 	m.duration = nil
 	m.addduration = nil
+	m.maxduration = nil
+	m.andduration = nil
+	m.orduration = nil
 	delete(m.clearedFields, fieldtype.FieldDuration)
 }
 
@@ -3858,6 +5589,7 @@ func (m *FieldTypeMutation) ResetDir() {
 // SetNdir sets the "ndir" field.
 func (m *FieldTypeMutation) SetNdir(h http.Dir) {
 	m.ndir = &h
+	delete(m.clearedFields, fieldtype.FieldNdir)
 }
 
 // Ndir returns the value of the "ndir" field in the mutation.
@@ -3907,6 +5639,7 @@ func (m *FieldTypeMutation) ResetNdir() {
 // SetStr sets the "str" field.
 func (m *FieldTypeMutation) SetStr(ss sql.NullString) {
 	m.str = &ss
+	delete(m.clearedFields, fieldtype.FieldStr)
 }
 
 // Str returns the value of the "str" field in the mutation.
@@ -3956,6 +5689,7 @@ func (m *FieldTypeMutation) ResetStr() {
 // SetNullStr sets the "null_str" field.
 func (m *FieldTypeMutation) SetNullStr(ss *sql.NullString) {
 	m.null_str = &ss
+	delete(m.clearedFields, fieldtype.FieldNullStr)
 }
 
 // NullStr returns the value of the "null_str" field in the mutation.
@@ -4005,6 +5739,7 @@ func (m *FieldTypeMutation) ResetNullStr() {
 // SetLink sets the "link" field.
 func (m *FieldTypeMutation) SetLink(s schema.Link) {
 	m.link = &s
+	delete(m.clearedFields, fieldtype.FieldLink)
 }
 
 // Link returns the value of the "link" field in the mutation.
@@ -4054,6 +5789,7 @@ func (m *FieldTypeMutation) ResetLink() {
 // SetNullLink sets the "null_link" field.
 func (m *FieldTypeMutation) SetNullLink(s *schema.Link) {
 	m.null_link = &s
+	delete(m.clearedFields, fieldtype.FieldNullLink)
 }
 
 // NullLink returns the value of the "null_link" field in the mutation.
@@ -4103,6 +5839,7 @@ func (m *FieldTypeMutation) ResetNullLink() {
 // SetActive sets the "active" field.
 func (m *FieldTypeMutation) SetActive(s schema.Status) {
 	m.active = &s
+	delete(m.clearedFields, fieldtype.FieldActive)
 }
 
 // Active returns the value of the "active" field in the mutation.
@@ -4152,6 +5889,7 @@ func (m *FieldTypeMutation) ResetActive() {
 // SetNullActive sets the "null_active" field.
 func (m *FieldTypeMutation) SetNullActive(s schema.Status) {
 	m.null_active = &s
+	delete(m.clearedFields, fieldtype.FieldNullActive)
 }
 
 // NullActive returns the value of the "null_active" field in the mutation.
@@ -4201,6 +5939,7 @@ func (m *FieldTypeMutation) ResetNullActive() {
 // SetDeleted sets the "deleted" field.
 func (m *FieldTypeMutation) SetDeleted(sb *sql.NullBool) {
 	m.deleted = &sb
+	delete(m.clearedFields, fieldtype.FieldDeleted)
 }
 
 // Deleted returns the value of the "deleted" field in the mutation.
@@ -4250,6 +5989,7 @@ func (m *FieldTypeMutation) ResetDeleted() {
 // SetDeletedAt sets the "deleted_at" field.
 func (m *FieldTypeMutation) SetDeletedAt(st *sql.NullTime) {
 	m.deleted_at = &st
+	delete(m.clearedFields, fieldtype.FieldDeletedAt)
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
@@ -4299,6 +6039,7 @@ func (m *FieldTypeMutation) ResetDeletedAt() {
 // SetRawData sets the "raw_data" field.
 func (m *FieldTypeMutation) SetRawData(b []byte) {
 	m.raw_data = &b
+	delete(m.clearedFields, fieldtype.FieldRawData)
 }
 
 // RawData returns the value of the "raw_data" field in the mutation.
@@ -4348,6 +6089,7 @@ func (m *FieldTypeMutation) ResetRawData() {
 // SetSensitive sets the "sensitive" field.
 func (m *FieldTypeMutation) SetSensitive(b []byte) {
 	m.sensitive = &b
+	delete(m.clearedFields, fieldtype.FieldSensitive)
 }
 
 // Sensitive returns the value of the "sensitive" field in the mutation.
@@ -4397,6 +6139,7 @@ func (m *FieldTypeMutation) ResetSensitive() {
 // SetIP sets the "ip" field.
 func (m *FieldTypeMutation) SetIP(n net.IP) {
 	m.ip = &n
+	delete(m.clearedFields, fieldtype.FieldIP)
 }
 
 // IP returns the value of the "ip" field in the mutation.
@@ -4446,6 +6189,7 @@ func (m *FieldTypeMutation) ResetIP() {
 // SetNullInt64 sets the "null_int64" field.
 func (m *FieldTypeMutation) SetNullInt64(si *sql.NullInt64) {
 	m.null_int64 = &si
+	delete(m.clearedFields, fieldtype.FieldNullInt64)
 }
 
 // NullInt64 returns the value of the "null_int64" field in the mutation.
@@ -4496,6 +6240,10 @@ func (m *FieldTypeMutation) ResetNullInt64() {
 func (m *FieldTypeMutation) SetSchemaInt(s schema.Int) {
 	m.schema_int = &s
 	m.addschema_int = nil
+	m.maxschema_int = nil
+	m.andschema_int = nil
+	m.orschema_int = nil
+	delete(m.clearedFields, fieldtype.FieldSchemaInt)
 }
 
 // SchemaInt returns the value of the "schema_int" field in the mutation.
@@ -4542,10 +6290,65 @@ func (m *FieldTypeMutation) AddedSchemaInt() (r schema.Int, exists bool) {
 	return *v, true
 }
 
+// SetSchemaIntIfGreater sets the "schema_int" field to s in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetSchemaIntIfGreater(s schema.Int) {
+	if m.maxschema_int == nil || s > *m.maxschema_int {
+		m.maxschema_int = &s
+	}
+}
+
+// SchemaIntIfGreater returns the value that was set to the "schema_int" field by SetSchemaIntIfGreater in this mutation.
+func (m *FieldTypeMutation) SchemaIntIfGreater() (r schema.Int, exists bool) {
+	v := m.maxschema_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndSchemaInt applies a bitwise AND with s on the "schema_int" field.
+func (m *FieldTypeMutation) BitAndSchemaInt(s schema.Int) {
+	if m.andschema_int != nil {
+		*m.andschema_int &= s
+	} else {
+		m.andschema_int = &s
+	}
+}
+
+// SchemaIntBitAnd returns the value that was applied by BitAndSchemaInt on the "schema_int" field in this mutation.
+func (m *FieldTypeMutation) SchemaIntBitAnd() (r schema.Int, exists bool) {
+	v := m.andschema_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrSchemaInt applies a bitwise OR with s on the "schema_int" field.
+func (m *FieldTypeMutation) BitOrSchemaInt(s schema.Int) {
+	if m.orschema_int != nil {
+		*m.orschema_int |= s
+	} else {
+		m.orschema_int = &s
+	}
+}
+
+// SchemaIntBitOr returns the value that was applied by BitOrSchemaInt on the "schema_int" field in this mutation.
+func (m *FieldTypeMutation) SchemaIntBitOr() (r schema.Int, exists bool) {
+	v := m.orschema_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearSchemaInt clears the value of the "schema_int" field.
 func (m *FieldTypeMutation) ClearSchemaInt() {
 	m.schema_int = nil
 	m.addschema_int = nil
+	m.maxschema_int = nil
+	m.andschema_int = nil
+	m.orschema_int = nil
 	m.clearedFields[fieldtype.FieldSchemaInt] = struct{}{}
 }
 
@@ -4559,6 +6362,9 @@ func (m *FieldTypeMutation) SchemaIntCleared() bool {
 func (m *FieldTypeMutation) ResetSchemaInt() {
 	m.schema_int = nil
 	m.addschema_int = nil
+	m.maxschema_int = nil
+	m.andschema_int = nil
+	m.orschema_int = nil
 	delete(m.clearedFields, fieldtype.FieldSchemaInt)
 }
 
@@ -4566,6 +6372,10 @@ func (m *FieldTypeMutation) ResetSchemaInt() {
 func (m *FieldTypeMutation) SetSchemaInt8(s schema.Int8) {
 	m.schema_int8 = &s
 	m.addschema_int8 = nil
+	m.maxschema_int8 = nil
+	m.andschema_int8 = nil
+	m.orschema_int8 = nil
+	delete(m.clearedFields, fieldtype.FieldSchemaInt8)
 }
 
 // SchemaInt8 returns the value of the "schema_int8" field in the mutation.
@@ -4612,10 +6422,65 @@ func (m *FieldTypeMutation) AddedSchemaInt8() (r schema.Int8, exists bool) {
 	return *v, true
 }
 
+// SetSchemaInt8IfGreater sets the "schema_int8" field to s in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetSchemaInt8IfGreater(s schema.Int8) {
+	if m.maxschema_int8 == nil || s > *m.maxschema_int8 {
+		m.maxschema_int8 = &s
+	}
+}
+
+// SchemaInt8IfGreater returns the value that was set to the "schema_int8" field by SetSchemaInt8IfGreater in this mutation.
+func (m *FieldTypeMutation) SchemaInt8IfGreater() (r schema.Int8, exists bool) {
+	v := m.maxschema_int8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndSchemaInt8 applies a bitwise AND with s on the "schema_int8" field.
+func (m *FieldTypeMutation) BitAndSchemaInt8(s schema.Int8) {
+	if m.andschema_int8 != nil {
+		*m.andschema_int8 &= s
+	} else {
+		m.andschema_int8 = &s
+	}
+}
+
+// SchemaInt8BitAnd returns the value that was applied by BitAndSchemaInt8 on the "schema_int8" field in this mutation.
+func (m *FieldTypeMutation) SchemaInt8BitAnd() (r schema.Int8, exists bool) {
+	v := m.andschema_int8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrSchemaInt8 applies a bitwise OR with s on the "schema_int8" field.
+func (m *FieldTypeMutation) BitOrSchemaInt8(s schema.Int8) {
+	if m.orschema_int8 != nil {
+		*m.orschema_int8 |= s
+	} else {
+		m.orschema_int8 = &s
+	}
+}
+
+// SchemaInt8BitOr returns the value that was applied by BitOrSchemaInt8 on the "schema_int8" field in this mutation.
+func (m *FieldTypeMutation) SchemaInt8BitOr() (r schema.Int8, exists bool) {
+	v := m.orschema_int8
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearSchemaInt8 clears the value of the "schema_int8" field.
 func (m *FieldTypeMutation) ClearSchemaInt8() {
 	m.schema_int8 = nil
 	m.addschema_int8 = nil
+	m.maxschema_int8 = nil
+	m.andschema_int8 = nil
+	m.orschema_int8 = nil
 	m.clearedFields[fieldtype.FieldSchemaInt8] = struct{}{}
 }
 
@@ -4629,6 +6494,9 @@ func (m *FieldTypeMutation) SchemaInt8Cleared() bool {
 func (m *FieldTypeMutation) ResetSchemaInt8() {
 	m.schema_int8 = nil
 	m.addschema_int8 = nil
+	m.maxschema_int8 = nil
+	m.andschema_int8 = nil
+	m.orschema_int8 = nil
 	delete(m.clearedFields, fieldtype.FieldSchemaInt8)
 }
 
@@ -4636,6 +6504,10 @@ func (m *FieldTypeMutation) ResetSchemaInt8() {
 func (m *FieldTypeMutation) SetSchemaInt64(s schema.Int64) {
 	m.schema_int64 = &s
 	m.addschema_int64 = nil
+	m.maxschema_int64 = nil
+	m.andschema_int64 = nil
+	m.orschema_int64 = nil
+	delete(m.clearedFields, fieldtype.FieldSchemaInt64)
 }
 
 // SchemaInt64 returns the value of the "schema_int64" field in the mutation.
@@ -4682,10 +6554,65 @@ func (m *FieldTypeMutation) AddedSchemaInt64() (r schema.Int64, exists bool) {
 	return *v, true
 }
 
+// SetSchemaInt64IfGreater sets the "schema_int64" field to s in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetSchemaInt64IfGreater(s schema.Int64) {
+	if m.maxschema_int64 == nil || s > *m.maxschema_int64 {
+		m.maxschema_int64 = &s
+	}
+}
+
+// SchemaInt64IfGreater returns the value that was set to the "schema_int64" field by SetSchemaInt64IfGreater in this mutation.
+func (m *FieldTypeMutation) SchemaInt64IfGreater() (r schema.Int64, exists bool) {
+	v := m.maxschema_int64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndSchemaInt64 applies a bitwise AND with s on the "schema_int64" field.
+func (m *FieldTypeMutation) BitAndSchemaInt64(s schema.Int64) {
+	if m.andschema_int64 != nil {
+		*m.andschema_int64 &= s
+	} else {
+		m.andschema_int64 = &s
+	}
+}
+
+// SchemaInt64BitAnd returns the value that was applied by BitAndSchemaInt64 on the "schema_int64" field in this mutation.
+func (m *FieldTypeMutation) SchemaInt64BitAnd() (r schema.Int64, exists bool) {
+	v := m.andschema_int64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrSchemaInt64 applies a bitwise OR with s on the "schema_int64" field.
+func (m *FieldTypeMutation) BitOrSchemaInt64(s schema.Int64) {
+	if m.orschema_int64 != nil {
+		*m.orschema_int64 |= s
+	} else {
+		m.orschema_int64 = &s
+	}
+}
+
+// SchemaInt64BitOr returns the value that was applied by BitOrSchemaInt64 on the "schema_int64" field in this mutation.
+func (m *FieldTypeMutation) SchemaInt64BitOr() (r schema.Int64, exists bool) {
+	v := m.orschema_int64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearSchemaInt64 clears the value of the "schema_int64" field.
 func (m *FieldTypeMutation) ClearSchemaInt64() {
 	m.schema_int64 = nil
 	m.addschema_int64 = nil
+	m.maxschema_int64 = nil
+	m.andschema_int64 = nil
+	m.orschema_int64 = nil
 	m.clearedFields[fieldtype.FieldSchemaInt64] = struct{}{}
 }
 
@@ -4699,6 +6626,9 @@ func (m *FieldTypeMutation) SchemaInt64Cleared() bool {
 func (m *FieldTypeMutation) ResetSchemaInt64() {
 	m.schema_int64 = nil
 	m.addschema_int64 = nil
+	m.maxschema_int64 = nil
+	m.andschema_int64 = nil
+	m.orschema_int64 = nil
 	delete(m.clearedFields, fieldtype.FieldSchemaInt64)
 }
 
@@ -4706,6 +6636,8 @@ func (m *FieldTypeMutation) ResetSchemaInt64() {
 func (m *FieldTypeMutation) SetSchemaFloat(s schema.Float64) {
 	m.schema_float = &s
 	m.addschema_float = nil
+	m.maxschema_float = nil
+	delete(m.clearedFields, fieldtype.FieldSchemaFloat)
 }
 
 // SchemaFloat returns the value of the "schema_float" field in the mutation.
@@ -4752,10 +6684,27 @@ func (m *FieldTypeMutation) AddedSchemaFloat() (r schema.Float64, exists bool) {
 	return *v, true
 }
 
+// SetSchemaFloatIfGreater sets the "schema_float" field to s in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetSchemaFloatIfGreater(s schema.Float64) {
+	if m.maxschema_float == nil || s > *m.maxschema_float {
+		m.maxschema_float = &s
+	}
+}
+
+// SchemaFloatIfGreater returns the value that was set to the "schema_float" field by SetSchemaFloatIfGreater in this mutation.
+func (m *FieldTypeMutation) SchemaFloatIfGreater() (r schema.Float64, exists bool) {
+	v := m.maxschema_float
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearSchemaFloat clears the value of the "schema_float" field.
 func (m *FieldTypeMutation) ClearSchemaFloat() {
 	m.schema_float = nil
 	m.addschema_float = nil
+	m.maxschema_float = nil
 	m.clearedFields[fieldtype.FieldSchemaFloat] = struct{}{}
 }
 
@@ -4769,6 +6718,7 @@ func (m *FieldTypeMutation) SchemaFloatCleared() bool {
 func (m *FieldTypeMutation) ResetSchemaFloat() {
 	m.schema_float = nil
 	m.addschema_float = nil
+	m.maxschema_float = nil
 	delete(m.clearedFields, fieldtype.FieldSchemaFloat)
 }
 
@@ -4776,6 +6726,8 @@ func (m *FieldTypeMutation) ResetSchemaFloat() {
 func (m *FieldTypeMutation) SetSchemaFloat32(s schema.Float32) {
 	m.schema_float32 = &s
 	m.addschema_float32 = nil
+	m.maxschema_float32 = nil
+	delete(m.clearedFields, fieldtype.FieldSchemaFloat32)
 }
 
 // SchemaFloat32 returns the value of the "schema_float32" field in the mutation.
@@ -4822,10 +6774,27 @@ func (m *FieldTypeMutation) AddedSchemaFloat32() (r schema.Float32, exists bool)
 	return *v, true
 }
 
+// SetSchemaFloat32IfGreater sets the "schema_float32" field to s in the database, only if it is greater than its stored value.
+func (m *FieldTypeMutation) SetSchemaFloat32IfGreater(s schema.Float32) {
+	if m.maxschema_float32 == nil || s > *m.maxschema_float32 {
+		m.maxschema_float32 = &s
+	}
+}
+
+// SchemaFloat32IfGreater returns the value that was set to the "schema_float32" field by SetSchemaFloat32IfGreater in this mutation.
+func (m *FieldTypeMutation) SchemaFloat32IfGreater() (r schema.Float32, exists bool) {
+	v := m.maxschema_float32
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearSchemaFloat32 clears the value of the "schema_float32" field.
 func (m *FieldTypeMutation) ClearSchemaFloat32() {
 	m.schema_float32 = nil
 	m.addschema_float32 = nil
+	m.maxschema_float32 = nil
 	m.clearedFields[fieldtype.FieldSchemaFloat32] = struct{}{}
 }
 
@@ -4839,12 +6808,14 @@ func (m *FieldTypeMutation) SchemaFloat32Cleared() bool {
 func (m *FieldTypeMutation) ResetSchemaFloat32() {
 	m.schema_float32 = nil
 	m.addschema_float32 = nil
+	m.maxschema_float32 = nil
 	delete(m.clearedFields, fieldtype.FieldSchemaFloat32)
 }
 
 // SetNullFloat sets the "null_float" field.
 func (m *FieldTypeMutation) SetNullFloat(sf *sql.NullFloat64) {
 	m.null_float = &sf
+	delete(m.clearedFields, fieldtype.FieldNullFloat)
 }
 
 // NullFloat returns the value of the "null_float" field in the mutation.
@@ -4930,6 +6901,7 @@ func (m *FieldTypeMutation) ResetRole() {
 // SetPriority sets the "priority" field.
 func (m *FieldTypeMutation) SetPriority(r role.Priority) {
 	m.priority = &r
+	delete(m.clearedFields, fieldtype.FieldPriority)
 }
 
 // Priority returns the value of the "priority" field in the mutation.
@@ -4979,6 +6951,7 @@ func (m *FieldTypeMutation) ResetPriority() {
 // SetOptionalUUID sets the "optional_uuid" field.
 func (m *FieldTypeMutation) SetOptionalUUID(u uuid.UUID) {
 	m.optional_uuid = &u
+	delete(m.clearedFields, fieldtype.FieldOptionalUUID)
 }
 
 // OptionalUUID returns the value of the "optional_uuid" field in the mutation.
@@ -5028,6 +7001,7 @@ func (m *FieldTypeMutation) ResetOptionalUUID() {
 // SetNillableUUID sets the "nillable_uuid" field.
 func (m *FieldTypeMutation) SetNillableUUID(u uuid.UUID) {
 	m.nillable_uuid = &u
+	delete(m.clearedFields, fieldtype.FieldNillableUUID)
 }
 
 // NillableUUID returns the value of the "nillable_uuid" field in the mutation.
@@ -5077,6 +7051,8 @@ func (m *FieldTypeMutation) ResetNillableUUID() {
 // SetStrings sets the "strings" field.
 func (m *FieldTypeMutation) SetStrings(s []string) {
 	m.strings = &s
+	m.appendedstrings = nil
+	delete(m.clearedFields, fieldtype.FieldStrings)
 }
 
 // Strings returns the value of the "strings" field in the mutation.
@@ -5105,9 +7081,23 @@ func (m *FieldTypeMutation) OldStrings(ctx context.Context) (v []string, err err
 	return oldValue.Strings, nil
 }
 
+// AppendStrings adds s to the "strings" field.
+func (m *FieldTypeMutation) AppendStrings(s []string) {
+	m.appendedstrings = append(m.appendedstrings, s...)
+}
+
+// AppendedStrings returns the list of values that were appended to the "strings" field in this mutation.
+func (m *FieldTypeMutation) AppendedStrings() ([]string, bool) {
+	if len(m.appendedstrings) == 0 {
+		return nil, false
+	}
+	return m.appendedstrings, true
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (m *FieldTypeMutation) ClearStrings() {
 	m.strings = nil
+	m.appendedstrings = nil
 	m.clearedFields[fieldtype.FieldStrings] = struct{}{}
 }
 
@@ -5120,6 +7110,7 @@ func (m *FieldTypeMutation) StringsCleared() bool {
 // ResetStrings resets all changes to the "strings" field.
 func (m *FieldTypeMutation) ResetStrings() {
 	m.strings = nil
+	m.appendedstrings = nil
 	delete(m.clearedFields, fieldtype.FieldStrings)
 }
 
@@ -5162,6 +7153,7 @@ func (m *FieldTypeMutation) ResetPair() {
 // SetNilPair sets the "nil_pair" field.
 func (m *FieldTypeMutation) SetNilPair(s *schema.Pair) {
 	m.nil_pair = &s
+	delete(m.clearedFields, fieldtype.FieldNilPair)
 }
 
 // NilPair returns the value of the "nil_pair" field in the mutation.
@@ -5284,6 +7276,7 @@ func (m *FieldTypeMutation) ResetTriple() {
 func (m *FieldTypeMutation) SetBigInt(si schema.BigInt) {
 	m.big_int = &si
 	m.addbig_int = nil
+	delete(m.clearedFields, fieldtype.FieldBigInt)
 }
 
 // BigInt returns the value of the "big_int" field in the mutation.
@@ -5353,6 +7346,7 @@ func (m *FieldTypeMutation) ResetBigInt() {
 // SetPasswordOther sets the "password_other" field.
 func (m *FieldTypeMutation) SetPasswordOther(s schema.Password) {
 	m.password_other = &s
+	delete(m.clearedFields, fieldtype.FieldPasswordOther)
 }
 
 // PasswordOther returns the value of the "password_other" field in the mutation.
@@ -5404,6 +7398,16 @@ func (m *FieldTypeMutation) Where(ps ...predicate.FieldType) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the FieldTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FieldTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FieldType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *FieldTypeMutation) Op() Op {
 	return m.op
@@ -7308,55 +9312,422 @@ func (m *FieldTypeMutation) ResetField(name string) error {
 		m.ResetPasswordOther()
 		return nil
 	}
-	return fmt.Errorf("unknown FieldType field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FieldTypeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *FieldTypeMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FieldTypeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *FieldTypeMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FieldTypeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *FieldTypeMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *FieldTypeMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown FieldType unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *FieldTypeMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown FieldType edge %s", name)
+	return fmt.Errorf("unknown FieldType field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FieldTypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FieldTypeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FieldTypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FieldTypeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FieldTypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FieldTypeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FieldTypeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FieldType unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FieldTypeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FieldType edge %s", name)
+}
+
+// ToPatch returns the changes of the mutable fields in this mutation as a FieldTypePatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *FieldTypeMutation) ToPatch() *FieldTypePatch {
+	p := &FieldTypePatch{}
+	if v, ok := m.Int(); ok {
+		p.Int = &v
+	}
+	if v, ok := m.Int8(); ok {
+		p.Int8 = &v
+	}
+	if v, ok := m.Int16(); ok {
+		p.Int16 = &v
+	}
+	if v, ok := m.Int32(); ok {
+		p.Int32 = &v
+	}
+	if v, ok := m.Int64(); ok {
+		p.Int64 = &v
+	}
+	if v, ok := m.OptionalInt(); ok {
+		p.OptionalInt = &v
+	}
+	if m.OptionalIntCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalInt)
+	}
+	if v, ok := m.OptionalInt8(); ok {
+		p.OptionalInt8 = &v
+	}
+	if m.OptionalInt8Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalInt8)
+	}
+	if v, ok := m.OptionalInt16(); ok {
+		p.OptionalInt16 = &v
+	}
+	if m.OptionalInt16Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalInt16)
+	}
+	if v, ok := m.OptionalInt32(); ok {
+		p.OptionalInt32 = &v
+	}
+	if m.OptionalInt32Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalInt32)
+	}
+	if v, ok := m.OptionalInt64(); ok {
+		p.OptionalInt64 = &v
+	}
+	if m.OptionalInt64Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalInt64)
+	}
+	if v, ok := m.NillableInt(); ok {
+		p.NillableInt = &v
+	}
+	if m.NillableIntCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNillableInt)
+	}
+	if v, ok := m.NillableInt8(); ok {
+		p.NillableInt8 = &v
+	}
+	if m.NillableInt8Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNillableInt8)
+	}
+	if v, ok := m.NillableInt16(); ok {
+		p.NillableInt16 = &v
+	}
+	if m.NillableInt16Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNillableInt16)
+	}
+	if v, ok := m.NillableInt32(); ok {
+		p.NillableInt32 = &v
+	}
+	if m.NillableInt32Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNillableInt32)
+	}
+	if v, ok := m.NillableInt64(); ok {
+		p.NillableInt64 = &v
+	}
+	if m.NillableInt64Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNillableInt64)
+	}
+	if v, ok := m.ValidateOptionalInt32(); ok {
+		p.ValidateOptionalInt32 = &v
+	}
+	if m.ValidateOptionalInt32Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldValidateOptionalInt32)
+	}
+	if v, ok := m.OptionalUint(); ok {
+		p.OptionalUint = &v
+	}
+	if m.OptionalUintCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUint)
+	}
+	if v, ok := m.OptionalUint8(); ok {
+		p.OptionalUint8 = &v
+	}
+	if m.OptionalUint8Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUint8)
+	}
+	if v, ok := m.OptionalUint16(); ok {
+		p.OptionalUint16 = &v
+	}
+	if m.OptionalUint16Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUint16)
+	}
+	if v, ok := m.OptionalUint32(); ok {
+		p.OptionalUint32 = &v
+	}
+	if m.OptionalUint32Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUint32)
+	}
+	if v, ok := m.OptionalUint64(); ok {
+		p.OptionalUint64 = &v
+	}
+	if m.OptionalUint64Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUint64)
+	}
+	if v, ok := m.State(); ok {
+		p.State = &v
+	}
+	if m.StateCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldState)
+	}
+	if v, ok := m.OptionalFloat(); ok {
+		p.OptionalFloat = &v
+	}
+	if m.OptionalFloatCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalFloat)
+	}
+	if v, ok := m.OptionalFloat32(); ok {
+		p.OptionalFloat32 = &v
+	}
+	if m.OptionalFloat32Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalFloat32)
+	}
+	if v, ok := m.Text(); ok {
+		p.Text = &v
+	}
+	if m.TextCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldText)
+	}
+	if v, ok := m.Datetime(); ok {
+		p.Datetime = &v
+	}
+	if m.DatetimeCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldDatetime)
+	}
+	if v, ok := m.Decimal(); ok {
+		p.Decimal = &v
+	}
+	if m.DecimalCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldDecimal)
+	}
+	if v, ok := m.LinkOther(); ok {
+		p.LinkOther = &v
+	}
+	if m.LinkOtherCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldLinkOther)
+	}
+	if v, ok := m.LinkOtherFunc(); ok {
+		p.LinkOtherFunc = &v
+	}
+	if m.LinkOtherFuncCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldLinkOtherFunc)
+	}
+	if v, ok := m.MAC(); ok {
+		p.MAC = &v
+	}
+	if m.MACCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldMAC)
+	}
+	if v, ok := m.StringArray(); ok {
+		p.StringArray = &v
+	}
+	if m.StringArrayCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldStringArray)
+	}
+	if v, ok := m.Password(); ok {
+		p.Password = &v
+	}
+	if m.PasswordCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldPassword)
+	}
+	if v, ok := m.StringScanner(); ok {
+		p.StringScanner = &v
+	}
+	if m.StringScannerCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldStringScanner)
+	}
+	if v, ok := m.Duration(); ok {
+		p.Duration = &v
+	}
+	if m.DurationCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldDuration)
+	}
+	if v, ok := m.Dir(); ok {
+		p.Dir = &v
+	}
+	if v, ok := m.Ndir(); ok {
+		p.Ndir = &v
+	}
+	if m.NdirCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNdir)
+	}
+	if v, ok := m.Str(); ok {
+		p.Str = &v
+	}
+	if m.StrCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldStr)
+	}
+	if v, ok := m.NullStr(); ok {
+		p.NullStr = &v
+	}
+	if m.NullStrCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNullStr)
+	}
+	if v, ok := m.Link(); ok {
+		p.Link = &v
+	}
+	if m.LinkCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldLink)
+	}
+	if v, ok := m.NullLink(); ok {
+		p.NullLink = &v
+	}
+	if m.NullLinkCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNullLink)
+	}
+	if v, ok := m.Active(); ok {
+		p.Active = &v
+	}
+	if m.ActiveCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldActive)
+	}
+	if v, ok := m.NullActive(); ok {
+		p.NullActive = &v
+	}
+	if m.NullActiveCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNullActive)
+	}
+	if v, ok := m.Deleted(); ok {
+		p.Deleted = &v
+	}
+	if m.DeletedCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldDeleted)
+	}
+	if v, ok := m.DeletedAt(); ok {
+		p.DeletedAt = &v
+	}
+	if m.DeletedAtCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldDeletedAt)
+	}
+	if v, ok := m.RawData(); ok {
+		p.RawData = &v
+	}
+	if m.RawDataCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldRawData)
+	}
+	if v, ok := m.Sensitive(); ok {
+		p.Sensitive = &v
+	}
+	if m.SensitiveCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldSensitive)
+	}
+	if v, ok := m.IP(); ok {
+		p.IP = &v
+	}
+	if m.IPCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldIP)
+	}
+	if v, ok := m.NullInt64(); ok {
+		p.NullInt64 = &v
+	}
+	if m.NullInt64Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNullInt64)
+	}
+	if v, ok := m.SchemaInt(); ok {
+		p.SchemaInt = &v
+	}
+	if m.SchemaIntCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldSchemaInt)
+	}
+	if v, ok := m.SchemaInt8(); ok {
+		p.SchemaInt8 = &v
+	}
+	if m.SchemaInt8Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldSchemaInt8)
+	}
+	if v, ok := m.SchemaInt64(); ok {
+		p.SchemaInt64 = &v
+	}
+	if m.SchemaInt64Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldSchemaInt64)
+	}
+	if v, ok := m.SchemaFloat(); ok {
+		p.SchemaFloat = &v
+	}
+	if m.SchemaFloatCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldSchemaFloat)
+	}
+	if v, ok := m.SchemaFloat32(); ok {
+		p.SchemaFloat32 = &v
+	}
+	if m.SchemaFloat32Cleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldSchemaFloat32)
+	}
+	if v, ok := m.NullFloat(); ok {
+		p.NullFloat = &v
+	}
+	if m.NullFloatCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNullFloat)
+	}
+	if v, ok := m.Role(); ok {
+		p.Role = &v
+	}
+	if v, ok := m.Priority(); ok {
+		p.Priority = &v
+	}
+	if m.PriorityCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldPriority)
+	}
+	if v, ok := m.OptionalUUID(); ok {
+		p.OptionalUUID = &v
+	}
+	if m.OptionalUUIDCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldOptionalUUID)
+	}
+	if v, ok := m.NillableUUID(); ok {
+		p.NillableUUID = &v
+	}
+	if m.NillableUUIDCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNillableUUID)
+	}
+	if v, ok := m.Strings(); ok {
+		p.Strings = &v
+	}
+	if m.StringsCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldStrings)
+	}
+	if v, ok := m.Pair(); ok {
+		p.Pair = &v
+	}
+	if v, ok := m.NilPair(); ok {
+		p.NilPair = &v
+	}
+	if m.NilPairCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldNilPair)
+	}
+	if v, ok := m.Vstring(); ok {
+		p.Vstring = &v
+	}
+	if v, ok := m.Triple(); ok {
+		p.Triple = &v
+	}
+	if v, ok := m.BigInt(); ok {
+		p.BigInt = &v
+	}
+	if m.BigIntCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldBigInt)
+	}
+	if v, ok := m.PasswordOther(); ok {
+		p.PasswordOther = &v
+	}
+	if m.PasswordOtherCleared() {
+		p.Cleared = append(p.Cleared, fieldtype.FieldPasswordOther)
+	}
+	return p
 }
 
 // FileMutation represents an operation that mutates the File nodes in the graph.
@@ -7367,6 +9738,9 @@ type FileMutation struct {
 	id            *int
 	size          *int
 	addsize       *int
+	maxsize       *int
+	andsize       *int
+	orsize        *int
 	name          *string
 	user          *string
 	group         *string
@@ -7486,6 +9860,9 @@ func (m *FileMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *FileMutation) SetSize(i int) {
 	m.size = &i
 	m.addsize = nil
+	m.maxsize = nil
+	m.andsize = nil
+	m.orsize = nil
 }
 
 // Size returns the value of the "size" field in the mutation.
@@ -7532,10 +9909,65 @@ func (m *FileMutation) AddedSize() (r int, exists bool) {
 	return *v, true
 }
 
+// SetSizeIfGreater sets the "size" field to i in the database, only if it is greater than its stored value.
+func (m *FileMutation) SetSizeIfGreater(i int) {
+	if m.maxsize == nil || i > *m.maxsize {
+		m.maxsize = &i
+	}
+}
+
+// SizeIfGreater returns the value that was set to the "size" field by SetSizeIfGreater in this mutation.
+func (m *FileMutation) SizeIfGreater() (r int, exists bool) {
+	v := m.maxsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndSize applies a bitwise AND with i on the "size" field.
+func (m *FileMutation) BitAndSize(i int) {
+	if m.andsize != nil {
+		*m.andsize &= i
+	} else {
+		m.andsize = &i
+	}
+}
+
+// SizeBitAnd returns the value that was applied by BitAndSize on the "size" field in this mutation.
+func (m *FileMutation) SizeBitAnd() (r int, exists bool) {
+	v := m.andsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrSize applies a bitwise OR with i on the "size" field.
+func (m *FileMutation) BitOrSize(i int) {
+	if m.orsize != nil {
+		*m.orsize |= i
+	} else {
+		m.orsize = &i
+	}
+}
+
+// SizeBitOr returns the value that was applied by BitOrSize on the "size" field in this mutation.
+func (m *FileMutation) SizeBitOr() (r int, exists bool) {
+	v := m.orsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetSize resets all changes to the "size" field.
 func (m *FileMutation) ResetSize() {
 	m.size = nil
 	m.addsize = nil
+	m.maxsize = nil
+	m.andsize = nil
+	m.orsize = nil
 }
 
 // SetName sets the "name" field.
@@ -7577,6 +10009,7 @@ func (m *FileMutation) ResetName() {
 // SetUser sets the "user" field.
 func (m *FileMutation) SetUser(s string) {
 	m.user = &s
+	delete(m.clearedFields, file.FieldUser)
 }
 
 // User returns the value of the "user" field in the mutation.
@@ -7626,6 +10059,7 @@ func (m *FileMutation) ResetUser() {
 // SetGroup sets the "group" field.
 func (m *FileMutation) SetGroup(s string) {
 	m.group = &s
+	delete(m.clearedFields, file.FieldGroup)
 }
 
 // Group returns the value of the "group" field in the mutation.
@@ -7675,6 +10109,7 @@ func (m *FileMutation) ResetGroup() {
 // SetOp sets the "op" field.
 func (m *FileMutation) SetOp(b bool) {
 	m._op = &b
+	delete(m.clearedFields, file.FieldOp)
 }
 
 // GetOp returns the value of the "op" field in the mutation.
@@ -7858,6 +10293,16 @@ func (m *FileMutation) Where(ps ...predicate.File) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.File, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *FileMutation) Op() Op {
 	return m.op
@@ -8193,6 +10638,37 @@ func (m *FileMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown File edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a FilePatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *FileMutation) ToPatch() *FilePatch {
+	p := &FilePatch{}
+	if v, ok := m.Size(); ok {
+		p.Size = &v
+	}
+	if v, ok := m.Name(); ok {
+		p.Name = &v
+	}
+	if v, ok := m.User(); ok {
+		p.User = &v
+	}
+	if m.UserCleared() {
+		p.Cleared = append(p.Cleared, file.FieldUser)
+	}
+	if v, ok := m.Group(); ok {
+		p.Group = &v
+	}
+	if m.GroupCleared() {
+		p.Cleared = append(p.Cleared, file.FieldGroup)
+	}
+	if v, ok := m.GetOp(); ok {
+		p.Op = &v
+	}
+	if m.OpCleared() {
+		p.Cleared = append(p.Cleared, file.FieldOp)
+	}
+	return p
+}
+
 // FileTypeMutation represents an operation that mutates the FileType nodes in the graph.
 type FileTypeMutation struct {
 	config
@@ -8476,6 +10952,16 @@ func (m *FileTypeMutation) Where(ps ...predicate.FileType) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the FileTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *FileTypeMutation) Op() Op {
 	return m.op
@@ -8705,6 +11191,22 @@ func (m *FileTypeMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown FileType edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a FileTypePatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *FileTypeMutation) ToPatch() *FileTypePatch {
+	p := &FileTypePatch{}
+	if v, ok := m.Name(); ok {
+		p.Name = &v
+	}
+	if v, ok := m.GetType(); ok {
+		p.Type = &v
+	}
+	if v, ok := m.State(); ok {
+		p.State = &v
+	}
+	return p
+}
+
 // GoodsMutation represents an operation that mutates the Goods nodes in the graph.
 type GoodsMutation struct {
 	config
@@ -8820,6 +11322,16 @@ func (m *GoodsMutation) Where(ps ...predicate.Goods) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the GoodsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoodsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Goods, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *GoodsMutation) Op() Op {
 	return m.op
@@ -8954,6 +11466,13 @@ func (m *GoodsMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Goods edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a GoodsPatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *GoodsMutation) ToPatch() *GoodsPatch {
+	p := &GoodsPatch{}
+	return p
+}
+
 // GroupMutation represents an operation that mutates the Group nodes in the graph.
 type GroupMutation struct {
 	config
@@ -8965,6 +11484,9 @@ type GroupMutation struct {
 	_type          *string
 	max_users      *int
 	addmax_users   *int
+	maxmax_users   *int
+	andmax_users   *int
+	ormax_users    *int
 	name           *string
 	clearedFields  map[string]struct{}
 	files          map[int]struct{}
@@ -9156,6 +11678,7 @@ func (m *GroupMutation) ResetExpire() {
 // SetType sets the "type" field.
 func (m *GroupMutation) SetType(s string) {
 	m._type = &s
+	delete(m.clearedFields, group.FieldType)
 }
 
 // GetType returns the value of the "type" field in the mutation.
@@ -9206,6 +11729,10 @@ func (m *GroupMutation) ResetType() {
 func (m *GroupMutation) SetMaxUsers(i int) {
 	m.max_users = &i
 	m.addmax_users = nil
+	m.maxmax_users = nil
+	m.andmax_users = nil
+	m.ormax_users = nil
+	delete(m.clearedFields, group.FieldMaxUsers)
 }
 
 // MaxUsers returns the value of the "max_users" field in the mutation.
@@ -9252,10 +11779,65 @@ func (m *GroupMutation) AddedMaxUsers() (r int, exists bool) {
 	return *v, true
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i in the database, only if it is greater than its stored value.
+func (m *GroupMutation) SetMaxUsersIfGreater(i int) {
+	if m.maxmax_users == nil || i > *m.maxmax_users {
+		m.maxmax_users = &i
+	}
+}
+
+// MaxUsersIfGreater returns the value that was set to the "max_users" field by SetMaxUsersIfGreater in this mutation.
+func (m *GroupMutation) MaxUsersIfGreater() (r int, exists bool) {
+	v := m.maxmax_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field.
+func (m *GroupMutation) BitAndMaxUsers(i int) {
+	if m.andmax_users != nil {
+		*m.andmax_users &= i
+	} else {
+		m.andmax_users = &i
+	}
+}
+
+// MaxUsersBitAnd returns the value that was applied by BitAndMaxUsers on the "max_users" field in this mutation.
+func (m *GroupMutation) MaxUsersBitAnd() (r int, exists bool) {
+	v := m.andmax_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field.
+func (m *GroupMutation) BitOrMaxUsers(i int) {
+	if m.ormax_users != nil {
+		*m.ormax_users |= i
+	} else {
+		m.ormax_users = &i
+	}
+}
+
+// MaxUsersBitOr returns the value that was applied by BitOrMaxUsers on the "max_users" field in this mutation.
+func (m *GroupMutation) MaxUsersBitOr() (r int, exists bool) {
+	v := m.ormax_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearMaxUsers clears the value of the "max_users" field.
 func (m *GroupMutation) ClearMaxUsers() {
 	m.max_users = nil
 	m.addmax_users = nil
+	m.maxmax_users = nil
+	m.andmax_users = nil
+	m.ormax_users = nil
 	m.clearedFields[group.FieldMaxUsers] = struct{}{}
 }
 
@@ -9269,6 +11851,9 @@ func (m *GroupMutation) MaxUsersCleared() bool {
 func (m *GroupMutation) ResetMaxUsers() {
 	m.max_users = nil
 	m.addmax_users = nil
+	m.maxmax_users = nil
+	m.andmax_users = nil
+	m.ormax_users = nil
 	delete(m.clearedFields, group.FieldMaxUsers)
 }
 
@@ -9514,6 +12099,16 @@ func (m *GroupMutation) Where(ps ...predicate.Group) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *GroupMutation) Op() Op {
 	return m.op
@@ -9877,6 +12472,34 @@ func (m *GroupMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Group edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a GroupPatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *GroupMutation) ToPatch() *GroupPatch {
+	p := &GroupPatch{}
+	if v, ok := m.Active(); ok {
+		p.Active = &v
+	}
+	if v, ok := m.Expire(); ok {
+		p.Expire = &v
+	}
+	if v, ok := m.GetType(); ok {
+		p.Type = &v
+	}
+	if m.TypeCleared() {
+		p.Cleared = append(p.Cleared, group.FieldType)
+	}
+	if v, ok := m.MaxUsers(); ok {
+		p.MaxUsers = &v
+	}
+	if m.MaxUsersCleared() {
+		p.Cleared = append(p.Cleared, group.FieldMaxUsers)
+	}
+	if v, ok := m.Name(); ok {
+		p.Name = &v
+	}
+	return p
+}
+
 // GroupInfoMutation represents an operation that mutates the GroupInfo nodes in the graph.
 type GroupInfoMutation struct {
 	config
@@ -9886,6 +12509,9 @@ type GroupInfoMutation struct {
 	desc          *string
 	max_users     *int
 	addmax_users  *int
+	maxmax_users  *int
+	andmax_users  *int
+	ormax_users   *int
 	clearedFields map[string]struct{}
 	groups        map[int]struct{}
 	removedgroups map[int]struct{}
@@ -10033,6 +12659,9 @@ func (m *GroupInfoMutation) ResetDesc() {
 func (m *GroupInfoMutation) SetMaxUsers(i int) {
 	m.max_users = &i
 	m.addmax_users = nil
+	m.maxmax_users = nil
+	m.andmax_users = nil
+	m.ormax_users = nil
 }
 
 // MaxUsers returns the value of the "max_users" field in the mutation.
@@ -10054,25 +12683,77 @@ func (m *GroupInfoMutation) OldMaxUsers(ctx context.Context) (v int, err error)
 	if m.id == nil || m.oldValue == nil {
 		return v, errors.New("OldMaxUsers requires an ID field in the mutation")
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxUsers: %w", err)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUsers: %w", err)
+	}
+	return oldValue.MaxUsers, nil
+}
+
+// AddMaxUsers adds i to the "max_users" field.
+func (m *GroupInfoMutation) AddMaxUsers(i int) {
+	if m.addmax_users != nil {
+		*m.addmax_users += i
+	} else {
+		m.addmax_users = &i
+	}
+}
+
+// AddedMaxUsers returns the value that was added to the "max_users" field in this mutation.
+func (m *GroupInfoMutation) AddedMaxUsers() (r int, exists bool) {
+	v := m.addmax_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// SetMaxUsersIfGreater sets the "max_users" field to i in the database, only if it is greater than its stored value.
+func (m *GroupInfoMutation) SetMaxUsersIfGreater(i int) {
+	if m.maxmax_users == nil || i > *m.maxmax_users {
+		m.maxmax_users = &i
+	}
+}
+
+// MaxUsersIfGreater returns the value that was set to the "max_users" field by SetMaxUsersIfGreater in this mutation.
+func (m *GroupInfoMutation) MaxUsersIfGreater() (r int, exists bool) {
+	v := m.maxmax_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field.
+func (m *GroupInfoMutation) BitAndMaxUsers(i int) {
+	if m.andmax_users != nil {
+		*m.andmax_users &= i
+	} else {
+		m.andmax_users = &i
+	}
+}
+
+// MaxUsersBitAnd returns the value that was applied by BitAndMaxUsers on the "max_users" field in this mutation.
+func (m *GroupInfoMutation) MaxUsersBitAnd() (r int, exists bool) {
+	v := m.andmax_users
+	if v == nil {
+		return
 	}
-	return oldValue.MaxUsers, nil
+	return *v, true
 }
 
-// AddMaxUsers adds i to the "max_users" field.
-func (m *GroupInfoMutation) AddMaxUsers(i int) {
-	if m.addmax_users != nil {
-		*m.addmax_users += i
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field.
+func (m *GroupInfoMutation) BitOrMaxUsers(i int) {
+	if m.ormax_users != nil {
+		*m.ormax_users |= i
 	} else {
-		m.addmax_users = &i
+		m.ormax_users = &i
 	}
 }
 
-// AddedMaxUsers returns the value that was added to the "max_users" field in this mutation.
-func (m *GroupInfoMutation) AddedMaxUsers() (r int, exists bool) {
-	v := m.addmax_users
+// MaxUsersBitOr returns the value that was applied by BitOrMaxUsers on the "max_users" field in this mutation.
+func (m *GroupInfoMutation) MaxUsersBitOr() (r int, exists bool) {
+	v := m.ormax_users
 	if v == nil {
 		return
 	}
@@ -10083,6 +12764,9 @@ func (m *GroupInfoMutation) AddedMaxUsers() (r int, exists bool) {
 func (m *GroupInfoMutation) ResetMaxUsers() {
 	m.max_users = nil
 	m.addmax_users = nil
+	m.maxmax_users = nil
+	m.andmax_users = nil
+	m.ormax_users = nil
 }
 
 // AddGroupIDs adds the "groups" edge to the Group entity by ids.
@@ -10144,6 +12828,16 @@ func (m *GroupInfoMutation) Where(ps ...predicate.GroupInfo) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the GroupInfoMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupInfoMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupInfo, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *GroupInfoMutation) Op() Op {
 	return m.op
@@ -10371,6 +13065,19 @@ func (m *GroupInfoMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown GroupInfo edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a GroupInfoPatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *GroupInfoMutation) ToPatch() *GroupInfoPatch {
+	p := &GroupInfoPatch{}
+	if v, ok := m.Desc(); ok {
+		p.Desc = &v
+	}
+	if v, ok := m.MaxUsers(); ok {
+		p.MaxUsers = &v
+	}
+	return p
+}
+
 // ItemMutation represents an operation that mutates the Item nodes in the graph.
 type ItemMutation struct {
 	config
@@ -10378,6 +13085,7 @@ type ItemMutation struct {
 	typ           string
 	id            *string
 	text          *string
+	token         *string
 	clearedFields map[string]struct{}
 	done          bool
 	oldValue      func(context.Context) (*Item, error)
@@ -10491,6 +13199,7 @@ func (m *ItemMutation) IDs(ctx context.Context) ([]string, error) {
 // SetText sets the "text" field.
 func (m *ItemMutation) SetText(s string) {
 	m.text = &s
+	delete(m.clearedFields, item.FieldText)
 }
 
 // Text returns the value of the "text" field in the mutation.
@@ -10537,11 +13246,57 @@ func (m *ItemMutation) ResetText() {
 	delete(m.clearedFields, item.FieldText)
 }
 
+// SetToken sets the "token" field.
+func (m *ItemMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *ItemMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *ItemMutation) ResetToken() {
+	m.token = nil
+}
+
 // Where appends a list predicates to the ItemMutation builder.
 func (m *ItemMutation) Where(ps ...predicate.Item) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Item, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *ItemMutation) Op() Op {
 	return m.op
@@ -10556,10 +13311,13 @@ func (m *ItemMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ItemMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+	fields := make([]string, 0, 2)
 	if m.text != nil {
 		fields = append(fields, item.FieldText)
 	}
+	if m.token != nil {
+		fields = append(fields, item.FieldToken)
+	}
 	return fields
 }
 
@@ -10570,6 +13328,8 @@ func (m *ItemMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case item.FieldText:
 		return m.Text()
+	case item.FieldToken:
+		return m.Token()
 	}
 	return nil, false
 }
@@ -10581,6 +13341,8 @@ func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, er
 	switch name {
 	case item.FieldText:
 		return m.OldText(ctx)
+	case item.FieldToken:
+		return m.OldToken(ctx)
 	}
 	return nil, fmt.Errorf("unknown Item field %s", name)
 }
@@ -10597,6 +13359,13 @@ func (m *ItemMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetText(v)
 		return nil
+	case item.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Item field %s", name)
 }
@@ -10658,6 +13427,9 @@ func (m *ItemMutation) ResetField(name string) error {
 	case item.FieldText:
 		m.ResetText()
 		return nil
+	case item.FieldToken:
+		m.ResetToken()
+		return nil
 	}
 	return fmt.Errorf("unknown Item field %s", name)
 }
@@ -10710,6 +13482,19 @@ func (m *ItemMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Item edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a ItemPatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *ItemMutation) ToPatch() *ItemPatch {
+	p := &ItemPatch{}
+	if v, ok := m.Text(); ok {
+		p.Text = &v
+	}
+	if m.TextCleared() {
+		p.Cleared = append(p.Cleared, item.FieldText)
+	}
+	return p
+}
+
 // LicenseMutation represents an operation that mutates the License nodes in the graph.
 type LicenseMutation struct {
 	config
@@ -10831,6 +13616,16 @@ func (m *LicenseMutation) Where(ps ...predicate.License) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the LicenseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LicenseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.License, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *LicenseMutation) Op() Op {
 	return m.op
@@ -10965,6 +13760,13 @@ func (m *LicenseMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown License edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a LicensePatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *LicenseMutation) ToPatch() *LicensePatch {
+	p := &LicensePatch{}
+	return p
+}
+
 // NodeMutation represents an operation that mutates the Node nodes in the graph.
 type NodeMutation struct {
 	config
@@ -10973,6 +13775,9 @@ type NodeMutation struct {
 	id            *int
 	value         *int
 	addvalue      *int
+	maxvalue      *int
+	andvalue      *int
+	orvalue       *int
 	clearedFields map[string]struct{}
 	prev          *int
 	clearedprev   bool
@@ -11085,6 +13890,10 @@ func (m *NodeMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *NodeMutation) SetValue(i int) {
 	m.value = &i
 	m.addvalue = nil
+	m.maxvalue = nil
+	m.andvalue = nil
+	m.orvalue = nil
+	delete(m.clearedFields, node.FieldValue)
 }
 
 // Value returns the value of the "value" field in the mutation.
@@ -11131,10 +13940,65 @@ func (m *NodeMutation) AddedValue() (r int, exists bool) {
 	return *v, true
 }
 
+// SetValueIfGreater sets the "value" field to i in the database, only if it is greater than its stored value.
+func (m *NodeMutation) SetValueIfGreater(i int) {
+	if m.maxvalue == nil || i > *m.maxvalue {
+		m.maxvalue = &i
+	}
+}
+
+// ValueIfGreater returns the value that was set to the "value" field by SetValueIfGreater in this mutation.
+func (m *NodeMutation) ValueIfGreater() (r int, exists bool) {
+	v := m.maxvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndValue applies a bitwise AND with i on the "value" field.
+func (m *NodeMutation) BitAndValue(i int) {
+	if m.andvalue != nil {
+		*m.andvalue &= i
+	} else {
+		m.andvalue = &i
+	}
+}
+
+// ValueBitAnd returns the value that was applied by BitAndValue on the "value" field in this mutation.
+func (m *NodeMutation) ValueBitAnd() (r int, exists bool) {
+	v := m.andvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrValue applies a bitwise OR with i on the "value" field.
+func (m *NodeMutation) BitOrValue(i int) {
+	if m.orvalue != nil {
+		*m.orvalue |= i
+	} else {
+		m.orvalue = &i
+	}
+}
+
+// ValueBitOr returns the value that was applied by BitOrValue on the "value" field in this mutation.
+func (m *NodeMutation) ValueBitOr() (r int, exists bool) {
+	v := m.orvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearValue clears the value of the "value" field.
 func (m *NodeMutation) ClearValue() {
 	m.value = nil
 	m.addvalue = nil
+	m.maxvalue = nil
+	m.andvalue = nil
+	m.orvalue = nil
 	m.clearedFields[node.FieldValue] = struct{}{}
 }
 
@@ -11148,6 +14012,9 @@ func (m *NodeMutation) ValueCleared() bool {
 func (m *NodeMutation) ResetValue() {
 	m.value = nil
 	m.addvalue = nil
+	m.maxvalue = nil
+	m.andvalue = nil
+	m.orvalue = nil
 	delete(m.clearedFields, node.FieldValue)
 }
 
@@ -11234,6 +14101,16 @@ func (m *NodeMutation) Where(ps ...predicate.Node) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Node, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *NodeMutation) Op() Op {
 	return m.op
@@ -11463,6 +14340,19 @@ func (m *NodeMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Node edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a NodePatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *NodeMutation) ToPatch() *NodePatch {
+	p := &NodePatch{}
+	if v, ok := m.Value(); ok {
+		p.Value = &v
+	}
+	if m.ValueCleared() {
+		p.Cleared = append(p.Cleared, node.FieldValue)
+	}
+	return p
+}
+
 // PetMutation represents an operation that mutates the Pet nodes in the graph.
 type PetMutation struct {
 	config
@@ -11471,6 +14361,7 @@ type PetMutation struct {
 	id            *int
 	age           *float64
 	addage        *float64
+	maxage        *float64
 	name          *string
 	uuid          *uuid.UUID
 	nickname      *string
@@ -11587,6 +14478,7 @@ func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *PetMutation) SetAge(f float64) {
 	m.age = &f
 	m.addage = nil
+	m.maxage = nil
 }
 
 // Age returns the value of the "age" field in the mutation.
@@ -11633,10 +14525,27 @@ func (m *PetMutation) AddedAge() (r float64, exists bool) {
 	return *v, true
 }
 
+// SetAgeIfGreater sets the "age" field to f in the database, only if it is greater than its stored value.
+func (m *PetMutation) SetAgeIfGreater(f float64) {
+	if m.maxage == nil || f > *m.maxage {
+		m.maxage = &f
+	}
+}
+
+// AgeIfGreater returns the value that was set to the "age" field by SetAgeIfGreater in this mutation.
+func (m *PetMutation) AgeIfGreater() (r float64, exists bool) {
+	v := m.maxage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetAge resets all changes to the "age" field.
 func (m *PetMutation) ResetAge() {
 	m.age = nil
 	m.addage = nil
+	m.maxage = nil
 }
 
 // SetName sets the "name" field.
@@ -11678,6 +14587,7 @@ func (m *PetMutation) ResetName() {
 // SetUUID sets the "uuid" field.
 func (m *PetMutation) SetUUID(u uuid.UUID) {
 	m.uuid = &u
+	delete(m.clearedFields, pet.FieldUUID)
 }
 
 // UUID returns the value of the "uuid" field in the mutation.
@@ -11727,6 +14637,7 @@ func (m *PetMutation) ResetUUID() {
 // SetNickname sets the "nickname" field.
 func (m *PetMutation) SetNickname(s string) {
 	m.nickname = &s
+	delete(m.clearedFields, pet.FieldNickname)
 }
 
 // Nickname returns the value of the "nickname" field in the mutation.
@@ -11892,6 +14803,16 @@ func (m *PetMutation) Where(ps ...predicate.Pet) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *PetMutation) Op() Op {
 	return m.op
@@ -12195,6 +15116,34 @@ func (m *PetMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Pet edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a PetPatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *PetMutation) ToPatch() *PetPatch {
+	p := &PetPatch{}
+	if v, ok := m.Age(); ok {
+		p.Age = &v
+	}
+	if v, ok := m.Name(); ok {
+		p.Name = &v
+	}
+	if v, ok := m.UUID(); ok {
+		p.UUID = &v
+	}
+	if m.UUIDCleared() {
+		p.Cleared = append(p.Cleared, pet.FieldUUID)
+	}
+	if v, ok := m.Nickname(); ok {
+		p.Nickname = &v
+	}
+	if m.NicknameCleared() {
+		p.Cleared = append(p.Cleared, pet.FieldNickname)
+	}
+	if v, ok := m.Trained(); ok {
+		p.Trained = &v
+	}
+	return p
+}
+
 // SpecMutation represents an operation that mutates the Spec nodes in the graph.
 type SpecMutation struct {
 	config
@@ -12367,6 +15316,16 @@ func (m *SpecMutation) Where(ps ...predicate.Spec) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the SpecMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SpecMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Spec, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *SpecMutation) Op() Op {
 	return m.op
@@ -12537,6 +15496,13 @@ func (m *SpecMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Spec edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a SpecPatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *SpecMutation) ToPatch() *SpecPatch {
+	p := &SpecPatch{}
+	return p
+}
+
 // TaskMutation represents an operation that mutates the Task nodes in the graph.
 type TaskMutation struct {
 	config
@@ -12545,6 +15511,9 @@ type TaskMutation struct {
 	id            *int
 	priority      *task.Priority
 	addpriority   *task.Priority
+	maxpriority   *task.Priority
+	andpriority   *task.Priority
+	orpriority    *task.Priority
 	priorities    *map[string]task.Priority
 	clearedFields map[string]struct{}
 	done          bool
@@ -12654,6 +15623,9 @@ func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *TaskMutation) SetPriority(t task.Priority) {
 	m.priority = &t
 	m.addpriority = nil
+	m.maxpriority = nil
+	m.andpriority = nil
+	m.orpriority = nil
 }
 
 // Priority returns the value of the "priority" field in the mutation.
@@ -12700,15 +15672,71 @@ func (m *TaskMutation) AddedPriority() (r task.Priority, exists bool) {
 	return *v, true
 }
 
+// SetPriorityIfGreater sets the "priority" field to t in the database, only if it is greater than its stored value.
+func (m *TaskMutation) SetPriorityIfGreater(t task.Priority) {
+	if m.maxpriority == nil || t > *m.maxpriority {
+		m.maxpriority = &t
+	}
+}
+
+// PriorityIfGreater returns the value that was set to the "priority" field by SetPriorityIfGreater in this mutation.
+func (m *TaskMutation) PriorityIfGreater() (r task.Priority, exists bool) {
+	v := m.maxpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndPriority applies a bitwise AND with t on the "priority" field.
+func (m *TaskMutation) BitAndPriority(t task.Priority) {
+	if m.andpriority != nil {
+		*m.andpriority &= t
+	} else {
+		m.andpriority = &t
+	}
+}
+
+// PriorityBitAnd returns the value that was applied by BitAndPriority on the "priority" field in this mutation.
+func (m *TaskMutation) PriorityBitAnd() (r task.Priority, exists bool) {
+	v := m.andpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrPriority applies a bitwise OR with t on the "priority" field.
+func (m *TaskMutation) BitOrPriority(t task.Priority) {
+	if m.orpriority != nil {
+		*m.orpriority |= t
+	} else {
+		m.orpriority = &t
+	}
+}
+
+// PriorityBitOr returns the value that was applied by BitOrPriority on the "priority" field in this mutation.
+func (m *TaskMutation) PriorityBitOr() (r task.Priority, exists bool) {
+	v := m.orpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetPriority resets all changes to the "priority" field.
 func (m *TaskMutation) ResetPriority() {
 	m.priority = nil
 	m.addpriority = nil
+	m.maxpriority = nil
+	m.andpriority = nil
+	m.orpriority = nil
 }
 
 // SetPriorities sets the "priorities" field.
 func (m *TaskMutation) SetPriorities(value map[string]task.Priority) {
 	m.priorities = &value
+	delete(m.clearedFields, enttask.FieldPriorities)
 }
 
 // Priorities returns the value of the "priorities" field in the mutation.
@@ -12760,6 +15788,16 @@ func (m *TaskMutation) Where(ps ...predicate.Task) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *TaskMutation) Op() Op {
 	return m.op
@@ -12960,6 +15998,22 @@ func (m *TaskMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Task edge %s", name)
 }
 
+// ToPatch returns the changes of the mutable fields in this mutation as a TaskPatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *TaskMutation) ToPatch() *TaskPatch {
+	p := &TaskPatch{}
+	if v, ok := m.Priority(); ok {
+		p.Priority = &v
+	}
+	if v, ok := m.Priorities(); ok {
+		p.Priorities = &v
+	}
+	if m.PrioritiesCleared() {
+		p.Cleared = append(p.Cleared, enttask.FieldPriorities)
+	}
+	return p
+}
+
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
@@ -12968,8 +16022,14 @@ type UserMutation struct {
 	id               *int
 	optional_int     *int
 	addoptional_int  *int
+	maxoptional_int  *int
+	andoptional_int  *int
+	oroptional_int   *int
 	age              *int
 	addage           *int
+	maxage           *int
+	andage           *int
+	orage            *int
 	name             *string
 	last             *string
 	nickname         *string
@@ -13116,6 +16176,10 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *UserMutation) SetOptionalInt(i int) {
 	m.optional_int = &i
 	m.addoptional_int = nil
+	m.maxoptional_int = nil
+	m.andoptional_int = nil
+	m.oroptional_int = nil
+	delete(m.clearedFields, user.FieldOptionalInt)
 }
 
 // OptionalInt returns the value of the "optional_int" field in the mutation.
@@ -13162,10 +16226,65 @@ func (m *UserMutation) AddedOptionalInt() (r int, exists bool) {
 	return *v, true
 }
 
+// SetOptionalIntIfGreater sets the "optional_int" field to i in the database, only if it is greater than its stored value.
+func (m *UserMutation) SetOptionalIntIfGreater(i int) {
+	if m.maxoptional_int == nil || i > *m.maxoptional_int {
+		m.maxoptional_int = &i
+	}
+}
+
+// OptionalIntIfGreater returns the value that was set to the "optional_int" field by SetOptionalIntIfGreater in this mutation.
+func (m *UserMutation) OptionalIntIfGreater() (r int, exists bool) {
+	v := m.maxoptional_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndOptionalInt applies a bitwise AND with i on the "optional_int" field.
+func (m *UserMutation) BitAndOptionalInt(i int) {
+	if m.andoptional_int != nil {
+		*m.andoptional_int &= i
+	} else {
+		m.andoptional_int = &i
+	}
+}
+
+// OptionalIntBitAnd returns the value that was applied by BitAndOptionalInt on the "optional_int" field in this mutation.
+func (m *UserMutation) OptionalIntBitAnd() (r int, exists bool) {
+	v := m.andoptional_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrOptionalInt applies a bitwise OR with i on the "optional_int" field.
+func (m *UserMutation) BitOrOptionalInt(i int) {
+	if m.oroptional_int != nil {
+		*m.oroptional_int |= i
+	} else {
+		m.oroptional_int = &i
+	}
+}
+
+// OptionalIntBitOr returns the value that was applied by BitOrOptionalInt on the "optional_int" field in this mutation.
+func (m *UserMutation) OptionalIntBitOr() (r int, exists bool) {
+	v := m.oroptional_int
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (m *UserMutation) ClearOptionalInt() {
 	m.optional_int = nil
 	m.addoptional_int = nil
+	m.maxoptional_int = nil
+	m.andoptional_int = nil
+	m.oroptional_int = nil
 	m.clearedFields[user.FieldOptionalInt] = struct{}{}
 }
 
@@ -13179,6 +16298,9 @@ func (m *UserMutation) OptionalIntCleared() bool {
 func (m *UserMutation) ResetOptionalInt() {
 	m.optional_int = nil
 	m.addoptional_int = nil
+	m.maxoptional_int = nil
+	m.andoptional_int = nil
+	m.oroptional_int = nil
 	delete(m.clearedFields, user.FieldOptionalInt)
 }
 
@@ -13186,6 +16308,9 @@ func (m *UserMutation) ResetOptionalInt() {
 func (m *UserMutation) SetAge(i int) {
 	m.age = &i
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 }
 
 // Age returns the value of the "age" field in the mutation.
@@ -13232,10 +16357,65 @@ func (m *UserMutation) AddedAge() (r int, exists bool) {
 	return *v, true
 }
 
+// SetAgeIfGreater sets the "age" field to i in the database, only if it is greater than its stored value.
+func (m *UserMutation) SetAgeIfGreater(i int) {
+	if m.maxage == nil || i > *m.maxage {
+		m.maxage = &i
+	}
+}
+
+// AgeIfGreater returns the value that was set to the "age" field by SetAgeIfGreater in this mutation.
+func (m *UserMutation) AgeIfGreater() (r int, exists bool) {
+	v := m.maxage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field.
+func (m *UserMutation) BitAndAge(i int) {
+	if m.andage != nil {
+		*m.andage &= i
+	} else {
+		m.andage = &i
+	}
+}
+
+// AgeBitAnd returns the value that was applied by BitAndAge on the "age" field in this mutation.
+func (m *UserMutation) AgeBitAnd() (r int, exists bool) {
+	v := m.andage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field.
+func (m *UserMutation) BitOrAge(i int) {
+	if m.orage != nil {
+		*m.orage |= i
+	} else {
+		m.orage = &i
+	}
+}
+
+// AgeBitOr returns the value that was applied by BitOrAge on the "age" field in this mutation.
+func (m *UserMutation) AgeBitOr() (r int, exists bool) {
+	v := m.orage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetAge resets all changes to the "age" field.
 func (m *UserMutation) ResetAge() {
 	m.age = nil
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 }
 
 // SetName sets the "name" field.
@@ -13313,6 +16493,7 @@ func (m *UserMutation) ResetLast() {
 // SetNickname sets the "nickname" field.
 func (m *UserMutation) SetNickname(s string) {
 	m.nickname = &s
+	delete(m.clearedFields, user.FieldNickname)
 }
 
 // Nickname returns the value of the "nickname" field in the mutation.
@@ -13362,6 +16543,7 @@ func (m *UserMutation) ResetNickname() {
 // SetAddress sets the "address" field.
 func (m *UserMutation) SetAddress(s string) {
 	m.address = &s
+	delete(m.clearedFields, user.FieldAddress)
 }
 
 // Address returns the value of the "address" field in the mutation.
@@ -13411,6 +16593,7 @@ func (m *UserMutation) ResetAddress() {
 // SetPhone sets the "phone" field.
 func (m *UserMutation) SetPhone(s string) {
 	m.phone = &s
+	delete(m.clearedFields, user.FieldPhone)
 }
 
 // Phone returns the value of the "phone" field in the mutation.
@@ -13460,6 +16643,7 @@ func (m *UserMutation) ResetPhone() {
 // SetPassword sets the "password" field.
 func (m *UserMutation) SetPassword(s string) {
 	m.password = &s
+	delete(m.clearedFields, user.FieldPassword)
 }
 
 // Password returns the value of the "password" field in the mutation.
@@ -13581,6 +16765,7 @@ func (m *UserMutation) ResetEmployment() {
 // SetSSOCert sets the "SSOCert" field.
 func (m *UserMutation) SetSSOCert(s string) {
 	m._SSOCert = &s
+	delete(m.clearedFields, user.FieldSSOCert)
 }
 
 // SSOCert returns the value of the "SSOCert" field in the mutation.
@@ -14166,6 +17351,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -14824,3 +18019,58 @@ func (m *UserMutation) ResetEdge(name string) error {
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
+
+// ToPatch returns the changes of the mutable fields in this mutation as a UserPatch. It allows
+// hooks to distinguish fields that were not set from fields that were cleared (set to NULL).
+func (m *UserMutation) ToPatch() *UserPatch {
+	p := &UserPatch{}
+	if v, ok := m.OptionalInt(); ok {
+		p.OptionalInt = &v
+	}
+	if m.OptionalIntCleared() {
+		p.Cleared = append(p.Cleared, user.FieldOptionalInt)
+	}
+	if v, ok := m.Age(); ok {
+		p.Age = &v
+	}
+	if v, ok := m.Name(); ok {
+		p.Name = &v
+	}
+	if v, ok := m.Last(); ok {
+		p.Last = &v
+	}
+	if v, ok := m.Nickname(); ok {
+		p.Nickname = &v
+	}
+	if m.NicknameCleared() {
+		p.Cleared = append(p.Cleared, user.FieldNickname)
+	}
+	if v, ok := m.Address(); ok {
+		p.Address = &v
+	}
+	if m.AddressCleared() {
+		p.Cleared = append(p.Cleared, user.FieldAddress)
+	}
+	if v, ok := m.Phone(); ok {
+		p.Phone = &v
+	}
+	if m.PhoneCleared() {
+		p.Cleared = append(p.Cleared, user.FieldPhone)
+	}
+	if v, ok := m.Password(); ok {
+		p.Password = &v
+	}
+	if m.PasswordCleared() {
+		p.Cleared = append(p.Cleared, user.FieldPassword)
+	}
+	if v, ok := m.Employment(); ok {
+		p.Employment = &v
+	}
+	if v, ok := m.SSOCert(); ok {
+		p.SSOCert = &v
+	}
+	if m.SSOCertCleared() {
+		p.Cleared = append(p.Cleared, user.FieldSSOCert)
+	}
+	return p
+}
@@ -7,7 +7,9 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -25,6 +27,9 @@ type Node struct {
 	// The values are being populated by the NodeQuery when eager-loading is set.
 	Edges     NodeEdges `json:"edges"`
 	node_next *int
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[1]bool
 }
 
 // NodeEdges holds the relations/edges for other nodes in the graph.
@@ -151,6 +156,181 @@ func (n *Node) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the Node and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (n *Node) Diff(other *Node) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(n.Value, other.Value) {
+		changes = append(changes, FieldChange{Field: node.FieldValue, Old: n.Value, New: other.Value})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the Node that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (n *Node) ApplyMask(paths ...string) (*Node, error) {
+	if len(paths) == 0 {
+		masked := *n
+		return &masked, nil
+	}
+	masked := &Node{config: n.config, ID: n.ID}
+	// Nested paths of the masked edges. A nil
+	// slice means that the entire edge is masked.
+	edges := make(map[string][]string)
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case node.FieldID:
+		case "value":
+			masked.Value = n.Value
+		case node.EdgePrev, node.EdgeNext:
+			if name == p {
+				edges[name] = nil
+			} else if sub, ok := edges[name]; !ok || sub != nil {
+				edges[name] = append(sub, p[len(name)+1:])
+			}
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type Node", p)
+		}
+	}
+	if sub, ok := edges[node.EdgePrev]; ok {
+		masked.Edges.loadedTypes[0] = n.Edges.loadedTypes[0]
+		if n := n.Edges.Prev; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Prev = mn
+		}
+	}
+	if sub, ok := edges[node.EdgeNext]; ok {
+		masked.Edges.loadedTypes[1] = n.Edges.loadedTypes[1]
+		if n := n.Edges.Next; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Next = mn
+		}
+	}
+	return masked, nil
+}
+
+// NodePatch describes a partial update of a Node entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type NodePatch struct {
+	// Value holds the new value of the "value" field.
+	Value *int `json:"value,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, node.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *NodePatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p NodePatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.Value != nil {
+		fields["value"] = *p.Value
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		case node.FieldValue:
+			fields["value"] = nil
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional Node field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *NodePatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "value":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, node.FieldValue)
+				continue
+			}
+			p.Value = new(int)
+			if err := json.Unmarshal(raw, p.Value); err != nil {
+				return fmt.Errorf("ent: decoding Node field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable Node field %q", name)
+		}
+	}
+	return nil
+}
+
+// Selected reports whether the given field was loaded into the Node, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	n := client.Node.Query().Select(node.FieldValue).FirstX(ctx)
+//	n.Selected(node.FieldValue) // true
+//
+func (n *Node) Selected(field string) bool {
+	switch field {
+	case node.FieldID:
+		return true
+	case node.FieldValue:
+		return n.selectedFields == nil || n.selectedFields[0]
+	}
+	return false
+}
+
+// ValueOrErr returns the value of the "value" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Node.
+func (n *Node) ValueOrErr() (int, error) {
+	if n.selectedFields != nil && !n.selectedFields[0] {
+		var zero int
+		return zero, &NotSelectedError{label: node.Label, field: node.FieldValue}
+	}
+	return n.Value, nil
+}
+
+// selectFields returns the fields of the Node that are included in the given
+// columns, or nil if all of them are included.
+func (*Node) selectFields(columns []string) *[1]bool {
+	var selected [1]bool
+	for _, c := range columns {
+		switch c {
+		case node.FieldValue:
+			selected[0] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // Nodes is a parsable slice of Node.
 type Nodes []*Node
 
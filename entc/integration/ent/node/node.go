@@ -6,6 +6,11 @@
 
 package node
 
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the node type in the database.
 	Label = "node"
@@ -56,4 +61,36 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// fieldInfos describes the fields of the Node type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "value",
+		Column:      FieldValue,
+		StructField: "Value",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Optional:    true,
+	},
+}
+
+// Fields returns the information of the Node fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+}
+
 // comment from another template.
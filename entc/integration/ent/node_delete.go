@@ -95,6 +95,19 @@ func (nd *NodeDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the Node entities that are joined with the rows of the given table, on the given columns
+// of the Node table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.Node.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (nd *NodeDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *NodeDelete {
+	nd.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return nd
+}
+
 // NodeDeleteOne is the builder for deleting a single Node entity.
 type NodeDeleteOne struct {
 	nd *NodeDelete
@@ -107,7 +120,7 @@ func (ndo *NodeDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{node.Label}
+		return &NotFoundError{label: node.Label}
 	default:
 		return nil
 	}
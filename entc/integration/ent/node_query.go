@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -23,16 +26,18 @@ import (
 // NodeQuery is the builder for querying Node entities.
 type NodeQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.Node
-	withPrev   *NodeQuery
-	withNext   *NodeQuery
-	withFKs    bool
-	modifiers  []func(*sql.Selector)
+	limit        *int
+	offset       *int
+	unique       *bool
+	order        []OrderFunc
+	fields       []string
+	inters       []Interceptor
+	predicates   []predicate.Node
+	withPrev     *NodeQuery
+	withNext     *NodeQuery
+	withFKs      bool
+	loadStrategy LoadStrategy
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -71,7 +76,7 @@ func (nq *NodeQuery) Order(o ...OrderFunc) *NodeQuery {
 
 // QueryPrev chains the current query on the "prev" edge.
 func (nq *NodeQuery) QueryPrev() *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -93,7 +98,7 @@ func (nq *NodeQuery) QueryPrev() *NodeQuery {
 
 // QueryNext chains the current query on the "next" edge.
 func (nq *NodeQuery) QueryNext() *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -121,7 +126,7 @@ func (nq *NodeQuery) First(ctx context.Context) (*Node, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{node.Label}
+		return nil, nq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -143,7 +148,7 @@ func (nq *NodeQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{node.Label}
+		err = nq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -162,6 +167,11 @@ func (nq *NodeQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one Node entity is found.
 // Returns a *NotFoundError when no Node entities are found.
 func (nq *NodeQuery) Only(ctx context.Context) (*Node, error) {
+	if nq.singleflight != nil && nq.withPrev == nil && nq.withNext == nil {
+		if _, ok := nq.driver.(*txDriver); !ok {
+			return nq.onlyShared(ctx)
+		}
+	}
 	nodes, err := nq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -170,7 +180,7 @@ func (nq *NodeQuery) Only(ctx context.Context) (*Node, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{node.Label}
+		return nil, nq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{node.Label}
 	}
@@ -197,7 +207,7 @@ func (nq *NodeQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{node.Label}
+		err = nq.sqlNotFound()
 	default:
 		err = &NotSingularError{node.Label}
 	}
@@ -218,7 +228,20 @@ func (nq *NodeQuery) All(ctx context.Context) ([]*Node, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return nq.sqlAll(ctx)
+	if p := nq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return nq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := nq.intercept(ctx, "All", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Node)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Node", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -232,10 +255,20 @@ func (nq *NodeQuery) AllX(ctx context.Context) []*Node {
 
 // IDs executes the query and returns a list of Node IDs.
 func (nq *NodeQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := nq.Select(node.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := nq.intercept(ctx, "IDs", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(node.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -253,7 +286,17 @@ func (nq *NodeQuery) Count(ctx context.Context) (int, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return nq.sqlCount(ctx)
+	v, err := nq.intercept(ctx, "Count", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -270,7 +313,61 @@ func (nq *NodeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return nq.sqlExist(ctx)
+	v, err := nq.intercept(ctx, "Exist", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (nq *NodeQuery) intercept(ctx context.Context, op string, fn func(context.Context, *NodeQuery) (Value, error)) (Value, error) {
+	if len(nq.inters) == 0 {
+		return fn(ctx, nq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeNode,
+		Op:     op,
+		Limit:  nq.limit,
+		Offset: nq.offset,
+		Unique: nq.unique,
+		Fields: nq.fields,
+	}
+	for _, p := range nq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range nq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if nq.withPrev != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, node.EdgePrev)
+		qc.EdgeQueries[node.EdgePrev] = nq.withPrev
+	}
+	if nq.withNext != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, node.EdgeNext)
+		qc.EdgeQueries[node.EdgeNext] = nq.withNext
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*NodeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *NodeQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, nq, qc, qr, nq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -293,20 +390,22 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 		limit:      nq.limit,
 		offset:     nq.offset,
 		order:      append([]OrderFunc{}, nq.order...),
+		inters:     append([]Interceptor{}, nq.inters...),
 		predicates: append([]predicate.Node{}, nq.predicates...),
 		withPrev:   nq.withPrev.Clone(),
 		withNext:   nq.withNext.Clone(),
 		// clone intermediate query.
-		sql:    nq.sql.Clone(),
-		path:   nq.path,
-		unique: nq.unique,
+		sql:          nq.sql.Clone(),
+		path:         nq.path,
+		unique:       nq.unique,
+		loadStrategy: nq.loadStrategy,
 	}
 }
 
 // WithPrev tells the query-builder to eager-load the nodes that are connected to
 // the "prev" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NodeQuery) WithPrev(opts ...func(*NodeQuery)) *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -317,7 +416,7 @@ func (nq *NodeQuery) WithPrev(opts ...func(*NodeQuery)) *NodeQuery {
 // WithNext tells the query-builder to eager-load the nodes that are connected to
 // the "next" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NodeQuery) WithNext(opts ...func(*NodeQuery)) *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -381,6 +480,16 @@ func (nq *NodeQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range nq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, nq); err != nil {
+				return err
+			}
+		}
+	}
 	if nq.path != nil {
 		prev, err := nq.path(ctx)
 		if err != nil {
@@ -407,6 +516,12 @@ func (nq *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, node.ForeignKeys...)
 	}
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[1]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Node).scanValues(nil, columns)
 	}
@@ -414,15 +529,84 @@ func (nq *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 		node := &Node{config: nq.config}
 		nodes = append(nodes, node)
 		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*Node).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(nq.modifiers) > 0 {
 		_spec.Modifiers = nq.modifiers
 	}
+	if query := nq.withPrev; query != nil && query.joinable() {
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		var (
+			joined   = query.sqlQuery(ctx)
+			jcolumns = query.querySpec().Node.Columns
+			scan     = _spec.ScanValues
+			assign   = _spec.Assign
+		)
+		selected := (*Node).selectFields(nil, jcolumns)
+		joined.Select(joined.Columns(jcolumns...)...)
+		_spec.Modifiers = append(_spec.Modifiers[:len(_spec.Modifiers):len(_spec.Modifiers)], func(s *sql.Selector) {
+			s.LeftJoin(joined).On(s.C(node.PrevColumn), joined.C(node.FieldID))
+			for _, c := range jcolumns {
+				s.AppendSelect(sql.As(joined.C(c), node.EdgePrev+"__"+c))
+			}
+		})
+		// The columns of the joined edge are selected last, and are scanned
+		// using nullable scanners, as they are NULL if the edge does not exist.
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			n := len(columns) - len(jcolumns)
+			values, err := scan(columns[:n])
+			if err != nil {
+				return nil, err
+			}
+			jvalues, err := (*Node).scanValues(nil, jcolumns)
+			if err != nil {
+				return nil, err
+			}
+			for i := range jvalues {
+				if s, ok := jvalues[i].(interface{ Scan(interface{}) error }); ok {
+					jvalues[i] = &sql.NullScanner{S: s}
+				}
+			}
+			return append(values, jvalues...), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			n := len(columns) - len(jcolumns)
+			if err := assign(columns[:n], values[:n]); err != nil {
+				return err
+			}
+			if id, ok := values[n].(*sql.NullScanner); ok && !id.Valid {
+				return nil
+			}
+			jvalues := make([]interface{}, len(jcolumns))
+			for i, v := range values[n:] {
+				if s, ok := v.(*sql.NullScanner); ok {
+					v = s.S
+				}
+				jvalues[i] = v
+			}
+			neighbor := &Node{config: nq.config}
+			neighbor.selectedFields = selected
+			if err := neighbor.assignValues(jcolumns, jvalues); err != nil {
+				return err
+			}
+			nodes[len(nodes)-1].Edges.Prev = neighbor
+			return nil
+		}
+		// The edge is loaded by the join, and not by an additional query.
+		clone := *nq
+		clone.withPrev = nil
+		nq = &clone
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, nq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, nq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -510,7 +694,7 @@ func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(nq.fields) > 0 {
 		_spec.Unique = nq.unique != nil && *nq.unique
 	}
-	return sqlgraph.CountNodes(ctx, nq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, nq.queryDriver(), _spec)
 }
 
 func (nq *NodeQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -604,6 +788,234 @@ func (nq *NodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (nq *NodeQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: node.Label}
+	if len(nq.predicates) > 0 {
+		selector := sql.Dialect(nq.driver.Dialect()).Select().From(sql.Table(node.Table))
+		for _, p := range nq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the NodeQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (nq *NodeQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Node, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Node(ps[i]))
+	}
+	nq.predicates = append(nq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (nq *NodeQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := nq.querySpec()
+	if len(nq.modifiers) > 0 {
+		_spec.Modifiers = nq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = nq.unique != nil && *nq.unique
+	return sqlgraph.EstimateNodes(ctx, nq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (nq *NodeQuery) CountEstimateX(ctx context.Context) int {
+	count, err := nq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// Node rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (nq *NodeQuery) Sample(percent float64) *NodeQuery {
+	nq.predicates = append(nq.predicates, sql.Sample(percent))
+	return nq
+}
+
+// NodeIterator iterates over the results of a Node query, and decodes them one at a time.
+type NodeIterator struct {
+	ctx   context.Context
+	query *NodeQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*Node
+	node  *Node
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the Node entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.Node.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (nq *NodeQuery) Iterate(ctx context.Context, opts ...IterateOption) (*NodeIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := nq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &NodeIterator{ctx: ctx, query: nq, batch: o.batch}
+	var (
+		withFKs     = nq.withFKs
+		_spec       = nq.querySpec()
+		loadedTypes = [2]bool{
+			nq.withPrev != nil,
+			nq.withNext != nil,
+		}
+	)
+	if nq.withPrev != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, node.ForeignKeys...)
+	}
+	var (
+		selected *[1]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Node).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Node{config: nq.config}
+		it.nodes = append(it.nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*Node).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(nq.modifiers) > 0 {
+		_spec.Modifiers = nq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, nq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (nq *NodeQuery) iterateLoad(ctx context.Context, nodes []*Node) ([]*Node, error) {
+	query := *nq
+	nq = &query
+	nq.withPrev = nq.withPrev.Clone()
+	nq.withNext = nq.withNext.Clone()
+	if query := nq.withPrev; query != nil {
+		if err := nq.loadPrev(ctx, query, nodes, nil,
+			func(n *Node, e *Node) { n.Edges.Prev = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withNext; query != nil {
+		if err := nq.loadNext(ctx, query, nodes, nil,
+			func(n *Node, e *Node) { n.Edges.Next = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// Next advances the iterator to the next Node, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *NodeIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*Node, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current Node of the iterator.
+func (it *NodeIterator) Value() *Node {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *NodeIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *NodeIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// LoadStrategy sets the strategy for eager-loading the Node entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (nq *NodeQuery) LoadStrategy(s LoadStrategy) *NodeQuery {
+	nq.loadStrategy = s
+	return nq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (nq *NodeQuery) joinable() bool {
+	if nq.loadStrategy != LoadStrategyJoin || nq.limit != nil || nq.offset != nil {
+		return false
+	}
+	if nq.withPrev != nil {
+		return false
+	}
+	if nq.withNext != nil {
+		return false
+	}
+	if len(nq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range nq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -636,6 +1048,342 @@ func (nq *NodeQuery) Modify(modifiers ...func(s *sql.Selector)) *NodeSelect {
 	return nq.Select()
 }
 
+// Project returns a builder for querying the given fields into NodeProjection structs,
+// instead of full Node entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.Node.Query().
+//		Project(node.FieldID, node.FieldValue).
+//		All(ctx)
+//
+func (nq *NodeQuery) Project(fields ...string) *NodeProject {
+	nq.fields = append(nq.fields, fields...)
+	return &NodeProject{query: nq}
+}
+
+// NodeProjection is a lightweight projection of the Node entity that is returned by
+// NodeProject. Fields that were not selected by the query hold their zero values.
+type NodeProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Value holds the value of the "value" field.
+	Value int `json:"value,omitempty"`
+}
+
+// NodeProject is the builder for querying Node fields into projections.
+type NodeProject struct {
+	query *NodeQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (np *NodeProject) All(ctx context.Context) ([]*NodeProjection, error) {
+	if err := np.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := np.query.fields
+	if len(columns) == 0 {
+		columns = node.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := np.query.sqlQuery(ctx).Query()
+	if err := np.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &Node{}
+		result []*NodeProjection
+	)
+	for rows.Next() {
+		*e = Node{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &NodeProjection{
+			ID:    e.ID,
+			Value: e.Value,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (np *NodeProject) AllX(ctx context.Context) []*NodeProjection {
+	items, err := np.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (nq *NodeQuery) onlyShared(ctx context.Context) (*Node, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	nq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *nq
+	kq.sql = nq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if nq.singleflightScope != nil {
+		scope = nq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := nq.singleflight.DoChan(singleflightKey(scope, node.Label, query, args), func() (interface{}, error) {
+		nodes, err := nq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, nq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{node.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*Node)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.Node.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (nq *NodeQuery) SelectMask(paths ...string) *NodeSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case node.FieldID:
+		case node.EdgePrev, node.EdgeNext:
+		case "value":
+			fields = append(fields, node.FieldValue)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, node.FieldID)
+	}
+	return nq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the node.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (nq *NodeQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = node.FieldID
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering Node`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		nq.Order(Asc(column))
+	case DirectionDesc:
+		nq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// NodePage is a page of Node entities returned by the Paginate method.
+type NodePage struct {
+	Nodes []*Node `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of Node entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.Node.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.Node.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (nq *NodeQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*NodePage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case node.FieldID:
+			order.column = node.FieldID
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating Node`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != node.FieldID {
+		order := paginateOrder{name: node.FieldID, column: node.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := nq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &NodePage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case node.FieldID:
+				values[j] = n.ID
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (nq *NodeQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case node.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (nq *NodeQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*Node, error) {
+	if nq.limit != nil {
+		return nq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	nq.limit = &limit
+	defer func() { nq.limit = nil }()
+	nodes, err := nq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: node.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // NodeGroupBy is the group-by builder for Node entities.
 type NodeGroupBy struct {
 	config
@@ -675,7 +1423,7 @@ func (ngb *NodeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ngb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ngb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -721,7 +1469,7 @@ func (ns *NodeSelect) Scan(ctx context.Context, v interface{}) error {
 func (ns *NodeSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ns.sql.Query()
-	if err := ns.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ns.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -52,6 +52,25 @@ func (nu *NodeUpdate) AddValue(i int) *NodeUpdate {
 	return nu
 }
 
+// SetValueIfGreater sets the "value" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (nu *NodeUpdate) SetValueIfGreater(i int) *NodeUpdate {
+	nu.mutation.SetValueIfGreater(i)
+	return nu
+}
+
+// BitAndValue applies a bitwise AND with i on the "value" field in the database.
+func (nu *NodeUpdate) BitAndValue(i int) *NodeUpdate {
+	nu.mutation.BitAndValue(i)
+	return nu
+}
+
+// BitOrValue applies a bitwise OR with i on the "value" field in the database.
+func (nu *NodeUpdate) BitOrValue(i int) *NodeUpdate {
+	nu.mutation.BitOrValue(i)
+	return nu
+}
+
 // ClearValue clears the value of the "value" field.
 func (nu *NodeUpdate) ClearValue() *NodeUpdate {
 	nu.mutation.ClearValue()
@@ -199,6 +218,27 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: node.FieldValue,
 		})
 	}
+	if value, ok := nu.mutation.ValueIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
+	if value, ok := nu.mutation.ValueBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
+	if value, ok := nu.mutation.ValueBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
 	if nu.mutation.ValueCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -277,7 +317,7 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{node.Label}
+			err = &NotFoundError{label: node.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -289,9 +329,18 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // NodeUpdateOne is the builder for updating a single Node entity.
 type NodeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *NodeMutation
+	fields     []string
+	conditions []predicate.Node
+	hooks      []Hook
+	mutation   *NodeMutation
+}
+
+// Where appends a list of conditions to the NodeUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (nuo *NodeUpdateOne) Where(ps ...predicate.Node) *NodeUpdateOne {
+	nuo.conditions = append(nuo.conditions, ps...)
+	return nuo
 }
 
 // SetValue sets the "value" field.
@@ -315,6 +364,25 @@ func (nuo *NodeUpdateOne) AddValue(i int) *NodeUpdateOne {
 	return nuo
 }
 
+// SetValueIfGreater sets the "value" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (nuo *NodeUpdateOne) SetValueIfGreater(i int) *NodeUpdateOne {
+	nuo.mutation.SetValueIfGreater(i)
+	return nuo
+}
+
+// BitAndValue applies a bitwise AND with i on the "value" field in the database.
+func (nuo *NodeUpdateOne) BitAndValue(i int) *NodeUpdateOne {
+	nuo.mutation.BitAndValue(i)
+	return nuo
+}
+
+// BitOrValue applies a bitwise OR with i on the "value" field in the database.
+func (nuo *NodeUpdateOne) BitOrValue(i int) *NodeUpdateOne {
+	nuo.mutation.BitOrValue(i)
+	return nuo
+}
+
 // ClearValue clears the value of the "value" field.
 func (nuo *NodeUpdateOne) ClearValue() *NodeUpdateOne {
 	nuo.mutation.ClearValue()
@@ -478,6 +546,13 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error)
 			}
 		}
 	}
+	if ps := nuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := nuo.mutation.Value(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -492,6 +567,27 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error)
 			Column: node.FieldValue,
 		})
 	}
+	if value, ok := nuo.mutation.ValueIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
+	if value, ok := nuo.mutation.ValueBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
+	if value, ok := nuo.mutation.ValueBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
 	if nuo.mutation.ValueCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -573,7 +669,9 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{node.Label}
+			err = &NotFoundError{label: node.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: node.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -581,3 +679,96 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error)
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the Node entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (nuo *NodeUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "Node.id" for update`)}
+	}
+	return enqueueAsync(ctx, nuo.config, nuo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Node.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (nu *NodeUpdate) SetChanged(changes []FieldChange) (*NodeUpdate, error) {
+	if err := setNodeChanged(nu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return nu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Node.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (nuo *NodeUpdateOne) SetChanged(changes []FieldChange) (*NodeUpdateOne, error) {
+	if err := setNodeChanged(nuo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return nuo, nil
+}
+
+// setNodeChanged applies the given field changes on the Node mutation.
+func setNodeChanged(m *NodeMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the Node entities that are joined with the rows of the given table, on the given columns
+// of the Node table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.Node.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (nu *NodeUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *NodeUpdate {
+	nu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return nu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Node schema.
+func (nu *NodeUpdate) ApplyPatch(p *NodePatch) (*NodeUpdate, error) {
+	if err := applyNodePatch(nu.mutation, p); err != nil {
+		return nil, err
+	}
+	return nu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Node schema.
+func (nuo *NodeUpdateOne) ApplyPatch(p *NodePatch) (*NodeUpdateOne, error) {
+	if err := applyNodePatch(nuo.mutation, p); err != nil {
+		return nil, err
+	}
+	return nuo, nil
+}
+
+// applyNodePatch applies the given patch on the Node mutation.
+func applyNodePatch(m *NodeMutation, p *NodePatch) error {
+	if p.Value != nil {
+		m.SetValue(*p.Value)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
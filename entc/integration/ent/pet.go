@@ -7,7 +7,9 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -36,6 +38,9 @@ type Pet struct {
 	Edges     PetEdges `json:"edges"`
 	user_pets *int
 	user_team *int
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[5]bool
 }
 
 // PetEdges holds the relations/edges for other nodes in the graph.
@@ -215,6 +220,312 @@ func (pe *Pet) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the Pet and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (pe *Pet) Diff(other *Pet) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(pe.Age, other.Age) {
+		changes = append(changes, FieldChange{Field: pet.FieldAge, Old: pe.Age, New: other.Age})
+	}
+	if !reflect.DeepEqual(pe.Name, other.Name) {
+		changes = append(changes, FieldChange{Field: pet.FieldName, Old: pe.Name, New: other.Name})
+	}
+	if !reflect.DeepEqual(pe.UUID, other.UUID) {
+		changes = append(changes, FieldChange{Field: pet.FieldUUID, Old: pe.UUID, New: other.UUID})
+	}
+	if !reflect.DeepEqual(pe.Nickname, other.Nickname) {
+		changes = append(changes, FieldChange{Field: pet.FieldNickname, Old: pe.Nickname, New: other.Nickname})
+	}
+	if !reflect.DeepEqual(pe.Trained, other.Trained) {
+		changes = append(changes, FieldChange{Field: pet.FieldTrained, Old: pe.Trained, New: other.Trained})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the Pet that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (pe *Pet) ApplyMask(paths ...string) (*Pet, error) {
+	if len(paths) == 0 {
+		masked := *pe
+		return &masked, nil
+	}
+	masked := &Pet{config: pe.config, ID: pe.ID}
+	// Nested paths of the masked edges. A nil
+	// slice means that the entire edge is masked.
+	edges := make(map[string][]string)
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case pet.FieldID:
+		case "age":
+			masked.Age = pe.Age
+		case "name":
+			masked.Name = pe.Name
+		case "uuid":
+			masked.UUID = pe.UUID
+		case "nickname":
+			masked.Nickname = pe.Nickname
+		case "trained":
+			masked.Trained = pe.Trained
+		case pet.EdgeTeam, pet.EdgeOwner:
+			if name == p {
+				edges[name] = nil
+			} else if sub, ok := edges[name]; !ok || sub != nil {
+				edges[name] = append(sub, p[len(name)+1:])
+			}
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type Pet", p)
+		}
+	}
+	if sub, ok := edges[pet.EdgeTeam]; ok {
+		masked.Edges.loadedTypes[0] = pe.Edges.loadedTypes[0]
+		if n := pe.Edges.Team; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Team = mn
+		}
+	}
+	if sub, ok := edges[pet.EdgeOwner]; ok {
+		masked.Edges.loadedTypes[1] = pe.Edges.loadedTypes[1]
+		if n := pe.Edges.Owner; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Owner = mn
+		}
+	}
+	return masked, nil
+}
+
+// PetPatch describes a partial update of a Pet entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type PetPatch struct {
+	// Age holds the new value of the "age" field.
+	Age *float64 `json:"age,omitempty"`
+	// Name holds the new value of the "name" field.
+	Name *string `json:"name,omitempty"`
+	// UUID holds the new value of the "uuid" field.
+	UUID *uuid.UUID `json:"uuid,omitempty"`
+	// Nickname holds the new value of the "nickname" field.
+	Nickname *string `json:"nickname,omitempty"`
+	// Trained holds the new value of the "trained" field.
+	Trained *bool `json:"trained,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, pet.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *PetPatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p PetPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.Age != nil {
+		fields["age"] = *p.Age
+	}
+	if p.Name != nil {
+		fields["name"] = *p.Name
+	}
+	if p.UUID != nil {
+		fields["uuid"] = *p.UUID
+	}
+	if p.Nickname != nil {
+		fields["nickname"] = *p.Nickname
+	}
+	if p.Trained != nil {
+		fields["trained"] = *p.Trained
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		case pet.FieldUUID:
+			fields["uuid"] = nil
+		case pet.FieldNickname:
+			fields["nickname"] = nil
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional Pet field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *PetPatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "age":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Pet field %q cannot be null", name)
+			}
+			p.Age = new(float64)
+			if err := json.Unmarshal(raw, p.Age); err != nil {
+				return fmt.Errorf("ent: decoding Pet field %q: %w", name, err)
+			}
+		case "name":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Pet field %q cannot be null", name)
+			}
+			p.Name = new(string)
+			if err := json.Unmarshal(raw, p.Name); err != nil {
+				return fmt.Errorf("ent: decoding Pet field %q: %w", name, err)
+			}
+		case "uuid":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, pet.FieldUUID)
+				continue
+			}
+			p.UUID = new(uuid.UUID)
+			if err := json.Unmarshal(raw, p.UUID); err != nil {
+				return fmt.Errorf("ent: decoding Pet field %q: %w", name, err)
+			}
+		case "nickname":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, pet.FieldNickname)
+				continue
+			}
+			p.Nickname = new(string)
+			if err := json.Unmarshal(raw, p.Nickname); err != nil {
+				return fmt.Errorf("ent: decoding Pet field %q: %w", name, err)
+			}
+		case "trained":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Pet field %q cannot be null", name)
+			}
+			p.Trained = new(bool)
+			if err := json.Unmarshal(raw, p.Trained); err != nil {
+				return fmt.Errorf("ent: decoding Pet field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable Pet field %q", name)
+		}
+	}
+	return nil
+}
+
+// Selected reports whether the given field was loaded into the Pet, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	pe := client.Pet.Query().Select(pet.FieldAge).FirstX(ctx)
+//	pe.Selected(pet.FieldAge) // true
+//
+func (pe *Pet) Selected(field string) bool {
+	switch field {
+	case pet.FieldID:
+		return true
+	case pet.FieldAge:
+		return pe.selectedFields == nil || pe.selectedFields[0]
+	case pet.FieldName:
+		return pe.selectedFields == nil || pe.selectedFields[1]
+	case pet.FieldUUID:
+		return pe.selectedFields == nil || pe.selectedFields[2]
+	case pet.FieldNickname:
+		return pe.selectedFields == nil || pe.selectedFields[3]
+	case pet.FieldTrained:
+		return pe.selectedFields == nil || pe.selectedFields[4]
+	}
+	return false
+}
+
+// AgeOrErr returns the value of the "age" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Pet.
+func (pe *Pet) AgeOrErr() (float64, error) {
+	if pe.selectedFields != nil && !pe.selectedFields[0] {
+		var zero float64
+		return zero, &NotSelectedError{label: pet.Label, field: pet.FieldAge}
+	}
+	return pe.Age, nil
+}
+
+// NameOrErr returns the value of the "name" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Pet.
+func (pe *Pet) NameOrErr() (string, error) {
+	if pe.selectedFields != nil && !pe.selectedFields[1] {
+		var zero string
+		return zero, &NotSelectedError{label: pet.Label, field: pet.FieldName}
+	}
+	return pe.Name, nil
+}
+
+// UUIDOrErr returns the value of the "uuid" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Pet.
+func (pe *Pet) UUIDOrErr() (uuid.UUID, error) {
+	if pe.selectedFields != nil && !pe.selectedFields[2] {
+		var zero uuid.UUID
+		return zero, &NotSelectedError{label: pet.Label, field: pet.FieldUUID}
+	}
+	return pe.UUID, nil
+}
+
+// NicknameOrErr returns the value of the "nickname" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Pet.
+func (pe *Pet) NicknameOrErr() (string, error) {
+	if pe.selectedFields != nil && !pe.selectedFields[3] {
+		var zero string
+		return zero, &NotSelectedError{label: pet.Label, field: pet.FieldNickname}
+	}
+	return pe.Nickname, nil
+}
+
+// TrainedOrErr returns the value of the "trained" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Pet.
+func (pe *Pet) TrainedOrErr() (bool, error) {
+	if pe.selectedFields != nil && !pe.selectedFields[4] {
+		var zero bool
+		return zero, &NotSelectedError{label: pet.Label, field: pet.FieldTrained}
+	}
+	return pe.Trained, nil
+}
+
+// selectFields returns the fields of the Pet that are included in the given
+// columns, or nil if all of them are included.
+func (*Pet) selectFields(columns []string) *[5]bool {
+	var selected [5]bool
+	for _, c := range columns {
+		switch c {
+		case pet.FieldAge:
+			selected[0] = true
+		case pet.FieldName:
+			selected[1] = true
+		case pet.FieldUUID:
+			selected[2] = true
+		case pet.FieldNickname:
+			selected[3] = true
+		case pet.FieldTrained:
+			selected[4] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // Pets is a parsable slice of Pet.
 type Pets []*Pet
 
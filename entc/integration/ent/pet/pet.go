@@ -6,6 +6,11 @@
 
 package pet
 
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the pet type in the database.
 	Label = "pet"
@@ -82,4 +87,69 @@ var (
 	DefaultTrained bool
 )
 
+// fieldInfos describes the fields of the Pet type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "age",
+		Column:      FieldAge,
+		StructField: "Age",
+		Type:        field.TypeFloat64,
+		GoType:      "float64",
+		Default:     true,
+	},
+	{
+		Name:        "name",
+		Column:      FieldName,
+		StructField: "Name",
+		Type:        field.TypeString,
+		GoType:      "string",
+	},
+	{
+		Name:        "uuid",
+		Column:      FieldUUID,
+		StructField: "UUID",
+		Type:        field.TypeUUID,
+		GoType:      "uuid.UUID",
+		Optional:    true,
+	},
+	{
+		Name:        "nickname",
+		Column:      FieldNickname,
+		StructField: "Nickname",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+	},
+	{
+		Name:        "trained",
+		Column:      FieldTrained,
+		StructField: "Trained",
+		Type:        field.TypeBool,
+		GoType:      "bool",
+		Default:     true,
+	},
+}
+
+// Fields returns the information of the Pet fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+	"name",
+	"nickname",
+}
+
 // comment from another template.
@@ -95,6 +95,19 @@ func (pd *PetDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the Pet entities that are joined with the rows of the given table, on the given columns
+// of the Pet table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.Pet.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (pd *PetDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *PetDelete {
+	pd.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return pd
+}
+
 // PetDeleteOne is the builder for deleting a single Pet entity.
 type PetDeleteOne struct {
 	pd *PetDelete
@@ -107,7 +120,7 @@ func (pdo *PetDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{pet.Label}
+		return &NotFoundError{label: pet.Label}
 	default:
 		return nil
 	}
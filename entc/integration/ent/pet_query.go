@@ -8,9 +8,13 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -18,21 +22,24 @@ import (
 	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/user"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // PetQuery is the builder for querying Pet entities.
 type PetQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.Pet
-	withTeam   *UserQuery
-	withOwner  *UserQuery
-	withFKs    bool
-	modifiers  []func(*sql.Selector)
+	limit        *int
+	offset       *int
+	unique       *bool
+	order        []OrderFunc
+	fields       []string
+	inters       []Interceptor
+	predicates   []predicate.Pet
+	withTeam     *UserQuery
+	withOwner    *UserQuery
+	withFKs      bool
+	loadStrategy LoadStrategy
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -71,7 +78,7 @@ func (pq *PetQuery) Order(o ...OrderFunc) *PetQuery {
 
 // QueryTeam chains the current query on the "team" edge.
 func (pq *PetQuery) QueryTeam() *UserQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -93,7 +100,7 @@ func (pq *PetQuery) QueryTeam() *UserQuery {
 
 // QueryOwner chains the current query on the "owner" edge.
 func (pq *PetQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -121,7 +128,7 @@ func (pq *PetQuery) First(ctx context.Context) (*Pet, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{pet.Label}
+		return nil, pq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -143,7 +150,7 @@ func (pq *PetQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{pet.Label}
+		err = pq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -162,6 +169,11 @@ func (pq *PetQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one Pet entity is found.
 // Returns a *NotFoundError when no Pet entities are found.
 func (pq *PetQuery) Only(ctx context.Context) (*Pet, error) {
+	if pq.singleflight != nil && pq.withTeam == nil && pq.withOwner == nil {
+		if _, ok := pq.driver.(*txDriver); !ok {
+			return pq.onlyShared(ctx)
+		}
+	}
 	nodes, err := pq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -170,7 +182,7 @@ func (pq *PetQuery) Only(ctx context.Context) (*Pet, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{pet.Label}
+		return nil, pq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{pet.Label}
 	}
@@ -197,7 +209,7 @@ func (pq *PetQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{pet.Label}
+		err = pq.sqlNotFound()
 	default:
 		err = &NotSingularError{pet.Label}
 	}
@@ -218,7 +230,20 @@ func (pq *PetQuery) All(ctx context.Context) ([]*Pet, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return pq.sqlAll(ctx)
+	if p := pq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return pq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := pq.intercept(ctx, "All", func(ctx context.Context, query *PetQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Pet)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Pet", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -232,10 +257,20 @@ func (pq *PetQuery) AllX(ctx context.Context) []*Pet {
 
 // IDs executes the query and returns a list of Pet IDs.
 func (pq *PetQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := pq.Select(pet.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := pq.intercept(ctx, "IDs", func(ctx context.Context, query *PetQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(pet.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -253,7 +288,17 @@ func (pq *PetQuery) Count(ctx context.Context) (int, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return pq.sqlCount(ctx)
+	v, err := pq.intercept(ctx, "Count", func(ctx context.Context, query *PetQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -270,7 +315,61 @@ func (pq *PetQuery) Exist(ctx context.Context) (bool, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return pq.sqlExist(ctx)
+	v, err := pq.intercept(ctx, "Exist", func(ctx context.Context, query *PetQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (pq *PetQuery) intercept(ctx context.Context, op string, fn func(context.Context, *PetQuery) (Value, error)) (Value, error) {
+	if len(pq.inters) == 0 {
+		return fn(ctx, pq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypePet,
+		Op:     op,
+		Limit:  pq.limit,
+		Offset: pq.offset,
+		Unique: pq.unique,
+		Fields: pq.fields,
+	}
+	for _, p := range pq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range pq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if pq.withTeam != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, pet.EdgeTeam)
+		qc.EdgeQueries[pet.EdgeTeam] = pq.withTeam
+	}
+	if pq.withOwner != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, pet.EdgeOwner)
+		qc.EdgeQueries[pet.EdgeOwner] = pq.withOwner
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *PetQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, pq, qc, qr, pq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -293,20 +392,22 @@ func (pq *PetQuery) Clone() *PetQuery {
 		limit:      pq.limit,
 		offset:     pq.offset,
 		order:      append([]OrderFunc{}, pq.order...),
+		inters:     append([]Interceptor{}, pq.inters...),
 		predicates: append([]predicate.Pet{}, pq.predicates...),
 		withTeam:   pq.withTeam.Clone(),
 		withOwner:  pq.withOwner.Clone(),
 		// clone intermediate query.
-		sql:    pq.sql.Clone(),
-		path:   pq.path,
-		unique: pq.unique,
+		sql:          pq.sql.Clone(),
+		path:         pq.path,
+		unique:       pq.unique,
+		loadStrategy: pq.loadStrategy,
 	}
 }
 
 // WithTeam tells the query-builder to eager-load the nodes that are connected to
 // the "team" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PetQuery) WithTeam(opts ...func(*UserQuery)) *PetQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -317,7 +418,7 @@ func (pq *PetQuery) WithTeam(opts ...func(*UserQuery)) *PetQuery {
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PetQuery) WithOwner(opts ...func(*UserQuery)) *PetQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -381,6 +482,16 @@ func (pq *PetQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range pq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pq); err != nil {
+				return err
+			}
+		}
+	}
 	if pq.path != nil {
 		prev, err := pq.path(ctx)
 		if err != nil {
@@ -407,6 +518,12 @@ func (pq *PetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pet, err
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, pet.ForeignKeys...)
 	}
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[5]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Pet).scanValues(nil, columns)
 	}
@@ -414,15 +531,149 @@ func (pq *PetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pet, err
 		node := &Pet{config: pq.config}
 		nodes = append(nodes, node)
 		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*Pet).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(pq.modifiers) > 0 {
 		_spec.Modifiers = pq.modifiers
 	}
+	if query := pq.withTeam; query != nil && query.joinable() {
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		var (
+			joined   = query.sqlQuery(ctx)
+			jcolumns = query.querySpec().Node.Columns
+			scan     = _spec.ScanValues
+			assign   = _spec.Assign
+		)
+		selected := (*User).selectFields(nil, jcolumns)
+		joined.Select(joined.Columns(jcolumns...)...)
+		_spec.Modifiers = append(_spec.Modifiers[:len(_spec.Modifiers):len(_spec.Modifiers)], func(s *sql.Selector) {
+			s.LeftJoin(joined).On(s.C(pet.TeamColumn), joined.C(user.FieldID))
+			for _, c := range jcolumns {
+				s.AppendSelect(sql.As(joined.C(c), pet.EdgeTeam+"__"+c))
+			}
+		})
+		// The columns of the joined edge are selected last, and are scanned
+		// using nullable scanners, as they are NULL if the edge does not exist.
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			n := len(columns) - len(jcolumns)
+			values, err := scan(columns[:n])
+			if err != nil {
+				return nil, err
+			}
+			jvalues, err := (*User).scanValues(nil, jcolumns)
+			if err != nil {
+				return nil, err
+			}
+			for i := range jvalues {
+				if s, ok := jvalues[i].(interface{ Scan(interface{}) error }); ok {
+					jvalues[i] = &sql.NullScanner{S: s}
+				}
+			}
+			return append(values, jvalues...), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			n := len(columns) - len(jcolumns)
+			if err := assign(columns[:n], values[:n]); err != nil {
+				return err
+			}
+			if id, ok := values[n].(*sql.NullScanner); ok && !id.Valid {
+				return nil
+			}
+			jvalues := make([]interface{}, len(jcolumns))
+			for i, v := range values[n:] {
+				if s, ok := v.(*sql.NullScanner); ok {
+					v = s.S
+				}
+				jvalues[i] = v
+			}
+			neighbor := &User{config: pq.config}
+			neighbor.selectedFields = selected
+			if err := neighbor.assignValues(jcolumns, jvalues); err != nil {
+				return err
+			}
+			nodes[len(nodes)-1].Edges.Team = neighbor
+			return nil
+		}
+		// The edge is loaded by the join, and not by an additional query.
+		clone := *pq
+		clone.withTeam = nil
+		pq = &clone
+	}
+	if query := pq.withOwner; query != nil && query.joinable() {
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		var (
+			joined   = query.sqlQuery(ctx)
+			jcolumns = query.querySpec().Node.Columns
+			scan     = _spec.ScanValues
+			assign   = _spec.Assign
+		)
+		selected := (*User).selectFields(nil, jcolumns)
+		joined.Select(joined.Columns(jcolumns...)...)
+		_spec.Modifiers = append(_spec.Modifiers[:len(_spec.Modifiers):len(_spec.Modifiers)], func(s *sql.Selector) {
+			s.LeftJoin(joined).On(s.C(pet.OwnerColumn), joined.C(user.FieldID))
+			for _, c := range jcolumns {
+				s.AppendSelect(sql.As(joined.C(c), pet.EdgeOwner+"__"+c))
+			}
+		})
+		// The columns of the joined edge are selected last, and are scanned
+		// using nullable scanners, as they are NULL if the edge does not exist.
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			n := len(columns) - len(jcolumns)
+			values, err := scan(columns[:n])
+			if err != nil {
+				return nil, err
+			}
+			jvalues, err := (*User).scanValues(nil, jcolumns)
+			if err != nil {
+				return nil, err
+			}
+			for i := range jvalues {
+				if s, ok := jvalues[i].(interface{ Scan(interface{}) error }); ok {
+					jvalues[i] = &sql.NullScanner{S: s}
+				}
+			}
+			return append(values, jvalues...), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			n := len(columns) - len(jcolumns)
+			if err := assign(columns[:n], values[:n]); err != nil {
+				return err
+			}
+			if id, ok := values[n].(*sql.NullScanner); ok && !id.Valid {
+				return nil
+			}
+			jvalues := make([]interface{}, len(jcolumns))
+			for i, v := range values[n:] {
+				if s, ok := v.(*sql.NullScanner); ok {
+					v = s.S
+				}
+				jvalues[i] = v
+			}
+			neighbor := &User{config: pq.config}
+			neighbor.selectedFields = selected
+			if err := neighbor.assignValues(jcolumns, jvalues); err != nil {
+				return err
+			}
+			nodes[len(nodes)-1].Edges.Owner = neighbor
+			return nil
+		}
+		// The edge is loaded by the join, and not by an additional query.
+		clone := *pq
+		clone.withOwner = nil
+		pq = &clone
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, pq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -511,7 +762,7 @@ func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(pq.fields) > 0 {
 		_spec.Unique = pq.unique != nil && *pq.unique
 	}
-	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, pq.queryDriver(), _spec)
 }
 
 func (pq *PetQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -605,6 +856,373 @@ func (pq *PetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (pq *PetQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: pet.Label}
+	if len(pq.predicates) > 0 {
+		selector := sql.Dialect(pq.driver.Dialect()).Select().From(sql.Table(pet.Table))
+		for _, p := range pq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the PetQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (pq *PetQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Pet, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Pet(ps[i]))
+	}
+	pq.predicates = append(pq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (pq *PetQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := pq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := pq.querySpec()
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = pq.unique != nil && *pq.unique
+	return sqlgraph.EstimateNodes(ctx, pq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (pq *PetQuery) CountEstimateX(ctx context.Context) int {
+	count, err := pq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// Pet rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (pq *PetQuery) Sample(percent float64) *PetQuery {
+	pq.predicates = append(pq.predicates, sql.Sample(percent))
+	return pq
+}
+
+// PetIterator iterates over the results of a Pet query, and decodes them one at a time.
+type PetIterator struct {
+	ctx   context.Context
+	query *PetQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*Pet
+	node  *Pet
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the Pet entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.Pet.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (pq *PetQuery) Iterate(ctx context.Context, opts ...IterateOption) (*PetIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := pq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &PetIterator{ctx: ctx, query: pq, batch: o.batch}
+	var (
+		withFKs     = pq.withFKs
+		_spec       = pq.querySpec()
+		loadedTypes = [2]bool{
+			pq.withTeam != nil,
+			pq.withOwner != nil,
+		}
+	)
+	if pq.withTeam != nil || pq.withOwner != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, pet.ForeignKeys...)
+	}
+	var (
+		selected *[5]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Pet).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Pet{config: pq.config}
+		it.nodes = append(it.nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*Pet).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, pq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (pq *PetQuery) iterateLoad(ctx context.Context, nodes []*Pet) ([]*Pet, error) {
+	query := *pq
+	pq = &query
+	pq.withTeam = pq.withTeam.Clone()
+	pq.withOwner = pq.withOwner.Clone()
+	if query := pq.withTeam; query != nil {
+		if err := pq.loadTeam(ctx, query, nodes, nil,
+			func(n *Pet, e *User) { n.Edges.Team = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withOwner; query != nil {
+		if err := pq.loadOwner(ctx, query, nodes, nil,
+			func(n *Pet, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// Next advances the iterator to the next Pet, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *PetIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*Pet, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current Pet of the iterator.
+func (it *PetIterator) Value() *Pet {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *PetIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *PetIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// Join returns a builder for joining the Pet entities with the entities of the given table, that
+// belongs to one of the types that are connected to Pet by an edge. The results are returned
+// as typed rows holding both entities. Note that the joined entities are queried using their own query
+// builder, and therefore, their privacy policies are applied as usual.
+//
+//	rows, err := client.Pet.Query().
+//		Join(user.Table).
+//		On(left, right).
+//		All(ctx)
+//
+func (pq *PetQuery) Join(table string) *PetJoin {
+	return &PetJoin{query: pq, table: table}
+}
+
+// PetJoinRow is a row that is returned by PetJoin. It holds the Pet entity,
+// and the joined entity in the field of its type. The rest of the fields are nil.
+type PetJoinRow struct {
+	Pet  *Pet
+	User *User
+}
+
+// PetJoin is the builder for joining Pet entities with the entities of another type.
+type PetJoin struct {
+	query   *PetQuery
+	table   string
+	on      []string
+	columns []string
+}
+
+// On sets the columns of the join condition. The left column belongs
+// to Pet, and the right column belongs to the joined table.
+func (pj *PetJoin) On(left, right string) *PetJoin {
+	pj.on = []string{left, right}
+	return pj
+}
+
+// Select sets the columns of the joined table to be selected. By default, all columns are selected.
+func (pj *PetJoin) Select(columns ...string) *PetJoin {
+	pj.columns = append(pj.columns, columns...)
+	return pj
+}
+
+// All executes the join query and returns the joined rows.
+func (pj *PetJoin) All(ctx context.Context) ([]*PetJoinRow, error) {
+	if len(pj.on) != 2 {
+		return nil, &ValidationError{Name: pj.table, err: errors.New("ent: missing join condition for Pet")}
+	}
+	if !pet.ValidColumn(pj.on[0]) {
+		return nil, &ValidationError{Name: pj.on[0], err: fmt.Errorf("ent: invalid join column %q for Pet", pj.on[0])}
+	}
+	var (
+		joined   *sql.Selector
+		jcolumns []string
+		scan     func(*PetJoinRow) ([]interface{}, func([]interface{}) error, error)
+	)
+	switch pj.table {
+	case user.Table:
+		if !user.ValidColumn(pj.on[1]) {
+			return nil, &ValidationError{Name: pj.on[1], err: fmt.Errorf("ent: invalid join column %q for User", pj.on[1])}
+		}
+		query := (&UserClient{config: pj.query.config}).Query()
+		if len(pj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{user.FieldID, pj.on[1]}, pj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = user.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *PetJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.User = &User{config: pj.query.config}
+			values, err := r.User.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.User.assignValues(jcolumns, values) }, err
+		}
+	default:
+		return nil, &ValidationError{Name: pj.table, err: fmt.Errorf("ent: cannot join Pet with table %q", pj.table)}
+	}
+	if err := pj.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := pj.query.fields
+	if len(columns) == 0 {
+		columns = pet.Columns
+	}
+	selector := pj.query.sqlQuery(ctx)
+	selector.Join(joined).On(selector.C(pj.on[0]), joined.C(pj.on[1]))
+	selector.AppendSelect(joined.Columns(jcolumns...)...)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pj.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*PetJoinRow
+	for rows.Next() {
+		r := &PetJoinRow{Pet: &Pet{config: pj.query.config}}
+		values, err := r.Pet.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		jvalues, assign, err := scan(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(append(values, jvalues...)...); err != nil {
+			return nil, err
+		}
+		if err := r.Pet.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		if err := assign(jvalues); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pj *PetJoin) AllX(ctx context.Context) []*PetJoinRow {
+	rows, err := pj.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// LoadStrategy sets the strategy for eager-loading the Pet entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (pq *PetQuery) LoadStrategy(s LoadStrategy) *PetQuery {
+	pq.loadStrategy = s
+	return pq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (pq *PetQuery) joinable() bool {
+	if pq.loadStrategy != LoadStrategyJoin || pq.limit != nil || pq.offset != nil {
+		return false
+	}
+	if pq.withTeam != nil {
+		return false
+	}
+	if pq.withOwner != nil {
+		return false
+	}
+	if len(pq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range pq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -637,6 +1255,374 @@ func (pq *PetQuery) Modify(modifiers ...func(s *sql.Selector)) *PetSelect {
 	return pq.Select()
 }
 
+// Project returns a builder for querying the given fields into PetProjection structs,
+// instead of full Pet entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.Pet.Query().
+//		Project(pet.FieldID, pet.FieldAge).
+//		All(ctx)
+//
+func (pq *PetQuery) Project(fields ...string) *PetProject {
+	pq.fields = append(pq.fields, fields...)
+	return &PetProject{query: pq}
+}
+
+// PetProjection is a lightweight projection of the Pet entity that is returned by
+// PetProject. Fields that were not selected by the query hold their zero values.
+type PetProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Age holds the value of the "age" field.
+	Age float64 `json:"age,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UUID holds the value of the "uuid" field.
+	UUID uuid.UUID `json:"uuid,omitempty"`
+	// Nickname holds the value of the "nickname" field.
+	Nickname string `json:"nickname,omitempty"`
+	// Trained holds the value of the "trained" field.
+	Trained bool `json:"trained,omitempty"`
+}
+
+// PetProject is the builder for querying Pet fields into projections.
+type PetProject struct {
+	query *PetQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (pp *PetProject) All(ctx context.Context) ([]*PetProjection, error) {
+	if err := pp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := pp.query.fields
+	if len(columns) == 0 {
+		columns = pet.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := pp.query.sqlQuery(ctx).Query()
+	if err := pp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &Pet{}
+		result []*PetProjection
+	)
+	for rows.Next() {
+		*e = Pet{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &PetProjection{
+			ID:       e.ID,
+			Age:      e.Age,
+			Name:     e.Name,
+			UUID:     e.UUID,
+			Nickname: e.Nickname,
+			Trained:  e.Trained,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pp *PetProject) AllX(ctx context.Context) []*PetProjection {
+	items, err := pp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (pq *PetQuery) onlyShared(ctx context.Context) (*Pet, error) {
+	if err := pq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	pq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *pq
+	kq.sql = pq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if pq.singleflightScope != nil {
+		scope = pq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := pq.singleflight.DoChan(singleflightKey(scope, pet.Label, query, args), func() (interface{}, error) {
+		nodes, err := pq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, pq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{pet.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*Pet)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.Pet.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (pq *PetQuery) SelectMask(paths ...string) *PetSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case pet.FieldID:
+		case pet.EdgeTeam, pet.EdgeOwner:
+		case "age":
+			fields = append(fields, pet.FieldAge)
+		case "name":
+			fields = append(fields, pet.FieldName)
+		case "uuid":
+			fields = append(fields, pet.FieldUUID)
+		case "nickname":
+			fields = append(fields, pet.FieldNickname)
+		case "trained":
+			fields = append(fields, pet.FieldTrained)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, pet.FieldID)
+	}
+	return pq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the pet.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (pq *PetQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = pet.FieldID
+	case "name":
+		column = pet.FieldName
+	case "nickname":
+		column = pet.FieldNickname
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering Pet`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		pq.Order(Asc(column))
+	case DirectionDesc:
+		pq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// PetPage is a page of Pet entities returned by the Paginate method.
+type PetPage struct {
+	Nodes []*Pet `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of Pet entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.Pet.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.Pet.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (pq *PetQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*PetPage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case pet.FieldID:
+			order.column = pet.FieldID
+		case pet.FieldName:
+			order.column = pet.FieldName
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating Pet`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != pet.FieldID {
+		order := paginateOrder{name: pet.FieldID, column: pet.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := pq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &PetPage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case pet.FieldID:
+				values[j] = n.ID
+			case pet.FieldName:
+				values[j] = n.Name
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (pq *PetQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case pet.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		case pet.FieldName:
+			var v string
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (pq *PetQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*Pet, error) {
+	if pq.limit != nil {
+		return pq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	pq.limit = &limit
+	defer func() { pq.limit = nil }()
+	nodes, err := pq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: pet.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // PetGroupBy is the group-by builder for Pet entities.
 type PetGroupBy struct {
 	config
@@ -676,7 +1662,7 @@ func (pgb *PetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := pgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := pgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -722,7 +1708,7 @@ func (ps *PetSelect) Scan(ctx context.Context, v interface{}) error {
 func (ps *PetSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ps.sql.Query()
-	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ps.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -54,6 +54,13 @@ func (pu *PetUpdate) AddAge(f float64) *PetUpdate {
 	return pu
 }
 
+// SetAgeIfGreater sets the "age" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (pu *PetUpdate) SetAgeIfGreater(f float64) *PetUpdate {
+	pu.mutation.SetAgeIfGreater(f)
+	return pu
+}
+
 // SetName sets the "name" field.
 func (pu *PetUpdate) SetName(s string) *PetUpdate {
 	pu.mutation.SetName(s)
@@ -255,6 +262,13 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: pet.FieldAge,
 		})
 	}
+	if value, ok := pu.mutation.AgeIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pet.FieldAge,
+		})
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -367,7 +381,7 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{pet.Label}
+			err = &NotFoundError{label: pet.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -379,9 +393,18 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // PetUpdateOne is the builder for updating a single Pet entity.
 type PetUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PetMutation
+	fields     []string
+	conditions []predicate.Pet
+	hooks      []Hook
+	mutation   *PetMutation
+}
+
+// Where appends a list of conditions to the PetUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (puo *PetUpdateOne) Where(ps ...predicate.Pet) *PetUpdateOne {
+	puo.conditions = append(puo.conditions, ps...)
+	return puo
 }
 
 // SetAge sets the "age" field.
@@ -405,6 +428,13 @@ func (puo *PetUpdateOne) AddAge(f float64) *PetUpdateOne {
 	return puo
 }
 
+// SetAgeIfGreater sets the "age" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (puo *PetUpdateOne) SetAgeIfGreater(f float64) *PetUpdateOne {
+	puo.mutation.SetAgeIfGreater(f)
+	return puo
+}
+
 // SetName sets the "name" field.
 func (puo *PetUpdateOne) SetName(s string) *PetUpdateOne {
 	puo.mutation.SetName(s)
@@ -622,6 +652,13 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 			}
 		}
 	}
+	if ps := puo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := puo.mutation.Age(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeFloat64,
@@ -636,6 +673,13 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 			Column: pet.FieldAge,
 		})
 	}
+	if value, ok := puo.mutation.AgeIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pet.FieldAge,
+		})
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -751,7 +795,9 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{pet.Label}
+			err = &NotFoundError{label: pet.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: pet.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -759,3 +805,108 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the Pet entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (puo *PetUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "Pet.id" for update`)}
+	}
+	return enqueueAsync(ctx, puo.config, puo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Pet.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (pu *PetUpdate) SetChanged(changes []FieldChange) (*PetUpdate, error) {
+	if err := setPetChanged(pu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return pu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Pet.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (puo *PetUpdateOne) SetChanged(changes []FieldChange) (*PetUpdateOne, error) {
+	if err := setPetChanged(puo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return puo, nil
+}
+
+// setPetChanged applies the given field changes on the Pet mutation.
+func setPetChanged(m *PetMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the Pet entities that are joined with the rows of the given table, on the given columns
+// of the Pet table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.Pet.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (pu *PetUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *PetUpdate {
+	pu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return pu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Pet schema.
+func (pu *PetUpdate) ApplyPatch(p *PetPatch) (*PetUpdate, error) {
+	if err := applyPetPatch(pu.mutation, p); err != nil {
+		return nil, err
+	}
+	return pu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Pet schema.
+func (puo *PetUpdateOne) ApplyPatch(p *PetPatch) (*PetUpdateOne, error) {
+	if err := applyPetPatch(puo.mutation, p); err != nil {
+		return nil, err
+	}
+	return puo, nil
+}
+
+// applyPetPatch applies the given patch on the Pet mutation.
+func applyPetPatch(m *PetMutation, p *PetPatch) error {
+	if p.Age != nil {
+		m.SetAge(*p.Age)
+	}
+	if p.Name != nil {
+		m.SetName(*p.Name)
+	}
+	if p.UUID != nil {
+		m.SetUUID(*p.UUID)
+	}
+	if p.Nickname != nil {
+		m.SetNickname(*p.Nickname)
+	}
+	if p.Trained != nil {
+		m.SetTrained(*p.Trained)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
@@ -214,6 +214,10 @@ func init() {
 	itemDescText := itemFields[1].Descriptor()
 	// item.TextValidator is a validator for the "text" field. It is called by the builders before save.
 	item.TextValidator = itemDescText.Validators[0].(func(string) error)
+	// itemDescToken is the schema descriptor for token field.
+	itemDescToken := itemFields[2].Descriptor()
+	// item.DefaultToken holds the default value on creation for the token field.
+	item.DefaultToken = itemDescToken.Default.(func() string)
 	// itemDescID is the schema descriptor for id field.
 	itemDescID := itemFields[0].Descriptor()
 	// item.DefaultID holds the default value on creation for the id field.
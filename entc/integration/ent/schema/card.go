@@ -51,6 +51,7 @@ func (Card) Annotations() []schema.Annotation {
 				"id": `json:"-"`,
 			},
 		},
+		schema.Comment("Card holds the payment cards of the users."),
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -59,7 +60,9 @@ func (Group) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("files", File.Type),
 		edge.To("blocked", User.Type),
-		edge.From("users", User.Type).Ref("groups"),
+		edge.From("users", User.Type).
+			Ref("groups").
+			Annotations(entsql.OrderField("-name", "id")),
 		edge.To("info", GroupInfo.Type).Unique().Required(),
 	}
 }
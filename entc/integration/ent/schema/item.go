@@ -6,6 +6,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema/entfield"
 	"entgo.io/ent/schema/field"
 
 	"github.com/google/uuid"
@@ -26,5 +27,8 @@ func (Item) Fields() []ent.Field {
 			MaxLen(128).
 			Unique().
 			Optional(),
+		field.String("token").
+			DefaultFunc(uuid.NewString).
+			Annotations(entfield.ReadOnlyAPI()),
 	}
 }
@@ -5,8 +5,13 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/entfield"
+	"entgo.io/ent/schema/entlineage"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
 )
@@ -22,6 +27,13 @@ func (User) Mixin() []ent.Mixin {
 	}
 }
 
+// Annotations of the user.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entlineage.Owner("identity"),
+	}
+}
+
 // Fields of the user.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
@@ -39,13 +51,19 @@ func (User) Fields() []ent.Field {
 			DefaultFunc(func() string { return "static" }),
 		field.String("phone").
 			Optional().
-			Unique(),
+			Unique().
+			Annotations(entlineage.Classify(entlineage.Confidential)),
 		field.String("password").
 			Optional().
-			Sensitive(),
+			Sensitive().
+			Annotations(
+				entlineage.Classify(entlineage.Restricted),
+				entlineage.Retention(90*24*time.Hour),
+			),
 		field.Enum("role").
 			Values("user", "admin", "free-user", "test user").
-			Default("user"),
+			Default("user").
+			Annotations(entfield.ReadOnlyAPI()),
 		field.Enum("employment").
 			Values("Full-Time", "Part-Time", "Contract").
 			Default("Full-Time"),
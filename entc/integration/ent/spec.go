@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -108,6 +109,105 @@ func (s *Spec) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the Spec and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (s *Spec) Diff(other *Spec) []FieldChange {
+	var changes []FieldChange
+	return changes
+}
+
+// ApplyMask returns a copy of the Spec that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (s *Spec) ApplyMask(paths ...string) (*Spec, error) {
+	if len(paths) == 0 {
+		masked := *s
+		return &masked, nil
+	}
+	masked := &Spec{config: s.config, ID: s.ID}
+	// Nested paths of the masked edges. A nil
+	// slice means that the entire edge is masked.
+	edges := make(map[string][]string)
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case spec.FieldID:
+		case spec.EdgeCard:
+			if name == p {
+				edges[name] = nil
+			} else if sub, ok := edges[name]; !ok || sub != nil {
+				edges[name] = append(sub, p[len(name)+1:])
+			}
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type Spec", p)
+		}
+	}
+	if sub, ok := edges[spec.EdgeCard]; ok {
+		masked.Edges.loadedTypes[0] = s.Edges.loadedTypes[0]
+		for _, n := range s.Edges.Card {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Card = append(masked.Edges.Card, mn)
+		}
+	}
+	return masked, nil
+}
+
+// SpecPatch describes a partial update of a Spec entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type SpecPatch struct {
+	// Cleared holds the names of the fields that are cleared. For example, spec.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *SpecPatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p SpecPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	for _, c := range p.Cleared {
+		switch c {
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional Spec field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *SpecPatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name := range fields {
+		switch name {
+		default:
+			return fmt.Errorf("ent: unknown or immutable Spec field %q", name)
+		}
+	}
+	return nil
+}
+
 // NamedCard returns the Card named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (s *Spec) NamedCard(name string) ([]*Card, error) {
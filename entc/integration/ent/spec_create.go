@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -167,6 +168,62 @@ func (sc *SpecCreate) createSpec() (*Spec, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// SpecInsertSelect is the builder for creating Spec entities from the result of a query.
+type SpecInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the Spec table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+func (sis *SpecInsertSelect) Columns(columns ...string) *SpecInsertSelect {
+	sis.columns = append(sis.columns, columns...)
+	return sis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (sis *SpecInsertSelect) Exec(ctx context.Context) (int, error) {
+	if sis.query == nil {
+		return 0, errors.New("ent: missing query for Spec.CreateFromQuery")
+	}
+	if err := sis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := sis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := sis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !spec.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for Spec.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: spec.Table, Columns: spec.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, sis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sis *SpecInsertSelect) ExecX(ctx context.Context) int {
+	n, err := sis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -204,6 +261,7 @@ type (
 	//  one Spec node.
 	SpecUpsertOne struct {
 		create *SpecCreate
+		err    error // first validation error of the update values.
 	}
 
 	// SpecUpsert is the "OnConflict" setter.
@@ -256,6 +314,9 @@ func (u *SpecUpsertOne) Update(set func(*SpecUpsert)) *SpecUpsertOne {
 
 // Exec executes the query.
 func (u *SpecUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for SpecCreate.OnConflict")
 	}
@@ -264,13 +325,16 @@ func (u *SpecUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *SpecUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *SpecUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -287,6 +351,43 @@ func (u *SpecUpsertOne) IDX(ctx context.Context) int {
 	return id
 }
 
+// SaveAsync adds the creation of the Spec entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (sc *SpecCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, sc.config, sc.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the Spec creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (sc *SpecCreate) IdempotencyKey(key string) *SpecCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &sc.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*Spec)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from SpecMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id int
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&SpecClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	sc.hooks = append([]Hook{hook}, sc.hooks...)
+	return sc
+}
+
 // SpecCreateBulk is the builder for creating many Spec entities in bulk.
 type SpecCreateBulk struct {
 	config
@@ -372,6 +473,78 @@ func (scb *SpecCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// SpecBulkResult holds the result of creating one of the Spec entities using SpecCreateBulk.ContinueOnError.
+type SpecBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *Spec
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the Spec entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.Spec.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (scb *SpecCreateBulk) ContinueOnError(ctx context.Context) ([]SpecBulkResult, error) {
+	client := &Client{config: scb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]SpecBulkResult, len(scb.builders))
+	for i, builder := range scb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = scb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -408,6 +581,7 @@ func (scb *SpecCreateBulk) OnConflictColumns(columns ...string) *SpecUpsertBulk
 // a bulk of Spec nodes.
 type SpecUpsertBulk struct {
 	create *SpecCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -454,6 +628,9 @@ func (u *SpecUpsertBulk) Update(set func(*SpecUpsert)) *SpecUpsertBulk {
 
 // Exec executes the query.
 func (u *SpecUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SpecCreateBulk instead", i)
@@ -467,7 +644,7 @@ func (u *SpecUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *SpecUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -95,6 +95,19 @@ func (sd *SpecDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the Spec entities that are joined with the rows of the given table, on the given columns
+// of the Spec table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.Spec.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (sd *SpecDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *SpecDelete {
+	sd.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return sd
+}
+
 // SpecDeleteOne is the builder for deleting a single Spec entity.
 type SpecDeleteOne struct {
 	sd *SpecDelete
@@ -107,7 +120,7 @@ func (sdo *SpecDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{spec.Label}
+		return &NotFoundError{label: spec.Label}
 	default:
 		return nil
 	}
@@ -9,9 +9,13 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -29,8 +33,10 @@ type SpecQuery struct {
 	unique        *bool
 	order         []OrderFunc
 	fields        []string
+	inters        []Interceptor
 	predicates    []predicate.Spec
 	withCard      *CardQuery
+	loadStrategy  LoadStrategy
 	modifiers     []func(*sql.Selector)
 	withNamedCard map[string]*CardQuery
 	// intermediate query (i.e. traversal path).
@@ -71,7 +77,7 @@ func (sq *SpecQuery) Order(o ...OrderFunc) *SpecQuery {
 
 // QueryCard chains the current query on the "card" edge.
 func (sq *SpecQuery) QueryCard() *CardQuery {
-	query := &CardQuery{config: sq.config}
+	query := (&CardClient{config: sq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := sq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -99,7 +105,7 @@ func (sq *SpecQuery) First(ctx context.Context) (*Spec, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{spec.Label}
+		return nil, sq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -121,7 +127,7 @@ func (sq *SpecQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{spec.Label}
+		err = sq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -140,6 +146,11 @@ func (sq *SpecQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one Spec entity is found.
 // Returns a *NotFoundError when no Spec entities are found.
 func (sq *SpecQuery) Only(ctx context.Context) (*Spec, error) {
+	if sq.singleflight != nil && sq.withCard == nil && len(sq.withNamedCard) == 0 {
+		if _, ok := sq.driver.(*txDriver); !ok {
+			return sq.onlyShared(ctx)
+		}
+	}
 	nodes, err := sq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -148,7 +159,7 @@ func (sq *SpecQuery) Only(ctx context.Context) (*Spec, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{spec.Label}
+		return nil, sq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{spec.Label}
 	}
@@ -175,7 +186,7 @@ func (sq *SpecQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{spec.Label}
+		err = sq.sqlNotFound()
 	default:
 		err = &NotSingularError{spec.Label}
 	}
@@ -196,7 +207,20 @@ func (sq *SpecQuery) All(ctx context.Context) ([]*Spec, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return sq.sqlAll(ctx)
+	if p := sq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return sq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := sq.intercept(ctx, "All", func(ctx context.Context, query *SpecQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Spec)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Spec", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -210,10 +234,20 @@ func (sq *SpecQuery) AllX(ctx context.Context) []*Spec {
 
 // IDs executes the query and returns a list of Spec IDs.
 func (sq *SpecQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := sq.Select(spec.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := sq.intercept(ctx, "IDs", func(ctx context.Context, query *SpecQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(spec.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -231,7 +265,17 @@ func (sq *SpecQuery) Count(ctx context.Context) (int, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return sq.sqlCount(ctx)
+	v, err := sq.intercept(ctx, "Count", func(ctx context.Context, query *SpecQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -248,7 +292,54 @@ func (sq *SpecQuery) Exist(ctx context.Context) (bool, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return sq.sqlExist(ctx)
+	v, err := sq.intercept(ctx, "Exist", func(ctx context.Context, query *SpecQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (sq *SpecQuery) intercept(ctx context.Context, op string, fn func(context.Context, *SpecQuery) (Value, error)) (Value, error) {
+	if len(sq.inters) == 0 {
+		return fn(ctx, sq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeSpec,
+		Op:     op,
+		Limit:  sq.limit,
+		Offset: sq.offset,
+		Unique: sq.unique,
+		Fields: sq.fields,
+	}
+	for _, p := range sq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range sq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if sq.withCard != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, spec.EdgeCard)
+		qc.EdgeQueries[spec.EdgeCard] = sq.withCard
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*SpecQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *SpecQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, sq, qc, qr, sq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -271,19 +362,21 @@ func (sq *SpecQuery) Clone() *SpecQuery {
 		limit:      sq.limit,
 		offset:     sq.offset,
 		order:      append([]OrderFunc{}, sq.order...),
+		inters:     append([]Interceptor{}, sq.inters...),
 		predicates: append([]predicate.Spec{}, sq.predicates...),
 		withCard:   sq.withCard.Clone(),
 		// clone intermediate query.
-		sql:    sq.sql.Clone(),
-		path:   sq.path,
-		unique: sq.unique,
+		sql:          sq.sql.Clone(),
+		path:         sq.path,
+		unique:       sq.unique,
+		loadStrategy: sq.loadStrategy,
 	}
 }
 
 // WithCard tells the query-builder to eager-load the nodes that are connected to
 // the "card" edge. The optional arguments are used to configure the query builder of the edge.
 func (sq *SpecQuery) WithCard(opts ...func(*CardQuery)) *SpecQuery {
-	query := &CardQuery{config: sq.config}
+	query := (&CardClient{config: sq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -323,6 +416,16 @@ func (sq *SpecQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range sq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sq); err != nil {
+				return err
+			}
+		}
+	}
 	if sq.path != nil {
 		prev, err := sq.path(ctx)
 		if err != nil {
@@ -356,7 +459,7 @@ func (sq *SpecQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Spec, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, sq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, sq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -444,7 +547,7 @@ func (sq *SpecQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(sq.fields) > 0 {
 		_spec.Unique = sq.unique != nil && *sq.unique
 	}
-	return sqlgraph.CountNodes(ctx, sq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, sq.queryDriver(), _spec)
 }
 
 func (sq *SpecQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -538,6 +641,361 @@ func (sq *SpecQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (sq *SpecQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: spec.Label}
+	if len(sq.predicates) > 0 {
+		selector := sql.Dialect(sq.driver.Dialect()).Select().From(sql.Table(spec.Table))
+		for _, p := range sq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the SpecQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (sq *SpecQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Spec, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Spec(ps[i]))
+	}
+	sq.predicates = append(sq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (sq *SpecQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := sq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := sq.querySpec()
+	if len(sq.modifiers) > 0 {
+		_spec.Modifiers = sq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = sq.unique != nil && *sq.unique
+	return sqlgraph.EstimateNodes(ctx, sq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (sq *SpecQuery) CountEstimateX(ctx context.Context) int {
+	count, err := sq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// Spec rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (sq *SpecQuery) Sample(percent float64) *SpecQuery {
+	sq.predicates = append(sq.predicates, sql.Sample(percent))
+	return sq
+}
+
+// SpecIterator iterates over the results of a Spec query, and decodes them one at a time.
+type SpecIterator struct {
+	ctx   context.Context
+	query *SpecQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*Spec
+	node  *Spec
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the Spec entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.Spec.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (sq *SpecQuery) Iterate(ctx context.Context, opts ...IterateOption) (*SpecIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := sq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &SpecIterator{ctx: ctx, query: sq, batch: o.batch}
+	var (
+		_spec       = sq.querySpec()
+		loadedTypes = [1]bool{
+			sq.withCard != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Spec).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Spec{config: sq.config}
+		it.nodes = append(it.nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(sq.modifiers) > 0 {
+		_spec.Modifiers = sq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, sq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (sq *SpecQuery) iterateLoad(ctx context.Context, nodes []*Spec) ([]*Spec, error) {
+	query := *sq
+	sq = &query
+	sq.withCard = sq.withCard.Clone()
+	if named := sq.withNamedCard; named != nil {
+		sq.withNamedCard = make(map[string]*CardQuery, len(named))
+		for name, q := range named {
+			sq.withNamedCard[name] = q.Clone()
+		}
+	}
+	if query := sq.withCard; query != nil {
+		if err := sq.loadCard(ctx, query, nodes,
+			func(n *Spec) { n.Edges.Card = []*Card{} },
+			func(n *Spec, e *Card) { n.Edges.Card = append(n.Edges.Card, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedCard {
+		if err := sq.loadCard(ctx, query, nodes,
+			func(n *Spec) { n.appendNamedCard(name) },
+			func(n *Spec, e *Card) { n.appendNamedCard(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// Next advances the iterator to the next Spec, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *SpecIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*Spec, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current Spec of the iterator.
+func (it *SpecIterator) Value() *Spec {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *SpecIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *SpecIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// Join returns a builder for joining the Spec entities with the entities of the given table, that
+// belongs to one of the types that are connected to Spec by an edge. The results are returned
+// as typed rows holding both entities. Note that the joined entities are queried using their own query
+// builder, and therefore, their privacy policies are applied as usual.
+//
+//	rows, err := client.Spec.Query().
+//		Join(card.Table).
+//		On(left, right).
+//		All(ctx)
+//
+func (sq *SpecQuery) Join(table string) *SpecJoin {
+	return &SpecJoin{query: sq, table: table}
+}
+
+// SpecJoinRow is a row that is returned by SpecJoin. It holds the Spec entity,
+// and the joined entity in the field of its type. The rest of the fields are nil.
+type SpecJoinRow struct {
+	Spec *Spec
+	Card *Card
+}
+
+// SpecJoin is the builder for joining Spec entities with the entities of another type.
+type SpecJoin struct {
+	query   *SpecQuery
+	table   string
+	on      []string
+	columns []string
+}
+
+// On sets the columns of the join condition. The left column belongs
+// to Spec, and the right column belongs to the joined table.
+func (sj *SpecJoin) On(left, right string) *SpecJoin {
+	sj.on = []string{left, right}
+	return sj
+}
+
+// Select sets the columns of the joined table to be selected. By default, all columns are selected.
+func (sj *SpecJoin) Select(columns ...string) *SpecJoin {
+	sj.columns = append(sj.columns, columns...)
+	return sj
+}
+
+// All executes the join query and returns the joined rows.
+func (sj *SpecJoin) All(ctx context.Context) ([]*SpecJoinRow, error) {
+	if len(sj.on) != 2 {
+		return nil, &ValidationError{Name: sj.table, err: errors.New("ent: missing join condition for Spec")}
+	}
+	if !spec.ValidColumn(sj.on[0]) {
+		return nil, &ValidationError{Name: sj.on[0], err: fmt.Errorf("ent: invalid join column %q for Spec", sj.on[0])}
+	}
+	var (
+		joined   *sql.Selector
+		jcolumns []string
+		scan     func(*SpecJoinRow) ([]interface{}, func([]interface{}) error, error)
+	)
+	switch sj.table {
+	case card.Table:
+		if !card.ValidColumn(sj.on[1]) {
+			return nil, &ValidationError{Name: sj.on[1], err: fmt.Errorf("ent: invalid join column %q for Card", sj.on[1])}
+		}
+		query := (&CardClient{config: sj.query.config}).Query()
+		if len(sj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{card.FieldID, sj.on[1]}, sj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = card.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *SpecJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.Card = &Card{config: sj.query.config}
+			values, err := r.Card.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.Card.assignValues(jcolumns, values) }, err
+		}
+	default:
+		return nil, &ValidationError{Name: sj.table, err: fmt.Errorf("ent: cannot join Spec with table %q", sj.table)}
+	}
+	if err := sj.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := sj.query.fields
+	if len(columns) == 0 {
+		columns = spec.Columns
+	}
+	selector := sj.query.sqlQuery(ctx)
+	selector.Join(joined).On(selector.C(sj.on[0]), joined.C(sj.on[1]))
+	selector.AppendSelect(joined.Columns(jcolumns...)...)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sj.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*SpecJoinRow
+	for rows.Next() {
+		r := &SpecJoinRow{Spec: &Spec{config: sj.query.config}}
+		values, err := r.Spec.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		jvalues, assign, err := scan(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(append(values, jvalues...)...); err != nil {
+			return nil, err
+		}
+		if err := r.Spec.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		if err := assign(jvalues); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sj *SpecJoin) AllX(ctx context.Context) []*SpecJoinRow {
+	rows, err := sj.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// LoadStrategy sets the strategy for eager-loading the Spec entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (sq *SpecQuery) LoadStrategy(s LoadStrategy) *SpecQuery {
+	sq.loadStrategy = s
+	return sq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (sq *SpecQuery) joinable() bool {
+	if sq.loadStrategy != LoadStrategyJoin || sq.limit != nil || sq.offset != nil {
+		return false
+	}
+	if sq.withCard != nil || len(sq.withNamedCard) > 0 {
+		return false
+	}
+	if len(sq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range sq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -573,7 +1031,7 @@ func (sq *SpecQuery) Modify(modifiers ...func(s *sql.Selector)) *SpecSelect {
 // WithNamedCard tells the query-builder to eager-load the nodes that are connected to the "card"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (sq *SpecQuery) WithNamedCard(name string, opts ...func(*CardQuery)) *SpecQuery {
-	query := &CardQuery{config: sq.config}
+	query := (&CardClient{config: sq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -584,6 +1042,337 @@ func (sq *SpecQuery) WithNamedCard(name string, opts ...func(*CardQuery)) *SpecQ
 	return sq
 }
 
+// Project returns a builder for querying the given fields into SpecProjection structs,
+// instead of full Spec entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.Spec.Query().
+//		Project(spec.FieldID).
+//		All(ctx)
+//
+func (sq *SpecQuery) Project(fields ...string) *SpecProject {
+	sq.fields = append(sq.fields, fields...)
+	return &SpecProject{query: sq}
+}
+
+// SpecProjection is a lightweight projection of the Spec entity that is returned by
+// SpecProject. Fields that were not selected by the query hold their zero values.
+type SpecProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+}
+
+// SpecProject is the builder for querying Spec fields into projections.
+type SpecProject struct {
+	query *SpecQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (sp *SpecProject) All(ctx context.Context) ([]*SpecProjection, error) {
+	if err := sp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := sp.query.fields
+	if len(columns) == 0 {
+		columns = spec.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := sp.query.sqlQuery(ctx).Query()
+	if err := sp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &Spec{}
+		result []*SpecProjection
+	)
+	for rows.Next() {
+		*e = Spec{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &SpecProjection{
+			ID: e.ID,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sp *SpecProject) AllX(ctx context.Context) []*SpecProjection {
+	items, err := sp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (sq *SpecQuery) onlyShared(ctx context.Context) (*Spec, error) {
+	if err := sq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	sq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *sq
+	kq.sql = sq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if sq.singleflightScope != nil {
+		scope = sq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := sq.singleflight.DoChan(singleflightKey(scope, spec.Label, query, args), func() (interface{}, error) {
+		nodes, err := sq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, sq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{spec.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*Spec)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.Spec.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (sq *SpecQuery) SelectMask(paths ...string) *SpecSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case spec.FieldID:
+		case spec.EdgeCard:
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, spec.FieldID)
+	}
+	return sq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the spec.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (sq *SpecQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = spec.FieldID
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering Spec`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		sq.Order(Asc(column))
+	case DirectionDesc:
+		sq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// SpecPage is a page of Spec entities returned by the Paginate method.
+type SpecPage struct {
+	Nodes []*Spec `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of Spec entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.Spec.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.Spec.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (sq *SpecQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*SpecPage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case spec.FieldID:
+			order.column = spec.FieldID
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating Spec`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != spec.FieldID {
+		order := paginateOrder{name: spec.FieldID, column: spec.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := sq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &SpecPage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case spec.FieldID:
+				values[j] = n.ID
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (sq *SpecQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case spec.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (sq *SpecQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*Spec, error) {
+	if sq.limit != nil {
+		return sq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	sq.limit = &limit
+	defer func() { sq.limit = nil }()
+	nodes, err := sq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: spec.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // SpecGroupBy is the group-by builder for Spec entities.
 type SpecGroupBy struct {
 	config
@@ -623,7 +1412,7 @@ func (sgb *SpecGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := sgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := sgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -669,7 +1458,7 @@ func (ss *SpecSelect) Scan(ctx context.Context, v interface{}) error {
 func (ss *SpecSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ss.sql.Query()
-	if err := ss.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ss.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -201,7 +201,7 @@ func (su *SpecUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{spec.Label}
+			err = &NotFoundError{label: spec.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -213,9 +213,18 @@ func (su *SpecUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // SpecUpdateOne is the builder for updating a single Spec entity.
 type SpecUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *SpecMutation
+	fields     []string
+	conditions []predicate.Spec
+	hooks      []Hook
+	mutation   *SpecMutation
+}
+
+// Where appends a list of conditions to the SpecUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (suo *SpecUpdateOne) Where(ps ...predicate.Spec) *SpecUpdateOne {
+	suo.conditions = append(suo.conditions, ps...)
+	return suo
 }
 
 // AddCardIDs adds the "card" edge to the Card entity by IDs.
@@ -361,6 +370,13 @@ func (suo *SpecUpdateOne) sqlSave(ctx context.Context) (_node *Spec, err error)
 			}
 		}
 	}
+	if ps := suo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if suo.mutation.CardCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -420,7 +436,9 @@ func (suo *SpecUpdateOne) sqlSave(ctx context.Context) (_node *Spec, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{spec.Label}
+			err = &NotFoundError{label: spec.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: spec.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -428,3 +446,93 @@ func (suo *SpecUpdateOne) sqlSave(ctx context.Context) (_node *Spec, err error)
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the Spec entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (suo *SpecUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "Spec.id" for update`)}
+	}
+	return enqueueAsync(ctx, suo.config, suo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Spec.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (su *SpecUpdate) SetChanged(changes []FieldChange) (*SpecUpdate, error) {
+	if err := setSpecChanged(su.mutation, changes); err != nil {
+		return nil, err
+	}
+	return su, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Spec.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (suo *SpecUpdateOne) SetChanged(changes []FieldChange) (*SpecUpdateOne, error) {
+	if err := setSpecChanged(suo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return suo, nil
+}
+
+// setSpecChanged applies the given field changes on the Spec mutation.
+func setSpecChanged(m *SpecMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the Spec entities that are joined with the rows of the given table, on the given columns
+// of the Spec table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.Spec.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (su *SpecUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *SpecUpdate {
+	su.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return su
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Spec schema.
+func (su *SpecUpdate) ApplyPatch(p *SpecPatch) (*SpecUpdate, error) {
+	if err := applySpecPatch(su.mutation, p); err != nil {
+		return nil, err
+	}
+	return su, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Spec schema.
+func (suo *SpecUpdateOne) ApplyPatch(p *SpecPatch) (*SpecUpdateOne, error) {
+	if err := applySpecPatch(suo.mutation, p); err != nil {
+		return nil, err
+	}
+	return suo, nil
+}
+
+// applySpecPatch applies the given patch on the Spec mutation.
+func applySpecPatch(m *SpecMutation, p *SpecPatch) error {
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
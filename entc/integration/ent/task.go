@@ -9,6 +9,7 @@ package ent
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -26,6 +27,9 @@ type Task struct {
 	Priority task.Priority `json:"priority,omitempty"`
 	// Priorities holds the value of the "priorities" field.
 	Priorities map[string]task.Priority `json:"priorities,omitempty"`
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[2]bool
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -109,6 +113,184 @@ func (t *Task) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the Task and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (t *Task) Diff(other *Task) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(t.Priority, other.Priority) {
+		changes = append(changes, FieldChange{Field: enttask.FieldPriority, Old: t.Priority, New: other.Priority})
+	}
+	if !reflect.DeepEqual(t.Priorities, other.Priorities) {
+		changes = append(changes, FieldChange{Field: enttask.FieldPriorities, Old: t.Priorities, New: other.Priorities})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the Task that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (t *Task) ApplyMask(paths ...string) (*Task, error) {
+	if len(paths) == 0 {
+		masked := *t
+		return &masked, nil
+	}
+	masked := &Task{config: t.config, ID: t.ID}
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case enttask.FieldID:
+		case "priority":
+			masked.Priority = t.Priority
+		case "priorities":
+			masked.Priorities = t.Priorities
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type Task", p)
+		}
+	}
+	return masked, nil
+}
+
+// TaskPatch describes a partial update of a Task entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type TaskPatch struct {
+	// Priority holds the new value of the "priority" field.
+	Priority *task.Priority `json:"priority,omitempty"`
+	// Priorities holds the new value of the "priorities" field.
+	Priorities *map[string]task.Priority `json:"priorities,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, enttask.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *TaskPatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p TaskPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.Priority != nil {
+		fields["priority"] = *p.Priority
+	}
+	if p.Priorities != nil {
+		fields["priorities"] = *p.Priorities
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		case enttask.FieldPriorities:
+			fields["priorities"] = nil
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional Task field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *TaskPatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "priority":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: Task field %q cannot be null", name)
+			}
+			p.Priority = new(task.Priority)
+			if err := json.Unmarshal(raw, p.Priority); err != nil {
+				return fmt.Errorf("ent: decoding Task field %q: %w", name, err)
+			}
+		case "priorities":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, enttask.FieldPriorities)
+				continue
+			}
+			p.Priorities = new(map[string]task.Priority)
+			if err := json.Unmarshal(raw, p.Priorities); err != nil {
+				return fmt.Errorf("ent: decoding Task field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable Task field %q", name)
+		}
+	}
+	return nil
+}
+
+// Selected reports whether the given field was loaded into the Task, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	t := client.Task.Query().Select(enttask.FieldPriority).FirstX(ctx)
+//	t.Selected(enttask.FieldPriority) // true
+//
+func (t *Task) Selected(field string) bool {
+	switch field {
+	case enttask.FieldID:
+		return true
+	case enttask.FieldPriority:
+		return t.selectedFields == nil || t.selectedFields[0]
+	case enttask.FieldPriorities:
+		return t.selectedFields == nil || t.selectedFields[1]
+	}
+	return false
+}
+
+// PriorityOrErr returns the value of the "priority" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Task.
+func (t *Task) PriorityOrErr() (task.Priority, error) {
+	if t.selectedFields != nil && !t.selectedFields[0] {
+		var zero task.Priority
+		return zero, &NotSelectedError{label: enttask.Label, field: enttask.FieldPriority}
+	}
+	return t.Priority, nil
+}
+
+// PrioritiesOrErr returns the value of the "priorities" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the Task.
+func (t *Task) PrioritiesOrErr() (map[string]task.Priority, error) {
+	if t.selectedFields != nil && !t.selectedFields[1] {
+		var zero map[string]task.Priority
+		return zero, &NotSelectedError{label: enttask.Label, field: enttask.FieldPriorities}
+	}
+	return t.Priorities, nil
+}
+
+// selectFields returns the fields of the Task that are included in the given
+// columns, or nil if all of them are included.
+func (*Task) selectFields(columns []string) *[2]bool {
+	var selected [2]bool
+	for _, c := range columns {
+		switch c {
+		case enttask.FieldPriority:
+			selected[0] = true
+		case enttask.FieldPriorities:
+			selected[1] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // Tasks is a parsable slice of Task.
 type Tasks []*Task
 
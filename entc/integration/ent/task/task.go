@@ -7,7 +7,9 @@
 package enttask
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/ent/schema/task"
+	"entgo.io/ent/schema/field"
 )
 
 const (
@@ -47,4 +49,44 @@ var (
 	PriorityValidator func(int) error
 )
 
+// fieldInfos describes the fields of the Task type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+	},
+	{
+		Name:        "priority",
+		Column:      FieldPriority,
+		StructField: "Priority",
+		Type:        field.TypeInt,
+		GoType:      "task.Priority",
+		Default:     true,
+	},
+	{
+		Name:        "priorities",
+		Column:      FieldPriorities,
+		StructField: "Priorities",
+		Type:        field.TypeJSON,
+		GoType:      "map[string]task.Priority",
+		Optional:    true,
+	},
+}
+
+// Fields returns the information of the Task fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+}
+
 // comment from another template.
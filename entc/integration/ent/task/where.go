@@ -8,6 +8,7 @@ package enttask
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/schema/task"
 )
@@ -175,6 +176,126 @@ func PrioritiesNotNil() predicate.Task {
 	})
 }
 
+// PrioritiesHasKey applies the HasKey predicate on the "priorities" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func PrioritiesHasKey(path string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldPriorities), sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesValueIsNull applies the ValueIsNull predicate on the "priorities" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func PrioritiesValueIsNull(path string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldPriorities), sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesValueEQ applies the ValueEQ predicate on the JSON value of the "priorities" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesValueEQ(path string, v interface{}) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldPriorities), v, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesValueNEQ applies the ValueNEQ predicate on the JSON value of the "priorities" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesValueNEQ(path string, v interface{}) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldPriorities), v, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesValueGT applies the ValueGT predicate on the JSON value of the "priorities" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesValueGT(path string, v interface{}) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldPriorities), v, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesValueGTE applies the ValueGTE predicate on the JSON value of the "priorities" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesValueGTE(path string, v interface{}) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldPriorities), v, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesValueLT applies the ValueLT predicate on the JSON value of the "priorities" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesValueLT(path string, v interface{}) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldPriorities), v, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesValueLTE applies the ValueLTE predicate on the JSON value of the "priorities" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesValueLTE(path string, v interface{}) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldPriorities), v, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesValueContains applies the ValueContains predicate on the JSON value of the "priorities" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesValueContains(path string, v interface{}) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldPriorities), v, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesLenEQ applies the LenEQ predicate on the length of the JSON array of the "priorities"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesLenEQ(path string, n int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldPriorities), n, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "priorities"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesLenNEQ(path string, n int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldPriorities), n, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesLenGT applies the LenGT predicate on the length of the JSON array of the "priorities"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesLenGT(path string, n int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldPriorities), n, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesLenGTE applies the LenGTE predicate on the length of the JSON array of the "priorities"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesLenGTE(path string, n int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldPriorities), n, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesLenLT applies the LenLT predicate on the length of the JSON array of the "priorities"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesLenLT(path string, n int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldPriorities), n, sqljson.DotPath(path)))
+	})
+}
+
+// PrioritiesLenLTE applies the LenLTE predicate on the length of the JSON array of the "priorities"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func PrioritiesLenLTE(path string, n int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldPriorities), n, sqljson.DotPath(path)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Task) predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
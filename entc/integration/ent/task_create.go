@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -187,6 +188,68 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// TaskInsertSelect is the builder for creating Task entities from the result of a query.
+type TaskInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the Task table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+// For example:
+//
+//	client.Task.CreateFromQuery(query.Select(...)).
+//		Columns(enttask.FieldPriority).
+//		Exec(ctx)
+//
+func (tis *TaskInsertSelect) Columns(columns ...string) *TaskInsertSelect {
+	tis.columns = append(tis.columns, columns...)
+	return tis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (tis *TaskInsertSelect) Exec(ctx context.Context) (int, error) {
+	if tis.query == nil {
+		return 0, errors.New("ent: missing query for Task.CreateFromQuery")
+	}
+	if err := tis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := tis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := tis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !enttask.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for Task.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: enttask.Table, Columns: enttask.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, tis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tis *TaskInsertSelect) ExecX(ctx context.Context) int {
+	n, err := tis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -230,6 +293,7 @@ type (
 	//  one Task node.
 	TaskUpsertOne struct {
 		create *TaskCreate
+		err    error // first validation error of the update values.
 	}
 
 	// TaskUpsert is the "OnConflict" setter.
@@ -316,8 +380,12 @@ func (u *TaskUpsertOne) Update(set func(*TaskUpsert)) *TaskUpsertOne {
 	return u
 }
 
-// SetPriority sets the "priority" field.
+// SetPriority sets the "priority" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *TaskUpsertOne) SetPriority(v task.Priority) *TaskUpsertOne {
+	if err := enttask.PriorityValidator(int(v)); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+	}
 	return u.Update(func(s *TaskUpsert) {
 		s.SetPriority(v)
 	})
@@ -360,6 +428,9 @@ func (u *TaskUpsertOne) ClearPriorities() *TaskUpsertOne {
 
 // Exec executes the query.
 func (u *TaskUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for TaskCreate.OnConflict")
 	}
@@ -368,13 +439,16 @@ func (u *TaskUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TaskUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *TaskUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -391,6 +465,43 @@ func (u *TaskUpsertOne) IDX(ctx context.Context) int {
 	return id
 }
 
+// SaveAsync adds the creation of the Task entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (tc *TaskCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, tc.config, tc.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the Task creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (tc *TaskCreate) IdempotencyKey(key string) *TaskCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &tc.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*Task)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from TaskMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id int
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&TaskClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	tc.hooks = append([]Hook{hook}, tc.hooks...)
+	return tc
+}
+
 // TaskCreateBulk is the builder for creating many Task entities in bulk.
 type TaskCreateBulk struct {
 	config
@@ -477,6 +588,78 @@ func (tcb *TaskCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// TaskBulkResult holds the result of creating one of the Task entities using TaskCreateBulk.ContinueOnError.
+type TaskBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *Task
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the Task entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.Task.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (tcb *TaskCreateBulk) ContinueOnError(ctx context.Context) ([]TaskBulkResult, error) {
+	client := &Client{config: tcb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]TaskBulkResult, len(tcb.builders))
+	for i, builder := range tcb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = tcb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -518,6 +701,7 @@ func (tcb *TaskCreateBulk) OnConflictColumns(columns ...string) *TaskUpsertBulk
 // a bulk of Task nodes.
 type TaskUpsertBulk struct {
 	create *TaskCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -562,8 +746,12 @@ func (u *TaskUpsertBulk) Update(set func(*TaskUpsert)) *TaskUpsertBulk {
 	return u
 }
 
-// SetPriority sets the "priority" field.
+// SetPriority sets the "priority" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *TaskUpsertBulk) SetPriority(v task.Priority) *TaskUpsertBulk {
+	if err := enttask.PriorityValidator(int(v)); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+	}
 	return u.Update(func(s *TaskUpsert) {
 		s.SetPriority(v)
 	})
@@ -606,6 +794,9 @@ func (u *TaskUpsertBulk) ClearPriorities() *TaskUpsertBulk {
 
 // Exec executes the query.
 func (u *TaskUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskCreateBulk instead", i)
@@ -619,7 +810,7 @@ func (u *TaskUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *TaskUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
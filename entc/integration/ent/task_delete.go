@@ -96,6 +96,19 @@ func (td *TaskDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the Task entities that are joined with the rows of the given table, on the given columns
+// of the Task table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.Task.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (td *TaskDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *TaskDelete {
+	td.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return td
+}
+
 // TaskDeleteOne is the builder for deleting a single Task entity.
 type TaskDeleteOne struct {
 	td *TaskDelete
@@ -108,7 +121,7 @@ func (tdo *TaskDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{enttask.Label}
+		return &NotFoundError{label: enttask.Label}
 	default:
 		return nil
 	}
@@ -8,13 +8,17 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/predicate"
+	"entgo.io/ent/entc/integration/ent/schema/task"
 	"entgo.io/ent/schema/field"
 
 	enttask "entgo.io/ent/entc/integration/ent/task"
@@ -23,13 +27,15 @@ import (
 // TaskQuery is the builder for querying Task entities.
 type TaskQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.Task
-	modifiers  []func(*sql.Selector)
+	limit        *int
+	offset       *int
+	unique       *bool
+	order        []OrderFunc
+	fields       []string
+	inters       []Interceptor
+	predicates   []predicate.Task
+	loadStrategy LoadStrategy
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -74,7 +80,7 @@ func (tq *TaskQuery) First(ctx context.Context) (*Task, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{enttask.Label}
+		return nil, tq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -96,7 +102,7 @@ func (tq *TaskQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{enttask.Label}
+		err = tq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -115,6 +121,11 @@ func (tq *TaskQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one Task entity is found.
 // Returns a *NotFoundError when no Task entities are found.
 func (tq *TaskQuery) Only(ctx context.Context) (*Task, error) {
+	if tq.singleflight != nil {
+		if _, ok := tq.driver.(*txDriver); !ok {
+			return tq.onlyShared(ctx)
+		}
+	}
 	nodes, err := tq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -123,7 +134,7 @@ func (tq *TaskQuery) Only(ctx context.Context) (*Task, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{enttask.Label}
+		return nil, tq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{enttask.Label}
 	}
@@ -150,7 +161,7 @@ func (tq *TaskQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{enttask.Label}
+		err = tq.sqlNotFound()
 	default:
 		err = &NotSingularError{enttask.Label}
 	}
@@ -171,7 +182,20 @@ func (tq *TaskQuery) All(ctx context.Context) ([]*Task, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.sqlAll(ctx)
+	if p := tq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return tq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := tq.intercept(ctx, "All", func(ctx context.Context, query *TaskQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Task)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Task", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -185,10 +209,20 @@ func (tq *TaskQuery) AllX(ctx context.Context) []*Task {
 
 // IDs executes the query and returns a list of Task IDs.
 func (tq *TaskQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := tq.Select(enttask.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := tq.intercept(ctx, "IDs", func(ctx context.Context, query *TaskQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(enttask.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -206,7 +240,17 @@ func (tq *TaskQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.sqlCount(ctx)
+	v, err := tq.intercept(ctx, "Count", func(ctx context.Context, query *TaskQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -223,7 +267,47 @@ func (tq *TaskQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.sqlExist(ctx)
+	v, err := tq.intercept(ctx, "Exist", func(ctx context.Context, query *TaskQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (tq *TaskQuery) intercept(ctx context.Context, op string, fn func(context.Context, *TaskQuery) (Value, error)) (Value, error) {
+	if len(tq.inters) == 0 {
+		return fn(ctx, tq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeTask,
+		Op:     op,
+		Limit:  tq.limit,
+		Offset: tq.offset,
+		Unique: tq.unique,
+		Fields: tq.fields,
+	}
+	for _, p := range tq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range tq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *TaskQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, tq, qc, qr, tq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -246,11 +330,13 @@ func (tq *TaskQuery) Clone() *TaskQuery {
 		limit:      tq.limit,
 		offset:     tq.offset,
 		order:      append([]OrderFunc{}, tq.order...),
+		inters:     append([]Interceptor{}, tq.inters...),
 		predicates: append([]predicate.Task{}, tq.predicates...),
 		// clone intermediate query.
-		sql:    tq.sql.Clone(),
-		path:   tq.path,
-		unique: tq.unique,
+		sql:          tq.sql.Clone(),
+		path:         tq.path,
+		unique:       tq.unique,
+		loadStrategy: tq.loadStrategy,
 	}
 }
 
@@ -310,6 +396,16 @@ func (tq *TaskQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range tq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tq); err != nil {
+				return err
+			}
+		}
+	}
 	if tq.path != nil {
 		prev, err := tq.path(ctx)
 		if err != nil {
@@ -325,12 +421,22 @@ func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 		nodes = []*Task{}
 		_spec = tq.querySpec()
 	)
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[2]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Task).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []interface{}) error {
 		node := &Task{config: tq.config}
 		nodes = append(nodes, node)
+		if !computed {
+			selected, computed = (*Task).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(tq.modifiers) > 0 {
@@ -339,7 +445,7 @@ func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, tq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, tq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -357,7 +463,7 @@ func (tq *TaskQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(tq.fields) > 0 {
 		_spec.Unique = tq.unique != nil && *tq.unique
 	}
-	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, tq.queryDriver(), _spec)
 }
 
 func (tq *TaskQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -451,6 +557,200 @@ func (tq *TaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (tq *TaskQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: enttask.Label}
+	if len(tq.predicates) > 0 {
+		selector := sql.Dialect(tq.driver.Dialect()).Select().From(sql.Table(enttask.Table))
+		for _, p := range tq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the TaskQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (tq *TaskQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Task, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Task(ps[i]))
+	}
+	tq.predicates = append(tq.predicates, wps...)
+}
+
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (tq *TaskQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := tq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	_spec := tq.querySpec()
+	if len(tq.modifiers) > 0 {
+		_spec.Modifiers = tq.modifiers
+	}
+	_spec.Node.Columns = nil
+	_spec.Unique = tq.unique != nil && *tq.unique
+	return sqlgraph.EstimateNodes(ctx, tq.queryDriver(), _spec)
+}
+
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (tq *TaskQuery) CountEstimateX(ctx context.Context) int {
+	count, err := tq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// Task rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (tq *TaskQuery) Sample(percent float64) *TaskQuery {
+	tq.predicates = append(tq.predicates, sql.Sample(percent))
+	return tq
+}
+
+// TaskIterator iterates over the results of a Task query, and decodes them one at a time.
+type TaskIterator struct {
+	ctx   context.Context
+	query *TaskQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*Task
+	node  *Task
+	err   error
+}
+
+// Iterate executes the query and returns an iterator over its results, that decodes the Task entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.Task.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (tq *TaskQuery) Iterate(ctx context.Context, opts ...IterateOption) (*TaskIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := tq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	it := &TaskIterator{ctx: ctx, query: tq, batch: o.batch}
+	var (
+		_spec = tq.querySpec()
+	)
+	var (
+		selected *[2]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Task).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Task{config: tq.config}
+		it.nodes = append(it.nodes, node)
+		if !computed {
+			selected, computed = (*Task).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(tq.modifiers) > 0 {
+		_spec.Modifiers = tq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, tq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (tq *TaskQuery) iterateLoad(ctx context.Context, nodes []*Task) ([]*Task, error) {
+	return nodes, nil
+}
+
+// Next advances the iterator to the next Task, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *TaskIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*Task, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current Task of the iterator.
+func (it *TaskIterator) Value() *Task {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *TaskIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *TaskIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// LoadStrategy sets the strategy for eager-loading the Task entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (tq *TaskQuery) LoadStrategy(s LoadStrategy) *TaskQuery {
+	tq.loadStrategy = s
+	return tq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (tq *TaskQuery) joinable() bool {
+	if tq.loadStrategy != LoadStrategyJoin || tq.limit != nil || tq.offset != nil {
+		return false
+	}
+	if len(tq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range tq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -483,6 +783,346 @@ func (tq *TaskQuery) Modify(modifiers ...func(s *sql.Selector)) *TaskSelect {
 	return tq.Select()
 }
 
+// Project returns a builder for querying the given fields into TaskProjection structs,
+// instead of full Task entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.Task.Query().
+//		Project(enttask.FieldID, enttask.FieldPriority).
+//		All(ctx)
+//
+func (tq *TaskQuery) Project(fields ...string) *TaskProject {
+	tq.fields = append(tq.fields, fields...)
+	return &TaskProject{query: tq}
+}
+
+// TaskProjection is a lightweight projection of the Task entity that is returned by
+// TaskProject. Fields that were not selected by the query hold their zero values.
+type TaskProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority task.Priority `json:"priority,omitempty"`
+	// Priorities holds the value of the "priorities" field.
+	Priorities map[string]task.Priority `json:"priorities,omitempty"`
+}
+
+// TaskProject is the builder for querying Task fields into projections.
+type TaskProject struct {
+	query *TaskQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (tp *TaskProject) All(ctx context.Context) ([]*TaskProjection, error) {
+	if err := tp.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := tp.query.fields
+	if len(columns) == 0 {
+		columns = enttask.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := tp.query.sqlQuery(ctx).Query()
+	if err := tp.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &Task{}
+		result []*TaskProjection
+	)
+	for rows.Next() {
+		*e = Task{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &TaskProjection{
+			ID:         e.ID,
+			Priority:   e.Priority,
+			Priorities: e.Priorities,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tp *TaskProject) AllX(ctx context.Context) []*TaskProjection {
+	items, err := tp.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (tq *TaskQuery) onlyShared(ctx context.Context) (*Task, error) {
+	if err := tq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	tq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *tq
+	kq.sql = tq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if tq.singleflightScope != nil {
+		scope = tq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := tq.singleflight.DoChan(singleflightKey(scope, enttask.Label, query, args), func() (interface{}, error) {
+		nodes, err := tq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, tq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{enttask.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*Task)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.Task.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (tq *TaskQuery) SelectMask(paths ...string) *TaskSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case enttask.FieldID:
+		case "priority":
+			fields = append(fields, enttask.FieldPriority)
+		case "priorities":
+			fields = append(fields, enttask.FieldPriorities)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, enttask.FieldID)
+	}
+	return tq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the enttask.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (tq *TaskQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = enttask.FieldID
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering Task`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		tq.Order(Asc(column))
+	case DirectionDesc:
+		tq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// TaskPage is a page of Task entities returned by the Paginate method.
+type TaskPage struct {
+	Nodes []*Task `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of Task entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.Task.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.Task.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (tq *TaskQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*TaskPage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case enttask.FieldID:
+			order.column = enttask.FieldID
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating Task`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != enttask.FieldID {
+		order := paginateOrder{name: enttask.FieldID, column: enttask.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := tq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &TaskPage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case enttask.FieldID:
+				values[j] = n.ID
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (tq *TaskQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case enttask.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (tq *TaskQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*Task, error) {
+	if tq.limit != nil {
+		return tq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	tq.limit = &limit
+	defer func() { tq.limit = nil }()
+	nodes, err := tq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: enttask.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // TaskGroupBy is the group-by builder for Task entities.
 type TaskGroupBy struct {
 	config
@@ -522,7 +1162,7 @@ func (tgb *TaskGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := tgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := tgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -568,7 +1208,7 @@ func (ts *TaskSelect) Scan(ctx context.Context, v interface{}) error {
 func (ts *TaskSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ts.sql.Query()
-	if err := ts.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ts.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
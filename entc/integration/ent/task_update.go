@@ -54,6 +54,25 @@ func (tu *TaskUpdate) AddPriority(t task.Priority) *TaskUpdate {
 	return tu
 }
 
+// SetPriorityIfGreater sets the "priority" field to t, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (tu *TaskUpdate) SetPriorityIfGreater(t task.Priority) *TaskUpdate {
+	tu.mutation.SetPriorityIfGreater(t)
+	return tu
+}
+
+// BitAndPriority applies a bitwise AND with t on the "priority" field in the database.
+func (tu *TaskUpdate) BitAndPriority(t task.Priority) *TaskUpdate {
+	tu.mutation.BitAndPriority(t)
+	return tu
+}
+
+// BitOrPriority applies a bitwise OR with t on the "priority" field in the database.
+func (tu *TaskUpdate) BitOrPriority(t task.Priority) *TaskUpdate {
+	tu.mutation.BitOrPriority(t)
+	return tu
+}
+
 // SetPriorities sets the "priorities" field.
 func (tu *TaskUpdate) SetPriorities(m map[string]task.Priority) *TaskUpdate {
 	tu.mutation.SetPriorities(m)
@@ -173,6 +192,27 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: enttask.FieldPriority,
 		})
 	}
+	if value, ok := tu.mutation.PriorityIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: enttask.FieldPriority,
+		})
+	}
+	if value, ok := tu.mutation.PriorityBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: enttask.FieldPriority,
+		})
+	}
+	if value, ok := tu.mutation.PriorityBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: enttask.FieldPriority,
+		})
+	}
 	if value, ok := tu.mutation.Priorities(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -188,7 +228,7 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{enttask.Label}
+			err = &NotFoundError{label: enttask.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -200,9 +240,18 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // TaskUpdateOne is the builder for updating a single Task entity.
 type TaskUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TaskMutation
+	fields     []string
+	conditions []predicate.Task
+	hooks      []Hook
+	mutation   *TaskMutation
+}
+
+// Where appends a list of conditions to the TaskUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (tuo *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
+	tuo.conditions = append(tuo.conditions, ps...)
+	return tuo
 }
 
 // SetPriority sets the "priority" field.
@@ -226,6 +275,25 @@ func (tuo *TaskUpdateOne) AddPriority(t task.Priority) *TaskUpdateOne {
 	return tuo
 }
 
+// SetPriorityIfGreater sets the "priority" field to t, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (tuo *TaskUpdateOne) SetPriorityIfGreater(t task.Priority) *TaskUpdateOne {
+	tuo.mutation.SetPriorityIfGreater(t)
+	return tuo
+}
+
+// BitAndPriority applies a bitwise AND with t on the "priority" field in the database.
+func (tuo *TaskUpdateOne) BitAndPriority(t task.Priority) *TaskUpdateOne {
+	tuo.mutation.BitAndPriority(t)
+	return tuo
+}
+
+// BitOrPriority applies a bitwise OR with t on the "priority" field in the database.
+func (tuo *TaskUpdateOne) BitOrPriority(t task.Priority) *TaskUpdateOne {
+	tuo.mutation.BitOrPriority(t)
+	return tuo
+}
+
 // SetPriorities sets the "priorities" field.
 func (tuo *TaskUpdateOne) SetPriorities(m map[string]task.Priority) *TaskUpdateOne {
 	tuo.mutation.SetPriorities(m)
@@ -361,6 +429,13 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 			}
 		}
 	}
+	if ps := tuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := tuo.mutation.Priority(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -375,6 +450,27 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 			Column: enttask.FieldPriority,
 		})
 	}
+	if value, ok := tuo.mutation.PriorityIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: enttask.FieldPriority,
+		})
+	}
+	if value, ok := tuo.mutation.PriorityBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: enttask.FieldPriority,
+		})
+	}
+	if value, ok := tuo.mutation.PriorityBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: enttask.FieldPriority,
+		})
+	}
 	if value, ok := tuo.mutation.Priorities(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -393,7 +489,9 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{enttask.Label}
+			err = &NotFoundError{label: enttask.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: enttask.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -401,3 +499,99 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the Task entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (tuo *TaskUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "Task.id" for update`)}
+	}
+	return enqueueAsync(ctx, tuo.config, tuo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Task.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (tu *TaskUpdate) SetChanged(changes []FieldChange) (*TaskUpdate, error) {
+	if err := setTaskChanged(tu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return tu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by Task.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (tuo *TaskUpdateOne) SetChanged(changes []FieldChange) (*TaskUpdateOne, error) {
+	if err := setTaskChanged(tuo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return tuo, nil
+}
+
+// setTaskChanged applies the given field changes on the Task mutation.
+func setTaskChanged(m *TaskMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the Task entities that are joined with the rows of the given table, on the given columns
+// of the Task table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.Task.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (tu *TaskUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *TaskUpdate {
+	tu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return tu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Task schema.
+func (tu *TaskUpdate) ApplyPatch(p *TaskPatch) (*TaskUpdate, error) {
+	if err := applyTaskPatch(tu.mutation, p); err != nil {
+		return nil, err
+	}
+	return tu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the Task schema.
+func (tuo *TaskUpdateOne) ApplyPatch(p *TaskPatch) (*TaskUpdateOne, error) {
+	if err := applyTaskPatch(tuo.mutation, p); err != nil {
+		return nil, err
+	}
+	return tuo, nil
+}
+
+// applyTaskPatch applies the given patch on the Task mutation.
+func applyTaskPatch(m *TaskMutation, p *TaskPatch) error {
+	if p.Priority != nil {
+		m.SetPriority(*p.Priority)
+	}
+	if p.Priorities != nil {
+		m.SetPriorities(*p.Priorities)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	stdsql "database/sql"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"entgo.io/ent/dialect"
 )
@@ -200,6 +201,79 @@ func (tx *Tx) init() {
 	tx.User = NewUserClient(tx.config)
 }
 
+// WithTx runs the given function in a transaction. The transaction is committed if the function
+// returns nil, and rolled back if it returns an error, panics, or if the context was canceled before
+// the transaction was committed. Panics are re-raised after the transaction was rolled back, and the
+// errors of failed rollbacks are returned as a *RollbackError that wraps the error of the function.
+//
+//	err := ent.WithTx(ctx, client, func(tx *ent.Tx) error {
+//		return Gen(ctx, tx.Client())
+//	})
+//
+func WithTx(ctx context.Context, client *Client, fn func(tx *Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		return rollbackTx(tx, err)
+	}
+	// A canceled context may not fail the function (e.g. if it
+	// did not use it), but it must not commit the transaction.
+	if err := ctx.Err(); err != nil {
+		return rollbackTx(tx, fmt.Errorf("ent: context done before commit: %w", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	return nil
+}
+
+// RollbackError is returned by WithTx when the rollback of a transaction failed. It holds
+// the error that caused the rollback, and the error that was returned by the rollback.
+type RollbackError struct {
+	// Err is the error that caused the rollback.
+	Err error
+	// RollbackErr is the error that was returned by the rollback.
+	RollbackErr error
+}
+
+// Error implements the error interface.
+func (e *RollbackError) Error() string {
+	return fmt.Sprintf("%v: rolling back transaction: %v", e.Err, e.RollbackErr)
+}
+
+// Unwrap returns the error that caused the rollback.
+func (e *RollbackError) Unwrap() error {
+	return e.Err
+}
+
+// rollbackTx rolls back the transaction, and joins the given error with the rollback error if occurred.
+func rollbackTx(tx *Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return &RollbackError{Err: err, RollbackErr: rerr}
+	}
+	return err
+}
+
+// withConfigTx runs the given function with a config that holds a transaction, using WithTx.
+// If the given config is already transactional (i.e. the config of a Tx client), the function
+// is executed in its transaction, and committing or rolling it back is left to its owner.
+func withConfigTx(ctx context.Context, cfg config, fn func(config) error) error {
+	if _, ok := cfg.driver.(*txDriver); ok {
+		return fn(cfg)
+	}
+	return WithTx(ctx, &Client{config: cfg}, func(tx *Tx) error {
+		return fn(tx.config)
+	})
+}
+
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
 // The idea is to support transactions without adding any extra code to the builders.
 // When a builder calls to driver.Tx(), it gets the same dialect.Tx instance.
@@ -257,6 +331,69 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v interface{}
 
 var _ dialect.Driver = (*txDriver)(nil)
 
+// savepointTx returns a nested transactional client that is bound to a new savepoint of the given
+// transaction. Committing the returned client releases the savepoint, and rolling it back rolls back
+// the changes that were made after the savepoint was created, without ending the parent transaction.
+func (c *Client) savepointTx(ctx context.Context, parent *txDriver) (*Tx, error) {
+	name := fmt.Sprintf("ent_savepoint_%d", atomic.AddUint64(&savepointSeq, 1))
+	if err := parent.tx.Exec(ctx, "SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return nil, fmt.Errorf("ent: creating savepoint: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{drv: parent.drv, tx: &savepoint{Tx: parent.tx, ctx: ctx, name: name}}
+	tx := &Tx{ctx: ctx, config: cfg}
+	tx.init()
+	return tx, nil
+}
+
+// savepointSeq is used for generating unique names for savepoints.
+var savepointSeq uint64
+
+// savepoint wraps a dialect.Tx with a savepoint. Statements are executed by the wrapped
+// transaction, and Commit and Rollback release or roll back to the savepoint instead of
+// ending the transaction.
+type savepoint struct {
+	dialect.Tx
+	// ctx is the context that was used for creating the savepoint.
+	ctx  context.Context
+	name string
+}
+
+// Commit releases the savepoint.
+func (s *savepoint) Commit() error {
+	return s.Tx.Exec(s.ctx, "RELEASE SAVEPOINT "+s.name, []interface{}{}, nil)
+}
+
+// Rollback rolls back the transaction to the savepoint, and releases it.
+func (s *savepoint) Rollback() error {
+	if err := s.Tx.Exec(s.ctx, "ROLLBACK TO SAVEPOINT "+s.name, []interface{}{}, nil); err != nil {
+		return err
+	}
+	return s.Tx.Exec(s.ctx, "RELEASE SAVEPOINT "+s.name, []interface{}{}, nil)
+}
+
+// ExecContext calls the ExecContext method of the wrapped transaction if it is supported by it.
+func (s *savepoint) ExecContext(ctx context.Context, query string, args ...interface{}) (stdsql.Result, error) {
+	ex, ok := s.Tx.(interface {
+		ExecContext(context.Context, string, ...interface{}) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext calls the QueryContext method of the wrapped transaction if it is supported by it.
+func (s *savepoint) QueryContext(ctx context.Context, query string, args ...interface{}) (*stdsql.Rows, error) {
+	q, ok := s.Tx.(interface {
+		QueryContext(context.Context, string, ...interface{}) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
+
 // ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
 // See, database/sql#Tx.ExecContext for more information.
 func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...interface{}) (stdsql.Result, error) {
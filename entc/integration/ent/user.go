@@ -7,7 +7,9 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -49,6 +51,9 @@ type User struct {
 	group_blocked *int
 	user_spouse   *int
 	user_parent   *int
+	// selectedFields holds the fields that were selected by the query
+	// that returned the entity, or nil if all fields were selected.
+	selectedFields *[11]bool
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
@@ -443,6 +448,405 @@ func (u *User) String() string {
 	return builder.String()
 }
 
+// Diff returns the fields that hold different values in the User and other, where Old
+// holds the value of the receiver and New holds the value of other. Note that the ID and the
+// edges are not compared. The returned changes can be applied using the SetChanged method of
+// the update builders.
+func (u *User) Diff(other *User) []FieldChange {
+	var changes []FieldChange
+	if !reflect.DeepEqual(u.OptionalInt, other.OptionalInt) {
+		changes = append(changes, FieldChange{Field: user.FieldOptionalInt, Old: u.OptionalInt, New: other.OptionalInt})
+	}
+	if !reflect.DeepEqual(u.Age, other.Age) {
+		changes = append(changes, FieldChange{Field: user.FieldAge, Old: u.Age, New: other.Age})
+	}
+	if !reflect.DeepEqual(u.Name, other.Name) {
+		changes = append(changes, FieldChange{Field: user.FieldName, Old: u.Name, New: other.Name})
+	}
+	if !reflect.DeepEqual(u.Last, other.Last) {
+		changes = append(changes, FieldChange{Field: user.FieldLast, Old: u.Last, New: other.Last})
+	}
+	if !reflect.DeepEqual(u.Nickname, other.Nickname) {
+		changes = append(changes, FieldChange{Field: user.FieldNickname, Old: u.Nickname, New: other.Nickname})
+	}
+	if !reflect.DeepEqual(u.Address, other.Address) {
+		changes = append(changes, FieldChange{Field: user.FieldAddress, Old: u.Address, New: other.Address})
+	}
+	if !reflect.DeepEqual(u.Phone, other.Phone) {
+		changes = append(changes, FieldChange{Field: user.FieldPhone, Old: u.Phone, New: other.Phone})
+	}
+	if !reflect.DeepEqual(u.Password, other.Password) {
+		changes = append(changes, FieldChange{Field: user.FieldPassword, Old: u.Password, New: other.Password})
+	}
+	if !reflect.DeepEqual(u.Role, other.Role) {
+		changes = append(changes, FieldChange{Field: user.FieldRole, Old: u.Role, New: other.Role})
+	}
+	if !reflect.DeepEqual(u.Employment, other.Employment) {
+		changes = append(changes, FieldChange{Field: user.FieldEmployment, Old: u.Employment, New: other.Employment})
+	}
+	if !reflect.DeepEqual(u.SSOCert, other.SSOCert) {
+		changes = append(changes, FieldChange{Field: user.FieldSSOCert, Old: u.SSOCert, New: other.SSOCert})
+	}
+	return changes
+}
+
+// ApplyMask returns a copy of the User that holds only the fields and edges of the given field
+// mask paths (and the ID), for building partial responses. Paths of edges can be nested using dots,
+// and are applied on the loaded edges. An empty mask returns a copy of the entire entity.
+func (u *User) ApplyMask(paths ...string) (*User, error) {
+	if len(paths) == 0 {
+		masked := *u
+		return &masked, nil
+	}
+	masked := &User{config: u.config, ID: u.ID}
+	// Nested paths of the masked edges. A nil
+	// slice means that the entire edge is masked.
+	edges := make(map[string][]string)
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case user.FieldID:
+		case "optional_int":
+			masked.OptionalInt = u.OptionalInt
+		case "age":
+			masked.Age = u.Age
+		case "name":
+			masked.Name = u.Name
+		case "last":
+			masked.Last = u.Last
+		case "nickname":
+			masked.Nickname = u.Nickname
+		case "address":
+			masked.Address = u.Address
+		case "phone":
+			masked.Phone = u.Phone
+		case "password":
+			masked.Password = u.Password
+		case "role":
+			masked.Role = u.Role
+		case "employment":
+			masked.Employment = u.Employment
+		case "SSOCert":
+			masked.SSOCert = u.SSOCert
+		case user.EdgeCard, user.EdgePets, user.EdgeFiles, user.EdgeGroups, user.EdgeFriends, user.EdgeFollowers, user.EdgeFollowing, user.EdgeTeam, user.EdgeSpouse, user.EdgeChildren, user.EdgeParent:
+			if name == p {
+				edges[name] = nil
+			} else if sub, ok := edges[name]; !ok || sub != nil {
+				edges[name] = append(sub, p[len(name)+1:])
+			}
+		default:
+			return nil, fmt.Errorf("ent: unknown field mask path %q for type User", p)
+		}
+	}
+	if sub, ok := edges[user.EdgeCard]; ok {
+		masked.Edges.loadedTypes[0] = u.Edges.loadedTypes[0]
+		if n := u.Edges.Card; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Card = mn
+		}
+	}
+	if sub, ok := edges[user.EdgePets]; ok {
+		masked.Edges.loadedTypes[1] = u.Edges.loadedTypes[1]
+		for _, n := range u.Edges.Pets {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Pets = append(masked.Edges.Pets, mn)
+		}
+	}
+	if sub, ok := edges[user.EdgeFiles]; ok {
+		masked.Edges.loadedTypes[2] = u.Edges.loadedTypes[2]
+		for _, n := range u.Edges.Files {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Files = append(masked.Edges.Files, mn)
+		}
+	}
+	if sub, ok := edges[user.EdgeGroups]; ok {
+		masked.Edges.loadedTypes[3] = u.Edges.loadedTypes[3]
+		for _, n := range u.Edges.Groups {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Groups = append(masked.Edges.Groups, mn)
+		}
+	}
+	if sub, ok := edges[user.EdgeFriends]; ok {
+		masked.Edges.loadedTypes[4] = u.Edges.loadedTypes[4]
+		for _, n := range u.Edges.Friends {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Friends = append(masked.Edges.Friends, mn)
+		}
+	}
+	if sub, ok := edges[user.EdgeFollowers]; ok {
+		masked.Edges.loadedTypes[5] = u.Edges.loadedTypes[5]
+		for _, n := range u.Edges.Followers {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Followers = append(masked.Edges.Followers, mn)
+		}
+	}
+	if sub, ok := edges[user.EdgeFollowing]; ok {
+		masked.Edges.loadedTypes[6] = u.Edges.loadedTypes[6]
+		for _, n := range u.Edges.Following {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Following = append(masked.Edges.Following, mn)
+		}
+	}
+	if sub, ok := edges[user.EdgeTeam]; ok {
+		masked.Edges.loadedTypes[7] = u.Edges.loadedTypes[7]
+		if n := u.Edges.Team; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Team = mn
+		}
+	}
+	if sub, ok := edges[user.EdgeSpouse]; ok {
+		masked.Edges.loadedTypes[8] = u.Edges.loadedTypes[8]
+		if n := u.Edges.Spouse; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Spouse = mn
+		}
+	}
+	if sub, ok := edges[user.EdgeChildren]; ok {
+		masked.Edges.loadedTypes[9] = u.Edges.loadedTypes[9]
+		for _, n := range u.Edges.Children {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Children = append(masked.Edges.Children, mn)
+		}
+	}
+	if sub, ok := edges[user.EdgeParent]; ok {
+		masked.Edges.loadedTypes[10] = u.Edges.loadedTypes[10]
+		if n := u.Edges.Parent; n != nil {
+			mn, err := n.ApplyMask(sub...)
+			if err != nil {
+				return nil, err
+			}
+			masked.Edges.Parent = mn
+		}
+	}
+	return masked, nil
+}
+
+// UserPatch describes a partial update of a User entity. For example, the body of
+// an HTTP PATCH request. Fields that are nil are not changed, and the fields that are listed
+// in Cleared are set to NULL. When a patch is decoded from JSON, optional fields that are
+// explicitly set to null are added to Cleared, and they are encoded back as null. Note that
+// the values of sensitive fields are not encoded, and fields that are read-only in the public
+// API are not part of the patch.
+type UserPatch struct {
+	// OptionalInt holds the new value of the "optional_int" field.
+	OptionalInt *int `json:"optional_int,omitempty"`
+	// Age holds the new value of the "age" field.
+	Age *int `json:"age,omitempty"`
+	// Name holds the new value of the "name" field.
+	Name *string `json:"name,omitempty"`
+	// Last holds the new value of the "last" field.
+	Last *string `json:"last,omitempty"`
+	// Nickname holds the new value of the "nickname" field.
+	Nickname *string `json:"nickname,omitempty"`
+	// Address holds the new value of the "address" field.
+	Address *string `json:"address,omitempty"`
+	// Phone holds the new value of the "phone" field.
+	Phone *string `json:"phone,omitempty"`
+	// Password holds the new value of the "password" field.
+	Password *string `json:"password,omitempty"`
+	// Employment holds the new value of the "employment" field.
+	Employment *user.Employment `json:"employment,omitempty"`
+	// SSOCert holds the new value of the "SSOCert" field.
+	SSOCert *string `json:"SSOCert,omitempty"`
+	// Cleared holds the names of the fields that are cleared. For example, user.FieldName.
+	Cleared []string `json:"-"`
+}
+
+// FieldCleared reports if the field with the given name is cleared by the patch.
+func (p *UserPatch) FieldCleared(name string) bool {
+	for _, c := range p.Cleared {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p UserPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	if p.OptionalInt != nil {
+		fields["optional_int"] = *p.OptionalInt
+	}
+	if p.Age != nil {
+		fields["age"] = *p.Age
+	}
+	if p.Name != nil {
+		fields["name"] = *p.Name
+	}
+	if p.Last != nil {
+		fields["last"] = *p.Last
+	}
+	if p.Nickname != nil {
+		fields["nickname"] = *p.Nickname
+	}
+	if p.Address != nil {
+		fields["address"] = *p.Address
+	}
+	if p.Phone != nil {
+		fields["phone"] = *p.Phone
+	}
+	if p.Employment != nil {
+		fields["employment"] = *p.Employment
+	}
+	if p.SSOCert != nil {
+		fields["SSOCert"] = *p.SSOCert
+	}
+	for _, c := range p.Cleared {
+		switch c {
+		case user.FieldOptionalInt:
+			fields["optional_int"] = nil
+		case user.FieldNickname:
+			fields["nickname"] = nil
+		case user.FieldAddress:
+			fields["address"] = nil
+		case user.FieldPhone:
+			fields["phone"] = nil
+		case user.FieldPassword:
+			fields["password"] = nil
+		case user.FieldSSOCert:
+			fields["SSOCert"] = nil
+		default:
+			return nil, fmt.Errorf("ent: unknown or non-optional User field %q cannot be cleared", c)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *UserPatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		switch name {
+		case "optional_int":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, user.FieldOptionalInt)
+				continue
+			}
+			p.OptionalInt = new(int)
+			if err := json.Unmarshal(raw, p.OptionalInt); err != nil {
+				return fmt.Errorf("ent: decoding User field %q: %w", name, err)
+			}
+		case "age":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: User field %q cannot be null", name)
+			}
+			p.Age = new(int)
+			if err := json.Unmarshal(raw, p.Age); err != nil {
+				return fmt.Errorf("ent: decoding User field %q: %w", name, err)
+			}
+		case "name":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: User field %q cannot be null", name)
+			}
+			p.Name = new(string)
+			if err := json.Unmarshal(raw, p.Name); err != nil {
+				return fmt.Errorf("ent: decoding User field %q: %w", name, err)
+			}
+		case "last":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: User field %q cannot be null", name)
+			}
+			p.Last = new(string)
+			if err := json.Unmarshal(raw, p.Last); err != nil {
+				return fmt.Errorf("ent: decoding User field %q: %w", name, err)
+			}
+		case "nickname":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, user.FieldNickname)
+				continue
+			}
+			p.Nickname = new(string)
+			if err := json.Unmarshal(raw, p.Nickname); err != nil {
+				return fmt.Errorf("ent: decoding User field %q: %w", name, err)
+			}
+		case "address":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, user.FieldAddress)
+				continue
+			}
+			p.Address = new(string)
+			if err := json.Unmarshal(raw, p.Address); err != nil {
+				return fmt.Errorf("ent: decoding User field %q: %w", name, err)
+			}
+		case "phone":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, user.FieldPhone)
+				continue
+			}
+			p.Phone = new(string)
+			if err := json.Unmarshal(raw, p.Phone); err != nil {
+				return fmt.Errorf("ent: decoding User field %q: %w", name, err)
+			}
+		case "password":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, user.FieldPassword)
+				continue
+			}
+			p.Password = new(string)
+			if err := json.Unmarshal(raw, p.Password); err != nil {
+				return fmt.Errorf("ent: decoding User field %q: %w", name, err)
+			}
+		case "employment":
+			if string(raw) == "null" {
+				return fmt.Errorf("ent: User field %q cannot be null", name)
+			}
+			p.Employment = new(user.Employment)
+			if err := json.Unmarshal(raw, p.Employment); err != nil {
+				return fmt.Errorf("ent: decoding User field %q: %w", name, err)
+			}
+		case "SSOCert":
+			if string(raw) == "null" {
+				p.Cleared = append(p.Cleared, user.FieldSSOCert)
+				continue
+			}
+			p.SSOCert = new(string)
+			if err := json.Unmarshal(raw, p.SSOCert); err != nil {
+				return fmt.Errorf("ent: decoding User field %q: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("ent: unknown or immutable User field %q", name)
+		}
+	}
+	return nil
+}
+
 // NamedPets returns the Pets named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (u *User) NamedPets(name string) ([]*Pet, error) {
@@ -611,6 +1015,191 @@ func (u *User) appendNamedChildren(name string, edges ...*User) {
 	}
 }
 
+// Selected reports whether the given field was loaded into the User, i.e. it was selected by the query that
+// returned it. Entities that were not returned by queries (e.g. created entities) report all their fields as selected.
+// For example:
+//
+//	u := client.User.Query().Select(user.FieldOptionalInt).FirstX(ctx)
+//	u.Selected(user.FieldOptionalInt) // true
+//
+func (u *User) Selected(field string) bool {
+	switch field {
+	case user.FieldID:
+		return true
+	case user.FieldOptionalInt:
+		return u.selectedFields == nil || u.selectedFields[0]
+	case user.FieldAge:
+		return u.selectedFields == nil || u.selectedFields[1]
+	case user.FieldName:
+		return u.selectedFields == nil || u.selectedFields[2]
+	case user.FieldLast:
+		return u.selectedFields == nil || u.selectedFields[3]
+	case user.FieldNickname:
+		return u.selectedFields == nil || u.selectedFields[4]
+	case user.FieldAddress:
+		return u.selectedFields == nil || u.selectedFields[5]
+	case user.FieldPhone:
+		return u.selectedFields == nil || u.selectedFields[6]
+	case user.FieldPassword:
+		return u.selectedFields == nil || u.selectedFields[7]
+	case user.FieldRole:
+		return u.selectedFields == nil || u.selectedFields[8]
+	case user.FieldEmployment:
+		return u.selectedFields == nil || u.selectedFields[9]
+	case user.FieldSSOCert:
+		return u.selectedFields == nil || u.selectedFields[10]
+	}
+	return false
+}
+
+// OptionalIntOrErr returns the value of the "optional_int" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) OptionalIntOrErr() (int, error) {
+	if u.selectedFields != nil && !u.selectedFields[0] {
+		var zero int
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldOptionalInt}
+	}
+	return u.OptionalInt, nil
+}
+
+// AgeOrErr returns the value of the "age" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) AgeOrErr() (int, error) {
+	if u.selectedFields != nil && !u.selectedFields[1] {
+		var zero int
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldAge}
+	}
+	return u.Age, nil
+}
+
+// NameOrErr returns the value of the "name" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) NameOrErr() (string, error) {
+	if u.selectedFields != nil && !u.selectedFields[2] {
+		var zero string
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldName}
+	}
+	return u.Name, nil
+}
+
+// LastOrErr returns the value of the "last" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) LastOrErr() (string, error) {
+	if u.selectedFields != nil && !u.selectedFields[3] {
+		var zero string
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldLast}
+	}
+	return u.Last, nil
+}
+
+// NicknameOrErr returns the value of the "nickname" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) NicknameOrErr() (string, error) {
+	if u.selectedFields != nil && !u.selectedFields[4] {
+		var zero string
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldNickname}
+	}
+	return u.Nickname, nil
+}
+
+// AddressOrErr returns the value of the "address" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) AddressOrErr() (string, error) {
+	if u.selectedFields != nil && !u.selectedFields[5] {
+		var zero string
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldAddress}
+	}
+	return u.Address, nil
+}
+
+// PhoneOrErr returns the value of the "phone" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) PhoneOrErr() (string, error) {
+	if u.selectedFields != nil && !u.selectedFields[6] {
+		var zero string
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldPhone}
+	}
+	return u.Phone, nil
+}
+
+// PasswordOrErr returns the value of the "password" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) PasswordOrErr() (string, error) {
+	if u.selectedFields != nil && !u.selectedFields[7] {
+		var zero string
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldPassword}
+	}
+	return u.Password, nil
+}
+
+// RoleOrErr returns the value of the "role" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) RoleOrErr() (user.Role, error) {
+	if u.selectedFields != nil && !u.selectedFields[8] {
+		var zero user.Role
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldRole}
+	}
+	return u.Role, nil
+}
+
+// EmploymentOrErr returns the value of the "employment" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) EmploymentOrErr() (user.Employment, error) {
+	if u.selectedFields != nil && !u.selectedFields[9] {
+		var zero user.Employment
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldEmployment}
+	}
+	return u.Employment, nil
+}
+
+// SSOCertOrErr returns the value of the "SSOCert" field, or a *NotSelectedError
+// if the field was not selected by the query that returned the User.
+func (u *User) SSOCertOrErr() (string, error) {
+	if u.selectedFields != nil && !u.selectedFields[10] {
+		var zero string
+		return zero, &NotSelectedError{label: user.Label, field: user.FieldSSOCert}
+	}
+	return u.SSOCert, nil
+}
+
+// selectFields returns the fields of the User that are included in the given
+// columns, or nil if all of them are included.
+func (*User) selectFields(columns []string) *[11]bool {
+	var selected [11]bool
+	for _, c := range columns {
+		switch c {
+		case user.FieldOptionalInt:
+			selected[0] = true
+		case user.FieldAge:
+			selected[1] = true
+		case user.FieldName:
+			selected[2] = true
+		case user.FieldLast:
+			selected[3] = true
+		case user.FieldNickname:
+			selected[4] = true
+		case user.FieldAddress:
+			selected[5] = true
+		case user.FieldPhone:
+			selected[6] = true
+		case user.FieldPassword:
+			selected[7] = true
+		case user.FieldRole:
+			selected[8] = true
+		case user.FieldEmployment:
+			selected[9] = true
+		case user.FieldSSOCert:
+			selected[10] = true
+		}
+	}
+	for _, ok := range selected {
+		if !ok {
+			return &selected
+		}
+	}
+	return nil
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
@@ -8,6 +8,9 @@ package user
 
 import (
 	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
 )
 
 const (
@@ -242,4 +245,137 @@ func (e Employment) Ptr() *Employment {
 	return &e
 }
 
+// fieldInfos describes the fields of the User type, as defined in the schema.
+var fieldInfos = []ent.FieldInfo{
+	{
+		Name:        "id",
+		Column:      FieldID,
+		StructField: "ID",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Owner:       "identity",
+	},
+	{
+		Name:        "optional_int",
+		Column:      FieldOptionalInt,
+		StructField: "OptionalInt",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Optional:    true,
+		Owner:       "identity",
+	},
+	{
+		Name:        "age",
+		Column:      FieldAge,
+		StructField: "Age",
+		Type:        field.TypeInt,
+		GoType:      "int",
+		Owner:       "identity",
+	},
+	{
+		Name:        "name",
+		Column:      FieldName,
+		StructField: "Name",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Owner:       "identity",
+	},
+	{
+		Name:        "last",
+		Column:      FieldLast,
+		StructField: "Last",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Default:     true,
+		Owner:       "identity",
+	},
+	{
+		Name:        "nickname",
+		Column:      FieldNickname,
+		StructField: "Nickname",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+		Unique:      true,
+		Owner:       "identity",
+	},
+	{
+		Name:        "address",
+		Column:      FieldAddress,
+		StructField: "Address",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+		Default:     true,
+		Owner:       "identity",
+	},
+	{
+		Name:           "phone",
+		Column:         FieldPhone,
+		StructField:    "Phone",
+		Type:           field.TypeString,
+		GoType:         "string",
+		Optional:       true,
+		Unique:         true,
+		Owner:          "identity",
+		Classification: "confidential",
+	},
+	{
+		Name:           "password",
+		Column:         FieldPassword,
+		StructField:    "Password",
+		Type:           field.TypeString,
+		GoType:         "string",
+		Optional:       true,
+		Sensitive:      true,
+		Owner:          "identity",
+		Classification: "restricted",
+		Retention:      7776000000000000, // 2160h0m0s
+	},
+	{
+		Name:        "role",
+		Column:      FieldRole,
+		StructField: "Role",
+		Type:        field.TypeEnum,
+		GoType:      "user.Role",
+		Enums:       []string{"user", "admin", "free-user", "test user"},
+		Default:     true,
+		Owner:       "identity",
+	},
+	{
+		Name:        "employment",
+		Column:      FieldEmployment,
+		StructField: "Employment",
+		Type:        field.TypeEnum,
+		GoType:      "user.Employment",
+		Enums:       []string{"Full-Time", "Part-Time", "Contract"},
+		Default:     true,
+		Owner:       "identity",
+	},
+	{
+		Name:        "SSOCert",
+		Column:      FieldSSOCert,
+		StructField: "SSOCert",
+		Type:        field.TypeString,
+		GoType:      "string",
+		Optional:    true,
+		Owner:       "identity",
+	},
+}
+
+// Fields returns the information of the User fields, ordered as they are
+// defined in the schema (the ID field is first). The returned slice can be modified by the caller.
+func Fields() []ent.FieldInfo {
+	fields := make([]ent.FieldInfo, len(fieldInfos))
+	copy(fields, fieldInfos)
+	return fields
+}
+
+// OrderFields holds the names of the fields that are allowed to be used in dynamic ordering (e.g. OrderByField).
+var OrderFields = []string{
+	"id",
+	"nickname",
+	"phone",
+}
+
 // comment from another template.
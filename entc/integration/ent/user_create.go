@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -805,6 +806,68 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// UserInsertSelect is the builder for creating User entities from the result of a query.
+type UserInsertSelect struct {
+	config
+	query   InsertSelectQuery
+	columns []string
+}
+
+// Columns sets the columns of the User table that are populated by the selected columns of
+// the query, by their order. Defaults to the columns that were selected by the query.
+// For example:
+//
+//	client.User.CreateFromQuery(query.Select(...)).
+//		Columns(user.FieldOptionalInt).
+//		Exec(ctx)
+//
+func (uis *UserInsertSelect) Columns(columns ...string) *UserInsertSelect {
+	uis.columns = append(uis.columns, columns...)
+	return uis
+}
+
+// Exec executes the INSERT INTO ... SELECT statement, and returns the number of created entities.
+func (uis *UserInsertSelect) Exec(ctx context.Context) (int, error) {
+	if uis.query == nil {
+		return 0, errors.New("ent: missing query for User.CreateFromQuery")
+	}
+	if err := uis.query.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	selector := uis.query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	columns := uis.columns
+	if len(columns) == 0 {
+		columns = selector.UnqualifiedColumns()
+	}
+	for _, c := range columns {
+		if !user.ValidColumn(c) {
+			return 0, &ValidationError{Name: c, err: fmt.Errorf("ent: invalid column %q for User.CreateFromQuery", c)}
+		}
+	}
+	_spec := &sqlgraph.InsertSelectSpec{
+		Node:    &sqlgraph.NodeSpec{Table: user.Table, Columns: user.Columns},
+		Columns: columns,
+		From:    selector,
+	}
+	n, err := sqlgraph.InsertSelect(ctx, uis.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return n, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uis *UserInsertSelect) ExecX(ctx context.Context) int {
+	n, err := uis.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -848,6 +911,7 @@ type (
 	//  one User node.
 	UserUpsertOne struct {
 		create *UserCreate
+		err    error // first validation error of the update values.
 	}
 
 	// UserUpsert is the "OnConflict" setter.
@@ -1078,8 +1142,12 @@ func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
 	return u
 }
 
-// SetOptionalInt sets the "optional_int" field.
+// SetOptionalInt sets the "optional_int" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *UserUpsertOne) SetOptionalInt(v int) *UserUpsertOne {
+	if err := user.OptionalIntValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "optional_int", err: fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)}
+	}
 	return u.Update(func(s *UserUpsert) {
 		s.SetOptionalInt(v)
 	})
@@ -1239,8 +1307,12 @@ func (u *UserUpsertOne) ClearPassword() *UserUpsertOne {
 	})
 }
 
-// SetRole sets the "role" field.
+// SetRole sets the "role" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *UserUpsertOne) SetRole(v user.Role) *UserUpsertOne {
+	if err := user.RoleValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+	}
 	return u.Update(func(s *UserUpsert) {
 		s.SetRole(v)
 	})
@@ -1253,8 +1325,12 @@ func (u *UserUpsertOne) UpdateRole() *UserUpsertOne {
 	})
 }
 
-// SetEmployment sets the "employment" field.
+// SetEmployment sets the "employment" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *UserUpsertOne) SetEmployment(v user.Employment) *UserUpsertOne {
+	if err := user.EmploymentValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "employment", err: fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err)}
+	}
 	return u.Update(func(s *UserUpsert) {
 		s.SetEmployment(v)
 	})
@@ -1290,6 +1366,9 @@ func (u *UserUpsertOne) ClearSSOCert() *UserUpsertOne {
 
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	if len(u.create.conflict) == 0 {
 		return errors.New("ent: missing options for UserCreate.OnConflict")
 	}
@@ -1298,13 +1377,16 @@ func (u *UserUpsertOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *UserUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // Exec executes the UPSERT query and returns the inserted/updated ID.
 func (u *UserUpsertOne) ID(ctx context.Context) (id int, err error) {
+	if u.err != nil {
+		return id, u.err
+	}
 	node, err := u.create.Save(ctx)
 	if err != nil {
 		return id, err
@@ -1321,6 +1403,43 @@ func (u *UserUpsertOne) IDX(ctx context.Context) int {
 	return id
 }
 
+// SaveAsync adds the creation of the User entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks, defaults and validators of the builder are applied, and failed executions are retried.
+func (uc *UserCreate) SaveAsync(ctx context.Context) (string, error) {
+	return enqueueAsync(ctx, uc.config, uc.mutation, nil)
+}
+
+// IdempotencyKey sets the idempotency key of the User creation. The key is recorded in the idempotency
+// store in the same transaction the entity is created in, and replays of the creation with the same key (within
+// the TTL of the store) return the entity that was created originally, instead of creating a new one.
+func (uc *UserCreate) IdempotencyKey(key string) *UserCreate {
+	hook := func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			return saveIdempotent(ctx, &uc.config, key, m, next,
+				func(v Value) (string, error) {
+					node, ok := v.(*User)
+					if !ok {
+						return "", fmt.Errorf("unexpected node type %T returned from UserMutation", v)
+					}
+					id, err := json.Marshal(node.ID)
+					return string(id), err
+				},
+				func(ctx context.Context, c config, res string) (Value, error) {
+					var id int
+					if err := json.Unmarshal([]byte(res), &id); err != nil {
+						return nil, err
+					}
+					return (&UserClient{config: c}).Get(ctx, id)
+				},
+			)
+		})
+	}
+	// The key is checked before executing the other hooks of the builder.
+	uc.hooks = append([]Hook{hook}, uc.hooks...)
+	return uc
+}
+
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
@@ -1407,6 +1526,78 @@ func (ucb *UserCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// UserBulkResult holds the result of creating one of the User entities using UserCreateBulk.ContinueOnError.
+type UserBulkResult struct {
+	// Node holds the created entity, if it was created successfully.
+	Node *User
+	// Err holds the error that failed the creation of the entity,
+	// for example, a *ValidationError or a *ConstraintError.
+	Err error
+}
+
+// ContinueOnError creates the User entities one by one, each within its own savepoint, and returns their
+// results by the order of the builders. Entities that fail to be created are rolled back to their savepoint, and
+// do not abort the creation of the others. The entities are created in a transaction (or in a savepoint of the
+// transaction, if the builder was created by a transactional client), that is committed once all builders were
+// executed. The returned error is set only if the transaction or one of the savepoints could not be handled.
+//
+//	results, err := client.User.CreateBulk(builders...).ContinueOnError(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	for i, r := range results {
+//		if r.Err != nil {
+//			log.Printf("row %d: %v", i, r.Err)
+//		}
+//	}
+//
+func (ucb *UserCreateBulk) ContinueOnError(ctx context.Context) ([]UserBulkResult, error) {
+	client := &Client{config: ucb.config}
+	client.init()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// sp holds the savepoint of the last executed builder. If one of the builders
+	// panics, its savepoint and the transaction are rolled back before re-panicking.
+	var sp *Tx
+	defer func() {
+		if v := recover(); v != nil {
+			if sp != nil {
+				_ = sp.Rollback()
+			}
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	results := make([]UserBulkResult, len(ucb.builders))
+	for i, builder := range ucb.builders {
+		if sp, err = tx.Client().Tx(ctx); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		builder.config, builder.mutation.config = sp.config, sp.config
+		node, err := builder.Save(ctx)
+		if err != nil {
+			if rerr := sp.Rollback(); rerr != nil {
+				return nil, rollbackTx(tx, rerr)
+			}
+			results[i].Err = err
+			continue
+		}
+		if err := sp.Commit(); err != nil {
+			return nil, rollbackTx(tx, err)
+		}
+		// Entities are bound to the driver of the builder,
+		// and not to the transaction that created them.
+		node.config = ucb.config
+		results[i].Node = node
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
@@ -1448,6 +1639,7 @@ func (ucb *UserCreateBulk) OnConflictColumns(columns ...string) *UserUpsertBulk
 // a bulk of User nodes.
 type UserUpsertBulk struct {
 	create *UserCreateBulk
+	err    error // first validation error of the update values.
 }
 
 // UpdateNewValues updates the mutable fields using the new values that
@@ -1492,8 +1684,12 @@ func (u *UserUpsertBulk) Update(set func(*UserUpsert)) *UserUpsertBulk {
 	return u
 }
 
-// SetOptionalInt sets the "optional_int" field.
+// SetOptionalInt sets the "optional_int" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *UserUpsertBulk) SetOptionalInt(v int) *UserUpsertBulk {
+	if err := user.OptionalIntValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "optional_int", err: fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)}
+	}
 	return u.Update(func(s *UserUpsert) {
 		s.SetOptionalInt(v)
 	})
@@ -1653,8 +1849,12 @@ func (u *UserUpsertBulk) ClearPassword() *UserUpsertBulk {
 	})
 }
 
-// SetRole sets the "role" field.
+// SetRole sets the "role" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *UserUpsertBulk) SetRole(v user.Role) *UserUpsertBulk {
+	if err := user.RoleValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+	}
 	return u.Update(func(s *UserUpsert) {
 		s.SetRole(v)
 	})
@@ -1667,8 +1867,12 @@ func (u *UserUpsertBulk) UpdateRole() *UserUpsertBulk {
 	})
 }
 
-// SetEmployment sets the "employment" field.
+// SetEmployment sets the "employment" field. The value is checked by the validators
+// of the field, and an invalid value fails the execution of the upsert.
 func (u *UserUpsertBulk) SetEmployment(v user.Employment) *UserUpsertBulk {
+	if err := user.EmploymentValidator(v); err != nil && u.err == nil {
+		u.err = &ValidationError{Name: "employment", err: fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err)}
+	}
 	return u.Update(func(s *UserUpsert) {
 		s.SetEmployment(v)
 	})
@@ -1704,6 +1908,9 @@ func (u *UserUpsertBulk) ClearSSOCert() *UserUpsertBulk {
 
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
+	if u.err != nil {
+		return u.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserCreateBulk instead", i)
@@ -1717,7 +1924,7 @@ func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (u *UserUpsertBulk) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
+	if err := u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
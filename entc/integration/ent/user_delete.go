@@ -95,6 +95,19 @@ func (ud *UserDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
+// Using deletes only the User entities that are joined with the rows of the given table, on the given columns
+// of the User table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. DELETE ... USING).
+//
+//	client.User.Delete().
+//		Using(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (ud *UserDelete) Using(table, left, right string, ps ...func(*sql.Selector)) *UserDelete {
+	ud.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return ud
+}
+
 // UserDeleteOne is the builder for deleting a single User entity.
 type UserDeleteOne struct {
 	ud *UserDelete
@@ -107,7 +120,7 @@ func (udo *UserDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{user.Label}
+		return &NotFoundError{label: user.Label}
 	default:
 		return nil
 	}
@@ -9,9 +9,13 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -27,24 +31,28 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	limit              *int
-	offset             *int
-	unique             *bool
-	order              []OrderFunc
-	fields             []string
-	predicates         []predicate.User
-	withCard           *CardQuery
-	withPets           *PetQuery
-	withFiles          *FileQuery
-	withGroups         *GroupQuery
-	withFriends        *UserQuery
-	withFollowers      *UserQuery
-	withFollowing      *UserQuery
-	withTeam           *PetQuery
-	withSpouse         *UserQuery
-	withChildren       *UserQuery
-	withParent         *UserQuery
-	withFKs            bool
+	limit         *int
+	offset        *int
+	unique        *bool
+	order         []OrderFunc
+	fields        []string
+	inters        []Interceptor
+	predicates    []predicate.User
+	withCard      *CardQuery
+	withPets      *PetQuery
+	withFiles     *FileQuery
+	withGroups    *GroupQuery
+	withFriends   *UserQuery
+	withFollowers *UserQuery
+	withFollowing *UserQuery
+	withTeam      *PetQuery
+	withSpouse    *UserQuery
+	withChildren  *UserQuery
+	withParent    *UserQuery
+	withFKs       bool
+	// edgeOrder holds the default order of the edge that is queried, if it was defined.
+	edgeOrder          []OrderFunc
+	loadStrategy       LoadStrategy
 	modifiers          []func(*sql.Selector)
 	withNamedPets      map[string]*PetQuery
 	withNamedFiles     map[string]*FileQuery
@@ -91,7 +99,7 @@ func (uq *UserQuery) Order(o ...OrderFunc) *UserQuery {
 
 // QueryCard chains the current query on the "card" edge.
 func (uq *UserQuery) QueryCard() *CardQuery {
-	query := &CardQuery{config: uq.config}
+	query := (&CardClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -113,7 +121,7 @@ func (uq *UserQuery) QueryCard() *CardQuery {
 
 // QueryPets chains the current query on the "pets" edge.
 func (uq *UserQuery) QueryPets() *PetQuery {
-	query := &PetQuery{config: uq.config}
+	query := (&PetClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -135,7 +143,7 @@ func (uq *UserQuery) QueryPets() *PetQuery {
 
 // QueryFiles chains the current query on the "files" edge.
 func (uq *UserQuery) QueryFiles() *FileQuery {
-	query := &FileQuery{config: uq.config}
+	query := (&FileClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -157,7 +165,7 @@ func (uq *UserQuery) QueryFiles() *FileQuery {
 
 // QueryGroups chains the current query on the "groups" edge.
 func (uq *UserQuery) QueryGroups() *GroupQuery {
-	query := &GroupQuery{config: uq.config}
+	query := (&GroupClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -179,7 +187,7 @@ func (uq *UserQuery) QueryGroups() *GroupQuery {
 
 // QueryFriends chains the current query on the "friends" edge.
 func (uq *UserQuery) QueryFriends() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -201,7 +209,7 @@ func (uq *UserQuery) QueryFriends() *UserQuery {
 
 // QueryFollowers chains the current query on the "followers" edge.
 func (uq *UserQuery) QueryFollowers() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -223,7 +231,7 @@ func (uq *UserQuery) QueryFollowers() *UserQuery {
 
 // QueryFollowing chains the current query on the "following" edge.
 func (uq *UserQuery) QueryFollowing() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -245,7 +253,7 @@ func (uq *UserQuery) QueryFollowing() *UserQuery {
 
 // QueryTeam chains the current query on the "team" edge.
 func (uq *UserQuery) QueryTeam() *PetQuery {
-	query := &PetQuery{config: uq.config}
+	query := (&PetClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -267,7 +275,7 @@ func (uq *UserQuery) QueryTeam() *PetQuery {
 
 // QuerySpouse chains the current query on the "spouse" edge.
 func (uq *UserQuery) QuerySpouse() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -289,7 +297,7 @@ func (uq *UserQuery) QuerySpouse() *UserQuery {
 
 // QueryChildren chains the current query on the "children" edge.
 func (uq *UserQuery) QueryChildren() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -311,7 +319,7 @@ func (uq *UserQuery) QueryChildren() *UserQuery {
 
 // QueryParent chains the current query on the "parent" edge.
 func (uq *UserQuery) QueryParent() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -339,7 +347,7 @@ func (uq *UserQuery) First(ctx context.Context) (*User, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{user.Label}
+		return nil, uq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -361,7 +369,7 @@ func (uq *UserQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{user.Label}
+		err = uq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -380,6 +388,11 @@ func (uq *UserQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one User entity is found.
 // Returns a *NotFoundError when no User entities are found.
 func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
+	if uq.singleflight != nil && uq.withCard == nil && uq.withPets == nil && len(uq.withNamedPets) == 0 && uq.withFiles == nil && len(uq.withNamedFiles) == 0 && uq.withGroups == nil && len(uq.withNamedGroups) == 0 && uq.withFriends == nil && len(uq.withNamedFriends) == 0 && uq.withFollowers == nil && len(uq.withNamedFollowers) == 0 && uq.withFollowing == nil && len(uq.withNamedFollowing) == 0 && uq.withTeam == nil && uq.withSpouse == nil && uq.withChildren == nil && len(uq.withNamedChildren) == 0 && uq.withParent == nil {
+		if _, ok := uq.driver.(*txDriver); !ok {
+			return uq.onlyShared(ctx)
+		}
+	}
 	nodes, err := uq.Limit(2).All(ctx)
 	if err != nil {
 		return nil, err
@@ -388,7 +401,7 @@ func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{user.Label}
+		return nil, uq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{user.Label}
 	}
@@ -415,7 +428,7 @@ func (uq *UserQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{user.Label}
+		err = uq.sqlNotFound()
 	default:
 		err = &NotSingularError{user.Label}
 	}
@@ -436,7 +449,20 @@ func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return uq.sqlAll(ctx)
+	if p := uq.queryLimit; p != nil && !skipQueryLimit(ctx) {
+		return uq.allWithQueryLimit(SkipQueryLimit(ctx), p)
+	}
+	v, err := uq.intercept(ctx, "All", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*User)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*User", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -450,10 +476,20 @@ func (uq *UserQuery) AllX(ctx context.Context) []*User {
 
 // IDs executes the query and returns a list of User IDs.
 func (uq *UserQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := uq.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := uq.intercept(ctx, "IDs", func(ctx context.Context, query *UserQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -471,7 +507,17 @@ func (uq *UserQuery) Count(ctx context.Context) (int, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return uq.sqlCount(ctx)
+	v, err := uq.intercept(ctx, "Count", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -488,7 +534,124 @@ func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return uq.sqlExist(ctx)
+	v, err := uq.intercept(ctx, "Exist", func(ctx context.Context, query *UserQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (uq *UserQuery) intercept(ctx context.Context, op string, fn func(context.Context, *UserQuery) (Value, error)) (Value, error) {
+	if len(uq.inters) == 0 {
+		return fn(ctx, uq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeUser,
+		Op:     op,
+		Limit:  uq.limit,
+		Offset: uq.offset,
+		Unique: uq.unique,
+		Fields: uq.fields,
+	}
+	for _, p := range uq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range uq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if uq.withCard != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeCard)
+		qc.EdgeQueries[user.EdgeCard] = uq.withCard
+	}
+	if uq.withPets != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgePets)
+		qc.EdgeQueries[user.EdgePets] = uq.withPets
+	}
+	if uq.withFiles != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeFiles)
+		qc.EdgeQueries[user.EdgeFiles] = uq.withFiles
+	}
+	if uq.withGroups != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeGroups)
+		qc.EdgeQueries[user.EdgeGroups] = uq.withGroups
+	}
+	if uq.withFriends != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeFriends)
+		qc.EdgeQueries[user.EdgeFriends] = uq.withFriends
+	}
+	if uq.withFollowers != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeFollowers)
+		qc.EdgeQueries[user.EdgeFollowers] = uq.withFollowers
+	}
+	if uq.withFollowing != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeFollowing)
+		qc.EdgeQueries[user.EdgeFollowing] = uq.withFollowing
+	}
+	if uq.withTeam != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeTeam)
+		qc.EdgeQueries[user.EdgeTeam] = uq.withTeam
+	}
+	if uq.withSpouse != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeSpouse)
+		qc.EdgeQueries[user.EdgeSpouse] = uq.withSpouse
+	}
+	if uq.withChildren != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeChildren)
+		qc.EdgeQueries[user.EdgeChildren] = uq.withChildren
+	}
+	if uq.withParent != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, user.EdgeParent)
+		qc.EdgeQueries[user.EdgeParent] = uq.withParent
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *UserQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, uq, qc, qr, uq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -511,6 +674,7 @@ func (uq *UserQuery) Clone() *UserQuery {
 		limit:         uq.limit,
 		offset:        uq.offset,
 		order:         append([]OrderFunc{}, uq.order...),
+		inters:        append([]Interceptor{}, uq.inters...),
 		predicates:    append([]predicate.User{}, uq.predicates...),
 		withCard:      uq.withCard.Clone(),
 		withPets:      uq.withPets.Clone(),
@@ -524,16 +688,18 @@ func (uq *UserQuery) Clone() *UserQuery {
 		withChildren:  uq.withChildren.Clone(),
 		withParent:    uq.withParent.Clone(),
 		// clone intermediate query.
-		sql:    uq.sql.Clone(),
-		path:   uq.path,
-		unique: uq.unique,
+		sql:          uq.sql.Clone(),
+		path:         uq.path,
+		unique:       uq.unique,
+		loadStrategy: uq.loadStrategy,
+		edgeOrder:    append([]OrderFunc{}, uq.edgeOrder...),
 	}
 }
 
 // WithCard tells the query-builder to eager-load the nodes that are connected to
 // the "card" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithCard(opts ...func(*CardQuery)) *UserQuery {
-	query := &CardQuery{config: uq.config}
+	query := (&CardClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -544,7 +710,7 @@ func (uq *UserQuery) WithCard(opts ...func(*CardQuery)) *UserQuery {
 // WithPets tells the query-builder to eager-load the nodes that are connected to
 // the "pets" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithPets(opts ...func(*PetQuery)) *UserQuery {
-	query := &PetQuery{config: uq.config}
+	query := (&PetClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -555,7 +721,7 @@ func (uq *UserQuery) WithPets(opts ...func(*PetQuery)) *UserQuery {
 // WithFiles tells the query-builder to eager-load the nodes that are connected to
 // the "files" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithFiles(opts ...func(*FileQuery)) *UserQuery {
-	query := &FileQuery{config: uq.config}
+	query := (&FileClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -566,7 +732,7 @@ func (uq *UserQuery) WithFiles(opts ...func(*FileQuery)) *UserQuery {
 // WithGroups tells the query-builder to eager-load the nodes that are connected to
 // the "groups" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithGroups(opts ...func(*GroupQuery)) *UserQuery {
-	query := &GroupQuery{config: uq.config}
+	query := (&GroupClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -577,7 +743,7 @@ func (uq *UserQuery) WithGroups(opts ...func(*GroupQuery)) *UserQuery {
 // WithFriends tells the query-builder to eager-load the nodes that are connected to
 // the "friends" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithFriends(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -588,7 +754,7 @@ func (uq *UserQuery) WithFriends(opts ...func(*UserQuery)) *UserQuery {
 // WithFollowers tells the query-builder to eager-load the nodes that are connected to
 // the "followers" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithFollowers(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -599,7 +765,7 @@ func (uq *UserQuery) WithFollowers(opts ...func(*UserQuery)) *UserQuery {
 // WithFollowing tells the query-builder to eager-load the nodes that are connected to
 // the "following" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithFollowing(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -610,7 +776,7 @@ func (uq *UserQuery) WithFollowing(opts ...func(*UserQuery)) *UserQuery {
 // WithTeam tells the query-builder to eager-load the nodes that are connected to
 // the "team" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithTeam(opts ...func(*PetQuery)) *UserQuery {
-	query := &PetQuery{config: uq.config}
+	query := (&PetClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -621,7 +787,7 @@ func (uq *UserQuery) WithTeam(opts ...func(*PetQuery)) *UserQuery {
 // WithSpouse tells the query-builder to eager-load the nodes that are connected to
 // the "spouse" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithSpouse(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -632,7 +798,7 @@ func (uq *UserQuery) WithSpouse(opts ...func(*UserQuery)) *UserQuery {
 // WithChildren tells the query-builder to eager-load the nodes that are connected to
 // the "children" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithChildren(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -643,7 +809,7 @@ func (uq *UserQuery) WithChildren(opts ...func(*UserQuery)) *UserQuery {
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithParent(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -707,6 +873,16 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range uq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, uq); err != nil {
+				return err
+			}
+		}
+	}
 	if uq.path != nil {
 		prev, err := uq.path(ctx)
 		if err != nil {
@@ -742,6 +918,20 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, user.ForeignKeys...)
 	}
+	// The default order of the edge takes precedence over the default order of the type.
+	if ps := uq.edgeOrder; _spec.Order == nil && len(ps) > 0 {
+		_spec.Order = func(s *sql.Selector) {
+			for i := range ps {
+				ps[i](s)
+			}
+		}
+	}
+	// All nodes are scanned from the same columns, and
+	// therefore, their selection is computed only once.
+	var (
+		selected *[11]bool
+		computed bool
+	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*User).scanValues(nil, columns)
 	}
@@ -749,15 +939,149 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node := &User{config: uq.config}
 		nodes = append(nodes, node)
 		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*User).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
 		return node.assignValues(columns, values)
 	}
 	if len(uq.modifiers) > 0 {
 		_spec.Modifiers = uq.modifiers
 	}
+	if query := uq.withSpouse; query != nil && query.joinable() {
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		var (
+			joined   = query.sqlQuery(ctx)
+			jcolumns = query.querySpec().Node.Columns
+			scan     = _spec.ScanValues
+			assign   = _spec.Assign
+		)
+		selected := (*User).selectFields(nil, jcolumns)
+		joined.Select(joined.Columns(jcolumns...)...)
+		_spec.Modifiers = append(_spec.Modifiers[:len(_spec.Modifiers):len(_spec.Modifiers)], func(s *sql.Selector) {
+			s.LeftJoin(joined).On(s.C(user.SpouseColumn), joined.C(user.FieldID))
+			for _, c := range jcolumns {
+				s.AppendSelect(sql.As(joined.C(c), user.EdgeSpouse+"__"+c))
+			}
+		})
+		// The columns of the joined edge are selected last, and are scanned
+		// using nullable scanners, as they are NULL if the edge does not exist.
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			n := len(columns) - len(jcolumns)
+			values, err := scan(columns[:n])
+			if err != nil {
+				return nil, err
+			}
+			jvalues, err := (*User).scanValues(nil, jcolumns)
+			if err != nil {
+				return nil, err
+			}
+			for i := range jvalues {
+				if s, ok := jvalues[i].(interface{ Scan(interface{}) error }); ok {
+					jvalues[i] = &sql.NullScanner{S: s}
+				}
+			}
+			return append(values, jvalues...), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			n := len(columns) - len(jcolumns)
+			if err := assign(columns[:n], values[:n]); err != nil {
+				return err
+			}
+			if id, ok := values[n].(*sql.NullScanner); ok && !id.Valid {
+				return nil
+			}
+			jvalues := make([]interface{}, len(jcolumns))
+			for i, v := range values[n:] {
+				if s, ok := v.(*sql.NullScanner); ok {
+					v = s.S
+				}
+				jvalues[i] = v
+			}
+			neighbor := &User{config: uq.config}
+			neighbor.selectedFields = selected
+			if err := neighbor.assignValues(jcolumns, jvalues); err != nil {
+				return err
+			}
+			nodes[len(nodes)-1].Edges.Spouse = neighbor
+			return nil
+		}
+		// The edge is loaded by the join, and not by an additional query.
+		clone := *uq
+		clone.withSpouse = nil
+		uq = &clone
+	}
+	if query := uq.withParent; query != nil && query.joinable() {
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		var (
+			joined   = query.sqlQuery(ctx)
+			jcolumns = query.querySpec().Node.Columns
+			scan     = _spec.ScanValues
+			assign   = _spec.Assign
+		)
+		selected := (*User).selectFields(nil, jcolumns)
+		joined.Select(joined.Columns(jcolumns...)...)
+		_spec.Modifiers = append(_spec.Modifiers[:len(_spec.Modifiers):len(_spec.Modifiers)], func(s *sql.Selector) {
+			s.LeftJoin(joined).On(s.C(user.ParentColumn), joined.C(user.FieldID))
+			for _, c := range jcolumns {
+				s.AppendSelect(sql.As(joined.C(c), user.EdgeParent+"__"+c))
+			}
+		})
+		// The columns of the joined edge are selected last, and are scanned
+		// using nullable scanners, as they are NULL if the edge does not exist.
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			n := len(columns) - len(jcolumns)
+			values, err := scan(columns[:n])
+			if err != nil {
+				return nil, err
+			}
+			jvalues, err := (*User).scanValues(nil, jcolumns)
+			if err != nil {
+				return nil, err
+			}
+			for i := range jvalues {
+				if s, ok := jvalues[i].(interface{ Scan(interface{}) error }); ok {
+					jvalues[i] = &sql.NullScanner{S: s}
+				}
+			}
+			return append(values, jvalues...), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			n := len(columns) - len(jcolumns)
+			if err := assign(columns[:n], values[:n]); err != nil {
+				return err
+			}
+			if id, ok := values[n].(*sql.NullScanner); ok && !id.Valid {
+				return nil
+			}
+			jvalues := make([]interface{}, len(jcolumns))
+			for i, v := range values[n:] {
+				if s, ok := v.(*sql.NullScanner); ok {
+					v = s.S
+				}
+				jvalues[i] = v
+			}
+			neighbor := &User{config: uq.config}
+			neighbor.selectedFields = selected
+			if err := neighbor.assignValues(jcolumns, jvalues); err != nil {
+				return err
+			}
+			nodes[len(nodes)-1].Edges.Parent = neighbor
+			return nil
+		}
+		// The edge is loaded by the join, and not by an additional query.
+		clone := *uq
+		clone.withParent = nil
+		uq = &clone
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, uq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -1325,7 +1649,7 @@ func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(uq.fields) > 0 {
 		_spec.Unique = uq.unique != nil && *uq.unique
 	}
-	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, uq.queryDriver(), _spec)
 }
 
 func (uq *UserQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -1419,136 +1743,1174 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
-// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
-// updated, deleted or "selected ... for update" by other sessions, until the transaction is
-// either committed or rolled-back.
-func (uq *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
-	if uq.driver.Dialect() == dialect.Postgres {
-		uq.Unique(false)
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (uq *UserQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: user.Label}
+	if len(uq.predicates) > 0 {
+		selector := sql.Dialect(uq.driver.Dialect()).Select().From(sql.Table(user.Table))
+		for _, p := range uq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
 	}
-	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
-		s.ForUpdate(opts...)
-	})
-	return uq
+	return err
 }
 
-// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
-// on any rows that are read. Other sessions can read the rows, but cannot modify them
-// until your transaction commits.
-func (uq *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
-	if uq.driver.Dialect() == dialect.Postgres {
-		uq.Unique(false)
+// WhereP appends storage-level predicates to the UserQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (uq *UserQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.User, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.User(ps[i]))
 	}
-	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
-		s.ForShare(opts...)
-	})
-	return uq
+	uq.predicates = append(uq.predicates, wps...)
 }
 
-// Modify adds a query modifier for attaching custom logic to queries.
-func (uq *UserQuery) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
-	uq.modifiers = append(uq.modifiers, modifiers...)
-	return uq.Select()
-}
-
-// WithNamedPets tells the query-builder to eager-load the nodes that are connected to the "pets"
-// edge with the given name. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithNamedPets(name string, opts ...func(*PetQuery)) *UserQuery {
-	query := &PetQuery{config: uq.config}
-	for _, opt := range opts {
-		opt(query)
+// CountEstimate returns an estimation of the count of the given query, based on the statistics of the
+// database instead of scanning the table. In PostgreSQL, unfiltered queries use the table statistics
+// (pg_class.reltuples), and filtered queries use the row estimation of the query planner. In MySQL, the
+// estimation of the query optimizer is used. Other dialects fall back to an exact count.
+func (uq *UserQuery) CountEstimate(ctx context.Context) (int, error) {
+	if err := uq.prepareQuery(ctx); err != nil {
+		return 0, err
 	}
-	if uq.withNamedPets == nil {
-		uq.withNamedPets = make(map[string]*PetQuery)
+	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
 	}
-	uq.withNamedPets[name] = query
-	return uq
+	_spec.Node.Columns = nil
+	_spec.Unique = uq.unique != nil && *uq.unique
+	return sqlgraph.EstimateNodes(ctx, uq.queryDriver(), _spec)
 }
 
-// WithNamedFiles tells the query-builder to eager-load the nodes that are connected to the "files"
-// edge with the given name. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithNamedFiles(name string, opts ...func(*FileQuery)) *UserQuery {
-	query := &FileQuery{config: uq.config}
-	for _, opt := range opts {
-		opt(query)
-	}
-	if uq.withNamedFiles == nil {
-		uq.withNamedFiles = make(map[string]*FileQuery)
+// CountEstimateX is like CountEstimate, but panics if an error occurs.
+func (uq *UserQuery) CountEstimateX(ctx context.Context) int {
+	count, err := uq.CountEstimate(ctx)
+	if err != nil {
+		panic(err)
 	}
-	uq.withNamedFiles[name] = query
-	return uq
+	return count
 }
 
-// WithNamedGroups tells the query-builder to eager-load the nodes that are connected to the "groups"
-// edge with the given name. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithNamedGroups(name string, opts ...func(*GroupQuery)) *UserQuery {
-	query := &GroupQuery{config: uq.config}
-	for _, opt := range opts {
-		opt(query)
-	}
-	if uq.withNamedGroups == nil {
-		uq.withNamedGroups = make(map[string]*GroupQuery)
-	}
-	uq.withNamedGroups[name] = query
+// Sample limits the query to a random sample of approximately the given percentage (0-100) of the
+// User rows. In PostgreSQL, it uses the TABLESAMPLE SYSTEM clause, which samples table pages
+// instead of scanning the entire table. Other dialects filter the rows using a random predicate.
+func (uq *UserQuery) Sample(percent float64) *UserQuery {
+	uq.predicates = append(uq.predicates, sql.Sample(percent))
 	return uq
 }
 
-// WithNamedFriends tells the query-builder to eager-load the nodes that are connected to the "friends"
-// edge with the given name. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithNamedFriends(name string, opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
-	for _, opt := range opts {
-		opt(query)
-	}
-	if uq.withNamedFriends == nil {
-		uq.withNamedFriends = make(map[string]*UserQuery)
-	}
-	uq.withNamedFriends[name] = query
-	return uq
+// UserIterator iterates over the results of a User query, and decodes them one at a time.
+type UserIterator struct {
+	ctx   context.Context
+	query *UserQuery
+	rows  *sqlgraph.NodeIterator
+	batch int
+	nodes []*User
+	node  *User
+	err   error
 }
 
-// WithNamedFollowers tells the query-builder to eager-load the nodes that are connected to the "followers"
-// edge with the given name. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithNamedFollowers(name string, opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
-	for _, opt := range opts {
-		opt(query)
+// Iterate executes the query and returns an iterator over its results, that decodes the User entities
+// one at a time instead of loading all of them to memory. The eager-loaded edges of the query (e.g. With<E>)
+// are loaded in batches (see IterateBatch). Note that the query limit of the client is not applied, and the
+// iterator holds a database connection until it is closed. For example:
+//
+//	it, err := client.User.Query().Iterate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// In transactions, eager-loading edges requires a database driver that supports executing queries while
+// the rows of another query are open on the same connection (e.g. SQLite and PostgreSQL with pgx).
+func (uq *UserQuery) Iterate(ctx context.Context, opts ...IterateOption) (*UserIterator, error) {
+	o, err := newIterateOptions(opts)
+	if err != nil {
+		return nil, err
 	}
-	if uq.withNamedFollowers == nil {
-		uq.withNamedFollowers = make(map[string]*UserQuery)
+	if err := uq.prepareQuery(ctx); err != nil {
+		return nil, err
 	}
-	uq.withNamedFollowers[name] = query
-	return uq
-}
-
-// WithNamedFollowing tells the query-builder to eager-load the nodes that are connected to the "following"
-// edge with the given name. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithNamedFollowing(name string, opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
-	for _, opt := range opts {
-		opt(query)
+	it := &UserIterator{ctx: ctx, query: uq, batch: o.batch}
+	var (
+		withFKs     = uq.withFKs
+		_spec       = uq.querySpec()
+		loadedTypes = [11]bool{
+			uq.withCard != nil,
+			uq.withPets != nil,
+			uq.withFiles != nil,
+			uq.withGroups != nil,
+			uq.withFriends != nil,
+			uq.withFollowers != nil,
+			uq.withFollowing != nil,
+			uq.withTeam != nil,
+			uq.withSpouse != nil,
+			uq.withChildren != nil,
+			uq.withParent != nil,
+		}
+	)
+	if uq.withSpouse != nil || uq.withParent != nil {
+		withFKs = true
 	}
-	if uq.withNamedFollowing == nil {
-		uq.withNamedFollowing = make(map[string]*UserQuery)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, user.ForeignKeys...)
 	}
-	uq.withNamedFollowing[name] = query
-	return uq
+	var (
+		selected *[11]bool
+		computed bool
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*User).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &User{config: uq.config}
+		it.nodes = append(it.nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		if !computed {
+			selected, computed = (*User).selectFields(nil, columns), true
+		}
+		node.selectedFields = selected
+		return node.assignValues(columns, values)
+	}
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
+	if it.rows, err = sqlgraph.IterateNodes(ctx, uq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	return it, nil
 }
 
-// WithNamedChildren tells the query-builder to eager-load the nodes that are connected to the "children"
-// edge with the given name. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithNamedChildren(name string, opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
-	for _, opt := range opts {
-		opt(query)
+// iterateLoad loads the eager-loading edges of the given batch of nodes of an iterator.
+func (uq *UserQuery) iterateLoad(ctx context.Context, nodes []*User) ([]*User, error) {
+	query := *uq
+	uq = &query
+	uq.withCard = uq.withCard.Clone()
+	uq.withPets = uq.withPets.Clone()
+	if named := uq.withNamedPets; named != nil {
+		uq.withNamedPets = make(map[string]*PetQuery, len(named))
+		for name, q := range named {
+			uq.withNamedPets[name] = q.Clone()
+		}
 	}
-	if uq.withNamedChildren == nil {
-		uq.withNamedChildren = make(map[string]*UserQuery)
+	uq.withFiles = uq.withFiles.Clone()
+	if named := uq.withNamedFiles; named != nil {
+		uq.withNamedFiles = make(map[string]*FileQuery, len(named))
+		for name, q := range named {
+			uq.withNamedFiles[name] = q.Clone()
+		}
+	}
+	uq.withGroups = uq.withGroups.Clone()
+	if named := uq.withNamedGroups; named != nil {
+		uq.withNamedGroups = make(map[string]*GroupQuery, len(named))
+		for name, q := range named {
+			uq.withNamedGroups[name] = q.Clone()
+		}
+	}
+	uq.withFriends = uq.withFriends.Clone()
+	if named := uq.withNamedFriends; named != nil {
+		uq.withNamedFriends = make(map[string]*UserQuery, len(named))
+		for name, q := range named {
+			uq.withNamedFriends[name] = q.Clone()
+		}
+	}
+	uq.withFollowers = uq.withFollowers.Clone()
+	if named := uq.withNamedFollowers; named != nil {
+		uq.withNamedFollowers = make(map[string]*UserQuery, len(named))
+		for name, q := range named {
+			uq.withNamedFollowers[name] = q.Clone()
+		}
+	}
+	uq.withFollowing = uq.withFollowing.Clone()
+	if named := uq.withNamedFollowing; named != nil {
+		uq.withNamedFollowing = make(map[string]*UserQuery, len(named))
+		for name, q := range named {
+			uq.withNamedFollowing[name] = q.Clone()
+		}
+	}
+	uq.withTeam = uq.withTeam.Clone()
+	uq.withSpouse = uq.withSpouse.Clone()
+	uq.withChildren = uq.withChildren.Clone()
+	if named := uq.withNamedChildren; named != nil {
+		uq.withNamedChildren = make(map[string]*UserQuery, len(named))
+		for name, q := range named {
+			uq.withNamedChildren[name] = q.Clone()
+		}
+	}
+	uq.withParent = uq.withParent.Clone()
+	if query := uq.withCard; query != nil {
+		if err := uq.loadCard(ctx, query, nodes, nil,
+			func(n *User, e *Card) { n.Edges.Card = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withPets; query != nil {
+		if err := uq.loadPets(ctx, query, nodes,
+			func(n *User) { n.Edges.Pets = []*Pet{} },
+			func(n *User, e *Pet) { n.Edges.Pets = append(n.Edges.Pets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFiles; query != nil {
+		if err := uq.loadFiles(ctx, query, nodes,
+			func(n *User) { n.Edges.Files = []*File{} },
+			func(n *User, e *File) { n.Edges.Files = append(n.Edges.Files, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withGroups; query != nil {
+		if err := uq.loadGroups(ctx, query, nodes,
+			func(n *User) { n.Edges.Groups = []*Group{} },
+			func(n *User, e *Group) { n.Edges.Groups = append(n.Edges.Groups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFriends; query != nil {
+		if err := uq.loadFriends(ctx, query, nodes,
+			func(n *User) { n.Edges.Friends = []*User{} },
+			func(n *User, e *User) { n.Edges.Friends = append(n.Edges.Friends, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFollowers; query != nil {
+		if err := uq.loadFollowers(ctx, query, nodes,
+			func(n *User) { n.Edges.Followers = []*User{} },
+			func(n *User, e *User) { n.Edges.Followers = append(n.Edges.Followers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFollowing; query != nil {
+		if err := uq.loadFollowing(ctx, query, nodes,
+			func(n *User) { n.Edges.Following = []*User{} },
+			func(n *User, e *User) { n.Edges.Following = append(n.Edges.Following, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withTeam; query != nil {
+		if err := uq.loadTeam(ctx, query, nodes, nil,
+			func(n *User, e *Pet) { n.Edges.Team = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSpouse; query != nil {
+		if err := uq.loadSpouse(ctx, query, nodes, nil,
+			func(n *User, e *User) { n.Edges.Spouse = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withChildren; query != nil {
+		if err := uq.loadChildren(ctx, query, nodes,
+			func(n *User) { n.Edges.Children = []*User{} },
+			func(n *User, e *User) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withParent; query != nil {
+		if err := uq.loadParent(ctx, query, nodes, nil,
+			func(n *User, e *User) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedPets {
+		if err := uq.loadPets(ctx, query, nodes,
+			func(n *User) { n.appendNamedPets(name) },
+			func(n *User, e *Pet) { n.appendNamedPets(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedFiles {
+		if err := uq.loadFiles(ctx, query, nodes,
+			func(n *User) { n.appendNamedFiles(name) },
+			func(n *User, e *File) { n.appendNamedFiles(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedGroups {
+		if err := uq.loadGroups(ctx, query, nodes,
+			func(n *User) { n.appendNamedGroups(name) },
+			func(n *User, e *Group) { n.appendNamedGroups(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedFriends {
+		if err := uq.loadFriends(ctx, query, nodes,
+			func(n *User) { n.appendNamedFriends(name) },
+			func(n *User, e *User) { n.appendNamedFriends(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedFollowers {
+		if err := uq.loadFollowers(ctx, query, nodes,
+			func(n *User) { n.appendNamedFollowers(name) },
+			func(n *User, e *User) { n.appendNamedFollowers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedFollowing {
+		if err := uq.loadFollowing(ctx, query, nodes,
+			func(n *User) { n.appendNamedFollowing(name) },
+			func(n *User, e *User) { n.appendNamedFollowing(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedChildren {
+		if err := uq.loadChildren(ctx, query, nodes,
+			func(n *User) { n.appendNamedChildren(name) },
+			func(n *User, e *User) { n.appendNamedChildren(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// Next advances the iterator to the next User, and reports if there was one. It returns false
+// when the results are exhausted or an error occurred, and the Err method should be checked in this case.
+func (it *UserIterator) Next() bool {
+	it.node = nil
+	if it.err != nil {
+		return false
+	}
+	if len(it.nodes) == 0 {
+		it.nodes = make([]*User, 0, it.batch)
+		for len(it.nodes) < it.batch && it.rows.Next() {
+		}
+		if it.err = it.rows.Err(); it.err != nil || len(it.nodes) == 0 {
+			return false
+		}
+		if it.nodes, it.err = it.query.iterateLoad(it.ctx, it.nodes); it.err != nil {
+			return false
+		}
+	}
+	it.node, it.nodes = it.nodes[0], it.nodes[1:]
+	return true
+}
+
+// Value returns the current User of the iterator.
+func (it *UserIterator) Value() *User {
+	return it.node
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *UserIterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator, and releases its database connection.
+func (it *UserIterator) Close() error {
+	it.nodes = nil
+	return it.rows.Close()
+}
+
+// Join returns a builder for joining the User entities with the entities of the given table, that
+// belongs to one of the types that are connected to User by an edge. The results are returned
+// as typed rows holding both entities. Note that the joined entities are queried using their own query
+// builder, and therefore, their privacy policies are applied as usual.
+//
+//	rows, err := client.User.Query().
+//		Join(card.Table).
+//		On(left, right).
+//		All(ctx)
+//
+func (uq *UserQuery) Join(table string) *UserJoin {
+	return &UserJoin{query: uq, table: table}
+}
+
+// UserJoinRow is a row that is returned by UserJoin. It holds the User entity,
+// and the joined entity in the field of its type. The rest of the fields are nil.
+type UserJoinRow struct {
+	User  *User
+	Card  *Card
+	Pet   *Pet
+	File  *File
+	Group *Group
+}
+
+// UserJoin is the builder for joining User entities with the entities of another type.
+type UserJoin struct {
+	query   *UserQuery
+	table   string
+	on      []string
+	columns []string
+}
+
+// On sets the columns of the join condition. The left column belongs
+// to User, and the right column belongs to the joined table.
+func (uj *UserJoin) On(left, right string) *UserJoin {
+	uj.on = []string{left, right}
+	return uj
+}
+
+// Select sets the columns of the joined table to be selected. By default, all columns are selected.
+func (uj *UserJoin) Select(columns ...string) *UserJoin {
+	uj.columns = append(uj.columns, columns...)
+	return uj
+}
+
+// All executes the join query and returns the joined rows.
+func (uj *UserJoin) All(ctx context.Context) ([]*UserJoinRow, error) {
+	if len(uj.on) != 2 {
+		return nil, &ValidationError{Name: uj.table, err: errors.New("ent: missing join condition for User")}
+	}
+	if !user.ValidColumn(uj.on[0]) {
+		return nil, &ValidationError{Name: uj.on[0], err: fmt.Errorf("ent: invalid join column %q for User", uj.on[0])}
+	}
+	var (
+		joined   *sql.Selector
+		jcolumns []string
+		scan     func(*UserJoinRow) ([]interface{}, func([]interface{}) error, error)
+	)
+	switch uj.table {
+	case card.Table:
+		if !card.ValidColumn(uj.on[1]) {
+			return nil, &ValidationError{Name: uj.on[1], err: fmt.Errorf("ent: invalid join column %q for Card", uj.on[1])}
+		}
+		query := (&CardClient{config: uj.query.config}).Query()
+		if len(uj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{card.FieldID, uj.on[1]}, uj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = card.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *UserJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.Card = &Card{config: uj.query.config}
+			values, err := r.Card.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.Card.assignValues(jcolumns, values) }, err
+		}
+	case pet.Table:
+		if !pet.ValidColumn(uj.on[1]) {
+			return nil, &ValidationError{Name: uj.on[1], err: fmt.Errorf("ent: invalid join column %q for Pet", uj.on[1])}
+		}
+		query := (&PetClient{config: uj.query.config}).Query()
+		if len(uj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{pet.FieldID, uj.on[1]}, uj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = pet.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *UserJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.Pet = &Pet{config: uj.query.config}
+			values, err := r.Pet.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.Pet.assignValues(jcolumns, values) }, err
+		}
+	case file.Table:
+		if !file.ValidColumn(uj.on[1]) {
+			return nil, &ValidationError{Name: uj.on[1], err: fmt.Errorf("ent: invalid join column %q for File", uj.on[1])}
+		}
+		query := (&FileClient{config: uj.query.config}).Query()
+		if len(uj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{file.FieldID, uj.on[1]}, uj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = file.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *UserJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.File = &File{config: uj.query.config}
+			values, err := r.File.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.File.assignValues(jcolumns, values) }, err
+		}
+	case group.Table:
+		if !group.ValidColumn(uj.on[1]) {
+			return nil, &ValidationError{Name: uj.on[1], err: fmt.Errorf("ent: invalid join column %q for Group", uj.on[1])}
+		}
+		query := (&GroupClient{config: uj.query.config}).Query()
+		if len(uj.columns) > 0 {
+			// The identifier and the join column are selected by the subquery, even if they were not requested.
+			seen := make(map[string]bool)
+			for _, c := range append([]string{group.FieldID, uj.on[1]}, uj.columns...) {
+				if !seen[c] {
+					seen[c] = true
+					query.fields = append(query.fields, c)
+				}
+			}
+		}
+		if err := query.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if jcolumns = query.fields; len(jcolumns) == 0 {
+			jcolumns = group.Columns
+		}
+		joined = query.sqlQuery(ctx)
+		scan = func(r *UserJoinRow) ([]interface{}, func([]interface{}) error, error) {
+			r.Group = &Group{config: uj.query.config}
+			values, err := r.Group.scanValues(jcolumns)
+			return values, func(values []interface{}) error { return r.Group.assignValues(jcolumns, values) }, err
+		}
+	default:
+		return nil, &ValidationError{Name: uj.table, err: fmt.Errorf("ent: cannot join User with table %q", uj.table)}
+	}
+	if err := uj.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := uj.query.fields
+	if len(columns) == 0 {
+		columns = user.Columns
+	}
+	selector := uj.query.sqlQuery(ctx)
+	selector.Join(joined).On(selector.C(uj.on[0]), joined.C(uj.on[1]))
+	selector.AppendSelect(joined.Columns(jcolumns...)...)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uj.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*UserJoinRow
+	for rows.Next() {
+		r := &UserJoinRow{User: &User{config: uj.query.config}}
+		values, err := r.User.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		jvalues, assign, err := scan(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(append(values, jvalues...)...); err != nil {
+			return nil, err
+		}
+		if err := r.User.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		if err := assign(jvalues); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (uj *UserJoin) AllX(ctx context.Context) []*UserJoinRow {
+	rows, err := uj.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// LoadStrategy sets the strategy for eager-loading the User entities of the query,
+// when it is used for loading the edge of another query. See LoadStrategyJoin for details.
+func (uq *UserQuery) LoadStrategy(s LoadStrategy) *UserQuery {
+	uq.loadStrategy = s
+	return uq
+}
+
+// joinable reports if the query is loaded using a join in the query of the entities that reference it.
+func (uq *UserQuery) joinable() bool {
+	if uq.loadStrategy != LoadStrategyJoin || uq.limit != nil || uq.offset != nil {
+		return false
+	}
+	if uq.withCard != nil {
+		return false
+	}
+	if uq.withPets != nil || len(uq.withNamedPets) > 0 {
+		return false
+	}
+	if uq.withFiles != nil || len(uq.withNamedFiles) > 0 {
+		return false
+	}
+	if uq.withGroups != nil || len(uq.withNamedGroups) > 0 {
+		return false
+	}
+	if uq.withFriends != nil || len(uq.withNamedFriends) > 0 {
+		return false
+	}
+	if uq.withFollowers != nil || len(uq.withNamedFollowers) > 0 {
+		return false
+	}
+	if uq.withFollowing != nil || len(uq.withNamedFollowing) > 0 {
+		return false
+	}
+	if uq.withTeam != nil {
+		return false
+	}
+	if uq.withSpouse != nil {
+		return false
+	}
+	if uq.withChildren != nil || len(uq.withNamedChildren) > 0 {
+		return false
+	}
+	if uq.withParent != nil {
+		return false
+	}
+	if len(uq.modifiers) > 0 {
+		return false
+	}
+	// Interceptors that are not traversers may modify the results of the query.
+	for _, inter := range uq.inters {
+		if _, ok := inter.(Traverser); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uq *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uq *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (uq *UserQuery) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	uq.modifiers = append(uq.modifiers, modifiers...)
+	return uq.Select()
+}
+
+// WithNamedPets tells the query-builder to eager-load the nodes that are connected to the "pets"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedPets(name string, opts ...func(*PetQuery)) *UserQuery {
+	query := (&PetClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedPets == nil {
+		uq.withNamedPets = make(map[string]*PetQuery)
+	}
+	uq.withNamedPets[name] = query
+	return uq
+}
+
+// WithNamedFiles tells the query-builder to eager-load the nodes that are connected to the "files"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedFiles(name string, opts ...func(*FileQuery)) *UserQuery {
+	query := (&FileClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedFiles == nil {
+		uq.withNamedFiles = make(map[string]*FileQuery)
+	}
+	uq.withNamedFiles[name] = query
+	return uq
+}
+
+// WithNamedGroups tells the query-builder to eager-load the nodes that are connected to the "groups"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedGroups(name string, opts ...func(*GroupQuery)) *UserQuery {
+	query := (&GroupClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedGroups == nil {
+		uq.withNamedGroups = make(map[string]*GroupQuery)
+	}
+	uq.withNamedGroups[name] = query
+	return uq
+}
+
+// WithNamedFriends tells the query-builder to eager-load the nodes that are connected to the "friends"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedFriends(name string, opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedFriends == nil {
+		uq.withNamedFriends = make(map[string]*UserQuery)
+	}
+	uq.withNamedFriends[name] = query
+	return uq
+}
+
+// WithNamedFollowers tells the query-builder to eager-load the nodes that are connected to the "followers"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedFollowers(name string, opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedFollowers == nil {
+		uq.withNamedFollowers = make(map[string]*UserQuery)
+	}
+	uq.withNamedFollowers[name] = query
+	return uq
+}
+
+// WithNamedFollowing tells the query-builder to eager-load the nodes that are connected to the "following"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedFollowing(name string, opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedFollowing == nil {
+		uq.withNamedFollowing = make(map[string]*UserQuery)
+	}
+	uq.withNamedFollowing[name] = query
+	return uq
+}
+
+// WithNamedChildren tells the query-builder to eager-load the nodes that are connected to the "children"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedChildren(name string, opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedChildren == nil {
+		uq.withNamedChildren = make(map[string]*UserQuery)
 	}
 	uq.withNamedChildren[name] = query
 	return uq
 }
 
+// Project returns a builder for querying the given fields into UserProjection structs,
+// instead of full User entities. Projections do not hold edges or the client config,
+// and are useful for list endpoints where the full entities are not needed. If no fields
+// are given, all fields are selected.
+//
+//	items, err := client.User.Query().
+//		Project(user.FieldID, user.FieldOptionalInt).
+//		All(ctx)
+//
+func (uq *UserQuery) Project(fields ...string) *UserProject {
+	uq.fields = append(uq.fields, fields...)
+	return &UserProject{query: uq}
+}
+
+// UserProjection is a lightweight projection of the User entity that is returned by
+// UserProject. Fields that were not selected by the query hold their zero values.
+type UserProjection struct {
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OptionalInt holds the value of the "optional_int" field.
+	OptionalInt int `json:"optional_int,omitempty"`
+	// Age holds the value of the "age" field.
+	Age int `json:"age,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"first_name" graphql:"first_name"`
+	// Last holds the value of the "last" field.
+	Last string `json:"last,omitempty" graphql:"last_name"`
+	// Nickname holds the value of the "nickname" field.
+	Nickname string `json:"nickname,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone,omitempty"`
+	// Password holds the value of the "password" field.
+	Password string `json:"-"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
+	// Employment holds the value of the "employment" field.
+	Employment user.Employment `json:"employment,omitempty"`
+	// SSOCert holds the value of the "SSOCert" field.
+	SSOCert string `json:"SSOCert,omitempty"`
+}
+
+// UserProject is the builder for querying User fields into projections.
+type UserProject struct {
+	query *UserQuery
+}
+
+// All executes the query and returns the projections of the matched entities.
+func (up *UserProject) All(ctx context.Context) ([]*UserProjection, error) {
+	if err := up.query.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	columns := up.query.fields
+	if len(columns) == 0 {
+		columns = user.Columns
+	}
+	rows := &sql.Rows{}
+	query, args := up.query.sqlQuery(ctx).Query()
+	if err := up.query.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		// The values are scanned and converted by a single entity,
+		// that is reset for each row and never returned to the caller.
+		e      = &User{}
+		result []*UserProjection
+	)
+	for rows.Next() {
+		*e = User{}
+		values, err := e.scanValues(columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		if err := e.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		result = append(result, &UserProjection{
+			ID:          e.ID,
+			OptionalInt: e.OptionalInt,
+			Age:         e.Age,
+			Name:        e.Name,
+			Last:        e.Last,
+			Nickname:    e.Nickname,
+			Address:     e.Address,
+			Phone:       e.Phone,
+			Password:    e.Password,
+			Role:        e.Role,
+			Employment:  e.Employment,
+			SSOCert:     e.SSOCert,
+		})
+	}
+	return result, rows.Err()
+}
+
+// AllX is like All, but panics if an error occurs.
+func (up *UserProject) AllX(ctx context.Context) []*UserProjection {
+	items, err := up.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return items
+}
+
+// onlyShared is like Only, but shares the result between identical concurrent calls.
+func (uq *UserQuery) onlyShared(ctx context.Context) (*User, error) {
+	if err := uq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	uq.Limit(2)
+	// The key is computed on a copy of the builder, as the
+	// intermediate selector is modified by sqlQuery.
+	kq := *uq
+	kq.sql = uq.sql.Clone()
+	query, args := kq.sqlQuery(ctx).Query()
+	var scope string
+	if uq.singleflightScope != nil {
+		scope = uq.singleflightScope(ctx)
+	}
+	// The shared query is detached from the cancellation of the first caller,
+	// and each caller stops waiting for it when its own context is done.
+	ch := uq.singleflight.DoChan(singleflightKey(scope, user.Label, query, args), func() (interface{}, error) {
+		nodes, err := uq.sqlAll(detachedContext{ctx})
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodes) {
+		case 1:
+			return nodes[0], nil
+		case 0:
+			return nil, uq.sqlNotFound()
+		default:
+			return nil, &NotSingularError{user.Label}
+		}
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		node := *res.Val.(*User)
+		return &node, nil
+	}
+}
+
+// SelectMask selects the columns that are needed for the fields of the given field mask paths.
+// An empty mask selects all fields, paths of edges are ignored (edges are loaded using the With
+// methods), and unknown paths fail the query. For example:
+//
+//	client.User.Query().
+//		SelectMask(req.GetReadMask().GetPaths()...).
+//		All(ctx)
+//
+func (uq *UserQuery) SelectMask(paths ...string) *UserSelect {
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		name := p
+		if i := strings.IndexByte(p, '.'); i > 0 {
+			name = p[:i]
+		}
+		switch name {
+		case user.FieldID:
+		case user.EdgeCard, user.EdgePets, user.EdgeFiles, user.EdgeGroups, user.EdgeFriends, user.EdgeFollowers, user.EdgeFollowing, user.EdgeTeam, user.EdgeSpouse, user.EdgeChildren, user.EdgeParent:
+		case "optional_int":
+			fields = append(fields, user.FieldOptionalInt)
+		case "age":
+			fields = append(fields, user.FieldAge)
+		case "name":
+			fields = append(fields, user.FieldName)
+		case "last":
+			fields = append(fields, user.FieldLast)
+		case "nickname":
+			fields = append(fields, user.FieldNickname)
+		case "address":
+			fields = append(fields, user.FieldAddress)
+		case "phone":
+			fields = append(fields, user.FieldPhone)
+		case "password":
+			fields = append(fields, user.FieldPassword)
+		case "role":
+			fields = append(fields, user.FieldRole)
+		case "employment":
+			fields = append(fields, user.FieldEmployment)
+		case "SSOCert":
+			fields = append(fields, user.FieldSSOCert)
+		default:
+			// Unknown paths are reported by the query validation.
+			fields = append(fields, name)
+		}
+	}
+	if len(paths) > 0 && len(fields) == 0 {
+		// The mask holds only the ID or edges.
+		fields = append(fields, user.FieldID)
+	}
+	return uq.Select(fields...)
+}
+
+// OrderByField orders the query by the field with the given name (e.g. an API parameter). It fails
+// with a *ValidationError if the field is not one of the user.OrderFields, or if the
+// direction is invalid, and the query is not modified. For example:
+//
+//	dir, err := ent.ParseDirection(r.URL.Query().Get("dir"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := query.OrderByField(r.URL.Query().Get("sort"), dir); err != nil {
+//		return err
+//	}
+//
+func (uq *UserQuery) OrderByField(name string, dir Direction) error {
+	var column string
+	switch name {
+	case "id":
+		column = user.FieldID
+	case "nickname":
+		column = user.FieldNickname
+	case "phone":
+		column = user.FieldPhone
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf(`ent: field %q is not allowed for ordering User`, name)}
+	}
+	switch dir {
+	case DirectionAsc:
+		uq.Order(Asc(column))
+	case DirectionDesc:
+		uq.Order(Desc(column))
+	default:
+		return &ValidationError{Name: name, err: fmt.Errorf("ent: invalid order direction %q", dir)}
+	}
+	return nil
+}
+
+// UserPage is a page of User entities returned by the Paginate method.
+type UserPage struct {
+	Nodes []*User `json:"nodes"`
+	// Cursors holds the cursors of the nodes. i.e. Cursors[i] points to Nodes[i].
+	Cursors  []Cursor `json:"cursors"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns the page of User entities that are after or before the given
+// cursors, limited to the first or last given number of entities (Relay-style). By default, the pages are ordered
+// by the ID field, and the PaginateOrder option orders them by other fields. Note that the order steps of the
+// query (e.g. Order) are ignored, and the query is not modified. For example:
+//
+//	first := 10
+//	page, err := client.User.Query().Paginate(ctx, nil, &first, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	next, err := client.User.Query().Paginate(ctx, page.PageInfo.EndCursor, &first, nil, nil)
+//
+func (uq *UserQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, opts ...PaginateOption) (*UserPage, error) {
+	o, err := newPaginateOptions(first, last, opts)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]paginateOrder, 0, len(o.orders)+1)
+	for _, order := range o.orders {
+		switch order.name {
+		case user.FieldID:
+			order.column = user.FieldID
+		default:
+			return nil, &ValidationError{Name: order.name, err: fmt.Errorf(`ent: field %q is not allowed for paginating User`, order.name)}
+		}
+		orders = append(orders, order)
+	}
+	if n := len(orders); n == 0 || orders[n-1].column != user.FieldID {
+		order := paginateOrder{name: user.FieldID, column: user.FieldID}
+		if n > 0 {
+			order.desc = orders[n-1].desc
+		}
+		orders = append(orders, order)
+	}
+	query := uq.Clone()
+	query.order = nil
+	for _, c := range []struct {
+		cursor *Cursor
+		before bool
+	}{{after, false}, {before, true}} {
+		if c.cursor == nil {
+			continue
+		}
+		values, err := query.cursorValues(*c.cursor, orders)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(cursorPredicate(orders, values, c.before))
+	}
+	for _, order := range orders {
+		// Pages that are limited by the last argument are queried in reverse order.
+		if order.desc != (last != nil) {
+			query.Order(Desc(order.column))
+		} else {
+			query.Order(Asc(order.column))
+		}
+	}
+	limit := first
+	if last != nil {
+		limit = last
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &UserPage{}
+	if limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+		page.PageInfo.HasNextPage = first != nil
+		page.PageInfo.HasPreviousPage = last != nil
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		page.PageInfo.HasNextPage = before != nil
+	} else {
+		page.PageInfo.HasPreviousPage = page.PageInfo.HasPreviousPage || after != nil
+	}
+	page.Nodes, page.Cursors = nodes, make([]Cursor, len(nodes))
+	for i, n := range nodes {
+		values := make([]interface{}, len(orders))
+		for j, order := range orders {
+			switch order.column {
+			case user.FieldID:
+				values[j] = n.ID
+			}
+		}
+		if page.Cursors[i], err = encodeCursor(orders, values); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.PageInfo.StartCursor, page.PageInfo.EndCursor = &page.Cursors[0], &page.Cursors[n-1]
+	}
+	return page, nil
+}
+
+// cursorValues decodes the values of the order keys of the given cursor.
+func (uq *UserQuery) cursorValues(c Cursor, orders []paginateOrder) ([]interface{}, error) {
+	raw, err := decodeCursor(c, orders)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		switch order.column {
+		case user.FieldID:
+			var v int
+			err = json.Unmarshal(raw[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, &ValidationError{Name: "cursor", err: fmt.Errorf("ent: malformed cursor value of field %q: %w", order.name, err)}
+		}
+	}
+	return values, nil
+}
+
+// allWithQueryLimit executes the query, and applies the given limit policy in case it has no limit.
+func (uq *UserQuery) allWithQueryLimit(ctx context.Context, p *QueryLimitPolicy) ([]*User, error) {
+	if uq.limit != nil {
+		return uq.sqlAll(ctx)
+	}
+	// Query one extra row to detect if the policy was exceeded.
+	limit := p.Max + 1
+	uq.limit = &limit
+	defer func() { uq.limit = nil }()
+	nodes, err := uq.sqlAll(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(nodes) <= p.Max:
+		return nodes, nil
+	case p.Reject:
+		return nil, &QueryLimitError{label: user.Label, max: p.Max}
+	default:
+		return nodes[:p.Max], nil
+	}
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	config
@@ -1588,7 +2950,7 @@ func (ugb *UserGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ugb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ugb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -1634,7 +2996,7 @@ func (us *UserSelect) Scan(ctx context.Context, v interface{}) error {
 func (us *UserSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := us.sql.Query()
-	if err := us.driver.Query(ctx, query, args, rows); err != nil {
+	if err := us.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
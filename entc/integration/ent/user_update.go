@@ -56,6 +56,25 @@ func (uu *UserUpdate) AddOptionalInt(i int) *UserUpdate {
 	return uu
 }
 
+// SetOptionalIntIfGreater sets the "optional_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uu *UserUpdate) SetOptionalIntIfGreater(i int) *UserUpdate {
+	uu.mutation.SetOptionalIntIfGreater(i)
+	return uu
+}
+
+// BitAndOptionalInt applies a bitwise AND with i on the "optional_int" field in the database.
+func (uu *UserUpdate) BitAndOptionalInt(i int) *UserUpdate {
+	uu.mutation.BitAndOptionalInt(i)
+	return uu
+}
+
+// BitOrOptionalInt applies a bitwise OR with i on the "optional_int" field in the database.
+func (uu *UserUpdate) BitOrOptionalInt(i int) *UserUpdate {
+	uu.mutation.BitOrOptionalInt(i)
+	return uu
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (uu *UserUpdate) ClearOptionalInt() *UserUpdate {
 	uu.mutation.ClearOptionalInt()
@@ -75,6 +94,25 @@ func (uu *UserUpdate) AddAge(i int) *UserUpdate {
 	return uu
 }
 
+// SetAgeIfGreater sets the "age" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uu *UserUpdate) SetAgeIfGreater(i int) *UserUpdate {
+	uu.mutation.SetAgeIfGreater(i)
+	return uu
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field in the database.
+func (uu *UserUpdate) BitAndAge(i int) *UserUpdate {
+	uu.mutation.BitAndAge(i)
+	return uu
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field in the database.
+func (uu *UserUpdate) BitOrAge(i int) *UserUpdate {
+	uu.mutation.BitOrAge(i)
+	return uu
+}
+
 // SetName sets the "name" field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.mutation.SetName(s)
@@ -692,6 +730,27 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldOptionalInt,
 		})
 	}
+	if value, ok := uu.mutation.OptionalIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldOptionalInt,
+		})
+	}
+	if value, ok := uu.mutation.OptionalIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldOptionalInt,
+		})
+	}
+	if value, ok := uu.mutation.OptionalIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldOptionalInt,
+		})
+	}
 	if uu.mutation.OptionalIntCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -712,6 +771,27 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldAge,
 		})
 	}
+	if value, ok := uu.mutation.AgeIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAge,
+		})
+	}
+	if value, ok := uu.mutation.AgeBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAge,
+		})
+	}
+	if value, ok := uu.mutation.AgeBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAge,
+		})
+	}
 	if value, ok := uu.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -1325,7 +1405,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -1337,9 +1417,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields     []string
+	conditions []predicate.User
+	hooks      []Hook
+	mutation   *UserMutation
+}
+
+// Where appends a list of conditions to the UserUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.conditions = append(uuo.conditions, ps...)
+	return uuo
 }
 
 // SetOptionalInt sets the "optional_int" field.
@@ -1363,6 +1452,25 @@ func (uuo *UserUpdateOne) AddOptionalInt(i int) *UserUpdateOne {
 	return uuo
 }
 
+// SetOptionalIntIfGreater sets the "optional_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uuo *UserUpdateOne) SetOptionalIntIfGreater(i int) *UserUpdateOne {
+	uuo.mutation.SetOptionalIntIfGreater(i)
+	return uuo
+}
+
+// BitAndOptionalInt applies a bitwise AND with i on the "optional_int" field in the database.
+func (uuo *UserUpdateOne) BitAndOptionalInt(i int) *UserUpdateOne {
+	uuo.mutation.BitAndOptionalInt(i)
+	return uuo
+}
+
+// BitOrOptionalInt applies a bitwise OR with i on the "optional_int" field in the database.
+func (uuo *UserUpdateOne) BitOrOptionalInt(i int) *UserUpdateOne {
+	uuo.mutation.BitOrOptionalInt(i)
+	return uuo
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (uuo *UserUpdateOne) ClearOptionalInt() *UserUpdateOne {
 	uuo.mutation.ClearOptionalInt()
@@ -1382,6 +1490,25 @@ func (uuo *UserUpdateOne) AddAge(i int) *UserUpdateOne {
 	return uuo
 }
 
+// SetAgeIfGreater sets the "age" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uuo *UserUpdateOne) SetAgeIfGreater(i int) *UserUpdateOne {
+	uuo.mutation.SetAgeIfGreater(i)
+	return uuo
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field in the database.
+func (uuo *UserUpdateOne) BitAndAge(i int) *UserUpdateOne {
+	uuo.mutation.BitAndAge(i)
+	return uuo
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field in the database.
+func (uuo *UserUpdateOne) BitOrAge(i int) *UserUpdateOne {
+	uuo.mutation.BitOrAge(i)
+	return uuo
+}
+
 // SetName sets the "name" field.
 func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
 	uuo.mutation.SetName(s)
@@ -2015,6 +2142,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			}
 		}
 	}
+	if ps := uuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.OptionalInt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -2029,6 +2163,27 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldOptionalInt,
 		})
 	}
+	if value, ok := uuo.mutation.OptionalIntIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldOptionalInt,
+		})
+	}
+	if value, ok := uuo.mutation.OptionalIntBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldOptionalInt,
+		})
+	}
+	if value, ok := uuo.mutation.OptionalIntBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldOptionalInt,
+		})
+	}
 	if uuo.mutation.OptionalIntCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -2049,6 +2204,27 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldAge,
 		})
 	}
+	if value, ok := uuo.mutation.AgeIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAge,
+		})
+	}
+	if value, ok := uuo.mutation.AgeBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAge,
+		})
+	}
+	if value, ok := uuo.mutation.AgeBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAge,
+		})
+	}
 	if value, ok := uuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -2665,7 +2841,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: user.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -2673,3 +2851,123 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	}
 	return _node, nil
 }
+
+// SaveAsync adds the update of the User entity to a durable queue, and returns its idempotency key
+// without waiting for its execution. The enqueued mutations are executed by Client.ProcessAsync (or RunAsync),
+// where the hooks and validators of the builder are applied, and failed executions are retried.
+func (uuo *UserUpdateOne) SaveAsync(ctx context.Context) (string, error) {
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return "", &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	return enqueueAsync(ctx, uuo.config, uuo.mutation, id)
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by User.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (uu *UserUpdate) SetChanged(changes []FieldChange) (*UserUpdate, error) {
+	if err := setUserChanged(uu.mutation, changes); err != nil {
+		return nil, err
+	}
+	return uu, nil
+}
+
+// SetChanged sets the New values of the given changes (e.g. returned by User.Diff) on the
+// builder, and clears the fields that were changed to nil. An error is returned if one of the
+// changes refers to an unknown or immutable field, or holds a value of an unexpected type.
+func (uuo *UserUpdateOne) SetChanged(changes []FieldChange) (*UserUpdateOne, error) {
+	if err := setUserChanged(uuo.mutation, changes); err != nil {
+		return nil, err
+	}
+	return uuo, nil
+}
+
+// setUserChanged applies the given field changes on the User mutation.
+func setUserChanged(m *UserMutation, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+		if c.New == nil {
+			err = m.ClearField(c.Field)
+		} else {
+			err = m.SetField(c.Field, c.New)
+		}
+		if err != nil {
+			return fmt.Errorf("ent: applying change of field %q: %w", c.Field, err)
+		}
+	}
+	return nil
+}
+
+// From updates only the User entities that are joined with the rows of the given table, on the given columns
+// of the User table (left) and the joined table (right), and that match the given predicates of the joined table.
+// Unlike edge predicates, that are executed as sub-queries, the statement is executed using a join (e.g. UPDATE ... FROM).
+//
+//	client.User.Update().
+//		From(table, left, right, predicates...).
+//		Exec(ctx)
+//
+func (uu *UserUpdate) From(table, left, right string, ps ...func(*sql.Selector)) *UserUpdate {
+	uu.mutation.Where(sqlgraph.JoinTable(table, left, right, ps...))
+	return uu
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the User schema.
+func (uu *UserUpdate) ApplyPatch(p *UserPatch) (*UserUpdate, error) {
+	if err := applyUserPatch(uu.mutation, p); err != nil {
+		return nil, err
+	}
+	return uu, nil
+}
+
+// ApplyPatch sets the non-nil fields of the given patch on the builder, and clears the fields
+// that are listed in its Cleared list. An error is returned if one of the cleared fields is not
+// an optional field of the User schema.
+func (uuo *UserUpdateOne) ApplyPatch(p *UserPatch) (*UserUpdateOne, error) {
+	if err := applyUserPatch(uuo.mutation, p); err != nil {
+		return nil, err
+	}
+	return uuo, nil
+}
+
+// applyUserPatch applies the given patch on the User mutation.
+func applyUserPatch(m *UserMutation, p *UserPatch) error {
+	if p.OptionalInt != nil {
+		m.SetOptionalInt(*p.OptionalInt)
+	}
+	if p.Age != nil {
+		m.SetAge(*p.Age)
+	}
+	if p.Name != nil {
+		m.SetName(*p.Name)
+	}
+	if p.Last != nil {
+		m.SetLast(*p.Last)
+	}
+	if p.Nickname != nil {
+		m.SetNickname(*p.Nickname)
+	}
+	if p.Address != nil {
+		m.SetAddress(*p.Address)
+	}
+	if p.Phone != nil {
+		m.SetPhone(*p.Phone)
+	}
+	if p.Password != nil {
+		m.SetPassword(*p.Password)
+	}
+	if p.Employment != nil {
+		m.SetEmployment(*p.Employment)
+	}
+	if p.SSOCert != nil {
+		m.SetSSOCert(*p.SSOCert)
+	}
+	for _, c := range p.Cleared {
+		if err := m.ClearField(c); err != nil {
+			return fmt.Errorf("ent: applying patch: %w", err)
+		}
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 )
 
 // Card is the model entity for the Card schema.
+// Card holds the payment cards of the users.
 type Card struct {
 	config `json:"-"`
 	// ID of the ent.
@@ -203,11 +203,11 @@ func (cc *CardCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (cc *CardCreate) defaults() {
 	if _, ok := cc.mutation.CreateTime(); !ok {
-		v := card.DefaultCreateTime()
+		v := cc.config.now(card.DefaultCreateTime)
 		cc.mutation.SetCreateTime(v)
 	}
 	if _, ok := cc.mutation.UpdateTime(); !ok {
-		v := card.DefaultUpdateTime()
+		v := cc.config.now(card.DefaultUpdateTime)
 		cc.mutation.SetUpdateTime(v)
 	}
 	if _, ok := cc.mutation.Balance(); !ok {
@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -28,6 +30,7 @@ type CardQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Card
 	withOwner  *UserQuery
 	withSpec   *SpecQuery
@@ -69,7 +72,7 @@ func (cq *CardQuery) Order(o ...OrderFunc) *CardQuery {
 
 // QueryOwner chains the current query on the "owner" edge.
 func (cq *CardQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: cq.config}
+	query := (&UserClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -83,7 +86,7 @@ func (cq *CardQuery) QueryOwner() *UserQuery {
 
 // QuerySpec chains the current query on the "spec" edge.
 func (cq *CardQuery) QuerySpec() *SpecQuery {
-	query := &SpecQuery{config: cq.config}
+	query := (&SpecClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -103,7 +106,7 @@ func (cq *CardQuery) First(ctx context.Context) (*Card, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{card.Label}
+		return nil, cq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -125,7 +128,7 @@ func (cq *CardQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{card.Label}
+		err = cq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -152,7 +155,7 @@ func (cq *CardQuery) Only(ctx context.Context) (*Card, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{card.Label}
+		return nil, cq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{card.Label}
 	}
@@ -179,7 +182,7 @@ func (cq *CardQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{card.Label}
+		err = cq.gremlinNotFound()
 	default:
 		err = &NotSingularError{card.Label}
 	}
@@ -200,7 +203,17 @@ func (cq *CardQuery) All(ctx context.Context) ([]*Card, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.gremlinAll(ctx)
+	v, err := cq.intercept(ctx, "All", func(ctx context.Context, query *CardQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Card)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Card", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -214,10 +227,20 @@ func (cq *CardQuery) AllX(ctx context.Context) []*Card {
 
 // IDs executes the query and returns a list of Card IDs.
 func (cq *CardQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := cq.Select(card.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := cq.intercept(ctx, "IDs", func(ctx context.Context, query *CardQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(card.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -235,7 +258,17 @@ func (cq *CardQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.gremlinCount(ctx)
+	v, err := cq.intercept(ctx, "Count", func(ctx context.Context, query *CardQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -252,7 +285,61 @@ func (cq *CardQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.gremlinExist(ctx)
+	v, err := cq.intercept(ctx, "Exist", func(ctx context.Context, query *CardQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (cq *CardQuery) intercept(ctx context.Context, op string, fn func(context.Context, *CardQuery) (Value, error)) (Value, error) {
+	if len(cq.inters) == 0 {
+		return fn(ctx, cq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeCard,
+		Op:     op,
+		Limit:  cq.limit,
+		Offset: cq.offset,
+		Unique: cq.unique,
+		Fields: cq.fields,
+	}
+	for _, p := range cq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range cq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if cq.withOwner != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, card.EdgeOwner)
+		qc.EdgeQueries[card.EdgeOwner] = cq.withOwner
+	}
+	if cq.withSpec != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, card.EdgeSpec)
+		qc.EdgeQueries[card.EdgeSpec] = cq.withSpec
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CardQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *CardQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, cq, qc, qr, cq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -275,6 +362,7 @@ func (cq *CardQuery) Clone() *CardQuery {
 		limit:      cq.limit,
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		predicates: append([]predicate.Card{}, cq.predicates...),
 		withOwner:  cq.withOwner.Clone(),
 		withSpec:   cq.withSpec.Clone(),
@@ -288,7 +376,7 @@ func (cq *CardQuery) Clone() *CardQuery {
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CardQuery) WithOwner(opts ...func(*UserQuery)) *CardQuery {
-	query := &UserQuery{config: cq.config}
+	query := (&UserClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -299,7 +387,7 @@ func (cq *CardQuery) WithOwner(opts ...func(*UserQuery)) *CardQuery {
 // WithSpec tells the query-builder to eager-load the nodes that are connected to
 // the "spec" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CardQuery) WithSpec(opts ...func(*SpecQuery)) *CardQuery {
-	query := &SpecQuery{config: cq.config}
+	query := (&SpecClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -358,6 +446,16 @@ func (cq *CardQuery) Select(fields ...string) *CardSelect {
 }
 
 func (cq *CardQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cq); err != nil {
+				return err
+			}
+		}
+	}
 	if cq.path != nil {
 		prev, err := cq.path(ctx)
 		if err != nil {
@@ -381,7 +479,7 @@ func (cq *CardQuery) gremlinAll(ctx context.Context) ([]*Card, error) {
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := cq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var cs Cards
@@ -395,7 +493,7 @@ func (cq *CardQuery) gremlinAll(ctx context.Context) ([]*Card, error) {
 func (cq *CardQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := cq.gremlinQuery(ctx).Count().Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := cq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -404,7 +502,7 @@ func (cq *CardQuery) gremlinCount(ctx context.Context) (int, error) {
 func (cq *CardQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := cq.gremlinQuery(ctx).HasNext().Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := cq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -438,6 +536,11 @@ func (cq *CardQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (cq *CardQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: card.Label}
+}
+
 // CardGroupBy is the group-by builder for Card entities.
 type CardGroupBy struct {
 	config
@@ -468,7 +571,7 @@ func (cgb *CardGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (cgb *CardGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := cgb.gremlinQuery().Query()
-	if err := cgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := cgb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(cgb.fields)+len(cgb.fns) == 1 {
@@ -538,7 +641,7 @@ func (cs *CardSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		traversal = cs.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := cs.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := cs.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(cs.fields) == 1 {
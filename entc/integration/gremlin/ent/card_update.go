@@ -63,6 +63,13 @@ func (cu *CardUpdate) AddBalance(f float64) *CardUpdate {
 	return cu
 }
 
+// SetBalanceIfGreater sets the "balance" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *CardUpdate) SetBalanceIfGreater(f float64) *CardUpdate {
+	cu.mutation.SetBalanceIfGreater(f)
+	return cu
+}
+
 // SetName sets the "name" field.
 func (cu *CardUpdate) SetName(s string) *CardUpdate {
 	cu.mutation.SetName(s)
@@ -213,7 +220,7 @@ func (cu *CardUpdate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (cu *CardUpdate) defaults() {
 	if _, ok := cu.mutation.UpdateTime(); !ok {
-		v := card.UpdateDefaultUpdateTime()
+		v := cu.config.now(card.UpdateDefaultUpdateTime)
 		cu.mutation.SetUpdateTime(v)
 	}
 }
@@ -265,6 +272,9 @@ func (cu *CardUpdate) gremlin() *dsl.Traversal {
 	if value, ok := cu.mutation.AddedBalance(); ok {
 		v.Property(dsl.Single, card.FieldBalance, __.Union(__.Values(card.FieldBalance), __.Constant(value)).Sum())
 	}
+	if value, ok := cu.mutation.BalanceIfGreater(); ok {
+		v.Property(dsl.Single, card.FieldBalance, __.Union(__.Values(card.FieldBalance), __.Constant(value)).Max())
+	}
 	if value, ok := cu.mutation.Name(); ok {
 		v.Property(dsl.Single, card.FieldName, value)
 	}
@@ -311,9 +321,18 @@ func (cu *CardUpdate) gremlin() *dsl.Traversal {
 // CardUpdateOne is the builder for updating a single Card entity.
 type CardUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CardMutation
+	fields     []string
+	conditions []predicate.Card
+	hooks      []Hook
+	mutation   *CardMutation
+}
+
+// Where appends a list of conditions to the CardUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (cuo *CardUpdateOne) Where(ps ...predicate.Card) *CardUpdateOne {
+	cuo.conditions = append(cuo.conditions, ps...)
+	return cuo
 }
 
 // SetUpdateTime sets the "update_time" field.
@@ -343,6 +362,13 @@ func (cuo *CardUpdateOne) AddBalance(f float64) *CardUpdateOne {
 	return cuo
 }
 
+// SetBalanceIfGreater sets the "balance" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *CardUpdateOne) SetBalanceIfGreater(f float64) *CardUpdateOne {
+	cuo.mutation.SetBalanceIfGreater(f)
+	return cuo
+}
+
 // SetName sets the "name" field.
 func (cuo *CardUpdateOne) SetName(s string) *CardUpdateOne {
 	cuo.mutation.SetName(s)
@@ -506,7 +532,7 @@ func (cuo *CardUpdateOne) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (cuo *CardUpdateOne) defaults() {
 	if _, ok := cuo.mutation.UpdateTime(); !ok {
-		v := card.UpdateDefaultUpdateTime()
+		v := cuo.config.now(card.UpdateDefaultUpdateTime)
 		cuo.mutation.SetUpdateTime(v)
 	}
 }
@@ -523,6 +549,9 @@ func (cuo *CardUpdateOne) check() error {
 
 func (cuo *CardUpdateOne) gremlinSave(ctx context.Context) (*Card, error) {
 	res := &gremlin.Response{}
+	if len(cuo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := cuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Card.id" for update`)}
@@ -563,6 +592,9 @@ func (cuo *CardUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := cuo.mutation.AddedBalance(); ok {
 		v.Property(dsl.Single, card.FieldBalance, __.Union(__.Values(card.FieldBalance), __.Constant(value)).Sum())
 	}
+	if value, ok := cuo.mutation.BalanceIfGreater(); ok {
+		v.Property(dsl.Single, card.FieldBalance, __.Union(__.Values(card.FieldBalance), __.Constant(value)).Max())
+	}
 	if value, ok := cuo.mutation.Name(); ok {
 		v.Property(dsl.Single, card.FieldName, value)
 	}
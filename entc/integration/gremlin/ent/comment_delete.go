@@ -101,7 +101,7 @@ func (cdo *CommentDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{comment.Label}
+		return &NotFoundError{label: comment.Label}
 	default:
 		return nil
 	}
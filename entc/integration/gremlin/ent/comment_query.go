@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -26,6 +28,7 @@ type CommentQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Comment
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
@@ -71,7 +74,7 @@ func (cq *CommentQuery) First(ctx context.Context) (*Comment, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{comment.Label}
+		return nil, cq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -93,7 +96,7 @@ func (cq *CommentQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{comment.Label}
+		err = cq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -120,7 +123,7 @@ func (cq *CommentQuery) Only(ctx context.Context) (*Comment, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{comment.Label}
+		return nil, cq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{comment.Label}
 	}
@@ -147,7 +150,7 @@ func (cq *CommentQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{comment.Label}
+		err = cq.gremlinNotFound()
 	default:
 		err = &NotSingularError{comment.Label}
 	}
@@ -168,7 +171,17 @@ func (cq *CommentQuery) All(ctx context.Context) ([]*Comment, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.gremlinAll(ctx)
+	v, err := cq.intercept(ctx, "All", func(ctx context.Context, query *CommentQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Comment)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Comment", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -182,10 +195,20 @@ func (cq *CommentQuery) AllX(ctx context.Context) []*Comment {
 
 // IDs executes the query and returns a list of Comment IDs.
 func (cq *CommentQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := cq.Select(comment.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := cq.intercept(ctx, "IDs", func(ctx context.Context, query *CommentQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(comment.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -203,7 +226,17 @@ func (cq *CommentQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.gremlinCount(ctx)
+	v, err := cq.intercept(ctx, "Count", func(ctx context.Context, query *CommentQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -220,7 +253,47 @@ func (cq *CommentQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.gremlinExist(ctx)
+	v, err := cq.intercept(ctx, "Exist", func(ctx context.Context, query *CommentQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (cq *CommentQuery) intercept(ctx context.Context, op string, fn func(context.Context, *CommentQuery) (Value, error)) (Value, error) {
+	if len(cq.inters) == 0 {
+		return fn(ctx, cq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeComment,
+		Op:     op,
+		Limit:  cq.limit,
+		Offset: cq.offset,
+		Unique: cq.unique,
+		Fields: cq.fields,
+	}
+	for _, p := range cq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range cq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CommentQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *CommentQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, cq, qc, qr, cq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -243,6 +316,7 @@ func (cq *CommentQuery) Clone() *CommentQuery {
 		limit:      cq.limit,
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		predicates: append([]predicate.Comment{}, cq.predicates...),
 		// clone intermediate query.
 		gremlin: cq.gremlin.Clone(),
@@ -302,6 +376,16 @@ func (cq *CommentQuery) Select(fields ...string) *CommentSelect {
 }
 
 func (cq *CommentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cq); err != nil {
+				return err
+			}
+		}
+	}
 	if cq.path != nil {
 		prev, err := cq.path(ctx)
 		if err != nil {
@@ -325,7 +409,7 @@ func (cq *CommentQuery) gremlinAll(ctx context.Context) ([]*Comment, error) {
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := cq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var cs Comments
@@ -339,7 +423,7 @@ func (cq *CommentQuery) gremlinAll(ctx context.Context) ([]*Comment, error) {
 func (cq *CommentQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := cq.gremlinQuery(ctx).Count().Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := cq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -348,7 +432,7 @@ func (cq *CommentQuery) gremlinCount(ctx context.Context) (int, error) {
 func (cq *CommentQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := cq.gremlinQuery(ctx).HasNext().Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := cq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -382,6 +466,11 @@ func (cq *CommentQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (cq *CommentQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: comment.Label}
+}
+
 // CommentGroupBy is the group-by builder for Comment entities.
 type CommentGroupBy struct {
 	config
@@ -412,7 +501,7 @@ func (cgb *CommentGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (cgb *CommentGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := cgb.gremlinQuery().Query()
-	if err := cgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := cgb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(cgb.fields)+len(cgb.fns) == 1 {
@@ -482,7 +571,7 @@ func (cs *CommentSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		traversal = cs.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := cs.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := cs.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(cs.fields) == 1 {
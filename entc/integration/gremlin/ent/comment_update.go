@@ -47,6 +47,25 @@ func (cu *CommentUpdate) AddUniqueInt(i int) *CommentUpdate {
 	return cu
 }
 
+// SetUniqueIntIfGreater sets the "unique_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *CommentUpdate) SetUniqueIntIfGreater(i int) *CommentUpdate {
+	cu.mutation.SetUniqueIntIfGreater(i)
+	return cu
+}
+
+// BitAndUniqueInt applies a bitwise AND with i on the "unique_int" field in the database.
+func (cu *CommentUpdate) BitAndUniqueInt(i int) *CommentUpdate {
+	cu.mutation.BitAndUniqueInt(i)
+	return cu
+}
+
+// BitOrUniqueInt applies a bitwise OR with i on the "unique_int" field in the database.
+func (cu *CommentUpdate) BitOrUniqueInt(i int) *CommentUpdate {
+	cu.mutation.BitOrUniqueInt(i)
+	return cu
+}
+
 // SetUniqueFloat sets the "unique_float" field.
 func (cu *CommentUpdate) SetUniqueFloat(f float64) *CommentUpdate {
 	cu.mutation.ResetUniqueFloat()
@@ -60,6 +79,13 @@ func (cu *CommentUpdate) AddUniqueFloat(f float64) *CommentUpdate {
 	return cu
 }
 
+// SetUniqueFloatIfGreater sets the "unique_float" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *CommentUpdate) SetUniqueFloatIfGreater(f float64) *CommentUpdate {
+	cu.mutation.SetUniqueFloatIfGreater(f)
+	return cu
+}
+
 // SetNillableInt sets the "nillable_int" field.
 func (cu *CommentUpdate) SetNillableInt(i int) *CommentUpdate {
 	cu.mutation.ResetNillableInt()
@@ -81,6 +107,25 @@ func (cu *CommentUpdate) AddNillableInt(i int) *CommentUpdate {
 	return cu
 }
 
+// SetNillableIntIfGreater sets the "nillable_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *CommentUpdate) SetNillableIntIfGreater(i int) *CommentUpdate {
+	cu.mutation.SetNillableIntIfGreater(i)
+	return cu
+}
+
+// BitAndNillableInt applies a bitwise AND with i on the "nillable_int" field in the database.
+func (cu *CommentUpdate) BitAndNillableInt(i int) *CommentUpdate {
+	cu.mutation.BitAndNillableInt(i)
+	return cu
+}
+
+// BitOrNillableInt applies a bitwise OR with i on the "nillable_int" field in the database.
+func (cu *CommentUpdate) BitOrNillableInt(i int) *CommentUpdate {
+	cu.mutation.BitOrNillableInt(i)
+	return cu
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (cu *CommentUpdate) ClearNillableInt() *CommentUpdate {
 	cu.mutation.ClearNillableInt()
@@ -188,6 +233,18 @@ func (cu *CommentUpdate) ExecX(ctx context.Context) {
 
 func (cu *CommentUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
+	if _, ok := cu.mutation.UniqueIntBitAnd(); ok {
+		return 0, errors.New("ent: BitAndUniqueInt is not supported by the gremlin dialect")
+	}
+	if _, ok := cu.mutation.UniqueIntBitOr(); ok {
+		return 0, errors.New("ent: BitOrUniqueInt is not supported by the gremlin dialect")
+	}
+	if _, ok := cu.mutation.NillableIntBitAnd(); ok {
+		return 0, errors.New("ent: BitAndNillableInt is not supported by the gremlin dialect")
+	}
+	if _, ok := cu.mutation.NillableIntBitOr(); ok {
+		return 0, errors.New("ent: BitOrNillableInt is not supported by the gremlin dialect")
+	}
 	query, bindings := cu.gremlin().Query()
 	if err := cu.driver.Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
@@ -229,6 +286,9 @@ func (cu *CommentUpdate) gremlin() *dsl.Traversal {
 		})
 		v.Property(dsl.Single, comment.FieldUniqueInt, __.Union(__.Values(comment.FieldUniqueInt), __.Constant(value)).Sum())
 	}
+	if value, ok := cu.mutation.UniqueIntIfGreater(); ok {
+		v.Property(dsl.Single, comment.FieldUniqueInt, __.Union(__.Values(comment.FieldUniqueInt), __.Constant(value)).Max())
+	}
 	if value, ok := cu.mutation.UniqueFloat(); ok {
 		constraints = append(constraints, &constraint{
 			pred: g.V().Has(comment.Label, comment.FieldUniqueFloat, value).Count(),
@@ -244,12 +304,18 @@ func (cu *CommentUpdate) gremlin() *dsl.Traversal {
 		})
 		v.Property(dsl.Single, comment.FieldUniqueFloat, __.Union(__.Values(comment.FieldUniqueFloat), __.Constant(value)).Sum())
 	}
+	if value, ok := cu.mutation.UniqueFloatIfGreater(); ok {
+		v.Property(dsl.Single, comment.FieldUniqueFloat, __.Union(__.Values(comment.FieldUniqueFloat), __.Constant(value)).Max())
+	}
 	if value, ok := cu.mutation.NillableInt(); ok {
 		v.Property(dsl.Single, comment.FieldNillableInt, value)
 	}
 	if value, ok := cu.mutation.AddedNillableInt(); ok {
 		v.Property(dsl.Single, comment.FieldNillableInt, __.Union(__.Values(comment.FieldNillableInt), __.Constant(value)).Sum())
 	}
+	if value, ok := cu.mutation.NillableIntIfGreater(); ok {
+		v.Property(dsl.Single, comment.FieldNillableInt, __.Union(__.Values(comment.FieldNillableInt), __.Constant(value)).Max())
+	}
 	if value, ok := cu.mutation.Table(); ok {
 		v.Property(dsl.Single, comment.FieldTable, value)
 	}
@@ -287,9 +353,18 @@ func (cu *CommentUpdate) gremlin() *dsl.Traversal {
 // CommentUpdateOne is the builder for updating a single Comment entity.
 type CommentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CommentMutation
+	fields     []string
+	conditions []predicate.Comment
+	hooks      []Hook
+	mutation   *CommentMutation
+}
+
+// Where appends a list of conditions to the CommentUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
+	cuo.conditions = append(cuo.conditions, ps...)
+	return cuo
 }
 
 // SetUniqueInt sets the "unique_int" field.
@@ -305,6 +380,25 @@ func (cuo *CommentUpdateOne) AddUniqueInt(i int) *CommentUpdateOne {
 	return cuo
 }
 
+// SetUniqueIntIfGreater sets the "unique_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *CommentUpdateOne) SetUniqueIntIfGreater(i int) *CommentUpdateOne {
+	cuo.mutation.SetUniqueIntIfGreater(i)
+	return cuo
+}
+
+// BitAndUniqueInt applies a bitwise AND with i on the "unique_int" field in the database.
+func (cuo *CommentUpdateOne) BitAndUniqueInt(i int) *CommentUpdateOne {
+	cuo.mutation.BitAndUniqueInt(i)
+	return cuo
+}
+
+// BitOrUniqueInt applies a bitwise OR with i on the "unique_int" field in the database.
+func (cuo *CommentUpdateOne) BitOrUniqueInt(i int) *CommentUpdateOne {
+	cuo.mutation.BitOrUniqueInt(i)
+	return cuo
+}
+
 // SetUniqueFloat sets the "unique_float" field.
 func (cuo *CommentUpdateOne) SetUniqueFloat(f float64) *CommentUpdateOne {
 	cuo.mutation.ResetUniqueFloat()
@@ -318,6 +412,13 @@ func (cuo *CommentUpdateOne) AddUniqueFloat(f float64) *CommentUpdateOne {
 	return cuo
 }
 
+// SetUniqueFloatIfGreater sets the "unique_float" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *CommentUpdateOne) SetUniqueFloatIfGreater(f float64) *CommentUpdateOne {
+	cuo.mutation.SetUniqueFloatIfGreater(f)
+	return cuo
+}
+
 // SetNillableInt sets the "nillable_int" field.
 func (cuo *CommentUpdateOne) SetNillableInt(i int) *CommentUpdateOne {
 	cuo.mutation.ResetNillableInt()
@@ -339,6 +440,25 @@ func (cuo *CommentUpdateOne) AddNillableInt(i int) *CommentUpdateOne {
 	return cuo
 }
 
+// SetNillableIntIfGreater sets the "nillable_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *CommentUpdateOne) SetNillableIntIfGreater(i int) *CommentUpdateOne {
+	cuo.mutation.SetNillableIntIfGreater(i)
+	return cuo
+}
+
+// BitAndNillableInt applies a bitwise AND with i on the "nillable_int" field in the database.
+func (cuo *CommentUpdateOne) BitAndNillableInt(i int) *CommentUpdateOne {
+	cuo.mutation.BitAndNillableInt(i)
+	return cuo
+}
+
+// BitOrNillableInt applies a bitwise OR with i on the "nillable_int" field in the database.
+func (cuo *CommentUpdateOne) BitOrNillableInt(i int) *CommentUpdateOne {
+	cuo.mutation.BitOrNillableInt(i)
+	return cuo
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (cuo *CommentUpdateOne) ClearNillableInt() *CommentUpdateOne {
 	cuo.mutation.ClearNillableInt()
@@ -459,6 +579,21 @@ func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
 
 func (cuo *CommentUpdateOne) gremlinSave(ctx context.Context) (*Comment, error) {
 	res := &gremlin.Response{}
+	if _, ok := cuo.mutation.UniqueIntBitAnd(); ok {
+		return nil, errors.New("ent: BitAndUniqueInt is not supported by the gremlin dialect")
+	}
+	if _, ok := cuo.mutation.UniqueIntBitOr(); ok {
+		return nil, errors.New("ent: BitOrUniqueInt is not supported by the gremlin dialect")
+	}
+	if _, ok := cuo.mutation.NillableIntBitAnd(); ok {
+		return nil, errors.New("ent: BitAndNillableInt is not supported by the gremlin dialect")
+	}
+	if _, ok := cuo.mutation.NillableIntBitOr(); ok {
+		return nil, errors.New("ent: BitOrNillableInt is not supported by the gremlin dialect")
+	}
+	if len(cuo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := cuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Comment.id" for update`)}
@@ -505,6 +640,9 @@ func (cuo *CommentUpdateOne) gremlin(id string) *dsl.Traversal {
 		})
 		v.Property(dsl.Single, comment.FieldUniqueInt, __.Union(__.Values(comment.FieldUniqueInt), __.Constant(value)).Sum())
 	}
+	if value, ok := cuo.mutation.UniqueIntIfGreater(); ok {
+		v.Property(dsl.Single, comment.FieldUniqueInt, __.Union(__.Values(comment.FieldUniqueInt), __.Constant(value)).Max())
+	}
 	if value, ok := cuo.mutation.UniqueFloat(); ok {
 		constraints = append(constraints, &constraint{
 			pred: g.V().Has(comment.Label, comment.FieldUniqueFloat, value).Count(),
@@ -520,12 +658,18 @@ func (cuo *CommentUpdateOne) gremlin(id string) *dsl.Traversal {
 		})
 		v.Property(dsl.Single, comment.FieldUniqueFloat, __.Union(__.Values(comment.FieldUniqueFloat), __.Constant(value)).Sum())
 	}
+	if value, ok := cuo.mutation.UniqueFloatIfGreater(); ok {
+		v.Property(dsl.Single, comment.FieldUniqueFloat, __.Union(__.Values(comment.FieldUniqueFloat), __.Constant(value)).Max())
+	}
 	if value, ok := cuo.mutation.NillableInt(); ok {
 		v.Property(dsl.Single, comment.FieldNillableInt, value)
 	}
 	if value, ok := cuo.mutation.AddedNillableInt(); ok {
 		v.Property(dsl.Single, comment.FieldNillableInt, __.Union(__.Values(comment.FieldNillableInt), __.Constant(value)).Sum())
 	}
+	if value, ok := cuo.mutation.NillableIntIfGreater(); ok {
+		v.Property(dsl.Single, comment.FieldNillableInt, __.Union(__.Values(comment.FieldNillableInt), __.Constant(value)).Max())
+	}
 	if value, ok := cuo.mutation.Table(); ok {
 		v.Property(dsl.Single, comment.FieldTable, value)
 	}
@@ -101,7 +101,7 @@ func (ftdo *FieldTypeDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{fieldtype.Label}
+		return &NotFoundError{label: fieldtype.Label}
 	default:
 		return nil
 	}
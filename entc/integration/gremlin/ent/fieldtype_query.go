@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -26,6 +28,7 @@ type FieldTypeQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.FieldType
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
@@ -71,7 +74,7 @@ func (ftq *FieldTypeQuery) First(ctx context.Context) (*FieldType, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{fieldtype.Label}
+		return nil, ftq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -93,7 +96,7 @@ func (ftq *FieldTypeQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{fieldtype.Label}
+		err = ftq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -120,7 +123,7 @@ func (ftq *FieldTypeQuery) Only(ctx context.Context) (*FieldType, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{fieldtype.Label}
+		return nil, ftq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{fieldtype.Label}
 	}
@@ -147,7 +150,7 @@ func (ftq *FieldTypeQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{fieldtype.Label}
+		err = ftq.gremlinNotFound()
 	default:
 		err = &NotSingularError{fieldtype.Label}
 	}
@@ -168,7 +171,17 @@ func (ftq *FieldTypeQuery) All(ctx context.Context) ([]*FieldType, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ftq.gremlinAll(ctx)
+	v, err := ftq.intercept(ctx, "All", func(ctx context.Context, query *FieldTypeQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*FieldType)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*FieldType", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -182,10 +195,20 @@ func (ftq *FieldTypeQuery) AllX(ctx context.Context) []*FieldType {
 
 // IDs executes the query and returns a list of FieldType IDs.
 func (ftq *FieldTypeQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := ftq.Select(fieldtype.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := ftq.intercept(ctx, "IDs", func(ctx context.Context, query *FieldTypeQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(fieldtype.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -203,7 +226,17 @@ func (ftq *FieldTypeQuery) Count(ctx context.Context) (int, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ftq.gremlinCount(ctx)
+	v, err := ftq.intercept(ctx, "Count", func(ctx context.Context, query *FieldTypeQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -220,7 +253,47 @@ func (ftq *FieldTypeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ftq.gremlinExist(ctx)
+	v, err := ftq.intercept(ctx, "Exist", func(ctx context.Context, query *FieldTypeQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (ftq *FieldTypeQuery) intercept(ctx context.Context, op string, fn func(context.Context, *FieldTypeQuery) (Value, error)) (Value, error) {
+	if len(ftq.inters) == 0 {
+		return fn(ctx, ftq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeFieldType,
+		Op:     op,
+		Limit:  ftq.limit,
+		Offset: ftq.offset,
+		Unique: ftq.unique,
+		Fields: ftq.fields,
+	}
+	for _, p := range ftq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range ftq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FieldTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *FieldTypeQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, ftq, qc, qr, ftq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -243,6 +316,7 @@ func (ftq *FieldTypeQuery) Clone() *FieldTypeQuery {
 		limit:      ftq.limit,
 		offset:     ftq.offset,
 		order:      append([]OrderFunc{}, ftq.order...),
+		inters:     append([]Interceptor{}, ftq.inters...),
 		predicates: append([]predicate.FieldType{}, ftq.predicates...),
 		// clone intermediate query.
 		gremlin: ftq.gremlin.Clone(),
@@ -302,6 +376,16 @@ func (ftq *FieldTypeQuery) Select(fields ...string) *FieldTypeSelect {
 }
 
 func (ftq *FieldTypeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ftq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ftq); err != nil {
+				return err
+			}
+		}
+	}
 	if ftq.path != nil {
 		prev, err := ftq.path(ctx)
 		if err != nil {
@@ -325,7 +409,7 @@ func (ftq *FieldTypeQuery) gremlinAll(ctx context.Context) ([]*FieldType, error)
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ftq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var fts FieldTypes
@@ -339,7 +423,7 @@ func (ftq *FieldTypeQuery) gremlinAll(ctx context.Context) ([]*FieldType, error)
 func (ftq *FieldTypeQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := ftq.gremlinQuery(ctx).Count().Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ftq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -348,7 +432,7 @@ func (ftq *FieldTypeQuery) gremlinCount(ctx context.Context) (int, error) {
 func (ftq *FieldTypeQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := ftq.gremlinQuery(ctx).HasNext().Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ftq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -382,6 +466,11 @@ func (ftq *FieldTypeQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (ftq *FieldTypeQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: fieldtype.Label}
+}
+
 // FieldTypeGroupBy is the group-by builder for FieldType entities.
 type FieldTypeGroupBy struct {
 	config
@@ -412,7 +501,7 @@ func (ftgb *FieldTypeGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (ftgb *FieldTypeGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := ftgb.gremlinQuery().Query()
-	if err := ftgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ftgb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(ftgb.fields)+len(ftgb.fns) == 1 {
@@ -482,7 +571,7 @@ func (fts *FieldTypeSelect) gremlinScan(ctx context.Context, v interface{}) erro
 		traversal = fts.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := fts.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := fts.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(fts.fields) == 1 {
@@ -52,6 +52,25 @@ func (ftu *FieldTypeUpdate) AddInt(i int) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetIntIfGreater sets the "int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetIntIfGreater(i int) *FieldTypeUpdate {
+	ftu.mutation.SetIntIfGreater(i)
+	return ftu
+}
+
+// BitAndInt applies a bitwise AND with i on the "int" field in the database.
+func (ftu *FieldTypeUpdate) BitAndInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitAndInt(i)
+	return ftu
+}
+
+// BitOrInt applies a bitwise OR with i on the "int" field in the database.
+func (ftu *FieldTypeUpdate) BitOrInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitOrInt(i)
+	return ftu
+}
+
 // SetInt8 sets the "int8" field.
 func (ftu *FieldTypeUpdate) SetInt8(i int8) *FieldTypeUpdate {
 	ftu.mutation.ResetInt8()
@@ -65,6 +84,25 @@ func (ftu *FieldTypeUpdate) AddInt8(i int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetInt8IfGreater sets the "int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetInt8IfGreater(i int8) *FieldTypeUpdate {
+	ftu.mutation.SetInt8IfGreater(i)
+	return ftu
+}
+
+// BitAndInt8 applies a bitwise AND with i on the "int8" field in the database.
+func (ftu *FieldTypeUpdate) BitAndInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitAndInt8(i)
+	return ftu
+}
+
+// BitOrInt8 applies a bitwise OR with i on the "int8" field in the database.
+func (ftu *FieldTypeUpdate) BitOrInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitOrInt8(i)
+	return ftu
+}
+
 // SetInt16 sets the "int16" field.
 func (ftu *FieldTypeUpdate) SetInt16(i int16) *FieldTypeUpdate {
 	ftu.mutation.ResetInt16()
@@ -78,6 +116,25 @@ func (ftu *FieldTypeUpdate) AddInt16(i int16) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetInt16IfGreater sets the "int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetInt16IfGreater(i int16) *FieldTypeUpdate {
+	ftu.mutation.SetInt16IfGreater(i)
+	return ftu
+}
+
+// BitAndInt16 applies a bitwise AND with i on the "int16" field in the database.
+func (ftu *FieldTypeUpdate) BitAndInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitAndInt16(i)
+	return ftu
+}
+
+// BitOrInt16 applies a bitwise OR with i on the "int16" field in the database.
+func (ftu *FieldTypeUpdate) BitOrInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitOrInt16(i)
+	return ftu
+}
+
 // SetInt32 sets the "int32" field.
 func (ftu *FieldTypeUpdate) SetInt32(i int32) *FieldTypeUpdate {
 	ftu.mutation.ResetInt32()
@@ -91,6 +148,25 @@ func (ftu *FieldTypeUpdate) AddInt32(i int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetInt32IfGreater sets the "int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetInt32IfGreater(i int32) *FieldTypeUpdate {
+	ftu.mutation.SetInt32IfGreater(i)
+	return ftu
+}
+
+// BitAndInt32 applies a bitwise AND with i on the "int32" field in the database.
+func (ftu *FieldTypeUpdate) BitAndInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitAndInt32(i)
+	return ftu
+}
+
+// BitOrInt32 applies a bitwise OR with i on the "int32" field in the database.
+func (ftu *FieldTypeUpdate) BitOrInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitOrInt32(i)
+	return ftu
+}
+
 // SetInt64 sets the "int64" field.
 func (ftu *FieldTypeUpdate) SetInt64(i int64) *FieldTypeUpdate {
 	ftu.mutation.ResetInt64()
@@ -104,6 +180,25 @@ func (ftu *FieldTypeUpdate) AddInt64(i int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetInt64IfGreater sets the "int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetInt64IfGreater(i int64) *FieldTypeUpdate {
+	ftu.mutation.SetInt64IfGreater(i)
+	return ftu
+}
+
+// BitAndInt64 applies a bitwise AND with i on the "int64" field in the database.
+func (ftu *FieldTypeUpdate) BitAndInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitAndInt64(i)
+	return ftu
+}
+
+// BitOrInt64 applies a bitwise OR with i on the "int64" field in the database.
+func (ftu *FieldTypeUpdate) BitOrInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitOrInt64(i)
+	return ftu
+}
+
 // SetOptionalInt sets the "optional_int" field.
 func (ftu *FieldTypeUpdate) SetOptionalInt(i int) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt()
@@ -125,6 +220,25 @@ func (ftu *FieldTypeUpdate) AddOptionalInt(i int) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalIntIfGreater sets the "optional_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalIntIfGreater(i int) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalIntIfGreater(i)
+	return ftu
+}
+
+// BitAndOptionalInt applies a bitwise AND with i on the "optional_int" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalInt(i)
+	return ftu
+}
+
+// BitOrOptionalInt applies a bitwise OR with i on the "optional_int" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalInt(i)
+	return ftu
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (ftu *FieldTypeUpdate) ClearOptionalInt() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalInt()
@@ -152,6 +266,25 @@ func (ftu *FieldTypeUpdate) AddOptionalInt8(i int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalInt8IfGreater sets the "optional_int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalInt8IfGreater(i int8) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalInt8IfGreater(i)
+	return ftu
+}
+
+// BitAndOptionalInt8 applies a bitwise AND with i on the "optional_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalInt8(i)
+	return ftu
+}
+
+// BitOrOptionalInt8 applies a bitwise OR with i on the "optional_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalInt8(i)
+	return ftu
+}
+
 // ClearOptionalInt8 clears the value of the "optional_int8" field.
 func (ftu *FieldTypeUpdate) ClearOptionalInt8() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalInt8()
@@ -179,6 +312,25 @@ func (ftu *FieldTypeUpdate) AddOptionalInt16(i int16) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalInt16IfGreater sets the "optional_int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalInt16IfGreater(i int16) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalInt16IfGreater(i)
+	return ftu
+}
+
+// BitAndOptionalInt16 applies a bitwise AND with i on the "optional_int16" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalInt16(i)
+	return ftu
+}
+
+// BitOrOptionalInt16 applies a bitwise OR with i on the "optional_int16" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalInt16(i)
+	return ftu
+}
+
 // ClearOptionalInt16 clears the value of the "optional_int16" field.
 func (ftu *FieldTypeUpdate) ClearOptionalInt16() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalInt16()
@@ -206,6 +358,25 @@ func (ftu *FieldTypeUpdate) AddOptionalInt32(i int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalInt32IfGreater sets the "optional_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalInt32IfGreater(i int32) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalInt32IfGreater(i)
+	return ftu
+}
+
+// BitAndOptionalInt32 applies a bitwise AND with i on the "optional_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalInt32(i)
+	return ftu
+}
+
+// BitOrOptionalInt32 applies a bitwise OR with i on the "optional_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalInt32(i)
+	return ftu
+}
+
 // ClearOptionalInt32 clears the value of the "optional_int32" field.
 func (ftu *FieldTypeUpdate) ClearOptionalInt32() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalInt32()
@@ -233,6 +404,25 @@ func (ftu *FieldTypeUpdate) AddOptionalInt64(i int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalInt64IfGreater sets the "optional_int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalInt64IfGreater(i int64) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalInt64IfGreater(i)
+	return ftu
+}
+
+// BitAndOptionalInt64 applies a bitwise AND with i on the "optional_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalInt64(i)
+	return ftu
+}
+
+// BitOrOptionalInt64 applies a bitwise OR with i on the "optional_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalInt64(i)
+	return ftu
+}
+
 // ClearOptionalInt64 clears the value of the "optional_int64" field.
 func (ftu *FieldTypeUpdate) ClearOptionalInt64() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalInt64()
@@ -260,6 +450,25 @@ func (ftu *FieldTypeUpdate) AddNillableInt(i int) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetNillableIntIfGreater sets the "nillable_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetNillableIntIfGreater(i int) *FieldTypeUpdate {
+	ftu.mutation.SetNillableIntIfGreater(i)
+	return ftu
+}
+
+// BitAndNillableInt applies a bitwise AND with i on the "nillable_int" field in the database.
+func (ftu *FieldTypeUpdate) BitAndNillableInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitAndNillableInt(i)
+	return ftu
+}
+
+// BitOrNillableInt applies a bitwise OR with i on the "nillable_int" field in the database.
+func (ftu *FieldTypeUpdate) BitOrNillableInt(i int) *FieldTypeUpdate {
+	ftu.mutation.BitOrNillableInt(i)
+	return ftu
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (ftu *FieldTypeUpdate) ClearNillableInt() *FieldTypeUpdate {
 	ftu.mutation.ClearNillableInt()
@@ -287,6 +496,25 @@ func (ftu *FieldTypeUpdate) AddNillableInt8(i int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetNillableInt8IfGreater sets the "nillable_int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetNillableInt8IfGreater(i int8) *FieldTypeUpdate {
+	ftu.mutation.SetNillableInt8IfGreater(i)
+	return ftu
+}
+
+// BitAndNillableInt8 applies a bitwise AND with i on the "nillable_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitAndNillableInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitAndNillableInt8(i)
+	return ftu
+}
+
+// BitOrNillableInt8 applies a bitwise OR with i on the "nillable_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitOrNillableInt8(i int8) *FieldTypeUpdate {
+	ftu.mutation.BitOrNillableInt8(i)
+	return ftu
+}
+
 // ClearNillableInt8 clears the value of the "nillable_int8" field.
 func (ftu *FieldTypeUpdate) ClearNillableInt8() *FieldTypeUpdate {
 	ftu.mutation.ClearNillableInt8()
@@ -314,6 +542,25 @@ func (ftu *FieldTypeUpdate) AddNillableInt16(i int16) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetNillableInt16IfGreater sets the "nillable_int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetNillableInt16IfGreater(i int16) *FieldTypeUpdate {
+	ftu.mutation.SetNillableInt16IfGreater(i)
+	return ftu
+}
+
+// BitAndNillableInt16 applies a bitwise AND with i on the "nillable_int16" field in the database.
+func (ftu *FieldTypeUpdate) BitAndNillableInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitAndNillableInt16(i)
+	return ftu
+}
+
+// BitOrNillableInt16 applies a bitwise OR with i on the "nillable_int16" field in the database.
+func (ftu *FieldTypeUpdate) BitOrNillableInt16(i int16) *FieldTypeUpdate {
+	ftu.mutation.BitOrNillableInt16(i)
+	return ftu
+}
+
 // ClearNillableInt16 clears the value of the "nillable_int16" field.
 func (ftu *FieldTypeUpdate) ClearNillableInt16() *FieldTypeUpdate {
 	ftu.mutation.ClearNillableInt16()
@@ -341,6 +588,25 @@ func (ftu *FieldTypeUpdate) AddNillableInt32(i int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetNillableInt32IfGreater sets the "nillable_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetNillableInt32IfGreater(i int32) *FieldTypeUpdate {
+	ftu.mutation.SetNillableInt32IfGreater(i)
+	return ftu
+}
+
+// BitAndNillableInt32 applies a bitwise AND with i on the "nillable_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitAndNillableInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitAndNillableInt32(i)
+	return ftu
+}
+
+// BitOrNillableInt32 applies a bitwise OR with i on the "nillable_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitOrNillableInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitOrNillableInt32(i)
+	return ftu
+}
+
 // ClearNillableInt32 clears the value of the "nillable_int32" field.
 func (ftu *FieldTypeUpdate) ClearNillableInt32() *FieldTypeUpdate {
 	ftu.mutation.ClearNillableInt32()
@@ -368,6 +634,25 @@ func (ftu *FieldTypeUpdate) AddNillableInt64(i int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetNillableInt64IfGreater sets the "nillable_int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetNillableInt64IfGreater(i int64) *FieldTypeUpdate {
+	ftu.mutation.SetNillableInt64IfGreater(i)
+	return ftu
+}
+
+// BitAndNillableInt64 applies a bitwise AND with i on the "nillable_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitAndNillableInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitAndNillableInt64(i)
+	return ftu
+}
+
+// BitOrNillableInt64 applies a bitwise OR with i on the "nillable_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitOrNillableInt64(i int64) *FieldTypeUpdate {
+	ftu.mutation.BitOrNillableInt64(i)
+	return ftu
+}
+
 // ClearNillableInt64 clears the value of the "nillable_int64" field.
 func (ftu *FieldTypeUpdate) ClearNillableInt64() *FieldTypeUpdate {
 	ftu.mutation.ClearNillableInt64()
@@ -395,6 +680,25 @@ func (ftu *FieldTypeUpdate) AddValidateOptionalInt32(i int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetValidateOptionalInt32IfGreater sets the "validate_optional_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetValidateOptionalInt32IfGreater(i int32) *FieldTypeUpdate {
+	ftu.mutation.SetValidateOptionalInt32IfGreater(i)
+	return ftu
+}
+
+// BitAndValidateOptionalInt32 applies a bitwise AND with i on the "validate_optional_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitAndValidateOptionalInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitAndValidateOptionalInt32(i)
+	return ftu
+}
+
+// BitOrValidateOptionalInt32 applies a bitwise OR with i on the "validate_optional_int32" field in the database.
+func (ftu *FieldTypeUpdate) BitOrValidateOptionalInt32(i int32) *FieldTypeUpdate {
+	ftu.mutation.BitOrValidateOptionalInt32(i)
+	return ftu
+}
+
 // ClearValidateOptionalInt32 clears the value of the "validate_optional_int32" field.
 func (ftu *FieldTypeUpdate) ClearValidateOptionalInt32() *FieldTypeUpdate {
 	ftu.mutation.ClearValidateOptionalInt32()
@@ -422,6 +726,25 @@ func (ftu *FieldTypeUpdate) AddOptionalUint(u int) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalUintIfGreater sets the "optional_uint" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalUintIfGreater(u uint) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalUintIfGreater(u)
+	return ftu
+}
+
+// BitAndOptionalUint applies a bitwise AND with u on the "optional_uint" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalUint(u uint) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalUint(u)
+	return ftu
+}
+
+// BitOrOptionalUint applies a bitwise OR with u on the "optional_uint" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalUint(u uint) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalUint(u)
+	return ftu
+}
+
 // ClearOptionalUint clears the value of the "optional_uint" field.
 func (ftu *FieldTypeUpdate) ClearOptionalUint() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalUint()
@@ -449,6 +772,25 @@ func (ftu *FieldTypeUpdate) AddOptionalUint8(u int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalUint8IfGreater sets the "optional_uint8" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalUint8IfGreater(u uint8) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalUint8IfGreater(u)
+	return ftu
+}
+
+// BitAndOptionalUint8 applies a bitwise AND with u on the "optional_uint8" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalUint8(u uint8) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalUint8(u)
+	return ftu
+}
+
+// BitOrOptionalUint8 applies a bitwise OR with u on the "optional_uint8" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalUint8(u uint8) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalUint8(u)
+	return ftu
+}
+
 // ClearOptionalUint8 clears the value of the "optional_uint8" field.
 func (ftu *FieldTypeUpdate) ClearOptionalUint8() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalUint8()
@@ -476,6 +818,25 @@ func (ftu *FieldTypeUpdate) AddOptionalUint16(u int16) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalUint16IfGreater sets the "optional_uint16" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalUint16IfGreater(u uint16) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalUint16IfGreater(u)
+	return ftu
+}
+
+// BitAndOptionalUint16 applies a bitwise AND with u on the "optional_uint16" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalUint16(u uint16) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalUint16(u)
+	return ftu
+}
+
+// BitOrOptionalUint16 applies a bitwise OR with u on the "optional_uint16" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalUint16(u uint16) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalUint16(u)
+	return ftu
+}
+
 // ClearOptionalUint16 clears the value of the "optional_uint16" field.
 func (ftu *FieldTypeUpdate) ClearOptionalUint16() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalUint16()
@@ -503,6 +864,25 @@ func (ftu *FieldTypeUpdate) AddOptionalUint32(u int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalUint32IfGreater sets the "optional_uint32" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalUint32IfGreater(u uint32) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalUint32IfGreater(u)
+	return ftu
+}
+
+// BitAndOptionalUint32 applies a bitwise AND with u on the "optional_uint32" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalUint32(u uint32) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalUint32(u)
+	return ftu
+}
+
+// BitOrOptionalUint32 applies a bitwise OR with u on the "optional_uint32" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalUint32(u uint32) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalUint32(u)
+	return ftu
+}
+
 // ClearOptionalUint32 clears the value of the "optional_uint32" field.
 func (ftu *FieldTypeUpdate) ClearOptionalUint32() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalUint32()
@@ -530,6 +910,25 @@ func (ftu *FieldTypeUpdate) AddOptionalUint64(u int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalUint64IfGreater sets the "optional_uint64" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalUint64IfGreater(u uint64) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalUint64IfGreater(u)
+	return ftu
+}
+
+// BitAndOptionalUint64 applies a bitwise AND with u on the "optional_uint64" field in the database.
+func (ftu *FieldTypeUpdate) BitAndOptionalUint64(u uint64) *FieldTypeUpdate {
+	ftu.mutation.BitAndOptionalUint64(u)
+	return ftu
+}
+
+// BitOrOptionalUint64 applies a bitwise OR with u on the "optional_uint64" field in the database.
+func (ftu *FieldTypeUpdate) BitOrOptionalUint64(u uint64) *FieldTypeUpdate {
+	ftu.mutation.BitOrOptionalUint64(u)
+	return ftu
+}
+
 // ClearOptionalUint64 clears the value of the "optional_uint64" field.
 func (ftu *FieldTypeUpdate) ClearOptionalUint64() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalUint64()
@@ -577,6 +976,13 @@ func (ftu *FieldTypeUpdate) AddOptionalFloat(f float64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalFloatIfGreater sets the "optional_float" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalFloatIfGreater(f float64) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalFloatIfGreater(f)
+	return ftu
+}
+
 // ClearOptionalFloat clears the value of the "optional_float" field.
 func (ftu *FieldTypeUpdate) ClearOptionalFloat() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalFloat()
@@ -604,6 +1010,13 @@ func (ftu *FieldTypeUpdate) AddOptionalFloat32(f float32) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetOptionalFloat32IfGreater sets the "optional_float32" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetOptionalFloat32IfGreater(f float32) *FieldTypeUpdate {
+	ftu.mutation.SetOptionalFloat32IfGreater(f)
+	return ftu
+}
+
 // ClearOptionalFloat32 clears the value of the "optional_float32" field.
 func (ftu *FieldTypeUpdate) ClearOptionalFloat32() *FieldTypeUpdate {
 	ftu.mutation.ClearOptionalFloat32()
@@ -671,6 +1084,13 @@ func (ftu *FieldTypeUpdate) AddDecimal(f float64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetDecimalIfGreater sets the "decimal" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetDecimalIfGreater(f float64) *FieldTypeUpdate {
+	ftu.mutation.SetDecimalIfGreater(f)
+	return ftu
+}
+
 // ClearDecimal clears the value of the "decimal" field.
 func (ftu *FieldTypeUpdate) ClearDecimal() *FieldTypeUpdate {
 	ftu.mutation.ClearDecimal()
@@ -786,6 +1206,25 @@ func (ftu *FieldTypeUpdate) AddDuration(t time.Duration) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetDurationIfGreater sets the "duration" field to t, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetDurationIfGreater(t time.Duration) *FieldTypeUpdate {
+	ftu.mutation.SetDurationIfGreater(t)
+	return ftu
+}
+
+// BitAndDuration applies a bitwise AND with t on the "duration" field in the database.
+func (ftu *FieldTypeUpdate) BitAndDuration(t time.Duration) *FieldTypeUpdate {
+	ftu.mutation.BitAndDuration(t)
+	return ftu
+}
+
+// BitOrDuration applies a bitwise OR with t on the "duration" field in the database.
+func (ftu *FieldTypeUpdate) BitOrDuration(t time.Duration) *FieldTypeUpdate {
+	ftu.mutation.BitOrDuration(t)
+	return ftu
+}
+
 // ClearDuration clears the value of the "duration" field.
 func (ftu *FieldTypeUpdate) ClearDuration() *FieldTypeUpdate {
 	ftu.mutation.ClearDuration()
@@ -1023,6 +1462,25 @@ func (ftu *FieldTypeUpdate) AddSchemaInt(s schema.Int) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetSchemaIntIfGreater sets the "schema_int" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetSchemaIntIfGreater(s schema.Int) *FieldTypeUpdate {
+	ftu.mutation.SetSchemaIntIfGreater(s)
+	return ftu
+}
+
+// BitAndSchemaInt applies a bitwise AND with s on the "schema_int" field in the database.
+func (ftu *FieldTypeUpdate) BitAndSchemaInt(s schema.Int) *FieldTypeUpdate {
+	ftu.mutation.BitAndSchemaInt(s)
+	return ftu
+}
+
+// BitOrSchemaInt applies a bitwise OR with s on the "schema_int" field in the database.
+func (ftu *FieldTypeUpdate) BitOrSchemaInt(s schema.Int) *FieldTypeUpdate {
+	ftu.mutation.BitOrSchemaInt(s)
+	return ftu
+}
+
 // ClearSchemaInt clears the value of the "schema_int" field.
 func (ftu *FieldTypeUpdate) ClearSchemaInt() *FieldTypeUpdate {
 	ftu.mutation.ClearSchemaInt()
@@ -1050,6 +1508,25 @@ func (ftu *FieldTypeUpdate) AddSchemaInt8(s schema.Int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetSchemaInt8IfGreater sets the "schema_int8" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetSchemaInt8IfGreater(s schema.Int8) *FieldTypeUpdate {
+	ftu.mutation.SetSchemaInt8IfGreater(s)
+	return ftu
+}
+
+// BitAndSchemaInt8 applies a bitwise AND with s on the "schema_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitAndSchemaInt8(s schema.Int8) *FieldTypeUpdate {
+	ftu.mutation.BitAndSchemaInt8(s)
+	return ftu
+}
+
+// BitOrSchemaInt8 applies a bitwise OR with s on the "schema_int8" field in the database.
+func (ftu *FieldTypeUpdate) BitOrSchemaInt8(s schema.Int8) *FieldTypeUpdate {
+	ftu.mutation.BitOrSchemaInt8(s)
+	return ftu
+}
+
 // ClearSchemaInt8 clears the value of the "schema_int8" field.
 func (ftu *FieldTypeUpdate) ClearSchemaInt8() *FieldTypeUpdate {
 	ftu.mutation.ClearSchemaInt8()
@@ -1077,6 +1554,25 @@ func (ftu *FieldTypeUpdate) AddSchemaInt64(s schema.Int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetSchemaInt64IfGreater sets the "schema_int64" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetSchemaInt64IfGreater(s schema.Int64) *FieldTypeUpdate {
+	ftu.mutation.SetSchemaInt64IfGreater(s)
+	return ftu
+}
+
+// BitAndSchemaInt64 applies a bitwise AND with s on the "schema_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitAndSchemaInt64(s schema.Int64) *FieldTypeUpdate {
+	ftu.mutation.BitAndSchemaInt64(s)
+	return ftu
+}
+
+// BitOrSchemaInt64 applies a bitwise OR with s on the "schema_int64" field in the database.
+func (ftu *FieldTypeUpdate) BitOrSchemaInt64(s schema.Int64) *FieldTypeUpdate {
+	ftu.mutation.BitOrSchemaInt64(s)
+	return ftu
+}
+
 // ClearSchemaInt64 clears the value of the "schema_int64" field.
 func (ftu *FieldTypeUpdate) ClearSchemaInt64() *FieldTypeUpdate {
 	ftu.mutation.ClearSchemaInt64()
@@ -1104,6 +1600,13 @@ func (ftu *FieldTypeUpdate) AddSchemaFloat(s schema.Float64) *FieldTypeUpdate {
 	return ftu
 }
 
+// SetSchemaFloatIfGreater sets the "schema_float" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetSchemaFloatIfGreater(s schema.Float64) *FieldTypeUpdate {
+	ftu.mutation.SetSchemaFloatIfGreater(s)
+	return ftu
+}
+
 // ClearSchemaFloat clears the value of the "schema_float" field.
 func (ftu *FieldTypeUpdate) ClearSchemaFloat() *FieldTypeUpdate {
 	ftu.mutation.ClearSchemaFloat()
@@ -1131,6 +1634,13 @@ func (ftu *FieldTypeUpdate) AddSchemaFloat32(s schema.Float32) *FieldTypeUpdate
 	return ftu
 }
 
+// SetSchemaFloat32IfGreater sets the "schema_float32" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftu *FieldTypeUpdate) SetSchemaFloat32IfGreater(s schema.Float32) *FieldTypeUpdate {
+	ftu.mutation.SetSchemaFloat32IfGreater(s)
+	return ftu
+}
+
 // ClearSchemaFloat32 clears the value of the "schema_float32" field.
 func (ftu *FieldTypeUpdate) ClearSchemaFloat32() *FieldTypeUpdate {
 	ftu.mutation.ClearSchemaFloat32()
@@ -1229,6 +1739,12 @@ func (ftu *FieldTypeUpdate) SetStrings(s []string) *FieldTypeUpdate {
 	return ftu
 }
 
+// AppendStrings appends s to the "strings" field in the database.
+func (ftu *FieldTypeUpdate) AppendStrings(s []string) *FieldTypeUpdate {
+	ftu.mutation.AppendStrings(s)
+	return ftu
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (ftu *FieldTypeUpdate) ClearStrings() *FieldTypeUpdate {
 	ftu.mutation.ClearStrings()
@@ -1470,6 +1986,159 @@ func (ftu *FieldTypeUpdate) check() error {
 
 func (ftu *FieldTypeUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
+	if _, ok := ftu.mutation.IntBitAnd(); ok {
+		return 0, errors.New("ent: BitAndInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.IntBitOr(); ok {
+		return 0, errors.New("ent: BitOrInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.Int8BitAnd(); ok {
+		return 0, errors.New("ent: BitAndInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.Int8BitOr(); ok {
+		return 0, errors.New("ent: BitOrInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.Int16BitAnd(); ok {
+		return 0, errors.New("ent: BitAndInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.Int16BitOr(); ok {
+		return 0, errors.New("ent: BitOrInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.Int32BitAnd(); ok {
+		return 0, errors.New("ent: BitAndInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.Int32BitOr(); ok {
+		return 0, errors.New("ent: BitOrInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.Int64BitAnd(); ok {
+		return 0, errors.New("ent: BitAndInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.Int64BitOr(); ok {
+		return 0, errors.New("ent: BitOrInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalIntBitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalIntBitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalInt8BitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalInt8BitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalInt16BitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalInt16BitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalInt32BitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalInt32BitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalInt64BitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalInt64BitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.NillableIntBitAnd(); ok {
+		return 0, errors.New("ent: BitAndNillableInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.NillableIntBitOr(); ok {
+		return 0, errors.New("ent: BitOrNillableInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.NillableInt8BitAnd(); ok {
+		return 0, errors.New("ent: BitAndNillableInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.NillableInt8BitOr(); ok {
+		return 0, errors.New("ent: BitOrNillableInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.NillableInt16BitAnd(); ok {
+		return 0, errors.New("ent: BitAndNillableInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.NillableInt16BitOr(); ok {
+		return 0, errors.New("ent: BitOrNillableInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.NillableInt32BitAnd(); ok {
+		return 0, errors.New("ent: BitAndNillableInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.NillableInt32BitOr(); ok {
+		return 0, errors.New("ent: BitOrNillableInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.NillableInt64BitAnd(); ok {
+		return 0, errors.New("ent: BitAndNillableInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.NillableInt64BitOr(); ok {
+		return 0, errors.New("ent: BitOrNillableInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.ValidateOptionalInt32BitAnd(); ok {
+		return 0, errors.New("ent: BitAndValidateOptionalInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.ValidateOptionalInt32BitOr(); ok {
+		return 0, errors.New("ent: BitOrValidateOptionalInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalUintBitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalUint is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalUintBitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalUint is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalUint8BitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalUint8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalUint8BitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalUint8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalUint16BitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalUint16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalUint16BitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalUint16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalUint32BitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalUint32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalUint32BitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalUint32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalUint64BitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalUint64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.OptionalUint64BitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalUint64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.DurationBitAnd(); ok {
+		return 0, errors.New("ent: BitAndDuration is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.DurationBitOr(); ok {
+		return 0, errors.New("ent: BitOrDuration is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.SchemaIntBitAnd(); ok {
+		return 0, errors.New("ent: BitAndSchemaInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.SchemaIntBitOr(); ok {
+		return 0, errors.New("ent: BitOrSchemaInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.SchemaInt8BitAnd(); ok {
+		return 0, errors.New("ent: BitAndSchemaInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.SchemaInt8BitOr(); ok {
+		return 0, errors.New("ent: BitOrSchemaInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.SchemaInt64BitAnd(); ok {
+		return 0, errors.New("ent: BitAndSchemaInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.SchemaInt64BitOr(); ok {
+		return 0, errors.New("ent: BitOrSchemaInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftu.mutation.AppendedStrings(); ok {
+		return 0, errors.New("ent: AppendStrings is not supported by the gremlin dialect")
+	}
 	query, bindings := ftu.gremlin().Query()
 	if err := ftu.driver.Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
@@ -1494,126 +2163,189 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if value, ok := ftu.mutation.AddedInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt, __.Union(__.Values(fieldtype.FieldInt), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.IntIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldInt, __.Union(__.Values(fieldtype.FieldInt), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.Int8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt8, value)
 	}
 	if value, ok := ftu.mutation.AddedInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt8, __.Union(__.Values(fieldtype.FieldInt8), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.Int8IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldInt8, __.Union(__.Values(fieldtype.FieldInt8), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.Int16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt16, value)
 	}
 	if value, ok := ftu.mutation.AddedInt16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt16, __.Union(__.Values(fieldtype.FieldInt16), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.Int16IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldInt16, __.Union(__.Values(fieldtype.FieldInt16), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.Int32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt32, value)
 	}
 	if value, ok := ftu.mutation.AddedInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt32, __.Union(__.Values(fieldtype.FieldInt32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.Int32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldInt32, __.Union(__.Values(fieldtype.FieldInt32), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.Int64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt64, value)
 	}
 	if value, ok := ftu.mutation.AddedInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt64, __.Union(__.Values(fieldtype.FieldInt64), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.Int64IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldInt64, __.Union(__.Values(fieldtype.FieldInt64), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt, __.Union(__.Values(fieldtype.FieldOptionalInt), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalIntIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalInt, __.Union(__.Values(fieldtype.FieldOptionalInt), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt8, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt8, __.Union(__.Values(fieldtype.FieldOptionalInt8), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalInt8IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalInt8, __.Union(__.Values(fieldtype.FieldOptionalInt8), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalInt16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt16, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalInt16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt16, __.Union(__.Values(fieldtype.FieldOptionalInt16), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalInt16IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalInt16, __.Union(__.Values(fieldtype.FieldOptionalInt16), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt32, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt32, __.Union(__.Values(fieldtype.FieldOptionalInt32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalInt32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalInt32, __.Union(__.Values(fieldtype.FieldOptionalInt32), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt64, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt64, __.Union(__.Values(fieldtype.FieldOptionalInt64), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalInt64IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalInt64, __.Union(__.Values(fieldtype.FieldOptionalInt64), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.NillableInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt, value)
 	}
 	if value, ok := ftu.mutation.AddedNillableInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt, __.Union(__.Values(fieldtype.FieldNillableInt), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.NillableIntIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldNillableInt, __.Union(__.Values(fieldtype.FieldNillableInt), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.NillableInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt8, value)
 	}
 	if value, ok := ftu.mutation.AddedNillableInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt8, __.Union(__.Values(fieldtype.FieldNillableInt8), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.NillableInt8IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldNillableInt8, __.Union(__.Values(fieldtype.FieldNillableInt8), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.NillableInt16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt16, value)
 	}
 	if value, ok := ftu.mutation.AddedNillableInt16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt16, __.Union(__.Values(fieldtype.FieldNillableInt16), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.NillableInt16IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldNillableInt16, __.Union(__.Values(fieldtype.FieldNillableInt16), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.NillableInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt32, value)
 	}
 	if value, ok := ftu.mutation.AddedNillableInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt32, __.Union(__.Values(fieldtype.FieldNillableInt32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.NillableInt32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldNillableInt32, __.Union(__.Values(fieldtype.FieldNillableInt32), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.NillableInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt64, value)
 	}
 	if value, ok := ftu.mutation.AddedNillableInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt64, __.Union(__.Values(fieldtype.FieldNillableInt64), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.NillableInt64IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldNillableInt64, __.Union(__.Values(fieldtype.FieldNillableInt64), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.ValidateOptionalInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldValidateOptionalInt32, value)
 	}
 	if value, ok := ftu.mutation.AddedValidateOptionalInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldValidateOptionalInt32, __.Union(__.Values(fieldtype.FieldValidateOptionalInt32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.ValidateOptionalInt32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldValidateOptionalInt32, __.Union(__.Values(fieldtype.FieldValidateOptionalInt32), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalUint(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalUint(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint, __.Union(__.Values(fieldtype.FieldOptionalUint), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalUintIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalUint, __.Union(__.Values(fieldtype.FieldOptionalUint), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalUint8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint8, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalUint8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint8, __.Union(__.Values(fieldtype.FieldOptionalUint8), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalUint8IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalUint8, __.Union(__.Values(fieldtype.FieldOptionalUint8), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalUint16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint16, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalUint16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint16, __.Union(__.Values(fieldtype.FieldOptionalUint16), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalUint16IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalUint16, __.Union(__.Values(fieldtype.FieldOptionalUint16), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalUint32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint32, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalUint32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint32, __.Union(__.Values(fieldtype.FieldOptionalUint32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalUint32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalUint32, __.Union(__.Values(fieldtype.FieldOptionalUint32), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalUint64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint64, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalUint64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint64, __.Union(__.Values(fieldtype.FieldOptionalUint64), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalUint64IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalUint64, __.Union(__.Values(fieldtype.FieldOptionalUint64), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.State(); ok {
 		v.Property(dsl.Single, fieldtype.FieldState, value)
 	}
@@ -1623,12 +2355,18 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if value, ok := ftu.mutation.AddedOptionalFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalFloat, __.Union(__.Values(fieldtype.FieldOptionalFloat), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalFloatIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalFloat, __.Union(__.Values(fieldtype.FieldOptionalFloat), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.OptionalFloat32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalFloat32, value)
 	}
 	if value, ok := ftu.mutation.AddedOptionalFloat32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalFloat32, __.Union(__.Values(fieldtype.FieldOptionalFloat32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.OptionalFloat32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalFloat32, __.Union(__.Values(fieldtype.FieldOptionalFloat32), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.Text(); ok {
 		v.Property(dsl.Single, fieldtype.FieldText, value)
 	}
@@ -1641,6 +2379,9 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if value, ok := ftu.mutation.AddedDecimal(); ok {
 		v.Property(dsl.Single, fieldtype.FieldDecimal, __.Union(__.Values(fieldtype.FieldDecimal), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.DecimalIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldDecimal, __.Union(__.Values(fieldtype.FieldDecimal), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.LinkOther(); ok {
 		v.Property(dsl.Single, fieldtype.FieldLinkOther, value)
 	}
@@ -1665,6 +2406,9 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if value, ok := ftu.mutation.AddedDuration(); ok {
 		v.Property(dsl.Single, fieldtype.FieldDuration, __.Union(__.Values(fieldtype.FieldDuration), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.DurationIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldDuration, __.Union(__.Values(fieldtype.FieldDuration), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.Dir(); ok {
 		v.Property(dsl.Single, fieldtype.FieldDir, value)
 	}
@@ -1713,30 +2457,45 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if value, ok := ftu.mutation.AddedSchemaInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaInt, __.Union(__.Values(fieldtype.FieldSchemaInt), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.SchemaIntIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldSchemaInt, __.Union(__.Values(fieldtype.FieldSchemaInt), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.SchemaInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaInt8, value)
 	}
 	if value, ok := ftu.mutation.AddedSchemaInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaInt8, __.Union(__.Values(fieldtype.FieldSchemaInt8), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.SchemaInt8IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldSchemaInt8, __.Union(__.Values(fieldtype.FieldSchemaInt8), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.SchemaInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaInt64, value)
 	}
 	if value, ok := ftu.mutation.AddedSchemaInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaInt64, __.Union(__.Values(fieldtype.FieldSchemaInt64), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.SchemaInt64IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldSchemaInt64, __.Union(__.Values(fieldtype.FieldSchemaInt64), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.SchemaFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaFloat, value)
 	}
 	if value, ok := ftu.mutation.AddedSchemaFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaFloat, __.Union(__.Values(fieldtype.FieldSchemaFloat), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.SchemaFloatIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldSchemaFloat, __.Union(__.Values(fieldtype.FieldSchemaFloat), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.SchemaFloat32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaFloat32, value)
 	}
 	if value, ok := ftu.mutation.AddedSchemaFloat32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaFloat32, __.Union(__.Values(fieldtype.FieldSchemaFloat32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftu.mutation.SchemaFloat32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldSchemaFloat32, __.Union(__.Values(fieldtype.FieldSchemaFloat32), __.Constant(value)).Max())
+	}
 	if value, ok := ftu.mutation.NullFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNullFloat, value)
 	}
@@ -1953,9 +2712,18 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 // FieldTypeUpdateOne is the builder for updating a single FieldType entity.
 type FieldTypeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FieldTypeMutation
+	fields     []string
+	conditions []predicate.FieldType
+	hooks      []Hook
+	mutation   *FieldTypeMutation
+}
+
+// Where appends a list of conditions to the FieldTypeUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ftuo *FieldTypeUpdateOne) Where(ps ...predicate.FieldType) *FieldTypeUpdateOne {
+	ftuo.conditions = append(ftuo.conditions, ps...)
+	return ftuo
 }
 
 // SetInt sets the "int" field.
@@ -1971,6 +2739,25 @@ func (ftuo *FieldTypeUpdateOne) AddInt(i int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetIntIfGreater sets the "int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetIntIfGreater(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.SetIntIfGreater(i)
+	return ftuo
+}
+
+// BitAndInt applies a bitwise AND with i on the "int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndInt(i)
+	return ftuo
+}
+
+// BitOrInt applies a bitwise OR with i on the "int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrInt(i)
+	return ftuo
+}
+
 // SetInt8 sets the "int8" field.
 func (ftuo *FieldTypeUpdateOne) SetInt8(i int8) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt8()
@@ -1984,6 +2771,25 @@ func (ftuo *FieldTypeUpdateOne) AddInt8(i int8) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetInt8IfGreater sets the "int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetInt8IfGreater(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.SetInt8IfGreater(i)
+	return ftuo
+}
+
+// BitAndInt8 applies a bitwise AND with i on the "int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndInt8(i)
+	return ftuo
+}
+
+// BitOrInt8 applies a bitwise OR with i on the "int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrInt8(i)
+	return ftuo
+}
+
 // SetInt16 sets the "int16" field.
 func (ftuo *FieldTypeUpdateOne) SetInt16(i int16) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt16()
@@ -1997,6 +2803,25 @@ func (ftuo *FieldTypeUpdateOne) AddInt16(i int16) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetInt16IfGreater sets the "int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetInt16IfGreater(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.SetInt16IfGreater(i)
+	return ftuo
+}
+
+// BitAndInt16 applies a bitwise AND with i on the "int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndInt16(i)
+	return ftuo
+}
+
+// BitOrInt16 applies a bitwise OR with i on the "int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrInt16(i)
+	return ftuo
+}
+
 // SetInt32 sets the "int32" field.
 func (ftuo *FieldTypeUpdateOne) SetInt32(i int32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt32()
@@ -2010,6 +2835,25 @@ func (ftuo *FieldTypeUpdateOne) AddInt32(i int32) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetInt32IfGreater sets the "int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetInt32IfGreater(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetInt32IfGreater(i)
+	return ftuo
+}
+
+// BitAndInt32 applies a bitwise AND with i on the "int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndInt32(i)
+	return ftuo
+}
+
+// BitOrInt32 applies a bitwise OR with i on the "int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrInt32(i)
+	return ftuo
+}
+
 // SetInt64 sets the "int64" field.
 func (ftuo *FieldTypeUpdateOne) SetInt64(i int64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt64()
@@ -2023,6 +2867,25 @@ func (ftuo *FieldTypeUpdateOne) AddInt64(i int64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetInt64IfGreater sets the "int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetInt64IfGreater(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetInt64IfGreater(i)
+	return ftuo
+}
+
+// BitAndInt64 applies a bitwise AND with i on the "int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndInt64(i)
+	return ftuo
+}
+
+// BitOrInt64 applies a bitwise OR with i on the "int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrInt64(i)
+	return ftuo
+}
+
 // SetOptionalInt sets the "optional_int" field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt(i int) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt()
@@ -2044,6 +2907,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalInt(i int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalIntIfGreater sets the "optional_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalIntIfGreater(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalIntIfGreater(i)
+	return ftuo
+}
+
+// BitAndOptionalInt applies a bitwise AND with i on the "optional_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalInt(i)
+	return ftuo
+}
+
+// BitOrOptionalInt applies a bitwise OR with i on the "optional_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalInt(i)
+	return ftuo
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalInt() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalInt()
@@ -2071,6 +2953,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalInt8(i int8) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalInt8IfGreater sets the "optional_int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalInt8IfGreater(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalInt8IfGreater(i)
+	return ftuo
+}
+
+// BitAndOptionalInt8 applies a bitwise AND with i on the "optional_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalInt8(i)
+	return ftuo
+}
+
+// BitOrOptionalInt8 applies a bitwise OR with i on the "optional_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalInt8(i)
+	return ftuo
+}
+
 // ClearOptionalInt8 clears the value of the "optional_int8" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalInt8() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalInt8()
@@ -2092,9 +2993,28 @@ func (ftuo *FieldTypeUpdateOne) SetNillableOptionalInt16(i *int16) *FieldTypeUpd
 	return ftuo
 }
 
-// AddOptionalInt16 adds i to the "optional_int16" field.
-func (ftuo *FieldTypeUpdateOne) AddOptionalInt16(i int16) *FieldTypeUpdateOne {
-	ftuo.mutation.AddOptionalInt16(i)
+// AddOptionalInt16 adds i to the "optional_int16" field.
+func (ftuo *FieldTypeUpdateOne) AddOptionalInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.AddOptionalInt16(i)
+	return ftuo
+}
+
+// SetOptionalInt16IfGreater sets the "optional_int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalInt16IfGreater(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalInt16IfGreater(i)
+	return ftuo
+}
+
+// BitAndOptionalInt16 applies a bitwise AND with i on the "optional_int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalInt16(i)
+	return ftuo
+}
+
+// BitOrOptionalInt16 applies a bitwise OR with i on the "optional_int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalInt16(i)
 	return ftuo
 }
 
@@ -2125,6 +3045,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalInt32(i int32) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalInt32IfGreater sets the "optional_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalInt32IfGreater(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalInt32IfGreater(i)
+	return ftuo
+}
+
+// BitAndOptionalInt32 applies a bitwise AND with i on the "optional_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalInt32(i)
+	return ftuo
+}
+
+// BitOrOptionalInt32 applies a bitwise OR with i on the "optional_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalInt32(i)
+	return ftuo
+}
+
 // ClearOptionalInt32 clears the value of the "optional_int32" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalInt32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalInt32()
@@ -2152,6 +3091,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalInt64(i int64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalInt64IfGreater sets the "optional_int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalInt64IfGreater(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalInt64IfGreater(i)
+	return ftuo
+}
+
+// BitAndOptionalInt64 applies a bitwise AND with i on the "optional_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalInt64(i)
+	return ftuo
+}
+
+// BitOrOptionalInt64 applies a bitwise OR with i on the "optional_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalInt64(i)
+	return ftuo
+}
+
 // ClearOptionalInt64 clears the value of the "optional_int64" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalInt64() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalInt64()
@@ -2179,6 +3137,25 @@ func (ftuo *FieldTypeUpdateOne) AddNillableInt(i int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetNillableIntIfGreater sets the "nillable_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetNillableIntIfGreater(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.SetNillableIntIfGreater(i)
+	return ftuo
+}
+
+// BitAndNillableInt applies a bitwise AND with i on the "nillable_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndNillableInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndNillableInt(i)
+	return ftuo
+}
+
+// BitOrNillableInt applies a bitwise OR with i on the "nillable_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrNillableInt(i int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrNillableInt(i)
+	return ftuo
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (ftuo *FieldTypeUpdateOne) ClearNillableInt() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearNillableInt()
@@ -2206,6 +3183,25 @@ func (ftuo *FieldTypeUpdateOne) AddNillableInt8(i int8) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetNillableInt8IfGreater sets the "nillable_int8" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetNillableInt8IfGreater(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.SetNillableInt8IfGreater(i)
+	return ftuo
+}
+
+// BitAndNillableInt8 applies a bitwise AND with i on the "nillable_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndNillableInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndNillableInt8(i)
+	return ftuo
+}
+
+// BitOrNillableInt8 applies a bitwise OR with i on the "nillable_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrNillableInt8(i int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrNillableInt8(i)
+	return ftuo
+}
+
 // ClearNillableInt8 clears the value of the "nillable_int8" field.
 func (ftuo *FieldTypeUpdateOne) ClearNillableInt8() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearNillableInt8()
@@ -2233,6 +3229,25 @@ func (ftuo *FieldTypeUpdateOne) AddNillableInt16(i int16) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetNillableInt16IfGreater sets the "nillable_int16" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetNillableInt16IfGreater(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.SetNillableInt16IfGreater(i)
+	return ftuo
+}
+
+// BitAndNillableInt16 applies a bitwise AND with i on the "nillable_int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndNillableInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndNillableInt16(i)
+	return ftuo
+}
+
+// BitOrNillableInt16 applies a bitwise OR with i on the "nillable_int16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrNillableInt16(i int16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrNillableInt16(i)
+	return ftuo
+}
+
 // ClearNillableInt16 clears the value of the "nillable_int16" field.
 func (ftuo *FieldTypeUpdateOne) ClearNillableInt16() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearNillableInt16()
@@ -2260,6 +3275,25 @@ func (ftuo *FieldTypeUpdateOne) AddNillableInt32(i int32) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetNillableInt32IfGreater sets the "nillable_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetNillableInt32IfGreater(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetNillableInt32IfGreater(i)
+	return ftuo
+}
+
+// BitAndNillableInt32 applies a bitwise AND with i on the "nillable_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndNillableInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndNillableInt32(i)
+	return ftuo
+}
+
+// BitOrNillableInt32 applies a bitwise OR with i on the "nillable_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrNillableInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrNillableInt32(i)
+	return ftuo
+}
+
 // ClearNillableInt32 clears the value of the "nillable_int32" field.
 func (ftuo *FieldTypeUpdateOne) ClearNillableInt32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearNillableInt32()
@@ -2287,6 +3321,25 @@ func (ftuo *FieldTypeUpdateOne) AddNillableInt64(i int64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetNillableInt64IfGreater sets the "nillable_int64" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetNillableInt64IfGreater(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetNillableInt64IfGreater(i)
+	return ftuo
+}
+
+// BitAndNillableInt64 applies a bitwise AND with i on the "nillable_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndNillableInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndNillableInt64(i)
+	return ftuo
+}
+
+// BitOrNillableInt64 applies a bitwise OR with i on the "nillable_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrNillableInt64(i int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrNillableInt64(i)
+	return ftuo
+}
+
 // ClearNillableInt64 clears the value of the "nillable_int64" field.
 func (ftuo *FieldTypeUpdateOne) ClearNillableInt64() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearNillableInt64()
@@ -2314,6 +3367,25 @@ func (ftuo *FieldTypeUpdateOne) AddValidateOptionalInt32(i int32) *FieldTypeUpda
 	return ftuo
 }
 
+// SetValidateOptionalInt32IfGreater sets the "validate_optional_int32" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetValidateOptionalInt32IfGreater(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetValidateOptionalInt32IfGreater(i)
+	return ftuo
+}
+
+// BitAndValidateOptionalInt32 applies a bitwise AND with i on the "validate_optional_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndValidateOptionalInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndValidateOptionalInt32(i)
+	return ftuo
+}
+
+// BitOrValidateOptionalInt32 applies a bitwise OR with i on the "validate_optional_int32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrValidateOptionalInt32(i int32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrValidateOptionalInt32(i)
+	return ftuo
+}
+
 // ClearValidateOptionalInt32 clears the value of the "validate_optional_int32" field.
 func (ftuo *FieldTypeUpdateOne) ClearValidateOptionalInt32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearValidateOptionalInt32()
@@ -2341,6 +3413,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalUint(u int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalUintIfGreater sets the "optional_uint" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalUintIfGreater(u uint) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalUintIfGreater(u)
+	return ftuo
+}
+
+// BitAndOptionalUint applies a bitwise AND with u on the "optional_uint" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalUint(u uint) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalUint(u)
+	return ftuo
+}
+
+// BitOrOptionalUint applies a bitwise OR with u on the "optional_uint" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalUint(u uint) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalUint(u)
+	return ftuo
+}
+
 // ClearOptionalUint clears the value of the "optional_uint" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalUint() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalUint()
@@ -2368,6 +3459,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalUint8(u int8) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalUint8IfGreater sets the "optional_uint8" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalUint8IfGreater(u uint8) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalUint8IfGreater(u)
+	return ftuo
+}
+
+// BitAndOptionalUint8 applies a bitwise AND with u on the "optional_uint8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalUint8(u uint8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalUint8(u)
+	return ftuo
+}
+
+// BitOrOptionalUint8 applies a bitwise OR with u on the "optional_uint8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalUint8(u uint8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalUint8(u)
+	return ftuo
+}
+
 // ClearOptionalUint8 clears the value of the "optional_uint8" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalUint8() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalUint8()
@@ -2395,6 +3505,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalUint16(u int16) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalUint16IfGreater sets the "optional_uint16" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalUint16IfGreater(u uint16) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalUint16IfGreater(u)
+	return ftuo
+}
+
+// BitAndOptionalUint16 applies a bitwise AND with u on the "optional_uint16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalUint16(u uint16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalUint16(u)
+	return ftuo
+}
+
+// BitOrOptionalUint16 applies a bitwise OR with u on the "optional_uint16" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalUint16(u uint16) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalUint16(u)
+	return ftuo
+}
+
 // ClearOptionalUint16 clears the value of the "optional_uint16" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalUint16() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalUint16()
@@ -2422,6 +3551,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalUint32(u int32) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalUint32IfGreater sets the "optional_uint32" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalUint32IfGreater(u uint32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalUint32IfGreater(u)
+	return ftuo
+}
+
+// BitAndOptionalUint32 applies a bitwise AND with u on the "optional_uint32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalUint32(u uint32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalUint32(u)
+	return ftuo
+}
+
+// BitOrOptionalUint32 applies a bitwise OR with u on the "optional_uint32" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalUint32(u uint32) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalUint32(u)
+	return ftuo
+}
+
 // ClearOptionalUint32 clears the value of the "optional_uint32" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalUint32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalUint32()
@@ -2449,6 +3597,25 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalUint64(u int64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetOptionalUint64IfGreater sets the "optional_uint64" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalUint64IfGreater(u uint64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalUint64IfGreater(u)
+	return ftuo
+}
+
+// BitAndOptionalUint64 applies a bitwise AND with u on the "optional_uint64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndOptionalUint64(u uint64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndOptionalUint64(u)
+	return ftuo
+}
+
+// BitOrOptionalUint64 applies a bitwise OR with u on the "optional_uint64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrOptionalUint64(u uint64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrOptionalUint64(u)
+	return ftuo
+}
+
 // ClearOptionalUint64 clears the value of the "optional_uint64" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalUint64() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalUint64()
@@ -2496,6 +3663,13 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalFloat(f float64) *FieldTypeUpdateOne
 	return ftuo
 }
 
+// SetOptionalFloatIfGreater sets the "optional_float" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalFloatIfGreater(f float64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalFloatIfGreater(f)
+	return ftuo
+}
+
 // ClearOptionalFloat clears the value of the "optional_float" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalFloat() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalFloat()
@@ -2523,6 +3697,13 @@ func (ftuo *FieldTypeUpdateOne) AddOptionalFloat32(f float32) *FieldTypeUpdateOn
 	return ftuo
 }
 
+// SetOptionalFloat32IfGreater sets the "optional_float32" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetOptionalFloat32IfGreater(f float32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetOptionalFloat32IfGreater(f)
+	return ftuo
+}
+
 // ClearOptionalFloat32 clears the value of the "optional_float32" field.
 func (ftuo *FieldTypeUpdateOne) ClearOptionalFloat32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearOptionalFloat32()
@@ -2590,6 +3771,13 @@ func (ftuo *FieldTypeUpdateOne) AddDecimal(f float64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetDecimalIfGreater sets the "decimal" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetDecimalIfGreater(f float64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetDecimalIfGreater(f)
+	return ftuo
+}
+
 // ClearDecimal clears the value of the "decimal" field.
 func (ftuo *FieldTypeUpdateOne) ClearDecimal() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearDecimal()
@@ -2705,6 +3893,25 @@ func (ftuo *FieldTypeUpdateOne) AddDuration(t time.Duration) *FieldTypeUpdateOne
 	return ftuo
 }
 
+// SetDurationIfGreater sets the "duration" field to t, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetDurationIfGreater(t time.Duration) *FieldTypeUpdateOne {
+	ftuo.mutation.SetDurationIfGreater(t)
+	return ftuo
+}
+
+// BitAndDuration applies a bitwise AND with t on the "duration" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndDuration(t time.Duration) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndDuration(t)
+	return ftuo
+}
+
+// BitOrDuration applies a bitwise OR with t on the "duration" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrDuration(t time.Duration) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrDuration(t)
+	return ftuo
+}
+
 // ClearDuration clears the value of the "duration" field.
 func (ftuo *FieldTypeUpdateOne) ClearDuration() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearDuration()
@@ -2942,6 +4149,25 @@ func (ftuo *FieldTypeUpdateOne) AddSchemaInt(s schema.Int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetSchemaIntIfGreater sets the "schema_int" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetSchemaIntIfGreater(s schema.Int) *FieldTypeUpdateOne {
+	ftuo.mutation.SetSchemaIntIfGreater(s)
+	return ftuo
+}
+
+// BitAndSchemaInt applies a bitwise AND with s on the "schema_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndSchemaInt(s schema.Int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndSchemaInt(s)
+	return ftuo
+}
+
+// BitOrSchemaInt applies a bitwise OR with s on the "schema_int" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrSchemaInt(s schema.Int) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrSchemaInt(s)
+	return ftuo
+}
+
 // ClearSchemaInt clears the value of the "schema_int" field.
 func (ftuo *FieldTypeUpdateOne) ClearSchemaInt() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearSchemaInt()
@@ -2969,6 +4195,25 @@ func (ftuo *FieldTypeUpdateOne) AddSchemaInt8(s schema.Int8) *FieldTypeUpdateOne
 	return ftuo
 }
 
+// SetSchemaInt8IfGreater sets the "schema_int8" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetSchemaInt8IfGreater(s schema.Int8) *FieldTypeUpdateOne {
+	ftuo.mutation.SetSchemaInt8IfGreater(s)
+	return ftuo
+}
+
+// BitAndSchemaInt8 applies a bitwise AND with s on the "schema_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndSchemaInt8(s schema.Int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndSchemaInt8(s)
+	return ftuo
+}
+
+// BitOrSchemaInt8 applies a bitwise OR with s on the "schema_int8" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrSchemaInt8(s schema.Int8) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrSchemaInt8(s)
+	return ftuo
+}
+
 // ClearSchemaInt8 clears the value of the "schema_int8" field.
 func (ftuo *FieldTypeUpdateOne) ClearSchemaInt8() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearSchemaInt8()
@@ -2996,6 +4241,25 @@ func (ftuo *FieldTypeUpdateOne) AddSchemaInt64(s schema.Int64) *FieldTypeUpdateO
 	return ftuo
 }
 
+// SetSchemaInt64IfGreater sets the "schema_int64" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetSchemaInt64IfGreater(s schema.Int64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetSchemaInt64IfGreater(s)
+	return ftuo
+}
+
+// BitAndSchemaInt64 applies a bitwise AND with s on the "schema_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitAndSchemaInt64(s schema.Int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitAndSchemaInt64(s)
+	return ftuo
+}
+
+// BitOrSchemaInt64 applies a bitwise OR with s on the "schema_int64" field in the database.
+func (ftuo *FieldTypeUpdateOne) BitOrSchemaInt64(s schema.Int64) *FieldTypeUpdateOne {
+	ftuo.mutation.BitOrSchemaInt64(s)
+	return ftuo
+}
+
 // ClearSchemaInt64 clears the value of the "schema_int64" field.
 func (ftuo *FieldTypeUpdateOne) ClearSchemaInt64() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearSchemaInt64()
@@ -3023,6 +4287,13 @@ func (ftuo *FieldTypeUpdateOne) AddSchemaFloat(s schema.Float64) *FieldTypeUpdat
 	return ftuo
 }
 
+// SetSchemaFloatIfGreater sets the "schema_float" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetSchemaFloatIfGreater(s schema.Float64) *FieldTypeUpdateOne {
+	ftuo.mutation.SetSchemaFloatIfGreater(s)
+	return ftuo
+}
+
 // ClearSchemaFloat clears the value of the "schema_float" field.
 func (ftuo *FieldTypeUpdateOne) ClearSchemaFloat() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearSchemaFloat()
@@ -3050,6 +4321,13 @@ func (ftuo *FieldTypeUpdateOne) AddSchemaFloat32(s schema.Float32) *FieldTypeUpd
 	return ftuo
 }
 
+// SetSchemaFloat32IfGreater sets the "schema_float32" field to s, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (ftuo *FieldTypeUpdateOne) SetSchemaFloat32IfGreater(s schema.Float32) *FieldTypeUpdateOne {
+	ftuo.mutation.SetSchemaFloat32IfGreater(s)
+	return ftuo
+}
+
 // ClearSchemaFloat32 clears the value of the "schema_float32" field.
 func (ftuo *FieldTypeUpdateOne) ClearSchemaFloat32() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearSchemaFloat32()
@@ -3148,6 +4426,12 @@ func (ftuo *FieldTypeUpdateOne) SetStrings(s []string) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// AppendStrings appends s to the "strings" field in the database.
+func (ftuo *FieldTypeUpdateOne) AppendStrings(s []string) *FieldTypeUpdateOne {
+	ftuo.mutation.AppendStrings(s)
+	return ftuo
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (ftuo *FieldTypeUpdateOne) ClearStrings() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearStrings()
@@ -3402,6 +4686,162 @@ func (ftuo *FieldTypeUpdateOne) check() error {
 
 func (ftuo *FieldTypeUpdateOne) gremlinSave(ctx context.Context) (*FieldType, error) {
 	res := &gremlin.Response{}
+	if _, ok := ftuo.mutation.IntBitAnd(); ok {
+		return nil, errors.New("ent: BitAndInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.IntBitOr(); ok {
+		return nil, errors.New("ent: BitOrInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.Int8BitAnd(); ok {
+		return nil, errors.New("ent: BitAndInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.Int8BitOr(); ok {
+		return nil, errors.New("ent: BitOrInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.Int16BitAnd(); ok {
+		return nil, errors.New("ent: BitAndInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.Int16BitOr(); ok {
+		return nil, errors.New("ent: BitOrInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.Int32BitAnd(); ok {
+		return nil, errors.New("ent: BitAndInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.Int32BitOr(); ok {
+		return nil, errors.New("ent: BitOrInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.Int64BitAnd(); ok {
+		return nil, errors.New("ent: BitAndInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.Int64BitOr(); ok {
+		return nil, errors.New("ent: BitOrInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalIntBitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalIntBitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalInt8BitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalInt8BitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalInt16BitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalInt16BitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalInt32BitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalInt32BitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalInt64BitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalInt64BitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.NillableIntBitAnd(); ok {
+		return nil, errors.New("ent: BitAndNillableInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.NillableIntBitOr(); ok {
+		return nil, errors.New("ent: BitOrNillableInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.NillableInt8BitAnd(); ok {
+		return nil, errors.New("ent: BitAndNillableInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.NillableInt8BitOr(); ok {
+		return nil, errors.New("ent: BitOrNillableInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.NillableInt16BitAnd(); ok {
+		return nil, errors.New("ent: BitAndNillableInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.NillableInt16BitOr(); ok {
+		return nil, errors.New("ent: BitOrNillableInt16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.NillableInt32BitAnd(); ok {
+		return nil, errors.New("ent: BitAndNillableInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.NillableInt32BitOr(); ok {
+		return nil, errors.New("ent: BitOrNillableInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.NillableInt64BitAnd(); ok {
+		return nil, errors.New("ent: BitAndNillableInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.NillableInt64BitOr(); ok {
+		return nil, errors.New("ent: BitOrNillableInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.ValidateOptionalInt32BitAnd(); ok {
+		return nil, errors.New("ent: BitAndValidateOptionalInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.ValidateOptionalInt32BitOr(); ok {
+		return nil, errors.New("ent: BitOrValidateOptionalInt32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalUintBitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalUint is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalUintBitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalUint is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalUint8BitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalUint8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalUint8BitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalUint8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalUint16BitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalUint16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalUint16BitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalUint16 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalUint32BitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalUint32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalUint32BitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalUint32 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalUint64BitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalUint64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.OptionalUint64BitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalUint64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.DurationBitAnd(); ok {
+		return nil, errors.New("ent: BitAndDuration is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.DurationBitOr(); ok {
+		return nil, errors.New("ent: BitOrDuration is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.SchemaIntBitAnd(); ok {
+		return nil, errors.New("ent: BitAndSchemaInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.SchemaIntBitOr(); ok {
+		return nil, errors.New("ent: BitOrSchemaInt is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.SchemaInt8BitAnd(); ok {
+		return nil, errors.New("ent: BitAndSchemaInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.SchemaInt8BitOr(); ok {
+		return nil, errors.New("ent: BitOrSchemaInt8 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.SchemaInt64BitAnd(); ok {
+		return nil, errors.New("ent: BitAndSchemaInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.SchemaInt64BitOr(); ok {
+		return nil, errors.New("ent: BitOrSchemaInt64 is not supported by the gremlin dialect")
+	}
+	if _, ok := ftuo.mutation.AppendedStrings(); ok {
+		return nil, errors.New("ent: AppendStrings is not supported by the gremlin dialect")
+	}
+	if len(ftuo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := ftuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FieldType.id" for update`)}
@@ -3431,126 +4871,189 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := ftuo.mutation.AddedInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt, __.Union(__.Values(fieldtype.FieldInt), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.IntIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldInt, __.Union(__.Values(fieldtype.FieldInt), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.Int8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt8, value)
 	}
 	if value, ok := ftuo.mutation.AddedInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt8, __.Union(__.Values(fieldtype.FieldInt8), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.Int8IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldInt8, __.Union(__.Values(fieldtype.FieldInt8), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.Int16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt16, value)
 	}
 	if value, ok := ftuo.mutation.AddedInt16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt16, __.Union(__.Values(fieldtype.FieldInt16), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.Int16IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldInt16, __.Union(__.Values(fieldtype.FieldInt16), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.Int32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt32, value)
 	}
 	if value, ok := ftuo.mutation.AddedInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt32, __.Union(__.Values(fieldtype.FieldInt32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.Int32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldInt32, __.Union(__.Values(fieldtype.FieldInt32), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.Int64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt64, value)
 	}
 	if value, ok := ftuo.mutation.AddedInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldInt64, __.Union(__.Values(fieldtype.FieldInt64), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.Int64IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldInt64, __.Union(__.Values(fieldtype.FieldInt64), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt, __.Union(__.Values(fieldtype.FieldOptionalInt), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalIntIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalInt, __.Union(__.Values(fieldtype.FieldOptionalInt), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt8, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt8, __.Union(__.Values(fieldtype.FieldOptionalInt8), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalInt8IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalInt8, __.Union(__.Values(fieldtype.FieldOptionalInt8), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalInt16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt16, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalInt16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt16, __.Union(__.Values(fieldtype.FieldOptionalInt16), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalInt16IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalInt16, __.Union(__.Values(fieldtype.FieldOptionalInt16), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt32, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt32, __.Union(__.Values(fieldtype.FieldOptionalInt32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalInt32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalInt32, __.Union(__.Values(fieldtype.FieldOptionalInt32), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt64, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalInt64, __.Union(__.Values(fieldtype.FieldOptionalInt64), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalInt64IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalInt64, __.Union(__.Values(fieldtype.FieldOptionalInt64), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.NillableInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt, value)
 	}
 	if value, ok := ftuo.mutation.AddedNillableInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt, __.Union(__.Values(fieldtype.FieldNillableInt), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.NillableIntIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldNillableInt, __.Union(__.Values(fieldtype.FieldNillableInt), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.NillableInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt8, value)
 	}
 	if value, ok := ftuo.mutation.AddedNillableInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt8, __.Union(__.Values(fieldtype.FieldNillableInt8), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.NillableInt8IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldNillableInt8, __.Union(__.Values(fieldtype.FieldNillableInt8), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.NillableInt16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt16, value)
 	}
 	if value, ok := ftuo.mutation.AddedNillableInt16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt16, __.Union(__.Values(fieldtype.FieldNillableInt16), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.NillableInt16IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldNillableInt16, __.Union(__.Values(fieldtype.FieldNillableInt16), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.NillableInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt32, value)
 	}
 	if value, ok := ftuo.mutation.AddedNillableInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt32, __.Union(__.Values(fieldtype.FieldNillableInt32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.NillableInt32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldNillableInt32, __.Union(__.Values(fieldtype.FieldNillableInt32), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.NillableInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt64, value)
 	}
 	if value, ok := ftuo.mutation.AddedNillableInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNillableInt64, __.Union(__.Values(fieldtype.FieldNillableInt64), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.NillableInt64IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldNillableInt64, __.Union(__.Values(fieldtype.FieldNillableInt64), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.ValidateOptionalInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldValidateOptionalInt32, value)
 	}
 	if value, ok := ftuo.mutation.AddedValidateOptionalInt32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldValidateOptionalInt32, __.Union(__.Values(fieldtype.FieldValidateOptionalInt32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.ValidateOptionalInt32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldValidateOptionalInt32, __.Union(__.Values(fieldtype.FieldValidateOptionalInt32), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalUint(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalUint(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint, __.Union(__.Values(fieldtype.FieldOptionalUint), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalUintIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalUint, __.Union(__.Values(fieldtype.FieldOptionalUint), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalUint8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint8, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalUint8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint8, __.Union(__.Values(fieldtype.FieldOptionalUint8), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalUint8IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalUint8, __.Union(__.Values(fieldtype.FieldOptionalUint8), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalUint16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint16, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalUint16(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint16, __.Union(__.Values(fieldtype.FieldOptionalUint16), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalUint16IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalUint16, __.Union(__.Values(fieldtype.FieldOptionalUint16), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalUint32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint32, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalUint32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint32, __.Union(__.Values(fieldtype.FieldOptionalUint32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalUint32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalUint32, __.Union(__.Values(fieldtype.FieldOptionalUint32), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalUint64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint64, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalUint64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalUint64, __.Union(__.Values(fieldtype.FieldOptionalUint64), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalUint64IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalUint64, __.Union(__.Values(fieldtype.FieldOptionalUint64), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.State(); ok {
 		v.Property(dsl.Single, fieldtype.FieldState, value)
 	}
@@ -3560,12 +5063,18 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := ftuo.mutation.AddedOptionalFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalFloat, __.Union(__.Values(fieldtype.FieldOptionalFloat), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalFloatIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalFloat, __.Union(__.Values(fieldtype.FieldOptionalFloat), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.OptionalFloat32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalFloat32, value)
 	}
 	if value, ok := ftuo.mutation.AddedOptionalFloat32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldOptionalFloat32, __.Union(__.Values(fieldtype.FieldOptionalFloat32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.OptionalFloat32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldOptionalFloat32, __.Union(__.Values(fieldtype.FieldOptionalFloat32), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.Text(); ok {
 		v.Property(dsl.Single, fieldtype.FieldText, value)
 	}
@@ -3578,6 +5087,9 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := ftuo.mutation.AddedDecimal(); ok {
 		v.Property(dsl.Single, fieldtype.FieldDecimal, __.Union(__.Values(fieldtype.FieldDecimal), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.DecimalIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldDecimal, __.Union(__.Values(fieldtype.FieldDecimal), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.LinkOther(); ok {
 		v.Property(dsl.Single, fieldtype.FieldLinkOther, value)
 	}
@@ -3602,6 +5114,9 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := ftuo.mutation.AddedDuration(); ok {
 		v.Property(dsl.Single, fieldtype.FieldDuration, __.Union(__.Values(fieldtype.FieldDuration), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.DurationIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldDuration, __.Union(__.Values(fieldtype.FieldDuration), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.Dir(); ok {
 		v.Property(dsl.Single, fieldtype.FieldDir, value)
 	}
@@ -3650,30 +5165,45 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := ftuo.mutation.AddedSchemaInt(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaInt, __.Union(__.Values(fieldtype.FieldSchemaInt), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.SchemaIntIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldSchemaInt, __.Union(__.Values(fieldtype.FieldSchemaInt), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.SchemaInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaInt8, value)
 	}
 	if value, ok := ftuo.mutation.AddedSchemaInt8(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaInt8, __.Union(__.Values(fieldtype.FieldSchemaInt8), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.SchemaInt8IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldSchemaInt8, __.Union(__.Values(fieldtype.FieldSchemaInt8), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.SchemaInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaInt64, value)
 	}
 	if value, ok := ftuo.mutation.AddedSchemaInt64(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaInt64, __.Union(__.Values(fieldtype.FieldSchemaInt64), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.SchemaInt64IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldSchemaInt64, __.Union(__.Values(fieldtype.FieldSchemaInt64), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.SchemaFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaFloat, value)
 	}
 	if value, ok := ftuo.mutation.AddedSchemaFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaFloat, __.Union(__.Values(fieldtype.FieldSchemaFloat), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.SchemaFloatIfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldSchemaFloat, __.Union(__.Values(fieldtype.FieldSchemaFloat), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.SchemaFloat32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaFloat32, value)
 	}
 	if value, ok := ftuo.mutation.AddedSchemaFloat32(); ok {
 		v.Property(dsl.Single, fieldtype.FieldSchemaFloat32, __.Union(__.Values(fieldtype.FieldSchemaFloat32), __.Constant(value)).Sum())
 	}
+	if value, ok := ftuo.mutation.SchemaFloat32IfGreater(); ok {
+		v.Property(dsl.Single, fieldtype.FieldSchemaFloat32, __.Union(__.Values(fieldtype.FieldSchemaFloat32), __.Constant(value)).Max())
+	}
 	if value, ok := ftuo.mutation.NullFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNullFloat, value)
 	}
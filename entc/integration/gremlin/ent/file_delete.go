@@ -101,7 +101,7 @@ func (fdo *FileDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{file.Label}
+		return &NotFoundError{label: file.Label}
 	default:
 		return nil
 	}
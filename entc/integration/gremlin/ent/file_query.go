@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -28,6 +30,7 @@ type FileQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.File
 	withOwner  *UserQuery
 	withType   *FileTypeQuery
@@ -70,7 +73,7 @@ func (fq *FileQuery) Order(o ...OrderFunc) *FileQuery {
 
 // QueryOwner chains the current query on the "owner" edge.
 func (fq *FileQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -84,7 +87,7 @@ func (fq *FileQuery) QueryOwner() *UserQuery {
 
 // QueryType chains the current query on the "type" edge.
 func (fq *FileQuery) QueryType() *FileTypeQuery {
-	query := &FileTypeQuery{config: fq.config}
+	query := (&FileTypeClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -98,7 +101,7 @@ func (fq *FileQuery) QueryType() *FileTypeQuery {
 
 // QueryField chains the current query on the "field" edge.
 func (fq *FileQuery) QueryField() *FieldTypeQuery {
-	query := &FieldTypeQuery{config: fq.config}
+	query := (&FieldTypeClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -118,7 +121,7 @@ func (fq *FileQuery) First(ctx context.Context) (*File, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{file.Label}
+		return nil, fq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -140,7 +143,7 @@ func (fq *FileQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{file.Label}
+		err = fq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -167,7 +170,7 @@ func (fq *FileQuery) Only(ctx context.Context) (*File, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{file.Label}
+		return nil, fq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{file.Label}
 	}
@@ -194,7 +197,7 @@ func (fq *FileQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{file.Label}
+		err = fq.gremlinNotFound()
 	default:
 		err = &NotSingularError{file.Label}
 	}
@@ -215,7 +218,17 @@ func (fq *FileQuery) All(ctx context.Context) ([]*File, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return fq.gremlinAll(ctx)
+	v, err := fq.intercept(ctx, "All", func(ctx context.Context, query *FileQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*File)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*File", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -229,10 +242,20 @@ func (fq *FileQuery) AllX(ctx context.Context) []*File {
 
 // IDs executes the query and returns a list of File IDs.
 func (fq *FileQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := fq.Select(file.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := fq.intercept(ctx, "IDs", func(ctx context.Context, query *FileQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(file.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -250,7 +273,17 @@ func (fq *FileQuery) Count(ctx context.Context) (int, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return fq.gremlinCount(ctx)
+	v, err := fq.intercept(ctx, "Count", func(ctx context.Context, query *FileQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -267,7 +300,68 @@ func (fq *FileQuery) Exist(ctx context.Context) (bool, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return fq.gremlinExist(ctx)
+	v, err := fq.intercept(ctx, "Exist", func(ctx context.Context, query *FileQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (fq *FileQuery) intercept(ctx context.Context, op string, fn func(context.Context, *FileQuery) (Value, error)) (Value, error) {
+	if len(fq.inters) == 0 {
+		return fn(ctx, fq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeFile,
+		Op:     op,
+		Limit:  fq.limit,
+		Offset: fq.offset,
+		Unique: fq.unique,
+		Fields: fq.fields,
+	}
+	for _, p := range fq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range fq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if fq.withOwner != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, file.EdgeOwner)
+		qc.EdgeQueries[file.EdgeOwner] = fq.withOwner
+	}
+	if fq.withType != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, file.EdgeType)
+		qc.EdgeQueries[file.EdgeType] = fq.withType
+	}
+	if fq.withField != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, file.EdgeField)
+		qc.EdgeQueries[file.EdgeField] = fq.withField
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *FileQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, fq, qc, qr, fq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -290,6 +384,7 @@ func (fq *FileQuery) Clone() *FileQuery {
 		limit:      fq.limit,
 		offset:     fq.offset,
 		order:      append([]OrderFunc{}, fq.order...),
+		inters:     append([]Interceptor{}, fq.inters...),
 		predicates: append([]predicate.File{}, fq.predicates...),
 		withOwner:  fq.withOwner.Clone(),
 		withType:   fq.withType.Clone(),
@@ -304,7 +399,7 @@ func (fq *FileQuery) Clone() *FileQuery {
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithOwner(opts ...func(*UserQuery)) *FileQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -315,7 +410,7 @@ func (fq *FileQuery) WithOwner(opts ...func(*UserQuery)) *FileQuery {
 // WithType tells the query-builder to eager-load the nodes that are connected to
 // the "type" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithType(opts ...func(*FileTypeQuery)) *FileQuery {
-	query := &FileTypeQuery{config: fq.config}
+	query := (&FileTypeClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -326,7 +421,7 @@ func (fq *FileQuery) WithType(opts ...func(*FileTypeQuery)) *FileQuery {
 // WithField tells the query-builder to eager-load the nodes that are connected to
 // the "field" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithField(opts ...func(*FieldTypeQuery)) *FileQuery {
-	query := &FieldTypeQuery{config: fq.config}
+	query := (&FieldTypeClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -385,6 +480,16 @@ func (fq *FileQuery) Select(fields ...string) *FileSelect {
 }
 
 func (fq *FileQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range fq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fq); err != nil {
+				return err
+			}
+		}
+	}
 	if fq.path != nil {
 		prev, err := fq.path(ctx)
 		if err != nil {
@@ -408,7 +513,7 @@ func (fq *FileQuery) gremlinAll(ctx context.Context) ([]*File, error) {
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := fq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := fq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var fs Files
@@ -422,7 +527,7 @@ func (fq *FileQuery) gremlinAll(ctx context.Context) ([]*File, error) {
 func (fq *FileQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := fq.gremlinQuery(ctx).Count().Query()
-	if err := fq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := fq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -431,7 +536,7 @@ func (fq *FileQuery) gremlinCount(ctx context.Context) (int, error) {
 func (fq *FileQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := fq.gremlinQuery(ctx).HasNext().Query()
-	if err := fq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := fq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -465,6 +570,11 @@ func (fq *FileQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (fq *FileQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: file.Label}
+}
+
 // FileGroupBy is the group-by builder for File entities.
 type FileGroupBy struct {
 	config
@@ -495,7 +605,7 @@ func (fgb *FileGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (fgb *FileGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := fgb.gremlinQuery().Query()
-	if err := fgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := fgb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(fgb.fields)+len(fgb.fns) == 1 {
@@ -565,7 +675,7 @@ func (fs *FileSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		traversal = fs.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := fs.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := fs.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(fs.fields) == 1 {
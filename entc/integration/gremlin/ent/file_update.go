@@ -56,6 +56,25 @@ func (fu *FileUpdate) AddSize(i int) *FileUpdate {
 	return fu
 }
 
+// SetSizeIfGreater sets the "size" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (fu *FileUpdate) SetSizeIfGreater(i int) *FileUpdate {
+	fu.mutation.SetSizeIfGreater(i)
+	return fu
+}
+
+// BitAndSize applies a bitwise AND with i on the "size" field in the database.
+func (fu *FileUpdate) BitAndSize(i int) *FileUpdate {
+	fu.mutation.BitAndSize(i)
+	return fu
+}
+
+// BitOrSize applies a bitwise OR with i on the "size" field in the database.
+func (fu *FileUpdate) BitOrSize(i int) *FileUpdate {
+	fu.mutation.BitOrSize(i)
+	return fu
+}
+
 // SetName sets the "name" field.
 func (fu *FileUpdate) SetName(s string) *FileUpdate {
 	fu.mutation.SetName(s)
@@ -285,6 +304,12 @@ func (fu *FileUpdate) check() error {
 
 func (fu *FileUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
+	if _, ok := fu.mutation.SizeBitAnd(); ok {
+		return 0, errors.New("ent: BitAndSize is not supported by the gremlin dialect")
+	}
+	if _, ok := fu.mutation.SizeBitOr(); ok {
+		return 0, errors.New("ent: BitOrSize is not supported by the gremlin dialect")
+	}
 	query, bindings := fu.gremlin().Query()
 	if err := fu.driver.Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
@@ -317,6 +342,9 @@ func (fu *FileUpdate) gremlin() *dsl.Traversal {
 	if value, ok := fu.mutation.AddedSize(); ok {
 		v.Property(dsl.Single, file.FieldSize, __.Union(__.Values(file.FieldSize), __.Constant(value)).Sum())
 	}
+	if value, ok := fu.mutation.SizeIfGreater(); ok {
+		v.Property(dsl.Single, file.FieldSize, __.Union(__.Values(file.FieldSize), __.Constant(value)).Max())
+	}
 	if value, ok := fu.mutation.Name(); ok {
 		v.Property(dsl.Single, file.FieldName, value)
 	}
@@ -385,9 +413,18 @@ func (fu *FileUpdate) gremlin() *dsl.Traversal {
 // FileUpdateOne is the builder for updating a single File entity.
 type FileUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FileMutation
+	fields     []string
+	conditions []predicate.File
+	hooks      []Hook
+	mutation   *FileMutation
+}
+
+// Where appends a list of conditions to the FileUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (fuo *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
+	fuo.conditions = append(fuo.conditions, ps...)
+	return fuo
 }
 
 // SetSize sets the "size" field.
@@ -411,6 +448,25 @@ func (fuo *FileUpdateOne) AddSize(i int) *FileUpdateOne {
 	return fuo
 }
 
+// SetSizeIfGreater sets the "size" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (fuo *FileUpdateOne) SetSizeIfGreater(i int) *FileUpdateOne {
+	fuo.mutation.SetSizeIfGreater(i)
+	return fuo
+}
+
+// BitAndSize applies a bitwise AND with i on the "size" field in the database.
+func (fuo *FileUpdateOne) BitAndSize(i int) *FileUpdateOne {
+	fuo.mutation.BitAndSize(i)
+	return fuo
+}
+
+// BitOrSize applies a bitwise OR with i on the "size" field in the database.
+func (fuo *FileUpdateOne) BitOrSize(i int) *FileUpdateOne {
+	fuo.mutation.BitOrSize(i)
+	return fuo
+}
+
 // SetName sets the "name" field.
 func (fuo *FileUpdateOne) SetName(s string) *FileUpdateOne {
 	fuo.mutation.SetName(s)
@@ -653,6 +709,15 @@ func (fuo *FileUpdateOne) check() error {
 
 func (fuo *FileUpdateOne) gremlinSave(ctx context.Context) (*File, error) {
 	res := &gremlin.Response{}
+	if _, ok := fuo.mutation.SizeBitAnd(); ok {
+		return nil, errors.New("ent: BitAndSize is not supported by the gremlin dialect")
+	}
+	if _, ok := fuo.mutation.SizeBitOr(); ok {
+		return nil, errors.New("ent: BitOrSize is not supported by the gremlin dialect")
+	}
+	if len(fuo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := fuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "File.id" for update`)}
@@ -690,6 +755,9 @@ func (fuo *FileUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := fuo.mutation.AddedSize(); ok {
 		v.Property(dsl.Single, file.FieldSize, __.Union(__.Values(file.FieldSize), __.Constant(value)).Sum())
 	}
+	if value, ok := fuo.mutation.SizeIfGreater(); ok {
+		v.Property(dsl.Single, file.FieldSize, __.Union(__.Values(file.FieldSize), __.Constant(value)).Max())
+	}
 	if value, ok := fuo.mutation.Name(); ok {
 		v.Property(dsl.Single, file.FieldName, value)
 	}
@@ -101,7 +101,7 @@ func (ftdo *FileTypeDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{filetype.Label}
+		return &NotFoundError{label: filetype.Label}
 	default:
 		return nil
 	}
@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -26,6 +28,7 @@ type FileTypeQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.FileType
 	withFiles  *FileQuery
 	// intermediate query (i.e. traversal path).
@@ -66,7 +69,7 @@ func (ftq *FileTypeQuery) Order(o ...OrderFunc) *FileTypeQuery {
 
 // QueryFiles chains the current query on the "files" edge.
 func (ftq *FileTypeQuery) QueryFiles() *FileQuery {
-	query := &FileQuery{config: ftq.config}
+	query := (&FileClient{config: ftq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := ftq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -86,7 +89,7 @@ func (ftq *FileTypeQuery) First(ctx context.Context) (*FileType, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{filetype.Label}
+		return nil, ftq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -108,7 +111,7 @@ func (ftq *FileTypeQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{filetype.Label}
+		err = ftq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -135,7 +138,7 @@ func (ftq *FileTypeQuery) Only(ctx context.Context) (*FileType, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{filetype.Label}
+		return nil, ftq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{filetype.Label}
 	}
@@ -162,7 +165,7 @@ func (ftq *FileTypeQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{filetype.Label}
+		err = ftq.gremlinNotFound()
 	default:
 		err = &NotSingularError{filetype.Label}
 	}
@@ -183,7 +186,17 @@ func (ftq *FileTypeQuery) All(ctx context.Context) ([]*FileType, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ftq.gremlinAll(ctx)
+	v, err := ftq.intercept(ctx, "All", func(ctx context.Context, query *FileTypeQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*FileType)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*FileType", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -197,10 +210,20 @@ func (ftq *FileTypeQuery) AllX(ctx context.Context) []*FileType {
 
 // IDs executes the query and returns a list of FileType IDs.
 func (ftq *FileTypeQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := ftq.Select(filetype.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := ftq.intercept(ctx, "IDs", func(ctx context.Context, query *FileTypeQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(filetype.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -218,7 +241,17 @@ func (ftq *FileTypeQuery) Count(ctx context.Context) (int, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ftq.gremlinCount(ctx)
+	v, err := ftq.intercept(ctx, "Count", func(ctx context.Context, query *FileTypeQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -235,7 +268,54 @@ func (ftq *FileTypeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ftq.gremlinExist(ctx)
+	v, err := ftq.intercept(ctx, "Exist", func(ctx context.Context, query *FileTypeQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (ftq *FileTypeQuery) intercept(ctx context.Context, op string, fn func(context.Context, *FileTypeQuery) (Value, error)) (Value, error) {
+	if len(ftq.inters) == 0 {
+		return fn(ctx, ftq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeFileType,
+		Op:     op,
+		Limit:  ftq.limit,
+		Offset: ftq.offset,
+		Unique: ftq.unique,
+		Fields: ftq.fields,
+	}
+	for _, p := range ftq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range ftq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if ftq.withFiles != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, filetype.EdgeFiles)
+		qc.EdgeQueries[filetype.EdgeFiles] = ftq.withFiles
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *FileTypeQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, ftq, qc, qr, ftq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -258,6 +338,7 @@ func (ftq *FileTypeQuery) Clone() *FileTypeQuery {
 		limit:      ftq.limit,
 		offset:     ftq.offset,
 		order:      append([]OrderFunc{}, ftq.order...),
+		inters:     append([]Interceptor{}, ftq.inters...),
 		predicates: append([]predicate.FileType{}, ftq.predicates...),
 		withFiles:  ftq.withFiles.Clone(),
 		// clone intermediate query.
@@ -270,7 +351,7 @@ func (ftq *FileTypeQuery) Clone() *FileTypeQuery {
 // WithFiles tells the query-builder to eager-load the nodes that are connected to
 // the "files" edge. The optional arguments are used to configure the query builder of the edge.
 func (ftq *FileTypeQuery) WithFiles(opts ...func(*FileQuery)) *FileTypeQuery {
-	query := &FileQuery{config: ftq.config}
+	query := (&FileClient{config: ftq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -329,6 +410,16 @@ func (ftq *FileTypeQuery) Select(fields ...string) *FileTypeSelect {
 }
 
 func (ftq *FileTypeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ftq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ftq); err != nil {
+				return err
+			}
+		}
+	}
 	if ftq.path != nil {
 		prev, err := ftq.path(ctx)
 		if err != nil {
@@ -352,7 +443,7 @@ func (ftq *FileTypeQuery) gremlinAll(ctx context.Context) ([]*FileType, error) {
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ftq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var fts FileTypes
@@ -366,7 +457,7 @@ func (ftq *FileTypeQuery) gremlinAll(ctx context.Context) ([]*FileType, error) {
 func (ftq *FileTypeQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := ftq.gremlinQuery(ctx).Count().Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ftq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -375,7 +466,7 @@ func (ftq *FileTypeQuery) gremlinCount(ctx context.Context) (int, error) {
 func (ftq *FileTypeQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := ftq.gremlinQuery(ctx).HasNext().Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ftq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -409,6 +500,11 @@ func (ftq *FileTypeQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (ftq *FileTypeQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: filetype.Label}
+}
+
 // FileTypeGroupBy is the group-by builder for FileType entities.
 type FileTypeGroupBy struct {
 	config
@@ -439,7 +535,7 @@ func (ftgb *FileTypeGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (ftgb *FileTypeGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := ftgb.gremlinQuery().Query()
-	if err := ftgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ftgb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(ftgb.fields)+len(ftgb.fns) == 1 {
@@ -509,7 +605,7 @@ func (fts *FileTypeSelect) gremlinScan(ctx context.Context, v interface{}) error
 		traversal = fts.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := fts.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := fts.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(fts.fields) == 1 {
@@ -253,9 +253,18 @@ func (ftu *FileTypeUpdate) gremlin() *dsl.Traversal {
 // FileTypeUpdateOne is the builder for updating a single FileType entity.
 type FileTypeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FileTypeMutation
+	fields     []string
+	conditions []predicate.FileType
+	hooks      []Hook
+	mutation   *FileTypeMutation
+}
+
+// Where appends a list of conditions to the FileTypeUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (ftuo *FileTypeUpdateOne) Where(ps ...predicate.FileType) *FileTypeUpdateOne {
+	ftuo.conditions = append(ftuo.conditions, ps...)
+	return ftuo
 }
 
 // SetName sets the "name" field.
@@ -423,6 +432,9 @@ func (ftuo *FileTypeUpdateOne) check() error {
 
 func (ftuo *FileTypeUpdateOne) gremlinSave(ctx context.Context) (*FileType, error) {
 	res := &gremlin.Response{}
+	if len(ftuo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := ftuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileType.id" for update`)}
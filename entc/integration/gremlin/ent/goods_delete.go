@@ -101,7 +101,7 @@ func (gdo *GoodsDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{goods.Label}
+		return &NotFoundError{label: goods.Label}
 	default:
 		return nil
 	}
@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -26,6 +28,7 @@ type GoodsQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Goods
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
@@ -71,7 +74,7 @@ func (gq *GoodsQuery) First(ctx context.Context) (*Goods, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{goods.Label}
+		return nil, gq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -93,7 +96,7 @@ func (gq *GoodsQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{goods.Label}
+		err = gq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -120,7 +123,7 @@ func (gq *GoodsQuery) Only(ctx context.Context) (*Goods, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{goods.Label}
+		return nil, gq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{goods.Label}
 	}
@@ -147,7 +150,7 @@ func (gq *GoodsQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{goods.Label}
+		err = gq.gremlinNotFound()
 	default:
 		err = &NotSingularError{goods.Label}
 	}
@@ -168,7 +171,17 @@ func (gq *GoodsQuery) All(ctx context.Context) ([]*Goods, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return gq.gremlinAll(ctx)
+	v, err := gq.intercept(ctx, "All", func(ctx context.Context, query *GoodsQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Goods)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Goods", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -182,10 +195,20 @@ func (gq *GoodsQuery) AllX(ctx context.Context) []*Goods {
 
 // IDs executes the query and returns a list of Goods IDs.
 func (gq *GoodsQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := gq.Select(goods.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := gq.intercept(ctx, "IDs", func(ctx context.Context, query *GoodsQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(goods.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -203,7 +226,17 @@ func (gq *GoodsQuery) Count(ctx context.Context) (int, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return gq.gremlinCount(ctx)
+	v, err := gq.intercept(ctx, "Count", func(ctx context.Context, query *GoodsQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -220,7 +253,47 @@ func (gq *GoodsQuery) Exist(ctx context.Context) (bool, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return gq.gremlinExist(ctx)
+	v, err := gq.intercept(ctx, "Exist", func(ctx context.Context, query *GoodsQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (gq *GoodsQuery) intercept(ctx context.Context, op string, fn func(context.Context, *GoodsQuery) (Value, error)) (Value, error) {
+	if len(gq.inters) == 0 {
+		return fn(ctx, gq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeGoods,
+		Op:     op,
+		Limit:  gq.limit,
+		Offset: gq.offset,
+		Unique: gq.unique,
+		Fields: gq.fields,
+	}
+	for _, p := range gq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range gq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GoodsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *GoodsQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, gq, qc, qr, gq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -243,6 +316,7 @@ func (gq *GoodsQuery) Clone() *GoodsQuery {
 		limit:      gq.limit,
 		offset:     gq.offset,
 		order:      append([]OrderFunc{}, gq.order...),
+		inters:     append([]Interceptor{}, gq.inters...),
 		predicates: append([]predicate.Goods{}, gq.predicates...),
 		// clone intermediate query.
 		gremlin: gq.gremlin.Clone(),
@@ -278,6 +352,16 @@ func (gq *GoodsQuery) Select(fields ...string) *GoodsSelect {
 }
 
 func (gq *GoodsQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gq); err != nil {
+				return err
+			}
+		}
+	}
 	if gq.path != nil {
 		prev, err := gq.path(ctx)
 		if err != nil {
@@ -301,7 +385,7 @@ func (gq *GoodsQuery) gremlinAll(ctx context.Context) ([]*Goods, error) {
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := gq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := gq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var _gos GoodsSlice
@@ -315,7 +399,7 @@ func (gq *GoodsQuery) gremlinAll(ctx context.Context) ([]*Goods, error) {
 func (gq *GoodsQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := gq.gremlinQuery(ctx).Count().Query()
-	if err := gq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := gq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -324,7 +408,7 @@ func (gq *GoodsQuery) gremlinCount(ctx context.Context) (int, error) {
 func (gq *GoodsQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := gq.gremlinQuery(ctx).HasNext().Query()
-	if err := gq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := gq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -358,6 +442,11 @@ func (gq *GoodsQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (gq *GoodsQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: goods.Label}
+}
+
 // GoodsGroupBy is the group-by builder for Goods entities.
 type GoodsGroupBy struct {
 	config
@@ -388,7 +477,7 @@ func (ggb *GoodsGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (ggb *GoodsGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := ggb.gremlinQuery().Query()
-	if err := ggb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ggb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(ggb.fields)+len(ggb.fns) == 1 {
@@ -458,7 +547,7 @@ func (gs *GoodsSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		traversal = gs.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := gs.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := gs.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(gs.fields) == 1 {
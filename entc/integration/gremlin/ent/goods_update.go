@@ -118,9 +118,18 @@ func (gu *GoodsUpdate) gremlin() *dsl.Traversal {
 // GoodsUpdateOne is the builder for updating a single Goods entity.
 type GoodsUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GoodsMutation
+	fields     []string
+	conditions []predicate.Goods
+	hooks      []Hook
+	mutation   *GoodsMutation
+}
+
+// Where appends a list of conditions to the GoodsUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (guo *GoodsUpdateOne) Where(ps ...predicate.Goods) *GoodsUpdateOne {
+	guo.conditions = append(guo.conditions, ps...)
+	return guo
 }
 
 // Mutation returns the GoodsMutation object of the builder.
@@ -197,6 +206,9 @@ func (guo *GoodsUpdateOne) ExecX(ctx context.Context) {
 
 func (guo *GoodsUpdateOne) gremlinSave(ctx context.Context) (*Goods, error) {
 	res := &gremlin.Response{}
+	if len(guo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := guo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Goods.id" for update`)}
@@ -96,6 +96,25 @@ func (gu *GroupUpdate) AddMaxUsers(i int) *GroupUpdate {
 	return gu
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (gu *GroupUpdate) SetMaxUsersIfGreater(i int) *GroupUpdate {
+	gu.mutation.SetMaxUsersIfGreater(i)
+	return gu
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field in the database.
+func (gu *GroupUpdate) BitAndMaxUsers(i int) *GroupUpdate {
+	gu.mutation.BitAndMaxUsers(i)
+	return gu
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field in the database.
+func (gu *GroupUpdate) BitOrMaxUsers(i int) *GroupUpdate {
+	gu.mutation.BitOrMaxUsers(i)
+	return gu
+}
+
 // ClearMaxUsers clears the value of the "max_users" field.
 func (gu *GroupUpdate) ClearMaxUsers() *GroupUpdate {
 	gu.mutation.ClearMaxUsers()
@@ -323,6 +342,12 @@ func (gu *GroupUpdate) check() error {
 
 func (gu *GroupUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
+	if _, ok := gu.mutation.MaxUsersBitAnd(); ok {
+		return 0, errors.New("ent: BitAndMaxUsers is not supported by the gremlin dialect")
+	}
+	if _, ok := gu.mutation.MaxUsersBitOr(); ok {
+		return 0, errors.New("ent: BitOrMaxUsers is not supported by the gremlin dialect")
+	}
 	query, bindings := gu.gremlin().Query()
 	if err := gu.driver.Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
@@ -364,6 +389,9 @@ func (gu *GroupUpdate) gremlin() *dsl.Traversal {
 	if value, ok := gu.mutation.AddedMaxUsers(); ok {
 		v.Property(dsl.Single, group.FieldMaxUsers, __.Union(__.Values(group.FieldMaxUsers), __.Constant(value)).Sum())
 	}
+	if value, ok := gu.mutation.MaxUsersIfGreater(); ok {
+		v.Property(dsl.Single, group.FieldMaxUsers, __.Union(__.Values(group.FieldMaxUsers), __.Constant(value)).Max())
+	}
 	if value, ok := gu.mutation.Name(); ok {
 		v.Property(dsl.Single, group.FieldName, value)
 	}
@@ -431,9 +459,18 @@ func (gu *GroupUpdate) gremlin() *dsl.Traversal {
 // GroupUpdateOne is the builder for updating a single Group entity.
 type GroupUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GroupMutation
+	fields     []string
+	conditions []predicate.Group
+	hooks      []Hook
+	mutation   *GroupMutation
+}
+
+// Where appends a list of conditions to the GroupUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
+	guo.conditions = append(guo.conditions, ps...)
+	return guo
 }
 
 // SetActive sets the "active" field.
@@ -497,6 +534,25 @@ func (guo *GroupUpdateOne) AddMaxUsers(i int) *GroupUpdateOne {
 	return guo
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (guo *GroupUpdateOne) SetMaxUsersIfGreater(i int) *GroupUpdateOne {
+	guo.mutation.SetMaxUsersIfGreater(i)
+	return guo
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field in the database.
+func (guo *GroupUpdateOne) BitAndMaxUsers(i int) *GroupUpdateOne {
+	guo.mutation.BitAndMaxUsers(i)
+	return guo
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field in the database.
+func (guo *GroupUpdateOne) BitOrMaxUsers(i int) *GroupUpdateOne {
+	guo.mutation.BitOrMaxUsers(i)
+	return guo
+}
+
 // ClearMaxUsers clears the value of the "max_users" field.
 func (guo *GroupUpdateOne) ClearMaxUsers() *GroupUpdateOne {
 	guo.mutation.ClearMaxUsers()
@@ -737,6 +793,15 @@ func (guo *GroupUpdateOne) check() error {
 
 func (guo *GroupUpdateOne) gremlinSave(ctx context.Context) (*Group, error) {
 	res := &gremlin.Response{}
+	if _, ok := guo.mutation.MaxUsersBitAnd(); ok {
+		return nil, errors.New("ent: BitAndMaxUsers is not supported by the gremlin dialect")
+	}
+	if _, ok := guo.mutation.MaxUsersBitOr(); ok {
+		return nil, errors.New("ent: BitOrMaxUsers is not supported by the gremlin dialect")
+	}
+	if len(guo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := guo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Group.id" for update`)}
@@ -783,6 +848,9 @@ func (guo *GroupUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := guo.mutation.AddedMaxUsers(); ok {
 		v.Property(dsl.Single, group.FieldMaxUsers, __.Union(__.Values(group.FieldMaxUsers), __.Constant(value)).Sum())
 	}
+	if value, ok := guo.mutation.MaxUsersIfGreater(); ok {
+		v.Property(dsl.Single, group.FieldMaxUsers, __.Union(__.Values(group.FieldMaxUsers), __.Constant(value)).Max())
+	}
 	if value, ok := guo.mutation.Name(); ok {
 		v.Property(dsl.Single, group.FieldName, value)
 	}
@@ -101,7 +101,7 @@ func (gido *GroupInfoDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{groupinfo.Label}
+		return &NotFoundError{label: groupinfo.Label}
 	default:
 		return nil
 	}
@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -27,6 +29,7 @@ type GroupInfoQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.GroupInfo
 	withGroups *GroupQuery
 	// intermediate query (i.e. traversal path).
@@ -67,7 +70,7 @@ func (giq *GroupInfoQuery) Order(o ...OrderFunc) *GroupInfoQuery {
 
 // QueryGroups chains the current query on the "groups" edge.
 func (giq *GroupInfoQuery) QueryGroups() *GroupQuery {
-	query := &GroupQuery{config: giq.config}
+	query := (&GroupClient{config: giq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := giq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -87,7 +90,7 @@ func (giq *GroupInfoQuery) First(ctx context.Context) (*GroupInfo, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{groupinfo.Label}
+		return nil, giq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -109,7 +112,7 @@ func (giq *GroupInfoQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{groupinfo.Label}
+		err = giq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -136,7 +139,7 @@ func (giq *GroupInfoQuery) Only(ctx context.Context) (*GroupInfo, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{groupinfo.Label}
+		return nil, giq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{groupinfo.Label}
 	}
@@ -163,7 +166,7 @@ func (giq *GroupInfoQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{groupinfo.Label}
+		err = giq.gremlinNotFound()
 	default:
 		err = &NotSingularError{groupinfo.Label}
 	}
@@ -184,7 +187,17 @@ func (giq *GroupInfoQuery) All(ctx context.Context) ([]*GroupInfo, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return giq.gremlinAll(ctx)
+	v, err := giq.intercept(ctx, "All", func(ctx context.Context, query *GroupInfoQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*GroupInfo)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*GroupInfo", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -198,10 +211,20 @@ func (giq *GroupInfoQuery) AllX(ctx context.Context) []*GroupInfo {
 
 // IDs executes the query and returns a list of GroupInfo IDs.
 func (giq *GroupInfoQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := giq.Select(groupinfo.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := giq.intercept(ctx, "IDs", func(ctx context.Context, query *GroupInfoQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(groupinfo.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -219,7 +242,17 @@ func (giq *GroupInfoQuery) Count(ctx context.Context) (int, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return giq.gremlinCount(ctx)
+	v, err := giq.intercept(ctx, "Count", func(ctx context.Context, query *GroupInfoQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -236,7 +269,54 @@ func (giq *GroupInfoQuery) Exist(ctx context.Context) (bool, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return giq.gremlinExist(ctx)
+	v, err := giq.intercept(ctx, "Exist", func(ctx context.Context, query *GroupInfoQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (giq *GroupInfoQuery) intercept(ctx context.Context, op string, fn func(context.Context, *GroupInfoQuery) (Value, error)) (Value, error) {
+	if len(giq.inters) == 0 {
+		return fn(ctx, giq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeGroupInfo,
+		Op:     op,
+		Limit:  giq.limit,
+		Offset: giq.offset,
+		Unique: giq.unique,
+		Fields: giq.fields,
+	}
+	for _, p := range giq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range giq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if giq.withGroups != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, groupinfo.EdgeGroups)
+		qc.EdgeQueries[groupinfo.EdgeGroups] = giq.withGroups
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupInfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *GroupInfoQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, giq, qc, qr, giq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -259,6 +339,7 @@ func (giq *GroupInfoQuery) Clone() *GroupInfoQuery {
 		limit:      giq.limit,
 		offset:     giq.offset,
 		order:      append([]OrderFunc{}, giq.order...),
+		inters:     append([]Interceptor{}, giq.inters...),
 		predicates: append([]predicate.GroupInfo{}, giq.predicates...),
 		withGroups: giq.withGroups.Clone(),
 		// clone intermediate query.
@@ -271,7 +352,7 @@ func (giq *GroupInfoQuery) Clone() *GroupInfoQuery {
 // WithGroups tells the query-builder to eager-load the nodes that are connected to
 // the "groups" edge. The optional arguments are used to configure the query builder of the edge.
 func (giq *GroupInfoQuery) WithGroups(opts ...func(*GroupQuery)) *GroupInfoQuery {
-	query := &GroupQuery{config: giq.config}
+	query := (&GroupClient{config: giq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -330,6 +411,16 @@ func (giq *GroupInfoQuery) Select(fields ...string) *GroupInfoSelect {
 }
 
 func (giq *GroupInfoQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range giq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, giq); err != nil {
+				return err
+			}
+		}
+	}
 	if giq.path != nil {
 		prev, err := giq.path(ctx)
 		if err != nil {
@@ -353,7 +444,7 @@ func (giq *GroupInfoQuery) gremlinAll(ctx context.Context) ([]*GroupInfo, error)
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := giq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := giq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var gis GroupInfos
@@ -367,7 +458,7 @@ func (giq *GroupInfoQuery) gremlinAll(ctx context.Context) ([]*GroupInfo, error)
 func (giq *GroupInfoQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := giq.gremlinQuery(ctx).Count().Query()
-	if err := giq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := giq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -376,7 +467,7 @@ func (giq *GroupInfoQuery) gremlinCount(ctx context.Context) (int, error) {
 func (giq *GroupInfoQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := giq.gremlinQuery(ctx).HasNext().Query()
-	if err := giq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := giq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -410,6 +501,11 @@ func (giq *GroupInfoQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (giq *GroupInfoQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: groupinfo.Label}
+}
+
 // GroupInfoGroupBy is the group-by builder for GroupInfo entities.
 type GroupInfoGroupBy struct {
 	config
@@ -440,7 +536,7 @@ func (gigb *GroupInfoGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (gigb *GroupInfoGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := gigb.gremlinQuery().Query()
-	if err := gigb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := gigb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(gigb.fields)+len(gigb.fns) == 1 {
@@ -510,7 +606,7 @@ func (gis *GroupInfoSelect) gremlinScan(ctx context.Context, v interface{}) erro
 		traversal = gis.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := gis.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := gis.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(gis.fields) == 1 {
@@ -61,6 +61,25 @@ func (giu *GroupInfoUpdate) AddMaxUsers(i int) *GroupInfoUpdate {
 	return giu
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (giu *GroupInfoUpdate) SetMaxUsersIfGreater(i int) *GroupInfoUpdate {
+	giu.mutation.SetMaxUsersIfGreater(i)
+	return giu
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field in the database.
+func (giu *GroupInfoUpdate) BitAndMaxUsers(i int) *GroupInfoUpdate {
+	giu.mutation.BitAndMaxUsers(i)
+	return giu
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field in the database.
+func (giu *GroupInfoUpdate) BitOrMaxUsers(i int) *GroupInfoUpdate {
+	giu.mutation.BitOrMaxUsers(i)
+	return giu
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (giu *GroupInfoUpdate) AddGroupIDs(ids ...string) *GroupInfoUpdate {
 	giu.mutation.AddGroupIDs(ids...)
@@ -158,6 +177,12 @@ func (giu *GroupInfoUpdate) ExecX(ctx context.Context) {
 
 func (giu *GroupInfoUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
+	if _, ok := giu.mutation.MaxUsersBitAnd(); ok {
+		return 0, errors.New("ent: BitAndMaxUsers is not supported by the gremlin dialect")
+	}
+	if _, ok := giu.mutation.MaxUsersBitOr(); ok {
+		return 0, errors.New("ent: BitOrMaxUsers is not supported by the gremlin dialect")
+	}
 	query, bindings := giu.gremlin().Query()
 	if err := giu.driver.Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
@@ -193,6 +218,9 @@ func (giu *GroupInfoUpdate) gremlin() *dsl.Traversal {
 	if value, ok := giu.mutation.AddedMaxUsers(); ok {
 		v.Property(dsl.Single, groupinfo.FieldMaxUsers, __.Union(__.Values(groupinfo.FieldMaxUsers), __.Constant(value)).Sum())
 	}
+	if value, ok := giu.mutation.MaxUsersIfGreater(); ok {
+		v.Property(dsl.Single, groupinfo.FieldMaxUsers, __.Union(__.Values(groupinfo.FieldMaxUsers), __.Constant(value)).Max())
+	}
 	for _, id := range giu.mutation.RemovedGroupsIDs() {
 		tr := rv.Clone().InE(group.InfoLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -222,9 +250,18 @@ func (giu *GroupInfoUpdate) gremlin() *dsl.Traversal {
 // GroupInfoUpdateOne is the builder for updating a single GroupInfo entity.
 type GroupInfoUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GroupInfoMutation
+	fields     []string
+	conditions []predicate.GroupInfo
+	hooks      []Hook
+	mutation   *GroupInfoMutation
+}
+
+// Where appends a list of conditions to the GroupInfoUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (giuo *GroupInfoUpdateOne) Where(ps ...predicate.GroupInfo) *GroupInfoUpdateOne {
+	giuo.conditions = append(giuo.conditions, ps...)
+	return giuo
 }
 
 // SetDesc sets the "desc" field.
@@ -254,6 +291,25 @@ func (giuo *GroupInfoUpdateOne) AddMaxUsers(i int) *GroupInfoUpdateOne {
 	return giuo
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (giuo *GroupInfoUpdateOne) SetMaxUsersIfGreater(i int) *GroupInfoUpdateOne {
+	giuo.mutation.SetMaxUsersIfGreater(i)
+	return giuo
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field in the database.
+func (giuo *GroupInfoUpdateOne) BitAndMaxUsers(i int) *GroupInfoUpdateOne {
+	giuo.mutation.BitAndMaxUsers(i)
+	return giuo
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field in the database.
+func (giuo *GroupInfoUpdateOne) BitOrMaxUsers(i int) *GroupInfoUpdateOne {
+	giuo.mutation.BitOrMaxUsers(i)
+	return giuo
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (giuo *GroupInfoUpdateOne) AddGroupIDs(ids ...string) *GroupInfoUpdateOne {
 	giuo.mutation.AddGroupIDs(ids...)
@@ -364,6 +420,15 @@ func (giuo *GroupInfoUpdateOne) ExecX(ctx context.Context) {
 
 func (giuo *GroupInfoUpdateOne) gremlinSave(ctx context.Context) (*GroupInfo, error) {
 	res := &gremlin.Response{}
+	if _, ok := giuo.mutation.MaxUsersBitAnd(); ok {
+		return nil, errors.New("ent: BitAndMaxUsers is not supported by the gremlin dialect")
+	}
+	if _, ok := giuo.mutation.MaxUsersBitOr(); ok {
+		return nil, errors.New("ent: BitOrMaxUsers is not supported by the gremlin dialect")
+	}
+	if len(giuo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := giuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupInfo.id" for update`)}
@@ -404,6 +469,9 @@ func (giuo *GroupInfoUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := giuo.mutation.AddedMaxUsers(); ok {
 		v.Property(dsl.Single, groupinfo.FieldMaxUsers, __.Union(__.Values(groupinfo.FieldMaxUsers), __.Constant(value)).Sum())
 	}
+	if value, ok := giuo.mutation.MaxUsersIfGreater(); ok {
+		v.Property(dsl.Single, groupinfo.FieldMaxUsers, __.Union(__.Values(groupinfo.FieldMaxUsers), __.Constant(value)).Max())
+	}
 	for _, id := range giuo.mutation.RemovedGroupsIDs() {
 		tr := rv.Clone().InE(group.InfoLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -20,6 +20,8 @@ type Item struct {
 	ID string `json:"id,omitempty"`
 	// Text holds the value of the "text" field.
 	Text string `json:"text,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
 }
 
 // FromResponse scans the gremlin response data into Item.
@@ -29,14 +31,16 @@ func (i *Item) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var scani struct {
-		ID   string `json:"id,omitempty"`
-		Text string `json:"text,omitempty"`
+		ID    string `json:"id,omitempty"`
+		Text  string `json:"text,omitempty"`
+		Token string `json:"token,omitempty"`
 	}
 	if err := vmap.Decode(&scani); err != nil {
 		return err
 	}
 	i.ID = scani.ID
 	i.Text = scani.Text
+	i.Token = scani.Token
 	return nil
 }
 
@@ -65,6 +69,9 @@ func (i *Item) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
 	builder.WriteString("text=")
 	builder.WriteString(i.Text)
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(i.Token)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -79,16 +86,18 @@ func (i *Items) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var scani []struct {
-		ID   string `json:"id,omitempty"`
-		Text string `json:"text,omitempty"`
+		ID    string `json:"id,omitempty"`
+		Text  string `json:"text,omitempty"`
+		Token string `json:"token,omitempty"`
 	}
 	if err := vmap.Decode(&scani); err != nil {
 		return err
 	}
 	for _, v := range scani {
 		*i = append(*i, &Item{
-			ID:   v.ID,
-			Text: v.Text,
+			ID:    v.ID,
+			Text:  v.Text,
+			Token: v.Token,
 		})
 	}
 	return nil
@@ -91,6 +91,13 @@ func Text(v string) predicate.Item {
 	})
 }
 
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.Item {
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.EQ(v))
+	})
+}
+
 // TextEQ applies the EQ predicate on the "text" field.
 func TextEQ(v string) predicate.Item {
 	return predicate.Item(func(t *dsl.Traversal) {
@@ -190,6 +197,91 @@ func TextNotNil() predicate.Item {
 	})
 }
 
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.Item {
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.EQ(v))
+	})
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.Item {
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.NEQ(v))
+	})
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.Item {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.Within(v...))
+	})
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.Item {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.Without(v...))
+	})
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.Item {
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.GT(v))
+	})
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.Item {
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.GTE(v))
+	})
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.Item {
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.LT(v))
+	})
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.Item {
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.LTE(v))
+	})
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.Item {
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.Containing(v))
+	})
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.Item {
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.StartingWith(v))
+	})
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.Item {
+	return predicate.Item(func(t *dsl.Traversal) {
+		t.Has(Label, FieldToken, p.EndingWith(v))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Item) predicate.Item {
 	return predicate.Item(func(tr *dsl.Traversal) {
@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"entgo.io/ent/dialect/gremlin"
@@ -39,6 +40,20 @@ func (ic *ItemCreate) SetNillableText(s *string) *ItemCreate {
 	return ic
 }
 
+// SetToken sets the "token" field.
+func (ic *ItemCreate) SetToken(s string) *ItemCreate {
+	ic.mutation.SetToken(s)
+	return ic
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableToken(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetToken(*s)
+	}
+	return ic
+}
+
 // SetID sets the "id" field.
 func (ic *ItemCreate) SetID(s string) *ItemCreate {
 	ic.mutation.SetID(s)
@@ -64,6 +79,9 @@ func (ic *ItemCreate) Save(ctx context.Context) (*Item, error) {
 		err  error
 		node *Item
 	)
+	if err := checkReadOnlyDefaults(ctx, ic.mutation, item.FieldToken); err != nil {
+		return nil, err
+	}
 	ic.defaults()
 	if len(ic.hooks) == 0 {
 		if err = ic.check(); err != nil {
@@ -130,6 +148,10 @@ func (ic *ItemCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ic *ItemCreate) defaults() {
+	if _, ok := ic.mutation.Token(); !ok {
+		v := item.DefaultToken()
+		ic.mutation.SetToken(v)
+	}
 	if _, ok := ic.mutation.ID(); !ok {
 		v := item.DefaultID()
 		ic.mutation.SetID(v)
@@ -143,6 +165,9 @@ func (ic *ItemCreate) check() error {
 			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
 		}
 	}
+	if _, ok := ic.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Item.token"`)}
+	}
 	if v, ok := ic.mutation.ID(); ok {
 		if err := item.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)}
@@ -184,6 +209,9 @@ func (ic *ItemCreate) gremlin() *dsl.Traversal {
 		})
 		v.Property(dsl.Single, item.FieldText, value)
 	}
+	if value, ok := ic.mutation.Token(); ok {
+		v.Property(dsl.Single, item.FieldToken, value)
+	}
 	if len(constraints) == 0 {
 		return v.ValueMap(true)
 	}
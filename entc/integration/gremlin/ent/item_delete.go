@@ -101,7 +101,7 @@ func (ido *ItemDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{item.Label}
+		return &NotFoundError{label: item.Label}
 	default:
 		return nil
 	}
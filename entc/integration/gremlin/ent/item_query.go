@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -26,6 +28,7 @@ type ItemQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Item
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
@@ -71,7 +74,7 @@ func (iq *ItemQuery) First(ctx context.Context) (*Item, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{item.Label}
+		return nil, iq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -93,7 +96,7 @@ func (iq *ItemQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{item.Label}
+		err = iq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -120,7 +123,7 @@ func (iq *ItemQuery) Only(ctx context.Context) (*Item, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{item.Label}
+		return nil, iq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{item.Label}
 	}
@@ -147,7 +150,7 @@ func (iq *ItemQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{item.Label}
+		err = iq.gremlinNotFound()
 	default:
 		err = &NotSingularError{item.Label}
 	}
@@ -168,7 +171,17 @@ func (iq *ItemQuery) All(ctx context.Context) ([]*Item, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return iq.gremlinAll(ctx)
+	v, err := iq.intercept(ctx, "All", func(ctx context.Context, query *ItemQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Item)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Item", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -182,10 +195,20 @@ func (iq *ItemQuery) AllX(ctx context.Context) []*Item {
 
 // IDs executes the query and returns a list of Item IDs.
 func (iq *ItemQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := iq.Select(item.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := iq.intercept(ctx, "IDs", func(ctx context.Context, query *ItemQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(item.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -203,7 +226,17 @@ func (iq *ItemQuery) Count(ctx context.Context) (int, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return iq.gremlinCount(ctx)
+	v, err := iq.intercept(ctx, "Count", func(ctx context.Context, query *ItemQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -220,7 +253,47 @@ func (iq *ItemQuery) Exist(ctx context.Context) (bool, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return iq.gremlinExist(ctx)
+	v, err := iq.intercept(ctx, "Exist", func(ctx context.Context, query *ItemQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (iq *ItemQuery) intercept(ctx context.Context, op string, fn func(context.Context, *ItemQuery) (Value, error)) (Value, error) {
+	if len(iq.inters) == 0 {
+		return fn(ctx, iq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeItem,
+		Op:     op,
+		Limit:  iq.limit,
+		Offset: iq.offset,
+		Unique: iq.unique,
+		Fields: iq.fields,
+	}
+	for _, p := range iq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range iq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*ItemQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *ItemQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, iq, qc, qr, iq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -243,6 +316,7 @@ func (iq *ItemQuery) Clone() *ItemQuery {
 		limit:      iq.limit,
 		offset:     iq.offset,
 		order:      append([]OrderFunc{}, iq.order...),
+		inters:     append([]Interceptor{}, iq.inters...),
 		predicates: append([]predicate.Item{}, iq.predicates...),
 		// clone intermediate query.
 		gremlin: iq.gremlin.Clone(),
@@ -302,6 +376,16 @@ func (iq *ItemQuery) Select(fields ...string) *ItemSelect {
 }
 
 func (iq *ItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iq); err != nil {
+				return err
+			}
+		}
+	}
 	if iq.path != nil {
 		prev, err := iq.path(ctx)
 		if err != nil {
@@ -325,7 +409,7 @@ func (iq *ItemQuery) gremlinAll(ctx context.Context) ([]*Item, error) {
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := iq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := iq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var is Items
@@ -339,7 +423,7 @@ func (iq *ItemQuery) gremlinAll(ctx context.Context) ([]*Item, error) {
 func (iq *ItemQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := iq.gremlinQuery(ctx).Count().Query()
-	if err := iq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := iq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -348,7 +432,7 @@ func (iq *ItemQuery) gremlinCount(ctx context.Context) (int, error) {
 func (iq *ItemQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := iq.gremlinQuery(ctx).HasNext().Query()
-	if err := iq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := iq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -382,6 +466,11 @@ func (iq *ItemQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (iq *ItemQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: item.Label}
+}
+
 // ItemGroupBy is the group-by builder for Item entities.
 type ItemGroupBy struct {
 	config
@@ -412,7 +501,7 @@ func (igb *ItemGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (igb *ItemGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := igb.gremlinQuery().Query()
-	if err := igb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := igb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(igb.fields)+len(igb.fns) == 1 {
@@ -482,7 +571,7 @@ func (is *ItemSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		traversal = is.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := is.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := is.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(is.fields) == 1 {
@@ -53,6 +53,20 @@ func (iu *ItemUpdate) ClearText() *ItemUpdate {
 	return iu
 }
 
+// SetToken sets the "token" field.
+func (iu *ItemUpdate) SetToken(s string) *ItemUpdate {
+	iu.mutation.SetToken(s)
+	return iu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableToken(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetToken(*s)
+	}
+	return iu
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iu *ItemUpdate) Mutation() *ItemMutation {
 	return iu.mutation
@@ -163,6 +177,9 @@ func (iu *ItemUpdate) gremlin() *dsl.Traversal {
 		})
 		v.Property(dsl.Single, item.FieldText, value)
 	}
+	if value, ok := iu.mutation.Token(); ok {
+		v.Property(dsl.Single, item.FieldToken, value)
+	}
 	var properties []interface{}
 	if iu.mutation.TextCleared() {
 		properties = append(properties, item.FieldText)
@@ -188,9 +205,18 @@ func (iu *ItemUpdate) gremlin() *dsl.Traversal {
 // ItemUpdateOne is the builder for updating a single Item entity.
 type ItemUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ItemMutation
+	fields     []string
+	conditions []predicate.Item
+	hooks      []Hook
+	mutation   *ItemMutation
+}
+
+// Where appends a list of conditions to the ItemUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (iuo *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
+	iuo.conditions = append(iuo.conditions, ps...)
+	return iuo
 }
 
 // SetText sets the "text" field.
@@ -213,6 +239,20 @@ func (iuo *ItemUpdateOne) ClearText() *ItemUpdateOne {
 	return iuo
 }
 
+// SetToken sets the "token" field.
+func (iuo *ItemUpdateOne) SetToken(s string) *ItemUpdateOne {
+	iuo.mutation.SetToken(s)
+	return iuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableToken(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetToken(*s)
+	}
+	return iuo
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iuo *ItemUpdateOne) Mutation() *ItemMutation {
 	return iuo.mutation
@@ -303,6 +343,9 @@ func (iuo *ItemUpdateOne) check() error {
 
 func (iuo *ItemUpdateOne) gremlinSave(ctx context.Context) (*Item, error) {
 	res := &gremlin.Response{}
+	if len(iuo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := iuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Item.id" for update`)}
@@ -341,6 +384,9 @@ func (iuo *ItemUpdateOne) gremlin(id string) *dsl.Traversal {
 		})
 		v.Property(dsl.Single, item.FieldText, value)
 	}
+	if value, ok := iuo.mutation.Token(); ok {
+		v.Property(dsl.Single, item.FieldToken, value)
+	}
 	var properties []interface{}
 	if iuo.mutation.TextCleared() {
 		properties = append(properties, item.FieldText)
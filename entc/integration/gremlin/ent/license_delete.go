@@ -101,7 +101,7 @@ func (ldo *LicenseDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{license.Label}
+		return &NotFoundError{label: license.Label}
 	default:
 		return nil
 	}
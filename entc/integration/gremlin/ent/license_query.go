@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -26,6 +28,7 @@ type LicenseQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.License
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
@@ -71,7 +74,7 @@ func (lq *LicenseQuery) First(ctx context.Context) (*License, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{license.Label}
+		return nil, lq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -93,7 +96,7 @@ func (lq *LicenseQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{license.Label}
+		err = lq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -120,7 +123,7 @@ func (lq *LicenseQuery) Only(ctx context.Context) (*License, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{license.Label}
+		return nil, lq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{license.Label}
 	}
@@ -147,7 +150,7 @@ func (lq *LicenseQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{license.Label}
+		err = lq.gremlinNotFound()
 	default:
 		err = &NotSingularError{license.Label}
 	}
@@ -168,7 +171,17 @@ func (lq *LicenseQuery) All(ctx context.Context) ([]*License, error) {
 	if err := lq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return lq.gremlinAll(ctx)
+	v, err := lq.intercept(ctx, "All", func(ctx context.Context, query *LicenseQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*License)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*License", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -182,10 +195,20 @@ func (lq *LicenseQuery) AllX(ctx context.Context) []*License {
 
 // IDs executes the query and returns a list of License IDs.
 func (lq *LicenseQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := lq.Select(license.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := lq.intercept(ctx, "IDs", func(ctx context.Context, query *LicenseQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(license.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -203,7 +226,17 @@ func (lq *LicenseQuery) Count(ctx context.Context) (int, error) {
 	if err := lq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return lq.gremlinCount(ctx)
+	v, err := lq.intercept(ctx, "Count", func(ctx context.Context, query *LicenseQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -220,7 +253,47 @@ func (lq *LicenseQuery) Exist(ctx context.Context) (bool, error) {
 	if err := lq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return lq.gremlinExist(ctx)
+	v, err := lq.intercept(ctx, "Exist", func(ctx context.Context, query *LicenseQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (lq *LicenseQuery) intercept(ctx context.Context, op string, fn func(context.Context, *LicenseQuery) (Value, error)) (Value, error) {
+	if len(lq.inters) == 0 {
+		return fn(ctx, lq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeLicense,
+		Op:     op,
+		Limit:  lq.limit,
+		Offset: lq.offset,
+		Unique: lq.unique,
+		Fields: lq.fields,
+	}
+	for _, p := range lq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range lq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*LicenseQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *LicenseQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, lq, qc, qr, lq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -243,6 +316,7 @@ func (lq *LicenseQuery) Clone() *LicenseQuery {
 		limit:      lq.limit,
 		offset:     lq.offset,
 		order:      append([]OrderFunc{}, lq.order...),
+		inters:     append([]Interceptor{}, lq.inters...),
 		predicates: append([]predicate.License{}, lq.predicates...),
 		// clone intermediate query.
 		gremlin: lq.gremlin.Clone(),
@@ -278,6 +352,16 @@ func (lq *LicenseQuery) Select(fields ...string) *LicenseSelect {
 }
 
 func (lq *LicenseQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lq); err != nil {
+				return err
+			}
+		}
+	}
 	if lq.path != nil {
 		prev, err := lq.path(ctx)
 		if err != nil {
@@ -301,7 +385,7 @@ func (lq *LicenseQuery) gremlinAll(ctx context.Context) ([]*License, error) {
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := lq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := lq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var ls Licenses
@@ -315,7 +399,7 @@ func (lq *LicenseQuery) gremlinAll(ctx context.Context) ([]*License, error) {
 func (lq *LicenseQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := lq.gremlinQuery(ctx).Count().Query()
-	if err := lq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := lq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -324,7 +408,7 @@ func (lq *LicenseQuery) gremlinCount(ctx context.Context) (int, error) {
 func (lq *LicenseQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := lq.gremlinQuery(ctx).HasNext().Query()
-	if err := lq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := lq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -358,6 +442,11 @@ func (lq *LicenseQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (lq *LicenseQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: license.Label}
+}
+
 // LicenseGroupBy is the group-by builder for License entities.
 type LicenseGroupBy struct {
 	config
@@ -388,7 +477,7 @@ func (lgb *LicenseGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (lgb *LicenseGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := lgb.gremlinQuery().Query()
-	if err := lgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := lgb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(lgb.fields)+len(lgb.fns) == 1 {
@@ -458,7 +547,7 @@ func (ls *LicenseSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		traversal = ls.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := ls.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ls.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(ls.fields) == 1 {
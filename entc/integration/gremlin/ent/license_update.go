@@ -118,9 +118,18 @@ func (lu *LicenseUpdate) gremlin() *dsl.Traversal {
 // LicenseUpdateOne is the builder for updating a single License entity.
 type LicenseUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LicenseMutation
+	fields     []string
+	conditions []predicate.License
+	hooks      []Hook
+	mutation   *LicenseMutation
+}
+
+// Where appends a list of conditions to the LicenseUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (luo *LicenseUpdateOne) Where(ps ...predicate.License) *LicenseUpdateOne {
+	luo.conditions = append(luo.conditions, ps...)
+	return luo
 }
 
 // Mutation returns the LicenseMutation object of the builder.
@@ -197,6 +206,9 @@ func (luo *LicenseUpdateOne) ExecX(ctx context.Context) {
 
 func (luo *LicenseUpdateOne) gremlinSave(ctx context.Context) (*License, error) {
 	res := &gremlin.Response{}
+	if len(luo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := luo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "License.id" for update`)}
@@ -101,7 +101,7 @@ func (ndo *NodeDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{node.Label}
+		return &NotFoundError{label: node.Label}
 	default:
 		return nil
 	}
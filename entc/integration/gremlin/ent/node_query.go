@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -26,6 +28,7 @@ type NodeQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Node
 	withPrev   *NodeQuery
 	withNext   *NodeQuery
@@ -67,7 +70,7 @@ func (nq *NodeQuery) Order(o ...OrderFunc) *NodeQuery {
 
 // QueryPrev chains the current query on the "prev" edge.
 func (nq *NodeQuery) QueryPrev() *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -81,7 +84,7 @@ func (nq *NodeQuery) QueryPrev() *NodeQuery {
 
 // QueryNext chains the current query on the "next" edge.
 func (nq *NodeQuery) QueryNext() *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -101,7 +104,7 @@ func (nq *NodeQuery) First(ctx context.Context) (*Node, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{node.Label}
+		return nil, nq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -123,7 +126,7 @@ func (nq *NodeQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{node.Label}
+		err = nq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -150,7 +153,7 @@ func (nq *NodeQuery) Only(ctx context.Context) (*Node, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{node.Label}
+		return nil, nq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{node.Label}
 	}
@@ -177,7 +180,7 @@ func (nq *NodeQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{node.Label}
+		err = nq.gremlinNotFound()
 	default:
 		err = &NotSingularError{node.Label}
 	}
@@ -198,7 +201,17 @@ func (nq *NodeQuery) All(ctx context.Context) ([]*Node, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return nq.gremlinAll(ctx)
+	v, err := nq.intercept(ctx, "All", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Node)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Node", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -212,10 +225,20 @@ func (nq *NodeQuery) AllX(ctx context.Context) []*Node {
 
 // IDs executes the query and returns a list of Node IDs.
 func (nq *NodeQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := nq.Select(node.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := nq.intercept(ctx, "IDs", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(node.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -233,7 +256,17 @@ func (nq *NodeQuery) Count(ctx context.Context) (int, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return nq.gremlinCount(ctx)
+	v, err := nq.intercept(ctx, "Count", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -250,7 +283,61 @@ func (nq *NodeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return nq.gremlinExist(ctx)
+	v, err := nq.intercept(ctx, "Exist", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (nq *NodeQuery) intercept(ctx context.Context, op string, fn func(context.Context, *NodeQuery) (Value, error)) (Value, error) {
+	if len(nq.inters) == 0 {
+		return fn(ctx, nq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeNode,
+		Op:     op,
+		Limit:  nq.limit,
+		Offset: nq.offset,
+		Unique: nq.unique,
+		Fields: nq.fields,
+	}
+	for _, p := range nq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range nq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if nq.withPrev != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, node.EdgePrev)
+		qc.EdgeQueries[node.EdgePrev] = nq.withPrev
+	}
+	if nq.withNext != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, node.EdgeNext)
+		qc.EdgeQueries[node.EdgeNext] = nq.withNext
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*NodeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *NodeQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, nq, qc, qr, nq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -273,6 +360,7 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 		limit:      nq.limit,
 		offset:     nq.offset,
 		order:      append([]OrderFunc{}, nq.order...),
+		inters:     append([]Interceptor{}, nq.inters...),
 		predicates: append([]predicate.Node{}, nq.predicates...),
 		withPrev:   nq.withPrev.Clone(),
 		withNext:   nq.withNext.Clone(),
@@ -286,7 +374,7 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 // WithPrev tells the query-builder to eager-load the nodes that are connected to
 // the "prev" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NodeQuery) WithPrev(opts ...func(*NodeQuery)) *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -297,7 +385,7 @@ func (nq *NodeQuery) WithPrev(opts ...func(*NodeQuery)) *NodeQuery {
 // WithNext tells the query-builder to eager-load the nodes that are connected to
 // the "next" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NodeQuery) WithNext(opts ...func(*NodeQuery)) *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -356,6 +444,16 @@ func (nq *NodeQuery) Select(fields ...string) *NodeSelect {
 }
 
 func (nq *NodeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range nq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, nq); err != nil {
+				return err
+			}
+		}
+	}
 	if nq.path != nil {
 		prev, err := nq.path(ctx)
 		if err != nil {
@@ -379,7 +477,7 @@ func (nq *NodeQuery) gremlinAll(ctx context.Context) ([]*Node, error) {
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := nq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := nq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var ns Nodes
@@ -393,7 +491,7 @@ func (nq *NodeQuery) gremlinAll(ctx context.Context) ([]*Node, error) {
 func (nq *NodeQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := nq.gremlinQuery(ctx).Count().Query()
-	if err := nq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := nq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -402,7 +500,7 @@ func (nq *NodeQuery) gremlinCount(ctx context.Context) (int, error) {
 func (nq *NodeQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := nq.gremlinQuery(ctx).HasNext().Query()
-	if err := nq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := nq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -436,6 +534,11 @@ func (nq *NodeQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (nq *NodeQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: node.Label}
+}
+
 // NodeGroupBy is the group-by builder for Node entities.
 type NodeGroupBy struct {
 	config
@@ -466,7 +569,7 @@ func (ngb *NodeGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (ngb *NodeGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := ngb.gremlinQuery().Query()
-	if err := ngb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ngb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(ngb.fields)+len(ngb.fns) == 1 {
@@ -536,7 +639,7 @@ func (ns *NodeSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		traversal = ns.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := ns.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ns.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(ns.fields) == 1 {
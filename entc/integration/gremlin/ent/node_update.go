@@ -54,6 +54,25 @@ func (nu *NodeUpdate) AddValue(i int) *NodeUpdate {
 	return nu
 }
 
+// SetValueIfGreater sets the "value" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (nu *NodeUpdate) SetValueIfGreater(i int) *NodeUpdate {
+	nu.mutation.SetValueIfGreater(i)
+	return nu
+}
+
+// BitAndValue applies a bitwise AND with i on the "value" field in the database.
+func (nu *NodeUpdate) BitAndValue(i int) *NodeUpdate {
+	nu.mutation.BitAndValue(i)
+	return nu
+}
+
+// BitOrValue applies a bitwise OR with i on the "value" field in the database.
+func (nu *NodeUpdate) BitOrValue(i int) *NodeUpdate {
+	nu.mutation.BitOrValue(i)
+	return nu
+}
+
 // ClearValue clears the value of the "value" field.
 func (nu *NodeUpdate) ClearValue() *NodeUpdate {
 	nu.mutation.ClearValue()
@@ -171,6 +190,12 @@ func (nu *NodeUpdate) ExecX(ctx context.Context) {
 
 func (nu *NodeUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
+	if _, ok := nu.mutation.ValueBitAnd(); ok {
+		return 0, errors.New("ent: BitAndValue is not supported by the gremlin dialect")
+	}
+	if _, ok := nu.mutation.ValueBitOr(); ok {
+		return 0, errors.New("ent: BitOrValue is not supported by the gremlin dialect")
+	}
 	query, bindings := nu.gremlin().Query()
 	if err := nu.driver.Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
@@ -203,6 +228,9 @@ func (nu *NodeUpdate) gremlin() *dsl.Traversal {
 	if value, ok := nu.mutation.AddedValue(); ok {
 		v.Property(dsl.Single, node.FieldValue, __.Union(__.Values(node.FieldValue), __.Constant(value)).Sum())
 	}
+	if value, ok := nu.mutation.ValueIfGreater(); ok {
+		v.Property(dsl.Single, node.FieldValue, __.Union(__.Values(node.FieldValue), __.Constant(value)).Max())
+	}
 	var properties []interface{}
 	if nu.mutation.ValueCleared() {
 		properties = append(properties, node.FieldValue)
@@ -250,9 +278,18 @@ func (nu *NodeUpdate) gremlin() *dsl.Traversal {
 // NodeUpdateOne is the builder for updating a single Node entity.
 type NodeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *NodeMutation
+	fields     []string
+	conditions []predicate.Node
+	hooks      []Hook
+	mutation   *NodeMutation
+}
+
+// Where appends a list of conditions to the NodeUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (nuo *NodeUpdateOne) Where(ps ...predicate.Node) *NodeUpdateOne {
+	nuo.conditions = append(nuo.conditions, ps...)
+	return nuo
 }
 
 // SetValue sets the "value" field.
@@ -276,6 +313,25 @@ func (nuo *NodeUpdateOne) AddValue(i int) *NodeUpdateOne {
 	return nuo
 }
 
+// SetValueIfGreater sets the "value" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (nuo *NodeUpdateOne) SetValueIfGreater(i int) *NodeUpdateOne {
+	nuo.mutation.SetValueIfGreater(i)
+	return nuo
+}
+
+// BitAndValue applies a bitwise AND with i on the "value" field in the database.
+func (nuo *NodeUpdateOne) BitAndValue(i int) *NodeUpdateOne {
+	nuo.mutation.BitAndValue(i)
+	return nuo
+}
+
+// BitOrValue applies a bitwise OR with i on the "value" field in the database.
+func (nuo *NodeUpdateOne) BitOrValue(i int) *NodeUpdateOne {
+	nuo.mutation.BitOrValue(i)
+	return nuo
+}
+
 // ClearValue clears the value of the "value" field.
 func (nuo *NodeUpdateOne) ClearValue() *NodeUpdateOne {
 	nuo.mutation.ClearValue()
@@ -406,6 +462,15 @@ func (nuo *NodeUpdateOne) ExecX(ctx context.Context) {
 
 func (nuo *NodeUpdateOne) gremlinSave(ctx context.Context) (*Node, error) {
 	res := &gremlin.Response{}
+	if _, ok := nuo.mutation.ValueBitAnd(); ok {
+		return nil, errors.New("ent: BitAndValue is not supported by the gremlin dialect")
+	}
+	if _, ok := nuo.mutation.ValueBitOr(); ok {
+		return nil, errors.New("ent: BitOrValue is not supported by the gremlin dialect")
+	}
+	if len(nuo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := nuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Node.id" for update`)}
@@ -443,6 +508,9 @@ func (nuo *NodeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := nuo.mutation.AddedValue(); ok {
 		v.Property(dsl.Single, node.FieldValue, __.Union(__.Values(node.FieldValue), __.Constant(value)).Sum())
 	}
+	if value, ok := nuo.mutation.ValueIfGreater(); ok {
+		v.Property(dsl.Single, node.FieldValue, __.Union(__.Values(node.FieldValue), __.Constant(value)).Max())
+	}
 	var properties []interface{}
 	if nuo.mutation.ValueCleared() {
 		properties = append(properties, node.FieldValue)
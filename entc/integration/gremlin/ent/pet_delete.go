@@ -101,7 +101,7 @@ func (pdo *PetDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{pet.Label}
+		return &NotFoundError{label: pet.Label}
 	default:
 		return nil
 	}
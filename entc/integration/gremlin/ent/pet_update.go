@@ -56,6 +56,13 @@ func (pu *PetUpdate) AddAge(f float64) *PetUpdate {
 	return pu
 }
 
+// SetAgeIfGreater sets the "age" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (pu *PetUpdate) SetAgeIfGreater(f float64) *PetUpdate {
+	pu.mutation.SetAgeIfGreater(f)
+	return pu
+}
+
 // SetName sets the "name" field.
 func (pu *PetUpdate) SetName(s string) *PetUpdate {
 	pu.mutation.SetName(s)
@@ -259,6 +266,9 @@ func (pu *PetUpdate) gremlin() *dsl.Traversal {
 	if value, ok := pu.mutation.AddedAge(); ok {
 		v.Property(dsl.Single, pet.FieldAge, __.Union(__.Values(pet.FieldAge), __.Constant(value)).Sum())
 	}
+	if value, ok := pu.mutation.AgeIfGreater(); ok {
+		v.Property(dsl.Single, pet.FieldAge, __.Union(__.Values(pet.FieldAge), __.Constant(value)).Max())
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		v.Property(dsl.Single, pet.FieldName, value)
 	}
@@ -317,9 +327,18 @@ func (pu *PetUpdate) gremlin() *dsl.Traversal {
 // PetUpdateOne is the builder for updating a single Pet entity.
 type PetUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PetMutation
+	fields     []string
+	conditions []predicate.Pet
+	hooks      []Hook
+	mutation   *PetMutation
+}
+
+// Where appends a list of conditions to the PetUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (puo *PetUpdateOne) Where(ps ...predicate.Pet) *PetUpdateOne {
+	puo.conditions = append(puo.conditions, ps...)
+	return puo
 }
 
 // SetAge sets the "age" field.
@@ -343,6 +362,13 @@ func (puo *PetUpdateOne) AddAge(f float64) *PetUpdateOne {
 	return puo
 }
 
+// SetAgeIfGreater sets the "age" field to f, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (puo *PetUpdateOne) SetAgeIfGreater(f float64) *PetUpdateOne {
+	puo.mutation.SetAgeIfGreater(f)
+	return puo
+}
+
 // SetName sets the "name" field.
 func (puo *PetUpdateOne) SetName(s string) *PetUpdateOne {
 	puo.mutation.SetName(s)
@@ -527,6 +553,9 @@ func (puo *PetUpdateOne) ExecX(ctx context.Context) {
 
 func (puo *PetUpdateOne) gremlinSave(ctx context.Context) (*Pet, error) {
 	res := &gremlin.Response{}
+	if len(puo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := puo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Pet.id" for update`)}
@@ -564,6 +593,9 @@ func (puo *PetUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := puo.mutation.AddedAge(); ok {
 		v.Property(dsl.Single, pet.FieldAge, __.Union(__.Values(pet.FieldAge), __.Constant(value)).Sum())
 	}
+	if value, ok := puo.mutation.AgeIfGreater(); ok {
+		v.Property(dsl.Single, pet.FieldAge, __.Union(__.Values(pet.FieldAge), __.Constant(value)).Max())
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		v.Property(dsl.Single, pet.FieldName, value)
 	}
@@ -101,7 +101,7 @@ func (sdo *SpecDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{spec.Label}
+		return &NotFoundError{label: spec.Label}
 	default:
 		return nil
 	}
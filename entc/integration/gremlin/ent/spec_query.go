@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -26,6 +28,7 @@ type SpecQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Spec
 	withCard   *CardQuery
 	// intermediate query (i.e. traversal path).
@@ -66,7 +69,7 @@ func (sq *SpecQuery) Order(o ...OrderFunc) *SpecQuery {
 
 // QueryCard chains the current query on the "card" edge.
 func (sq *SpecQuery) QueryCard() *CardQuery {
-	query := &CardQuery{config: sq.config}
+	query := (&CardClient{config: sq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := sq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -86,7 +89,7 @@ func (sq *SpecQuery) First(ctx context.Context) (*Spec, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{spec.Label}
+		return nil, sq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -108,7 +111,7 @@ func (sq *SpecQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{spec.Label}
+		err = sq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -135,7 +138,7 @@ func (sq *SpecQuery) Only(ctx context.Context) (*Spec, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{spec.Label}
+		return nil, sq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{spec.Label}
 	}
@@ -162,7 +165,7 @@ func (sq *SpecQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{spec.Label}
+		err = sq.gremlinNotFound()
 	default:
 		err = &NotSingularError{spec.Label}
 	}
@@ -183,7 +186,17 @@ func (sq *SpecQuery) All(ctx context.Context) ([]*Spec, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return sq.gremlinAll(ctx)
+	v, err := sq.intercept(ctx, "All", func(ctx context.Context, query *SpecQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Spec)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Spec", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -197,10 +210,20 @@ func (sq *SpecQuery) AllX(ctx context.Context) []*Spec {
 
 // IDs executes the query and returns a list of Spec IDs.
 func (sq *SpecQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := sq.Select(spec.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := sq.intercept(ctx, "IDs", func(ctx context.Context, query *SpecQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(spec.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -218,7 +241,17 @@ func (sq *SpecQuery) Count(ctx context.Context) (int, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return sq.gremlinCount(ctx)
+	v, err := sq.intercept(ctx, "Count", func(ctx context.Context, query *SpecQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -235,7 +268,54 @@ func (sq *SpecQuery) Exist(ctx context.Context) (bool, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return sq.gremlinExist(ctx)
+	v, err := sq.intercept(ctx, "Exist", func(ctx context.Context, query *SpecQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (sq *SpecQuery) intercept(ctx context.Context, op string, fn func(context.Context, *SpecQuery) (Value, error)) (Value, error) {
+	if len(sq.inters) == 0 {
+		return fn(ctx, sq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeSpec,
+		Op:     op,
+		Limit:  sq.limit,
+		Offset: sq.offset,
+		Unique: sq.unique,
+		Fields: sq.fields,
+	}
+	for _, p := range sq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range sq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if sq.withCard != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, spec.EdgeCard)
+		qc.EdgeQueries[spec.EdgeCard] = sq.withCard
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*SpecQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *SpecQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, sq, qc, qr, sq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -258,6 +338,7 @@ func (sq *SpecQuery) Clone() *SpecQuery {
 		limit:      sq.limit,
 		offset:     sq.offset,
 		order:      append([]OrderFunc{}, sq.order...),
+		inters:     append([]Interceptor{}, sq.inters...),
 		predicates: append([]predicate.Spec{}, sq.predicates...),
 		withCard:   sq.withCard.Clone(),
 		// clone intermediate query.
@@ -270,7 +351,7 @@ func (sq *SpecQuery) Clone() *SpecQuery {
 // WithCard tells the query-builder to eager-load the nodes that are connected to
 // the "card" edge. The optional arguments are used to configure the query builder of the edge.
 func (sq *SpecQuery) WithCard(opts ...func(*CardQuery)) *SpecQuery {
-	query := &CardQuery{config: sq.config}
+	query := (&CardClient{config: sq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -305,6 +386,16 @@ func (sq *SpecQuery) Select(fields ...string) *SpecSelect {
 }
 
 func (sq *SpecQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sq); err != nil {
+				return err
+			}
+		}
+	}
 	if sq.path != nil {
 		prev, err := sq.path(ctx)
 		if err != nil {
@@ -328,7 +419,7 @@ func (sq *SpecQuery) gremlinAll(ctx context.Context) ([]*Spec, error) {
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := sq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := sq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var sSlice Specs
@@ -342,7 +433,7 @@ func (sq *SpecQuery) gremlinAll(ctx context.Context) ([]*Spec, error) {
 func (sq *SpecQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := sq.gremlinQuery(ctx).Count().Query()
-	if err := sq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := sq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -351,7 +442,7 @@ func (sq *SpecQuery) gremlinCount(ctx context.Context) (int, error) {
 func (sq *SpecQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := sq.gremlinQuery(ctx).HasNext().Query()
-	if err := sq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := sq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -385,6 +476,11 @@ func (sq *SpecQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (sq *SpecQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: spec.Label}
+}
+
 // SpecGroupBy is the group-by builder for Spec entities.
 type SpecGroupBy struct {
 	config
@@ -415,7 +511,7 @@ func (sgb *SpecGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (sgb *SpecGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := sgb.gremlinQuery().Query()
-	if err := sgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := sgb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(sgb.fields)+len(sgb.fns) == 1 {
@@ -485,7 +581,7 @@ func (ss *SpecSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		traversal = ss.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := ss.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ss.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(ss.fields) == 1 {
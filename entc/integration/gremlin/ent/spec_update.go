@@ -165,9 +165,18 @@ func (su *SpecUpdate) gremlin() *dsl.Traversal {
 // SpecUpdateOne is the builder for updating a single Spec entity.
 type SpecUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *SpecMutation
+	fields     []string
+	conditions []predicate.Spec
+	hooks      []Hook
+	mutation   *SpecMutation
+}
+
+// Where appends a list of conditions to the SpecUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (suo *SpecUpdateOne) Where(ps ...predicate.Spec) *SpecUpdateOne {
+	suo.conditions = append(suo.conditions, ps...)
+	return suo
 }
 
 // AddCardIDs adds the "card" edge to the Card entity by IDs.
@@ -280,6 +289,9 @@ func (suo *SpecUpdateOne) ExecX(ctx context.Context) {
 
 func (suo *SpecUpdateOne) gremlinSave(ctx context.Context) (*Spec, error) {
 	res := &gremlin.Response{}
+	if len(suo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := suo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Spec.id" for update`)}
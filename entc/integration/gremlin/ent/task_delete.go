@@ -102,7 +102,7 @@ func (tdo *TaskDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{enttask.Label}
+		return &NotFoundError{label: enttask.Label}
 	default:
 		return nil
 	}
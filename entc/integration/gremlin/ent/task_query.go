@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
@@ -27,6 +29,7 @@ type TaskQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Task
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
@@ -72,7 +75,7 @@ func (tq *TaskQuery) First(ctx context.Context) (*Task, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{enttask.Label}
+		return nil, tq.gremlinNotFound()
 	}
 	return nodes[0], nil
 }
@@ -94,7 +97,7 @@ func (tq *TaskQuery) FirstID(ctx context.Context) (id string, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{enttask.Label}
+		err = tq.gremlinNotFound()
 		return
 	}
 	return ids[0], nil
@@ -121,7 +124,7 @@ func (tq *TaskQuery) Only(ctx context.Context) (*Task, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{enttask.Label}
+		return nil, tq.gremlinNotFound()
 	default:
 		return nil, &NotSingularError{enttask.Label}
 	}
@@ -148,7 +151,7 @@ func (tq *TaskQuery) OnlyID(ctx context.Context) (id string, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{enttask.Label}
+		err = tq.gremlinNotFound()
 	default:
 		err = &NotSingularError{enttask.Label}
 	}
@@ -169,7 +172,17 @@ func (tq *TaskQuery) All(ctx context.Context) ([]*Task, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.gremlinAll(ctx)
+	v, err := tq.intercept(ctx, "All", func(ctx context.Context, query *TaskQuery) (Value, error) {
+		return query.gremlinAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Task)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Task", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -183,10 +196,20 @@ func (tq *TaskQuery) AllX(ctx context.Context) []*Task {
 
 // IDs executes the query and returns a list of Task IDs.
 func (tq *TaskQuery) IDs(ctx context.Context) ([]string, error) {
-	var ids []string
-	if err := tq.Select(enttask.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := tq.intercept(ctx, "IDs", func(ctx context.Context, query *TaskQuery) (Value, error) {
+		var ids []string
+		if err := query.Select(enttask.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]string)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []string", v)
+	}
 	return ids, nil
 }
 
@@ -204,7 +227,17 @@ func (tq *TaskQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.gremlinCount(ctx)
+	v, err := tq.intercept(ctx, "Count", func(ctx context.Context, query *TaskQuery) (Value, error) {
+		return query.gremlinCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -221,7 +254,47 @@ func (tq *TaskQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.gremlinExist(ctx)
+	v, err := tq.intercept(ctx, "Exist", func(ctx context.Context, query *TaskQuery) (Value, error) {
+		return query.gremlinExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (tq *TaskQuery) intercept(ctx context.Context, op string, fn func(context.Context, *TaskQuery) (Value, error)) (Value, error) {
+	if len(tq.inters) == 0 {
+		return fn(ctx, tq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeTask,
+		Op:     op,
+		Limit:  tq.limit,
+		Offset: tq.offset,
+		Unique: tq.unique,
+		Fields: tq.fields,
+	}
+	for _, p := range tq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range tq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *TaskQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, tq, qc, qr, tq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -244,6 +317,7 @@ func (tq *TaskQuery) Clone() *TaskQuery {
 		limit:      tq.limit,
 		offset:     tq.offset,
 		order:      append([]OrderFunc{}, tq.order...),
+		inters:     append([]Interceptor{}, tq.inters...),
 		predicates: append([]predicate.Task{}, tq.predicates...),
 		// clone intermediate query.
 		gremlin: tq.gremlin.Clone(),
@@ -303,6 +377,16 @@ func (tq *TaskQuery) Select(fields ...string) *TaskSelect {
 }
 
 func (tq *TaskQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tq); err != nil {
+				return err
+			}
+		}
+	}
 	if tq.path != nil {
 		prev, err := tq.path(ctx)
 		if err != nil {
@@ -326,7 +410,7 @@ func (tq *TaskQuery) gremlinAll(ctx context.Context) ([]*Task, error) {
 		traversal.ValueMap(true)
 	}
 	query, bindings := traversal.Query()
-	if err := tq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := tq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return nil, err
 	}
 	var ts Tasks
@@ -340,7 +424,7 @@ func (tq *TaskQuery) gremlinAll(ctx context.Context) ([]*Task, error) {
 func (tq *TaskQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := tq.gremlinQuery(ctx).Count().Query()
-	if err := tq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := tq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -349,7 +433,7 @@ func (tq *TaskQuery) gremlinCount(ctx context.Context) (int, error) {
 func (tq *TaskQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
 	query, bindings := tq.gremlinQuery(ctx).HasNext().Query()
-	if err := tq.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := tq.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
@@ -383,6 +467,11 @@ func (tq *TaskQuery) gremlinQuery(context.Context) *dsl.Traversal {
 	return v
 }
 
+// gremlinNotFound returns the *NotFoundError of the query.
+func (tq *TaskQuery) gremlinNotFound() *NotFoundError {
+	return &NotFoundError{label: enttask.Label}
+}
+
 // TaskGroupBy is the group-by builder for Task entities.
 type TaskGroupBy struct {
 	config
@@ -413,7 +502,7 @@ func (tgb *TaskGroupBy) Scan(ctx context.Context, v interface{}) error {
 func (tgb *TaskGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
 	query, bindings := tgb.gremlinQuery().Query()
-	if err := tgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := tgb.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(tgb.fields)+len(tgb.fns) == 1 {
@@ -483,7 +572,7 @@ func (ts *TaskSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		traversal = ts.gremlin.ValueMap(fields...)
 	}
 	query, bindings := traversal.Query()
-	if err := ts.driver.Exec(ctx, query, bindings, res); err != nil {
+	if err := ts.queryDriver().Exec(ctx, query, bindings, res); err != nil {
 		return err
 	}
 	if len(ts.fields) == 1 {
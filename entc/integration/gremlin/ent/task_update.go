@@ -55,6 +55,25 @@ func (tu *TaskUpdate) AddPriority(t task.Priority) *TaskUpdate {
 	return tu
 }
 
+// SetPriorityIfGreater sets the "priority" field to t, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (tu *TaskUpdate) SetPriorityIfGreater(t task.Priority) *TaskUpdate {
+	tu.mutation.SetPriorityIfGreater(t)
+	return tu
+}
+
+// BitAndPriority applies a bitwise AND with t on the "priority" field in the database.
+func (tu *TaskUpdate) BitAndPriority(t task.Priority) *TaskUpdate {
+	tu.mutation.BitAndPriority(t)
+	return tu
+}
+
+// BitOrPriority applies a bitwise OR with t on the "priority" field in the database.
+func (tu *TaskUpdate) BitOrPriority(t task.Priority) *TaskUpdate {
+	tu.mutation.BitOrPriority(t)
+	return tu
+}
+
 // SetPriorities sets the "priorities" field.
 func (tu *TaskUpdate) SetPriorities(m map[string]task.Priority) *TaskUpdate {
 	tu.mutation.SetPriorities(m)
@@ -144,6 +163,12 @@ func (tu *TaskUpdate) check() error {
 
 func (tu *TaskUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
+	if _, ok := tu.mutation.PriorityBitAnd(); ok {
+		return 0, errors.New("ent: BitAndPriority is not supported by the gremlin dialect")
+	}
+	if _, ok := tu.mutation.PriorityBitOr(); ok {
+		return 0, errors.New("ent: BitOrPriority is not supported by the gremlin dialect")
+	}
 	query, bindings := tu.gremlin().Query()
 	if err := tu.driver.Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
@@ -168,6 +193,9 @@ func (tu *TaskUpdate) gremlin() *dsl.Traversal {
 	if value, ok := tu.mutation.AddedPriority(); ok {
 		v.Property(dsl.Single, enttask.FieldPriority, __.Union(__.Values(enttask.FieldPriority), __.Constant(value)).Sum())
 	}
+	if value, ok := tu.mutation.PriorityIfGreater(); ok {
+		v.Property(dsl.Single, enttask.FieldPriority, __.Union(__.Values(enttask.FieldPriority), __.Constant(value)).Max())
+	}
 	if value, ok := tu.mutation.Priorities(); ok {
 		v.Property(dsl.Single, enttask.FieldPriorities, value)
 	}
@@ -186,9 +214,18 @@ func (tu *TaskUpdate) gremlin() *dsl.Traversal {
 // TaskUpdateOne is the builder for updating a single Task entity.
 type TaskUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TaskMutation
+	fields     []string
+	conditions []predicate.Task
+	hooks      []Hook
+	mutation   *TaskMutation
+}
+
+// Where appends a list of conditions to the TaskUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (tuo *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
+	tuo.conditions = append(tuo.conditions, ps...)
+	return tuo
 }
 
 // SetPriority sets the "priority" field.
@@ -212,6 +249,25 @@ func (tuo *TaskUpdateOne) AddPriority(t task.Priority) *TaskUpdateOne {
 	return tuo
 }
 
+// SetPriorityIfGreater sets the "priority" field to t, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (tuo *TaskUpdateOne) SetPriorityIfGreater(t task.Priority) *TaskUpdateOne {
+	tuo.mutation.SetPriorityIfGreater(t)
+	return tuo
+}
+
+// BitAndPriority applies a bitwise AND with t on the "priority" field in the database.
+func (tuo *TaskUpdateOne) BitAndPriority(t task.Priority) *TaskUpdateOne {
+	tuo.mutation.BitAndPriority(t)
+	return tuo
+}
+
+// BitOrPriority applies a bitwise OR with t on the "priority" field in the database.
+func (tuo *TaskUpdateOne) BitOrPriority(t task.Priority) *TaskUpdateOne {
+	tuo.mutation.BitOrPriority(t)
+	return tuo
+}
+
 // SetPriorities sets the "priorities" field.
 func (tuo *TaskUpdateOne) SetPriorities(m map[string]task.Priority) *TaskUpdateOne {
 	tuo.mutation.SetPriorities(m)
@@ -314,6 +370,15 @@ func (tuo *TaskUpdateOne) check() error {
 
 func (tuo *TaskUpdateOne) gremlinSave(ctx context.Context) (*Task, error) {
 	res := &gremlin.Response{}
+	if _, ok := tuo.mutation.PriorityBitAnd(); ok {
+		return nil, errors.New("ent: BitAndPriority is not supported by the gremlin dialect")
+	}
+	if _, ok := tuo.mutation.PriorityBitOr(); ok {
+		return nil, errors.New("ent: BitOrPriority is not supported by the gremlin dialect")
+	}
+	if len(tuo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := tuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Task.id" for update`)}
@@ -343,6 +408,9 @@ func (tuo *TaskUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := tuo.mutation.AddedPriority(); ok {
 		v.Property(dsl.Single, enttask.FieldPriority, __.Union(__.Values(enttask.FieldPriority), __.Constant(value)).Sum())
 	}
+	if value, ok := tuo.mutation.PriorityIfGreater(); ok {
+		v.Property(dsl.Single, enttask.FieldPriority, __.Union(__.Values(enttask.FieldPriority), __.Constant(value)).Max())
+	}
 	if value, ok := tuo.mutation.Priorities(); ok {
 		v.Property(dsl.Single, enttask.FieldPriorities, value)
 	}
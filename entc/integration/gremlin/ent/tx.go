@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -198,6 +199,67 @@ func (tx *Tx) init() {
 	tx.User = NewUserClient(tx.config)
 }
 
+// WithTx runs the given function in a transaction. The transaction is committed if the function
+// returns nil, and rolled back if it returns an error, panics, or if the context was canceled before
+// the transaction was committed. Panics are re-raised after the transaction was rolled back, and the
+// errors of failed rollbacks are returned as a *RollbackError that wraps the error of the function.
+//
+//	err := ent.WithTx(ctx, client, func(tx *ent.Tx) error {
+//		return Gen(ctx, tx.Client())
+//	})
+//
+func WithTx(ctx context.Context, client *Client, fn func(tx *Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		return rollbackTx(tx, err)
+	}
+	// A canceled context may not fail the function (e.g. if it
+	// did not use it), but it must not commit the transaction.
+	if err := ctx.Err(); err != nil {
+		return rollbackTx(tx, fmt.Errorf("ent: context done before commit: %w", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	return nil
+}
+
+// RollbackError is returned by WithTx when the rollback of a transaction failed. It holds
+// the error that caused the rollback, and the error that was returned by the rollback.
+type RollbackError struct {
+	// Err is the error that caused the rollback.
+	Err error
+	// RollbackErr is the error that was returned by the rollback.
+	RollbackErr error
+}
+
+// Error implements the error interface.
+func (e *RollbackError) Error() string {
+	return fmt.Sprintf("%v: rolling back transaction: %v", e.Err, e.RollbackErr)
+}
+
+// Unwrap returns the error that caused the rollback.
+func (e *RollbackError) Unwrap() error {
+	return e.Err
+}
+
+// rollbackTx rolls back the transaction, and joins the given error with the rollback error if occurred.
+func rollbackTx(tx *Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return &RollbackError{Err: err, RollbackErr: rerr}
+	}
+	return err
+}
+
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
 // The idea is to support transactions without adding any extra code to the builders.
 // When a builder calls to driver.Tx(), it gets the same dialect.Tx instance.
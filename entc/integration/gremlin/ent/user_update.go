@@ -54,6 +54,25 @@ func (uu *UserUpdate) AddOptionalInt(i int) *UserUpdate {
 	return uu
 }
 
+// SetOptionalIntIfGreater sets the "optional_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uu *UserUpdate) SetOptionalIntIfGreater(i int) *UserUpdate {
+	uu.mutation.SetOptionalIntIfGreater(i)
+	return uu
+}
+
+// BitAndOptionalInt applies a bitwise AND with i on the "optional_int" field in the database.
+func (uu *UserUpdate) BitAndOptionalInt(i int) *UserUpdate {
+	uu.mutation.BitAndOptionalInt(i)
+	return uu
+}
+
+// BitOrOptionalInt applies a bitwise OR with i on the "optional_int" field in the database.
+func (uu *UserUpdate) BitOrOptionalInt(i int) *UserUpdate {
+	uu.mutation.BitOrOptionalInt(i)
+	return uu
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (uu *UserUpdate) ClearOptionalInt() *UserUpdate {
 	uu.mutation.ClearOptionalInt()
@@ -73,6 +92,25 @@ func (uu *UserUpdate) AddAge(i int) *UserUpdate {
 	return uu
 }
 
+// SetAgeIfGreater sets the "age" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uu *UserUpdate) SetAgeIfGreater(i int) *UserUpdate {
+	uu.mutation.SetAgeIfGreater(i)
+	return uu
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field in the database.
+func (uu *UserUpdate) BitAndAge(i int) *UserUpdate {
+	uu.mutation.BitAndAge(i)
+	return uu
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field in the database.
+func (uu *UserUpdate) BitOrAge(i int) *UserUpdate {
+	uu.mutation.BitOrAge(i)
+	return uu
+}
+
 // SetName sets the "name" field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.mutation.SetName(s)
@@ -660,6 +698,18 @@ func (uu *UserUpdate) check() error {
 
 func (uu *UserUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
+	if _, ok := uu.mutation.OptionalIntBitAnd(); ok {
+		return 0, errors.New("ent: BitAndOptionalInt is not supported by the gremlin dialect")
+	}
+	if _, ok := uu.mutation.OptionalIntBitOr(); ok {
+		return 0, errors.New("ent: BitOrOptionalInt is not supported by the gremlin dialect")
+	}
+	if _, ok := uu.mutation.AgeBitAnd(); ok {
+		return 0, errors.New("ent: BitAndAge is not supported by the gremlin dialect")
+	}
+	if _, ok := uu.mutation.AgeBitOr(); ok {
+		return 0, errors.New("ent: BitOrAge is not supported by the gremlin dialect")
+	}
 	query, bindings := uu.gremlin().Query()
 	if err := uu.driver.Exec(ctx, query, bindings, res); err != nil {
 		return 0, err
@@ -692,12 +742,18 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 	if value, ok := uu.mutation.AddedOptionalInt(); ok {
 		v.Property(dsl.Single, user.FieldOptionalInt, __.Union(__.Values(user.FieldOptionalInt), __.Constant(value)).Sum())
 	}
+	if value, ok := uu.mutation.OptionalIntIfGreater(); ok {
+		v.Property(dsl.Single, user.FieldOptionalInt, __.Union(__.Values(user.FieldOptionalInt), __.Constant(value)).Max())
+	}
 	if value, ok := uu.mutation.Age(); ok {
 		v.Property(dsl.Single, user.FieldAge, value)
 	}
 	if value, ok := uu.mutation.AddedAge(); ok {
 		v.Property(dsl.Single, user.FieldAge, __.Union(__.Values(user.FieldAge), __.Constant(value)).Sum())
 	}
+	if value, ok := uu.mutation.AgeIfGreater(); ok {
+		v.Property(dsl.Single, user.FieldAge, __.Union(__.Values(user.FieldAge), __.Constant(value)).Max())
+	}
 	if value, ok := uu.mutation.Name(); ok {
 		v.Property(dsl.Single, user.FieldName, value)
 	}
@@ -878,9 +934,18 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields     []string
+	conditions []predicate.User
+	hooks      []Hook
+	mutation   *UserMutation
+}
+
+// Where appends a list of conditions to the UserUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.conditions = append(uuo.conditions, ps...)
+	return uuo
 }
 
 // SetOptionalInt sets the "optional_int" field.
@@ -904,6 +969,25 @@ func (uuo *UserUpdateOne) AddOptionalInt(i int) *UserUpdateOne {
 	return uuo
 }
 
+// SetOptionalIntIfGreater sets the "optional_int" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uuo *UserUpdateOne) SetOptionalIntIfGreater(i int) *UserUpdateOne {
+	uuo.mutation.SetOptionalIntIfGreater(i)
+	return uuo
+}
+
+// BitAndOptionalInt applies a bitwise AND with i on the "optional_int" field in the database.
+func (uuo *UserUpdateOne) BitAndOptionalInt(i int) *UserUpdateOne {
+	uuo.mutation.BitAndOptionalInt(i)
+	return uuo
+}
+
+// BitOrOptionalInt applies a bitwise OR with i on the "optional_int" field in the database.
+func (uuo *UserUpdateOne) BitOrOptionalInt(i int) *UserUpdateOne {
+	uuo.mutation.BitOrOptionalInt(i)
+	return uuo
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (uuo *UserUpdateOne) ClearOptionalInt() *UserUpdateOne {
 	uuo.mutation.ClearOptionalInt()
@@ -923,6 +1007,25 @@ func (uuo *UserUpdateOne) AddAge(i int) *UserUpdateOne {
 	return uuo
 }
 
+// SetAgeIfGreater sets the "age" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uuo *UserUpdateOne) SetAgeIfGreater(i int) *UserUpdateOne {
+	uuo.mutation.SetAgeIfGreater(i)
+	return uuo
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field in the database.
+func (uuo *UserUpdateOne) BitAndAge(i int) *UserUpdateOne {
+	uuo.mutation.BitAndAge(i)
+	return uuo
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field in the database.
+func (uuo *UserUpdateOne) BitOrAge(i int) *UserUpdateOne {
+	uuo.mutation.BitOrAge(i)
+	return uuo
+}
+
 // SetName sets the "name" field.
 func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
 	uuo.mutation.SetName(s)
@@ -1523,6 +1626,21 @@ func (uuo *UserUpdateOne) check() error {
 
 func (uuo *UserUpdateOne) gremlinSave(ctx context.Context) (*User, error) {
 	res := &gremlin.Response{}
+	if _, ok := uuo.mutation.OptionalIntBitAnd(); ok {
+		return nil, errors.New("ent: BitAndOptionalInt is not supported by the gremlin dialect")
+	}
+	if _, ok := uuo.mutation.OptionalIntBitOr(); ok {
+		return nil, errors.New("ent: BitOrOptionalInt is not supported by the gremlin dialect")
+	}
+	if _, ok := uuo.mutation.AgeBitAnd(); ok {
+		return nil, errors.New("ent: BitAndAge is not supported by the gremlin dialect")
+	}
+	if _, ok := uuo.mutation.AgeBitOr(); ok {
+		return nil, errors.New("ent: BitOrAge is not supported by the gremlin dialect")
+	}
+	if len(uuo.conditions) > 0 {
+		return nil, errors.New("ent: conditional updates are not supported by the gremlin dialect")
+	}
 	id, ok := uuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
@@ -1560,12 +1678,18 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := uuo.mutation.AddedOptionalInt(); ok {
 		v.Property(dsl.Single, user.FieldOptionalInt, __.Union(__.Values(user.FieldOptionalInt), __.Constant(value)).Sum())
 	}
+	if value, ok := uuo.mutation.OptionalIntIfGreater(); ok {
+		v.Property(dsl.Single, user.FieldOptionalInt, __.Union(__.Values(user.FieldOptionalInt), __.Constant(value)).Max())
+	}
 	if value, ok := uuo.mutation.Age(); ok {
 		v.Property(dsl.Single, user.FieldAge, value)
 	}
 	if value, ok := uuo.mutation.AddedAge(); ok {
 		v.Property(dsl.Single, user.FieldAge, __.Union(__.Values(user.FieldAge), __.Constant(value)).Sum())
 	}
+	if value, ok := uuo.mutation.AgeIfGreater(); ok {
+		v.Property(dsl.Single, user.FieldAge, __.Union(__.Values(user.FieldAge), __.Constant(value)).Max())
+	}
 	if value, ok := uuo.mutation.Name(); ok {
 		v.Property(dsl.Single, user.FieldName, value)
 	}
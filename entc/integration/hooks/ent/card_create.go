@@ -179,7 +179,7 @@ func (cc *CardCreate) defaults() error {
 		if card.DefaultCreatedAt == nil {
 			return fmt.Errorf("ent: uninitialized card.DefaultCreatedAt (forgotten import ent/runtime?)")
 		}
-		v := card.DefaultCreatedAt()
+		v := cc.config.now(card.DefaultCreatedAt)
 		cc.mutation.SetCreatedAt(v)
 	}
 	return nil
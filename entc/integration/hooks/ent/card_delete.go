@@ -107,7 +107,7 @@ func (cdo *CardDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{card.Label}
+		return &NotFoundError{label: card.Label}
 	default:
 		return nil
 	}
@@ -14,10 +14,12 @@ import (
 	"time"
 
 	"entgo.io/ent/entc/integration/hooks/ent/card"
+	"entgo.io/ent/entc/integration/hooks/ent/pet"
 	"entgo.io/ent/entc/integration/hooks/ent/predicate"
 	"entgo.io/ent/entc/integration/hooks/ent/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -30,6 +32,7 @@ const (
 
 	// Node types.
 	TypeCard = "Card"
+	TypePet  = "Pet"
 	TypeUser = "User"
 )
 
@@ -188,6 +191,7 @@ func (m *CardMutation) ResetNumber() {
 // SetName sets the "name" field.
 func (m *CardMutation) SetName(s string) {
 	m.name = &s
+	delete(m.clearedFields, card.FieldName)
 }
 
 // Name returns the value of the "name" field in the mutation.
@@ -350,6 +354,16 @@ func (m *CardMutation) Where(ps ...predicate.Card) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the CardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Card, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *CardMutation) Op() Op {
 	return m.op
@@ -597,6 +611,687 @@ func (m *CardMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Card edge %s", name)
 }
 
+// PetMutation represents an operation that mutates the Pet nodes in the graph.
+type PetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	checksum      *string
+	name          *string
+	age           *int
+	addage        *int
+	maxage        *int
+	andage        *int
+	orage         *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Pet, error)
+	predicates    []predicate.Pet
+}
+
+var _ ent.Mutation = (*PetMutation)(nil)
+
+// petOption allows management of the mutation configuration using functional options.
+type petOption func(*PetMutation)
+
+// newPetMutation creates new mutation for the Pet entity.
+func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
+	m := &PetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPetID sets the ID field of the mutation.
+func withPetID(id int) petOption {
+	return func(m *PetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pet
+		)
+		m.oldValue = func(ctx context.Context) (*Pet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPet sets the old Pet of the mutation.
+func withPet(node *Pet) petOption {
+	return func(m *PetMutation) {
+		m.oldValue = func(context.Context) (*Pet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PetMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *PetMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *PetMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *PetMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *PetMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *PetMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *PetMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *PetMutation) SetChecksum(s string) {
+	m.checksum = &s
+	delete(m.clearedFields, pet.FieldChecksum)
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *PetMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (m *PetMutation) ClearChecksum() {
+	m.checksum = nil
+	m.clearedFields[pet.FieldChecksum] = struct{}{}
+}
+
+// ChecksumCleared returns if the "checksum" field was cleared in this mutation.
+func (m *PetMutation) ChecksumCleared() bool {
+	_, ok := m.clearedFields[pet.FieldChecksum]
+	return ok
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *PetMutation) ResetChecksum() {
+	m.checksum = nil
+	delete(m.clearedFields, pet.FieldChecksum)
+}
+
+// SetName sets the "name" field.
+func (m *PetMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PetMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAge sets the "age" field.
+func (m *PetMutation) SetAge(i int) {
+	m.age = &i
+	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
+	delete(m.clearedFields, pet.FieldAge)
+}
+
+// Age returns the value of the "age" field in the mutation.
+func (m *PetMutation) Age() (r int, exists bool) {
+	v := m.age
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAge returns the old "age" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldAge(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAge: %w", err)
+	}
+	return oldValue.Age, nil
+}
+
+// AddAge adds i to the "age" field.
+func (m *PetMutation) AddAge(i int) {
+	if m.addage != nil {
+		*m.addage += i
+	} else {
+		m.addage = &i
+	}
+}
+
+// AddedAge returns the value that was added to the "age" field in this mutation.
+func (m *PetMutation) AddedAge() (r int, exists bool) {
+	v := m.addage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// SetAgeIfGreater sets the "age" field to i in the database, only if it is greater than its stored value.
+func (m *PetMutation) SetAgeIfGreater(i int) {
+	if m.maxage == nil || i > *m.maxage {
+		m.maxage = &i
+	}
+}
+
+// AgeIfGreater returns the value that was set to the "age" field by SetAgeIfGreater in this mutation.
+func (m *PetMutation) AgeIfGreater() (r int, exists bool) {
+	v := m.maxage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field.
+func (m *PetMutation) BitAndAge(i int) {
+	if m.andage != nil {
+		*m.andage &= i
+	} else {
+		m.andage = &i
+	}
+}
+
+// AgeBitAnd returns the value that was applied by BitAndAge on the "age" field in this mutation.
+func (m *PetMutation) AgeBitAnd() (r int, exists bool) {
+	v := m.andage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field.
+func (m *PetMutation) BitOrAge(i int) {
+	if m.orage != nil {
+		*m.orage |= i
+	} else {
+		m.orage = &i
+	}
+}
+
+// AgeBitOr returns the value that was applied by BitOrAge on the "age" field in this mutation.
+func (m *PetMutation) AgeBitOr() (r int, exists bool) {
+	v := m.orage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAge clears the value of the "age" field.
+func (m *PetMutation) ClearAge() {
+	m.age = nil
+	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
+	m.clearedFields[pet.FieldAge] = struct{}{}
+}
+
+// AgeCleared returns if the "age" field was cleared in this mutation.
+func (m *PetMutation) AgeCleared() bool {
+	_, ok := m.clearedFields[pet.FieldAge]
+	return ok
+}
+
+// ResetAge resets all changes to the "age" field.
+func (m *PetMutation) ResetAge() {
+	m.age = nil
+	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
+	delete(m.clearedFields, pet.FieldAge)
+}
+
+// Where appends a list predicates to the PetMutation builder.
+func (m *PetMutation) Where(ps ...predicate.Pet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PetMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Pet).
+func (m *PetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PetMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, pet.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, pet.FieldUpdateTime)
+	}
+	if m.checksum != nil {
+		fields = append(fields, pet.FieldChecksum)
+	}
+	if m.name != nil {
+		fields = append(fields, pet.FieldName)
+	}
+	if m.age != nil {
+		fields = append(fields, pet.FieldAge)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pet.FieldCreateTime:
+		return m.CreateTime()
+	case pet.FieldUpdateTime:
+		return m.UpdateTime()
+	case pet.FieldChecksum:
+		return m.Checksum()
+	case pet.FieldName:
+		return m.Name()
+	case pet.FieldAge:
+		return m.Age()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pet.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case pet.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case pet.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case pet.FieldName:
+		return m.OldName(ctx)
+	case pet.FieldAge:
+		return m.OldAge(ctx)
+	}
+	return nil, fmt.Errorf("unknown Pet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pet.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case pet.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case pet.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
+	case pet.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case pet.FieldAge:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAge(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PetMutation) AddedFields() []string {
+	var fields []string
+	if m.addage != nil {
+		fields = append(fields, pet.FieldAge)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pet.FieldAge:
+		return m.AddedAge()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pet.FieldAge:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAge(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pet.FieldChecksum) {
+		fields = append(fields, pet.FieldChecksum)
+	}
+	if m.FieldCleared(pet.FieldAge) {
+		fields = append(fields, pet.FieldAge)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PetMutation) ClearField(name string) error {
+	switch name {
+	case pet.FieldChecksum:
+		m.ClearChecksum()
+		return nil
+	case pet.FieldAge:
+		m.ClearAge()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PetMutation) ResetField(name string) error {
+	switch name {
+	case pet.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case pet.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case pet.FieldChecksum:
+		m.ResetChecksum()
+		return nil
+	case pet.FieldName:
+		m.ResetName()
+		return nil
+	case pet.FieldAge:
+		m.ResetAge()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PetMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PetMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Pet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Pet edge %s", name)
+}
+
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
@@ -605,9 +1300,15 @@ type UserMutation struct {
 	id                 *int
 	version            *int
 	addversion         *int
+	maxversion         *int
+	andversion         *int
+	orversion          *int
 	name               *string
 	worth              *uint
 	addworth           *int
+	maxworth           *uint
+	andworth           *uint
+	orworth            *uint
 	password           *string
 	clearedFields      map[string]struct{}
 	cards              map[int]struct{}
@@ -725,6 +1426,9 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *UserMutation) SetVersion(i int) {
 	m.version = &i
 	m.addversion = nil
+	m.maxversion = nil
+	m.andversion = nil
+	m.orversion = nil
 }
 
 // Version returns the value of the "version" field in the mutation.
@@ -771,10 +1475,65 @@ func (m *UserMutation) AddedVersion() (r int, exists bool) {
 	return *v, true
 }
 
+// SetVersionIfGreater sets the "version" field to i in the database, only if it is greater than its stored value.
+func (m *UserMutation) SetVersionIfGreater(i int) {
+	if m.maxversion == nil || i > *m.maxversion {
+		m.maxversion = &i
+	}
+}
+
+// VersionIfGreater returns the value that was set to the "version" field by SetVersionIfGreater in this mutation.
+func (m *UserMutation) VersionIfGreater() (r int, exists bool) {
+	v := m.maxversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndVersion applies a bitwise AND with i on the "version" field.
+func (m *UserMutation) BitAndVersion(i int) {
+	if m.andversion != nil {
+		*m.andversion &= i
+	} else {
+		m.andversion = &i
+	}
+}
+
+// VersionBitAnd returns the value that was applied by BitAndVersion on the "version" field in this mutation.
+func (m *UserMutation) VersionBitAnd() (r int, exists bool) {
+	v := m.andversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrVersion applies a bitwise OR with i on the "version" field.
+func (m *UserMutation) BitOrVersion(i int) {
+	if m.orversion != nil {
+		*m.orversion |= i
+	} else {
+		m.orversion = &i
+	}
+}
+
+// VersionBitOr returns the value that was applied by BitOrVersion on the "version" field in this mutation.
+func (m *UserMutation) VersionBitOr() (r int, exists bool) {
+	v := m.orversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetVersion resets all changes to the "version" field.
 func (m *UserMutation) ResetVersion() {
 	m.version = nil
 	m.addversion = nil
+	m.maxversion = nil
+	m.andversion = nil
+	m.orversion = nil
 }
 
 // SetName sets the "name" field.
@@ -817,6 +1576,10 @@ func (m *UserMutation) ResetName() {
 func (m *UserMutation) SetWorth(u uint) {
 	m.worth = &u
 	m.addworth = nil
+	m.maxworth = nil
+	m.andworth = nil
+	m.orworth = nil
+	delete(m.clearedFields, user.FieldWorth)
 }
 
 // Worth returns the value of the "worth" field in the mutation.
@@ -863,10 +1626,65 @@ func (m *UserMutation) AddedWorth() (r int, exists bool) {
 	return *v, true
 }
 
+// SetWorthIfGreater sets the "worth" field to u in the database, only if it is greater than its stored value.
+func (m *UserMutation) SetWorthIfGreater(u uint) {
+	if m.maxworth == nil || u > *m.maxworth {
+		m.maxworth = &u
+	}
+}
+
+// WorthIfGreater returns the value that was set to the "worth" field by SetWorthIfGreater in this mutation.
+func (m *UserMutation) WorthIfGreater() (r uint, exists bool) {
+	v := m.maxworth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndWorth applies a bitwise AND with u on the "worth" field.
+func (m *UserMutation) BitAndWorth(u uint) {
+	if m.andworth != nil {
+		*m.andworth &= u
+	} else {
+		m.andworth = &u
+	}
+}
+
+// WorthBitAnd returns the value that was applied by BitAndWorth on the "worth" field in this mutation.
+func (m *UserMutation) WorthBitAnd() (r uint, exists bool) {
+	v := m.andworth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrWorth applies a bitwise OR with u on the "worth" field.
+func (m *UserMutation) BitOrWorth(u uint) {
+	if m.orworth != nil {
+		*m.orworth |= u
+	} else {
+		m.orworth = &u
+	}
+}
+
+// WorthBitOr returns the value that was applied by BitOrWorth on the "worth" field in this mutation.
+func (m *UserMutation) WorthBitOr() (r uint, exists bool) {
+	v := m.orworth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearWorth clears the value of the "worth" field.
 func (m *UserMutation) ClearWorth() {
 	m.worth = nil
 	m.addworth = nil
+	m.maxworth = nil
+	m.andworth = nil
+	m.orworth = nil
 	m.clearedFields[user.FieldWorth] = struct{}{}
 }
 
@@ -880,12 +1698,16 @@ func (m *UserMutation) WorthCleared() bool {
 func (m *UserMutation) ResetWorth() {
 	m.worth = nil
 	m.addworth = nil
+	m.maxworth = nil
+	m.andworth = nil
+	m.orworth = nil
 	delete(m.clearedFields, user.FieldWorth)
 }
 
 // SetPassword sets the "password" field.
 func (m *UserMutation) SetPassword(s string) {
 	m.password = &s
+	delete(m.clearedFields, user.FieldPassword)
 }
 
 // Password returns the value of the "password" field in the mutation.
@@ -1084,6 +1906,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
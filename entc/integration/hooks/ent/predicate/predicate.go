@@ -13,5 +13,8 @@ import (
 // Card is the predicate function for card builders.
 type Card func(*sql.Selector)
 
+// Pet is the predicate function for pet builders.
+type Pet func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
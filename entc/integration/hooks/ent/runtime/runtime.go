@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"entgo.io/ent/entc/integration/hooks/ent/card"
+	"entgo.io/ent/entc/integration/hooks/ent/pet"
 	"entgo.io/ent/entc/integration/hooks/ent/schema"
 	"entgo.io/ent/entc/integration/hooks/ent/user"
 )
@@ -36,6 +37,11 @@ func init() {
 	cardDescCreatedAt := cardFields[2].Descriptor()
 	// card.DefaultCreatedAt holds the default value on creation for the created_at field.
 	card.DefaultCreatedAt = cardDescCreatedAt.Default.(func() time.Time)
+	petMixin := schema.Pet{}.Mixin()
+	petMixinHooks0 := petMixin[0].Hooks()
+	petMixinHooks1 := petMixin[1].Hooks()
+	pet.Hooks[0] = petMixinHooks0[0]
+	pet.Hooks[1] = petMixinHooks1[0]
 	userMixin := schema.User{}.Mixin()
 	userMixinHooks0 := userMixin[0].Hooks()
 	userHooks := schema.User{}.Hooks()
@@ -0,0 +1,39 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// PetIntegrity maintains the checksum of the pets.
+var PetIntegrity = mixin.Integrity{
+	Covers: []string{"age", "create_time"},
+}
+
+// Pet holds the schema definition for the Pet entity.
+type Pet struct {
+	ent.Schema
+}
+
+// Mixin of the Pet.
+func (Pet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamps{Lock: true},
+		PetIntegrity,
+	}
+}
+
+// Fields of the Pet.
+func (Pet) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("age").
+			Optional().
+			Nillable(),
+	}
+}
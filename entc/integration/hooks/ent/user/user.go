@@ -93,3 +93,9 @@ var (
 	// DefaultVersion holds the default value on creation for the "version" field.
 	DefaultVersion int
 )
+
+// SensitiveColumns holds the SQL columns of the sensitive fields. They are not selected by queries
+// without an explicit field selection, unless the query was configured using WithSensitive.
+var SensitiveColumns = []string{
+	FieldPassword,
+}
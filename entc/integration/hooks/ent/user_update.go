@@ -53,6 +53,25 @@ func (uu *UserUpdate) AddVersion(i int) *UserUpdate {
 	return uu
 }
 
+// SetVersionIfGreater sets the "version" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uu *UserUpdate) SetVersionIfGreater(i int) *UserUpdate {
+	uu.mutation.SetVersionIfGreater(i)
+	return uu
+}
+
+// BitAndVersion applies a bitwise AND with i on the "version" field in the database.
+func (uu *UserUpdate) BitAndVersion(i int) *UserUpdate {
+	uu.mutation.BitAndVersion(i)
+	return uu
+}
+
+// BitOrVersion applies a bitwise OR with i on the "version" field in the database.
+func (uu *UserUpdate) BitOrVersion(i int) *UserUpdate {
+	uu.mutation.BitOrVersion(i)
+	return uu
+}
+
 // SetName sets the "name" field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.mutation.SetName(s)
@@ -80,6 +99,25 @@ func (uu *UserUpdate) AddWorth(u int) *UserUpdate {
 	return uu
 }
 
+// SetWorthIfGreater sets the "worth" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uu *UserUpdate) SetWorthIfGreater(u uint) *UserUpdate {
+	uu.mutation.SetWorthIfGreater(u)
+	return uu
+}
+
+// BitAndWorth applies a bitwise AND with u on the "worth" field in the database.
+func (uu *UserUpdate) BitAndWorth(u uint) *UserUpdate {
+	uu.mutation.BitAndWorth(u)
+	return uu
+}
+
+// BitOrWorth applies a bitwise OR with u on the "worth" field in the database.
+func (uu *UserUpdate) BitOrWorth(u uint) *UserUpdate {
+	uu.mutation.BitOrWorth(u)
+	return uu
+}
+
 // ClearWorth clears the value of the "worth" field.
 func (uu *UserUpdate) ClearWorth() *UserUpdate {
 	uu.mutation.ClearWorth()
@@ -294,6 +332,27 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldVersion,
 		})
 	}
+	if value, ok := uu.mutation.VersionIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+	}
+	if value, ok := uu.mutation.VersionBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+	}
+	if value, ok := uu.mutation.VersionBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+	}
 	if value, ok := uu.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -315,6 +374,27 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldWorth,
 		})
 	}
+	if value, ok := uu.mutation.WorthIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: user.FieldWorth,
+		})
+	}
+	if value, ok := uu.mutation.WorthBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: user.FieldWorth,
+		})
+	}
+	if value, ok := uu.mutation.WorthBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: user.FieldWorth,
+		})
+	}
 	if uu.mutation.WorthCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint,
@@ -479,7 +559,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -491,9 +571,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields     []string
+	conditions []predicate.User
+	hooks      []Hook
+	mutation   *UserMutation
+}
+
+// Where appends a list of conditions to the UserUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.conditions = append(uuo.conditions, ps...)
+	return uuo
 }
 
 // SetVersion sets the "version" field.
@@ -517,6 +606,25 @@ func (uuo *UserUpdateOne) AddVersion(i int) *UserUpdateOne {
 	return uuo
 }
 
+// SetVersionIfGreater sets the "version" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uuo *UserUpdateOne) SetVersionIfGreater(i int) *UserUpdateOne {
+	uuo.mutation.SetVersionIfGreater(i)
+	return uuo
+}
+
+// BitAndVersion applies a bitwise AND with i on the "version" field in the database.
+func (uuo *UserUpdateOne) BitAndVersion(i int) *UserUpdateOne {
+	uuo.mutation.BitAndVersion(i)
+	return uuo
+}
+
+// BitOrVersion applies a bitwise OR with i on the "version" field in the database.
+func (uuo *UserUpdateOne) BitOrVersion(i int) *UserUpdateOne {
+	uuo.mutation.BitOrVersion(i)
+	return uuo
+}
+
 // SetName sets the "name" field.
 func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
 	uuo.mutation.SetName(s)
@@ -544,6 +652,25 @@ func (uuo *UserUpdateOne) AddWorth(u int) *UserUpdateOne {
 	return uuo
 }
 
+// SetWorthIfGreater sets the "worth" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (uuo *UserUpdateOne) SetWorthIfGreater(u uint) *UserUpdateOne {
+	uuo.mutation.SetWorthIfGreater(u)
+	return uuo
+}
+
+// BitAndWorth applies a bitwise AND with u on the "worth" field in the database.
+func (uuo *UserUpdateOne) BitAndWorth(u uint) *UserUpdateOne {
+	uuo.mutation.BitAndWorth(u)
+	return uuo
+}
+
+// BitOrWorth applies a bitwise OR with u on the "worth" field in the database.
+func (uuo *UserUpdateOne) BitOrWorth(u uint) *UserUpdateOne {
+	uuo.mutation.BitOrWorth(u)
+	return uuo
+}
+
 // ClearWorth clears the value of the "worth" field.
 func (uuo *UserUpdateOne) ClearWorth() *UserUpdateOne {
 	uuo.mutation.ClearWorth()
@@ -774,6 +901,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			}
 		}
 	}
+	if ps := uuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.Version(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -788,6 +922,27 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldVersion,
 		})
 	}
+	if value, ok := uuo.mutation.VersionIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+	}
+	if value, ok := uuo.mutation.VersionBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+	}
+	if value, ok := uuo.mutation.VersionBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+	}
 	if value, ok := uuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -809,6 +964,27 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldWorth,
 		})
 	}
+	if value, ok := uuo.mutation.WorthIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: user.FieldWorth,
+		})
+	}
+	if value, ok := uuo.mutation.WorthBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: user.FieldWorth,
+		})
+	}
+	if value, ok := uuo.mutation.WorthBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: user.FieldWorth,
+		})
+	}
 	if uuo.mutation.WorthCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint,
@@ -976,7 +1152,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: user.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -6,16 +6,25 @@ package hooks
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
+	entgo "entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/entaudit"
 	"entgo.io/ent/entc/integration/hooks/ent"
 	"entgo.io/ent/entc/integration/hooks/ent/card"
 	"entgo.io/ent/entc/integration/hooks/ent/enttest"
 	"entgo.io/ent/entc/integration/hooks/ent/hook"
 	"entgo.io/ent/entc/integration/hooks/ent/migrate"
+	"entgo.io/ent/entc/integration/hooks/ent/pet"
+	"entgo.io/ent/entc/integration/hooks/ent/schema"
 	"entgo.io/ent/entc/integration/hooks/ent/user"
+	"entgo.io/ent/schema/mixin"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
@@ -394,3 +403,201 @@ func TestConditions(t *testing.T) {
 	client.User.Update().Where(user.ID(alexsn.ID)).AddWorth(100).SaveX(ctx)
 	client.User.DeleteOne(alexsn).ExecX(ctx)
 }
+
+func TestSensitive(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	a8m := client.User.Create().SetName("a8m").SetPassword("a8m-secret").SaveX(ctx)
+	require.Equal(t, "a8m-secret", a8m.Password, "created entities are not queried")
+	client.User.Create().SetName("nati").SetPassword("nati-secret").AddFriends(a8m).SaveX(ctx)
+
+	u := client.User.Query().Where(user.Name("nati")).WithFriends().OnlyX(ctx)
+	require.Empty(t, u.Password, "sensitive fields are not selected by default")
+	require.Empty(t, u.Edges.Friends[0].Password)
+
+	u = client.User.Query().Where(user.Name("nati")).Select(user.FieldName, user.FieldPassword).OnlyX(ctx)
+	require.Equal(t, "nati-secret", u.Password, "sensitive fields can be selected explicitly")
+
+	u = client.User.Query().Where(user.Name("nati")).WithFriends().WithSensitive().OnlyX(ctx)
+	require.Equal(t, "nati-secret", u.Password)
+	require.Equal(t, "a8m-secret", u.Edges.Friends[0].Password, "WithSensitive cascades to eager-loaded edges")
+}
+
+func TestTimestamps(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	p := client.Pet.Create().SetName("pedro").SaveX(ctx)
+	require.False(t, p.CreateTime.IsZero())
+	require.Equal(t, p.CreateTime, p.UpdateTime)
+
+	// Timestamps are shared between mutations executed with the same context.
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	p1 := tx.Pet.Create().SetName("xabi").SaveX(ctx)
+	tctx := mixin.NewTimestampContext(ctx)
+	p2 := tx.Pet.Create().SetName("coco").SaveX(tctx)
+	p3 := tx.Pet.Create().SetName("nala").SaveX(tctx)
+	require.NoError(t, tx.Commit())
+	require.True(t, p2.CreateTime.Equal(p3.CreateTime))
+	require.True(t, p2.UpdateTime.Equal(p3.UpdateTime))
+	require.False(t, p1.CreateTime.After(p2.CreateTime))
+
+	// The update time never goes backwards.
+	future := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+	p2 = client.Pet.UpdateOne(p2).SetUpdateTime(future).SaveX(ctx)
+	p2 = p2.Update().SetName("coco2").SaveX(ctx)
+	require.True(t, p2.UpdateTime.After(future))
+	require.True(t, p2.CreateTime.Equal(p3.CreateTime), "create_time is immutable")
+
+	// Stale entities fail the update.
+	stale := client.Pet.GetX(ctx, p.ID)
+	p.Update().SetName("pedro2").ExecX(ctx)
+	err = stale.Update().SetName("pedro3").Exec(ctx)
+	require.True(t, ent.IsNotFound(err), "stale update_time should fail the update")
+	require.Equal(t, "pedro2", client.Pet.GetX(ctx, p.ID).Name)
+	client.Pet.GetX(ctx, p.ID).Update().SetName("pedro3").ExecX(ctx)
+	require.Equal(t, "pedro3", client.Pet.GetX(ctx, p.ID).Name)
+
+	// Bulk updates set the update time.
+	before := client.Pet.GetX(ctx, p1.ID).UpdateTime
+	client.Pet.Update().Where(pet.ID(p1.ID)).SetName("xabi2").ExecX(ctx)
+	require.True(t, client.Pet.GetX(ctx, p1.ID).UpdateTime.After(before))
+}
+
+func TestIntegrity(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:integrity?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	defer client.Close()
+	p1 := client.Pet.Create().SetName("pedro").SetAge(3).SaveX(ctx)
+	require.NotEmpty(t, p1.Checksum)
+	p2 := client.Pet.Create().SetName("xabi").SaveX(ctx)
+	require.NoError(t, schema.PetIntegrity.VerifyIntegrity(ctx, client.Pet.Query()))
+
+	// Updates of a single entity maintain the checksum.
+	p1 = p1.Update().AddAge(1).SaveX(ctx)
+	p2 = p2.Update().SetAge(1).SaveX(ctx)
+	p2 = p2.Update().ClearAge().SaveX(ctx)
+	require.NoError(t, schema.PetIntegrity.VerifyIntegrity(ctx, client.Pet.Query()))
+
+	// Bulk updates of covered fields are rejected.
+	err = client.Pet.Update().SetAge(10).Exec(ctx)
+	require.EqualError(t, err, `mixin: field "age" is covered by a checksum and cannot be changed by bulk updates`)
+	client.Pet.Update().SetName("coco").ExecX(ctx)
+
+	// Out-of-band changes fail the verification.
+	err = drv.Exec(ctx, "UPDATE pets SET age = 10 WHERE id = ?", []interface{}{p1.ID}, nil)
+	require.NoError(t, err)
+	err = schema.PetIntegrity.VerifyIntegrity(ctx, client.Pet.Query())
+	var ierr *mixin.IntegrityError
+	require.ErrorAs(t, err, &ierr)
+	require.Equal(t, []interface{}{p1.ID}, ierr.IDs)
+	require.NoError(t, schema.PetIntegrity.VerifyIntegrity(ctx, client.Pet.Query().Where(pet.ID(p2.ID))))
+}
+
+func TestAuditLog(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:audit?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	defer client.Close()
+	alog := entaudit.New(drv, entaudit.WithActor(func(context.Context) string { return "a8m" }))
+	require.NoError(t, alog.Create(ctx))
+	client.Pet.Use(alog.Hook())
+
+	p := client.Pet.Create().SetName("pedro").SaveX(ctx)
+	p.Update().SetName("pedro2").ClearAge().ExecX(ctx)
+	client.Pet.Delete().Where(pet.ID(p.ID)).ExecX(ctx)
+	entries, err := alog.Entries(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, "Pet", entries[0].Type)
+	require.Equal(t, "OpCreate", entries[0].Op)
+	require.Equal(t, "a8m", entries[0].Actor)
+	require.Equal(t, "pedro", entries[0].Fields["name"])
+	require.Equal(t, "OpUpdateOne", entries[1].Op)
+	require.Equal(t, []string{pet.FieldAge}, entries[1].Cleared)
+	require.Equal(t, "OpDelete", entries[2].Op)
+	for _, e := range entries {
+		require.Equal(t, []interface{}{float64(p.ID)}, e.IDs)
+	}
+	require.NoError(t, alog.Verify(ctx))
+}
+
+// countExtension counts the mutations, queries and statements of the client.
+type countExtension struct {
+	entgo.DefaultExtension
+	mutations, queries, stmts int
+	err                       error
+}
+
+func (*countExtension) Name() string { return "count" }
+
+func (c *countExtension) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				c.mutations++
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
+
+func (c *countExtension) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				c.queries++
+				return next.Query(ctx, q)
+			})
+		}),
+	}
+}
+
+func (c *countExtension) Driver(drv dialect.Driver) dialect.Driver {
+	return &countDriver{Driver: drv, ext: c}
+}
+
+func (c *countExtension) HealthCheck(context.Context) error { return c.err }
+
+type countDriver struct {
+	dialect.Driver
+	ext *countExtension
+}
+
+func (d *countDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	d.ext.stmts++
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *countDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	d.ext.stmts++
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func TestExtension(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ext := &countExtension{}
+	client.AddExtension(ext)
+	require.NoError(t, client.HealthCheck(ctx))
+
+	p := client.Pet.Create().SetName("pedro").SaveX(ctx)
+	client.Pet.Query().Where(pet.ID(p.ID)).OnlyX(ctx)
+	require.Equal(t, 1, ext.mutations)
+	require.Equal(t, 1, ext.queries)
+	require.Equal(t, 2, ext.stmts)
+
+	// Derived clients inherit the extensions.
+	client.WithOptions().Pet.Query().CountX(ctx)
+	require.Equal(t, 2, ext.queries)
+	require.Equal(t, 3, ext.stmts)
+
+	ext.err = errors.New("unavailable")
+	require.EqualError(t, client.HealthCheck(ctx), `ent: extension "count": unavailable`)
+}
@@ -12,20 +12,30 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"reflect"
 	"runtime"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entstats"
 	"entgo.io/ent/dialect/sql"
 	sqlschema "entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqlidem"
+	"entgo.io/ent/dialect/sql/sqlqueue"
 	"entgo.io/ent/entc/integration/ent"
 	"entgo.io/ent/entc/integration/ent/card"
+	"entgo.io/ent/entc/integration/ent/comment"
+	"entgo.io/ent/entc/integration/ent/entmiddleware"
 	"entgo.io/ent/entc/integration/ent/enttest"
 	"entgo.io/ent/entc/integration/ent/file"
 	"entgo.io/ent/entc/integration/ent/filetype"
@@ -36,9 +46,12 @@ import (
 	"entgo.io/ent/entc/integration/ent/migrate"
 	"entgo.io/ent/entc/integration/ent/node"
 	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/schema"
 	"entgo.io/ent/entc/integration/ent/user"
 	"entgo.io/ent/entc/integration/privacy/ent/task"
+	"entgo.io/ent/entql"
+	"entgo.io/ent/schema/field"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/lib/pq"
@@ -60,6 +73,1053 @@ func TestSQLite(t *testing.T) {
 	}
 }
 
+func QueryLimit(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	for i := 0; i < 5; i++ {
+		client.Pet.Create().SetName(strconv.Itoa(i)).SetOwner(a8m).ExecX(ctx)
+	}
+	for _, p := range []ent.QueryLimitPolicy{{Max: 3}, {Max: 3, Reject: true}} {
+		client := client.WithOptions(ent.QueryLimit(p))
+		pets, err := client.Pet.Query().All(ctx)
+		if p.Reject {
+			require.True(t, ent.IsQueryLimitError(err))
+		} else {
+			require.NoError(t, err)
+			require.Len(t, pets, 3)
+		}
+		// Queries with explicit limits or context overrides.
+		require.Len(t, client.Pet.Query().Limit(4).AllX(ctx), 4)
+		require.Len(t, client.Pet.Query().AllX(ent.SkipQueryLimit(ctx)), 5)
+		require.Len(t, client.Pet.Query().Where(pet.NameIn("1", "2")).AllX(ctx), 2)
+		// The policy does not apply on eager-loaded edges.
+		require.Len(t, client.User.Query().WithPets().OnlyX(ctx).Edges.Pets, 5)
+	}
+}
+
+// slowDriver delays and counts the queries executed by the driver.
+type slowDriver struct {
+	dialect.Driver
+	queries int32
+}
+
+func (d *slowDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	atomic.AddInt32(&d.queries, 1)
+	time.Sleep(100 * time.Millisecond)
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func Singleflight(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	slow := &slowDriver{Driver: client.Driver()}
+	client = ent.NewClient(ent.Driver(slow), ent.Singleflight())
+	c1 := client.Card.Create().SetNumber("1234").SaveX(ctx)
+
+	var wg sync.WaitGroup
+	cards := make([]*ent.Card, 5)
+	atomic.StoreInt32(&slow.queries, 0)
+	for i := range cards {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cards[i] = client.Card.GetX(ctx, c1.ID)
+		}(i)
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), atomic.LoadInt32(&slow.queries))
+	for i := range cards {
+		require.Equal(t, c1.Number, cards[i].Number)
+		if i > 0 {
+			require.False(t, cards[i] == cards[i-1], "callers should get a copy of the entity")
+		}
+	}
+
+	// Different queries are not coalesced.
+	atomic.StoreInt32(&slow.queries, 0)
+	for _, number := range []string{"1234", "5678"} {
+		wg.Add(1)
+		go func(number string) {
+			defer wg.Done()
+			client.Card.Query().Where(card.Number(number)).Only(ctx)
+		}(number)
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), atomic.LoadInt32(&slow.queries))
+	_, err := client.Card.Query().Where(card.Number("5678")).Only(ctx)
+	require.True(t, ent.IsNotFound(err))
+
+	// Arguments with the same formatting are not coalesced.
+	client.Card.Create().SetNumber("a b").ExecX(ctx)
+	client.Card.Create().SetNumber("a").ExecX(ctx)
+	atomic.StoreInt32(&slow.queries, 0)
+	numbers := make([]string, 2)
+	for i, args := range [][]string{{"a b", "c"}, {"a", "b c"}} {
+		wg.Add(1)
+		go func(i int, args []string) {
+			defer wg.Done()
+			numbers[i] = client.Card.Query().Where(card.NumberIn(args...)).OnlyX(ctx).Number
+		}(i, args)
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), atomic.LoadInt32(&slow.queries))
+	require.Equal(t, []string{"a b", "a"}, numbers)
+
+	// Canceling the first caller does not fail the others.
+	atomic.StoreInt32(&slow.queries, 0)
+	cctx, cancel := context.WithCancel(ctx)
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.Card.Get(cctx, c1.ID)
+		errs <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		cards[0] = client.Card.GetX(ctx, c1.ID)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-errs, context.Canceled)
+	wg.Wait()
+	require.Equal(t, c1.Number, cards[0].Number)
+	require.Equal(t, int32(1), atomic.LoadInt32(&slow.queries))
+
+	// Callers with different scopes are not coalesced.
+	type scopeKey struct{}
+	client = ent.NewClient(ent.Driver(slow), ent.SingleflightScope(func(ctx context.Context) string {
+		s, _ := ctx.Value(scopeKey{}).(string)
+		return s
+	}))
+	atomic.StoreInt32(&slow.queries, 0)
+	for _, scope := range []string{"a", "a", "b"} {
+		wg.Add(1)
+		go func(scope string) {
+			defer wg.Done()
+			client.Card.GetX(context.WithValue(ctx, scopeKey{}, scope), c1.ID)
+		}(scope)
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), atomic.LoadInt32(&slow.queries))
+}
+
+// countDriver counts the statements and the transactions executed by the driver.
+type countDriver struct {
+	dialect.Driver
+	stmts int
+}
+
+func (d *countDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	d.stmts++
+	return d.Driver.Tx(ctx)
+}
+
+func (d *countDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	d.stmts++
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *countDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	d.stmts++
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func ReadDriver(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	// Both drivers are connected to the same database, and
+	// are used for checking where statements are executed.
+	primary, replica := &countDriver{Driver: client.Driver()}, &countDriver{Driver: client.Driver()}
+	client = ent.NewClient(ent.Driver(primary), ent.ReadDriver(replica))
+
+	// Mutations are executed on the primary, and queries on the replica.
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	require.NotZero(t, primary.stmts)
+	require.Zero(t, replica.stmts)
+	primary.stmts = 0
+	require.Equal(t, 1, client.User.Query().CountX(ctx))
+	require.Equal(t, []string{"a8m"}, client.User.Query().Select(user.FieldName).StringsX(ctx))
+	require.Equal(t, a8m.ID, client.User.GetX(ctx, a8m.ID).ID)
+	require.Zero(t, primary.stmts)
+	require.Equal(t, 3, replica.stmts)
+
+	// Queries in transactions, or of clients without a read driver, are executed on the primary.
+	replica.stmts = 0
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a8m", tx.User.Query().OnlyX(ctx).Name)
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, "a8m", client.WithOptions(ent.ReadDriver(nil)).User.Query().OnlyX(ctx).Name)
+	require.Equal(t, 2, primary.stmts)
+	require.Zero(t, replica.stmts)
+
+	// Entities that were loaded from the replica are updated on the primary.
+	primary.stmts = 0
+	u := client.User.Query().OnlyX(ctx)
+	require.Equal(t, 1, replica.stmts)
+	u.Update().SetName("updated").ExecX(ctx)
+	require.NotZero(t, primary.stmts)
+	require.Equal(t, 1, replica.stmts)
+	require.Equal(t, "updated", client.User.GetX(ctx, a8m.ID).Name)
+}
+
+func Async(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	require.NoError(t, client.CreateAsyncQueue(ctx))
+	// Remove the tasks that were left in the queue by previous runs.
+	query, args := sql.Dialect(client.Dialect()).Delete(sqlqueue.DefaultTable).Query()
+	_, err := client.ExecContext(ctx, query, args...)
+	require.NoError(t, err)
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+
+	key, err := client.Card.Create().SetNumber("1234").SetOwner(a8m).SaveAsync(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, key)
+	// Mutations with the same key are enqueued once.
+	kctx := ent.WithAsyncKey(ctx, "card-5678")
+	for i := 0; i < 2; i++ {
+		key, err = client.Card.Create().SetNumber("5678").SaveAsync(kctx)
+		require.NoError(t, err)
+		require.Equal(t, "card-5678", key)
+	}
+	require.Zero(t, client.Card.Query().CountX(ctx))
+	n, err := client.ProcessAsync(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, 2, client.Card.Query().CountX(ctx))
+	c1 := client.Card.Query().Where(card.Number("1234")).OnlyX(ctx)
+	require.Equal(t, a8m.ID, c1.QueryOwner().OnlyIDX(ctx))
+	// Executed mutations are not executed again.
+	n, err = client.ProcessAsync(ctx, 10)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	_, err = client.Card.UpdateOne(c1).AddBalance(10).SetName("a8m").ClearOwner().SaveAsync(ctx)
+	require.NoError(t, err)
+	require.Zero(t, client.Card.GetX(ctx, c1.ID).Balance)
+	n, err = client.ProcessAsync(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	c1 = client.Card.GetX(ctx, c1.ID)
+	require.Equal(t, 10.0, c1.Balance)
+	require.Equal(t, "a8m", c1.Name)
+	require.False(t, c1.QueryOwner().ExistX(ctx))
+
+	// Failed mutations are rolled back, and retried later.
+	_, err = client.Card.Create().SetNumber("").SaveAsync(ctx)
+	require.NoError(t, err)
+	n, err = client.ProcessAsync(ctx, 10)
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.Equal(t, 2, client.Card.Query().CountX(ctx))
+	n, err = client.ProcessAsync(ctx, 10)
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func IdempotencyKey(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	require.NoError(t, client.CreateIdempotencyTable(ctx))
+	// Remove the keys that were recorded by previous runs.
+	query, args := sql.Dialect(client.Dialect()).Delete(sqlidem.DefaultTable).Query()
+	_, err := client.ExecContext(ctx, query, args...)
+	require.NoError(t, err)
+
+	c1 := client.Card.Create().SetNumber("1234").IdempotencyKey("k1").SaveX(ctx)
+	// Replays return the original entity.
+	c2 := client.Card.Create().SetNumber("5678").IdempotencyKey("k1").SaveX(ctx)
+	require.Equal(t, c1.ID, c2.ID)
+	require.Equal(t, "1234", c2.Number)
+	require.Equal(t, 1, client.Card.Query().CountX(ctx))
+	// Keys are scoped by entity type.
+	client.Comment.Create().SetUniqueInt(1).SetUniqueFloat(1).IdempotencyKey("k1").ExecX(ctx)
+	require.Equal(t, 1, client.Comment.Query().CountX(ctx))
+
+	// Failed creations do not record their keys.
+	err = client.Card.Create().SetNumber("").IdempotencyKey("k2").Exec(ctx)
+	require.Error(t, err)
+	client.Card.Create().SetNumber("5678").IdempotencyKey("k2").ExecX(ctx)
+	require.Equal(t, 2, client.Card.Query().CountX(ctx))
+
+	// Keys are recorded in the transaction of the builder.
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.Card.Create().SetNumber("9012").IdempotencyKey("k3").ExecX(ctx)
+	require.NoError(t, tx.Rollback())
+	c3 := client.Card.Create().SetNumber("9012").IdempotencyKey("k3").SaveX(ctx)
+	require.Equal(t, "9012", c3.Number)
+	tx, err = client.Tx(ctx)
+	require.NoError(t, err)
+	require.Equal(t, c3.ID, tx.Card.Create().SetNumber("3456").IdempotencyKey("k3").SaveX(ctx).ID)
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 3, client.Card.Query().CountX(ctx))
+}
+
+func TransferOwnership(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nat := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(a8m).ExecX(ctx)
+	client.Card.Create().SetNumber("1234").SetOwner(a8m).ExecX(ctx)
+	inf := client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)
+	hub := client.Group.Create().SetName("GitHub").SetExpire(time.Now()).SetInfo(inf).AddUsers(a8m, nat).SaveX(ctx)
+	lab := client.Group.Create().SetName("GitLab").SetExpire(time.Now()).SetInfo(inf).AddUsers(a8m).SaveX(ctx)
+	client.User.UpdateOne(a8m).AddFollowing(nat).ExecX(ctx)
+
+	err := client.User.TransferOwnership(ctx, a8m.ID, nat.ID, ent.TransferEdges("spouse"))
+	require.EqualError(t, err, `ent: edge "spouse" of type user cannot be transferred`)
+	err = client.User.TransferOwnership(ctx, a8m.ID, a8m.ID+100)
+	require.True(t, ent.IsNotFound(err))
+
+	err = client.User.TransferOwnership(ctx, a8m.ID, nat.ID, ent.TransferSkipEdges(user.EdgeCard))
+	require.NoError(t, err)
+	require.Zero(t, a8m.QueryPets().CountX(ctx))
+	require.Equal(t, 2, nat.QueryPets().CountX(ctx))
+	require.Equal(t, []int{hub.ID, lab.ID}, nat.QueryGroups().Order(ent.Asc(group.FieldID)).IDsX(ctx))
+	require.Zero(t, a8m.QueryGroups().CountX(ctx))
+	require.False(t, a8m.QueryFollowing().ExistX(ctx))
+	require.False(t, nat.QueryFollowing().ExistX(ctx), "users do not follow themselves")
+	require.True(t, a8m.QueryCard().ExistX(ctx), "skipped edge")
+
+	// Failures roll back the transfer.
+	client.Card.Create().SetNumber("5678").SetOwner(nat).ExecX(ctx)
+	client.Pet.Create().SetName("luna").SetOwner(a8m).ExecX(ctx)
+	err = client.User.TransferOwnership(ctx, a8m.ID, nat.ID)
+	require.True(t, ent.IsConstraintError(err), "nati already has a card")
+	require.True(t, a8m.QueryPets().ExistX(ctx))
+	require.True(t, a8m.QueryCard().ExistX(ctx))
+
+	// Transfers within a transaction use it.
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.User.TransferOwnership(ctx, a8m.ID, nat.ID, ent.TransferEdges(user.EdgePets)))
+	require.Equal(t, 3, tx.User.QueryPets(nat).CountX(ctx))
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 2, nat.QueryPets().CountX(ctx))
+}
+
+func Dedup(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	a8m2 := client.User.Create().SetName("a8m").SetAge(30).SetNickname("ariel").SaveX(ctx)
+	a8m3 := client.User.Create().SetName("a8m").SetAge(31).SetPhone("123").SaveX(ctx)
+	nat := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m2).ExecX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(a8m3).ExecX(ctx)
+
+	_, err := client.User.FindDuplicates(ctx)
+	require.Error(t, err)
+	_, err = client.User.FindDuplicates(ctx, "unknown")
+	require.True(t, ent.IsValidationError(err))
+	groups := client.User.FindDuplicatesX(ctx, user.FieldName)
+	require.Equal(t, [][]int{{a8m.ID, a8m2.ID, a8m3.ID}}, groups)
+	groups = client.User.FindDuplicatesX(ctx, user.FieldName, user.FieldAge)
+	require.Equal(t, [][]int{{a8m.ID, a8m2.ID}}, groups)
+	nat2 := client.User.Create().SetName("nati").SetAge(27).SaveX(ctx)
+	groups = client.User.FindDuplicatesX(ctx, user.FieldName)
+	require.Len(t, groups, 2)
+
+	err = client.User.Merge(ctx, a8m.ID, []int{a8m2.ID, a8m.ID})
+	require.EqualError(t, err, "ent: survivor and duplicates of User merge must be distinct")
+	err = client.User.Merge(ctx, a8m.ID, []int{a8m2.ID, a8m3.ID},
+		ent.MergeResolver(user.FieldAge, func(values []ent.Value) ent.Value {
+			return values[len(values)-1]
+		}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 3, client.User.Query().CountX(ctx), "duplicates were deleted")
+	a8m = client.User.GetX(ctx, a8m.ID)
+	require.Equal(t, "ariel", a8m.Nickname, "empty fields are filled from the duplicates")
+	require.Equal(t, "123", a8m.Phone)
+	require.Equal(t, 31, a8m.Age, "resolved by the last duplicate")
+	require.Equal(t, 2, a8m.QueryPets().CountX(ctx))
+	require.Equal(t, [][]int{{nat.ID, nat2.ID}}, client.User.FindDuplicatesX(ctx, user.FieldName))
+}
+
+func FieldMask(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	crd := client.Card.Create().SetNumber("1234").SetName("a8m").SetOwner(a8m).SaveX(ctx)
+
+	c := client.Card.Query().SelectMask("number").OnlyX(ctx)
+	require.Equal(t, crd.ID, c.ID)
+	require.Equal(t, "1234", c.Number)
+	require.Empty(t, c.Name)
+	c = client.Card.Query().SelectMask("id").OnlyX(ctx)
+	require.Equal(t, crd.ID, c.ID)
+	require.Empty(t, c.Number)
+	_, err := client.Card.Query().SelectMask("unknown").All(ctx)
+	require.Error(t, err)
+
+	c = client.Card.Query().WithOwner().SelectMask("number", "owner.name").OnlyX(ctx)
+	require.Equal(t, "1234", c.Number)
+	require.NotNil(t, c.Edges.Owner)
+	masked, err := c.ApplyMask("number", "owner.name")
+	require.NoError(t, err)
+	require.Equal(t, c.ID, masked.ID)
+	require.Equal(t, "1234", masked.Number)
+	require.Equal(t, "a8m", masked.Edges.Owner.Name)
+	require.Zero(t, masked.Edges.Owner.Age)
+	masked, err = c.ApplyMask("name")
+	require.NoError(t, err)
+	require.Empty(t, masked.Number)
+	_, err = masked.Edges.OwnerOrErr()
+	require.True(t, ent.IsNotLoaded(err), "unmasked edges are not loaded")
+	_, err = c.ApplyMask("owner.unknown")
+	require.Error(t, err)
+	masked, err = c.ApplyMask()
+	require.NoError(t, err)
+	require.Equal(t, c.Number, masked.Number)
+}
+
+func Middleware(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	create := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if err := entmiddleware.Client(ctx).Card.Create().SetNumber(r.URL.Query().Get("number")).Exec(ctx); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Has("fail") {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+	serve := func(h http.Handler, target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, target, nil))
+		return rec
+	}
+
+	// Without a transaction, mutations are not rolled back.
+	h := entmiddleware.Handler(client)(create)
+	require.Equal(t, http.StatusConflict, serve(h, "/?number=1&fail").Code)
+	require.Equal(t, 1, client.Card.Query().CountX(ctx))
+	// Transactions are committed or rolled back based on the status.
+	h = entmiddleware.Handler(client, entmiddleware.WithTx())(create)
+	rec := serve(h, "/?number=2")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "ok", rec.Body.String())
+	require.Equal(t, 2, client.Card.Query().CountX(ctx))
+	require.Equal(t, http.StatusConflict, serve(h, "/?number=3&fail").Code)
+	require.Equal(t, 2, client.Card.Query().CountX(ctx))
+	require.Equal(t, http.StatusBadRequest, serve(h, "/?number=").Code)
+	require.Equal(t, 2, client.Card.Query().CountX(ctx))
+
+	// Handlers that return without writing a response commit the transaction.
+	h = entmiddleware.Handler(client, entmiddleware.WithTx())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NotNil(t, entmiddleware.Tx(r.Context()))
+		entmiddleware.Client(r.Context()).Card.Create().SetNumber("4").ExecX(r.Context())
+	}))
+	require.Equal(t, http.StatusOK, serve(h, "/").Code)
+	require.Equal(t, 3, client.Card.Query().CountX(ctx))
+
+	// Panics roll back the transaction.
+	h = entmiddleware.Handler(client, entmiddleware.WithTxIf(func(r *http.Request) bool {
+		return r.Method != http.MethodGet
+	}))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		entmiddleware.Client(r.Context()).Card.Create().SetNumber("5").ExecX(r.Context())
+		panic("oops")
+	}))
+	require.Panics(t, func() { serve(h, "/") })
+	require.Equal(t, 3, client.Card.Query().CountX(ctx))
+}
+
+func QueryStats(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	drv := entstats.NewDriver(client.Driver(), entstats.WithSkip("entgo.io/ent/entc/integration/ent"))
+	client = ent.NewClient(ent.Driver(drv))
+
+	for i := 0; i < 3; i++ {
+		client.User.Create().SetName(fmt.Sprintf("a8m%d", i)).SetAge(30).ExecX(ctx)
+	}
+	client.User.Query().WithPets().AllX(ctx)
+	stats := drv.Stats()
+	require.Len(t, stats, 2)
+	require.Equal(t, int64(3), stats[0].Count())
+	require.Equal(t, int64(2), stats[1].Queries, "eager-loading is attributed to the call site")
+	for _, s := range stats {
+		require.True(t, strings.HasSuffix(s.Func, ".QueryStats"), s.Func)
+		require.True(t, strings.HasPrefix(filepath.Base(s.Site), "integration_test.go:"), s.Site)
+	}
+	var b strings.Builder
+	require.NoError(t, drv.Dump(&b))
+	require.Contains(t, b.String(), stats[0].Site)
+}
+
+func Patch(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetNickname("a").SetPhone("1").SaveX(ctx)
+
+	// The last call between set and clear takes effect.
+	a8m = client.User.UpdateOne(a8m).ClearNickname().SetNickname("b").SaveX(ctx)
+	require.Equal(t, "b", a8m.Nickname)
+	a8m = client.User.UpdateOne(a8m).SetNickname("c").ClearNickname().SaveX(ctx)
+	require.Empty(t, a8m.Nickname)
+
+	var patches []string
+	// Hooks are registered on a derived client, in order to not affect other tests.
+	client = client.WithOptions()
+	client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpUpdateOne) {
+				buf, err := json.Marshal(m.ToPatch())
+				if err != nil {
+					return nil, err
+				}
+				patches = append(patches, string(buf))
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	var p ent.UserPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "Ariel", "phone": null, "password": "secret"}`), &p))
+	require.Equal(t, "Ariel", *p.Name)
+	require.Nil(t, p.Nickname, "fields that are not set")
+	require.True(t, p.FieldCleared(user.FieldPhone))
+	require.False(t, p.FieldCleared(user.FieldNickname))
+	update, err := client.User.UpdateOne(a8m).ApplyPatch(&p)
+	require.NoError(t, err)
+	a8m = update.SaveX(ctx)
+	require.Equal(t, "Ariel", a8m.Name)
+	require.Empty(t, a8m.Phone)
+	require.Equal(t, 30, a8m.Age)
+	require.Equal(t, []string{`{"name":"Ariel","phone":null}`}, patches, "sensitive fields are not encoded")
+
+	err = json.Unmarshal([]byte(`{"age": null}`), &ent.UserPatch{})
+	require.EqualError(t, err, `ent: User field "age" cannot be null`)
+	err = json.Unmarshal([]byte(`{"unknown": 1}`), &ent.UserPatch{})
+	require.EqualError(t, err, `ent: unknown or immutable User field "unknown"`)
+	_, err = client.User.UpdateOne(a8m).ApplyPatch(&ent.UserPatch{Cleared: []string{user.FieldAge}})
+	require.Error(t, err)
+}
+
+func FieldInfo(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetPassword("secret").SaveX(ctx)
+
+	fields := user.Fields()
+	require.Equal(t, user.FieldID, fields[0].Column)
+	info := make(map[string]int)
+	for i, f := range fields {
+		info[f.Name] = i
+	}
+	require.True(t, fields[info[user.FieldPassword]].Sensitive)
+	require.True(t, fields[info[user.FieldNickname]].Optional)
+	require.True(t, fields[info[user.FieldNickname]].Unique)
+	require.Equal(t, field.TypeEnum, fields[info[user.FieldRole]].Type)
+	require.Equal(t, []string{"user", "admin", "free-user", "test user"}, fields[info[user.FieldRole]].Enums)
+	for _, f := range card.Fields() {
+		if f.Name == card.FieldNumber {
+			require.True(t, f.Immutable)
+		}
+	}
+
+	// Export the non-sensitive fields of an entity using reflection.
+	export := make(map[string]interface{})
+	rv := reflect.ValueOf(a8m).Elem()
+	for _, f := range fields {
+		if !f.Sensitive {
+			export[f.Name] = rv.FieldByName(f.StructField).Interface()
+		}
+	}
+	require.Equal(t, "a8m", export[user.FieldName])
+	require.Equal(t, a8m.ID, export[user.FieldID])
+	require.NotContains(t, export, user.FieldPassword)
+
+	// Lineage information is inherited from the schema, and overridden by the fields.
+	require.Equal(t, "identity", fields[info[user.FieldName]].Owner)
+	require.Empty(t, fields[info[user.FieldName]].Classification)
+	require.Equal(t, "identity", fields[info[user.FieldPassword]].Owner)
+	require.Equal(t, "restricted", fields[info[user.FieldPassword]].Classification)
+	require.Equal(t, 90*24*time.Hour, fields[info[user.FieldPassword]].Retention)
+	all := ent.FieldInfos()
+	require.Equal(t, fields, all["User"])
+	require.Contains(t, all, "Card")
+
+	// Modifying the returned slice does not affect the package information.
+	fields[0].Name = "changed"
+	require.Equal(t, "id", user.Fields()[0].Name)
+}
+
+func OrderByField(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	client.User.CreateBulk(
+		client.User.Create().SetName("a").SetAge(1).SetNickname("b"),
+		client.User.Create().SetName("b").SetAge(2).SetNickname("c"),
+		client.User.Create().SetName("c").SetAge(3).SetNickname("a"),
+	).ExecX(ctx)
+	require.Equal(t, []string{user.FieldID, user.FieldNickname, user.FieldPhone}, user.OrderFields)
+
+	dir, err := ent.ParseDirection("desc")
+	require.NoError(t, err)
+	require.Equal(t, ent.DirectionDesc, dir)
+	query := client.User.Query()
+	require.NoError(t, query.OrderByField(user.FieldNickname, dir))
+	require.Equal(t, []string{"b", "a", "c"}, query.Select(user.FieldName).StringsX(ctx))
+
+	query = client.User.Query()
+	err = query.OrderByField(user.FieldName, ent.DirectionAsc)
+	require.True(t, ent.IsValidationError(err), "name is not an order field")
+	require.EqualError(t, err, `ent: field "name" is not allowed for ordering User`)
+	err = query.OrderByField(user.FieldNickname, "up")
+	require.True(t, ent.IsValidationError(err))
+	_, err = ent.ParseDirection("up")
+	require.EqualError(t, err, `ent: invalid order direction "up"`)
+}
+
+func Pagination(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	pets := client.Pet.CreateBulk(
+		client.Pet.Create().SetName("a"),
+		client.Pet.Create().SetName("b"),
+		client.Pet.Create().SetName("b"),
+		client.Pet.Create().SetName("c"),
+		client.Pet.Create().SetName("d"),
+	).SaveX(ctx)
+	ids := func(page *ent.PetPage) []int {
+		ids := make([]int, len(page.Nodes))
+		for i, n := range page.Nodes {
+			ids[i] = n.ID
+		}
+		return ids
+	}
+	two := 2
+
+	t.Log("Paginate forward and backward by ID")
+	page, err := client.Pet.Query().Paginate(ctx, nil, &two, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int{pets[0].ID, pets[1].ID}, ids(page))
+	require.Len(t, page.Cursors, 2)
+	require.Equal(t, ent.PageInfo{HasNextPage: true, StartCursor: &page.Cursors[0], EndCursor: &page.Cursors[1]}, page.PageInfo)
+	page, err = client.Pet.Query().Paginate(ctx, page.PageInfo.EndCursor, &two, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int{pets[2].ID, pets[3].ID}, ids(page))
+	require.True(t, page.PageInfo.HasNextPage)
+	require.True(t, page.PageInfo.HasPreviousPage)
+	page, err = client.Pet.Query().Paginate(ctx, page.PageInfo.EndCursor, &two, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int{pets[4].ID}, ids(page))
+	require.False(t, page.PageInfo.HasNextPage)
+	page, err = client.Pet.Query().Paginate(ctx, nil, nil, nil, &two)
+	require.NoError(t, err)
+	require.Equal(t, []int{pets[3].ID, pets[4].ID}, ids(page))
+	require.True(t, page.PageInfo.HasPreviousPage)
+	require.False(t, page.PageInfo.HasNextPage)
+	page, err = client.Pet.Query().Paginate(ctx, nil, nil, page.PageInfo.StartCursor, &two)
+	require.NoError(t, err)
+	require.Equal(t, []int{pets[1].ID, pets[2].ID}, ids(page))
+	require.True(t, page.PageInfo.HasNextPage)
+
+	t.Log("Paginate by an order field, with the ID as a tie-breaker")
+	byName := ent.PaginateOrder(pet.FieldName, ent.DirectionDesc)
+	page, err = client.Pet.Query().Order(ent.Asc(pet.FieldID)).Paginate(ctx, nil, &two, nil, nil, byName)
+	require.NoError(t, err)
+	require.Equal(t, []int{pets[4].ID, pets[3].ID}, ids(page))
+	page, err = client.Pet.Query().Paginate(ctx, page.PageInfo.EndCursor, &two, nil, nil, byName)
+	require.NoError(t, err)
+	require.Equal(t, []int{pets[2].ID, pets[1].ID}, ids(page))
+	page, err = client.Pet.Query().Where(pet.NameNEQ("a")).Paginate(ctx, page.PageInfo.StartCursor, nil, nil, nil, byName)
+	require.NoError(t, err)
+	require.Equal(t, []int{pets[1].ID}, ids(page))
+	require.False(t, page.PageInfo.HasNextPage)
+
+	t.Log("Invalid arguments and cursors")
+	_, err = client.Pet.Query().Paginate(ctx, nil, &two, nil, &two)
+	require.True(t, ent.IsValidationError(err))
+	_, err = client.Pet.Query().Paginate(ctx, nil, &two, nil, nil, ent.PaginateOrder(pet.FieldAge, ent.DirectionAsc))
+	require.EqualError(t, err, `ent: field "age" is not allowed for paginating Pet`)
+	_, err = client.Pet.Query().Paginate(ctx, page.PageInfo.StartCursor, &two, nil, nil)
+	require.EqualError(t, err, "ent: cursor does not match the order of the pagination")
+	cursor := ent.Cursor("cursor")
+	_, err = client.Pet.Query().Paginate(ctx, &cursor, &two, nil, nil)
+	require.True(t, ent.IsValidationError(err))
+}
+
+func Iterate(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.Pet.CreateBulk(
+		client.Pet.Create().SetName("a").SetOwner(a8m),
+		client.Pet.Create().SetName("b").SetOwner(nati),
+		client.Pet.Create().SetName("c").SetOwner(a8m),
+		client.Pet.Create().SetName("d"),
+		client.Pet.Create().SetName("e").SetOwner(nati),
+	).ExecX(ctx)
+
+	t.Log("Iterate in batches with eager-loading")
+	it, err := client.Pet.Query().WithOwner().Order(ent.Asc(pet.FieldName)).Iterate(ctx, ent.IterateBatch(2))
+	require.NoError(t, err)
+	var names, owners []string
+	for it.Next() {
+		p := it.Value()
+		names = append(names, p.Name)
+		if p.Edges.Owner != nil {
+			owners = append(owners, p.Edges.Owner.Name)
+		}
+	}
+	require.NoError(t, it.Err())
+	require.NoError(t, it.Close())
+	require.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+	require.Equal(t, []string{"a8m", "nati", "a8m", "nati"}, owners)
+	require.Nil(t, it.Value())
+
+	t.Log("Iterate over O2M edges and empty results")
+	users, err := client.User.Query().WithPets().Order(ent.Asc(user.FieldName)).Iterate(ctx)
+	require.NoError(t, err)
+	require.True(t, users.Next())
+	require.Equal(t, a8m.ID, users.Value().ID)
+	require.Len(t, users.Value().Edges.Pets, 2)
+	require.True(t, users.Next())
+	require.Equal(t, nati.ID, users.Value().ID)
+	require.Len(t, users.Value().Edges.Pets, 2)
+	require.False(t, users.Next())
+	require.NoError(t, users.Close())
+	users, err = client.User.Query().Where(user.Name("none")).Iterate(ctx)
+	require.NoError(t, err)
+	require.False(t, users.Next())
+	require.NoError(t, users.Err())
+	require.NoError(t, users.Close())
+
+	t.Log("Invalid options and queries")
+	_, err = client.Pet.Query().Iterate(ctx, ent.IterateBatch(0))
+	require.EqualError(t, err, "ent: invalid iterate batch size 0")
+	_, err = client.Pet.Query().Select("unknown").Iterate(ctx)
+	require.True(t, ent.IsValidationError(err))
+}
+
+func ReadOnlyAPI(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	api := ent.APIContext(ctx)
+	require.True(t, ent.IsAPIContext(api))
+	require.False(t, ent.IsAPIContext(ctx))
+
+	// Fields with default values can be created with their defaults.
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(api)
+	require.Equal(t, user.RoleUser, a8m.Role)
+	_, err := client.User.Create().SetName("nati").SetAge(30).SetRole(user.RoleAdmin).Save(api)
+	require.True(t, ent.IsValidationError(err))
+	require.EqualError(t, err, `ent: field "role" of User is read-only in the API`)
+	_, err = client.User.UpdateOne(a8m).SetRole(user.RoleAdmin).Save(api)
+	require.Error(t, err)
+	_, err = client.User.Update().SetRole(user.RoleAdmin).Save(api)
+	require.Error(t, err)
+	a8m = client.User.UpdateOne(a8m).SetNickname("a8m").SaveX(api)
+
+	// Fields with default functions cannot be set explicitly.
+	it := client.Item.Create().SaveX(api)
+	require.NotEmpty(t, it.Token)
+	_, err = client.Item.Create().SetToken(it.Token).Save(api)
+	require.EqualError(t, err, `ent: field "token" of Item is read-only in the API`)
+	_, err = client.Item.CreateBulk(client.Item.Create(), client.Item.Create().SetToken("token")).Save(api)
+	require.True(t, ent.IsValidationError(err))
+	require.Equal(t, "token", client.Item.Create().SetToken("token").SaveX(ctx).Token)
+
+	// Internal code and hooks can set the field.
+	a8m = client.User.UpdateOne(a8m).SetRole(user.RoleAdmin).SaveX(ctx)
+	require.Equal(t, user.RoleAdmin, a8m.Role)
+	client = client.WithOptions()
+	client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			if name, ok := m.Name(); ok && name == "root" {
+				m.SetRole(user.RoleAdmin)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	root := client.User.Create().SetName("root").SetAge(30).SaveX(api)
+	require.Equal(t, user.RoleAdmin, root.Role)
+
+	// Read-only fields are not part of the public patches.
+	_, ok := reflect.TypeOf(ent.UserPatch{}).FieldByName("Role")
+	require.False(t, ok)
+	var p ent.UserPatch
+	err = json.Unmarshal([]byte(`{"role": "admin"}`), &p)
+	require.EqualError(t, err, `ent: unknown or immutable User field "role"`)
+}
+
+func QueryJSON(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Create().SetName("alex").SetAge(20).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(nati).ExecX(ctx)
+
+	// Client side.
+	buf, err := json.Marshal(&entql.Query{
+		Where: entql.Or(
+			entql.FieldGT(user.FieldAge, 25),
+			entql.HasEdgeWith(user.EdgePets, entql.FieldEQ(pet.FieldName, "xabi")),
+		),
+		Order: []*entql.OrderField{{Field: user.FieldAge, Desc: true}},
+	})
+	require.NoError(t, err)
+
+	// Server side.
+	allow := []entql.ParseOption{
+		entql.AllowFields(user.FieldName, user.FieldAge, user.EdgePets+"."+pet.FieldName),
+		entql.AllowEdges(user.EdgePets),
+		entql.MaxLimit(1),
+	}
+	q, err := entql.ParseQuery(buf, allow...)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a8m"}, client.User.Query().Apply(q).Select(user.FieldName).StringsX(ctx))
+	offset := 1
+	q.Offset = &offset
+	require.Equal(t, []string{"nati"}, client.User.Query().Apply(q).Select(user.FieldName).StringsX(ctx))
+
+	_, err = entql.ParseQuery([]byte(`{"where":{"op":"==","args":[{"field":"password"},{"value":"secret"}]}}`), allow...)
+	require.EqualError(t, err, `entql: field "password" is not allowed`)
+	// Unknown fields are reported on execution for queries that were not parsed.
+	q = &entql.Query{Order: []*entql.OrderField{{Field: "unknown"}}}
+	_, err = client.User.Query().Apply(q).All(ctx)
+	require.Error(t, err)
+}
+
+func Join(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(nati).ExecX(ctx)
+	client.Pet.Create().SetName("orphan").ExecX(ctx)
+
+	rows := client.Pet.Query().
+		Order(ent.Asc(pet.FieldName)).
+		Join(user.Table).
+		On(pet.OwnerColumn, user.FieldID).
+		Select(user.FieldName).
+		AllX(ctx)
+	require.Len(t, rows, 2)
+	require.Equal(t, "pedro", rows[0].Pet.Name)
+	require.Equal(t, a8m.ID, rows[0].User.ID)
+	require.Equal(t, "a8m", rows[0].User.Name)
+	require.Zero(t, rows[0].User.Age, "only the selected columns are scanned")
+	require.Equal(t, "xabi", rows[1].Pet.Name)
+	require.Equal(t, "nati", rows[1].User.Name)
+
+	rows = client.Pet.Query().
+		Where(pet.Name("xabi")).
+		Select(pet.FieldName).
+		Join(user.Table).
+		On(pet.OwnerColumn, user.FieldID).
+		AllX(ctx)
+	require.Len(t, rows, 1)
+	require.Equal(t, "xabi", rows[0].Pet.Name)
+	require.Equal(t, nati.Age, rows[0].User.Age)
+
+	_, err := client.Pet.Query().Join(user.Table).All(ctx)
+	require.Error(t, err, "missing join condition")
+	_, err = client.Pet.Query().Join(group.Table).On(pet.OwnerColumn, group.FieldID).All(ctx)
+	require.Error(t, err, "groups is not a neighbor of pets")
+	_, err = client.Pet.Query().Join(user.Table).On(pet.OwnerColumn, "unknown").All(ctx)
+	require.Error(t, err, "unknown column")
+}
+
+func Projection(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetNickname("a8m").SaveX(ctx)
+	client.User.Create().SetName("nati").SetAge(28).SetNickname("nati").ExecX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+
+	items := client.User.Query().
+		Where(user.AgeGT(25)).
+		Order(ent.Asc(user.FieldAge)).
+		Project(user.FieldID, user.FieldName).
+		AllX(ctx)
+	require.Len(t, items, 2)
+	require.Equal(t, "nati", items[0].Name)
+	require.Equal(t, a8m.ID, items[1].ID)
+	require.Equal(t, "a8m", items[1].Name)
+	require.Zero(t, items[1].Age, "unselected fields hold their zero values")
+	require.Empty(t, items[1].Nickname)
+
+	items = client.User.Query().Where(user.ID(a8m.ID)).Project().AllX(ctx)
+	require.Len(t, items, 1)
+	require.Equal(t, a8m.Age, items[0].Age)
+	require.Equal(t, a8m.Nickname, items[0].Nickname)
+
+	pets := client.Pet.Query().Where(pet.HasOwnerWith(user.ID(a8m.ID))).Project(pet.FieldName).AllX(ctx)
+	require.Len(t, pets, 1)
+	require.Equal(t, "pedro", pets[0].Name)
+
+	_, err := client.User.Query().Project("unknown").All(ctx)
+	require.Error(t, err)
+}
+
+func Selected(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetAge(3).SetOwner(a8m).ExecX(ctx)
+	require.True(t, a8m.Selected(user.FieldAge), "created entities hold all fields")
+
+	p := client.Pet.Query().Select(pet.FieldID, pet.FieldName).OnlyX(ctx)
+	require.True(t, p.Selected(pet.FieldID))
+	require.True(t, p.Selected(pet.FieldName))
+	require.False(t, p.Selected(pet.FieldAge))
+	require.False(t, p.Selected("unknown"))
+	name, err := p.NameOrErr()
+	require.NoError(t, err)
+	require.Equal(t, "pedro", name)
+	_, err = p.AgeOrErr()
+	require.True(t, ent.IsNotSelected(err))
+	require.EqualError(t, err, "ent: pet.age field was not selected")
+
+	p = client.Pet.Query().OnlyX(ctx)
+	require.True(t, p.Selected(pet.FieldAge))
+	age, err := p.AgeOrErr()
+	require.NoError(t, err)
+	require.Equal(t, float64(3), age)
+
+	t.Log("selection of eager-loaded edges")
+	u := client.User.Query().
+		Where(user.ID(a8m.ID)).
+		WithPets(func(q *ent.PetQuery) {
+			q.Select(pet.FieldAge)
+		}).
+		OnlyX(ctx)
+	require.True(t, u.Selected(user.FieldAge))
+	require.Len(t, u.Edges.Pets, 1)
+	require.True(t, u.Edges.Pets[0].Selected(pet.FieldAge))
+	require.False(t, u.Edges.Pets[0].Selected(pet.FieldName))
+}
+
+func NotFoundError(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+
+	_, err := client.User.Get(ctx, a8m.ID+1)
+	require.True(t, errors.Is(err, ent.ErrNotFound))
+	var nf *ent.NotFoundError
+	require.True(t, errors.As(err, &nf))
+	require.Equal(t, user.Label, nf.Label())
+	require.Equal(t, a8m.ID+1, nf.ID())
+	require.EqualError(t, err, fmt.Sprintf("ent: user not found (id=%d)", a8m.ID+1))
+
+	_, err = client.User.Query().Where(user.Name("nati"), user.AgeGT(20)).Only(ctx)
+	require.True(t, errors.As(err, &nf))
+	require.Nil(t, nf.ID())
+	s := sql.Dialect(client.Dialect()).Select().From(sql.Table(user.Table))
+	user.Name("nati")(s)
+	user.AgeGT(20)(s)
+	where, args := s.P().Query()
+	require.Equal(t, []interface{}{"nati", 20}, args)
+	require.Equal(t, where, nf.Predicate(), "arguments are omitted")
+	require.EqualError(t, err, fmt.Sprintf("ent: user not found (where %s)", where))
+	_, err = client.User.Query().Where(user.Name("nati")).FirstID(ctx)
+	require.True(t, ent.IsNotFound(err))
+
+	err = client.User.UpdateOneID(a8m.ID + 1).SetName("nati").Exec(ctx)
+	require.True(t, errors.As(err, &nf))
+	require.EqualValues(t, a8m.ID+1, nf.ID())
+
+	_, err = client.Pet.Query().First(ctx)
+	require.True(t, errors.Is(err, ent.ErrNotFound))
+	require.False(t, errors.Is(err, nf), "not found errors of other entities do not match")
+	require.EqualError(t, err, "ent: pet not found")
+
+	require.Equal(t, http.StatusNotFound, entmiddleware.StatusCode(err))
+	require.EqualValues(t, 5, entmiddleware.GRPCCode(err))
+	_, err = client.Card.Create().SetNumber("").Save(ctx)
+	require.Equal(t, http.StatusBadRequest, entmiddleware.StatusCode(err))
+	require.EqualValues(t, 3, entmiddleware.GRPCCode(err))
+	err = &ent.ConstraintError{}
+	require.Equal(t, http.StatusConflict, entmiddleware.StatusCode(err))
+	require.EqualValues(t, 6, entmiddleware.GRPCCode(err))
+	err = &ent.NotSingularError{}
+	require.Equal(t, http.StatusInternalServerError, entmiddleware.StatusCode(err))
+	require.EqualValues(t, 13, entmiddleware.GRPCCode(err))
+	require.Equal(t, http.StatusOK, entmiddleware.StatusCode(nil))
+	rec := httptest.NewRecorder()
+	entmiddleware.Error(rec, errors.New("secret"))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.NotContains(t, rec.Body.String(), "secret")
+}
+
+func WithTx(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+
+	err := ent.WithTx(ctx, client, func(tx *ent.Tx) error {
+		return tx.User.Create().SetName("a8m").SetAge(30).Exec(ctx)
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, client.User.Query().CountX(ctx))
+
+	errFail := errors.New("fail")
+	err = ent.WithTx(ctx, client, func(tx *ent.Tx) error {
+		tx.User.Create().SetName("nati").SetAge(28).ExecX(ctx)
+		return errFail
+	})
+	require.ErrorIs(t, err, errFail)
+	require.Equal(t, 1, client.User.Query().CountX(ctx), "transaction was rolled back")
+
+	require.PanicsWithValue(t, "boom", func() {
+		_ = ent.WithTx(ctx, client, func(tx *ent.Tx) error {
+			tx.User.Create().SetName("nati").SetAge(28).ExecX(ctx)
+			panic("boom")
+		})
+	})
+	require.Equal(t, 1, client.User.Query().CountX(ctx), "transaction was rolled back on panic")
+
+	cctx, cancel := context.WithCancel(ctx)
+	err = ent.WithTx(cctx, client, func(tx *ent.Tx) error {
+		cancel()
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	// Rollback errors are joined to the error of the function.
+	err = ent.WithTx(ctx, client, func(tx *ent.Tx) error {
+		tx.OnRollback(func(next ent.Rollbacker) ent.Rollbacker {
+			return ent.RollbackFunc(func(ctx context.Context, tx *ent.Tx) error {
+				if err := next.Rollback(ctx, tx); err != nil {
+					return err
+				}
+				return errors.New("rollback failed")
+			})
+		})
+		return errFail
+	})
+	var rerr *ent.RollbackError
+	require.ErrorAs(t, err, &rerr)
+	require.ErrorIs(t, err, errFail)
+	require.EqualError(t, rerr.RollbackErr, "rollback failed")
+
+	// Nested transactions are executed within savepoints.
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback()
+	err = ent.WithTx(ctx, tx.Client(), func(tx *ent.Tx) error {
+		tx.Node.Create().ExecX(ctx)
+		return errFail
+	})
+	require.ErrorIs(t, err, errFail)
+	require.Zero(t, tx.Node.Query().CountX(ctx), "savepoint should be rolled back")
+	require.NoError(t, ent.WithTx(ctx, tx.Client(), func(*ent.Tx) error { return nil }))
+}
+
+func Clock(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	now := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	client = client.WithOptions(ent.Clock(func() time.Time { return now }))
+
+	c := client.Card.Create().SetNumber("1234").SaveX(ctx)
+	require.True(t, now.Equal(c.CreateTime))
+	require.True(t, now.Equal(c.UpdateTime))
+	now = now.Add(time.Hour)
+	c = c.Update().SetName("a8m").SaveX(ctx)
+	require.True(t, now.Add(-time.Hour).Equal(c.CreateTime))
+	require.True(t, now.Equal(c.UpdateTime))
+
+	// Explicit values are not replaced by the clock.
+	at := now.Add(-24 * time.Hour)
+	c = client.Card.Create().SetNumber("5678").SetCreateTime(at).SaveX(ctx)
+	require.True(t, at.Equal(c.CreateTime))
+}
+
 func TestMySQL(t *testing.T) {
 	for version, port := range map[string]int{"56": 3306, "57": 3307, "8": 3308} {
 		addr := net.JoinHostPort("localhost", strconv.Itoa(port))
@@ -136,6 +1196,16 @@ var (
 		ExecQuery,
 		Predicate,
 		AddValues,
+		FieldOps,
+		ConditionalUpdate,
+		CreateFromQuery,
+		MutationJoin,
+		Truncate,
+		CreateBulkContinueOnError,
+		EagerLoadJoin,
+		GetOrCreate,
+		LockOrCreate,
+		EdgeOrder,
 		ClearEdges,
 		ClearFields,
 		UniqueConstraint,
@@ -152,9 +1222,36 @@ var (
 		Sensitive,
 		EagerLoading,
 		NamedEagerLoading,
+		QueryLimit,
+		Singleflight,
+		ReadDriver,
+		Async,
+		IdempotencyKey,
+		TransferOwnership,
+		Dedup,
+		FieldMask,
+		Middleware,
+		QueryStats,
+		Patch,
+		FieldInfo,
+		OrderByField,
+		Pagination,
+		Iterate,
+		ReadOnlyAPI,
+		QueryJSON,
+		Join,
+		Projection,
+		Selected,
+		NotFoundError,
+		WithTx,
+		Clock,
 		Mutation,
 		CreateBulk,
 		ConstraintChecks,
+		Diff,
+		TimeBucket,
+		GroupByEdges,
+		Estimate,
 	}
 )
 
@@ -351,10 +1448,27 @@ func Upsert(t *testing.T, client *ent.Client) {
 	u = client.User.GetX(ctx, id)
 	require.Equal(t, 32, u.Age, "age was modified by the UPDATE clause")
 
+	// Values that are set on the UPDATE clause are validated.
+	err = client.User.Create().
+		SetName("Boring").
+		SetAge(33).
+		SetPhone("0000").
+		OnConflictColumns(user.FieldPhone).
+		SetOptionalInt(-1).
+		AddAge(1).
+		Exec(ctx)
+	require.True(t, ent.IsValidationError(err), "optional_int must be positive")
+	require.Equal(t, 32, client.User.GetX(ctx, id).Age, "the upsert was not executed")
+
 	builders := []*ent.UserCreate{
 		client.User.Create().SetName("A").SetAge(1).SetPhone("0000"), // Duplicate
 		client.User.Create().SetName("B").SetAge(1).SetPhone("1111"), // New row.
 	}
+	err = client.User.CreateBulk(builders...).
+		OnConflictColumns(user.FieldPhone).
+		SetRole("unknown").
+		Exec(ctx)
+	require.True(t, ent.IsValidationError(err), "role must be a valid enum value")
 	client.User.CreateBulk(builders...).
 		OnConflictColumns(user.FieldPhone).
 		UpdateNewValues().
@@ -852,6 +1966,403 @@ func AddValues(t *testing.T, client *ent.Client) {
 	require.Equal(30, client.Comment.GetX(ctx, cmt1.ID).UniqueInt)
 }
 
+func FieldOps(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	t.Log("set fields only if greater")
+	cmt := client.Comment.Create().SetUniqueInt(10).SetUniqueFloat(1).SaveX(ctx)
+	cmt = cmt.Update().SetUniqueIntIfGreater(5).SetUniqueFloatIfGreater(1.5).SaveX(ctx)
+	require.Equal(10, cmt.UniqueInt)
+	require.Equal(1.5, cmt.UniqueFloat)
+	cmt = cmt.Update().SetUniqueIntIfGreater(15).SetUniqueIntIfGreater(12).SaveX(ctx)
+	require.Equal(15, cmt.UniqueInt)
+	cmt = cmt.Update().SetNillableIntIfGreater(-1).SaveX(ctx)
+	require.Equal(-1, *cmt.NillableInt, "null values are always set")
+
+	t.Log("combine operations on the same field")
+	cmt = cmt.Update().AddUniqueInt(10).SetUniqueIntIfGreater(20).SaveX(ctx)
+	require.Equal(25, cmt.UniqueInt)
+	cmt = cmt.Update().AddUniqueInt(-10).SetUniqueIntIfGreater(20).SaveX(ctx)
+	require.Equal(20, cmt.UniqueInt)
+
+	t.Log("bitwise operations")
+	cmt = cmt.Update().SetNillableInt(0b0101).SaveX(ctx)
+	cmt = cmt.Update().BitOrNillableInt(0b0010).SaveX(ctx)
+	require.Equal(0b0111, *cmt.NillableInt)
+	cmt = cmt.Update().BitAndNillableInt(0b0110).SaveX(ctx)
+	require.Equal(0b0110, *cmt.NillableInt)
+	cmt = cmt.Update().BitAndNillableInt(0b0100).BitOrNillableInt(0b1000).SaveX(ctx)
+	require.Equal(0b1100, *cmt.NillableInt)
+	require.Equal(0b1100, *client.Comment.GetX(ctx, cmt.ID).NillableInt)
+
+	t.Log("update multiple rows")
+	n := client.Comment.Update().Where(comment.ID(cmt.ID)).BitOrNillableInt(0b0001).SetUniqueIntIfGreater(30).SaveX(ctx)
+	require.Equal(1, n)
+	cmt = client.Comment.GetX(ctx, cmt.ID)
+	require.Equal(0b1101, *cmt.NillableInt)
+	require.Equal(30, cmt.UniqueInt)
+
+	t.Log("set overrides other operations")
+	cmt = cmt.Update().SetUniqueIntIfGreater(100).SetUniqueInt(40).SaveX(ctx)
+	require.Equal(40, cmt.UniqueInt)
+}
+
+func ConditionalUpdate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	u := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	u = client.User.UpdateOneID(u.ID).Where(user.Name("a8m")).SetAge(31).SaveX(ctx)
+	require.Equal(31, u.Age)
+
+	t.Log("entity does not match the conditions")
+	err := client.User.UpdateOneID(u.ID).Where(user.Name("nati")).SetAge(32).Exec(ctx)
+	require.True(ent.IsConflict(err))
+	require.False(ent.IsNotFound(err))
+	require.EqualError(err, fmt.Sprintf("ent: user does not match the conditions of the update (id=%d)", u.ID))
+	require.Equal(31, client.User.GetX(ctx, u.ID).Age)
+	err = u.Update().Where(user.Name("a8m"), user.AgeGT(31)).SetAge(32).Exec(ctx)
+	require.True(ent.IsConflict(err))
+
+	t.Log("entity matches the conditions, but was not changed")
+	u = u.Update().Where(user.Age(31)).SetAge(31).SaveX(ctx)
+	require.Equal(31, u.Age)
+
+	t.Log("entity does not exist")
+	err = client.User.UpdateOneID(u.ID + 1000).Where(user.Name("a8m")).SetAge(32).Exec(ctx)
+	require.True(ent.IsNotFound(err))
+}
+
+func CreateFromQuery(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	client.User.Create().SetName("a8m").SetAge(30).ExecX(ctx)
+	client.User.Create().SetName("nati").SetAge(28).ExecX(ctx)
+	client.User.Create().SetName("ariel").SetAge(18).ExecX(ctx)
+	n := client.Pet.CreateFromQuery(client.User.Query().Where(user.AgeGT(20)).Select(user.FieldName, user.FieldAge)).ExecX(ctx)
+	require.Equal(2, n)
+	pets := client.Pet.Query().Order(ent.Asc(pet.FieldName)).AllX(ctx)
+	require.Len(pets, 2)
+	require.Equal("a8m", pets[0].Name)
+	require.Equal(float64(30), pets[0].Age)
+	require.Equal("nati", pets[1].Name)
+	require.Equal(float64(28), pets[1].Age)
+
+	t.Log("selected columns are mapped to the given columns by their order")
+	n = client.Pet.CreateFromQuery(client.User.Query().Where(user.Name("ariel")).Select(user.FieldName, user.FieldName)).
+		Columns(pet.FieldName, pet.FieldNickname).
+		ExecX(ctx)
+	require.Equal(1, n)
+	p := client.Pet.Query().Where(pet.Nickname("ariel")).OnlyX(ctx)
+	require.Equal("ariel", p.Name)
+	require.Zero(p.Age, "database default")
+
+	t.Log("queries with traversals")
+	client.Pet.Update().Where(pet.Name("a8m")).SetOwner(client.User.Query().Where(user.Name("a8m")).OnlyX(ctx)).ExecX(ctx)
+	n = client.Pet.CreateFromQuery(client.User.Query().Where(user.Name("a8m")).QueryPets().Select(pet.FieldName)).ExecX(ctx)
+	require.Equal(1, n)
+	require.Equal(2, client.Pet.Query().Where(pet.Name("a8m")).CountX(ctx))
+
+	_, err := client.Pet.CreateFromQuery(client.User.Query().Select(user.FieldName)).Columns("unknown").Exec(ctx)
+	require.True(ent.IsValidationError(err))
+	require.EqualError(err, `ent: invalid column "unknown" for Pet.CreateFromQuery`)
+	_, err = client.Pet.CreateFromQuery(client.User.Query().Select("unknown")).Exec(ctx)
+	require.True(ent.IsValidationError(err))
+}
+
+func MutationJoin(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(20).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(a8m).ExecX(ctx)
+	client.Pet.Create().SetName("coco").SetOwner(nati).ExecX(ctx)
+	client.Pet.Create().SetName("luna").ExecX(ctx)
+
+	n := client.Pet.Update().
+		From(user.Table, pet.OwnerColumn, user.FieldID, user.AgeGTE(30)).
+		SetAge(10).
+		SaveX(ctx)
+	require.Equal(2, n)
+	require.Equal([]string{"pedro", "xabi"}, client.Pet.Query().Where(pet.Age(10)).Order(ent.Asc(pet.FieldName)).Select(pet.FieldName).StringsX(ctx))
+
+	n = client.Pet.Delete().
+		Using(user.Table, pet.OwnerColumn, user.FieldID, user.Name("nati")).
+		ExecX(ctx)
+	require.Equal(1, n)
+	require.Equal([]string{"luna", "pedro", "xabi"}, client.Pet.Query().Order(ent.Asc(pet.FieldName)).Select(pet.FieldName).StringsX(ctx))
+
+	t.Log("updates with edges query the ids of the joined entities once")
+	n = client.User.Update().
+		From(pet.Table, user.FieldID, pet.OwnerColumn, pet.AgeGT(5)).
+		AddFriends(nati).
+		SaveX(ctx)
+	require.Equal(1, n)
+	require.Equal(nati.ID, a8m.QueryFriends().OnlyIDX(ctx))
+
+	t.Log("joins without predicates match all joined entities")
+	n = client.Pet.Delete().Using(user.Table, pet.OwnerColumn, user.FieldID).ExecX(ctx)
+	require.Equal(2, n)
+	require.Equal("luna", client.Pet.Query().Select(pet.FieldName).StringX(ctx))
+}
+
+func Truncate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(a8m).ExecX(ctx)
+
+	require.NoError(client.Pet.Truncate(ctx))
+	require.Zero(client.Pet.Query().CountX(ctx))
+	require.Equal(1, client.User.Query().CountX(ctx))
+	pedro := client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	require.Equal(1, pedro.ID, "identity sequence should be reset")
+
+	// SQLite deletes the rows instead of truncating the table, and
+	// therefore, it applies the ON DELETE actions of the foreign-keys.
+	if !strings.Contains(t.Name(), "SQLite") {
+		err := client.User.Truncate(ctx)
+		require.Error(err, "users table is referenced by the pets table")
+		require.Equal(1, client.User.Query().CountX(ctx))
+	}
+
+	require.NoError(client.User.Truncate(ctx, ent.Cascade))
+	require.Zero(client.User.Query().CountX(ctx))
+	require.Zero(client.Pet.Query().CountX(ctx))
+
+	a8m = client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	require.Equal(1, a8m.ID)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+	client.Group.Create().SetName("GitHub").SetExpire(time.Now()).AddUsers(a8m).SetInfo(client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)).ExecX(ctx)
+	require.NoError(client.ResetAll(ctx))
+	require.Zero(client.User.Query().CountX(ctx))
+	require.Zero(client.Pet.Query().CountX(ctx))
+	require.Zero(client.Group.Query().CountX(ctx))
+	require.Zero(client.GroupInfo.Query().CountX(ctx))
+}
+
+func CreateBulkContinueOnError(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	results, err := client.User.CreateBulk(
+		client.User.Create().SetName("a8m").SetAge(30).SetNickname("a"),
+		client.User.Create().SetName("nati").SetAge(28).SetNickname("a"),
+		client.User.Create().SetAge(1),
+		client.User.Create().SetName("alex").SetAge(20).SetNickname("b"),
+	).ContinueOnError(ctx)
+	require.NoError(err)
+	require.Len(results, 4)
+	require.NoError(results[0].Err)
+	require.Equal("a8m", results[0].Node.Name)
+	require.True(ent.IsConstraintError(results[1].Err))
+	require.Nil(results[1].Node)
+	require.True(ent.IsValidationError(results[2].Err))
+	require.NoError(results[3].Err)
+	require.Equal([]string{"a8m", "alex"}, client.User.Query().Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx))
+	require.Zero(results[0].Node.QueryCard().CountX(ctx), "entities are bound to the client, and not to the closed transaction")
+
+	t.Log("rows of a transactional client are created in savepoints of its transaction")
+	tx, err := client.Tx(ctx)
+	require.NoError(err)
+	results, err = tx.User.CreateBulk(
+		tx.User.Create().SetName("nati").SetAge(28).SetNickname("b"),
+		tx.User.Create().SetName("nati").SetAge(28).SetNickname("c"),
+	).ContinueOnError(ctx)
+	require.NoError(err)
+	require.Error(results[0].Err)
+	require.NoError(results[1].Err)
+	require.Equal(3, tx.User.Query().CountX(ctx))
+	require.NoError(tx.Rollback())
+	require.Equal(2, client.User.Query().CountX(ctx))
+
+	t.Log("panics roll back the savepoint and the transaction")
+	require.Panics(func() {
+		_, _ = client.User.CreateBulk(
+			client.User.Create().SetName("nati").SetAge(28).SetNickname("c"),
+			nil,
+		).ContinueOnError(ctx)
+	})
+	require.Equal(2, client.User.Query().CountX(ctx))
+}
+
+func EagerLoadJoin(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(nati).ExecX(ctx)
+	client.Pet.Create().SetName("coco").ExecX(ctx)
+	join := func(q *ent.UserQuery) { q.LoadStrategy(ent.LoadStrategyJoin) }
+
+	pets := client.Pet.Query().WithOwner(join).Order(ent.Asc(pet.FieldName)).AllX(ctx)
+	require.Len(pets, 3)
+	_, err := pets[0].Edges.OwnerOrErr()
+	require.True(ent.IsNotFound(err), "edge was loaded, but it does not exist")
+	require.Equal(a8m.ID, pets[1].Edges.Owner.ID)
+	require.Equal(a8m.Name, pets[1].Edges.Owner.Name)
+	require.Equal(a8m.Age, pets[1].Edges.Owner.Age)
+	require.Equal(nati.ID, pets[2].Edges.Owner.ID)
+	require.Zero(pets[1].Edges.Owner.QueryPets().Where(pet.Name("xabi")).CountX(ctx), "owners are bound to the client")
+	expected := client.Pet.Query().WithOwner().Order(ent.Asc(pet.FieldName)).AllX(ctx)
+	for i := range pets[1:] {
+		require.Equal(expected[i+1].String(), pets[i+1].String())
+		require.Equal(expected[i+1].Edges.Owner.String(), pets[i+1].Edges.Owner.String())
+	}
+
+	t.Log("predicates and selected fields of the edge query are applied on the joined table")
+	pets = client.Pet.Query().
+		WithOwner(func(q *ent.UserQuery) {
+			q.Where(user.Name("nati")).Select(user.FieldName).LoadStrategy(ent.LoadStrategyJoin)
+		}).
+		Order(ent.Asc(pet.FieldName)).
+		AllX(ctx)
+	require.Nil(pets[1].Edges.Owner)
+	require.Equal("nati", pets[2].Edges.Owner.Name)
+	require.Zero(pets[2].Edges.Owner.Age)
+	p := client.Pet.Query().Where(pet.Name("xabi")).WithOwner(join).Select(pet.FieldName).OnlyX(ctx)
+	require.Equal(nati.ID, p.Edges.Owner.ID)
+
+	t.Log("edge queries that cannot be joined fall back to an additional query")
+	p = client.Pet.Query().
+		Where(pet.Name("pedro")).
+		WithOwner(func(q *ent.UserQuery) {
+			q.WithPets().LoadStrategy(ent.LoadStrategyJoin)
+		}).
+		OnlyX(ctx)
+	require.Equal(a8m.ID, p.Edges.Owner.ID)
+	require.Len(p.Edges.Owner.Edges.Pets, 1)
+
+	t.Log("joined edges can be loaded by eager-loaded queries")
+	owners := client.User.Query().
+		WithPets(func(q *ent.PetQuery) {
+			q.WithOwner(join)
+		}).
+		Order(ent.Asc(user.FieldName)).
+		AllX(ctx)
+	require.Len(owners, 2)
+	require.Equal(a8m.ID, owners[0].Edges.Pets[0].Edges.Owner.ID)
+	require.Equal(nati.ID, owners[1].Edges.Pets[0].Edges.Owner.ID)
+}
+
+func GetOrCreate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	set := func(name, nickname string) func(*ent.UserCreate) {
+		return func(c *ent.UserCreate) { c.SetName(name).SetAge(30).SetNickname(nickname) }
+	}
+	a8m, err := client.User.GetOrCreate(ctx, []predicate.User{user.Nickname("a8m")}, set("a8m", "a8m"))
+	require.NoError(err)
+	require.Equal("a8m", a8m.Name)
+	u, err := client.User.GetOrCreate(ctx, []predicate.User{user.Nickname("a8m")}, set("ariel", "a8m"))
+	require.NoError(err)
+	require.Equal(a8m.ID, u.ID)
+	require.Equal("a8m", u.Name)
+	require.Equal(1, client.User.Query().CountX(ctx))
+
+	t.Log("entities that were created concurrently are returned on conflicts")
+	var concurrent *ent.User
+	u, err = client.User.GetOrCreate(ctx, []predicate.User{user.Nickname("nati")}, func(c *ent.UserCreate) {
+		concurrent = client.User.Create().SetName("nati").SetAge(30).SetNickname("nati").SaveX(ctx)
+		set("natan", "nati")(c)
+	})
+	require.NoError(err)
+	require.Equal(concurrent.ID, u.ID)
+	require.Equal("nati", u.Name)
+	_, err = client.User.GetOrCreate(ctx, []predicate.User{user.Nickname("nati"), user.Name("natan")}, set("natan", "nati"))
+	require.True(ent.IsConstraintError(err), "conflicting entities that do not match the predicates are not returned")
+
+	t.Log("FirstOrCreate does not fail if more than one entity matches")
+	_, err = client.User.GetOrCreate(ctx, []predicate.User{user.Age(30)}, set("alex", "alex"))
+	require.True(ent.IsNotSingular(err))
+	u, err = client.User.FirstOrCreate(ctx, []predicate.User{user.Age(30)}, set("alex", "alex"))
+	require.NoError(err)
+	require.Equal(30, u.Age)
+	require.Equal(2, client.User.Query().CountX(ctx))
+
+	t.Log("conflicts do not abort transactions")
+	tx, err := client.Tx(ctx)
+	require.NoError(err)
+	u, err = tx.User.GetOrCreate(ctx, []predicate.User{user.Nickname("alex")}, func(c *ent.UserCreate) {
+		concurrent = tx.User.Create().SetName("alex").SetAge(20).SetNickname("alex").SaveX(ctx)
+		set("alexs", "alex")(c)
+	})
+	require.NoError(err)
+	require.Equal(concurrent.ID, u.ID)
+	require.Equal(3, tx.User.Query().CountX(ctx))
+	require.NoError(tx.Commit())
+	require.Equal(3, client.User.Query().CountX(ctx))
+}
+
+func LockOrCreate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	ps := []predicate.User{user.Nickname("a8m")}
+	set := func(c *ent.UserCreate) { c.SetName("a8m").SetAge(30).SetNickname("a8m") }
+	_, err := client.User.LockOrCreate(ctx, ps, set)
+	require.EqualError(err, "ent: LockOrCreate must be called on a transactional client")
+
+	tx, err := client.Tx(ctx)
+	require.NoError(err)
+	a8m, err := tx.User.LockOrCreate(ctx, ps, set)
+	require.NoError(err)
+	require.Equal("a8m", a8m.Name)
+	u, err := tx.User.LockOrCreate(ctx, ps, set)
+	require.NoError(err)
+	require.Equal(a8m.ID, u.ID)
+	require.NoError(tx.Commit())
+	require.Equal(1, client.User.Query().CountX(ctx))
+
+	t.Log("existing entities are locked and returned")
+	tx, err = client.Tx(ctx)
+	require.NoError(err)
+	u, err = tx.User.LockOrCreate(ctx, ps, func(c *ent.UserCreate) { c.SetName("ariel").SetAge(30).SetNickname("a8m") })
+	require.NoError(err)
+	require.Equal(a8m.ID, u.ID)
+	require.Equal("a8m", u.Name)
+	u.Update().SetAge(31).ExecX(ctx)
+	require.NoError(tx.Commit())
+	require.Equal(31, client.User.GetX(ctx, a8m.ID).Age)
+}
+
+func EdgeOrder(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	users := client.User.CreateBulk(
+		client.User.Create().SetName("a").SetAge(1),
+		client.User.Create().SetName("c").SetAge(1),
+		client.User.Create().SetName("b").SetAge(1),
+		client.User.Create().SetName("c").SetAge(2),
+	).SaveX(ctx)
+	inf := client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)
+	hub := client.Group.Create().SetName("GitHub").SetExpire(time.Now()).SetInfo(inf).AddUsers(users...).SaveX(ctx)
+	names := func(us []*ent.User) (s []string) {
+		for _, u := range us {
+			s = append(s, u.Name)
+		}
+		return s
+	}
+
+	t.Log("edges are ordered by their default order (name descending, and id ascending)")
+	ordered := hub.QueryUsers().AllX(ctx)
+	require.Equal([]string{"c", "c", "b", "a"}, names(ordered))
+	require.Equal([]int{users[1].ID, users[3].ID}, []int{ordered[0].ID, ordered[1].ID})
+	require.Equal(names(ordered), names(client.Group.Query().QueryUsers().AllX(ctx)))
+	require.Equal(users[1].ID, hub.QueryUsers().FirstX(ctx).ID)
+	groups := client.Group.Query().WithUsers().AllX(ctx)
+	require.Equal(names(ordered), names(groups[0].Edges.Users))
+
+	t.Log("queries that are ordered explicitly are not affected by the default order of the edge")
+	require.Equal([]string{"a", "b", "c", "c"}, names(hub.QueryUsers().Order(ent.Asc(user.FieldName)).AllX(ctx)))
+	groups = client.Group.Query().
+		WithUsers(func(q *ent.UserQuery) {
+			q.Order(ent.Asc(user.FieldName))
+		}).
+		AllX(ctx)
+	require.Equal([]string{"a", "b", "c", "c"}, names(groups[0].Edges.Users))
+}
+
 func Delete(t *testing.T, client *ent.Client) {
 	require := require.New(t)
 	ctx := context.Background()
@@ -1460,9 +2971,32 @@ func Tx(t *testing.T, client *ent.Client) {
 		m.On("onRollback", nil).Once()
 		defer m.AssertExpectations(t)
 		tx.OnRollback(m.rHook())
-		_, err = tx.Client().Tx(ctx)
-		require.Error(t, err, "cannot start a transaction within a transaction")
+		tx.Node.Create().SetValue(101).ExecX(ctx)
+
+		// Rolling back a nested transaction discards only its changes.
+		nested, err := tx.Client().Tx(ctx)
+		require.NoError(t, err)
+		nested.Node.Create().SetValue(102).ExecX(ctx)
+		require.Equal(t, 2, nested.Node.Query().Where(node.ValueGT(100)).CountX(ctx))
+		require.NoError(t, nested.Rollback())
+		require.Equal(t, []int{101}, tx.Node.Query().Where(node.ValueGT(100)).Select(node.FieldValue).IntsX(ctx))
+
+		// Committing a nested transaction releases its changes to the parent.
+		err = ent.WithTx(ctx, tx.Client(), func(tx *ent.Tx) error {
+			if err := tx.Node.Create().SetValue(103).Exec(ctx); err != nil {
+				return err
+			}
+			return ent.WithTx(ctx, tx.Client(), func(tx *ent.Tx) error {
+				return tx.Node.Create().SetValue(104).Exec(ctx)
+			})
+		})
+		require.NoError(t, err)
+		require.Equal(t, []int{101, 103, 104}, tx.Node.Query().Where(node.ValueGT(100)).Order(ent.Asc(node.FieldValue)).Select(node.FieldValue).IntsX(ctx))
+
+		_, err = tx.Client().BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+		require.Error(t, err, "cannot start a transaction with options within a transaction")
 		require.NoError(t, tx.Rollback())
+		require.Zero(t, client.Node.Query().Where(node.ValueGT(100)).CountX(ctx), "rollback should discard the changes of the nested transactions")
 	})
 	t.Run("TxOptions Rollback", func(t *testing.T) {
 		skip(t, "SQLite")
@@ -1502,6 +3036,24 @@ func Tx(t *testing.T, client *ent.Client) {
 		require.NoError(t, tx.Commit())
 		require.NoError(t, err)
 	})
+	t.Run("Snapshot", func(t *testing.T) {
+		a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+		client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+		snap, err := client.Snapshot(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, snap.User.Query().CountX(ctx))
+		// SQLite transactions lock the database for writers in other connections.
+		if !strings.HasPrefix(t.Name(), "TestSQLite") {
+			client.User.Create().SetName("nati").SetAge(30).SaveX(ctx)
+			client.Pet.Create().SetName("xabi").SetOwner(a8m).ExecX(ctx)
+			require.Error(t, snap.Pet.Create().SetName("lola").Exec(ctx), "expect creation to fail in read-only snapshot")
+		}
+		require.Equal(t, 1, snap.User.Query().CountX(ctx), "snapshot should not see later changes")
+		require.Equal(t, []string{"pedro"}, snap.User.Query().QueryPets().Select(pet.FieldName).StringsX(ctx))
+		require.NoError(t, snap.Rollback())
+		_, err = snap.Client().Snapshot(ctx)
+		require.Error(t, err, "cannot start a snapshot within a transaction")
+	})
 }
 
 func DefaultValue(t *testing.T, client *ent.Client) {
@@ -2144,3 +3696,195 @@ func drop(t *testing.T, client *ent.Client) {
 	client.FieldType.Delete().ExecX(ctx)
 	client.FileType.Delete().ExecX(ctx)
 }
+
+func Diff(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	c1 := client.Comment.Create().SetUniqueInt(1).SetUniqueFloat(1).SetNillableInt(1).SaveX(ctx)
+	c2 := client.Comment.Create().SetUniqueInt(2).SetUniqueFloat(2).SetTable("t").SaveX(ctx)
+	changes := c1.Diff(c2)
+	require.Equal([]ent.FieldChange{
+		{Field: comment.FieldUniqueInt, Old: 1, New: 2},
+		{Field: comment.FieldUniqueFloat, Old: 1.0, New: 2.0},
+		{Field: comment.FieldNillableInt, Old: 1, New: nil},
+		{Field: comment.FieldTable, Old: "", New: "t"},
+	}, changes)
+	require.Empty(c1.Diff(c1))
+
+	// Apply all changes, except the unique ones, on the first comment.
+	_, err := c1.Update().SetChanged([]ent.FieldChange{{Field: "unknown", New: 1}})
+	require.Error(err)
+	u, err := c1.Update().SetChanged(changes[2:])
+	require.NoError(err)
+	c1 = u.SaveX(ctx)
+	require.Nil(c1.NillableInt)
+	require.Equal("t", c1.Table)
+
+	// Immutable fields cannot be changed.
+	crd1 := client.Card.Create().SetNumber("1").SaveX(ctx)
+	crd2 := client.Card.Create().SetNumber("2").SetName("a").SaveX(ctx)
+	_, err = crd1.Update().SetChanged(crd1.Diff(crd2))
+	require.EqualError(err, `ent: immutable field "create_time" cannot be changed`)
+
+	// Three-way merge.
+	base := client.Comment.Create().SetUniqueInt(3).SetUniqueFloat(3).SaveX(ctx)
+	local, remote := *base, *base
+	local.UniqueInt, local.Table = 4, "local"
+	remote.UniqueInt, remote.NillableInt = 5, new(int)
+	merged, conflicts := ent.MergeChanges(base.Diff(&local), base.Diff(&remote))
+	require.Equal([]ent.FieldChange{
+		{Field: comment.FieldTable, Old: "", New: "local"},
+		{Field: comment.FieldNillableInt, Old: nil, New: 0},
+	}, merged)
+	require.Equal([]ent.FieldConflict{
+		{Field: comment.FieldUniqueInt, Base: 3, Local: 4, Remote: 5},
+	}, conflicts)
+	u, err = base.Update().SetChanged(merged)
+	require.NoError(err)
+	base = u.SaveX(ctx)
+	require.Equal("local", base.Table)
+	require.NotNil(base.NillableInt)
+	require.Equal(3, base.UniqueInt)
+}
+
+func GroupByEdges(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Create().SetName("ariel").SetAge(28).ExecX(ctx)
+	client.Pet.CreateBulk(
+		client.Pet.Create().SetName("a").SetOwner(a8m),
+		client.Pet.Create().SetName("b").SetOwner(a8m),
+		client.Pet.Create().SetName("c").SetOwner(nati),
+	).ExecX(ctx)
+	client.Group.Create().SetName("GitHub").SetExpire(time.Now()).AddUsers(a8m, nati).SetInfo(
+		client.GroupInfo.Create().SetDesc("desc").SaveX(ctx),
+	).ExecX(ctx)
+
+	t.Log("count the neighbors of each group")
+	var v []struct {
+		Age    int `json:"age"`
+		Pets   int `json:"pets"`
+		Groups int `json:"groups"`
+	}
+	client.User.Query().
+		GroupBy(user.FieldAge).
+		Aggregate(
+			ent.As(ent.CountEdges(user.EdgePets), "pets"),
+			ent.As(ent.CountEdges(user.EdgeGroups), "groups"),
+		).
+		ScanX(ctx, &v)
+	require.Len(v, 2)
+	sort.Slice(v, func(i, j int) bool { return v[i].Age < v[j].Age })
+	require.Equal(28, v[0].Age)
+	require.Equal(1, v[0].Pets)
+	require.Equal(1, v[0].Groups)
+	require.Equal(30, v[1].Age)
+	require.Equal(2, v[1].Pets)
+	require.Equal(1, v[1].Groups)
+	var owned []struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+	client.User.Query().
+		Where(user.HasPets()).
+		GroupBy(user.FieldName).
+		Aggregate(ent.As(ent.CountEdges(user.EdgePets), "count")).
+		ScanX(ctx, &owned)
+	require.Len(owned, 2)
+	sort.Slice(owned, func(i, j int) bool { return owned[i].Name < owned[j].Name })
+	require.Equal(2, owned[0].Count)
+	require.Equal(1, owned[1].Count)
+	var pets []struct {
+		Name   string `json:"name"`
+		Owners int    `json:"owners"`
+	}
+	client.Pet.Query().
+		GroupBy(pet.FieldName).
+		Aggregate(ent.As(ent.CountEdges(pet.EdgeOwner), "owners")).
+		ScanX(ctx, &pets)
+	require.Len(pets, 3)
+	for _, p := range pets {
+		require.Equal(1, p.Owners)
+	}
+	err := client.User.Query().GroupBy(user.FieldAge).Aggregate(ent.CountEdges("unknown")).Scan(ctx, &v)
+	require.EqualError(err, "ent: unknown edge \"unknown\" for table \"users\"")
+
+	t.Run("Window", func(t *testing.T) {
+		skip(t, "MySQL/5")
+		var v []struct {
+			Name string `json:"name"`
+			Age  int    `json:"age"`
+			Rank int    `json:"rank"`
+		}
+		client.User.Query().
+			GroupBy(user.FieldName, user.FieldAge).
+			Aggregate(ent.As(ent.Rank(ent.PartitionBy(user.FieldAge), ent.OrderBy("-"+user.FieldName)), "rank")).
+			ScanX(ctx, &v)
+		require.Len(v, 3)
+		ranks := make(map[string]int)
+		for _, r := range v {
+			ranks[r.Name] = r.Rank
+		}
+		require.Equal(map[string]int{"a8m": 1, "nati": 1, "ariel": 2}, ranks)
+		err := client.User.Query().GroupBy(user.FieldAge).Aggregate(ent.RowNumber(ent.OrderBy("unknown"))).Scan(ctx, &v)
+		require.EqualError(err, "ent: unknown column \"unknown\" for table \"users\"")
+	})
+}
+
+func TimeBucket(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	start := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	for i, d := range []time.Duration{0, 10 * time.Minute, 50 * time.Minute, 2*time.Hour + time.Minute} {
+		client.Card.Create().SetNumber(strconv.Itoa(i)).SetBalance(float64(i)).SetCreateTime(start.Add(d)).ExecX(ctx)
+	}
+	buckets := client.Card.Query().
+		BucketBy(card.FieldCreateTime, "1 hour").
+		Aggregate(ent.Count(), ent.Sum(card.FieldBalance)).
+		BucketsX(ctx)
+	require.Len(buckets, 2)
+	require.Equal(start.Unix(), buckets[0].Time.Unix())
+	require.Equal([]float64{3, 3}, buckets[0].Values)
+	require.Equal(start.Add(2*time.Hour).Unix(), buckets[1].Time.Unix())
+	require.Equal([]float64{1, 3}, buckets[1].Values)
+
+	buckets = client.Card.Query().
+		Where(card.BalanceGT(0)).
+		BucketBy(card.FieldCreateTime, "15 minutes").
+		Aggregate(ent.Count()).
+		BucketsX(ctx)
+	require.Len(buckets, 3)
+	require.Equal(start.Unix(), buckets[0].Time.Unix())
+	require.Equal(start.Add(45*time.Minute).Unix(), buckets[1].Time.Unix())
+
+	buckets = client.Card.Query().
+		BucketBy(card.FieldCreateTime, "month").
+		Aggregate(ent.Count()).
+		BucketsX(ctx)
+	require.Len(buckets, 1)
+	require.Equal(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC).Unix(), buckets[0].Time.Unix())
+	require.Equal([]float64{4}, buckets[0].Values)
+
+	_, err := client.Card.Query().BucketBy(card.FieldNumber, "1 hour").Buckets(ctx)
+	require.True(ent.IsValidationError(err))
+	_, err = client.Card.Query().BucketBy(card.FieldCreateTime, "2 fortnights").Buckets(ctx)
+	require.Error(err)
+}
+
+func Estimate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		client.Card.Create().SetNumber(strconv.Itoa(i)).SetBalance(float64(i)).ExecX(ctx)
+	}
+	// SQLite falls back to an exact count.
+	require.Equal(10, client.Card.Query().CountEstimateX(ctx))
+	require.Equal(5, client.Card.Query().Where(card.BalanceGTE(5)).CountEstimateX(ctx))
+
+	require.Equal(10, client.Card.Query().Sample(100).CountX(ctx))
+	require.Zero(client.Card.Query().Sample(0).CountX(ctx))
+	n := client.Card.Query().Where(card.BalanceGTE(5)).Sample(50).CountX(ctx)
+	require.True(n >= 0 && n <= 5)
+}
@@ -0,0 +1,56 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// tenantKey is the context key for the tenant of the queries.
+type tenantKey struct{}
+
+// NewTenantContext returns a new context that carries the given tenant.
+func NewTenantContext(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantMixin adds a tenant field to the schema, and scopes
+// its queries to the tenant that is stored in the context.
+type TenantMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TenantMixin.
+func (TenantMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant").
+			Immutable(),
+	}
+}
+
+// Interceptors of the TenantMixin.
+func (TenantMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+			tenant, ok := ctx.Value(tenantKey{}).(string)
+			if !ok {
+				return nil
+			}
+			wq, ok := q.(interface{ WhereP(...func(*sql.Selector)) })
+			if !ok {
+				return nil
+			}
+			wq.WhereP(func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("tenant"), tenant))
+			})
+			return nil
+		}),
+	}
+}
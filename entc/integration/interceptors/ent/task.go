@@ -0,0 +1,155 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/interceptors/ent/task"
+	"entgo.io/ent/entc/integration/interceptors/ent/user"
+)
+
+// Task is the model entity for the Task schema.
+type Task struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskQuery when eager-loading is set.
+	Edges      TaskEdges `json:"edges"`
+	user_tasks *int
+}
+
+// TaskEdges holds the relations/edges for other nodes in the graph.
+type TaskEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Task) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case task.FieldID:
+			values[i] = new(sql.NullInt64)
+		case task.FieldTenant, task.FieldTitle:
+			values[i] = new(sql.NullString)
+		case task.ForeignKeys[0]: // user_tasks
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Task", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Task fields.
+func (t *Task) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case task.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = int(value.Int64)
+		case task.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				t.Tenant = value.String
+			}
+		case task.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				t.Title = value.String
+			}
+		case task.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_tasks", value)
+			} else if value.Valid {
+				t.user_tasks = new(int)
+				*t.user_tasks = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the Task entity.
+func (t *Task) QueryOwner() *UserQuery {
+	return (&TaskClient{config: t.config}).QueryOwner(t)
+}
+
+// Update returns a builder for updating this Task.
+// Note that you need to call Task.Unwrap() before calling this method if this Task
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Task) Update() *TaskUpdateOne {
+	return (&TaskClient{config: t.config}).UpdateOne(t)
+}
+
+// Unwrap unwraps the Task entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Task) Unwrap() *Task {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Task is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Task) String() string {
+	var builder strings.Builder
+	builder.WriteString("Task(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(t.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(t.Title)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Tasks is a parsable slice of Task.
+type Tasks []*Task
+
+func (t Tasks) config(cfg config) {
+	for _i := range t {
+		t[_i].config = cfg
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package task
+
+import (
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the task type in the database.
+	Label = "task"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// Table holds the table name of the task in the database.
+	Table = "tasks"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "tasks"
+	// OwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	OwnerInverseTable = "users"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "user_tasks"
+)
+
+// Columns holds all SQL columns for task fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldTitle,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "tasks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_tasks",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "entgo.io/ent/entc/integration/interceptors/ent/runtime"
+//
+var (
+	Interceptors [1]ent.Interceptor
+)
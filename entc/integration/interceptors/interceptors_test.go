@@ -0,0 +1,116 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/interceptors/ent"
+	"entgo.io/ent/entc/integration/interceptors/ent/predicate"
+	_ "entgo.io/ent/entc/integration/interceptors/ent/runtime"
+	"entgo.io/ent/entc/integration/interceptors/ent/schema"
+	"entgo.io/ent/entc/integration/interceptors/ent/task"
+	"entgo.io/ent/entc/integration/interceptors/ent/user"
+
+	entgo "entgo.io/ent"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterceptors(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	client.Task.Create().SetTitle("a").SetTenant("foo").SetOwner(a8m).ExecX(ctx)
+	client.Task.Create().SetTitle("b").SetTenant("foo").SetOwner(a8m).ExecX(ctx)
+	client.Task.Create().SetTitle("c").SetTenant("bar").SetOwner(a8m).ExecX(ctx)
+
+	t.Log("Schema traversers scope the queries and their traversals")
+	foo := schema.NewTenantContext(ctx, "foo")
+	require.Equal(t, 3, client.Task.Query().CountX(ctx))
+	require.Equal(t, 2, client.Task.Query().CountX(foo))
+	require.Equal(t, []string{"c"}, client.Task.Query().Select(task.FieldTitle).StringsX(schema.NewTenantContext(ctx, "bar")))
+	require.Equal(t, 2, a8m.QueryTasks().CountX(foo))
+	require.Equal(t, 2, client.User.Query().QueryTasks().CountX(foo))
+	require.Len(t, client.User.Query().WithTasks().OnlyX(foo).Edges.Tasks, 2)
+	require.True(t, client.User.Query().Where(user.HasTasksWith(task.TitleEQ("a"))).ExistX(foo))
+
+	t.Log("Client interceptors receive the context of the queries")
+	var qcs []entgo.QueryContext
+	client.Intercept(entgo.InterceptFunc(func(next entgo.Querier) entgo.Querier {
+		return entgo.QuerierFunc(func(ctx context.Context, q entgo.Query) (entgo.Value, error) {
+			qc := entgo.QueryFromContext(ctx)
+			require.NotNil(t, qc)
+			qcs = append(qcs, *qc)
+			return next.Query(ctx, q)
+		})
+	}))
+	client.User.Query().WithTasks().Limit(5).AllX(ctx)
+	client.Task.Query().Where(task.TitleEQ("a")).Order(ent.Asc(task.FieldTitle)).OnlyIDX(ctx)
+	client.Task.Query().ExistX(ctx)
+	require.Len(t, qcs, 4)
+	require.IsType(t, (*ent.TaskQuery)(nil), qcs[0].EdgeQueries[user.EdgeTasks])
+	require.Len(t, qcs[1].Predicates, 1)
+	require.Len(t, qcs[2].Predicates, 1)
+	require.Len(t, qcs[2].Order, 1)
+	for i := range qcs {
+		qcs[i].EdgeQueries, qcs[i].Predicates, qcs[i].Order = nil, nil, nil
+	}
+	limit, only := 5, 2
+	require.Equal(t, []entgo.QueryContext{
+		{Type: ent.TypeUser, Op: "All", Limit: &limit, Edges: []string{user.EdgeTasks}},
+		{Type: ent.TypeTask, Op: "All"},
+		{Type: ent.TypeTask, Op: "IDs", Limit: &only},
+		{Type: ent.TypeTask, Op: "Exist"},
+	}, qcs)
+
+	t.Log("Interceptors can read the predicates, the order and the eager-loaded edges of the queries")
+	var stmt string
+	scoped := client.WithOptions()
+	scoped.User.Intercept(entgo.InterceptFunc(func(next entgo.Querier) entgo.Querier {
+		return entgo.QuerierFunc(func(ctx context.Context, q entgo.Query) (entgo.Value, error) {
+			qc := entgo.QueryFromContext(ctx)
+			s := sql.Select().From(sql.Table(user.Table))
+			for _, p := range qc.Predicates {
+				p.(predicate.User)(s)
+			}
+			for _, o := range qc.Order {
+				o.(ent.OrderFunc)(s)
+			}
+			stmt, _ = s.Query()
+			if tq, ok := qc.EdgeQueries[user.EdgeTasks].(*ent.TaskQuery); ok {
+				tq.Where(task.TitleNEQ("a"))
+			}
+			return next.Query(ctx, q)
+		})
+	}))
+	u := scoped.User.Query().Where(user.Name("a8m")).Order(ent.Asc(user.FieldName)).WithTasks().OnlyX(ctx)
+	require.Equal(t, "SELECT * FROM `users` WHERE `users`.`name` = ? ORDER BY `users`.`name` ASC", stmt)
+	require.Len(t, u.Edges.Tasks, 2, "eager-loaded tasks were scoped by the interceptor")
+
+	t.Log("Interceptors can modify the queries or return their own results")
+	cache := map[string]int{"tasks": 100}
+	client.Task.Intercept(entgo.InterceptFunc(func(next entgo.Querier) entgo.Querier {
+		return entgo.QuerierFunc(func(ctx context.Context, q entgo.Query) (entgo.Value, error) {
+			if qc := entgo.QueryFromContext(ctx); qc.Op == "Count" {
+				return cache["tasks"], nil
+			}
+			q.(*ent.TaskQuery).Where(task.TitleNEQ("a"))
+			return next.Query(ctx, q)
+		})
+	}))
+	require.Equal(t, 100, client.Task.Query().CountX(ctx))
+	require.Equal(t, []string{"a", "b", "c"}, client.Task.Query().Order(ent.Asc(task.FieldTitle)).Select(task.FieldTitle).StringsX(ctx), "interceptors do not wrap scans")
+	require.Len(t, client.Task.Query().AllX(foo), 1)
+	require.Equal(t, 100, a8m.QueryTasks().CountX(ctx), "traversals use the interceptors of their type")
+	require.Len(t, client.User.Query().WithTasks().OnlyX(ctx).Edges.Tasks, 2)
+}
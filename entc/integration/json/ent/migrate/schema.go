@@ -23,6 +23,10 @@ var (
 		{Name: "floats", Type: field.TypeJSON, Nullable: true},
 		{Name: "strings", Type: field.TypeJSON, Nullable: true},
 		{Name: "addr", Type: field.TypeJSON, Nullable: true},
+		{Name: "payload", Type: field.TypeBytes, Nullable: true, Size: 4294967295},
+		{Name: "body", Type: field.TypeBytes, Nullable: true, Size: 2147483647},
+		{Name: "secret", Type: field.TypeBytes, Nullable: true, Size: 4294967295},
+		{Name: "meta", Type: field.TypeBytes, Nullable: true, Size: 4294967295},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
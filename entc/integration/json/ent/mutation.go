@@ -20,6 +20,7 @@ import (
 	"entgo.io/ent/entc/integration/json/ent/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -37,21 +38,30 @@ const (
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	t             **schema.T
-	url           **url.URL
-	raw           *json.RawMessage
-	dirs          *[]http.Dir
-	ints          *[]int
-	floats        *[]float64
-	strings       *[]string
-	addr          *schema.Addr
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op              Op
+	typ             string
+	id              *int
+	t               **schema.T
+	url             **url.URL
+	raw             *json.RawMessage
+	appendedraw     json.RawMessage
+	dirs            *[]http.Dir
+	appendeddirs    []http.Dir
+	ints            *[]int
+	appendedints    []int
+	floats          *[]float64
+	appendedfloats  []float64
+	strings         *[]string
+	appendedstrings []string
+	addr            *schema.Addr
+	payload         *map[string]interface{}
+	body            *string
+	secret          *string
+	meta            *map[string]string
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*User, error)
+	predicates      []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -155,6 +165,7 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 // SetT sets the "t" field.
 func (m *UserMutation) SetT(s *schema.T) {
 	m.t = &s
+	delete(m.clearedFields, user.FieldT)
 }
 
 // T returns the value of the "t" field in the mutation.
@@ -204,6 +215,7 @@ func (m *UserMutation) ResetT() {
 // SetURL sets the "url" field.
 func (m *UserMutation) SetURL(u *url.URL) {
 	m.url = &u
+	delete(m.clearedFields, user.FieldURL)
 }
 
 // URL returns the value of the "url" field in the mutation.
@@ -253,6 +265,8 @@ func (m *UserMutation) ResetURL() {
 // SetRaw sets the "raw" field.
 func (m *UserMutation) SetRaw(jm json.RawMessage) {
 	m.raw = &jm
+	m.appendedraw = nil
+	delete(m.clearedFields, user.FieldRaw)
 }
 
 // Raw returns the value of the "raw" field in the mutation.
@@ -281,9 +295,23 @@ func (m *UserMutation) OldRaw(ctx context.Context) (v json.RawMessage, err error
 	return oldValue.Raw, nil
 }
 
+// AppendRaw adds jm to the "raw" field.
+func (m *UserMutation) AppendRaw(jm json.RawMessage) {
+	m.appendedraw = append(m.appendedraw, jm...)
+}
+
+// AppendedRaw returns the list of values that were appended to the "raw" field in this mutation.
+func (m *UserMutation) AppendedRaw() (json.RawMessage, bool) {
+	if len(m.appendedraw) == 0 {
+		return nil, false
+	}
+	return m.appendedraw, true
+}
+
 // ClearRaw clears the value of the "raw" field.
 func (m *UserMutation) ClearRaw() {
 	m.raw = nil
+	m.appendedraw = nil
 	m.clearedFields[user.FieldRaw] = struct{}{}
 }
 
@@ -296,12 +324,14 @@ func (m *UserMutation) RawCleared() bool {
 // ResetRaw resets all changes to the "raw" field.
 func (m *UserMutation) ResetRaw() {
 	m.raw = nil
+	m.appendedraw = nil
 	delete(m.clearedFields, user.FieldRaw)
 }
 
 // SetDirs sets the "dirs" field.
 func (m *UserMutation) SetDirs(h []http.Dir) {
 	m.dirs = &h
+	m.appendeddirs = nil
 }
 
 // Dirs returns the value of the "dirs" field in the mutation.
@@ -330,14 +360,30 @@ func (m *UserMutation) OldDirs(ctx context.Context) (v []http.Dir, err error) {
 	return oldValue.Dirs, nil
 }
 
+// AppendDirs adds h to the "dirs" field.
+func (m *UserMutation) AppendDirs(h []http.Dir) {
+	m.appendeddirs = append(m.appendeddirs, h...)
+}
+
+// AppendedDirs returns the list of values that were appended to the "dirs" field in this mutation.
+func (m *UserMutation) AppendedDirs() ([]http.Dir, bool) {
+	if len(m.appendeddirs) == 0 {
+		return nil, false
+	}
+	return m.appendeddirs, true
+}
+
 // ResetDirs resets all changes to the "dirs" field.
 func (m *UserMutation) ResetDirs() {
 	m.dirs = nil
+	m.appendeddirs = nil
 }
 
 // SetInts sets the "ints" field.
 func (m *UserMutation) SetInts(i []int) {
 	m.ints = &i
+	m.appendedints = nil
+	delete(m.clearedFields, user.FieldInts)
 }
 
 // Ints returns the value of the "ints" field in the mutation.
@@ -366,9 +412,23 @@ func (m *UserMutation) OldInts(ctx context.Context) (v []int, err error) {
 	return oldValue.Ints, nil
 }
 
+// AppendInts adds i to the "ints" field.
+func (m *UserMutation) AppendInts(i []int) {
+	m.appendedints = append(m.appendedints, i...)
+}
+
+// AppendedInts returns the list of values that were appended to the "ints" field in this mutation.
+func (m *UserMutation) AppendedInts() ([]int, bool) {
+	if len(m.appendedints) == 0 {
+		return nil, false
+	}
+	return m.appendedints, true
+}
+
 // ClearInts clears the value of the "ints" field.
 func (m *UserMutation) ClearInts() {
 	m.ints = nil
+	m.appendedints = nil
 	m.clearedFields[user.FieldInts] = struct{}{}
 }
 
@@ -381,12 +441,15 @@ func (m *UserMutation) IntsCleared() bool {
 // ResetInts resets all changes to the "ints" field.
 func (m *UserMutation) ResetInts() {
 	m.ints = nil
+	m.appendedints = nil
 	delete(m.clearedFields, user.FieldInts)
 }
 
 // SetFloats sets the "floats" field.
 func (m *UserMutation) SetFloats(f []float64) {
 	m.floats = &f
+	m.appendedfloats = nil
+	delete(m.clearedFields, user.FieldFloats)
 }
 
 // Floats returns the value of the "floats" field in the mutation.
@@ -415,9 +478,23 @@ func (m *UserMutation) OldFloats(ctx context.Context) (v []float64, err error) {
 	return oldValue.Floats, nil
 }
 
+// AppendFloats adds f to the "floats" field.
+func (m *UserMutation) AppendFloats(f []float64) {
+	m.appendedfloats = append(m.appendedfloats, f...)
+}
+
+// AppendedFloats returns the list of values that were appended to the "floats" field in this mutation.
+func (m *UserMutation) AppendedFloats() ([]float64, bool) {
+	if len(m.appendedfloats) == 0 {
+		return nil, false
+	}
+	return m.appendedfloats, true
+}
+
 // ClearFloats clears the value of the "floats" field.
 func (m *UserMutation) ClearFloats() {
 	m.floats = nil
+	m.appendedfloats = nil
 	m.clearedFields[user.FieldFloats] = struct{}{}
 }
 
@@ -430,12 +507,15 @@ func (m *UserMutation) FloatsCleared() bool {
 // ResetFloats resets all changes to the "floats" field.
 func (m *UserMutation) ResetFloats() {
 	m.floats = nil
+	m.appendedfloats = nil
 	delete(m.clearedFields, user.FieldFloats)
 }
 
 // SetStrings sets the "strings" field.
 func (m *UserMutation) SetStrings(s []string) {
 	m.strings = &s
+	m.appendedstrings = nil
+	delete(m.clearedFields, user.FieldStrings)
 }
 
 // Strings returns the value of the "strings" field in the mutation.
@@ -464,9 +544,23 @@ func (m *UserMutation) OldStrings(ctx context.Context) (v []string, err error) {
 	return oldValue.Strings, nil
 }
 
+// AppendStrings adds s to the "strings" field.
+func (m *UserMutation) AppendStrings(s []string) {
+	m.appendedstrings = append(m.appendedstrings, s...)
+}
+
+// AppendedStrings returns the list of values that were appended to the "strings" field in this mutation.
+func (m *UserMutation) AppendedStrings() ([]string, bool) {
+	if len(m.appendedstrings) == 0 {
+		return nil, false
+	}
+	return m.appendedstrings, true
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (m *UserMutation) ClearStrings() {
 	m.strings = nil
+	m.appendedstrings = nil
 	m.clearedFields[user.FieldStrings] = struct{}{}
 }
 
@@ -479,12 +573,14 @@ func (m *UserMutation) StringsCleared() bool {
 // ResetStrings resets all changes to the "strings" field.
 func (m *UserMutation) ResetStrings() {
 	m.strings = nil
+	m.appendedstrings = nil
 	delete(m.clearedFields, user.FieldStrings)
 }
 
 // SetAddr sets the "addr" field.
 func (m *UserMutation) SetAddr(s schema.Addr) {
 	m.addr = &s
+	delete(m.clearedFields, user.FieldAddr)
 }
 
 // Addr returns the value of the "addr" field in the mutation.
@@ -531,11 +627,221 @@ func (m *UserMutation) ResetAddr() {
 	delete(m.clearedFields, user.FieldAddr)
 }
 
+// SetPayload sets the "payload" field.
+func (m *UserMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
+	delete(m.clearedFields, user.FieldPayload)
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *UserMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (m *UserMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[user.FieldPayload] = struct{}{}
+}
+
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *UserMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[user.FieldPayload]
+	return ok
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *UserMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, user.FieldPayload)
+}
+
+// SetBody sets the "body" field.
+func (m *UserMutation) SetBody(s string) {
+	m.body = &s
+	delete(m.clearedFields, user.FieldBody)
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *UserMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ClearBody clears the value of the "body" field.
+func (m *UserMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[user.FieldBody] = struct{}{}
+}
+
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *UserMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[user.FieldBody]
+	return ok
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *UserMutation) ResetBody() {
+	m.body = nil
+	delete(m.clearedFields, user.FieldBody)
+}
+
+// SetSecret sets the "secret" field.
+func (m *UserMutation) SetSecret(s string) {
+	m.secret = &s
+	delete(m.clearedFields, user.FieldSecret)
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *UserMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (m *UserMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[user.FieldSecret] = struct{}{}
+}
+
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *UserMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldSecret]
+	return ok
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *UserMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, user.FieldSecret)
+}
+
+// SetMeta sets the "meta" field.
+func (m *UserMutation) SetMeta(value map[string]string) {
+	m.meta = &value
+	delete(m.clearedFields, user.FieldMeta)
+}
+
+// Meta returns the value of the "meta" field in the mutation.
+func (m *UserMutation) Meta() (r map[string]string, exists bool) {
+	v := m.meta
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeta returns the old "meta" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMeta(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeta is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeta requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeta: %w", err)
+	}
+	return oldValue.Meta, nil
+}
+
+// ClearMeta clears the value of the "meta" field.
+func (m *UserMutation) ClearMeta() {
+	m.meta = nil
+	m.clearedFields[user.FieldMeta] = struct{}{}
+}
+
+// MetaCleared returns if the "meta" field was cleared in this mutation.
+func (m *UserMutation) MetaCleared() bool {
+	_, ok := m.clearedFields[user.FieldMeta]
+	return ok
+}
+
+// ResetMeta resets all changes to the "meta" field.
+func (m *UserMutation) ResetMeta() {
+	m.meta = nil
+	delete(m.clearedFields, user.FieldMeta)
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -550,7 +856,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 12)
 	if m.t != nil {
 		fields = append(fields, user.FieldT)
 	}
@@ -575,6 +881,18 @@ func (m *UserMutation) Fields() []string {
 	if m.addr != nil {
 		fields = append(fields, user.FieldAddr)
 	}
+	if m.payload != nil {
+		fields = append(fields, user.FieldPayload)
+	}
+	if m.body != nil {
+		fields = append(fields, user.FieldBody)
+	}
+	if m.secret != nil {
+		fields = append(fields, user.FieldSecret)
+	}
+	if m.meta != nil {
+		fields = append(fields, user.FieldMeta)
+	}
 	return fields
 }
 
@@ -599,6 +917,14 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Strings()
 	case user.FieldAddr:
 		return m.Addr()
+	case user.FieldPayload:
+		return m.Payload()
+	case user.FieldBody:
+		return m.Body()
+	case user.FieldSecret:
+		return m.Secret()
+	case user.FieldMeta:
+		return m.Meta()
 	}
 	return nil, false
 }
@@ -624,6 +950,14 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldStrings(ctx)
 	case user.FieldAddr:
 		return m.OldAddr(ctx)
+	case user.FieldPayload:
+		return m.OldPayload(ctx)
+	case user.FieldBody:
+		return m.OldBody(ctx)
+	case user.FieldSecret:
+		return m.OldSecret(ctx)
+	case user.FieldMeta:
+		return m.OldMeta(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -689,6 +1023,34 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAddr(v)
 		return nil
+	case user.FieldPayload:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case user.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case user.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case user.FieldMeta:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeta(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -740,6 +1102,18 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldAddr) {
 		fields = append(fields, user.FieldAddr)
 	}
+	if m.FieldCleared(user.FieldPayload) {
+		fields = append(fields, user.FieldPayload)
+	}
+	if m.FieldCleared(user.FieldBody) {
+		fields = append(fields, user.FieldBody)
+	}
+	if m.FieldCleared(user.FieldSecret) {
+		fields = append(fields, user.FieldSecret)
+	}
+	if m.FieldCleared(user.FieldMeta) {
+		fields = append(fields, user.FieldMeta)
+	}
 	return fields
 }
 
@@ -775,6 +1149,18 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldAddr:
 		m.ClearAddr()
 		return nil
+	case user.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case user.FieldBody:
+		m.ClearBody()
+		return nil
+	case user.FieldSecret:
+		m.ClearSecret()
+		return nil
+	case user.FieldMeta:
+		m.ClearMeta()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -807,6 +1193,18 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldAddr:
 		m.ResetAddr()
 		return nil
+	case user.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case user.FieldBody:
+		m.ResetBody()
+		return nil
+	case user.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case user.FieldMeta:
+		m.ResetMeta()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
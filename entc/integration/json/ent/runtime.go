@@ -27,4 +27,12 @@ func init() {
 	userDescInts := userFields[4].Descriptor()
 	// user.DefaultInts holds the default value on creation for the ints field.
 	user.DefaultInts = userDescInts.Default.([]int)
+	// userDescSecret is the schema descriptor for secret field.
+	userDescSecret := userFields[10].Descriptor()
+	// user.SecretCodec encodes the values of the "secret" field before they are stored, and decodes them after they are read.
+	user.SecretCodec = userDescSecret.Codec
+	// userDescMeta is the schema descriptor for meta field.
+	userDescMeta := userFields[11].Descriptor()
+	// user.MetaCodec encodes the values of the "meta" field before they are stored, and decodes them after they are read.
+	user.MetaCodec = userDescMeta.Codec
 }
@@ -5,6 +5,7 @@
 package schema
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"net/url"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/field"
 )
 
@@ -44,9 +46,31 @@ func (User) Fields() []ent.Field {
 		field.JSON("addr", Addr{}).
 			Sensitive().
 			Optional(),
+		field.JSON("payload", map[string]interface{}{}).
+			Optional().
+			Annotations(entsql.Compress("gzip")),
+		field.Text("body").
+			Optional().
+			Annotations(entsql.Compress("gzip")),
+		field.String("secret").
+			Optional().
+			Codec(Base64),
+		field.JSON("meta", map[string]string{}).
+			Optional().
+			Codec(Base64),
 	}
 }
 
+// Base64 is a field.Codec that stores the values in their base64 encoding.
+var Base64 = field.CodecFuncs(
+	func(b []byte) ([]byte, error) {
+		return []byte(base64.StdEncoding.EncodeToString(b)), nil
+	},
+	func(b []byte) ([]byte, error) {
+		return base64.StdEncoding.DecodeString(string(b))
+	},
+)
+
 type T struct {
 	I  int      `json:"i,omitempty"`
 	F  float64  `json:"f,omitempty"`
@@ -14,6 +14,8 @@ import (
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlcodec"
+	"entgo.io/ent/dialect/sql/sqlcompress"
 	"entgo.io/ent/entc/integration/json/ent/schema"
 	"entgo.io/ent/entc/integration/json/ent/user"
 )
@@ -39,6 +41,14 @@ type User struct {
 	Strings []string `json:"strings,omitempty"`
 	// Addr holds the value of the "addr" field.
 	Addr schema.Addr `json:"-"`
+	// Payload holds the value of the "payload" field.
+	Payload map[string]interface{} `json:"payload,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"secret,omitempty"`
+	// Meta holds the value of the "meta" field.
+	Meta map[string]string `json:"meta,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -46,7 +56,7 @@ func (*User) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldT, user.FieldURL, user.FieldRaw, user.FieldDirs, user.FieldInts, user.FieldFloats, user.FieldStrings, user.FieldAddr:
+		case user.FieldT, user.FieldURL, user.FieldRaw, user.FieldDirs, user.FieldInts, user.FieldFloats, user.FieldStrings, user.FieldAddr, user.FieldPayload, user.FieldBody, user.FieldSecret, user.FieldMeta:
 			values[i] = new([]byte)
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
@@ -135,6 +145,54 @@ func (u *User) assignValues(columns []string, values []interface{}) error {
 					return fmt.Errorf("unmarshal field addr: %w", err)
 				}
 			}
+		case user.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && *value != nil {
+				b, err := sqlcompress.Decompress(*value)
+				if err != nil {
+					return fmt.Errorf("decompress field payload: %w", err)
+				}
+				if len(b) > 0 {
+					if err := json.Unmarshal(b, &u.Payload); err != nil {
+						return fmt.Errorf("unmarshal field payload: %w", err)
+					}
+				}
+			}
+		case user.FieldBody:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value != nil && *value != nil {
+				b, err := sqlcompress.Decompress(*value)
+				if err != nil {
+					return fmt.Errorf("decompress field body: %w", err)
+				}
+				u.Body = string(b)
+			}
+		case user.FieldSecret:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value != nil && *value != nil {
+				b, err := sqlcodec.Decode(user.SecretCodec, *value)
+				if err != nil {
+					return fmt.Errorf("decode field secret: %w", err)
+				}
+				u.Secret = string(b)
+			}
+		case user.FieldMeta:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field meta", values[i])
+			} else if value != nil && *value != nil {
+				b, err := sqlcodec.Decode(user.MetaCodec, *value)
+				if err != nil {
+					return fmt.Errorf("decode field meta: %w", err)
+				}
+				if len(b) > 0 {
+					if err := json.Unmarshal(b, &u.Meta); err != nil {
+						return fmt.Errorf("unmarshal field meta: %w", err)
+					}
+				}
+			}
 		}
 	}
 	return nil
@@ -185,6 +243,18 @@ func (u *User) String() string {
 	builder.WriteString(fmt.Sprintf("%v", u.Strings))
 	builder.WriteString(", ")
 	builder.WriteString("addr=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", u.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(u.Body)
+	builder.WriteString(", ")
+	builder.WriteString("secret=")
+	builder.WriteString(u.Secret)
+	builder.WriteString(", ")
+	builder.WriteString("meta=")
+	builder.WriteString(fmt.Sprintf("%v", u.Meta))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -8,6 +8,8 @@ package user
 
 import (
 	"net/http"
+
+	"entgo.io/ent/schema/field"
 )
 
 const (
@@ -31,6 +33,14 @@ const (
 	FieldStrings = "strings"
 	// FieldAddr holds the string denoting the addr field in the database.
 	FieldAddr = "addr"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
+	// FieldMeta holds the string denoting the meta field in the database.
+	FieldMeta = "meta"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 )
@@ -46,6 +56,10 @@ var Columns = []string{
 	FieldFloats,
 	FieldStrings,
 	FieldAddr,
+	FieldPayload,
+	FieldBody,
+	FieldSecret,
+	FieldMeta,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -58,9 +72,19 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "entgo.io/ent/entc/integration/json/ent/runtime"
+//
 var (
 	// DefaultDirs holds the default value on creation for the "dirs" field.
 	DefaultDirs func() []http.Dir
 	// DefaultInts holds the default value on creation for the "ints" field.
 	DefaultInts []int
+	// SecretCodec encodes the values of the "secret" field before they are stored, and decodes them after they are read.
+	SecretCodec field.Codec
+	// MetaCodec encodes the values of the "meta" field before they are stored, and decodes them after they are read.
+	MetaCodec field.Codec
 )
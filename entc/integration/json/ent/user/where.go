@@ -8,6 +8,7 @@ package user
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/json/ent/predicate"
 )
 
@@ -180,6 +181,1022 @@ func AddrNotNil() predicate.User {
 	})
 }
 
+// PayloadIsNil applies the IsNil predicate on the "payload" field.
+func PayloadIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPayload)))
+	})
+}
+
+// PayloadNotNil applies the NotNil predicate on the "payload" field.
+func PayloadNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPayload)))
+	})
+}
+
+// BodyIsNil applies the IsNil predicate on the "body" field.
+func BodyIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBody)))
+	})
+}
+
+// BodyNotNil applies the NotNil predicate on the "body" field.
+func BodyNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBody)))
+	})
+}
+
+// SecretIsNil applies the IsNil predicate on the "secret" field.
+func SecretIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSecret)))
+	})
+}
+
+// SecretNotNil applies the NotNil predicate on the "secret" field.
+func SecretNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSecret)))
+	})
+}
+
+// MetaIsNil applies the IsNil predicate on the "meta" field.
+func MetaIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMeta)))
+	})
+}
+
+// MetaNotNil applies the NotNil predicate on the "meta" field.
+func MetaNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMeta)))
+	})
+}
+
+// THasKey applies the HasKey predicate on the "t" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func THasKey(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.DotPath(path)))
+	})
+}
+
+// TValueIsNull applies the ValueIsNull predicate on the "t" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func TValueIsNull(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.DotPath(path)))
+	})
+}
+
+// TValueEQ applies the ValueEQ predicate on the JSON value of the "t" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TValueEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.DotPath(path)))
+	})
+}
+
+// TValueNEQ applies the ValueNEQ predicate on the JSON value of the "t" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TValueNEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.DotPath(path)))
+	})
+}
+
+// TValueGT applies the ValueGT predicate on the JSON value of the "t" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TValueGT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldT), v, sqljson.DotPath(path)))
+	})
+}
+
+// TValueGTE applies the ValueGTE predicate on the JSON value of the "t" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TValueGTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldT), v, sqljson.DotPath(path)))
+	})
+}
+
+// TValueLT applies the ValueLT predicate on the JSON value of the "t" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TValueLT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldT), v, sqljson.DotPath(path)))
+	})
+}
+
+// TValueLTE applies the ValueLTE predicate on the JSON value of the "t" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TValueLTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldT), v, sqljson.DotPath(path)))
+	})
+}
+
+// TValueContains applies the ValueContains predicate on the JSON value of the "t" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TValueContains(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldT), v, sqljson.DotPath(path)))
+	})
+}
+
+// TLenEQ applies the LenEQ predicate on the length of the JSON array of the "t"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TLenEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldT), n, sqljson.DotPath(path)))
+	})
+}
+
+// TLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "t"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TLenNEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldT), n, sqljson.DotPath(path)))
+	})
+}
+
+// TLenGT applies the LenGT predicate on the length of the JSON array of the "t"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TLenGT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldT), n, sqljson.DotPath(path)))
+	})
+}
+
+// TLenGTE applies the LenGTE predicate on the length of the JSON array of the "t"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TLenGTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldT), n, sqljson.DotPath(path)))
+	})
+}
+
+// TLenLT applies the LenLT predicate on the length of the JSON array of the "t"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TLenLT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldT), n, sqljson.DotPath(path)))
+	})
+}
+
+// TLenLTE applies the LenLTE predicate on the length of the JSON array of the "t"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func TLenLTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldT), n, sqljson.DotPath(path)))
+	})
+}
+
+// URLHasKey applies the HasKey predicate on the "url" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func URLHasKey(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.DotPath(path)))
+	})
+}
+
+// URLValueIsNull applies the ValueIsNull predicate on the "url" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func URLValueIsNull(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.DotPath(path)))
+	})
+}
+
+// URLValueEQ applies the ValueEQ predicate on the JSON value of the "url" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLValueEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.DotPath(path)))
+	})
+}
+
+// URLValueNEQ applies the ValueNEQ predicate on the JSON value of the "url" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLValueNEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.DotPath(path)))
+	})
+}
+
+// URLValueGT applies the ValueGT predicate on the JSON value of the "url" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLValueGT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.DotPath(path)))
+	})
+}
+
+// URLValueGTE applies the ValueGTE predicate on the JSON value of the "url" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLValueGTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.DotPath(path)))
+	})
+}
+
+// URLValueLT applies the ValueLT predicate on the JSON value of the "url" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLValueLT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.DotPath(path)))
+	})
+}
+
+// URLValueLTE applies the ValueLTE predicate on the JSON value of the "url" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLValueLTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.DotPath(path)))
+	})
+}
+
+// URLValueContains applies the ValueContains predicate on the JSON value of the "url" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLValueContains(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldURL), v, sqljson.DotPath(path)))
+	})
+}
+
+// URLLenEQ applies the LenEQ predicate on the length of the JSON array of the "url"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLLenEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldURL), n, sqljson.DotPath(path)))
+	})
+}
+
+// URLLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "url"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLLenNEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldURL), n, sqljson.DotPath(path)))
+	})
+}
+
+// URLLenGT applies the LenGT predicate on the length of the JSON array of the "url"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLLenGT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldURL), n, sqljson.DotPath(path)))
+	})
+}
+
+// URLLenGTE applies the LenGTE predicate on the length of the JSON array of the "url"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLLenGTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldURL), n, sqljson.DotPath(path)))
+	})
+}
+
+// URLLenLT applies the LenLT predicate on the length of the JSON array of the "url"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLLenLT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldURL), n, sqljson.DotPath(path)))
+	})
+}
+
+// URLLenLTE applies the LenLTE predicate on the length of the JSON array of the "url"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func URLLenLTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldURL), n, sqljson.DotPath(path)))
+	})
+}
+
+// RawHasKey applies the HasKey predicate on the "raw" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func RawHasKey(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldRaw), sqljson.DotPath(path)))
+	})
+}
+
+// RawValueIsNull applies the ValueIsNull predicate on the "raw" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func RawValueIsNull(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldRaw), sqljson.DotPath(path)))
+	})
+}
+
+// RawValueEQ applies the ValueEQ predicate on the JSON value of the "raw" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawValueEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldRaw), v, sqljson.DotPath(path)))
+	})
+}
+
+// RawValueNEQ applies the ValueNEQ predicate on the JSON value of the "raw" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawValueNEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldRaw), v, sqljson.DotPath(path)))
+	})
+}
+
+// RawValueGT applies the ValueGT predicate on the JSON value of the "raw" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawValueGT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldRaw), v, sqljson.DotPath(path)))
+	})
+}
+
+// RawValueGTE applies the ValueGTE predicate on the JSON value of the "raw" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawValueGTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldRaw), v, sqljson.DotPath(path)))
+	})
+}
+
+// RawValueLT applies the ValueLT predicate on the JSON value of the "raw" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawValueLT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldRaw), v, sqljson.DotPath(path)))
+	})
+}
+
+// RawValueLTE applies the ValueLTE predicate on the JSON value of the "raw" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawValueLTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldRaw), v, sqljson.DotPath(path)))
+	})
+}
+
+// RawValueContains applies the ValueContains predicate on the JSON value of the "raw" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawValueContains(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldRaw), v, sqljson.DotPath(path)))
+	})
+}
+
+// RawLenEQ applies the LenEQ predicate on the length of the JSON array of the "raw"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawLenEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldRaw), n, sqljson.DotPath(path)))
+	})
+}
+
+// RawLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "raw"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawLenNEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldRaw), n, sqljson.DotPath(path)))
+	})
+}
+
+// RawLenGT applies the LenGT predicate on the length of the JSON array of the "raw"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawLenGT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldRaw), n, sqljson.DotPath(path)))
+	})
+}
+
+// RawLenGTE applies the LenGTE predicate on the length of the JSON array of the "raw"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawLenGTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldRaw), n, sqljson.DotPath(path)))
+	})
+}
+
+// RawLenLT applies the LenLT predicate on the length of the JSON array of the "raw"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawLenLT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldRaw), n, sqljson.DotPath(path)))
+	})
+}
+
+// RawLenLTE applies the LenLTE predicate on the length of the JSON array of the "raw"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func RawLenLTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldRaw), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirsHasKey applies the HasKey predicate on the "dirs" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func DirsHasKey(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldDirs), sqljson.DotPath(path)))
+	})
+}
+
+// DirsValueIsNull applies the ValueIsNull predicate on the "dirs" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func DirsValueIsNull(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldDirs), sqljson.DotPath(path)))
+	})
+}
+
+// DirsValueEQ applies the ValueEQ predicate on the JSON value of the "dirs" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsValueEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldDirs), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirsValueNEQ applies the ValueNEQ predicate on the JSON value of the "dirs" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsValueNEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldDirs), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirsValueGT applies the ValueGT predicate on the JSON value of the "dirs" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsValueGT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldDirs), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirsValueGTE applies the ValueGTE predicate on the JSON value of the "dirs" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsValueGTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldDirs), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirsValueLT applies the ValueLT predicate on the JSON value of the "dirs" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsValueLT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldDirs), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirsValueLTE applies the ValueLTE predicate on the JSON value of the "dirs" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsValueLTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldDirs), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirsValueContains applies the ValueContains predicate on the JSON value of the "dirs" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsValueContains(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldDirs), v, sqljson.DotPath(path)))
+	})
+}
+
+// DirsLenEQ applies the LenEQ predicate on the length of the JSON array of the "dirs"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsLenEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldDirs), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirsLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "dirs"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsLenNEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldDirs), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirsLenGT applies the LenGT predicate on the length of the JSON array of the "dirs"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsLenGT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldDirs), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirsLenGTE applies the LenGTE predicate on the length of the JSON array of the "dirs"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsLenGTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldDirs), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirsLenLT applies the LenLT predicate on the length of the JSON array of the "dirs"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsLenLT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldDirs), n, sqljson.DotPath(path)))
+	})
+}
+
+// DirsLenLTE applies the LenLTE predicate on the length of the JSON array of the "dirs"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func DirsLenLTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldDirs), n, sqljson.DotPath(path)))
+	})
+}
+
+// IntsHasKey applies the HasKey predicate on the "ints" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func IntsHasKey(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldInts), sqljson.DotPath(path)))
+	})
+}
+
+// IntsValueIsNull applies the ValueIsNull predicate on the "ints" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func IntsValueIsNull(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldInts), sqljson.DotPath(path)))
+	})
+}
+
+// IntsValueEQ applies the ValueEQ predicate on the JSON value of the "ints" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsValueEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldInts), v, sqljson.DotPath(path)))
+	})
+}
+
+// IntsValueNEQ applies the ValueNEQ predicate on the JSON value of the "ints" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsValueNEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldInts), v, sqljson.DotPath(path)))
+	})
+}
+
+// IntsValueGT applies the ValueGT predicate on the JSON value of the "ints" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsValueGT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldInts), v, sqljson.DotPath(path)))
+	})
+}
+
+// IntsValueGTE applies the ValueGTE predicate on the JSON value of the "ints" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsValueGTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldInts), v, sqljson.DotPath(path)))
+	})
+}
+
+// IntsValueLT applies the ValueLT predicate on the JSON value of the "ints" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsValueLT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldInts), v, sqljson.DotPath(path)))
+	})
+}
+
+// IntsValueLTE applies the ValueLTE predicate on the JSON value of the "ints" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsValueLTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldInts), v, sqljson.DotPath(path)))
+	})
+}
+
+// IntsValueContains applies the ValueContains predicate on the JSON value of the "ints" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsValueContains(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldInts), v, sqljson.DotPath(path)))
+	})
+}
+
+// IntsLenEQ applies the LenEQ predicate on the length of the JSON array of the "ints"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsLenEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldInts), n, sqljson.DotPath(path)))
+	})
+}
+
+// IntsLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "ints"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsLenNEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldInts), n, sqljson.DotPath(path)))
+	})
+}
+
+// IntsLenGT applies the LenGT predicate on the length of the JSON array of the "ints"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsLenGT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldInts), n, sqljson.DotPath(path)))
+	})
+}
+
+// IntsLenGTE applies the LenGTE predicate on the length of the JSON array of the "ints"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsLenGTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldInts), n, sqljson.DotPath(path)))
+	})
+}
+
+// IntsLenLT applies the LenLT predicate on the length of the JSON array of the "ints"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsLenLT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldInts), n, sqljson.DotPath(path)))
+	})
+}
+
+// IntsLenLTE applies the LenLTE predicate on the length of the JSON array of the "ints"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func IntsLenLTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldInts), n, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsHasKey applies the HasKey predicate on the "floats" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func FloatsHasKey(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldFloats), sqljson.DotPath(path)))
+	})
+}
+
+// FloatsValueIsNull applies the ValueIsNull predicate on the "floats" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func FloatsValueIsNull(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldFloats), sqljson.DotPath(path)))
+	})
+}
+
+// FloatsValueEQ applies the ValueEQ predicate on the JSON value of the "floats" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsValueEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldFloats), v, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsValueNEQ applies the ValueNEQ predicate on the JSON value of the "floats" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsValueNEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldFloats), v, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsValueGT applies the ValueGT predicate on the JSON value of the "floats" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsValueGT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldFloats), v, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsValueGTE applies the ValueGTE predicate on the JSON value of the "floats" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsValueGTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldFloats), v, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsValueLT applies the ValueLT predicate on the JSON value of the "floats" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsValueLT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldFloats), v, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsValueLTE applies the ValueLTE predicate on the JSON value of the "floats" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsValueLTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldFloats), v, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsValueContains applies the ValueContains predicate on the JSON value of the "floats" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsValueContains(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldFloats), v, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsLenEQ applies the LenEQ predicate on the length of the JSON array of the "floats"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsLenEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldFloats), n, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "floats"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsLenNEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldFloats), n, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsLenGT applies the LenGT predicate on the length of the JSON array of the "floats"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsLenGT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldFloats), n, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsLenGTE applies the LenGTE predicate on the length of the JSON array of the "floats"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsLenGTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldFloats), n, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsLenLT applies the LenLT predicate on the length of the JSON array of the "floats"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsLenLT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldFloats), n, sqljson.DotPath(path)))
+	})
+}
+
+// FloatsLenLTE applies the LenLTE predicate on the length of the JSON array of the "floats"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FloatsLenLTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldFloats), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsHasKey applies the HasKey predicate on the "strings" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func StringsHasKey(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldStrings), sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueIsNull applies the ValueIsNull predicate on the "strings" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func StringsValueIsNull(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldStrings), sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueEQ applies the ValueEQ predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueNEQ applies the ValueNEQ predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueNEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueGT applies the ValueGT predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueGT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueGTE applies the ValueGTE predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueGTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueLT applies the ValueLT predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueLT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueLTE applies the ValueLTE predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueLTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsValueContains applies the ValueContains predicate on the JSON value of the "strings" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsValueContains(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldStrings), v, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenEQ applies the LenEQ predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenNEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenGT applies the LenGT predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenGT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenGTE applies the LenGTE predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenGTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenLT applies the LenLT predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenLT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// StringsLenLTE applies the LenLTE predicate on the length of the JSON array of the "strings"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func StringsLenLTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldStrings), n, sqljson.DotPath(path)))
+	})
+}
+
+// AddrHasKey applies the HasKey predicate on the "addr" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func AddrHasKey(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldAddr), sqljson.DotPath(path)))
+	})
+}
+
+// AddrValueIsNull applies the ValueIsNull predicate on the "addr" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func AddrValueIsNull(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldAddr), sqljson.DotPath(path)))
+	})
+}
+
+// AddrValueEQ applies the ValueEQ predicate on the JSON value of the "addr" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrValueEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldAddr), v, sqljson.DotPath(path)))
+	})
+}
+
+// AddrValueNEQ applies the ValueNEQ predicate on the JSON value of the "addr" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrValueNEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldAddr), v, sqljson.DotPath(path)))
+	})
+}
+
+// AddrValueGT applies the ValueGT predicate on the JSON value of the "addr" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrValueGT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldAddr), v, sqljson.DotPath(path)))
+	})
+}
+
+// AddrValueGTE applies the ValueGTE predicate on the JSON value of the "addr" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrValueGTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldAddr), v, sqljson.DotPath(path)))
+	})
+}
+
+// AddrValueLT applies the ValueLT predicate on the JSON value of the "addr" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrValueLT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldAddr), v, sqljson.DotPath(path)))
+	})
+}
+
+// AddrValueLTE applies the ValueLTE predicate on the JSON value of the "addr" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrValueLTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldAddr), v, sqljson.DotPath(path)))
+	})
+}
+
+// AddrValueContains applies the ValueContains predicate on the JSON value of the "addr" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrValueContains(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldAddr), v, sqljson.DotPath(path)))
+	})
+}
+
+// AddrLenEQ applies the LenEQ predicate on the length of the JSON array of the "addr"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrLenEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldAddr), n, sqljson.DotPath(path)))
+	})
+}
+
+// AddrLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "addr"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrLenNEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldAddr), n, sqljson.DotPath(path)))
+	})
+}
+
+// AddrLenGT applies the LenGT predicate on the length of the JSON array of the "addr"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrLenGT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldAddr), n, sqljson.DotPath(path)))
+	})
+}
+
+// AddrLenGTE applies the LenGTE predicate on the length of the JSON array of the "addr"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrLenGTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldAddr), n, sqljson.DotPath(path)))
+	})
+}
+
+// AddrLenLT applies the LenLT predicate on the length of the JSON array of the "addr"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrLenLT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldAddr), n, sqljson.DotPath(path)))
+	})
+}
+
+// AddrLenLTE applies the LenLTE predicate on the length of the JSON array of the "addr"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func AddrLenLTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldAddr), n, sqljson.DotPath(path)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
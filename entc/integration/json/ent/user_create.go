@@ -14,6 +14,8 @@ import (
 	"net/http"
 	"net/url"
 
+	"entgo.io/ent/dialect/sql/sqlcodec"
+	"entgo.io/ent/dialect/sql/sqlcompress"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/json/ent/schema"
 	"entgo.io/ent/entc/integration/json/ent/user"
@@ -83,6 +85,46 @@ func (uc *UserCreate) SetNillableAddr(s *schema.Addr) *UserCreate {
 	return uc
 }
 
+// SetPayload sets the "payload" field.
+func (uc *UserCreate) SetPayload(m map[string]interface{}) *UserCreate {
+	uc.mutation.SetPayload(m)
+	return uc
+}
+
+// SetBody sets the "body" field.
+func (uc *UserCreate) SetBody(s string) *UserCreate {
+	uc.mutation.SetBody(s)
+	return uc
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (uc *UserCreate) SetNillableBody(s *string) *UserCreate {
+	if s != nil {
+		uc.SetBody(*s)
+	}
+	return uc
+}
+
+// SetSecret sets the "secret" field.
+func (uc *UserCreate) SetSecret(s string) *UserCreate {
+	uc.mutation.SetSecret(s)
+	return uc
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetSecret(*s)
+	}
+	return uc
+}
+
+// SetMeta sets the "meta" field.
+func (uc *UserCreate) SetMeta(m map[string]string) *UserCreate {
+	uc.mutation.SetMeta(m)
+	return uc
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -266,6 +308,38 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		})
 		_node.Addr = value
 	}
+	if value, ok := uc.mutation.Payload(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcompress.JSON("gzip", value),
+			Column: user.FieldPayload,
+		})
+		_node.Payload = value
+	}
+	if value, ok := uc.mutation.Body(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcompress.Value("gzip", value),
+			Column: user.FieldBody,
+		})
+		_node.Body = value
+	}
+	if value, ok := uc.mutation.Secret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcodec.Value(user.SecretCodec, value),
+			Column: user.FieldSecret,
+		})
+		_node.Secret = value
+	}
+	if value, ok := uc.mutation.Meta(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcodec.JSON(user.MetaCodec, value),
+			Column: user.FieldMeta,
+		})
+		_node.Meta = value
+	}
 	return _node, _spec
 }
 
@@ -15,6 +15,8 @@ import (
 	"net/url"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlcodec"
+	"entgo.io/ent/dialect/sql/sqlcompress"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/json/ent/predicate"
 	"entgo.io/ent/entc/integration/json/ent/schema"
@@ -65,6 +67,12 @@ func (uu *UserUpdate) SetRaw(jm json.RawMessage) *UserUpdate {
 	return uu
 }
 
+// AppendRaw appends jm to the "raw" field in the database.
+func (uu *UserUpdate) AppendRaw(jm json.RawMessage) *UserUpdate {
+	uu.mutation.AppendRaw(jm)
+	return uu
+}
+
 // ClearRaw clears the value of the "raw" field.
 func (uu *UserUpdate) ClearRaw() *UserUpdate {
 	uu.mutation.ClearRaw()
@@ -77,12 +85,24 @@ func (uu *UserUpdate) SetDirs(h []http.Dir) *UserUpdate {
 	return uu
 }
 
+// AppendDirs appends h to the "dirs" field in the database.
+func (uu *UserUpdate) AppendDirs(h []http.Dir) *UserUpdate {
+	uu.mutation.AppendDirs(h)
+	return uu
+}
+
 // SetInts sets the "ints" field.
 func (uu *UserUpdate) SetInts(i []int) *UserUpdate {
 	uu.mutation.SetInts(i)
 	return uu
 }
 
+// AppendInts appends i to the "ints" field in the database.
+func (uu *UserUpdate) AppendInts(i []int) *UserUpdate {
+	uu.mutation.AppendInts(i)
+	return uu
+}
+
 // ClearInts clears the value of the "ints" field.
 func (uu *UserUpdate) ClearInts() *UserUpdate {
 	uu.mutation.ClearInts()
@@ -95,6 +115,12 @@ func (uu *UserUpdate) SetFloats(f []float64) *UserUpdate {
 	return uu
 }
 
+// AppendFloats appends f to the "floats" field in the database.
+func (uu *UserUpdate) AppendFloats(f []float64) *UserUpdate {
+	uu.mutation.AppendFloats(f)
+	return uu
+}
+
 // ClearFloats clears the value of the "floats" field.
 func (uu *UserUpdate) ClearFloats() *UserUpdate {
 	uu.mutation.ClearFloats()
@@ -107,6 +133,12 @@ func (uu *UserUpdate) SetStrings(s []string) *UserUpdate {
 	return uu
 }
 
+// AppendStrings appends s to the "strings" field in the database.
+func (uu *UserUpdate) AppendStrings(s []string) *UserUpdate {
+	uu.mutation.AppendStrings(s)
+	return uu
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (uu *UserUpdate) ClearStrings() *UserUpdate {
 	uu.mutation.ClearStrings()
@@ -133,6 +165,70 @@ func (uu *UserUpdate) ClearAddr() *UserUpdate {
 	return uu
 }
 
+// SetPayload sets the "payload" field.
+func (uu *UserUpdate) SetPayload(m map[string]interface{}) *UserUpdate {
+	uu.mutation.SetPayload(m)
+	return uu
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (uu *UserUpdate) ClearPayload() *UserUpdate {
+	uu.mutation.ClearPayload()
+	return uu
+}
+
+// SetBody sets the "body" field.
+func (uu *UserUpdate) SetBody(s string) *UserUpdate {
+	uu.mutation.SetBody(s)
+	return uu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableBody(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetBody(*s)
+	}
+	return uu
+}
+
+// ClearBody clears the value of the "body" field.
+func (uu *UserUpdate) ClearBody() *UserUpdate {
+	uu.mutation.ClearBody()
+	return uu
+}
+
+// SetSecret sets the "secret" field.
+func (uu *UserUpdate) SetSecret(s string) *UserUpdate {
+	uu.mutation.SetSecret(s)
+	return uu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSecret(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetSecret(*s)
+	}
+	return uu
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (uu *UserUpdate) ClearSecret() *UserUpdate {
+	uu.mutation.ClearSecret()
+	return uu
+}
+
+// SetMeta sets the "meta" field.
+func (uu *UserUpdate) SetMeta(m map[string]string) *UserUpdate {
+	uu.mutation.SetMeta(m)
+	return uu
+}
+
+// ClearMeta clears the value of the "meta" field.
+func (uu *UserUpdate) ClearMeta() *UserUpdate {
+	uu.mutation.ClearMeta()
+	return uu
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -243,6 +339,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldRaw,
 		})
 	}
+	if value, ok := uu.mutation.AppendedRaw(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldRaw,
+		})
+	}
 	if uu.mutation.RawCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -256,6 +359,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldDirs,
 		})
 	}
+	if value, ok := uu.mutation.AppendedDirs(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldDirs,
+		})
+	}
 	if value, ok := uu.mutation.Ints(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -263,6 +373,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldInts,
 		})
 	}
+	if value, ok := uu.mutation.AppendedInts(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldInts,
+		})
+	}
 	if uu.mutation.IntsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -276,6 +393,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldFloats,
 		})
 	}
+	if value, ok := uu.mutation.AppendedFloats(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldFloats,
+		})
+	}
 	if uu.mutation.FloatsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -289,6 +413,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldStrings,
 		})
 	}
+	if value, ok := uu.mutation.AppendedStrings(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldStrings,
+		})
+	}
 	if uu.mutation.StringsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -308,9 +439,61 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldAddr,
 		})
 	}
+	if value, ok := uu.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcompress.JSON("gzip", value),
+			Column: user.FieldPayload,
+		})
+	}
+	if uu.mutation.PayloadCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldPayload,
+		})
+	}
+	if value, ok := uu.mutation.Body(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcompress.Value("gzip", value),
+			Column: user.FieldBody,
+		})
+	}
+	if uu.mutation.BodyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldBody,
+		})
+	}
+	if value, ok := uu.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcodec.Value(user.SecretCodec, value),
+			Column: user.FieldSecret,
+		})
+	}
+	if uu.mutation.SecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldSecret,
+		})
+	}
+	if value, ok := uu.mutation.Meta(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcodec.JSON(user.MetaCodec, value),
+			Column: user.FieldMeta,
+		})
+	}
+	if uu.mutation.MetaCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldMeta,
+		})
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -322,9 +505,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields     []string
+	conditions []predicate.User
+	hooks      []Hook
+	mutation   *UserMutation
+}
+
+// Where appends a list of conditions to the UserUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.conditions = append(uuo.conditions, ps...)
+	return uuo
 }
 
 // SetT sets the "t" field.
@@ -357,6 +549,12 @@ func (uuo *UserUpdateOne) SetRaw(jm json.RawMessage) *UserUpdateOne {
 	return uuo
 }
 
+// AppendRaw appends jm to the "raw" field in the database.
+func (uuo *UserUpdateOne) AppendRaw(jm json.RawMessage) *UserUpdateOne {
+	uuo.mutation.AppendRaw(jm)
+	return uuo
+}
+
 // ClearRaw clears the value of the "raw" field.
 func (uuo *UserUpdateOne) ClearRaw() *UserUpdateOne {
 	uuo.mutation.ClearRaw()
@@ -369,12 +567,24 @@ func (uuo *UserUpdateOne) SetDirs(h []http.Dir) *UserUpdateOne {
 	return uuo
 }
 
+// AppendDirs appends h to the "dirs" field in the database.
+func (uuo *UserUpdateOne) AppendDirs(h []http.Dir) *UserUpdateOne {
+	uuo.mutation.AppendDirs(h)
+	return uuo
+}
+
 // SetInts sets the "ints" field.
 func (uuo *UserUpdateOne) SetInts(i []int) *UserUpdateOne {
 	uuo.mutation.SetInts(i)
 	return uuo
 }
 
+// AppendInts appends i to the "ints" field in the database.
+func (uuo *UserUpdateOne) AppendInts(i []int) *UserUpdateOne {
+	uuo.mutation.AppendInts(i)
+	return uuo
+}
+
 // ClearInts clears the value of the "ints" field.
 func (uuo *UserUpdateOne) ClearInts() *UserUpdateOne {
 	uuo.mutation.ClearInts()
@@ -387,6 +597,12 @@ func (uuo *UserUpdateOne) SetFloats(f []float64) *UserUpdateOne {
 	return uuo
 }
 
+// AppendFloats appends f to the "floats" field in the database.
+func (uuo *UserUpdateOne) AppendFloats(f []float64) *UserUpdateOne {
+	uuo.mutation.AppendFloats(f)
+	return uuo
+}
+
 // ClearFloats clears the value of the "floats" field.
 func (uuo *UserUpdateOne) ClearFloats() *UserUpdateOne {
 	uuo.mutation.ClearFloats()
@@ -399,6 +615,12 @@ func (uuo *UserUpdateOne) SetStrings(s []string) *UserUpdateOne {
 	return uuo
 }
 
+// AppendStrings appends s to the "strings" field in the database.
+func (uuo *UserUpdateOne) AppendStrings(s []string) *UserUpdateOne {
+	uuo.mutation.AppendStrings(s)
+	return uuo
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (uuo *UserUpdateOne) ClearStrings() *UserUpdateOne {
 	uuo.mutation.ClearStrings()
@@ -425,6 +647,70 @@ func (uuo *UserUpdateOne) ClearAddr() *UserUpdateOne {
 	return uuo
 }
 
+// SetPayload sets the "payload" field.
+func (uuo *UserUpdateOne) SetPayload(m map[string]interface{}) *UserUpdateOne {
+	uuo.mutation.SetPayload(m)
+	return uuo
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (uuo *UserUpdateOne) ClearPayload() *UserUpdateOne {
+	uuo.mutation.ClearPayload()
+	return uuo
+}
+
+// SetBody sets the "body" field.
+func (uuo *UserUpdateOne) SetBody(s string) *UserUpdateOne {
+	uuo.mutation.SetBody(s)
+	return uuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBody(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetBody(*s)
+	}
+	return uuo
+}
+
+// ClearBody clears the value of the "body" field.
+func (uuo *UserUpdateOne) ClearBody() *UserUpdateOne {
+	uuo.mutation.ClearBody()
+	return uuo
+}
+
+// SetSecret sets the "secret" field.
+func (uuo *UserUpdateOne) SetSecret(s string) *UserUpdateOne {
+	uuo.mutation.SetSecret(s)
+	return uuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSecret(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetSecret(*s)
+	}
+	return uuo
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (uuo *UserUpdateOne) ClearSecret() *UserUpdateOne {
+	uuo.mutation.ClearSecret()
+	return uuo
+}
+
+// SetMeta sets the "meta" field.
+func (uuo *UserUpdateOne) SetMeta(m map[string]string) *UserUpdateOne {
+	uuo.mutation.SetMeta(m)
+	return uuo
+}
+
+// ClearMeta clears the value of the "meta" field.
+func (uuo *UserUpdateOne) ClearMeta() *UserUpdateOne {
+	uuo.mutation.ClearMeta()
+	return uuo
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -532,6 +818,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			}
 		}
 	}
+	if ps := uuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.T(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -565,6 +858,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldRaw,
 		})
 	}
+	if value, ok := uuo.mutation.AppendedRaw(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldRaw,
+		})
+	}
 	if uuo.mutation.RawCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -578,6 +878,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldDirs,
 		})
 	}
+	if value, ok := uuo.mutation.AppendedDirs(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldDirs,
+		})
+	}
 	if value, ok := uuo.mutation.Ints(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -585,6 +892,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldInts,
 		})
 	}
+	if value, ok := uuo.mutation.AppendedInts(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldInts,
+		})
+	}
 	if uuo.mutation.IntsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -598,6 +912,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldFloats,
 		})
 	}
+	if value, ok := uuo.mutation.AppendedFloats(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldFloats,
+		})
+	}
 	if uuo.mutation.FloatsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -611,6 +932,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldStrings,
 		})
 	}
+	if value, ok := uuo.mutation.AppendedStrings(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldStrings,
+		})
+	}
 	if uuo.mutation.StringsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -630,12 +958,66 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldAddr,
 		})
 	}
+	if value, ok := uuo.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcompress.JSON("gzip", value),
+			Column: user.FieldPayload,
+		})
+	}
+	if uuo.mutation.PayloadCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldPayload,
+		})
+	}
+	if value, ok := uuo.mutation.Body(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcompress.Value("gzip", value),
+			Column: user.FieldBody,
+		})
+	}
+	if uuo.mutation.BodyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldBody,
+		})
+	}
+	if value, ok := uuo.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcodec.Value(user.SecretCodec, value),
+			Column: user.FieldSecret,
+		})
+	}
+	if uuo.mutation.SecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldSecret,
+		})
+	}
+	if value, ok := uuo.mutation.Meta(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  sqlcodec.JSON(user.MetaCodec, value),
+			Column: user.FieldMeta,
+		})
+	}
+	if uuo.mutation.MetaCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldMeta,
+		})
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: user.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -6,12 +6,14 @@ package json
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 
 	"entgo.io/ent/dialect"
@@ -53,6 +55,9 @@ func TestMySQL(t *testing.T) {
 			// Skip predicates test for MySQL old versions.
 			if version != "56" {
 				Predicates(t, client)
+				Append(t, client)
+	Compressed(t, client)
+	Encoded(t, client)
 			}
 		})
 	}
@@ -85,6 +90,9 @@ func TestMaria(t *testing.T) {
 			NetAddr(t, client)
 			RawMessage(t, client)
 			Predicates(t, client)
+			Append(t, client)
+	Compressed(t, client)
+	Encoded(t, client)
 		})
 	}
 }
@@ -115,6 +123,9 @@ func TestPostgres(t *testing.T) {
 			NetAddr(t, client)
 			RawMessage(t, client)
 			Predicates(t, client)
+			Append(t, client)
+	Compressed(t, client)
+	Encoded(t, client)
 		})
 	}
 }
@@ -134,6 +145,9 @@ func TestSQLite(t *testing.T) {
 	NetAddr(t, client)
 	RawMessage(t, client)
 	Predicates(t, client)
+	Append(t, client)
+	Compressed(t, client)
+	Encoded(t, client)
 }
 
 func Ints(t *testing.T, client *ent.Client) {
@@ -155,6 +169,29 @@ func Ints(t *testing.T, client *ent.Client) {
 	require.Equal(t, []int{1, 2, 3}, usr.Ints)
 }
 
+func Append(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetInts([]int{1}).SetStrings([]string{"a"}).SaveX(ctx)
+	usr = usr.Update().AppendInts([]int{2, 3}).AppendStrings([]string{"b"}).AppendStrings([]string{"c"}).SaveX(ctx)
+	require.Equal(t, []int{1, 2, 3}, usr.Ints)
+	require.Equal(t, []string{"a", "b", "c"}, usr.Strings)
+	require.Equal(t, []string{"a", "b", "c"}, client.User.GetX(ctx, usr.ID).Strings)
+
+	t.Log("append to null columns")
+	usr = usr.Update().ClearStrings().SaveX(ctx)
+	usr = usr.Update().AppendStrings([]string{"d"}).SaveX(ctx)
+	require.Equal(t, []string{"d"}, usr.Strings)
+
+	t.Log("append to multiple rows")
+	n := client.User.Update().Where(user.ID(usr.ID)).AppendStrings([]string{"e"}).SaveX(ctx)
+	require.Equal(t, 1, n)
+	require.Equal(t, []string{"d", "e"}, client.User.GetX(ctx, usr.ID).Strings)
+
+	t.Log("set overrides append")
+	usr = usr.Update().AppendStrings([]string{"f"}).SetStrings([]string{"g"}).SaveX(ctx)
+	require.Equal(t, []string{"g"}, usr.Strings)
+}
+
 func Floats(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	flts := []float64{1, 2, 3}
@@ -231,6 +268,47 @@ func URL(t *testing.T, client *ent.Client) {
 	require.Equal(t, u, client.User.GetX(ctx, usr.ID).URL)
 }
 
+func Compressed(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	payload := map[string]interface{}{"type": "click", "count": float64(10)}
+	usr := client.User.Create().SetPayload(payload).SetBody("hello world").SaveX(ctx)
+	usr = client.User.GetX(ctx, usr.ID)
+	require.Equal(t, payload, usr.Payload)
+	require.Equal(t, "hello world", usr.Body)
+	raw := client.User.Query().Where(user.ID(usr.ID)).Select(user.FieldBody).StringsX(ctx)
+	require.Len(t, raw, 1)
+	require.True(t, strings.HasPrefix(raw[0], "\x00ez"), "value should be stored compressed")
+
+	usr = usr.Update().SetBody("").ClearPayload().SaveX(ctx)
+	usr = client.User.GetX(ctx, usr.ID)
+	require.Empty(t, usr.Body)
+	require.Nil(t, usr.Payload)
+}
+
+func Encoded(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	meta := map[string]string{"team": "ent"}
+	usr := client.User.Create().SetSecret("p@ssw0rd").SetMeta(meta).SaveX(ctx)
+	usr = client.User.GetX(ctx, usr.ID)
+	require.Equal(t, "p@ssw0rd", usr.Secret)
+	require.Equal(t, meta, usr.Meta)
+	raw := client.User.Query().Where(user.ID(usr.ID)).Select(user.FieldSecret).StringsX(ctx)
+	require.Equal(t, []string{base64.StdEncoding.EncodeToString([]byte("p@ssw0rd"))}, raw, "value should be stored encoded")
+
+	encoded := client.User.Query().Where(user.SecretNotNil(), user.MetaNotNil()).AllX(ctx)
+	require.Len(t, encoded, 1)
+	require.Equal(t, usr.ID, encoded[0].ID)
+	require.Equal(t, "p@ssw0rd", encoded[0].Secret, "values are decoded when queried")
+	require.Equal(t, meta, encoded[0].Meta)
+
+	usr = usr.Update().SetSecret("s3cr3t").ClearMeta().SaveX(ctx)
+	usr = client.User.GetX(ctx, usr.ID)
+	require.Equal(t, "s3cr3t", usr.Secret)
+	require.Nil(t, usr.Meta)
+	require.False(t, client.User.Query().Where(user.ID(usr.ID), user.MetaNotNil()).ExistX(ctx))
+	require.Equal(t, "s3cr3t", client.User.Query().Where(user.ID(usr.ID), user.MetaIsNil(), user.SecretNotNil()).OnlyX(ctx).Secret)
+}
+
 func Predicates(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 
@@ -409,4 +487,31 @@ func Predicates(t *testing.T, client *ent.Client) {
 			require.Equal(t, []string{"foo", "bar"}, r.T.Ls)
 		}
 	})
+
+	t.Run("Generated", func(t *testing.T) {
+		client.User.Delete().ExecX(ctx)
+		users := client.User.CreateBulk(
+			client.User.Create().SetInts([]int{1}).SetT(&schema.T{I: 1, S: "foo", T: &schema.T{I: 10}}),
+			client.User.Create().SetInts([]int{1, 2}).SetT(&schema.T{I: 2, Li: []int{1, 2}, T: &schema.T{I: 20}}),
+			client.User.Create().SetURL(u1),
+		).SaveX(ctx)
+
+		require.Equal(t, 2, client.User.Query().Where(user.THasKey("t.i")).CountX(ctx))
+		require.Equal(t, users[0].ID, client.User.Query().Where(user.TValueEQ("s", "foo")).OnlyIDX(ctx))
+		require.Equal(t, users[1].ID, client.User.Query().Where(user.TValueNEQ("i", 1)).OnlyIDX(ctx))
+		require.Equal(t, users[1].ID, client.User.Query().Where(user.TValueGT("t.i", 10)).OnlyIDX(ctx))
+		require.Equal(t, 2, client.User.Query().Where(user.TValueGTE("t.i", 10)).CountX(ctx))
+		require.Equal(t, users[0].ID, client.User.Query().Where(user.TValueLT("t.i", 20)).OnlyIDX(ctx))
+		require.Equal(t, 2, client.User.Query().Where(user.TValueLTE("i", 2)).CountX(ctx))
+		require.Equal(t, users[1].ID, client.User.Query().Where(user.TValueContains("li", 2)).OnlyIDX(ctx))
+		require.Equal(t, users[2].ID, client.User.Query().Where(user.IntsValueContains("", 3)).OnlyIDX(ctx), "ints has a default value")
+		require.Equal(t, users[2].ID, client.User.Query().Where(user.URLValueIsNull("User"), user.URLHasKey("Host")).OnlyIDX(ctx))
+
+		require.Equal(t, users[0].ID, client.User.Query().Where(user.IntsLenEQ("", 1)).OnlyIDX(ctx))
+		require.Equal(t, 2, client.User.Query().Where(user.IntsLenNEQ("", 1)).CountX(ctx))
+		require.Equal(t, users[1].ID, client.User.Query().Where(user.TLenGT("li", 1)).OnlyIDX(ctx))
+		require.Equal(t, 2, client.User.Query().Where(user.IntsLenGTE("", 2)).CountX(ctx))
+		require.Equal(t, users[0].ID, client.User.Query().Where(user.IntsLenLT("", 2)).OnlyIDX(ctx))
+		require.Equal(t, users[1].ID, client.User.Query().Where(user.TLenLTE("li", 2)).OnlyIDX(ctx))
+	})
 }
@@ -107,7 +107,7 @@ func (cdo *ConversionDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{conversion.Label}
+		return &NotFoundError{label: conversion.Label}
 	default:
 		return nil
 	}
@@ -72,6 +72,25 @@ func (cu *ConversionUpdate) AddInt8ToString(i int8) *ConversionUpdate {
 	return cu
 }
 
+// SetInt8ToStringIfGreater sets the "int8_to_string" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *ConversionUpdate) SetInt8ToStringIfGreater(i int8) *ConversionUpdate {
+	cu.mutation.SetInt8ToStringIfGreater(i)
+	return cu
+}
+
+// BitAndInt8ToString applies a bitwise AND with i on the "int8_to_string" field in the database.
+func (cu *ConversionUpdate) BitAndInt8ToString(i int8) *ConversionUpdate {
+	cu.mutation.BitAndInt8ToString(i)
+	return cu
+}
+
+// BitOrInt8ToString applies a bitwise OR with i on the "int8_to_string" field in the database.
+func (cu *ConversionUpdate) BitOrInt8ToString(i int8) *ConversionUpdate {
+	cu.mutation.BitOrInt8ToString(i)
+	return cu
+}
+
 // ClearInt8ToString clears the value of the "int8_to_string" field.
 func (cu *ConversionUpdate) ClearInt8ToString() *ConversionUpdate {
 	cu.mutation.ClearInt8ToString()
@@ -99,6 +118,25 @@ func (cu *ConversionUpdate) AddUint8ToString(u int8) *ConversionUpdate {
 	return cu
 }
 
+// SetUint8ToStringIfGreater sets the "uint8_to_string" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *ConversionUpdate) SetUint8ToStringIfGreater(u uint8) *ConversionUpdate {
+	cu.mutation.SetUint8ToStringIfGreater(u)
+	return cu
+}
+
+// BitAndUint8ToString applies a bitwise AND with u on the "uint8_to_string" field in the database.
+func (cu *ConversionUpdate) BitAndUint8ToString(u uint8) *ConversionUpdate {
+	cu.mutation.BitAndUint8ToString(u)
+	return cu
+}
+
+// BitOrUint8ToString applies a bitwise OR with u on the "uint8_to_string" field in the database.
+func (cu *ConversionUpdate) BitOrUint8ToString(u uint8) *ConversionUpdate {
+	cu.mutation.BitOrUint8ToString(u)
+	return cu
+}
+
 // ClearUint8ToString clears the value of the "uint8_to_string" field.
 func (cu *ConversionUpdate) ClearUint8ToString() *ConversionUpdate {
 	cu.mutation.ClearUint8ToString()
@@ -126,6 +164,25 @@ func (cu *ConversionUpdate) AddInt16ToString(i int16) *ConversionUpdate {
 	return cu
 }
 
+// SetInt16ToStringIfGreater sets the "int16_to_string" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *ConversionUpdate) SetInt16ToStringIfGreater(i int16) *ConversionUpdate {
+	cu.mutation.SetInt16ToStringIfGreater(i)
+	return cu
+}
+
+// BitAndInt16ToString applies a bitwise AND with i on the "int16_to_string" field in the database.
+func (cu *ConversionUpdate) BitAndInt16ToString(i int16) *ConversionUpdate {
+	cu.mutation.BitAndInt16ToString(i)
+	return cu
+}
+
+// BitOrInt16ToString applies a bitwise OR with i on the "int16_to_string" field in the database.
+func (cu *ConversionUpdate) BitOrInt16ToString(i int16) *ConversionUpdate {
+	cu.mutation.BitOrInt16ToString(i)
+	return cu
+}
+
 // ClearInt16ToString clears the value of the "int16_to_string" field.
 func (cu *ConversionUpdate) ClearInt16ToString() *ConversionUpdate {
 	cu.mutation.ClearInt16ToString()
@@ -153,6 +210,25 @@ func (cu *ConversionUpdate) AddUint16ToString(u int16) *ConversionUpdate {
 	return cu
 }
 
+// SetUint16ToStringIfGreater sets the "uint16_to_string" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *ConversionUpdate) SetUint16ToStringIfGreater(u uint16) *ConversionUpdate {
+	cu.mutation.SetUint16ToStringIfGreater(u)
+	return cu
+}
+
+// BitAndUint16ToString applies a bitwise AND with u on the "uint16_to_string" field in the database.
+func (cu *ConversionUpdate) BitAndUint16ToString(u uint16) *ConversionUpdate {
+	cu.mutation.BitAndUint16ToString(u)
+	return cu
+}
+
+// BitOrUint16ToString applies a bitwise OR with u on the "uint16_to_string" field in the database.
+func (cu *ConversionUpdate) BitOrUint16ToString(u uint16) *ConversionUpdate {
+	cu.mutation.BitOrUint16ToString(u)
+	return cu
+}
+
 // ClearUint16ToString clears the value of the "uint16_to_string" field.
 func (cu *ConversionUpdate) ClearUint16ToString() *ConversionUpdate {
 	cu.mutation.ClearUint16ToString()
@@ -180,6 +256,25 @@ func (cu *ConversionUpdate) AddInt32ToString(i int32) *ConversionUpdate {
 	return cu
 }
 
+// SetInt32ToStringIfGreater sets the "int32_to_string" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *ConversionUpdate) SetInt32ToStringIfGreater(i int32) *ConversionUpdate {
+	cu.mutation.SetInt32ToStringIfGreater(i)
+	return cu
+}
+
+// BitAndInt32ToString applies a bitwise AND with i on the "int32_to_string" field in the database.
+func (cu *ConversionUpdate) BitAndInt32ToString(i int32) *ConversionUpdate {
+	cu.mutation.BitAndInt32ToString(i)
+	return cu
+}
+
+// BitOrInt32ToString applies a bitwise OR with i on the "int32_to_string" field in the database.
+func (cu *ConversionUpdate) BitOrInt32ToString(i int32) *ConversionUpdate {
+	cu.mutation.BitOrInt32ToString(i)
+	return cu
+}
+
 // ClearInt32ToString clears the value of the "int32_to_string" field.
 func (cu *ConversionUpdate) ClearInt32ToString() *ConversionUpdate {
 	cu.mutation.ClearInt32ToString()
@@ -207,6 +302,25 @@ func (cu *ConversionUpdate) AddUint32ToString(u int32) *ConversionUpdate {
 	return cu
 }
 
+// SetUint32ToStringIfGreater sets the "uint32_to_string" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *ConversionUpdate) SetUint32ToStringIfGreater(u uint32) *ConversionUpdate {
+	cu.mutation.SetUint32ToStringIfGreater(u)
+	return cu
+}
+
+// BitAndUint32ToString applies a bitwise AND with u on the "uint32_to_string" field in the database.
+func (cu *ConversionUpdate) BitAndUint32ToString(u uint32) *ConversionUpdate {
+	cu.mutation.BitAndUint32ToString(u)
+	return cu
+}
+
+// BitOrUint32ToString applies a bitwise OR with u on the "uint32_to_string" field in the database.
+func (cu *ConversionUpdate) BitOrUint32ToString(u uint32) *ConversionUpdate {
+	cu.mutation.BitOrUint32ToString(u)
+	return cu
+}
+
 // ClearUint32ToString clears the value of the "uint32_to_string" field.
 func (cu *ConversionUpdate) ClearUint32ToString() *ConversionUpdate {
 	cu.mutation.ClearUint32ToString()
@@ -234,6 +348,25 @@ func (cu *ConversionUpdate) AddInt64ToString(i int64) *ConversionUpdate {
 	return cu
 }
 
+// SetInt64ToStringIfGreater sets the "int64_to_string" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *ConversionUpdate) SetInt64ToStringIfGreater(i int64) *ConversionUpdate {
+	cu.mutation.SetInt64ToStringIfGreater(i)
+	return cu
+}
+
+// BitAndInt64ToString applies a bitwise AND with i on the "int64_to_string" field in the database.
+func (cu *ConversionUpdate) BitAndInt64ToString(i int64) *ConversionUpdate {
+	cu.mutation.BitAndInt64ToString(i)
+	return cu
+}
+
+// BitOrInt64ToString applies a bitwise OR with i on the "int64_to_string" field in the database.
+func (cu *ConversionUpdate) BitOrInt64ToString(i int64) *ConversionUpdate {
+	cu.mutation.BitOrInt64ToString(i)
+	return cu
+}
+
 // ClearInt64ToString clears the value of the "int64_to_string" field.
 func (cu *ConversionUpdate) ClearInt64ToString() *ConversionUpdate {
 	cu.mutation.ClearInt64ToString()
@@ -261,6 +394,25 @@ func (cu *ConversionUpdate) AddUint64ToString(u int64) *ConversionUpdate {
 	return cu
 }
 
+// SetUint64ToStringIfGreater sets the "uint64_to_string" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cu *ConversionUpdate) SetUint64ToStringIfGreater(u uint64) *ConversionUpdate {
+	cu.mutation.SetUint64ToStringIfGreater(u)
+	return cu
+}
+
+// BitAndUint64ToString applies a bitwise AND with u on the "uint64_to_string" field in the database.
+func (cu *ConversionUpdate) BitAndUint64ToString(u uint64) *ConversionUpdate {
+	cu.mutation.BitAndUint64ToString(u)
+	return cu
+}
+
+// BitOrUint64ToString applies a bitwise OR with u on the "uint64_to_string" field in the database.
+func (cu *ConversionUpdate) BitOrUint64ToString(u uint64) *ConversionUpdate {
+	cu.mutation.BitOrUint64ToString(u)
+	return cu
+}
+
 // ClearUint64ToString clears the value of the "uint64_to_string" field.
 func (cu *ConversionUpdate) ClearUint64ToString() *ConversionUpdate {
 	cu.mutation.ClearUint64ToString()
@@ -371,6 +523,27 @@ func (cu *ConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: conversion.FieldInt8ToString,
 		})
 	}
+	if value, ok := cu.mutation.Int8ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: conversion.FieldInt8ToString,
+		})
+	}
+	if value, ok := cu.mutation.Int8ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: conversion.FieldInt8ToString,
+		})
+	}
+	if value, ok := cu.mutation.Int8ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: conversion.FieldInt8ToString,
+		})
+	}
 	if cu.mutation.Int8ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt8,
@@ -391,6 +564,27 @@ func (cu *ConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: conversion.FieldUint8ToString,
 		})
 	}
+	if value, ok := cu.mutation.Uint8ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: conversion.FieldUint8ToString,
+		})
+	}
+	if value, ok := cu.mutation.Uint8ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: conversion.FieldUint8ToString,
+		})
+	}
+	if value, ok := cu.mutation.Uint8ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: conversion.FieldUint8ToString,
+		})
+	}
 	if cu.mutation.Uint8ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint8,
@@ -411,6 +605,27 @@ func (cu *ConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: conversion.FieldInt16ToString,
 		})
 	}
+	if value, ok := cu.mutation.Int16ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: conversion.FieldInt16ToString,
+		})
+	}
+	if value, ok := cu.mutation.Int16ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: conversion.FieldInt16ToString,
+		})
+	}
+	if value, ok := cu.mutation.Int16ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: conversion.FieldInt16ToString,
+		})
+	}
 	if cu.mutation.Int16ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt16,
@@ -431,6 +646,27 @@ func (cu *ConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: conversion.FieldUint16ToString,
 		})
 	}
+	if value, ok := cu.mutation.Uint16ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: conversion.FieldUint16ToString,
+		})
+	}
+	if value, ok := cu.mutation.Uint16ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: conversion.FieldUint16ToString,
+		})
+	}
+	if value, ok := cu.mutation.Uint16ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: conversion.FieldUint16ToString,
+		})
+	}
 	if cu.mutation.Uint16ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint16,
@@ -451,6 +687,27 @@ func (cu *ConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: conversion.FieldInt32ToString,
 		})
 	}
+	if value, ok := cu.mutation.Int32ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: conversion.FieldInt32ToString,
+		})
+	}
+	if value, ok := cu.mutation.Int32ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: conversion.FieldInt32ToString,
+		})
+	}
+	if value, ok := cu.mutation.Int32ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: conversion.FieldInt32ToString,
+		})
+	}
 	if cu.mutation.Int32ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -471,6 +728,27 @@ func (cu *ConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: conversion.FieldUint32ToString,
 		})
 	}
+	if value, ok := cu.mutation.Uint32ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: conversion.FieldUint32ToString,
+		})
+	}
+	if value, ok := cu.mutation.Uint32ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: conversion.FieldUint32ToString,
+		})
+	}
+	if value, ok := cu.mutation.Uint32ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: conversion.FieldUint32ToString,
+		})
+	}
 	if cu.mutation.Uint32ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint32,
@@ -491,6 +769,27 @@ func (cu *ConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: conversion.FieldInt64ToString,
 		})
 	}
+	if value, ok := cu.mutation.Int64ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: conversion.FieldInt64ToString,
+		})
+	}
+	if value, ok := cu.mutation.Int64ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: conversion.FieldInt64ToString,
+		})
+	}
+	if value, ok := cu.mutation.Int64ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: conversion.FieldInt64ToString,
+		})
+	}
 	if cu.mutation.Int64ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -511,6 +810,27 @@ func (cu *ConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: conversion.FieldUint64ToString,
 		})
 	}
+	if value, ok := cu.mutation.Uint64ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: conversion.FieldUint64ToString,
+		})
+	}
+	if value, ok := cu.mutation.Uint64ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: conversion.FieldUint64ToString,
+		})
+	}
+	if value, ok := cu.mutation.Uint64ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: conversion.FieldUint64ToString,
+		})
+	}
 	if cu.mutation.Uint64ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint64,
@@ -519,7 +839,7 @@ func (cu *ConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{conversion.Label}
+			err = &NotFoundError{label: conversion.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -531,9 +851,18 @@ func (cu *ConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // ConversionUpdateOne is the builder for updating a single Conversion entity.
 type ConversionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ConversionMutation
+	fields     []string
+	conditions []predicate.Conversion
+	hooks      []Hook
+	mutation   *ConversionMutation
+}
+
+// Where appends a list of conditions to the ConversionUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (cuo *ConversionUpdateOne) Where(ps ...predicate.Conversion) *ConversionUpdateOne {
+	cuo.conditions = append(cuo.conditions, ps...)
+	return cuo
 }
 
 // SetName sets the "name" field.
@@ -577,6 +906,25 @@ func (cuo *ConversionUpdateOne) AddInt8ToString(i int8) *ConversionUpdateOne {
 	return cuo
 }
 
+// SetInt8ToStringIfGreater sets the "int8_to_string" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *ConversionUpdateOne) SetInt8ToStringIfGreater(i int8) *ConversionUpdateOne {
+	cuo.mutation.SetInt8ToStringIfGreater(i)
+	return cuo
+}
+
+// BitAndInt8ToString applies a bitwise AND with i on the "int8_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitAndInt8ToString(i int8) *ConversionUpdateOne {
+	cuo.mutation.BitAndInt8ToString(i)
+	return cuo
+}
+
+// BitOrInt8ToString applies a bitwise OR with i on the "int8_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitOrInt8ToString(i int8) *ConversionUpdateOne {
+	cuo.mutation.BitOrInt8ToString(i)
+	return cuo
+}
+
 // ClearInt8ToString clears the value of the "int8_to_string" field.
 func (cuo *ConversionUpdateOne) ClearInt8ToString() *ConversionUpdateOne {
 	cuo.mutation.ClearInt8ToString()
@@ -604,6 +952,25 @@ func (cuo *ConversionUpdateOne) AddUint8ToString(u int8) *ConversionUpdateOne {
 	return cuo
 }
 
+// SetUint8ToStringIfGreater sets the "uint8_to_string" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *ConversionUpdateOne) SetUint8ToStringIfGreater(u uint8) *ConversionUpdateOne {
+	cuo.mutation.SetUint8ToStringIfGreater(u)
+	return cuo
+}
+
+// BitAndUint8ToString applies a bitwise AND with u on the "uint8_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitAndUint8ToString(u uint8) *ConversionUpdateOne {
+	cuo.mutation.BitAndUint8ToString(u)
+	return cuo
+}
+
+// BitOrUint8ToString applies a bitwise OR with u on the "uint8_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitOrUint8ToString(u uint8) *ConversionUpdateOne {
+	cuo.mutation.BitOrUint8ToString(u)
+	return cuo
+}
+
 // ClearUint8ToString clears the value of the "uint8_to_string" field.
 func (cuo *ConversionUpdateOne) ClearUint8ToString() *ConversionUpdateOne {
 	cuo.mutation.ClearUint8ToString()
@@ -631,6 +998,25 @@ func (cuo *ConversionUpdateOne) AddInt16ToString(i int16) *ConversionUpdateOne {
 	return cuo
 }
 
+// SetInt16ToStringIfGreater sets the "int16_to_string" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *ConversionUpdateOne) SetInt16ToStringIfGreater(i int16) *ConversionUpdateOne {
+	cuo.mutation.SetInt16ToStringIfGreater(i)
+	return cuo
+}
+
+// BitAndInt16ToString applies a bitwise AND with i on the "int16_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitAndInt16ToString(i int16) *ConversionUpdateOne {
+	cuo.mutation.BitAndInt16ToString(i)
+	return cuo
+}
+
+// BitOrInt16ToString applies a bitwise OR with i on the "int16_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitOrInt16ToString(i int16) *ConversionUpdateOne {
+	cuo.mutation.BitOrInt16ToString(i)
+	return cuo
+}
+
 // ClearInt16ToString clears the value of the "int16_to_string" field.
 func (cuo *ConversionUpdateOne) ClearInt16ToString() *ConversionUpdateOne {
 	cuo.mutation.ClearInt16ToString()
@@ -658,6 +1044,25 @@ func (cuo *ConversionUpdateOne) AddUint16ToString(u int16) *ConversionUpdateOne
 	return cuo
 }
 
+// SetUint16ToStringIfGreater sets the "uint16_to_string" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *ConversionUpdateOne) SetUint16ToStringIfGreater(u uint16) *ConversionUpdateOne {
+	cuo.mutation.SetUint16ToStringIfGreater(u)
+	return cuo
+}
+
+// BitAndUint16ToString applies a bitwise AND with u on the "uint16_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitAndUint16ToString(u uint16) *ConversionUpdateOne {
+	cuo.mutation.BitAndUint16ToString(u)
+	return cuo
+}
+
+// BitOrUint16ToString applies a bitwise OR with u on the "uint16_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitOrUint16ToString(u uint16) *ConversionUpdateOne {
+	cuo.mutation.BitOrUint16ToString(u)
+	return cuo
+}
+
 // ClearUint16ToString clears the value of the "uint16_to_string" field.
 func (cuo *ConversionUpdateOne) ClearUint16ToString() *ConversionUpdateOne {
 	cuo.mutation.ClearUint16ToString()
@@ -685,6 +1090,25 @@ func (cuo *ConversionUpdateOne) AddInt32ToString(i int32) *ConversionUpdateOne {
 	return cuo
 }
 
+// SetInt32ToStringIfGreater sets the "int32_to_string" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *ConversionUpdateOne) SetInt32ToStringIfGreater(i int32) *ConversionUpdateOne {
+	cuo.mutation.SetInt32ToStringIfGreater(i)
+	return cuo
+}
+
+// BitAndInt32ToString applies a bitwise AND with i on the "int32_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitAndInt32ToString(i int32) *ConversionUpdateOne {
+	cuo.mutation.BitAndInt32ToString(i)
+	return cuo
+}
+
+// BitOrInt32ToString applies a bitwise OR with i on the "int32_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitOrInt32ToString(i int32) *ConversionUpdateOne {
+	cuo.mutation.BitOrInt32ToString(i)
+	return cuo
+}
+
 // ClearInt32ToString clears the value of the "int32_to_string" field.
 func (cuo *ConversionUpdateOne) ClearInt32ToString() *ConversionUpdateOne {
 	cuo.mutation.ClearInt32ToString()
@@ -712,6 +1136,25 @@ func (cuo *ConversionUpdateOne) AddUint32ToString(u int32) *ConversionUpdateOne
 	return cuo
 }
 
+// SetUint32ToStringIfGreater sets the "uint32_to_string" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *ConversionUpdateOne) SetUint32ToStringIfGreater(u uint32) *ConversionUpdateOne {
+	cuo.mutation.SetUint32ToStringIfGreater(u)
+	return cuo
+}
+
+// BitAndUint32ToString applies a bitwise AND with u on the "uint32_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitAndUint32ToString(u uint32) *ConversionUpdateOne {
+	cuo.mutation.BitAndUint32ToString(u)
+	return cuo
+}
+
+// BitOrUint32ToString applies a bitwise OR with u on the "uint32_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitOrUint32ToString(u uint32) *ConversionUpdateOne {
+	cuo.mutation.BitOrUint32ToString(u)
+	return cuo
+}
+
 // ClearUint32ToString clears the value of the "uint32_to_string" field.
 func (cuo *ConversionUpdateOne) ClearUint32ToString() *ConversionUpdateOne {
 	cuo.mutation.ClearUint32ToString()
@@ -739,6 +1182,25 @@ func (cuo *ConversionUpdateOne) AddInt64ToString(i int64) *ConversionUpdateOne {
 	return cuo
 }
 
+// SetInt64ToStringIfGreater sets the "int64_to_string" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *ConversionUpdateOne) SetInt64ToStringIfGreater(i int64) *ConversionUpdateOne {
+	cuo.mutation.SetInt64ToStringIfGreater(i)
+	return cuo
+}
+
+// BitAndInt64ToString applies a bitwise AND with i on the "int64_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitAndInt64ToString(i int64) *ConversionUpdateOne {
+	cuo.mutation.BitAndInt64ToString(i)
+	return cuo
+}
+
+// BitOrInt64ToString applies a bitwise OR with i on the "int64_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitOrInt64ToString(i int64) *ConversionUpdateOne {
+	cuo.mutation.BitOrInt64ToString(i)
+	return cuo
+}
+
 // ClearInt64ToString clears the value of the "int64_to_string" field.
 func (cuo *ConversionUpdateOne) ClearInt64ToString() *ConversionUpdateOne {
 	cuo.mutation.ClearInt64ToString()
@@ -766,6 +1228,25 @@ func (cuo *ConversionUpdateOne) AddUint64ToString(u int64) *ConversionUpdateOne
 	return cuo
 }
 
+// SetUint64ToStringIfGreater sets the "uint64_to_string" field to u, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (cuo *ConversionUpdateOne) SetUint64ToStringIfGreater(u uint64) *ConversionUpdateOne {
+	cuo.mutation.SetUint64ToStringIfGreater(u)
+	return cuo
+}
+
+// BitAndUint64ToString applies a bitwise AND with u on the "uint64_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitAndUint64ToString(u uint64) *ConversionUpdateOne {
+	cuo.mutation.BitAndUint64ToString(u)
+	return cuo
+}
+
+// BitOrUint64ToString applies a bitwise OR with u on the "uint64_to_string" field in the database.
+func (cuo *ConversionUpdateOne) BitOrUint64ToString(u uint64) *ConversionUpdateOne {
+	cuo.mutation.BitOrUint64ToString(u)
+	return cuo
+}
+
 // ClearUint64ToString clears the value of the "uint64_to_string" field.
 func (cuo *ConversionUpdateOne) ClearUint64ToString() *ConversionUpdateOne {
 	cuo.mutation.ClearUint64ToString()
@@ -879,6 +1360,13 @@ func (cuo *ConversionUpdateOne) sqlSave(ctx context.Context) (_node *Conversion,
 			}
 		}
 	}
+	if ps := cuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := cuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -906,6 +1394,27 @@ func (cuo *ConversionUpdateOne) sqlSave(ctx context.Context) (_node *Conversion,
 			Column: conversion.FieldInt8ToString,
 		})
 	}
+	if value, ok := cuo.mutation.Int8ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: conversion.FieldInt8ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Int8ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: conversion.FieldInt8ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Int8ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: conversion.FieldInt8ToString,
+		})
+	}
 	if cuo.mutation.Int8ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt8,
@@ -926,6 +1435,27 @@ func (cuo *ConversionUpdateOne) sqlSave(ctx context.Context) (_node *Conversion,
 			Column: conversion.FieldUint8ToString,
 		})
 	}
+	if value, ok := cuo.mutation.Uint8ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: conversion.FieldUint8ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Uint8ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: conversion.FieldUint8ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Uint8ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: conversion.FieldUint8ToString,
+		})
+	}
 	if cuo.mutation.Uint8ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint8,
@@ -946,6 +1476,27 @@ func (cuo *ConversionUpdateOne) sqlSave(ctx context.Context) (_node *Conversion,
 			Column: conversion.FieldInt16ToString,
 		})
 	}
+	if value, ok := cuo.mutation.Int16ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: conversion.FieldInt16ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Int16ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: conversion.FieldInt16ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Int16ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: conversion.FieldInt16ToString,
+		})
+	}
 	if cuo.mutation.Int16ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt16,
@@ -966,6 +1517,27 @@ func (cuo *ConversionUpdateOne) sqlSave(ctx context.Context) (_node *Conversion,
 			Column: conversion.FieldUint16ToString,
 		})
 	}
+	if value, ok := cuo.mutation.Uint16ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: conversion.FieldUint16ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Uint16ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: conversion.FieldUint16ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Uint16ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: conversion.FieldUint16ToString,
+		})
+	}
 	if cuo.mutation.Uint16ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint16,
@@ -986,6 +1558,27 @@ func (cuo *ConversionUpdateOne) sqlSave(ctx context.Context) (_node *Conversion,
 			Column: conversion.FieldInt32ToString,
 		})
 	}
+	if value, ok := cuo.mutation.Int32ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: conversion.FieldInt32ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Int32ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: conversion.FieldInt32ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Int32ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: conversion.FieldInt32ToString,
+		})
+	}
 	if cuo.mutation.Int32ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -1006,6 +1599,27 @@ func (cuo *ConversionUpdateOne) sqlSave(ctx context.Context) (_node *Conversion,
 			Column: conversion.FieldUint32ToString,
 		})
 	}
+	if value, ok := cuo.mutation.Uint32ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: conversion.FieldUint32ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Uint32ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: conversion.FieldUint32ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Uint32ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: conversion.FieldUint32ToString,
+		})
+	}
 	if cuo.mutation.Uint32ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint32,
@@ -1026,6 +1640,27 @@ func (cuo *ConversionUpdateOne) sqlSave(ctx context.Context) (_node *Conversion,
 			Column: conversion.FieldInt64ToString,
 		})
 	}
+	if value, ok := cuo.mutation.Int64ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: conversion.FieldInt64ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Int64ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: conversion.FieldInt64ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Int64ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: conversion.FieldInt64ToString,
+		})
+	}
 	if cuo.mutation.Int64ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -1046,6 +1681,27 @@ func (cuo *ConversionUpdateOne) sqlSave(ctx context.Context) (_node *Conversion,
 			Column: conversion.FieldUint64ToString,
 		})
 	}
+	if value, ok := cuo.mutation.Uint64ToStringIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: conversion.FieldUint64ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Uint64ToStringBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: conversion.FieldUint64ToString,
+		})
+	}
+	if value, ok := cuo.mutation.Uint64ToStringBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: conversion.FieldUint64ToString,
+		})
+	}
 	if cuo.mutation.Uint64ToStringCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint64,
@@ -1057,7 +1713,9 @@ func (cuo *ConversionUpdateOne) sqlSave(ctx context.Context) (_node *Conversion,
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{conversion.Label}
+			err = &NotFoundError{label: conversion.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: conversion.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
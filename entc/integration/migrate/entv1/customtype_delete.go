@@ -107,7 +107,7 @@ func (ctdo *CustomTypeDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{customtype.Label}
+		return &NotFoundError{label: customtype.Label}
 	default:
 		return nil
 	}
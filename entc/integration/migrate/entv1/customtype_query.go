@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/migrate/entv1/customtype"
@@ -26,6 +27,7 @@ type CustomTypeQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.CustomType
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -71,7 +73,7 @@ func (ctq *CustomTypeQuery) First(ctx context.Context) (*CustomType, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{customtype.Label}
+		return nil, ctq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -93,7 +95,7 @@ func (ctq *CustomTypeQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{customtype.Label}
+		err = ctq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -120,7 +122,7 @@ func (ctq *CustomTypeQuery) Only(ctx context.Context) (*CustomType, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{customtype.Label}
+		return nil, ctq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{customtype.Label}
 	}
@@ -147,7 +149,7 @@ func (ctq *CustomTypeQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{customtype.Label}
+		err = ctq.sqlNotFound()
 	default:
 		err = &NotSingularError{customtype.Label}
 	}
@@ -168,7 +170,17 @@ func (ctq *CustomTypeQuery) All(ctx context.Context) ([]*CustomType, error) {
 	if err := ctq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ctq.sqlAll(ctx)
+	v, err := ctq.intercept(ctx, "All", func(ctx context.Context, query *CustomTypeQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*CustomType)
+	if !ok {
+		return nil, fmt.Errorf("entv1: unexpected type %T returned from the interceptors of All, expect []*CustomType", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -182,10 +194,20 @@ func (ctq *CustomTypeQuery) AllX(ctx context.Context) []*CustomType {
 
 // IDs executes the query and returns a list of CustomType IDs.
 func (ctq *CustomTypeQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := ctq.Select(customtype.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := ctq.intercept(ctx, "IDs", func(ctx context.Context, query *CustomTypeQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(customtype.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("entv1: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -203,7 +225,17 @@ func (ctq *CustomTypeQuery) Count(ctx context.Context) (int, error) {
 	if err := ctq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ctq.sqlCount(ctx)
+	v, err := ctq.intercept(ctx, "Count", func(ctx context.Context, query *CustomTypeQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("entv1: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -220,7 +252,47 @@ func (ctq *CustomTypeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ctq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ctq.sqlExist(ctx)
+	v, err := ctq.intercept(ctx, "Exist", func(ctx context.Context, query *CustomTypeQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("entv1: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (ctq *CustomTypeQuery) intercept(ctx context.Context, op string, fn func(context.Context, *CustomTypeQuery) (Value, error)) (Value, error) {
+	if len(ctq.inters) == 0 {
+		return fn(ctx, ctq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeCustomType,
+		Op:     op,
+		Limit:  ctq.limit,
+		Offset: ctq.offset,
+		Unique: ctq.unique,
+		Fields: ctq.fields,
+	}
+	for _, p := range ctq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range ctq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CustomTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("entv1: unexpected query type %T, expect *CustomTypeQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, ctq, qc, qr, ctq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -243,6 +315,7 @@ func (ctq *CustomTypeQuery) Clone() *CustomTypeQuery {
 		limit:      ctq.limit,
 		offset:     ctq.offset,
 		order:      append([]OrderFunc{}, ctq.order...),
+		inters:     append([]Interceptor{}, ctq.inters...),
 		predicates: append([]predicate.CustomType{}, ctq.predicates...),
 		// clone intermediate query.
 		sql:    ctq.sql.Clone(),
@@ -307,6 +380,16 @@ func (ctq *CustomTypeQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("entv1: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range ctq.inters {
+		if inter == nil {
+			return fmt.Errorf("entv1: uninitialized interceptor (forgotten import entv1/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ctq); err != nil {
+				return err
+			}
+		}
+	}
 	if ctq.path != nil {
 		prev, err := ctq.path(ctx)
 		if err != nil {
@@ -333,7 +416,7 @@ func (ctq *CustomTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, ctq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, ctq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -348,7 +431,7 @@ func (ctq *CustomTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(ctq.fields) > 0 {
 		_spec.Unique = ctq.unique != nil && *ctq.unique
 	}
-	return sqlgraph.CountNodes(ctx, ctq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, ctq.queryDriver(), _spec)
 }
 
 func (ctq *CustomTypeQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -439,6 +522,32 @@ func (ctq *CustomTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (ctq *CustomTypeQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: customtype.Label}
+	if len(ctq.predicates) > 0 {
+		selector := sql.Dialect(ctq.driver.Dialect()).Select().From(sql.Table(customtype.Table))
+		for _, p := range ctq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the CustomTypeQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (ctq *CustomTypeQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.CustomType, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.CustomType(ps[i]))
+	}
+	ctq.predicates = append(ctq.predicates, wps...)
+}
+
 // CustomTypeGroupBy is the group-by builder for CustomType entities.
 type CustomTypeGroupBy struct {
 	config
@@ -478,7 +587,7 @@ func (ctgb *CustomTypeGroupBy) sqlScan(ctx context.Context, v interface{}) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ctgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ctgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -524,7 +633,7 @@ func (cts *CustomTypeSelect) Scan(ctx context.Context, v interface{}) error {
 func (cts *CustomTypeSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := cts.sql.Query()
-	if err := cts.driver.Query(ctx, query, args, rows); err != nil {
+	if err := cts.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -19,6 +19,7 @@ import (
 	"entgo.io/ent/entc/integration/migrate/entv1/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -192,6 +193,16 @@ func (m *CarMutation) Where(ps ...predicate.Car) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the CarMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CarMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Car, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *CarMutation) Op() Op {
 	return m.op
@@ -363,20 +374,44 @@ type ConversionMutation struct {
 	name                *string
 	int8_to_string      *int8
 	addint8_to_string   *int8
+	maxint8_to_string   *int8
+	andint8_to_string   *int8
+	orint8_to_string    *int8
 	uint8_to_string     *uint8
 	adduint8_to_string  *int8
+	maxuint8_to_string  *uint8
+	anduint8_to_string  *uint8
+	oruint8_to_string   *uint8
 	int16_to_string     *int16
 	addint16_to_string  *int16
+	maxint16_to_string  *int16
+	andint16_to_string  *int16
+	orint16_to_string   *int16
 	uint16_to_string    *uint16
 	adduint16_to_string *int16
+	maxuint16_to_string *uint16
+	anduint16_to_string *uint16
+	oruint16_to_string  *uint16
 	int32_to_string     *int32
 	addint32_to_string  *int32
+	maxint32_to_string  *int32
+	andint32_to_string  *int32
+	orint32_to_string   *int32
 	uint32_to_string    *uint32
 	adduint32_to_string *int32
+	maxuint32_to_string *uint32
+	anduint32_to_string *uint32
+	oruint32_to_string  *uint32
 	int64_to_string     *int64
 	addint64_to_string  *int64
+	maxint64_to_string  *int64
+	andint64_to_string  *int64
+	orint64_to_string   *int64
 	uint64_to_string    *uint64
 	adduint64_to_string *int64
+	maxuint64_to_string *uint64
+	anduint64_to_string *uint64
+	oruint64_to_string  *uint64
 	clearedFields       map[string]struct{}
 	done                bool
 	oldValue            func(context.Context) (*Conversion, error)
@@ -484,6 +519,7 @@ func (m *ConversionMutation) IDs(ctx context.Context) ([]int, error) {
 // SetName sets the "name" field.
 func (m *ConversionMutation) SetName(s string) {
 	m.name = &s
+	delete(m.clearedFields, conversion.FieldName)
 }
 
 // Name returns the value of the "name" field in the mutation.
@@ -534,6 +570,10 @@ func (m *ConversionMutation) ResetName() {
 func (m *ConversionMutation) SetInt8ToString(i int8) {
 	m.int8_to_string = &i
 	m.addint8_to_string = nil
+	m.maxint8_to_string = nil
+	m.andint8_to_string = nil
+	m.orint8_to_string = nil
+	delete(m.clearedFields, conversion.FieldInt8ToString)
 }
 
 // Int8ToString returns the value of the "int8_to_string" field in the mutation.
@@ -580,10 +620,65 @@ func (m *ConversionMutation) AddedInt8ToString() (r int8, exists bool) {
 	return *v, true
 }
 
+// SetInt8ToStringIfGreater sets the "int8_to_string" field to i in the database, only if it is greater than its stored value.
+func (m *ConversionMutation) SetInt8ToStringIfGreater(i int8) {
+	if m.maxint8_to_string == nil || i > *m.maxint8_to_string {
+		m.maxint8_to_string = &i
+	}
+}
+
+// Int8ToStringIfGreater returns the value that was set to the "int8_to_string" field by SetInt8ToStringIfGreater in this mutation.
+func (m *ConversionMutation) Int8ToStringIfGreater() (r int8, exists bool) {
+	v := m.maxint8_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndInt8ToString applies a bitwise AND with i on the "int8_to_string" field.
+func (m *ConversionMutation) BitAndInt8ToString(i int8) {
+	if m.andint8_to_string != nil {
+		*m.andint8_to_string &= i
+	} else {
+		m.andint8_to_string = &i
+	}
+}
+
+// Int8ToStringBitAnd returns the value that was applied by BitAndInt8ToString on the "int8_to_string" field in this mutation.
+func (m *ConversionMutation) Int8ToStringBitAnd() (r int8, exists bool) {
+	v := m.andint8_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrInt8ToString applies a bitwise OR with i on the "int8_to_string" field.
+func (m *ConversionMutation) BitOrInt8ToString(i int8) {
+	if m.orint8_to_string != nil {
+		*m.orint8_to_string |= i
+	} else {
+		m.orint8_to_string = &i
+	}
+}
+
+// Int8ToStringBitOr returns the value that was applied by BitOrInt8ToString on the "int8_to_string" field in this mutation.
+func (m *ConversionMutation) Int8ToStringBitOr() (r int8, exists bool) {
+	v := m.orint8_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearInt8ToString clears the value of the "int8_to_string" field.
 func (m *ConversionMutation) ClearInt8ToString() {
 	m.int8_to_string = nil
 	m.addint8_to_string = nil
+	m.maxint8_to_string = nil
+	m.andint8_to_string = nil
+	m.orint8_to_string = nil
 	m.clearedFields[conversion.FieldInt8ToString] = struct{}{}
 }
 
@@ -597,6 +692,9 @@ func (m *ConversionMutation) Int8ToStringCleared() bool {
 func (m *ConversionMutation) ResetInt8ToString() {
 	m.int8_to_string = nil
 	m.addint8_to_string = nil
+	m.maxint8_to_string = nil
+	m.andint8_to_string = nil
+	m.orint8_to_string = nil
 	delete(m.clearedFields, conversion.FieldInt8ToString)
 }
 
@@ -604,6 +702,10 @@ func (m *ConversionMutation) ResetInt8ToString() {
 func (m *ConversionMutation) SetUint8ToString(u uint8) {
 	m.uint8_to_string = &u
 	m.adduint8_to_string = nil
+	m.maxuint8_to_string = nil
+	m.anduint8_to_string = nil
+	m.oruint8_to_string = nil
+	delete(m.clearedFields, conversion.FieldUint8ToString)
 }
 
 // Uint8ToString returns the value of the "uint8_to_string" field in the mutation.
@@ -650,10 +752,65 @@ func (m *ConversionMutation) AddedUint8ToString() (r int8, exists bool) {
 	return *v, true
 }
 
+// SetUint8ToStringIfGreater sets the "uint8_to_string" field to u in the database, only if it is greater than its stored value.
+func (m *ConversionMutation) SetUint8ToStringIfGreater(u uint8) {
+	if m.maxuint8_to_string == nil || u > *m.maxuint8_to_string {
+		m.maxuint8_to_string = &u
+	}
+}
+
+// Uint8ToStringIfGreater returns the value that was set to the "uint8_to_string" field by SetUint8ToStringIfGreater in this mutation.
+func (m *ConversionMutation) Uint8ToStringIfGreater() (r uint8, exists bool) {
+	v := m.maxuint8_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndUint8ToString applies a bitwise AND with u on the "uint8_to_string" field.
+func (m *ConversionMutation) BitAndUint8ToString(u uint8) {
+	if m.anduint8_to_string != nil {
+		*m.anduint8_to_string &= u
+	} else {
+		m.anduint8_to_string = &u
+	}
+}
+
+// Uint8ToStringBitAnd returns the value that was applied by BitAndUint8ToString on the "uint8_to_string" field in this mutation.
+func (m *ConversionMutation) Uint8ToStringBitAnd() (r uint8, exists bool) {
+	v := m.anduint8_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrUint8ToString applies a bitwise OR with u on the "uint8_to_string" field.
+func (m *ConversionMutation) BitOrUint8ToString(u uint8) {
+	if m.oruint8_to_string != nil {
+		*m.oruint8_to_string |= u
+	} else {
+		m.oruint8_to_string = &u
+	}
+}
+
+// Uint8ToStringBitOr returns the value that was applied by BitOrUint8ToString on the "uint8_to_string" field in this mutation.
+func (m *ConversionMutation) Uint8ToStringBitOr() (r uint8, exists bool) {
+	v := m.oruint8_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearUint8ToString clears the value of the "uint8_to_string" field.
 func (m *ConversionMutation) ClearUint8ToString() {
 	m.uint8_to_string = nil
 	m.adduint8_to_string = nil
+	m.maxuint8_to_string = nil
+	m.anduint8_to_string = nil
+	m.oruint8_to_string = nil
 	m.clearedFields[conversion.FieldUint8ToString] = struct{}{}
 }
 
@@ -667,6 +824,9 @@ func (m *ConversionMutation) Uint8ToStringCleared() bool {
 func (m *ConversionMutation) ResetUint8ToString() {
 	m.uint8_to_string = nil
 	m.adduint8_to_string = nil
+	m.maxuint8_to_string = nil
+	m.anduint8_to_string = nil
+	m.oruint8_to_string = nil
 	delete(m.clearedFields, conversion.FieldUint8ToString)
 }
 
@@ -674,6 +834,10 @@ func (m *ConversionMutation) ResetUint8ToString() {
 func (m *ConversionMutation) SetInt16ToString(i int16) {
 	m.int16_to_string = &i
 	m.addint16_to_string = nil
+	m.maxint16_to_string = nil
+	m.andint16_to_string = nil
+	m.orint16_to_string = nil
+	delete(m.clearedFields, conversion.FieldInt16ToString)
 }
 
 // Int16ToString returns the value of the "int16_to_string" field in the mutation.
@@ -720,10 +884,65 @@ func (m *ConversionMutation) AddedInt16ToString() (r int16, exists bool) {
 	return *v, true
 }
 
+// SetInt16ToStringIfGreater sets the "int16_to_string" field to i in the database, only if it is greater than its stored value.
+func (m *ConversionMutation) SetInt16ToStringIfGreater(i int16) {
+	if m.maxint16_to_string == nil || i > *m.maxint16_to_string {
+		m.maxint16_to_string = &i
+	}
+}
+
+// Int16ToStringIfGreater returns the value that was set to the "int16_to_string" field by SetInt16ToStringIfGreater in this mutation.
+func (m *ConversionMutation) Int16ToStringIfGreater() (r int16, exists bool) {
+	v := m.maxint16_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndInt16ToString applies a bitwise AND with i on the "int16_to_string" field.
+func (m *ConversionMutation) BitAndInt16ToString(i int16) {
+	if m.andint16_to_string != nil {
+		*m.andint16_to_string &= i
+	} else {
+		m.andint16_to_string = &i
+	}
+}
+
+// Int16ToStringBitAnd returns the value that was applied by BitAndInt16ToString on the "int16_to_string" field in this mutation.
+func (m *ConversionMutation) Int16ToStringBitAnd() (r int16, exists bool) {
+	v := m.andint16_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrInt16ToString applies a bitwise OR with i on the "int16_to_string" field.
+func (m *ConversionMutation) BitOrInt16ToString(i int16) {
+	if m.orint16_to_string != nil {
+		*m.orint16_to_string |= i
+	} else {
+		m.orint16_to_string = &i
+	}
+}
+
+// Int16ToStringBitOr returns the value that was applied by BitOrInt16ToString on the "int16_to_string" field in this mutation.
+func (m *ConversionMutation) Int16ToStringBitOr() (r int16, exists bool) {
+	v := m.orint16_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearInt16ToString clears the value of the "int16_to_string" field.
 func (m *ConversionMutation) ClearInt16ToString() {
 	m.int16_to_string = nil
 	m.addint16_to_string = nil
+	m.maxint16_to_string = nil
+	m.andint16_to_string = nil
+	m.orint16_to_string = nil
 	m.clearedFields[conversion.FieldInt16ToString] = struct{}{}
 }
 
@@ -737,6 +956,9 @@ func (m *ConversionMutation) Int16ToStringCleared() bool {
 func (m *ConversionMutation) ResetInt16ToString() {
 	m.int16_to_string = nil
 	m.addint16_to_string = nil
+	m.maxint16_to_string = nil
+	m.andint16_to_string = nil
+	m.orint16_to_string = nil
 	delete(m.clearedFields, conversion.FieldInt16ToString)
 }
 
@@ -744,6 +966,10 @@ func (m *ConversionMutation) ResetInt16ToString() {
 func (m *ConversionMutation) SetUint16ToString(u uint16) {
 	m.uint16_to_string = &u
 	m.adduint16_to_string = nil
+	m.maxuint16_to_string = nil
+	m.anduint16_to_string = nil
+	m.oruint16_to_string = nil
+	delete(m.clearedFields, conversion.FieldUint16ToString)
 }
 
 // Uint16ToString returns the value of the "uint16_to_string" field in the mutation.
@@ -790,10 +1016,65 @@ func (m *ConversionMutation) AddedUint16ToString() (r int16, exists bool) {
 	return *v, true
 }
 
+// SetUint16ToStringIfGreater sets the "uint16_to_string" field to u in the database, only if it is greater than its stored value.
+func (m *ConversionMutation) SetUint16ToStringIfGreater(u uint16) {
+	if m.maxuint16_to_string == nil || u > *m.maxuint16_to_string {
+		m.maxuint16_to_string = &u
+	}
+}
+
+// Uint16ToStringIfGreater returns the value that was set to the "uint16_to_string" field by SetUint16ToStringIfGreater in this mutation.
+func (m *ConversionMutation) Uint16ToStringIfGreater() (r uint16, exists bool) {
+	v := m.maxuint16_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndUint16ToString applies a bitwise AND with u on the "uint16_to_string" field.
+func (m *ConversionMutation) BitAndUint16ToString(u uint16) {
+	if m.anduint16_to_string != nil {
+		*m.anduint16_to_string &= u
+	} else {
+		m.anduint16_to_string = &u
+	}
+}
+
+// Uint16ToStringBitAnd returns the value that was applied by BitAndUint16ToString on the "uint16_to_string" field in this mutation.
+func (m *ConversionMutation) Uint16ToStringBitAnd() (r uint16, exists bool) {
+	v := m.anduint16_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrUint16ToString applies a bitwise OR with u on the "uint16_to_string" field.
+func (m *ConversionMutation) BitOrUint16ToString(u uint16) {
+	if m.oruint16_to_string != nil {
+		*m.oruint16_to_string |= u
+	} else {
+		m.oruint16_to_string = &u
+	}
+}
+
+// Uint16ToStringBitOr returns the value that was applied by BitOrUint16ToString on the "uint16_to_string" field in this mutation.
+func (m *ConversionMutation) Uint16ToStringBitOr() (r uint16, exists bool) {
+	v := m.oruint16_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearUint16ToString clears the value of the "uint16_to_string" field.
 func (m *ConversionMutation) ClearUint16ToString() {
 	m.uint16_to_string = nil
 	m.adduint16_to_string = nil
+	m.maxuint16_to_string = nil
+	m.anduint16_to_string = nil
+	m.oruint16_to_string = nil
 	m.clearedFields[conversion.FieldUint16ToString] = struct{}{}
 }
 
@@ -807,6 +1088,9 @@ func (m *ConversionMutation) Uint16ToStringCleared() bool {
 func (m *ConversionMutation) ResetUint16ToString() {
 	m.uint16_to_string = nil
 	m.adduint16_to_string = nil
+	m.maxuint16_to_string = nil
+	m.anduint16_to_string = nil
+	m.oruint16_to_string = nil
 	delete(m.clearedFields, conversion.FieldUint16ToString)
 }
 
@@ -814,6 +1098,10 @@ func (m *ConversionMutation) ResetUint16ToString() {
 func (m *ConversionMutation) SetInt32ToString(i int32) {
 	m.int32_to_string = &i
 	m.addint32_to_string = nil
+	m.maxint32_to_string = nil
+	m.andint32_to_string = nil
+	m.orint32_to_string = nil
+	delete(m.clearedFields, conversion.FieldInt32ToString)
 }
 
 // Int32ToString returns the value of the "int32_to_string" field in the mutation.
@@ -860,10 +1148,65 @@ func (m *ConversionMutation) AddedInt32ToString() (r int32, exists bool) {
 	return *v, true
 }
 
+// SetInt32ToStringIfGreater sets the "int32_to_string" field to i in the database, only if it is greater than its stored value.
+func (m *ConversionMutation) SetInt32ToStringIfGreater(i int32) {
+	if m.maxint32_to_string == nil || i > *m.maxint32_to_string {
+		m.maxint32_to_string = &i
+	}
+}
+
+// Int32ToStringIfGreater returns the value that was set to the "int32_to_string" field by SetInt32ToStringIfGreater in this mutation.
+func (m *ConversionMutation) Int32ToStringIfGreater() (r int32, exists bool) {
+	v := m.maxint32_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndInt32ToString applies a bitwise AND with i on the "int32_to_string" field.
+func (m *ConversionMutation) BitAndInt32ToString(i int32) {
+	if m.andint32_to_string != nil {
+		*m.andint32_to_string &= i
+	} else {
+		m.andint32_to_string = &i
+	}
+}
+
+// Int32ToStringBitAnd returns the value that was applied by BitAndInt32ToString on the "int32_to_string" field in this mutation.
+func (m *ConversionMutation) Int32ToStringBitAnd() (r int32, exists bool) {
+	v := m.andint32_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrInt32ToString applies a bitwise OR with i on the "int32_to_string" field.
+func (m *ConversionMutation) BitOrInt32ToString(i int32) {
+	if m.orint32_to_string != nil {
+		*m.orint32_to_string |= i
+	} else {
+		m.orint32_to_string = &i
+	}
+}
+
+// Int32ToStringBitOr returns the value that was applied by BitOrInt32ToString on the "int32_to_string" field in this mutation.
+func (m *ConversionMutation) Int32ToStringBitOr() (r int32, exists bool) {
+	v := m.orint32_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearInt32ToString clears the value of the "int32_to_string" field.
 func (m *ConversionMutation) ClearInt32ToString() {
 	m.int32_to_string = nil
 	m.addint32_to_string = nil
+	m.maxint32_to_string = nil
+	m.andint32_to_string = nil
+	m.orint32_to_string = nil
 	m.clearedFields[conversion.FieldInt32ToString] = struct{}{}
 }
 
@@ -877,6 +1220,9 @@ func (m *ConversionMutation) Int32ToStringCleared() bool {
 func (m *ConversionMutation) ResetInt32ToString() {
 	m.int32_to_string = nil
 	m.addint32_to_string = nil
+	m.maxint32_to_string = nil
+	m.andint32_to_string = nil
+	m.orint32_to_string = nil
 	delete(m.clearedFields, conversion.FieldInt32ToString)
 }
 
@@ -884,6 +1230,10 @@ func (m *ConversionMutation) ResetInt32ToString() {
 func (m *ConversionMutation) SetUint32ToString(u uint32) {
 	m.uint32_to_string = &u
 	m.adduint32_to_string = nil
+	m.maxuint32_to_string = nil
+	m.anduint32_to_string = nil
+	m.oruint32_to_string = nil
+	delete(m.clearedFields, conversion.FieldUint32ToString)
 }
 
 // Uint32ToString returns the value of the "uint32_to_string" field in the mutation.
@@ -930,10 +1280,65 @@ func (m *ConversionMutation) AddedUint32ToString() (r int32, exists bool) {
 	return *v, true
 }
 
+// SetUint32ToStringIfGreater sets the "uint32_to_string" field to u in the database, only if it is greater than its stored value.
+func (m *ConversionMutation) SetUint32ToStringIfGreater(u uint32) {
+	if m.maxuint32_to_string == nil || u > *m.maxuint32_to_string {
+		m.maxuint32_to_string = &u
+	}
+}
+
+// Uint32ToStringIfGreater returns the value that was set to the "uint32_to_string" field by SetUint32ToStringIfGreater in this mutation.
+func (m *ConversionMutation) Uint32ToStringIfGreater() (r uint32, exists bool) {
+	v := m.maxuint32_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndUint32ToString applies a bitwise AND with u on the "uint32_to_string" field.
+func (m *ConversionMutation) BitAndUint32ToString(u uint32) {
+	if m.anduint32_to_string != nil {
+		*m.anduint32_to_string &= u
+	} else {
+		m.anduint32_to_string = &u
+	}
+}
+
+// Uint32ToStringBitAnd returns the value that was applied by BitAndUint32ToString on the "uint32_to_string" field in this mutation.
+func (m *ConversionMutation) Uint32ToStringBitAnd() (r uint32, exists bool) {
+	v := m.anduint32_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrUint32ToString applies a bitwise OR with u on the "uint32_to_string" field.
+func (m *ConversionMutation) BitOrUint32ToString(u uint32) {
+	if m.oruint32_to_string != nil {
+		*m.oruint32_to_string |= u
+	} else {
+		m.oruint32_to_string = &u
+	}
+}
+
+// Uint32ToStringBitOr returns the value that was applied by BitOrUint32ToString on the "uint32_to_string" field in this mutation.
+func (m *ConversionMutation) Uint32ToStringBitOr() (r uint32, exists bool) {
+	v := m.oruint32_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearUint32ToString clears the value of the "uint32_to_string" field.
 func (m *ConversionMutation) ClearUint32ToString() {
 	m.uint32_to_string = nil
 	m.adduint32_to_string = nil
+	m.maxuint32_to_string = nil
+	m.anduint32_to_string = nil
+	m.oruint32_to_string = nil
 	m.clearedFields[conversion.FieldUint32ToString] = struct{}{}
 }
 
@@ -947,6 +1352,9 @@ func (m *ConversionMutation) Uint32ToStringCleared() bool {
 func (m *ConversionMutation) ResetUint32ToString() {
 	m.uint32_to_string = nil
 	m.adduint32_to_string = nil
+	m.maxuint32_to_string = nil
+	m.anduint32_to_string = nil
+	m.oruint32_to_string = nil
 	delete(m.clearedFields, conversion.FieldUint32ToString)
 }
 
@@ -954,6 +1362,10 @@ func (m *ConversionMutation) ResetUint32ToString() {
 func (m *ConversionMutation) SetInt64ToString(i int64) {
 	m.int64_to_string = &i
 	m.addint64_to_string = nil
+	m.maxint64_to_string = nil
+	m.andint64_to_string = nil
+	m.orint64_to_string = nil
+	delete(m.clearedFields, conversion.FieldInt64ToString)
 }
 
 // Int64ToString returns the value of the "int64_to_string" field in the mutation.
@@ -1000,10 +1412,65 @@ func (m *ConversionMutation) AddedInt64ToString() (r int64, exists bool) {
 	return *v, true
 }
 
+// SetInt64ToStringIfGreater sets the "int64_to_string" field to i in the database, only if it is greater than its stored value.
+func (m *ConversionMutation) SetInt64ToStringIfGreater(i int64) {
+	if m.maxint64_to_string == nil || i > *m.maxint64_to_string {
+		m.maxint64_to_string = &i
+	}
+}
+
+// Int64ToStringIfGreater returns the value that was set to the "int64_to_string" field by SetInt64ToStringIfGreater in this mutation.
+func (m *ConversionMutation) Int64ToStringIfGreater() (r int64, exists bool) {
+	v := m.maxint64_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndInt64ToString applies a bitwise AND with i on the "int64_to_string" field.
+func (m *ConversionMutation) BitAndInt64ToString(i int64) {
+	if m.andint64_to_string != nil {
+		*m.andint64_to_string &= i
+	} else {
+		m.andint64_to_string = &i
+	}
+}
+
+// Int64ToStringBitAnd returns the value that was applied by BitAndInt64ToString on the "int64_to_string" field in this mutation.
+func (m *ConversionMutation) Int64ToStringBitAnd() (r int64, exists bool) {
+	v := m.andint64_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrInt64ToString applies a bitwise OR with i on the "int64_to_string" field.
+func (m *ConversionMutation) BitOrInt64ToString(i int64) {
+	if m.orint64_to_string != nil {
+		*m.orint64_to_string |= i
+	} else {
+		m.orint64_to_string = &i
+	}
+}
+
+// Int64ToStringBitOr returns the value that was applied by BitOrInt64ToString on the "int64_to_string" field in this mutation.
+func (m *ConversionMutation) Int64ToStringBitOr() (r int64, exists bool) {
+	v := m.orint64_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearInt64ToString clears the value of the "int64_to_string" field.
 func (m *ConversionMutation) ClearInt64ToString() {
 	m.int64_to_string = nil
 	m.addint64_to_string = nil
+	m.maxint64_to_string = nil
+	m.andint64_to_string = nil
+	m.orint64_to_string = nil
 	m.clearedFields[conversion.FieldInt64ToString] = struct{}{}
 }
 
@@ -1017,6 +1484,9 @@ func (m *ConversionMutation) Int64ToStringCleared() bool {
 func (m *ConversionMutation) ResetInt64ToString() {
 	m.int64_to_string = nil
 	m.addint64_to_string = nil
+	m.maxint64_to_string = nil
+	m.andint64_to_string = nil
+	m.orint64_to_string = nil
 	delete(m.clearedFields, conversion.FieldInt64ToString)
 }
 
@@ -1024,6 +1494,10 @@ func (m *ConversionMutation) ResetInt64ToString() {
 func (m *ConversionMutation) SetUint64ToString(u uint64) {
 	m.uint64_to_string = &u
 	m.adduint64_to_string = nil
+	m.maxuint64_to_string = nil
+	m.anduint64_to_string = nil
+	m.oruint64_to_string = nil
+	delete(m.clearedFields, conversion.FieldUint64ToString)
 }
 
 // Uint64ToString returns the value of the "uint64_to_string" field in the mutation.
@@ -1070,10 +1544,65 @@ func (m *ConversionMutation) AddedUint64ToString() (r int64, exists bool) {
 	return *v, true
 }
 
+// SetUint64ToStringIfGreater sets the "uint64_to_string" field to u in the database, only if it is greater than its stored value.
+func (m *ConversionMutation) SetUint64ToStringIfGreater(u uint64) {
+	if m.maxuint64_to_string == nil || u > *m.maxuint64_to_string {
+		m.maxuint64_to_string = &u
+	}
+}
+
+// Uint64ToStringIfGreater returns the value that was set to the "uint64_to_string" field by SetUint64ToStringIfGreater in this mutation.
+func (m *ConversionMutation) Uint64ToStringIfGreater() (r uint64, exists bool) {
+	v := m.maxuint64_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndUint64ToString applies a bitwise AND with u on the "uint64_to_string" field.
+func (m *ConversionMutation) BitAndUint64ToString(u uint64) {
+	if m.anduint64_to_string != nil {
+		*m.anduint64_to_string &= u
+	} else {
+		m.anduint64_to_string = &u
+	}
+}
+
+// Uint64ToStringBitAnd returns the value that was applied by BitAndUint64ToString on the "uint64_to_string" field in this mutation.
+func (m *ConversionMutation) Uint64ToStringBitAnd() (r uint64, exists bool) {
+	v := m.anduint64_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrUint64ToString applies a bitwise OR with u on the "uint64_to_string" field.
+func (m *ConversionMutation) BitOrUint64ToString(u uint64) {
+	if m.oruint64_to_string != nil {
+		*m.oruint64_to_string |= u
+	} else {
+		m.oruint64_to_string = &u
+	}
+}
+
+// Uint64ToStringBitOr returns the value that was applied by BitOrUint64ToString on the "uint64_to_string" field in this mutation.
+func (m *ConversionMutation) Uint64ToStringBitOr() (r uint64, exists bool) {
+	v := m.oruint64_to_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearUint64ToString clears the value of the "uint64_to_string" field.
 func (m *ConversionMutation) ClearUint64ToString() {
 	m.uint64_to_string = nil
 	m.adduint64_to_string = nil
+	m.maxuint64_to_string = nil
+	m.anduint64_to_string = nil
+	m.oruint64_to_string = nil
 	m.clearedFields[conversion.FieldUint64ToString] = struct{}{}
 }
 
@@ -1087,6 +1616,9 @@ func (m *ConversionMutation) Uint64ToStringCleared() bool {
 func (m *ConversionMutation) ResetUint64ToString() {
 	m.uint64_to_string = nil
 	m.adduint64_to_string = nil
+	m.maxuint64_to_string = nil
+	m.anduint64_to_string = nil
+	m.oruint64_to_string = nil
 	delete(m.clearedFields, conversion.FieldUint64ToString)
 }
 
@@ -1095,6 +1627,16 @@ func (m *ConversionMutation) Where(ps ...predicate.Conversion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the ConversionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConversionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Conversion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *ConversionMutation) Op() Op {
 	return m.op
@@ -1660,6 +2202,7 @@ func (m *CustomTypeMutation) IDs(ctx context.Context) ([]int, error) {
 // SetCustom sets the "custom" field.
 func (m *CustomTypeMutation) SetCustom(s string) {
 	m.custom = &s
+	delete(m.clearedFields, customtype.FieldCustom)
 }
 
 // Custom returns the value of the "custom" field in the mutation.
@@ -1711,6 +2254,16 @@ func (m *CustomTypeMutation) Where(ps ...predicate.CustomType) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the CustomTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CustomTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CustomType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *CustomTypeMutation) Op() Op {
 	return m.op
@@ -1887,6 +2440,9 @@ type UserMutation struct {
 	id              *int
 	age             *int32
 	addage          *int32
+	maxage          *int32
+	andage          *int32
+	orage           *int32
 	name            *string
 	description     *string
 	nickname        *string
@@ -2021,6 +2577,9 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *UserMutation) SetAge(i int32) {
 	m.age = &i
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 }
 
 // Age returns the value of the "age" field in the mutation.
@@ -2067,10 +2626,65 @@ func (m *UserMutation) AddedAge() (r int32, exists bool) {
 	return *v, true
 }
 
+// SetAgeIfGreater sets the "age" field to i in the database, only if it is greater than its stored value.
+func (m *UserMutation) SetAgeIfGreater(i int32) {
+	if m.maxage == nil || i > *m.maxage {
+		m.maxage = &i
+	}
+}
+
+// AgeIfGreater returns the value that was set to the "age" field by SetAgeIfGreater in this mutation.
+func (m *UserMutation) AgeIfGreater() (r int32, exists bool) {
+	v := m.maxage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field.
+func (m *UserMutation) BitAndAge(i int32) {
+	if m.andage != nil {
+		*m.andage &= i
+	} else {
+		m.andage = &i
+	}
+}
+
+// AgeBitAnd returns the value that was applied by BitAndAge on the "age" field in this mutation.
+func (m *UserMutation) AgeBitAnd() (r int32, exists bool) {
+	v := m.andage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field.
+func (m *UserMutation) BitOrAge(i int32) {
+	if m.orage != nil {
+		*m.orage |= i
+	} else {
+		m.orage = &i
+	}
+}
+
+// AgeBitOr returns the value that was applied by BitOrAge on the "age" field in this mutation.
+func (m *UserMutation) AgeBitOr() (r int32, exists bool) {
+	v := m.orage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetAge resets all changes to the "age" field.
 func (m *UserMutation) ResetAge() {
 	m.age = nil
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 }
 
 // SetName sets the "name" field.
@@ -2112,6 +2726,7 @@ func (m *UserMutation) ResetName() {
 // SetDescription sets the "description" field.
 func (m *UserMutation) SetDescription(s string) {
 	m.description = &s
+	delete(m.clearedFields, user.FieldDescription)
 }
 
 // Description returns the value of the "description" field in the mutation.
@@ -2197,6 +2812,7 @@ func (m *UserMutation) ResetNickname() {
 // SetAddress sets the "address" field.
 func (m *UserMutation) SetAddress(s string) {
 	m.address = &s
+	delete(m.clearedFields, user.FieldAddress)
 }
 
 // Address returns the value of the "address" field in the mutation.
@@ -2246,6 +2862,7 @@ func (m *UserMutation) ResetAddress() {
 // SetRenamed sets the "renamed" field.
 func (m *UserMutation) SetRenamed(s string) {
 	m.renamed = &s
+	delete(m.clearedFields, user.FieldRenamed)
 }
 
 // Renamed returns the value of the "renamed" field in the mutation.
@@ -2331,6 +2948,7 @@ func (m *UserMutation) ResetOldToken() {
 // SetBlob sets the "blob" field.
 func (m *UserMutation) SetBlob(b []byte) {
 	m.blob = &b
+	delete(m.clearedFields, user.FieldBlob)
 }
 
 // Blob returns the value of the "blob" field in the mutation.
@@ -2380,6 +2998,7 @@ func (m *UserMutation) ResetBlob() {
 // SetState sets the "state" field.
 func (m *UserMutation) SetState(u user.State) {
 	m.state = &u
+	delete(m.clearedFields, user.FieldState)
 }
 
 // State returns the value of the "state" field in the mutation.
@@ -2429,6 +3048,7 @@ func (m *UserMutation) ResetState() {
 // SetStatus sets the "status" field.
 func (m *UserMutation) SetStatus(s string) {
 	m.status = &s
+	delete(m.clearedFields, user.FieldStatus)
 }
 
 // Status returns the value of the "status" field in the mutation.
@@ -2478,6 +3098,7 @@ func (m *UserMutation) ResetStatus() {
 // SetWorkplace sets the "workplace" field.
 func (m *UserMutation) SetWorkplace(s string) {
 	m.workplace = &s
+	delete(m.clearedFields, user.FieldWorkplace)
 }
 
 // Workplace returns the value of the "workplace" field in the mutation.
@@ -2527,6 +3148,7 @@ func (m *UserMutation) ResetWorkplace() {
 // SetDropOptional sets the "drop_optional" field.
 func (m *UserMutation) SetDropOptional(s string) {
 	m.drop_optional = &s
+	delete(m.clearedFields, user.FieldDropOptional)
 }
 
 // DropOptional returns the value of the "drop_optional" field in the mutation.
@@ -2749,6 +3371,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
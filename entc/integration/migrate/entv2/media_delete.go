@@ -107,7 +107,7 @@ func (mdo *MediaDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{media.Label}
+		return &NotFoundError{label: media.Label}
 	default:
 		return nil
 	}
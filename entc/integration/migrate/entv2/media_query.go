@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/migrate/entv2/media"
@@ -26,6 +27,7 @@ type MediaQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Media
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -71,7 +73,7 @@ func (mq *MediaQuery) First(ctx context.Context) (*Media, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{media.Label}
+		return nil, mq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -93,7 +95,7 @@ func (mq *MediaQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{media.Label}
+		err = mq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -120,7 +122,7 @@ func (mq *MediaQuery) Only(ctx context.Context) (*Media, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{media.Label}
+		return nil, mq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{media.Label}
 	}
@@ -147,7 +149,7 @@ func (mq *MediaQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{media.Label}
+		err = mq.sqlNotFound()
 	default:
 		err = &NotSingularError{media.Label}
 	}
@@ -168,7 +170,17 @@ func (mq *MediaQuery) All(ctx context.Context) ([]*Media, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return mq.sqlAll(ctx)
+	v, err := mq.intercept(ctx, "All", func(ctx context.Context, query *MediaQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Media)
+	if !ok {
+		return nil, fmt.Errorf("entv2: unexpected type %T returned from the interceptors of All, expect []*Media", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -182,10 +194,20 @@ func (mq *MediaQuery) AllX(ctx context.Context) []*Media {
 
 // IDs executes the query and returns a list of Media IDs.
 func (mq *MediaQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := mq.Select(media.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := mq.intercept(ctx, "IDs", func(ctx context.Context, query *MediaQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(media.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("entv2: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -203,7 +225,17 @@ func (mq *MediaQuery) Count(ctx context.Context) (int, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return mq.sqlCount(ctx)
+	v, err := mq.intercept(ctx, "Count", func(ctx context.Context, query *MediaQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("entv2: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -220,7 +252,47 @@ func (mq *MediaQuery) Exist(ctx context.Context) (bool, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return mq.sqlExist(ctx)
+	v, err := mq.intercept(ctx, "Exist", func(ctx context.Context, query *MediaQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("entv2: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (mq *MediaQuery) intercept(ctx context.Context, op string, fn func(context.Context, *MediaQuery) (Value, error)) (Value, error) {
+	if len(mq.inters) == 0 {
+		return fn(ctx, mq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeMedia,
+		Op:     op,
+		Limit:  mq.limit,
+		Offset: mq.offset,
+		Unique: mq.unique,
+		Fields: mq.fields,
+	}
+	for _, p := range mq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range mq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MediaQuery)
+		if !ok {
+			return nil, fmt.Errorf("entv2: unexpected query type %T, expect *MediaQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, mq, qc, qr, mq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -243,6 +315,7 @@ func (mq *MediaQuery) Clone() *MediaQuery {
 		limit:      mq.limit,
 		offset:     mq.offset,
 		order:      append([]OrderFunc{}, mq.order...),
+		inters:     append([]Interceptor{}, mq.inters...),
 		predicates: append([]predicate.Media{}, mq.predicates...),
 		// clone intermediate query.
 		sql:    mq.sql.Clone(),
@@ -307,6 +380,16 @@ func (mq *MediaQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("entv2: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range mq.inters {
+		if inter == nil {
+			return fmt.Errorf("entv2: uninitialized interceptor (forgotten import entv2/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mq); err != nil {
+				return err
+			}
+		}
+	}
 	if mq.path != nil {
 		prev, err := mq.path(ctx)
 		if err != nil {
@@ -333,7 +416,7 @@ func (mq *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, mq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, mq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -348,7 +431,7 @@ func (mq *MediaQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(mq.fields) > 0 {
 		_spec.Unique = mq.unique != nil && *mq.unique
 	}
-	return sqlgraph.CountNodes(ctx, mq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, mq.queryDriver(), _spec)
 }
 
 func (mq *MediaQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -439,6 +522,32 @@ func (mq *MediaQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (mq *MediaQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: media.Label}
+	if len(mq.predicates) > 0 {
+		selector := sql.Dialect(mq.driver.Dialect()).Select().From(sql.Table(media.Table))
+		for _, p := range mq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the MediaQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (mq *MediaQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Media, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Media(ps[i]))
+	}
+	mq.predicates = append(mq.predicates, wps...)
+}
+
 // MediaGroupBy is the group-by builder for Media entities.
 type MediaGroupBy struct {
 	config
@@ -478,7 +587,7 @@ func (mgb *MediaGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := mgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := mgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -524,7 +633,7 @@ func (ms *MediaSelect) Scan(ctx context.Context, v interface{}) error {
 func (ms *MediaSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ms.sql.Query()
-	if err := ms.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ms.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
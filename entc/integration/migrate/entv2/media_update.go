@@ -209,7 +209,7 @@ func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{media.Label}
+			err = &NotFoundError{label: media.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -221,9 +221,18 @@ func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // MediaUpdateOne is the builder for updating a single Media entity.
 type MediaUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *MediaMutation
+	fields     []string
+	conditions []predicate.Media
+	hooks      []Hook
+	mutation   *MediaMutation
+}
+
+// Where appends a list of conditions to the MediaUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (muo *MediaUpdateOne) Where(ps ...predicate.Media) *MediaUpdateOne {
+	muo.conditions = append(muo.conditions, ps...)
+	return muo
 }
 
 // SetSource sets the "source" field.
@@ -393,6 +402,13 @@ func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error
 			}
 		}
 	}
+	if ps := muo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := muo.mutation.Source(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -437,7 +453,9 @@ func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{media.Label}
+			err = &NotFoundError{label: media.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: media.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
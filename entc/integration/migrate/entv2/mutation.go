@@ -22,6 +22,7 @@ import (
 	"entgo.io/ent/entc/integration/migrate/entv2/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -158,6 +159,7 @@ func (m *CarMutation) IDs(ctx context.Context) ([]int, error) {
 // SetName sets the "name" field.
 func (m *CarMutation) SetName(s string) {
 	m.name = &s
+	delete(m.clearedFields, car.FieldName)
 }
 
 // Name returns the value of the "name" field in the mutation.
@@ -248,6 +250,16 @@ func (m *CarMutation) Where(ps ...predicate.Car) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the CarMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CarMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Car, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *CarMutation) Op() Op {
 	return m.op
@@ -566,6 +578,7 @@ func (m *ConversionMutation) IDs(ctx context.Context) ([]int, error) {
 // SetName sets the "name" field.
 func (m *ConversionMutation) SetName(s string) {
 	m.name = &s
+	delete(m.clearedFields, conversion.FieldName)
 }
 
 // Name returns the value of the "name" field in the mutation.
@@ -615,6 +628,7 @@ func (m *ConversionMutation) ResetName() {
 // SetInt8ToString sets the "int8_to_string" field.
 func (m *ConversionMutation) SetInt8ToString(s string) {
 	m.int8_to_string = &s
+	delete(m.clearedFields, conversion.FieldInt8ToString)
 }
 
 // Int8ToString returns the value of the "int8_to_string" field in the mutation.
@@ -664,6 +678,7 @@ func (m *ConversionMutation) ResetInt8ToString() {
 // SetUint8ToString sets the "uint8_to_string" field.
 func (m *ConversionMutation) SetUint8ToString(s string) {
 	m.uint8_to_string = &s
+	delete(m.clearedFields, conversion.FieldUint8ToString)
 }
 
 // Uint8ToString returns the value of the "uint8_to_string" field in the mutation.
@@ -713,6 +728,7 @@ func (m *ConversionMutation) ResetUint8ToString() {
 // SetInt16ToString sets the "int16_to_string" field.
 func (m *ConversionMutation) SetInt16ToString(s string) {
 	m.int16_to_string = &s
+	delete(m.clearedFields, conversion.FieldInt16ToString)
 }
 
 // Int16ToString returns the value of the "int16_to_string" field in the mutation.
@@ -762,6 +778,7 @@ func (m *ConversionMutation) ResetInt16ToString() {
 // SetUint16ToString sets the "uint16_to_string" field.
 func (m *ConversionMutation) SetUint16ToString(s string) {
 	m.uint16_to_string = &s
+	delete(m.clearedFields, conversion.FieldUint16ToString)
 }
 
 // Uint16ToString returns the value of the "uint16_to_string" field in the mutation.
@@ -811,6 +828,7 @@ func (m *ConversionMutation) ResetUint16ToString() {
 // SetInt32ToString sets the "int32_to_string" field.
 func (m *ConversionMutation) SetInt32ToString(s string) {
 	m.int32_to_string = &s
+	delete(m.clearedFields, conversion.FieldInt32ToString)
 }
 
 // Int32ToString returns the value of the "int32_to_string" field in the mutation.
@@ -860,6 +878,7 @@ func (m *ConversionMutation) ResetInt32ToString() {
 // SetUint32ToString sets the "uint32_to_string" field.
 func (m *ConversionMutation) SetUint32ToString(s string) {
 	m.uint32_to_string = &s
+	delete(m.clearedFields, conversion.FieldUint32ToString)
 }
 
 // Uint32ToString returns the value of the "uint32_to_string" field in the mutation.
@@ -909,6 +928,7 @@ func (m *ConversionMutation) ResetUint32ToString() {
 // SetInt64ToString sets the "int64_to_string" field.
 func (m *ConversionMutation) SetInt64ToString(s string) {
 	m.int64_to_string = &s
+	delete(m.clearedFields, conversion.FieldInt64ToString)
 }
 
 // Int64ToString returns the value of the "int64_to_string" field in the mutation.
@@ -958,6 +978,7 @@ func (m *ConversionMutation) ResetInt64ToString() {
 // SetUint64ToString sets the "uint64_to_string" field.
 func (m *ConversionMutation) SetUint64ToString(s string) {
 	m.uint64_to_string = &s
+	delete(m.clearedFields, conversion.FieldUint64ToString)
 }
 
 // Uint64ToString returns the value of the "uint64_to_string" field in the mutation.
@@ -1009,6 +1030,16 @@ func (m *ConversionMutation) Where(ps ...predicate.Conversion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the ConversionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConversionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Conversion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *ConversionMutation) Op() Op {
 	return m.op
@@ -1477,6 +1508,7 @@ func (m *CustomTypeMutation) IDs(ctx context.Context) ([]int, error) {
 // SetCustom sets the "custom" field.
 func (m *CustomTypeMutation) SetCustom(s string) {
 	m.custom = &s
+	delete(m.clearedFields, customtype.FieldCustom)
 }
 
 // Custom returns the value of the "custom" field in the mutation.
@@ -1526,6 +1558,7 @@ func (m *CustomTypeMutation) ResetCustom() {
 // SetTz0 sets the "tz0" field.
 func (m *CustomTypeMutation) SetTz0(t time.Time) {
 	m.tz0 = &t
+	delete(m.clearedFields, customtype.FieldTz0)
 }
 
 // Tz0 returns the value of the "tz0" field in the mutation.
@@ -1575,6 +1608,7 @@ func (m *CustomTypeMutation) ResetTz0() {
 // SetTz3 sets the "tz3" field.
 func (m *CustomTypeMutation) SetTz3(t time.Time) {
 	m.tz3 = &t
+	delete(m.clearedFields, customtype.FieldTz3)
 }
 
 // Tz3 returns the value of the "tz3" field in the mutation.
@@ -1626,6 +1660,16 @@ func (m *CustomTypeMutation) Where(ps ...predicate.CustomType) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the CustomTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CustomTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CustomType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *CustomTypeMutation) Op() Op {
 	return m.op
@@ -1955,6 +1999,16 @@ func (m *GroupMutation) Where(ps ...predicate.Group) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *GroupMutation) Op() Op {
 	return m.op
@@ -2205,6 +2259,7 @@ func (m *MediaMutation) IDs(ctx context.Context) ([]int, error) {
 // SetSource sets the "source" field.
 func (m *MediaMutation) SetSource(s string) {
 	m.source = &s
+	delete(m.clearedFields, media.FieldSource)
 }
 
 // Source returns the value of the "source" field in the mutation.
@@ -2254,6 +2309,7 @@ func (m *MediaMutation) ResetSource() {
 // SetSourceURI sets the "source_uri" field.
 func (m *MediaMutation) SetSourceURI(s string) {
 	m.source_uri = &s
+	delete(m.clearedFields, media.FieldSourceURI)
 }
 
 // SourceURI returns the value of the "source_uri" field in the mutation.
@@ -2303,6 +2359,7 @@ func (m *MediaMutation) ResetSourceURI() {
 // SetText sets the "text" field.
 func (m *MediaMutation) SetText(s string) {
 	m.text = &s
+	delete(m.clearedFields, media.FieldText)
 }
 
 // Text returns the value of the "text" field in the mutation.
@@ -2354,6 +2411,16 @@ func (m *MediaMutation) Where(ps ...predicate.Media) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the MediaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Media, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *MediaMutation) Op() Op {
 	return m.op
@@ -2684,6 +2751,7 @@ func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
 // SetName sets the "name" field.
 func (m *PetMutation) SetName(s string) {
 	m.name = &s
+	delete(m.clearedFields, pet.FieldName)
 }
 
 // Name returns the value of the "name" field in the mutation.
@@ -2774,6 +2842,16 @@ func (m *PetMutation) Where(ps ...predicate.Pet) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *PetMutation) Op() Op {
 	return m.op
@@ -2980,6 +3058,9 @@ type UserMutation struct {
 	mixed_enum     *user.MixedEnum
 	age            *int
 	addage         *int
+	maxage         *int
+	andage         *int
+	orage          *int
 	name           *string
 	description    *string
 	nickname       *string
@@ -3188,6 +3269,9 @@ func (m *UserMutation) ResetMixedEnum() {
 func (m *UserMutation) SetAge(i int) {
 	m.age = &i
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 }
 
 // Age returns the value of the "age" field in the mutation.
@@ -3234,10 +3318,65 @@ func (m *UserMutation) AddedAge() (r int, exists bool) {
 	return *v, true
 }
 
+// SetAgeIfGreater sets the "age" field to i in the database, only if it is greater than its stored value.
+func (m *UserMutation) SetAgeIfGreater(i int) {
+	if m.maxage == nil || i > *m.maxage {
+		m.maxage = &i
+	}
+}
+
+// AgeIfGreater returns the value that was set to the "age" field by SetAgeIfGreater in this mutation.
+func (m *UserMutation) AgeIfGreater() (r int, exists bool) {
+	v := m.maxage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field.
+func (m *UserMutation) BitAndAge(i int) {
+	if m.andage != nil {
+		*m.andage &= i
+	} else {
+		m.andage = &i
+	}
+}
+
+// AgeBitAnd returns the value that was applied by BitAndAge on the "age" field in this mutation.
+func (m *UserMutation) AgeBitAnd() (r int, exists bool) {
+	v := m.andage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field.
+func (m *UserMutation) BitOrAge(i int) {
+	if m.orage != nil {
+		*m.orage |= i
+	} else {
+		m.orage = &i
+	}
+}
+
+// AgeBitOr returns the value that was applied by BitOrAge on the "age" field in this mutation.
+func (m *UserMutation) AgeBitOr() (r int, exists bool) {
+	v := m.orage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetAge resets all changes to the "age" field.
 func (m *UserMutation) ResetAge() {
 	m.age = nil
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 }
 
 // SetName sets the "name" field.
@@ -3279,6 +3418,7 @@ func (m *UserMutation) ResetName() {
 // SetDescription sets the "description" field.
 func (m *UserMutation) SetDescription(s string) {
 	m.description = &s
+	delete(m.clearedFields, user.FieldDescription)
 }
 
 // Description returns the value of the "description" field in the mutation.
@@ -3400,6 +3540,7 @@ func (m *UserMutation) ResetPhone() {
 // SetBuffer sets the "buffer" field.
 func (m *UserMutation) SetBuffer(b []byte) {
 	m.buffer = &b
+	delete(m.clearedFields, user.FieldBuffer)
 }
 
 // Buffer returns the value of the "buffer" field in the mutation.
@@ -3485,6 +3626,7 @@ func (m *UserMutation) ResetTitle() {
 // SetNewName sets the "new_name" field.
 func (m *UserMutation) SetNewName(s string) {
 	m.new_name = &s
+	delete(m.clearedFields, user.FieldNewName)
 }
 
 // NewName returns the value of the "new_name" field in the mutation.
@@ -3570,6 +3712,7 @@ func (m *UserMutation) ResetNewToken() {
 // SetBlob sets the "blob" field.
 func (m *UserMutation) SetBlob(b []byte) {
 	m.blob = &b
+	delete(m.clearedFields, user.FieldBlob)
 }
 
 // Blob returns the value of the "blob" field in the mutation.
@@ -3619,6 +3762,7 @@ func (m *UserMutation) ResetBlob() {
 // SetState sets the "state" field.
 func (m *UserMutation) SetState(u user.State) {
 	m.state = &u
+	delete(m.clearedFields, user.FieldState)
 }
 
 // State returns the value of the "state" field in the mutation.
@@ -3668,6 +3812,7 @@ func (m *UserMutation) ResetState() {
 // SetStatus sets the "status" field.
 func (m *UserMutation) SetStatus(u user.Status) {
 	m.status = &u
+	delete(m.clearedFields, user.FieldStatus)
 }
 
 // Status returns the value of the "status" field in the mutation.
@@ -3717,6 +3862,7 @@ func (m *UserMutation) ResetStatus() {
 // SetWorkplace sets the "workplace" field.
 func (m *UserMutation) SetWorkplace(s string) {
 	m.workplace = &s
+	delete(m.clearedFields, user.FieldWorkplace)
 }
 
 // Workplace returns the value of the "workplace" field in the mutation.
@@ -3987,6 +4133,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
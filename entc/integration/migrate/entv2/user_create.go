@@ -385,7 +385,7 @@ func (uc *UserCreate) defaults() {
 		uc.mutation.SetState(v)
 	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
-		v := user.DefaultCreatedAt()
+		v := uc.config.now(user.DefaultCreatedAt)
 		uc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := uc.mutation.DropOptional(); !ok {
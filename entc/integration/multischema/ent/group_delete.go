@@ -110,7 +110,7 @@ func (gdo *GroupDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{group.Label}
+		return &NotFoundError{label: group.Label}
 	default:
 		return nil
 	}
@@ -108,6 +108,37 @@ func TestMySQL(t *testing.T) {
 	require.Equal(t, client.Pet.Query().CountX(ctx), len(client.Pet.Query().AllX(ctx)))
 }
 
+func TestMySQL_Tenant(t *testing.T) {
+	db, err := sql.Open("mysql", "root:pass@tcp(localhost:3308)/")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	tenants := []string{"acme", "umbrella"}
+	for _, name := range tenants {
+		_, err = db.ExecContext(ctx, "CREATE DATABASE IF NOT EXISTS "+name)
+		require.NoError(t, err, "creating database")
+		defer db.ExecContext(ctx, "DROP DATABASE IF EXISTS "+name)
+		tdb, err := sql.Open("mysql", "root:pass@tcp(localhost:3308)/"+name)
+		require.NoError(t, err)
+		err = ent.NewClient(ent.Driver(tdb)).Schema.Create(ctx, migrate.WithForeignKeys(false))
+		require.NoError(t, err)
+		require.NoError(t, tdb.Close())
+	}
+
+	client := ent.NewClient(ent.Driver(db))
+	acme, umbrella := client.Tenant("acme"), client.Tenant("umbrella")
+	pedro := acme.Pet.Create().SetName("Pedro").SaveX(ctx)
+	gh := acme.Group.Create().SetName("GitHub").SaveX(ctx)
+	acme.User.Create().SetName("a8m").AddPets(pedro).AddGroups(gh).SaveX(ctx)
+	umbrella.User.Create().SetName("nati").SaveX(ctx)
+
+	require.Equal(t, 1, acme.User.Query().CountX(ctx))
+	require.Equal(t, "nati", umbrella.User.Query().OnlyX(ctx).Name)
+	require.Equal(t, pedro.ID, acme.Group.Query().QueryUsers().QueryPets().OnlyIDX(ctx))
+	require.False(t, umbrella.User.Query().Where(user.HasGroups()).ExistX(ctx))
+	require.Equal(t, "a8m", acme.User.Query().Where(user.HasGroupsWith(group.Name("GitHub"))).OnlyX(ctx).Name)
+}
+
 func setupSchema(t *testing.T, drv *sql.Driver) {
 	client := ent.NewClient(ent.Driver(&rewriter{drv}))
 	err := client.Schema.Create(context.Background(), migrate.WithForeignKeys(false), schema.WithAtlas(false))
@@ -0,0 +1,40 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Revision holds the schema definition for the Revision entity.
+type Revision struct {
+	ent.Schema
+}
+
+// Mixin of the Revision.
+func (Revision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Version{Field: "revision"},
+	}
+}
+
+// Fields of the Revision.
+func (Revision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("note"),
+	}
+}
+
+// Edges of the Revision.
+func (Revision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("document", Document.Type).
+			Ref("revisions").
+			Unique(),
+	}
+}
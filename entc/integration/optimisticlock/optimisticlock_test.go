@@ -0,0 +1,143 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package optimisticlock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/entc/integration/optimisticlock/ent"
+	"entgo.io/ent/entc/integration/optimisticlock/ent/document"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimisticLock(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	doc := client.Document.Create().SetTitle("draft").SaveX(ctx)
+	require.Equal(t, int64(1), doc.Version)
+
+	t.Log("Updating an entity increments its version")
+	doc = doc.Update().SetTitle("first").SaveX(ctx)
+	require.Equal(t, int64(2), doc.Version)
+	require.Equal(t, int64(2), client.Document.GetX(ctx, doc.ID).Version)
+
+	t.Log("Updating a stale entity fails with a conflict error")
+	stale := client.Document.GetX(ctx, doc.ID)
+	doc = doc.Update().SetBody("body").SaveX(ctx)
+	require.Equal(t, int64(3), doc.Version)
+	_, err = stale.Update().SetTitle("second").Save(ctx)
+	require.True(t, ent.IsConflict(err))
+	var cerr *ent.ConflictError
+	require.True(t, errors.As(err, &cerr))
+	require.Equal(t, document.Label, cerr.Label())
+	require.Equal(t, doc.ID, cerr.ID())
+	require.Equal(t, int64(2), cerr.Version())
+	require.Equal(t, "first", client.Document.GetX(ctx, doc.ID).Title)
+
+	t.Log("Conflict errors are not returned for missing entities")
+	missing := *doc
+	missing.ID++
+	_, err = missing.Update().SetTitle("missing").Save(ctx)
+	require.True(t, ent.IsNotFound(err))
+	require.False(t, ent.IsConflict(err))
+
+	t.Log("UpdateOneID checks the version only if it was set explicitly")
+	doc = client.Document.UpdateOneID(doc.ID).SetTitle("second").SaveX(ctx)
+	require.Equal(t, int64(4), doc.Version)
+	err = client.Document.UpdateOneID(doc.ID).ExpectVersion(3).SetTitle("third").Exec(ctx)
+	require.True(t, ent.IsConflict(err))
+	doc = client.Document.UpdateOneID(doc.ID).ExpectVersion(4).SetTitle("third").SaveX(ctx)
+	require.Equal(t, int64(5), doc.Version)
+
+	t.Log("Edge changes increment the version of the updated entity")
+	rev := client.Revision.Create().SetNote("initial").SaveX(ctx)
+	require.Equal(t, int64(1), rev.Revision)
+	doc = doc.Update().AddRevisions(rev).SaveX(ctx)
+	require.Equal(t, int64(6), doc.Version)
+	err = rev.Update().SetNote("stale").Exec(ctx)
+	require.NoError(t, err, "revisions are not changed by document edges")
+
+	t.Log("Bulk updates increment the versions without checking them")
+	client.Document.Create().SetTitle("other").ExecX(ctx)
+	n := client.Document.Update().Where(document.TitleIn("third", "other")).SetBody("bulk").SaveX(ctx)
+	require.Equal(t, 2, n)
+	require.Equal(t, int64(7), client.Document.GetX(ctx, doc.ID).Version)
+	require.True(t, ent.IsConflict(doc.Update().SetTitle("fourth").Exec(ctx)))
+
+	t.Log("Conflicts roll back the edge changes of the update")
+	rev2 := client.Revision.Create().SetNote("second").SaveX(ctx)
+	err = doc.Update().AddRevisions(rev2).Exec(ctx)
+	require.True(t, ent.IsConflict(err))
+	require.False(t, rev2.QueryDocument().ExistX(ctx))
+}
+
+func TestSync(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:sync?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	base := client.Document.Create().SetTitle("draft").SaveX(ctx)
+	// Changes made on the database.
+	client.Document.UpdateOne(base).SetTitle("local").SetBody("local").ExecX(ctx)
+	// Changes made on the replica.
+	replica := *base
+	replica.Title = "remote"
+	change := &ent.DocumentSyncChange{ID: base.ID, Base: base, Changes: base.Diff(&replica)}
+
+	t.Log("Conflicts are resolved using the given resolver")
+	_, err = client.Document.Sync(ctx, ent.RejectConflicts, change)
+	require.True(t, ent.IsSyncConflict(err))
+	require.Equal(t, "local", client.Document.GetX(ctx, base.ID).Title)
+	nodes, err := client.Document.Sync(ctx, ent.ResolveLocal, change)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	require.Equal(t, "local", nodes[0].Title)
+	require.Equal(t, "local", nodes[0].Body)
+	require.Equal(t, int64(3), nodes[0].Version)
+	nodes, err = client.Document.Sync(ctx, ent.ResolveRemote, change)
+	require.NoError(t, err)
+	require.Equal(t, "remote", nodes[0].Title)
+	require.Equal(t, int64(4), nodes[0].Version)
+
+	t.Log("Concurrent changes are merged again instead of being overwritten")
+	client.Document.UpdateOneID(base.ID).SetTitle("local").ExecX(ctx)
+	var calls int
+	resolve := func(ctx context.Context, c ent.FieldConflict) (ent.Value, error) {
+		if calls++; calls == 1 {
+			// Simulate a writer that changes the entity after it was read by Sync.
+			client.Document.UpdateOneID(base.ID).SetTitle("concurrent").ExecX(ctx)
+		}
+		return c.Local, nil
+	}
+	nodes, err = client.Document.Sync(ctx, resolve, change)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+	require.Equal(t, "concurrent", nodes[0].Title)
+	require.Equal(t, int64(7), nodes[0].Version)
+
+	t.Log("Entities that keep changing fail with a conflict error")
+	resolve = func(ctx context.Context, c ent.FieldConflict) (ent.Value, error) {
+		client.Document.UpdateOneID(base.ID).SetTitle("concurrent").ExecX(ctx)
+		return c.Remote, nil
+	}
+	_, err = client.Document.Sync(ctx, resolve, change)
+	require.True(t, ent.IsConflict(err))
+	require.Equal(t, "concurrent", client.Document.GetX(ctx, base.ID).Title)
+
+	_, err = client.Document.Sync(ctx, ent.ResolveRemote, &ent.DocumentSyncChange{ID: base.ID})
+	require.Error(t, err)
+	_, err = client.Document.Sync(ctx, ent.ResolveRemote, &ent.DocumentSyncChange{ID: base.ID + 1, Base: base})
+	require.True(t, ent.IsNotFound(err))
+}
@@ -0,0 +1,288 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/partition/ent/event"
+	"entgo.io/ent/entc/integration/partition/ent/user"
+	"entgo.io/ent/schema/field"
+)
+
+// EventCreate is the builder for creating a Event entity.
+type EventCreate struct {
+	config
+	mutation *EventMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (ec *EventCreate) SetKind(s string) *EventCreate {
+	ec.mutation.SetKind(s)
+	return ec
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (ec *EventCreate) SetOccurredAt(t time.Time) *EventCreate {
+	ec.mutation.SetOccurredAt(t)
+	return ec
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ec *EventCreate) SetUserID(id int) *EventCreate {
+	ec.mutation.SetUserID(id)
+	return ec
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (ec *EventCreate) SetNillableUserID(id *int) *EventCreate {
+	if id != nil {
+		ec = ec.SetUserID(*id)
+	}
+	return ec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ec *EventCreate) SetUser(u *User) *EventCreate {
+	return ec.SetUserID(u.ID)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (ec *EventCreate) Mutation() *EventMutation {
+	return ec.mutation
+}
+
+// Save creates the Event in the database.
+func (ec *EventCreate) Save(ctx context.Context) (*Event, error) {
+	var (
+		err  error
+		node *Event
+	)
+	if len(ec.hooks) == 0 {
+		if err = ec.check(); err != nil {
+			return nil, err
+		}
+		node, err = ec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ec.check(); err != nil {
+				return nil, err
+			}
+			ec.mutation = mutation
+			if node, err = ec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ec.hooks) - 1; i >= 0; i-- {
+			if ec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ec.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ec.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Event)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from EventMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ec *EventCreate) SaveX(ctx context.Context) *Event {
+	v, err := ec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ec *EventCreate) Exec(ctx context.Context) error {
+	_, err := ec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ec *EventCreate) ExecX(ctx context.Context) {
+	if err := ec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ec *EventCreate) check() error {
+	if _, ok := ec.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Event.kind"`)}
+	}
+	if _, ok := ec.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "Event.occurred_at"`)}
+	}
+	return nil
+}
+
+func (ec *EventCreate) sqlSave(ctx context.Context) (*Event, error) {
+	_node, _spec := ec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ec *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Event{config: ec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: event.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		}
+	)
+	if value, ok := ec.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldKind,
+		})
+		_node.Kind = value
+	}
+	if value, ok := ec.mutation.OccurredAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: event.FieldOccurredAt,
+		})
+		_node.OccurredAt = value
+	}
+	if nodes := ec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.UserTable,
+			Columns: []string{event.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EventCreateBulk is the builder for creating many Event entities in bulk.
+type EventCreateBulk struct {
+	config
+	builders []*EventCreate
+}
+
+// Save creates the Event entities in the database.
+func (ecb *EventCreateBulk) Save(ctx context.Context) ([]*Event, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ecb.builders))
+	nodes := make([]*Event, len(ecb.builders))
+	mutators := make([]Mutator, len(ecb.builders))
+	for i := range ecb.builders {
+		func(i int, root context.Context) {
+			builder := ecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecb *EventCreateBulk) SaveX(ctx context.Context) []*Event {
+	v, err := ecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecb *EventCreateBulk) Exec(ctx context.Context) error {
+	_, err := ecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecb *EventCreateBulk) ExecX(ctx context.Context) {
+	if err := ecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
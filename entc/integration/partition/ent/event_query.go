@@ -0,0 +1,780 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/partition/ent/event"
+	"entgo.io/ent/entc/integration/partition/ent/predicate"
+	"entgo.io/ent/entc/integration/partition/ent/user"
+	"entgo.io/ent/schema/field"
+)
+
+// EventQuery is the builder for querying Event entities.
+type EventQuery struct {
+	config
+	limit              *int
+	offset             *int
+	unique             *bool
+	order              []OrderFunc
+	fields             []string
+	inters             []Interceptor
+	predicates         []predicate.Event
+	rangeFrom, rangeTo *time.Time
+	allPartitions      bool
+	withUser           *UserQuery
+	withFKs            bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EventQuery builder.
+func (eq *EventQuery) Where(ps ...predicate.Event) *EventQuery {
+	eq.predicates = append(eq.predicates, ps...)
+	return eq
+}
+
+// Limit adds a limit step to the query.
+func (eq *EventQuery) Limit(limit int) *EventQuery {
+	eq.limit = &limit
+	return eq
+}
+
+// Offset adds an offset step to the query.
+func (eq *EventQuery) Offset(offset int) *EventQuery {
+	eq.offset = &offset
+	return eq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eq *EventQuery) Unique(unique bool) *EventQuery {
+	eq.unique = &unique
+	return eq
+}
+
+// Order adds an order step to the query.
+func (eq *EventQuery) Order(o ...OrderFunc) *EventQuery {
+	eq.order = append(eq.order, o...)
+	return eq
+}
+
+// Range restricts the query to the Event entities that their "occurred_at" field is in the half-open
+// time range [from, to). The table of Event is partitioned by this field, and its queries are required
+// to be restricted to a time range that is not longer than 744h0m0s.
+//
+// The range is applied using plain comparisons on the column, that allow the database to prune the
+// partitions that are out of range. Calling Range more than once restricts the query to the intersection
+// of the ranges.
+func (eq *EventQuery) Range(from, to time.Time) *EventQuery {
+	if eq.rangeFrom == nil || from.After(*eq.rangeFrom) {
+		eq.rangeFrom = &from
+	}
+	if eq.rangeTo == nil || to.Before(*eq.rangeTo) {
+		eq.rangeTo = &to
+	}
+	return eq.Where(event.OccurredAtGTE(from), event.OccurredAtLT(to))
+}
+
+// AllPartitions configures the query to read from all the partitions of the Event table, without
+// a time range. It should be used only by queries that are expected to scan the entire table.
+func (eq *EventQuery) AllPartitions() *EventQuery {
+	eq.allPartitions = true
+	return eq
+}
+
+// checkRange checks that the query is restricted to a valid time range, unless it reads from all partitions.
+func (eq *EventQuery) checkRange() error {
+	switch {
+	case eq.allPartitions:
+	case eq.rangeFrom == nil:
+		return errors.New(`ent: query on Event requires a time range on the "occurred_at" field (use Range or AllPartitions)`)
+	case !eq.rangeFrom.Before(*eq.rangeTo):
+		return fmt.Errorf("ent: empty time range [%v, %v) for query on Event", *eq.rangeFrom, *eq.rangeTo)
+	case eq.rangeTo.Sub(*eq.rangeFrom) > 2678400000000000: // 744h0m0s
+		return fmt.Errorf("ent: time range [%v, %v) for query on Event exceeds the maximum of 744h0m0s", *eq.rangeFrom, *eq.rangeTo)
+	}
+	return nil
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (eq *EventQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, event.UserTable, event.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Event entity from the query.
+// Returns a *NotFoundError when no Event was found.
+func (eq *EventQuery) First(ctx context.Context) (*Event, error) {
+	nodes, err := eq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, eq.sqlNotFound()
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eq *EventQuery) FirstX(ctx context.Context) *Event {
+	node, err := eq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Event ID from the query.
+// Returns a *NotFoundError when no Event ID was found.
+func (eq *EventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = eq.sqlNotFound()
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eq *EventQuery) FirstIDX(ctx context.Context) int {
+	id, err := eq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Event entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Event entity is found.
+// Returns a *NotFoundError when no Event entities are found.
+func (eq *EventQuery) Only(ctx context.Context) (*Event, error) {
+	nodes, err := eq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, eq.sqlNotFound()
+	default:
+		return nil, &NotSingularError{event.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eq *EventQuery) OnlyX(ctx context.Context) *Event {
+	node, err := eq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Event ID in the query.
+// Returns a *NotSingularError when more than one Event ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eq *EventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = eq.sqlNotFound()
+	default:
+		err = &NotSingularError{event.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eq *EventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := eq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Events.
+func (eq *EventQuery) All(ctx context.Context) ([]*Event, error) {
+	if err := eq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	v, err := eq.intercept(ctx, "All", func(ctx context.Context, query *EventQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Event)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Event", v)
+	}
+	return nodes, nil
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eq *EventQuery) AllX(ctx context.Context) []*Event {
+	nodes, err := eq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Event IDs.
+func (eq *EventQuery) IDs(ctx context.Context) ([]int, error) {
+	v, err := eq.intercept(ctx, "IDs", func(ctx context.Context, query *EventQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(event.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eq *EventQuery) IDsX(ctx context.Context) []int {
+	ids, err := eq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eq *EventQuery) Count(ctx context.Context) (int, error) {
+	if err := eq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	v, err := eq.intercept(ctx, "Count", func(ctx context.Context, query *EventQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eq *EventQuery) CountX(ctx context.Context) int {
+	count, err := eq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eq *EventQuery) Exist(ctx context.Context) (bool, error) {
+	if err := eq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	v, err := eq.intercept(ctx, "Exist", func(ctx context.Context, query *EventQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (eq *EventQuery) intercept(ctx context.Context, op string, fn func(context.Context, *EventQuery) (Value, error)) (Value, error) {
+	if len(eq.inters) == 0 {
+		return fn(ctx, eq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeEvent,
+		Op:     op,
+		Limit:  eq.limit,
+		Offset: eq.offset,
+		Unique: eq.unique,
+		Fields: eq.fields,
+	}
+	for _, p := range eq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range eq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if eq.withUser != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, event.EdgeUser)
+		qc.EdgeQueries[event.EdgeUser] = eq.withUser
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*EventQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *EventQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, eq, qc, qr, eq.inters)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eq *EventQuery) ExistX(ctx context.Context) bool {
+	exist, err := eq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eq *EventQuery) Clone() *EventQuery {
+	if eq == nil {
+		return nil
+	}
+	return &EventQuery{
+		config:     eq.config,
+		limit:      eq.limit,
+		offset:     eq.offset,
+		order:      append([]OrderFunc{}, eq.order...),
+		inters:     append([]Interceptor{}, eq.inters...),
+		predicates: append([]predicate.Event{}, eq.predicates...),
+		withUser:   eq.withUser.Clone(),
+		// clone intermediate query.
+		sql:           eq.sql.Clone(),
+		path:          eq.path,
+		unique:        eq.unique,
+		rangeFrom:     eq.rangeFrom,
+		rangeTo:       eq.rangeTo,
+		allPartitions: eq.allPartitions,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EventQuery) WithUser(opts ...func(*UserQuery)) *EventQuery {
+	query := (&UserClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withUser = query
+	return eq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind string `json:"kind,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Event.Query().
+//		GroupBy(event.FieldKind).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (eq *EventQuery) GroupBy(field string, fields ...string) *EventGroupBy {
+	grbuild := &EventGroupBy{config: eq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return eq.sqlQuery(ctx), nil
+	}
+	grbuild.label = event.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind string `json:"kind,omitempty"`
+//	}
+//
+//	client.Event.Query().
+//		Select(event.FieldKind).
+//		Scan(ctx, &v)
+//
+func (eq *EventQuery) Select(fields ...string) *EventSelect {
+	eq.fields = append(eq.fields, fields...)
+	selbuild := &EventSelect{EventQuery: eq}
+	selbuild.label = event.Label
+	selbuild.flds, selbuild.scan = &eq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (eq *EventQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range eq.fields {
+		if !event.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	for _, inter := range eq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eq); err != nil {
+				return err
+			}
+		}
+	}
+	if err := eq.checkRange(); err != nil {
+		return err
+	}
+	if eq.path != nil {
+		prev, err := eq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eq.sql = prev
+	}
+	return nil
+}
+
+func (eq *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event, error) {
+	var (
+		nodes       = []*Event{}
+		withFKs     = eq.withFKs
+		_spec       = eq.querySpec()
+		loadedTypes = [1]bool{
+			eq.withUser != nil,
+		}
+	)
+	if eq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, event.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Event).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Event{config: eq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := eq.withUser; query != nil {
+		if err := eq.loadUser(ctx, query, nodes, nil,
+			func(n *Event, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (eq *EventQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Event, init func(*Event), assign func(*Event, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Event)
+	for i := range nodes {
+		if nodes[i].user_events == nil {
+			continue
+		}
+		fk := *nodes[i].user_events
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_events" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (eq *EventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eq.querySpec()
+	_spec.Node.Columns = eq.fields
+	if len(eq.fields) > 0 {
+		_spec.Unique = eq.unique != nil && *eq.unique
+	}
+	return sqlgraph.CountNodes(ctx, eq.queryDriver(), _spec)
+}
+
+func (eq *EventQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := eq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (eq *EventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   event.Table,
+			Columns: event.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		},
+		From:   eq.sql,
+		Unique: true,
+	}
+	if unique := eq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := eq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, event.FieldID)
+		for i := range fields {
+			if fields[i] != event.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := eq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eq *EventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eq.driver.Dialect())
+	t1 := builder.Table(event.Table)
+	columns := eq.fields
+	if len(columns) == 0 {
+		columns = event.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eq.sql != nil {
+		selector = eq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eq.unique != nil && *eq.unique {
+		selector.Distinct()
+	}
+	for _, p := range eq.predicates {
+		p(selector)
+	}
+	for _, p := range eq.order {
+		p(selector)
+	}
+	if offset := eq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (eq *EventQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: event.Label}
+	if len(eq.predicates) > 0 {
+		selector := sql.Dialect(eq.driver.Dialect()).Select().From(sql.Table(event.Table))
+		for _, p := range eq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the EventQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (eq *EventQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Event, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Event(ps[i]))
+	}
+	eq.predicates = append(eq.predicates, wps...)
+}
+
+// EventGroupBy is the group-by builder for Event entities.
+type EventGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (egb *EventGroupBy) Aggregate(fns ...AggregateFunc) *EventGroupBy {
+	egb.fns = append(egb.fns, fns...)
+	return egb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (egb *EventGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := egb.path(ctx)
+	if err != nil {
+		return err
+	}
+	egb.sql = query
+	return egb.sqlScan(ctx, v)
+}
+
+func (egb *EventGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range egb.fields {
+		if !event.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := egb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := egb.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (egb *EventGroupBy) sqlQuery() *sql.Selector {
+	selector := egb.sql.Select()
+	aggregation := make([]string, 0, len(egb.fns))
+	for _, fn := range egb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(egb.fields)+len(egb.fns))
+		for _, f := range egb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(egb.fields...)...)
+}
+
+// EventSelect is the builder for selecting fields of Event entities.
+type EventSelect struct {
+	*EventQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (es *EventSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := es.prepareQuery(ctx); err != nil {
+		return err
+	}
+	es.sql = es.EventQuery.sqlQuery(ctx)
+	return es.sqlScan(ctx, v)
+}
+
+func (es *EventSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := es.sql.Query()
+	if err := es.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,414 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/partition/ent/event"
+	"entgo.io/ent/entc/integration/partition/ent/predicate"
+	"entgo.io/ent/entc/integration/partition/ent/user"
+	"entgo.io/ent/schema/field"
+)
+
+// EventUpdate is the builder for updating Event entities.
+type EventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// Where appends a list predicates to the EventUpdate builder.
+func (eu *EventUpdate) Where(ps ...predicate.Event) *EventUpdate {
+	eu.mutation.Where(ps...)
+	return eu
+}
+
+// SetKind sets the "kind" field.
+func (eu *EventUpdate) SetKind(s string) *EventUpdate {
+	eu.mutation.SetKind(s)
+	return eu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (eu *EventUpdate) SetUserID(id int) *EventUpdate {
+	eu.mutation.SetUserID(id)
+	return eu
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (eu *EventUpdate) SetNillableUserID(id *int) *EventUpdate {
+	if id != nil {
+		eu = eu.SetUserID(*id)
+	}
+	return eu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (eu *EventUpdate) SetUser(u *User) *EventUpdate {
+	return eu.SetUserID(u.ID)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (eu *EventUpdate) Mutation() *EventMutation {
+	return eu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (eu *EventUpdate) ClearUser() *EventUpdate {
+	eu.mutation.ClearUser()
+	return eu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eu *EventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(eu.hooks) == 0 {
+		affected, err = eu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			eu.mutation = mutation
+			affected, err = eu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(eu.hooks) - 1; i >= 0; i-- {
+			if eu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = eu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, eu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *EventUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *EventUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *EventUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eu *EventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   event.Table,
+			Columns: event.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		},
+	}
+	if ps := eu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldKind,
+		})
+	}
+	if eu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.UserTable,
+			Columns: []string{event.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.UserTable,
+			Columns: []string{event.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label: event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// EventUpdateOne is the builder for updating a single Event entity.
+type EventUpdateOne struct {
+	config
+	fields     []string
+	conditions []predicate.Event
+	hooks      []Hook
+	mutation   *EventMutation
+}
+
+// Where appends a list of conditions to the EventUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (euo *EventUpdateOne) Where(ps ...predicate.Event) *EventUpdateOne {
+	euo.conditions = append(euo.conditions, ps...)
+	return euo
+}
+
+// SetKind sets the "kind" field.
+func (euo *EventUpdateOne) SetKind(s string) *EventUpdateOne {
+	euo.mutation.SetKind(s)
+	return euo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (euo *EventUpdateOne) SetUserID(id int) *EventUpdateOne {
+	euo.mutation.SetUserID(id)
+	return euo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableUserID(id *int) *EventUpdateOne {
+	if id != nil {
+		euo = euo.SetUserID(*id)
+	}
+	return euo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (euo *EventUpdateOne) SetUser(u *User) *EventUpdateOne {
+	return euo.SetUserID(u.ID)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (euo *EventUpdateOne) Mutation() *EventMutation {
+	return euo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (euo *EventUpdateOne) ClearUser() *EventUpdateOne {
+	euo.mutation.ClearUser()
+	return euo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *EventUpdateOne) Select(field string, fields ...string) *EventUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Event entity.
+func (euo *EventUpdateOne) Save(ctx context.Context) (*Event, error) {
+	var (
+		err  error
+		node *Event
+	)
+	if len(euo.hooks) == 0 {
+		node, err = euo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			euo.mutation = mutation
+			node, err = euo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(euo.hooks) - 1; i >= 0; i-- {
+			if euo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = euo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, euo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Event)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from EventMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EventUpdateOne) SaveX(ctx context.Context) *Event {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *EventUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EventUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (euo *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   event.Table,
+			Columns: event.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		},
+	}
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Event.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, event.FieldID)
+		for _, f := range fields {
+			if !event.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != event.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ps := euo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldKind,
+		})
+	}
+	if euo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.UserTable,
+			Columns: []string{event.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.UserTable,
+			Columns: []string{event.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Event{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label: event.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: event.Label, id: _spec.Node.ID.Value, version: e.Version()}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
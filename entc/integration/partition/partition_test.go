@@ -0,0 +1,71 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package partition
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc/integration/partition/ent"
+	"entgo.io/ent/entc/integration/partition/ent/event"
+	"entgo.io/ent/entc/integration/partition/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartition(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	day := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	for i := 0; i < 3; i++ {
+		client.Event.Create().SetKind("login").SetOccurredAt(day.AddDate(0, i, 0)).SetUser(a8m).ExecX(ctx)
+	}
+
+	t.Log("Queries without a time range are rejected")
+	_, err = client.Event.Query().All(ctx)
+	require.EqualError(t, err, `ent: query on Event requires a time range on the "occurred_at" field (use Range or AllPartitions)`)
+	_, err = client.Event.Query().Count(ctx)
+	require.Error(t, err)
+	_, err = client.Event.Query().Select(event.FieldKind).Strings(ctx)
+	require.Error(t, err)
+	_, err = a8m.QueryEvents().Count(ctx)
+	require.Error(t, err)
+	_, err = client.Event.Query().QueryUser().All(ctx)
+	require.Error(t, err, "traversals from partitioned types require a range")
+	_, err = client.User.Query().WithEvents().All(ctx)
+	require.Error(t, err, "eager-loading of partitioned types requires a range")
+
+	t.Log("Time ranges are validated")
+	_, err = client.Event.Query().Range(day, day).All(ctx)
+	require.Error(t, err)
+	_, err = client.Event.Query().Range(day, day.AddDate(0, 2, 0)).All(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "exceeds the maximum of 744h0m0s")
+
+	t.Log("Queries with a time range are restricted to it")
+	require.Equal(t, 1, client.Event.Query().Range(day, day.AddDate(0, 1, 0)).CountX(ctx))
+	require.Equal(t, 1, client.Event.Query().Range(day.AddDate(0, 0, 15), day.AddDate(0, 1, 15)).CountX(ctx), "end of range is exclusive")
+	require.Zero(t, client.Event.Query().Range(day, day.AddDate(0, 1, 0)).Range(day.AddDate(0, 0, 15), day.AddDate(0, 1, 15)).CountX(ctx))
+	require.Equal(t, 1, a8m.QueryEvents().Range(day, day.AddDate(0, 0, 1)).CountX(ctx))
+	require.Equal(t, a8m.ID, client.Event.Query().Range(day, day.AddDate(0, 0, 1)).QueryUser().OnlyIDX(ctx))
+	u := client.User.Query().WithEvents(func(q *ent.EventQuery) {
+		q.Range(day.AddDate(0, 1, 0), day.AddDate(0, 2, 0))
+	}).OnlyX(ctx)
+	require.Len(t, u.Edges.Events, 1)
+	require.True(t, day.AddDate(0, 1, 0).Equal(u.Edges.Events[0].OccurredAt))
+	require.True(t, client.User.Query().Where(user.HasEvents()).ExistX(ctx), "predicates are not restricted")
+
+	t.Log("Full scans must be explicit")
+	require.Equal(t, 3, client.Event.Query().AllPartitions().CountX(ctx))
+	require.Equal(t, 3, a8m.QueryEvents().AllPartitions().CountX(ctx))
+	require.Len(t, client.Event.Query().AllPartitions().Clone().AllX(ctx), 3)
+}
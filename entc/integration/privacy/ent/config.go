@@ -7,10 +7,14 @@
 package ent
 
 import (
+	"context"
 	"net/http"
+	"reflect"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/privacy"
 )
 
 // Option function to configure the client.
@@ -20,13 +24,23 @@ type Option func(*config)
 type config struct {
 	// driver used for executing database requests.
 	driver dialect.Driver
+	// readDriver used for executing read queries outside of transactions, if set.
+	readDriver dialect.Driver
 	// debug enable a debug logging.
 	debug bool
 	// log used for logging on debug mode.
 	log func(...interface{})
 	// hooks to execute on mutations.
-	hooks      *hooks
-	HTTPClient *http.Client
+	hooks *hooks
+	// interceptors to execute on queries.
+	inters *inters
+	// clock used for computing the time.Now defaults of fields.
+	clock func() time.Time
+	// extensions that were added to the client.
+	extensions []ent.Extension
+	// skipPrivacy skips the privacy policies of the schemas.
+	skipPrivacy bool
+	HTTPClient  *http.Client
 }
 
 // hooks per client, for fast access.
@@ -36,14 +50,24 @@ type hooks struct {
 	User []ent.Hook
 }
 
+// inters per client, for fast access.
+type inters struct {
+	Task []ent.Interceptor
+	Team []ent.Interceptor
+	User []ent.Interceptor
+}
+
 // Options applies the options on the config object.
 func (c *config) options(opts ...Option) {
 	for _, opt := range opts {
 		opt(c)
 	}
-	if c.debug {
+	if _, ok := c.driver.(*dialect.DebugDriver); c.debug && !ok {
 		c.driver = dialect.Debug(c.driver, c.log)
 	}
+	if _, ok := c.readDriver.(*dialect.DebugDriver); c.debug && c.readDriver != nil && !ok {
+		c.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
 }
 
 // Debug enables debug logging on the ent.Driver.
@@ -67,6 +91,97 @@ func Driver(driver dialect.Driver) Option {
 	}
 }
 
+// ReadDriver configures the driver that executes the read queries of the client (e.g. Query, Only and Count),
+// for splitting the reads and writes between a read replica and the primary database. Mutations, and queries
+// that are executed in transactions, are executed by the driver that was configured using Driver. For example:
+//
+//	client := ent.NewClient(ent.Driver(primary), ent.ReadDriver(replica))
+//
+// Note that replicas may lag behind the primary. Reads that must observe the writes of the client should
+// be executed in a transaction, or using a client that was derived using WithOptions(ReadDriver(nil)).
+func ReadDriver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.readDriver = driver
+	}
+}
+
+// Clock sets the clock of the client. Fields whose default (or update default) function is time.Now
+// use the clock instead, which allows freezing the time in tests, or backfilling entities with
+// historical timestamps. For example:
+//
+//	client := ent.NewClient(ent.Driver(drv), ent.Clock(func() time.Time {
+//		return time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+//	}))
+//
+func Clock(now func() time.Time) Option {
+	return func(c *config) {
+		c.clock = now
+	}
+}
+
+// ReplaceHooks replaces the hooks that were registered on the entity clients using Use with the given
+// hooks. It is mainly used with Client.WithOptions, for creating clients with a different set of hooks.
+// Note that the hooks and policies that are defined in the schema are not affected by this option.
+func ReplaceHooks(hs ...Hook) Option {
+	return func(c *config) {
+		c.hooks = &hooks{
+			Task: hs[:len(hs):len(hs)],
+			Team: hs[:len(hs):len(hs)],
+			User: hs[:len(hs):len(hs)],
+		}
+	}
+}
+
+// SkipPrivacy configures the client to skip the privacy policies of the schemas. It is equivalent to
+// executing all operations of the client with a context that holds the privacy.Allow decision, and it
+// is mainly used with Client.WithOptions, for creating clients for trusted background jobs.
+func SkipPrivacy() Option {
+	return func(c *config) {
+		c.skipPrivacy = true
+	}
+}
+
+// privacyContext returns the context for evaluating the privacy policies. The
+// returned context holds the privacy.Allow decision if SkipPrivacy was set.
+func (c config) privacyContext(ctx context.Context) context.Context {
+	if c.skipPrivacy {
+		return privacy.DecisionContext(ctx, privacy.Allow)
+	}
+	return ctx
+}
+
+// allowPrivacy is a mutation hook that executes the mutations
+// of clients that skip privacy with the privacy.Allow decision.
+func allowPrivacy(next Mutator) Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		return next.Mutate(privacy.DecisionContext(ctx, privacy.Allow), m)
+	})
+}
+
+// queryDriver returns the driver for executing read queries. It is the read
+// driver of the config, if it was set and the config is not transactional.
+func (c config) queryDriver() dialect.Driver {
+	if c.readDriver == nil {
+		return c.driver
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.driver
+	}
+	return c.readDriver
+}
+
+// timeNow holds the code pointer of time.Now, for detecting defaults that can be replaced by the clock.
+var timeNow = reflect.ValueOf(time.Now).Pointer()
+
+// now returns the value of the given time default function. Functions that
+// are time.Now are replaced by the clock of the config, if it was set.
+func (c config) now(fn func() time.Time) time.Time {
+	if c.clock != nil && reflect.ValueOf(fn).Pointer() == timeNow {
+		return c.clock()
+	}
+	return fn()
+}
+
 // HTTPClient configures the HTTPClient.
 func HTTPClient(v *http.Client) Option {
 	return func(c *config) {
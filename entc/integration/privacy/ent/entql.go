@@ -158,6 +158,29 @@ func (tq *TaskQuery) Filter() *TaskFilter {
 	return &TaskFilter{config: tq.config, predicateAdder: tq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// TaskQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (tq *TaskQuery) Apply(q *entql.Query) *TaskQuery {
+	if q.Where != nil {
+		tq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			tq.Order(Desc(o.Field))
+		} else {
+			tq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		tq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		tq.Offset(*q.Offset)
+	}
+	return tq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *TaskMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -246,6 +269,29 @@ func (tq *TeamQuery) Filter() *TeamFilter {
 	return &TeamFilter{config: tq.config, predicateAdder: tq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// TeamQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (tq *TeamQuery) Apply(q *entql.Query) *TeamQuery {
+	if q.Where != nil {
+		tq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			tq.Order(Desc(o.Field))
+		} else {
+			tq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		tq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		tq.Offset(*q.Offset)
+	}
+	return tq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *TeamMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -319,6 +365,29 @@ func (uq *UserQuery) Filter() *UserFilter {
 	return &UserFilter{config: uq.config, predicateAdder: uq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// UserQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (uq *UserQuery) Apply(q *entql.Query) *UserQuery {
+	if q.Where != nil {
+		uq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			uq.Order(Desc(o.Field))
+		} else {
+			uq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		uq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		uq.Offset(*q.Offset)
+	}
+	return uq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
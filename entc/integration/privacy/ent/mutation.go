@@ -19,6 +19,7 @@ import (
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -193,6 +194,7 @@ func (m *TaskMutation) ResetTitle() {
 // SetDescription sets the "description" field.
 func (m *TaskMutation) SetDescription(s string) {
 	m.description = &s
+	delete(m.clearedFields, task.FieldDescription)
 }
 
 // Description returns the value of the "description" field in the mutation.
@@ -278,6 +280,7 @@ func (m *TaskMutation) ResetStatus() {
 // SetUUID sets the "uuid" field.
 func (m *TaskMutation) SetUUID(u uuid.UUID) {
 	m.uuid = &u
+	delete(m.clearedFields, task.FieldUUID)
 }
 
 // UUID returns the value of the "uuid" field in the mutation.
@@ -422,6 +425,16 @@ func (m *TaskMutation) Where(ps ...predicate.Task) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *TaskMutation) Op() Op {
 	return m.op
@@ -967,6 +980,16 @@ func (m *TeamMutation) Where(ps ...predicate.Team) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *TeamMutation) Op() Op {
 	return m.op
@@ -1197,6 +1220,9 @@ type UserMutation struct {
 	name          *string
 	age           *uint
 	addage        *int
+	maxage        *uint
+	andage        *uint
+	orage         *uint
 	clearedFields map[string]struct{}
 	teams         map[int]struct{}
 	removedteams  map[int]struct{}
@@ -1347,6 +1373,10 @@ func (m *UserMutation) ResetName() {
 func (m *UserMutation) SetAge(u uint) {
 	m.age = &u
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
+	delete(m.clearedFields, user.FieldAge)
 }
 
 // Age returns the value of the "age" field in the mutation.
@@ -1393,10 +1423,65 @@ func (m *UserMutation) AddedAge() (r int, exists bool) {
 	return *v, true
 }
 
+// SetAgeIfGreater sets the "age" field to u in the database, only if it is greater than its stored value.
+func (m *UserMutation) SetAgeIfGreater(u uint) {
+	if m.maxage == nil || u > *m.maxage {
+		m.maxage = &u
+	}
+}
+
+// AgeIfGreater returns the value that was set to the "age" field by SetAgeIfGreater in this mutation.
+func (m *UserMutation) AgeIfGreater() (r uint, exists bool) {
+	v := m.maxage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndAge applies a bitwise AND with u on the "age" field.
+func (m *UserMutation) BitAndAge(u uint) {
+	if m.andage != nil {
+		*m.andage &= u
+	} else {
+		m.andage = &u
+	}
+}
+
+// AgeBitAnd returns the value that was applied by BitAndAge on the "age" field in this mutation.
+func (m *UserMutation) AgeBitAnd() (r uint, exists bool) {
+	v := m.andage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrAge applies a bitwise OR with u on the "age" field.
+func (m *UserMutation) BitOrAge(u uint) {
+	if m.orage != nil {
+		*m.orage |= u
+	} else {
+		m.orage = &u
+	}
+}
+
+// AgeBitOr returns the value that was applied by BitOrAge on the "age" field in this mutation.
+func (m *UserMutation) AgeBitOr() (r uint, exists bool) {
+	v := m.orage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ClearAge clears the value of the "age" field.
 func (m *UserMutation) ClearAge() {
 	m.age = nil
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 	m.clearedFields[user.FieldAge] = struct{}{}
 }
 
@@ -1410,6 +1495,9 @@ func (m *UserMutation) AgeCleared() bool {
 func (m *UserMutation) ResetAge() {
 	m.age = nil
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 	delete(m.clearedFields, user.FieldAge)
 }
 
@@ -1526,6 +1614,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -56,6 +56,21 @@ func DecisionFromContext(ctx context.Context) (error, bool) {
 	return privacy.DecisionFromContext(ctx)
 }
 
+// BypassContext creates a new context from the given parent context with a scoped bypass token
+// attach to it. The token expires when the returned context is done, or when the returned cancel
+// function is called. For example:
+//
+//	ctx, cancel := privacy.BypassContext(ctx, privacy.Bypass{
+//		Reason: "nightly cleanup job",
+//		Types:  []string{ent.TypeTask},
+//		Ops:    ent.OpDelete,
+//	})
+//	defer cancel()
+//
+func BypassContext(parent context.Context, b Bypass) (context.Context, context.CancelFunc) {
+	return privacy.BypassContext(parent, b)
+}
+
 type (
 	// Policy groups query and mutation policies.
 	Policy = privacy.Policy
@@ -71,6 +86,11 @@ type (
 	MutationRule = privacy.MutationRule
 	// MutationPolicy combines multiple mutation rules into a single policy.
 	MutationPolicy = privacy.MutationPolicy
+
+	// Bypass defines the scope of a privacy bypass token.
+	Bypass = privacy.Bypass
+	// BypassRecord is the audit record of a bypass token usage.
+	BypassRecord = privacy.BypassRecord
 )
 
 // QueryRuleFunc type is an adapter to allow the use of
@@ -107,7 +107,7 @@ func (tdo *TeamDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{team.Label}
+		return &NotFoundError{label: team.Label}
 	default:
 		return nil
 	}
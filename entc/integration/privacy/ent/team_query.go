@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/privacy/ent/predicate"
@@ -30,6 +31,7 @@ type TeamQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Team
 	withTasks  *TaskQuery
 	withUsers  *UserQuery
@@ -71,7 +73,7 @@ func (tq *TeamQuery) Order(o ...OrderFunc) *TeamQuery {
 
 // QueryTasks chains the current query on the "tasks" edge.
 func (tq *TeamQuery) QueryTasks() *TaskQuery {
-	query := &TaskQuery{config: tq.config}
+	query := (&TaskClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -93,7 +95,7 @@ func (tq *TeamQuery) QueryTasks() *TaskQuery {
 
 // QueryUsers chains the current query on the "users" edge.
 func (tq *TeamQuery) QueryUsers() *UserQuery {
-	query := &UserQuery{config: tq.config}
+	query := (&UserClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -121,7 +123,7 @@ func (tq *TeamQuery) First(ctx context.Context) (*Team, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{team.Label}
+		return nil, tq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -143,7 +145,7 @@ func (tq *TeamQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{team.Label}
+		err = tq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -170,7 +172,7 @@ func (tq *TeamQuery) Only(ctx context.Context) (*Team, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{team.Label}
+		return nil, tq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{team.Label}
 	}
@@ -197,7 +199,7 @@ func (tq *TeamQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{team.Label}
+		err = tq.sqlNotFound()
 	default:
 		err = &NotSingularError{team.Label}
 	}
@@ -218,7 +220,17 @@ func (tq *TeamQuery) All(ctx context.Context) ([]*Team, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.sqlAll(ctx)
+	v, err := tq.intercept(ctx, "All", func(ctx context.Context, query *TeamQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Team)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Team", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -232,10 +244,20 @@ func (tq *TeamQuery) AllX(ctx context.Context) []*Team {
 
 // IDs executes the query and returns a list of Team IDs.
 func (tq *TeamQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := tq.Select(team.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := tq.intercept(ctx, "IDs", func(ctx context.Context, query *TeamQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(team.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -253,7 +275,17 @@ func (tq *TeamQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.sqlCount(ctx)
+	v, err := tq.intercept(ctx, "Count", func(ctx context.Context, query *TeamQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -270,7 +302,61 @@ func (tq *TeamQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.sqlExist(ctx)
+	v, err := tq.intercept(ctx, "Exist", func(ctx context.Context, query *TeamQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (tq *TeamQuery) intercept(ctx context.Context, op string, fn func(context.Context, *TeamQuery) (Value, error)) (Value, error) {
+	if len(tq.inters) == 0 {
+		return fn(ctx, tq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeTeam,
+		Op:     op,
+		Limit:  tq.limit,
+		Offset: tq.offset,
+		Unique: tq.unique,
+		Fields: tq.fields,
+	}
+	for _, p := range tq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range tq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if tq.withTasks != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, team.EdgeTasks)
+		qc.EdgeQueries[team.EdgeTasks] = tq.withTasks
+	}
+	if tq.withUsers != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, team.EdgeUsers)
+		qc.EdgeQueries[team.EdgeUsers] = tq.withUsers
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TeamQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *TeamQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, tq, qc, qr, tq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -293,6 +379,7 @@ func (tq *TeamQuery) Clone() *TeamQuery {
 		limit:      tq.limit,
 		offset:     tq.offset,
 		order:      append([]OrderFunc{}, tq.order...),
+		inters:     append([]Interceptor{}, tq.inters...),
 		predicates: append([]predicate.Team{}, tq.predicates...),
 		withTasks:  tq.withTasks.Clone(),
 		withUsers:  tq.withUsers.Clone(),
@@ -306,7 +393,7 @@ func (tq *TeamQuery) Clone() *TeamQuery {
 // WithTasks tells the query-builder to eager-load the nodes that are connected to
 // the "tasks" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TeamQuery) WithTasks(opts ...func(*TaskQuery)) *TeamQuery {
-	query := &TaskQuery{config: tq.config}
+	query := (&TaskClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -317,7 +404,7 @@ func (tq *TeamQuery) WithTasks(opts ...func(*TaskQuery)) *TeamQuery {
 // WithUsers tells the query-builder to eager-load the nodes that are connected to
 // the "users" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TeamQuery) WithUsers(opts ...func(*UserQuery)) *TeamQuery {
-	query := &UserQuery{config: tq.config}
+	query := (&UserClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -381,6 +468,16 @@ func (tq *TeamQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range tq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tq); err != nil {
+				return err
+			}
+		}
+	}
 	if tq.path != nil {
 		prev, err := tq.path(ctx)
 		if err != nil {
@@ -391,7 +488,7 @@ func (tq *TeamQuery) prepareQuery(ctx context.Context) error {
 	if team.Policy == nil {
 		return errors.New("ent: uninitialized team.Policy (forgotten import ent/runtime?)")
 	}
-	if err := team.Policy.EvalQuery(ctx, tq); err != nil {
+	if err := team.Policy.EvalQuery(tq.privacyContext(ctx), tq); err != nil {
 		return err
 	}
 	return nil
@@ -418,7 +515,7 @@ func (tq *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, tq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, tq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -558,7 +655,7 @@ func (tq *TeamQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(tq.fields) > 0 {
 		_spec.Unique = tq.unique != nil && *tq.unique
 	}
-	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, tq.queryDriver(), _spec)
 }
 
 func (tq *TeamQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -649,6 +746,32 @@ func (tq *TeamQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (tq *TeamQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: team.Label}
+	if len(tq.predicates) > 0 {
+		selector := sql.Dialect(tq.driver.Dialect()).Select().From(sql.Table(team.Table))
+		for _, p := range tq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the TeamQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (tq *TeamQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Team, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Team(ps[i]))
+	}
+	tq.predicates = append(tq.predicates, wps...)
+}
+
 // TeamGroupBy is the group-by builder for Team entities.
 type TeamGroupBy struct {
 	config
@@ -688,7 +811,7 @@ func (tgb *TeamGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := tgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := tgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -734,7 +857,7 @@ func (ts *TeamSelect) Scan(ctx context.Context, v interface{}) error {
 func (ts *TeamSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ts.sql.Query()
-	if err := ts.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ts.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
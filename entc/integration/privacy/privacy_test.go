@@ -9,6 +9,7 @@ import (
 	"errors"
 	"testing"
 
+	"entgo.io/ent/entc/integration/privacy/ent"
 	"entgo.io/ent/entc/integration/privacy/ent/enttest"
 	"entgo.io/ent/entc/integration/privacy/ent/privacy"
 	"entgo.io/ent/entc/integration/privacy/ent/task"
@@ -80,3 +81,72 @@ func TestPrivacyRules(t *testing.T) {
 	task3.Update().SetDescription("boring description").SaveX(natctx)
 	task3.Update().SetDescription("boring description").SaveX(a8mctx)
 }
+
+func TestClientWithOptions(t *testing.T) {
+	client := enttest.Open(t, "sqlite3",
+		"file:ent?mode=memory&cache=shared&_fk=1",
+	)
+	defer client.Close()
+	var calls []string
+	counter := func(name string) ent.Hook {
+		return func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				calls = append(calls, name)
+				return next.Mutate(ctx, m)
+			})
+		}
+	}
+	client.Use(counter("client"))
+	ctx := context.Background()
+	_, err := client.Team.Create().SetName("ent").Save(ctx)
+	require.True(t, errors.Is(err, privacy.Deny), "policy requires viewer context")
+	require.Equal(t, []string{"client"}, calls)
+
+	calls = nil
+	jobs := client.WithOptions(ent.ReplaceHooks(counter("jobs")), ent.SkipPrivacy())
+	team := jobs.Team.Create().SetName("ent").SaveX(ctx)
+	a8m := jobs.User.Create().SetName("a8m").AddTeams(team).SaveX(ctx)
+	jobs.Task.Create().SetTitle("task 1").AddTeams(team).SetOwner(a8m).SaveX(ctx)
+	require.Equal(t, []string{"jobs", "jobs", "jobs"}, calls, "client hooks were replaced")
+	require.Equal(t, 1, jobs.Task.Query().CountX(ctx))
+	require.Equal(t, "a8m", jobs.Task.Query().QueryOwner().OnlyX(ctx).Name)
+	_, err = client.Task.Query().Count(ctx)
+	require.True(t, errors.Is(err, privacy.Deny), "original client is not affected")
+
+	calls = nil
+	jobs.Use(counter("jobs2"))
+	client.Team.Create().SetName("ent-contrib").SaveX(viewer.NewContext(ctx, viewer.AppViewer{Role: viewer.Admin}))
+	require.Equal(t, []string{"client"}, calls, "hooks of derived clients are not shared")
+	calls = nil
+	jobs.Team.Create().SetName("ent-jobs").SaveX(ctx)
+	require.Equal(t, []string{"jobs", "jobs2"}, calls)
+}
+
+func TestBypassContext(t *testing.T) {
+	client := enttest.Open(t, "sqlite3",
+		"file:ent?mode=memory&cache=shared&_fk=1",
+	)
+	defer client.Close()
+	var records []privacy.BypassRecord
+	ctx, cancel := privacy.BypassContext(context.Background(), privacy.Bypass{
+		Reason: "seed",
+		Types:  []string{ent.TypeTeam, ent.TypeUser},
+		Ops:    ent.OpCreate,
+		Audit: func(_ context.Context, r privacy.BypassRecord) {
+			records = append(records, r)
+		},
+	})
+	team := client.Team.Create().SetName("ent").SaveX(ctx)
+	a8m := client.User.Create().SetName("a8m").AddTeams(team).SaveX(ctx)
+	_, err := client.Task.Create().SetTitle("task 1").AddTeams(team).SetOwner(a8m).Save(ctx)
+	require.True(t, errors.Is(err, privacy.Deny), "task type is not in the token scope")
+	_, err = client.Team.Query().All(ctx)
+	require.True(t, errors.Is(err, privacy.Deny), "token does not apply to queries")
+	require.Equal(t, []privacy.BypassRecord{
+		{Reason: "seed", Type: ent.TypeTeam, Op: ent.OpCreate},
+		{Reason: "seed", Type: ent.TypeUser, Op: ent.OpCreate},
+	}, records)
+	cancel()
+	_, err = client.Team.Create().SetName("ent-contrib").Save(context.Background())
+	require.True(t, errors.Is(err, privacy.Deny), "token expired")
+}
@@ -0,0 +1,145 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc/integration/retention/ent"
+	"entgo.io/ent/entc/integration/retention/ent/event"
+	"entgo.io/ent/entc/integration/retention/ent/session"
+	"entgo.io/ent/entc/integration/retention/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetention(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	now := time.Now()
+	old := now.Add(-2 * 365 * 24 * time.Hour)
+	a8m := client.User.Create().SetName("a8m").SetEmail("a8m@example.com").SetPhone("555").SetLastLogin(old).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetEmail("nati@example.com").SaveX(ctx)
+	for i := 0; i < 5; i++ {
+		client.Session.Create().SetToken("expired").SetCreatedAt(old).SetUser(a8m).ExecX(ctx)
+	}
+	client.Session.Create().SetToken("active").SetUser(nati).ExecX(ctx)
+	for i := 0; i < 3; i++ {
+		client.Event.Create().SetKind("expired").SetOccurredAt(old).ExecX(ctx)
+	}
+	client.Event.Create().SetKind("active").SetOccurredAt(now).ExecX(ctx)
+
+	t.Log("Policies with the archive strategy require an archiver")
+	_, err = client.Event.ApplyRetention(ctx)
+	require.EqualError(t, err, "ent: missing archiver for the retention policy of Event")
+	_, err = client.ApplyRetention(ctx, ent.RetentionBatch(0))
+	require.Error(t, err)
+	_, err = client.ApplyRetention(ctx, ent.RetentionTypes("Unknown"))
+	require.Error(t, err)
+
+	t.Log("Expired entities are processed in batches, and the limit bounds the run")
+	var progress []ent.RetentionProgress
+	p, err := client.Session.ApplyRetention(ctx, ent.RetentionBatch(2), ent.RetentionLimit(3), ent.RetentionProgressFunc(func(p ent.RetentionProgress) {
+		progress = append(progress, p)
+	}))
+	require.NoError(t, err)
+	require.Equal(t, ent.RetentionProgress{Type: ent.TypeSession, Strategy: "delete", Processed: 3, Done: true}, p)
+	require.Equal(t, []ent.RetentionProgress{
+		{Type: ent.TypeSession, Strategy: "delete", Processed: 2},
+		{Type: ent.TypeSession, Strategy: "delete", Processed: 3, Done: true},
+	}, progress)
+	require.Equal(t, 3, client.Session.Query().CountX(ctx))
+
+	t.Log("Failed archives roll back their batch")
+	fail := ent.RetentionArchiveFunc(func(context.Context, string, []byte) error {
+		return errors.New("unavailable")
+	})
+	_, err = client.ApplyRetention(ctx, ent.RetentionTypes(ent.TypeEvent), ent.RetentionArchive(fail))
+	require.Error(t, err)
+	require.Equal(t, 4, client.Event.Query().CountX(ctx))
+
+	t.Log("All policies are executed by the client")
+	archive := make(map[string][]byte)
+	ps, err := client.ApplyRetention(ctx, ent.RetentionBatch(2), ent.RetentionArchive(ent.RetentionArchiveFunc(func(_ context.Context, key string, data []byte) error {
+		archive[key] = data
+		return nil
+	})))
+	require.NoError(t, err)
+	require.Equal(t, []ent.RetentionProgress{
+		{Type: ent.TypeEvent, Strategy: "archive", Processed: 3, Done: true},
+		{Type: ent.TypeSession, Strategy: "delete", Processed: 2, Done: true},
+		{Type: ent.TypeUser, Strategy: "anonymize", Processed: 1, Done: true},
+	}, ps)
+	require.Equal(t, []string{"active"}, client.Session.Query().Select(session.FieldToken).StringsX(ctx))
+	require.Equal(t, []string{"active"}, client.Event.Query().Select(event.FieldKind).StringsX(ctx))
+	require.Len(t, archive, 2)
+	var archived []*ent.Event
+	for _, data := range archive {
+		var events []*ent.Event
+		require.NoError(t, json.Unmarshal(data, &events))
+		archived = append(archived, events...)
+	}
+	require.Len(t, archived, 3)
+	for _, e := range archived {
+		require.Equal(t, "expired", e.Kind)
+	}
+
+	t.Log("Anonymized entities are kept without their personal fields")
+	a8m = client.User.GetX(ctx, a8m.ID)
+	require.Equal(t, "a8m", a8m.Name)
+	require.Empty(t, a8m.Email)
+	require.Nil(t, a8m.Phone)
+	require.Equal(t, "nati@example.com", client.User.GetX(ctx, nati.ID).Email)
+
+	t.Log("Runs are idempotent")
+	ps, err = client.ApplyRetention(ctx, ent.RetentionTypes(ent.TypeUser, ent.TypeSession))
+	require.NoError(t, err)
+	require.Equal(t, []ent.RetentionProgress{
+		{Type: ent.TypeSession, Strategy: "delete", Done: true},
+		{Type: ent.TypeUser, Strategy: "anonymize", Done: true},
+	}, ps)
+
+	t.Log("The retention windows are measured to the time of the run")
+	p, err = client.User.ApplyRetention(ctx, ent.RetentionTime(now.Add(2*365*24*time.Hour)))
+	require.NoError(t, err)
+	require.Equal(t, 1, p.Processed)
+	require.Zero(t, client.User.Query().Where(user.EmailNotNil()).CountX(ctx))
+
+	t.Log("Transactional clients process all batches in their transaction")
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	p, err = tx.Session.ApplyRetention(ctx, ent.RetentionBatch(1), ent.RetentionTime(now.Add(48*time.Hour)))
+	require.NoError(t, err)
+	require.Equal(t, 1, p.Processed)
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 1, client.Session.Query().CountX(ctx))
+
+	t.Log("The retention windows are measured to the clock of the client by default")
+	p, err = client.WithOptions(ent.Clock(func() time.Time { return now })).Session.ApplyRetention(ctx)
+	require.NoError(t, err)
+	require.Zero(t, p.Processed)
+	tx, err = client.WithOptions(ent.Clock(func() time.Time { return now.Add(48 * time.Hour) })).Tx(ctx)
+	require.NoError(t, err)
+	p, err = tx.Session.ApplyRetention(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, p.Processed)
+	require.NoError(t, tx.Rollback())
+
+	t.Log("RunRetention runs until the context is done")
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	err = client.RunRetention(ctx, 10*time.Millisecond, ent.RetentionTypes(ent.TypeSession), ent.RetentionTime(now.Add(48*time.Hour)))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Zero(t, client.Session.Query().CountX(context.Background()))
+}
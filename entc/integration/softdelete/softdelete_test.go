@@ -0,0 +1,149 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package softdelete
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc/integration/softdelete/ent"
+	"entgo.io/ent/entc/integration/softdelete/ent/hook"
+	"entgo.io/ent/entc/integration/softdelete/ent/pet"
+	"entgo.io/ent/entc/integration/softdelete/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftDelete(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	var ops []ent.Op
+	client.Pet.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.PetFunc(func(ctx context.Context, m *ent.PetMutation) (ent.Value, error) {
+			ops = append(ops, m.Op())
+			return next.Mutate(ctx, m)
+		})
+	})
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetName("nati").AddFriends(a8m).SaveX(ctx)
+	pedro := client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	xabi := client.Pet.Create().SetName("xabi").SetOwner(a8m).SaveX(ctx)
+
+	t.Log("Delete marks the entities as deleted and hooks are executed with OpDelete")
+	require.Equal(t, 1, client.Pet.Delete().Where(pet.Name("pedro")).ExecX(ctx))
+	require.Equal(t, ent.OpDelete, ops[len(ops)-1])
+	require.Equal(t, 0, client.Pet.Delete().Where(pet.Name("pedro")).ExecX(ctx), "deleted entities are not deleted again")
+	require.True(t, ent.IsNotFound(client.Pet.DeleteOne(pedro).Exec(ctx)))
+	_, err = client.Pet.Get(ctx, pedro.ID)
+	require.True(t, ent.IsNotFound(err))
+	deleted := client.Pet.Query().Unscoped().Where(pet.ID(pedro.ID)).OnlyX(ctx)
+	require.NotNil(t, deleted.RemovedAt)
+
+	t.Log("Queries, aggregations, edge traversals and eager-loading are scoped")
+	require.Equal(t, []int{xabi.ID}, client.Pet.Query().IDsX(ctx))
+	require.Equal(t, 1, client.Pet.Query().CountX(ctx))
+	require.Equal(t, 2, client.Pet.Query().Unscoped().CountX(ctx))
+	require.Equal(t, []string{"xabi"}, client.Pet.Query().Select(pet.FieldName).StringsX(ctx))
+	require.Equal(t, []string{"xabi"}, client.Pet.Query().GroupBy(pet.FieldName).StringsX(ctx))
+	require.Equal(t, []int{xabi.ID}, a8m.QueryPets().IDsX(ctx))
+	require.Equal(t, []int{xabi.ID}, client.User.Query().Where(user.ID(a8m.ID)).QueryPets().IDsX(ctx))
+	require.Equal(t, 2, a8m.QueryPets().Unscoped().CountX(ctx))
+	u := client.User.Query().Where(user.ID(a8m.ID)).WithPets().OnlyX(ctx)
+	require.Len(t, u.Edges.Pets, 1)
+	u = client.User.Query().Where(user.ID(a8m.ID)).WithPets(func(q *ent.PetQuery) { q.Unscoped() }).OnlyX(ctx)
+	require.Len(t, u.Edges.Pets, 2)
+
+	t.Log("Edge predicates match only neighbors that were not deleted")
+	require.Equal(t, []int{a8m.ID}, client.User.Query().Where(user.HasPets()).IDsX(ctx))
+	require.Equal(t, []int{a8m.ID}, client.User.Query().Where(user.HasPetsWith(pet.NameIn("pedro", "xabi"))).IDsX(ctx))
+	require.False(t, client.User.Query().Where(user.HasPetsWith(pet.Name("pedro"))).ExistX(ctx))
+	require.True(t, client.User.Query().Unscoped().Where(user.HasPetsWith(pet.Name("pedro"))).ExistX(ctx))
+	client.Pet.DeleteOne(xabi).ExecX(ctx)
+	require.False(t, client.User.Query().Where(user.HasPets()).ExistX(ctx), "users whose pets were all deleted")
+	require.Equal(t, []int{a8m.ID}, client.User.Query().Unscoped().Where(user.HasPets()).IDsX(ctx))
+	client.Pet.UpdateOneID(xabi.ID).ClearRemovedAt().ExecX(ctx)
+
+	client.User.DeleteOne(a8m).ExecX(ctx)
+	require.Equal(t, []int{nati.ID}, client.User.Query().IDsX(ctx))
+	require.False(t, nati.QueryFriends().ExistX(ctx))
+	require.Zero(t, client.Pet.Query().QueryOwner().CountX(ctx))
+	require.Nil(t, client.Pet.Query().WithOwner().OnlyX(ctx).Edges.Owner)
+	require.Equal(t, a8m.ID, nati.QueryFriends().Unscoped().OnlyIDX(ctx))
+	require.Equal(t, nati.ID, client.User.Query().Unscoped().Where(user.ID(a8m.ID)).QueryFriends().OnlyIDX(ctx))
+
+	t.Log("Restore a deleted entity by clearing its field")
+	client.User.UpdateOneID(a8m.ID).ClearDeletedAt().ExecX(ctx)
+	require.Equal(t, 2, client.User.Query().CountX(ctx))
+	require.Equal(t, xabi.ID, client.Pet.Query().QueryOwner().QueryPets().OnlyIDX(ctx))
+
+	t.Log("Unscoped deletes the entities permanently")
+	require.Equal(t, 2, client.Pet.Delete().Unscoped().ExecX(ctx))
+	require.Zero(t, client.Pet.Query().Unscoped().CountX(ctx))
+	client.User.DeleteOne(nati).Unscoped().ExecX(ctx)
+	require.Equal(t, []int{a8m.ID}, client.User.Query().Unscoped().IDsX(ctx))
+}
+
+func TestSoftDeleteClock(t *testing.T) {
+	now := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	client, err := ent.Open("sqlite3", "file:clock?mode=memory&cache=shared&_fk=1", ent.Clock(func() time.Time { return now }))
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	client.User.DeleteOne(a8m).ExecX(ctx)
+	deleted := client.User.Query().Unscoped().OnlyX(ctx)
+	require.NotNil(t, deleted.DeletedAt)
+	require.True(t, now.Equal(*deleted.DeletedAt), "deletion time is taken from the client clock")
+}
+
+func TestQueryDefaults(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:defaults?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	client.Pet.CreateBulk(
+		client.Pet.Create().SetName("a").SetAge(1).SetOwner(a8m),
+		client.Pet.Create().SetName("b").SetAge(3).SetOwner(a8m),
+		client.Pet.Create().SetName("c").SetAge(3).SetOwner(a8m),
+		client.Pet.Create().SetName("d").SetAge(2).SetOwner(a8m),
+		client.Pet.Create().SetName("e").SetAge(5).SetHidden(true).SetOwner(a8m),
+	).ExecX(ctx)
+
+	t.Log("Queries are ordered, limited and filtered by default")
+	names := func(pets []*ent.Pet) (s []string) {
+		for _, p := range pets {
+			s = append(s, p.Name)
+		}
+		return s
+	}
+	require.Equal(t, []string{"b", "c", "d"}, names(client.Pet.Query().AllX(ctx)))
+	require.Equal(t, "b", client.Pet.Query().FirstX(ctx).Name)
+	require.Equal(t, 4, client.Pet.Query().CountX(ctx))
+	require.Equal(t, 4, a8m.QueryPets().CountX(ctx))
+
+	t.Log("Explicit order and limit override the defaults")
+	require.Equal(t, []string{"a", "b", "c", "d"}, names(client.Pet.Query().Order(ent.Asc(pet.FieldName)).Limit(10).AllX(ctx)))
+	require.Equal(t, []string{"b"}, names(client.Pet.Query().Limit(1).AllX(ctx)))
+
+	t.Log("Eager-loaded edges are filtered but not limited")
+	u := client.User.Query().WithPets().OnlyX(ctx)
+	require.Equal(t, []string{"b", "c", "d", "a"}, names(u.Edges.Pets))
+
+	t.Log("NoDefaults opts out of the defaults")
+	require.Len(t, client.Pet.Query().NoDefaults().AllX(ctx), 5)
+	require.Equal(t, 5, client.Pet.Query().NoDefaults().CountX(ctx))
+	require.Equal(t, 5, a8m.QueryPets().NoDefaults().CountX(ctx))
+}
@@ -44,6 +44,25 @@ func (gu *GroupUpdate) AddMaxUsers(i int) *GroupUpdate {
 	return gu
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (gu *GroupUpdate) SetMaxUsersIfGreater(i int) *GroupUpdate {
+	gu.mutation.SetMaxUsersIfGreater(i)
+	return gu
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field in the database.
+func (gu *GroupUpdate) BitAndMaxUsers(i int) *GroupUpdate {
+	gu.mutation.BitAndMaxUsers(i)
+	return gu
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field in the database.
+func (gu *GroupUpdate) BitOrMaxUsers(i int) *GroupUpdate {
+	gu.mutation.BitOrMaxUsers(i)
+	return gu
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gu *GroupUpdate) Mutation() *GroupMutation {
 	return gu.mutation
@@ -135,9 +154,30 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: group.FieldMaxUsers,
 		})
 	}
+	if value, ok := gu.mutation.MaxUsersIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
+	if value, ok := gu.mutation.MaxUsersBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
+	if value, ok := gu.mutation.MaxUsersBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{group.Label}
+			err = &NotFoundError{label: group.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -149,9 +189,18 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // GroupUpdateOne is the builder for updating a single Group entity.
 type GroupUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GroupMutation
+	fields     []string
+	conditions []predicate.Group
+	hooks      []Hook
+	mutation   *GroupMutation
+}
+
+// Where appends a list of conditions to the GroupUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
+	guo.conditions = append(guo.conditions, ps...)
+	return guo
 }
 
 // SetMaxUsers sets the "max_users" field.
@@ -167,6 +216,25 @@ func (guo *GroupUpdateOne) AddMaxUsers(i int) *GroupUpdateOne {
 	return guo
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (guo *GroupUpdateOne) SetMaxUsersIfGreater(i int) *GroupUpdateOne {
+	guo.mutation.SetMaxUsersIfGreater(i)
+	return guo
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field in the database.
+func (guo *GroupUpdateOne) BitAndMaxUsers(i int) *GroupUpdateOne {
+	guo.mutation.BitAndMaxUsers(i)
+	return guo
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field in the database.
+func (guo *GroupUpdateOne) BitOrMaxUsers(i int) *GroupUpdateOne {
+	guo.mutation.BitOrMaxUsers(i)
+	return guo
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (guo *GroupUpdateOne) Mutation() *GroupMutation {
 	return guo.mutation
@@ -274,6 +342,13 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 			}
 		}
 	}
+	if ps := guo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := guo.mutation.MaxUsers(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -288,12 +363,35 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 			Column: group.FieldMaxUsers,
 		})
 	}
+	if value, ok := guo.mutation.MaxUsersIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
+	if value, ok := guo.mutation.MaxUsersBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
+	if value, ok := guo.mutation.MaxUsersBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: group.FieldMaxUsers,
+		})
+	}
 	_node = &Group{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{group.Label}
+			err = &NotFoundError{label: group.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: group.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -19,6 +19,7 @@ import (
 	"entgo.io/ent/entc/integration/template/ent/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -43,6 +44,9 @@ type GroupMutation struct {
 	id            *int
 	max_users     *int
 	addmax_users  *int
+	maxmax_users  *int
+	andmax_users  *int
+	ormax_users   *int
 	clearedFields map[string]struct{}
 	done          bool
 	oldValue      func(context.Context) (*Group, error)
@@ -151,6 +155,9 @@ func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *GroupMutation) SetMaxUsers(i int) {
 	m.max_users = &i
 	m.addmax_users = nil
+	m.maxmax_users = nil
+	m.andmax_users = nil
+	m.ormax_users = nil
 }
 
 // MaxUsers returns the value of the "max_users" field in the mutation.
@@ -197,10 +204,65 @@ func (m *GroupMutation) AddedMaxUsers() (r int, exists bool) {
 	return *v, true
 }
 
+// SetMaxUsersIfGreater sets the "max_users" field to i in the database, only if it is greater than its stored value.
+func (m *GroupMutation) SetMaxUsersIfGreater(i int) {
+	if m.maxmax_users == nil || i > *m.maxmax_users {
+		m.maxmax_users = &i
+	}
+}
+
+// MaxUsersIfGreater returns the value that was set to the "max_users" field by SetMaxUsersIfGreater in this mutation.
+func (m *GroupMutation) MaxUsersIfGreater() (r int, exists bool) {
+	v := m.maxmax_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndMaxUsers applies a bitwise AND with i on the "max_users" field.
+func (m *GroupMutation) BitAndMaxUsers(i int) {
+	if m.andmax_users != nil {
+		*m.andmax_users &= i
+	} else {
+		m.andmax_users = &i
+	}
+}
+
+// MaxUsersBitAnd returns the value that was applied by BitAndMaxUsers on the "max_users" field in this mutation.
+func (m *GroupMutation) MaxUsersBitAnd() (r int, exists bool) {
+	v := m.andmax_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrMaxUsers applies a bitwise OR with i on the "max_users" field.
+func (m *GroupMutation) BitOrMaxUsers(i int) {
+	if m.ormax_users != nil {
+		*m.ormax_users |= i
+	} else {
+		m.ormax_users = &i
+	}
+}
+
+// MaxUsersBitOr returns the value that was applied by BitOrMaxUsers on the "max_users" field in this mutation.
+func (m *GroupMutation) MaxUsersBitOr() (r int, exists bool) {
+	v := m.ormax_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetMaxUsers resets all changes to the "max_users" field.
 func (m *GroupMutation) ResetMaxUsers() {
 	m.max_users = nil
 	m.addmax_users = nil
+	m.maxmax_users = nil
+	m.andmax_users = nil
+	m.ormax_users = nil
 }
 
 // Where appends a list predicates to the GroupMutation builder.
@@ -208,6 +270,16 @@ func (m *GroupMutation) Where(ps ...predicate.Group) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *GroupMutation) Op() Op {
 	return m.op
@@ -390,6 +462,9 @@ type PetMutation struct {
 	id            *int
 	age           *int
 	addage        *int
+	maxage        *int
+	andage        *int
+	orage         *int
 	licensed_at   *time.Time
 	clearedFields map[string]struct{}
 	owner         *int
@@ -501,6 +576,9 @@ func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *PetMutation) SetAge(i int) {
 	m.age = &i
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 }
 
 // Age returns the value of the "age" field in the mutation.
@@ -547,15 +625,71 @@ func (m *PetMutation) AddedAge() (r int, exists bool) {
 	return *v, true
 }
 
+// SetAgeIfGreater sets the "age" field to i in the database, only if it is greater than its stored value.
+func (m *PetMutation) SetAgeIfGreater(i int) {
+	if m.maxage == nil || i > *m.maxage {
+		m.maxage = &i
+	}
+}
+
+// AgeIfGreater returns the value that was set to the "age" field by SetAgeIfGreater in this mutation.
+func (m *PetMutation) AgeIfGreater() (r int, exists bool) {
+	v := m.maxage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field.
+func (m *PetMutation) BitAndAge(i int) {
+	if m.andage != nil {
+		*m.andage &= i
+	} else {
+		m.andage = &i
+	}
+}
+
+// AgeBitAnd returns the value that was applied by BitAndAge on the "age" field in this mutation.
+func (m *PetMutation) AgeBitAnd() (r int, exists bool) {
+	v := m.andage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field.
+func (m *PetMutation) BitOrAge(i int) {
+	if m.orage != nil {
+		*m.orage |= i
+	} else {
+		m.orage = &i
+	}
+}
+
+// AgeBitOr returns the value that was applied by BitOrAge on the "age" field in this mutation.
+func (m *PetMutation) AgeBitOr() (r int, exists bool) {
+	v := m.orage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetAge resets all changes to the "age" field.
 func (m *PetMutation) ResetAge() {
 	m.age = nil
 	m.addage = nil
+	m.maxage = nil
+	m.andage = nil
+	m.orage = nil
 }
 
 // SetLicensedAt sets the "licensed_at" field.
 func (m *PetMutation) SetLicensedAt(t time.Time) {
 	m.licensed_at = &t
+	delete(m.clearedFields, pet.FieldLicensedAt)
 }
 
 // LicensedAt returns the value of the "licensed_at" field in the mutation.
@@ -646,6 +780,16 @@ func (m *PetMutation) Where(ps ...predicate.Pet) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *PetMutation) Op() Op {
 	return m.op
@@ -1140,6 +1284,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -46,6 +46,25 @@ func (pu *PetUpdate) AddAge(i int) *PetUpdate {
 	return pu
 }
 
+// SetAgeIfGreater sets the "age" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (pu *PetUpdate) SetAgeIfGreater(i int) *PetUpdate {
+	pu.mutation.SetAgeIfGreater(i)
+	return pu
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field in the database.
+func (pu *PetUpdate) BitAndAge(i int) *PetUpdate {
+	pu.mutation.BitAndAge(i)
+	return pu
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field in the database.
+func (pu *PetUpdate) BitOrAge(i int) *PetUpdate {
+	pu.mutation.BitOrAge(i)
+	return pu
+}
+
 // SetLicensedAt sets the "licensed_at" field.
 func (pu *PetUpdate) SetLicensedAt(t time.Time) *PetUpdate {
 	pu.mutation.SetLicensedAt(t)
@@ -182,6 +201,27 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: pet.FieldAge,
 		})
 	}
+	if value, ok := pu.mutation.AgeIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldAge,
+		})
+	}
+	if value, ok := pu.mutation.AgeBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldAge,
+		})
+	}
+	if value, ok := pu.mutation.AgeBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldAge,
+		})
+	}
 	if value, ok := pu.mutation.LicensedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -232,7 +272,7 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{pet.Label}
+			err = &NotFoundError{label: pet.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -244,9 +284,18 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // PetUpdateOne is the builder for updating a single Pet entity.
 type PetUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PetMutation
+	fields     []string
+	conditions []predicate.Pet
+	hooks      []Hook
+	mutation   *PetMutation
+}
+
+// Where appends a list of conditions to the PetUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (puo *PetUpdateOne) Where(ps ...predicate.Pet) *PetUpdateOne {
+	puo.conditions = append(puo.conditions, ps...)
+	return puo
 }
 
 // SetAge sets the "age" field.
@@ -262,6 +311,25 @@ func (puo *PetUpdateOne) AddAge(i int) *PetUpdateOne {
 	return puo
 }
 
+// SetAgeIfGreater sets the "age" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (puo *PetUpdateOne) SetAgeIfGreater(i int) *PetUpdateOne {
+	puo.mutation.SetAgeIfGreater(i)
+	return puo
+}
+
+// BitAndAge applies a bitwise AND with i on the "age" field in the database.
+func (puo *PetUpdateOne) BitAndAge(i int) *PetUpdateOne {
+	puo.mutation.BitAndAge(i)
+	return puo
+}
+
+// BitOrAge applies a bitwise OR with i on the "age" field in the database.
+func (puo *PetUpdateOne) BitOrAge(i int) *PetUpdateOne {
+	puo.mutation.BitOrAge(i)
+	return puo
+}
+
 // SetLicensedAt sets the "licensed_at" field.
 func (puo *PetUpdateOne) SetLicensedAt(t time.Time) *PetUpdateOne {
 	puo.mutation.SetLicensedAt(t)
@@ -414,6 +482,13 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 			}
 		}
 	}
+	if ps := puo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := puo.mutation.Age(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -428,6 +503,27 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 			Column: pet.FieldAge,
 		})
 	}
+	if value, ok := puo.mutation.AgeIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldAge,
+		})
+	}
+	if value, ok := puo.mutation.AgeBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldAge,
+		})
+	}
+	if value, ok := puo.mutation.AgeBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pet.FieldAge,
+		})
+	}
 	if value, ok := puo.mutation.LicensedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -481,7 +577,9 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{pet.Label}
+			err = &NotFoundError{label: pet.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: pet.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
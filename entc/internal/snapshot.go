@@ -25,11 +25,7 @@ type Snapshot struct {
 // If there is a conflict between upstream and local snapshots, it is merged
 // before running the code generation.
 func (s *Snapshot) Restore() error {
-	buf, err := os.ReadFile(s.Path)
-	if err != nil {
-		return fmt.Errorf("unable to read snapshot schema %w", err)
-	}
-	snap, err := s.parseSnapshot(buf)
+	snap, err := s.Load()
 	if err != nil {
 		return err
 	}
@@ -43,6 +39,16 @@ func (s *Snapshot) Restore() error {
 	return graph.Gen()
 }
 
+// Load reads the schema snapshot from its path. If there is a conflict
+// between upstream and local snapshots, they are merged.
+func (s *Snapshot) Load() (*gen.Snapshot, error) {
+	buf, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read snapshot schema %w", err)
+	}
+	return s.parseSnapshot(buf)
+}
+
 // schemaIdent holds the schema identifier in snapshot file.
 const schemaIdent = "const Schema"
 
@@ -18,14 +18,16 @@ import (
 
 // Schema represents an ent.Schema that was loaded from a complied user package.
 type Schema struct {
-	Name        string                 `json:"name,omitempty"`
-	Config      ent.Config             `json:"config,omitempty"`
-	Edges       []*Edge                `json:"edges,omitempty"`
-	Fields      []*Field               `json:"fields,omitempty"`
-	Indexes     []*Index               `json:"indexes,omitempty"`
-	Hooks       []*Position            `json:"hooks,omitempty"`
-	Policy      []*Position            `json:"policy,omitempty"`
-	Annotations map[string]interface{} `json:"annotations,omitempty"`
+	Name         string                 `json:"name,omitempty"`
+	Config       ent.Config             `json:"config,omitempty"`
+	Edges        []*Edge                `json:"edges,omitempty"`
+	Fields       []*Field               `json:"fields,omitempty"`
+	Indexes      []*Index               `json:"indexes,omitempty"`
+	Hooks        []*Position            `json:"hooks,omitempty"`
+	Interceptors []*Position            `json:"interceptors,omitempty"`
+	Policy       []*Position            `json:"policy,omitempty"`
+	Annotations  map[string]interface{} `json:"annotations,omitempty"`
+	Comment      string                 `json:"comment,omitempty"`
 }
 
 // Position describes a position in the schema.
@@ -51,6 +53,7 @@ type Field struct {
 	UpdateDefault bool                    `json:"update_default,omitempty"`
 	Immutable     bool                    `json:"immutable,omitempty"`
 	Validators    int                     `json:"validators,omitempty"`
+	Codec         bool                    `json:"codec,omitempty"`
 	StorageKey    string                  `json:"storage_key,omitempty"`
 	Position      *Position               `json:"position,omitempty"`
 	Sensitive     bool                    `json:"sensitive,omitempty"`
@@ -74,6 +77,8 @@ type Edge struct {
 	StorageKey  *edge.StorageKey       `json:"storage_key,omitempty"`
 	Annotations map[string]interface{} `json:"annotations,omitempty"`
 	Comment     string                 `json:"comment,omitempty"`
+	External    *field.TypeInfo        `json:"external,omitempty"`
+	Types       []string               `json:"types,omitempty"`
 }
 
 // Index represents an ent.Index that was loaded from a complied user package.
@@ -99,6 +104,8 @@ func NewEdge(ed *edge.Descriptor) *Edge {
 		Through:     ed.Through,
 		StorageKey:  ed.StorageKey,
 		Comment:     ed.Comment,
+		External:    ed.External,
+		Types:       ed.Types,
 		Annotations: make(map[string]interface{}),
 	}
 	for _, at := range ed.Annotations {
@@ -106,7 +113,9 @@ func NewEdge(ed *edge.Descriptor) *Edge {
 	}
 	if ref := ed.Ref; ref != nil {
 		ne.Ref = NewEdge(ref)
-		ne.StorageKey = ne.Ref.StorageKey
+		if ne.StorageKey == nil {
+			ne.StorageKey = ne.Ref.StorageKey
+		}
 	}
 	return ne
 }
@@ -129,6 +138,7 @@ func NewField(fd *field.Descriptor) (*Field, error) {
 		Immutable:     fd.Immutable,
 		StorageKey:    fd.StorageKey,
 		Validators:    len(fd.Validators),
+		Codec:         fd.Codec != nil,
 		Sensitive:     fd.Sensitive,
 		SchemaType:    fd.SchemaType,
 		Annotations:   make(map[string]interface{}),
@@ -204,6 +214,9 @@ func MarshalSchema(schema ent.Interface) (b []byte, err error) {
 	if err := s.loadHooks(schema); err != nil {
 		return nil, fmt.Errorf("schema %q: %w", s.Name, err)
 	}
+	if err := s.loadInterceptors(schema); err != nil {
+		return nil, fmt.Errorf("schema %q: %w", s.Name, err)
+	}
 	if err := s.loadPolicy(schema); err != nil {
 		return nil, fmt.Errorf("schema %q: %w", s.Name, err)
 	}
@@ -273,6 +286,17 @@ func (s *Schema) loadMixin(schema ent.Interface) error {
 				MixinIndex: i,
 			})
 		}
+		inters, err := safeInterceptors(mx)
+		if err != nil {
+			return fmt.Errorf("mixin %q: %w", name, err)
+		}
+		for j := range inters {
+			s.Interceptors = append(s.Interceptors, &Position{
+				Index:      j,
+				MixedIn:    true,
+				MixinIndex: i,
+			})
+		}
 		policy, err := safePolicy(mx)
 		if err != nil {
 			return fmt.Errorf("mixin %q: %w", name, err)
@@ -321,6 +345,20 @@ func (s *Schema) loadHooks(schema ent.Interface) error {
 	return nil
 }
 
+func (s *Schema) loadInterceptors(schema ent.Interface) error {
+	inters, err := safeInterceptors(schema)
+	if err != nil {
+		return err
+	}
+	for i := range inters {
+		s.Interceptors = append(s.Interceptors, &Position{
+			Index:   i,
+			MixedIn: false,
+		})
+	}
+	return nil
+}
+
 func (s *Schema) loadPolicy(schema ent.Interface) error {
 	policy, err := safePolicy(schema)
 	if err != nil {
@@ -333,6 +371,9 @@ func (s *Schema) loadPolicy(schema ent.Interface) error {
 }
 
 func (s *Schema) addAnnotation(an schema.Annotation) {
+	if c, ok := an.(*schema.CommentAnnotation); ok {
+		s.Comment = c.Text
+	}
 	curr, ok := s.Annotations[an.Name()]
 	if !ok {
 		s.Annotations[an.Name()] = an
@@ -438,6 +479,17 @@ func safeHooks(schema interface{ Hooks() []ent.Hook }) (hooks []ent.Hook, err er
 	return schema.Hooks(), nil
 }
 
+// safeInterceptors wraps the schema.Interceptors method with recover to ensure no panics in marshaling.
+func safeInterceptors(schema interface{ Interceptors() []ent.Interceptor }) (inters []ent.Interceptor, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("schema.Interceptors panics: %v", v)
+			inters = nil
+		}
+	}()
+	return schema.Interceptors(), nil
+}
+
 // safePolicy wraps the schema.Policy method with recover to ensure no panics in marshaling.
 func safePolicy(schema interface{ Policy() ent.Policy }) (policy ent.Policy, err error) {
 	defer func() {
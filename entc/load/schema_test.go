@@ -82,6 +82,7 @@ func (User) Mixin() []ent.Mixin {
 func (User) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		OrderConfig{FieldName: "type annotations"},
+		schema.Comment("user comment"),
 	}
 }
 
@@ -158,7 +159,8 @@ func TestMarshalSchema(t *testing.T) {
 		schema, err := UnmarshalSchema(buf)
 		require.NoError(t, err)
 		require.Equal(t, "User", schema.Name)
-		require.Len(t, schema.Annotations, 2)
+		require.Equal(t, "user comment", schema.Comment)
+		require.Len(t, schema.Annotations, 3)
 		ant := schema.Annotations["order_config"].(map[string]interface{})
 		require.Equal(t, ant["FieldName"], "type annotations")
 
@@ -381,6 +383,12 @@ func (HooksMixin) Hooks() []ent.Hook {
 	}
 }
 
+func (HooksMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.InterceptFunc(func(ent.Querier) ent.Querier { return nil }),
+	}
+}
+
 type BoringPolicy struct{}
 
 func (BoringPolicy) EvalMutation(context.Context, ent.Mutation) error { return nil }
@@ -432,6 +440,12 @@ func (WithMixin) Hooks() []ent.Hook {
 	}
 }
 
+func (WithMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.TraverseFunc(func(context.Context, ent.Query) error { return nil }),
+	}
+}
+
 func (WithMixin) Policy() ent.Policy {
 	return BoringPolicy{}
 }
@@ -487,6 +501,16 @@ func TestMarshalMixin(t *testing.T) {
 		require.Equal(t, 0, schema.Hooks[2].MixinIndex)
 	})
 
+	t.Run("Interceptors", func(t *testing.T) {
+		require.Len(t, schema.Interceptors, 2)
+		require.True(t, schema.Interceptors[0].MixedIn)
+		require.Equal(t, 1, schema.Interceptors[0].MixinIndex)
+		require.Equal(t, 0, schema.Interceptors[0].Index)
+
+		require.False(t, schema.Interceptors[1].MixedIn)
+		require.Equal(t, 0, schema.Interceptors[1].Index)
+	})
+
 	t.Run("Edges", func(t *testing.T) {
 		require.Len(t, schema.Edges, 2)
 		require.Equal(t, "user", schema.Edges[0].Name)
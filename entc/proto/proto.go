@@ -0,0 +1,336 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package proto provides an entc.Extension that generates protocol buffers definitions from the schema
+// graph. It generates a message for each type, and a CRUD service definition for each type with an id.
+// The message fields are named after the schema fields, and the paths of the field masks that are used
+// by the services are the names of the schema fields. Therefore, they can be passed as-is to the Select
+// method of the queries, and the SetField method of the mutations. For example:
+//
+//	ex, err := proto.NewExtension(proto.WithPackage("entpb"))
+//	if err != nil {
+//		log.Fatalf("creating proto extension: %v", err)
+//	}
+//	err = entc.Generate("./schema", &gen.Config{}, entc.Extensions(ex))
+//	if err != nil {
+//		log.Fatalf("running ent codegen: %v", err)
+//	}
+//
+// The Go code of the messages and the services is generated from the definitions by protoc.
+package proto
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/entproto"
+	"entgo.io/ent/schema/field"
+)
+
+// Extension generates the protocol buffers definitions of the schema graph.
+type Extension struct {
+	entc.DefaultExtension
+	pkg, goPkg string
+}
+
+// ExtensionOption allows configuring the Extension.
+type ExtensionOption func(*Extension) error
+
+// WithPackage sets the name of the generated protobuf package. The definitions are written
+// to "<target>/proto/<name>/<name>.proto". The default name is "entpb".
+func WithPackage(name string) ExtensionOption {
+	return func(e *Extension) error {
+		if name == "" || strings.ContainsAny(name, `/\ `) {
+			return fmt.Errorf("proto: invalid package name %q", name)
+		}
+		e.pkg = name
+		return nil
+	}
+}
+
+// WithGoPackage sets the go_package option of the generated definitions.
+// The default is the import path of the directory of the definitions.
+func WithGoPackage(path string) ExtensionOption {
+	return func(e *Extension) error {
+		e.goPkg = path
+		return nil
+	}
+}
+
+// NewExtension creates a new proto extension with the given options.
+func NewExtension(opts ...ExtensionOption) (*Extension, error) {
+	e := &Extension{pkg: "entpb"}
+	for _, opt := range opts {
+		if err := opt(e); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// Hooks of the extension.
+func (e *Extension) Hooks() []gen.Hook {
+	return []gen.Hook{e.generate}
+}
+
+// generate writes the definitions after the code generation.
+func (e *Extension) generate(next gen.Generator) gen.Generator {
+	return gen.GenerateFunc(func(g *gen.Graph) error {
+		if err := next.Generate(g); err != nil {
+			return err
+		}
+		b, err := e.Generate(g)
+		if err != nil {
+			return err
+		}
+		dir := filepath.Join(g.Target, "proto", e.pkg)
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return fmt.Errorf("proto: create directory: %w", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, e.pkg+".proto"), b, 0644); err != nil {
+			return fmt.Errorf("proto: write definitions: %w", err)
+		}
+		return nil
+	})
+}
+
+// Generate returns the protocol buffers definitions of the given graph.
+func (e *Extension) Generate(g *gen.Graph) ([]byte, error) {
+	goPkg := e.goPkg
+	if goPkg == "" {
+		goPkg = path.Join(g.Package, "proto", e.pkg)
+	}
+	w := &writer{imports: make(map[string]bool)}
+	for _, n := range g.Nodes {
+		if annotation(n.Annotations).Skip {
+			continue
+		}
+		if err := w.message(n); err != nil {
+			return nil, err
+		}
+		if n.HasOneFieldID() && !n.IsView() {
+			w.service(n)
+		}
+	}
+	var b bytes.Buffer
+	b.WriteString("// Code generated by ent, DO NOT EDIT.\n\n")
+	b.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&b, "package %s;\n\n", e.pkg)
+	if len(w.imports) > 0 {
+		imports := make([]string, 0, len(w.imports))
+		for imp := range w.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		for _, imp := range imports {
+			fmt.Fprintf(&b, "import %q;\n", imp)
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "option go_package = %q;\n", goPkg)
+	b.Write(w.Bytes())
+	return b.Bytes(), nil
+}
+
+// writer writes the messages and services of the graph types.
+type writer struct {
+	bytes.Buffer
+	imports map[string]bool
+}
+
+// message writes the message of the given type.
+func (w *writer) message(n *gen.Type) error {
+	var (
+		fields  []string
+		numbers = make(map[int]string)
+		names   = make(map[string]bool)
+	)
+	add := func(name, typ string, num int, comment string) error {
+		if other, ok := numbers[num]; ok {
+			return fmt.Errorf("proto: %s.%s and %s.%s have the same field number %d", n.Name, other, n.Name, name, num)
+		}
+		numbers[num] = name
+		names[name] = true
+		for _, line := range strings.Split(comment, "\n") {
+			if line != "" {
+				fields = append(fields, "// "+line)
+			}
+		}
+		fields = append(fields, fmt.Sprintf("%s %s = %d;", typ, name, num))
+		return nil
+	}
+	if n.HasOneFieldID() {
+		typ, ok := w.scalar(n.ID.Type)
+		if !ok {
+			return fmt.Errorf("proto: unsupported id type %q of %s", n.ID.Type, n.Name)
+		}
+		if err := add(n.ID.Name, typ, 1, n.ID.Comment()); err != nil {
+			return err
+		}
+	}
+	for i, f := range n.Fields {
+		ant := annotation(f.Annotations)
+		if ant.Skip {
+			continue
+		}
+		typ, ok := w.scalar(f.Type)
+		if !ok {
+			fields = append(fields, fmt.Sprintf("// Field %q of type %s is not supported.", f.Name, f.Type))
+			continue
+		}
+		if f.Optional || f.Nillable {
+			typ = "optional " + typ
+		}
+		if err := add(f.Name, typ, number(ant, i+2), f.Comment()); err != nil {
+			return err
+		}
+	}
+	for i, e := range n.Edges {
+		ant := annotation(e.Annotations)
+		if ant.Skip || e.Field() != nil || !e.Type.HasOneFieldID() {
+			continue
+		}
+		typ, ok := w.scalar(e.Type.ID.Type)
+		if !ok {
+			continue
+		}
+		name := e.Name + "_id"
+		if !e.Unique {
+			name, typ = singular(e.Name)+"_ids", "repeated "+typ
+		}
+		if names[name] {
+			return fmt.Errorf("proto: field %s.%s of edge %q conflicts with an existing field", n.Name, name, e.Name)
+		}
+		if err := add(name, typ, number(ant, len(n.Fields)+i+2), e.Comment()); err != nil {
+			return err
+		}
+	}
+	w.WriteString("\n")
+	writeComment(w, n.Comment())
+	fmt.Fprintf(w, "message %s {\n", n.Name)
+	for _, f := range fields {
+		fmt.Fprintf(w, "  %s\n", f)
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+// service writes the CRUD service of the given type and its messages.
+func (w *writer) service(n *gen.Type) {
+	w.imports["google/protobuf/empty.proto"] = true
+	w.imports["google/protobuf/field_mask.proto"] = true
+	id, _ := w.scalar(n.ID.Type)
+	fmt.Fprintf(w, `
+// %[1]sService is the CRUD service of the %[1]s type.
+service %[1]sService {
+  rpc Create(Create%[1]sRequest) returns (%[1]s);
+  rpc Get(Get%[1]sRequest) returns (%[1]s);
+  rpc Update(Update%[1]sRequest) returns (%[1]s);
+  rpc Delete(Delete%[1]sRequest) returns (google.protobuf.Empty);
+  rpc List(List%[1]sRequest) returns (List%[1]sResponse);
+}
+
+message Create%[1]sRequest {
+  %[1]s %[3]s = 1;
+}
+
+message Get%[1]sRequest {
+  %[2]s id = 1;
+  // The fields to return. All fields are returned if the mask is empty.
+  google.protobuf.FieldMask read_mask = 2;
+}
+
+message Update%[1]sRequest {
+  %[1]s %[3]s = 1;
+  // The fields to update. All fields are updated if the mask is empty.
+  google.protobuf.FieldMask update_mask = 2;
+}
+
+message Delete%[1]sRequest {
+  %[2]s id = 1;
+}
+
+message List%[1]sRequest {
+  int32 limit = 1;
+  int32 offset = 2;
+  // The fields to return. All fields are returned if the mask is empty.
+  google.protobuf.FieldMask read_mask = 3;
+}
+
+message List%[1]sResponse {
+  repeated %[1]s %[4]s = 1;
+}
+`, n.Name, id, snake(n.Name), snake(plural(n.Name)))
+}
+
+// scalar returns the protobuf type of the given field type.
+func (w *writer) scalar(t *field.TypeInfo) (string, bool) {
+	switch t.Type {
+	case field.TypeBool:
+		return "bool", true
+	case field.TypeInt8, field.TypeInt16, field.TypeInt32:
+		return "int32", true
+	case field.TypeInt, field.TypeInt64:
+		return "int64", true
+	case field.TypeUint8, field.TypeUint16, field.TypeUint32:
+		return "uint32", true
+	case field.TypeUint, field.TypeUint64:
+		return "uint64", true
+	case field.TypeFloat32:
+		return "float", true
+	case field.TypeFloat64:
+		return "double", true
+	case field.TypeString, field.TypeEnum, field.TypeUUID:
+		return "string", true
+	case field.TypeBytes:
+		return "bytes", true
+	case field.TypeTime:
+		w.imports["google/protobuf/timestamp.proto"] = true
+		return "google.protobuf.Timestamp", true
+	default:
+		return "", false
+	}
+}
+
+// number returns the field number that was set by the annotation, or the default one.
+func number(ant *entproto.Annotation, def int) int {
+	if ant.Field != 0 {
+		return ant.Field
+	}
+	return def
+}
+
+// annotation decodes the entproto annotation from the given annotations.
+func annotation(ants gen.Annotations) *entproto.Annotation {
+	ant := &entproto.Annotation{}
+	if v, ok := ants[ant.Name()]; ok {
+		if buf, err := json.Marshal(v); err == nil {
+			_ = json.Unmarshal(buf, ant)
+		}
+	}
+	return ant
+}
+
+// writeComment writes the given comment as protobuf comment lines.
+func writeComment(w *writer, comment string) {
+	for _, line := range strings.Split(comment, "\n") {
+		if line != "" {
+			fmt.Fprintf(w, "// %s\n", line)
+		}
+	}
+}
+
+var (
+	snake    = gen.Funcs["snake"].(func(string) string)
+	plural   = gen.Funcs["plural"].(func(string) string)
+	singular = gen.Funcs["singular"].(func(string) string)
+)
@@ -0,0 +1,168 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package proto
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/entproto"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func storage(t *testing.T) *gen.Storage {
+	s, err := gen.NewStorage("sql")
+	require.NoError(t, err)
+	return s
+}
+
+func annotations(ant *entproto.Annotation) map[string]interface{} {
+	return map[string]interface{}{ant.Name(): ant}
+}
+
+func TestExtension_Generate(t *testing.T) {
+	graph, err := gen.NewGraph(&gen.Config{Package: "example.com/app/ent", Storage: storage(t)},
+		&load.Schema{
+			Name: "User",
+			Fields: []*load.Field{
+				// Custom id with a storage key.
+				{Name: "id", Info: &field.TypeInfo{Type: field.TypeInt}, StorageKey: "oid"},
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Comment: "Name of the user."},
+				{Name: "nickname", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true, Annotations: annotations(entproto.Field(10))},
+				{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}},
+				{Name: "labels", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]string"}},
+				{Name: "password", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: annotations(entproto.Skip())},
+			},
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet"},
+			},
+		},
+		&load.Schema{
+			Name: "Pet",
+			Fields: []*load.Field{
+				{Name: "id", Info: &field.TypeInfo{Type: field.TypeUUID}},
+				{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true},
+			},
+			Edges: []*load.Edge{
+				{Name: "owner", Type: "User", RefName: "pets", Field: "owner_id", Unique: true, Inverse: true},
+			},
+		},
+		&load.Schema{
+			Name:        "Secret",
+			Annotations: annotations(entproto.Skip()),
+		},
+	)
+	require.NoError(t, err)
+	ex, err := NewExtension()
+	require.NoError(t, err)
+	b, err := ex.Generate(graph)
+	require.NoError(t, err)
+	require.Equal(t, `// Code generated by ent, DO NOT EDIT.
+
+syntax = "proto3";
+
+package entpb;
+
+import "google/protobuf/empty.proto";
+import "google/protobuf/field_mask.proto";
+import "google/protobuf/timestamp.proto";
+
+option go_package = "example.com/app/ent/proto/entpb";
+
+message User {
+  int64 id = 1;
+  // Name of the user.
+  string name = 2;
+  optional string nickname = 10;
+  google.protobuf.Timestamp created_at = 4;
+  // Field "labels" of type []string is not supported.
+  repeated string pet_ids = 7;
+}
+`, string(b[:bytes.Index(b, []byte("\n}\n"))+3]))
+	require.Contains(t, string(b), `
+// UserService is the CRUD service of the User type.
+service UserService {
+  rpc Create(CreateUserRequest) returns (User);
+  rpc Get(GetUserRequest) returns (User);
+  rpc Update(UpdateUserRequest) returns (User);
+  rpc Delete(DeleteUserRequest) returns (google.protobuf.Empty);
+  rpc List(ListUserRequest) returns (ListUserResponse);
+}
+`)
+	require.Contains(t, string(b), `
+message GetUserRequest {
+  int64 id = 1;
+  // The fields to return. All fields are returned if the mask is empty.
+  google.protobuf.FieldMask read_mask = 2;
+}
+`)
+	require.Contains(t, string(b), `
+message ListUserResponse {
+  repeated User users = 1;
+}
+`)
+	// The edge-field is mirrored once.
+	require.Contains(t, string(b), `
+message Pet {
+  string id = 1;
+  optional int64 owner_id = 2;
+}
+`)
+	require.Contains(t, string(b), "message DeletePetRequest {\n  string id = 1;\n}\n")
+	require.NotContains(t, string(b), "Secret")
+	require.NotContains(t, string(b), "password")
+
+	ex, err = NewExtension(WithPackage("userpb"), WithGoPackage("example.com/app/userpb"))
+	require.NoError(t, err)
+	b, err = ex.Generate(graph)
+	require.NoError(t, err)
+	require.Contains(t, string(b), "package userpb;\n")
+	require.Contains(t, string(b), `option go_package = "example.com/app/userpb";`)
+	_, err = NewExtension(WithPackage("user/pb"))
+	require.EqualError(t, err, `proto: invalid package name "user/pb"`)
+}
+
+func TestExtension_DuplicateNumbers(t *testing.T) {
+	graph, err := gen.NewGraph(&gen.Config{Package: "example.com/app/ent", Storage: storage(t)},
+		&load.Schema{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+				{Name: "nickname", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: annotations(entproto.Field(2))},
+			},
+		},
+	)
+	require.NoError(t, err)
+	ex, err := NewExtension()
+	require.NoError(t, err)
+	_, err = ex.Generate(graph)
+	require.EqualError(t, err, "proto: User.name and User.nickname have the same field number 2")
+}
+
+func TestExtension_Hook(t *testing.T) {
+	target := t.TempDir()
+	graph, err := gen.NewGraph(&gen.Config{Package: "example.com/app/ent", Target: target, Storage: storage(t)},
+		&load.Schema{Name: "User"},
+	)
+	require.NoError(t, err)
+	ex, err := NewExtension()
+	require.NoError(t, err)
+	var called bool
+	next := gen.GenerateFunc(func(*gen.Graph) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, ex.Hooks()[0](next).Generate(graph))
+	require.True(t, called)
+	b, err := os.ReadFile(filepath.Join(target, "proto", "entpb", "entpb.proto"))
+	require.NoError(t, err)
+	require.Contains(t, string(b), "message User {\n  int64 id = 1;\n}\n")
+}
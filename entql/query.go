@@ -0,0 +1,388 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type (
+	// A Query is a portable representation of a query, that holds its predicate, order and
+	// pagination. Queries can be encoded to JSON, passed between services (or stored as saved
+	// filters), and decoded back using ParseQuery that validates them against a whitelist.
+	//
+	//	q := &entql.Query{
+	//		Where: entql.And(entql.FieldGT("age", 30), entql.HasEdgeWith("pets", entql.FieldEQ("name", "pedro"))),
+	//		Order: []*entql.OrderField{{Field: "name"}},
+	//	}
+	//	buf, err := json.Marshal(q)
+	//
+	Query struct {
+		// Where holds the predicate of the query.
+		Where P
+		// Order holds the fields to order the results by.
+		Order []*OrderField
+		// Limit and Offset hold the pagination of the query.
+		Limit, Offset *int
+	}
+
+	// An OrderField describes a field in the order of a query.
+	OrderField struct {
+		Field string `json:"field"`
+		Desc  bool   `json:"desc,omitempty"`
+	}
+
+	// A ParseOption configures the validation of ParseQuery.
+	ParseOption func(*parser)
+
+	// parser holds the whitelist of the query parser.
+	parser struct {
+		fields, edges map[string]bool
+		maxLimit      int
+	}
+
+	// queryJSON is the JSON representation of a query.
+	queryJSON struct {
+		Where  *exprJSON     `json:"where,omitempty"`
+		Order  []*OrderField `json:"order,omitempty"`
+		Limit  *int          `json:"limit,omitempty"`
+		Offset *int          `json:"offset,omitempty"`
+	}
+
+	// exprJSON is the JSON representation of an expression. Fields, edges and values
+	// are leaf expressions, and the rest are operators or function calls on their args.
+	exprJSON struct {
+		Op    string          `json:"op,omitempty"`
+		Func  Func            `json:"func,omitempty"`
+		Field string          `json:"field,omitempty"`
+		Edge  string          `json:"edge,omitempty"`
+		Value json.RawMessage `json:"value,omitempty"`
+		Args  []*exprJSON     `json:"args,omitempty"`
+	}
+)
+
+// AllowFields allows the given fields to be used in the predicates and the order of the
+// parsed query. Fields of neighbors are prefixed with the path of their edges, separated
+// by dots. For example, "pets.name" allows the "name" field in the predicates of the
+// "pets" edge. Note that only the fields of the queried type can be used for ordering.
+func AllowFields(names ...string) ParseOption {
+	return func(p *parser) {
+		for _, n := range names {
+			p.fields[n] = true
+		}
+	}
+}
+
+// AllowEdges allows the given edges to be used in the predicates of the parsed query.
+// Edges of neighbors are prefixed with the path of their edges, separated by dots.
+func AllowEdges(names ...string) ParseOption {
+	return func(p *parser) {
+		for _, n := range names {
+			p.edges[n] = true
+		}
+	}
+}
+
+// MaxLimit limits the maximum number of results that can be requested by the parsed query.
+// Queries without a limit are given this limit.
+func MaxLimit(n int) ParseOption {
+	return func(p *parser) {
+		p.maxLimit = n
+	}
+}
+
+// ParseQuery decodes the JSON-encoded query and validates it against the whitelist
+// of the given options. Fields and edges that were not allowed explicitly using the
+// AllowFields and AllowEdges options are rejected.
+func ParseQuery(data []byte, opts ...ParseOption) (*Query, error) {
+	p := &parser{fields: make(map[string]bool), edges: make(map[string]bool)}
+	for _, opt := range opts {
+		opt(p)
+	}
+	q := &Query{}
+	if err := json.Unmarshal(data, q); err != nil {
+		return nil, err
+	}
+	if err := p.validate(q); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// validate checks that the query uses only the allowed fields and edges.
+func (p *parser) validate(q *Query) error {
+	if q.Where != nil {
+		if err := p.expr("", q.Where); err != nil {
+			return err
+		}
+	}
+	for _, o := range q.Order {
+		if strings.Contains(o.Field, ".") || !p.fields[o.Field] {
+			return fmt.Errorf("entql: field %q is not allowed for ordering", o.Field)
+		}
+	}
+	switch {
+	case q.Limit != nil && *q.Limit < 0, q.Offset != nil && *q.Offset < 0:
+		return errors.New("entql: limit and offset must not be negative")
+	case p.maxLimit > 0 && q.Limit == nil:
+		limit := p.maxLimit
+		q.Limit = &limit
+	case p.maxLimit > 0 && *q.Limit > p.maxLimit:
+		return fmt.Errorf("entql: limit %d exceeds the maximum limit %d", *q.Limit, p.maxLimit)
+	}
+	return nil
+}
+
+// expr validates the given expression, where path holds the edges that lead to it.
+func (p *parser) expr(path string, x Expr) error {
+	switch x := x.(type) {
+	case *Field:
+		if !p.fields[path+x.Name] {
+			return fmt.Errorf("entql: field %q is not allowed", path+x.Name)
+		}
+	case *Edge:
+		if !p.edges[path+x.Name] {
+			return fmt.Errorf("entql: edge %q is not allowed", path+x.Name)
+		}
+	case *Value:
+	case *UnaryExpr:
+		return p.expr(path, x.X)
+	case *BinaryExpr:
+		if _, ok := x.X.(*Field); !ok && x.Op != OpAnd && x.Op != OpOr {
+			return fmt.Errorf("entql: expect field on the left side of %q, got %s", x.Op, x.X)
+		}
+		if err := p.expr(path, x.X); err != nil {
+			return err
+		}
+		return p.expr(path, x.Y)
+	case *NaryExpr:
+		for _, x := range x.Xs {
+			if err := p.expr(path, x); err != nil {
+				return err
+			}
+		}
+	case *CallExpr:
+		if len(x.Args) == 0 {
+			return fmt.Errorf("entql: missing arguments for %s", x.Func)
+		}
+		if x.Func != FuncHasEdge {
+			for _, arg := range x.Args {
+				if err := p.expr(path, arg); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		e, ok := x.Args[0].(*Edge)
+		if !ok {
+			return fmt.Errorf("entql: expect edge as the first argument of %s, got %s", x.Func, x.Args[0])
+		}
+		if err := p.expr(path, e); err != nil {
+			return err
+		}
+		for _, arg := range x.Args[1:] {
+			if err := p.expr(path+e.Name+".", arg); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("entql: unexpected expression %T", x)
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *Query) MarshalJSON() ([]byte, error) {
+	v := &queryJSON{Order: q.Order, Limit: q.Limit, Offset: q.Offset}
+	if q.Where != nil {
+		w, err := encodeExpr(q.Where)
+		if err != nil {
+			return nil, err
+		}
+		v.Where = w
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Note that it does not
+// validate the query, and ParseQuery should be used for queries that were received
+// from untrusted sources.
+func (q *Query) UnmarshalJSON(data []byte) error {
+	var v queryJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*q = Query{Order: v.Order, Limit: v.Limit, Offset: v.Offset}
+	if v.Where != nil {
+		x, err := decodeExpr(v.Where)
+		if err != nil {
+			return err
+		}
+		p, ok := x.(P)
+		if !ok {
+			return fmt.Errorf("entql: expect predicate in query, got %s", x)
+		}
+		q.Where = p
+	}
+	return nil
+}
+
+// encodeExpr returns the JSON representation of the given expression.
+func encodeExpr(x Expr) (*exprJSON, error) {
+	switch x := x.(type) {
+	case *Field:
+		return &exprJSON{Field: x.Name}, nil
+	case *Edge:
+		return &exprJSON{Edge: x.Name}, nil
+	case *Value:
+		if x == nil {
+			return &exprJSON{Value: json.RawMessage("null")}, nil
+		}
+		buf, err := json.Marshal(x.V)
+		if err != nil {
+			return nil, fmt.Errorf("entql: encoding value: %w", err)
+		}
+		return &exprJSON{Value: buf}, nil
+	case *UnaryExpr:
+		return encodeOp(x.Op.String(), x.X)
+	case *BinaryExpr:
+		return encodeOp(x.Op.String(), x.X, x.Y)
+	case *NaryExpr:
+		return encodeOp(x.Op.String(), x.Xs...)
+	case *CallExpr:
+		v, err := encodeOp("", x.Args...)
+		if err != nil {
+			return nil, err
+		}
+		v.Func = x.Func
+		return v, nil
+	default:
+		return nil, fmt.Errorf("entql: unsupported expression for encoding %T", x)
+	}
+}
+
+// encodeOp returns the JSON representation of an operator on the given args.
+func encodeOp(op string, args ...Expr) (*exprJSON, error) {
+	v := &exprJSON{Op: op, Args: make([]*exprJSON, len(args))}
+	for i, arg := range args {
+		a, err := encodeExpr(arg)
+		if err != nil {
+			return nil, err
+		}
+		v.Args[i] = a
+	}
+	return v, nil
+}
+
+// decodeExpr returns the expression of the given JSON representation.
+func decodeExpr(v *exprJSON) (Expr, error) {
+	switch {
+	case v == nil:
+		return nil, errors.New("entql: missing expression")
+	case v.Field != "":
+		return &Field{Name: v.Field}, nil
+	case v.Edge != "":
+		return &Edge{Name: v.Edge}, nil
+	case v.Value != nil:
+		return decodeValue(v.Value)
+	}
+	args := make([]Expr, len(v.Args))
+	for i, a := range v.Args {
+		x, err := decodeExpr(a)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = x
+	}
+	if v.Func != "" {
+		switch v.Func {
+		case FuncEqualFold, FuncContains, FuncContainsFold, FuncHasPrefix, FuncHasSuffix, FuncHasEdge:
+			return &CallExpr{Func: v.Func, Args: args}, nil
+		default:
+			return nil, fmt.Errorf("entql: unknown function %q", v.Func)
+		}
+	}
+	op, ok := parseOp(v.Op)
+	if !ok {
+		return nil, fmt.Errorf("entql: unknown operator %q", v.Op)
+	}
+	switch {
+	case op == OpNot && len(args) == 1:
+		p, ok := args[0].(P)
+		if !ok {
+			return nil, fmt.Errorf("entql: expect predicate in %q, got %s", op, args[0])
+		}
+		return Not(p), nil
+	case (op == OpAnd || op == OpOr) && len(args) > 1:
+		for _, x := range args {
+			if _, ok := x.(P); !ok {
+				return nil, fmt.Errorf("entql: expect predicate in %q, got %s", op, x)
+			}
+		}
+		if len(args) == 2 {
+			return &BinaryExpr{Op: op, X: args[0], Y: args[1]}, nil
+		}
+		return &NaryExpr{Op: op, Xs: args}, nil
+	case op != OpNot && op != OpAnd && op != OpOr && len(args) == 2:
+		return &BinaryExpr{Op: op, X: args[0], Y: args[1]}, nil
+	default:
+		return nil, fmt.Errorf("entql: invalid number of arguments for %q: %d", op, len(args))
+	}
+}
+
+// decodeValue decodes the JSON-encoded value. Numbers are decoded as int64,
+// unless they have a fractional part or an exponent, and decoded as float64.
+func decodeValue(data json.RawMessage) (*Value, error) {
+	if bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("entql: decoding value: %w", err)
+	}
+	v, err := number(v)
+	if err != nil {
+		return nil, err
+	}
+	return &Value{V: v}, nil
+}
+
+// number converts the json.Number values to int64 or float64.
+func number(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case json.Number:
+		if !strings.ContainsAny(v.String(), ".eE") {
+			return v.Int64()
+		}
+		return v.Float64()
+	case []interface{}:
+		for i := range v {
+			n, err := number(v[i])
+			if err != nil {
+				return nil, err
+			}
+			v[i] = n
+		}
+	case map[string]interface{}:
+		return nil, errors.New("entql: object values are not supported")
+	}
+	return v, nil
+}
+
+// parseOp returns the operator of the given text representation.
+func parseOp(s string) (Op, bool) {
+	for op, name := range ops {
+		if name == s {
+			return Op(op), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,80 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entql_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"entgo.io/ent/entql"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryJSON(t *testing.T) {
+	limit := 10
+	q := &entql.Query{
+		Where: entql.And(
+			entql.FieldGT("age", 30),
+			entql.Or(
+				entql.FieldNil("nickname"),
+				entql.Not(entql.FieldIn("name", "a8m", "nati")),
+				entql.FieldHasPrefix("name", "m"),
+			),
+			entql.HasEdgeWith("pets", entql.FieldLTE("weight", 2.5)),
+		),
+		Order: []*entql.OrderField{{Field: "name"}, {Field: "age", Desc: true}},
+		Limit: &limit,
+	}
+	buf, err := json.Marshal(q)
+	require.NoError(t, err)
+	parsed := &entql.Query{}
+	require.NoError(t, json.Unmarshal(buf, parsed))
+	require.Equal(t, q.Where.String(), parsed.Where.String())
+	require.Equal(t, q.Order, parsed.Order)
+	require.Equal(t, 10, *parsed.Limit)
+	require.Nil(t, parsed.Offset)
+	// Integers are decoded as int64, and the rest of the numbers as float64.
+	require.Equal(t, entql.FieldGT("age", int64(30)), parsed.Where.(*entql.NaryExpr).Xs[0])
+	require.Equal(t, entql.HasEdgeWith("pets", entql.FieldLTE("weight", 2.5)), parsed.Where.(*entql.NaryExpr).Xs[2])
+
+	q = &entql.Query{Where: entql.HasEdgeWith("pets", entql.FieldEQ("name", func() {}))}
+	_, err = json.Marshal(q)
+	require.Error(t, err)
+	for _, s := range []string{
+		`{"where":{"op":"==","args":[{"field":"name"}]}}`,
+		`{"where":{"op":"~","args":[{"field":"name"},{"value":1}]}}`,
+		`{"where":{"func":"sql_selector","args":[{"value":1}]}}`,
+		`{"where":{"field":"name"}}`,
+		`{"where":{"op":"==","args":[{"field":"name"},{"value":{"a":1}}]}}`,
+	} {
+		require.Error(t, json.Unmarshal([]byte(s), &entql.Query{}), s)
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	opts := []entql.ParseOption{
+		entql.AllowFields("name", "age", "pets.name"),
+		entql.AllowEdges("pets"),
+		entql.MaxLimit(100),
+	}
+	q, err := entql.ParseQuery([]byte(`{"where":{"op":"&&","args":[{"op":">","args":[{"field":"age"},{"value":30}]},{"func":"has_edge","args":[{"edge":"pets"},{"op":"==","args":[{"field":"name"},{"value":"pedro"}]}]}]},"order":[{"field":"name","desc":true}]}`), opts...)
+	require.NoError(t, err)
+	require.Equal(t, `age > 30 && has_edge(pets, name == "pedro")`, q.Where.String())
+	require.Equal(t, 100, *q.Limit, "max limit is used by default")
+
+	for s, msg := range map[string]string{
+		`{"where":{"op":"==","args":[{"field":"password"},{"value":"secret"}]}}`:                                  `entql: field "password" is not allowed`,
+		`{"where":{"func":"has_edge","args":[{"edge":"friends"}]}}`:                                               `entql: edge "friends" is not allowed`,
+		`{"where":{"func":"has_edge","args":[{"edge":"pets"},{"op":"==","args":[{"field":"age"},{"value":1}]}]}}`: `entql: field "pets.age" is not allowed`,
+		`{"where":{"op":"==","args":[{"value":1},{"field":"name"}]}}`:                                             `entql: expect field on the left side of "==", got 1`,
+		`{"order":[{"field":"pets.name"}]}`:                                                                       `entql: field "pets.name" is not allowed for ordering`,
+		`{"limit":1000}`:                                                                                          `entql: limit 1000 exceeds the maximum limit 100`,
+		`{"offset":-1}`:                                                                                           `entql: limit and offset must not be negative`,
+	} {
+		_, err := entql.ParseQuery([]byte(s), opts...)
+		require.EqualError(t, err, msg, s)
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package containers provides a harness for running integration tests against a matrix of database
+// servers (MySQL, MariaDB, PostgreSQL and SQLite). Servers are started lazily in docker containers
+// (using the docker CLI) and are shared by all tests of the package, and each test gets its own
+// database, that is dropped when the test completes. For example:
+//
+//	var dbs = []*containers.Database{
+//		containers.SQLite(),
+//		containers.MySQL("8"),
+//		containers.Postgres("14"),
+//	}
+//
+//	func TestMain(m *testing.M) {
+//		code := m.Run()
+//		containers.TerminateAll()
+//		os.Exit(code)
+//	}
+//
+//	func TestUser(t *testing.T) {
+//		containers.Run(t, dbs, func(t *testing.T, db *containers.DB) {
+//			client := enttest.Open(t, db.Dialect, db.DSN)
+//			defer client.Close()
+//			// ...
+//		})
+//	}
+//
+// Instead of starting a container, an existing server can be used by setting its address in
+// the environment variable ENTTEST_<NAME>_ADDR. For example, ENTTEST_MYSQL_8_ADDR=localhost:3306.
+package containers
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+)
+
+// StartTimeout is the time to wait for a container to be ready.
+var StartTimeout = 2 * time.Minute
+
+// Database describes a database server in the test matrix.
+type Database struct {
+	// Name of the database. Used for naming subtests, and
+	// the environment variable of the server address.
+	Name string
+	// Dialect of the database.
+	Dialect string
+	// Image is the docker image of the server.
+	Image string
+
+	env   []string
+	port  string
+	admin func(addr string) string
+	dsn   func(addr, name string) string
+
+	once sync.Once
+	id   string
+	db   *sql.DB
+	addr string
+	err  error
+}
+
+// MySQL returns a MySQL server with the given version (e.g. "8").
+func MySQL(version string) *Database {
+	return mysql("mysql-"+version, "mysql:"+version)
+}
+
+// MariaDB returns a MariaDB server with the given version (e.g. "10.5").
+func MariaDB(version string) *Database {
+	return mysql("mariadb-"+version, "mariadb:"+version)
+}
+
+func mysql(name, image string) *Database {
+	return &Database{
+		Name:    name,
+		Dialect: dialect.MySQL,
+		Image:   image,
+		env:     []string{"MYSQL_ROOT_PASSWORD=pass"},
+		port:    "3306/tcp",
+		admin: func(addr string) string {
+			return fmt.Sprintf("root:pass@tcp(%s)/", addr)
+		},
+		dsn: func(addr, name string) string {
+			return fmt.Sprintf("root:pass@tcp(%s)/%s?parseTime=True", addr, name)
+		},
+	}
+}
+
+// Postgres returns a PostgreSQL server with the given version (e.g. "14").
+func Postgres(version string) *Database {
+	dsn := func(addr, name string) string {
+		host, port := addr, "5432"
+		if i := strings.LastIndexByte(addr, ':'); i > 0 {
+			host, port = addr[:i], addr[i+1:]
+		}
+		return fmt.Sprintf("host=%s port=%s user=postgres dbname=%s password=pass sslmode=disable", host, port, name)
+	}
+	return &Database{
+		Name:    "postgres-" + version,
+		Dialect: dialect.Postgres,
+		Image:   "postgres:" + version,
+		env:     []string{"POSTGRES_PASSWORD=pass"},
+		port:    "5432/tcp",
+		admin: func(addr string) string {
+			return dsn(addr, "postgres")
+		},
+		dsn: dsn,
+	}
+}
+
+// SQLite returns an in-memory SQLite database. It does not require a container,
+// and the github.com/mattn/go-sqlite3 driver should be imported by the tests.
+func SQLite() *Database {
+	return &Database{
+		Name:    "sqlite",
+		Dialect: dialect.SQLite,
+		dsn: func(_, name string) string {
+			return fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", name)
+		},
+	}
+}
+
+// DB is a database that was created for a single test.
+type DB struct {
+	// Name of the database.
+	Name string
+	// Dialect and DSN of the database.
+	Dialect, DSN string
+}
+
+// Run runs fn as a parallel subtest of t for each of the given databases,
+// with a new database that is dropped when the subtest completes.
+func Run(t *testing.T, dbs []*Database, fn func(*testing.T, *DB)) {
+	for _, d := range dbs {
+		d := d
+		t.Run(d.Name, func(t *testing.T) {
+			t.Parallel()
+			fn(t, d.New(t))
+		})
+	}
+}
+
+// New creates a new database for the given test, starting the server of the
+// database if needed, and drops the database when the test completes.
+func (d *Database) New(t testing.TB) *DB {
+	t.Helper()
+	name := dbname(t.Name())
+	if d.Dialect == dialect.SQLite {
+		return &DB{Name: name, Dialect: d.Dialect, DSN: d.dsn("", name)}
+	}
+	if err := d.start(); err != nil {
+		t.Fatalf("containers: starting %s: %v", d.Name, err)
+	}
+	ctx := context.Background()
+	if _, err := d.db.ExecContext(ctx, "CREATE DATABASE "+d.quote(name)); err != nil {
+		t.Fatalf("containers: creating database %s: %v", name, err)
+	}
+	t.Cleanup(func() {
+		if _, err := d.db.ExecContext(ctx, "DROP DATABASE IF EXISTS "+d.quote(name)); err != nil {
+			t.Errorf("containers: dropping database %s: %v", name, err)
+		}
+	})
+	return &DB{Name: name, Dialect: d.Dialect, DSN: d.dsn(d.addr, name)}
+}
+
+// Terminate closes the connection to the server, and removes its container.
+func (d *Database) Terminate() error {
+	if d.db != nil {
+		d.db.Close()
+	}
+	if d.id == "" {
+		return nil
+	}
+	id := d.id
+	d.id = ""
+	if out, err := exec.Command("docker", "rm", "-f", "-v", id).CombinedOutput(); err != nil {
+		return fmt.Errorf("containers: removing container %s: %w: %s", id, err, out)
+	}
+	return nil
+}
+
+var (
+	mu      sync.Mutex
+	started []*Database
+)
+
+// TerminateAll terminates all servers that were started by the package.
+// It is usually called by TestMain, after all tests were executed.
+func TerminateAll() error {
+	mu.Lock()
+	defer mu.Unlock()
+	var errs []string
+	for _, d := range started {
+		if err := d.Terminate(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	started = nil
+	if len(errs) > 0 {
+		return fmt.Errorf("containers: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// start starts the server of the database once, and waits for it to be ready.
+func (d *Database) start() error {
+	d.once.Do(func() {
+		d.addr = os.Getenv(d.envKey())
+		if d.addr == "" {
+			if d.err = d.run(); d.err != nil {
+				return
+			}
+		}
+		mu.Lock()
+		started = append(started, d)
+		mu.Unlock()
+		if d.db, d.err = sql.Open(d.Dialect, d.admin(d.addr)); d.err != nil {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), StartTimeout)
+		defer cancel()
+		for {
+			// Servers may restart during their initialization,
+			// and therefore, readiness is checked using a query.
+			var n int
+			if d.err = d.db.QueryRowContext(ctx, "SELECT 1").Scan(&n); d.err == nil {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				d.err = fmt.Errorf("server is not ready: %w", d.err)
+				return
+			case <-time.After(time.Second):
+			}
+		}
+	})
+	return d.err
+}
+
+// run starts the container of the database, and sets its host address.
+func (d *Database) run() error {
+	args := []string{"run", "-d", "-P", "--label", "io.entgo.enttest=true"}
+	for _, e := range d.env {
+		args = append(args, "-e", e)
+	}
+	out, err := exec.Command("docker", append(args, d.Image)...).Output()
+	if err != nil {
+		return fmt.Errorf("docker run %s: %w", d.Image, err)
+	}
+	d.id = strings.TrimSpace(string(out))
+	if out, err = exec.Command("docker", "port", d.id, d.port).Output(); err != nil {
+		return fmt.Errorf("docker port %s: %w", d.id, err)
+	}
+	// The output holds a line for each mapping (e.g. IPv4 and IPv6).
+	addr := strings.TrimSpace(strings.SplitN(string(out), "\n", 2)[0])
+	d.addr = strings.Replace(addr, "0.0.0.0", "127.0.0.1", 1)
+	return nil
+}
+
+// envKey returns the name of the environment variable of the server address.
+func (d *Database) envKey() string {
+	return "ENTTEST_" + strings.ToUpper(sanitize(d.Name)) + "_ADDR"
+}
+
+func (d *Database) quote(name string) string {
+	if d.Dialect == dialect.MySQL {
+		return "`" + name + "`"
+	}
+	return `"` + name + `"`
+}
+
+// dbname returns a unique database name for the given test name, that is
+// safe for parallel tests and does not exceed the limits of the databases.
+func dbname(test string) string {
+	name := strings.ToLower(sanitize(test))
+	if len(name) > 40 {
+		name = name[:40]
+	}
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return name + "_" + hex.EncodeToString(b)
+}
+
+// sanitize replaces the characters that are not letters, digits or underscores.
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, s)
+}
@@ -0,0 +1,48 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package containers
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	var names []string
+	t.Run("matrix", func(t *testing.T) {
+		Run(t, []*Database{SQLite()}, func(t *testing.T, db *DB) {
+			require.Equal(t, dialect.SQLite, db.Dialect)
+			names = append(names, db.Name)
+			conn, err := sql.Open("sqlite3", db.DSN)
+			require.NoError(t, err)
+			defer conn.Close()
+			_, err = conn.Exec("CREATE TABLE users (id integer PRIMARY KEY)")
+			require.NoError(t, err)
+		})
+	})
+	require.Len(t, names, 1)
+	require.Regexp(t, `^testrun_matrix_sqlite_[0-9a-f]{8}$`, names[0])
+}
+
+func TestDBName(t *testing.T) {
+	a, b := dbname("TestUser/mysql-8"), dbname("TestUser/mysql-8")
+	require.NotEqual(t, a, b, "names should be unique per test")
+	require.Regexp(t, regexp.MustCompile(`^testuser_mysql_8_[0-9a-f]{8}$`), a)
+	require.Len(t, dbname("TestVeryLongTestName/WithMany/Nested/Subtests/mysql-8"), 49)
+}
+
+func TestEnvKey(t *testing.T) {
+	require.Equal(t, "ENTTEST_MYSQL_8_ADDR", MySQL("8").envKey())
+	require.Equal(t, "ENTTEST_MARIADB_10_5_ADDR", MariaDB("10.5").envKey())
+	require.Equal(t, "ENTTEST_POSTGRES_14_ADDR", Postgres("14").envKey())
+	require.Equal(t, "host=localhost port=5433 user=postgres dbname=test password=pass sslmode=disable", Postgres("14").dsn("localhost:5433", "test"))
+	require.Equal(t, "root:pass@tcp(localhost:3306)/test?parseTime=True", MySQL("8").dsn("localhost:3306", "test"))
+}
@@ -107,7 +107,7 @@ func (cdo *CityDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{city.Label}
+		return &NotFoundError{label: city.Label}
 	default:
 		return nil
 	}
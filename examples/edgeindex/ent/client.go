@@ -17,6 +17,7 @@ import (
 	"entgo.io/ent/examples/edgeindex/ent/city"
 	"entgo.io/ent/examples/edgeindex/ent/street"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -35,10 +36,11 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
+	client.AddExtension(ent.RegisteredExtensions()...)
 	return client
 }
 
@@ -118,14 +120,24 @@ func (c *Client) Debug() *Client {
 	}
 	cfg := c.config
 	cfg.driver = dialect.Debug(c.driver, c.log)
+	if c.readDriver != nil {
+		cfg.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
 	client := &Client{config: cfg}
 	client.init()
 	return client
 }
 
 // Close closes the database connection and prevents new queries from starting.
+// The read driver of the client is closed as well, if it was configured.
 func (c *Client) Close() error {
-	return c.driver.Close()
+	err := c.driver.Close()
+	if _, ok := c.driver.(*txDriver); !ok && c.readDriver != nil {
+		if rerr := c.readDriver.Close(); err == nil {
+			err = rerr
+		}
+	}
+	return err
 }
 
 // Use adds the mutation hooks to all the entity clients.
@@ -135,6 +147,78 @@ func (c *Client) Use(hooks ...Hook) {
 	c.Street.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.City.Intercept(interceptors...)
+	c.Street.Intercept(interceptors...)
+}
+
+// AddExtension adds the given extensions to the client. Their hooks and interceptors are added to all the
+// entity clients, and their drivers wrap the drivers of the client. Extensions that decorate the driver
+// should be added before the client is used, as entity clients that were obtained before the call (e.g.
+// `users := client.User`) keep using the previous driver. For example:
+//
+//	client.AddExtension(cache.NewExtension(cache.WithTTL(time.Minute)))
+//
+func (c *Client) AddExtension(exts ...ent.Extension) {
+	if len(exts) == 0 {
+		return
+	}
+	for _, ext := range exts {
+		if drv := ext.Driver(c.driver); drv != nil {
+			c.driver = drv
+		}
+		if c.readDriver != nil {
+			if drv := ext.Driver(c.readDriver); drv != nil {
+				c.readDriver = drv
+			}
+		}
+	}
+	c.extensions = append(c.extensions[:len(c.extensions):len(c.extensions)], exts...)
+	c.init()
+	for _, ext := range exts {
+		c.Use(ext.Hooks()...)
+		c.Intercept(ext.Interceptors()...)
+	}
+}
+
+// HealthCheck runs the health checks of the extensions that were added to the client,
+// and returns the first error that was reported.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	for _, ext := range c.extensions {
+		if err := ext.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("ent: extension %q: %w", ext.Name(), err)
+		}
+	}
+	return nil
+}
+
+// WithOptions returns a new client that is derived from c and configured with the given options.
+// Hooks that are registered on the new client using Use are not added to c (and vice versa). For
+// example, creating a client for trusted background jobs:
+//
+//	client.WithOptions(ent.ReplaceHooks(AuditHook()), ent.SkipPrivacy()).
+//		City.
+//		Delete().
+//		Exec(ctx)
+//
+func (c *Client) WithOptions(opts ...Option) *Client {
+	cfg := c.config
+	cfg.hooks = &hooks{
+		City:   c.hooks.City[:len(c.hooks.City):len(c.hooks.City)],
+		Street: c.hooks.Street[:len(c.hooks.Street):len(c.hooks.Street)],
+	}
+	cfg.inters = &inters{
+		City:   c.inters.City[:len(c.inters.City):len(c.inters.City)],
+		Street: c.inters.Street[:len(c.inters.Street):len(c.inters.Street)],
+	}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
 // CityClient is a client for the City schema.
 type CityClient struct {
 	config
@@ -151,6 +235,12 @@ func (c *CityClient) Use(hooks ...Hook) {
 	c.hooks.City = append(c.hooks.City, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `city.Intercept(f(g(h())))`.
+func (c *CityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.City = append(c.inters.City, interceptors...)
+}
+
 // Create returns a builder for creating a City entity.
 func (c *CityClient) Create() *CityCreate {
 	mutation := newCityMutation(c.config, OpCreate)
@@ -203,12 +293,17 @@ func (c *CityClient) DeleteOneID(id int) *CityDeleteOne {
 func (c *CityClient) Query() *CityQuery {
 	return &CityQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a City entity by its id.
 func (c *CityClient) Get(ctx context.Context, id int) (*City, error) {
-	return c.Query().Where(city.ID(id)).Only(ctx)
+	node, err := c.Query().Where(city.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -222,7 +317,7 @@ func (c *CityClient) GetX(ctx context.Context, id int) *City {
 
 // QueryStreets queries the streets edge of a City.
 func (c *CityClient) QueryStreets(ci *City) *StreetQuery {
-	query := &StreetQuery{config: c.config}
+	query := (&StreetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ci.ID
 		step := sqlgraph.NewStep(
@@ -236,6 +331,11 @@ func (c *CityClient) QueryStreets(ci *City) *StreetQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *CityClient) Interceptors() []Interceptor {
+	return c.inters.City
+}
+
 // Hooks returns the client hooks.
 func (c *CityClient) Hooks() []Hook {
 	return c.hooks.City
@@ -257,6 +357,12 @@ func (c *StreetClient) Use(hooks ...Hook) {
 	c.hooks.Street = append(c.hooks.Street, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `street.Intercept(f(g(h())))`.
+func (c *StreetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Street = append(c.inters.Street, interceptors...)
+}
+
 // Create returns a builder for creating a Street entity.
 func (c *StreetClient) Create() *StreetCreate {
 	mutation := newStreetMutation(c.config, OpCreate)
@@ -309,12 +415,17 @@ func (c *StreetClient) DeleteOneID(id int) *StreetDeleteOne {
 func (c *StreetClient) Query() *StreetQuery {
 	return &StreetQuery{
 		config: c.config,
+		inters: c.Interceptors(),
 	}
 }
 
 // Get returns a Street entity by its id.
 func (c *StreetClient) Get(ctx context.Context, id int) (*Street, error) {
-	return c.Query().Where(street.ID(id)).Only(ctx)
+	node, err := c.Query().Where(street.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -328,7 +439,7 @@ func (c *StreetClient) GetX(ctx context.Context, id int) *Street {
 
 // QueryCity queries the city edge of a Street.
 func (c *StreetClient) QueryCity(s *Street) *CityQuery {
-	query := &CityQuery{config: c.config}
+	query := (&CityClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := s.ID
 		step := sqlgraph.NewStep(
@@ -342,6 +453,11 @@ func (c *StreetClient) QueryCity(s *Street) *CityQuery {
 	return query
 }
 
+// Interceptors returns the client interceptors.
+func (c *StreetClient) Interceptors() []Interceptor {
+	return c.inters.Street
+}
+
 // Hooks returns the client hooks.
 func (c *StreetClient) Hooks() []Hook {
 	return c.hooks.Street
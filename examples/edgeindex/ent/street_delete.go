@@ -107,7 +107,7 @@ func (sdo *StreetDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{street.Label}
+		return &NotFoundError{label: street.Label}
 	default:
 		return nil
 	}
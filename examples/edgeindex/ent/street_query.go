@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/examples/edgeindex/ent/city"
@@ -27,6 +28,7 @@ type StreetQuery struct {
 	unique     *bool
 	order      []OrderFunc
 	fields     []string
+	inters     []Interceptor
 	predicates []predicate.Street
 	withCity   *CityQuery
 	withFKs    bool
@@ -68,7 +70,7 @@ func (sq *StreetQuery) Order(o ...OrderFunc) *StreetQuery {
 
 // QueryCity chains the current query on the "city" edge.
 func (sq *StreetQuery) QueryCity() *CityQuery {
-	query := &CityQuery{config: sq.config}
+	query := (&CityClient{config: sq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := sq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -96,7 +98,7 @@ func (sq *StreetQuery) First(ctx context.Context) (*Street, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{street.Label}
+		return nil, sq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -118,7 +120,7 @@ func (sq *StreetQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{street.Label}
+		err = sq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -145,7 +147,7 @@ func (sq *StreetQuery) Only(ctx context.Context) (*Street, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{street.Label}
+		return nil, sq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{street.Label}
 	}
@@ -172,7 +174,7 @@ func (sq *StreetQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{street.Label}
+		err = sq.sqlNotFound()
 	default:
 		err = &NotSingularError{street.Label}
 	}
@@ -193,7 +195,17 @@ func (sq *StreetQuery) All(ctx context.Context) ([]*Street, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return sq.sqlAll(ctx)
+	v, err := sq.intercept(ctx, "All", func(ctx context.Context, query *StreetQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Street)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Street", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -207,10 +219,20 @@ func (sq *StreetQuery) AllX(ctx context.Context) []*Street {
 
 // IDs executes the query and returns a list of Street IDs.
 func (sq *StreetQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := sq.Select(street.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := sq.intercept(ctx, "IDs", func(ctx context.Context, query *StreetQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(street.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -228,7 +250,17 @@ func (sq *StreetQuery) Count(ctx context.Context) (int, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return sq.sqlCount(ctx)
+	v, err := sq.intercept(ctx, "Count", func(ctx context.Context, query *StreetQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -245,7 +277,54 @@ func (sq *StreetQuery) Exist(ctx context.Context) (bool, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return sq.sqlExist(ctx)
+	v, err := sq.intercept(ctx, "Exist", func(ctx context.Context, query *StreetQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (sq *StreetQuery) intercept(ctx context.Context, op string, fn func(context.Context, *StreetQuery) (Value, error)) (Value, error) {
+	if len(sq.inters) == 0 {
+		return fn(ctx, sq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeStreet,
+		Op:     op,
+		Limit:  sq.limit,
+		Offset: sq.offset,
+		Unique: sq.unique,
+		Fields: sq.fields,
+	}
+	for _, p := range sq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range sq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if sq.withCity != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, street.EdgeCity)
+		qc.EdgeQueries[street.EdgeCity] = sq.withCity
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*StreetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *StreetQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, sq, qc, qr, sq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -268,6 +347,7 @@ func (sq *StreetQuery) Clone() *StreetQuery {
 		limit:      sq.limit,
 		offset:     sq.offset,
 		order:      append([]OrderFunc{}, sq.order...),
+		inters:     append([]Interceptor{}, sq.inters...),
 		predicates: append([]predicate.Street{}, sq.predicates...),
 		withCity:   sq.withCity.Clone(),
 		// clone intermediate query.
@@ -280,7 +360,7 @@ func (sq *StreetQuery) Clone() *StreetQuery {
 // WithCity tells the query-builder to eager-load the nodes that are connected to
 // the "city" edge. The optional arguments are used to configure the query builder of the edge.
 func (sq *StreetQuery) WithCity(opts ...func(*CityQuery)) *StreetQuery {
-	query := &CityQuery{config: sq.config}
+	query := (&CityClient{config: sq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -344,6 +424,16 @@ func (sq *StreetQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range sq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sq); err != nil {
+				return err
+			}
+		}
+	}
 	if sq.path != nil {
 		prev, err := sq.path(ctx)
 		if err != nil {
@@ -381,7 +471,7 @@ func (sq *StreetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Stree
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, sq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, sq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -432,7 +522,7 @@ func (sq *StreetQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(sq.fields) > 0 {
 		_spec.Unique = sq.unique != nil && *sq.unique
 	}
-	return sqlgraph.CountNodes(ctx, sq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, sq.queryDriver(), _spec)
 }
 
 func (sq *StreetQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -523,6 +613,32 @@ func (sq *StreetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (sq *StreetQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: street.Label}
+	if len(sq.predicates) > 0 {
+		selector := sql.Dialect(sq.driver.Dialect()).Select().From(sql.Table(street.Table))
+		for _, p := range sq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the StreetQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (sq *StreetQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Street, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Street(ps[i]))
+	}
+	sq.predicates = append(sq.predicates, wps...)
+}
+
 // StreetGroupBy is the group-by builder for Street entities.
 type StreetGroupBy struct {
 	config
@@ -562,7 +678,7 @@ func (sgb *StreetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := sgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := sgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -608,7 +724,7 @@ func (ss *StreetSelect) Scan(ctx context.Context, v interface{}) error {
 func (ss *StreetSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ss.sql.Query()
-	if err := ss.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ss.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
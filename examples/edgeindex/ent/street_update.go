@@ -184,7 +184,7 @@ func (su *StreetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{street.Label}
+			err = &NotFoundError{label: street.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -196,9 +196,18 @@ func (su *StreetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // StreetUpdateOne is the builder for updating a single Street entity.
 type StreetUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *StreetMutation
+	fields     []string
+	conditions []predicate.Street
+	hooks      []Hook
+	mutation   *StreetMutation
+}
+
+// Where appends a list of conditions to the StreetUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (suo *StreetUpdateOne) Where(ps ...predicate.Street) *StreetUpdateOne {
+	suo.conditions = append(suo.conditions, ps...)
+	return suo
 }
 
 // SetName sets the "name" field.
@@ -339,6 +348,13 @@ func (suo *StreetUpdateOne) sqlSave(ctx context.Context) (_node *Street, err err
 			}
 		}
 	}
+	if ps := suo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := suo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -386,7 +402,9 @@ func (suo *StreetUpdateOne) sqlSave(ctx context.Context) (_node *Street, err err
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{street.Label}
+			err = &NotFoundError{label: street.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: street.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/examples/fs/ent/file"
@@ -27,6 +28,7 @@ type FileQuery struct {
 	unique       *bool
 	order        []OrderFunc
 	fields       []string
+	inters       []Interceptor
 	predicates   []predicate.File
 	withParent   *FileQuery
 	withChildren *FileQuery
@@ -68,7 +70,7 @@ func (fq *FileQuery) Order(o ...OrderFunc) *FileQuery {
 
 // QueryParent chains the current query on the "parent" edge.
 func (fq *FileQuery) QueryParent() *FileQuery {
-	query := &FileQuery{config: fq.config}
+	query := (&FileClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -90,7 +92,7 @@ func (fq *FileQuery) QueryParent() *FileQuery {
 
 // QueryChildren chains the current query on the "children" edge.
 func (fq *FileQuery) QueryChildren() *FileQuery {
-	query := &FileQuery{config: fq.config}
+	query := (&FileClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -118,7 +120,7 @@ func (fq *FileQuery) First(ctx context.Context) (*File, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{file.Label}
+		return nil, fq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -140,7 +142,7 @@ func (fq *FileQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{file.Label}
+		err = fq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -167,7 +169,7 @@ func (fq *FileQuery) Only(ctx context.Context) (*File, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{file.Label}
+		return nil, fq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{file.Label}
 	}
@@ -194,7 +196,7 @@ func (fq *FileQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{file.Label}
+		err = fq.sqlNotFound()
 	default:
 		err = &NotSingularError{file.Label}
 	}
@@ -215,7 +217,17 @@ func (fq *FileQuery) All(ctx context.Context) ([]*File, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return fq.sqlAll(ctx)
+	v, err := fq.intercept(ctx, "All", func(ctx context.Context, query *FileQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*File)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*File", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -229,10 +241,20 @@ func (fq *FileQuery) AllX(ctx context.Context) []*File {
 
 // IDs executes the query and returns a list of File IDs.
 func (fq *FileQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := fq.Select(file.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := fq.intercept(ctx, "IDs", func(ctx context.Context, query *FileQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(file.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -250,7 +272,17 @@ func (fq *FileQuery) Count(ctx context.Context) (int, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return fq.sqlCount(ctx)
+	v, err := fq.intercept(ctx, "Count", func(ctx context.Context, query *FileQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -267,7 +299,61 @@ func (fq *FileQuery) Exist(ctx context.Context) (bool, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return fq.sqlExist(ctx)
+	v, err := fq.intercept(ctx, "Exist", func(ctx context.Context, query *FileQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (fq *FileQuery) intercept(ctx context.Context, op string, fn func(context.Context, *FileQuery) (Value, error)) (Value, error) {
+	if len(fq.inters) == 0 {
+		return fn(ctx, fq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeFile,
+		Op:     op,
+		Limit:  fq.limit,
+		Offset: fq.offset,
+		Unique: fq.unique,
+		Fields: fq.fields,
+	}
+	for _, p := range fq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range fq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if fq.withParent != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, file.EdgeParent)
+		qc.EdgeQueries[file.EdgeParent] = fq.withParent
+	}
+	if fq.withChildren != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, file.EdgeChildren)
+		qc.EdgeQueries[file.EdgeChildren] = fq.withChildren
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *FileQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, fq, qc, qr, fq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -290,6 +376,7 @@ func (fq *FileQuery) Clone() *FileQuery {
 		limit:        fq.limit,
 		offset:       fq.offset,
 		order:        append([]OrderFunc{}, fq.order...),
+		inters:       append([]Interceptor{}, fq.inters...),
 		predicates:   append([]predicate.File{}, fq.predicates...),
 		withParent:   fq.withParent.Clone(),
 		withChildren: fq.withChildren.Clone(),
@@ -303,7 +390,7 @@ func (fq *FileQuery) Clone() *FileQuery {
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithParent(opts ...func(*FileQuery)) *FileQuery {
-	query := &FileQuery{config: fq.config}
+	query := (&FileClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -314,7 +401,7 @@ func (fq *FileQuery) WithParent(opts ...func(*FileQuery)) *FileQuery {
 // WithChildren tells the query-builder to eager-load the nodes that are connected to
 // the "children" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithChildren(opts ...func(*FileQuery)) *FileQuery {
-	query := &FileQuery{config: fq.config}
+	query := (&FileClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -378,6 +465,16 @@ func (fq *FileQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range fq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fq); err != nil {
+				return err
+			}
+		}
+	}
 	if fq.path != nil {
 		prev, err := fq.path(ctx)
 		if err != nil {
@@ -409,7 +506,7 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, fq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, fq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -491,7 +588,7 @@ func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(fq.fields) > 0 {
 		_spec.Unique = fq.unique != nil && *fq.unique
 	}
-	return sqlgraph.CountNodes(ctx, fq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, fq.queryDriver(), _spec)
 }
 
 func (fq *FileQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -582,6 +679,32 @@ func (fq *FileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (fq *FileQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: file.Label}
+	if len(fq.predicates) > 0 {
+		selector := sql.Dialect(fq.driver.Dialect()).Select().From(sql.Table(file.Table))
+		for _, p := range fq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the FileQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (fq *FileQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.File, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.File(ps[i]))
+	}
+	fq.predicates = append(fq.predicates, wps...)
+}
+
 // FileGroupBy is the group-by builder for File entities.
 type FileGroupBy struct {
 	config
@@ -621,7 +744,7 @@ func (fgb *FileGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := fgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := fgb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -667,7 +790,7 @@ func (fs *FileSelect) Scan(ctx context.Context, v interface{}) error {
 func (fs *FileSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := fs.sql.Query()
-	if err := fs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := fs.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/examples/fs/ent/predicate"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -222,6 +223,7 @@ func (m *FileMutation) ResetDeleted() {
 // SetParentID sets the "parent_id" field.
 func (m *FileMutation) SetParentID(i int) {
 	m.parent = &i
+	delete(m.clearedFields, file.FieldParentID)
 }
 
 // ParentID returns the value of the "parent_id" field in the mutation.
@@ -353,6 +355,16 @@ func (m *FileMutation) Where(ps ...predicate.File) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.File, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *FileMutation) Op() Op {
 	return m.op
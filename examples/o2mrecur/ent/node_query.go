@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/examples/o2mrecur/ent/node"
@@ -27,6 +28,7 @@ type NodeQuery struct {
 	unique       *bool
 	order        []OrderFunc
 	fields       []string
+	inters       []Interceptor
 	predicates   []predicate.Node
 	withParent   *NodeQuery
 	withChildren *NodeQuery
@@ -69,7 +71,7 @@ func (nq *NodeQuery) Order(o ...OrderFunc) *NodeQuery {
 
 // QueryParent chains the current query on the "parent" edge.
 func (nq *NodeQuery) QueryParent() *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -91,7 +93,7 @@ func (nq *NodeQuery) QueryParent() *NodeQuery {
 
 // QueryChildren chains the current query on the "children" edge.
 func (nq *NodeQuery) QueryChildren() *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -119,7 +121,7 @@ func (nq *NodeQuery) First(ctx context.Context) (*Node, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{node.Label}
+		return nil, nq.sqlNotFound()
 	}
 	return nodes[0], nil
 }
@@ -141,7 +143,7 @@ func (nq *NodeQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{node.Label}
+		err = nq.sqlNotFound()
 		return
 	}
 	return ids[0], nil
@@ -168,7 +170,7 @@ func (nq *NodeQuery) Only(ctx context.Context) (*Node, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{node.Label}
+		return nil, nq.sqlNotFound()
 	default:
 		return nil, &NotSingularError{node.Label}
 	}
@@ -195,7 +197,7 @@ func (nq *NodeQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{node.Label}
+		err = nq.sqlNotFound()
 	default:
 		err = &NotSingularError{node.Label}
 	}
@@ -216,7 +218,17 @@ func (nq *NodeQuery) All(ctx context.Context) ([]*Node, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return nq.sqlAll(ctx)
+	v, err := nq.intercept(ctx, "All", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Node)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*Node", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -230,10 +242,20 @@ func (nq *NodeQuery) AllX(ctx context.Context) []*Node {
 
 // IDs executes the query and returns a list of Node IDs.
 func (nq *NodeQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := nq.Select(node.FieldID).Scan(ctx, &ids); err != nil {
+	v, err := nq.intercept(ctx, "IDs", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(node.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
 	return ids, nil
 }
 
@@ -251,7 +273,17 @@ func (nq *NodeQuery) Count(ctx context.Context) (int, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return nq.sqlCount(ctx)
+	v, err := nq.intercept(ctx, "Count", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -268,7 +300,61 @@ func (nq *NodeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return nq.sqlExist(ctx)
+	v, err := nq.intercept(ctx, "Exist", func(ctx context.Context, query *NodeQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (nq *NodeQuery) intercept(ctx context.Context, op string, fn func(context.Context, *NodeQuery) (Value, error)) (Value, error) {
+	if len(nq.inters) == 0 {
+		return fn(ctx, nq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeNode,
+		Op:     op,
+		Limit:  nq.limit,
+		Offset: nq.offset,
+		Unique: nq.unique,
+		Fields: nq.fields,
+	}
+	for _, p := range nq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range nq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	if nq.withParent != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, node.EdgeParent)
+		qc.EdgeQueries[node.EdgeParent] = nq.withParent
+	}
+	if nq.withChildren != nil {
+		if qc.EdgeQueries == nil {
+			qc.EdgeQueries = make(map[string]ent.Query)
+		}
+		qc.Edges = append(qc.Edges, node.EdgeChildren)
+		qc.EdgeQueries[node.EdgeChildren] = nq.withChildren
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*NodeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *NodeQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, nq, qc, qr, nq.inters)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -291,6 +377,7 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 		limit:        nq.limit,
 		offset:       nq.offset,
 		order:        append([]OrderFunc{}, nq.order...),
+		inters:       append([]Interceptor{}, nq.inters...),
 		predicates:   append([]predicate.Node{}, nq.predicates...),
 		withParent:   nq.withParent.Clone(),
 		withChildren: nq.withChildren.Clone(),
@@ -304,7 +391,7 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NodeQuery) WithParent(opts ...func(*NodeQuery)) *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -315,7 +402,7 @@ func (nq *NodeQuery) WithParent(opts ...func(*NodeQuery)) *NodeQuery {
 // WithChildren tells the query-builder to eager-load the nodes that are connected to
 // the "children" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NodeQuery) WithChildren(opts ...func(*NodeQuery)) *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -379,6 +466,16 @@ func (nq *NodeQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	for _, inter := range nq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, nq); err != nil {
+				return err
+			}
+		}
+	}
 	if nq.path != nil {
 		prev, err := nq.path(ctx)
 		if err != nil {
@@ -417,7 +514,7 @@ func (nq *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, nq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, nq.queryDriver(), _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -506,7 +603,7 @@ func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(nq.fields) > 0 {
 		_spec.Unique = nq.unique != nil && *nq.unique
 	}
-	return sqlgraph.CountNodes(ctx, nq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, nq.queryDriver(), _spec)
 }
 
 func (nq *NodeQuery) sqlExist(ctx context.Context) (bool, error) {
@@ -597,6 +694,32 @@ func (nq *NodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (nq *NodeQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: node.Label}
+	if len(nq.predicates) > 0 {
+		selector := sql.Dialect(nq.driver.Dialect()).Select().From(sql.Table(node.Table))
+		for _, p := range nq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the NodeQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (nq *NodeQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Node, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Node(ps[i]))
+	}
+	nq.predicates = append(nq.predicates, wps...)
+}
+
 // NodeGroupBy is the group-by builder for Node entities.
 type NodeGroupBy struct {
 	config
@@ -636,7 +759,7 @@ func (ngb *NodeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ngb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ngb.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -682,7 +805,7 @@ func (ns *NodeSelect) Scan(ctx context.Context, v interface{}) error {
 func (ns *NodeSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
 	query, args := ns.sql.Query()
-	if err := ns.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ns.queryDriver().Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
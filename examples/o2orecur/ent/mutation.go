@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/examples/o2orecur/ent/predicate"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -38,6 +39,9 @@ type NodeMutation struct {
 	id            *int
 	value         *int
 	addvalue      *int
+	maxvalue      *int
+	andvalue      *int
+	orvalue       *int
 	clearedFields map[string]struct{}
 	prev          *int
 	clearedprev   bool
@@ -150,6 +154,9 @@ func (m *NodeMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *NodeMutation) SetValue(i int) {
 	m.value = &i
 	m.addvalue = nil
+	m.maxvalue = nil
+	m.andvalue = nil
+	m.orvalue = nil
 }
 
 // Value returns the value of the "value" field in the mutation.
@@ -196,10 +203,65 @@ func (m *NodeMutation) AddedValue() (r int, exists bool) {
 	return *v, true
 }
 
+// SetValueIfGreater sets the "value" field to i in the database, only if it is greater than its stored value.
+func (m *NodeMutation) SetValueIfGreater(i int) {
+	if m.maxvalue == nil || i > *m.maxvalue {
+		m.maxvalue = &i
+	}
+}
+
+// ValueIfGreater returns the value that was set to the "value" field by SetValueIfGreater in this mutation.
+func (m *NodeMutation) ValueIfGreater() (r int, exists bool) {
+	v := m.maxvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndValue applies a bitwise AND with i on the "value" field.
+func (m *NodeMutation) BitAndValue(i int) {
+	if m.andvalue != nil {
+		*m.andvalue &= i
+	} else {
+		m.andvalue = &i
+	}
+}
+
+// ValueBitAnd returns the value that was applied by BitAndValue on the "value" field in this mutation.
+func (m *NodeMutation) ValueBitAnd() (r int, exists bool) {
+	v := m.andvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrValue applies a bitwise OR with i on the "value" field.
+func (m *NodeMutation) BitOrValue(i int) {
+	if m.orvalue != nil {
+		*m.orvalue |= i
+	} else {
+		m.orvalue = &i
+	}
+}
+
+// ValueBitOr returns the value that was applied by BitOrValue on the "value" field in this mutation.
+func (m *NodeMutation) ValueBitOr() (r int, exists bool) {
+	v := m.orvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetValue resets all changes to the "value" field.
 func (m *NodeMutation) ResetValue() {
 	m.value = nil
 	m.addvalue = nil
+	m.maxvalue = nil
+	m.andvalue = nil
+	m.orvalue = nil
 }
 
 // SetPrevID sets the "prev" edge to the Node entity by id.
@@ -285,6 +347,16 @@ func (m *NodeMutation) Where(ps ...predicate.Node) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Node, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *NodeMutation) Op() Op {
 	return m.op
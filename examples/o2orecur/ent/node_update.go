@@ -44,6 +44,25 @@ func (nu *NodeUpdate) AddValue(i int) *NodeUpdate {
 	return nu
 }
 
+// SetValueIfGreater sets the "value" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (nu *NodeUpdate) SetValueIfGreater(i int) *NodeUpdate {
+	nu.mutation.SetValueIfGreater(i)
+	return nu
+}
+
+// BitAndValue applies a bitwise AND with i on the "value" field in the database.
+func (nu *NodeUpdate) BitAndValue(i int) *NodeUpdate {
+	nu.mutation.BitAndValue(i)
+	return nu
+}
+
+// BitOrValue applies a bitwise OR with i on the "value" field in the database.
+func (nu *NodeUpdate) BitOrValue(i int) *NodeUpdate {
+	nu.mutation.BitOrValue(i)
+	return nu
+}
+
 // SetPrevID sets the "prev" edge to the Node entity by ID.
 func (nu *NodeUpdate) SetPrevID(id int) *NodeUpdate {
 	nu.mutation.SetPrevID(id)
@@ -185,6 +204,27 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: node.FieldValue,
 		})
 	}
+	if value, ok := nu.mutation.ValueIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
+	if value, ok := nu.mutation.ValueBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
+	if value, ok := nu.mutation.ValueBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
 	if nu.mutation.PrevCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -257,7 +297,7 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{node.Label}
+			err = &NotFoundError{label: node.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -269,9 +309,18 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // NodeUpdateOne is the builder for updating a single Node entity.
 type NodeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *NodeMutation
+	fields     []string
+	conditions []predicate.Node
+	hooks      []Hook
+	mutation   *NodeMutation
+}
+
+// Where appends a list of conditions to the NodeUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (nuo *NodeUpdateOne) Where(ps ...predicate.Node) *NodeUpdateOne {
+	nuo.conditions = append(nuo.conditions, ps...)
+	return nuo
 }
 
 // SetValue sets the "value" field.
@@ -287,6 +336,25 @@ func (nuo *NodeUpdateOne) AddValue(i int) *NodeUpdateOne {
 	return nuo
 }
 
+// SetValueIfGreater sets the "value" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (nuo *NodeUpdateOne) SetValueIfGreater(i int) *NodeUpdateOne {
+	nuo.mutation.SetValueIfGreater(i)
+	return nuo
+}
+
+// BitAndValue applies a bitwise AND with i on the "value" field in the database.
+func (nuo *NodeUpdateOne) BitAndValue(i int) *NodeUpdateOne {
+	nuo.mutation.BitAndValue(i)
+	return nuo
+}
+
+// BitOrValue applies a bitwise OR with i on the "value" field in the database.
+func (nuo *NodeUpdateOne) BitOrValue(i int) *NodeUpdateOne {
+	nuo.mutation.BitOrValue(i)
+	return nuo
+}
+
 // SetPrevID sets the "prev" edge to the Node entity by ID.
 func (nuo *NodeUpdateOne) SetPrevID(id int) *NodeUpdateOne {
 	nuo.mutation.SetPrevID(id)
@@ -444,6 +512,13 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error)
 			}
 		}
 	}
+	if ps := nuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := nuo.mutation.Value(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -458,6 +533,27 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error)
 			Column: node.FieldValue,
 		})
 	}
+	if value, ok := nuo.mutation.ValueIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
+	if value, ok := nuo.mutation.ValueBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
+	if value, ok := nuo.mutation.ValueBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
 	if nuo.mutation.PrevCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -533,7 +629,9 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{node.Label}
+			err = &NotFoundError{label: node.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: node.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -133,6 +133,29 @@ func (gq *GroupQuery) Filter() *GroupFilter {
 	return &GroupFilter{config: gq.config, predicateAdder: gq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// GroupQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (gq *GroupQuery) Apply(q *entql.Query) *GroupQuery {
+	if q.Where != nil {
+		gq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			gq.Order(Desc(o.Field))
+		} else {
+			gq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		gq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		gq.Offset(*q.Offset)
+	}
+	return gq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *GroupMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -211,6 +234,29 @@ func (tq *TenantQuery) Filter() *TenantFilter {
 	return &TenantFilter{config: tq.config, predicateAdder: tq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// TenantQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (tq *TenantQuery) Apply(q *entql.Query) *TenantQuery {
+	if q.Where != nil {
+		tq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			tq.Order(Desc(o.Field))
+		} else {
+			tq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		tq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		tq.Offset(*q.Offset)
+	}
+	return tq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *TenantMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
@@ -256,6 +302,29 @@ func (uq *UserQuery) Filter() *UserFilter {
 	return &UserFilter{config: uq.config, predicateAdder: uq}
 }
 
+// Apply applies the predicate, order and pagination of the given entql.Query on the
+// UserQuery builder. Unknown fields are reported when the query is executed, and queries
+// that were received from untrusted sources should be parsed using entql.ParseQuery.
+func (uq *UserQuery) Apply(q *entql.Query) *UserQuery {
+	if q.Where != nil {
+		uq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			uq.Order(Desc(o.Field))
+		} else {
+			uq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit != nil {
+		uq.Limit(*q.Limit)
+	}
+	if q.Offset != nil {
+		uq.Offset(*q.Offset)
+	}
+	return uq
+}
+
 // addPredicate implements the predicateAdder interface.
 func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
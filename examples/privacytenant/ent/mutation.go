@@ -18,6 +18,7 @@ import (
 	"entgo.io/ent/examples/privacytenant/ent/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -307,6 +308,16 @@ func (m *GroupMutation) Where(ps ...predicate.Group) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *GroupMutation) Op() Op {
 	return m.op
@@ -692,6 +703,16 @@ func (m *TenantMutation) Where(ps ...predicate.Tenant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the TenantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tenant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *TenantMutation) Op() Op {
 	return m.op
@@ -859,6 +880,7 @@ type UserMutation struct {
 	id            *int
 	name          *string
 	foods         *[]string
+	appendedfoods []string
 	clearedFields map[string]struct{}
 	tenant        *int
 	clearedtenant bool
@@ -1043,6 +1065,8 @@ func (m *UserMutation) ResetName() {
 // SetFoods sets the "foods" field.
 func (m *UserMutation) SetFoods(s []string) {
 	m.foods = &s
+	m.appendedfoods = nil
+	delete(m.clearedFields, user.FieldFoods)
 }
 
 // Foods returns the value of the "foods" field in the mutation.
@@ -1071,9 +1095,23 @@ func (m *UserMutation) OldFoods(ctx context.Context) (v []string, err error) {
 	return oldValue.Foods, nil
 }
 
+// AppendFoods adds s to the "foods" field.
+func (m *UserMutation) AppendFoods(s []string) {
+	m.appendedfoods = append(m.appendedfoods, s...)
+}
+
+// AppendedFoods returns the list of values that were appended to the "foods" field in this mutation.
+func (m *UserMutation) AppendedFoods() ([]string, bool) {
+	if len(m.appendedfoods) == 0 {
+		return nil, false
+	}
+	return m.appendedfoods, true
+}
+
 // ClearFoods clears the value of the "foods" field.
 func (m *UserMutation) ClearFoods() {
 	m.foods = nil
+	m.appendedfoods = nil
 	m.clearedFields[user.FieldFoods] = struct{}{}
 }
 
@@ -1086,6 +1124,7 @@ func (m *UserMutation) FoodsCleared() bool {
 // ResetFoods resets all changes to the "foods" field.
 func (m *UserMutation) ResetFoods() {
 	m.foods = nil
+	m.appendedfoods = nil
 	delete(m.clearedFields, user.FieldFoods)
 }
 
@@ -1174,6 +1213,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -107,7 +107,7 @@ func (tdo *TenantDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{tenant.Label}
+		return &NotFoundError{label: tenant.Label}
 	default:
 		return nil
 	}
@@ -9,6 +9,7 @@ package user
 import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/examples/privacytenant/ent/predicate"
 )
 
@@ -246,6 +247,126 @@ func FoodsNotNil() predicate.User {
 	})
 }
 
+// FoodsHasKey applies the HasKey predicate on the "foods" field. It checks that the JSON key
+// at the given path (in dot format, e.g. "a.b[2].c") exists. Keys with a JSON null value also match.
+func FoodsHasKey(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldFoods), sqljson.DotPath(path)))
+	})
+}
+
+// FoodsValueIsNull applies the ValueIsNull predicate on the "foods" field. It checks
+// that the JSON value at the given path is a null literal (JSON "null").
+func FoodsValueIsNull(path string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldFoods), sqljson.DotPath(path)))
+	})
+}
+
+// FoodsValueEQ applies the ValueEQ predicate on the JSON value of the "foods" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsValueEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldFoods), v, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsValueNEQ applies the ValueNEQ predicate on the JSON value of the "foods" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsValueNEQ(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldFoods), v, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsValueGT applies the ValueGT predicate on the JSON value of the "foods" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsValueGT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldFoods), v, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsValueGTE applies the ValueGTE predicate on the JSON value of the "foods" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsValueGTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldFoods), v, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsValueLT applies the ValueLT predicate on the JSON value of the "foods" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsValueLT(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldFoods), v, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsValueLTE applies the ValueLTE predicate on the JSON value of the "foods" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsValueLTE(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldFoods), v, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsValueContains applies the ValueContains predicate on the JSON value of the "foods" field at
+// the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsValueContains(path string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldFoods), v, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsLenEQ applies the LenEQ predicate on the length of the JSON array of the "foods"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsLenEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldFoods), n, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsLenNEQ applies the LenNEQ predicate on the length of the JSON array of the "foods"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsLenNEQ(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldFoods), n, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsLenGT applies the LenGT predicate on the length of the JSON array of the "foods"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsLenGT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldFoods), n, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsLenGTE applies the LenGTE predicate on the length of the JSON array of the "foods"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsLenGTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldFoods), n, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsLenLT applies the LenLT predicate on the length of the JSON array of the "foods"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsLenLT(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldFoods), n, sqljson.DotPath(path)))
+	})
+}
+
+// FoodsLenLTE applies the LenLTE predicate on the length of the JSON array of the "foods"
+// field at the given path (in dot format, e.g. "a.b[2].c"). An empty path refers to the value of the field.
+func FoodsLenLTE(path string, n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldFoods), n, sqljson.DotPath(path)))
+	})
+}
+
 // HasTenant applies the HasEdge predicate on the "tenant" edge.
 func HasTenant() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -59,6 +59,12 @@ func (uu *UserUpdate) SetFoods(s []string) *UserUpdate {
 	return uu
 }
 
+// AppendFoods appends s to the "foods" field in the database.
+func (uu *UserUpdate) AppendFoods(s []string) *UserUpdate {
+	uu.mutation.AppendFoods(s)
+	return uu
+}
+
 // ClearFoods clears the value of the "foods" field.
 func (uu *UserUpdate) ClearFoods() *UserUpdate {
 	uu.mutation.ClearFoods()
@@ -217,6 +223,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldFoods,
 		})
 	}
+	if value, ok := uu.mutation.AppendedFoods(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldFoods,
+		})
+	}
 	if uu.mutation.FoodsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -314,7 +327,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -326,9 +339,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields     []string
+	conditions []predicate.User
+	hooks      []Hook
+	mutation   *UserMutation
+}
+
+// Where appends a list of conditions to the UserUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.conditions = append(uuo.conditions, ps...)
+	return uuo
 }
 
 // SetTenantID sets the "tenant_id" field.
@@ -357,6 +379,12 @@ func (uuo *UserUpdateOne) SetFoods(s []string) *UserUpdateOne {
 	return uuo
 }
 
+// AppendFoods appends s to the "foods" field in the database.
+func (uuo *UserUpdateOne) AppendFoods(s []string) *UserUpdateOne {
+	uuo.mutation.AppendFoods(s)
+	return uuo
+}
+
 // ClearFoods clears the value of the "foods" field.
 func (uuo *UserUpdateOne) ClearFoods() *UserUpdateOne {
 	uuo.mutation.ClearFoods()
@@ -531,6 +559,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			}
 		}
 	}
+	if ps := uuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -545,6 +580,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldFoods,
 		})
 	}
+	if value, ok := uuo.mutation.AppendedFoods(); ok {
+		_spec.Fields.Append = append(_spec.Fields.Append, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldFoods,
+		})
+	}
 	if uuo.mutation.FoodsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -645,7 +687,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
+			err = &NotFoundError{label: user.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: user.Label, id: _spec.Node.ID.Value, version: e.Version()}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
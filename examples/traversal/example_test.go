@@ -48,7 +48,7 @@ func Example_Traversal() {
 		log.Fatal(err)
 	}
 	// WithTx helper.
-	if err := WithTx(ctx, client, func(tx *ent.Tx) error {
+	if err := ent.WithTx(ctx, client, func(tx *ent.Tx) error {
 		return Gen(ctx, tx.Client())
 	}); err != nil {
 		log.Fatal(err)
@@ -211,30 +211,6 @@ func WrapGen(ctx context.Context, client *ent.Client) error {
 	return tx.Commit()
 }
 
-// WithTx example from the doc.
-func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
-	tx, err := client.Tx(ctx)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if v := recover(); v != nil {
-			tx.Rollback()
-			panic(v)
-		}
-	}()
-	if err := fn(tx); err != nil {
-		if rerr := tx.Rollback(); rerr != nil {
-			err = fmt.Errorf("rolling back transaction: %w", rerr)
-		}
-		return err
-	}
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("committing transaction: %w", err)
-	}
-	return nil
-}
-
 // rollback calls to tx.Rollback and wraps the given error with the rollback error if occurred.
 func rollback(tx *ent.Tx, err error) error {
 	if rerr := tx.Rollback(); rerr != nil {
@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/examples/version/ent/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -38,6 +39,9 @@ type UserMutation struct {
 	id            *int
 	version       *int64
 	addversion    *int64
+	maxversion    *int64
+	andversion    *int64
+	orversion     *int64
 	status        *user.Status
 	clearedFields map[string]struct{}
 	done          bool
@@ -147,6 +151,9 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 func (m *UserMutation) SetVersion(i int64) {
 	m.version = &i
 	m.addversion = nil
+	m.maxversion = nil
+	m.andversion = nil
+	m.orversion = nil
 }
 
 // Version returns the value of the "version" field in the mutation.
@@ -193,10 +200,65 @@ func (m *UserMutation) AddedVersion() (r int64, exists bool) {
 	return *v, true
 }
 
+// SetVersionIfGreater sets the "version" field to i in the database, only if it is greater than its stored value.
+func (m *UserMutation) SetVersionIfGreater(i int64) {
+	if m.maxversion == nil || i > *m.maxversion {
+		m.maxversion = &i
+	}
+}
+
+// VersionIfGreater returns the value that was set to the "version" field by SetVersionIfGreater in this mutation.
+func (m *UserMutation) VersionIfGreater() (r int64, exists bool) {
+	v := m.maxversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitAndVersion applies a bitwise AND with i on the "version" field.
+func (m *UserMutation) BitAndVersion(i int64) {
+	if m.andversion != nil {
+		*m.andversion &= i
+	} else {
+		m.andversion = &i
+	}
+}
+
+// VersionBitAnd returns the value that was applied by BitAndVersion on the "version" field in this mutation.
+func (m *UserMutation) VersionBitAnd() (r int64, exists bool) {
+	v := m.andversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// BitOrVersion applies a bitwise OR with i on the "version" field.
+func (m *UserMutation) BitOrVersion(i int64) {
+	if m.orversion != nil {
+		*m.orversion |= i
+	} else {
+		m.orversion = &i
+	}
+}
+
+// VersionBitOr returns the value that was applied by BitOrVersion on the "version" field in this mutation.
+func (m *UserMutation) VersionBitOr() (r int64, exists bool) {
+	v := m.orversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetVersion resets all changes to the "version" field.
 func (m *UserMutation) ResetVersion() {
 	m.version = nil
 	m.addversion = nil
+	m.maxversion = nil
+	m.andversion = nil
+	m.orversion = nil
 }
 
 // SetStatus sets the "status" field.
@@ -240,6 +302,16 @@ func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -0,0 +1,817 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"entgo.io/ent/examples/views/ent/migrate"
+
+	"entgo.io/ent/examples/views/ent/adult"
+	"entgo.io/ent/examples/views/ent/dailysignup"
+	"entgo.io/ent/examples/views/ent/post"
+	"entgo.io/ent/examples/views/ent/user"
+	"entgo.io/ent/examples/views/ent/userstats"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// Adult is the client for interacting with the Adult builders.
+	Adult *AdultClient
+	// DailySignup is the client for interacting with the DailySignup builders.
+	DailySignup *DailySignupClient
+	// Post is the client for interacting with the Post builders.
+	Post *PostClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
+	// UserStats is the client for interacting with the UserStats builders.
+	UserStats *UserStatsClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	client.AddExtension(ent.RegisteredExtensions()...)
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.Adult = NewAdultClient(c.config)
+	c.DailySignup = NewDailySignupClient(c.config)
+	c.Post = NewPostClient(c.config)
+	c.User = NewUserClient(c.config)
+	c.UserStats = NewUserStatsClient(c.config)
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:         ctx,
+		config:      cfg,
+		Adult:       NewAdultClient(cfg),
+		DailySignup: NewDailySignupClient(cfg),
+		Post:        NewPostClient(cfg),
+		User:        NewUserClient(cfg),
+		UserStats:   NewUserStatsClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:         ctx,
+		config:      cfg,
+		Adult:       NewAdultClient(cfg),
+		DailySignup: NewDailySignupClient(cfg),
+		Post:        NewPostClient(cfg),
+		User:        NewUserClient(cfg),
+		UserStats:   NewUserStatsClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		Adult.
+//		Query().
+//		Count(ctx)
+//
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	if c.readDriver != nil {
+		cfg.readDriver = dialect.Debug(c.readDriver, c.log)
+	}
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+// The read driver of the client is closed as well, if it was configured.
+func (c *Client) Close() error {
+	err := c.driver.Close()
+	if _, ok := c.driver.(*txDriver); !ok && c.readDriver != nil {
+		if rerr := c.readDriver.Close(); err == nil {
+			err = rerr
+		}
+	}
+	return err
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.Adult.Use(hooks...)
+	c.DailySignup.Use(hooks...)
+	c.Post.Use(hooks...)
+	c.User.Use(hooks...)
+	c.UserStats.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Adult.Intercept(interceptors...)
+	c.DailySignup.Intercept(interceptors...)
+	c.Post.Intercept(interceptors...)
+	c.User.Intercept(interceptors...)
+	c.UserStats.Intercept(interceptors...)
+}
+
+// AddExtension adds the given extensions to the client. Their hooks and interceptors are added to all the
+// entity clients, and their drivers wrap the drivers of the client. Extensions that decorate the driver
+// should be added before the client is used, as entity clients that were obtained before the call (e.g.
+// `users := client.User`) keep using the previous driver. For example:
+//
+//	client.AddExtension(cache.NewExtension(cache.WithTTL(time.Minute)))
+//
+func (c *Client) AddExtension(exts ...ent.Extension) {
+	if len(exts) == 0 {
+		return
+	}
+	for _, ext := range exts {
+		if drv := ext.Driver(c.driver); drv != nil {
+			c.driver = drv
+		}
+		if c.readDriver != nil {
+			if drv := ext.Driver(c.readDriver); drv != nil {
+				c.readDriver = drv
+			}
+		}
+	}
+	c.extensions = append(c.extensions[:len(c.extensions):len(c.extensions)], exts...)
+	c.init()
+	for _, ext := range exts {
+		c.Use(ext.Hooks()...)
+		c.Intercept(ext.Interceptors()...)
+	}
+}
+
+// HealthCheck runs the health checks of the extensions that were added to the client,
+// and returns the first error that was reported.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	for _, ext := range c.extensions {
+		if err := ext.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("ent: extension %q: %w", ext.Name(), err)
+		}
+	}
+	return nil
+}
+
+// WithOptions returns a new client that is derived from c and configured with the given options.
+// Hooks that are registered on the new client using Use are not added to c (and vice versa). For
+// example, creating a client for trusted background jobs:
+//
+//	client.WithOptions(ent.ReplaceHooks(AuditHook()), ent.SkipPrivacy()).
+//		Adult.
+//		Delete().
+//		Exec(ctx)
+//
+func (c *Client) WithOptions(opts ...Option) *Client {
+	cfg := c.config
+	cfg.hooks = &hooks{
+		Adult:       c.hooks.Adult[:len(c.hooks.Adult):len(c.hooks.Adult)],
+		DailySignup: c.hooks.DailySignup[:len(c.hooks.DailySignup):len(c.hooks.DailySignup)],
+		Post:        c.hooks.Post[:len(c.hooks.Post):len(c.hooks.Post)],
+		User:        c.hooks.User[:len(c.hooks.User):len(c.hooks.User)],
+		UserStats:   c.hooks.UserStats[:len(c.hooks.UserStats):len(c.hooks.UserStats)],
+	}
+	cfg.inters = &inters{
+		Adult:       c.inters.Adult[:len(c.inters.Adult):len(c.inters.Adult)],
+		DailySignup: c.inters.DailySignup[:len(c.inters.DailySignup):len(c.inters.DailySignup)],
+		Post:        c.inters.Post[:len(c.inters.Post):len(c.inters.Post)],
+		User:        c.inters.User[:len(c.inters.User):len(c.inters.User)],
+		UserStats:   c.inters.UserStats[:len(c.inters.UserStats):len(c.inters.UserStats)],
+	}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// AdultClient is a client for the Adult schema.
+type AdultClient struct {
+	config
+}
+
+// NewAdultClient returns a client for the Adult from the given config.
+func NewAdultClient(c config) *AdultClient {
+	return &AdultClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `adult.Hooks(f(g(h())))`.
+func (c *AdultClient) Use(hooks ...Hook) {
+	c.hooks.Adult = append(c.hooks.Adult, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `adult.Intercept(f(g(h())))`.
+func (c *AdultClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Adult = append(c.inters.Adult, interceptors...)
+}
+
+// Create returns a builder for creating a Adult entity.
+func (c *AdultClient) Create() *AdultCreate {
+	mutation := newAdultMutation(c.config, OpCreate)
+	return &AdultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Adult entities.
+func (c *AdultClient) CreateBulk(builders ...*AdultCreate) *AdultCreateBulk {
+	return &AdultCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Adult.
+func (c *AdultClient) Update() *AdultUpdate {
+	mutation := newAdultMutation(c.config, OpUpdate)
+	return &AdultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AdultClient) UpdateOne(a *Adult) *AdultUpdateOne {
+	mutation := newAdultMutation(c.config, OpUpdateOne, withAdult(a))
+	return &AdultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AdultClient) UpdateOneID(id int) *AdultUpdateOne {
+	mutation := newAdultMutation(c.config, OpUpdateOne, withAdultID(id))
+	return &AdultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Adult.
+func (c *AdultClient) Delete() *AdultDelete {
+	mutation := newAdultMutation(c.config, OpDelete)
+	return &AdultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AdultClient) DeleteOne(a *Adult) *AdultDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *AdultClient) DeleteOneID(id int) *AdultDeleteOne {
+	builder := c.Delete().Where(adult.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AdultDeleteOne{builder}
+}
+
+// Query returns a query builder for Adult.
+func (c *AdultClient) Query() *AdultQuery {
+	return &AdultQuery{
+		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Adult entity by its id.
+func (c *AdultClient) Get(ctx context.Context, id int) (*Adult, error) {
+	node, err := c.Query().Where(adult.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AdultClient) GetX(ctx context.Context, id int) *Adult {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Interceptors returns the client interceptors.
+func (c *AdultClient) Interceptors() []Interceptor {
+	return c.inters.Adult
+}
+
+// Hooks returns the client hooks.
+func (c *AdultClient) Hooks() []Hook {
+	return append([]Hook{readOnlyView}, c.hooks.Adult...)
+}
+
+// DailySignupClient is a client for the DailySignup schema.
+type DailySignupClient struct {
+	config
+}
+
+// NewDailySignupClient returns a client for the DailySignup from the given config.
+func NewDailySignupClient(c config) *DailySignupClient {
+	return &DailySignupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dailysignup.Hooks(f(g(h())))`.
+func (c *DailySignupClient) Use(hooks ...Hook) {
+	c.hooks.DailySignup = append(c.hooks.DailySignup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dailysignup.Intercept(f(g(h())))`.
+func (c *DailySignupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DailySignup = append(c.inters.DailySignup, interceptors...)
+}
+
+// Create returns a builder for creating a DailySignup entity.
+func (c *DailySignupClient) Create() *DailySignupCreate {
+	mutation := newDailySignupMutation(c.config, OpCreate)
+	return &DailySignupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DailySignup entities.
+func (c *DailySignupClient) CreateBulk(builders ...*DailySignupCreate) *DailySignupCreateBulk {
+	return &DailySignupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DailySignup.
+func (c *DailySignupClient) Update() *DailySignupUpdate {
+	mutation := newDailySignupMutation(c.config, OpUpdate)
+	return &DailySignupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DailySignupClient) UpdateOne(ds *DailySignup) *DailySignupUpdateOne {
+	mutation := newDailySignupMutation(c.config, OpUpdateOne, withDailySignup(ds))
+	return &DailySignupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DailySignupClient) UpdateOneID(id int) *DailySignupUpdateOne {
+	mutation := newDailySignupMutation(c.config, OpUpdateOne, withDailySignupID(id))
+	return &DailySignupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DailySignup.
+func (c *DailySignupClient) Delete() *DailySignupDelete {
+	mutation := newDailySignupMutation(c.config, OpDelete)
+	return &DailySignupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DailySignupClient) DeleteOne(ds *DailySignup) *DailySignupDeleteOne {
+	return c.DeleteOneID(ds.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *DailySignupClient) DeleteOneID(id int) *DailySignupDeleteOne {
+	builder := c.Delete().Where(dailysignup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DailySignupDeleteOne{builder}
+}
+
+// Query returns a query builder for DailySignup.
+func (c *DailySignupClient) Query() *DailySignupQuery {
+	return &DailySignupQuery{
+		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DailySignup entity by its id.
+func (c *DailySignupClient) Get(ctx context.Context, id int) (*DailySignup, error) {
+	node, err := c.Query().Where(dailysignup.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DailySignupClient) GetX(ctx context.Context, id int) *DailySignup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Interceptors returns the client interceptors.
+func (c *DailySignupClient) Interceptors() []Interceptor {
+	return c.inters.DailySignup
+}
+
+// Hooks returns the client hooks.
+func (c *DailySignupClient) Hooks() []Hook {
+	return append([]Hook{readOnlyView}, c.hooks.DailySignup...)
+}
+
+// Refresh refreshes the contents of the daily_signups materialized view.
+func (c *DailySignupClient) Refresh(ctx context.Context, opts ...RefreshOption) error {
+	return refreshView(ctx, c.driver, dailysignup.Table, opts...)
+}
+
+// PostClient is a client for the Post schema.
+type PostClient struct {
+	config
+}
+
+// NewPostClient returns a client for the Post from the given config.
+func NewPostClient(c config) *PostClient {
+	return &PostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
+func (c *PostClient) Use(hooks ...Hook) {
+	c.hooks.Post = append(c.hooks.Post, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
+func (c *PostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Post = append(c.inters.Post, interceptors...)
+}
+
+// Create returns a builder for creating a Post entity.
+func (c *PostClient) Create() *PostCreate {
+	mutation := newPostMutation(c.config, OpCreate)
+	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Post entities.
+func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Post.
+func (c *PostClient) Update() *PostUpdate {
+	mutation := newPostMutation(c.config, OpUpdate)
+	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostClient) UpdateOne(po *Post) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPost(po))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Post.
+func (c *PostClient) Delete() *PostDelete {
+	mutation := newPostMutation(c.config, OpDelete)
+	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostClient) DeleteOne(po *Post) *PostDeleteOne {
+	return c.DeleteOneID(po.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *PostClient) DeleteOneID(id int) *PostDeleteOne {
+	builder := c.Delete().Where(post.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostDeleteOne{builder}
+}
+
+// Query returns a query builder for Post.
+func (c *PostClient) Query() *PostQuery {
+	return &PostQuery{
+		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Post entity by its id.
+func (c *PostClient) Get(ctx context.Context, id int) (*Post, error) {
+	node, err := c.Query().Where(post.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostClient) GetX(ctx context.Context, id int) *Post {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAuthor queries the author edge of a Post.
+func (c *PostClient) QueryAuthor(po *Post) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.AuthorTable, post.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostClient) Interceptors() []Interceptor {
+	return c.inters.Post
+}
+
+// Hooks returns the client hooks.
+func (c *PostClient) Hooks() []Hook {
+	return c.hooks.Post
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	node, err := c.Query().Where(user.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPosts queries the posts edge of a User.
+func (c *UserClient) QueryPosts(u *User) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PostsTable, user.PostsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// UserStatsClient is a client for the UserStats schema.
+type UserStatsClient struct {
+	config
+}
+
+// NewUserStatsClient returns a client for the UserStats from the given config.
+func NewUserStatsClient(c config) *UserStatsClient {
+	return &UserStatsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userstats.Hooks(f(g(h())))`.
+func (c *UserStatsClient) Use(hooks ...Hook) {
+	c.hooks.UserStats = append(c.hooks.UserStats, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userstats.Intercept(f(g(h())))`.
+func (c *UserStatsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserStats = append(c.inters.UserStats, interceptors...)
+}
+
+// Create returns a builder for creating a UserStats entity.
+func (c *UserStatsClient) Create() *UserStatsCreate {
+	mutation := newUserStatsMutation(c.config, OpCreate)
+	return &UserStatsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserStats entities.
+func (c *UserStatsClient) CreateBulk(builders ...*UserStatsCreate) *UserStatsCreateBulk {
+	return &UserStatsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserStats.
+func (c *UserStatsClient) Update() *UserStatsUpdate {
+	mutation := newUserStatsMutation(c.config, OpUpdate)
+	return &UserStatsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserStatsClient) UpdateOne(us *UserStats) *UserStatsUpdateOne {
+	mutation := newUserStatsMutation(c.config, OpUpdateOne, withUserStats(us))
+	return &UserStatsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserStatsClient) UpdateOneID(id int) *UserStatsUpdateOne {
+	mutation := newUserStatsMutation(c.config, OpUpdateOne, withUserStatsID(id))
+	return &UserStatsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserStats.
+func (c *UserStatsClient) Delete() *UserStatsDelete {
+	mutation := newUserStatsMutation(c.config, OpDelete)
+	return &UserStatsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserStatsClient) DeleteOne(us *UserStats) *UserStatsDeleteOne {
+	return c.DeleteOneID(us.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *UserStatsClient) DeleteOneID(id int) *UserStatsDeleteOne {
+	builder := c.Delete().Where(userstats.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserStatsDeleteOne{builder}
+}
+
+// Query returns a query builder for UserStats.
+func (c *UserStatsClient) Query() *UserStatsQuery {
+	return &UserStatsQuery{
+		config: c.config,
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserStats entity by its id.
+func (c *UserStatsClient) Get(ctx context.Context, id int) (*UserStats, error) {
+	node, err := c.Query().Where(userstats.ID(id)).Only(ctx)
+	if e, ok := err.(*NotFoundError); ok {
+		err = &NotFoundError{label: e.label, id: id}
+	}
+	return node, err
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserStatsClient) GetX(ctx context.Context, id int) *UserStats {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserStatsClient) Interceptors() []Interceptor {
+	return c.inters.UserStats
+}
+
+// Hooks returns the client hooks.
+func (c *UserStatsClient) Hooks() []Hook {
+	return append([]Hook{readOnlyView}, c.hooks.UserStats...)
+}
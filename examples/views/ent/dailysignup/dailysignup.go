@@ -0,0 +1,37 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package dailysignup
+
+const (
+	// Label holds the string label denoting the dailysignup type in the database.
+	Label = "daily_signup"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldSignups holds the string denoting the signups field in the database.
+	FieldSignups = "signups"
+	// Table holds the table name of the dailysignup in the database.
+	Table = "daily_signups"
+)
+
+// Columns holds all SQL columns for dailysignup fields.
+var Columns = []string{
+	FieldID,
+	FieldDay,
+	FieldSignups,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,259 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package dailysignup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/examples/views/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDay), v))
+	})
+}
+
+// Signups applies equality check predicate on the "signups" field. It's identical to SignupsEQ.
+func Signups(v int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSignups), v))
+	})
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDay), v))
+	})
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDay), v))
+	})
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.DailySignup {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDay), v...))
+	})
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.DailySignup {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDay), v...))
+	})
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDay), v))
+	})
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDay), v))
+	})
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDay), v))
+	})
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDay), v))
+	})
+}
+
+// SignupsEQ applies the EQ predicate on the "signups" field.
+func SignupsEQ(v int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSignups), v))
+	})
+}
+
+// SignupsNEQ applies the NEQ predicate on the "signups" field.
+func SignupsNEQ(v int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSignups), v))
+	})
+}
+
+// SignupsIn applies the In predicate on the "signups" field.
+func SignupsIn(vs ...int) predicate.DailySignup {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSignups), v...))
+	})
+}
+
+// SignupsNotIn applies the NotIn predicate on the "signups" field.
+func SignupsNotIn(vs ...int) predicate.DailySignup {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSignups), v...))
+	})
+}
+
+// SignupsGT applies the GT predicate on the "signups" field.
+func SignupsGT(v int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSignups), v))
+	})
+}
+
+// SignupsGTE applies the GTE predicate on the "signups" field.
+func SignupsGTE(v int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSignups), v))
+	})
+}
+
+// SignupsLT applies the LT predicate on the "signups" field.
+func SignupsLT(v int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSignups), v))
+	})
+}
+
+// SignupsLTE applies the LTE predicate on the "signups" field.
+func SignupsLTE(v int) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSignups), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DailySignup) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DailySignup) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DailySignup) predicate.DailySignup {
+	return predicate.DailySignup(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,641 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/examples/views/ent/dailysignup"
+	"entgo.io/ent/examples/views/ent/predicate"
+	"entgo.io/ent/schema/field"
+)
+
+// DailySignupQuery is the builder for querying DailySignup entities.
+type DailySignupQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	inters     []Interceptor
+	predicates []predicate.DailySignup
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DailySignupQuery builder.
+func (dsq *DailySignupQuery) Where(ps ...predicate.DailySignup) *DailySignupQuery {
+	dsq.predicates = append(dsq.predicates, ps...)
+	return dsq
+}
+
+// Limit adds a limit step to the query.
+func (dsq *DailySignupQuery) Limit(limit int) *DailySignupQuery {
+	dsq.limit = &limit
+	return dsq
+}
+
+// Offset adds an offset step to the query.
+func (dsq *DailySignupQuery) Offset(offset int) *DailySignupQuery {
+	dsq.offset = &offset
+	return dsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dsq *DailySignupQuery) Unique(unique bool) *DailySignupQuery {
+	dsq.unique = &unique
+	return dsq
+}
+
+// Order adds an order step to the query.
+func (dsq *DailySignupQuery) Order(o ...OrderFunc) *DailySignupQuery {
+	dsq.order = append(dsq.order, o...)
+	return dsq
+}
+
+// First returns the first DailySignup entity from the query.
+// Returns a *NotFoundError when no DailySignup was found.
+func (dsq *DailySignupQuery) First(ctx context.Context) (*DailySignup, error) {
+	nodes, err := dsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, dsq.sqlNotFound()
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dsq *DailySignupQuery) FirstX(ctx context.Context) *DailySignup {
+	node, err := dsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DailySignup ID from the query.
+// Returns a *NotFoundError when no DailySignup ID was found.
+func (dsq *DailySignupQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = dsq.sqlNotFound()
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dsq *DailySignupQuery) FirstIDX(ctx context.Context) int {
+	id, err := dsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DailySignup entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DailySignup entity is found.
+// Returns a *NotFoundError when no DailySignup entities are found.
+func (dsq *DailySignupQuery) Only(ctx context.Context) (*DailySignup, error) {
+	nodes, err := dsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, dsq.sqlNotFound()
+	default:
+		return nil, &NotSingularError{dailysignup.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dsq *DailySignupQuery) OnlyX(ctx context.Context) *DailySignup {
+	node, err := dsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DailySignup ID in the query.
+// Returns a *NotSingularError when more than one DailySignup ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dsq *DailySignupQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = dsq.sqlNotFound()
+	default:
+		err = &NotSingularError{dailysignup.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dsq *DailySignupQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DailySignups.
+func (dsq *DailySignupQuery) All(ctx context.Context) ([]*DailySignup, error) {
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	v, err := dsq.intercept(ctx, "All", func(ctx context.Context, query *DailySignupQuery) (Value, error) {
+		return query.sqlAll(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*DailySignup)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of All, expect []*DailySignup", v)
+	}
+	return nodes, nil
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dsq *DailySignupQuery) AllX(ctx context.Context) []*DailySignup {
+	nodes, err := dsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DailySignup IDs.
+func (dsq *DailySignupQuery) IDs(ctx context.Context) ([]int, error) {
+	v, err := dsq.intercept(ctx, "IDs", func(ctx context.Context, query *DailySignupQuery) (Value, error) {
+		var ids []int
+		if err := query.Select(dailysignup.FieldID).Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids, ok := v.([]int)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from the interceptors of IDs, expect []int", v)
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dsq *DailySignupQuery) IDsX(ctx context.Context) []int {
+	ids, err := dsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dsq *DailySignupQuery) Count(ctx context.Context) (int, error) {
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	v, err := dsq.intercept(ctx, "Count", func(ctx context.Context, query *DailySignupQuery) (Value, error) {
+		return query.sqlCount(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Count, expect int", v)
+	}
+	return count, nil
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dsq *DailySignupQuery) CountX(ctx context.Context) int {
+	count, err := dsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dsq *DailySignupQuery) Exist(ctx context.Context) (bool, error) {
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	v, err := dsq.intercept(ctx, "Exist", func(ctx context.Context, query *DailySignupQuery) (Value, error) {
+		return query.sqlExist(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from the interceptors of Exist, expect bool", v)
+	}
+	return exist, nil
+}
+
+// intercept executes the given function with the interceptors of the query, that are
+// registered on the client and in the schema, as the Querier of the given operation.
+func (dsq *DailySignupQuery) intercept(ctx context.Context, op string, fn func(context.Context, *DailySignupQuery) (Value, error)) (Value, error) {
+	if len(dsq.inters) == 0 {
+		return fn(ctx, dsq)
+	}
+	qc := &ent.QueryContext{
+		Type:   TypeDailySignup,
+		Op:     op,
+		Limit:  dsq.limit,
+		Offset: dsq.offset,
+		Unique: dsq.unique,
+		Fields: dsq.fields,
+	}
+	for _, p := range dsq.predicates {
+		qc.Predicates = append(qc.Predicates, p)
+	}
+	for _, o := range dsq.order {
+		qc.Order = append(qc.Order, o)
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*DailySignupQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T, expect *DailySignupQuery", q)
+		}
+		return fn(ctx, query)
+	})
+	return withInterceptors(ctx, dsq, qc, qr, dsq.inters)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dsq *DailySignupQuery) ExistX(ctx context.Context) bool {
+	exist, err := dsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DailySignupQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dsq *DailySignupQuery) Clone() *DailySignupQuery {
+	if dsq == nil {
+		return nil
+	}
+	return &DailySignupQuery{
+		config:     dsq.config,
+		limit:      dsq.limit,
+		offset:     dsq.offset,
+		order:      append([]OrderFunc{}, dsq.order...),
+		inters:     append([]Interceptor{}, dsq.inters...),
+		predicates: append([]predicate.DailySignup{}, dsq.predicates...),
+		// clone intermediate query.
+		sql:    dsq.sql.Clone(),
+		path:   dsq.path,
+		unique: dsq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Day time.Time `json:"day,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DailySignup.Query().
+//		GroupBy(dailysignup.FieldDay).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (dsq *DailySignupQuery) GroupBy(field string, fields ...string) *DailySignupGroupBy {
+	grbuild := &DailySignupGroupBy{config: dsq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := dsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return dsq.sqlQuery(ctx), nil
+	}
+	grbuild.label = dailysignup.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Day time.Time `json:"day,omitempty"`
+//	}
+//
+//	client.DailySignup.Query().
+//		Select(dailysignup.FieldDay).
+//		Scan(ctx, &v)
+//
+func (dsq *DailySignupQuery) Select(fields ...string) *DailySignupSelect {
+	dsq.fields = append(dsq.fields, fields...)
+	selbuild := &DailySignupSelect{DailySignupQuery: dsq}
+	selbuild.label = dailysignup.Label
+	selbuild.flds, selbuild.scan = &dsq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (dsq *DailySignupQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range dsq.fields {
+		if !dailysignup.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	for _, inter := range dsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dsq); err != nil {
+				return err
+			}
+		}
+	}
+	if dsq.path != nil {
+		prev, err := dsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dsq.sql = prev
+	}
+	return nil
+}
+
+func (dsq *DailySignupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DailySignup, error) {
+	var (
+		nodes = []*DailySignup{}
+		_spec = dsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*DailySignup).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &DailySignup{config: dsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dsq.queryDriver(), _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (dsq *DailySignupQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dsq.querySpec()
+	_spec.Node.Columns = dsq.fields
+	if len(dsq.fields) > 0 {
+		_spec.Unique = dsq.unique != nil && *dsq.unique
+	}
+	return sqlgraph.CountNodes(ctx, dsq.queryDriver(), _spec)
+}
+
+func (dsq *DailySignupQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := dsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (dsq *DailySignupQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   dailysignup.Table,
+			Columns: dailysignup.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: dailysignup.FieldID,
+			},
+		},
+		From:   dsq.sql,
+		Unique: true,
+	}
+	if unique := dsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := dsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dailysignup.FieldID)
+		for i := range fields {
+			if fields[i] != dailysignup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dsq *DailySignupQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dsq.driver.Dialect())
+	t1 := builder.Table(dailysignup.Table)
+	columns := dsq.fields
+	if len(columns) == 0 {
+		columns = dailysignup.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dsq.sql != nil {
+		selector = dsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dsq.unique != nil && *dsq.unique {
+		selector.Distinct()
+	}
+	for _, p := range dsq.predicates {
+		p(selector)
+	}
+	for _, p := range dsq.order {
+		p(selector)
+	}
+	if offset := dsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// sqlNotFound returns the *NotFoundError of the query, that holds a summary of its predicates.
+func (dsq *DailySignupQuery) sqlNotFound() *NotFoundError {
+	err := &NotFoundError{label: dailysignup.Label}
+	if len(dsq.predicates) > 0 {
+		selector := sql.Dialect(dsq.driver.Dialect()).Select().From(sql.Table(dailysignup.Table))
+		for _, p := range dsq.predicates {
+			p(selector)
+		}
+		if p := selector.P(); p != nil {
+			err.predicate, _ = p.Query()
+		}
+	}
+	return err
+}
+
+// WhereP appends storage-level predicates to the DailySignupQuery builder. Using this method, users
+// can use type-assertion to append predicates that do not depend on any generated package (e.g. in
+// interceptors that are shared by multiple types).
+func (dsq *DailySignupQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.DailySignup, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.DailySignup(ps[i]))
+	}
+	dsq.predicates = append(dsq.predicates, wps...)
+}
+
+// DailySignupGroupBy is the group-by builder for DailySignup entities.
+type DailySignupGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dsgb *DailySignupGroupBy) Aggregate(fns ...AggregateFunc) *DailySignupGroupBy {
+	dsgb.fns = append(dsgb.fns, fns...)
+	return dsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (dsgb *DailySignupGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := dsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	dsgb.sql = query
+	return dsgb.sqlScan(ctx, v)
+}
+
+func (dsgb *DailySignupGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range dsgb.fields {
+		if !dailysignup.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := dsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dsgb.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (dsgb *DailySignupGroupBy) sqlQuery() *sql.Selector {
+	selector := dsgb.sql.Select()
+	aggregation := make([]string, 0, len(dsgb.fns))
+	for _, fn := range dsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(dsgb.fields)+len(dsgb.fns))
+		for _, f := range dsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(dsgb.fields...)...)
+}
+
+// DailySignupSelect is the builder for selecting fields of DailySignup entities.
+type DailySignupSelect struct {
+	*DailySignupQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dss *DailySignupSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := dss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	dss.sql = dss.DailySignupQuery.sqlQuery(ctx)
+	return dss.sqlScan(ctx, v)
+}
+
+func (dss *DailySignupSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := dss.sql.Query()
+	if err := dss.queryDriver().Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,118 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// AdultsColumns holds the columns for the "adults" table.
+	AdultsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "age", Type: field.TypeInt},
+	}
+	// AdultsTable holds the schema information for the "adults" table.
+	AdultsTable = &schema.Table{
+		Name:       "adults",
+		Columns:    AdultsColumns,
+		PrimaryKey: []*schema.Column{AdultsColumns[0]},
+	}
+	// DailySignupsColumns holds the columns for the "daily_signups" table.
+	DailySignupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "signups", Type: field.TypeInt},
+	}
+	// DailySignupsTable holds the schema information for the "daily_signups" table.
+	DailySignupsTable = &schema.Table{
+		Name:       "daily_signups",
+		Columns:    DailySignupsColumns,
+		PrimaryKey: []*schema.Column{DailySignupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dailysignup_day",
+				Unique:  true,
+				Columns: []*schema.Column{DailySignupsColumns[1]},
+			},
+		},
+	}
+	// PostsColumns holds the columns for the "posts" table.
+	PostsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "likes", Type: field.TypeInt, Default: 0},
+		{Name: "author_id", Type: field.TypeInt},
+	}
+	// PostsTable holds the schema information for the "posts" table.
+	PostsTable = &schema.Table{
+		Name:       "posts",
+		Columns:    PostsColumns,
+		PrimaryKey: []*schema.Column{PostsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "posts_users_posts",
+				Columns:    []*schema.Column{PostsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "age", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
+	// UserStatsColumns holds the columns for the "user_stats" table.
+	UserStatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "posts", Type: field.TypeInt},
+		{Name: "likes", Type: field.TypeInt},
+	}
+	// UserStatsTable holds the schema information for the "user_stats" table.
+	UserStatsTable = &schema.Table{
+		Name:       "user_stats",
+		Columns:    UserStatsColumns,
+		PrimaryKey: []*schema.Column{UserStatsColumns[0]},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		AdultsTable,
+		DailySignupsTable,
+		PostsTable,
+		UsersTable,
+		UserStatsTable,
+	}
+)
+
+func init() {
+	AdultsTable.Annotation = &entsql.Annotation{
+		View: "SELECT id, name, age FROM users WHERE age >= 18",
+	}
+	DailySignupsTable.Annotation = &entsql.Annotation{
+		View:         "SELECT ROW_NUMBER() OVER (ORDER BY created_at::date) AS id, created_at::date AS day, COUNT(*) AS signups FROM users GROUP BY created_at::date",
+		Materialized: true,
+	}
+	PostsTable.ForeignKeys[0].RefTable = UsersTable
+	UserStatsTable.Annotation = &entsql.Annotation{}
+	UserStatsTable.Annotation.ViewQueries = map[string]string{
+		"postgres": "SELECT \"u\".\"id\", \"u\".\"name\", COUNT(\"p\".\"id\") AS \"posts\", COALESCE(SUM(\"p\".\"likes\"), 0) AS \"likes\" FROM \"users\" AS \"u\" LEFT JOIN \"posts\" AS \"p\" ON \"u\".\"id\" = \"p\".\"author_id\" GROUP BY \"u\".\"id\", \"u\".\"name\"",
+		"sqlite3":  "SELECT `u`.`id`, `u`.`name`, COUNT(`p`.`id`) AS `posts`, COALESCE(SUM(`p`.`likes`), 0) AS `likes` FROM `users` AS `u` LEFT JOIN `posts` AS `p` ON `u`.`id` = `p`.`author_id` GROUP BY `u`.`id`, `u`.`name`",
+	}
+}
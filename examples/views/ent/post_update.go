@@ -0,0 +1,576 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/examples/views/ent/post"
+	"entgo.io/ent/examples/views/ent/predicate"
+	"entgo.io/ent/examples/views/ent/user"
+	"entgo.io/ent/schema/field"
+)
+
+// PostUpdate is the builder for updating Post entities.
+type PostUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostMutation
+}
+
+// Where appends a list predicates to the PostUpdate builder.
+func (pu *PostUpdate) Where(ps ...predicate.Post) *PostUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetTitle sets the "title" field.
+func (pu *PostUpdate) SetTitle(s string) *PostUpdate {
+	pu.mutation.SetTitle(s)
+	return pu
+}
+
+// SetLikes sets the "likes" field.
+func (pu *PostUpdate) SetLikes(i int) *PostUpdate {
+	pu.mutation.ResetLikes()
+	pu.mutation.SetLikes(i)
+	return pu
+}
+
+// SetNillableLikes sets the "likes" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableLikes(i *int) *PostUpdate {
+	if i != nil {
+		pu.SetLikes(*i)
+	}
+	return pu
+}
+
+// AddLikes adds i to the "likes" field.
+func (pu *PostUpdate) AddLikes(i int) *PostUpdate {
+	pu.mutation.AddLikes(i)
+	return pu
+}
+
+// SetLikesIfGreater sets the "likes" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (pu *PostUpdate) SetLikesIfGreater(i int) *PostUpdate {
+	pu.mutation.SetLikesIfGreater(i)
+	return pu
+}
+
+// BitAndLikes applies a bitwise AND with i on the "likes" field in the database.
+func (pu *PostUpdate) BitAndLikes(i int) *PostUpdate {
+	pu.mutation.BitAndLikes(i)
+	return pu
+}
+
+// BitOrLikes applies a bitwise OR with i on the "likes" field in the database.
+func (pu *PostUpdate) BitOrLikes(i int) *PostUpdate {
+	pu.mutation.BitOrLikes(i)
+	return pu
+}
+
+// SetAuthorID sets the "author_id" field.
+func (pu *PostUpdate) SetAuthorID(i int) *PostUpdate {
+	pu.mutation.SetAuthorID(i)
+	return pu
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (pu *PostUpdate) SetAuthor(u *User) *PostUpdate {
+	return pu.SetAuthorID(u.ID)
+}
+
+// Mutation returns the PostMutation object of the builder.
+func (pu *PostUpdate) Mutation() *PostMutation {
+	return pu.mutation
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (pu *PostUpdate) ClearAuthor() *PostUpdate {
+	pu.mutation.ClearAuthor()
+	return pu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PostUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pu.hooks) == 0 {
+		if err = pu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PostMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pu.check(); err != nil {
+				return 0, err
+			}
+			pu.mutation = mutation
+			affected, err = pu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pu.hooks) - 1; i >= 0; i-- {
+			if pu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PostUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PostUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PostUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PostUpdate) check() error {
+	if _, ok := pu.mutation.AuthorID(); pu.mutation.AuthorCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Post.author"`)
+	}
+	return nil
+}
+
+func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   post.Table,
+			Columns: post.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: post.FieldID,
+			},
+		},
+	}
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: post.FieldTitle,
+		})
+	}
+	if value, ok := pu.mutation.Likes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: post.FieldLikes,
+		})
+	}
+	if value, ok := pu.mutation.AddedLikes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: post.FieldLikes,
+		})
+	}
+	if value, ok := pu.mutation.LikesIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: post.FieldLikes,
+		})
+	}
+	if value, ok := pu.mutation.LikesBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: post.FieldLikes,
+		})
+	}
+	if value, ok := pu.mutation.LikesBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: post.FieldLikes,
+		})
+	}
+	if pu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.AuthorTable,
+			Columns: []string{post.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.AuthorTable,
+			Columns: []string{post.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label: post.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PostUpdateOne is the builder for updating a single Post entity.
+type PostUpdateOne struct {
+	config
+	fields     []string
+	conditions []predicate.Post
+	hooks      []Hook
+	mutation   *PostMutation
+}
+
+// Where appends a list of conditions to the PostUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (puo *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
+	puo.conditions = append(puo.conditions, ps...)
+	return puo
+}
+
+// SetTitle sets the "title" field.
+func (puo *PostUpdateOne) SetTitle(s string) *PostUpdateOne {
+	puo.mutation.SetTitle(s)
+	return puo
+}
+
+// SetLikes sets the "likes" field.
+func (puo *PostUpdateOne) SetLikes(i int) *PostUpdateOne {
+	puo.mutation.ResetLikes()
+	puo.mutation.SetLikes(i)
+	return puo
+}
+
+// SetNillableLikes sets the "likes" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableLikes(i *int) *PostUpdateOne {
+	if i != nil {
+		puo.SetLikes(*i)
+	}
+	return puo
+}
+
+// AddLikes adds i to the "likes" field.
+func (puo *PostUpdateOne) AddLikes(i int) *PostUpdateOne {
+	puo.mutation.AddLikes(i)
+	return puo
+}
+
+// SetLikesIfGreater sets the "likes" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (puo *PostUpdateOne) SetLikesIfGreater(i int) *PostUpdateOne {
+	puo.mutation.SetLikesIfGreater(i)
+	return puo
+}
+
+// BitAndLikes applies a bitwise AND with i on the "likes" field in the database.
+func (puo *PostUpdateOne) BitAndLikes(i int) *PostUpdateOne {
+	puo.mutation.BitAndLikes(i)
+	return puo
+}
+
+// BitOrLikes applies a bitwise OR with i on the "likes" field in the database.
+func (puo *PostUpdateOne) BitOrLikes(i int) *PostUpdateOne {
+	puo.mutation.BitOrLikes(i)
+	return puo
+}
+
+// SetAuthorID sets the "author_id" field.
+func (puo *PostUpdateOne) SetAuthorID(i int) *PostUpdateOne {
+	puo.mutation.SetAuthorID(i)
+	return puo
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (puo *PostUpdateOne) SetAuthor(u *User) *PostUpdateOne {
+	return puo.SetAuthorID(u.ID)
+}
+
+// Mutation returns the PostMutation object of the builder.
+func (puo *PostUpdateOne) Mutation() *PostMutation {
+	return puo.mutation
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (puo *PostUpdateOne) ClearAuthor() *PostUpdateOne {
+	puo.mutation.ClearAuthor()
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PostUpdateOne) Select(field string, fields ...string) *PostUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Post entity.
+func (puo *PostUpdateOne) Save(ctx context.Context) (*Post, error) {
+	var (
+		err  error
+		node *Post
+	)
+	if len(puo.hooks) == 0 {
+		if err = puo.check(); err != nil {
+			return nil, err
+		}
+		node, err = puo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PostMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = puo.check(); err != nil {
+				return nil, err
+			}
+			puo.mutation = mutation
+			node, err = puo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puo.hooks) - 1; i >= 0; i-- {
+			if puo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = puo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, puo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Post)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PostMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PostUpdateOne) SaveX(ctx context.Context) *Post {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PostUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PostUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PostUpdateOne) check() error {
+	if _, ok := puo.mutation.AuthorID(); puo.mutation.AuthorCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Post.author"`)
+	}
+	return nil
+}
+
+func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   post.Table,
+			Columns: post.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: post.FieldID,
+			},
+		},
+	}
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Post.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, post.FieldID)
+		for _, f := range fields {
+			if !post.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != post.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ps := puo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: post.FieldTitle,
+		})
+	}
+	if value, ok := puo.mutation.Likes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: post.FieldLikes,
+		})
+	}
+	if value, ok := puo.mutation.AddedLikes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: post.FieldLikes,
+		})
+	}
+	if value, ok := puo.mutation.LikesIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: post.FieldLikes,
+		})
+	}
+	if value, ok := puo.mutation.LikesBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: post.FieldLikes,
+		})
+	}
+	if value, ok := puo.mutation.LikesBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: post.FieldLikes,
+		})
+	}
+	if puo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.AuthorTable,
+			Columns: []string{post.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.AuthorTable,
+			Columns: []string{post.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Post{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label: post.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: post.Label, id: _spec.Node.ID.Value, version: e.Version()}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
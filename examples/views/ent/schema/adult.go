@@ -0,0 +1,33 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// Adult holds the schema definition for the Adult entity.
+// It is backed by a view over the users table.
+type Adult struct {
+	ent.Schema
+}
+
+// Annotations of the Adult.
+func (Adult) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.View("SELECT id, name, age FROM users WHERE age >= 18"),
+	}
+}
+
+// Fields of the Adult.
+func (Adult) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("age"),
+	}
+}
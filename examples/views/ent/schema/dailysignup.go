@@ -0,0 +1,44 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DailySignup holds the schema definition for the DailySignup entity.
+// It is backed by a PostgreSQL materialized view, that is refreshed
+// using the Refresh method of its client.
+type DailySignup struct {
+	ent.Schema
+}
+
+// Annotations of the DailySignup.
+func (DailySignup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.MaterializedView("SELECT ROW_NUMBER() OVER (ORDER BY created_at::date) AS id, created_at::date AS day, COUNT(*) AS signups FROM users GROUP BY created_at::date"),
+	}
+}
+
+// Fields of the DailySignup.
+func (DailySignup) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("day"),
+		field.Int("signups"),
+	}
+}
+
+// Indexes of the DailySignup.
+func (DailySignup) Indexes() []ent.Index {
+	return []ent.Index{
+		// A unique index is required for refreshing the view concurrently.
+		index.Fields("day").
+			Unique(),
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// UserStats holds the schema definition for the UserStats entity. It is a
+// reporting entity that aggregates the posts of each user, and its query
+// is composed using the SQL builder.
+type UserStats struct {
+	ent.Schema
+}
+
+// Annotations of the UserStats.
+func (UserStats) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.ViewQuery(func(s *sql.Selector) {
+			u, p := sql.Table("users").As("u"), sql.Table("posts").As("p")
+			s.From(u).
+				Select(
+					u.C("id"),
+					u.C("name"),
+					sql.As(sql.Count(p.C("id")), "posts"),
+					sql.As("COALESCE(SUM("+p.C("likes")+"), 0)", "likes"),
+				).
+				LeftJoin(p).
+				On(u.C("id"), p.C("author_id")).
+				GroupBy(u.C("id"), u.C("name"))
+		}),
+	}
+}
+
+// Fields of the UserStats.
+func (UserStats) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("posts"),
+		field.Int("likes"),
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package userstats
+
+const (
+	// Label holds the string label denoting the userstats type in the database.
+	Label = "user_stats"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPosts holds the string denoting the posts field in the database.
+	FieldPosts = "posts"
+	// FieldLikes holds the string denoting the likes field in the database.
+	FieldLikes = "likes"
+	// Table holds the table name of the userstats in the database.
+	Table = "user_stats"
+)
+
+// Columns holds all SQL columns for userstats fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldPosts,
+	FieldLikes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
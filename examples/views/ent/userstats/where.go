@@ -0,0 +1,363 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package userstats
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/examples/views/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Posts applies equality check predicate on the "posts" field. It's identical to PostsEQ.
+func Posts(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPosts), v))
+	})
+}
+
+// Likes applies equality check predicate on the "likes" field. It's identical to LikesEQ.
+func Likes(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLikes), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.UserStats {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.UserStats {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// PostsEQ applies the EQ predicate on the "posts" field.
+func PostsEQ(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPosts), v))
+	})
+}
+
+// PostsNEQ applies the NEQ predicate on the "posts" field.
+func PostsNEQ(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPosts), v))
+	})
+}
+
+// PostsIn applies the In predicate on the "posts" field.
+func PostsIn(vs ...int) predicate.UserStats {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldPosts), v...))
+	})
+}
+
+// PostsNotIn applies the NotIn predicate on the "posts" field.
+func PostsNotIn(vs ...int) predicate.UserStats {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldPosts), v...))
+	})
+}
+
+// PostsGT applies the GT predicate on the "posts" field.
+func PostsGT(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPosts), v))
+	})
+}
+
+// PostsGTE applies the GTE predicate on the "posts" field.
+func PostsGTE(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPosts), v))
+	})
+}
+
+// PostsLT applies the LT predicate on the "posts" field.
+func PostsLT(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPosts), v))
+	})
+}
+
+// PostsLTE applies the LTE predicate on the "posts" field.
+func PostsLTE(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPosts), v))
+	})
+}
+
+// LikesEQ applies the EQ predicate on the "likes" field.
+func LikesEQ(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLikes), v))
+	})
+}
+
+// LikesNEQ applies the NEQ predicate on the "likes" field.
+func LikesNEQ(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLikes), v))
+	})
+}
+
+// LikesIn applies the In predicate on the "likes" field.
+func LikesIn(vs ...int) predicate.UserStats {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLikes), v...))
+	})
+}
+
+// LikesNotIn applies the NotIn predicate on the "likes" field.
+func LikesNotIn(vs ...int) predicate.UserStats {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLikes), v...))
+	})
+}
+
+// LikesGT applies the GT predicate on the "likes" field.
+func LikesGT(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLikes), v))
+	})
+}
+
+// LikesGTE applies the GTE predicate on the "likes" field.
+func LikesGTE(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLikes), v))
+	})
+}
+
+// LikesLT applies the LT predicate on the "likes" field.
+func LikesLT(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLikes), v))
+	})
+}
+
+// LikesLTE applies the LTE predicate on the "likes" field.
+func LikesLTE(v int) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLikes), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserStats) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserStats) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserStats) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,561 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/examples/views/ent/predicate"
+	"entgo.io/ent/examples/views/ent/userstats"
+	"entgo.io/ent/schema/field"
+)
+
+// UserStatsUpdate is the builder for updating UserStats entities.
+type UserStatsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserStatsMutation
+}
+
+// Where appends a list predicates to the UserStatsUpdate builder.
+func (usu *UserStatsUpdate) Where(ps ...predicate.UserStats) *UserStatsUpdate {
+	usu.mutation.Where(ps...)
+	return usu
+}
+
+// SetName sets the "name" field.
+func (usu *UserStatsUpdate) SetName(s string) *UserStatsUpdate {
+	usu.mutation.SetName(s)
+	return usu
+}
+
+// SetPosts sets the "posts" field.
+func (usu *UserStatsUpdate) SetPosts(i int) *UserStatsUpdate {
+	usu.mutation.ResetPosts()
+	usu.mutation.SetPosts(i)
+	return usu
+}
+
+// AddPosts adds i to the "posts" field.
+func (usu *UserStatsUpdate) AddPosts(i int) *UserStatsUpdate {
+	usu.mutation.AddPosts(i)
+	return usu
+}
+
+// SetPostsIfGreater sets the "posts" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (usu *UserStatsUpdate) SetPostsIfGreater(i int) *UserStatsUpdate {
+	usu.mutation.SetPostsIfGreater(i)
+	return usu
+}
+
+// BitAndPosts applies a bitwise AND with i on the "posts" field in the database.
+func (usu *UserStatsUpdate) BitAndPosts(i int) *UserStatsUpdate {
+	usu.mutation.BitAndPosts(i)
+	return usu
+}
+
+// BitOrPosts applies a bitwise OR with i on the "posts" field in the database.
+func (usu *UserStatsUpdate) BitOrPosts(i int) *UserStatsUpdate {
+	usu.mutation.BitOrPosts(i)
+	return usu
+}
+
+// SetLikes sets the "likes" field.
+func (usu *UserStatsUpdate) SetLikes(i int) *UserStatsUpdate {
+	usu.mutation.ResetLikes()
+	usu.mutation.SetLikes(i)
+	return usu
+}
+
+// AddLikes adds i to the "likes" field.
+func (usu *UserStatsUpdate) AddLikes(i int) *UserStatsUpdate {
+	usu.mutation.AddLikes(i)
+	return usu
+}
+
+// SetLikesIfGreater sets the "likes" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (usu *UserStatsUpdate) SetLikesIfGreater(i int) *UserStatsUpdate {
+	usu.mutation.SetLikesIfGreater(i)
+	return usu
+}
+
+// BitAndLikes applies a bitwise AND with i on the "likes" field in the database.
+func (usu *UserStatsUpdate) BitAndLikes(i int) *UserStatsUpdate {
+	usu.mutation.BitAndLikes(i)
+	return usu
+}
+
+// BitOrLikes applies a bitwise OR with i on the "likes" field in the database.
+func (usu *UserStatsUpdate) BitOrLikes(i int) *UserStatsUpdate {
+	usu.mutation.BitOrLikes(i)
+	return usu
+}
+
+// Mutation returns the UserStatsMutation object of the builder.
+func (usu *UserStatsUpdate) Mutation() *UserStatsMutation {
+	return usu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (usu *UserStatsUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(usu.hooks) == 0 {
+		affected, err = usu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserStatsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			usu.mutation = mutation
+			affected, err = usu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(usu.hooks) - 1; i >= 0; i-- {
+			if usu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = usu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, usu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (usu *UserStatsUpdate) SaveX(ctx context.Context) int {
+	affected, err := usu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (usu *UserStatsUpdate) Exec(ctx context.Context) error {
+	_, err := usu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usu *UserStatsUpdate) ExecX(ctx context.Context) {
+	if err := usu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (usu *UserStatsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   userstats.Table,
+			Columns: userstats.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: userstats.FieldID,
+			},
+		},
+	}
+	if ps := usu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := usu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userstats.FieldName,
+		})
+	}
+	if value, ok := usu.mutation.Posts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldPosts,
+		})
+	}
+	if value, ok := usu.mutation.AddedPosts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldPosts,
+		})
+	}
+	if value, ok := usu.mutation.PostsIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldPosts,
+		})
+	}
+	if value, ok := usu.mutation.PostsBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldPosts,
+		})
+	}
+	if value, ok := usu.mutation.PostsBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldPosts,
+		})
+	}
+	if value, ok := usu.mutation.Likes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldLikes,
+		})
+	}
+	if value, ok := usu.mutation.AddedLikes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldLikes,
+		})
+	}
+	if value, ok := usu.mutation.LikesIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldLikes,
+		})
+	}
+	if value, ok := usu.mutation.LikesBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldLikes,
+		})
+	}
+	if value, ok := usu.mutation.LikesBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldLikes,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, usu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label: userstats.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// UserStatsUpdateOne is the builder for updating a single UserStats entity.
+type UserStatsUpdateOne struct {
+	config
+	fields     []string
+	conditions []predicate.UserStats
+	hooks      []Hook
+	mutation   *UserStatsMutation
+}
+
+// Where appends a list of conditions to the UserStatsUpdateOne builder. The entity is updated only if
+// it matches all of them, and a *ConflictError is returned otherwise (compare-and-set). A *NotFoundError
+// is returned if the entity does not exist.
+func (usuo *UserStatsUpdateOne) Where(ps ...predicate.UserStats) *UserStatsUpdateOne {
+	usuo.conditions = append(usuo.conditions, ps...)
+	return usuo
+}
+
+// SetName sets the "name" field.
+func (usuo *UserStatsUpdateOne) SetName(s string) *UserStatsUpdateOne {
+	usuo.mutation.SetName(s)
+	return usuo
+}
+
+// SetPosts sets the "posts" field.
+func (usuo *UserStatsUpdateOne) SetPosts(i int) *UserStatsUpdateOne {
+	usuo.mutation.ResetPosts()
+	usuo.mutation.SetPosts(i)
+	return usuo
+}
+
+// AddPosts adds i to the "posts" field.
+func (usuo *UserStatsUpdateOne) AddPosts(i int) *UserStatsUpdateOne {
+	usuo.mutation.AddPosts(i)
+	return usuo
+}
+
+// SetPostsIfGreater sets the "posts" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (usuo *UserStatsUpdateOne) SetPostsIfGreater(i int) *UserStatsUpdateOne {
+	usuo.mutation.SetPostsIfGreater(i)
+	return usuo
+}
+
+// BitAndPosts applies a bitwise AND with i on the "posts" field in the database.
+func (usuo *UserStatsUpdateOne) BitAndPosts(i int) *UserStatsUpdateOne {
+	usuo.mutation.BitAndPosts(i)
+	return usuo
+}
+
+// BitOrPosts applies a bitwise OR with i on the "posts" field in the database.
+func (usuo *UserStatsUpdateOne) BitOrPosts(i int) *UserStatsUpdateOne {
+	usuo.mutation.BitOrPosts(i)
+	return usuo
+}
+
+// SetLikes sets the "likes" field.
+func (usuo *UserStatsUpdateOne) SetLikes(i int) *UserStatsUpdateOne {
+	usuo.mutation.ResetLikes()
+	usuo.mutation.SetLikes(i)
+	return usuo
+}
+
+// AddLikes adds i to the "likes" field.
+func (usuo *UserStatsUpdateOne) AddLikes(i int) *UserStatsUpdateOne {
+	usuo.mutation.AddLikes(i)
+	return usuo
+}
+
+// SetLikesIfGreater sets the "likes" field to i, only if it is greater than its stored value.
+// The comparison is executed in the database, as part of the update statement.
+func (usuo *UserStatsUpdateOne) SetLikesIfGreater(i int) *UserStatsUpdateOne {
+	usuo.mutation.SetLikesIfGreater(i)
+	return usuo
+}
+
+// BitAndLikes applies a bitwise AND with i on the "likes" field in the database.
+func (usuo *UserStatsUpdateOne) BitAndLikes(i int) *UserStatsUpdateOne {
+	usuo.mutation.BitAndLikes(i)
+	return usuo
+}
+
+// BitOrLikes applies a bitwise OR with i on the "likes" field in the database.
+func (usuo *UserStatsUpdateOne) BitOrLikes(i int) *UserStatsUpdateOne {
+	usuo.mutation.BitOrLikes(i)
+	return usuo
+}
+
+// Mutation returns the UserStatsMutation object of the builder.
+func (usuo *UserStatsUpdateOne) Mutation() *UserStatsMutation {
+	return usuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (usuo *UserStatsUpdateOne) Select(field string, fields ...string) *UserStatsUpdateOne {
+	usuo.fields = append([]string{field}, fields...)
+	return usuo
+}
+
+// Save executes the query and returns the updated UserStats entity.
+func (usuo *UserStatsUpdateOne) Save(ctx context.Context) (*UserStats, error) {
+	var (
+		err  error
+		node *UserStats
+	)
+	if len(usuo.hooks) == 0 {
+		node, err = usuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserStatsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			usuo.mutation = mutation
+			node, err = usuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(usuo.hooks) - 1; i >= 0; i-- {
+			if usuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = usuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, usuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*UserStats)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from UserStatsMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (usuo *UserStatsUpdateOne) SaveX(ctx context.Context) *UserStats {
+	node, err := usuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (usuo *UserStatsUpdateOne) Exec(ctx context.Context) error {
+	_, err := usuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usuo *UserStatsUpdateOne) ExecX(ctx context.Context) {
+	if err := usuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (usuo *UserStatsUpdateOne) sqlSave(ctx context.Context) (_node *UserStats, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   userstats.Table,
+			Columns: userstats.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: userstats.FieldID,
+			},
+		},
+	}
+	id, ok := usuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserStats.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := usuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userstats.FieldID)
+		for _, f := range fields {
+			if !userstats.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userstats.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := usuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ps := usuo.conditions; len(ps) > 0 {
+		_spec.Conditions = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := usuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userstats.FieldName,
+		})
+	}
+	if value, ok := usuo.mutation.Posts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldPosts,
+		})
+	}
+	if value, ok := usuo.mutation.AddedPosts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldPosts,
+		})
+	}
+	if value, ok := usuo.mutation.PostsIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldPosts,
+		})
+	}
+	if value, ok := usuo.mutation.PostsBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldPosts,
+		})
+	}
+	if value, ok := usuo.mutation.PostsBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldPosts,
+		})
+	}
+	if value, ok := usuo.mutation.Likes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldLikes,
+		})
+	}
+	if value, ok := usuo.mutation.AddedLikes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldLikes,
+		})
+	}
+	if value, ok := usuo.mutation.LikesIfGreater(); ok {
+		_spec.Fields.Max = append(_spec.Fields.Max, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldLikes,
+		})
+	}
+	if value, ok := usuo.mutation.LikesBitAnd(); ok {
+		_spec.Fields.And = append(_spec.Fields.And, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldLikes,
+		})
+	}
+	if value, ok := usuo.mutation.LikesBitOr(); ok {
+		_spec.Fields.Or = append(_spec.Fields.Or, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: userstats.FieldLikes,
+		})
+	}
+	_node = &UserStats{config: usuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, usuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label: userstats.Label, id: _spec.Node.ID.Value}
+		} else if e, ok := err.(*sqlgraph.ConflictError); ok {
+			err = &ConflictError{label: userstats.Label, id: _spec.Node.ID.Value, version: e.Version()}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/examples/views/ent"
+	"entgo.io/ent/examples/views/ent/adult"
+	"entgo.io/ent/examples/views/ent/userstats"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func Example_view() {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	// Run the auto migration tool. Materialized views are
+	// not supported by SQLite, and therefore, are skipped.
+	if err := client.Schema.Create(ctx, schema.WithHooks(skipMaterialized)); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+	client.User.CreateBulk(
+		client.User.Create().SetName("a8m").SetAge(30),
+		client.User.Create().SetName("nati").SetAge(1),
+		client.User.Create().SetName("masseelch").SetAge(28),
+	).ExecX(ctx)
+	// Views are queried like any other type.
+	adults := client.Adult.Query().
+		Order(ent.Asc(adult.FieldAge)).
+		AllX(ctx)
+	for _, a := range adults {
+		fmt.Println(a.Name, a.Age)
+	}
+	// Views are read-only.
+	_, err = client.Adult.Create().SetName("a8m").SetAge(30).Save(ctx)
+	fmt.Println(err)
+	// Output:
+	// masseelch 28
+	// a8m 30
+	// ent: Adult is read-only, as it is backed by a view
+}
+
+func Example_reportingView() {
+	client, err := ent.Open("sqlite3", "file:report?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx, schema.WithHooks(skipMaterialized)); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(1).SaveX(ctx)
+	client.User.Create().SetName("masseelch").SetAge(28).SaveX(ctx)
+	client.Post.CreateBulk(
+		client.Post.Create().SetTitle("ent").SetLikes(10).SetAuthor(a8m),
+		client.Post.Create().SetTitle("atlas").SetLikes(5).SetAuthor(a8m),
+		client.Post.Create().SetTitle("hello").SetLikes(1).SetAuthor(nati),
+	).ExecX(ctx)
+	// Reporting entities are queried using their typed predicates.
+	stats := client.UserStats.Query().
+		Where(userstats.PostsGT(0)).
+		Order(ent.Desc(userstats.FieldLikes)).
+		AllX(ctx)
+	for _, s := range stats {
+		fmt.Println(s.Name, s.Posts, s.Likes)
+	}
+	// Output:
+	// a8m 2 15
+	// nati 1 1
+}
+
+func Example_materializedView() {
+	client, err := ent.Open("postgres", "host=localhost port=5432 user=postgres dbname=test password=pass sslmode=disable")
+	if err != nil {
+		log.Fatalf("failed opening connection to postgres: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	// Run the auto migration tool. The materialized view and its
+	// indexes are created (or replaced) in case they were changed.
+	if err := client.Schema.Create(ctx); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+	// Refresh the contents of the view without blocking its readers.
+	if err := client.DailySignup.Refresh(ctx, ent.Concurrently); err != nil {
+		log.Fatalf("failed refreshing view: %v", err)
+	}
+	for _, s := range client.DailySignup.Query().AllX(ctx) {
+		fmt.Println(s.Day.Format("2006-01-02"), s.Signups)
+	}
+}
+
+// skipMaterialized is a migration hook that skips the creation of materialized views.
+func skipMaterialized(next schema.Creator) schema.Creator {
+	return schema.CreateFunc(func(ctx context.Context, tables ...*schema.Table) error {
+		var filtered []*schema.Table
+		for _, t := range tables {
+			if !t.IsView() || !t.Annotation.Materialized {
+				filtered = append(filtered, t)
+			}
+		}
+		return next.Create(ctx, filtered...)
+	})
+}
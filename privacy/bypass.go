@@ -0,0 +1,115 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package privacy
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"strings"
+
+	"entgo.io/ent"
+)
+
+type (
+	// Bypass defines the scope of a privacy bypass token. Unlike the Allow decision context,
+	// a bypass token skips the policies only for the operations and entity types in its scope,
+	// expires with its context, and reports an audit record whenever it is used.
+	Bypass struct {
+		// Reason describes why the policies are bypassed. It is
+		// reported in the audit records of the bypass token.
+		Reason string
+
+		// Types holds the names of the entity types that the token applies
+		// to (e.g. "User"). The token does not apply to any type if empty.
+		Types []string
+
+		// Ops holds the mutation operations that the token applies to. For example,
+		// ent.OpUpdate|ent.OpUpdateOne. Mutations are not bypassed if it is zero.
+		Ops ent.Op
+
+		// Query reports whether the token applies also to the queries of the Types.
+		Query bool
+
+		// Audit is called whenever the token is used to bypass the policies of an
+		// operation. If nil, the records are written using the standard logger.
+		Audit func(context.Context, BypassRecord)
+	}
+
+	// BypassRecord is the audit record of a bypass token usage.
+	BypassRecord struct {
+		// Reason of the bypass token.
+		Reason string
+		// Type is the name of the entity type.
+		Type string
+		// Op is the operation of the bypassed mutation, or zero for queries.
+		Op ent.Op
+	}
+
+	// bypassToken is the bypass token stored in the context.
+	bypassToken struct {
+		Bypass
+		ctx context.Context
+	}
+
+	bypassCtxKey struct{}
+)
+
+// BypassContext returns a new context from the given parent context with a bypass token attached
+// to it. The token expires when the returned context is done, or when the returned cancel function
+// is called, whichever happens first. For example:
+//
+//	ctx, cancel := privacy.BypassContext(ctx, privacy.Bypass{
+//		Reason: "nightly cleanup job",
+//		Types:  []string{"Session"},
+//		Ops:    ent.OpDelete,
+//	})
+//	defer cancel()
+//
+func BypassContext(parent context.Context, b Bypass) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	return context.WithValue(ctx, bypassCtxKey{}, &bypassToken{Bypass: b, ctx: ctx}), cancel
+}
+
+// bypassed reports whether the policy evaluation of the operation is bypassed by a
+// token in the context. The scope function returns the entity type and the operation
+// of the evaluated query or mutation, and it is called only if there is a valid token.
+func bypassed(ctx context.Context, scope func() (string, ent.Op)) bool {
+	token, ok := ctx.Value(bypassCtxKey{}).(*bypassToken)
+	if !ok || token.ctx.Err() != nil {
+		return false
+	}
+	typ, op := scope()
+	switch {
+	case op == 0 && !token.Query, op != 0 && !op.Is(token.Ops):
+		return false
+	}
+	for _, t := range token.Types {
+		if t == typ {
+			r := BypassRecord{Reason: token.Reason, Type: typ, Op: op}
+			if token.Audit != nil {
+				token.Audit(ctx, r)
+			} else {
+				log.Printf("ent/privacy: bypass token %q used for %s (%s)", r.Reason, r.Type, r.opName())
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// opName returns the name of the operation in the record.
+func (r BypassRecord) opName() string {
+	if r.Op == 0 {
+		return "Query"
+	}
+	return strings.TrimPrefix(r.Op.String(), "Op")
+}
+
+// queryType returns the entity type of the given query. Note that
+// the query builders are generated as <Type>Query (e.g. UserQuery).
+func queryType(q ent.Query) string {
+	return strings.TrimSuffix(reflect.Indirect(reflect.ValueOf(q)).Type().Name(), "Query")
+}
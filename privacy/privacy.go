@@ -88,7 +88,9 @@ type Policies []ent.Policy
 // EvalQuery evaluates the query policies. If the Allow error is returned
 // from one of the policies, it stops the evaluation with a nil error.
 func (policies Policies) EvalQuery(ctx context.Context, q ent.Query) error {
-	return policies.eval(ctx, func(policy ent.Policy) error {
+	return policies.eval(ctx, func() (string, ent.Op) {
+		return queryType(q), 0
+	}, func(policy ent.Policy) error {
 		return policy.EvalQuery(ctx, q)
 	})
 }
@@ -96,15 +98,20 @@ func (policies Policies) EvalQuery(ctx context.Context, q ent.Query) error {
 // EvalMutation evaluates the mutation policies. If the Allow error is returned
 // from one of the policies, it stops the evaluation with a nil error.
 func (policies Policies) EvalMutation(ctx context.Context, m ent.Mutation) error {
-	return policies.eval(ctx, func(policy ent.Policy) error {
+	return policies.eval(ctx, func() (string, ent.Op) {
+		return m.Type(), m.Op()
+	}, func(policy ent.Policy) error {
 		return policy.EvalMutation(ctx, m)
 	})
 }
 
-func (policies Policies) eval(ctx context.Context, eval func(ent.Policy) error) error {
+func (policies Policies) eval(ctx context.Context, scope func() (string, ent.Op), eval func(ent.Policy) error) error {
 	if decision, ok := DecisionFromContext(ctx); ok {
 		return decision
 	}
+	if bypassed(ctx, scope) {
+		return nil
+	}
 	for _, policy := range policies {
 		switch decision := eval(policy); {
 		case decision == nil || errors.Is(decision, Skip):
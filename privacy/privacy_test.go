@@ -56,6 +56,69 @@ func TestNewPolicies(t *testing.T) {
 	assert.Equal(t, 8, *(ctx.Value(key).(*int)))
 }
 
+func TestBypassContext(t *testing.T) {
+	var (
+		records []privacy.BypassRecord
+		deny    = privacy.NewPolicies(policyFunc(func(context.Context) error { return privacy.Deny }))
+	)
+	ctx, cancel := privacy.BypassContext(context.Background(), privacy.Bypass{
+		Reason: "cleanup",
+		Types:  []string{"User"},
+		Ops:    ent.OpUpdate | ent.OpDelete,
+		Audit: func(_ context.Context, r privacy.BypassRecord) {
+			records = append(records, r)
+		},
+	})
+	assert.NoError(t, deny.EvalMutation(ctx, mutation{typ: "User", op: ent.OpDelete}))
+	assert.NoError(t, deny.EvalMutation(ctx, mutation{typ: "User", op: ent.OpUpdate}))
+	err := deny.EvalMutation(ctx, mutation{typ: "User", op: ent.OpCreate})
+	assert.True(t, errors.Is(err, privacy.Deny), "operation is not in the token scope")
+	err = deny.EvalMutation(ctx, mutation{typ: "Group", op: ent.OpDelete})
+	assert.True(t, errors.Is(err, privacy.Deny), "type is not in the token scope")
+	err = deny.EvalQuery(ctx, &UserQuery{})
+	assert.True(t, errors.Is(err, privacy.Deny), "token does not apply to queries")
+	assert.Equal(t, []privacy.BypassRecord{
+		{Reason: "cleanup", Type: "User", Op: ent.OpDelete},
+		{Reason: "cleanup", Type: "User", Op: ent.OpUpdate},
+	}, records)
+
+	records = nil
+	denyctx := privacy.DecisionContext(ctx, privacy.Deny)
+	err = deny.EvalMutation(denyctx, mutation{typ: "User", op: ent.OpDelete})
+	assert.True(t, errors.Is(err, privacy.Deny), "decision context precedes bypass tokens")
+	cancel()
+	err = deny.EvalMutation(ctx, mutation{typ: "User", op: ent.OpDelete})
+	assert.True(t, errors.Is(err, privacy.Deny), "token expires with its context")
+	assert.Empty(t, records)
+
+	ctx, cancel = privacy.BypassContext(context.Background(), privacy.Bypass{
+		Reason: "reporting",
+		Types:  []string{"User"},
+		Query:  true,
+		Audit: func(_ context.Context, r privacy.BypassRecord) {
+			records = append(records, r)
+		},
+	})
+	defer cancel()
+	assert.NoError(t, deny.EvalQuery(ctx, &UserQuery{}))
+	err = deny.EvalMutation(ctx, mutation{typ: "User", op: ent.OpDelete})
+	assert.True(t, errors.Is(err, privacy.Deny))
+	assert.Equal(t, []privacy.BypassRecord{{Reason: "reporting", Type: "User"}}, records)
+}
+
+// UserQuery mimics a generated query builder.
+type UserQuery struct{}
+
+// mutation mimics a generated mutation.
+type mutation struct {
+	ent.Mutation
+	typ string
+	op  ent.Op
+}
+
+func (m mutation) Type() string { return m.typ }
+func (m mutation) Op() ent.Op   { return m.op }
+
 type policyFunc func(context.Context) error
 
 func (f policyFunc) Policy() ent.Policy {
@@ -6,8 +6,10 @@ package edge
 
 import (
 	"reflect"
+	"strings"
 
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
 )
 
 // A Descriptor for edge configuration.
@@ -25,6 +27,8 @@ type Descriptor struct {
 	StorageKey  *StorageKey            // optional storage-key configuration.
 	Annotations []schema.Annotation    // edge annotations.
 	Comment     string                 // edge comment.
+	External    *field.TypeInfo        // external entity type; external edges only.
+	Types       []string               // target types; polymorphic edges only.
 }
 
 // To defines an association edge between two vertices.
@@ -37,6 +41,54 @@ func From(name string, t interface{}) *inverseBuilder {
 	return &inverseBuilder{desc: &Descriptor{Name: name, Type: typ(t), Inverse: true}}
 }
 
+// External defines an edge to entities that are owned by another ent client or package, and are
+// referenced by their ids only. The ids are stored in the field that is bound to the edge, and the
+// referenced entities are loaded using a typed loader interface that is generated for the edge and
+// implemented by the owner of the entities. For example:
+//
+//	field.Int("account_id").
+//		Optional(),
+//
+//	edge.External("account", accounts.Account{}).
+//		Field("account_id"),
+//
+func External(name string, t interface{}) *externalBuilder {
+	rt := reflect.TypeOf(t)
+	if rt.Kind() == reflect.Struct {
+		rt = reflect.PtrTo(rt)
+	}
+	tv := rt
+	for tv.Kind() == reflect.Ptr {
+		tv = tv.Elem()
+	}
+	info := &field.TypeInfo{
+		Type:     field.TypeOther,
+		Ident:    rt.String(),
+		PkgPath:  tv.PkgPath(),
+		Nillable: rt.Kind() == reflect.Ptr || rt.Kind() == reflect.Interface,
+	}
+	if i := strings.LastIndexByte(tv.String(), '.'); i != -1 {
+		info.PkgName = tv.String()[:i]
+	}
+	return &externalBuilder{desc: &Descriptor{Name: name, Type: tv.Name(), Unique: true, External: info}}
+}
+
+// Polymorphic defines a unique edge that points to an entity of one of the given types. The edge is
+// stored using a nullable foreign-key for each type, and the generated code provides an interface
+// for the entities it can point to. For example, a comment that is owned by a user or an organization:
+//
+//	edge.Polymorphic("owner", User.Type, Organization.Type)
+//
+// The example above generates the "owner_user" and "owner_organization" edges, and the CommentOwner
+// interface that is implemented by both *User and *Organization, and returned by Comment.QueryOwner.
+func Polymorphic(name string, types ...interface{}) *polymorphicBuilder {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = typ(t)
+	}
+	return &polymorphicBuilder{desc: &Descriptor{Name: name, Unique: true, Types: names}}
+}
+
 func typ(t interface{}) string {
 	if rt := reflect.TypeOf(t); rt.NumIn() > 0 {
 		return rt.In(0).Name()
@@ -187,6 +239,24 @@ func (b *inverseBuilder) Field(f string) *inverseBuilder {
 	return b
 }
 
+// StorageKey sets the storage key of the edge. Note that, the storage key can be
+// defined on either the assoc edge (edge.To) or its inverse, but not on both.
+//
+//	edge.From("owner", User.Type).
+//		Ref("pets").
+//		Unique().
+//		StorageKey(edge.Column("owner_id"))
+//
+func (b *inverseBuilder) StorageKey(opts ...StorageOption) *inverseBuilder {
+	if b.desc.StorageKey == nil {
+		b.desc.StorageKey = &StorageKey{}
+	}
+	for i := range opts {
+		opts[i](b.desc.StorageKey)
+	}
+	return b
+}
+
 // Through allows setting an "edge schema" to interact explicitly with M2M edges.
 //
 //	edge.From("liked_users", User.Type).
@@ -216,6 +286,59 @@ func (b *inverseBuilder) Descriptor() *Descriptor {
 	return b.desc
 }
 
+// externalBuilder is the builder for external edges.
+type externalBuilder struct {
+	desc *Descriptor
+}
+
+// Field binds the external edge to the field that holds the id of the referenced entity.
+// Unlike other edges, external edges must be bound to a field.
+func (b *externalBuilder) Field(f string) *externalBuilder {
+	b.desc.Field = f
+	return b
+}
+
+// Comment used to put annotations on the schema.
+func (b *externalBuilder) Comment(c string) *externalBuilder {
+	b.desc.Comment = c
+	return b
+}
+
+// Annotations adds a list of annotations to the edge object to be used by
+// codegen extensions.
+func (b *externalBuilder) Annotations(annotations ...schema.Annotation) *externalBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Descriptor implements the ent.Descriptor interface.
+func (b *externalBuilder) Descriptor() *Descriptor {
+	return b.desc
+}
+
+// polymorphicBuilder is the builder for polymorphic edges.
+type polymorphicBuilder struct {
+	desc *Descriptor
+}
+
+// Comment used to put annotations on the schema.
+func (b *polymorphicBuilder) Comment(c string) *polymorphicBuilder {
+	b.desc.Comment = c
+	return b
+}
+
+// Annotations adds a list of annotations to the edge object to be used by
+// codegen extensions. They are added to the edges of all types as well.
+func (b *polymorphicBuilder) Annotations(annotations ...schema.Annotation) *polymorphicBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Descriptor implements the ent.Descriptor interface.
+func (b *polymorphicBuilder) Descriptor() *Descriptor {
+	return b.desc
+}
+
 // StorageKey holds the configuration for edge storage-key.
 type StorageKey struct {
 	Table   string   // Table or label.
@@ -97,6 +97,14 @@ func TestEdge(t *testing.T) {
 	assert.Equal("followers", from.Tag)
 	assert.Equal("following", from.Ref.Tag)
 	assert.Equal(edge.StorageKey{Table: "user_followers", Symbols: []string{"users_followers"}, Columns: []string{"following_id", "followers_id"}}, *from.Ref.StorageKey)
+
+	from = edge.From("owner", User.Type).
+		Ref("pets").
+		Unique().
+		StorageKey(edge.Column("owner_id"), edge.Symbol("pets_owner")).
+		Descriptor()
+	assert.Nil(from.Ref)
+	assert.Equal(edge.StorageKey{Symbols: []string{"pets_owner"}, Columns: []string{"owner_id"}}, *from.StorageKey)
 }
 
 type GQL struct {
@@ -125,3 +133,37 @@ func TestAnnotations(t *testing.T) {
 	require.Equal(t, []schema.Annotation{GQL{Field: "from"}}, bidi.Annotations)
 	require.Equal(t, []schema.Annotation{GQL{Field: "to"}}, bidi.Ref.Annotations)
 }
+
+func TestExternal(t *testing.T) {
+	e := edge.External("annotation", GQL{}).
+		Field("annotation_id").
+		Comment("comment").
+		Descriptor()
+	require.Equal(t, "annotation", e.Name)
+	require.Equal(t, "GQL", e.Type)
+	require.Equal(t, "annotation_id", e.Field)
+	require.Equal(t, "comment", e.Comment)
+	require.True(t, e.Unique)
+	require.Equal(t, "*edge_test.GQL", e.External.Ident)
+	require.Equal(t, "edge_test", e.External.PkgName)
+	require.Equal(t, "entgo.io/ent/schema/edge_test", e.External.PkgPath)
+	require.True(t, e.External.Nillable)
+
+	e = edge.External("annotation", (*GQL)(nil)).Descriptor()
+	require.Equal(t, "GQL", e.Type)
+	require.Equal(t, "*edge_test.GQL", e.External.Ident)
+}
+
+func TestPolymorphic(t *testing.T) {
+	type User struct{ ent.Schema }
+	type Org struct{ ent.Schema }
+	e := edge.Polymorphic("owner", User.Type, Org.Type).
+		Comment("comment").
+		Descriptor()
+	require.Equal(t, "owner", e.Name)
+	require.Empty(t, e.Type)
+	require.Equal(t, []string{"User", "Org"}, e.Types)
+	require.Equal(t, "comment", e.Comment)
+	require.True(t, e.Unique)
+	require.False(t, e.Inverse)
+}
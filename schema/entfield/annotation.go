@@ -0,0 +1,61 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entfield provides annotations for configuring the access
+// of the public API to the schema fields in the generated code.
+package entfield
+
+import "entgo.io/ent/schema"
+
+// Annotation is a schema annotation for configuring
+// the access of the public API to a schema field.
+type Annotation struct {
+	// ReadOnlyAPI indicates that the field can be read by the public API, but it
+	// can be set only by the system (e.g. hooks or internal services). See the
+	// ReadOnlyAPI function for more info.
+	ReadOnlyAPI bool `json:"read_only_api,omitempty"`
+}
+
+// ReadOnlyAPI marks the field as read-only in the public API. The field is excluded
+// from the generated public types (e.g. patches), and mutations that were executed
+// with an API context (see the generated APIContext function) fail if they set or
+// clear the field. Internal code can set the field as usual. For example:
+//
+//	field.Int("balance").
+//		Annotations(
+//			entfield.ReadOnlyAPI(),
+//		)
+//
+func ReadOnlyAPI() *Annotation {
+	return &Annotation{ReadOnlyAPI: true}
+}
+
+// Name describes the annotation name.
+func (Annotation) Name() string {
+	return "EntField"
+}
+
+// Merge implements the schema.Merger interface.
+func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
+	var ant Annotation
+	switch other := other.(type) {
+	case Annotation:
+		ant = other
+	case *Annotation:
+		if other != nil {
+			ant = *other
+		}
+	default:
+		return a
+	}
+	if ant.ReadOnlyAPI {
+		a.ReadOnlyAPI = true
+	}
+	return a
+}
+
+var _ interface {
+	schema.Annotation
+	schema.Merger
+} = (*Annotation)(nil)
@@ -0,0 +1,108 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entlineage provides annotations for describing the lineage of
+// the schema fields (e.g. owner team, data classification and retention),
+// that are exposed by the describe command and the generated code.
+package entlineage
+
+import (
+	"time"
+
+	"entgo.io/ent/schema"
+)
+
+// Classification describes the sensitivity of the data stored in a field.
+type Classification string
+
+// Common data classifications.
+const (
+	Public       Classification = "public"
+	Internal     Classification = "internal"
+	Confidential Classification = "confidential"
+	Restricted   Classification = "restricted"
+)
+
+// Annotation is a schema annotation for describing the lineage of schema fields.
+// When it is defined on a schema, it applies to all of its fields, and annotations
+// that are defined on the fields override it. For example:
+//
+//	func (User) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entlineage.Owner("identity"),
+//		}
+//	}
+//
+//	func (User) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.String("email").
+//				Annotations(
+//					entlineage.Classify(entlineage.Confidential),
+//					entlineage.Retention(90*24*time.Hour),
+//				),
+//		}
+//	}
+type Annotation struct {
+	// Owner is the team that owns the data of the field.
+	Owner string `json:"owner,omitempty"`
+	// Classification of the data stored in the field.
+	Classification Classification `json:"classification,omitempty"`
+	// Retention is the period for which the data of the field is retained.
+	Retention time.Duration `json:"retention,omitempty"`
+}
+
+// Owner sets the team that owns the data of the field.
+func Owner(team string) *Annotation {
+	return &Annotation{Owner: team}
+}
+
+// Classify sets the classification of the data stored in the field.
+func Classify(c Classification) *Annotation {
+	return &Annotation{Classification: c}
+}
+
+// Retention sets the period for which the data of the field is retained.
+func Retention(d time.Duration) *Annotation {
+	return &Annotation{Retention: d}
+}
+
+// Name describes the annotation name.
+func (Annotation) Name() string {
+	return "Lineage"
+}
+
+// Merge implements the schema.Merger interface.
+func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
+	var ant Annotation
+	switch other := other.(type) {
+	case Annotation:
+		ant = other
+	case *Annotation:
+		if other != nil {
+			ant = *other
+		}
+	default:
+		return a
+	}
+	if ant.Owner != "" {
+		a.Owner = ant.Owner
+	}
+	if ant.Classification != "" {
+		a.Classification = ant.Classification
+	}
+	if ant.Retention != 0 {
+		a.Retention = ant.Retention
+	}
+	return a
+}
+
+// IsZero reports if the annotation does not describe any lineage information.
+func (a Annotation) IsZero() bool {
+	return a == Annotation{}
+}
+
+var _ interface {
+	schema.Annotation
+	schema.Merger
+} = (*Annotation)(nil)
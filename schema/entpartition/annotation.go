@@ -0,0 +1,73 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entpartition provides annotations for declaring the time-range partitioning
+// of the schemas, that is enforced on the queries of the generated query builders.
+package entpartition
+
+import (
+	"time"
+
+	"entgo.io/ent/schema"
+)
+
+// Annotation is a schema annotation for declaring that the table of a schema is partitioned
+// by a time field (e.g. a time-series table). The queries of the schema are required to be
+// restricted to a time range on this field using the generated Range method, which compiles
+// to plain comparisons on the column that allow the database to prune the partitions that are
+// out of range. Queries without a range are rejected, unless they explicitly opt out using
+// the generated AllPartitions method. For example:
+//
+//	func (Event) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entpartition.TimeRange("occurred_at", 31*24*time.Hour),
+//		}
+//	}
+//
+type Annotation struct {
+	// Field is the name of the time field that the table is partitioned by.
+	Field string `json:"field,omitempty"`
+	// MaxRange bounds the length of the time ranges of the queries.
+	// Zero means that ranges of any length are accepted.
+	MaxRange time.Duration `json:"max_range,omitempty"`
+}
+
+// TimeRange returns a partitioning annotation that requires the queries of the schema to be
+// restricted to a time range on the given field, that is not longer than the given maximum.
+// Zero maximum means that ranges of any length are accepted.
+func TimeRange(field string, max time.Duration) *Annotation {
+	return &Annotation{Field: field, MaxRange: max}
+}
+
+// Name describes the annotation name.
+func (Annotation) Name() string {
+	return "Partition"
+}
+
+// Merge implements the schema.Merger interface.
+func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
+	var ant Annotation
+	switch other := other.(type) {
+	case Annotation:
+		ant = other
+	case *Annotation:
+		if other != nil {
+			ant = *other
+		}
+	default:
+		return a
+	}
+	if ant.Field != "" {
+		a.Field = ant.Field
+	}
+	if ant.MaxRange != 0 {
+		a.MaxRange = ant.MaxRange
+	}
+	return a
+}
+
+var _ interface {
+	schema.Annotation
+	schema.Merger
+} = (*Annotation)(nil)
@@ -0,0 +1,69 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entproto provides annotations for configuring the protocol buffers
+// definitions that are generated from the schema by the entc/proto extension.
+package entproto
+
+import "entgo.io/ent/schema"
+
+// Annotation is a schema annotation for configuring the protocol
+// buffers definitions of a schema type, field or edge.
+type Annotation struct {
+	// Field is the number of the field (or edge) in the generated message.
+	Field int `json:"field,omitempty"`
+	// Skip excludes the type, field or edge from the generated definitions.
+	Skip bool `json:"skip,omitempty"`
+}
+
+// Field sets the number of the field (or edge) in the generated message. Fields that are not
+// annotated are numbered by their position in the schema, and therefore, annotating them is
+// required for keeping the messages compatible when fields are added or removed. For example:
+//
+//	field.String("name").
+//		Annotations(
+//			entproto.Field(2),
+//		)
+//
+// Note that number 1 is reserved for the id field.
+func Field(n int) *Annotation {
+	return &Annotation{Field: n}
+}
+
+// Skip excludes the type, field or edge from the generated definitions.
+func Skip() *Annotation {
+	return &Annotation{Skip: true}
+}
+
+// Name describes the annotation name.
+func (Annotation) Name() string {
+	return "EntProto"
+}
+
+// Merge implements the schema.Merger interface.
+func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
+	var ant Annotation
+	switch other := other.(type) {
+	case Annotation:
+		ant = other
+	case *Annotation:
+		if other != nil {
+			ant = *other
+		}
+	default:
+		return a
+	}
+	if ant.Field != 0 {
+		a.Field = ant.Field
+	}
+	if ant.Skip {
+		a.Skip = true
+	}
+	return a
+}
+
+var _ interface {
+	schema.Annotation
+	schema.Merger
+} = (*Annotation)(nil)
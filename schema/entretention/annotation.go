@@ -0,0 +1,111 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entretention provides annotations for declaring the retention policies
+// of the schemas, that are executed by the code generated by the "sql/retention"
+// feature-flag.
+package entretention
+
+import (
+	"time"
+
+	"entgo.io/ent/schema"
+)
+
+// Strategy describes how the entities are handled when their retention window expires.
+type Strategy string
+
+// Retention strategies.
+const (
+	// StrategyDelete deletes the expired entities.
+	StrategyDelete Strategy = "delete"
+	// StrategyAnonymize clears the personal fields of the expired entities, and keeps them.
+	StrategyAnonymize Strategy = "anonymize"
+	// StrategyArchive stores the expired entities in an archive (e.g. a blob store), and deletes them.
+	StrategyArchive Strategy = "archive"
+)
+
+// Annotation is a schema annotation for declaring the retention policy of a schema.
+// The retention window of an entity is measured from the value of a time field of
+// the schema, and entities that their window expired are handled by the strategy
+// of the policy. For example:
+//
+//	func (Session) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entretention.Delete("created_at", 30*24*time.Hour),
+//		}
+//	}
+//
+//	func (User) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entretention.Anonymize("last_login", 2*365*24*time.Hour, "email", "phone"),
+//		}
+//	}
+//
+type Annotation struct {
+	// Field is the name of the time field that the retention window is measured from.
+	Field string `json:"field,omitempty"`
+	// Window is the period for which the entities are retained.
+	Window time.Duration `json:"window,omitempty"`
+	// Strategy of the policy. Defaults to StrategyDelete.
+	Strategy Strategy `json:"strategy,omitempty"`
+	// Fields holds the optional fields that are cleared by the anonymize strategy.
+	Fields []string `json:"fields,omitempty"`
+}
+
+// Delete returns a retention policy that deletes the entities when
+// the given window has passed since the time of the given field.
+func Delete(field string, window time.Duration) *Annotation {
+	return &Annotation{Field: field, Window: window, Strategy: StrategyDelete}
+}
+
+// Anonymize returns a retention policy that clears the given optional fields of
+// the entities when the given window has passed since the time of the given field.
+func Anonymize(field string, window time.Duration, fields ...string) *Annotation {
+	return &Annotation{Field: field, Window: window, Strategy: StrategyAnonymize, Fields: fields}
+}
+
+// Archive returns a retention policy that archives and deletes the entities when
+// the given window has passed since the time of the given field.
+func Archive(field string, window time.Duration) *Annotation {
+	return &Annotation{Field: field, Window: window, Strategy: StrategyArchive}
+}
+
+// Name describes the annotation name.
+func (Annotation) Name() string {
+	return "Retention"
+}
+
+// Merge implements the schema.Merger interface.
+func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
+	var ant Annotation
+	switch other := other.(type) {
+	case Annotation:
+		ant = other
+	case *Annotation:
+		if other != nil {
+			ant = *other
+		}
+	default:
+		return a
+	}
+	if ant.Field != "" {
+		a.Field = ant.Field
+	}
+	if ant.Window != 0 {
+		a.Window = ant.Window
+	}
+	if ant.Strategy != "" {
+		a.Strategy = ant.Strategy
+	}
+	if len(ant.Fields) > 0 {
+		a.Fields = ant.Fields
+	}
+	return a
+}
+
+var _ interface {
+	schema.Annotation
+	schema.Merger
+} = (*Annotation)(nil)
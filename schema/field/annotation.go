@@ -20,8 +20,8 @@ type Annotation struct {
 	//
 	StructTag map[string]string
 
-	// ID defines a multi-field schema identifier. Note,
-	// the annotation is valid only for edge schemas.
+	// ID defines a multi-field schema identifier (a composite
+	// primary key). The fields must be required and non-nillable.
 	//
 	//	func (TweetLike) Annotations() []schema.Annotation {
 	//		return []schema.Annotation{
@@ -30,10 +30,26 @@ type Annotation struct {
 	//	}
 	//
 	ID []string
+
+	// OrderFields defines the fields that are allowed to be used in dynamic
+	// ordering (e.g. the OrderByField method of the generated queries). If
+	// not set, the ID field and the fields that lead an index are allowed.
+	//
+	//	func (User) Annotations() []schema.Annotation {
+	//		return []schema.Annotation{
+	//			field.OrderFields("name", "created_at"),
+	//		}
+	//	}
+	//
+	OrderFields []string
 }
 
-// ID defines a multi-field schema identifier. Note, the
-// annotation is valid only for edge schemas.
+// ID defines a multi-field schema identifier (a composite primary key).
+// The identifier fields must be required and non-nillable, and they are
+// immutable. Since composite foreign-keys are not supported, types with
+// composite identifiers (that are not edge schemas) can be referenced only
+// by edges that hold their foreign-keys in the table of the type. Note that
+// the code generator currently supports identifiers of exactly two fields.
 //
 //	func (TweetLike) Annotations() []schema.Annotation {
 //		return []schema.Annotation{
@@ -45,6 +61,19 @@ func ID(first, second string, fields ...string) *Annotation {
 	return &Annotation{ID: append([]string{first, second}, fields...)}
 }
 
+// OrderFields defines the fields that are allowed
+// to be used in dynamic ordering of the schema.
+//
+//	func (User) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			field.OrderFields("name", "created_at"),
+//		}
+//	}
+//
+func OrderFields(fields ...string) *Annotation {
+	return &Annotation{OrderFields: fields}
+}
+
 // Name describes the annotation name.
 func (Annotation) Name() string {
 	return "Fields"
@@ -72,6 +101,9 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if len(ant.ID) > 0 {
 		a.ID = ant.ID
 	}
+	if len(ant.OrderFields) > 0 {
+		a.OrderFields = append(a.OrderFields, ant.OrderFields...)
+	}
 	return a
 }
 
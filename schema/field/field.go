@@ -181,6 +181,18 @@ func (b *stringBuilder) Sensitive() *stringBuilder {
 	return b
 }
 
+// Codec sets the codec that encodes the field values before they are written to the
+// database, and decodes them after they are read from it. For example, for encrypting
+// them at rest:
+//
+//	field.String("ssn").
+//		Codec(aesCodec)
+//
+func (b *stringBuilder) Codec(c Codec) *stringBuilder {
+	b.desc.Codec = c
+	return b
+}
+
 // Match adds a regex matcher for this field. Operation fails if the regex fails.
 func (b *stringBuilder) Match(re *regexp.Regexp) *stringBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v string) error {
@@ -295,7 +307,7 @@ func (b *stringBuilder) StorageKey(key string) *stringBuilder {
 //		})
 //
 func (b *stringBuilder) SchemaType(types map[string]string) *stringBuilder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -444,7 +456,7 @@ func (b *timeBuilder) Descriptor() *Descriptor {
 //		})
 //
 func (b *timeBuilder) SchemaType(types map[string]string) *timeBuilder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -568,6 +580,13 @@ func (b *bytesBuilder) Sensitive() *bytesBuilder {
 	return b
 }
 
+// Codec sets the codec that encodes the field values before they are written to
+// the database, and decodes them after they are read from it.
+func (b *bytesBuilder) Codec(c Codec) *bytesBuilder {
+	b.desc.Codec = c
+	return b
+}
+
 // Unique makes the field unique within all vertices of this type.
 // Only supported in PostgreSQL.
 func (b *bytesBuilder) Unique() *bytesBuilder {
@@ -674,7 +693,7 @@ func (b *bytesBuilder) Annotations(annotations ...schema.Annotation) *bytesBuild
 //		})
 //
 func (b *bytesBuilder) SchemaType(types map[string]string) *bytesBuilder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -723,6 +742,13 @@ func (b *jsonBuilder) Sensitive() *jsonBuilder {
 	return b
 }
 
+// Codec sets the codec that encodes the JSON encoding of the field values before
+// they are written to the database, and decodes them after they are read from it.
+func (b *jsonBuilder) Codec(c Codec) *jsonBuilder {
+	b.desc.Codec = c
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *jsonBuilder) StructTag(s string) *jsonBuilder {
 	b.desc.Tag = s
@@ -739,7 +765,7 @@ func (b *jsonBuilder) StructTag(s string) *jsonBuilder {
 //		})
 //
 func (b *jsonBuilder) SchemaType(types map[string]string) *jsonBuilder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -872,7 +898,7 @@ func (b *enumBuilder) StructTag(s string) *enumBuilder {
 //		})
 //
 func (b *enumBuilder) SchemaType(types map[string]string) *enumBuilder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -991,7 +1017,7 @@ func (b *uuidBuilder) Default(fn interface{}) *uuidBuilder {
 //		})
 //
 func (b *uuidBuilder) SchemaType(types map[string]string) *uuidBuilder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -1109,7 +1135,7 @@ func (b *otherBuilder) StorageKey(key string) *otherBuilder {
 //		})
 //
 func (b *otherBuilder) SchemaType(types map[string]string) *otherBuilder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -1157,9 +1183,21 @@ type Descriptor struct {
 	SchemaType    map[string]string       // override the schema type.
 	Annotations   []schema.Annotation     // field annotations.
 	Comment       string                  // field comment.
+	Codec         Codec                   // codec of the values at rest.
 	Err           error
 }
 
+// schemaType merges the given per-dialect types into the descriptor, and
+// overrides the types that were previously set for the same dialects.
+func (d *Descriptor) schemaType(types map[string]string) {
+	if d.SchemaType == nil {
+		d.SchemaType = make(map[string]string, len(types))
+	}
+	for k, v := range types {
+		d.SchemaType[k] = v
+	}
+}
+
 func (d *Descriptor) goType(typ interface{}, expectType reflect.Type) {
 	t := reflect.TypeOf(typ)
 	tv := indirect(t)
@@ -1262,6 +1300,27 @@ type ValueScanner interface {
 	sql.Scanner
 }
 
+// Codec transforms the values of a field at rest. For example, encrypts, compresses or
+// hashes them. Encode is called on the values before they are written to the database,
+// and Decode is called on the values that are read from it. Note that the database holds
+// the encoded values, and therefore, encoded fields can be checked only for NULL.
+type Codec interface {
+	Encode([]byte) ([]byte, error)
+	Decode([]byte) ([]byte, error)
+}
+
+// CodecFuncs returns a Codec from the given encode and decode functions.
+func CodecFuncs(encode, decode func([]byte) ([]byte, error)) Codec {
+	return codecFuncs{encode: encode, decode: decode}
+}
+
+type codecFuncs struct {
+	encode, decode func([]byte) ([]byte, error)
+}
+
+func (c codecFuncs) Encode(b []byte) ([]byte, error) { return c.encode(b) }
+func (c codecFuncs) Decode(b []byte) ([]byte, error) { return c.decode(b) }
+
 // indirect returns the type at the end of indirection.
 func indirect(t reflect.Type) reflect.Type {
 	for t.Kind() == reflect.Ptr {
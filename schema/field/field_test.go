@@ -5,6 +5,7 @@
 package field_test
 
 import (
+	"bytes"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
@@ -61,6 +62,24 @@ func TestInt(t *testing.T) {
 	assert.Equal(t, "numeric", fd.SchemaType[dialect.SQLite])
 	assert.Equal(t, "int_type", fd.SchemaType[dialect.Postgres])
 
+	fd = field.Int("age").
+		SchemaType(map[string]string{
+			dialect.MySQL:    "int",
+			dialect.Postgres: "int_type",
+		}).
+		SchemaType(map[string]string{
+			dialect.Postgres: "int4",
+			"sqlserver":      "int",
+			"clickhouse":     "Int32",
+		}).
+		Descriptor()
+	assert.Equal(t, map[string]string{
+		dialect.MySQL:    "int",
+		dialect.Postgres: "int4",
+		"sqlserver":      "int",
+		"clickhouse":     "Int32",
+	}, fd.SchemaType)
+
 	assert.Equal(t, field.TypeInt8, field.Int8("age").Descriptor().Info.Type)
 	assert.Equal(t, field.TypeInt16, field.Int16("age").Descriptor().Info.Type)
 	assert.Equal(t, field.TypeInt32, field.Int32("age").Descriptor().Info.Type)
@@ -338,6 +357,21 @@ func TestString(t *testing.T) {
 	assert.Len(t, fd.Validators, 2)
 	assert.True(t, fd.Sensitive)
 
+	upper := field.CodecFuncs(
+		func(b []byte) ([]byte, error) { return bytes.ToUpper(b), nil },
+		func(b []byte) ([]byte, error) { return bytes.ToLower(b), nil },
+	)
+	fd = field.String("name").Codec(upper).Descriptor()
+	assert.NotNil(t, fd.Codec)
+	b, err := fd.Codec.Encode([]byte("ent"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ENT", string(b))
+	b, err = fd.Codec.Decode(b)
+	assert.NoError(t, err)
+	assert.Equal(t, "ent", string(b))
+	assert.NotNil(t, field.Bytes("blob").Codec(upper).Descriptor().Codec)
+	assert.NotNil(t, field.JSON("dirs", []http.Dir{}).Codec(upper).Descriptor().Codec)
+
 	fd = field.String("name").GoType(http.Dir("dir")).Descriptor()
 	assert.NoError(t, fd.Err)
 	assert.Equal(t, "http.Dir", fd.Info.Ident)
@@ -249,7 +249,7 @@ func (b *intBuilder) StorageKey(key string) *intBuilder {
 //		})
 //
 func (b *intBuilder) SchemaType(types map[string]string) *intBuilder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -418,7 +418,7 @@ func (b *uintBuilder) StorageKey(key string) *uintBuilder {
 //		})
 //
 func (b *uintBuilder) SchemaType(types map[string]string) *uintBuilder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -597,7 +597,7 @@ func (b *int8Builder) StorageKey(key string) *int8Builder {
 //		})
 //
 func (b *int8Builder) SchemaType(types map[string]string) *int8Builder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -776,7 +776,7 @@ func (b *int16Builder) StorageKey(key string) *int16Builder {
 //		})
 //
 func (b *int16Builder) SchemaType(types map[string]string) *int16Builder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -955,7 +955,7 @@ func (b *int32Builder) StorageKey(key string) *int32Builder {
 //		})
 //
 func (b *int32Builder) SchemaType(types map[string]string) *int32Builder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -1134,7 +1134,7 @@ func (b *int64Builder) StorageKey(key string) *int64Builder {
 //		})
 //
 func (b *int64Builder) SchemaType(types map[string]string) *int64Builder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -1303,7 +1303,7 @@ func (b *uint8Builder) StorageKey(key string) *uint8Builder {
 //		})
 //
 func (b *uint8Builder) SchemaType(types map[string]string) *uint8Builder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -1472,7 +1472,7 @@ func (b *uint16Builder) StorageKey(key string) *uint16Builder {
 //		})
 //
 func (b *uint16Builder) SchemaType(types map[string]string) *uint16Builder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -1641,7 +1641,7 @@ func (b *uint32Builder) StorageKey(key string) *uint32Builder {
 //		})
 //
 func (b *uint32Builder) SchemaType(types map[string]string) *uint32Builder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -1810,7 +1810,7 @@ func (b *uint64Builder) StorageKey(key string) *uint64Builder {
 //		})
 //
 func (b *uint64Builder) SchemaType(types map[string]string) *uint64Builder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -1979,7 +1979,7 @@ func (b *float64Builder) StorageKey(key string) *float64Builder {
 //		})
 //
 func (b *float64Builder) SchemaType(types map[string]string) *float64Builder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -2132,7 +2132,7 @@ func (b *float32Builder) StorageKey(key string) *float32Builder {
 //		})
 //
 func (b *float32Builder) SchemaType(types map[string]string) *float32Builder {
-	b.desc.SchemaType = types
+	b.desc.schemaType(types)
 	return b
 }
 
@@ -0,0 +1,166 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package mixin
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+	"entgo.io/ent/schema"
+)
+
+// Extend returns a mixin that composes the given schema into the schema that uses it. It allows
+// extending schemas that are defined in other packages or modules (e.g. a shared schema library),
+// with the fields, edges and annotations that are defined by the local schema. For example:
+//
+//	// User extends the User schema of the shared library.
+//	type User struct {
+//		ent.Schema
+//	}
+//
+//	func (User) Mixin() []ent.Mixin {
+//		return []ent.Mixin{
+//			mixin.Extend(shared.User{}),
+//		}
+//	}
+//
+//	func (User) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.String("team"),
+//		}
+//	}
+//
+// The fields, edges, indexes, hooks, interceptors, policy and annotations of the given schema, including
+// the ones that are mixed into it, are mixed into the local schema before its own definitions. Fields and
+// edges that are defined by both schemas are reported as redeclared by the codegen, unless they are omitted
+// from the extended schema using Omit. Annotations that are defined by both schemas are merged if they implement
+// the schema.Merger interface (e.g. entsql.Annotation), and otherwise, the annotation of the extended schema is kept.
+// Note that edges are resolved by the names of their types, and the schema configuration (i.e. the deprecated Config
+// method) is not mixed in.
+func Extend(s ent.Interface) ent.Mixin {
+	return extender{s: s}
+}
+
+// extender composes a schema into the schema that uses it.
+type extender struct {
+	s ent.Interface
+}
+
+// Fields of the extended schema.
+func (e extender) Fields() []ent.Field {
+	var fields []ent.Field
+	for _, m := range e.s.Mixin() {
+		fields = append(fields, m.Fields()...)
+	}
+	return append(fields, e.s.Fields()...)
+}
+
+// Edges of the extended schema.
+func (e extender) Edges() []ent.Edge {
+	var edges []ent.Edge
+	for _, m := range e.s.Mixin() {
+		edges = append(edges, m.Edges()...)
+	}
+	return append(edges, e.s.Edges()...)
+}
+
+// Indexes of the extended schema.
+func (e extender) Indexes() []ent.Index {
+	var indexes []ent.Index
+	for _, m := range e.s.Mixin() {
+		indexes = append(indexes, m.Indexes()...)
+	}
+	return append(indexes, e.s.Indexes()...)
+}
+
+// Hooks of the extended schema.
+func (e extender) Hooks() []ent.Hook {
+	var hooks []ent.Hook
+	for _, m := range e.s.Mixin() {
+		hooks = append(hooks, m.Hooks()...)
+	}
+	return append(hooks, e.s.Hooks()...)
+}
+
+// Interceptors of the extended schema.
+func (e extender) Interceptors() []ent.Interceptor {
+	var inters []ent.Interceptor
+	for _, m := range e.s.Mixin() {
+		inters = append(inters, m.Interceptors()...)
+	}
+	return append(inters, e.s.Interceptors()...)
+}
+
+// Policy of the extended schema. The policies of its mixins are evaluated before its own policy.
+func (e extender) Policy() ent.Policy {
+	schemas := make([]interface{ Policy() ent.Policy }, 0, len(e.s.Mixin())+1)
+	for _, m := range e.s.Mixin() {
+		schemas = append(schemas, m)
+	}
+	switch policies := privacy.NewPolicies(append(schemas, e.s)...).(privacy.Policies); len(policies) {
+	case 0:
+		return nil
+	case 1:
+		return policies[0]
+	default:
+		return policies
+	}
+}
+
+// Annotations of the extended schema.
+func (e extender) Annotations() []schema.Annotation {
+	var annotations []schema.Annotation
+	for _, m := range e.s.Mixin() {
+		annotations = append(annotations, m.Annotations()...)
+	}
+	return append(annotations, e.s.Annotations()...)
+}
+
+// Omit returns a mixin that omits the fields and edges with the given names from the given mixin. It is
+// used for replacing the definitions of an extended schema with the definitions of the local schema:
+//
+//	func (User) Mixin() []ent.Mixin {
+//		return []ent.Mixin{
+//			// The "name" field is redefined by the User schema.
+//			mixin.Omit(mixin.Extend(shared.User{}), "name"),
+//		}
+//	}
+//
+// Note that the indexes of the mixin that use the omitted fields or edges are resolved
+// by their names, and therefore, these fields or edges must be redefined.
+func Omit(m ent.Mixin, names ...string) ent.Mixin {
+	omit := make(map[string]bool, len(names))
+	for _, n := range names {
+		omit[n] = true
+	}
+	return omitter{Mixin: m, omit: omit}
+}
+
+// omitter omits fields and edges from a mixin.
+type omitter struct {
+	ent.Mixin
+	omit map[string]bool
+}
+
+// Fields of the mixin that were not omitted.
+func (o omitter) Fields() []ent.Field {
+	var fields []ent.Field
+	for _, f := range o.Mixin.Fields() {
+		if !o.omit[f.Descriptor().Name] {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// Edges of the mixin that were not omitted.
+func (o omitter) Edges() []ent.Edge {
+	var edges []ent.Edge
+	for _, e := range o.Mixin.Edges() {
+		if !o.omit[e.Descriptor().Name] {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
@@ -0,0 +1,276 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package mixin
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"reflect"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Integrity adds a checksum field to the schema that holds a hash of the values of the
+// fields it covers. The checksum is maintained by a hook on every write, and the VerifyIntegrity
+// method detects entities whose values were changed out-of-band (i.e. not through ent), for
+// example, by manual edits or data corruption.
+//
+// Since the checksum of an entity is computed from all its covered fields, bulk updates
+// (i.e. Update) of covered fields are rejected, and should be executed using UpdateOne.
+//
+//	// Integrity of the Account schema.
+//	var AccountIntegrity = mixin.Integrity{
+//		Covers: []string{"owner", "balance"},
+//		Key:    []byte(os.Getenv("INTEGRITY_KEY")),
+//	}
+//
+//	func (Account) Mixin() []ent.Mixin {
+//		return []ent.Mixin{
+//			AccountIntegrity,
+//		}
+//	}
+//
+type Integrity struct {
+	Schema
+
+	// Covers holds the names of the fields that are covered by the checksum.
+	Covers []string
+
+	// Field is the name of the checksum field. Defaults to "checksum".
+	Field string
+
+	// Key is an optional secret key. If set, the checksum is an HMAC-SHA256 of the
+	// values, and cannot be recomputed by parties that do not hold the key. Otherwise,
+	// it is a plain SHA-256 of the values.
+	Key []byte
+
+	// Precision is the precision of the time columns in the database. Time values are
+	// truncated to it before they are hashed. Defaults to time.Microsecond.
+	Precision time.Duration
+}
+
+// Fields of the integrity mixin.
+func (i Integrity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String(i.field()).
+			Optional(),
+	}
+}
+
+// Hooks of the integrity mixin.
+func (i Integrity) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if err := i.set(ctx, m); err != nil {
+					return nil, err
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
+
+// set sets the checksum field of the mutation.
+func (i Integrity) set(ctx context.Context, m ent.Mutation) error {
+	switch op := m.Op(); {
+	case op.Is(ent.OpCreate):
+		values := make([]ent.Value, len(i.Covers))
+		for j, name := range i.Covers {
+			values[j], _ = m.Field(name)
+		}
+		return m.SetField(i.field(), i.checksum(values))
+	case op.Is(ent.OpUpdateOne):
+		values := make([]ent.Value, len(i.Covers))
+		for j, name := range i.Covers {
+			v, err := i.newValue(ctx, m, name)
+			if err != nil {
+				return err
+			}
+			values[j] = v
+		}
+		return m.SetField(i.field(), i.checksum(values))
+	case op.Is(ent.OpUpdate):
+		for _, name := range i.Covers {
+			if i.changed(m, name) {
+				return fmt.Errorf("mixin: field %q is covered by a checksum and cannot be changed by bulk updates", name)
+			}
+		}
+	}
+	return nil
+}
+
+// newValue returns the value of the given field after the UpdateOne mutation is applied.
+func (i Integrity) newValue(ctx context.Context, m ent.Mutation, name string) (ent.Value, error) {
+	if v, ok := m.Field(name); ok {
+		return v, nil
+	}
+	if m.FieldCleared(name) {
+		return nil, nil
+	}
+	old, err := m.OldField(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if delta, ok := m.AddedField(name); ok {
+		return add(old, delta)
+	}
+	return old, nil
+}
+
+// changed reports if the mutation changes the given field.
+func (Integrity) changed(m ent.Mutation, name string) bool {
+	_, set := m.Field(name)
+	_, added := m.AddedField(name)
+	return set || added || m.FieldCleared(name)
+}
+
+// VerifyIntegrity loads the entities of the given query (e.g. client.Account.Query()), and
+// returns an *IntegrityError that holds the identifiers of the entities whose checksum does
+// not match their values. Large tables should be verified in batches, using Limit and Offset.
+func (i Integrity) VerifyIntegrity(ctx context.Context, query ent.Query) error {
+	all := reflect.ValueOf(query).MethodByName("All")
+	if !all.IsValid() {
+		return fmt.Errorf("mixin: unexpected query type %T", query)
+	}
+	out := all.Call([]reflect.Value{reflect.ValueOf(ctx)})
+	if err, _ := out[1].Interface().(error); err != nil {
+		return err
+	}
+	var ids []interface{}
+	for j, nodes := 0, out[0]; j < nodes.Len(); j++ {
+		node := reflect.Indirect(nodes.Index(j))
+		values := make([]ent.Value, len(i.Covers))
+		for k, name := range i.Covers {
+			f, ok := structField(node, name)
+			if !ok {
+				return fmt.Errorf("mixin: field %q was not found in %s", name, node.Type())
+			}
+			values[k] = f.Interface()
+		}
+		sum, ok := structField(node, i.field())
+		if !ok {
+			return fmt.Errorf("mixin: checksum field %q was not found in %s", i.field(), node.Type())
+		}
+		if sum.String() != i.checksum(values) {
+			ids = append(ids, node.FieldByName("ID").Interface())
+		}
+	}
+	if len(ids) > 0 {
+		return &IntegrityError{IDs: ids}
+	}
+	return nil
+}
+
+// IntegrityError is returned by VerifyIntegrity when the checksum of
+// one or more entities does not match their stored values.
+type IntegrityError struct {
+	// IDs of the entities that failed the check.
+	IDs []interface{}
+}
+
+// Error implements the error interface.
+func (e *IntegrityError) Error() string {
+	return fmt.Sprintf("mixin: integrity check failed for %d entities: %v", len(e.IDs), e.IDs)
+}
+
+// checksum returns the hex-encoded checksum of the given values. Each value is hashed as
+// its field name, followed by its JSON encoding. Pointers are dereferenced, and zero values
+// are hashed as null, in order to match unset optional fields with the values they are
+// loaded with (e.g. nil or the zero value).
+func (i Integrity) checksum(values []ent.Value) string {
+	var h hash.Hash
+	if len(i.Key) > 0 {
+		h = hmac.New(sha256.New, i.Key)
+	} else {
+		h = sha256.New()
+	}
+	enc := json.NewEncoder(h)
+	for j, v := range values {
+		h.Write([]byte(i.Covers[j]))
+		h.Write([]byte{0})
+		rv := reflect.ValueOf(v)
+		for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+			rv = rv.Elem()
+		}
+		switch {
+		case !rv.IsValid() || rv.IsZero():
+			v = nil
+		case rv.Type() == reflect.TypeOf(time.Time{}):
+			v = rv.Interface().(time.Time).UTC().Truncate(i.precision())
+		default:
+			v = rv.Interface()
+		}
+		// Encoding errors are hashed as is, and fail the verification.
+		if err := enc.Encode(v); err != nil {
+			h.Write([]byte(err.Error()))
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (i Integrity) field() string {
+	if i.Field != "" {
+		return i.Field
+	}
+	return "checksum"
+}
+
+func (i Integrity) precision() time.Duration {
+	if i.Precision > 0 {
+		return i.Precision
+	}
+	return time.Microsecond
+}
+
+// integrity mixin must implement `Mixin` interface.
+var _ ent.Mixin = (*Integrity)(nil)
+
+// structField returns the field of the generated entity struct for the given
+// schema field name. For example, "create_time" for "CreateTime", and "url" for "URL".
+func structField(node reflect.Value, name string) (reflect.Value, bool) {
+	name = strings.ReplaceAll(name, "_", "")
+	f := node.FieldByNameFunc(func(s string) bool {
+		return strings.EqualFold(s, name)
+	})
+	return f, f.IsValid()
+}
+
+// add returns the sum of two numeric values of the same type.
+// The first value can be a pointer, as the old values of nillable fields, and a
+// nil pointer is treated as zero, like the COALESCE used by the update builders.
+func add(a, b ent.Value) (ent.Value, error) {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() == reflect.Ptr {
+		if va.IsNil() {
+			va = reflect.Zero(va.Type().Elem())
+		} else {
+			va = va.Elem()
+		}
+	}
+	if va.Type() != vb.Type() {
+		return nil, fmt.Errorf("mixin: mismatch types for add: %s and %s", va.Type(), vb.Type())
+	}
+	sum := reflect.New(va.Type()).Elem()
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		sum.SetInt(va.Int() + vb.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		sum.SetUint(va.Uint() + vb.Uint())
+	case reflect.Float32, reflect.Float64:
+		sum.SetFloat(va.Float() + vb.Float())
+	default:
+		return nil, fmt.Errorf("mixin: unexpected type %s for add", va.Type())
+	}
+	return sum.Interface(), nil
+}
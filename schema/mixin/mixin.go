@@ -33,6 +33,9 @@ func (Schema) Indexes() []ent.Index { return nil }
 // Hooks of the mixin.
 func (Schema) Hooks() []ent.Hook { return nil }
 
+// Interceptors of the mixin.
+func (Schema) Interceptors() []ent.Interceptor { return nil }
+
 // Policy of the mixin.
 func (Schema) Policy() ent.Policy { return nil }
 
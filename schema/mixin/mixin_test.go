@@ -8,8 +8,11 @@ import (
 	"testing"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/privacy"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
 
 	"github.com/stretchr/testify/assert"
@@ -45,10 +48,60 @@ func TestTimeMixin(t *testing.T) {
 	})
 }
 
+func TestTimestampsMixin(t *testing.T) {
+	fields := mixin.Timestamps{}.Fields()
+	require.Len(t, fields, 2)
+	create, update := fields[0].Descriptor(), fields[1].Descriptor()
+	assert.Equal(t, "create_time", create.Name)
+	assert.True(t, create.Immutable)
+	assert.Nil(t, create.Default)
+	assert.Equal(t, "update_time", update.Name)
+	assert.Nil(t, update.UpdateDefault)
+	assert.Empty(t, update.Annotations)
+	assert.Len(t, mixin.Timestamps{}.Hooks(), 1)
+
+	fields = mixin.Timestamps{DBDefault: true}.Fields()
+	for _, f := range fields {
+		desc := f.Descriptor()
+		require.Len(t, desc.Annotations, 1)
+		assert.Equal(t, entsql.Annotation{Default: "CURRENT_TIMESTAMP"}, desc.Annotations[0])
+	}
+}
+
 type annotation string
 
 func (annotation) Name() string { return "" }
 
+func TestSoftDeleteMixin(t *testing.T) {
+	fields := mixin.SoftDelete{}.Fields()
+	require.Len(t, fields, 1)
+	desc := fields[0].Descriptor()
+	assert.Equal(t, "deleted_at", desc.Name)
+	assert.True(t, desc.Optional)
+	assert.True(t, desc.Nillable)
+	assert.Equal(t, []schema.Annotation{entsql.SoftDelete("deleted_at")}, mixin.SoftDelete{}.Annotations())
+
+	fields = mixin.SoftDelete{Field: "removed_at"}.Fields()
+	require.Len(t, fields, 1)
+	assert.Equal(t, "removed_at", fields[0].Descriptor().Name)
+	assert.Equal(t, []schema.Annotation{entsql.SoftDelete("removed_at")}, mixin.SoftDelete{Field: "removed_at"}.Annotations())
+}
+
+func TestVersionMixin(t *testing.T) {
+	fields := mixin.Version{}.Fields()
+	require.Len(t, fields, 1)
+	desc := fields[0].Descriptor()
+	assert.Equal(t, "version", desc.Name)
+	assert.Equal(t, int64(1), desc.Default)
+	assert.True(t, desc.Immutable)
+	assert.Equal(t, []schema.Annotation{entsql.Version("version")}, mixin.Version{}.Annotations())
+
+	fields = mixin.Version{Field: "revision"}.Fields()
+	require.Len(t, fields, 1)
+	assert.Equal(t, "revision", fields[0].Descriptor().Name)
+	assert.Equal(t, []schema.Annotation{entsql.Version("revision")}, mixin.Version{Field: "revision"}.Annotations())
+}
+
 func TestAnnotateFields(t *testing.T) {
 	annotations := []schema.Annotation{
 		annotation("foo"),
@@ -98,3 +151,58 @@ func TestAnnotateEdges(t *testing.T) {
 		}
 	}
 }
+
+type SharedSchema struct {
+	ent.Schema
+}
+
+func (SharedSchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (SharedSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("email"),
+	}
+}
+
+func (SharedSchema) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("friends", SharedSchema.Type),
+	}
+}
+
+func (SharedSchema) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{},
+	}
+}
+
+func (SharedSchema) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "shared"},
+	}
+}
+
+func TestExtend(t *testing.T) {
+	m := mixin.Extend(SharedSchema{})
+	fields := m.Fields()
+	require.Len(t, fields, 4)
+	for i, name := range []string{"create_time", "update_time", "name", "email"} {
+		require.Equal(t, name, fields[i].Descriptor().Name)
+	}
+	require.Len(t, m.Edges(), 1)
+	require.Len(t, m.Hooks(), 0)
+	require.NotNil(t, m.Policy())
+	require.Equal(t, []schema.Annotation{entsql.Annotation{Table: "shared"}}, m.Annotations())
+
+	m = mixin.Omit(m, "name", "friends")
+	fields = m.Fields()
+	require.Len(t, fields, 3)
+	require.Equal(t, "email", fields[2].Descriptor().Name)
+	require.Empty(t, m.Edges())
+	require.Len(t, m.Annotations(), 1)
+}
@@ -0,0 +1,66 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package mixin
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// SoftDelete adds an optional "deleted_at" field to the schema, and annotates it with
+// entsql.SoftDelete. As a result, the generated code changes in the following way:
+//
+//   - The Delete and DeleteOne builders set the "deleted_at" field of the entities that were
+//     not deleted yet, instead of deleting their rows. Note that their hooks are still executed
+//     with an OpDelete mutation.
+//   - All queries, including edge traversals (e.g. QueryPets), eager-loading (e.g. WithPets) and
+//     aggregations, return only the entities that were not deleted.
+//   - Edge predicates (e.g. HasPets and HasPetsWith) match only neighbors that were not deleted.
+//   - The Unscoped method of the query builders includes deleted entities in the query results
+//     and in the edge predicates of the query, and the Unscoped method of the delete builders
+//     deletes the rows permanently.
+//
+// For example:
+//
+//	func (Pet) Mixin() []ent.Mixin {
+//		return []ent.Mixin{
+//			mixin.SoftDelete{},
+//		}
+//	}
+//
+type SoftDelete struct {
+	Schema
+
+	// Field is the name of the field that holds the deletion time. Defaults to "deleted_at".
+	Field string
+}
+
+// Fields of the soft-delete mixin.
+func (d SoftDelete) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time(d.field()).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Annotations of the soft-delete mixin.
+func (d SoftDelete) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.SoftDelete(d.field()),
+	}
+}
+
+func (d SoftDelete) field() string {
+	if d.Field != "" {
+		return d.Field
+	}
+	return "deleted_at"
+}
+
+// soft-delete mixin must implement `Mixin` interface.
+var _ ent.Mixin = (*SoftDelete)(nil)
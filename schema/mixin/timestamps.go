@@ -0,0 +1,194 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package mixin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+)
+
+// Timestamps adds the "create_time" and "update_time" fields to the schema. Unlike the
+// Time mixin, the fields are set by a hook that keeps the timestamps consistent, even
+// when the clocks of the application servers are skewed:
+//
+//   - Mutations that are executed with a context returned by NewTimestampContext (e.g.
+//     all mutations of a transaction) share the same timestamp.
+//   - The "update_time" of an entity updated by UpdateOne never goes backwards, and is
+//     always after its previous value. Note that this requires loading the previous value,
+//     which executes an additional query when the update was not created from an entity.
+//
+// For example:
+//
+//	func (User) Mixin() []ent.Mixin {
+//		return []ent.Mixin{
+//			mixin.Timestamps{Lock: true},
+//		}
+//	}
+//
+type Timestamps struct {
+	Schema
+
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	// Precision is the precision of the timestamp columns in the database. Timestamps are
+	// truncated to it, and a timestamp that does not advance the "update_time" of an entity
+	// is moved forward by it. Defaults to time.Microsecond. Note that MySQL columns without
+	// a fractional seconds precision (e.g. "timestamp" and not "timestamp(6)") require
+	// setting it to time.Second.
+	Precision time.Duration
+
+	// DBDefault sets the default value of the columns in the database to CURRENT_TIMESTAMP,
+	// in order to set the timestamps of rows that are inserted outside of ent.
+	DBDefault bool
+
+	// Lock enables optimistic locking using the "update_time" field. If set, UpdateOne
+	// operations are executed only if the "update_time" of the entity was not changed
+	// since it was loaded, and fail with a not-found error otherwise.
+	Lock bool
+}
+
+// Fields of the timestamps mixin.
+func (t Timestamps) Fields() []ent.Field {
+	fields := []ent.Field{
+		field.Time("create_time").
+			Immutable(),
+		field.Time("update_time"),
+	}
+	if t.DBDefault {
+		for _, f := range fields {
+			desc := f.Descriptor()
+			desc.Annotations = append(desc.Annotations, entsql.Annotation{Default: "CURRENT_TIMESTAMP"})
+		}
+	}
+	return fields
+}
+
+// Hooks of the timestamps mixin.
+func (t Timestamps) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if err := t.set(ctx, m); err != nil {
+					return nil, err
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
+
+// set sets the timestamp fields of the mutation.
+func (t Timestamps) set(ctx context.Context, m ent.Mutation) error {
+	now := t.now(ctx)
+	switch op := m.Op(); {
+	case op.Is(ent.OpCreate):
+		created, ok := m.Field("create_time")
+		if !ok {
+			created = now
+			if err := m.SetField("create_time", now); err != nil {
+				return err
+			}
+		}
+		if _, ok := m.Field("update_time"); !ok {
+			if c, ok := created.(time.Time); ok && c.After(now) {
+				now = c
+			}
+			return m.SetField("update_time", now)
+		}
+	case op.Is(ent.OpUpdateOne):
+		v, err := m.OldField(ctx, "update_time")
+		if err != nil {
+			return err
+		}
+		prev, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("mixin: unexpected type %T for field update_time", v)
+		}
+		if t.Lock {
+			w, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+			if !ok {
+				return fmt.Errorf("mixin: unexpected mutation type %T for optimistic locking", m)
+			}
+			w.WhereP(func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("update_time"), prev))
+			})
+		}
+		if _, ok := m.Field("update_time"); !ok {
+			if !now.After(prev) {
+				now = prev.Add(t.precision())
+			}
+			return m.SetField("update_time", now)
+		}
+	case op.Is(ent.OpUpdate):
+		if _, ok := m.Field("update_time"); !ok {
+			return m.SetField("update_time", now)
+		}
+	}
+	return nil
+}
+
+// now returns the current timestamp of the mutation.
+func (t Timestamps) now(ctx context.Context) time.Time {
+	nowFn := t.Now
+	if nowFn == nil {
+		nowFn = time.Now
+	}
+	if ts, ok := ctx.Value(timestampCtxKey{}).(*timestamp); ok {
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		if ts.t.IsZero() {
+			ts.t = nowFn().Truncate(t.precision())
+		}
+		return ts.t
+	}
+	return nowFn().Truncate(t.precision())
+}
+
+func (t Timestamps) precision() time.Duration {
+	if t.Precision > 0 {
+		return t.Precision
+	}
+	return time.Microsecond
+}
+
+// timestamps mixin must implement `Mixin` interface.
+var _ ent.Mixin = (*Timestamps)(nil)
+
+type (
+	// timestampCtxKey is the context key of the shared timestamp.
+	timestampCtxKey struct{}
+
+	// timestamp is the timestamp shared between the mutations
+	// that are executed with the same context.
+	timestamp struct {
+		mu sync.Mutex
+		t  time.Time
+	}
+)
+
+// NewTimestampContext returns a new context that shares the same timestamp between all
+// mutations that are executed with it by the Timestamps mixin. The timestamp is set by
+// the first mutation. For example:
+//
+//	ctx = mixin.NewTimestampContext(ctx)
+//	tx, err := client.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	// All entities get the same "create_time" and "update_time".
+//	a := tx.User.Create().SaveX(ctx)
+//	b := tx.User.Create().SaveX(ctx)
+//
+func NewTimestampContext(parent context.Context) context.Context {
+	return context.WithValue(parent, timestampCtxKey{}, &timestamp{})
+}
@@ -0,0 +1,64 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package mixin
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// Version adds an immutable "version" field to the schema that starts at 1, and annotates
+// it with entsql.Version for optimistic concurrency control. As a result, the generated code
+// changes in the following way:
+//
+//   - All updates increment the "version" field of the updated entities.
+//   - The UpdateOne builder that was created from an entity (e.g. client.Pet.UpdateOne(p) or
+//     p.Update()) updates it only if its version was not changed since it was loaded, and fails
+//     with a *ConflictError otherwise. The entity that is returned holds the new version.
+//   - The ExpectVersion method of the UpdateOne builders sets the expected version explicitly,
+//     for example, when updating an entity by its id (e.g. client.Pet.UpdateOneID(id)).
+//
+// Note that the bulk Update builders increment the version without checking it. For example:
+//
+//	func (Pet) Mixin() []ent.Mixin {
+//		return []ent.Mixin{
+//			mixin.Version{},
+//		}
+//	}
+//
+type Version struct {
+	Schema
+
+	// Field is the name of the field that holds the version. Defaults to "version".
+	Field string
+}
+
+// Fields of the version mixin.
+func (v Version) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64(v.field()).
+			Default(1).
+			Immutable(),
+	}
+}
+
+// Annotations of the version mixin.
+func (v Version) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Version(v.field()),
+	}
+}
+
+func (v Version) field() string {
+	if v.Field != "" {
+		return v.Field
+	}
+	return "version"
+}
+
+// version mixin must implement `Mixin` interface.
+var _ ent.Mixin = (*Version)(nil)
@@ -22,3 +22,84 @@ type Annotation interface {
 type Merger interface {
 	Merge(Annotation) Annotation
 }
+
+// CommentAnnotation is a builtin schema annotation for
+// configuring the comment of the schema (i.e. the type).
+type CommentAnnotation struct {
+	Text string // Comment text.
+}
+
+// Name implements the Annotation interface.
+func (*CommentAnnotation) Name() string {
+	return "Comment"
+}
+
+// Comment sets the comment of the schema. The comment is used as the Godoc
+// of the generated entity, and it is stored as the comment of the table in
+// the database. For example:
+//
+//	func (User) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			schema.Comment("User represents a registered user of the system."),
+//		}
+//	}
+//
+func Comment(text string) *CommentAnnotation {
+	return &CommentAnnotation{Text: text}
+}
+
+// BuildTargetsAnnotation is a builtin schema annotation for limiting
+// the generated code of a schema object to a set of build targets.
+type BuildTargetsAnnotation struct {
+	Targets []string // Names of the build targets.
+}
+
+// Name implements the Annotation interface.
+func (*BuildTargetsAnnotation) Name() string {
+	return "BuildTargets"
+}
+
+// Merge implements the Merger interface.
+func (a *BuildTargetsAnnotation) Merge(other Annotation) Annotation {
+	b, ok := other.(*BuildTargetsAnnotation)
+	if !ok || b == nil {
+		return a
+	}
+	targets := make([]string, 0, len(a.Targets)+len(b.Targets))
+	targets = append(targets, a.Targets...)
+	return &BuildTargetsAnnotation{Targets: append(targets, b.Targets...)}
+}
+
+// Includes reports if the given build target is included in the annotation.
+func (a *BuildTargetsAnnotation) Includes(target string) bool {
+	for _, t := range a.Targets {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildTargets limits the generated code of the annotated schema, field or edge to the given
+// build targets. Schema objects without this annotation are generated for all targets, and
+// all objects are generated when the codegen is executed without a build target. For example:
+//
+//	func (Audit) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			// The Audit type is generated only for the "server" target.
+//			schema.BuildTargets("server"),
+//		}
+//	}
+//
+//	func (User) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.String("name"),
+//			field.Bytes("avatar").
+//				Optional().
+//				Annotations(schema.BuildTargets("server", "cli")),
+//		}
+//	}
+//
+func BuildTargets(targets ...string) *BuildTargetsAnnotation {
+	return &BuildTargetsAnnotation{Targets: targets}
+}